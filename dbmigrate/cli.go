@@ -0,0 +1,105 @@
+package dbmigrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+// Usage migrate 子命令用法
+const Usage = `用法: migrate <command>
+
+  up        执行全部未执行的迁移
+  down N    按版本倒序回滚最近执行的 N 个迁移
+  status    列出每个迁移的执行状态
+  verify    校验数据库与当前版本一致（有未执行或被修改的迁移时退出码非 0）`
+
+// ErrUsage 子命令参数错误
+var ErrUsage = errors.New("参数错误\n" + Usage)
+
+// Run 执行 migrate 子命令：up | down N | status | verify
+func Run(ctx context.Context, m *Migrator, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return ErrUsage
+	}
+
+	switch args[0] {
+	case "up":
+		if len(args) != 1 {
+			return ErrUsage
+		}
+
+		done, err := m.Up(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(out, "已执行 %d 个迁移\n", len(done))
+
+		return err
+	case "down":
+		if len(args) != 2 {
+			return ErrUsage
+		}
+
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n <= 0 {
+			return ErrUsage
+		}
+
+		done, err := m.Down(ctx, n)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(out, "已回滚 %d 个迁移\n", len(done))
+
+		return err
+	case "status":
+		if len(args) != 1 {
+			return ErrUsage
+		}
+
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		return writeStatus(out, statuses)
+	case "verify":
+		if len(args) != 1 {
+			return ErrUsage
+		}
+
+		if err := m.Verify(ctx); err != nil {
+			return err
+		}
+
+		_, err := fmt.Fprintln(out, "迁移校验通过")
+
+		return err
+	default:
+		return ErrUsage
+	}
+}
+
+// writeStatus 以表格输出迁移状态
+func writeStatus(out io.Writer, statuses []MigrationStatus) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATE\tAPPLIED_AT")
+
+	for _, st := range statuses {
+		appliedAt := "-"
+		if !st.AppliedAt.IsZero() {
+			appliedAt = st.AppliedAt.Local().Format(time.DateTime)
+		}
+
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", st.Version, st.Name, st.State, appliedAt)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,58 @@
+package dbmigrate
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_Usage(t *testing.T) {
+	m := New(nil, nil)
+
+	cases := [][]string{
+		nil,
+		{"sideways"},
+		{"up", "extra"},
+		{"down"},
+		{"down", "0"},
+		{"down", "x"},
+		{"status", "extra"},
+		{"verify", "extra"},
+	}
+
+	for _, args := range cases {
+		err := Run(context.Background(), m, args, &bytes.Buffer{})
+		assert.ErrorIs(t, err, ErrUsage, "args=%v", args)
+	}
+}
+
+func TestWriteStatus(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := writeStatus(&buf, []MigrationStatus{
+		{Version: 1, Name: "baseline", State: StateApplied, AppliedAt: time.Now()},
+		{Version: 2, Name: "add_index", State: StatePending},
+	})
+
+	require.NoError(t, err)
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 3)
+	assert.Contains(t, string(lines[0]), "VERSION")
+	assert.Contains(t, string(lines[1]), "baseline")
+	assert.Contains(t, string(lines[2]), "pending")
+	assert.Contains(t, string(lines[2]), "-")
+}
+
+func TestNew_LockKeyDerivedFromTable(t *testing.T) {
+	a := New(nil, nil)
+	b := New(nil, nil, WithTable("policy_schema_migrations"))
+
+	assert.Equal(t, DefaultTable, a.table)
+	assert.NotEqual(t, a.lockKey, b.lockKey)
+	assert.Equal(t, a.lockKey, New(nil, nil).lockKey)
+}
@@ -0,0 +1,28 @@
+// Package dbmigrate 是各服务共用的版本化 SQL 迁移执行器（PostgreSQL）。
+//
+// 取代启动时的 GORM AutoMigrate：
+//  1. 迁移脚本按 <version>_<name>.up.sql / .down.sql 命名，随服务二进制 embed 分发；
+//  2. 执行记录写入 schema_migrations（版本、名称、校验和、执行时间），
+//     已执行脚本被改动时 verify 报告校验和不一致，up 与 down 均拒绝继续；
+//  3. up / down 在 pg_advisory_lock 会话锁内串行执行，多副本同时启动不会互相踩踏；
+//  4. 每个迁移单独一个事务（PostgreSQL 支持事务内 DDL），失败时整体回滚。
+//
+// 典型用法：
+//
+//	migrations, err := dbmigrate.Load(migrationsFS, ".")
+//	if err != nil {
+//	    return err
+//	}
+//
+//	m := dbmigrate.New(sqlDB, migrations)
+//
+//	// 启动时自动升级
+//	if _, err := m.Up(ctx); err != nil {
+//	    return err
+//	}
+//
+//	// 命令行子命令：<service> migrate up | down N | status | verify
+//	if err := dbmigrate.Run(ctx, m, os.Args[2:], os.Stdout); err != nil {
+//	    log.Fatal(err)
+//	}
+package dbmigrate
@@ -0,0 +1,107 @@
+package dbmigrate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Migration 单个版本化迁移
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Checksum 返回 up/down 脚本的 SHA-256（十六进制）
+//
+// 已执行迁移的脚本（含回滚脚本）被修改后校验和随之变化，verify 据此报告不一致。
+func (m Migration) Checksum() string {
+	h := sha256.New()
+	h.Write([]byte(m.Up))
+	h.Write([]byte{0})
+	h.Write([]byte(m.Down))
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// fileNamePattern 迁移文件命名：000001_baseline.up.sql
+var fileNamePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Load 读取 dir 下的迁移文件，按版本升序返回
+//
+// 每个版本必须同时提供 up 与 down 脚本；非 .sql 文件忽略，命名不合规的 .sql 文件报错。
+func Load(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("读取迁移目录失败: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	upSeen := make(map[int64]bool)
+	downSeen := make(map[int64]bool)
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".sql") {
+			continue
+		}
+
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("迁移文件 %s 命名不合规，需为 <version>_<name>.(up|down).sql", entry.Name())
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("迁移文件 %s 的版本号无效", entry.Name())
+		}
+
+		name, direction := match[2], match[3]
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+		} else if m.Name != name {
+			return nil, fmt.Errorf("版本 %d 存在两个不同名称的迁移: %s / %s", version, m.Name, name)
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("读取迁移文件 %s 失败: %w", entry.Name(), err)
+		}
+
+		if direction == "up" {
+			m.Up = string(content)
+			upSeen[version] = true
+		} else {
+			m.Down = string(content)
+			downSeen[version] = true
+		}
+	}
+
+	out := make([]Migration, 0, len(byVersion))
+
+	for version, m := range byVersion {
+		if !upSeen[version] {
+			return nil, fmt.Errorf("迁移 %d_%s 缺少 up 脚本", version, m.Name)
+		}
+
+		if !downSeen[version] {
+			return nil, fmt.Errorf("迁移 %d_%s 缺少 down 脚本", version, m.Name)
+		}
+
+		out = append(out, *m)
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Version < out[j].Version })
+
+	return out, nil
+}
@@ -0,0 +1,77 @@
+package dbmigrate
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("按版本升序返回并忽略非 sql 文件", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"migrations/000002_add_index.up.sql":   {Data: []byte("CREATE INDEX a ON t (c);")},
+			"migrations/000002_add_index.down.sql": {Data: []byte("DROP INDEX a;")},
+			"migrations/000001_baseline.up.sql":    {Data: []byte("CREATE TABLE t (c int);")},
+			"migrations/000001_baseline.down.sql":  {Data: []byte("DROP TABLE t;")},
+			"migrations/migrations.go":             {Data: []byte("package migrations")},
+		}
+
+		migrations, err := Load(fsys, "migrations")
+
+		require.NoError(t, err)
+		require.Len(t, migrations, 2)
+		assert.Equal(t, int64(1), migrations[0].Version)
+		assert.Equal(t, "baseline", migrations[0].Name)
+		assert.Equal(t, "CREATE TABLE t (c int);", migrations[0].Up)
+		assert.Equal(t, "DROP TABLE t;", migrations[0].Down)
+		assert.Equal(t, int64(2), migrations[1].Version)
+	})
+
+	t.Run("缺少 down 脚本", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"000001_baseline.up.sql": {Data: []byte("SELECT 1;")},
+		}
+
+		_, err := Load(fsys, ".")
+
+		assert.ErrorContains(t, err, "缺少 down 脚本")
+	})
+
+	t.Run("同一版本名称不一致", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"000001_a.up.sql":   {Data: []byte("SELECT 1;")},
+			"000001_b.down.sql": {Data: []byte("SELECT 1;")},
+		}
+
+		_, err := Load(fsys, ".")
+
+		assert.ErrorContains(t, err, "两个不同名称")
+	})
+
+	t.Run("命名不合规", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"baseline.sql": {Data: []byte("SELECT 1;")},
+		}
+
+		_, err := Load(fsys, ".")
+
+		assert.ErrorContains(t, err, "命名不合规")
+	})
+}
+
+func TestMigration_Checksum(t *testing.T) {
+	m := Migration{Version: 1, Name: "baseline", Up: "CREATE TABLE t (c int);", Down: "DROP TABLE t;"}
+
+	assert.Equal(t, m.Checksum(), m.Checksum())
+	assert.Len(t, m.Checksum(), 64)
+
+	changedDown := m
+	changedDown.Down = "DROP TABLE IF EXISTS t;"
+	assert.NotEqual(t, m.Checksum(), changedDown.Checksum())
+
+	// up/down 之间有分隔，内容平移不会得到相同校验和
+	shifted := Migration{Up: m.Up + m.Down}
+	assert.NotEqual(t, m.Checksum(), shifted.Checksum())
+}
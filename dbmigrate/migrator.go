@@ -0,0 +1,331 @@
+package dbmigrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"regexp"
+	"time"
+)
+
+// DefaultTable 执行记录表的默认名称
+const DefaultTable = "schema_migrations"
+
+var tableNamePattern = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// Migrator 迁移执行器
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+	table      string
+	lockKey    int64
+	logf       func(format string, args ...any)
+}
+
+// Option Migrator 配置项
+type Option func(*Migrator)
+
+// WithTable 指定执行记录表名（仅允许小写字母、数字与下划线）
+func WithTable(name string) Option {
+	return func(m *Migrator) {
+		m.table = name
+	}
+}
+
+// WithLogger 指定进度日志输出，默认使用标准库 log.Printf
+func WithLogger(logf func(format string, args ...any)) Option {
+	return func(m *Migrator) {
+		if logf != nil {
+			m.logf = logf
+		}
+	}
+}
+
+// New 创建迁移执行器；migrations 通常来自 Load
+func New(db *sql.DB, migrations []Migration, opts ...Option) *Migrator {
+	m := &Migrator{
+		db:         db,
+		migrations: migrations,
+		table:      DefaultTable,
+		logf:       log.Printf,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	// 会话锁 key 由表名派生：同库内共用同一张记录表的执行器互斥
+	h := fnv.New64a()
+	h.Write([]byte("dbmigrate:" + m.table))
+	m.lockKey = int64(h.Sum64())
+
+	return m
+}
+
+// Up 执行全部未执行的迁移，返回本次执行的迁移
+//
+// 已执行迁移存在校验和不一致或缺失时拒绝执行，需先人工处理（verify 可列出问题）。
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var done []Migration
+
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := m.loadApplied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		if err := verifyStatus(buildStatus(m.migrations, applied), false); err != nil {
+			return err
+		}
+
+		appliedVersions := make(map[int64]bool, len(applied))
+		for _, a := range applied {
+			appliedVersions[a.Version] = true
+		}
+
+		for _, mig := range m.migrations {
+			if appliedVersions[mig.Version] {
+				continue
+			}
+
+			start := time.Now()
+
+			if err := m.apply(ctx, conn, mig); err != nil {
+				return err
+			}
+
+			m.logf("dbmigrate: 已执行 %d_%s（%s）", mig.Version, mig.Name, time.Since(start).Round(time.Millisecond))
+			done = append(done, mig)
+		}
+
+		return nil
+	})
+
+	return done, err
+}
+
+// Down 按版本倒序回滚最近执行的 n 个迁移，返回本次回滚的迁移
+//
+// 与 Up 一样，已执行迁移存在校验和不一致或缺失时拒绝回滚：down 脚本须与实际执行过的 up 脚本对应。
+func (m *Migrator) Down(ctx context.Context, n int) ([]Migration, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("回滚数量必须大于 0")
+	}
+
+	var done []Migration
+
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := m.loadApplied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		targets, err := planDown(m.migrations, applied, n)
+		if err != nil {
+			return err
+		}
+
+		for _, mig := range targets {
+			if err := m.revert(ctx, conn, mig); err != nil {
+				return err
+			}
+
+			m.logf("dbmigrate: 已回滚 %d_%s", mig.Version, mig.Name)
+			done = append(done, mig)
+		}
+
+		return nil
+	})
+
+	return done, err
+}
+
+// planDown 校验执行记录并按版本倒序选出最近执行的 n 个待回滚迁移
+func planDown(migrations []Migration, applied []AppliedMigration, n int) ([]Migration, error) {
+	if err := verifyStatus(buildStatus(migrations, applied), false); err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]Migration, len(migrations))
+	for _, mig := range migrations {
+		byVersion[mig.Version] = mig
+	}
+
+	// 校验通过后每条执行记录都能在当前版本中找到对应迁移
+	var targets []Migration
+
+	for i := len(applied) - 1; i >= 0 && len(targets) < n; i-- {
+		targets = append(targets, byVersion[applied[i].Version])
+	}
+
+	return targets, nil
+}
+
+// Status 返回每个迁移的执行状态（只读，不加锁）
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取数据库连接失败: %w", err)
+	}
+	defer conn.Close()
+
+	exists, err := m.tableExists(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []AppliedMigration
+
+	if exists {
+		if applied, err = m.queryApplied(ctx, conn); err != nil {
+			return nil, err
+		}
+	}
+
+	return buildStatus(m.migrations, applied), nil
+}
+
+// Verify 校验数据库与当前版本的迁移完全一致：无未执行、无校验和不一致、无缺失
+//
+// 不一致时返回 *VerifyError。
+func (m *Migrator) Verify(ctx context.Context) error {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	return verifyStatus(statuses, true)
+}
+
+// withLock 在专用连接上持有 pg_advisory_lock 执行 fn
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	if !tableNamePattern.MatchString(m.table) {
+		return fmt.Errorf("非法的迁移记录表名: %q", m.table)
+	}
+
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("获取数据库连接失败: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", m.lockKey); err != nil {
+		return fmt.Errorf("获取迁移锁失败: %w", err)
+	}
+
+	defer func() {
+		// 使用独立 ctx：调用方 ctx 已取消时也要释放会话锁
+		_, _ = conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", m.lockKey)
+	}()
+
+	return fn(conn)
+}
+
+// loadApplied 确保记录表存在并读取执行记录
+func (m *Migrator) loadApplied(ctx context.Context, conn *sql.Conn) ([]AppliedMigration, error) {
+	_, err := conn.ExecContext(ctx, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		version    BIGINT PRIMARY KEY,
+		name       TEXT NOT NULL,
+		checksum   TEXT NOT NULL,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`, m.table))
+	if err != nil {
+		return nil, fmt.Errorf("创建迁移记录表失败: %w", err)
+	}
+
+	return m.queryApplied(ctx, conn)
+}
+
+// tableExists 记录表是否存在
+func (m *Migrator) tableExists(ctx context.Context, conn *sql.Conn) (bool, error) {
+	if !tableNamePattern.MatchString(m.table) {
+		return false, fmt.Errorf("非法的迁移记录表名: %q", m.table)
+	}
+
+	var exists bool
+
+	err := conn.QueryRowContext(ctx, "SELECT to_regclass($1) IS NOT NULL", m.table).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("检查迁移记录表失败: %w", err)
+	}
+
+	return exists, nil
+}
+
+// queryApplied 按版本升序读取执行记录
+func (m *Migrator) queryApplied(ctx context.Context, conn *sql.Conn) ([]AppliedMigration, error) {
+	rows, err := conn.QueryContext(ctx,
+		fmt.Sprintf("SELECT version, name, checksum, applied_at FROM %s ORDER BY version", m.table))
+	if err != nil {
+		return nil, fmt.Errorf("读取迁移记录失败: %w", err)
+	}
+	defer rows.Close()
+
+	var applied []AppliedMigration
+
+	for rows.Next() {
+		var a AppliedMigration
+		if err := rows.Scan(&a.Version, &a.Name, &a.Checksum, &a.AppliedAt); err != nil {
+			return nil, fmt.Errorf("读取迁移记录失败: %w", err)
+		}
+
+		applied = append(applied, a)
+	}
+
+	return applied, rows.Err()
+}
+
+// apply 在单个事务中执行 up 脚本并写入执行记录
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, mig Migration) error {
+	return inTx(ctx, conn, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, mig.Up); err != nil {
+			return fmt.Errorf("执行迁移 %d_%s 失败: %w", mig.Version, mig.Name, err)
+		}
+
+		_, err := tx.ExecContext(ctx,
+			fmt.Sprintf("INSERT INTO %s (version, name, checksum) VALUES ($1, $2, $3)", m.table),
+			mig.Version, mig.Name, mig.Checksum())
+		if err != nil {
+			return fmt.Errorf("记录迁移 %d_%s 失败: %w", mig.Version, mig.Name, err)
+		}
+
+		return nil
+	})
+}
+
+// revert 在单个事务中执行 down 脚本并删除执行记录
+func (m *Migrator) revert(ctx context.Context, conn *sql.Conn, mig Migration) error {
+	return inTx(ctx, conn, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, mig.Down); err != nil {
+			return fmt.Errorf("回滚迁移 %d_%s 失败: %w", mig.Version, mig.Name, err)
+		}
+
+		_, err := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE version = $1", m.table), mig.Version)
+		if err != nil {
+			return fmt.Errorf("删除迁移记录 %d_%s 失败: %w", mig.Version, mig.Name, err)
+		}
+
+		return nil
+	})
+}
+
+// inTx 在事务中执行 fn，出错回滚
+func inTx(ctx context.Context, conn *sql.Conn, fn func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			return errors.Join(err, rbErr)
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,54 @@
+package dbmigrate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanDown(t *testing.T) {
+	m1 := Migration{Version: 1, Name: "baseline", Up: "a", Down: "b"}
+	m2 := Migration{Version: 2, Name: "add_index", Up: "c", Down: "d"}
+	m3 := Migration{Version: 3, Name: "backfill", Up: "e", Down: "f"}
+
+	t.Run("按版本倒序选出最近执行的迁移", func(t *testing.T) {
+		applied := []AppliedMigration{
+			{Version: 1, Name: "baseline", Checksum: m1.Checksum()},
+			{Version: 2, Name: "add_index", Checksum: m2.Checksum()},
+		}
+
+		targets, err := planDown([]Migration{m1, m2, m3}, applied, 5)
+
+		require.NoError(t, err)
+		assert.Equal(t, []Migration{m2, m1}, targets)
+	})
+
+	t.Run("已执行迁移校验和不一致时拒绝回滚", func(t *testing.T) {
+		applied := []AppliedMigration{
+			{Version: 1, Name: "baseline", Checksum: "stale"},
+			{Version: 2, Name: "add_index", Checksum: m2.Checksum()},
+		}
+
+		targets, err := planDown([]Migration{m1, m2}, applied, 1)
+
+		var verifyErr *VerifyError
+		require.True(t, errors.As(err, &verifyErr))
+		assert.Equal(t, []string{"1_baseline 已执行但脚本校验和不一致"}, verifyErr.Problems)
+		assert.Empty(t, targets)
+	})
+
+	t.Run("已执行迁移缺失时拒绝回滚", func(t *testing.T) {
+		applied := []AppliedMigration{
+			{Version: 1, Name: "baseline", Checksum: m1.Checksum()},
+			{Version: 9, Name: "removed", Checksum: "x"},
+		}
+
+		_, err := planDown([]Migration{m1}, applied, 1)
+
+		var verifyErr *VerifyError
+		require.True(t, errors.As(err, &verifyErr))
+		assert.Equal(t, []string{"9_removed 已执行但当前版本中不存在"}, verifyErr.Problems)
+	})
+}
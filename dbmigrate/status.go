@@ -0,0 +1,113 @@
+package dbmigrate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// State 迁移状态
+type State string
+
+const (
+	// StatePending 尚未执行
+	StatePending State = "pending"
+	// StateApplied 已执行且校验和一致
+	StateApplied State = "applied"
+	// StateModified 已执行但脚本在之后被修改
+	StateModified State = "modified"
+	// StateMissing 数据库中有执行记录，但当前二进制中没有该迁移
+	StateMissing State = "missing"
+)
+
+// AppliedMigration schema_migrations 中的一条执行记录
+type AppliedMigration struct {
+	Version   int64
+	Name      string
+	Checksum  string
+	AppliedAt time.Time
+}
+
+// MigrationStatus 单个迁移的状态
+type MigrationStatus struct {
+	Version   int64
+	Name      string
+	State     State
+	AppliedAt time.Time
+}
+
+// VerifyError verify 发现的问题
+type VerifyError struct {
+	Problems []string
+}
+
+func (e *VerifyError) Error() string {
+	return "数据库迁移校验未通过:\n  - " + strings.Join(e.Problems, "\n  - ")
+}
+
+// buildStatus 对比二进制中的迁移与数据库执行记录，按版本升序返回每个迁移的状态
+func buildStatus(migrations []Migration, applied []AppliedMigration) []MigrationStatus {
+	appliedByVersion := make(map[int64]AppliedMigration, len(applied))
+	for _, a := range applied {
+		appliedByVersion[a.Version] = a
+	}
+
+	known := make(map[int64]bool, len(migrations))
+	out := make([]MigrationStatus, 0, len(migrations)+len(applied))
+
+	for _, m := range migrations {
+		known[m.Version] = true
+		st := MigrationStatus{Version: m.Version, Name: m.Name, State: StatePending}
+
+		if a, ok := appliedByVersion[m.Version]; ok {
+			st.AppliedAt = a.AppliedAt
+			st.State = StateApplied
+
+			if a.Checksum != m.Checksum() {
+				st.State = StateModified
+			}
+		}
+
+		out = append(out, st)
+	}
+
+	for _, a := range applied {
+		if !known[a.Version] {
+			out = append(out, MigrationStatus{
+				Version:   a.Version,
+				Name:      a.Name,
+				State:     StateMissing,
+				AppliedAt: a.AppliedAt,
+			})
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Version < out[j].Version })
+
+	return out
+}
+
+// verifyStatus 汇总状态中的问题；pendingIsProblem=false 时只检查已执行部分
+func verifyStatus(statuses []MigrationStatus, pendingIsProblem bool) error {
+	var problems []string
+
+	for _, st := range statuses {
+		switch st.State {
+		case StateModified:
+			problems = append(problems, fmt.Sprintf("%d_%s 已执行但脚本校验和不一致", st.Version, st.Name))
+		case StateMissing:
+			problems = append(problems, fmt.Sprintf("%d_%s 已执行但当前版本中不存在", st.Version, st.Name))
+		case StatePending:
+			if pendingIsProblem {
+				problems = append(problems, fmt.Sprintf("%d_%s 尚未执行", st.Version, st.Name))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return &VerifyError{Problems: problems}
+	}
+
+	return nil
+}
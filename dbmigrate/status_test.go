@@ -0,0 +1,70 @@
+package dbmigrate
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildStatus(t *testing.T) {
+	m1 := Migration{Version: 1, Name: "baseline", Up: "a", Down: "b"}
+	m2 := Migration{Version: 2, Name: "add_index", Up: "c", Down: "d"}
+	m3 := Migration{Version: 3, Name: "backfill", Up: "e", Down: "f"}
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	applied := []AppliedMigration{
+		{Version: 1, Name: "baseline", Checksum: m1.Checksum(), AppliedAt: at},
+		{Version: 2, Name: "add_index", Checksum: "stale", AppliedAt: at},
+		{Version: 9, Name: "removed", Checksum: "x", AppliedAt: at},
+	}
+
+	statuses := buildStatus([]Migration{m1, m2, m3}, applied)
+
+	require.Len(t, statuses, 4)
+	assert.Equal(t, MigrationStatus{Version: 1, Name: "baseline", State: StateApplied, AppliedAt: at}, statuses[0])
+	assert.Equal(t, StateModified, statuses[1].State)
+	assert.Equal(t, MigrationStatus{Version: 3, Name: "backfill", State: StatePending}, statuses[2])
+	assert.Equal(t, StateMissing, statuses[3].State)
+	assert.Equal(t, int64(9), statuses[3].Version)
+}
+
+func TestVerifyStatus(t *testing.T) {
+	t.Run("全部已执行", func(t *testing.T) {
+		statuses := []MigrationStatus{{Version: 1, Name: "baseline", State: StateApplied}}
+
+		assert.NoError(t, verifyStatus(statuses, true))
+	})
+
+	t.Run("未执行仅在 verify 时视为问题", func(t *testing.T) {
+		statuses := []MigrationStatus{
+			{Version: 1, Name: "baseline", State: StateApplied},
+			{Version: 2, Name: "add_index", State: StatePending},
+		}
+
+		assert.NoError(t, verifyStatus(statuses, false))
+
+		err := verifyStatus(statuses, true)
+
+		var verifyErr *VerifyError
+		require.True(t, errors.As(err, &verifyErr))
+		assert.Equal(t, []string{"2_add_index 尚未执行"}, verifyErr.Problems)
+	})
+
+	t.Run("校验和不一致与缺失总是问题", func(t *testing.T) {
+		statuses := []MigrationStatus{
+			{Version: 1, Name: "baseline", State: StateModified},
+			{Version: 9, Name: "removed", State: StateMissing},
+		}
+
+		err := verifyStatus(statuses, false)
+
+		var verifyErr *VerifyError
+		require.True(t, errors.As(err, &verifyErr))
+		assert.Len(t, verifyErr.Problems, 2)
+		assert.Contains(t, err.Error(), "1_baseline 已执行但脚本校验和不一致")
+		assert.Contains(t, err.Error(), "9_removed 已执行但当前版本中不存在")
+	})
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+
+	authzmw "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/application/middleware/authz_middleware"
+)
+
+// runAuthZCheck 校验 authz 规则文件并输出每条路由命中的规则，返回进程退出码
+//
+// 只注册路由、不初始化任何依赖（RPC / Redis），可在 CI 或发布前离线执行：
+//
+//	go run . -check-authz ./config/authz_rules.yaml
+func runAuthZCheck(rulesFile string) int {
+	rules, err := authzmw.LoadRulesFromFile(rulesFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "authz 规则校验失败: %v\n", err)
+		return 1
+	}
+
+	// 屏蔽路由注册的 Debug 日志，只输出报告
+	hlog.SetLevel(hlog.LevelWarn)
+
+	h := server.New()
+	register(h)
+
+	routes := make([]authzmw.Route, 0, len(h.Routes())+1)
+	for _, ri := range h.Routes() {
+		routes = append(routes, authzmw.Route{Method: ri.Method, Path: ri.Path})
+	}
+
+	// JWKS 端点由 HandlerRegistry 依赖 JWT 中间件注册，这里单独补上
+	routes = append(routes, authzmw.Route{Method: http.MethodGet, Path: "/.well-known/jwks.json"})
+
+	if err := authzmw.WriteRouteReport(os.Stdout, authzmw.BuildRouteReport(rules, routes)); err != nil {
+		fmt.Fprintf(os.Stderr, "输出路由报告失败: %v\n", err)
+		return 1
+	}
+
+	return 0
+}
@@ -4,7 +4,10 @@ package identity
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"path/filepath"
+	"strings"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/adaptor"
@@ -155,6 +158,52 @@ func ResetPassword(ctx context.Context, c *app.RequestContext) {
 	errors.JSON(c, consts.StatusOK, resp)
 }
 
+// RequestPasswordReset
+// @Summary 找回密码
+// @Description 按用户名、邮箱或手机号发送一次性重置链接到账号绑定的邮箱；无论账号是否存在都返回成功
+// @Tags 认证管理
+// @Accept json
+// @Produce json
+// @Param req body identity.RequestPasswordResetRequestDTO true "请求体"
+// @Success 200 {object} http_base.OperationStatusResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/identity/auth/password/forgot [POST]
+func RequestPasswordReset(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.RequestPasswordResetRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		// 参数绑定和验证错误，使用统一错误处理
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.RequestPasswordReset(ctx, &req, c.ClientIP())
+	if err != nil {
+		errors.HandleServiceError(c, err, "发起找回密码失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// ConfirmPasswordReset
+// @Summary 确认找回密码
+// @Description 凭邮件中的一次性令牌设置新密码，成功后该用户的全部会话失效
+// @Tags 认证管理
+// @Accept json
+// @Produce json
+// @Param req body identity.ConfirmPasswordResetRequestDTO true "请求体"
+// @Success 200 {object} http_base.OperationStatusResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误或令牌无效"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/identity/auth/password/reset/confirm [POST]
+func ConfirmPasswordReset(ctx context.Context, c *app.RequestContext) {
+	jwtMiddlewareInstance.ConfirmPasswordResetHandler(ctx, c)
+}
+
 // ForcePasswordChange
 // @Summary 强制下次登录修改密码
 // @Description 管理员标记用户需要在下次登录时强制修改密码
@@ -206,6 +255,170 @@ func RefreshToken(ctx context.Context, c *app.RequestContext) {
 	jwtMiddlewareInstance.RefreshHandler(ctx, c)
 }
 
+// SwitchTenant
+// @Summary 切换租户
+// @Description 切换到当前用户所属的另一个组织，按目标租户的角色重新签发令牌
+// @Tags 认证管理
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param req body identity.SwitchTenantRequestDTO true "请求体"
+// @Success 200 {object} identity.SwitchTenantResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 403 {object} http_base.OperationStatusResponseDTO "非目标组织成员"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/identity/auth/switch-tenant [POST]
+func SwitchTenant(ctx context.Context, c *app.RequestContext) {
+	// 使用全局JWT中间件实例的切换租户处理器
+	jwtMiddlewareInstance.SwitchTenantHandler(ctx, c)
+}
+
+// VerifyMFA
+// @Summary 多因素认证校验
+// @Description 登录第二步：凭挑战令牌提交 TOTP 口令或恢复码，校验通过后签发令牌
+// @Tags 认证管理
+// @Accept json
+// @Produce json
+// @Param req body identity.MFAVerifyRequestDTO true "请求体"
+// @Success 200 {object} identity.LoginResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "口令错误或挑战已失效"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/identity/auth/mfa/verify [POST]
+func VerifyMFA(ctx context.Context, c *app.RequestContext) {
+	// 使用全局JWT中间件实例的MFA校验处理器
+	jwtMiddlewareInstance.MFAVerifyHandler(ctx, c)
+}
+
+// EnrollMFAChallenge
+// @Summary 登录中登记多因素认证
+// @Description 组织强制 MFA 而用户尚未登记时，凭挑战令牌获取 TOTP 密钥与 otpauth URI
+// @Tags 认证管理
+// @Accept json
+// @Produce json
+// @Param req body identity.MFAEnrollRequestDTO true "请求体"
+// @Success 200 {object} identity.MFAEnrollResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "挑战已失效"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/identity/auth/mfa/enroll [POST]
+func EnrollMFAChallenge(ctx context.Context, c *app.RequestContext) {
+	// 使用全局JWT中间件实例的MFA登记处理器
+	jwtMiddlewareInstance.MFAEnrollHandler(ctx, c)
+}
+
+// StartMFAEnrollment
+// @Summary 发起多因素认证登记
+// @Description 当前用户发起 TOTP 登记，返回密钥与 otpauth URI，需调用确认接口后生效
+// @Tags 认证管理
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param req body identity.StartMFAEnrollmentRequestDTO true "请求体"
+// @Success 200 {object} identity.MFAEnrollResponseDTO "成功"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 409 {object} http_base.OperationStatusResponseDTO "已启用多因素认证"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/identity/users/me/mfa [POST]
+func StartMFAEnrollment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.StartMFAEnrollmentRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	userID, authErr := auth_context.GetCurrentUserProfileID(c)
+	if !authErr {
+		errors.AbortWithError(c, errors.ErrJWTValidationFail)
+		return
+	}
+
+	resp, err := identityService.EnrollMFA(ctx, userID)
+	if err != nil {
+		errors.HandleServiceError(c, err, "发起多因素认证登记失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// ConfirmMFA
+// @Summary 确认多因素认证登记
+// @Description 提交验证器生成的口令确认登记，启用 MFA 并返回一次性恢复码（仅此一次）
+// @Tags 认证管理
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param req body identity.ConfirmMFARequestDTO true "请求体"
+// @Success 200 {object} identity.ConfirmMFAResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "口令错误"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/identity/users/me/mfa/confirm [POST]
+func ConfirmMFA(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.ConfirmMFARequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	userID, authErr := auth_context.GetCurrentUserProfileID(c)
+	if !authErr {
+		errors.AbortWithError(c, errors.ErrJWTValidationFail)
+		return
+	}
+
+	resp, err := identityService.ConfirmMFA(ctx, userID, req.GetCode())
+	if err != nil {
+		errors.HandleServiceError(c, err, "确认多因素认证登记失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// DisableMFA
+// @Summary 停用多因素认证
+// @Description 当前用户提交 TOTP 口令或恢复码后停用 MFA
+// @Tags 认证管理
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param req body identity.DisableMFARequestDTO true "请求体"
+// @Success 200 {object} http_base.OperationStatusResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "口令错误"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/identity/users/me/mfa/disable [POST]
+func DisableMFA(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.DisableMFARequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	userID, authErr := auth_context.GetCurrentUserProfileID(c)
+	if !authErr {
+		errors.AbortWithError(c, errors.ErrJWTValidationFail)
+		return
+	}
+
+	resp, err := identityService.DisableMFA(ctx, userID, req.GetCode())
+	if err != nil {
+		errors.HandleServiceError(c, err, "停用多因素认证失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
 // CreateUser
 // @Summary 创建用户
 // @Description 管理员创建新用户账户
@@ -244,6 +457,7 @@ func CreateUser(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	errors.SetETag(c, resp.GetUser().GetVersion())
 	errors.JSON(c, consts.StatusOK, resp)
 }
 
@@ -278,6 +492,7 @@ func GetUser(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	errors.SetETag(c, resp.GetUser().GetVersion())
 	errors.JSON(c, consts.StatusOK, resp)
 }
 
@@ -302,13 +517,17 @@ func GetMe(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	// 当前 token 所属租户，用于标记可切换租户列表中的当前项
+	currentTenant, _ := auth_context.GetCurrentTenant(c)
+
 	// 调用业务服务层
-	resp, err := identityService.GetMe(ctx, userID)
+	resp, err := identityService.GetMe(ctx, userID, currentTenant)
 	if err != nil {
 		errors.HandleServiceError(c, err, "获取当前用户信息失败")
 		return
 	}
 
+	errors.SetETag(c, resp.GetUser().GetVersion())
 	errors.JSON(c, consts.StatusOK, resp)
 }
 
@@ -321,11 +540,13 @@ func GetMe(ctx context.Context, c *app.RequestContext) {
 // @Security ApiKeyAuth
 // @Param userID path string true "用户ID"
 // @Param req body identity.UpdateUserRequestDTO true "请求体"
+// @Param If-Match header string false "读取时获得的 ETag，与请求体 version 二选一"
 // @Success 200 {object} identity.UserProfileResponseDTO "成功"
 // @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
 // @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
 // @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
 // @Failure 404 {object} http_base.OperationStatusResponseDTO "用户未找到"
+// @Failure 409 {object} http_base.OperationStatusResponseDTO "数据已被他人修改（版本冲突）"
 // @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
 // @Router /api/v1/identity/users/{userID} [PUT]
 func UpdateUser(ctx context.Context, c *app.RequestContext) {
@@ -351,6 +572,7 @@ func UpdateUser(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	errors.SetETag(c, resp.GetUser().GetVersion())
 	errors.JSON(c, consts.StatusOK, resp)
 }
 
@@ -362,9 +584,11 @@ func UpdateUser(ctx context.Context, c *app.RequestContext) {
 // @Produce json
 // @Security ApiKeyAuth
 // @Param req body identity.UpdateMeRequestDTO true "请求体"
+// @Param If-Match header string false "读取时获得的 ETag，与请求体 version 二选一"
 // @Success 200 {object} identity.UserProfileResponseDTO "成功"
 // @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
 // @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 409 {object} http_base.OperationStatusResponseDTO "数据已被他人修改（版本冲突）"
 // @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
 // @Router /api/v1/identity/users/me [PUT]
 func UpdateMe(ctx context.Context, c *app.RequestContext) {
@@ -391,6 +615,7 @@ func UpdateMe(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	errors.SetETag(c, resp.GetUser().GetVersion())
 	errors.JSON(c, consts.StatusOK, resp)
 }
 
@@ -444,6 +669,7 @@ func DeleteUser(ctx context.Context, c *app.RequestContext) {
 // @Param sort query string false "排序规则"
 // @Param fields query string false "指定返回字段"
 // @Param include_total query bool false "是否返回总数" default(false)
+// @Param cursor query string false "游标分页：首页传空串，之后传上一页的 next_cursor"
 // @Param organization_id query string false "按组织ID筛选"
 // @Param status query int false "按用户状态筛选"
 // @Param fetch_all query bool false "是否获取所有数据（不分页）" default(false)
@@ -512,6 +738,120 @@ func SearchUsers(ctx context.Context, c *app.RequestContext) {
 	errors.JSON(c, consts.StatusOK, resp)
 }
 
+// ImportUsers
+// @Summary 批量导入用户
+// @Description 上传 CSV/XLSX 文件批量创建用户，逐行返回校验结果；dry_run=true 时仅校验不写入
+// @Tags 用户管理
+// @Accept multipart/form-data
+// @Produce json
+// @Security ApiKeyAuth
+// @Param file formData file true "导入文件（CSV 或 XLSX，最大 10MB）"
+// @Param format formData string false "文件格式（csv/xlsx），为空时按文件扩展名识别"
+// @Param dry_run formData bool false "是否仅校验不写入" default(false)
+// @Param batch_size formData int false "每批提交的行数（1-500）" default(100)
+// @Success 200 {object} identity.ImportUsersResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/identity/users/import [POST]
+func ImportUsers(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.ImportUsersRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage("导入文件不能为空"))
+		return
+	}
+
+	const maxImportFileSize = 10 * 1024 * 1024
+	if fileHeader.Size > maxImportFileSize {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage("导入文件大小不能超过 10MB"))
+		return
+	}
+
+	// 未显式指定格式时按扩展名识别
+	if req.Format == nil || *req.Format == "" {
+		format := strings.TrimPrefix(strings.ToLower(filepath.Ext(fileHeader.Filename)), ".")
+		if format != "csv" && format != "xlsx" {
+			errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage("仅支持 csv 或 xlsx 文件"))
+			return
+		}
+
+		req.Format = &format
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage("无法打开上传的文件"))
+		return
+	}
+	defer file.Close()
+
+	req.FileContent, err = io.ReadAll(file)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage("读取上传的文件失败"))
+		return
+	}
+
+	// 获取当前用户ID
+	operatorID, ok := auth_context.GetCurrentUserProfileID(c)
+	if !ok {
+		errors.AbortWithError(c, errors.ErrJWTValidationFail)
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.ImportUsers(ctx, &req, operatorID)
+	if err != nil {
+		errors.HandleServiceError(c, err, "批量导入用户失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// ExportUsers
+// @Summary 导出用户
+// @Description 按筛选条件导出用户为 CSV/XLSX 文件（受数据范围限制），列格式与导入文件一致
+// @Tags 用户管理
+// @Produce octet-stream
+// @Security ApiKeyAuth
+// @Param format query string false "文件格式（csv/xlsx）" default(csv)
+// @Param organization_id query string false "按组织ID筛选"
+// @Param status query int false "按用户状态筛选"
+// @Success 200 {file} file "导出文件"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/identity/users/export [GET]
+func ExportUsers(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.ExportUsersRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 调用业务服务层
+	file, err := identityService.ExportUsers(ctx, &req)
+	if err != nil {
+		errors.HandleServiceError(c, err, "导出用户失败")
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.FileName))
+	c.Data(consts.StatusOK, file.ContentType, file.Content)
+}
+
 // ChangeUserStatus
 // @Summary 变更用户状态
 // @Description 管理员变更用户状态（激活、停用、锁定等）
@@ -656,6 +996,7 @@ func CreateOrganization(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	errors.SetETag(c, resp.GetOrganization().GetVersion())
 	errors.JSON(c, consts.StatusOK, resp)
 }
 
@@ -690,6 +1031,7 @@ func GetOrganization(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	errors.SetETag(c, resp.GetOrganization().GetVersion())
 	errors.JSON(c, consts.StatusOK, resp)
 }
 
@@ -702,11 +1044,13 @@ func GetOrganization(ctx context.Context, c *app.RequestContext) {
 // @Security ApiKeyAuth
 // @Param organizationID path string true "组织ID"
 // @Param req body identity.UpdateOrganizationRequestDTO true "请求体"
+// @Param If-Match header string false "读取时获得的 ETag，与请求体 version 二选一"
 // @Success 200 {object} identity.OrganizationResponseDTO "成功"
 // @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
 // @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
 // @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
 // @Failure 404 {object} http_base.OperationStatusResponseDTO "组织未找到"
+// @Failure 409 {object} http_base.OperationStatusResponseDTO "数据已被他人修改（版本冲突）"
 // @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
 // @Router /api/v1/identity/organizations/{organizationID} [PUT]
 func UpdateOrganization(ctx context.Context, c *app.RequestContext) {
@@ -726,6 +1070,7 @@ func UpdateOrganization(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	errors.SetETag(c, resp.GetOrganization().GetVersion())
 	errors.JSON(c, consts.StatusOK, resp)
 }
 
@@ -835,6 +1180,7 @@ func CreateDepartment(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	errors.SetETag(c, resp.GetDepartment().GetVersion())
 	errors.JSON(c, consts.StatusOK, resp)
 }
 
@@ -869,6 +1215,7 @@ func GetDepartment(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	errors.SetETag(c, resp.GetDepartment().GetVersion())
 	errors.JSON(c, consts.StatusOK, resp)
 }
 
@@ -881,11 +1228,13 @@ func GetDepartment(ctx context.Context, c *app.RequestContext) {
 // @Security ApiKeyAuth
 // @Param departmentID path string true "部门ID"
 // @Param req body identity.UpdateDepartmentRequestDTO true "请求体"
+// @Param If-Match header string false "读取时获得的 ETag，与请求体 version 二选一"
 // @Success 200 {object} identity.DepartmentResponseDTO "成功"
 // @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
 // @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
 // @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
 // @Failure 404 {object} http_base.OperationStatusResponseDTO "部门未找到"
+// @Failure 409 {object} http_base.OperationStatusResponseDTO "数据已被他人修改（版本冲突）"
 // @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
 // @Router /api/v1/identity/departments/{departmentID} [PUT]
 func UpdateDepartment(ctx context.Context, c *app.RequestContext) {
@@ -905,6 +1254,7 @@ func UpdateDepartment(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	errors.SetETag(c, resp.GetDepartment().GetVersion())
 	errors.JSON(c, consts.StatusOK, resp)
 }
 
@@ -1210,6 +1560,8 @@ func BindLogoToOrganization(ctx context.Context, c *app.RequestContext) {
 // @Security ApiKeyAuth
 // @Param page query int false "页码" default(1)
 // @Param limit query int false "每页数量" default(20)
+// @Param cursor query string false "游标分页：首页传空串，之后传上一页的 next_cursor（不统计总数与全局统计）"
+// @Param include_total query bool false "是否返回总数与全局统计"
 // @Param user_id query string false "按用户ID筛选"
 // @Param action query int false "按操作类型筛选"
 // @Param resource query string false "按资源路径筛选"
@@ -1241,6 +1593,642 @@ func ListAuditLogs(ctx context.Context, c *app.RequestContext) {
 	errors.JSON(c, consts.StatusOK, resp)
 }
 
+// ListUserRoleAssignments 查询用户角色分配
+// @Summary 查询用户角色分配
+// @Description 分页查询用户角色分配记录，支持按用户、角色、组织筛选及即将到期的临时授权
+// @Tags 角色分配
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param page query int false "页码" default(1)
+// @Param limit query int false "每页数量" default(20)
+// @Param cursor query string false "游标分页：首页传空串，之后传上一页的 next_cursor"
+// @Param include_total query bool false "是否返回总数"
+// @Param user_id query string false "按用户ID筛选"
+// @Param role_id query string false "按角色ID筛选"
+// @Param organization_id query string false "按组织ID筛选（含全局分配）"
+// @Param expiring_within_seconds query int false "仅返回指定秒数内到期的分配"
+// @Success 200 {object} identity.ListUserRoleAssignmentsResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/permission/user-roles [GET]
+func ListUserRoleAssignments(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.ListUserRoleAssignmentsRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.ListUserRoleAssignments(ctx, &req)
+	if err != nil {
+		errors.HandleServiceError(c, err, "查询用户角色分配失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// GetPermissionMatrix 查询角色权限矩阵
+// @Summary 查询角色权限矩阵
+// @Description 角色 × 菜单/策略权限矩阵，含菜单权限级别与数据范围；策略含通配规则的角色列出全部菜单
+// @Tags 权限报告
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param product_line query string false "菜单产品线" default(default)
+// @Param role_ids query []string false "仅包含指定角色" collectionFormat(multi)
+// @Param organization_id query string false "同时列出该组织域下的策略规则"
+// @Success 200 {object} identity.GetPermissionMatrixResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/permission/matrix [GET]
+func GetPermissionMatrix(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.GetPermissionMatrixRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.GetPermissionMatrix(ctx, &req)
+	if err != nil {
+		errors.HandleServiceError(c, err, "查询角色权限矩阵失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// ExportPermissionReport 导出权限报告
+// @Summary 导出权限报告
+// @Description 按角色视图（角色权限矩阵）或用户视图（用户在各租户下的有效权限）导出 CSV/XLSX，每条授权一行
+// @Tags 权限报告
+// @Produce octet-stream
+// @Security ApiKeyAuth
+// @Param format query string false "文件格式（csv/xlsx）" default(csv)
+// @Param view query string false "报告视图（role/user）" default(role)
+// @Param product_line query string false "菜单产品线" default(default)
+// @Param role_ids query []string false "角色视图：仅包含指定角色" collectionFormat(multi)
+// @Param user_ids query []string false "用户视图：仅包含指定用户，为空表示全部持有有效角色分配的用户" collectionFormat(multi)
+// @Param organization_id query string false "按组织筛选"
+// @Success 200 {file} file "导出文件"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/permission/matrix/export [GET]
+func ExportPermissionReport(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.ExportPermissionReportRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 调用业务服务层
+	file, err := identityService.ExportPermissionReport(ctx, &req)
+	if err != nil {
+		errors.HandleServiceError(c, err, "导出权限报告失败")
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.FileName))
+	c.Data(consts.StatusOK, file.ContentType, file.Content)
+}
+
+// GetUserEffectivePermissions 查询用户有效权限
+// @Summary 查询用户有效权限
+// @Description 用户在各租户下的有效权限及授予该权限的角色，含直接授予用户的策略规则与紧急账号标记
+// @Tags 权限报告
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param userID path string true "用户ID"
+// @Param product_line query string false "菜单产品线" default(default)
+// @Param organization_id query string false "仅计算该租户"
+// @Success 200 {object} identity.GetUserEffectivePermissionsResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
+// @Failure 404 {object} http_base.OperationStatusResponseDTO "用户未找到"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/permission/users/{userID}/effective [GET]
+func GetUserEffectivePermissions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.GetUserEffectivePermissionsRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.GetUserEffectivePermissions(ctx, &req)
+	if err != nil {
+		errors.HandleServiceError(c, err, "查询用户有效权限失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// ListRoleGrantRequests 查询角色授权申请
+// @Summary 查询角色授权申请
+// @Description 分页查询敏感角色（系统角色或标记为需审批的角色）的授权申请，可按状态、用户、角色、申请人筛选
+// @Tags 角色授权审批
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param status query int false "申请状态（1待审批 2已通过 3已驳回 4已过期）"
+// @Param user_id query string false "被授权用户ID"
+// @Param role_id query string false "角色ID"
+// @Param requested_by query string false "申请人ID"
+// @Success 200 {object} identity.ListRoleGrantRequestsResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/permission/role-grants [GET]
+func ListRoleGrantRequests(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.ListRoleGrantRequestsRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.ListRoleGrantRequests(ctx, &req)
+	if err != nil {
+		errors.HandleServiceError(c, err, "查询角色授权申请失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// GetRoleGrantRequest 获取角色授权申请
+// @Summary 获取角色授权申请
+// @Description 获取单个角色授权申请详情
+// @Tags 角色授权审批
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param requestID path string true "申请ID"
+// @Success 200 {object} identity.RoleGrantRequestResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
+// @Failure 404 {object} http_base.OperationStatusResponseDTO "申请未找到"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/permission/role-grants/{requestID} [GET]
+func GetRoleGrantRequest(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.GetRoleGrantRequestRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.GetRoleGrantRequest(ctx, &req)
+	if err != nil {
+		errors.HandleServiceError(c, err, "获取角色授权申请失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// ApproveRoleGrant 审批通过角色授权申请
+// @Summary 审批通过角色授权申请
+// @Description 审批通过后生成角色分配；审批人取自当前登录用户，不能是申请人或被授权用户本人，且需拥有该角色的 approve 权限
+// @Tags 角色授权审批
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param requestID path string true "申请ID"
+// @Param req body identity.DecideRoleGrantRequestDTO false "审批意见"
+// @Success 200 {object} identity.RoleGrantRequestResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
+// @Failure 404 {object} http_base.OperationStatusResponseDTO "申请未找到"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/permission/role-grants/{requestID}/approve [POST]
+func ApproveRoleGrant(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.DecideRoleGrantRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 获取当前用户ID作为审批人
+	approverID, ok := auth_context.GetCurrentUserProfileID(c)
+	if !ok {
+		errors.AbortWithError(c, errors.ErrJWTValidationFail)
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.ApproveRoleGrant(ctx, &req, approverID)
+	if err != nil {
+		errors.HandleServiceError(c, err, "审批角色授权申请失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// RejectRoleGrant 驳回角色授权申请
+// @Summary 驳回角色授权申请
+// @Description 驳回待审批的角色授权申请；审批人取自当前登录用户，需拥有该角色的 approve 权限
+// @Tags 角色授权审批
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param requestID path string true "申请ID"
+// @Param req body identity.DecideRoleGrantRequestDTO false "驳回原因"
+// @Success 200 {object} identity.RoleGrantRequestResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
+// @Failure 404 {object} http_base.OperationStatusResponseDTO "申请未找到"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/permission/role-grants/{requestID}/reject [POST]
+func RejectRoleGrant(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.DecideRoleGrantRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 获取当前用户ID作为审批人
+	approverID, ok := auth_context.GetCurrentUserProfileID(c)
+	if !ok {
+		errors.AbortWithError(c, errors.ErrJWTValidationFail)
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.RejectRoleGrant(ctx, &req, approverID)
+	if err != nil {
+		errors.HandleServiceError(c, err, "驳回角色授权申请失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// ListAccessReviewCampaigns 查询访问复核活动
+// @Summary 查询访问复核活动
+// @Description 分页查询访问复核活动及其完成情况，可按状态、组织筛选
+// @Tags 访问复核
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param status query int false "活动状态（1进行中 2已关闭）"
+// @Param organization_id query string false "组织ID"
+// @Success 200 {object} identity.ListAccessReviewCampaignsResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/permission/access-reviews [GET]
+func ListAccessReviewCampaigns(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.ListAccessReviewCampaignsRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.ListAccessReviewCampaigns(ctx, &req)
+	if err != nil {
+		errors.HandleServiceError(c, err, "查询访问复核活动失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// CreateAccessReviewCampaign 创建访问复核活动
+// @Summary 创建访问复核活动
+// @Description 按组织/角色范围创建复核活动，范围内当前有效的角色分配生成待复核条目；创建人取自当前登录用户
+// @Tags 访问复核
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param req body identity.CreateAccessReviewCampaignRequestDTO true "活动信息"
+// @Success 200 {object} identity.AccessReviewCampaignResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/permission/access-reviews [POST]
+func CreateAccessReviewCampaign(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.CreateAccessReviewCampaignRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 获取当前用户ID作为创建人
+	operatorID, ok := auth_context.GetCurrentUserProfileID(c)
+	if !ok {
+		errors.AbortWithError(c, errors.ErrJWTValidationFail)
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.CreateAccessReviewCampaign(ctx, &req, operatorID)
+	if err != nil {
+		errors.HandleServiceError(c, err, "创建访问复核活动失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// GetAccessReviewCampaign 获取访问复核活动
+// @Summary 获取访问复核活动
+// @Description 获取单个访问复核活动详情及完成情况
+// @Tags 访问复核
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param campaignID path string true "活动ID"
+// @Success 200 {object} identity.AccessReviewCampaignResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
+// @Failure 404 {object} http_base.OperationStatusResponseDTO "活动未找到"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/permission/access-reviews/{campaignID} [GET]
+func GetAccessReviewCampaign(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.GetAccessReviewCampaignRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.GetAccessReviewCampaign(ctx, &req)
+	if err != nil {
+		errors.HandleServiceError(c, err, "获取访问复核活动失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// ListAccessReviewItems 查询访问复核条目
+// @Summary 查询访问复核条目
+// @Description 分页查询活动下的复核条目，可按复核结论、用户筛选
+// @Tags 访问复核
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param campaignID path string true "活动ID"
+// @Param decision query int false "复核结论（1待复核 2确认保留 3撤销 4自动撤销）"
+// @Param user_id query string false "用户ID"
+// @Success 200 {object} identity.ListAccessReviewItemsResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
+// @Failure 404 {object} http_base.OperationStatusResponseDTO "活动未找到"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/permission/access-reviews/{campaignID}/items [GET]
+func ListAccessReviewItems(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.ListAccessReviewItemsRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.ListAccessReviewItems(ctx, &req)
+	if err != nil {
+		errors.HandleServiceError(c, err, "查询访问复核条目失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// CloseAccessReviewCampaign 关闭访问复核活动
+// @Summary 关闭访问复核活动
+// @Description 关闭进行中的活动；活动开启自动撤销时，未复核条目对应的角色分配会被撤销，任一撤销失败则活动保持进行中
+// @Tags 访问复核
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param campaignID path string true "活动ID"
+// @Success 200 {object} identity.AccessReviewCampaignResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
+// @Failure 404 {object} http_base.OperationStatusResponseDTO "活动未找到"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/permission/access-reviews/{campaignID}/close [POST]
+func CloseAccessReviewCampaign(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.CloseAccessReviewCampaignRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 获取当前用户ID作为关闭人
+	operatorID, ok := auth_context.GetCurrentUserProfileID(c)
+	if !ok {
+		errors.AbortWithError(c, errors.ErrJWTValidationFail)
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.CloseAccessReviewCampaign(ctx, &req, operatorID)
+	if err != nil {
+		errors.HandleServiceError(c, err, "关闭访问复核活动失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// DecideAccessReviewItem 复核访问复核条目
+// @Summary 复核访问复核条目
+// @Description 确认保留（2）或撤销（3）条目对应的角色分配；复核人取自当前登录用户，不能复核自己的分配，活动指定了复核人时只能由其复核
+// @Tags 访问复核
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param itemID path string true "条目ID"
+// @Param req body identity.DecideAccessReviewItemRequestDTO true "复核结论"
+// @Success 200 {object} identity.AccessReviewItemResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
+// @Failure 404 {object} http_base.OperationStatusResponseDTO "条目未找到"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/permission/access-review-items/{itemID}/decision [POST]
+func DecideAccessReviewItem(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.DecideAccessReviewItemRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 获取当前用户ID作为复核人
+	reviewerID, ok := auth_context.GetCurrentUserProfileID(c)
+	if !ok {
+		errors.AbortWithError(c, errors.ErrJWTValidationFail)
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.DecideAccessReviewItem(ctx, &req, reviewerID)
+	if err != nil {
+		errors.HandleServiceError(c, err, "复核访问复核条目失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// ListDeletedEntities 查询回收站
+// @Summary 查询回收站
+// @Description 分页查询已删除的用户、组织或部门，默认按删除时间倒序，返回自动清除时间
+// @Tags 回收站
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param entityType path string true "回收站类型" Enums(users, organizations, departments)
+// @Param page query int false "页码" default(1)
+// @Param limit query int false "每页数量" default(20)
+// @Param search query string false "按名称/代码搜索"
+// @Success 200 {object} identity.ListDeletedEntitiesResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/identity/recycle-bin/{entityType} [GET]
+func ListDeletedEntities(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.ListDeletedEntitiesRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.ListDeletedEntities(ctx, &req)
+	if err != nil {
+		errors.HandleServiceError(c, err, "查询回收站失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// RestoreDeletedEntity 恢复回收站记录
+// @Summary 恢复回收站记录
+// @Description 恢复已删除的用户、组织或部门；恢复用户时一并恢复删除用户时级联删除的角色分配与成员关系
+// @Tags 回收站
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param entityType path string true "回收站类型" Enums(users, organizations, departments)
+// @Param id path string true "记录ID"
+// @Success 200 {object} identity.RestoreDeletedEntityResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误或上级记录已删除"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
+// @Failure 404 {object} http_base.OperationStatusResponseDTO "回收站中不存在该记录"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/identity/recycle-bin/{entityType}/{id}/restore [POST]
+func RestoreDeletedEntity(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.DeletedEntityRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.RestoreDeletedEntity(ctx, &req)
+	if err != nil {
+		errors.HandleServiceError(c, err, "恢复回收站记录失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// PurgeDeletedEntity 彻底删除回收站记录
+// @Summary 彻底删除回收站记录
+// @Description 物理删除回收站中的用户、组织或部门，不可恢复；仍被未删除数据引用时拒绝
+// @Tags 回收站
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param entityType path string true "回收站类型" Enums(users, organizations, departments)
+// @Param id path string true "记录ID"
+// @Success 200 {object} http_base.OperationStatusResponseDTO "成功"
+// @Failure 400 {object} http_base.OperationStatusResponseDTO "请求参数错误或仍被引用"
+// @Failure 401 {object} http_base.OperationStatusResponseDTO "认证失败"
+// @Failure 403 {object} http_base.OperationStatusResponseDTO "权限不足"
+// @Failure 404 {object} http_base.OperationStatusResponseDTO "回收站中不存在该记录"
+// @Failure 500 {object} http_base.OperationStatusResponseDTO "内部错误"
+// @Router /api/v1/identity/recycle-bin/{entityType}/{id} [DELETE]
+func PurgeDeletedEntity(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.DeletedEntityRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.PurgeDeletedEntity(ctx, &req)
+	if err != nil {
+		errors.HandleServiceError(c, err, "彻底删除回收站记录失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
 // GetOIDCDiscovery
 // @Summary OIDC Discovery
 // @Description 返回 OIDC Provider 的配置信息（自动发现端点），客户端可通过此端点获取所有 OIDC 服务地址
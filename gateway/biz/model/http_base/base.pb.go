@@ -200,6 +200,9 @@ type TokenInfoDTO struct {
 	TokenType   *string `protobuf:"bytes,3,opt,name=tokenType,proto3,oneof" form:"token_type" json:"token_type,omitempty" query:"token_type"`
 	// OIDC ID Token (JWT)
 	IdToken *string `protobuf:"bytes,4,opt,name=idToken,proto3,oneof" form:"id_token" json:"id_token,omitempty" query:"id_token"`
+	// 不透明刷新令牌（一次性使用，每次刷新轮换）
+	RefreshToken     *string `protobuf:"bytes,5,opt,name=refreshToken,proto3,oneof" form:"refresh_token" json:"refresh_token,omitempty" query:"refresh_token"`
+	RefreshExpiresIn *int64  `protobuf:"varint,6,opt,name=refreshExpiresIn,proto3,oneof" form:"refresh_expires_in" json:"refresh_expires_in,omitempty" query:"refresh_expires_in"`
 }
 
 func (x *TokenInfoDTO) Reset() {
@@ -262,6 +265,20 @@ func (x *TokenInfoDTO) GetIdToken() string {
 	return ""
 }
 
+func (x *TokenInfoDTO) GetRefreshToken() string {
+	if x != nil && x.RefreshToken != nil {
+		return *x.RefreshToken
+	}
+	return ""
+}
+
+func (x *TokenInfoDTO) GetRefreshExpiresIn() int64 {
+	if x != nil && x.RefreshExpiresIn != nil {
+		return *x.RefreshExpiresIn
+	}
+	return 0
+}
+
 type BaseResponseDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -377,6 +394,8 @@ type PageRequestDTO struct {
 	Fields       []string          `protobuf:"bytes,6,rep,name=fields,proto3" form:"fields" json:"fields,omitempty" query:"fields"`
 	IncludeTotal *bool             `protobuf:"varint,7,opt,name=includeTotal,proto3,oneof" form:"include_total" json:"include_total,omitempty" query:"include_total"`
 	FetchAll     *bool             `protobuf:"varint,8,opt,name=fetchAll,proto3,oneof" form:"fetch_all" json:"fetch_all,omitempty" query:"fetch_all"`
+	// 游标分页：首页传空值（?cursor=），后续传上一页返回的 next_cursor
+	Cursor *string `protobuf:"bytes,9,opt,name=cursor,proto3,oneof" form:"cursor" json:"cursor,omitempty" query:"cursor" vd:"@:len($)<=512; msg:'游标格式不正确'"`
 }
 
 func (x *PageRequestDTO) Reset() {
@@ -467,17 +486,25 @@ func (x *PageRequestDTO) GetFetchAll() bool {
 	return false
 }
 
+func (x *PageRequestDTO) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
 type PageResponseDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total      *int32 `protobuf:"varint,1,opt,name=total,proto3,oneof" form:"total" json:"total,omitempty" query:"total"`
-	Page       *int32 `protobuf:"varint,2,opt,name=page,proto3,oneof" form:"page" json:"page,omitempty" query:"page"`
-	Limit      *int32 `protobuf:"varint,3,opt,name=limit,proto3,oneof" form:"limit" json:"limit,omitempty" query:"limit"`
-	TotalPages *int32 `protobuf:"varint,4,opt,name=totalPages,proto3,oneof" form:"totalPages" json:"total_pages,omitempty" query:"totalPages"`
-	HasNext    *bool  `protobuf:"varint,5,opt,name=hasNext,proto3,oneof" form:"hasNext" json:"has_next,omitempty" query:"hasNext"`
-	HasPrev    *bool  `protobuf:"varint,6,opt,name=hasPrev,proto3,oneof" form:"hasPrev" json:"has_prev,omitempty" query:"hasPrev"`
+	Total      *int32  `protobuf:"varint,1,opt,name=total,proto3,oneof" form:"total" json:"total,omitempty" query:"total"`
+	Page       *int32  `protobuf:"varint,2,opt,name=page,proto3,oneof" form:"page" json:"page,omitempty" query:"page"`
+	Limit      *int32  `protobuf:"varint,3,opt,name=limit,proto3,oneof" form:"limit" json:"limit,omitempty" query:"limit"`
+	TotalPages *int32  `protobuf:"varint,4,opt,name=totalPages,proto3,oneof" form:"totalPages" json:"total_pages,omitempty" query:"totalPages"`
+	HasNext    *bool   `protobuf:"varint,5,opt,name=hasNext,proto3,oneof" form:"hasNext" json:"has_next,omitempty" query:"hasNext"`
+	HasPrev    *bool   `protobuf:"varint,6,opt,name=hasPrev,proto3,oneof" form:"hasPrev" json:"has_prev,omitempty" query:"hasPrev"`
+	NextCursor *string `protobuf:"bytes,7,opt,name=nextCursor,proto3,oneof" form:"nextCursor" json:"next_cursor,omitempty" query:"nextCursor"`
 }
 
 func (x *PageResponseDTO) Reset() {
@@ -554,6 +581,13 @@ func (x *PageResponseDTO) GetHasPrev() bool {
 	return false
 }
 
+func (x *PageResponseDTO) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
 var File_http_base_base_proto protoreflect.FileDescriptor
 
 var file_http_base_base_proto_rawDesc = []byte{
@@ -665,8 +699,8 @@ var file_http_base_base_proto_rawDesc = []byte{
 	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73, 0x75, 0x62, 0x42,
 	0x06, 0x0a, 0x04, 0x5f, 0x69, 0x73, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x61, 0x75, 0x64, 0x42,
 	0x06, 0x0a, 0x04, 0x5f, 0x61, 0x7a, 0x70, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6e, 0x6f, 0x6e, 0x63,
-	0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xea,
-	0x03, 0x0a, 0x0c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x44, 0x54, 0x4f, 0x12,
+	0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x98,
+	0x06, 0x0a, 0x0c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x44, 0x54, 0x4f, 0x12,
 	0x71, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x4a, 0xca, 0xf3, 0x18, 0x46, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
 	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2c, 0x6f, 0x6d, 0x69,
@@ -693,127 +727,161 @@ var file_http_base_base_proto_rawDesc = []byte{
 	0x70, 0x74, 0x79, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x64, 0x5f, 0x74, 0x6f,
 	0x6b, 0x65, 0x6e, 0x22, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x22, 0x69, 0x64, 0x5f, 0x74,
 	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x48, 0x03, 0x52, 0x07, 0x69, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49,
-	0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x42,
-	0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x0f,
-	0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12,
-	0x28, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0f, 0xca,
-	0xf3, 0x18, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x48, 0x00,
-	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xca, 0xf3, 0x18, 0x0e,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x48, 0x01,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05,
-	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x7c, 0x0a, 0x1a, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12,
-	0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61,
-	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca,
-	0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65,
-	0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88,
-	0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0xb4, 0x08, 0x0a, 0x0e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44,
-	0x54, 0x4f, 0x12, 0x7e, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x65, 0xb2, 0xbb, 0x18, 0x04, 0x70, 0x61, 0x67, 0x65, 0xda, 0xbb, 0x18, 0x27, 0x21, 0x69,
-	0x73, 0x73, 0x65, 0x74, 0x28, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x29, 0x20, 0x7c,
-	0x7c, 0x20, 0x2a, 0x28, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x29, 0x24, 0x20, 0x7c,
-	0x7c, 0x20, 0x24, 0x3e, 0x30, 0xca, 0xf3, 0x18, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70,
-	0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x20, 0x66,
-	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x22, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x22, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x90, 0x01, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x75, 0xb2, 0xbb, 0x18, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0xda, 0xbb, 0x18,
-	0x33, 0x21, 0x69, 0x73, 0x73, 0x65, 0x74, 0x28, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c,
-	0x29, 0x20, 0x7c, 0x7c, 0x20, 0x2a, 0x28, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x29,
-	0x24, 0x20, 0x7c, 0x7c, 0x20, 0x28, 0x24, 0x3e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x24, 0x3c, 0x3d,
-	0x32, 0x30, 0x30, 0x29, 0xca, 0xf3, 0x18, 0x31, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x20, 0x66,
-	0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x20, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x3a, 0x22, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x5f, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0xb2, 0xbb, 0x18, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0xca, 0xf3, 0x18, 0x34, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x20, 0x66, 0x6f, 0x72,
-	0x6d, 0x3a, 0x22, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x3a, 0x22, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0x48, 0x02, 0x52, 0x06, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x88, 0x01, 0x01, 0x12, 0x81, 0x01, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44,
-	0x54, 0x4f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x42,
-	0xb2, 0xbb, 0x18, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0xca, 0xf3, 0x18, 0x34, 0x6a, 0x73,
-	0x6f, 0x6e, 0x3a, 0x22, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x22, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x22, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x22, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x53, 0x0a, 0x04, 0x73, 0x6f,
-	0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0xb2, 0xbb, 0x18, 0x04, 0x73, 0x6f,
-	0x72, 0x74, 0xca, 0xf3, 0x18, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x6f, 0x72, 0x74,
-	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d,
-	0x3a, 0x22, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x22, 0x73,
-	0x6f, 0x72, 0x74, 0x22, 0x48, 0x03, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x5a, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42,
-	0x42, 0xb2, 0xbb, 0x18, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0xca, 0xf3, 0x18, 0x34, 0x6a,
-	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x22, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x22, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x22, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x0c,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x08, 0x42, 0x5e, 0xb2, 0xbb, 0x18, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0xca, 0xf3, 0x18, 0x49, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69,
-	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x2c, 0x6f, 0x6d, 0x69,
-	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x20, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x3a, 0x22, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x22, 0x48, 0x04, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x6f, 0x0a, 0x08, 0x66, 0x65, 0x74, 0x63, 0x68, 0x41, 0x6c,
-	0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x42, 0x4e, 0xb2, 0xbb, 0x18, 0x09, 0x66, 0x65, 0x74,
-	0x63, 0x68, 0x5f, 0x61, 0x6c, 0x6c, 0xca, 0xf3, 0x18, 0x3d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x66, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x61, 0x6c, 0x6c, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x65, 0x74, 0x63, 0x68,
-	0x5f, 0x61, 0x6c, 0x6c, 0x22, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x22, 0x66, 0x65, 0x74,
-	0x63, 0x68, 0x5f, 0x61, 0x6c, 0x6c, 0x22, 0x48, 0x05, 0x52, 0x08, 0x66, 0x65, 0x74, 0x63, 0x68,
-	0x41, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42,
-	0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x66, 0x65,
-	0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x22, 0xba, 0x03, 0x0a, 0x0f, 0x50, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a,
-	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x88, 0x01,
-	0x01, 0x12, 0x32, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42,
-	0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x48, 0x02, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0a,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x20, 0xca, 0xf3, 0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x48, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73,
-	0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x42, 0x1d, 0xca, 0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x88, 0x01,
-	0x01, 0x12, 0x3c, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x50, 0x72, 0x65, 0x76, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x42, 0x1d, 0xca, 0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x68, 0x61,
-	0x73, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x48, 0x05, 0x52, 0x07, 0x68, 0x61, 0x73, 0x50, 0x72, 0x65, 0x76, 0x88, 0x01, 0x01, 0x42,
+	0x88, 0x01, 0x01, 0x12, 0x76, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4d, 0xca, 0xf3, 0x18, 0x49, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x20, 0x66, 0x6f,
+	0x72, 0x6d, 0x3a, 0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x48, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x8d, 0x01, 0x0a, 0x10,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x5c, 0xca, 0xf3, 0x18, 0x58, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x69, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x20,
+	0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x22, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a,
+	0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x69, 0x6e, 0x22, 0x48, 0x05, 0x52, 0x10, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x64, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x0f, 0x42, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x28, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0f, 0xca, 0xf3, 0x18,
+	0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x48, 0x00, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x48, 0x01, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x7c, 0x0a, 0x1a, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a,
+	0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18,
+	0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0xcf, 0x09,
+	0x0a, 0x0e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f,
+	0x12, 0x7e, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x65,
+	0xb2, 0xbb, 0x18, 0x04, 0x70, 0x61, 0x67, 0x65, 0xda, 0xbb, 0x18, 0x27, 0x21, 0x69, 0x73, 0x73,
+	0x65, 0x74, 0x28, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x29, 0x20, 0x7c, 0x7c, 0x20,
+	0x2a, 0x28, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x29, 0x24, 0x20, 0x7c, 0x7c, 0x20,
+	0x24, 0x3e, 0x30, 0xca, 0xf3, 0x18, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67,
+	0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x20, 0x66, 0x6f, 0x72,
+	0x6d, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x22, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x22,
+	0x70, 0x61, 0x67, 0x65, 0x22, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x90, 0x01, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x75, 0xb2, 0xbb, 0x18, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0xda, 0xbb, 0x18, 0x33, 0x21,
+	0x69, 0x73, 0x73, 0x65, 0x74, 0x28, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x29, 0x20,
+	0x7c, 0x7c, 0x20, 0x2a, 0x28, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x29, 0x24, 0x20,
+	0x7c, 0x7c, 0x20, 0x28, 0x24, 0x3e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x24, 0x3c, 0x3d, 0x32, 0x30,
+	0x30, 0x29, 0xca, 0xf3, 0x18, 0x31, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x20, 0x66, 0x6f, 0x72,
+	0x6d, 0x3a, 0x22, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a,
+	0x22, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x5f, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x42, 0xb2, 0xbb, 0x18, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0xca,
+	0xf3, 0x18, 0x34, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x22,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0x48, 0x02, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x88, 0x01, 0x01, 0x12, 0x81, 0x01, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x42, 0xb2, 0xbb,
+	0x18, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0xca, 0xf3, 0x18, 0x34, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x22, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x22, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x53, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0xb2, 0xbb, 0x18, 0x04, 0x73, 0x6f, 0x72, 0x74,
+	0xca, 0xf3, 0x18, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x6f, 0x72, 0x74, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x22,
+	0x73, 0x6f, 0x72, 0x74, 0x22, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x22, 0x73, 0x6f, 0x72,
+	0x74, 0x22, 0x48, 0x03, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x5a, 0x0a,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x42, 0xb2,
+	0xbb, 0x18, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0xca, 0xf3, 0x18, 0x34, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x22, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x22, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x0c, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x5e, 0xb2, 0xbb, 0x18, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0xca, 0xf3, 0x18, 0x49, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x3a, 0x22, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22,
+	0x48, 0x04, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x88, 0x01, 0x01, 0x12, 0x6f, 0x0a, 0x08, 0x66, 0x65, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x42, 0x4e, 0xb2, 0xbb, 0x18, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68,
+	0x5f, 0x61, 0x6c, 0x6c, 0xca, 0xf3, 0x18, 0x3d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x66, 0x65,
+	0x74, 0x63, 0x68, 0x5f, 0x61, 0x6c, 0x6c, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x22, 0x66, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x61,
+	0x6c, 0x6c, 0x22, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x22, 0x66, 0x65, 0x74, 0x63, 0x68,
+	0x5f, 0x61, 0x6c, 0x6c, 0x22, 0x48, 0x05, 0x52, 0x08, 0x66, 0x65, 0x74, 0x63, 0x68, 0x41, 0x6c,
+	0x6c, 0x88, 0x01, 0x01, 0x12, 0x8d, 0x01, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x70, 0xb2, 0xbb, 0x18, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0xda, 0xbb, 0x18, 0x2a, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x35,
+	0x31, 0x32, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe6, 0xb8, 0xb8, 0xe6, 0xa0, 0x87, 0xe6,
+	0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca,
+	0xf3, 0x18, 0x34, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x3a,
+	0x22, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x22,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x48, 0x06, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x88, 0x01, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x66, 0x65, 0x74, 0x63,
+	0x68, 0x41, 0x6c, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
+	0x90, 0x04, 0x0a, 0x0f, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x44, 0x54, 0x4f, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x00,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x35,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1a, 0xca,
+	0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x20, 0xca, 0xf3, 0x18, 0x1c, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x07,
+	0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1d, 0xca,
+	0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x78,
+	0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x07,
+	0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x07, 0x68, 0x61,
+	0x73, 0x50, 0x72, 0x65, 0x76, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1d, 0xca, 0xf3, 0x18,
+	0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x07, 0x68, 0x61,
+	0x73, 0x50, 0x72, 0x65, 0x76, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xca, 0xf3,
+	0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x06,
+	0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42,
 	0x08, 0x0a, 0x06, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61,
 	0x67, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x0d, 0x0a, 0x0b,
 	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
 	0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x68, 0x61, 0x73, 0x50,
-	0x72, 0x65, 0x76, 0x42, 0x4d, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6d, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x78, 0x75, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x77, 0x65, 0x67, 0x6f, 0x2d, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2d, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x62,
-	0x69, 0x7a, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61,
-	0x73, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x65, 0x76, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x42, 0x4d, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6d, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x78, 0x75, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x77,
+	0x65, 0x67, 0x6f, 0x2d, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2d, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x62, 0x69,
+	0x7a, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -37,6 +37,8 @@ type LoginRequestDTO struct {
 	OidcRedirectURI  *string `protobuf:"bytes,6,opt,name=oidcRedirectURI,proto3,oneof" form:"oidc_redirect_uri" json:"oidc_redirect_uri,omitempty"`
 	OidcCodeVerifier *string `protobuf:"bytes,7,opt,name=oidcCodeVerifier,proto3,oneof" form:"oidc_code_verifier" json:"oidc_code_verifier,omitempty"`
 	Nonce            *string `protobuf:"bytes,8,opt,name=nonce,proto3,oneof" form:"nonce" json:"nonce,omitempty"`
+	// 客户端标识（如 nurse-mobile），用于选择登录结果中的菜单产品线
+	ClientID *string `protobuf:"bytes,9,opt,name=clientID,proto3,oneof" form:"client_id" json:"client_id,omitempty"`
 }
 
 func (x *LoginRequestDTO) Reset() {
@@ -127,6 +129,13 @@ func (x *LoginRequestDTO) GetNonce() string {
 	return ""
 }
 
+func (x *LoginRequestDTO) GetClientID() string {
+	if x != nil && x.ClientID != nil {
+		return *x.ClientID
+	}
+	return ""
+}
+
 type RoleInfoDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -209,6 +218,10 @@ type LoginResponseDTO struct {
 	Memberships []*UserMembershipDTO       `protobuf:"bytes,5,rep,name=memberships,proto3" form:"memberships" json:"memberships,omitempty" query:"memberships"`
 	RoleIDs     []string                   `protobuf:"bytes,6,rep,name=roleIDs,proto3" form:"roleIDs" json:"role_ids,omitempty" query:"roleIDs"`
 	Roles       []*RoleInfoDTO             `protobuf:"bytes,8,rep,name=roles,proto3" form:"roles" json:"roles,omitempty" query:"roles"`
+	// 需要第二因素时只返回挑战，不签发令牌；客户端凭挑战令牌完成校验或登记
+	MfaChallenge *MFAChallengeDTO `protobuf:"bytes,9,opt,name=mfaChallenge,proto3,oneof" form:"mfaChallenge" json:"mfa_challenge,omitempty" query:"mfaChallenge"`
+	// 在登录流程中完成 MFA 登记时返回的一次性恢复码（仅此一次展示）
+	MfaRecoveryCodes []string `protobuf:"bytes,10,rep,name=mfaRecoveryCodes,proto3" form:"mfaRecoveryCodes" json:"mfa_recovery_codes,omitempty" query:"mfaRecoveryCodes"`
 }
 
 func (x *LoginResponseDTO) Reset() {
@@ -285,16 +298,34 @@ func (x *LoginResponseDTO) GetRoles() []*RoleInfoDTO {
 	return nil
 }
 
-type LogoutRequestDTO struct {
+func (x *LoginResponseDTO) GetMfaChallenge() *MFAChallengeDTO {
+	if x != nil {
+		return x.MfaChallenge
+	}
+	return nil
+}
+
+func (x *LoginResponseDTO) GetMfaRecoveryCodes() []string {
+	if x != nil {
+		return x.MfaRecoveryCodes
+	}
+	return nil
+}
+
+// 登录第二因素挑战
+type MFAChallengeDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RefreshToken *string `protobuf:"bytes,1,opt,name=refreshToken,proto3,oneof" form:"refresh_token" json:"refresh_token" vd:"@:len($) > 0; msg:'刷新令牌不能为空'"`
+	ChallengeToken *string `protobuf:"bytes,1,opt,name=challengeToken,proto3,oneof" form:"challengeToken" json:"challenge_token" query:"challengeToken"`
+	ExpiresIn      *int64  `protobuf:"varint,2,opt,name=expiresIn,proto3,oneof" form:"expiresIn" json:"expires_in" query:"expiresIn"`
+	// true 表示组织强制 MFA 但用户尚未登记，需先调用登记接口再提交口令
+	EnrollmentRequired *bool `protobuf:"varint,3,opt,name=enrollmentRequired,proto3,oneof" form:"enrollmentRequired" json:"enrollment_required" query:"enrollmentRequired"`
 }
 
-func (x *LogoutRequestDTO) Reset() {
-	*x = LogoutRequestDTO{}
+func (x *MFAChallengeDTO) Reset() {
+	*x = MFAChallengeDTO{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_http_identity_identity_model_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -302,13 +333,13 @@ func (x *LogoutRequestDTO) Reset() {
 	}
 }
 
-func (x *LogoutRequestDTO) String() string {
+func (x *MFAChallengeDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutRequestDTO) ProtoMessage() {}
+func (*MFAChallengeDTO) ProtoMessage() {}
 
-func (x *LogoutRequestDTO) ProtoReflect() protoreflect.Message {
+func (x *MFAChallengeDTO) ProtoReflect() protoreflect.Message {
 	mi := &file_http_identity_identity_model_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -320,29 +351,43 @@ func (x *LogoutRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutRequestDTO.ProtoReflect.Descriptor instead.
-func (*LogoutRequestDTO) Descriptor() ([]byte, []int) {
+// Deprecated: Use MFAChallengeDTO.ProtoReflect.Descriptor instead.
+func (*MFAChallengeDTO) Descriptor() ([]byte, []int) {
 	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *LogoutRequestDTO) GetRefreshToken() string {
-	if x != nil && x.RefreshToken != nil {
-		return *x.RefreshToken
+func (x *MFAChallengeDTO) GetChallengeToken() string {
+	if x != nil && x.ChallengeToken != nil {
+		return *x.ChallengeToken
 	}
 	return ""
 }
 
-type ChangePasswordRequestDTO struct {
+func (x *MFAChallengeDTO) GetExpiresIn() int64 {
+	if x != nil && x.ExpiresIn != nil {
+		return *x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *MFAChallengeDTO) GetEnrollmentRequired() bool {
+	if x != nil && x.EnrollmentRequired != nil {
+		return *x.EnrollmentRequired
+	}
+	return false
+}
+
+type MFAVerifyRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OldPassword *string `protobuf:"bytes,1,opt,name=oldPassword,proto3,oneof" form:"old_password" json:"old_password" vd:"@:len($)>0; msg:'当前密码不能为空'"`
-	NewPassword *string `protobuf:"bytes,2,opt,name=newPassword,proto3,oneof" form:"new_password" json:"new_password" vd:"@:len($)>0 && len($)>=6; msg:'新密码不能为空且长度至少为6位'"`
+	ChallengeToken *string `protobuf:"bytes,1,opt,name=challengeToken,proto3,oneof" form:"challenge_token" json:"challenge_token" vd:"@:len($)>0; msg:'挑战令牌不能为空'"`
+	Code           *string `protobuf:"bytes,2,opt,name=code,proto3,oneof" form:"code" json:"code" vd:"@:len($)>0; msg:'验证码不能为空'"`
 }
 
-func (x *ChangePasswordRequestDTO) Reset() {
-	*x = ChangePasswordRequestDTO{}
+func (x *MFAVerifyRequestDTO) Reset() {
+	*x = MFAVerifyRequestDTO{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_http_identity_identity_model_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -350,13 +395,13 @@ func (x *ChangePasswordRequestDTO) Reset() {
 	}
 }
 
-func (x *ChangePasswordRequestDTO) String() string {
+func (x *MFAVerifyRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordRequestDTO) ProtoMessage() {}
+func (*MFAVerifyRequestDTO) ProtoMessage() {}
 
-func (x *ChangePasswordRequestDTO) ProtoReflect() protoreflect.Message {
+func (x *MFAVerifyRequestDTO) ProtoReflect() protoreflect.Message {
 	mi := &file_http_identity_identity_model_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -368,37 +413,35 @@ func (x *ChangePasswordRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordRequestDTO.ProtoReflect.Descriptor instead.
-func (*ChangePasswordRequestDTO) Descriptor() ([]byte, []int) {
+// Deprecated: Use MFAVerifyRequestDTO.ProtoReflect.Descriptor instead.
+func (*MFAVerifyRequestDTO) Descriptor() ([]byte, []int) {
 	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ChangePasswordRequestDTO) GetOldPassword() string {
-	if x != nil && x.OldPassword != nil {
-		return *x.OldPassword
+func (x *MFAVerifyRequestDTO) GetChallengeToken() string {
+	if x != nil && x.ChallengeToken != nil {
+		return *x.ChallengeToken
 	}
 	return ""
 }
 
-func (x *ChangePasswordRequestDTO) GetNewPassword() string {
-	if x != nil && x.NewPassword != nil {
-		return *x.NewPassword
+func (x *MFAVerifyRequestDTO) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
 	}
 	return ""
 }
 
-type ResetPasswordRequestDTO struct {
+type MFAEnrollRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID      *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" form:"user_id" json:"user_id" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
-	NewPassword *string `protobuf:"bytes,2,opt,name=newPassword,proto3,oneof" form:"new_password" json:"new_password,omitempty" vd:"@:len($)==0 || len($)>=6; msg:'新密码长度至少为6位'"`
-	ResetReason *string `protobuf:"bytes,3,opt,name=resetReason,proto3,oneof" form:"reset_reason" json:"reset_reason,omitempty" vd:"@:len($)<=200; msg:'重置原因不能超过200个字符'"`
+	ChallengeToken *string `protobuf:"bytes,1,opt,name=challengeToken,proto3,oneof" form:"challenge_token" json:"challenge_token" vd:"@:len($)>0; msg:'挑战令牌不能为空'"`
 }
 
-func (x *ResetPasswordRequestDTO) Reset() {
-	*x = ResetPasswordRequestDTO{}
+func (x *MFAEnrollRequestDTO) Reset() {
+	*x = MFAEnrollRequestDTO{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_http_identity_identity_model_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -406,13 +449,13 @@ func (x *ResetPasswordRequestDTO) Reset() {
 	}
 }
 
-func (x *ResetPasswordRequestDTO) String() string {
+func (x *MFAEnrollRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPasswordRequestDTO) ProtoMessage() {}
+func (*MFAEnrollRequestDTO) ProtoMessage() {}
 
-func (x *ResetPasswordRequestDTO) ProtoReflect() protoreflect.Message {
+func (x *MFAEnrollRequestDTO) ProtoReflect() protoreflect.Message {
 	mi := &file_http_identity_identity_model_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -424,58 +467,83 @@ func (x *ResetPasswordRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPasswordRequestDTO.ProtoReflect.Descriptor instead.
-func (*ResetPasswordRequestDTO) Descriptor() ([]byte, []int) {
+// Deprecated: Use MFAEnrollRequestDTO.ProtoReflect.Descriptor instead.
+func (*MFAEnrollRequestDTO) Descriptor() ([]byte, []int) {
 	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ResetPasswordRequestDTO) GetUserID() string {
-	if x != nil && x.UserID != nil {
-		return *x.UserID
+func (x *MFAEnrollRequestDTO) GetChallengeToken() string {
+	if x != nil && x.ChallengeToken != nil {
+		return *x.ChallengeToken
 	}
 	return ""
 }
 
-func (x *ResetPasswordRequestDTO) GetNewPassword() string {
-	if x != nil && x.NewPassword != nil {
-		return *x.NewPassword
+type StartMFAEnrollmentRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StartMFAEnrollmentRequestDTO) Reset() {
+	*x = StartMFAEnrollmentRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ResetPasswordRequestDTO) GetResetReason() string {
-	if x != nil && x.ResetReason != nil {
-		return *x.ResetReason
+func (x *StartMFAEnrollmentRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMFAEnrollmentRequestDTO) ProtoMessage() {}
+
+func (x *StartMFAEnrollmentRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type ForcePasswordChangeRequestDTO struct {
+// Deprecated: Use StartMFAEnrollmentRequestDTO.ProtoReflect.Descriptor instead.
+func (*StartMFAEnrollmentRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{6}
+}
+
+type MFAEnrollResponseDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" form:"user_id" json:"user_id" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
-	Reason *string `protobuf:"bytes,2,opt,name=reason,proto3,oneof" form:"reason" json:"reason,omitempty" vd:"@:len($)<=200; msg:'原因不能超过200个字符'"`
+	BaseResp        *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	Secret          *string                    `protobuf:"bytes,2,opt,name=secret,proto3,oneof" form:"secret" json:"secret" query:"secret"`
+	ProvisioningURI *string                    `protobuf:"bytes,3,opt,name=provisioningURI,proto3,oneof" form:"provisioningURI" json:"provisioning_uri" query:"provisioningURI"`
 }
 
-func (x *ForcePasswordChangeRequestDTO) Reset() {
-	*x = ForcePasswordChangeRequestDTO{}
+func (x *MFAEnrollResponseDTO) Reset() {
+	*x = MFAEnrollResponseDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[6]
+		mi := &file_http_identity_identity_model_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ForcePasswordChangeRequestDTO) String() string {
+func (x *MFAEnrollResponseDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForcePasswordChangeRequestDTO) ProtoMessage() {}
+func (*MFAEnrollResponseDTO) ProtoMessage() {}
 
-func (x *ForcePasswordChangeRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[6]
+func (x *MFAEnrollResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -486,50 +554,57 @@ func (x *ForcePasswordChangeRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForcePasswordChangeRequestDTO.ProtoReflect.Descriptor instead.
-func (*ForcePasswordChangeRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MFAEnrollResponseDTO.ProtoReflect.Descriptor instead.
+func (*MFAEnrollResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ForcePasswordChangeRequestDTO) GetUserID() string {
-	if x != nil && x.UserID != nil {
-		return *x.UserID
+func (x *MFAEnrollResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
+	}
+	return nil
+}
+
+func (x *MFAEnrollResponseDTO) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
 	}
 	return ""
 }
 
-func (x *ForcePasswordChangeRequestDTO) GetReason() string {
-	if x != nil && x.Reason != nil {
-		return *x.Reason
+func (x *MFAEnrollResponseDTO) GetProvisioningURI() string {
+	if x != nil && x.ProvisioningURI != nil {
+		return *x.ProvisioningURI
 	}
 	return ""
 }
 
-type RefreshTokenRequestDTO struct {
+type ConfirmMFARequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RefreshToken *string `protobuf:"bytes,1,opt,name=refreshToken,proto3,oneof" form:"refresh_token" json:"refresh_token" vd:"@:len($)>0; msg:'刷新令牌不能为空'"`
+	Code *string `protobuf:"bytes,1,opt,name=code,proto3,oneof" form:"code" json:"code" vd:"@:len($)>0; msg:'验证码不能为空'"`
 }
 
-func (x *RefreshTokenRequestDTO) Reset() {
-	*x = RefreshTokenRequestDTO{}
+func (x *ConfirmMFARequestDTO) Reset() {
+	*x = ConfirmMFARequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[7]
+		mi := &file_http_identity_identity_model_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RefreshTokenRequestDTO) String() string {
+func (x *ConfirmMFARequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshTokenRequestDTO) ProtoMessage() {}
+func (*ConfirmMFARequestDTO) ProtoMessage() {}
 
-func (x *RefreshTokenRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[7]
+func (x *ConfirmMFARequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,44 +615,44 @@ func (x *RefreshTokenRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshTokenRequestDTO.ProtoReflect.Descriptor instead.
-func (*RefreshTokenRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ConfirmMFARequestDTO.ProtoReflect.Descriptor instead.
+func (*ConfirmMFARequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *RefreshTokenRequestDTO) GetRefreshToken() string {
-	if x != nil && x.RefreshToken != nil {
-		return *x.RefreshToken
+func (x *ConfirmMFARequestDTO) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
 	}
 	return ""
 }
 
-type RefreshTokenResponseDTO struct {
+type ConfirmMFAResponseDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BaseResp  *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
-	TokenInfo *http_base.TokenInfoDTO    `protobuf:"bytes,2,opt,name=tokenInfo,proto3,oneof" form:"tokenInfo" json:"token_info,omitempty" query:"tokenInfo"`
+	BaseResp      *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	RecoveryCodes []string                   `protobuf:"bytes,2,rep,name=recoveryCodes,proto3" form:"recoveryCodes" json:"recovery_codes" query:"recoveryCodes"`
 }
 
-func (x *RefreshTokenResponseDTO) Reset() {
-	*x = RefreshTokenResponseDTO{}
+func (x *ConfirmMFAResponseDTO) Reset() {
+	*x = ConfirmMFAResponseDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[8]
+		mi := &file_http_identity_identity_model_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RefreshTokenResponseDTO) String() string {
+func (x *ConfirmMFAResponseDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshTokenResponseDTO) ProtoMessage() {}
+func (*ConfirmMFAResponseDTO) ProtoMessage() {}
 
-func (x *RefreshTokenResponseDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[8]
+func (x *ConfirmMFAResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -588,78 +663,50 @@ func (x *RefreshTokenResponseDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshTokenResponseDTO.ProtoReflect.Descriptor instead.
-func (*RefreshTokenResponseDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ConfirmMFAResponseDTO.ProtoReflect.Descriptor instead.
+func (*ConfirmMFAResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RefreshTokenResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+func (x *ConfirmMFAResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
 	if x != nil {
 		return x.BaseResp
 	}
 	return nil
 }
 
-func (x *RefreshTokenResponseDTO) GetTokenInfo() *http_base.TokenInfoDTO {
+func (x *ConfirmMFAResponseDTO) GetRecoveryCodes() []string {
 	if x != nil {
-		return x.TokenInfo
+		return x.RecoveryCodes
 	}
 	return nil
 }
 
-type UserProfileDTO struct {
+type DisableMFARequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                    *string  `protobuf:"bytes,1,opt,name=id,proto3,oneof" form:"id" json:"id" query:"id"`
-	Username              *string  `protobuf:"bytes,2,opt,name=username,proto3,oneof" form:"username" json:"username" query:"username"`
-	Email                 *string  `protobuf:"bytes,3,opt,name=email,proto3,oneof" form:"email" json:"email,omitempty" query:"email"`
-	Phone                 *string  `protobuf:"bytes,4,opt,name=phone,proto3,oneof" form:"phone" json:"phone,omitempty" query:"phone"`
-	FirstName             *string  `protobuf:"bytes,5,opt,name=firstName,proto3,oneof" form:"firstName" json:"first_name,omitempty" query:"firstName"`
-	LastName              *string  `protobuf:"bytes,6,opt,name=lastName,proto3,oneof" form:"lastName" json:"last_name,omitempty" query:"lastName"`
-	RealName              *string  `protobuf:"bytes,7,opt,name=realName,proto3,oneof" form:"realName" json:"real_name,omitempty" query:"realName"`
-	ProfessionalTitle     *string  `protobuf:"bytes,8,opt,name=professionalTitle,proto3,oneof" form:"professionalTitle" json:"professional_title,omitempty" query:"professionalTitle"`
-	EmployeeID            *string  `protobuf:"bytes,9,opt,name=employeeID,proto3,oneof" form:"employeeID" json:"employee_id,omitempty" query:"employeeID"`
-	Status                *int32   `protobuf:"varint,10,opt,name=status,proto3,oneof" form:"status" json:"status" query:"status"`
-	MustChangePassword    *bool    `protobuf:"varint,11,opt,name=mustChangePassword,proto3,oneof" form:"mustChangePassword" json:"must_change_password,omitempty" query:"mustChangePassword"`
-	AccountExpiry         *int64   `protobuf:"varint,12,opt,name=accountExpiry,proto3,oneof" form:"accountExpiry" json:"account_expiry,omitempty" query:"accountExpiry"`
-	Gender                *int32   `protobuf:"varint,13,opt,name=gender,proto3,oneof" form:"gender" json:"gender,omitempty" query:"gender"`
-	CreatedAt             *int64   `protobuf:"varint,14,opt,name=createdAt,proto3,oneof" form:"createdAt" json:"created_at" query:"createdAt"`
-	UpdatedAt             *int64   `protobuf:"varint,15,opt,name=updatedAt,proto3,oneof" form:"updatedAt" json:"updated_at" query:"updatedAt"`
-	LastLoginTime         *int64   `protobuf:"varint,16,opt,name=lastLoginTime,proto3,oneof" form:"lastLoginTime" json:"last_login_time,omitempty" query:"lastLoginTime"`
-	LoginAttempts         *int32   `protobuf:"varint,17,opt,name=loginAttempts,proto3,oneof" form:"loginAttempts" json:"login_attempts,omitempty" query:"loginAttempts"`
-	CreatedBy             *string  `protobuf:"bytes,18,opt,name=createdBy,proto3,oneof" form:"createdBy" json:"created_by,omitempty" query:"createdBy"`
-	UpdatedBy             *string  `protobuf:"bytes,19,opt,name=updatedBy,proto3,oneof" form:"updatedBy" json:"updated_by,omitempty" query:"updatedBy"`
-	RoleIDs               []string `protobuf:"bytes,20,rep,name=roleIDs,proto3" form:"roleIDs" json:"role_ids,omitempty" query:"roleIDs"`
-	PrimaryOrganizationID *string  `protobuf:"bytes,21,opt,name=primaryOrganizationID,proto3,oneof" form:"primaryOrganizationID" json:"primary_organization_id,omitempty" query:"primaryOrganizationID"`
-	PrimaryDepartmentID   *string  `protobuf:"bytes,22,opt,name=primaryDepartmentID,proto3,oneof" form:"primaryDepartmentID" json:"primary_department_id,omitempty" query:"primaryDepartmentID"`
-	// OIDC identity linkage
-	OidcSub      *string `protobuf:"bytes,23,opt,name=oidcSub,proto3,oneof" form:"oidcSub" json:"oidc_sub,omitempty" query:"oidcSub"`
-	OidcIssuer   *string `protobuf:"bytes,24,opt,name=oidcIssuer,proto3,oneof" form:"oidcIssuer" json:"oidc_issuer,omitempty" query:"oidcIssuer"`
-	OidcEmail    *string `protobuf:"bytes,25,opt,name=oidcEmail,proto3,oneof" form:"oidcEmail" json:"oidc_email,omitempty" query:"oidcEmail"`
-	OidcName     *string `protobuf:"bytes,26,opt,name=oidcName,proto3,oneof" form:"oidcName" json:"oidc_name,omitempty" query:"oidcName"`
-	OidcPicture  *string `protobuf:"bytes,27,opt,name=oidcPicture,proto3,oneof" form:"oidcPicture" json:"oidc_picture,omitempty" query:"oidcPicture"`
-	OidcAuthTime *int64  `protobuf:"varint,28,opt,name=oidcAuthTime,proto3,oneof" form:"oidcAuthTime" json:"oidc_auth_time,omitempty" query:"oidcAuthTime"`
+	Code *string `protobuf:"bytes,1,opt,name=code,proto3,oneof" form:"code" json:"code" vd:"@:len($)>0; msg:'验证码不能为空'"`
 }
 
-func (x *UserProfileDTO) Reset() {
-	*x = UserProfileDTO{}
+func (x *DisableMFARequestDTO) Reset() {
+	*x = DisableMFARequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[9]
+		mi := &file_http_identity_identity_model_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserProfileDTO) String() string {
+func (x *DisableMFARequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserProfileDTO) ProtoMessage() {}
+func (*DisableMFARequestDTO) ProtoMessage() {}
 
-func (x *UserProfileDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[9]
+func (x *DisableMFARequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,233 +717,209 @@ func (x *UserProfileDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserProfileDTO.ProtoReflect.Descriptor instead.
-func (*UserProfileDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DisableMFARequestDTO.ProtoReflect.Descriptor instead.
+func (*DisableMFARequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UserProfileDTO) GetId() string {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *DisableMFARequestDTO) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
 	}
 	return ""
 }
 
-func (x *UserProfileDTO) GetUsername() string {
-	if x != nil && x.Username != nil {
-		return *x.Username
-	}
-	return ""
-}
+type LogoutRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *UserProfileDTO) GetEmail() string {
-	if x != nil && x.Email != nil {
-		return *x.Email
-	}
-	return ""
+	RefreshToken *string `protobuf:"bytes,1,opt,name=refreshToken,proto3,oneof" form:"refresh_token" json:"refresh_token" vd:"@:len($) > 0; msg:'刷新令牌不能为空'"`
 }
 
-func (x *UserProfileDTO) GetPhone() string {
-	if x != nil && x.Phone != nil {
-		return *x.Phone
+func (x *LogoutRequestDTO) Reset() {
+	*x = LogoutRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *UserProfileDTO) GetFirstName() string {
-	if x != nil && x.FirstName != nil {
-		return *x.FirstName
-	}
-	return ""
+func (x *LogoutRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserProfileDTO) GetLastName() string {
-	if x != nil && x.LastName != nil {
-		return *x.LastName
-	}
-	return ""
-}
+func (*LogoutRequestDTO) ProtoMessage() {}
 
-func (x *UserProfileDTO) GetRealName() string {
-	if x != nil && x.RealName != nil {
-		return *x.RealName
+func (x *LogoutRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserProfileDTO) GetProfessionalTitle() string {
-	if x != nil && x.ProfessionalTitle != nil {
-		return *x.ProfessionalTitle
-	}
-	return ""
+// Deprecated: Use LogoutRequestDTO.ProtoReflect.Descriptor instead.
+func (*LogoutRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *UserProfileDTO) GetEmployeeID() string {
-	if x != nil && x.EmployeeID != nil {
-		return *x.EmployeeID
+func (x *LogoutRequestDTO) GetRefreshToken() string {
+	if x != nil && x.RefreshToken != nil {
+		return *x.RefreshToken
 	}
 	return ""
 }
 
-func (x *UserProfileDTO) GetStatus() int32 {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return 0
-}
-
-func (x *UserProfileDTO) GetMustChangePassword() bool {
-	if x != nil && x.MustChangePassword != nil {
-		return *x.MustChangePassword
-	}
-	return false
-}
+type ChangePasswordRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *UserProfileDTO) GetAccountExpiry() int64 {
-	if x != nil && x.AccountExpiry != nil {
-		return *x.AccountExpiry
-	}
-	return 0
+	OldPassword *string `protobuf:"bytes,1,opt,name=oldPassword,proto3,oneof" form:"old_password" json:"old_password" vd:"@:len($)>0; msg:'当前密码不能为空'"`
+	NewPassword *string `protobuf:"bytes,2,opt,name=newPassword,proto3,oneof" form:"new_password" json:"new_password" vd:"@:len($)>0 && len($)>=6; msg:'新密码不能为空且长度至少为6位'"`
 }
 
-func (x *UserProfileDTO) GetGender() int32 {
-	if x != nil && x.Gender != nil {
-		return *x.Gender
+func (x *ChangePasswordRequestDTO) Reset() {
+	*x = ChangePasswordRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *UserProfileDTO) GetCreatedAt() int64 {
-	if x != nil && x.CreatedAt != nil {
-		return *x.CreatedAt
-	}
-	return 0
+func (x *ChangePasswordRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserProfileDTO) GetUpdatedAt() int64 {
-	if x != nil && x.UpdatedAt != nil {
-		return *x.UpdatedAt
-	}
-	return 0
-}
+func (*ChangePasswordRequestDTO) ProtoMessage() {}
 
-func (x *UserProfileDTO) GetLastLoginTime() int64 {
-	if x != nil && x.LastLoginTime != nil {
-		return *x.LastLoginTime
+func (x *ChangePasswordRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UserProfileDTO) GetLoginAttempts() int32 {
-	if x != nil && x.LoginAttempts != nil {
-		return *x.LoginAttempts
-	}
-	return 0
+// Deprecated: Use ChangePasswordRequestDTO.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UserProfileDTO) GetCreatedBy() string {
-	if x != nil && x.CreatedBy != nil {
-		return *x.CreatedBy
+func (x *ChangePasswordRequestDTO) GetOldPassword() string {
+	if x != nil && x.OldPassword != nil {
+		return *x.OldPassword
 	}
 	return ""
 }
 
-func (x *UserProfileDTO) GetUpdatedBy() string {
-	if x != nil && x.UpdatedBy != nil {
-		return *x.UpdatedBy
+func (x *ChangePasswordRequestDTO) GetNewPassword() string {
+	if x != nil && x.NewPassword != nil {
+		return *x.NewPassword
 	}
 	return ""
 }
 
-func (x *UserProfileDTO) GetRoleIDs() []string {
-	if x != nil {
-		return x.RoleIDs
-	}
-	return nil
-}
+type ResetPasswordRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *UserProfileDTO) GetPrimaryOrganizationID() string {
-	if x != nil && x.PrimaryOrganizationID != nil {
-		return *x.PrimaryOrganizationID
-	}
-	return ""
+	UserID      *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" form:"user_id" json:"user_id" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
+	NewPassword *string `protobuf:"bytes,2,opt,name=newPassword,proto3,oneof" form:"new_password" json:"new_password,omitempty" vd:"@:len($)==0 || len($)>=6; msg:'新密码长度至少为6位'"`
+	ResetReason *string `protobuf:"bytes,3,opt,name=resetReason,proto3,oneof" form:"reset_reason" json:"reset_reason,omitempty" vd:"@:len($)<=200; msg:'重置原因不能超过200个字符'"`
 }
 
-func (x *UserProfileDTO) GetPrimaryDepartmentID() string {
-	if x != nil && x.PrimaryDepartmentID != nil {
-		return *x.PrimaryDepartmentID
+func (x *ResetPasswordRequestDTO) Reset() {
+	*x = ResetPasswordRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *UserProfileDTO) GetOidcSub() string {
-	if x != nil && x.OidcSub != nil {
-		return *x.OidcSub
-	}
-	return ""
+func (x *ResetPasswordRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UserProfileDTO) GetOidcIssuer() string {
-	if x != nil && x.OidcIssuer != nil {
-		return *x.OidcIssuer
+func (*ResetPasswordRequestDTO) ProtoMessage() {}
+
+func (x *ResetPasswordRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserProfileDTO) GetOidcEmail() string {
-	if x != nil && x.OidcEmail != nil {
-		return *x.OidcEmail
-	}
-	return ""
+// Deprecated: Use ResetPasswordRequestDTO.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *UserProfileDTO) GetOidcName() string {
-	if x != nil && x.OidcName != nil {
-		return *x.OidcName
+func (x *ResetPasswordRequestDTO) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
 	}
 	return ""
 }
 
-func (x *UserProfileDTO) GetOidcPicture() string {
-	if x != nil && x.OidcPicture != nil {
-		return *x.OidcPicture
+func (x *ResetPasswordRequestDTO) GetNewPassword() string {
+	if x != nil && x.NewPassword != nil {
+		return *x.NewPassword
 	}
 	return ""
 }
 
-func (x *UserProfileDTO) GetOidcAuthTime() int64 {
-	if x != nil && x.OidcAuthTime != nil {
-		return *x.OidcAuthTime
+func (x *ResetPasswordRequestDTO) GetResetReason() string {
+	if x != nil && x.ResetReason != nil {
+		return *x.ResetReason
 	}
-	return 0
+	return ""
 }
 
-type UserProfileResponseDTO struct {
+// 自助找回密码：无论账号是否存在都返回成功，避免账号枚举
+type RequestPasswordResetRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BaseResp *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
-	User     *UserProfileDTO            `protobuf:"bytes,2,opt,name=user,proto3,oneof" form:"user" json:"user,omitempty" query:"user"`
+	Identifier *string `protobuf:"bytes,1,opt,name=identifier,proto3,oneof" form:"identifier" json:"identifier" vd:"@:len($)>0 && len($)<=100; msg:'请输入用户名、邮箱或手机号'"`
 }
 
-func (x *UserProfileResponseDTO) Reset() {
-	*x = UserProfileResponseDTO{}
+func (x *RequestPasswordResetRequestDTO) Reset() {
+	*x = RequestPasswordResetRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[10]
+		mi := &file_http_identity_identity_model_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserProfileResponseDTO) String() string {
+func (x *RequestPasswordResetRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserProfileResponseDTO) ProtoMessage() {}
+func (*RequestPasswordResetRequestDTO) ProtoMessage() {}
 
-func (x *UserProfileResponseDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[10]
+func (x *RequestPasswordResetRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -907,63 +930,45 @@ func (x *UserProfileResponseDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserProfileResponseDTO.ProtoReflect.Descriptor instead.
-func (*UserProfileResponseDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *UserProfileResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
-	if x != nil {
-		return x.BaseResp
-	}
-	return nil
+// Deprecated: Use RequestPasswordResetRequestDTO.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UserProfileResponseDTO) GetUser() *UserProfileDTO {
-	if x != nil {
-		return x.User
+func (x *RequestPasswordResetRequestDTO) GetIdentifier() string {
+	if x != nil && x.Identifier != nil {
+		return *x.Identifier
 	}
-	return nil
+	return ""
 }
 
-type CreateUserRequestDTO struct {
+// 凭邮件中的一次性令牌设置新密码，成功后该用户的全部会话失效
+type ConfirmPasswordResetRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username           *string  `protobuf:"bytes,1,opt,name=username,proto3,oneof" form:"username" json:"username" vd:"@:len($)>0 && len($)>=3 && len($)<=20 && regexp('^[a-zA-Z0-9_-]+$',$); msg:'用户名必须是3-20位字母、数字、下划线或短横线'"`
-	Password           *string  `protobuf:"bytes,2,opt,name=password,proto3,oneof" form:"password" json:"password" vd:"@:len($)>0 && len($)>=6; msg:'密码不能为空且长度至少为6位'"`
-	Email              *string  `protobuf:"bytes,3,opt,name=email,proto3,oneof" form:"email" json:"email,omitempty" vd:"@:len($)==0 || email($); msg:'邮箱格式不正确'"`
-	Phone              *string  `protobuf:"bytes,4,opt,name=phone,proto3,oneof" form:"phone" json:"phone,omitempty" vd:"@:len($)==0 || phone($); msg:'手机号格式不正确'"`
-	FirstName          *string  `protobuf:"bytes,5,opt,name=firstName,proto3,oneof" form:"first_name" json:"first_name,omitempty" vd:"@:len($)<=50; msg:'名字长度不能超过50个字符'"`
-	LastName           *string  `protobuf:"bytes,6,opt,name=lastName,proto3,oneof" form:"last_name" json:"last_name,omitempty" vd:"@:len($)<=50; msg:'姓氏长度不能超过50个字符'"`
-	RealName           *string  `protobuf:"bytes,7,opt,name=realName,proto3,oneof" form:"real_name" json:"real_name,omitempty" vd:"@:len($)<=100; msg:'真实姓名长度不能超过100个字符'"`
-	ProfessionalTitle  *string  `protobuf:"bytes,8,opt,name=professionalTitle,proto3,oneof" form:"professional_title" json:"professional_title,omitempty" vd:"@:len($)<=100; msg:'职业头衔长度不能超过100个字符'"`
-	EmployeeID         *string  `protobuf:"bytes,9,opt,name=employeeID,proto3,oneof" form:"employee_id" json:"employee_id,omitempty" vd:"@:len($)<=50; msg:'员工工号长度不能超过50个字符'"`
-	MustChangePassword *bool    `protobuf:"varint,10,opt,name=mustChangePassword,proto3,oneof" form:"must_change_password" json:"must_change_password,omitempty"`
-	AccountExpiry      *int64   `protobuf:"varint,11,opt,name=accountExpiry,proto3,oneof" form:"account_expiry" json:"account_expiry,omitempty"`
-	Gender             *int32   `protobuf:"varint,12,opt,name=gender,proto3,oneof" form:"gender" json:"gender,omitempty" vd:"@:$ == null || ($ >= 0 && $ <= 2); msg:'性别值必须为null或在0-2之间'"`
-	RoleIDs            []string `protobuf:"bytes,13,rep,name=roleIDs,proto3" form:"role_ids" json:"role_ids,omitempty"`
-	OrganizationID     *string  `protobuf:"bytes,14,opt,name=organizationID,proto3,oneof" form:"organization_id" json:"organization_id,omitempty" vd:"@:len($)==0 || len($)==36; msg:'组织ID格式不正确'"`
+	Token       *string `protobuf:"bytes,1,opt,name=token,proto3,oneof" form:"token" json:"token" vd:"@:len($)>0; msg:'重置令牌不能为空'"`
+	NewPassword *string `protobuf:"bytes,2,opt,name=newPassword,proto3,oneof" form:"new_password" json:"new_password" vd:"@:len($)>0 && len($)>=6; msg:'新密码不能为空且长度至少为6位'"`
 }
 
-func (x *CreateUserRequestDTO) Reset() {
-	*x = CreateUserRequestDTO{}
+func (x *ConfirmPasswordResetRequestDTO) Reset() {
+	*x = ConfirmPasswordResetRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[11]
+		mi := &file_http_identity_identity_model_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateUserRequestDTO) String() string {
+func (x *ConfirmPasswordResetRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserRequestDTO) ProtoMessage() {}
+func (*ConfirmPasswordResetRequestDTO) ProtoMessage() {}
 
-func (x *CreateUserRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[11]
+func (x *ConfirmPasswordResetRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -974,134 +979,153 @@ func (x *CreateUserRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserRequestDTO.ProtoReflect.Descriptor instead.
-func (*CreateUserRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ConfirmPasswordResetRequestDTO.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CreateUserRequestDTO) GetUsername() string {
-	if x != nil && x.Username != nil {
-		return *x.Username
+func (x *ConfirmPasswordResetRequestDTO) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
 	}
 	return ""
 }
 
-func (x *CreateUserRequestDTO) GetPassword() string {
-	if x != nil && x.Password != nil {
-		return *x.Password
+func (x *ConfirmPasswordResetRequestDTO) GetNewPassword() string {
+	if x != nil && x.NewPassword != nil {
+		return *x.NewPassword
 	}
 	return ""
 }
 
-func (x *CreateUserRequestDTO) GetEmail() string {
-	if x != nil && x.Email != nil {
-		return *x.Email
-	}
-	return ""
+type ForcePasswordChangeRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" form:"user_id" json:"user_id" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
+	Reason *string `protobuf:"bytes,2,opt,name=reason,proto3,oneof" form:"reason" json:"reason,omitempty" vd:"@:len($)<=200; msg:'原因不能超过200个字符'"`
 }
 
-func (x *CreateUserRequestDTO) GetPhone() string {
-	if x != nil && x.Phone != nil {
-		return *x.Phone
+func (x *ForcePasswordChangeRequestDTO) Reset() {
+	*x = ForcePasswordChangeRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *CreateUserRequestDTO) GetFirstName() string {
-	if x != nil && x.FirstName != nil {
-		return *x.FirstName
-	}
-	return ""
+func (x *ForcePasswordChangeRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateUserRequestDTO) GetLastName() string {
-	if x != nil && x.LastName != nil {
-		return *x.LastName
+func (*ForcePasswordChangeRequestDTO) ProtoMessage() {}
+
+func (x *ForcePasswordChangeRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateUserRequestDTO) GetRealName() string {
-	if x != nil && x.RealName != nil {
-		return *x.RealName
-	}
-	return ""
+// Deprecated: Use ForcePasswordChangeRequestDTO.ProtoReflect.Descriptor instead.
+func (*ForcePasswordChangeRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CreateUserRequestDTO) GetProfessionalTitle() string {
-	if x != nil && x.ProfessionalTitle != nil {
-		return *x.ProfessionalTitle
+func (x *ForcePasswordChangeRequestDTO) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
 	}
 	return ""
 }
 
-func (x *CreateUserRequestDTO) GetEmployeeID() string {
-	if x != nil && x.EmployeeID != nil {
-		return *x.EmployeeID
+func (x *ForcePasswordChangeRequestDTO) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
 	}
 	return ""
 }
 
-func (x *CreateUserRequestDTO) GetMustChangePassword() bool {
-	if x != nil && x.MustChangePassword != nil {
-		return *x.MustChangePassword
-	}
-	return false
+type RefreshTokenRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefreshToken *string `protobuf:"bytes,1,opt,name=refreshToken,proto3,oneof" form:"refresh_token" json:"refresh_token" vd:"@:len($)>0; msg:'刷新令牌不能为空'"`
 }
 
-func (x *CreateUserRequestDTO) GetAccountExpiry() int64 {
-	if x != nil && x.AccountExpiry != nil {
-		return *x.AccountExpiry
+func (x *RefreshTokenRequestDTO) Reset() {
+	*x = RefreshTokenRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *CreateUserRequestDTO) GetGender() int32 {
-	if x != nil && x.Gender != nil {
-		return *x.Gender
-	}
-	return 0
+func (x *RefreshTokenRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateUserRequestDTO) GetRoleIDs() []string {
-	if x != nil {
-		return x.RoleIDs
+func (*RefreshTokenRequestDTO) ProtoMessage() {}
+
+func (x *RefreshTokenRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateUserRequestDTO) GetOrganizationID() string {
-	if x != nil && x.OrganizationID != nil {
-		return *x.OrganizationID
+// Deprecated: Use RefreshTokenRequestDTO.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RefreshTokenRequestDTO) GetRefreshToken() string {
+	if x != nil && x.RefreshToken != nil {
+		return *x.RefreshToken
 	}
 	return ""
 }
 
-type GetUserRequestDTO struct {
+type RefreshTokenResponseDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"-" path:"userID" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
+	BaseResp  *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	TokenInfo *http_base.TokenInfoDTO    `protobuf:"bytes,2,opt,name=tokenInfo,proto3,oneof" form:"tokenInfo" json:"token_info,omitempty" query:"tokenInfo"`
 }
 
-func (x *GetUserRequestDTO) Reset() {
-	*x = GetUserRequestDTO{}
+func (x *RefreshTokenResponseDTO) Reset() {
+	*x = RefreshTokenResponseDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[12]
+		mi := &file_http_identity_identity_model_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserRequestDTO) String() string {
+func (x *RefreshTokenResponseDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRequestDTO) ProtoMessage() {}
+func (*RefreshTokenResponseDTO) ProtoMessage() {}
 
-func (x *GetUserRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[12]
+func (x *RefreshTokenResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1112,54 +1136,52 @@ func (x *GetUserRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRequestDTO.ProtoReflect.Descriptor instead.
-func (*GetUserRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use RefreshTokenResponseDTO.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetUserRequestDTO) GetUserID() string {
-	if x != nil && x.UserID != nil {
-		return *x.UserID
+func (x *RefreshTokenResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
 	}
-	return ""
+	return nil
 }
 
-type UpdateUserRequestDTO struct {
+func (x *RefreshTokenResponseDTO) GetTokenInfo() *http_base.TokenInfoDTO {
+	if x != nil {
+		return x.TokenInfo
+	}
+	return nil
+}
+
+type SwitchTenantRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID            *string             `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"-" path:"userID" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
-	Email             *string             `protobuf:"bytes,2,opt,name=email,proto3,oneof" form:"email" json:"email,omitempty" vd:"@:len($)==0 || email($); msg:'邮箱格式不正确'"`
-	Phone             *string             `protobuf:"bytes,3,opt,name=phone,proto3,oneof" form:"phone" json:"phone,omitempty" vd:"@:len($)==0 || phone($); msg:'手机号格式不正确'"`
-	FirstName         *string             `protobuf:"bytes,4,opt,name=firstName,proto3,oneof" form:"first_name" json:"first_name,omitempty" vd:"@:len($)<=50; msg:'名字长度不能超过50个字符'"`
-	LastName          *string             `protobuf:"bytes,5,opt,name=lastName,proto3,oneof" form:"last_name" json:"last_name,omitempty" vd:"@:len($)<=50; msg:'姓氏长度不能超过50个字符'"`
-	RealName          *string             `protobuf:"bytes,6,opt,name=realName,proto3,oneof" form:"real_name" json:"real_name,omitempty" vd:"@:len($)<=100; msg:'真实姓名长度不能超过100个字符'"`
-	ProfessionalTitle *string             `protobuf:"bytes,7,opt,name=professionalTitle,proto3,oneof" form:"professional_title" json:"professional_title,omitempty" vd:"@:len($)<=100; msg:'职业头衔长度不能超过100个字符'"`
-	EmployeeID        *string             `protobuf:"bytes,8,opt,name=employeeID,proto3,oneof" form:"employee_id" json:"employee_id,omitempty" vd:"@:len($)<=50; msg:'员工工号长度不能超过50个字符'"`
-	AccountExpiry     *int64              `protobuf:"varint,9,opt,name=accountExpiry,proto3,oneof" form:"account_expiry" json:"account_expiry,omitempty"`
-	Gender            *int32              `protobuf:"varint,10,opt,name=gender,proto3,oneof" form:"gender" json:"gender,omitempty" vd:"@:$ == null || ($ >= 0 && $ <= 2); msg:'性别值必须为null或在0-2之间'"`
-	RoleIDs           *structpb.ListValue `protobuf:"bytes,11,opt,name=roleIDs,proto3,oneof" form:"role_ids" json:"role_ids,omitempty"`
-	OrganizationID    *string             `protobuf:"bytes,12,opt,name=organizationID,proto3,oneof" form:"organization_id" json:"organization_id,omitempty" vd:"@:len($)==0 || len($)==36; msg:'组织ID格式不正确'"`
+	OrganizationID *string `protobuf:"bytes,1,opt,name=organizationID,proto3,oneof" form:"organization_id" json:"organization_id" vd:"@:len($)==36; msg:'组织ID格式不正确'"`
+	// 当前会话的刷新令牌；携带时旧令牌族会被吊销，避免其继续刷新出原租户的 token
+	RefreshToken *string `protobuf:"bytes,2,opt,name=refreshToken,proto3,oneof" form:"refresh_token" json:"refresh_token,omitempty"`
 }
 
-func (x *UpdateUserRequestDTO) Reset() {
-	*x = UpdateUserRequestDTO{}
+func (x *SwitchTenantRequestDTO) Reset() {
+	*x = SwitchTenantRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[13]
+		mi := &file_http_identity_identity_model_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateUserRequestDTO) String() string {
+func (x *SwitchTenantRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserRequestDTO) ProtoMessage() {}
+func (*SwitchTenantRequestDTO) ProtoMessage() {}
 
-func (x *UpdateUserRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[13]
+func (x *SwitchTenantRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1170,128 +1192,133 @@ func (x *UpdateUserRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserRequestDTO.ProtoReflect.Descriptor instead.
-func (*UpdateUserRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SwitchTenantRequestDTO.ProtoReflect.Descriptor instead.
+func (*SwitchTenantRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *UpdateUserRequestDTO) GetUserID() string {
-	if x != nil && x.UserID != nil {
-		return *x.UserID
+func (x *SwitchTenantRequestDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
 	}
 	return ""
 }
 
-func (x *UpdateUserRequestDTO) GetEmail() string {
-	if x != nil && x.Email != nil {
-		return *x.Email
+func (x *SwitchTenantRequestDTO) GetRefreshToken() string {
+	if x != nil && x.RefreshToken != nil {
+		return *x.RefreshToken
 	}
 	return ""
 }
 
-func (x *UpdateUserRequestDTO) GetPhone() string {
-	if x != nil && x.Phone != nil {
-		return *x.Phone
-	}
-	return ""
-}
+type SwitchTenantResponseDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *UpdateUserRequestDTO) GetFirstName() string {
-	if x != nil && x.FirstName != nil {
-		return *x.FirstName
-	}
-	return ""
+	BaseResp       *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	TokenInfo      *http_base.TokenInfoDTO    `protobuf:"bytes,2,opt,name=tokenInfo,proto3,oneof" form:"tokenInfo" json:"token_info,omitempty" query:"tokenInfo"`
+	OrganizationID *string                    `protobuf:"bytes,3,opt,name=organizationID,proto3,oneof" form:"organizationID" json:"organization_id" query:"organizationID"`
+	RoleIDs        []string                   `protobuf:"bytes,4,rep,name=roleIDs,proto3" form:"roleIDs" json:"role_ids,omitempty" query:"roleIDs"`
+	Roles          []*RoleInfoDTO             `protobuf:"bytes,5,rep,name=roles,proto3" form:"roles" json:"roles,omitempty" query:"roles"`
 }
 
-func (x *UpdateUserRequestDTO) GetLastName() string {
-	if x != nil && x.LastName != nil {
-		return *x.LastName
+func (x *SwitchTenantResponseDTO) Reset() {
+	*x = SwitchTenantResponseDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *UpdateUserRequestDTO) GetRealName() string {
-	if x != nil && x.RealName != nil {
-		return *x.RealName
-	}
-	return ""
+func (x *SwitchTenantResponseDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateUserRequestDTO) GetProfessionalTitle() string {
-	if x != nil && x.ProfessionalTitle != nil {
-		return *x.ProfessionalTitle
-	}
-	return ""
-}
+func (*SwitchTenantResponseDTO) ProtoMessage() {}
 
-func (x *UpdateUserRequestDTO) GetEmployeeID() string {
-	if x != nil && x.EmployeeID != nil {
-		return *x.EmployeeID
+func (x *SwitchTenantResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateUserRequestDTO) GetAccountExpiry() int64 {
-	if x != nil && x.AccountExpiry != nil {
-		return *x.AccountExpiry
-	}
-	return 0
+// Deprecated: Use SwitchTenantResponseDTO.ProtoReflect.Descriptor instead.
+func (*SwitchTenantResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *UpdateUserRequestDTO) GetGender() int32 {
-	if x != nil && x.Gender != nil {
-		return *x.Gender
+func (x *SwitchTenantResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateUserRequestDTO) GetRoleIDs() *structpb.ListValue {
+func (x *SwitchTenantResponseDTO) GetTokenInfo() *http_base.TokenInfoDTO {
 	if x != nil {
-		return x.RoleIDs
+		return x.TokenInfo
 	}
 	return nil
 }
 
-func (x *UpdateUserRequestDTO) GetOrganizationID() string {
+func (x *SwitchTenantResponseDTO) GetOrganizationID() string {
 	if x != nil && x.OrganizationID != nil {
 		return *x.OrganizationID
 	}
 	return ""
 }
 
-type UpdateMeRequestDTO struct {
+func (x *SwitchTenantResponseDTO) GetRoleIDs() []string {
+	if x != nil {
+		return x.RoleIDs
+	}
+	return nil
+}
+
+func (x *SwitchTenantResponseDTO) GetRoles() []*RoleInfoDTO {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// 当前用户可切换的租户（组织）
+type TenantOptionDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email             *string `protobuf:"bytes,1,opt,name=email,proto3,oneof" form:"email" json:"email,omitempty" vd:"@:len($)==0 || email($); msg:'邮箱格式不正确'"`
-	Phone             *string `protobuf:"bytes,2,opt,name=phone,proto3,oneof" form:"phone" json:"phone,omitempty" vd:"@:len($)==0 || phone($); msg:'手机号格式不正确'"`
-	FirstName         *string `protobuf:"bytes,3,opt,name=firstName,proto3,oneof" form:"first_name" json:"first_name,omitempty" vd:"@:len($)<=50; msg:'名字长度不能超过50个字符'"`
-	LastName          *string `protobuf:"bytes,4,opt,name=lastName,proto3,oneof" form:"last_name" json:"last_name,omitempty" vd:"@:len($)<=50; msg:'姓氏长度不能超过50个字符'"`
-	RealName          *string `protobuf:"bytes,5,opt,name=realName,proto3,oneof" form:"real_name" json:"real_name,omitempty" vd:"@:len($)<=100; msg:'真实姓名长度不能超过100个字符'"`
-	ProfessionalTitle *string `protobuf:"bytes,6,opt,name=professionalTitle,proto3,oneof" form:"professional_title" json:"professional_title,omitempty" vd:"@:len($)<=100; msg:'职业头衔长度不能超过100个字符'"`
-	EmployeeID        *string `protobuf:"bytes,7,opt,name=employeeID,proto3,oneof" form:"employee_id" json:"employee_id,omitempty" vd:"@:len($)<=50; msg:'员工工号长度不能超过50个字符'"`
-	AccountExpiry     *int64  `protobuf:"varint,8,opt,name=accountExpiry,proto3,oneof" form:"account_expiry" json:"account_expiry,omitempty"`
-	Gender            *int32  `protobuf:"varint,9,opt,name=gender,proto3,oneof" form:"gender" json:"gender,omitempty" vd:"@:$ == null || ($ >= 0 && $ <= 2); msg:'性别值必须为null或在0-2之间'"`
+	OrganizationID   *string `protobuf:"bytes,1,opt,name=organizationID,proto3,oneof" form:"organizationID" json:"organization_id" query:"organizationID"`
+	OrganizationName *string `protobuf:"bytes,2,opt,name=organizationName,proto3,oneof" form:"organizationName" json:"organization_name,omitempty" query:"organizationName"`
+	IsPrimary        *bool   `protobuf:"varint,3,opt,name=isPrimary,proto3,oneof" form:"isPrimary" json:"is_primary" query:"isPrimary"`
+	IsCurrent        *bool   `protobuf:"varint,4,opt,name=isCurrent,proto3,oneof" form:"isCurrent" json:"is_current" query:"isCurrent"`
 }
 
-func (x *UpdateMeRequestDTO) Reset() {
-	*x = UpdateMeRequestDTO{}
+func (x *TenantOptionDTO) Reset() {
+	*x = TenantOptionDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[14]
+		mi := &file_http_identity_identity_model_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateMeRequestDTO) String() string {
+func (x *TenantOptionDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMeRequestDTO) ProtoMessage() {}
+func (*TenantOptionDTO) ProtoMessage() {}
 
-func (x *UpdateMeRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[14]
+func (x *TenantOptionDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1302,100 +1329,94 @@ func (x *UpdateMeRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMeRequestDTO.ProtoReflect.Descriptor instead.
-func (*UpdateMeRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *UpdateMeRequestDTO) GetEmail() string {
-	if x != nil && x.Email != nil {
-		return *x.Email
-	}
-	return ""
-}
-
-func (x *UpdateMeRequestDTO) GetPhone() string {
-	if x != nil && x.Phone != nil {
-		return *x.Phone
-	}
-	return ""
-}
-
-func (x *UpdateMeRequestDTO) GetFirstName() string {
-	if x != nil && x.FirstName != nil {
-		return *x.FirstName
-	}
-	return ""
-}
-
-func (x *UpdateMeRequestDTO) GetLastName() string {
-	if x != nil && x.LastName != nil {
-		return *x.LastName
-	}
-	return ""
-}
-
-func (x *UpdateMeRequestDTO) GetRealName() string {
-	if x != nil && x.RealName != nil {
-		return *x.RealName
-	}
-	return ""
+// Deprecated: Use TenantOptionDTO.ProtoReflect.Descriptor instead.
+func (*TenantOptionDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *UpdateMeRequestDTO) GetProfessionalTitle() string {
-	if x != nil && x.ProfessionalTitle != nil {
-		return *x.ProfessionalTitle
+func (x *TenantOptionDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
 	}
 	return ""
 }
 
-func (x *UpdateMeRequestDTO) GetEmployeeID() string {
-	if x != nil && x.EmployeeID != nil {
-		return *x.EmployeeID
+func (x *TenantOptionDTO) GetOrganizationName() string {
+	if x != nil && x.OrganizationName != nil {
+		return *x.OrganizationName
 	}
 	return ""
 }
 
-func (x *UpdateMeRequestDTO) GetAccountExpiry() int64 {
-	if x != nil && x.AccountExpiry != nil {
-		return *x.AccountExpiry
+func (x *TenantOptionDTO) GetIsPrimary() bool {
+	if x != nil && x.IsPrimary != nil {
+		return *x.IsPrimary
 	}
-	return 0
+	return false
 }
 
-func (x *UpdateMeRequestDTO) GetGender() int32 {
-	if x != nil && x.Gender != nil {
-		return *x.Gender
+func (x *TenantOptionDTO) GetIsCurrent() bool {
+	if x != nil && x.IsCurrent != nil {
+		return *x.IsCurrent
 	}
-	return 0
+	return false
 }
 
-type DeleteUserRequestDTO struct {
+type UserProfileDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"-" path:"userID" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
-	Reason *string `protobuf:"bytes,2,opt,name=reason,proto3,oneof" form:"reason" json:"reason,omitempty" vd:"@:len($)<=200; msg:'删除原因不能超过200个字符'"`
+	Id                    *string  `protobuf:"bytes,1,opt,name=id,proto3,oneof" form:"id" json:"id" query:"id"`
+	Username              *string  `protobuf:"bytes,2,opt,name=username,proto3,oneof" form:"username" json:"username" query:"username"`
+	Email                 *string  `protobuf:"bytes,3,opt,name=email,proto3,oneof" form:"email" json:"email,omitempty" query:"email"`
+	Phone                 *string  `protobuf:"bytes,4,opt,name=phone,proto3,oneof" form:"phone" json:"phone,omitempty" query:"phone"`
+	FirstName             *string  `protobuf:"bytes,5,opt,name=firstName,proto3,oneof" form:"firstName" json:"first_name,omitempty" query:"firstName"`
+	LastName              *string  `protobuf:"bytes,6,opt,name=lastName,proto3,oneof" form:"lastName" json:"last_name,omitempty" query:"lastName"`
+	RealName              *string  `protobuf:"bytes,7,opt,name=realName,proto3,oneof" form:"realName" json:"real_name,omitempty" query:"realName"`
+	ProfessionalTitle     *string  `protobuf:"bytes,8,opt,name=professionalTitle,proto3,oneof" form:"professionalTitle" json:"professional_title,omitempty" query:"professionalTitle"`
+	EmployeeID            *string  `protobuf:"bytes,9,opt,name=employeeID,proto3,oneof" form:"employeeID" json:"employee_id,omitempty" query:"employeeID"`
+	Status                *int32   `protobuf:"varint,10,opt,name=status,proto3,oneof" form:"status" json:"status" query:"status"`
+	MustChangePassword    *bool    `protobuf:"varint,11,opt,name=mustChangePassword,proto3,oneof" form:"mustChangePassword" json:"must_change_password,omitempty" query:"mustChangePassword"`
+	AccountExpiry         *int64   `protobuf:"varint,12,opt,name=accountExpiry,proto3,oneof" form:"accountExpiry" json:"account_expiry,omitempty" query:"accountExpiry"`
+	Gender                *int32   `protobuf:"varint,13,opt,name=gender,proto3,oneof" form:"gender" json:"gender,omitempty" query:"gender"`
+	CreatedAt             *int64   `protobuf:"varint,14,opt,name=createdAt,proto3,oneof" form:"createdAt" json:"created_at" query:"createdAt"`
+	UpdatedAt             *int64   `protobuf:"varint,15,opt,name=updatedAt,proto3,oneof" form:"updatedAt" json:"updated_at" query:"updatedAt"`
+	LastLoginTime         *int64   `protobuf:"varint,16,opt,name=lastLoginTime,proto3,oneof" form:"lastLoginTime" json:"last_login_time,omitempty" query:"lastLoginTime"`
+	LoginAttempts         *int32   `protobuf:"varint,17,opt,name=loginAttempts,proto3,oneof" form:"loginAttempts" json:"login_attempts,omitempty" query:"loginAttempts"`
+	CreatedBy             *string  `protobuf:"bytes,18,opt,name=createdBy,proto3,oneof" form:"createdBy" json:"created_by,omitempty" query:"createdBy"`
+	UpdatedBy             *string  `protobuf:"bytes,19,opt,name=updatedBy,proto3,oneof" form:"updatedBy" json:"updated_by,omitempty" query:"updatedBy"`
+	RoleIDs               []string `protobuf:"bytes,20,rep,name=roleIDs,proto3" form:"roleIDs" json:"role_ids,omitempty" query:"roleIDs"`
+	PrimaryOrganizationID *string  `protobuf:"bytes,21,opt,name=primaryOrganizationID,proto3,oneof" form:"primaryOrganizationID" json:"primary_organization_id,omitempty" query:"primaryOrganizationID"`
+	PrimaryDepartmentID   *string  `protobuf:"bytes,22,opt,name=primaryDepartmentID,proto3,oneof" form:"primaryDepartmentID" json:"primary_department_id,omitempty" query:"primaryDepartmentID"`
+	// OIDC identity linkage
+	OidcSub      *string `protobuf:"bytes,23,opt,name=oidcSub,proto3,oneof" form:"oidcSub" json:"oidc_sub,omitempty" query:"oidcSub"`
+	OidcIssuer   *string `protobuf:"bytes,24,opt,name=oidcIssuer,proto3,oneof" form:"oidcIssuer" json:"oidc_issuer,omitempty" query:"oidcIssuer"`
+	OidcEmail    *string `protobuf:"bytes,25,opt,name=oidcEmail,proto3,oneof" form:"oidcEmail" json:"oidc_email,omitempty" query:"oidcEmail"`
+	OidcName     *string `protobuf:"bytes,26,opt,name=oidcName,proto3,oneof" form:"oidcName" json:"oidc_name,omitempty" query:"oidcName"`
+	OidcPicture  *string `protobuf:"bytes,27,opt,name=oidcPicture,proto3,oneof" form:"oidcPicture" json:"oidc_picture,omitempty" query:"oidcPicture"`
+	OidcAuthTime *int64  `protobuf:"varint,28,opt,name=oidcAuthTime,proto3,oneof" form:"oidcAuthTime" json:"oidc_auth_time,omitempty" query:"oidcAuthTime"`
+	// 乐观锁版本号，同时以 ETag 响应头返回；更新时通过 version 或 If-Match 回传
+	Version *int64 `protobuf:"varint,29,opt,name=version,proto3,oneof" form:"version" json:"version" query:"version"`
 }
 
-func (x *DeleteUserRequestDTO) Reset() {
-	*x = DeleteUserRequestDTO{}
+func (x *UserProfileDTO) Reset() {
+	*x = UserProfileDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[15]
+		mi := &file_http_identity_identity_model_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteUserRequestDTO) String() string {
+func (x *UserProfileDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserRequestDTO) ProtoMessage() {}
+func (*UserProfileDTO) ProtoMessage() {}
 
-func (x *DeleteUserRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[15]
+func (x *UserProfileDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1406,233 +1427,242 @@ func (x *DeleteUserRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserRequestDTO.ProtoReflect.Descriptor instead.
-func (*DeleteUserRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use UserProfileDTO.ProtoReflect.Descriptor instead.
+func (*UserProfileDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *DeleteUserRequestDTO) GetUserID() string {
-	if x != nil && x.UserID != nil {
-		return *x.UserID
+func (x *UserProfileDTO) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
 	return ""
 }
 
-func (x *DeleteUserRequestDTO) GetReason() string {
-	if x != nil && x.Reason != nil {
-		return *x.Reason
+func (x *UserProfileDTO) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
 	}
 	return ""
 }
 
-type ListUsersRequestDTO struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Page           *http_base.PageRequestDTO `protobuf:"bytes,1,opt,name=page,proto3,oneof" form:"-" json:"-" query:"-"`
-	OrganizationID *string                   `protobuf:"bytes,2,opt,name=organizationID,proto3,oneof" json:"organization_id,omitempty" query:"organization_id"`
-	Status         *int32                    `protobuf:"varint,3,opt,name=status,proto3,oneof" json:"status,omitempty" query:"status"`
+func (x *UserProfileDTO) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
 }
 
-func (x *ListUsersRequestDTO) Reset() {
-	*x = ListUsersRequestDTO{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UserProfileDTO) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
 	}
+	return ""
 }
 
-func (x *ListUsersRequestDTO) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserProfileDTO) GetFirstName() string {
+	if x != nil && x.FirstName != nil {
+		return *x.FirstName
+	}
+	return ""
 }
 
-func (*ListUsersRequestDTO) ProtoMessage() {}
-
-func (x *ListUsersRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UserProfileDTO) GetLastName() string {
+	if x != nil && x.LastName != nil {
+		return *x.LastName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListUsersRequestDTO.ProtoReflect.Descriptor instead.
-func (*ListUsersRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{16}
+func (x *UserProfileDTO) GetRealName() string {
+	if x != nil && x.RealName != nil {
+		return *x.RealName
+	}
+	return ""
 }
 
-func (x *ListUsersRequestDTO) GetPage() *http_base.PageRequestDTO {
-	if x != nil {
-		return x.Page
+func (x *UserProfileDTO) GetProfessionalTitle() string {
+	if x != nil && x.ProfessionalTitle != nil {
+		return *x.ProfessionalTitle
 	}
-	return nil
+	return ""
 }
 
-func (x *ListUsersRequestDTO) GetOrganizationID() string {
-	if x != nil && x.OrganizationID != nil {
-		return *x.OrganizationID
+func (x *UserProfileDTO) GetEmployeeID() string {
+	if x != nil && x.EmployeeID != nil {
+		return *x.EmployeeID
 	}
 	return ""
 }
 
-func (x *ListUsersRequestDTO) GetStatus() int32 {
+func (x *UserProfileDTO) GetStatus() int32 {
 	if x != nil && x.Status != nil {
 		return *x.Status
 	}
 	return 0
 }
 
-type ListUsersResponseDTO struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	BaseResp *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
-	Users    []*UserProfileDTO          `protobuf:"bytes,2,rep,name=users,proto3" form:"users" json:"users,omitempty" query:"users"`
-	Page     *http_base.PageResponseDTO `protobuf:"bytes,3,opt,name=page,proto3,oneof" form:"page" json:"page,omitempty" query:"page"`
+func (x *UserProfileDTO) GetMustChangePassword() bool {
+	if x != nil && x.MustChangePassword != nil {
+		return *x.MustChangePassword
+	}
+	return false
 }
 
-func (x *ListUsersResponseDTO) Reset() {
-	*x = ListUsersResponseDTO{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UserProfileDTO) GetAccountExpiry() int64 {
+	if x != nil && x.AccountExpiry != nil {
+		return *x.AccountExpiry
 	}
+	return 0
 }
 
-func (x *ListUsersResponseDTO) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserProfileDTO) GetGender() int32 {
+	if x != nil && x.Gender != nil {
+		return *x.Gender
+	}
+	return 0
 }
 
-func (*ListUsersResponseDTO) ProtoMessage() {}
-
-func (x *ListUsersResponseDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UserProfileDTO) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListUsersResponseDTO.ProtoReflect.Descriptor instead.
-func (*ListUsersResponseDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{17}
+func (x *UserProfileDTO) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
 }
 
-func (x *ListUsersResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
-	if x != nil {
-		return x.BaseResp
+func (x *UserProfileDTO) GetLastLoginTime() int64 {
+	if x != nil && x.LastLoginTime != nil {
+		return *x.LastLoginTime
 	}
-	return nil
+	return 0
 }
 
-func (x *ListUsersResponseDTO) GetUsers() []*UserProfileDTO {
-	if x != nil {
-		return x.Users
+func (x *UserProfileDTO) GetLoginAttempts() int32 {
+	if x != nil && x.LoginAttempts != nil {
+		return *x.LoginAttempts
 	}
-	return nil
+	return 0
 }
 
-func (x *ListUsersResponseDTO) GetPage() *http_base.PageResponseDTO {
+func (x *UserProfileDTO) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
+}
+
+func (x *UserProfileDTO) GetUpdatedBy() string {
+	if x != nil && x.UpdatedBy != nil {
+		return *x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *UserProfileDTO) GetRoleIDs() []string {
 	if x != nil {
-		return x.Page
+		return x.RoleIDs
 	}
 	return nil
 }
 
-type SearchUsersRequestDTO struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *UserProfileDTO) GetPrimaryOrganizationID() string {
+	if x != nil && x.PrimaryOrganizationID != nil {
+		return *x.PrimaryOrganizationID
+	}
+	return ""
+}
 
-	Page           *http_base.PageRequestDTO `protobuf:"bytes,1,opt,name=page,proto3,oneof" form:"-" json:"-" query:"-"`
-	OrganizationID *string                   `protobuf:"bytes,2,opt,name=organizationID,proto3,oneof" json:"organization_id,omitempty" query:"organization_id"`
+func (x *UserProfileDTO) GetPrimaryDepartmentID() string {
+	if x != nil && x.PrimaryDepartmentID != nil {
+		return *x.PrimaryDepartmentID
+	}
+	return ""
 }
 
-func (x *SearchUsersRequestDTO) Reset() {
-	*x = SearchUsersRequestDTO{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UserProfileDTO) GetOidcSub() string {
+	if x != nil && x.OidcSub != nil {
+		return *x.OidcSub
 	}
+	return ""
 }
 
-func (x *SearchUsersRequestDTO) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserProfileDTO) GetOidcIssuer() string {
+	if x != nil && x.OidcIssuer != nil {
+		return *x.OidcIssuer
+	}
+	return ""
 }
 
-func (*SearchUsersRequestDTO) ProtoMessage() {}
+func (x *UserProfileDTO) GetOidcEmail() string {
+	if x != nil && x.OidcEmail != nil {
+		return *x.OidcEmail
+	}
+	return ""
+}
 
-func (x *SearchUsersRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UserProfileDTO) GetOidcName() string {
+	if x != nil && x.OidcName != nil {
+		return *x.OidcName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SearchUsersRequestDTO.ProtoReflect.Descriptor instead.
-func (*SearchUsersRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{18}
+func (x *UserProfileDTO) GetOidcPicture() string {
+	if x != nil && x.OidcPicture != nil {
+		return *x.OidcPicture
+	}
+	return ""
 }
 
-func (x *SearchUsersRequestDTO) GetPage() *http_base.PageRequestDTO {
-	if x != nil {
-		return x.Page
+func (x *UserProfileDTO) GetOidcAuthTime() int64 {
+	if x != nil && x.OidcAuthTime != nil {
+		return *x.OidcAuthTime
 	}
-	return nil
+	return 0
 }
 
-func (x *SearchUsersRequestDTO) GetOrganizationID() string {
-	if x != nil && x.OrganizationID != nil {
-		return *x.OrganizationID
+func (x *UserProfileDTO) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
-	return ""
+	return 0
 }
 
-type SearchUsersResponseDTO struct {
+type UserProfileResponseDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	BaseResp *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
-	Users    []*UserProfileDTO          `protobuf:"bytes,2,rep,name=users,proto3" form:"users" json:"users,omitempty" query:"users"`
-	Page     *http_base.PageResponseDTO `protobuf:"bytes,3,opt,name=page,proto3,oneof" form:"page" json:"page,omitempty" query:"page"`
+	User     *UserProfileDTO            `protobuf:"bytes,2,opt,name=user,proto3,oneof" form:"user" json:"user,omitempty" query:"user"`
+	// 仅 GetMe 填充：当前用户可切换的租户列表
+	SwitchableTenants []*TenantOptionDTO `protobuf:"bytes,3,rep,name=switchableTenants,proto3" form:"switchableTenants" json:"switchable_tenants,omitempty" query:"switchableTenants"`
 }
 
-func (x *SearchUsersResponseDTO) Reset() {
-	*x = SearchUsersResponseDTO{}
+func (x *UserProfileResponseDTO) Reset() {
+	*x = UserProfileResponseDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[19]
+		mi := &file_http_identity_identity_model_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchUsersResponseDTO) String() string {
+func (x *UserProfileResponseDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUsersResponseDTO) ProtoMessage() {}
+func (*UserProfileResponseDTO) ProtoMessage() {}
 
-func (x *SearchUsersResponseDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[19]
+func (x *UserProfileResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1643,59 +1673,70 @@ func (x *SearchUsersResponseDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUsersResponseDTO.ProtoReflect.Descriptor instead.
-func (*SearchUsersResponseDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use UserProfileResponseDTO.ProtoReflect.Descriptor instead.
+func (*UserProfileResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *SearchUsersResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+func (x *UserProfileResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
 	if x != nil {
 		return x.BaseResp
 	}
 	return nil
 }
 
-func (x *SearchUsersResponseDTO) GetUsers() []*UserProfileDTO {
+func (x *UserProfileResponseDTO) GetUser() *UserProfileDTO {
 	if x != nil {
-		return x.Users
+		return x.User
 	}
 	return nil
 }
 
-func (x *SearchUsersResponseDTO) GetPage() *http_base.PageResponseDTO {
+func (x *UserProfileResponseDTO) GetSwitchableTenants() []*TenantOptionDTO {
 	if x != nil {
-		return x.Page
+		return x.SwitchableTenants
 	}
 	return nil
 }
 
-type ChangeUserStatusRequestDTO struct {
+type CreateUserRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID    *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"-" path:"userID"`
-	NewStatus *int32  `protobuf:"varint,2,opt,name=newStatus,proto3,oneof" form:"new_status" json:"new_status"`
-	Reason    *string `protobuf:"bytes,3,opt,name=reason,proto3,oneof" form:"reason" json:"reason,omitempty"`
+	Username           *string  `protobuf:"bytes,1,opt,name=username,proto3,oneof" form:"username" json:"username" vd:"@:len($)>0 && len($)>=3 && len($)<=20 && regexp('^[a-zA-Z0-9_-]+$',$); msg:'用户名必须是3-20位字母、数字、下划线或短横线'"`
+	Password           *string  `protobuf:"bytes,2,opt,name=password,proto3,oneof" form:"password" json:"password" vd:"@:len($)>0 && len($)>=6; msg:'密码不能为空且长度至少为6位'"`
+	Email              *string  `protobuf:"bytes,3,opt,name=email,proto3,oneof" form:"email" json:"email,omitempty" vd:"@:len($)==0 || email($); msg:'邮箱格式不正确'"`
+	Phone              *string  `protobuf:"bytes,4,opt,name=phone,proto3,oneof" form:"phone" json:"phone,omitempty" vd:"@:len($)==0 || phone($); msg:'手机号格式不正确'"`
+	FirstName          *string  `protobuf:"bytes,5,opt,name=firstName,proto3,oneof" form:"first_name" json:"first_name,omitempty" vd:"@:len($)<=50; msg:'名字长度不能超过50个字符'"`
+	LastName           *string  `protobuf:"bytes,6,opt,name=lastName,proto3,oneof" form:"last_name" json:"last_name,omitempty" vd:"@:len($)<=50; msg:'姓氏长度不能超过50个字符'"`
+	RealName           *string  `protobuf:"bytes,7,opt,name=realName,proto3,oneof" form:"real_name" json:"real_name,omitempty" vd:"@:len($)<=100; msg:'真实姓名长度不能超过100个字符'"`
+	ProfessionalTitle  *string  `protobuf:"bytes,8,opt,name=professionalTitle,proto3,oneof" form:"professional_title" json:"professional_title,omitempty" vd:"@:len($)<=100; msg:'职业头衔长度不能超过100个字符'"`
+	EmployeeID         *string  `protobuf:"bytes,9,opt,name=employeeID,proto3,oneof" form:"employee_id" json:"employee_id,omitempty" vd:"@:len($)<=50; msg:'员工工号长度不能超过50个字符'"`
+	MustChangePassword *bool    `protobuf:"varint,10,opt,name=mustChangePassword,proto3,oneof" form:"must_change_password" json:"must_change_password,omitempty"`
+	AccountExpiry      *int64   `protobuf:"varint,11,opt,name=accountExpiry,proto3,oneof" form:"account_expiry" json:"account_expiry,omitempty"`
+	Gender             *int32   `protobuf:"varint,12,opt,name=gender,proto3,oneof" form:"gender" json:"gender,omitempty" vd:"@:$ == null || ($ >= 0 && $ <= 2); msg:'性别值必须为null或在0-2之间'"`
+	RoleIDs            []string `protobuf:"bytes,13,rep,name=roleIDs,proto3" form:"role_ids" json:"role_ids,omitempty"`
+	OrganizationID     *string  `protobuf:"bytes,14,opt,name=organizationID,proto3,oneof" form:"organization_id" json:"organization_id,omitempty" vd:"@:len($)==0 || len($)==36; msg:'组织ID格式不正确'"`
 }
 
-func (x *ChangeUserStatusRequestDTO) Reset() {
-	*x = ChangeUserStatusRequestDTO{}
+func (x *CreateUserRequestDTO) Reset() {
+	*x = CreateUserRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[20]
+		mi := &file_http_identity_identity_model_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangeUserStatusRequestDTO) String() string {
+func (x *CreateUserRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeUserStatusRequestDTO) ProtoMessage() {}
+func (*CreateUserRequestDTO) ProtoMessage() {}
 
-func (x *ChangeUserStatusRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[20]
+func (x *CreateUserRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1706,57 +1747,134 @@ func (x *ChangeUserStatusRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeUserStatusRequestDTO.ProtoReflect.Descriptor instead.
-func (*ChangeUserStatusRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use CreateUserRequestDTO.ProtoReflect.Descriptor instead.
+func (*CreateUserRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ChangeUserStatusRequestDTO) GetUserID() string {
-	if x != nil && x.UserID != nil {
-		return *x.UserID
+func (x *CreateUserRequestDTO) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
 	}
 	return ""
 }
 
-func (x *ChangeUserStatusRequestDTO) GetNewStatus() int32 {
-	if x != nil && x.NewStatus != nil {
-		return *x.NewStatus
+func (x *CreateUserRequestDTO) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
 	}
-	return 0
+	return ""
 }
 
-func (x *ChangeUserStatusRequestDTO) GetReason() string {
-	if x != nil && x.Reason != nil {
-		return *x.Reason
+func (x *CreateUserRequestDTO) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
 	}
 	return ""
 }
 
-type UnlockUserRequestDTO struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserID *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"-" path:"userID"`
+func (x *CreateUserRequestDTO) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
 }
 
-func (x *UnlockUserRequestDTO) Reset() {
-	*x = UnlockUserRequestDTO{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateUserRequestDTO) GetFirstName() string {
+	if x != nil && x.FirstName != nil {
+		return *x.FirstName
 	}
+	return ""
 }
 
-func (x *UnlockUserRequestDTO) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateUserRequestDTO) GetLastName() string {
+	if x != nil && x.LastName != nil {
+		return *x.LastName
+	}
+	return ""
 }
 
-func (*UnlockUserRequestDTO) ProtoMessage() {}
+func (x *CreateUserRequestDTO) GetRealName() string {
+	if x != nil && x.RealName != nil {
+		return *x.RealName
+	}
+	return ""
+}
 
-func (x *UnlockUserRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[21]
+func (x *CreateUserRequestDTO) GetProfessionalTitle() string {
+	if x != nil && x.ProfessionalTitle != nil {
+		return *x.ProfessionalTitle
+	}
+	return ""
+}
+
+func (x *CreateUserRequestDTO) GetEmployeeID() string {
+	if x != nil && x.EmployeeID != nil {
+		return *x.EmployeeID
+	}
+	return ""
+}
+
+func (x *CreateUserRequestDTO) GetMustChangePassword() bool {
+	if x != nil && x.MustChangePassword != nil {
+		return *x.MustChangePassword
+	}
+	return false
+}
+
+func (x *CreateUserRequestDTO) GetAccountExpiry() int64 {
+	if x != nil && x.AccountExpiry != nil {
+		return *x.AccountExpiry
+	}
+	return 0
+}
+
+func (x *CreateUserRequestDTO) GetGender() int32 {
+	if x != nil && x.Gender != nil {
+		return *x.Gender
+	}
+	return 0
+}
+
+func (x *CreateUserRequestDTO) GetRoleIDs() []string {
+	if x != nil {
+		return x.RoleIDs
+	}
+	return nil
+}
+
+func (x *CreateUserRequestDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+type GetUserRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"-" path:"userID" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
+}
+
+func (x *GetUserRequestDTO) Reset() {
+	*x = GetUserRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequestDTO) ProtoMessage() {}
+
+func (x *GetUserRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1767,51 +1885,57 @@ func (x *UnlockUserRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlockUserRequestDTO.ProtoReflect.Descriptor instead.
-func (*UnlockUserRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetUserRequestDTO.ProtoReflect.Descriptor instead.
+func (*GetUserRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *UnlockUserRequestDTO) GetUserID() string {
+func (x *GetUserRequestDTO) GetUserID() string {
 	if x != nil && x.UserID != nil {
 		return *x.UserID
 	}
 	return ""
 }
 
-type UserMembershipDTO struct {
+type UpdateUserRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             *string          `protobuf:"bytes,1,opt,name=id,proto3,oneof" form:"id" json:"id" query:"id"`
-	UserID         *string          `protobuf:"bytes,2,opt,name=userID,proto3,oneof" form:"userID" json:"user_id" query:"userID"`
-	OrganizationID *string          `protobuf:"bytes,3,opt,name=organizationID,proto3,oneof" form:"organizationID" json:"organization_id" query:"organizationID"`
-	DepartmentID   *string          `protobuf:"bytes,4,opt,name=departmentID,proto3,oneof" form:"departmentID" json:"department_id,omitempty" query:"departmentID"`
-	IsPrimary      *bool            `protobuf:"varint,5,opt,name=isPrimary,proto3,oneof" form:"isPrimary" json:"is_primary,omitempty" query:"isPrimary"`
-	CreatedAt      *int64           `protobuf:"varint,6,opt,name=createdAt,proto3,oneof" form:"createdAt" json:"created_at" query:"createdAt"`
-	UpdatedAt      *int64           `protobuf:"varint,7,opt,name=updatedAt,proto3,oneof" form:"updatedAt" json:"updated_at" query:"updatedAt"`
-	Organization   *OrganizationDTO `protobuf:"bytes,8,opt,name=organization,proto3,oneof" form:"organization" json:"organization,omitempty" query:"organization"`
-	Department     *DepartmentDTO   `protobuf:"bytes,9,opt,name=department,proto3,oneof" form:"department" json:"department,omitempty" query:"department"`
+	UserID            *string             `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"-" path:"userID" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
+	Email             *string             `protobuf:"bytes,2,opt,name=email,proto3,oneof" form:"email" json:"email,omitempty" vd:"@:len($)==0 || email($); msg:'邮箱格式不正确'"`
+	Phone             *string             `protobuf:"bytes,3,opt,name=phone,proto3,oneof" form:"phone" json:"phone,omitempty" vd:"@:len($)==0 || phone($); msg:'手机号格式不正确'"`
+	FirstName         *string             `protobuf:"bytes,4,opt,name=firstName,proto3,oneof" form:"first_name" json:"first_name,omitempty" vd:"@:len($)<=50; msg:'名字长度不能超过50个字符'"`
+	LastName          *string             `protobuf:"bytes,5,opt,name=lastName,proto3,oneof" form:"last_name" json:"last_name,omitempty" vd:"@:len($)<=50; msg:'姓氏长度不能超过50个字符'"`
+	RealName          *string             `protobuf:"bytes,6,opt,name=realName,proto3,oneof" form:"real_name" json:"real_name,omitempty" vd:"@:len($)<=100; msg:'真实姓名长度不能超过100个字符'"`
+	ProfessionalTitle *string             `protobuf:"bytes,7,opt,name=professionalTitle,proto3,oneof" form:"professional_title" json:"professional_title,omitempty" vd:"@:len($)<=100; msg:'职业头衔长度不能超过100个字符'"`
+	EmployeeID        *string             `protobuf:"bytes,8,opt,name=employeeID,proto3,oneof" form:"employee_id" json:"employee_id,omitempty" vd:"@:len($)<=50; msg:'员工工号长度不能超过50个字符'"`
+	AccountExpiry     *int64              `protobuf:"varint,9,opt,name=accountExpiry,proto3,oneof" form:"account_expiry" json:"account_expiry,omitempty"`
+	Gender            *int32              `protobuf:"varint,10,opt,name=gender,proto3,oneof" form:"gender" json:"gender,omitempty" vd:"@:$ == null || ($ >= 0 && $ <= 2); msg:'性别值必须为null或在0-2之间'"`
+	RoleIDs           *structpb.ListValue `protobuf:"bytes,11,opt,name=roleIDs,proto3,oneof" form:"role_ids" json:"role_ids,omitempty"`
+	OrganizationID    *string             `protobuf:"bytes,12,opt,name=organizationID,proto3,oneof" form:"organization_id" json:"organization_id,omitempty" vd:"@:len($)==0 || len($)==36; msg:'组织ID格式不正确'"`
+	// 读取时获得的版本号，也可通过 If-Match 请求头传入；与当前版本不一致时返回 409
+	Version *int64  `protobuf:"varint,13,opt,name=version,proto3,oneof" form:"version" json:"version,omitempty"`
+	IfMatch *string `protobuf:"bytes,14,opt,name=ifMatch,proto3,oneof" header:"If-Match" json:"-"`
 }
 
-func (x *UserMembershipDTO) Reset() {
-	*x = UserMembershipDTO{}
+func (x *UpdateUserRequestDTO) Reset() {
+	*x = UpdateUserRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[22]
+		mi := &file_http_identity_identity_model_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserMembershipDTO) String() string {
+func (x *UpdateUserRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserMembershipDTO) ProtoMessage() {}
+func (*UpdateUserRequestDTO) ProtoMessage() {}
 
-func (x *UserMembershipDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[22]
+func (x *UpdateUserRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1822,100 +1946,145 @@ func (x *UserMembershipDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserMembershipDTO.ProtoReflect.Descriptor instead.
-func (*UserMembershipDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UpdateUserRequestDTO.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *UserMembershipDTO) GetId() string {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *UpdateUserRequestDTO) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
 	}
 	return ""
 }
 
-func (x *UserMembershipDTO) GetUserID() string {
-	if x != nil && x.UserID != nil {
-		return *x.UserID
+func (x *UpdateUserRequestDTO) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
 	}
 	return ""
 }
 
-func (x *UserMembershipDTO) GetOrganizationID() string {
-	if x != nil && x.OrganizationID != nil {
-		return *x.OrganizationID
+func (x *UpdateUserRequestDTO) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
 	}
 	return ""
 }
 
-func (x *UserMembershipDTO) GetDepartmentID() string {
-	if x != nil && x.DepartmentID != nil {
-		return *x.DepartmentID
+func (x *UpdateUserRequestDTO) GetFirstName() string {
+	if x != nil && x.FirstName != nil {
+		return *x.FirstName
 	}
 	return ""
 }
 
-func (x *UserMembershipDTO) GetIsPrimary() bool {
-	if x != nil && x.IsPrimary != nil {
-		return *x.IsPrimary
+func (x *UpdateUserRequestDTO) GetLastName() string {
+	if x != nil && x.LastName != nil {
+		return *x.LastName
 	}
-	return false
+	return ""
 }
 
-func (x *UserMembershipDTO) GetCreatedAt() int64 {
-	if x != nil && x.CreatedAt != nil {
-		return *x.CreatedAt
+func (x *UpdateUserRequestDTO) GetRealName() string {
+	if x != nil && x.RealName != nil {
+		return *x.RealName
+	}
+	return ""
+}
+
+func (x *UpdateUserRequestDTO) GetProfessionalTitle() string {
+	if x != nil && x.ProfessionalTitle != nil {
+		return *x.ProfessionalTitle
+	}
+	return ""
+}
+
+func (x *UpdateUserRequestDTO) GetEmployeeID() string {
+	if x != nil && x.EmployeeID != nil {
+		return *x.EmployeeID
+	}
+	return ""
+}
+
+func (x *UpdateUserRequestDTO) GetAccountExpiry() int64 {
+	if x != nil && x.AccountExpiry != nil {
+		return *x.AccountExpiry
 	}
 	return 0
 }
 
-func (x *UserMembershipDTO) GetUpdatedAt() int64 {
-	if x != nil && x.UpdatedAt != nil {
-		return *x.UpdatedAt
+func (x *UpdateUserRequestDTO) GetGender() int32 {
+	if x != nil && x.Gender != nil {
+		return *x.Gender
 	}
 	return 0
 }
 
-func (x *UserMembershipDTO) GetOrganization() *OrganizationDTO {
+func (x *UpdateUserRequestDTO) GetRoleIDs() *structpb.ListValue {
 	if x != nil {
-		return x.Organization
+		return x.RoleIDs
 	}
 	return nil
 }
 
-func (x *UserMembershipDTO) GetDepartment() *DepartmentDTO {
-	if x != nil {
-		return x.Department
+func (x *UpdateUserRequestDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
 	}
-	return nil
+	return ""
 }
 
-type UserMembershipResponseDTO struct {
+func (x *UpdateUserRequestDTO) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *UpdateUserRequestDTO) GetIfMatch() string {
+	if x != nil && x.IfMatch != nil {
+		return *x.IfMatch
+	}
+	return ""
+}
+
+type UpdateMeRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BaseResp   *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
-	Membership *UserMembershipDTO         `protobuf:"bytes,2,opt,name=membership,proto3,oneof" form:"membership" json:"membership,omitempty" query:"membership"`
+	Email             *string `protobuf:"bytes,1,opt,name=email,proto3,oneof" form:"email" json:"email,omitempty" vd:"@:len($)==0 || email($); msg:'邮箱格式不正确'"`
+	Phone             *string `protobuf:"bytes,2,opt,name=phone,proto3,oneof" form:"phone" json:"phone,omitempty" vd:"@:len($)==0 || phone($); msg:'手机号格式不正确'"`
+	FirstName         *string `protobuf:"bytes,3,opt,name=firstName,proto3,oneof" form:"first_name" json:"first_name,omitempty" vd:"@:len($)<=50; msg:'名字长度不能超过50个字符'"`
+	LastName          *string `protobuf:"bytes,4,opt,name=lastName,proto3,oneof" form:"last_name" json:"last_name,omitempty" vd:"@:len($)<=50; msg:'姓氏长度不能超过50个字符'"`
+	RealName          *string `protobuf:"bytes,5,opt,name=realName,proto3,oneof" form:"real_name" json:"real_name,omitempty" vd:"@:len($)<=100; msg:'真实姓名长度不能超过100个字符'"`
+	ProfessionalTitle *string `protobuf:"bytes,6,opt,name=professionalTitle,proto3,oneof" form:"professional_title" json:"professional_title,omitempty" vd:"@:len($)<=100; msg:'职业头衔长度不能超过100个字符'"`
+	EmployeeID        *string `protobuf:"bytes,7,opt,name=employeeID,proto3,oneof" form:"employee_id" json:"employee_id,omitempty" vd:"@:len($)<=50; msg:'员工工号长度不能超过50个字符'"`
+	AccountExpiry     *int64  `protobuf:"varint,8,opt,name=accountExpiry,proto3,oneof" form:"account_expiry" json:"account_expiry,omitempty"`
+	Gender            *int32  `protobuf:"varint,9,opt,name=gender,proto3,oneof" form:"gender" json:"gender,omitempty" vd:"@:$ == null || ($ >= 0 && $ <= 2); msg:'性别值必须为null或在0-2之间'"`
+	// 读取时获得的版本号，也可通过 If-Match 请求头传入；与当前版本不一致时返回 409
+	Version *int64  `protobuf:"varint,10,opt,name=version,proto3,oneof" form:"version" json:"version,omitempty"`
+	IfMatch *string `protobuf:"bytes,11,opt,name=ifMatch,proto3,oneof" header:"If-Match" json:"-"`
 }
 
-func (x *UserMembershipResponseDTO) Reset() {
-	*x = UserMembershipResponseDTO{}
+func (x *UpdateMeRequestDTO) Reset() {
+	*x = UpdateMeRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[23]
+		mi := &file_http_identity_identity_model_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserMembershipResponseDTO) String() string {
+func (x *UpdateMeRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserMembershipResponseDTO) ProtoMessage() {}
+func (*UpdateMeRequestDTO) ProtoMessage() {}
 
-func (x *UserMembershipResponseDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[23]
+func (x *UpdateMeRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1926,51 +2095,114 @@ func (x *UserMembershipResponseDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserMembershipResponseDTO.ProtoReflect.Descriptor instead.
-func (*UserMembershipResponseDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use UpdateMeRequestDTO.ProtoReflect.Descriptor instead.
+func (*UpdateMeRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *UserMembershipResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
-	if x != nil {
-		return x.BaseResp
+func (x *UpdateMeRequestDTO) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
 	}
-	return nil
+	return ""
 }
 
-func (x *UserMembershipResponseDTO) GetMembership() *UserMembershipDTO {
-	if x != nil {
-		return x.Membership
-	}
-	return nil
+func (x *UpdateMeRequestDTO) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
 }
 
-type GetUserMembershipsRequestDTO struct {
+func (x *UpdateMeRequestDTO) GetFirstName() string {
+	if x != nil && x.FirstName != nil {
+		return *x.FirstName
+	}
+	return ""
+}
+
+func (x *UpdateMeRequestDTO) GetLastName() string {
+	if x != nil && x.LastName != nil {
+		return *x.LastName
+	}
+	return ""
+}
+
+func (x *UpdateMeRequestDTO) GetRealName() string {
+	if x != nil && x.RealName != nil {
+		return *x.RealName
+	}
+	return ""
+}
+
+func (x *UpdateMeRequestDTO) GetProfessionalTitle() string {
+	if x != nil && x.ProfessionalTitle != nil {
+		return *x.ProfessionalTitle
+	}
+	return ""
+}
+
+func (x *UpdateMeRequestDTO) GetEmployeeID() string {
+	if x != nil && x.EmployeeID != nil {
+		return *x.EmployeeID
+	}
+	return ""
+}
+
+func (x *UpdateMeRequestDTO) GetAccountExpiry() int64 {
+	if x != nil && x.AccountExpiry != nil {
+		return *x.AccountExpiry
+	}
+	return 0
+}
+
+func (x *UpdateMeRequestDTO) GetGender() int32 {
+	if x != nil && x.Gender != nil {
+		return *x.Gender
+	}
+	return 0
+}
+
+func (x *UpdateMeRequestDTO) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *UpdateMeRequestDTO) GetIfMatch() string {
+	if x != nil && x.IfMatch != nil {
+		return *x.IfMatch
+	}
+	return ""
+}
+
+type DeleteUserRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID *string                   `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"-" path:"userID" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
-	Page   *http_base.PageRequestDTO `protobuf:"bytes,2,opt,name=page,proto3,oneof" form:"-" json:"-" query:"-"`
+	UserID *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"-" path:"userID" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
+	Reason *string `protobuf:"bytes,2,opt,name=reason,proto3,oneof" form:"reason" json:"reason,omitempty" vd:"@:len($)<=200; msg:'删除原因不能超过200个字符'"`
 }
 
-func (x *GetUserMembershipsRequestDTO) Reset() {
-	*x = GetUserMembershipsRequestDTO{}
+func (x *DeleteUserRequestDTO) Reset() {
+	*x = DeleteUserRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[24]
+		mi := &file_http_identity_identity_model_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserMembershipsRequestDTO) String() string {
+func (x *DeleteUserRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserMembershipsRequestDTO) ProtoMessage() {}
+func (*DeleteUserRequestDTO) ProtoMessage() {}
 
-func (x *GetUserMembershipsRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[24]
+func (x *DeleteUserRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1981,52 +2213,57 @@ func (x *GetUserMembershipsRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserMembershipsRequestDTO.ProtoReflect.Descriptor instead.
-func (*GetUserMembershipsRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use DeleteUserRequestDTO.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetUserMembershipsRequestDTO) GetUserID() string {
+func (x *DeleteUserRequestDTO) GetUserID() string {
 	if x != nil && x.UserID != nil {
 		return *x.UserID
 	}
 	return ""
 }
 
-func (x *GetUserMembershipsRequestDTO) GetPage() *http_base.PageRequestDTO {
-	if x != nil {
-		return x.Page
+func (x *DeleteUserRequestDTO) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
 	}
-	return nil
+	return ""
 }
 
-type GetUserMembershipsResponseDTO struct {
+// 搜索命中信息：page.search 非空时随列表返回，供前端高亮
+type SearchHighlightDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BaseResp    *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
-	Memberships []*UserMembershipDTO       `protobuf:"bytes,2,rep,name=memberships,proto3" form:"memberships" json:"memberships,omitempty" query:"memberships"`
-	Page        *http_base.PageResponseDTO `protobuf:"bytes,3,opt,name=page,proto3,oneof" form:"page" json:"page,omitempty" query:"page"`
+	Id     *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" form:"id" json:"id" query:"id"`
+	Field  *string `protobuf:"bytes,2,opt,name=field,proto3,oneof" form:"field" json:"field" query:"field"`
+	Value  *string `protobuf:"bytes,3,opt,name=value,proto3,oneof" form:"value" json:"value" query:"value"`
+	Start  *int32  `protobuf:"varint,4,opt,name=start,proto3,oneof" form:"start" json:"start" query:"start"`
+	End    *int32  `protobuf:"varint,5,opt,name=end,proto3,oneof" form:"end" json:"end" query:"end"`
+	Rank   *int32  `protobuf:"varint,6,opt,name=rank,proto3,oneof" form:"rank" json:"rank" query:"rank"`
+	Pinyin *bool   `protobuf:"varint,7,opt,name=pinyin,proto3,oneof" form:"pinyin" json:"pinyin" query:"pinyin"`
 }
 
-func (x *GetUserMembershipsResponseDTO) Reset() {
-	*x = GetUserMembershipsResponseDTO{}
+func (x *SearchHighlightDTO) Reset() {
+	*x = SearchHighlightDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[25]
+		mi := &file_http_identity_identity_model_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserMembershipsResponseDTO) String() string {
+func (x *SearchHighlightDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserMembershipsResponseDTO) ProtoMessage() {}
+func (*SearchHighlightDTO) ProtoMessage() {}
 
-func (x *GetUserMembershipsResponseDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[25]
+func (x *SearchHighlightDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2037,57 +2274,87 @@ func (x *GetUserMembershipsResponseDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserMembershipsResponseDTO.ProtoReflect.Descriptor instead.
-func (*GetUserMembershipsResponseDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use SearchHighlightDTO.ProtoReflect.Descriptor instead.
+func (*SearchHighlightDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetUserMembershipsResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
-	if x != nil {
-		return x.BaseResp
+func (x *SearchHighlightDTO) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *GetUserMembershipsResponseDTO) GetMemberships() []*UserMembershipDTO {
-	if x != nil {
-		return x.Memberships
+func (x *SearchHighlightDTO) GetField() string {
+	if x != nil && x.Field != nil {
+		return *x.Field
 	}
-	return nil
+	return ""
 }
 
-func (x *GetUserMembershipsResponseDTO) GetPage() *http_base.PageResponseDTO {
-	if x != nil {
-		return x.Page
+func (x *SearchHighlightDTO) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
 	}
-	return nil
+	return ""
 }
 
-type GetPrimaryMembershipRequestDTO struct {
+func (x *SearchHighlightDTO) GetStart() int32 {
+	if x != nil && x.Start != nil {
+		return *x.Start
+	}
+	return 0
+}
+
+func (x *SearchHighlightDTO) GetEnd() int32 {
+	if x != nil && x.End != nil {
+		return *x.End
+	}
+	return 0
+}
+
+func (x *SearchHighlightDTO) GetRank() int32 {
+	if x != nil && x.Rank != nil {
+		return *x.Rank
+	}
+	return 0
+}
+
+func (x *SearchHighlightDTO) GetPinyin() bool {
+	if x != nil && x.Pinyin != nil {
+		return *x.Pinyin
+	}
+	return false
+}
+
+type ListUsersRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"-" path:"userID" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
+	Page           *http_base.PageRequestDTO `protobuf:"bytes,1,opt,name=page,proto3,oneof" form:"-" json:"-" query:"-"`
+	OrganizationID *string                   `protobuf:"bytes,2,opt,name=organizationID,proto3,oneof" json:"organization_id,omitempty" query:"organization_id"`
+	Status         *int32                    `protobuf:"varint,3,opt,name=status,proto3,oneof" json:"status,omitempty" query:"status"`
 }
 
-func (x *GetPrimaryMembershipRequestDTO) Reset() {
-	*x = GetPrimaryMembershipRequestDTO{}
+func (x *ListUsersRequestDTO) Reset() {
+	*x = ListUsersRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[26]
+		mi := &file_http_identity_identity_model_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPrimaryMembershipRequestDTO) String() string {
+func (x *ListUsersRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPrimaryMembershipRequestDTO) ProtoMessage() {}
+func (*ListUsersRequestDTO) ProtoMessage() {}
 
-func (x *GetPrimaryMembershipRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[26]
+func (x *ListUsersRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2098,45 +2365,64 @@ func (x *GetPrimaryMembershipRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPrimaryMembershipRequestDTO.ProtoReflect.Descriptor instead.
-func (*GetPrimaryMembershipRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ListUsersRequestDTO.ProtoReflect.Descriptor instead.
+func (*ListUsersRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetPrimaryMembershipRequestDTO) GetUserID() string {
-	if x != nil && x.UserID != nil {
-		return *x.UserID
+func (x *ListUsersRequestDTO) GetPage() *http_base.PageRequestDTO {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListUsersRequestDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
 	}
 	return ""
 }
 
-type CheckMembershipRequestDTO struct {
+func (x *ListUsersRequestDTO) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+type ImportUsersRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID         *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"user_id,omitempty" query:"user_id" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
-	OrganizationID *string `protobuf:"bytes,2,opt,name=organizationID,proto3,oneof" json:"organization_id,omitempty" query:"organization_id" vd:"@:len($)==36; msg:'组织ID格式不正确'"`
-	DepartmentID   *string `protobuf:"bytes,3,opt,name=departmentID,proto3,oneof" json:"department_id,omitempty" query:"department_id" vd:"@:len($)==0 || len($)==36; msg:'部门ID格式不正确'"`
+	// 文件内容由处理器从 multipart 的 file 字段读取
+	FileContent []byte `protobuf:"bytes,1,opt,name=fileContent,proto3,oneof" form:"-" json:"-" query:"-"`
+	// 文件格式 csv / xlsx，为空时按上传文件的扩展名识别
+	Format *string `protobuf:"bytes,2,opt,name=format,proto3,oneof" form:"format" json:"format,omitempty" vd:"@:len($)==0 || regexp('^(csv|xlsx)$',$); msg:'文件格式必须是 csv 或 xlsx'"`
+	// 仅校验不导入，返回逐行校验结果
+	DryRun *bool `protobuf:"varint,3,opt,name=dryRun,proto3,oneof" form:"dry_run" json:"dry_run,omitempty"`
+	// 每批提交的行数，默认 100，最大 500
+	BatchSize *int32 `protobuf:"varint,4,opt,name=batchSize,proto3,oneof" form:"batch_size" json:"batch_size,omitempty" vd:"@:$ == null || ($ >= 1 && $ <= 500); msg:'批次大小必须在1-500之间'"`
 }
 
-func (x *CheckMembershipRequestDTO) Reset() {
-	*x = CheckMembershipRequestDTO{}
+func (x *ImportUsersRequestDTO) Reset() {
+	*x = ImportUsersRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[27]
+		mi := &file_http_identity_identity_model_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CheckMembershipRequestDTO) String() string {
+func (x *ImportUsersRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckMembershipRequestDTO) ProtoMessage() {}
+func (*ImportUsersRequestDTO) ProtoMessage() {}
 
-func (x *CheckMembershipRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[27]
+func (x *ImportUsersRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2147,71 +2433,67 @@ func (x *CheckMembershipRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckMembershipRequestDTO.ProtoReflect.Descriptor instead.
-func (*CheckMembershipRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ImportUsersRequestDTO.ProtoReflect.Descriptor instead.
+func (*ImportUsersRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *CheckMembershipRequestDTO) GetUserID() string {
-	if x != nil && x.UserID != nil {
-		return *x.UserID
+func (x *ImportUsersRequestDTO) GetFileContent() []byte {
+	if x != nil {
+		return x.FileContent
 	}
-	return ""
+	return nil
 }
 
-func (x *CheckMembershipRequestDTO) GetOrganizationID() string {
-	if x != nil && x.OrganizationID != nil {
-		return *x.OrganizationID
+func (x *ImportUsersRequestDTO) GetFormat() string {
+	if x != nil && x.Format != nil {
+		return *x.Format
 	}
 	return ""
 }
 
-func (x *CheckMembershipRequestDTO) GetDepartmentID() string {
-	if x != nil && x.DepartmentID != nil {
-		return *x.DepartmentID
+func (x *ImportUsersRequestDTO) GetDryRun() bool {
+	if x != nil && x.DryRun != nil {
+		return *x.DryRun
 	}
-	return ""
+	return false
 }
 
-type OrganizationDTO struct {
+func (x *ImportUsersRequestDTO) GetBatchSize() int32 {
+	if x != nil && x.BatchSize != nil {
+		return *x.BatchSize
+	}
+	return 0
+}
+
+type UserImportRowResultDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                  *string            `protobuf:"bytes,1,opt,name=id,proto3,oneof" form:"id" json:"id" query:"id"`
-	Code                *string            `protobuf:"bytes,2,opt,name=code,proto3,oneof" form:"code" json:"code,omitempty" query:"code"`
-	Name                *string            `protobuf:"bytes,3,opt,name=name,proto3,oneof" form:"name" json:"name" query:"name"`
-	ParentID            *string            `protobuf:"bytes,4,opt,name=parentID,proto3,oneof" form:"parentID" json:"parent_id,omitempty" query:"parentID"`
-	FacilityType        *string            `protobuf:"bytes,5,opt,name=facilityType,proto3,oneof" form:"facilityType" json:"facility_type,omitempty" query:"facilityType"`
-	AccreditationStatus *string            `protobuf:"bytes,6,opt,name=accreditationStatus,proto3,oneof" form:"accreditationStatus" json:"accreditation_status,omitempty" query:"accreditationStatus"`
-	Logo                *string            `protobuf:"bytes,7,opt,name=logo,proto3,oneof" form:"logo" json:"logo,omitempty" query:"logo"`
-	ProvinceCity        []string           `protobuf:"bytes,8,rep,name=provinceCity,proto3" form:"provinceCity" json:"province_city,omitempty" query:"provinceCity"`
-	CreatedAt           *int64             `protobuf:"varint,9,opt,name=createdAt,proto3,oneof" form:"createdAt" json:"created_at" query:"createdAt"`
-	UpdatedAt           *int64             `protobuf:"varint,10,opt,name=updatedAt,proto3,oneof" form:"updatedAt" json:"updated_at" query:"updatedAt"`
-	Parent              *OrganizationDTO   `protobuf:"bytes,11,opt,name=parent,proto3,oneof" form:"parent" json:"parent,omitempty" query:"parent"`
-	Children            []*OrganizationDTO `protobuf:"bytes,12,rep,name=children,proto3" form:"children" json:"children,omitempty" query:"children"`
-	MemberCount         *int32             `protobuf:"varint,13,opt,name=memberCount,proto3,oneof" form:"memberCount" json:"member_count,omitempty" query:"memberCount"`
-	DepartmentCount     *int32             `protobuf:"varint,14,opt,name=departmentCount,proto3,oneof" form:"departmentCount" json:"department_count,omitempty" query:"departmentCount"`
-	LogoID              *string            `protobuf:"bytes,15,opt,name=logoID,proto3,oneof" form:"logoID" json:"logo_id,omitempty" query:"logoID"`
+	RowNumber *int32   `protobuf:"varint,1,opt,name=rowNumber,proto3,oneof" form:"rowNumber" json:"row_number" query:"rowNumber"`
+	Username  *string  `protobuf:"bytes,2,opt,name=username,proto3,oneof" form:"username" json:"username,omitempty" query:"username"`
+	Errors    []string `protobuf:"bytes,3,rep,name=errors,proto3" form:"errors" json:"errors,omitempty" query:"errors"`
+	UserID    *string  `protobuf:"bytes,4,opt,name=userID,proto3,oneof" form:"userID" json:"user_id,omitempty" query:"userID"`
 }
 
-func (x *OrganizationDTO) Reset() {
-	*x = OrganizationDTO{}
+func (x *UserImportRowResultDTO) Reset() {
+	*x = UserImportRowResultDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[28]
+		mi := &file_http_identity_identity_model_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OrganizationDTO) String() string {
+func (x *UserImportRowResultDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrganizationDTO) ProtoMessage() {}
+func (*UserImportRowResultDTO) ProtoMessage() {}
 
-func (x *OrganizationDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[28]
+func (x *UserImportRowResultDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2222,142 +2504,161 @@ func (x *OrganizationDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrganizationDTO.ProtoReflect.Descriptor instead.
-func (*OrganizationDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use UserImportRowResultDTO.ProtoReflect.Descriptor instead.
+func (*UserImportRowResultDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *OrganizationDTO) GetId() string {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *UserImportRowResultDTO) GetRowNumber() int32 {
+	if x != nil && x.RowNumber != nil {
+		return *x.RowNumber
 	}
-	return ""
+	return 0
 }
 
-func (x *OrganizationDTO) GetCode() string {
-	if x != nil && x.Code != nil {
-		return *x.Code
+func (x *UserImportRowResultDTO) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
 	}
 	return ""
 }
 
-func (x *OrganizationDTO) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *UserImportRowResultDTO) GetErrors() []string {
+	if x != nil {
+		return x.Errors
 	}
-	return ""
+	return nil
 }
 
-func (x *OrganizationDTO) GetParentID() string {
-	if x != nil && x.ParentID != nil {
-		return *x.ParentID
+func (x *UserImportRowResultDTO) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
 	}
 	return ""
 }
 
-func (x *OrganizationDTO) GetFacilityType() string {
-	if x != nil && x.FacilityType != nil {
-		return *x.FacilityType
-	}
-	return ""
+type ImportUsersResponseDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResp    *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	DryRun      *bool                      `protobuf:"varint,2,opt,name=dryRun,proto3,oneof" form:"dryRun" json:"dry_run" query:"dryRun"`
+	TotalRows   *int32                     `protobuf:"varint,3,opt,name=totalRows,proto3,oneof" form:"totalRows" json:"total_rows" query:"totalRows"`
+	ValidRows   *int32                     `protobuf:"varint,4,opt,name=validRows,proto3,oneof" form:"validRows" json:"valid_rows" query:"validRows"`
+	CreatedRows *int32                     `protobuf:"varint,5,opt,name=createdRows,proto3,oneof" form:"createdRows" json:"created_rows" query:"createdRows"`
+	FailedRows  *int32                     `protobuf:"varint,6,opt,name=failedRows,proto3,oneof" form:"failedRows" json:"failed_rows" query:"failedRows"`
+	Rows        []*UserImportRowResultDTO  `protobuf:"bytes,7,rep,name=rows,proto3" form:"rows" json:"rows,omitempty" query:"rows"`
 }
 
-func (x *OrganizationDTO) GetAccreditationStatus() string {
-	if x != nil && x.AccreditationStatus != nil {
-		return *x.AccreditationStatus
+func (x *ImportUsersResponseDTO) Reset() {
+	*x = ImportUsersResponseDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *OrganizationDTO) GetLogo() string {
-	if x != nil && x.Logo != nil {
-		return *x.Logo
+func (x *ImportUsersResponseDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUsersResponseDTO) ProtoMessage() {}
+
+func (x *ImportUsersResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *OrganizationDTO) GetProvinceCity() []string {
+// Deprecated: Use ImportUsersResponseDTO.ProtoReflect.Descriptor instead.
+func (*ImportUsersResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ImportUsersResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
 	if x != nil {
-		return x.ProvinceCity
+		return x.BaseResp
 	}
 	return nil
 }
 
-func (x *OrganizationDTO) GetCreatedAt() int64 {
-	if x != nil && x.CreatedAt != nil {
-		return *x.CreatedAt
+func (x *ImportUsersResponseDTO) GetDryRun() bool {
+	if x != nil && x.DryRun != nil {
+		return *x.DryRun
 	}
-	return 0
+	return false
 }
 
-func (x *OrganizationDTO) GetUpdatedAt() int64 {
-	if x != nil && x.UpdatedAt != nil {
-		return *x.UpdatedAt
+func (x *ImportUsersResponseDTO) GetTotalRows() int32 {
+	if x != nil && x.TotalRows != nil {
+		return *x.TotalRows
 	}
 	return 0
 }
 
-func (x *OrganizationDTO) GetParent() *OrganizationDTO {
-	if x != nil {
-		return x.Parent
-	}
-	return nil
-}
-
-func (x *OrganizationDTO) GetChildren() []*OrganizationDTO {
-	if x != nil {
-		return x.Children
+func (x *ImportUsersResponseDTO) GetValidRows() int32 {
+	if x != nil && x.ValidRows != nil {
+		return *x.ValidRows
 	}
-	return nil
+	return 0
 }
 
-func (x *OrganizationDTO) GetMemberCount() int32 {
-	if x != nil && x.MemberCount != nil {
-		return *x.MemberCount
+func (x *ImportUsersResponseDTO) GetCreatedRows() int32 {
+	if x != nil && x.CreatedRows != nil {
+		return *x.CreatedRows
 	}
 	return 0
 }
 
-func (x *OrganizationDTO) GetDepartmentCount() int32 {
-	if x != nil && x.DepartmentCount != nil {
-		return *x.DepartmentCount
+func (x *ImportUsersResponseDTO) GetFailedRows() int32 {
+	if x != nil && x.FailedRows != nil {
+		return *x.FailedRows
 	}
 	return 0
 }
 
-func (x *OrganizationDTO) GetLogoID() string {
-	if x != nil && x.LogoID != nil {
-		return *x.LogoID
+func (x *ImportUsersResponseDTO) GetRows() []*UserImportRowResultDTO {
+	if x != nil {
+		return x.Rows
 	}
-	return ""
+	return nil
 }
 
-type OrganizationResponseDTO struct {
+type ExportUsersRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BaseResp     *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
-	Organization *OrganizationDTO           `protobuf:"bytes,2,opt,name=organization,proto3,oneof" form:"organization" json:"organization,omitempty" query:"organization"`
+	Format         *string `protobuf:"bytes,1,opt,name=format,proto3,oneof" json:"format,omitempty" query:"format" vd:"@:len($)==0 || regexp('^(csv|xlsx)$',$); msg:'文件格式必须是 csv 或 xlsx'"`
+	OrganizationID *string `protobuf:"bytes,2,opt,name=organizationID,proto3,oneof" json:"organization_id,omitempty" query:"organization_id"`
+	Status         *int32  `protobuf:"varint,3,opt,name=status,proto3,oneof" json:"status,omitempty" query:"status"`
 }
 
-func (x *OrganizationResponseDTO) Reset() {
-	*x = OrganizationResponseDTO{}
+func (x *ExportUsersRequestDTO) Reset() {
+	*x = ExportUsersRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[29]
+		mi := &file_http_identity_identity_model_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OrganizationResponseDTO) String() string {
+func (x *ExportUsersRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrganizationResponseDTO) ProtoMessage() {}
+func (*ExportUsersRequestDTO) ProtoMessage() {}
 
-func (x *OrganizationResponseDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[29]
+func (x *ExportUsersRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2368,54 +2669,60 @@ func (x *OrganizationResponseDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrganizationResponseDTO.ProtoReflect.Descriptor instead.
-func (*OrganizationResponseDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ExportUsersRequestDTO.ProtoReflect.Descriptor instead.
+func (*ExportUsersRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *OrganizationResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
-	if x != nil {
-		return x.BaseResp
+func (x *ExportUsersRequestDTO) GetFormat() string {
+	if x != nil && x.Format != nil {
+		return *x.Format
 	}
-	return nil
+	return ""
 }
 
-func (x *OrganizationResponseDTO) GetOrganization() *OrganizationDTO {
-	if x != nil {
-		return x.Organization
+func (x *ExportUsersRequestDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
 	}
-	return nil
+	return ""
 }
 
-type CreateOrganizationRequestDTO struct {
+func (x *ExportUsersRequestDTO) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+type ListUsersResponseDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name                *string  `protobuf:"bytes,1,opt,name=name,proto3,oneof" form:"name" json:"name" vd:"@:len($)>0 && len($)>=2 && len($)<=100; msg:'组织名称长度必须在2-100个字符之间'"`
-	ParentID            *string  `protobuf:"bytes,2,opt,name=parentID,proto3,oneof" form:"parent_id" json:"parent_id,omitempty" vd:"@:len($)==0 || len($)==36; msg:'父组织ID格式不正确'"`
-	FacilityType        *string  `protobuf:"bytes,3,opt,name=facilityType,proto3,oneof" form:"facility_type" json:"facility_type,omitempty" vd:"@:len($)<=100; msg:'机构类型长度不能超过100个字符'"`
-	AccreditationStatus *string  `protobuf:"bytes,4,opt,name=accreditationStatus,proto3,oneof" form:"accreditation_status" json:"accreditation_status,omitempty" vd:"@:len($)<=100; msg:'认证状态长度不能超过100个字符'"`
-	ProvinceCity        []string `protobuf:"bytes,5,rep,name=provinceCity,proto3" form:"province_city" json:"province_city,omitempty"`
+	BaseResp   *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	Users      []*UserProfileDTO          `protobuf:"bytes,2,rep,name=users,proto3" form:"users" json:"users,omitempty" query:"users"`
+	Page       *http_base.PageResponseDTO `protobuf:"bytes,3,opt,name=page,proto3,oneof" form:"page" json:"page,omitempty" query:"page"`
+	Highlights []*SearchHighlightDTO      `protobuf:"bytes,4,rep,name=highlights,proto3" form:"highlights" json:"highlights,omitempty" query:"highlights"`
 }
 
-func (x *CreateOrganizationRequestDTO) Reset() {
-	*x = CreateOrganizationRequestDTO{}
+func (x *ListUsersResponseDTO) Reset() {
+	*x = ListUsersResponseDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[30]
+		mi := &file_http_identity_identity_model_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateOrganizationRequestDTO) String() string {
+func (x *ListUsersResponseDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrganizationRequestDTO) ProtoMessage() {}
+func (*ListUsersResponseDTO) ProtoMessage() {}
 
-func (x *CreateOrganizationRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[30]
+func (x *ListUsersResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2426,71 +2733,65 @@ func (x *CreateOrganizationRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrganizationRequestDTO.ProtoReflect.Descriptor instead.
-func (*CreateOrganizationRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *CreateOrganizationRequestDTO) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
+// Deprecated: Use ListUsersResponseDTO.ProtoReflect.Descriptor instead.
+func (*ListUsersResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *CreateOrganizationRequestDTO) GetParentID() string {
-	if x != nil && x.ParentID != nil {
-		return *x.ParentID
+func (x *ListUsersResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateOrganizationRequestDTO) GetFacilityType() string {
-	if x != nil && x.FacilityType != nil {
-		return *x.FacilityType
+func (x *ListUsersResponseDTO) GetUsers() []*UserProfileDTO {
+	if x != nil {
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateOrganizationRequestDTO) GetAccreditationStatus() string {
-	if x != nil && x.AccreditationStatus != nil {
-		return *x.AccreditationStatus
+func (x *ListUsersResponseDTO) GetPage() *http_base.PageResponseDTO {
+	if x != nil {
+		return x.Page
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateOrganizationRequestDTO) GetProvinceCity() []string {
+func (x *ListUsersResponseDTO) GetHighlights() []*SearchHighlightDTO {
 	if x != nil {
-		return x.ProvinceCity
+		return x.Highlights
 	}
 	return nil
 }
 
-type GetOrganizationRequestDTO struct {
+type SearchUsersRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrganizationID *string `protobuf:"bytes,1,opt,name=organizationID,proto3,oneof" json:"-" path:"organizationID" vd:"@:len($)==36; msg:'组织ID格式不正确'"`
+	Page           *http_base.PageRequestDTO `protobuf:"bytes,1,opt,name=page,proto3,oneof" form:"-" json:"-" query:"-"`
+	OrganizationID *string                   `protobuf:"bytes,2,opt,name=organizationID,proto3,oneof" json:"organization_id,omitempty" query:"organization_id"`
 }
 
-func (x *GetOrganizationRequestDTO) Reset() {
-	*x = GetOrganizationRequestDTO{}
+func (x *SearchUsersRequestDTO) Reset() {
+	*x = SearchUsersRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[31]
+		mi := &file_http_identity_identity_model_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetOrganizationRequestDTO) String() string {
+func (x *SearchUsersRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrganizationRequestDTO) ProtoMessage() {}
+func (*SearchUsersRequestDTO) ProtoMessage() {}
 
-func (x *GetOrganizationRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[31]
+func (x *SearchUsersRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2501,48 +2802,53 @@ func (x *GetOrganizationRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrganizationRequestDTO.ProtoReflect.Descriptor instead.
-func (*GetOrganizationRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use SearchUsersRequestDTO.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetOrganizationRequestDTO) GetOrganizationID() string {
+func (x *SearchUsersRequestDTO) GetPage() *http_base.PageRequestDTO {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *SearchUsersRequestDTO) GetOrganizationID() string {
 	if x != nil && x.OrganizationID != nil {
 		return *x.OrganizationID
 	}
 	return ""
 }
 
-type UpdateOrganizationRequestDTO struct {
+type SearchUsersResponseDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrganizationID      *string             `protobuf:"bytes,1,opt,name=organizationID,proto3,oneof" json:"-" path:"organizationID" vd:"@:len($)==36; msg:'组织ID格式不正确'"`
-	Name                *string             `protobuf:"bytes,2,opt,name=name,proto3,oneof" form:"name" json:"name,omitempty" vd:"@:len($)==0 || (len($)>=2 && len($)<=100); msg:'名称长度必须在2-100个字符之间'"`
-	ParentID            *string             `protobuf:"bytes,3,opt,name=parentID,proto3,oneof" form:"parent_id" json:"parent_id,omitempty" vd:"@:len($)==0 || len($)==36; msg:'父组织ID格式不正确'"`
-	FacilityType        *string             `protobuf:"bytes,4,opt,name=facilityType,proto3,oneof" form:"facility_type" json:"facility_type,omitempty" vd:"@:len($)<=100; msg:'机构类型长度不能超过100个字符'"`
-	AccreditationStatus *string             `protobuf:"bytes,5,opt,name=accreditationStatus,proto3,oneof" form:"accreditation_status" json:"accreditation_status,omitempty" vd:"@:len($)<=100; msg:'认证状态长度不能超过100个字符'"`
-	ProvinceCity        *structpb.ListValue `protobuf:"bytes,6,opt,name=provinceCity,proto3,oneof" form:"province_city" json:"province_city,omitempty"`
+	BaseResp   *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	Users      []*UserProfileDTO          `protobuf:"bytes,2,rep,name=users,proto3" form:"users" json:"users,omitempty" query:"users"`
+	Page       *http_base.PageResponseDTO `protobuf:"bytes,3,opt,name=page,proto3,oneof" form:"page" json:"page,omitempty" query:"page"`
+	Highlights []*SearchHighlightDTO      `protobuf:"bytes,4,rep,name=highlights,proto3" form:"highlights" json:"highlights,omitempty" query:"highlights"`
 }
 
-func (x *UpdateOrganizationRequestDTO) Reset() {
-	*x = UpdateOrganizationRequestDTO{}
+func (x *SearchUsersResponseDTO) Reset() {
+	*x = SearchUsersResponseDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[32]
+		mi := &file_http_identity_identity_model_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateOrganizationRequestDTO) String() string {
+func (x *SearchUsersResponseDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrganizationRequestDTO) ProtoMessage() {}
+func (*SearchUsersResponseDTO) ProtoMessage() {}
 
-func (x *UpdateOrganizationRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[32]
+func (x *SearchUsersResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2553,78 +2859,66 @@ func (x *UpdateOrganizationRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrganizationRequestDTO.ProtoReflect.Descriptor instead.
-func (*UpdateOrganizationRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use SearchUsersResponseDTO.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *UpdateOrganizationRequestDTO) GetOrganizationID() string {
-	if x != nil && x.OrganizationID != nil {
-		return *x.OrganizationID
-	}
-	return ""
-}
-
-func (x *UpdateOrganizationRequestDTO) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-func (x *UpdateOrganizationRequestDTO) GetParentID() string {
-	if x != nil && x.ParentID != nil {
-		return *x.ParentID
+func (x *SearchUsersResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateOrganizationRequestDTO) GetFacilityType() string {
-	if x != nil && x.FacilityType != nil {
-		return *x.FacilityType
+func (x *SearchUsersResponseDTO) GetUsers() []*UserProfileDTO {
+	if x != nil {
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateOrganizationRequestDTO) GetAccreditationStatus() string {
-	if x != nil && x.AccreditationStatus != nil {
-		return *x.AccreditationStatus
+func (x *SearchUsersResponseDTO) GetPage() *http_base.PageResponseDTO {
+	if x != nil {
+		return x.Page
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateOrganizationRequestDTO) GetProvinceCity() *structpb.ListValue {
+func (x *SearchUsersResponseDTO) GetHighlights() []*SearchHighlightDTO {
 	if x != nil {
-		return x.ProvinceCity
+		return x.Highlights
 	}
 	return nil
 }
 
-type DeleteOrganizationRequestDTO struct {
+type ChangeUserStatusRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrganizationID *string `protobuf:"bytes,1,opt,name=organizationID,proto3,oneof" json:"-" path:"organizationID" vd:"@:len($)==36; msg:'组织ID格式不正确'"`
+	UserID    *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"-" path:"userID"`
+	NewStatus *int32  `protobuf:"varint,2,opt,name=newStatus,proto3,oneof" form:"new_status" json:"new_status"`
+	Reason    *string `protobuf:"bytes,3,opt,name=reason,proto3,oneof" form:"reason" json:"reason,omitempty"`
 }
 
-func (x *DeleteOrganizationRequestDTO) Reset() {
-	*x = DeleteOrganizationRequestDTO{}
+func (x *ChangeUserStatusRequestDTO) Reset() {
+	*x = ChangeUserStatusRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[33]
+		mi := &file_http_identity_identity_model_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteOrganizationRequestDTO) String() string {
+func (x *ChangeUserStatusRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteOrganizationRequestDTO) ProtoMessage() {}
+func (*ChangeUserStatusRequestDTO) ProtoMessage() {}
 
-func (x *DeleteOrganizationRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[33]
+func (x *ChangeUserStatusRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2635,44 +2929,57 @@ func (x *DeleteOrganizationRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteOrganizationRequestDTO.ProtoReflect.Descriptor instead.
-func (*DeleteOrganizationRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ChangeUserStatusRequestDTO.ProtoReflect.Descriptor instead.
+func (*ChangeUserStatusRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *DeleteOrganizationRequestDTO) GetOrganizationID() string {
-	if x != nil && x.OrganizationID != nil {
-		return *x.OrganizationID
+func (x *ChangeUserStatusRequestDTO) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
 	}
 	return ""
 }
 
-type ListOrganizationsRequestDTO struct {
+func (x *ChangeUserStatusRequestDTO) GetNewStatus() int32 {
+	if x != nil && x.NewStatus != nil {
+		return *x.NewStatus
+	}
+	return 0
+}
+
+func (x *ChangeUserStatusRequestDTO) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type UnlockUserRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ParentID *string                   `protobuf:"bytes,1,opt,name=parentID,proto3,oneof" json:"parentID,omitempty" query:"parent_id"`
-	Page     *http_base.PageRequestDTO `protobuf:"bytes,2,opt,name=page,proto3,oneof" form:"-" json:"-" query:"-"`
+	UserID *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"-" path:"userID"`
 }
 
-func (x *ListOrganizationsRequestDTO) Reset() {
-	*x = ListOrganizationsRequestDTO{}
+func (x *UnlockUserRequestDTO) Reset() {
+	*x = UnlockUserRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[34]
+		mi := &file_http_identity_identity_model_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListOrganizationsRequestDTO) String() string {
+func (x *UnlockUserRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrganizationsRequestDTO) ProtoMessage() {}
+func (*UnlockUserRequestDTO) ProtoMessage() {}
 
-func (x *ListOrganizationsRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[34]
+func (x *UnlockUserRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2683,52 +2990,52 @@ func (x *ListOrganizationsRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrganizationsRequestDTO.ProtoReflect.Descriptor instead.
-func (*ListOrganizationsRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use UnlockUserRequestDTO.ProtoReflect.Descriptor instead.
+func (*UnlockUserRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ListOrganizationsRequestDTO) GetParentID() string {
-	if x != nil && x.ParentID != nil {
-		return *x.ParentID
+func (x *UnlockUserRequestDTO) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
 	}
 	return ""
 }
 
-func (x *ListOrganizationsRequestDTO) GetPage() *http_base.PageRequestDTO {
-	if x != nil {
-		return x.Page
-	}
-	return nil
-}
-
-type ListOrganizationsResponseDTO struct {
+type UserMembershipDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BaseResp      *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
-	Organizations []*OrganizationDTO         `protobuf:"bytes,2,rep,name=organizations,proto3" form:"organizations" json:"organizations,omitempty" query:"organizations"`
-	Page          *http_base.PageResponseDTO `protobuf:"bytes,3,opt,name=page,proto3,oneof" form:"page" json:"page,omitempty" query:"page"`
+	Id             *string          `protobuf:"bytes,1,opt,name=id,proto3,oneof" form:"id" json:"id" query:"id"`
+	UserID         *string          `protobuf:"bytes,2,opt,name=userID,proto3,oneof" form:"userID" json:"user_id" query:"userID"`
+	OrganizationID *string          `protobuf:"bytes,3,opt,name=organizationID,proto3,oneof" form:"organizationID" json:"organization_id" query:"organizationID"`
+	DepartmentID   *string          `protobuf:"bytes,4,opt,name=departmentID,proto3,oneof" form:"departmentID" json:"department_id,omitempty" query:"departmentID"`
+	IsPrimary      *bool            `protobuf:"varint,5,opt,name=isPrimary,proto3,oneof" form:"isPrimary" json:"is_primary,omitempty" query:"isPrimary"`
+	CreatedAt      *int64           `protobuf:"varint,6,opt,name=createdAt,proto3,oneof" form:"createdAt" json:"created_at" query:"createdAt"`
+	UpdatedAt      *int64           `protobuf:"varint,7,opt,name=updatedAt,proto3,oneof" form:"updatedAt" json:"updated_at" query:"updatedAt"`
+	Organization   *OrganizationDTO `protobuf:"bytes,8,opt,name=organization,proto3,oneof" form:"organization" json:"organization,omitempty" query:"organization"`
+	Department     *DepartmentDTO   `protobuf:"bytes,9,opt,name=department,proto3,oneof" form:"department" json:"department,omitempty" query:"department"`
+	Version        *int64           `protobuf:"varint,10,opt,name=version,proto3,oneof" form:"version" json:"version" query:"version"`
 }
 
-func (x *ListOrganizationsResponseDTO) Reset() {
-	*x = ListOrganizationsResponseDTO{}
+func (x *UserMembershipDTO) Reset() {
+	*x = UserMembershipDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[35]
+		mi := &file_http_identity_identity_model_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListOrganizationsResponseDTO) String() string {
+func (x *UserMembershipDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrganizationsResponseDTO) ProtoMessage() {}
+func (*UserMembershipDTO) ProtoMessage() {}
 
-func (x *ListOrganizationsResponseDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[35]
+func (x *UserMembershipDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2739,66 +3046,116 @@ func (x *ListOrganizationsResponseDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrganizationsResponseDTO.ProtoReflect.Descriptor instead.
-func (*ListOrganizationsResponseDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use UserMembershipDTO.ProtoReflect.Descriptor instead.
+func (*UserMembershipDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ListOrganizationsResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
-	if x != nil {
-		return x.BaseResp
+func (x *UserMembershipDTO) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ListOrganizationsResponseDTO) GetOrganizations() []*OrganizationDTO {
+func (x *UserMembershipDTO) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *UserMembershipDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *UserMembershipDTO) GetDepartmentID() string {
+	if x != nil && x.DepartmentID != nil {
+		return *x.DepartmentID
+	}
+	return ""
+}
+
+func (x *UserMembershipDTO) GetIsPrimary() bool {
+	if x != nil && x.IsPrimary != nil {
+		return *x.IsPrimary
+	}
+	return false
+}
+
+func (x *UserMembershipDTO) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *UserMembershipDTO) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *UserMembershipDTO) GetOrganization() *OrganizationDTO {
 	if x != nil {
-		return x.Organizations
+		return x.Organization
 	}
 	return nil
 }
 
-func (x *ListOrganizationsResponseDTO) GetPage() *http_base.PageResponseDTO {
+func (x *UserMembershipDTO) GetDepartment() *DepartmentDTO {
 	if x != nil {
-		return x.Page
+		return x.Department
 	}
 	return nil
 }
 
-type DepartmentDTO struct {
+func (x *UserMembershipDTO) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type UserRoleAssignmentDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                 *string          `protobuf:"bytes,1,opt,name=id,proto3,oneof" form:"id" json:"id" query:"id"`
-	Code               *string          `protobuf:"bytes,2,opt,name=code,proto3,oneof" form:"code" json:"code,omitempty" query:"code"`
-	Name               *string          `protobuf:"bytes,3,opt,name=name,proto3,oneof" form:"name" json:"name" query:"name"`
-	OrganizationID     *string          `protobuf:"bytes,4,opt,name=organizationID,proto3,oneof" form:"organizationID" json:"organization_id" query:"organizationID"`
-	DepartmentType     *string          `protobuf:"bytes,5,opt,name=departmentType,proto3,oneof" form:"departmentType" json:"department_type,omitempty" query:"departmentType"`
-	AvailableEquipment []string         `protobuf:"bytes,6,rep,name=availableEquipment,proto3" form:"availableEquipment" json:"available_equipment,omitempty" query:"availableEquipment"`
-	CreatedAt          *int64           `protobuf:"varint,7,opt,name=createdAt,proto3,oneof" form:"createdAt" json:"created_at" query:"createdAt"`
-	UpdatedAt          *int64           `protobuf:"varint,8,opt,name=updatedAt,proto3,oneof" form:"updatedAt" json:"updated_at" query:"updatedAt"`
-	Organization       *OrganizationDTO `protobuf:"bytes,9,opt,name=organization,proto3,oneof" form:"organization" json:"organization,omitempty" query:"organization"`
-	MemberCount        *int32           `protobuf:"varint,10,opt,name=memberCount,proto3,oneof" form:"memberCount" json:"member_count,omitempty" query:"memberCount"`
-}
-
-func (x *DepartmentDTO) Reset() {
-	*x = DepartmentDTO{}
+	Id             *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" form:"id" json:"id" query:"id"`
+	UserID         *string `protobuf:"bytes,2,opt,name=userID,proto3,oneof" form:"userID" json:"user_id" query:"userID"`
+	RoleID         *string `protobuf:"bytes,3,opt,name=roleID,proto3,oneof" form:"roleID" json:"role_id" query:"roleID"`
+	OrganizationID *string `protobuf:"bytes,4,opt,name=organizationID,proto3,oneof" form:"organizationID" json:"organization_id,omitempty" query:"organizationID"`
+	ValidFrom      *int64  `protobuf:"varint,5,opt,name=validFrom,proto3,oneof" form:"validFrom" json:"valid_from,omitempty" query:"validFrom"`
+	ValidUntil     *int64  `protobuf:"varint,6,opt,name=validUntil,proto3,oneof" form:"validUntil" json:"valid_until,omitempty" query:"validUntil"`
+	CreatedBy      *string `protobuf:"bytes,7,opt,name=createdBy,proto3,oneof" form:"createdBy" json:"created_by,omitempty" query:"createdBy"`
+	UpdatedBy      *string `protobuf:"bytes,8,opt,name=updatedBy,proto3,oneof" form:"updatedBy" json:"updated_by,omitempty" query:"updatedBy"`
+	CreatedAt      *int64  `protobuf:"varint,9,opt,name=createdAt,proto3,oneof" form:"createdAt" json:"created_at" query:"createdAt"`
+	UpdatedAt      *int64  `protobuf:"varint,10,opt,name=updatedAt,proto3,oneof" form:"updatedAt" json:"updated_at" query:"updatedAt"`
+	Version        *int64  `protobuf:"varint,11,opt,name=version,proto3,oneof" form:"version" json:"version" query:"version"`
+}
+
+func (x *UserRoleAssignmentDTO) Reset() {
+	*x = UserRoleAssignmentDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[36]
+		mi := &file_http_identity_identity_model_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DepartmentDTO) String() string {
+func (x *UserRoleAssignmentDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepartmentDTO) ProtoMessage() {}
+func (*UserRoleAssignmentDTO) ProtoMessage() {}
 
-func (x *DepartmentDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[36]
+func (x *UserRoleAssignmentDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2809,107 +3166,117 @@ func (x *DepartmentDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepartmentDTO.ProtoReflect.Descriptor instead.
-func (*DepartmentDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use UserRoleAssignmentDTO.ProtoReflect.Descriptor instead.
+func (*UserRoleAssignmentDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *DepartmentDTO) GetId() string {
+func (x *UserRoleAssignmentDTO) GetId() string {
 	if x != nil && x.Id != nil {
 		return *x.Id
 	}
 	return ""
 }
 
-func (x *DepartmentDTO) GetCode() string {
-	if x != nil && x.Code != nil {
-		return *x.Code
+func (x *UserRoleAssignmentDTO) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
 	}
 	return ""
 }
 
-func (x *DepartmentDTO) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *UserRoleAssignmentDTO) GetRoleID() string {
+	if x != nil && x.RoleID != nil {
+		return *x.RoleID
 	}
 	return ""
 }
 
-func (x *DepartmentDTO) GetOrganizationID() string {
+func (x *UserRoleAssignmentDTO) GetOrganizationID() string {
 	if x != nil && x.OrganizationID != nil {
 		return *x.OrganizationID
 	}
 	return ""
 }
 
-func (x *DepartmentDTO) GetDepartmentType() string {
-	if x != nil && x.DepartmentType != nil {
-		return *x.DepartmentType
+func (x *UserRoleAssignmentDTO) GetValidFrom() int64 {
+	if x != nil && x.ValidFrom != nil {
+		return *x.ValidFrom
+	}
+	return 0
+}
+
+func (x *UserRoleAssignmentDTO) GetValidUntil() int64 {
+	if x != nil && x.ValidUntil != nil {
+		return *x.ValidUntil
+	}
+	return 0
+}
+
+func (x *UserRoleAssignmentDTO) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
 	}
 	return ""
 }
 
-func (x *DepartmentDTO) GetAvailableEquipment() []string {
-	if x != nil {
-		return x.AvailableEquipment
+func (x *UserRoleAssignmentDTO) GetUpdatedBy() string {
+	if x != nil && x.UpdatedBy != nil {
+		return *x.UpdatedBy
 	}
-	return nil
+	return ""
 }
 
-func (x *DepartmentDTO) GetCreatedAt() int64 {
+func (x *UserRoleAssignmentDTO) GetCreatedAt() int64 {
 	if x != nil && x.CreatedAt != nil {
 		return *x.CreatedAt
 	}
 	return 0
 }
 
-func (x *DepartmentDTO) GetUpdatedAt() int64 {
+func (x *UserRoleAssignmentDTO) GetUpdatedAt() int64 {
 	if x != nil && x.UpdatedAt != nil {
 		return *x.UpdatedAt
 	}
 	return 0
 }
 
-func (x *DepartmentDTO) GetOrganization() *OrganizationDTO {
-	if x != nil {
-		return x.Organization
-	}
-	return nil
-}
-
-func (x *DepartmentDTO) GetMemberCount() int32 {
-	if x != nil && x.MemberCount != nil {
-		return *x.MemberCount
+func (x *UserRoleAssignmentDTO) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return 0
 }
 
-type DepartmentResponseDTO struct {
+type ListUserRoleAssignmentsRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BaseResp   *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
-	Department *DepartmentDTO             `protobuf:"bytes,2,opt,name=department,proto3,oneof" form:"department" json:"department,omitempty" query:"department"`
+	Page                  *http_base.PageRequestDTO `protobuf:"bytes,1,opt,name=page,proto3,oneof" form:"-" json:"-" query:"-"`
+	UserID                *string                   `protobuf:"bytes,2,opt,name=userID,proto3,oneof" json:"user_id,omitempty" query:"user_id"`
+	RoleID                *string                   `protobuf:"bytes,3,opt,name=roleID,proto3,oneof" json:"role_id,omitempty" query:"role_id"`
+	OrganizationID        *string                   `protobuf:"bytes,4,opt,name=organizationID,proto3,oneof" json:"organization_id,omitempty" query:"organization_id"`
+	ExpiringWithinSeconds *int64                    `protobuf:"varint,5,opt,name=expiringWithinSeconds,proto3,oneof" json:"expiring_within_seconds,omitempty" query:"expiring_within_seconds"`
 }
 
-func (x *DepartmentResponseDTO) Reset() {
-	*x = DepartmentResponseDTO{}
+func (x *ListUserRoleAssignmentsRequestDTO) Reset() {
+	*x = ListUserRoleAssignmentsRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[37]
+		mi := &file_http_identity_identity_model_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DepartmentResponseDTO) String() string {
+func (x *ListUserRoleAssignmentsRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepartmentResponseDTO) ProtoMessage() {}
+func (*ListUserRoleAssignmentsRequestDTO) ProtoMessage() {}
 
-func (x *DepartmentResponseDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[37]
+func (x *ListUserRoleAssignmentsRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2920,52 +3287,73 @@ func (x *DepartmentResponseDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepartmentResponseDTO.ProtoReflect.Descriptor instead.
-func (*DepartmentResponseDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ListUserRoleAssignmentsRequestDTO.ProtoReflect.Descriptor instead.
+func (*ListUserRoleAssignmentsRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *DepartmentResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+func (x *ListUserRoleAssignmentsRequestDTO) GetPage() *http_base.PageRequestDTO {
 	if x != nil {
-		return x.BaseResp
+		return x.Page
 	}
 	return nil
 }
 
-func (x *DepartmentResponseDTO) GetDepartment() *DepartmentDTO {
-	if x != nil {
-		return x.Department
+func (x *ListUserRoleAssignmentsRequestDTO) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
 	}
-	return nil
+	return ""
 }
 
-type CreateDepartmentRequestDTO struct {
+func (x *ListUserRoleAssignmentsRequestDTO) GetRoleID() string {
+	if x != nil && x.RoleID != nil {
+		return *x.RoleID
+	}
+	return ""
+}
+
+func (x *ListUserRoleAssignmentsRequestDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *ListUserRoleAssignmentsRequestDTO) GetExpiringWithinSeconds() int64 {
+	if x != nil && x.ExpiringWithinSeconds != nil {
+		return *x.ExpiringWithinSeconds
+	}
+	return 0
+}
+
+type ListUserRoleAssignmentsResponseDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrganizationID *string `protobuf:"bytes,1,opt,name=organizationID,proto3,oneof" form:"organization_id" json:"organization_id" vd:"@:len($)==36; msg:'组织ID格式不正确'"`
-	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" form:"name" json:"name" vd:"@:len($)>0 && len($)>=2 && len($)<=100; msg:'部门名称长度必须在2-100个字符之间'"`
-	DepartmentType *string `protobuf:"bytes,3,opt,name=departmentType,proto3,oneof" form:"department_type" json:"department_type,omitempty" vd:"@:len($)<=50; msg:'部门类型长度不能超过50个字符'"`
+	BaseResp    *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	Assignments []*UserRoleAssignmentDTO   `protobuf:"bytes,2,rep,name=assignments,proto3" form:"assignments" json:"assignments,omitempty" query:"assignments"`
+	Page        *http_base.PageResponseDTO `protobuf:"bytes,3,opt,name=page,proto3,oneof" form:"page" json:"page,omitempty" query:"page"`
 }
 
-func (x *CreateDepartmentRequestDTO) Reset() {
-	*x = CreateDepartmentRequestDTO{}
+func (x *ListUserRoleAssignmentsResponseDTO) Reset() {
+	*x = ListUserRoleAssignmentsResponseDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[38]
+		mi := &file_http_identity_identity_model_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateDepartmentRequestDTO) String() string {
+func (x *ListUserRoleAssignmentsResponseDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDepartmentRequestDTO) ProtoMessage() {}
+func (*ListUserRoleAssignmentsResponseDTO) ProtoMessage() {}
 
-func (x *CreateDepartmentRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[38]
+func (x *ListUserRoleAssignmentsResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2976,57 +3364,76 @@ func (x *CreateDepartmentRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDepartmentRequestDTO.ProtoReflect.Descriptor instead.
-func (*CreateDepartmentRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ListUserRoleAssignmentsResponseDTO.ProtoReflect.Descriptor instead.
+func (*ListUserRoleAssignmentsResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *CreateDepartmentRequestDTO) GetOrganizationID() string {
-	if x != nil && x.OrganizationID != nil {
-		return *x.OrganizationID
+func (x *ListUserRoleAssignmentsResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateDepartmentRequestDTO) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *ListUserRoleAssignmentsResponseDTO) GetAssignments() []*UserRoleAssignmentDTO {
+	if x != nil {
+		return x.Assignments
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateDepartmentRequestDTO) GetDepartmentType() string {
-	if x != nil && x.DepartmentType != nil {
-		return *x.DepartmentType
+func (x *ListUserRoleAssignmentsResponseDTO) GetPage() *http_base.PageResponseDTO {
+	if x != nil {
+		return x.Page
 	}
-	return ""
+	return nil
 }
 
-type GetDepartmentRequestDTO struct {
+// 敏感角色授权申请：status 1=待审批 2=已通过 3=已驳回 4=已过期
+type RoleGrantRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DepartmentID *string `protobuf:"bytes,1,opt,name=departmentID,proto3,oneof" json:"-" path:"departmentID" vd:"@:len($)==36; msg:'部门ID格式不正确'"`
-}
-
-func (x *GetDepartmentRequestDTO) Reset() {
-	*x = GetDepartmentRequestDTO{}
+	Id              *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" form:"id" json:"id" query:"id"`
+	UserID          *string `protobuf:"bytes,2,opt,name=userID,proto3,oneof" form:"userID" json:"user_id" query:"userID"`
+	RoleID          *string `protobuf:"bytes,3,opt,name=roleID,proto3,oneof" form:"roleID" json:"role_id" query:"roleID"`
+	RoleCode        *string `protobuf:"bytes,4,opt,name=roleCode,proto3,oneof" form:"roleCode" json:"role_code,omitempty" query:"roleCode"`
+	RoleName        *string `protobuf:"bytes,5,opt,name=roleName,proto3,oneof" form:"roleName" json:"role_name,omitempty" query:"roleName"`
+	OrganizationID  *string `protobuf:"bytes,6,opt,name=organizationID,proto3,oneof" form:"organizationID" json:"organization_id,omitempty" query:"organizationID"`
+	ValidFrom       *int64  `protobuf:"varint,7,opt,name=validFrom,proto3,oneof" form:"validFrom" json:"valid_from,omitempty" query:"validFrom"`
+	ValidUntil      *int64  `protobuf:"varint,8,opt,name=validUntil,proto3,oneof" form:"validUntil" json:"valid_until,omitempty" query:"validUntil"`
+	RequestedBy     *string `protobuf:"bytes,9,opt,name=requestedBy,proto3,oneof" form:"requestedBy" json:"requested_by,omitempty" query:"requestedBy"`
+	Reason          *string `protobuf:"bytes,10,opt,name=reason,proto3,oneof" form:"reason" json:"reason,omitempty" query:"reason"`
+	Status          *int32  `protobuf:"varint,11,opt,name=status,proto3,oneof" form:"status" json:"status" query:"status"`
+	DecidedBy       *string `protobuf:"bytes,12,opt,name=decidedBy,proto3,oneof" form:"decidedBy" json:"decided_by,omitempty" query:"decidedBy"`
+	DecidedAt       *int64  `protobuf:"varint,13,opt,name=decidedAt,proto3,oneof" form:"decidedAt" json:"decided_at,omitempty" query:"decidedAt"`
+	DecisionComment *string `protobuf:"bytes,14,opt,name=decisionComment,proto3,oneof" form:"decisionComment" json:"decision_comment,omitempty" query:"decisionComment"`
+	// 超过该时间仍未审批的申请自动过期
+	ExpiresAt *int64 `protobuf:"varint,15,opt,name=expiresAt,proto3,oneof" form:"expiresAt" json:"expires_at" query:"expiresAt"`
+	// 审批通过后生成的角色分配ID
+	AssignmentID *string `protobuf:"bytes,16,opt,name=assignmentID,proto3,oneof" form:"assignmentID" json:"assignment_id,omitempty" query:"assignmentID"`
+	CreatedAt    *int64  `protobuf:"varint,17,opt,name=createdAt,proto3,oneof" form:"createdAt" json:"created_at" query:"createdAt"`
+}
+
+func (x *RoleGrantRequestDTO) Reset() {
+	*x = RoleGrantRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[39]
+		mi := &file_http_identity_identity_model_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDepartmentRequestDTO) String() string {
+func (x *RoleGrantRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDepartmentRequestDTO) ProtoMessage() {}
+func (*RoleGrantRequestDTO) ProtoMessage() {}
 
-func (x *GetDepartmentRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[39]
+func (x *RoleGrantRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3037,154 +3444,159 @@ func (x *GetDepartmentRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDepartmentRequestDTO.ProtoReflect.Descriptor instead.
-func (*GetDepartmentRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use RoleGrantRequestDTO.ProtoReflect.Descriptor instead.
+func (*RoleGrantRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetDepartmentRequestDTO) GetDepartmentID() string {
-	if x != nil && x.DepartmentID != nil {
-		return *x.DepartmentID
+func (x *RoleGrantRequestDTO) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
 	return ""
 }
 
-type UpdateDepartmentRequestDTO struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	DepartmentID   *string `protobuf:"bytes,1,opt,name=departmentID,proto3,oneof" json:"-" path:"departmentID" vd:"@:len($)==36; msg:'部门ID格式不正确'"`
-	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" form:"name" json:"name,omitempty" vd:"@:len($)==0 || (len($)>=2 && len($)<=100); msg:'名称长度必须在2-100个字符之间'"`
-	DepartmentType *string `protobuf:"bytes,3,opt,name=departmentType,proto3,oneof" form:"department_type" json:"department_type,omitempty" vd:"@:len($)<=50; msg:'部门类型长度不能超过50个字符'"`
+func (x *RoleGrantRequestDTO) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
 }
 
-func (x *UpdateDepartmentRequestDTO) Reset() {
-	*x = UpdateDepartmentRequestDTO{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[40]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *RoleGrantRequestDTO) GetRoleID() string {
+	if x != nil && x.RoleID != nil {
+		return *x.RoleID
 	}
+	return ""
 }
 
-func (x *UpdateDepartmentRequestDTO) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RoleGrantRequestDTO) GetRoleCode() string {
+	if x != nil && x.RoleCode != nil {
+		return *x.RoleCode
+	}
+	return ""
 }
 
-func (*UpdateDepartmentRequestDTO) ProtoMessage() {}
+func (x *RoleGrantRequestDTO) GetRoleName() string {
+	if x != nil && x.RoleName != nil {
+		return *x.RoleName
+	}
+	return ""
+}
 
-func (x *UpdateDepartmentRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[40]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *RoleGrantRequestDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpdateDepartmentRequestDTO.ProtoReflect.Descriptor instead.
-func (*UpdateDepartmentRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{40}
+func (x *RoleGrantRequestDTO) GetValidFrom() int64 {
+	if x != nil && x.ValidFrom != nil {
+		return *x.ValidFrom
+	}
+	return 0
 }
 
-func (x *UpdateDepartmentRequestDTO) GetDepartmentID() string {
-	if x != nil && x.DepartmentID != nil {
-		return *x.DepartmentID
+func (x *RoleGrantRequestDTO) GetValidUntil() int64 {
+	if x != nil && x.ValidUntil != nil {
+		return *x.ValidUntil
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateDepartmentRequestDTO) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *RoleGrantRequestDTO) GetRequestedBy() string {
+	if x != nil && x.RequestedBy != nil {
+		return *x.RequestedBy
 	}
 	return ""
 }
 
-func (x *UpdateDepartmentRequestDTO) GetDepartmentType() string {
-	if x != nil && x.DepartmentType != nil {
-		return *x.DepartmentType
+func (x *RoleGrantRequestDTO) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
 	}
 	return ""
 }
 
-type DeleteDepartmentRequestDTO struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	DepartmentID *string `protobuf:"bytes,1,opt,name=departmentID,proto3,oneof" json:"-" path:"departmentID" vd:"@:len($)==36; msg:'部门ID格式不正确'"`
+func (x *RoleGrantRequestDTO) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
 }
 
-func (x *DeleteDepartmentRequestDTO) Reset() {
-	*x = DeleteDepartmentRequestDTO{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[41]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *RoleGrantRequestDTO) GetDecidedBy() string {
+	if x != nil && x.DecidedBy != nil {
+		return *x.DecidedBy
 	}
+	return ""
 }
 
-func (x *DeleteDepartmentRequestDTO) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RoleGrantRequestDTO) GetDecidedAt() int64 {
+	if x != nil && x.DecidedAt != nil {
+		return *x.DecidedAt
+	}
+	return 0
 }
 
-func (*DeleteDepartmentRequestDTO) ProtoMessage() {}
-
-func (x *DeleteDepartmentRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[41]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *RoleGrantRequestDTO) GetDecisionComment() string {
+	if x != nil && x.DecisionComment != nil {
+		return *x.DecisionComment
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteDepartmentRequestDTO.ProtoReflect.Descriptor instead.
-func (*DeleteDepartmentRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{41}
+func (x *RoleGrantRequestDTO) GetExpiresAt() int64 {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return 0
 }
 
-func (x *DeleteDepartmentRequestDTO) GetDepartmentID() string {
-	if x != nil && x.DepartmentID != nil {
-		return *x.DepartmentID
+func (x *RoleGrantRequestDTO) GetAssignmentID() string {
+	if x != nil && x.AssignmentID != nil {
+		return *x.AssignmentID
 	}
 	return ""
 }
 
-type GetOrganizationDepartmentsRequestDTO struct {
+func (x *RoleGrantRequestDTO) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+type ListRoleGrantRequestsRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrganizationID *string                   `protobuf:"bytes,1,opt,name=organizationID,proto3,oneof" json:"-" path:"organizationID" vd:"@:len($)==36; msg:'组织ID格式不正确'"`
-	Page           *http_base.PageRequestDTO `protobuf:"bytes,2,opt,name=page,proto3,oneof" form:"-" json:"-" query:"-"`
+	Page        *http_base.PageRequestDTO `protobuf:"bytes,1,opt,name=page,proto3,oneof" form:"-" json:"-" query:"-"`
+	Status      *int32                    `protobuf:"varint,2,opt,name=status,proto3,oneof" json:"status,omitempty" query:"status" vd:"@:$ == null || ($ >= 0 && $ <= 4); msg:'申请状态必须在0-4之间'"`
+	UserID      *string                   `protobuf:"bytes,3,opt,name=userID,proto3,oneof" json:"user_id,omitempty" query:"user_id"`
+	RoleID      *string                   `protobuf:"bytes,4,opt,name=roleID,proto3,oneof" json:"role_id,omitempty" query:"role_id"`
+	RequestedBy *string                   `protobuf:"bytes,5,opt,name=requestedBy,proto3,oneof" json:"requested_by,omitempty" query:"requested_by"`
 }
 
-func (x *GetOrganizationDepartmentsRequestDTO) Reset() {
-	*x = GetOrganizationDepartmentsRequestDTO{}
+func (x *ListRoleGrantRequestsRequestDTO) Reset() {
+	*x = ListRoleGrantRequestsRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[42]
+		mi := &file_http_identity_identity_model_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetOrganizationDepartmentsRequestDTO) String() string {
+func (x *ListRoleGrantRequestsRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrganizationDepartmentsRequestDTO) ProtoMessage() {}
+func (*ListRoleGrantRequestsRequestDTO) ProtoMessage() {}
 
-func (x *GetOrganizationDepartmentsRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[42]
+func (x *ListRoleGrantRequestsRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3195,52 +3607,73 @@ func (x *GetOrganizationDepartmentsRequestDTO) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrganizationDepartmentsRequestDTO.ProtoReflect.Descriptor instead.
-func (*GetOrganizationDepartmentsRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use ListRoleGrantRequestsRequestDTO.ProtoReflect.Descriptor instead.
+func (*ListRoleGrantRequestsRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetOrganizationDepartmentsRequestDTO) GetOrganizationID() string {
-	if x != nil && x.OrganizationID != nil {
-		return *x.OrganizationID
+func (x *ListRoleGrantRequestsRequestDTO) GetPage() *http_base.PageRequestDTO {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListRoleGrantRequestsRequestDTO) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+func (x *ListRoleGrantRequestsRequestDTO) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
 	}
 	return ""
 }
 
-func (x *GetOrganizationDepartmentsRequestDTO) GetPage() *http_base.PageRequestDTO {
-	if x != nil {
-		return x.Page
+func (x *ListRoleGrantRequestsRequestDTO) GetRoleID() string {
+	if x != nil && x.RoleID != nil {
+		return *x.RoleID
 	}
-	return nil
+	return ""
 }
 
-type GetOrganizationDepartmentsResponseDTO struct {
+func (x *ListRoleGrantRequestsRequestDTO) GetRequestedBy() string {
+	if x != nil && x.RequestedBy != nil {
+		return *x.RequestedBy
+	}
+	return ""
+}
+
+type ListRoleGrantRequestsResponseDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BaseResp    *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
-	Departments []*DepartmentDTO           `protobuf:"bytes,2,rep,name=departments,proto3" form:"departments" json:"departments,omitempty" query:"departments"`
-	Page        *http_base.PageResponseDTO `protobuf:"bytes,3,opt,name=page,proto3,oneof" form:"page" json:"page,omitempty" query:"page"`
+	BaseResp *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	Requests []*RoleGrantRequestDTO     `protobuf:"bytes,2,rep,name=requests,proto3" form:"requests" json:"requests" query:"requests"`
+	Page     *http_base.PageResponseDTO `protobuf:"bytes,3,opt,name=page,proto3,oneof" form:"page" json:"page,omitempty" query:"page"`
 }
 
-func (x *GetOrganizationDepartmentsResponseDTO) Reset() {
-	*x = GetOrganizationDepartmentsResponseDTO{}
+func (x *ListRoleGrantRequestsResponseDTO) Reset() {
+	*x = ListRoleGrantRequestsResponseDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[43]
+		mi := &file_http_identity_identity_model_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetOrganizationDepartmentsResponseDTO) String() string {
+func (x *ListRoleGrantRequestsResponseDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrganizationDepartmentsResponseDTO) ProtoMessage() {}
+func (*ListRoleGrantRequestsResponseDTO) ProtoMessage() {}
 
-func (x *GetOrganizationDepartmentsResponseDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[43]
+func (x *ListRoleGrantRequestsResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3251,68 +3684,57 @@ func (x *GetOrganizationDepartmentsResponseDTO) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrganizationDepartmentsResponseDTO.ProtoReflect.Descriptor instead.
-func (*GetOrganizationDepartmentsResponseDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ListRoleGrantRequestsResponseDTO.ProtoReflect.Descriptor instead.
+func (*ListRoleGrantRequestsResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GetOrganizationDepartmentsResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+func (x *ListRoleGrantRequestsResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
 	if x != nil {
 		return x.BaseResp
 	}
 	return nil
 }
 
-func (x *GetOrganizationDepartmentsResponseDTO) GetDepartments() []*DepartmentDTO {
+func (x *ListRoleGrantRequestsResponseDTO) GetRequests() []*RoleGrantRequestDTO {
 	if x != nil {
-		return x.Departments
+		return x.Requests
 	}
 	return nil
 }
 
-func (x *GetOrganizationDepartmentsResponseDTO) GetPage() *http_base.PageResponseDTO {
+func (x *ListRoleGrantRequestsResponseDTO) GetPage() *http_base.PageResponseDTO {
 	if x != nil {
 		return x.Page
 	}
 	return nil
 }
 
-type OrganizationLogoDTO struct {
+type GetRoleGrantRequestRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                  *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" form:"id" json:"id" query:"id"`
-	Status              *string `protobuf:"bytes,2,opt,name=status,proto3,oneof" form:"status" json:"status" query:"status"`
-	BoundOrganizationID *string `protobuf:"bytes,3,opt,name=boundOrganizationID,proto3,oneof" form:"boundOrganizationID" json:"bound_organization_id,omitempty" query:"boundOrganizationID"`
-	FileID              *string `protobuf:"bytes,4,opt,name=fileID,proto3,oneof" form:"fileID" json:"file_id" query:"fileID"`
-	FileName            *string `protobuf:"bytes,5,opt,name=fileName,proto3,oneof" form:"fileName" json:"file_name" query:"fileName"`
-	FileSize            *int64  `protobuf:"varint,6,opt,name=fileSize,proto3,oneof" form:"fileSize" json:"file_size" query:"fileSize"`
-	MimeType            *string `protobuf:"bytes,7,opt,name=mimeType,proto3,oneof" form:"mimeType" json:"mime_type" query:"mimeType"`
-	ExpiresAt           *int64  `protobuf:"varint,8,opt,name=expiresAt,proto3,oneof" form:"expiresAt" json:"expires_at,omitempty" query:"expiresAt"`
-	DownloadUrl         *string `protobuf:"bytes,9,opt,name=downloadUrl,proto3,oneof" form:"downloadUrl" json:"download_url,omitempty" query:"downloadUrl"`
-	UploadedBy          *string `protobuf:"bytes,10,opt,name=uploadedBy,proto3,oneof" form:"uploadedBy" json:"uploaded_by" query:"uploadedBy"`
-	CreatedAt           *int64  `protobuf:"varint,11,opt,name=createdAt,proto3,oneof" form:"createdAt" json:"created_at" query:"createdAt"`
-	UpdatedAt           *int64  `protobuf:"varint,12,opt,name=updatedAt,proto3,oneof" form:"updatedAt" json:"updated_at" query:"updatedAt"`
+	RequestID *string `protobuf:"bytes,1,opt,name=requestID,proto3,oneof" json:"-" path:"requestID" vd:"@:len($)==36; msg:'申请ID格式不正确'"`
 }
 
-func (x *OrganizationLogoDTO) Reset() {
-	*x = OrganizationLogoDTO{}
+func (x *GetRoleGrantRequestRequestDTO) Reset() {
+	*x = GetRoleGrantRequestRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[44]
+		mi := &file_http_identity_identity_model_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OrganizationLogoDTO) String() string {
+func (x *GetRoleGrantRequestRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrganizationLogoDTO) ProtoMessage() {}
+func (*GetRoleGrantRequestRequestDTO) ProtoMessage() {}
 
-func (x *OrganizationLogoDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[44]
+func (x *GetRoleGrantRequestRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3323,121 +3745,100 @@ func (x *OrganizationLogoDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrganizationLogoDTO.ProtoReflect.Descriptor instead.
-func (*OrganizationLogoDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use GetRoleGrantRequestRequestDTO.ProtoReflect.Descriptor instead.
+func (*GetRoleGrantRequestRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *OrganizationLogoDTO) GetId() string {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *GetRoleGrantRequestRequestDTO) GetRequestID() string {
+	if x != nil && x.RequestID != nil {
+		return *x.RequestID
 	}
 	return ""
 }
 
-func (x *OrganizationLogoDTO) GetStatus() string {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return ""
-}
+// 审批或驳回授权申请，审批人取自当前登录用户
+type DecideRoleGrantRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *OrganizationLogoDTO) GetBoundOrganizationID() string {
-	if x != nil && x.BoundOrganizationID != nil {
-		return *x.BoundOrganizationID
-	}
-	return ""
+	RequestID *string `protobuf:"bytes,1,opt,name=requestID,proto3,oneof" json:"-" path:"requestID" vd:"@:len($)==36; msg:'申请ID格式不正确'"`
+	Comment   *string `protobuf:"bytes,2,opt,name=comment,proto3,oneof" form:"comment" json:"comment,omitempty"`
 }
 
-func (x *OrganizationLogoDTO) GetFileID() string {
-	if x != nil && x.FileID != nil {
-		return *x.FileID
+func (x *DecideRoleGrantRequestDTO) Reset() {
+	*x = DecideRoleGrantRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *OrganizationLogoDTO) GetFileName() string {
-	if x != nil && x.FileName != nil {
-		return *x.FileName
-	}
-	return ""
+func (x *DecideRoleGrantRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *OrganizationLogoDTO) GetFileSize() int64 {
-	if x != nil && x.FileSize != nil {
-		return *x.FileSize
-	}
-	return 0
-}
+func (*DecideRoleGrantRequestDTO) ProtoMessage() {}
 
-func (x *OrganizationLogoDTO) GetMimeType() string {
-	if x != nil && x.MimeType != nil {
-		return *x.MimeType
+func (x *DecideRoleGrantRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *OrganizationLogoDTO) GetExpiresAt() int64 {
-	if x != nil && x.ExpiresAt != nil {
-		return *x.ExpiresAt
-	}
-	return 0
+// Deprecated: Use DecideRoleGrantRequestDTO.ProtoReflect.Descriptor instead.
+func (*DecideRoleGrantRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *OrganizationLogoDTO) GetDownloadUrl() string {
-	if x != nil && x.DownloadUrl != nil {
-		return *x.DownloadUrl
+func (x *DecideRoleGrantRequestDTO) GetRequestID() string {
+	if x != nil && x.RequestID != nil {
+		return *x.RequestID
 	}
 	return ""
 }
 
-func (x *OrganizationLogoDTO) GetUploadedBy() string {
-	if x != nil && x.UploadedBy != nil {
-		return *x.UploadedBy
+func (x *DecideRoleGrantRequestDTO) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
 	}
 	return ""
 }
 
-func (x *OrganizationLogoDTO) GetCreatedAt() int64 {
-	if x != nil && x.CreatedAt != nil {
-		return *x.CreatedAt
-	}
-	return 0
-}
-
-func (x *OrganizationLogoDTO) GetUpdatedAt() int64 {
-	if x != nil && x.UpdatedAt != nil {
-		return *x.UpdatedAt
-	}
-	return 0
-}
-
-type OrganizationLogoResponseDTO struct {
+type RoleGrantRequestResponseDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	BaseResp *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
-	Logo     *OrganizationLogoDTO       `protobuf:"bytes,2,opt,name=logo,proto3,oneof" form:"logo" json:"logo,omitempty" query:"logo"`
+	Request  *RoleGrantRequestDTO       `protobuf:"bytes,2,opt,name=request,proto3,oneof" form:"request" json:"request,omitempty" query:"request"`
 }
 
-func (x *OrganizationLogoResponseDTO) Reset() {
-	*x = OrganizationLogoResponseDTO{}
+func (x *RoleGrantRequestResponseDTO) Reset() {
+	*x = RoleGrantRequestResponseDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[45]
+		mi := &file_http_identity_identity_model_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OrganizationLogoResponseDTO) String() string {
+func (x *RoleGrantRequestResponseDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrganizationLogoResponseDTO) ProtoMessage() {}
+func (*RoleGrantRequestResponseDTO) ProtoMessage() {}
 
-func (x *OrganizationLogoResponseDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[45]
+func (x *RoleGrantRequestResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3448,52 +3849,56 @@ func (x *OrganizationLogoResponseDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrganizationLogoResponseDTO.ProtoReflect.Descriptor instead.
-func (*OrganizationLogoResponseDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use RoleGrantRequestResponseDTO.ProtoReflect.Descriptor instead.
+func (*RoleGrantRequestResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *OrganizationLogoResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+func (x *RoleGrantRequestResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
 	if x != nil {
 		return x.BaseResp
 	}
 	return nil
 }
 
-func (x *OrganizationLogoResponseDTO) GetLogo() *OrganizationLogoDTO {
+func (x *RoleGrantRequestResponseDTO) GetRequest() *RoleGrantRequestDTO {
 	if x != nil {
-		return x.Logo
+		return x.Request
 	}
 	return nil
 }
 
-type UploadTemporaryLogoRequestDTO struct {
+// 访问复核完成情况
+type AccessReviewStatsDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FileName    *string `protobuf:"bytes,1,opt,name=fileName,proto3,oneof" form:"file_name" json:"file_name" vd:"@:len($)>0; msg:'文件名不能为空'"`
-	FileContent []byte  `protobuf:"bytes,2,opt,name=fileContent,proto3,oneof" form:"file_content" json:"file_content"`
-	MimeType    *string `protobuf:"bytes,3,opt,name=mimeType,proto3,oneof" form:"mime_type" json:"mime_type,omitempty"`
+	Total             *int32 `protobuf:"varint,1,opt,name=total,proto3,oneof" form:"total" json:"total" query:"total"`
+	Pending           *int32 `protobuf:"varint,2,opt,name=pending,proto3,oneof" form:"pending" json:"pending" query:"pending"`
+	Certified         *int32 `protobuf:"varint,3,opt,name=certified,proto3,oneof" form:"certified" json:"certified" query:"certified"`
+	Revoked           *int32 `protobuf:"varint,4,opt,name=revoked,proto3,oneof" form:"revoked" json:"revoked" query:"revoked"`
+	AutoRevoked       *int32 `protobuf:"varint,5,opt,name=autoRevoked,proto3,oneof" form:"autoRevoked" json:"auto_revoked" query:"autoRevoked"`
+	CompletionPercent *int32 `protobuf:"varint,6,opt,name=completionPercent,proto3,oneof" form:"completionPercent" json:"completion_percent" query:"completionPercent"`
 }
 
-func (x *UploadTemporaryLogoRequestDTO) Reset() {
-	*x = UploadTemporaryLogoRequestDTO{}
+func (x *AccessReviewStatsDTO) Reset() {
+	*x = AccessReviewStatsDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[46]
+		mi := &file_http_identity_identity_model_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadTemporaryLogoRequestDTO) String() string {
+func (x *AccessReviewStatsDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadTemporaryLogoRequestDTO) ProtoMessage() {}
+func (*AccessReviewStatsDTO) ProtoMessage() {}
 
-func (x *UploadTemporaryLogoRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[46]
+func (x *AccessReviewStatsDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3504,57 +3909,93 @@ func (x *UploadTemporaryLogoRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadTemporaryLogoRequestDTO.ProtoReflect.Descriptor instead.
-func (*UploadTemporaryLogoRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use AccessReviewStatsDTO.ProtoReflect.Descriptor instead.
+func (*AccessReviewStatsDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *UploadTemporaryLogoRequestDTO) GetFileName() string {
-	if x != nil && x.FileName != nil {
-		return *x.FileName
+func (x *AccessReviewStatsDTO) GetTotal() int32 {
+	if x != nil && x.Total != nil {
+		return *x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *UploadTemporaryLogoRequestDTO) GetFileContent() []byte {
-	if x != nil {
-		return x.FileContent
+func (x *AccessReviewStatsDTO) GetPending() int32 {
+	if x != nil && x.Pending != nil {
+		return *x.Pending
 	}
-	return nil
+	return 0
 }
 
-func (x *UploadTemporaryLogoRequestDTO) GetMimeType() string {
-	if x != nil && x.MimeType != nil {
-		return *x.MimeType
+func (x *AccessReviewStatsDTO) GetCertified() int32 {
+	if x != nil && x.Certified != nil {
+		return *x.Certified
 	}
-	return ""
+	return 0
 }
 
-type GetOrganizationLogoRequestDTO struct {
+func (x *AccessReviewStatsDTO) GetRevoked() int32 {
+	if x != nil && x.Revoked != nil {
+		return *x.Revoked
+	}
+	return 0
+}
+
+func (x *AccessReviewStatsDTO) GetAutoRevoked() int32 {
+	if x != nil && x.AutoRevoked != nil {
+		return *x.AutoRevoked
+	}
+	return 0
+}
+
+func (x *AccessReviewStatsDTO) GetCompletionPercent() int32 {
+	if x != nil && x.CompletionPercent != nil {
+		return *x.CompletionPercent
+	}
+	return 0
+}
+
+// 访问复核活动：status 1=进行中 2=已关闭
+type AccessReviewCampaignDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LogoID *string `protobuf:"bytes,1,opt,name=logoID,proto3,oneof" json:"-" path:"logoID" vd:"@:len($)==36; msg:'Logo ID格式不正确'"`
-}
-
-func (x *GetOrganizationLogoRequestDTO) Reset() {
-	*x = GetOrganizationLogoRequestDTO{}
+	Id             *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" form:"id" json:"id" query:"id"`
+	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" form:"name" json:"name" query:"name"`
+	Description    *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" form:"description" json:"description,omitempty" query:"description"`
+	OrganizationID *string `protobuf:"bytes,4,opt,name=organizationID,proto3,oneof" form:"organizationID" json:"organization_id,omitempty" query:"organizationID"`
+	RoleID         *string `protobuf:"bytes,5,opt,name=roleID,proto3,oneof" form:"roleID" json:"role_id,omitempty" query:"roleID"`
+	ReviewerID     *string `protobuf:"bytes,6,opt,name=reviewerID,proto3,oneof" form:"reviewerID" json:"reviewer_id,omitempty" query:"reviewerID"`
+	Status         *int32  `protobuf:"varint,7,opt,name=status,proto3,oneof" form:"status" json:"status" query:"status"`
+	DueAt          *int64  `protobuf:"varint,8,opt,name=dueAt,proto3,oneof" form:"dueAt" json:"due_at,omitempty" query:"dueAt"`
+	AutoRevoke     *bool   `protobuf:"varint,9,opt,name=autoRevoke,proto3,oneof" form:"autoRevoke" json:"auto_revoke" query:"autoRevoke"`
+	CreatedBy      *string `protobuf:"bytes,10,opt,name=createdBy,proto3,oneof" form:"createdBy" json:"created_by,omitempty" query:"createdBy"`
+	// 为空表示到期自动关闭
+	ClosedBy  *string               `protobuf:"bytes,11,opt,name=closedBy,proto3,oneof" form:"closedBy" json:"closed_by,omitempty" query:"closedBy"`
+	ClosedAt  *int64                `protobuf:"varint,12,opt,name=closedAt,proto3,oneof" form:"closedAt" json:"closed_at,omitempty" query:"closedAt"`
+	CreatedAt *int64                `protobuf:"varint,13,opt,name=createdAt,proto3,oneof" form:"createdAt" json:"created_at" query:"createdAt"`
+	Stats     *AccessReviewStatsDTO `protobuf:"bytes,14,opt,name=stats,proto3,oneof" form:"stats" json:"stats,omitempty" query:"stats"`
+}
+
+func (x *AccessReviewCampaignDTO) Reset() {
+	*x = AccessReviewCampaignDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[47]
+		mi := &file_http_identity_identity_model_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetOrganizationLogoRequestDTO) String() string {
+func (x *AccessReviewCampaignDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrganizationLogoRequestDTO) ProtoMessage() {}
+func (*AccessReviewCampaignDTO) ProtoMessage() {}
 
-func (x *GetOrganizationLogoRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[47]
+func (x *AccessReviewCampaignDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3565,160 +4006,149 @@ func (x *GetOrganizationLogoRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrganizationLogoRequestDTO.ProtoReflect.Descriptor instead.
-func (*GetOrganizationLogoRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use AccessReviewCampaignDTO.ProtoReflect.Descriptor instead.
+func (*AccessReviewCampaignDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetOrganizationLogoRequestDTO) GetLogoID() string {
-	if x != nil && x.LogoID != nil {
-		return *x.LogoID
+func (x *AccessReviewCampaignDTO) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
 	return ""
 }
 
-type DeleteOrganizationLogoRequestDTO struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	LogoID *string `protobuf:"bytes,1,opt,name=logoID,proto3,oneof" json:"-" path:"logoID" vd:"@:len($)==36; msg:'Logo ID格式不正确'"`
+func (x *AccessReviewCampaignDTO) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
 }
 
-func (x *DeleteOrganizationLogoRequestDTO) Reset() {
-	*x = DeleteOrganizationLogoRequestDTO{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[48]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AccessReviewCampaignDTO) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
+	return ""
 }
 
-func (x *DeleteOrganizationLogoRequestDTO) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteOrganizationLogoRequestDTO) ProtoMessage() {}
-
-func (x *DeleteOrganizationLogoRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[48]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AccessReviewCampaignDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteOrganizationLogoRequestDTO.ProtoReflect.Descriptor instead.
-func (*DeleteOrganizationLogoRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{48}
+func (x *AccessReviewCampaignDTO) GetRoleID() string {
+	if x != nil && x.RoleID != nil {
+		return *x.RoleID
+	}
+	return ""
 }
 
-func (x *DeleteOrganizationLogoRequestDTO) GetLogoID() string {
-	if x != nil && x.LogoID != nil {
-		return *x.LogoID
+func (x *AccessReviewCampaignDTO) GetReviewerID() string {
+	if x != nil && x.ReviewerID != nil {
+		return *x.ReviewerID
 	}
 	return ""
 }
 
-type BindLogoToOrganizationRequestDTO struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	OrganizationID *string `protobuf:"bytes,1,opt,name=organizationID,proto3,oneof" json:"-" path:"organizationID" vd:"@:len($)==36; msg:'组织ID格式不正确'"`
-	LogoID         *string `protobuf:"bytes,2,opt,name=logoID,proto3,oneof" form:"logo_id" json:"logo_id" vd:"@:len($)==36; msg:'Logo ID格式不正确'"`
+func (x *AccessReviewCampaignDTO) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
 }
 
-func (x *BindLogoToOrganizationRequestDTO) Reset() {
-	*x = BindLogoToOrganizationRequestDTO{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[49]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AccessReviewCampaignDTO) GetDueAt() int64 {
+	if x != nil && x.DueAt != nil {
+		return *x.DueAt
 	}
+	return 0
 }
 
-func (x *BindLogoToOrganizationRequestDTO) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AccessReviewCampaignDTO) GetAutoRevoke() bool {
+	if x != nil && x.AutoRevoke != nil {
+		return *x.AutoRevoke
+	}
+	return false
 }
 
-func (*BindLogoToOrganizationRequestDTO) ProtoMessage() {}
+func (x *AccessReviewCampaignDTO) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
+}
 
-func (x *BindLogoToOrganizationRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[49]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AccessReviewCampaignDTO) GetClosedBy() string {
+	if x != nil && x.ClosedBy != nil {
+		return *x.ClosedBy
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use BindLogoToOrganizationRequestDTO.ProtoReflect.Descriptor instead.
-func (*BindLogoToOrganizationRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{49}
+func (x *AccessReviewCampaignDTO) GetClosedAt() int64 {
+	if x != nil && x.ClosedAt != nil {
+		return *x.ClosedAt
+	}
+	return 0
 }
 
-func (x *BindLogoToOrganizationRequestDTO) GetOrganizationID() string {
-	if x != nil && x.OrganizationID != nil {
-		return *x.OrganizationID
+func (x *AccessReviewCampaignDTO) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
 	}
-	return ""
+	return 0
 }
 
-func (x *BindLogoToOrganizationRequestDTO) GetLogoID() string {
-	if x != nil && x.LogoID != nil {
-		return *x.LogoID
+func (x *AccessReviewCampaignDTO) GetStats() *AccessReviewStatsDTO {
+	if x != nil {
+		return x.Stats
 	}
-	return ""
+	return nil
 }
 
-type AuditLogDTO struct {
+// 访问复核条目：decision 1=待复核 2=确认保留 3=撤销 4=自动撤销
+type AccessReviewItemDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id             *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" form:"id" json:"id" query:"id"`
-	RequestID      *string `protobuf:"bytes,2,opt,name=requestID,proto3,oneof" form:"requestID" json:"request_id" query:"requestID"`
-	TraceID        *string `protobuf:"bytes,3,opt,name=traceID,proto3,oneof" form:"traceID" json:"trace_id" query:"traceID"`
-	UserID         *string `protobuf:"bytes,4,opt,name=userID,proto3,oneof" form:"userID" json:"user_id,omitempty" query:"userID"`
+	CampaignID     *string `protobuf:"bytes,2,opt,name=campaignID,proto3,oneof" form:"campaignID" json:"campaign_id" query:"campaignID"`
+	AssignmentID   *string `protobuf:"bytes,3,opt,name=assignmentID,proto3,oneof" form:"assignmentID" json:"assignment_id" query:"assignmentID"`
+	UserID         *string `protobuf:"bytes,4,opt,name=userID,proto3,oneof" form:"userID" json:"user_id" query:"userID"`
 	Username       *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" form:"username" json:"username,omitempty" query:"username"`
-	OrganizationID *string `protobuf:"bytes,6,opt,name=organizationID,proto3,oneof" form:"organizationID" json:"organization_id,omitempty" query:"organizationID"`
-	Action         *int32  `protobuf:"varint,7,opt,name=action,proto3,oneof" form:"action" json:"action" query:"action"`
-	Resource       *string `protobuf:"bytes,8,opt,name=resource,proto3,oneof" form:"resource" json:"resource" query:"resource"`
-	ResourceID     *string `protobuf:"bytes,9,opt,name=resourceID,proto3,oneof" form:"resourceID" json:"resource_id,omitempty" query:"resourceID"`
-	StatusCode     *int32  `protobuf:"varint,10,opt,name=statusCode,proto3,oneof" form:"statusCode" json:"status_code" query:"statusCode"`
-	Success        *bool   `protobuf:"varint,11,opt,name=success,proto3,oneof" form:"success" json:"success" query:"success"`
-	ClientIP       *string `protobuf:"bytes,12,opt,name=clientIP,proto3,oneof" form:"clientIP" json:"client_ip" query:"clientIP"`
-	UserAgent      *string `protobuf:"bytes,13,opt,name=userAgent,proto3,oneof" form:"userAgent" json:"user_agent,omitempty" query:"userAgent"`
-	RequestBody    *string `protobuf:"bytes,14,opt,name=requestBody,proto3,oneof" form:"requestBody" json:"request_body,omitempty" query:"requestBody"`
-	DurationMs     *int32  `protobuf:"varint,15,opt,name=durationMs,proto3,oneof" form:"durationMs" json:"duration_ms" query:"durationMs"`
-	CreatedAt      *int64  `protobuf:"varint,16,opt,name=createdAt,proto3,oneof" form:"createdAt" json:"created_at" query:"createdAt"`
-}
-
-func (x *AuditLogDTO) Reset() {
-	*x = AuditLogDTO{}
+	RealName       *string `protobuf:"bytes,6,opt,name=realName,proto3,oneof" form:"realName" json:"real_name,omitempty" query:"realName"`
+	RoleID         *string `protobuf:"bytes,7,opt,name=roleID,proto3,oneof" form:"roleID" json:"role_id" query:"roleID"`
+	RoleCode       *string `protobuf:"bytes,8,opt,name=roleCode,proto3,oneof" form:"roleCode" json:"role_code,omitempty" query:"roleCode"`
+	RoleName       *string `protobuf:"bytes,9,opt,name=roleName,proto3,oneof" form:"roleName" json:"role_name,omitempty" query:"roleName"`
+	OrganizationID *string `protobuf:"bytes,10,opt,name=organizationID,proto3,oneof" form:"organizationID" json:"organization_id,omitempty" query:"organizationID"`
+	ValidUntil     *int64  `protobuf:"varint,11,opt,name=validUntil,proto3,oneof" form:"validUntil" json:"valid_until,omitempty" query:"validUntil"`
+	Decision       *int32  `protobuf:"varint,12,opt,name=decision,proto3,oneof" form:"decision" json:"decision" query:"decision"`
+	ReviewedBy     *string `protobuf:"bytes,13,opt,name=reviewedBy,proto3,oneof" form:"reviewedBy" json:"reviewed_by,omitempty" query:"reviewedBy"`
+	ReviewedAt     *int64  `protobuf:"varint,14,opt,name=reviewedAt,proto3,oneof" form:"reviewedAt" json:"reviewed_at,omitempty" query:"reviewedAt"`
+	Comment        *string `protobuf:"bytes,15,opt,name=comment,proto3,oneof" form:"comment" json:"comment,omitempty" query:"comment"`
+}
+
+func (x *AccessReviewItemDTO) Reset() {
+	*x = AccessReviewItemDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[50]
+		mi := &file_http_identity_identity_model_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuditLogDTO) String() string {
+func (x *AccessReviewItemDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuditLogDTO) ProtoMessage() {}
+func (*AccessReviewItemDTO) ProtoMessage() {}
 
-func (x *AuditLogDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[50]
+func (x *AccessReviewItemDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3729,154 +4159,150 @@ func (x *AuditLogDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuditLogDTO.ProtoReflect.Descriptor instead.
-func (*AuditLogDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use AccessReviewItemDTO.ProtoReflect.Descriptor instead.
+func (*AccessReviewItemDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *AuditLogDTO) GetId() string {
+func (x *AccessReviewItemDTO) GetId() string {
 	if x != nil && x.Id != nil {
 		return *x.Id
 	}
 	return ""
 }
 
-func (x *AuditLogDTO) GetRequestID() string {
-	if x != nil && x.RequestID != nil {
-		return *x.RequestID
+func (x *AccessReviewItemDTO) GetCampaignID() string {
+	if x != nil && x.CampaignID != nil {
+		return *x.CampaignID
 	}
 	return ""
 }
 
-func (x *AuditLogDTO) GetTraceID() string {
-	if x != nil && x.TraceID != nil {
-		return *x.TraceID
+func (x *AccessReviewItemDTO) GetAssignmentID() string {
+	if x != nil && x.AssignmentID != nil {
+		return *x.AssignmentID
 	}
 	return ""
 }
 
-func (x *AuditLogDTO) GetUserID() string {
+func (x *AccessReviewItemDTO) GetUserID() string {
 	if x != nil && x.UserID != nil {
 		return *x.UserID
 	}
 	return ""
 }
 
-func (x *AuditLogDTO) GetUsername() string {
+func (x *AccessReviewItemDTO) GetUsername() string {
 	if x != nil && x.Username != nil {
 		return *x.Username
 	}
 	return ""
 }
 
-func (x *AuditLogDTO) GetOrganizationID() string {
-	if x != nil && x.OrganizationID != nil {
-		return *x.OrganizationID
+func (x *AccessReviewItemDTO) GetRealName() string {
+	if x != nil && x.RealName != nil {
+		return *x.RealName
 	}
 	return ""
 }
 
-func (x *AuditLogDTO) GetAction() int32 {
-	if x != nil && x.Action != nil {
-		return *x.Action
-	}
-	return 0
-}
-
-func (x *AuditLogDTO) GetResource() string {
-	if x != nil && x.Resource != nil {
-		return *x.Resource
+func (x *AccessReviewItemDTO) GetRoleID() string {
+	if x != nil && x.RoleID != nil {
+		return *x.RoleID
 	}
 	return ""
 }
 
-func (x *AuditLogDTO) GetResourceID() string {
-	if x != nil && x.ResourceID != nil {
-		return *x.ResourceID
+func (x *AccessReviewItemDTO) GetRoleCode() string {
+	if x != nil && x.RoleCode != nil {
+		return *x.RoleCode
 	}
 	return ""
 }
 
-func (x *AuditLogDTO) GetStatusCode() int32 {
-	if x != nil && x.StatusCode != nil {
-		return *x.StatusCode
+func (x *AccessReviewItemDTO) GetRoleName() string {
+	if x != nil && x.RoleName != nil {
+		return *x.RoleName
 	}
-	return 0
+	return ""
 }
 
-func (x *AuditLogDTO) GetSuccess() bool {
-	if x != nil && x.Success != nil {
-		return *x.Success
+func (x *AccessReviewItemDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
 	}
-	return false
+	return ""
 }
 
-func (x *AuditLogDTO) GetClientIP() string {
-	if x != nil && x.ClientIP != nil {
-		return *x.ClientIP
+func (x *AccessReviewItemDTO) GetValidUntil() int64 {
+	if x != nil && x.ValidUntil != nil {
+		return *x.ValidUntil
 	}
-	return ""
+	return 0
 }
 
-func (x *AuditLogDTO) GetUserAgent() string {
-	if x != nil && x.UserAgent != nil {
-		return *x.UserAgent
+func (x *AccessReviewItemDTO) GetDecision() int32 {
+	if x != nil && x.Decision != nil {
+		return *x.Decision
 	}
-	return ""
+	return 0
 }
 
-func (x *AuditLogDTO) GetRequestBody() string {
-	if x != nil && x.RequestBody != nil {
-		return *x.RequestBody
+func (x *AccessReviewItemDTO) GetReviewedBy() string {
+	if x != nil && x.ReviewedBy != nil {
+		return *x.ReviewedBy
 	}
 	return ""
 }
 
-func (x *AuditLogDTO) GetDurationMs() int32 {
-	if x != nil && x.DurationMs != nil {
-		return *x.DurationMs
+func (x *AccessReviewItemDTO) GetReviewedAt() int64 {
+	if x != nil && x.ReviewedAt != nil {
+		return *x.ReviewedAt
 	}
 	return 0
 }
 
-func (x *AuditLogDTO) GetCreatedAt() int64 {
-	if x != nil && x.CreatedAt != nil {
-		return *x.CreatedAt
+func (x *AccessReviewItemDTO) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
 	}
-	return 0
+	return ""
 }
 
-type ListAuditLogsRequestDTO struct {
+// 创建访问复核活动，创建人取自当前登录用户
+type CreateAccessReviewCampaignRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page      *http_base.PageRequestDTO `protobuf:"bytes,1,opt,name=page,proto3,oneof" form:"-" json:"-" query:"-"`
-	UserID    *string                   `protobuf:"bytes,2,opt,name=userID,proto3,oneof" json:"user_id,omitempty" query:"user_id"`
-	Action    *int32                    `protobuf:"varint,3,opt,name=action,proto3,oneof" json:"action,omitempty" query:"action"`
-	Resource  *string                   `protobuf:"bytes,4,opt,name=resource,proto3,oneof" json:"resource,omitempty" query:"resource"`
-	Success   *bool                     `protobuf:"varint,5,opt,name=success,proto3,oneof" json:"success,omitempty" query:"success"`
-	StartTime *int64                    `protobuf:"varint,6,opt,name=startTime,proto3,oneof" json:"start_time,omitempty" query:"start_time"`
-	EndTime   *int64                    `protobuf:"varint,7,opt,name=endTime,proto3,oneof" json:"end_time,omitempty" query:"end_time"`
+	Name        *string `protobuf:"bytes,1,opt,name=name,proto3,oneof" form:"name" json:"name" vd:"@:len($)>0 && len($)<=100; msg:'活动名称长度必须在1-100个字符之间'"`
+	Description *string `protobuf:"bytes,2,opt,name=description,proto3,oneof" form:"description" json:"description,omitempty"`
+	// 复核范围：仅该组织下的分配，为空表示全部（含全局分配）
+	OrganizationID *string `protobuf:"bytes,3,opt,name=organizationID,proto3,oneof" form:"organization_id" json:"organization_id,omitempty" vd:"@:len($)==0 || len($)==36; msg:'组织ID格式不正确'"`
+	// 复核范围：仅该角色的分配，为空表示全部角色
+	RoleID     *string `protobuf:"bytes,4,opt,name=roleID,proto3,oneof" form:"role_id" json:"role_id,omitempty" vd:"@:len($)==0 || len($)==36; msg:'角色ID格式不正确'"`
+	ReviewerID *string `protobuf:"bytes,5,opt,name=reviewerID,proto3,oneof" form:"reviewer_id" json:"reviewer_id,omitempty" vd:"@:len($)==0 || len($)==36; msg:'复核人ID格式不正确'"`
+	DueAt      *int64  `protobuf:"varint,6,opt,name=dueAt,proto3,oneof" form:"due_at" json:"due_at,omitempty"`
+	AutoRevoke *bool   `protobuf:"varint,7,opt,name=autoRevoke,proto3,oneof" form:"auto_revoke" json:"auto_revoke,omitempty"`
 }
 
-func (x *ListAuditLogsRequestDTO) Reset() {
-	*x = ListAuditLogsRequestDTO{}
+func (x *CreateAccessReviewCampaignRequestDTO) Reset() {
+	*x = CreateAccessReviewCampaignRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[51]
+		mi := &file_http_identity_identity_model_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListAuditLogsRequestDTO) String() string {
+func (x *CreateAccessReviewCampaignRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAuditLogsRequestDTO) ProtoMessage() {}
+func (*CreateAccessReviewCampaignRequestDTO) ProtoMessage() {}
 
-func (x *ListAuditLogsRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[51]
+func (x *CreateAccessReviewCampaignRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3887,87 +4313,87 @@ func (x *ListAuditLogsRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAuditLogsRequestDTO.ProtoReflect.Descriptor instead.
-func (*ListAuditLogsRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use CreateAccessReviewCampaignRequestDTO.ProtoReflect.Descriptor instead.
+func (*CreateAccessReviewCampaignRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ListAuditLogsRequestDTO) GetPage() *http_base.PageRequestDTO {
-	if x != nil {
-		return x.Page
+func (x *CreateAccessReviewCampaignRequestDTO) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ListAuditLogsRequestDTO) GetUserID() string {
-	if x != nil && x.UserID != nil {
-		return *x.UserID
+func (x *CreateAccessReviewCampaignRequestDTO) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
 	return ""
 }
 
-func (x *ListAuditLogsRequestDTO) GetAction() int32 {
-	if x != nil && x.Action != nil {
-		return *x.Action
+func (x *CreateAccessReviewCampaignRequestDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
 	}
-	return 0
+	return ""
 }
 
-func (x *ListAuditLogsRequestDTO) GetResource() string {
-	if x != nil && x.Resource != nil {
-		return *x.Resource
+func (x *CreateAccessReviewCampaignRequestDTO) GetRoleID() string {
+	if x != nil && x.RoleID != nil {
+		return *x.RoleID
 	}
 	return ""
 }
 
-func (x *ListAuditLogsRequestDTO) GetSuccess() bool {
-	if x != nil && x.Success != nil {
-		return *x.Success
+func (x *CreateAccessReviewCampaignRequestDTO) GetReviewerID() string {
+	if x != nil && x.ReviewerID != nil {
+		return *x.ReviewerID
 	}
-	return false
+	return ""
 }
 
-func (x *ListAuditLogsRequestDTO) GetStartTime() int64 {
-	if x != nil && x.StartTime != nil {
-		return *x.StartTime
+func (x *CreateAccessReviewCampaignRequestDTO) GetDueAt() int64 {
+	if x != nil && x.DueAt != nil {
+		return *x.DueAt
 	}
 	return 0
 }
 
-func (x *ListAuditLogsRequestDTO) GetEndTime() int64 {
-	if x != nil && x.EndTime != nil {
-		return *x.EndTime
+func (x *CreateAccessReviewCampaignRequestDTO) GetAutoRevoke() bool {
+	if x != nil && x.AutoRevoke != nil {
+		return *x.AutoRevoke
 	}
-	return 0
+	return false
 }
 
-type AuditLogStatsDTO struct {
+type ListAccessReviewCampaignsRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalCount    *int64 `protobuf:"varint,1,opt,name=totalCount,proto3,oneof" form:"totalCount" json:"total_count" query:"totalCount"`
-	SuccessCount  *int64 `protobuf:"varint,2,opt,name=successCount,proto3,oneof" form:"successCount" json:"success_count" query:"successCount"`
-	AvgDurationMs *int32 `protobuf:"varint,3,opt,name=avgDurationMs,proto3,oneof" form:"avgDurationMs" json:"avg_duration_ms" query:"avgDurationMs"`
+	Page           *http_base.PageRequestDTO `protobuf:"bytes,1,opt,name=page,proto3,oneof" form:"-" json:"-" query:"-"`
+	Status         *int32                    `protobuf:"varint,2,opt,name=status,proto3,oneof" json:"status,omitempty" query:"status" vd:"@:$ == null || ($ >= 0 && $ <= 2); msg:'活动状态必须在0-2之间'"`
+	OrganizationID *string                   `protobuf:"bytes,3,opt,name=organizationID,proto3,oneof" json:"organization_id,omitempty" query:"organization_id"`
 }
 
-func (x *AuditLogStatsDTO) Reset() {
-	*x = AuditLogStatsDTO{}
+func (x *ListAccessReviewCampaignsRequestDTO) Reset() {
+	*x = ListAccessReviewCampaignsRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[52]
+		mi := &file_http_identity_identity_model_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuditLogStatsDTO) String() string {
+func (x *ListAccessReviewCampaignsRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuditLogStatsDTO) ProtoMessage() {}
+func (*ListAccessReviewCampaignsRequestDTO) ProtoMessage() {}
 
-func (x *AuditLogStatsDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[52]
+func (x *ListAccessReviewCampaignsRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3978,60 +4404,59 @@ func (x *AuditLogStatsDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuditLogStatsDTO.ProtoReflect.Descriptor instead.
-func (*AuditLogStatsDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use ListAccessReviewCampaignsRequestDTO.ProtoReflect.Descriptor instead.
+func (*ListAccessReviewCampaignsRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *AuditLogStatsDTO) GetTotalCount() int64 {
-	if x != nil && x.TotalCount != nil {
-		return *x.TotalCount
+func (x *ListAccessReviewCampaignsRequestDTO) GetPage() *http_base.PageRequestDTO {
+	if x != nil {
+		return x.Page
 	}
-	return 0
+	return nil
 }
 
-func (x *AuditLogStatsDTO) GetSuccessCount() int64 {
-	if x != nil && x.SuccessCount != nil {
-		return *x.SuccessCount
+func (x *ListAccessReviewCampaignsRequestDTO) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
 	}
 	return 0
 }
 
-func (x *AuditLogStatsDTO) GetAvgDurationMs() int32 {
-	if x != nil && x.AvgDurationMs != nil {
-		return *x.AvgDurationMs
+func (x *ListAccessReviewCampaignsRequestDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
 	}
-	return 0
+	return ""
 }
 
-type ListAuditLogsResponseDTO struct {
+type ListAccessReviewCampaignsResponseDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	BaseResp  *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
-	AuditLogs []*AuditLogDTO             `protobuf:"bytes,2,rep,name=auditLogs,proto3" form:"auditLogs" json:"audit_logs,omitempty" query:"auditLogs"`
+	Campaigns []*AccessReviewCampaignDTO `protobuf:"bytes,2,rep,name=campaigns,proto3" form:"campaigns" json:"campaigns" query:"campaigns"`
 	Page      *http_base.PageResponseDTO `protobuf:"bytes,3,opt,name=page,proto3,oneof" form:"page" json:"page,omitempty" query:"page"`
-	Stats     *AuditLogStatsDTO          `protobuf:"bytes,4,opt,name=stats,proto3,oneof" form:"stats" json:"stats,omitempty" query:"stats"`
 }
 
-func (x *ListAuditLogsResponseDTO) Reset() {
-	*x = ListAuditLogsResponseDTO{}
+func (x *ListAccessReviewCampaignsResponseDTO) Reset() {
+	*x = ListAccessReviewCampaignsResponseDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[53]
+		mi := &file_http_identity_identity_model_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListAuditLogsResponseDTO) String() string {
+func (x *ListAccessReviewCampaignsResponseDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAuditLogsResponseDTO) ProtoMessage() {}
+func (*ListAccessReviewCampaignsResponseDTO) ProtoMessage() {}
 
-func (x *ListAuditLogsResponseDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[53]
+func (x *ListAccessReviewCampaignsResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4042,62 +4467,57 @@ func (x *ListAuditLogsResponseDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAuditLogsResponseDTO.ProtoReflect.Descriptor instead.
-func (*ListAuditLogsResponseDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use ListAccessReviewCampaignsResponseDTO.ProtoReflect.Descriptor instead.
+func (*ListAccessReviewCampaignsResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *ListAuditLogsResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+func (x *ListAccessReviewCampaignsResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
 	if x != nil {
 		return x.BaseResp
 	}
 	return nil
 }
 
-func (x *ListAuditLogsResponseDTO) GetAuditLogs() []*AuditLogDTO {
+func (x *ListAccessReviewCampaignsResponseDTO) GetCampaigns() []*AccessReviewCampaignDTO {
 	if x != nil {
-		return x.AuditLogs
+		return x.Campaigns
 	}
 	return nil
 }
 
-func (x *ListAuditLogsResponseDTO) GetPage() *http_base.PageResponseDTO {
+func (x *ListAccessReviewCampaignsResponseDTO) GetPage() *http_base.PageResponseDTO {
 	if x != nil {
 		return x.Page
 	}
 	return nil
 }
 
-func (x *ListAuditLogsResponseDTO) GetStats() *AuditLogStatsDTO {
-	if x != nil {
-		return x.Stats
-	}
-	return nil
-}
-
-type GetMeRequestDTO struct {
+type GetAccessReviewCampaignRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	CampaignID *string `protobuf:"bytes,1,opt,name=campaignID,proto3,oneof" json:"-" path:"campaignID" vd:"@:len($)==36; msg:'活动ID格式不正确'"`
 }
 
-func (x *GetMeRequestDTO) Reset() {
-	*x = GetMeRequestDTO{}
+func (x *GetAccessReviewCampaignRequestDTO) Reset() {
+	*x = GetAccessReviewCampaignRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[54]
+		mi := &file_http_identity_identity_model_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetMeRequestDTO) String() string {
+func (x *GetAccessReviewCampaignRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMeRequestDTO) ProtoMessage() {}
+func (*GetAccessReviewCampaignRequestDTO) ProtoMessage() {}
 
-func (x *GetMeRequestDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[54]
+func (x *GetAccessReviewCampaignRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4108,34 +4528,44 @@ func (x *GetMeRequestDTO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMeRequestDTO.ProtoReflect.Descriptor instead.
-func (*GetMeRequestDTO) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use GetAccessReviewCampaignRequestDTO.ProtoReflect.Descriptor instead.
+func (*GetAccessReviewCampaignRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{56}
 }
 
-type EmptyRequest struct {
+func (x *GetAccessReviewCampaignRequestDTO) GetCampaignID() string {
+	if x != nil && x.CampaignID != nil {
+		return *x.CampaignID
+	}
+	return ""
+}
+
+type AccessReviewCampaignResponseDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	BaseResp *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	Campaign *AccessReviewCampaignDTO   `protobuf:"bytes,2,opt,name=campaign,proto3,oneof" form:"campaign" json:"campaign,omitempty" query:"campaign"`
 }
 
-func (x *EmptyRequest) Reset() {
-	*x = EmptyRequest{}
+func (x *AccessReviewCampaignResponseDTO) Reset() {
+	*x = AccessReviewCampaignResponseDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[55]
+		mi := &file_http_identity_identity_model_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EmptyRequest) String() string {
+func (x *AccessReviewCampaignResponseDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmptyRequest) ProtoMessage() {}
+func (*AccessReviewCampaignResponseDTO) ProtoMessage() {}
 
-func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[55]
+func (x *AccessReviewCampaignResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4146,85 +4576,53 @@ func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmptyRequest.ProtoReflect.Descriptor instead.
-func (*EmptyRequest) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{55}
-}
-
-type EmptyResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Deprecated: Use AccessReviewCampaignResponseDTO.ProtoReflect.Descriptor instead.
+func (*AccessReviewCampaignResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *EmptyResponse) Reset() {
-	*x = EmptyResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[56]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AccessReviewCampaignResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
 	}
+	return nil
 }
 
-func (x *EmptyResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*EmptyResponse) ProtoMessage() {}
-
-func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[56]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AccessReviewCampaignResponseDTO) GetCampaign() *AccessReviewCampaignDTO {
+	if x != nil {
+		return x.Campaign
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use EmptyResponse.ProtoReflect.Descriptor instead.
-func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{56}
+	return nil
 }
 
-type OIDCDiscoveryResponse struct {
+type ListAccessReviewItemsRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Issuer                            *string  `protobuf:"bytes,1,opt,name=issuer,proto3,oneof" form:"issuer" json:"issuer" query:"issuer"`
-	AuthorizationEndpoint             *string  `protobuf:"bytes,2,opt,name=authorization_endpoint,json=authorizationEndpoint,proto3,oneof" form:"authorization_endpoint" json:"authorization_endpoint" query:"authorization_endpoint"`
-	TokenEndpoint                     *string  `protobuf:"bytes,3,opt,name=token_endpoint,json=tokenEndpoint,proto3,oneof" form:"token_endpoint" json:"token_endpoint" query:"token_endpoint"`
-	UserinfoEndpoint                  *string  `protobuf:"bytes,4,opt,name=userinfo_endpoint,json=userinfoEndpoint,proto3,oneof" form:"userinfo_endpoint" json:"userinfo_endpoint" query:"userinfo_endpoint"`
-	RevocationEndpoint                *string  `protobuf:"bytes,5,opt,name=revocation_endpoint,json=revocationEndpoint,proto3,oneof" form:"revocation_endpoint" json:"revocation_endpoint" query:"revocation_endpoint"`
-	IntrospectionEndpoint             *string  `protobuf:"bytes,6,opt,name=introspection_endpoint,json=introspectionEndpoint,proto3,oneof" form:"introspection_endpoint" json:"introspection_endpoint" query:"introspection_endpoint"`
-	JwksUri                           *string  `protobuf:"bytes,7,opt,name=jwks_uri,json=jwksUri,proto3,oneof" form:"jwks_uri" json:"jwks_uri" query:"jwks_uri"`
-	ResponseTypesSupported            []string `protobuf:"bytes,8,rep,name=response_types_supported,json=responseTypesSupported,proto3" form:"response_types_supported" json:"response_types_supported" query:"response_types_supported"`
-	SubjectTypesSupported             []string `protobuf:"bytes,9,rep,name=subject_types_supported,json=subjectTypesSupported,proto3" form:"subject_types_supported" json:"subject_types_supported" query:"subject_types_supported"`
-	IdTokenSigningAlgValuesSupported  []string `protobuf:"bytes,10,rep,name=id_token_signing_alg_values_supported,json=idTokenSigningAlgValuesSupported,proto3" form:"id_token_signing_alg_values_supported" json:"id_token_signing_alg_values_supported" query:"id_token_signing_alg_values_supported"`
-	ScopesSupported                   []string `protobuf:"bytes,11,rep,name=scopes_supported,json=scopesSupported,proto3" form:"scopes_supported" json:"scopes_supported" query:"scopes_supported"`
-	TokenEndpointAuthMethodsSupported []string `protobuf:"bytes,12,rep,name=token_endpoint_auth_methods_supported,json=tokenEndpointAuthMethodsSupported,proto3" form:"token_endpoint_auth_methods_supported" json:"token_endpoint_auth_methods_supported" query:"token_endpoint_auth_methods_supported"`
+	CampaignID *string                   `protobuf:"bytes,1,opt,name=campaignID,proto3,oneof" json:"-" path:"campaignID" vd:"@:len($)==36; msg:'活动ID格式不正确'"`
+	Page       *http_base.PageRequestDTO `protobuf:"bytes,2,opt,name=page,proto3,oneof" form:"-" json:"-" query:"-"`
+	Decision   *int32                    `protobuf:"varint,3,opt,name=decision,proto3,oneof" json:"decision,omitempty" query:"decision" vd:"@:$ == null || ($ >= 0 && $ <= 4); msg:'复核结论必须在0-4之间'"`
+	UserID     *string                   `protobuf:"bytes,4,opt,name=userID,proto3,oneof" json:"user_id,omitempty" query:"user_id"`
 }
 
-func (x *OIDCDiscoveryResponse) Reset() {
-	*x = OIDCDiscoveryResponse{}
+func (x *ListAccessReviewItemsRequestDTO) Reset() {
+	*x = ListAccessReviewItemsRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[57]
+		mi := &file_http_identity_identity_model_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OIDCDiscoveryResponse) String() string {
+func (x *ListAccessReviewItemsRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OIDCDiscoveryResponse) ProtoMessage() {}
+func (*ListAccessReviewItemsRequestDTO) ProtoMessage() {}
 
-func (x *OIDCDiscoveryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[57]
+func (x *ListAccessReviewItemsRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4235,120 +4633,130 @@ func (x *OIDCDiscoveryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OIDCDiscoveryResponse.ProtoReflect.Descriptor instead.
-func (*OIDCDiscoveryResponse) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use ListAccessReviewItemsRequestDTO.ProtoReflect.Descriptor instead.
+func (*ListAccessReviewItemsRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *OIDCDiscoveryResponse) GetIssuer() string {
-	if x != nil && x.Issuer != nil {
-		return *x.Issuer
+func (x *ListAccessReviewItemsRequestDTO) GetCampaignID() string {
+	if x != nil && x.CampaignID != nil {
+		return *x.CampaignID
 	}
 	return ""
 }
 
-func (x *OIDCDiscoveryResponse) GetAuthorizationEndpoint() string {
-	if x != nil && x.AuthorizationEndpoint != nil {
-		return *x.AuthorizationEndpoint
+func (x *ListAccessReviewItemsRequestDTO) GetPage() *http_base.PageRequestDTO {
+	if x != nil {
+		return x.Page
 	}
-	return ""
+	return nil
 }
 
-func (x *OIDCDiscoveryResponse) GetTokenEndpoint() string {
-	if x != nil && x.TokenEndpoint != nil {
-		return *x.TokenEndpoint
+func (x *ListAccessReviewItemsRequestDTO) GetDecision() int32 {
+	if x != nil && x.Decision != nil {
+		return *x.Decision
 	}
-	return ""
+	return 0
 }
 
-func (x *OIDCDiscoveryResponse) GetUserinfoEndpoint() string {
-	if x != nil && x.UserinfoEndpoint != nil {
-		return *x.UserinfoEndpoint
+func (x *ListAccessReviewItemsRequestDTO) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
 	}
 	return ""
 }
 
-func (x *OIDCDiscoveryResponse) GetRevocationEndpoint() string {
-	if x != nil && x.RevocationEndpoint != nil {
-		return *x.RevocationEndpoint
-	}
-	return ""
+type ListAccessReviewItemsResponseDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResp *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	Items    []*AccessReviewItemDTO     `protobuf:"bytes,2,rep,name=items,proto3" form:"items" json:"items" query:"items"`
+	Page     *http_base.PageResponseDTO `protobuf:"bytes,3,opt,name=page,proto3,oneof" form:"page" json:"page,omitempty" query:"page"`
 }
 
-func (x *OIDCDiscoveryResponse) GetIntrospectionEndpoint() string {
-	if x != nil && x.IntrospectionEndpoint != nil {
-		return *x.IntrospectionEndpoint
+func (x *ListAccessReviewItemsResponseDTO) Reset() {
+	*x = ListAccessReviewItemsResponseDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *OIDCDiscoveryResponse) GetJwksUri() string {
-	if x != nil && x.JwksUri != nil {
-		return *x.JwksUri
-	}
-	return ""
+func (x *ListAccessReviewItemsResponseDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *OIDCDiscoveryResponse) GetResponseTypesSupported() []string {
-	if x != nil {
-		return x.ResponseTypesSupported
+func (*ListAccessReviewItemsResponseDTO) ProtoMessage() {}
+
+func (x *ListAccessReviewItemsResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *OIDCDiscoveryResponse) GetSubjectTypesSupported() []string {
-	if x != nil {
-		return x.SubjectTypesSupported
-	}
-	return nil
+// Deprecated: Use ListAccessReviewItemsResponseDTO.ProtoReflect.Descriptor instead.
+func (*ListAccessReviewItemsResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *OIDCDiscoveryResponse) GetIdTokenSigningAlgValuesSupported() []string {
+func (x *ListAccessReviewItemsResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
 	if x != nil {
-		return x.IdTokenSigningAlgValuesSupported
+		return x.BaseResp
 	}
 	return nil
 }
 
-func (x *OIDCDiscoveryResponse) GetScopesSupported() []string {
+func (x *ListAccessReviewItemsResponseDTO) GetItems() []*AccessReviewItemDTO {
 	if x != nil {
-		return x.ScopesSupported
+		return x.Items
 	}
 	return nil
 }
 
-func (x *OIDCDiscoveryResponse) GetTokenEndpointAuthMethodsSupported() []string {
+func (x *ListAccessReviewItemsResponseDTO) GetPage() *http_base.PageResponseDTO {
 	if x != nil {
-		return x.TokenEndpointAuthMethodsSupported
+		return x.Page
 	}
 	return nil
 }
 
-type OIDCJWKSResponse struct {
+// 复核单个条目，复核人取自当前登录用户；decision 2=确认保留 3=撤销
+type DecideAccessReviewItemRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keys *string `protobuf:"bytes,1,opt,name=keys,proto3,oneof" form:"keys" json:"keys" query:"keys"`
+	ItemID   *string `protobuf:"bytes,1,opt,name=itemID,proto3,oneof" json:"-" path:"itemID" vd:"@:len($)==36; msg:'条目ID格式不正确'"`
+	Decision *int32  `protobuf:"varint,2,opt,name=decision,proto3,oneof" form:"decision" json:"decision" vd:"@:$ == 2 || $ == 3; msg:'复核结论只能为2（确认保留）或3（撤销）'"`
+	Comment  *string `protobuf:"bytes,3,opt,name=comment,proto3,oneof" form:"comment" json:"comment,omitempty"`
 }
 
-func (x *OIDCJWKSResponse) Reset() {
-	*x = OIDCJWKSResponse{}
+func (x *DecideAccessReviewItemRequestDTO) Reset() {
+	*x = DecideAccessReviewItemRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[58]
+		mi := &file_http_identity_identity_model_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OIDCJWKSResponse) String() string {
+func (x *DecideAccessReviewItemRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OIDCJWKSResponse) ProtoMessage() {}
+func (*DecideAccessReviewItemRequestDTO) ProtoMessage() {}
 
-func (x *OIDCJWKSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[58]
+func (x *DecideAccessReviewItemRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4359,50 +4767,58 @@ func (x *OIDCJWKSResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OIDCJWKSResponse.ProtoReflect.Descriptor instead.
-func (*OIDCJWKSResponse) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use DecideAccessReviewItemRequestDTO.ProtoReflect.Descriptor instead.
+func (*DecideAccessReviewItemRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *OIDCJWKSResponse) GetKeys() string {
-	if x != nil && x.Keys != nil {
-		return *x.Keys
+func (x *DecideAccessReviewItemRequestDTO) GetItemID() string {
+	if x != nil && x.ItemID != nil {
+		return *x.ItemID
 	}
 	return ""
 }
 
-type OIDCAuthorizeRequest struct {
+func (x *DecideAccessReviewItemRequestDTO) GetDecision() int32 {
+	if x != nil && x.Decision != nil {
+		return *x.Decision
+	}
+	return 0
+}
+
+func (x *DecideAccessReviewItemRequestDTO) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+type AccessReviewItemResponseDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ResponseType        *string `protobuf:"bytes,1,opt,name=response_type,json=responseType,proto3,oneof" json:"response_type" query:"response_type" vd:"@:len($)>0; msg:'response_type不能为空'"`
-	ClientId            *string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id" query:"client_id" vd:"@:len($)>0; msg:'client_id不能为空'"`
-	RedirectUri         *string `protobuf:"bytes,3,opt,name=redirect_uri,json=redirectUri,proto3,oneof" json:"redirect_uri" query:"redirect_uri" vd:"@:len($)>0; msg:'redirect_uri不能为空'"`
-	Scope               *string `protobuf:"bytes,4,opt,name=scope,proto3,oneof" json:"scope" query:"scope" vd:"@:len($)>0; msg:'scope不能为空'"`
-	State               *string `protobuf:"bytes,5,opt,name=state,proto3,oneof" json:"state,omitempty" query:"state"`
-	Nonce               *string `protobuf:"bytes,6,opt,name=nonce,proto3,oneof" json:"nonce,omitempty" query:"nonce"`
-	CodeChallenge       *string `protobuf:"bytes,7,opt,name=code_challenge,json=codeChallenge,proto3,oneof" json:"code_challenge,omitempty" query:"code_challenge"`
-	CodeChallengeMethod *string `protobuf:"bytes,8,opt,name=code_challenge_method,json=codeChallengeMethod,proto3,oneof" json:"code_challenge_method,omitempty" query:"code_challenge_method"`
+	BaseResp *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	Item     *AccessReviewItemDTO       `protobuf:"bytes,2,opt,name=item,proto3,oneof" form:"item" json:"item,omitempty" query:"item"`
 }
 
-func (x *OIDCAuthorizeRequest) Reset() {
-	*x = OIDCAuthorizeRequest{}
+func (x *AccessReviewItemResponseDTO) Reset() {
+	*x = AccessReviewItemResponseDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[59]
+		mi := &file_http_identity_identity_model_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OIDCAuthorizeRequest) String() string {
+func (x *AccessReviewItemResponseDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OIDCAuthorizeRequest) ProtoMessage() {}
+func (*AccessReviewItemResponseDTO) ProtoMessage() {}
 
-func (x *OIDCAuthorizeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[59]
+func (x *AccessReviewItemResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4413,93 +4829,51 @@ func (x *OIDCAuthorizeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OIDCAuthorizeRequest.ProtoReflect.Descriptor instead.
-func (*OIDCAuthorizeRequest) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use AccessReviewItemResponseDTO.ProtoReflect.Descriptor instead.
+func (*AccessReviewItemResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *OIDCAuthorizeRequest) GetResponseType() string {
-	if x != nil && x.ResponseType != nil {
-		return *x.ResponseType
-	}
-	return ""
-}
-
-func (x *OIDCAuthorizeRequest) GetClientId() string {
-	if x != nil && x.ClientId != nil {
-		return *x.ClientId
-	}
-	return ""
-}
-
-func (x *OIDCAuthorizeRequest) GetRedirectUri() string {
-	if x != nil && x.RedirectUri != nil {
-		return *x.RedirectUri
-	}
-	return ""
-}
-
-func (x *OIDCAuthorizeRequest) GetScope() string {
-	if x != nil && x.Scope != nil {
-		return *x.Scope
-	}
-	return ""
-}
-
-func (x *OIDCAuthorizeRequest) GetState() string {
-	if x != nil && x.State != nil {
-		return *x.State
-	}
-	return ""
-}
-
-func (x *OIDCAuthorizeRequest) GetNonce() string {
-	if x != nil && x.Nonce != nil {
-		return *x.Nonce
-	}
-	return ""
-}
-
-func (x *OIDCAuthorizeRequest) GetCodeChallenge() string {
-	if x != nil && x.CodeChallenge != nil {
-		return *x.CodeChallenge
+func (x *AccessReviewItemResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
 	}
-	return ""
+	return nil
 }
 
-func (x *OIDCAuthorizeRequest) GetCodeChallengeMethod() string {
-	if x != nil && x.CodeChallengeMethod != nil {
-		return *x.CodeChallengeMethod
+func (x *AccessReviewItemResponseDTO) GetItem() *AccessReviewItemDTO {
+	if x != nil {
+		return x.Item
 	}
-	return ""
+	return nil
 }
 
-type OIDCAuthorizeResponse struct {
+// 关闭访问复核活动，关闭人取自当前登录用户
+type CloseAccessReviewCampaignRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BaseResp    *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
-	RedirectUrl *string                    `protobuf:"bytes,2,opt,name=redirect_url,json=redirectUrl,proto3,oneof" form:"redirect_url" json:"redirect_url" query:"redirect_url"`
+	CampaignID *string `protobuf:"bytes,1,opt,name=campaignID,proto3,oneof" json:"-" path:"campaignID" vd:"@:len($)==36; msg:'活动ID格式不正确'"`
 }
 
-func (x *OIDCAuthorizeResponse) Reset() {
-	*x = OIDCAuthorizeResponse{}
+func (x *CloseAccessReviewCampaignRequestDTO) Reset() {
+	*x = CloseAccessReviewCampaignRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[60]
+		mi := &file_http_identity_identity_model_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OIDCAuthorizeResponse) String() string {
+func (x *CloseAccessReviewCampaignRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OIDCAuthorizeResponse) ProtoMessage() {}
+func (*CloseAccessReviewCampaignRequestDTO) ProtoMessage() {}
 
-func (x *OIDCAuthorizeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[60]
+func (x *CloseAccessReviewCampaignRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4510,56 +4884,51 @@ func (x *OIDCAuthorizeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OIDCAuthorizeResponse.ProtoReflect.Descriptor instead.
-func (*OIDCAuthorizeResponse) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{60}
-}
-
-func (x *OIDCAuthorizeResponse) GetBaseResp() *http_base.BaseResponseDTO {
-	if x != nil {
-		return x.BaseResp
-	}
-	return nil
+// Deprecated: Use CloseAccessReviewCampaignRequestDTO.ProtoReflect.Descriptor instead.
+func (*CloseAccessReviewCampaignRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *OIDCAuthorizeResponse) GetRedirectUrl() string {
-	if x != nil && x.RedirectUrl != nil {
-		return *x.RedirectUrl
+func (x *CloseAccessReviewCampaignRequestDTO) GetCampaignID() string {
+	if x != nil && x.CampaignID != nil {
+		return *x.CampaignID
 	}
 	return ""
 }
 
-type OIDCTokenRequest struct {
+// 权限报告中的一条授权：菜单授权（source=menu）或 policy_srv 策略规则（source=policy）
+type PermissionGrantDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GrantType    *string `protobuf:"bytes,1,opt,name=grant_type,json=grantType,proto3,oneof" form:"grant_type" json:"grant_type" vd:"@:len($)>0; msg:'grant_type不能为空'"`
-	Code         *string `protobuf:"bytes,2,opt,name=code,proto3,oneof" form:"code" json:"code,omitempty"`
-	RedirectUri  *string `protobuf:"bytes,3,opt,name=redirect_uri,json=redirectUri,proto3,oneof" form:"redirect_uri" json:"redirect_uri,omitempty"`
-	ClientId     *string `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3,oneof" form:"client_id" json:"client_id,omitempty"`
-	ClientSecret *string `protobuf:"bytes,5,opt,name=client_secret,json=clientSecret,proto3,oneof" form:"client_secret" json:"client_secret,omitempty"`
-	CodeVerifier *string `protobuf:"bytes,6,opt,name=code_verifier,json=codeVerifier,proto3,oneof" form:"code_verifier" json:"code_verifier,omitempty"`
-	RefreshToken *string `protobuf:"bytes,7,opt,name=refresh_token,json=refreshToken,proto3,oneof" form:"refresh_token" json:"refresh_token,omitempty"`
+	Source   *string `protobuf:"bytes,1,opt,name=source,proto3,oneof" form:"source" json:"source" query:"source"`
+	MenuID   *string `protobuf:"bytes,2,opt,name=menuID,proto3,oneof" form:"menuID" json:"menu_id,omitempty" query:"menuID"`
+	MenuName *string `protobuf:"bytes,3,opt,name=menuName,proto3,oneof" form:"menuName" json:"menu_name,omitempty" query:"menuName"`
+	// 菜单权限级别：read / write / full
+	Permission *string `protobuf:"bytes,4,opt,name=permission,proto3,oneof" form:"permission" json:"permission,omitempty" query:"permission"`
+	Resource   *string `protobuf:"bytes,5,opt,name=resource,proto3,oneof" form:"resource" json:"resource,omitempty" query:"resource"`
+	Action     *string `protobuf:"bytes,6,opt,name=action,proto3,oneof" form:"action" json:"action,omitempty" query:"action"`
+	DataScope  *string `protobuf:"bytes,7,opt,name=dataScope,proto3,oneof" form:"dataScope" json:"data_scope,omitempty" query:"dataScope"`
 }
 
-func (x *OIDCTokenRequest) Reset() {
-	*x = OIDCTokenRequest{}
+func (x *PermissionGrantDTO) Reset() {
+	*x = PermissionGrantDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[61]
+		mi := &file_http_identity_identity_model_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OIDCTokenRequest) String() string {
+func (x *PermissionGrantDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OIDCTokenRequest) ProtoMessage() {}
+func (*PermissionGrantDTO) ProtoMessage() {}
 
-func (x *OIDCTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[61]
+func (x *PermissionGrantDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4570,91 +4939,92 @@ func (x *OIDCTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OIDCTokenRequest.ProtoReflect.Descriptor instead.
-func (*OIDCTokenRequest) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use PermissionGrantDTO.ProtoReflect.Descriptor instead.
+func (*PermissionGrantDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *OIDCTokenRequest) GetGrantType() string {
-	if x != nil && x.GrantType != nil {
-		return *x.GrantType
+func (x *PermissionGrantDTO) GetSource() string {
+	if x != nil && x.Source != nil {
+		return *x.Source
 	}
 	return ""
 }
 
-func (x *OIDCTokenRequest) GetCode() string {
-	if x != nil && x.Code != nil {
-		return *x.Code
+func (x *PermissionGrantDTO) GetMenuID() string {
+	if x != nil && x.MenuID != nil {
+		return *x.MenuID
 	}
 	return ""
 }
 
-func (x *OIDCTokenRequest) GetRedirectUri() string {
-	if x != nil && x.RedirectUri != nil {
-		return *x.RedirectUri
+func (x *PermissionGrantDTO) GetMenuName() string {
+	if x != nil && x.MenuName != nil {
+		return *x.MenuName
 	}
 	return ""
 }
 
-func (x *OIDCTokenRequest) GetClientId() string {
-	if x != nil && x.ClientId != nil {
-		return *x.ClientId
+func (x *PermissionGrantDTO) GetPermission() string {
+	if x != nil && x.Permission != nil {
+		return *x.Permission
 	}
 	return ""
 }
 
-func (x *OIDCTokenRequest) GetClientSecret() string {
-	if x != nil && x.ClientSecret != nil {
-		return *x.ClientSecret
+func (x *PermissionGrantDTO) GetResource() string {
+	if x != nil && x.Resource != nil {
+		return *x.Resource
 	}
 	return ""
 }
 
-func (x *OIDCTokenRequest) GetCodeVerifier() string {
-	if x != nil && x.CodeVerifier != nil {
-		return *x.CodeVerifier
+func (x *PermissionGrantDTO) GetAction() string {
+	if x != nil && x.Action != nil {
+		return *x.Action
 	}
 	return ""
 }
 
-func (x *OIDCTokenRequest) GetRefreshToken() string {
-	if x != nil && x.RefreshToken != nil {
-		return *x.RefreshToken
+func (x *PermissionGrantDTO) GetDataScope() string {
+	if x != nil && x.DataScope != nil {
+		return *x.DataScope
 	}
 	return ""
 }
 
-type OIDCTokenResponse struct {
+type RolePermissionsDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BaseResp     *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
-	AccessToken  *string                    `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3,oneof" form:"access_token" json:"access_token" query:"access_token"`
-	TokenType    *string                    `protobuf:"bytes,3,opt,name=token_type,json=tokenType,proto3,oneof" form:"token_type" json:"token_type" query:"token_type"`
-	ExpiresIn    *int64                     `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3,oneof" form:"expires_in" json:"expires_in" query:"expires_in"`
-	RefreshToken *string                    `protobuf:"bytes,5,opt,name=refresh_token,json=refreshToken,proto3,oneof" form:"refresh_token" json:"refresh_token,omitempty" query:"refresh_token"`
-	IdToken      *string                    `protobuf:"bytes,6,opt,name=id_token,json=idToken,proto3,oneof" form:"id_token" json:"id_token,omitempty" query:"id_token"`
-	Scope        *string                    `protobuf:"bytes,7,opt,name=scope,proto3,oneof" form:"scope" json:"scope,omitempty" query:"scope"`
+	RoleID   *string `protobuf:"bytes,1,opt,name=roleID,proto3,oneof" form:"roleID" json:"role_id" query:"roleID"`
+	RoleName *string `protobuf:"bytes,2,opt,name=roleName,proto3,oneof" form:"roleName" json:"role_name" query:"roleName"`
+	RoleCode *string `protobuf:"bytes,3,opt,name=roleCode,proto3,oneof" form:"roleCode" json:"role_code,omitempty" query:"roleCode"`
+	// 策略含通配规则，拥有全部菜单与全部动作
+	FullAccess *bool                 `protobuf:"varint,4,opt,name=fullAccess,proto3,oneof" form:"fullAccess" json:"full_access" query:"fullAccess"`
+	Grants     []*PermissionGrantDTO `protobuf:"bytes,5,rep,name=grants,proto3" form:"grants" json:"grants" query:"grants"`
+	// 用户视图：授予该角色的分配是否为全局分配
+	GlobalAssignment *bool `protobuf:"varint,6,opt,name=globalAssignment,proto3,oneof" form:"globalAssignment" json:"global_assignment,omitempty" query:"globalAssignment"`
 }
 
-func (x *OIDCTokenResponse) Reset() {
-	*x = OIDCTokenResponse{}
+func (x *RolePermissionsDTO) Reset() {
+	*x = RolePermissionsDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[62]
+		mi := &file_http_identity_identity_model_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OIDCTokenResponse) String() string {
+func (x *RolePermissionsDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OIDCTokenResponse) ProtoMessage() {}
+func (*RolePermissionsDTO) ProtoMessage() {}
 
-func (x *OIDCTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[62]
+func (x *RolePermissionsDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4665,91 +5035,83 @@ func (x *OIDCTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OIDCTokenResponse.ProtoReflect.Descriptor instead.
-func (*OIDCTokenResponse) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{62}
-}
-
-func (x *OIDCTokenResponse) GetBaseResp() *http_base.BaseResponseDTO {
-	if x != nil {
-		return x.BaseResp
-	}
-	return nil
+// Deprecated: Use RolePermissionsDTO.ProtoReflect.Descriptor instead.
+func (*RolePermissionsDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *OIDCTokenResponse) GetAccessToken() string {
-	if x != nil && x.AccessToken != nil {
-		return *x.AccessToken
+func (x *RolePermissionsDTO) GetRoleID() string {
+	if x != nil && x.RoleID != nil {
+		return *x.RoleID
 	}
 	return ""
 }
 
-func (x *OIDCTokenResponse) GetTokenType() string {
-	if x != nil && x.TokenType != nil {
-		return *x.TokenType
+func (x *RolePermissionsDTO) GetRoleName() string {
+	if x != nil && x.RoleName != nil {
+		return *x.RoleName
 	}
 	return ""
 }
 
-func (x *OIDCTokenResponse) GetExpiresIn() int64 {
-	if x != nil && x.ExpiresIn != nil {
-		return *x.ExpiresIn
+func (x *RolePermissionsDTO) GetRoleCode() string {
+	if x != nil && x.RoleCode != nil {
+		return *x.RoleCode
 	}
-	return 0
+	return ""
 }
 
-func (x *OIDCTokenResponse) GetRefreshToken() string {
-	if x != nil && x.RefreshToken != nil {
-		return *x.RefreshToken
+func (x *RolePermissionsDTO) GetFullAccess() bool {
+	if x != nil && x.FullAccess != nil {
+		return *x.FullAccess
 	}
-	return ""
+	return false
 }
 
-func (x *OIDCTokenResponse) GetIdToken() string {
-	if x != nil && x.IdToken != nil {
-		return *x.IdToken
+func (x *RolePermissionsDTO) GetGrants() []*PermissionGrantDTO {
+	if x != nil {
+		return x.Grants
 	}
-	return ""
+	return nil
 }
 
-func (x *OIDCTokenResponse) GetScope() string {
-	if x != nil && x.Scope != nil {
-		return *x.Scope
+func (x *RolePermissionsDTO) GetGlobalAssignment() bool {
+	if x != nil && x.GlobalAssignment != nil {
+		return *x.GlobalAssignment
 	}
-	return ""
+	return false
 }
 
-type OIDCUserinfoResponse struct {
+type TenantPermissionsDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BaseResp          *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
-	Sub               *string                    `protobuf:"bytes,2,opt,name=sub,proto3,oneof" form:"sub" json:"sub" query:"sub"`
-	Name              *string                    `protobuf:"bytes,3,opt,name=name,proto3,oneof" form:"name" json:"name,omitempty" query:"name"`
-	PreferredUsername *string                    `protobuf:"bytes,4,opt,name=preferred_username,json=preferredUsername,proto3,oneof" form:"preferred_username" json:"preferred_username,omitempty" query:"preferred_username"`
-	Email             *string                    `protobuf:"bytes,5,opt,name=email,proto3,oneof" form:"email" json:"email,omitempty" query:"email"`
-	EmailVerified     *bool                      `protobuf:"varint,6,opt,name=email_verified,json=emailVerified,proto3,oneof" form:"email_verified" json:"email_verified,omitempty" query:"email_verified"`
-	Picture           *string                    `protobuf:"bytes,7,opt,name=picture,proto3,oneof" form:"picture" json:"picture,omitempty" query:"picture"`
+	// 为空表示用户不属于任何组织，仅全局分配生效
+	OrganizationID   *string               `protobuf:"bytes,1,opt,name=organizationID,proto3,oneof" form:"organizationID" json:"organization_id,omitempty" query:"organizationID"`
+	OrganizationName *string               `protobuf:"bytes,2,opt,name=organizationName,proto3,oneof" form:"organizationName" json:"organization_name,omitempty" query:"organizationName"`
+	Roles            []*RolePermissionsDTO `protobuf:"bytes,3,rep,name=roles,proto3" form:"roles" json:"roles" query:"roles"`
+	// 直接授予用户（不经角色）的策略规则
+	DirectGrants []*PermissionGrantDTO `protobuf:"bytes,4,rep,name=directGrants,proto3" form:"directGrants" json:"direct_grants,omitempty" query:"directGrants"`
 }
 
-func (x *OIDCUserinfoResponse) Reset() {
-	*x = OIDCUserinfoResponse{}
+func (x *TenantPermissionsDTO) Reset() {
+	*x = TenantPermissionsDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[63]
+		mi := &file_http_identity_identity_model_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OIDCUserinfoResponse) String() string {
+func (x *TenantPermissionsDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OIDCUserinfoResponse) ProtoMessage() {}
+func (*TenantPermissionsDTO) ProtoMessage() {}
 
-func (x *OIDCUserinfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[63]
+func (x *TenantPermissionsDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4760,86 +5122,69 @@ func (x *OIDCUserinfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OIDCUserinfoResponse.ProtoReflect.Descriptor instead.
-func (*OIDCUserinfoResponse) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{63}
-}
-
-func (x *OIDCUserinfoResponse) GetBaseResp() *http_base.BaseResponseDTO {
-	if x != nil {
-		return x.BaseResp
-	}
-	return nil
-}
-
-func (x *OIDCUserinfoResponse) GetSub() string {
-	if x != nil && x.Sub != nil {
-		return *x.Sub
-	}
-	return ""
-}
-
-func (x *OIDCUserinfoResponse) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
+// Deprecated: Use TenantPermissionsDTO.ProtoReflect.Descriptor instead.
+func (*TenantPermissionsDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *OIDCUserinfoResponse) GetPreferredUsername() string {
-	if x != nil && x.PreferredUsername != nil {
-		return *x.PreferredUsername
+func (x *TenantPermissionsDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
 	}
 	return ""
 }
 
-func (x *OIDCUserinfoResponse) GetEmail() string {
-	if x != nil && x.Email != nil {
-		return *x.Email
+func (x *TenantPermissionsDTO) GetOrganizationName() string {
+	if x != nil && x.OrganizationName != nil {
+		return *x.OrganizationName
 	}
 	return ""
 }
 
-func (x *OIDCUserinfoResponse) GetEmailVerified() bool {
-	if x != nil && x.EmailVerified != nil {
-		return *x.EmailVerified
+func (x *TenantPermissionsDTO) GetRoles() []*RolePermissionsDTO {
+	if x != nil {
+		return x.Roles
 	}
-	return false
+	return nil
 }
 
-func (x *OIDCUserinfoResponse) GetPicture() string {
-	if x != nil && x.Picture != nil {
-		return *x.Picture
+func (x *TenantPermissionsDTO) GetDirectGrants() []*PermissionGrantDTO {
+	if x != nil {
+		return x.DirectGrants
 	}
-	return ""
+	return nil
 }
 
-type OIDCRevokeRequest struct {
+type UserPermissionsDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token         *string `protobuf:"bytes,1,opt,name=token,proto3,oneof" form:"token" json:"token" vd:"@:len($)>0; msg:'token不能为空'"`
-	TokenTypeHint *string `protobuf:"bytes,2,opt,name=token_type_hint,json=tokenTypeHint,proto3,oneof" form:"token_type_hint" json:"token_type_hint,omitempty"`
+	UserID   *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" form:"userID" json:"user_id" query:"userID"`
+	Username *string `protobuf:"bytes,2,opt,name=username,proto3,oneof" form:"username" json:"username" query:"username"`
+	RealName *string `protobuf:"bytes,3,opt,name=realName,proto3,oneof" form:"realName" json:"real_name,omitempty" query:"realName"`
+	// 紧急账号绕过策略决策，拥有全部权限
+	BreakGlass *bool                   `protobuf:"varint,4,opt,name=breakGlass,proto3,oneof" form:"breakGlass" json:"break_glass" query:"breakGlass"`
+	Tenants    []*TenantPermissionsDTO `protobuf:"bytes,5,rep,name=tenants,proto3" form:"tenants" json:"tenants" query:"tenants"`
 }
 
-func (x *OIDCRevokeRequest) Reset() {
-	*x = OIDCRevokeRequest{}
+func (x *UserPermissionsDTO) Reset() {
+	*x = UserPermissionsDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[64]
+		mi := &file_http_identity_identity_model_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OIDCRevokeRequest) String() string {
+func (x *UserPermissionsDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OIDCRevokeRequest) ProtoMessage() {}
+func (*UserPermissionsDTO) ProtoMessage() {}
 
-func (x *OIDCRevokeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[64]
+func (x *UserPermissionsDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4850,51 +5195,74 @@ func (x *OIDCRevokeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OIDCRevokeRequest.ProtoReflect.Descriptor instead.
-func (*OIDCRevokeRequest) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use UserPermissionsDTO.ProtoReflect.Descriptor instead.
+func (*UserPermissionsDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *OIDCRevokeRequest) GetToken() string {
-	if x != nil && x.Token != nil {
-		return *x.Token
+func (x *UserPermissionsDTO) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
 	}
 	return ""
 }
 
-func (x *OIDCRevokeRequest) GetTokenTypeHint() string {
-	if x != nil && x.TokenTypeHint != nil {
-		return *x.TokenTypeHint
+func (x *UserPermissionsDTO) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
 	}
 	return ""
 }
 
-type OIDCIntrospectRequest struct {
+func (x *UserPermissionsDTO) GetRealName() string {
+	if x != nil && x.RealName != nil {
+		return *x.RealName
+	}
+	return ""
+}
+
+func (x *UserPermissionsDTO) GetBreakGlass() bool {
+	if x != nil && x.BreakGlass != nil {
+		return *x.BreakGlass
+	}
+	return false
+}
+
+func (x *UserPermissionsDTO) GetTenants() []*TenantPermissionsDTO {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type GetPermissionMatrixRequestDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token         *string `protobuf:"bytes,1,opt,name=token,proto3,oneof" form:"token" json:"token" vd:"@:len($)>0; msg:'token不能为空'"`
-	TokenTypeHint *string `protobuf:"bytes,2,opt,name=token_type_hint,json=tokenTypeHint,proto3,oneof" form:"token_type_hint" json:"token_type_hint,omitempty"`
+	ProductLine *string  `protobuf:"bytes,1,opt,name=productLine,proto3,oneof" json:"product_line,omitempty" query:"product_line"`
+	RoleIDs     []string `protobuf:"bytes,2,rep,name=roleIDs,proto3" json:"role_ids,omitempty" query:"role_ids"`
+	// 同时列出该组织域下的策略规则
+	OrganizationID *string `protobuf:"bytes,3,opt,name=organizationID,proto3,oneof" json:"organization_id,omitempty" query:"organization_id"`
 }
 
-func (x *OIDCIntrospectRequest) Reset() {
-	*x = OIDCIntrospectRequest{}
+func (x *GetPermissionMatrixRequestDTO) Reset() {
+	*x = GetPermissionMatrixRequestDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[65]
+		mi := &file_http_identity_identity_model_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OIDCIntrospectRequest) String() string {
+func (x *GetPermissionMatrixRequestDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OIDCIntrospectRequest) ProtoMessage() {}
+func (*GetPermissionMatrixRequestDTO) ProtoMessage() {}
 
-func (x *OIDCIntrospectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[65]
+func (x *GetPermissionMatrixRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4905,57 +5273,60 @@ func (x *OIDCIntrospectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OIDCIntrospectRequest.ProtoReflect.Descriptor instead.
-func (*OIDCIntrospectRequest) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use GetPermissionMatrixRequestDTO.ProtoReflect.Descriptor instead.
+func (*GetPermissionMatrixRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *OIDCIntrospectRequest) GetToken() string {
-	if x != nil && x.Token != nil {
-		return *x.Token
+func (x *GetPermissionMatrixRequestDTO) GetProductLine() string {
+	if x != nil && x.ProductLine != nil {
+		return *x.ProductLine
 	}
 	return ""
 }
 
-func (x *OIDCIntrospectRequest) GetTokenTypeHint() string {
-	if x != nil && x.TokenTypeHint != nil {
-		return *x.TokenTypeHint
+func (x *GetPermissionMatrixRequestDTO) GetRoleIDs() []string {
+	if x != nil {
+		return x.RoleIDs
+	}
+	return nil
+}
+
+func (x *GetPermissionMatrixRequestDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
 	}
 	return ""
 }
 
-type OIDCIntrospectResponse struct {
+type GetPermissionMatrixResponseDTO struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BaseResp *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
-	Active   *bool                      `protobuf:"varint,2,opt,name=active,proto3,oneof" form:"active" json:"active" query:"active"`
-	ClientId *string                    `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3,oneof" form:"client_id" json:"client_id,omitempty" query:"client_id"`
-	Username *string                    `protobuf:"bytes,4,opt,name=username,proto3,oneof" form:"username" json:"username,omitempty" query:"username"`
-	Scope    *string                    `protobuf:"bytes,5,opt,name=scope,proto3,oneof" form:"scope" json:"scope,omitempty" query:"scope"`
-	Sub      *string                    `protobuf:"bytes,6,opt,name=sub,proto3,oneof" form:"sub" json:"sub,omitempty" query:"sub"`
-	Exp      *int64                     `protobuf:"varint,7,opt,name=exp,proto3,oneof" form:"exp" json:"exp,omitempty" query:"exp"`
-	Iat      *int64                     `protobuf:"varint,8,opt,name=iat,proto3,oneof" form:"iat" json:"iat,omitempty" query:"iat"`
+	BaseResp    *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	ProductLine *string                    `protobuf:"bytes,2,opt,name=productLine,proto3,oneof" form:"productLine" json:"product_line" query:"productLine"`
+	MenuVersion *int32                     `protobuf:"varint,3,opt,name=menuVersion,proto3,oneof" form:"menuVersion" json:"menu_version" query:"menuVersion"`
+	Roles       []*RolePermissionsDTO      `protobuf:"bytes,4,rep,name=roles,proto3" form:"roles" json:"roles" query:"roles"`
 }
 
-func (x *OIDCIntrospectResponse) Reset() {
-	*x = OIDCIntrospectResponse{}
+func (x *GetPermissionMatrixResponseDTO) Reset() {
+	*x = GetPermissionMatrixResponseDTO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_http_identity_identity_model_proto_msgTypes[66]
+		mi := &file_http_identity_identity_model_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OIDCIntrospectResponse) String() string {
+func (x *GetPermissionMatrixResponseDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OIDCIntrospectResponse) ProtoMessage() {}
+func (*GetPermissionMatrixResponseDTO) ProtoMessage() {}
 
-func (x *OIDCIntrospectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_http_identity_identity_model_proto_msgTypes[66]
+func (x *GetPermissionMatrixResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4966,449 +5337,4442 @@ func (x *OIDCIntrospectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OIDCIntrospectResponse.ProtoReflect.Descriptor instead.
-func (*OIDCIntrospectResponse) Descriptor() ([]byte, []int) {
-	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use GetPermissionMatrixResponseDTO.ProtoReflect.Descriptor instead.
+func (*GetPermissionMatrixResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *OIDCIntrospectResponse) GetBaseResp() *http_base.BaseResponseDTO {
+func (x *GetPermissionMatrixResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
 	if x != nil {
 		return x.BaseResp
 	}
 	return nil
 }
 
-func (x *OIDCIntrospectResponse) GetActive() bool {
-	if x != nil && x.Active != nil {
-		return *x.Active
+func (x *GetPermissionMatrixResponseDTO) GetProductLine() string {
+	if x != nil && x.ProductLine != nil {
+		return *x.ProductLine
 	}
-	return false
+	return ""
 }
 
-func (x *OIDCIntrospectResponse) GetClientId() string {
-	if x != nil && x.ClientId != nil {
-		return *x.ClientId
+func (x *GetPermissionMatrixResponseDTO) GetMenuVersion() int32 {
+	if x != nil && x.MenuVersion != nil {
+		return *x.MenuVersion
+	}
+	return 0
+}
+
+func (x *GetPermissionMatrixResponseDTO) GetRoles() []*RolePermissionsDTO {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type GetUserEffectivePermissionsRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID      *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"-" path:"userID" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
+	ProductLine *string `protobuf:"bytes,2,opt,name=productLine,proto3,oneof" json:"product_line,omitempty" query:"product_line"`
+	// 仅计算该租户，为空表示全部租户
+	OrganizationID *string `protobuf:"bytes,3,opt,name=organizationID,proto3,oneof" json:"organization_id,omitempty" query:"organization_id"`
+}
+
+func (x *GetUserEffectivePermissionsRequestDTO) Reset() {
+	*x = GetUserEffectivePermissionsRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserEffectivePermissionsRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserEffectivePermissionsRequestDTO) ProtoMessage() {}
+
+func (x *GetUserEffectivePermissionsRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserEffectivePermissionsRequestDTO.ProtoReflect.Descriptor instead.
+func (*GetUserEffectivePermissionsRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetUserEffectivePermissionsRequestDTO) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
 	}
 	return ""
 }
 
-func (x *OIDCIntrospectResponse) GetUsername() string {
-	if x != nil && x.Username != nil {
-		return *x.Username
+func (x *GetUserEffectivePermissionsRequestDTO) GetProductLine() string {
+	if x != nil && x.ProductLine != nil {
+		return *x.ProductLine
 	}
 	return ""
 }
 
-func (x *OIDCIntrospectResponse) GetScope() string {
-	if x != nil && x.Scope != nil {
-		return *x.Scope
+func (x *GetUserEffectivePermissionsRequestDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
 	}
 	return ""
 }
 
-func (x *OIDCIntrospectResponse) GetSub() string {
-	if x != nil && x.Sub != nil {
-		return *x.Sub
+type GetUserEffectivePermissionsResponseDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResp    *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	ProductLine *string                    `protobuf:"bytes,2,opt,name=productLine,proto3,oneof" form:"productLine" json:"product_line" query:"productLine"`
+	User        *UserPermissionsDTO        `protobuf:"bytes,3,opt,name=user,proto3,oneof" form:"user" json:"user,omitempty" query:"user"`
+}
+
+func (x *GetUserEffectivePermissionsResponseDTO) Reset() {
+	*x = GetUserEffectivePermissionsResponseDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserEffectivePermissionsResponseDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserEffectivePermissionsResponseDTO) ProtoMessage() {}
+
+func (x *GetUserEffectivePermissionsResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserEffectivePermissionsResponseDTO.ProtoReflect.Descriptor instead.
+func (*GetUserEffectivePermissionsResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetUserEffectivePermissionsResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
+	}
+	return nil
+}
+
+func (x *GetUserEffectivePermissionsResponseDTO) GetProductLine() string {
+	if x != nil && x.ProductLine != nil {
+		return *x.ProductLine
 	}
 	return ""
 }
 
-func (x *OIDCIntrospectResponse) GetExp() int64 {
-	if x != nil && x.Exp != nil {
-		return *x.Exp
+func (x *GetUserEffectivePermissionsResponseDTO) GetUser() *UserPermissionsDTO {
+	if x != nil {
+		return x.User
 	}
-	return 0
+	return nil
 }
 
-func (x *OIDCIntrospectResponse) GetIat() int64 {
-	if x != nil && x.Iat != nil {
-		return *x.Iat
+type ExportPermissionReportRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format *string `protobuf:"bytes,1,opt,name=format,proto3,oneof" json:"format,omitempty" query:"format" vd:"@:len($)==0 || regexp('^(csv|xlsx)$',$); msg:'文件格式必须是 csv 或 xlsx'"`
+	// role：角色权限矩阵（默认）；user：用户有效权限
+	View           *string  `protobuf:"bytes,2,opt,name=view,proto3,oneof" json:"view,omitempty" query:"view" vd:"@:len($)==0 || regexp('^(role|user)$',$); msg:'报告视图必须是 role 或 user'"`
+	ProductLine    *string  `protobuf:"bytes,3,opt,name=productLine,proto3,oneof" json:"product_line,omitempty" query:"product_line"`
+	RoleIDs        []string `protobuf:"bytes,4,rep,name=roleIDs,proto3" json:"role_ids,omitempty" query:"role_ids"`
+	UserIDs        []string `protobuf:"bytes,5,rep,name=userIDs,proto3" json:"user_ids,omitempty" query:"user_ids"`
+	OrganizationID *string  `protobuf:"bytes,6,opt,name=organizationID,proto3,oneof" json:"organization_id,omitempty" query:"organization_id"`
+}
+
+func (x *ExportPermissionReportRequestDTO) Reset() {
+	*x = ExportPermissionReportRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-var File_http_identity_identity_model_proto protoreflect.FileDescriptor
+func (x *ExportPermissionReportRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var file_http_identity_identity_model_proto_rawDesc = []byte{
-	0x0a, 0x22, 0x68, 0x74, 0x74, 0x70, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x0e,
-	0x62, 0x61, 0x73, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x68, 0x74,
-	0x74, 0x70, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x8b, 0x07, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x6d, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4c, 0xca, 0xbb, 0x18, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0xda, 0xbb, 0x18, 0x29, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28,
-	0x24, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0x94, 0xa8,
-	0xe6, 0x88, 0xb7, 0xe5, 0x90, 0x8d, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7,
-	0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x00, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x6a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x49, 0xca, 0xbb, 0x18, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0xda, 0xbb, 0x18, 0x26, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24,
-	0x29, 0x20, 0x3e, 0x20, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe5, 0xaf, 0x86, 0xe7,
-	0xa0, 0x81, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x27, 0xca,
-	0xf3, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x22, 0x48, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01,
-	0x01, 0x12, 0x5a, 0x0a, 0x0b, 0x6f, 0x69, 0x64, 0x63, 0x49, 0x44, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xca, 0xbb, 0x18, 0x0d, 0x6f, 0x69, 0x64, 0x63,
-	0x5f, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f,
-	0x6e, 0x3a, 0x22, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x0b, 0x6f,
-	0x69, 0x64, 0x63, 0x49, 0x44, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x5c, 0x0a,
-	0x0c, 0x6f, 0x69, 0x64, 0x63, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x33, 0xca, 0xbb, 0x18, 0x0d, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x6f, 0x69, 0x64, 0x63, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2c, 0x6f, 0x6d,
-	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x0c, 0x6f, 0x69, 0x64, 0x63,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x08, 0x6f,
-	0x69, 0x64, 0x63, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xca,
-	0xbb, 0x18, 0x09, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0xca, 0xf3, 0x18, 0x1a,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x08, 0x6f, 0x69,
-	0x64, 0x63, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x6a, 0x0a, 0x0f, 0x6f, 0x69, 0x64,
-	0x63, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x52, 0x49, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x3b, 0xca, 0xbb, 0x18, 0x11, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x72, 0x65, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0xca, 0xf3, 0x18, 0x22, 0x6a, 0x73, 0x6f,
-	0x6e, 0x3a, 0x22, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x5f, 0x75, 0x72, 0x69, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48,
-	0x05, 0x52, 0x0f, 0x6f, 0x69, 0x64, 0x63, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55,
-	0x52, 0x49, 0x88, 0x01, 0x01, 0x12, 0x6e, 0x0a, 0x10, 0x6f, 0x69, 0x64, 0x63, 0x43, 0x6f, 0x64,
-	0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x3d, 0xca, 0xbb, 0x18, 0x12, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x76,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0xca, 0xf3, 0x18, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x22, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x06,
-	0x52, 0x10, 0x6f, 0x69, 0x64, 0x63, 0x43, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xca, 0xbb, 0x18, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0xca,
-	0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x2c, 0x6f,
-	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x07, 0x52, 0x05, 0x6e, 0x6f, 0x6e,
-	0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42,
-	0x0e, 0x0a, 0x0c, 0x5f, 0x6f, 0x69, 0x64, 0x63, 0x49, 0x44, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42,
-	0x0f, 0x0a, 0x0d, 0x5f, 0x6f, 0x69, 0x64, 0x63, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x69, 0x64, 0x63, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x12, 0x0a,
-	0x10, 0x5f, 0x6f, 0x69, 0x64, 0x63, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x52,
-	0x49, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6f, 0x69, 0x64, 0x63, 0x43, 0x6f, 0x64, 0x65, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x22, 0xe6, 0x01, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x44, 0x54, 0x4f,
-	0x12, 0x22, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xca, 0xf3,
-	0x18, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x02, 0x69,
-	0x64, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0f, 0xca, 0xf3, 0x18, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f,
-	0x64, 0x65, 0x22, 0x48, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xca, 0xf3,
-	0x18, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x02, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61,
-	0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x15, 0xca, 0xf3, 0x18,
-	0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x63, 0x6f, 0x70,
-	0x65, 0x22, 0x48, 0x03, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x88,
-	0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
-	0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x22, 0xa0, 0x04, 0x0a, 0x10, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51,
-	0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3,
-	0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73,
-	0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01,
-	0x01, 0x12, 0x58, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x54, 0x4f,
-	0x42, 0x17, 0xca, 0xf3, 0x18, 0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x48, 0x01, 0x52, 0x0b, 0x75, 0x73, 0x65,
-	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x09, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x44, 0x54, 0x4f, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f,
-	0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x48, 0x02,
-	0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x5f,
-	0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x44, 0x54, 0x4f,
-	0x42, 0x20, 0xca, 0xf3, 0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12,
-	0x37, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
-	0x42, 0x1d, 0xca, 0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x6f, 0x6c, 0x65,
-	0x5f, 0x69, 0x64, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52,
-	0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x73, 0x12, 0x47, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65,
-	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x44, 0x54, 0x4f, 0x42, 0x1a,
-	0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65,
-	0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x0e,
-	0x0a, 0x0c, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x0c,
-	0x0a, 0x0a, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xa8, 0x01, 0x0a,
-	0x10, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54,
-	0x4f, 0x12, 0x82, 0x01, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x59, 0xca, 0xbb, 0x18, 0x0d, 0x72, 0x65,
-	0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xda, 0xbb, 0x18, 0x2c, 0x40,
-	0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67,
-	0x3a, 0x27, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0xe4, 0xb8,
-	0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x14, 0x6a,
-	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x22, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65,
-	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd7, 0x02, 0x0a, 0x18, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x44, 0x54, 0x4f, 0x12, 0x7c, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x55, 0xca, 0xbb, 0x18, 0x0c, 0x6f,
-	0x6c, 0x64, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0xda, 0xbb, 0x18, 0x2a, 0x40,
+func (*ExportPermissionReportRequestDTO) ProtoMessage() {}
+
+func (x *ExportPermissionReportRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPermissionReportRequestDTO.ProtoReflect.Descriptor instead.
+func (*ExportPermissionReportRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ExportPermissionReportRequestDTO) GetFormat() string {
+	if x != nil && x.Format != nil {
+		return *x.Format
+	}
+	return ""
+}
+
+func (x *ExportPermissionReportRequestDTO) GetView() string {
+	if x != nil && x.View != nil {
+		return *x.View
+	}
+	return ""
+}
+
+func (x *ExportPermissionReportRequestDTO) GetProductLine() string {
+	if x != nil && x.ProductLine != nil {
+		return *x.ProductLine
+	}
+	return ""
+}
+
+func (x *ExportPermissionReportRequestDTO) GetRoleIDs() []string {
+	if x != nil {
+		return x.RoleIDs
+	}
+	return nil
+}
+
+func (x *ExportPermissionReportRequestDTO) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *ExportPermissionReportRequestDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+// 回收站条目：已删除的用户、组织或部门
+type DeletedEntityDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" form:"id" json:"id" query:"id"`
+	EntityType     *string `protobuf:"bytes,2,opt,name=entityType,proto3,oneof" form:"entityType" json:"entity_type" query:"entityType"`
+	Name           *string `protobuf:"bytes,3,opt,name=name,proto3,oneof" form:"name" json:"name" query:"name"`
+	Code           *string `protobuf:"bytes,4,opt,name=code,proto3,oneof" form:"code" json:"code,omitempty" query:"code"`
+	OrganizationID *string `protobuf:"bytes,5,opt,name=organizationID,proto3,oneof" form:"organizationID" json:"organization_id,omitempty" query:"organizationID"`
+	DeletedAt      *int64  `protobuf:"varint,6,opt,name=deletedAt,proto3,oneof" form:"deletedAt" json:"deleted_at" query:"deletedAt"`
+	PurgeAt        *int64  `protobuf:"varint,7,opt,name=purgeAt,proto3,oneof" form:"purgeAt" json:"purge_at,omitempty" query:"purgeAt"`
+}
+
+func (x *DeletedEntityDTO) Reset() {
+	*x = DeletedEntityDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletedEntityDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedEntityDTO) ProtoMessage() {}
+
+func (x *DeletedEntityDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedEntityDTO.ProtoReflect.Descriptor instead.
+func (*DeletedEntityDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeletedEntityDTO) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *DeletedEntityDTO) GetEntityType() string {
+	if x != nil && x.EntityType != nil {
+		return *x.EntityType
+	}
+	return ""
+}
+
+func (x *DeletedEntityDTO) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *DeletedEntityDTO) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+func (x *DeletedEntityDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *DeletedEntityDTO) GetDeletedAt() int64 {
+	if x != nil && x.DeletedAt != nil {
+		return *x.DeletedAt
+	}
+	return 0
+}
+
+func (x *DeletedEntityDTO) GetPurgeAt() int64 {
+	if x != nil && x.PurgeAt != nil {
+		return *x.PurgeAt
+	}
+	return 0
+}
+
+type ListDeletedEntitiesRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityType *string                   `protobuf:"bytes,1,opt,name=entityType,proto3,oneof" json:"-" path:"entityType" vd:"@:regexp('^(users|organizations|departments)$',$); msg:'回收站类型必须是 users、organizations 或 departments'"`
+	Page       *http_base.PageRequestDTO `protobuf:"bytes,2,opt,name=page,proto3,oneof" form:"-" json:"-" query:"-"`
+}
+
+func (x *ListDeletedEntitiesRequestDTO) Reset() {
+	*x = ListDeletedEntitiesRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeletedEntitiesRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedEntitiesRequestDTO) ProtoMessage() {}
+
+func (x *ListDeletedEntitiesRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedEntitiesRequestDTO.ProtoReflect.Descriptor instead.
+func (*ListDeletedEntitiesRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListDeletedEntitiesRequestDTO) GetEntityType() string {
+	if x != nil && x.EntityType != nil {
+		return *x.EntityType
+	}
+	return ""
+}
+
+func (x *ListDeletedEntitiesRequestDTO) GetPage() *http_base.PageRequestDTO {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListDeletedEntitiesResponseDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResp *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	Entities []*DeletedEntityDTO        `protobuf:"bytes,2,rep,name=entities,proto3" form:"entities" json:"entities,omitempty" query:"entities"`
+	Page     *http_base.PageResponseDTO `protobuf:"bytes,3,opt,name=page,proto3,oneof" form:"page" json:"page,omitempty" query:"page"`
+}
+
+func (x *ListDeletedEntitiesResponseDTO) Reset() {
+	*x = ListDeletedEntitiesResponseDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeletedEntitiesResponseDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedEntitiesResponseDTO) ProtoMessage() {}
+
+func (x *ListDeletedEntitiesResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedEntitiesResponseDTO.ProtoReflect.Descriptor instead.
+func (*ListDeletedEntitiesResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListDeletedEntitiesResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
+	}
+	return nil
+}
+
+func (x *ListDeletedEntitiesResponseDTO) GetEntities() []*DeletedEntityDTO {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *ListDeletedEntitiesResponseDTO) GetPage() *http_base.PageResponseDTO {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type DeletedEntityRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityType *string `protobuf:"bytes,1,opt,name=entityType,proto3,oneof" json:"-" path:"entityType" vd:"@:regexp('^(users|organizations|departments)$',$); msg:'回收站类型必须是 users、organizations 或 departments'"`
+	Id         *string `protobuf:"bytes,2,opt,name=id,proto3,oneof" json:"-" path:"id" vd:"@:len($)==36; msg:'记录ID格式不正确'"`
+}
+
+func (x *DeletedEntityRequestDTO) Reset() {
+	*x = DeletedEntityRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletedEntityRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedEntityRequestDTO) ProtoMessage() {}
+
+func (x *DeletedEntityRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedEntityRequestDTO.ProtoReflect.Descriptor instead.
+func (*DeletedEntityRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeletedEntityRequestDTO) GetEntityType() string {
+	if x != nil && x.EntityType != nil {
+		return *x.EntityType
+	}
+	return ""
+}
+
+func (x *DeletedEntityRequestDTO) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+type RestoreDeletedEntityResponseDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResp *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	// 恢复用户时随之恢复的角色分配与成员关系数量
+	RestoredRoleAssignments *int32 `protobuf:"varint,2,opt,name=restoredRoleAssignments,proto3,oneof" form:"restoredRoleAssignments" json:"restored_role_assignments" query:"restoredRoleAssignments"`
+	RestoredMemberships     *int32 `protobuf:"varint,3,opt,name=restoredMemberships,proto3,oneof" form:"restoredMemberships" json:"restored_memberships" query:"restoredMemberships"`
+	// 需经审批或违反职责分离约束而未随用户恢复的角色编码，需重新发起分配
+	SkippedRoleCodes []string `protobuf:"bytes,4,rep,name=skippedRoleCodes,proto3" form:"skippedRoleCodes" json:"skipped_role_codes,omitempty" query:"skippedRoleCodes"`
+}
+
+func (x *RestoreDeletedEntityResponseDTO) Reset() {
+	*x = RestoreDeletedEntityResponseDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreDeletedEntityResponseDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreDeletedEntityResponseDTO) ProtoMessage() {}
+
+func (x *RestoreDeletedEntityResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreDeletedEntityResponseDTO.ProtoReflect.Descriptor instead.
+func (*RestoreDeletedEntityResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RestoreDeletedEntityResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
+	}
+	return nil
+}
+
+func (x *RestoreDeletedEntityResponseDTO) GetRestoredRoleAssignments() int32 {
+	if x != nil && x.RestoredRoleAssignments != nil {
+		return *x.RestoredRoleAssignments
+	}
+	return 0
+}
+
+func (x *RestoreDeletedEntityResponseDTO) GetRestoredMemberships() int32 {
+	if x != nil && x.RestoredMemberships != nil {
+		return *x.RestoredMemberships
+	}
+	return 0
+}
+
+func (x *RestoreDeletedEntityResponseDTO) GetSkippedRoleCodes() []string {
+	if x != nil {
+		return x.SkippedRoleCodes
+	}
+	return nil
+}
+
+type UserMembershipResponseDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResp   *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	Membership *UserMembershipDTO         `protobuf:"bytes,2,opt,name=membership,proto3,oneof" form:"membership" json:"membership,omitempty" query:"membership"`
+}
+
+func (x *UserMembershipResponseDTO) Reset() {
+	*x = UserMembershipResponseDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserMembershipResponseDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserMembershipResponseDTO) ProtoMessage() {}
+
+func (x *UserMembershipResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserMembershipResponseDTO.ProtoReflect.Descriptor instead.
+func (*UserMembershipResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *UserMembershipResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
+	}
+	return nil
+}
+
+func (x *UserMembershipResponseDTO) GetMembership() *UserMembershipDTO {
+	if x != nil {
+		return x.Membership
+	}
+	return nil
+}
+
+type GetUserMembershipsRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID *string                   `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"-" path:"userID" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
+	Page   *http_base.PageRequestDTO `protobuf:"bytes,2,opt,name=page,proto3,oneof" form:"-" json:"-" query:"-"`
+}
+
+func (x *GetUserMembershipsRequestDTO) Reset() {
+	*x = GetUserMembershipsRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserMembershipsRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserMembershipsRequestDTO) ProtoMessage() {}
+
+func (x *GetUserMembershipsRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserMembershipsRequestDTO.ProtoReflect.Descriptor instead.
+func (*GetUserMembershipsRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetUserMembershipsRequestDTO) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *GetUserMembershipsRequestDTO) GetPage() *http_base.PageRequestDTO {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GetUserMembershipsResponseDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResp    *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	Memberships []*UserMembershipDTO       `protobuf:"bytes,2,rep,name=memberships,proto3" form:"memberships" json:"memberships,omitempty" query:"memberships"`
+	Page        *http_base.PageResponseDTO `protobuf:"bytes,3,opt,name=page,proto3,oneof" form:"page" json:"page,omitempty" query:"page"`
+}
+
+func (x *GetUserMembershipsResponseDTO) Reset() {
+	*x = GetUserMembershipsResponseDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserMembershipsResponseDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserMembershipsResponseDTO) ProtoMessage() {}
+
+func (x *GetUserMembershipsResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserMembershipsResponseDTO.ProtoReflect.Descriptor instead.
+func (*GetUserMembershipsResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetUserMembershipsResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
+	}
+	return nil
+}
+
+func (x *GetUserMembershipsResponseDTO) GetMemberships() []*UserMembershipDTO {
+	if x != nil {
+		return x.Memberships
+	}
+	return nil
+}
+
+func (x *GetUserMembershipsResponseDTO) GetPage() *http_base.PageResponseDTO {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GetPrimaryMembershipRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"-" path:"userID" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
+}
+
+func (x *GetPrimaryMembershipRequestDTO) Reset() {
+	*x = GetPrimaryMembershipRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPrimaryMembershipRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrimaryMembershipRequestDTO) ProtoMessage() {}
+
+func (x *GetPrimaryMembershipRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrimaryMembershipRequestDTO.ProtoReflect.Descriptor instead.
+func (*GetPrimaryMembershipRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetPrimaryMembershipRequestDTO) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+type CheckMembershipRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"user_id,omitempty" query:"user_id" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
+	OrganizationID *string `protobuf:"bytes,2,opt,name=organizationID,proto3,oneof" json:"organization_id,omitempty" query:"organization_id" vd:"@:len($)==36; msg:'组织ID格式不正确'"`
+	DepartmentID   *string `protobuf:"bytes,3,opt,name=departmentID,proto3,oneof" json:"department_id,omitempty" query:"department_id" vd:"@:len($)==0 || len($)==36; msg:'部门ID格式不正确'"`
+}
+
+func (x *CheckMembershipRequestDTO) Reset() {
+	*x = CheckMembershipRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckMembershipRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckMembershipRequestDTO) ProtoMessage() {}
+
+func (x *CheckMembershipRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckMembershipRequestDTO.ProtoReflect.Descriptor instead.
+func (*CheckMembershipRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CheckMembershipRequestDTO) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *CheckMembershipRequestDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *CheckMembershipRequestDTO) GetDepartmentID() string {
+	if x != nil && x.DepartmentID != nil {
+		return *x.DepartmentID
+	}
+	return ""
+}
+
+type OrganizationDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  *string            `protobuf:"bytes,1,opt,name=id,proto3,oneof" form:"id" json:"id" query:"id"`
+	Code                *string            `protobuf:"bytes,2,opt,name=code,proto3,oneof" form:"code" json:"code,omitempty" query:"code"`
+	Name                *string            `protobuf:"bytes,3,opt,name=name,proto3,oneof" form:"name" json:"name" query:"name"`
+	ParentID            *string            `protobuf:"bytes,4,opt,name=parentID,proto3,oneof" form:"parentID" json:"parent_id,omitempty" query:"parentID"`
+	FacilityType        *string            `protobuf:"bytes,5,opt,name=facilityType,proto3,oneof" form:"facilityType" json:"facility_type,omitempty" query:"facilityType"`
+	AccreditationStatus *string            `protobuf:"bytes,6,opt,name=accreditationStatus,proto3,oneof" form:"accreditationStatus" json:"accreditation_status,omitempty" query:"accreditationStatus"`
+	Logo                *string            `protobuf:"bytes,7,opt,name=logo,proto3,oneof" form:"logo" json:"logo,omitempty" query:"logo"`
+	ProvinceCity        []string           `protobuf:"bytes,8,rep,name=provinceCity,proto3" form:"provinceCity" json:"province_city,omitempty" query:"provinceCity"`
+	CreatedAt           *int64             `protobuf:"varint,9,opt,name=createdAt,proto3,oneof" form:"createdAt" json:"created_at" query:"createdAt"`
+	UpdatedAt           *int64             `protobuf:"varint,10,opt,name=updatedAt,proto3,oneof" form:"updatedAt" json:"updated_at" query:"updatedAt"`
+	Parent              *OrganizationDTO   `protobuf:"bytes,11,opt,name=parent,proto3,oneof" form:"parent" json:"parent,omitempty" query:"parent"`
+	Children            []*OrganizationDTO `protobuf:"bytes,12,rep,name=children,proto3" form:"children" json:"children,omitempty" query:"children"`
+	MemberCount         *int32             `protobuf:"varint,13,opt,name=memberCount,proto3,oneof" form:"memberCount" json:"member_count,omitempty" query:"memberCount"`
+	DepartmentCount     *int32             `protobuf:"varint,14,opt,name=departmentCount,proto3,oneof" form:"departmentCount" json:"department_count,omitempty" query:"departmentCount"`
+	LogoID              *string            `protobuf:"bytes,15,opt,name=logoID,proto3,oneof" form:"logoID" json:"logo_id,omitempty" query:"logoID"`
+	MfaRequired         *bool              `protobuf:"varint,16,opt,name=mfaRequired,proto3,oneof" form:"mfaRequired" json:"mfa_required" query:"mfaRequired"`
+	// 乐观锁版本号，同时以 ETag 响应头返回；更新时通过 version 或 If-Match 回传
+	Version *int64 `protobuf:"varint,17,opt,name=version,proto3,oneof" form:"version" json:"version" query:"version"`
+}
+
+func (x *OrganizationDTO) Reset() {
+	*x = OrganizationDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrganizationDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationDTO) ProtoMessage() {}
+
+func (x *OrganizationDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationDTO.ProtoReflect.Descriptor instead.
+func (*OrganizationDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *OrganizationDTO) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *OrganizationDTO) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+func (x *OrganizationDTO) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *OrganizationDTO) GetParentID() string {
+	if x != nil && x.ParentID != nil {
+		return *x.ParentID
+	}
+	return ""
+}
+
+func (x *OrganizationDTO) GetFacilityType() string {
+	if x != nil && x.FacilityType != nil {
+		return *x.FacilityType
+	}
+	return ""
+}
+
+func (x *OrganizationDTO) GetAccreditationStatus() string {
+	if x != nil && x.AccreditationStatus != nil {
+		return *x.AccreditationStatus
+	}
+	return ""
+}
+
+func (x *OrganizationDTO) GetLogo() string {
+	if x != nil && x.Logo != nil {
+		return *x.Logo
+	}
+	return ""
+}
+
+func (x *OrganizationDTO) GetProvinceCity() []string {
+	if x != nil {
+		return x.ProvinceCity
+	}
+	return nil
+}
+
+func (x *OrganizationDTO) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *OrganizationDTO) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *OrganizationDTO) GetParent() *OrganizationDTO {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *OrganizationDTO) GetChildren() []*OrganizationDTO {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *OrganizationDTO) GetMemberCount() int32 {
+	if x != nil && x.MemberCount != nil {
+		return *x.MemberCount
+	}
+	return 0
+}
+
+func (x *OrganizationDTO) GetDepartmentCount() int32 {
+	if x != nil && x.DepartmentCount != nil {
+		return *x.DepartmentCount
+	}
+	return 0
+}
+
+func (x *OrganizationDTO) GetLogoID() string {
+	if x != nil && x.LogoID != nil {
+		return *x.LogoID
+	}
+	return ""
+}
+
+func (x *OrganizationDTO) GetMfaRequired() bool {
+	if x != nil && x.MfaRequired != nil {
+		return *x.MfaRequired
+	}
+	return false
+}
+
+func (x *OrganizationDTO) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type OrganizationResponseDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResp     *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	Organization *OrganizationDTO           `protobuf:"bytes,2,opt,name=organization,proto3,oneof" form:"organization" json:"organization,omitempty" query:"organization"`
+}
+
+func (x *OrganizationResponseDTO) Reset() {
+	*x = OrganizationResponseDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrganizationResponseDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationResponseDTO) ProtoMessage() {}
+
+func (x *OrganizationResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationResponseDTO.ProtoReflect.Descriptor instead.
+func (*OrganizationResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *OrganizationResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
+	}
+	return nil
+}
+
+func (x *OrganizationResponseDTO) GetOrganization() *OrganizationDTO {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+type CreateOrganizationRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                *string  `protobuf:"bytes,1,opt,name=name,proto3,oneof" form:"name" json:"name" vd:"@:len($)>0 && len($)>=2 && len($)<=100; msg:'组织名称长度必须在2-100个字符之间'"`
+	ParentID            *string  `protobuf:"bytes,2,opt,name=parentID,proto3,oneof" form:"parent_id" json:"parent_id,omitempty" vd:"@:len($)==0 || len($)==36; msg:'父组织ID格式不正确'"`
+	FacilityType        *string  `protobuf:"bytes,3,opt,name=facilityType,proto3,oneof" form:"facility_type" json:"facility_type,omitempty" vd:"@:len($)<=100; msg:'机构类型长度不能超过100个字符'"`
+	AccreditationStatus *string  `protobuf:"bytes,4,opt,name=accreditationStatus,proto3,oneof" form:"accreditation_status" json:"accreditation_status,omitempty" vd:"@:len($)<=100; msg:'认证状态长度不能超过100个字符'"`
+	ProvinceCity        []string `protobuf:"bytes,5,rep,name=provinceCity,proto3" form:"province_city" json:"province_city,omitempty"`
+	// 是否强制组织成员启用多因素认证
+	MfaRequired *bool `protobuf:"varint,6,opt,name=mfaRequired,proto3,oneof" form:"mfa_required" json:"mfa_required,omitempty"`
+}
+
+func (x *CreateOrganizationRequestDTO) Reset() {
+	*x = CreateOrganizationRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateOrganizationRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationRequestDTO) ProtoMessage() {}
+
+func (x *CreateOrganizationRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationRequestDTO.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CreateOrganizationRequestDTO) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *CreateOrganizationRequestDTO) GetParentID() string {
+	if x != nil && x.ParentID != nil {
+		return *x.ParentID
+	}
+	return ""
+}
+
+func (x *CreateOrganizationRequestDTO) GetFacilityType() string {
+	if x != nil && x.FacilityType != nil {
+		return *x.FacilityType
+	}
+	return ""
+}
+
+func (x *CreateOrganizationRequestDTO) GetAccreditationStatus() string {
+	if x != nil && x.AccreditationStatus != nil {
+		return *x.AccreditationStatus
+	}
+	return ""
+}
+
+func (x *CreateOrganizationRequestDTO) GetProvinceCity() []string {
+	if x != nil {
+		return x.ProvinceCity
+	}
+	return nil
+}
+
+func (x *CreateOrganizationRequestDTO) GetMfaRequired() bool {
+	if x != nil && x.MfaRequired != nil {
+		return *x.MfaRequired
+	}
+	return false
+}
+
+type GetOrganizationRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrganizationID *string `protobuf:"bytes,1,opt,name=organizationID,proto3,oneof" json:"-" path:"organizationID" vd:"@:len($)==36; msg:'组织ID格式不正确'"`
+}
+
+func (x *GetOrganizationRequestDTO) Reset() {
+	*x = GetOrganizationRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrganizationRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationRequestDTO) ProtoMessage() {}
+
+func (x *GetOrganizationRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationRequestDTO.ProtoReflect.Descriptor instead.
+func (*GetOrganizationRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetOrganizationRequestDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+type UpdateOrganizationRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrganizationID      *string             `protobuf:"bytes,1,opt,name=organizationID,proto3,oneof" json:"-" path:"organizationID" vd:"@:len($)==36; msg:'组织ID格式不正确'"`
+	Name                *string             `protobuf:"bytes,2,opt,name=name,proto3,oneof" form:"name" json:"name,omitempty" vd:"@:len($)==0 || (len($)>=2 && len($)<=100); msg:'名称长度必须在2-100个字符之间'"`
+	ParentID            *string             `protobuf:"bytes,3,opt,name=parentID,proto3,oneof" form:"parent_id" json:"parent_id,omitempty" vd:"@:len($)==0 || len($)==36; msg:'父组织ID格式不正确'"`
+	FacilityType        *string             `protobuf:"bytes,4,opt,name=facilityType,proto3,oneof" form:"facility_type" json:"facility_type,omitempty" vd:"@:len($)<=100; msg:'机构类型长度不能超过100个字符'"`
+	AccreditationStatus *string             `protobuf:"bytes,5,opt,name=accreditationStatus,proto3,oneof" form:"accreditation_status" json:"accreditation_status,omitempty" vd:"@:len($)<=100; msg:'认证状态长度不能超过100个字符'"`
+	ProvinceCity        *structpb.ListValue `protobuf:"bytes,6,opt,name=provinceCity,proto3,oneof" form:"province_city" json:"province_city,omitempty"`
+	MfaRequired         *bool               `protobuf:"varint,7,opt,name=mfaRequired,proto3,oneof" form:"mfa_required" json:"mfa_required,omitempty"`
+	// 读取时获得的版本号，也可通过 If-Match 请求头传入；与当前版本不一致时返回 409
+	Version *int64  `protobuf:"varint,8,opt,name=version,proto3,oneof" form:"version" json:"version,omitempty"`
+	IfMatch *string `protobuf:"bytes,9,opt,name=ifMatch,proto3,oneof" header:"If-Match" json:"-"`
+}
+
+func (x *UpdateOrganizationRequestDTO) Reset() {
+	*x = UpdateOrganizationRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateOrganizationRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationRequestDTO) ProtoMessage() {}
+
+func (x *UpdateOrganizationRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizationRequestDTO.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *UpdateOrganizationRequestDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationRequestDTO) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationRequestDTO) GetParentID() string {
+	if x != nil && x.ParentID != nil {
+		return *x.ParentID
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationRequestDTO) GetFacilityType() string {
+	if x != nil && x.FacilityType != nil {
+		return *x.FacilityType
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationRequestDTO) GetAccreditationStatus() string {
+	if x != nil && x.AccreditationStatus != nil {
+		return *x.AccreditationStatus
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationRequestDTO) GetProvinceCity() *structpb.ListValue {
+	if x != nil {
+		return x.ProvinceCity
+	}
+	return nil
+}
+
+func (x *UpdateOrganizationRequestDTO) GetMfaRequired() bool {
+	if x != nil && x.MfaRequired != nil {
+		return *x.MfaRequired
+	}
+	return false
+}
+
+func (x *UpdateOrganizationRequestDTO) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *UpdateOrganizationRequestDTO) GetIfMatch() string {
+	if x != nil && x.IfMatch != nil {
+		return *x.IfMatch
+	}
+	return ""
+}
+
+type DeleteOrganizationRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrganizationID *string `protobuf:"bytes,1,opt,name=organizationID,proto3,oneof" json:"-" path:"organizationID" vd:"@:len($)==36; msg:'组织ID格式不正确'"`
+}
+
+func (x *DeleteOrganizationRequestDTO) Reset() {
+	*x = DeleteOrganizationRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteOrganizationRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrganizationRequestDTO) ProtoMessage() {}
+
+func (x *DeleteOrganizationRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrganizationRequestDTO.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizationRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *DeleteOrganizationRequestDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+type ListOrganizationsRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ParentID *string                   `protobuf:"bytes,1,opt,name=parentID,proto3,oneof" json:"parentID,omitempty" query:"parent_id"`
+	Page     *http_base.PageRequestDTO `protobuf:"bytes,2,opt,name=page,proto3,oneof" form:"-" json:"-" query:"-"`
+}
+
+func (x *ListOrganizationsRequestDTO) Reset() {
+	*x = ListOrganizationsRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrganizationsRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsRequestDTO) ProtoMessage() {}
+
+func (x *ListOrganizationsRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationsRequestDTO.ProtoReflect.Descriptor instead.
+func (*ListOrganizationsRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListOrganizationsRequestDTO) GetParentID() string {
+	if x != nil && x.ParentID != nil {
+		return *x.ParentID
+	}
+	return ""
+}
+
+func (x *ListOrganizationsRequestDTO) GetPage() *http_base.PageRequestDTO {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListOrganizationsResponseDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResp      *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	Organizations []*OrganizationDTO         `protobuf:"bytes,2,rep,name=organizations,proto3" form:"organizations" json:"organizations,omitempty" query:"organizations"`
+	Page          *http_base.PageResponseDTO `protobuf:"bytes,3,opt,name=page,proto3,oneof" form:"page" json:"page,omitempty" query:"page"`
+	Highlights    []*SearchHighlightDTO      `protobuf:"bytes,4,rep,name=highlights,proto3" form:"highlights" json:"highlights,omitempty" query:"highlights"`
+}
+
+func (x *ListOrganizationsResponseDTO) Reset() {
+	*x = ListOrganizationsResponseDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrganizationsResponseDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsResponseDTO) ProtoMessage() {}
+
+func (x *ListOrganizationsResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationsResponseDTO.ProtoReflect.Descriptor instead.
+func (*ListOrganizationsResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListOrganizationsResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
+	}
+	return nil
+}
+
+func (x *ListOrganizationsResponseDTO) GetOrganizations() []*OrganizationDTO {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+func (x *ListOrganizationsResponseDTO) GetPage() *http_base.PageResponseDTO {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListOrganizationsResponseDTO) GetHighlights() []*SearchHighlightDTO {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type DepartmentDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 *string          `protobuf:"bytes,1,opt,name=id,proto3,oneof" form:"id" json:"id" query:"id"`
+	Code               *string          `protobuf:"bytes,2,opt,name=code,proto3,oneof" form:"code" json:"code,omitempty" query:"code"`
+	Name               *string          `protobuf:"bytes,3,opt,name=name,proto3,oneof" form:"name" json:"name" query:"name"`
+	OrganizationID     *string          `protobuf:"bytes,4,opt,name=organizationID,proto3,oneof" form:"organizationID" json:"organization_id" query:"organizationID"`
+	DepartmentType     *string          `protobuf:"bytes,5,opt,name=departmentType,proto3,oneof" form:"departmentType" json:"department_type,omitempty" query:"departmentType"`
+	AvailableEquipment []string         `protobuf:"bytes,6,rep,name=availableEquipment,proto3" form:"availableEquipment" json:"available_equipment,omitempty" query:"availableEquipment"`
+	CreatedAt          *int64           `protobuf:"varint,7,opt,name=createdAt,proto3,oneof" form:"createdAt" json:"created_at" query:"createdAt"`
+	UpdatedAt          *int64           `protobuf:"varint,8,opt,name=updatedAt,proto3,oneof" form:"updatedAt" json:"updated_at" query:"updatedAt"`
+	Organization       *OrganizationDTO `protobuf:"bytes,9,opt,name=organization,proto3,oneof" form:"organization" json:"organization,omitempty" query:"organization"`
+	MemberCount        *int32           `protobuf:"varint,10,opt,name=memberCount,proto3,oneof" form:"memberCount" json:"member_count,omitempty" query:"memberCount"`
+	// 乐观锁版本号，同时以 ETag 响应头返回；更新时通过 version 或 If-Match 回传
+	Version *int64 `protobuf:"varint,11,opt,name=version,proto3,oneof" form:"version" json:"version" query:"version"`
+}
+
+func (x *DepartmentDTO) Reset() {
+	*x = DepartmentDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepartmentDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartmentDTO) ProtoMessage() {}
+
+func (x *DepartmentDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartmentDTO.ProtoReflect.Descriptor instead.
+func (*DepartmentDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *DepartmentDTO) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *DepartmentDTO) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+func (x *DepartmentDTO) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *DepartmentDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *DepartmentDTO) GetDepartmentType() string {
+	if x != nil && x.DepartmentType != nil {
+		return *x.DepartmentType
+	}
+	return ""
+}
+
+func (x *DepartmentDTO) GetAvailableEquipment() []string {
+	if x != nil {
+		return x.AvailableEquipment
+	}
+	return nil
+}
+
+func (x *DepartmentDTO) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *DepartmentDTO) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *DepartmentDTO) GetOrganization() *OrganizationDTO {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *DepartmentDTO) GetMemberCount() int32 {
+	if x != nil && x.MemberCount != nil {
+		return *x.MemberCount
+	}
+	return 0
+}
+
+func (x *DepartmentDTO) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type DepartmentResponseDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResp   *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	Department *DepartmentDTO             `protobuf:"bytes,2,opt,name=department,proto3,oneof" form:"department" json:"department,omitempty" query:"department"`
+}
+
+func (x *DepartmentResponseDTO) Reset() {
+	*x = DepartmentResponseDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepartmentResponseDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartmentResponseDTO) ProtoMessage() {}
+
+func (x *DepartmentResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartmentResponseDTO.ProtoReflect.Descriptor instead.
+func (*DepartmentResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *DepartmentResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
+	}
+	return nil
+}
+
+func (x *DepartmentResponseDTO) GetDepartment() *DepartmentDTO {
+	if x != nil {
+		return x.Department
+	}
+	return nil
+}
+
+type CreateDepartmentRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrganizationID *string `protobuf:"bytes,1,opt,name=organizationID,proto3,oneof" form:"organization_id" json:"organization_id" vd:"@:len($)==36; msg:'组织ID格式不正确'"`
+	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" form:"name" json:"name" vd:"@:len($)>0 && len($)>=2 && len($)<=100; msg:'部门名称长度必须在2-100个字符之间'"`
+	DepartmentType *string `protobuf:"bytes,3,opt,name=departmentType,proto3,oneof" form:"department_type" json:"department_type,omitempty" vd:"@:len($)<=50; msg:'部门类型长度不能超过50个字符'"`
+}
+
+func (x *CreateDepartmentRequestDTO) Reset() {
+	*x = CreateDepartmentRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateDepartmentRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDepartmentRequestDTO) ProtoMessage() {}
+
+func (x *CreateDepartmentRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDepartmentRequestDTO.ProtoReflect.Descriptor instead.
+func (*CreateDepartmentRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CreateDepartmentRequestDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *CreateDepartmentRequestDTO) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *CreateDepartmentRequestDTO) GetDepartmentType() string {
+	if x != nil && x.DepartmentType != nil {
+		return *x.DepartmentType
+	}
+	return ""
+}
+
+type GetDepartmentRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartmentID *string `protobuf:"bytes,1,opt,name=departmentID,proto3,oneof" json:"-" path:"departmentID" vd:"@:len($)==36; msg:'部门ID格式不正确'"`
+}
+
+func (x *GetDepartmentRequestDTO) Reset() {
+	*x = GetDepartmentRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDepartmentRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepartmentRequestDTO) ProtoMessage() {}
+
+func (x *GetDepartmentRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepartmentRequestDTO.ProtoReflect.Descriptor instead.
+func (*GetDepartmentRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetDepartmentRequestDTO) GetDepartmentID() string {
+	if x != nil && x.DepartmentID != nil {
+		return *x.DepartmentID
+	}
+	return ""
+}
+
+type UpdateDepartmentRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartmentID   *string `protobuf:"bytes,1,opt,name=departmentID,proto3,oneof" json:"-" path:"departmentID" vd:"@:len($)==36; msg:'部门ID格式不正确'"`
+	Name           *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" form:"name" json:"name,omitempty" vd:"@:len($)==0 || (len($)>=2 && len($)<=100); msg:'名称长度必须在2-100个字符之间'"`
+	DepartmentType *string `protobuf:"bytes,3,opt,name=departmentType,proto3,oneof" form:"department_type" json:"department_type,omitempty" vd:"@:len($)<=50; msg:'部门类型长度不能超过50个字符'"`
+	// 读取时获得的版本号，也可通过 If-Match 请求头传入；与当前版本不一致时返回 409
+	Version *int64  `protobuf:"varint,4,opt,name=version,proto3,oneof" form:"version" json:"version,omitempty"`
+	IfMatch *string `protobuf:"bytes,5,opt,name=ifMatch,proto3,oneof" header:"If-Match" json:"-"`
+}
+
+func (x *UpdateDepartmentRequestDTO) Reset() {
+	*x = UpdateDepartmentRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDepartmentRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDepartmentRequestDTO) ProtoMessage() {}
+
+func (x *UpdateDepartmentRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDepartmentRequestDTO.ProtoReflect.Descriptor instead.
+func (*UpdateDepartmentRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *UpdateDepartmentRequestDTO) GetDepartmentID() string {
+	if x != nil && x.DepartmentID != nil {
+		return *x.DepartmentID
+	}
+	return ""
+}
+
+func (x *UpdateDepartmentRequestDTO) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateDepartmentRequestDTO) GetDepartmentType() string {
+	if x != nil && x.DepartmentType != nil {
+		return *x.DepartmentType
+	}
+	return ""
+}
+
+func (x *UpdateDepartmentRequestDTO) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *UpdateDepartmentRequestDTO) GetIfMatch() string {
+	if x != nil && x.IfMatch != nil {
+		return *x.IfMatch
+	}
+	return ""
+}
+
+type DeleteDepartmentRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartmentID *string `protobuf:"bytes,1,opt,name=departmentID,proto3,oneof" json:"-" path:"departmentID" vd:"@:len($)==36; msg:'部门ID格式不正确'"`
+}
+
+func (x *DeleteDepartmentRequestDTO) Reset() {
+	*x = DeleteDepartmentRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDepartmentRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDepartmentRequestDTO) ProtoMessage() {}
+
+func (x *DeleteDepartmentRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDepartmentRequestDTO.ProtoReflect.Descriptor instead.
+func (*DeleteDepartmentRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DeleteDepartmentRequestDTO) GetDepartmentID() string {
+	if x != nil && x.DepartmentID != nil {
+		return *x.DepartmentID
+	}
+	return ""
+}
+
+type GetOrganizationDepartmentsRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrganizationID *string                   `protobuf:"bytes,1,opt,name=organizationID,proto3,oneof" json:"-" path:"organizationID" vd:"@:len($)==36; msg:'组织ID格式不正确'"`
+	Page           *http_base.PageRequestDTO `protobuf:"bytes,2,opt,name=page,proto3,oneof" form:"-" json:"-" query:"-"`
+}
+
+func (x *GetOrganizationDepartmentsRequestDTO) Reset() {
+	*x = GetOrganizationDepartmentsRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrganizationDepartmentsRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationDepartmentsRequestDTO) ProtoMessage() {}
+
+func (x *GetOrganizationDepartmentsRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationDepartmentsRequestDTO.ProtoReflect.Descriptor instead.
+func (*GetOrganizationDepartmentsRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetOrganizationDepartmentsRequestDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *GetOrganizationDepartmentsRequestDTO) GetPage() *http_base.PageRequestDTO {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GetOrganizationDepartmentsResponseDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResp    *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	Departments []*DepartmentDTO           `protobuf:"bytes,2,rep,name=departments,proto3" form:"departments" json:"departments,omitempty" query:"departments"`
+	Page        *http_base.PageResponseDTO `protobuf:"bytes,3,opt,name=page,proto3,oneof" form:"page" json:"page,omitempty" query:"page"`
+	Highlights  []*SearchHighlightDTO      `protobuf:"bytes,4,rep,name=highlights,proto3" form:"highlights" json:"highlights,omitempty" query:"highlights"`
+}
+
+func (x *GetOrganizationDepartmentsResponseDTO) Reset() {
+	*x = GetOrganizationDepartmentsResponseDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrganizationDepartmentsResponseDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationDepartmentsResponseDTO) ProtoMessage() {}
+
+func (x *GetOrganizationDepartmentsResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationDepartmentsResponseDTO.ProtoReflect.Descriptor instead.
+func (*GetOrganizationDepartmentsResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetOrganizationDepartmentsResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
+	}
+	return nil
+}
+
+func (x *GetOrganizationDepartmentsResponseDTO) GetDepartments() []*DepartmentDTO {
+	if x != nil {
+		return x.Departments
+	}
+	return nil
+}
+
+func (x *GetOrganizationDepartmentsResponseDTO) GetPage() *http_base.PageResponseDTO {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetOrganizationDepartmentsResponseDTO) GetHighlights() []*SearchHighlightDTO {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type OrganizationLogoDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" form:"id" json:"id" query:"id"`
+	Status              *string `protobuf:"bytes,2,opt,name=status,proto3,oneof" form:"status" json:"status" query:"status"`
+	BoundOrganizationID *string `protobuf:"bytes,3,opt,name=boundOrganizationID,proto3,oneof" form:"boundOrganizationID" json:"bound_organization_id,omitempty" query:"boundOrganizationID"`
+	FileID              *string `protobuf:"bytes,4,opt,name=fileID,proto3,oneof" form:"fileID" json:"file_id" query:"fileID"`
+	FileName            *string `protobuf:"bytes,5,opt,name=fileName,proto3,oneof" form:"fileName" json:"file_name" query:"fileName"`
+	FileSize            *int64  `protobuf:"varint,6,opt,name=fileSize,proto3,oneof" form:"fileSize" json:"file_size" query:"fileSize"`
+	MimeType            *string `protobuf:"bytes,7,opt,name=mimeType,proto3,oneof" form:"mimeType" json:"mime_type" query:"mimeType"`
+	ExpiresAt           *int64  `protobuf:"varint,8,opt,name=expiresAt,proto3,oneof" form:"expiresAt" json:"expires_at,omitempty" query:"expiresAt"`
+	DownloadUrl         *string `protobuf:"bytes,9,opt,name=downloadUrl,proto3,oneof" form:"downloadUrl" json:"download_url,omitempty" query:"downloadUrl"`
+	UploadedBy          *string `protobuf:"bytes,10,opt,name=uploadedBy,proto3,oneof" form:"uploadedBy" json:"uploaded_by" query:"uploadedBy"`
+	CreatedAt           *int64  `protobuf:"varint,11,opt,name=createdAt,proto3,oneof" form:"createdAt" json:"created_at" query:"createdAt"`
+	UpdatedAt           *int64  `protobuf:"varint,12,opt,name=updatedAt,proto3,oneof" form:"updatedAt" json:"updated_at" query:"updatedAt"`
+	Version             *int64  `protobuf:"varint,13,opt,name=version,proto3,oneof" form:"version" json:"version" query:"version"`
+}
+
+func (x *OrganizationLogoDTO) Reset() {
+	*x = OrganizationLogoDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrganizationLogoDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationLogoDTO) ProtoMessage() {}
+
+func (x *OrganizationLogoDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationLogoDTO.ProtoReflect.Descriptor instead.
+func (*OrganizationLogoDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *OrganizationLogoDTO) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *OrganizationLogoDTO) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *OrganizationLogoDTO) GetBoundOrganizationID() string {
+	if x != nil && x.BoundOrganizationID != nil {
+		return *x.BoundOrganizationID
+	}
+	return ""
+}
+
+func (x *OrganizationLogoDTO) GetFileID() string {
+	if x != nil && x.FileID != nil {
+		return *x.FileID
+	}
+	return ""
+}
+
+func (x *OrganizationLogoDTO) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
+	}
+	return ""
+}
+
+func (x *OrganizationLogoDTO) GetFileSize() int64 {
+	if x != nil && x.FileSize != nil {
+		return *x.FileSize
+	}
+	return 0
+}
+
+func (x *OrganizationLogoDTO) GetMimeType() string {
+	if x != nil && x.MimeType != nil {
+		return *x.MimeType
+	}
+	return ""
+}
+
+func (x *OrganizationLogoDTO) GetExpiresAt() int64 {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *OrganizationLogoDTO) GetDownloadUrl() string {
+	if x != nil && x.DownloadUrl != nil {
+		return *x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *OrganizationLogoDTO) GetUploadedBy() string {
+	if x != nil && x.UploadedBy != nil {
+		return *x.UploadedBy
+	}
+	return ""
+}
+
+func (x *OrganizationLogoDTO) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *OrganizationLogoDTO) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *OrganizationLogoDTO) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type OrganizationLogoResponseDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResp *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	Logo     *OrganizationLogoDTO       `protobuf:"bytes,2,opt,name=logo,proto3,oneof" form:"logo" json:"logo,omitempty" query:"logo"`
+}
+
+func (x *OrganizationLogoResponseDTO) Reset() {
+	*x = OrganizationLogoResponseDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrganizationLogoResponseDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationLogoResponseDTO) ProtoMessage() {}
+
+func (x *OrganizationLogoResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationLogoResponseDTO.ProtoReflect.Descriptor instead.
+func (*OrganizationLogoResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *OrganizationLogoResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
+	}
+	return nil
+}
+
+func (x *OrganizationLogoResponseDTO) GetLogo() *OrganizationLogoDTO {
+	if x != nil {
+		return x.Logo
+	}
+	return nil
+}
+
+type UploadTemporaryLogoRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileName    *string `protobuf:"bytes,1,opt,name=fileName,proto3,oneof" form:"file_name" json:"file_name" vd:"@:len($)>0; msg:'文件名不能为空'"`
+	FileContent []byte  `protobuf:"bytes,2,opt,name=fileContent,proto3,oneof" form:"file_content" json:"file_content"`
+	MimeType    *string `protobuf:"bytes,3,opt,name=mimeType,proto3,oneof" form:"mime_type" json:"mime_type,omitempty"`
+}
+
+func (x *UploadTemporaryLogoRequestDTO) Reset() {
+	*x = UploadTemporaryLogoRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadTemporaryLogoRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadTemporaryLogoRequestDTO) ProtoMessage() {}
+
+func (x *UploadTemporaryLogoRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadTemporaryLogoRequestDTO.ProtoReflect.Descriptor instead.
+func (*UploadTemporaryLogoRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *UploadTemporaryLogoRequestDTO) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
+	}
+	return ""
+}
+
+func (x *UploadTemporaryLogoRequestDTO) GetFileContent() []byte {
+	if x != nil {
+		return x.FileContent
+	}
+	return nil
+}
+
+func (x *UploadTemporaryLogoRequestDTO) GetMimeType() string {
+	if x != nil && x.MimeType != nil {
+		return *x.MimeType
+	}
+	return ""
+}
+
+type GetOrganizationLogoRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LogoID *string `protobuf:"bytes,1,opt,name=logoID,proto3,oneof" json:"-" path:"logoID" vd:"@:len($)==36; msg:'Logo ID格式不正确'"`
+}
+
+func (x *GetOrganizationLogoRequestDTO) Reset() {
+	*x = GetOrganizationLogoRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrganizationLogoRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationLogoRequestDTO) ProtoMessage() {}
+
+func (x *GetOrganizationLogoRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationLogoRequestDTO.ProtoReflect.Descriptor instead.
+func (*GetOrganizationLogoRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetOrganizationLogoRequestDTO) GetLogoID() string {
+	if x != nil && x.LogoID != nil {
+		return *x.LogoID
+	}
+	return ""
+}
+
+type DeleteOrganizationLogoRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LogoID *string `protobuf:"bytes,1,opt,name=logoID,proto3,oneof" json:"-" path:"logoID" vd:"@:len($)==36; msg:'Logo ID格式不正确'"`
+}
+
+func (x *DeleteOrganizationLogoRequestDTO) Reset() {
+	*x = DeleteOrganizationLogoRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteOrganizationLogoRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrganizationLogoRequestDTO) ProtoMessage() {}
+
+func (x *DeleteOrganizationLogoRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrganizationLogoRequestDTO.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizationLogoRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *DeleteOrganizationLogoRequestDTO) GetLogoID() string {
+	if x != nil && x.LogoID != nil {
+		return *x.LogoID
+	}
+	return ""
+}
+
+type BindLogoToOrganizationRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrganizationID *string `protobuf:"bytes,1,opt,name=organizationID,proto3,oneof" json:"-" path:"organizationID" vd:"@:len($)==36; msg:'组织ID格式不正确'"`
+	LogoID         *string `protobuf:"bytes,2,opt,name=logoID,proto3,oneof" form:"logo_id" json:"logo_id" vd:"@:len($)==36; msg:'Logo ID格式不正确'"`
+}
+
+func (x *BindLogoToOrganizationRequestDTO) Reset() {
+	*x = BindLogoToOrganizationRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BindLogoToOrganizationRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindLogoToOrganizationRequestDTO) ProtoMessage() {}
+
+func (x *BindLogoToOrganizationRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BindLogoToOrganizationRequestDTO.ProtoReflect.Descriptor instead.
+func (*BindLogoToOrganizationRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *BindLogoToOrganizationRequestDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *BindLogoToOrganizationRequestDTO) GetLogoID() string {
+	if x != nil && x.LogoID != nil {
+		return *x.LogoID
+	}
+	return ""
+}
+
+type AuditLogDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" form:"id" json:"id" query:"id"`
+	RequestID      *string `protobuf:"bytes,2,opt,name=requestID,proto3,oneof" form:"requestID" json:"request_id" query:"requestID"`
+	TraceID        *string `protobuf:"bytes,3,opt,name=traceID,proto3,oneof" form:"traceID" json:"trace_id" query:"traceID"`
+	UserID         *string `protobuf:"bytes,4,opt,name=userID,proto3,oneof" form:"userID" json:"user_id,omitempty" query:"userID"`
+	Username       *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" form:"username" json:"username,omitempty" query:"username"`
+	OrganizationID *string `protobuf:"bytes,6,opt,name=organizationID,proto3,oneof" form:"organizationID" json:"organization_id,omitempty" query:"organizationID"`
+	Action         *int32  `protobuf:"varint,7,opt,name=action,proto3,oneof" form:"action" json:"action" query:"action"`
+	Resource       *string `protobuf:"bytes,8,opt,name=resource,proto3,oneof" form:"resource" json:"resource" query:"resource"`
+	ResourceID     *string `protobuf:"bytes,9,opt,name=resourceID,proto3,oneof" form:"resourceID" json:"resource_id,omitempty" query:"resourceID"`
+	StatusCode     *int32  `protobuf:"varint,10,opt,name=statusCode,proto3,oneof" form:"statusCode" json:"status_code" query:"statusCode"`
+	Success        *bool   `protobuf:"varint,11,opt,name=success,proto3,oneof" form:"success" json:"success" query:"success"`
+	ClientIP       *string `protobuf:"bytes,12,opt,name=clientIP,proto3,oneof" form:"clientIP" json:"client_ip" query:"clientIP"`
+	UserAgent      *string `protobuf:"bytes,13,opt,name=userAgent,proto3,oneof" form:"userAgent" json:"user_agent,omitempty" query:"userAgent"`
+	RequestBody    *string `protobuf:"bytes,14,opt,name=requestBody,proto3,oneof" form:"requestBody" json:"request_body,omitempty" query:"requestBody"`
+	DurationMs     *int32  `protobuf:"varint,15,opt,name=durationMs,proto3,oneof" form:"durationMs" json:"duration_ms" query:"durationMs"`
+	CreatedAt      *int64  `protobuf:"varint,16,opt,name=createdAt,proto3,oneof" form:"createdAt" json:"created_at" query:"createdAt"`
+}
+
+func (x *AuditLogDTO) Reset() {
+	*x = AuditLogDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogDTO) ProtoMessage() {}
+
+func (x *AuditLogDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogDTO.ProtoReflect.Descriptor instead.
+func (*AuditLogDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *AuditLogDTO) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *AuditLogDTO) GetRequestID() string {
+	if x != nil && x.RequestID != nil {
+		return *x.RequestID
+	}
+	return ""
+}
+
+func (x *AuditLogDTO) GetTraceID() string {
+	if x != nil && x.TraceID != nil {
+		return *x.TraceID
+	}
+	return ""
+}
+
+func (x *AuditLogDTO) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *AuditLogDTO) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *AuditLogDTO) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *AuditLogDTO) GetAction() int32 {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return 0
+}
+
+func (x *AuditLogDTO) GetResource() string {
+	if x != nil && x.Resource != nil {
+		return *x.Resource
+	}
+	return ""
+}
+
+func (x *AuditLogDTO) GetResourceID() string {
+	if x != nil && x.ResourceID != nil {
+		return *x.ResourceID
+	}
+	return ""
+}
+
+func (x *AuditLogDTO) GetStatusCode() int32 {
+	if x != nil && x.StatusCode != nil {
+		return *x.StatusCode
+	}
+	return 0
+}
+
+func (x *AuditLogDTO) GetSuccess() bool {
+	if x != nil && x.Success != nil {
+		return *x.Success
+	}
+	return false
+}
+
+func (x *AuditLogDTO) GetClientIP() string {
+	if x != nil && x.ClientIP != nil {
+		return *x.ClientIP
+	}
+	return ""
+}
+
+func (x *AuditLogDTO) GetUserAgent() string {
+	if x != nil && x.UserAgent != nil {
+		return *x.UserAgent
+	}
+	return ""
+}
+
+func (x *AuditLogDTO) GetRequestBody() string {
+	if x != nil && x.RequestBody != nil {
+		return *x.RequestBody
+	}
+	return ""
+}
+
+func (x *AuditLogDTO) GetDurationMs() int32 {
+	if x != nil && x.DurationMs != nil {
+		return *x.DurationMs
+	}
+	return 0
+}
+
+func (x *AuditLogDTO) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+type ListAuditLogsRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page      *http_base.PageRequestDTO `protobuf:"bytes,1,opt,name=page,proto3,oneof" form:"-" json:"-" query:"-"`
+	UserID    *string                   `protobuf:"bytes,2,opt,name=userID,proto3,oneof" json:"user_id,omitempty" query:"user_id"`
+	Action    *int32                    `protobuf:"varint,3,opt,name=action,proto3,oneof" json:"action,omitempty" query:"action"`
+	Resource  *string                   `protobuf:"bytes,4,opt,name=resource,proto3,oneof" json:"resource,omitempty" query:"resource"`
+	Success   *bool                     `protobuf:"varint,5,opt,name=success,proto3,oneof" json:"success,omitempty" query:"success"`
+	StartTime *int64                    `protobuf:"varint,6,opt,name=startTime,proto3,oneof" json:"start_time,omitempty" query:"start_time"`
+	EndTime   *int64                    `protobuf:"varint,7,opt,name=endTime,proto3,oneof" json:"end_time,omitempty" query:"end_time"`
+}
+
+func (x *ListAuditLogsRequestDTO) Reset() {
+	*x = ListAuditLogsRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditLogsRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsRequestDTO) ProtoMessage() {}
+
+func (x *ListAuditLogsRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsRequestDTO.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ListAuditLogsRequestDTO) GetPage() *http_base.PageRequestDTO {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListAuditLogsRequestDTO) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequestDTO) GetAction() int32 {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequestDTO) GetResource() string {
+	if x != nil && x.Resource != nil {
+		return *x.Resource
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequestDTO) GetSuccess() bool {
+	if x != nil && x.Success != nil {
+		return *x.Success
+	}
+	return false
+}
+
+func (x *ListAuditLogsRequestDTO) GetStartTime() int64 {
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequestDTO) GetEndTime() int64 {
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
+	}
+	return 0
+}
+
+type AuditLogStatsDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalCount    *int64 `protobuf:"varint,1,opt,name=totalCount,proto3,oneof" form:"totalCount" json:"total_count" query:"totalCount"`
+	SuccessCount  *int64 `protobuf:"varint,2,opt,name=successCount,proto3,oneof" form:"successCount" json:"success_count" query:"successCount"`
+	AvgDurationMs *int32 `protobuf:"varint,3,opt,name=avgDurationMs,proto3,oneof" form:"avgDurationMs" json:"avg_duration_ms" query:"avgDurationMs"`
+}
+
+func (x *AuditLogStatsDTO) Reset() {
+	*x = AuditLogStatsDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogStatsDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogStatsDTO) ProtoMessage() {}
+
+func (x *AuditLogStatsDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogStatsDTO.ProtoReflect.Descriptor instead.
+func (*AuditLogStatsDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *AuditLogStatsDTO) GetTotalCount() int64 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
+	}
+	return 0
+}
+
+func (x *AuditLogStatsDTO) GetSuccessCount() int64 {
+	if x != nil && x.SuccessCount != nil {
+		return *x.SuccessCount
+	}
+	return 0
+}
+
+func (x *AuditLogStatsDTO) GetAvgDurationMs() int32 {
+	if x != nil && x.AvgDurationMs != nil {
+		return *x.AvgDurationMs
+	}
+	return 0
+}
+
+type ListAuditLogsResponseDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResp  *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	AuditLogs []*AuditLogDTO             `protobuf:"bytes,2,rep,name=auditLogs,proto3" form:"auditLogs" json:"audit_logs,omitempty" query:"auditLogs"`
+	Page      *http_base.PageResponseDTO `protobuf:"bytes,3,opt,name=page,proto3,oneof" form:"page" json:"page,omitempty" query:"page"`
+	Stats     *AuditLogStatsDTO          `protobuf:"bytes,4,opt,name=stats,proto3,oneof" form:"stats" json:"stats,omitempty" query:"stats"`
+}
+
+func (x *ListAuditLogsResponseDTO) Reset() {
+	*x = ListAuditLogsResponseDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditLogsResponseDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsResponseDTO) ProtoMessage() {}
+
+func (x *ListAuditLogsResponseDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsResponseDTO.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsResponseDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListAuditLogsResponseDTO) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
+	}
+	return nil
+}
+
+func (x *ListAuditLogsResponseDTO) GetAuditLogs() []*AuditLogDTO {
+	if x != nil {
+		return x.AuditLogs
+	}
+	return nil
+}
+
+func (x *ListAuditLogsResponseDTO) GetPage() *http_base.PageResponseDTO {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListAuditLogsResponseDTO) GetStats() *AuditLogStatsDTO {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type GetMeRequestDTO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetMeRequestDTO) Reset() {
+	*x = GetMeRequestDTO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeRequestDTO) ProtoMessage() {}
+
+func (x *GetMeRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeRequestDTO.ProtoReflect.Descriptor instead.
+func (*GetMeRequestDTO) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{108}
+}
+
+type EmptyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EmptyRequest) Reset() {
+	*x = EmptyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmptyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyRequest) ProtoMessage() {}
+
+func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyRequest.ProtoReflect.Descriptor instead.
+func (*EmptyRequest) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{109}
+}
+
+type EmptyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EmptyResponse) Reset() {
+	*x = EmptyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmptyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyResponse) ProtoMessage() {}
+
+func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyResponse.ProtoReflect.Descriptor instead.
+func (*EmptyResponse) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{110}
+}
+
+type OIDCDiscoveryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Issuer                            *string  `protobuf:"bytes,1,opt,name=issuer,proto3,oneof" form:"issuer" json:"issuer" query:"issuer"`
+	AuthorizationEndpoint             *string  `protobuf:"bytes,2,opt,name=authorization_endpoint,json=authorizationEndpoint,proto3,oneof" form:"authorization_endpoint" json:"authorization_endpoint" query:"authorization_endpoint"`
+	TokenEndpoint                     *string  `protobuf:"bytes,3,opt,name=token_endpoint,json=tokenEndpoint,proto3,oneof" form:"token_endpoint" json:"token_endpoint" query:"token_endpoint"`
+	UserinfoEndpoint                  *string  `protobuf:"bytes,4,opt,name=userinfo_endpoint,json=userinfoEndpoint,proto3,oneof" form:"userinfo_endpoint" json:"userinfo_endpoint" query:"userinfo_endpoint"`
+	RevocationEndpoint                *string  `protobuf:"bytes,5,opt,name=revocation_endpoint,json=revocationEndpoint,proto3,oneof" form:"revocation_endpoint" json:"revocation_endpoint" query:"revocation_endpoint"`
+	IntrospectionEndpoint             *string  `protobuf:"bytes,6,opt,name=introspection_endpoint,json=introspectionEndpoint,proto3,oneof" form:"introspection_endpoint" json:"introspection_endpoint" query:"introspection_endpoint"`
+	JwksUri                           *string  `protobuf:"bytes,7,opt,name=jwks_uri,json=jwksUri,proto3,oneof" form:"jwks_uri" json:"jwks_uri" query:"jwks_uri"`
+	ResponseTypesSupported            []string `protobuf:"bytes,8,rep,name=response_types_supported,json=responseTypesSupported,proto3" form:"response_types_supported" json:"response_types_supported" query:"response_types_supported"`
+	SubjectTypesSupported             []string `protobuf:"bytes,9,rep,name=subject_types_supported,json=subjectTypesSupported,proto3" form:"subject_types_supported" json:"subject_types_supported" query:"subject_types_supported"`
+	IdTokenSigningAlgValuesSupported  []string `protobuf:"bytes,10,rep,name=id_token_signing_alg_values_supported,json=idTokenSigningAlgValuesSupported,proto3" form:"id_token_signing_alg_values_supported" json:"id_token_signing_alg_values_supported" query:"id_token_signing_alg_values_supported"`
+	ScopesSupported                   []string `protobuf:"bytes,11,rep,name=scopes_supported,json=scopesSupported,proto3" form:"scopes_supported" json:"scopes_supported" query:"scopes_supported"`
+	TokenEndpointAuthMethodsSupported []string `protobuf:"bytes,12,rep,name=token_endpoint_auth_methods_supported,json=tokenEndpointAuthMethodsSupported,proto3" form:"token_endpoint_auth_methods_supported" json:"token_endpoint_auth_methods_supported" query:"token_endpoint_auth_methods_supported"`
+}
+
+func (x *OIDCDiscoveryResponse) Reset() {
+	*x = OIDCDiscoveryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCDiscoveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCDiscoveryResponse) ProtoMessage() {}
+
+func (x *OIDCDiscoveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCDiscoveryResponse.ProtoReflect.Descriptor instead.
+func (*OIDCDiscoveryResponse) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *OIDCDiscoveryResponse) GetIssuer() string {
+	if x != nil && x.Issuer != nil {
+		return *x.Issuer
+	}
+	return ""
+}
+
+func (x *OIDCDiscoveryResponse) GetAuthorizationEndpoint() string {
+	if x != nil && x.AuthorizationEndpoint != nil {
+		return *x.AuthorizationEndpoint
+	}
+	return ""
+}
+
+func (x *OIDCDiscoveryResponse) GetTokenEndpoint() string {
+	if x != nil && x.TokenEndpoint != nil {
+		return *x.TokenEndpoint
+	}
+	return ""
+}
+
+func (x *OIDCDiscoveryResponse) GetUserinfoEndpoint() string {
+	if x != nil && x.UserinfoEndpoint != nil {
+		return *x.UserinfoEndpoint
+	}
+	return ""
+}
+
+func (x *OIDCDiscoveryResponse) GetRevocationEndpoint() string {
+	if x != nil && x.RevocationEndpoint != nil {
+		return *x.RevocationEndpoint
+	}
+	return ""
+}
+
+func (x *OIDCDiscoveryResponse) GetIntrospectionEndpoint() string {
+	if x != nil && x.IntrospectionEndpoint != nil {
+		return *x.IntrospectionEndpoint
+	}
+	return ""
+}
+
+func (x *OIDCDiscoveryResponse) GetJwksUri() string {
+	if x != nil && x.JwksUri != nil {
+		return *x.JwksUri
+	}
+	return ""
+}
+
+func (x *OIDCDiscoveryResponse) GetResponseTypesSupported() []string {
+	if x != nil {
+		return x.ResponseTypesSupported
+	}
+	return nil
+}
+
+func (x *OIDCDiscoveryResponse) GetSubjectTypesSupported() []string {
+	if x != nil {
+		return x.SubjectTypesSupported
+	}
+	return nil
+}
+
+func (x *OIDCDiscoveryResponse) GetIdTokenSigningAlgValuesSupported() []string {
+	if x != nil {
+		return x.IdTokenSigningAlgValuesSupported
+	}
+	return nil
+}
+
+func (x *OIDCDiscoveryResponse) GetScopesSupported() []string {
+	if x != nil {
+		return x.ScopesSupported
+	}
+	return nil
+}
+
+func (x *OIDCDiscoveryResponse) GetTokenEndpointAuthMethodsSupported() []string {
+	if x != nil {
+		return x.TokenEndpointAuthMethodsSupported
+	}
+	return nil
+}
+
+type OIDCJWKSResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys *string `protobuf:"bytes,1,opt,name=keys,proto3,oneof" form:"keys" json:"keys" query:"keys"`
+}
+
+func (x *OIDCJWKSResponse) Reset() {
+	*x = OIDCJWKSResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCJWKSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCJWKSResponse) ProtoMessage() {}
+
+func (x *OIDCJWKSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCJWKSResponse.ProtoReflect.Descriptor instead.
+func (*OIDCJWKSResponse) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *OIDCJWKSResponse) GetKeys() string {
+	if x != nil && x.Keys != nil {
+		return *x.Keys
+	}
+	return ""
+}
+
+type OIDCAuthorizeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResponseType        *string `protobuf:"bytes,1,opt,name=response_type,json=responseType,proto3,oneof" json:"response_type" query:"response_type" vd:"@:len($)>0; msg:'response_type不能为空'"`
+	ClientId            *string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id" query:"client_id" vd:"@:len($)>0; msg:'client_id不能为空'"`
+	RedirectUri         *string `protobuf:"bytes,3,opt,name=redirect_uri,json=redirectUri,proto3,oneof" json:"redirect_uri" query:"redirect_uri" vd:"@:len($)>0; msg:'redirect_uri不能为空'"`
+	Scope               *string `protobuf:"bytes,4,opt,name=scope,proto3,oneof" json:"scope" query:"scope" vd:"@:len($)>0; msg:'scope不能为空'"`
+	State               *string `protobuf:"bytes,5,opt,name=state,proto3,oneof" json:"state,omitempty" query:"state"`
+	Nonce               *string `protobuf:"bytes,6,opt,name=nonce,proto3,oneof" json:"nonce,omitempty" query:"nonce"`
+	CodeChallenge       *string `protobuf:"bytes,7,opt,name=code_challenge,json=codeChallenge,proto3,oneof" json:"code_challenge,omitempty" query:"code_challenge"`
+	CodeChallengeMethod *string `protobuf:"bytes,8,opt,name=code_challenge_method,json=codeChallengeMethod,proto3,oneof" json:"code_challenge_method,omitempty" query:"code_challenge_method"`
+}
+
+func (x *OIDCAuthorizeRequest) Reset() {
+	*x = OIDCAuthorizeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCAuthorizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCAuthorizeRequest) ProtoMessage() {}
+
+func (x *OIDCAuthorizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCAuthorizeRequest.ProtoReflect.Descriptor instead.
+func (*OIDCAuthorizeRequest) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *OIDCAuthorizeRequest) GetResponseType() string {
+	if x != nil && x.ResponseType != nil {
+		return *x.ResponseType
+	}
+	return ""
+}
+
+func (x *OIDCAuthorizeRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *OIDCAuthorizeRequest) GetRedirectUri() string {
+	if x != nil && x.RedirectUri != nil {
+		return *x.RedirectUri
+	}
+	return ""
+}
+
+func (x *OIDCAuthorizeRequest) GetScope() string {
+	if x != nil && x.Scope != nil {
+		return *x.Scope
+	}
+	return ""
+}
+
+func (x *OIDCAuthorizeRequest) GetState() string {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return ""
+}
+
+func (x *OIDCAuthorizeRequest) GetNonce() string {
+	if x != nil && x.Nonce != nil {
+		return *x.Nonce
+	}
+	return ""
+}
+
+func (x *OIDCAuthorizeRequest) GetCodeChallenge() string {
+	if x != nil && x.CodeChallenge != nil {
+		return *x.CodeChallenge
+	}
+	return ""
+}
+
+func (x *OIDCAuthorizeRequest) GetCodeChallengeMethod() string {
+	if x != nil && x.CodeChallengeMethod != nil {
+		return *x.CodeChallengeMethod
+	}
+	return ""
+}
+
+type OIDCAuthorizeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResp    *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	RedirectUrl *string                    `protobuf:"bytes,2,opt,name=redirect_url,json=redirectUrl,proto3,oneof" form:"redirect_url" json:"redirect_url" query:"redirect_url"`
+}
+
+func (x *OIDCAuthorizeResponse) Reset() {
+	*x = OIDCAuthorizeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCAuthorizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCAuthorizeResponse) ProtoMessage() {}
+
+func (x *OIDCAuthorizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCAuthorizeResponse.ProtoReflect.Descriptor instead.
+func (*OIDCAuthorizeResponse) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *OIDCAuthorizeResponse) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
+	}
+	return nil
+}
+
+func (x *OIDCAuthorizeResponse) GetRedirectUrl() string {
+	if x != nil && x.RedirectUrl != nil {
+		return *x.RedirectUrl
+	}
+	return ""
+}
+
+type OIDCTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GrantType    *string `protobuf:"bytes,1,opt,name=grant_type,json=grantType,proto3,oneof" form:"grant_type" json:"grant_type" vd:"@:len($)>0; msg:'grant_type不能为空'"`
+	Code         *string `protobuf:"bytes,2,opt,name=code,proto3,oneof" form:"code" json:"code,omitempty"`
+	RedirectUri  *string `protobuf:"bytes,3,opt,name=redirect_uri,json=redirectUri,proto3,oneof" form:"redirect_uri" json:"redirect_uri,omitempty"`
+	ClientId     *string `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3,oneof" form:"client_id" json:"client_id,omitempty"`
+	ClientSecret *string `protobuf:"bytes,5,opt,name=client_secret,json=clientSecret,proto3,oneof" form:"client_secret" json:"client_secret,omitempty"`
+	CodeVerifier *string `protobuf:"bytes,6,opt,name=code_verifier,json=codeVerifier,proto3,oneof" form:"code_verifier" json:"code_verifier,omitempty"`
+	RefreshToken *string `protobuf:"bytes,7,opt,name=refresh_token,json=refreshToken,proto3,oneof" form:"refresh_token" json:"refresh_token,omitempty"`
+}
+
+func (x *OIDCTokenRequest) Reset() {
+	*x = OIDCTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCTokenRequest) ProtoMessage() {}
+
+func (x *OIDCTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCTokenRequest.ProtoReflect.Descriptor instead.
+func (*OIDCTokenRequest) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *OIDCTokenRequest) GetGrantType() string {
+	if x != nil && x.GrantType != nil {
+		return *x.GrantType
+	}
+	return ""
+}
+
+func (x *OIDCTokenRequest) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+func (x *OIDCTokenRequest) GetRedirectUri() string {
+	if x != nil && x.RedirectUri != nil {
+		return *x.RedirectUri
+	}
+	return ""
+}
+
+func (x *OIDCTokenRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *OIDCTokenRequest) GetClientSecret() string {
+	if x != nil && x.ClientSecret != nil {
+		return *x.ClientSecret
+	}
+	return ""
+}
+
+func (x *OIDCTokenRequest) GetCodeVerifier() string {
+	if x != nil && x.CodeVerifier != nil {
+		return *x.CodeVerifier
+	}
+	return ""
+}
+
+func (x *OIDCTokenRequest) GetRefreshToken() string {
+	if x != nil && x.RefreshToken != nil {
+		return *x.RefreshToken
+	}
+	return ""
+}
+
+type OIDCTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResp     *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	AccessToken  *string                    `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3,oneof" form:"access_token" json:"access_token" query:"access_token"`
+	TokenType    *string                    `protobuf:"bytes,3,opt,name=token_type,json=tokenType,proto3,oneof" form:"token_type" json:"token_type" query:"token_type"`
+	ExpiresIn    *int64                     `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3,oneof" form:"expires_in" json:"expires_in" query:"expires_in"`
+	RefreshToken *string                    `protobuf:"bytes,5,opt,name=refresh_token,json=refreshToken,proto3,oneof" form:"refresh_token" json:"refresh_token,omitempty" query:"refresh_token"`
+	IdToken      *string                    `protobuf:"bytes,6,opt,name=id_token,json=idToken,proto3,oneof" form:"id_token" json:"id_token,omitempty" query:"id_token"`
+	Scope        *string                    `protobuf:"bytes,7,opt,name=scope,proto3,oneof" form:"scope" json:"scope,omitempty" query:"scope"`
+}
+
+func (x *OIDCTokenResponse) Reset() {
+	*x = OIDCTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCTokenResponse) ProtoMessage() {}
+
+func (x *OIDCTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCTokenResponse.ProtoReflect.Descriptor instead.
+func (*OIDCTokenResponse) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *OIDCTokenResponse) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
+	}
+	return nil
+}
+
+func (x *OIDCTokenResponse) GetAccessToken() string {
+	if x != nil && x.AccessToken != nil {
+		return *x.AccessToken
+	}
+	return ""
+}
+
+func (x *OIDCTokenResponse) GetTokenType() string {
+	if x != nil && x.TokenType != nil {
+		return *x.TokenType
+	}
+	return ""
+}
+
+func (x *OIDCTokenResponse) GetExpiresIn() int64 {
+	if x != nil && x.ExpiresIn != nil {
+		return *x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *OIDCTokenResponse) GetRefreshToken() string {
+	if x != nil && x.RefreshToken != nil {
+		return *x.RefreshToken
+	}
+	return ""
+}
+
+func (x *OIDCTokenResponse) GetIdToken() string {
+	if x != nil && x.IdToken != nil {
+		return *x.IdToken
+	}
+	return ""
+}
+
+func (x *OIDCTokenResponse) GetScope() string {
+	if x != nil && x.Scope != nil {
+		return *x.Scope
+	}
+	return ""
+}
+
+type OIDCUserinfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResp          *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	Sub               *string                    `protobuf:"bytes,2,opt,name=sub,proto3,oneof" form:"sub" json:"sub" query:"sub"`
+	Name              *string                    `protobuf:"bytes,3,opt,name=name,proto3,oneof" form:"name" json:"name,omitempty" query:"name"`
+	PreferredUsername *string                    `protobuf:"bytes,4,opt,name=preferred_username,json=preferredUsername,proto3,oneof" form:"preferred_username" json:"preferred_username,omitempty" query:"preferred_username"`
+	Email             *string                    `protobuf:"bytes,5,opt,name=email,proto3,oneof" form:"email" json:"email,omitempty" query:"email"`
+	EmailVerified     *bool                      `protobuf:"varint,6,opt,name=email_verified,json=emailVerified,proto3,oneof" form:"email_verified" json:"email_verified,omitempty" query:"email_verified"`
+	Picture           *string                    `protobuf:"bytes,7,opt,name=picture,proto3,oneof" form:"picture" json:"picture,omitempty" query:"picture"`
+}
+
+func (x *OIDCUserinfoResponse) Reset() {
+	*x = OIDCUserinfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCUserinfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCUserinfoResponse) ProtoMessage() {}
+
+func (x *OIDCUserinfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCUserinfoResponse.ProtoReflect.Descriptor instead.
+func (*OIDCUserinfoResponse) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *OIDCUserinfoResponse) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
+	}
+	return nil
+}
+
+func (x *OIDCUserinfoResponse) GetSub() string {
+	if x != nil && x.Sub != nil {
+		return *x.Sub
+	}
+	return ""
+}
+
+func (x *OIDCUserinfoResponse) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *OIDCUserinfoResponse) GetPreferredUsername() string {
+	if x != nil && x.PreferredUsername != nil {
+		return *x.PreferredUsername
+	}
+	return ""
+}
+
+func (x *OIDCUserinfoResponse) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *OIDCUserinfoResponse) GetEmailVerified() bool {
+	if x != nil && x.EmailVerified != nil {
+		return *x.EmailVerified
+	}
+	return false
+}
+
+func (x *OIDCUserinfoResponse) GetPicture() string {
+	if x != nil && x.Picture != nil {
+		return *x.Picture
+	}
+	return ""
+}
+
+type OIDCRevokeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token         *string `protobuf:"bytes,1,opt,name=token,proto3,oneof" form:"token" json:"token" vd:"@:len($)>0; msg:'token不能为空'"`
+	TokenTypeHint *string `protobuf:"bytes,2,opt,name=token_type_hint,json=tokenTypeHint,proto3,oneof" form:"token_type_hint" json:"token_type_hint,omitempty"`
+}
+
+func (x *OIDCRevokeRequest) Reset() {
+	*x = OIDCRevokeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCRevokeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCRevokeRequest) ProtoMessage() {}
+
+func (x *OIDCRevokeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCRevokeRequest.ProtoReflect.Descriptor instead.
+func (*OIDCRevokeRequest) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *OIDCRevokeRequest) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+func (x *OIDCRevokeRequest) GetTokenTypeHint() string {
+	if x != nil && x.TokenTypeHint != nil {
+		return *x.TokenTypeHint
+	}
+	return ""
+}
+
+type OIDCIntrospectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token         *string `protobuf:"bytes,1,opt,name=token,proto3,oneof" form:"token" json:"token" vd:"@:len($)>0; msg:'token不能为空'"`
+	TokenTypeHint *string `protobuf:"bytes,2,opt,name=token_type_hint,json=tokenTypeHint,proto3,oneof" form:"token_type_hint" json:"token_type_hint,omitempty"`
+}
+
+func (x *OIDCIntrospectRequest) Reset() {
+	*x = OIDCIntrospectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCIntrospectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCIntrospectRequest) ProtoMessage() {}
+
+func (x *OIDCIntrospectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCIntrospectRequest.ProtoReflect.Descriptor instead.
+func (*OIDCIntrospectRequest) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *OIDCIntrospectRequest) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+func (x *OIDCIntrospectRequest) GetTokenTypeHint() string {
+	if x != nil && x.TokenTypeHint != nil {
+		return *x.TokenTypeHint
+	}
+	return ""
+}
+
+type OIDCIntrospectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseResp *http_base.BaseResponseDTO `protobuf:"bytes,1,opt,name=baseResp,proto3,oneof" form:"baseResp" json:"base_resp" query:"baseResp"`
+	Active   *bool                      `protobuf:"varint,2,opt,name=active,proto3,oneof" form:"active" json:"active" query:"active"`
+	ClientId *string                    `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3,oneof" form:"client_id" json:"client_id,omitempty" query:"client_id"`
+	Username *string                    `protobuf:"bytes,4,opt,name=username,proto3,oneof" form:"username" json:"username,omitempty" query:"username"`
+	Scope    *string                    `protobuf:"bytes,5,opt,name=scope,proto3,oneof" form:"scope" json:"scope,omitempty" query:"scope"`
+	Sub      *string                    `protobuf:"bytes,6,opt,name=sub,proto3,oneof" form:"sub" json:"sub,omitempty" query:"sub"`
+	Exp      *int64                     `protobuf:"varint,7,opt,name=exp,proto3,oneof" form:"exp" json:"exp,omitempty" query:"exp"`
+	Iat      *int64                     `protobuf:"varint,8,opt,name=iat,proto3,oneof" form:"iat" json:"iat,omitempty" query:"iat"`
+}
+
+func (x *OIDCIntrospectResponse) Reset() {
+	*x = OIDCIntrospectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_http_identity_identity_model_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OIDCIntrospectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OIDCIntrospectResponse) ProtoMessage() {}
+
+func (x *OIDCIntrospectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_http_identity_identity_model_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OIDCIntrospectResponse.ProtoReflect.Descriptor instead.
+func (*OIDCIntrospectResponse) Descriptor() ([]byte, []int) {
+	return file_http_identity_identity_model_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *OIDCIntrospectResponse) GetBaseResp() *http_base.BaseResponseDTO {
+	if x != nil {
+		return x.BaseResp
+	}
+	return nil
+}
+
+func (x *OIDCIntrospectResponse) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+func (x *OIDCIntrospectResponse) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+func (x *OIDCIntrospectResponse) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *OIDCIntrospectResponse) GetScope() string {
+	if x != nil && x.Scope != nil {
+		return *x.Scope
+	}
+	return ""
+}
+
+func (x *OIDCIntrospectResponse) GetSub() string {
+	if x != nil && x.Sub != nil {
+		return *x.Sub
+	}
+	return ""
+}
+
+func (x *OIDCIntrospectResponse) GetExp() int64 {
+	if x != nil && x.Exp != nil {
+		return *x.Exp
+	}
+	return 0
+}
+
+func (x *OIDCIntrospectResponse) GetIat() int64 {
+	if x != nil && x.Iat != nil {
+		return *x.Iat
+	}
+	return 0
+}
+
+var File_http_identity_identity_model_proto protoreflect.FileDescriptor
+
+var file_http_identity_identity_model_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x68, 0x74, 0x74, 0x70, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x0e,
+	0x62, 0x61, 0x73, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x68, 0x74,
+	0x74, 0x70, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xe6, 0x07, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x6d, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4c, 0xca, 0xbb, 0x18, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0xda, 0xbb, 0x18, 0x29, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28,
+	0x24, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0x94, 0xa8,
+	0xe6, 0x88, 0xb7, 0xe5, 0x90, 0x8d, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7,
+	0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x00, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x6a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x49, 0xca, 0xbb, 0x18, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0xda, 0xbb, 0x18, 0x26, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24,
+	0x29, 0x20, 0x3e, 0x20, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe5, 0xaf, 0x86, 0xe7,
+	0xa0, 0x81, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x27, 0xca,
+	0xf3, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0x48, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x5a, 0x0a, 0x0b, 0x6f, 0x69, 0x64, 0x63, 0x49, 0x44, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xca, 0xbb, 0x18, 0x0d, 0x6f, 0x69, 0x64, 0x63,
+	0x5f, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x0b, 0x6f,
+	0x69, 0x64, 0x63, 0x49, 0x44, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x5c, 0x0a,
+	0x0c, 0x6f, 0x69, 0x64, 0x63, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x33, 0xca, 0xbb, 0x18, 0x0d, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x6f, 0x69, 0x64, 0x63, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x0c, 0x6f, 0x69, 0x64, 0x63,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x08, 0x6f,
+	0x69, 0x64, 0x63, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xca,
+	0xbb, 0x18, 0x09, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0xca, 0xf3, 0x18, 0x1a,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x08, 0x6f, 0x69,
+	0x64, 0x63, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x6a, 0x0a, 0x0f, 0x6f, 0x69, 0x64,
+	0x63, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x52, 0x49, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x3b, 0xca, 0xbb, 0x18, 0x11, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x72, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0xca, 0xf3, 0x18, 0x22, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x5f, 0x75, 0x72, 0x69, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48,
+	0x05, 0x52, 0x0f, 0x6f, 0x69, 0x64, 0x63, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55,
+	0x52, 0x49, 0x88, 0x01, 0x01, 0x12, 0x6e, 0x0a, 0x10, 0x6f, 0x69, 0x64, 0x63, 0x43, 0x6f, 0x64,
+	0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x3d, 0xca, 0xbb, 0x18, 0x12, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0xca, 0xf3, 0x18, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x06,
+	0x52, 0x10, 0x6f, 0x69, 0x64, 0x63, 0x43, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xca, 0xbb, 0x18, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0xca,
+	0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x07, 0x52, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xca, 0xbb, 0x18, 0x09, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x08, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x6f, 0x69, 0x64, 0x63, 0x49, 0x44, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x6f, 0x69, 0x64, 0x63, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x6f, 0x69, 0x64, 0x63, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f,
+	0x6f, 0x69, 0x64, 0x63, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x52, 0x49, 0x42,
+	0x13, 0x0a, 0x11, 0x5f, 0x6f, 0x69, 0x64, 0x63, 0x43, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0xe6, 0x01, 0x0a, 0x0b,
+	0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x44, 0x54, 0x4f, 0x12, 0x22, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xca, 0xf3, 0x18, 0x09, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x28, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xca,
+	0xf3, 0x18, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x48, 0x01,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xca, 0xf3, 0x18, 0x0b, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x48, 0x03, 0x52,
+	0x09, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a,
+	0x03, 0x5f, 0x69, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x22, 0xee, 0x05, 0x0a, 0x10, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74,
+	0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52,
+	0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x58, 0x0a, 0x0b,
+	0x75, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x17, 0xca, 0xf3, 0x18,
+	0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x22, 0x48, 0x01, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x68, 0x74, 0x74, 0x70,
+	0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x44,
+	0x54, 0x4f, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x48, 0x02, 0x52, 0x09, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x5f, 0x0a, 0x0b, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x44, 0x54, 0x4f, 0x42, 0x20, 0xca, 0xf3, 0x18,
+	0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x0b, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x6f,
+	0x6c, 0x65, 0x49, 0x44, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1d, 0xca, 0xf3, 0x18,
+	0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65,
+	0x49, 0x44, 0x73, 0x12, 0x47, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x44, 0x54, 0x4f, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x66, 0x0a, 0x0c,
+	0x6d, 0x66, 0x61, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x4d, 0x46,
+	0x41, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x22, 0xca,
+	0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x66, 0x61, 0x5f, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x48, 0x03, 0x52, 0x0c, 0x6d, 0x66, 0x61, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x53, 0x0a, 0x10, 0x6d, 0x66, 0x61, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x42, 0x27,
+	0xca, 0xf3, 0x18, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x66, 0x61, 0x5f, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x10, 0x6d, 0x66, 0x61, 0x52, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x66, 0x61, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x22, 0xa1, 0x02, 0x0a, 0x0f, 0x4d, 0x46, 0x41, 0x43, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x47, 0x0a, 0x0e, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x48, 0x00, 0x52,
+	0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x22, 0x48, 0x01, 0x52, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x53, 0x0a, 0x12,
+	0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1e, 0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x48, 0x02, 0x52, 0x12, 0x65, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x88, 0x01,
+	0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x49, 0x6e, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x65, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x99, 0x02, 0x0a, 0x13, 0x4d, 0x46,
+	0x41, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54,
+	0x4f, 0x12, 0x88, 0x01, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5b, 0xca, 0xbb, 0x18, 0x0f,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xda,
+	0xbb, 0x18, 0x2a, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d,
+	0x73, 0x67, 0x3a, 0x27, 0xe6, 0x8c, 0x91, 0xe6, 0x88, 0x98, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c,
+	0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18,
+	0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x5b, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0xca, 0xbb, 0x18, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0xda, 0xbb, 0x18, 0x27, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29,
+	0x3e, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe7,
+	0xa0, 0x81, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x27, 0xca,
+	0xf3, 0x18, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x48, 0x01,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x13, 0x4d, 0x46, 0x41, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x88, 0x01,
+	0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5b, 0xca, 0xbb, 0x18, 0x0f, 0x63, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xda, 0xbb, 0x18, 0x2a, 0x40,
 	0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27,
-	0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe5, 0xaf, 0x86, 0xe7, 0xa0, 0x81, 0xe4, 0xb8, 0x8d, 0xe8,
-	0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x13, 0x6a, 0x73, 0x6f,
-	0x6e, 0x3a, 0x22, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
-	0x48, 0x00, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88,
-	0x01, 0x01, 0x12, 0x9c, 0x01, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x75, 0xca, 0xbb, 0x18, 0x0c, 0x6e, 0x65,
-	0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0xda, 0xbb, 0x18, 0x4a, 0x40, 0x3a,
-	0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x6c, 0x65, 0x6e, 0x28,
-	0x24, 0x29, 0x3e, 0x3d, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe6, 0x96, 0xb0, 0xe5,
-	0xaf, 0x86, 0xe7, 0xa0, 0x81, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9,
-	0xba, 0xe4, 0xb8, 0x94, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe8, 0x87, 0xb3, 0xe5, 0xb0, 0x91,
-	0xe4, 0xb8, 0xba, 0x36, 0xe4, 0xbd, 0x8d, 0x27, 0xca, 0xf3, 0x18, 0x13, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x48,
-	0x01, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01,
-	0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x22, 0xe2, 0x03, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x69, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4c, 0xca,
-	0xbb, 0x18, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a,
-	0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a,
-	0x27, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4,
-	0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f,
-	0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x98, 0x01, 0x0a, 0x0b, 0x6e, 0x65, 0x77,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x71,
-	0xca, 0xbb, 0x18, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0xda, 0xbb, 0x18, 0x3c, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x30, 0x20,
-	0x7c, 0x7c, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x3d, 0x36, 0x3b, 0x20, 0x6d, 0x73,
-	0x67, 0x3a, 0x27, 0xe6, 0x96, 0xb0, 0xe5, 0xaf, 0x86, 0xe7, 0xa0, 0x81, 0xe9, 0x95, 0xbf, 0xe5,
-	0xba, 0xa6, 0xe8, 0x87, 0xb3, 0xe5, 0xb0, 0x91, 0xe4, 0xb8, 0xba, 0x36, 0xe4, 0xbd, 0x8d, 0x27,
-	0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x48, 0x01, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x88, 0x01, 0x01, 0x12, 0x95, 0x01, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6e, 0xca, 0xbb, 0x18, 0x0c, 0x72,
-	0x65, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0xda, 0xbb, 0x18, 0x39, 0x40,
-	0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x32, 0x30, 0x30, 0x3b, 0x20, 0x6d, 0x73,
-	0x67, 0x3a, 0x27, 0xe9, 0x87, 0x8d, 0xe7, 0xbd, 0xae, 0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0, 0xe4,
-	0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0x32, 0x30, 0x30, 0xe4, 0xb8,
-	0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2c, 0x6f,
-	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x0b, 0x72, 0x65, 0x73,
-	0x65, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6e, 0x65, 0x77, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74,
-	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x9b, 0x02, 0x0a, 0x1d, 0x46, 0x6f, 0x72, 0x63, 0x65,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x69, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4c, 0xca, 0xbb, 0x18, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24,
-	0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0x94, 0xa8, 0xe6,
-	0x88, 0xb7, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3,
-	0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x88, 0x01, 0x01, 0x12, 0x79, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x5c, 0xca, 0xbb, 0x18, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0xda,
-	0xbb, 0x18, 0x33, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x32, 0x30, 0x30,
-	0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0, 0xe4, 0xb8, 0x8d,
-	0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0x32, 0x30, 0x30, 0xe4, 0xb8, 0xaa, 0xe5,
-	0xad, 0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x48, 0x01, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09,
-	0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x22, 0xac, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12,
-	0x80, 0x01, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x57, 0xca, 0xbb, 0x18, 0x0d, 0x72, 0x65, 0x66, 0x72,
-	0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xda, 0xbb, 0x18, 0x2a, 0x40, 0x3a, 0x6c,
-	0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe5, 0x88,
-	0xb7, 0xe6, 0x96, 0xb0, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd,
-	0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x14, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x48,
-	0x00, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88,
-	0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0xe4, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12,
-	0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61,
-	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca,
-	0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65,
-	0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88,
-	0x01, 0x01, 0x12, 0x5b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x44, 0x54, 0x4f, 0x42, 0x1f,
-	0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48,
-	0x01, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x42,
-	0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x0c, 0x0a, 0x0a,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xb8, 0x12, 0x0a, 0x0e, 0x55,
-	0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x22, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xca, 0xf3, 0x18, 0x09, 0x6a,
-	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01,
-	0x01, 0x12, 0x34, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x13, 0xca, 0xf3, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x48, 0x02, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x35,
-	0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xca,
-	0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x2c, 0x6f,
-	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x05, 0x70, 0x68, 0x6f,
-	0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73,
-	0x6f, 0x6e, 0x3a, 0x22, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f,
-	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x09, 0x66, 0x69, 0x72,
-	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x6c, 0x61, 0x73,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xca, 0xf3, 0x18,
-	0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x08, 0x6c,
-	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x72, 0x65,
-	0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xca, 0xf3,
-	0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x06, 0x52, 0x08,
-	0x72, 0x65, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x5a, 0x0a, 0x11, 0x70,
-	0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0xca, 0xf3, 0x18, 0x23, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48,
-	0x07, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54,
-	0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f,
-	0x79, 0x65, 0x65, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xca, 0xf3, 0x18,
-	0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f,
-	0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x08, 0x52,
-	0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x2e,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11,
-	0xca, 0xf3, 0x18, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x48, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x5e,
-	0x0a, 0x12, 0x6d, 0x75, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x29, 0xca, 0xf3, 0x18, 0x25,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x75, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0a, 0x52, 0x12, 0x6d, 0x75, 0x73, 0x74, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x4e,
-	0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x03, 0x42, 0x23, 0xca, 0xf3, 0x18, 0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x22, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0b, 0x52, 0x0d, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x38,
-	0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1b,
-	0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0c, 0x52, 0x06, 0x67,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca, 0xf3, 0x18,
-	0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x22, 0x48, 0x0d, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x22, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x48, 0x0e, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x0d,
-	0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x03, 0x42, 0x24, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x2c, 0x6f,
-	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0f, 0x52, 0x0d, 0x6c, 0x61, 0x73,
-	0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a,
-	0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x11,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x23, 0xca, 0xf3, 0x18, 0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x2c, 0x6f,
-	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x10, 0x52, 0x0d, 0x6c, 0x6f, 0x67,
-	0x69, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x48, 0x11, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x88, 0x01,
-	0x01, 0x12, 0x42, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x13,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x12, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x42, 0x79, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x73,
-	0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1d, 0xca, 0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x73, 0x12, 0x67,
-	0x0a, 0x15, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xca,
-	0xf3, 0x18, 0x28, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
-	0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x13, 0x52, 0x15, 0x70,
-	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x61, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x6d, 0x61,
-	0x72, 0x79, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x16,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0xca, 0xf3, 0x18, 0x26, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x48, 0x14, 0x52, 0x13, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x44, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x07, 0x6f, 0x69,
-	0x64, 0x63, 0x53, 0x75, 0x62, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xca, 0xf3, 0x18,
-	0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x73, 0x75, 0x62, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x15, 0x52, 0x07, 0x6f, 0x69,
-	0x64, 0x63, 0x53, 0x75, 0x62, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0a, 0x6f, 0x69, 0x64, 0x63,
-	0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xca, 0xf3,
-	0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x69, 0x73, 0x73,
-	0x75, 0x65, 0x72, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x16,
-	0x52, 0x0a, 0x6f, 0x69, 0x64, 0x63, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
-	0x42, 0x0a, 0x09, 0x6f, 0x69, 0x64, 0x63, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x19, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x69,
-	0x64, 0x63, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x48, 0x17, 0x52, 0x09, 0x6f, 0x69, 0x64, 0x63, 0x45, 0x6d, 0x61, 0x69, 0x6c,
-	0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x6f, 0x69, 0x64, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x1a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x22, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x18, 0x52, 0x08, 0x6f, 0x69, 0x64, 0x63, 0x4e, 0x61, 0x6d,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0b, 0x6f, 0x69, 0x64, 0x63, 0x50, 0x69, 0x63, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xca, 0xf3, 0x18, 0x1d, 0x6a,
-	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72,
-	0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x19, 0x52, 0x0b,
-	0x6f, 0x69, 0x64, 0x63, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4c,
-	0x0a, 0x0c, 0x6f, 0x69, 0x64, 0x63, 0x41, 0x75, 0x74, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x1c,
+	0xe6, 0x8c, 0x91, 0xe6, 0x88, 0x98, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0xe4, 0xb8, 0x8d, 0xe8,
+	0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x1e, 0x0a, 0x1c, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x4d, 0x46, 0x41, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x22, 0x91, 0x02, 0x0a, 0x14,
+	0x4d, 0x46, 0x41, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
+	0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xca, 0xf3, 0x18, 0x0d, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x48, 0x01, 0x52, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x55, 0x52, 0x49, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1b, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x69, 0x22, 0x48, 0x02, 0x52,
+	0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x55, 0x52, 0x49,
+	0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x55, 0x52, 0x49, 0x22,
+	0x7c, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x4d, 0x46, 0x41, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x5b, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0xca, 0xbb, 0x18, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xda,
+	0xbb, 0x18, 0x27, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d,
+	0x73, 0x67, 0x3a, 0x27, 0xe9, 0xaa, 0x8c, 0xe8, 0xaf, 0x81, 0xe7, 0xa0, 0x81, 0xe4, 0xb8, 0x8d,
+	0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x0b, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x48, 0x00, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xb8, 0x01,
+	0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x4d, 0x46, 0x41, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70,
+	0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x52, 0x0d, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x7c, 0x0a, 0x14, 0x44, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x4d, 0x46, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f,
+	0x12, 0x5b, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42,
+	0xca, 0xbb, 0x18, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xda, 0xbb, 0x18, 0x27, 0x40, 0x3a, 0x6c, 0x65,
+	0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe9, 0xaa, 0x8c,
+	0xe8, 0xaf, 0x81, 0xe7, 0xa0, 0x81, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7,
+	0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x64,
+	0x65, 0x22, 0x48, 0x00, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x10, 0x4c, 0x6f, 0x67, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x82, 0x01, 0x0a, 0x0c,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x59, 0xca, 0xbb, 0x18, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xda, 0xbb, 0x18, 0x2c, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24,
+	0x29, 0x20, 0x3e, 0x20, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe5, 0x88, 0xb7, 0xe6,
+	0x96, 0xb0, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8,
+	0xba, 0xe7, 0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x14, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x48, 0x00, 0x52,
+	0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0xd7, 0x02, 0x0a, 0x18, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x7c,
+	0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x55, 0xca, 0xbb, 0x18, 0x0c, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0xda, 0xbb, 0x18, 0x2a, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24,
+	0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d,
+	0xe5, 0xaf, 0x86, 0xe7, 0xa0, 0x81, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7,
+	0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x6c, 0x64,
+	0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x6c,
+	0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x9c, 0x01, 0x0a,
+	0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x75, 0xca, 0xbb, 0x18, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0xda, 0xbb, 0x18, 0x4a, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29,
+	0x3e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x3d, 0x36, 0x3b,
+	0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe6, 0x96, 0xb0, 0xe5, 0xaf, 0x86, 0xe7, 0xa0, 0x81, 0xe4,
+	0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe4, 0xb8, 0x94, 0xe9, 0x95,
+	0xbf, 0xe5, 0xba, 0xa6, 0xe8, 0x87, 0xb3, 0xe5, 0xb0, 0x91, 0xe4, 0xb8, 0xba, 0x36, 0xe4, 0xbd,
+	0x8d, 0x27, 0xca, 0xf3, 0x18, 0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x65, 0x77, 0x5f,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x48, 0x01, 0x52, 0x0b, 0x6e, 0x65, 0x77,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xe2, 0x03, 0x0a, 0x17,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x69, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4c, 0xca, 0xbb, 0x18, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29,
+	0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0x94, 0xa8, 0xe6, 0x88,
+	0xb7, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7,
+	0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88,
+	0x01, 0x01, 0x12, 0x98, 0x01, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x71, 0xca, 0xbb, 0x18, 0x0c, 0x6e, 0x65,
+	0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0xda, 0xbb, 0x18, 0x3c, 0x40, 0x3a,
+	0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x6c, 0x65, 0x6e,
+	0x28, 0x24, 0x29, 0x3e, 0x3d, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe6, 0x96, 0xb0,
+	0xe5, 0xaf, 0x86, 0xe7, 0xa0, 0x81, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe8, 0x87, 0xb3, 0xe5,
+	0xb0, 0x91, 0xe4, 0xb8, 0xba, 0x36, 0xe4, 0xbd, 0x8d, 0x27, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x0b, 0x6e,
+	0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x95, 0x01,
+	0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x6e, 0xca, 0xbb, 0x18, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0xda, 0xbb, 0x18, 0x39, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24,
+	0x29, 0x3c, 0x3d, 0x32, 0x30, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe9, 0x87, 0x8d,
+	0xe7, 0xbd, 0xae, 0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe8,
+	0xb6, 0x85, 0xe8, 0xbf, 0x87, 0x32, 0x30, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac,
+	0xa6, 0x27, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0xc6, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x44, 0x54, 0x4f, 0x12, 0x94, 0x01, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6f, 0xca, 0xbb, 0x18, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0xda, 0xbb, 0x18, 0x48, 0x40, 0x3a, 0x6c, 0x65,
+	0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29,
+	0x3c, 0x3d, 0x31, 0x30, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe8, 0xaf, 0xb7, 0xe8,
+	0xbe, 0x93, 0xe5, 0x85, 0xa5, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x90, 0x8d, 0xe3, 0x80,
+	0x81, 0xe9, 0x82, 0xae, 0xe7, 0xae, 0xb1, 0xe6, 0x88, 0x96, 0xe6, 0x89, 0x8b, 0xe6, 0x9c, 0xba,
+	0xe5, 0x8f, 0xb7, 0x27, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0xbd, 0x02, 0x0a, 0x1e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x62, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x47, 0xca, 0xbb, 0x18,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xda, 0xbb, 0x18, 0x2a, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28,
+	0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe9, 0x87, 0x8d, 0xe7, 0xbd,
+	0xae, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba,
+	0xe7, 0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x9c, 0x01, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x75, 0xca, 0xbb, 0x18, 0x0c, 0x6e, 0x65, 0x77, 0x5f,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0xda, 0xbb, 0x18, 0x4a, 0x40, 0x3a, 0x6c, 0x65,
+	0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29,
+	0x3e, 0x3d, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe6, 0x96, 0xb0, 0xe5, 0xaf, 0x86,
+	0xe7, 0xa0, 0x81, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe4,
+	0xb8, 0x94, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe8, 0x87, 0xb3, 0xe5, 0xb0, 0x91, 0xe4, 0xb8,
+	0xba, 0x36, 0xe4, 0xbd, 0x8d, 0x27, 0xca, 0xf3, 0x18, 0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x48, 0x01, 0x52,
+	0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6e, 0x65,
+	0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x9b, 0x02, 0x0a, 0x1d, 0x46, 0x6f,
+	0x72, 0x63, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x69, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4c, 0xca, 0xbb, 0x18,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65,
+	0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7,
+	0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d,
+	0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x79, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5c, 0xca, 0xbb, 0x18, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0xda, 0xbb, 0x18, 0x33, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d,
+	0x32, 0x30, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0,
+	0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0x32, 0x30, 0x30, 0xe4,
+	0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xac, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44,
+	0x54, 0x4f, 0x12, 0x80, 0x01, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x57, 0xca, 0xbb, 0x18, 0x0d, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xda, 0xbb, 0x18, 0x2a,
+	0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a,
+	0x27, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0xe4, 0xb8, 0x8d,
+	0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x14, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe4, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
+	0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f,
+	0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x5b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x44, 0x54,
+	0x4f, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x48, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x88,
+	0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xa6, 0x02,
+	0x0a, 0x16, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x89, 0x01, 0x0a, 0x0e, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x5c, 0xca, 0xbb, 0x18, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28,
+	0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0xbb, 0x84,
+	0xe7, 0xbb, 0x87, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad,
+	0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x48,
+	0x00, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x88, 0x01, 0x01, 0x12, 0x5c, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xca, 0xbb, 0x18, 0x0d,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xca, 0xf3, 0x18,
+	0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48,
+	0x01, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88,
+	0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xc2, 0x03, 0x0a, 0x17, 0x53, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
+	0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f,
+	0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x5b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x44, 0x54,
+	0x4f, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x48, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x88,
+	0x01, 0x01, 0x12, 0x47, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x02, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x07, 0x72,
+	0x6f, 0x6c, 0x65, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1d, 0xca, 0xf3,
+	0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x07, 0x72, 0x6f, 0x6c,
+	0x65, 0x49, 0x44, 0x73, 0x12, 0x47, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x52,
+	0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x44, 0x54, 0x4f, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xeb, 0x02, 0x0a, 0x0f,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x54, 0x4f, 0x12,
+	0x47, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x57, 0x0a, 0x10, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x26, 0xca, 0xf3, 0x18, 0x22, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x10, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x38, 0x0a, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x69, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x48, 0x02, 0x52, 0x09, 0x69,
+	0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x69,
+	0x73, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x15,
+	0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x73, 0x5f, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x22, 0x48, 0x03, 0x52, 0x09, 0x69, 0x73, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x69, 0x73, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0xf7, 0x12, 0x0a, 0x0e, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x22, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xca, 0xf3, 0x18, 0x09, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x34, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x13, 0xca, 0xf3, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x48, 0x02, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a,
+	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xca, 0xf3,
+	0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xca, 0xf3, 0x18, 0x1a,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x08, 0x6c, 0x61,
+	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x72, 0x65, 0x61,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xca, 0xf3, 0x18,
+	0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x06, 0x52, 0x08, 0x72,
+	0x65, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x5a, 0x0a, 0x11, 0x70, 0x72,
+	0x6f, 0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0xca, 0xf3, 0x18, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x07,
+	0x52, 0x11, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79,
+	0x65, 0x65, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xca, 0xf3, 0x18, 0x1c,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69,
+	0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x08, 0x52, 0x0a,
+	0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0xca,
+	0xf3, 0x18, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x48, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x5e, 0x0a,
+	0x12, 0x6d, 0x75, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x29, 0xca, 0xf3, 0x18, 0x25, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x75, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x0a, 0x52, 0x12, 0x6d, 0x75, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a,
+	0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x0c,
 	0x20, 0x01, 0x28, 0x03, 0x42, 0x23, 0xca, 0xf3, 0x18, 0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x6f, 0x69, 0x64, 0x63, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x2c, 0x6f,
-	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x1a, 0x52, 0x0c, 0x6f, 0x69, 0x64,
-	0x63, 0x41, 0x75, 0x74, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03,
-	0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42,
-	0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x14, 0x0a, 0x12,
-	0x5f, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x69, 0x74,
-	0x6c, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49,
-	0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x15, 0x0a, 0x13,
-	0x5f, 0x6d, 0x75, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45,
-	0x78, 0x70, 0x69, 0x72, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0c,
-	0x0a, 0x0a, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x10, 0x0a, 0x0e,
-	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x10,
-	0x0a, 0x0e, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
-	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x42, 0x0c,
-	0x0a, 0x0a, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x42, 0x18, 0x0a, 0x16,
-	0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61,
-	0x72, 0x79, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x42, 0x0a,
-	0x0a, 0x08, 0x5f, 0x6f, 0x69, 0x64, 0x63, 0x53, 0x75, 0x62, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6f,
-	0x69, 0x64, 0x63, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6f, 0x69,
-	0x64, 0x63, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x69, 0x64, 0x63,
-	0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6f, 0x69, 0x64, 0x63, 0x50, 0x69, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6f, 0x69, 0x64, 0x63, 0x41, 0x75, 0x74,
-	0x68, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x16, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f,
-	0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14,
-	0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72,
-	0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x19, 0xca, 0xf3, 0x18,
-	0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x88, 0x01,
-	0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0b, 0x52, 0x0d, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a,
+	0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1b, 0xca,
+	0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0c, 0x52, 0x06, 0x67, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x22, 0x48, 0x0d, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x48, 0x0e, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x0d, 0x6c,
+	0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x24, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0f, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x0d,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x23, 0xca, 0xf3, 0x18, 0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x10, 0x52, 0x0d, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x48, 0x11, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x42, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x12, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x73, 0x18,
+	0x14, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1d, 0xca, 0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x73, 0x12, 0x67, 0x0a,
+	0x15, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xca, 0xf3,
+	0x18, 0x28, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x13, 0x52, 0x15, 0x70, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x61, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x2a, 0xca, 0xf3, 0x18, 0x26, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48,
+	0x14, 0x52, 0x13, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x07, 0x6f, 0x69, 0x64,
+	0x63, 0x53, 0x75, 0x62, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xca, 0xf3, 0x18, 0x19,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x73, 0x75, 0x62, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x15, 0x52, 0x07, 0x6f, 0x69, 0x64,
+	0x63, 0x53, 0x75, 0x62, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0a, 0x6f, 0x69, 0x64, 0x63, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xca, 0xf3, 0x18,
+	0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x16, 0x52,
+	0x0a, 0x6f, 0x69, 0x64, 0x63, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x42,
+	0x0a, 0x09, 0x6f, 0x69, 0x64, 0x63, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x69, 0x64,
+	0x63, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x48, 0x17, 0x52, 0x09, 0x6f, 0x69, 0x64, 0x63, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x6f, 0x69, 0x64, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x6f, 0x69, 0x64, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x18, 0x52, 0x08, 0x6f, 0x69, 0x64, 0x63, 0x4e, 0x61, 0x6d, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0b, 0x6f, 0x69, 0x64, 0x63, 0x50, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x69, 0x64, 0x63, 0x5f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x19, 0x52, 0x0b, 0x6f,
+	0x69, 0x64, 0x63, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a,
+	0x0c, 0x6f, 0x69, 0x64, 0x63, 0x41, 0x75, 0x74, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x1c, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x23, 0xca, 0xf3, 0x18, 0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f,
+	0x69, 0x64, 0x63, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x1a, 0x52, 0x0c, 0x6f, 0x69, 0x64, 0x63,
+	0x41, 0x75, 0x74, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x03, 0x42, 0x12, 0xca, 0xf3,
+	0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x48, 0x1b, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x05,
+	0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x14,
+	0x0a, 0x12, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65,
+	0x65, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x15,
+	0x0a, 0x13, 0x5f, 0x6d, 0x75, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x67, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x42, 0x18,
+	0x0a, 0x16, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x70, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x79, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6f, 0x69, 0x64, 0x63, 0x53, 0x75, 0x62, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x6f, 0x69, 0x64, 0x63, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x6f, 0x69, 0x64, 0x63, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x69,
+	0x64, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6f, 0x69, 0x64, 0x63, 0x50,
+	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6f, 0x69, 0x64, 0x63, 0x41,
+	0x75, 0x74, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0xc1, 0x02, 0x0a, 0x16, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51,
+	0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3,
+	0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01,
+	0x01, 0x12, 0x4c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x70, 0x0a, 0x11, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x54, 0x4f, 0x42, 0x27, 0xca, 0xf3, 0x18, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x11,
+	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x07,
 	0x0a, 0x05, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x22, 0x81, 0x11, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f,
 	0x12, 0xd3, 0x01, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
@@ -5554,7 +9918,7 @@ var file_http_identity_identity_model_proto_rawDesc = []byte{
 	0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08,
 	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
 	0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x22, 0x90, 0x0e, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x22, 0xa9, 0x0f, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x62, 0x0a, 0x06, 0x75, 0x73, 0x65,
 	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0xd2, 0xbb, 0x18, 0x06, 0x75,
 	0x73, 0x65, 0x72, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24,
@@ -5656,803 +10020,2079 @@ var file_http_identity_identity_model_proto_rawDesc = []byte{
 	0xae, 0x27, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61,
 	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
 	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0b, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x66,
-	0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61, 0x73,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x4e, 0x61,
-	0x6d, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x6d, 0x70,
-	0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x44, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x67, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x73,
-	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x22, 0xf7, 0x0a, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x76, 0x0a, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5b, 0xca, 0xbb, 0x18, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0xda, 0xbb, 0x18, 0x34, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29,
-	0x3d, 0x3d, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x28, 0x24, 0x29, 0x3b,
-	0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe9, 0x82, 0xae, 0xe7, 0xae, 0xb1, 0xe6, 0xa0, 0xbc, 0xe5,
-	0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x16,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x88,
-	0x01, 0x01, 0x12, 0x79, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x5e, 0xca, 0xbb, 0x18, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0xda, 0xbb, 0x18, 0x37,
-	0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x28, 0x24, 0x29, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe6, 0x89,
-	0x8b, 0xe6, 0x9c, 0xba, 0xe5, 0x8f, 0xb7, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d,
-	0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x22, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x48, 0x01, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x8b, 0x01,
-	0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x68, 0xca, 0xbb, 0x18, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0xda, 0xbb, 0x18, 0x37, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x35,
-	0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe5, 0x90, 0x8d, 0xe5, 0xad, 0x97, 0xe9, 0x95,
-	0xbf, 0xe5, 0xba, 0xa6, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87,
-	0x35, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18, 0x1b,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x09, 0x66,
-	0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x87, 0x01, 0x0a, 0x08,
-	0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x66,
-	0xca, 0xbb, 0x18, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0xda, 0xbb, 0x18,
-	0x37, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x35, 0x30, 0x3b, 0x20, 0x6d,
-	0x73, 0x67, 0x3a, 0x27, 0xe5, 0xa7, 0x93, 0xe6, 0xb0, 0x8f, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6,
-	0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0x35, 0x30, 0xe4, 0xb8,
-	0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x8f, 0x01, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x6c, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6e, 0xca, 0xbb, 0x18, 0x09, 0x72, 0x65,
-	0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0xda, 0xbb, 0x18, 0x3f, 0x40, 0x3a, 0x6c, 0x65, 0x6e,
-	0x28, 0x24, 0x29, 0x3c, 0x3d, 0x31, 0x30, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7,
-	0x9c, 0x9f, 0xe5, 0xae, 0x9e, 0xe5, 0xa7, 0x93, 0xe5, 0x90, 0x8d, 0xe9, 0x95, 0xbf, 0xe5, 0xba,
-	0xa6, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0x31, 0x30, 0x30,
-	0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73,
-	0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d,
-	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x08, 0x72, 0x65, 0x61, 0x6c,
-	0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0xb4, 0x01, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x66,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x80, 0x01, 0xca, 0xbb, 0x18, 0x12, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0xda, 0xbb, 0x18, 0x3f,
-	0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x31, 0x30, 0x30, 0x3b, 0x20, 0x6d,
-	0x73, 0x67, 0x3a, 0x27, 0xe8, 0x81, 0x8c, 0xe4, 0xb8, 0x9a, 0xe5, 0xa4, 0xb4, 0xe8, 0xa1, 0x94,
-	0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8,
-	0xbf, 0x87, 0x31, 0x30, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca,
-	0xf3, 0x18, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x95,
-	0x01, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x44, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x70, 0xca, 0xbb, 0x18, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65,
-	0x65, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x3d, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29,
-	0x3c, 0x3d, 0x35, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe5, 0x91, 0x98, 0xe5, 0xb7,
-	0xa5, 0xe5, 0xb7, 0xa5, 0xe5, 0x8f, 0xb7, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe4, 0xb8, 0x8d,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x42, 0x27, 0xca, 0xbb,
+	0x18, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0c, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x07, 0x69, 0x66, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xba, 0xbb, 0x18, 0x08, 0x49, 0x66, 0x2d, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x0d,
+	0x52, 0x07, 0x69, 0x66, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61,
+	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x4e,
+	0x61, 0x6d, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x6d,
+	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x44, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x67,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44,
+	0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x66, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x90, 0x0c, 0x0a,
+	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x44, 0x54, 0x4f, 0x12, 0x76, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x5b, 0xca, 0xbb, 0x18, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0xda, 0xbb, 0x18,
+	0x34, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x30, 0x20, 0x7c, 0x7c, 0x20,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x28, 0x24, 0x29, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe9,
+	0x82, 0xae, 0xe7, 0xae, 0xb1, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad,
+	0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x79, 0x0a, 0x05, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5e, 0xca, 0xbb, 0x18, 0x05,
+	0x70, 0x68, 0x6f, 0x6e, 0x65, 0xda, 0xbb, 0x18, 0x37, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24,
+	0x29, 0x3d, 0x3d, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x28, 0x24, 0x29,
+	0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe6, 0x89, 0x8b, 0xe6, 0x9c, 0xba, 0xe5, 0x8f, 0xb7,
+	0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27,
+	0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x05, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x8b, 0x01, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x68, 0xca, 0xbb, 0x18, 0x0a,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0xda, 0xbb, 0x18, 0x37, 0x40, 0x3a,
+	0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x35, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a,
+	0x27, 0xe5, 0x90, 0x8d, 0xe5, 0xad, 0x97, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe4, 0xb8, 0x8d,
 	0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0x35, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad,
-	0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65,
-	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x06, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65,
-	0x65, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x60, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x35, 0xca,
-	0xbb, 0x18, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x79, 0xca, 0xf3, 0x18, 0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x48, 0x07, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45,
-	0x78, 0x70, 0x69, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x94, 0x01, 0x0a, 0x06, 0x67, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x42, 0x77, 0xca, 0xbb, 0x18, 0x06, 0x67,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0xda, 0xbb, 0x18, 0x4e, 0x40, 0x3a, 0x24, 0x20, 0x3d, 0x3d, 0x20,
-	0x6e, 0x75, 0x6c, 0x6c, 0x20, 0x7c, 0x7c, 0x20, 0x28, 0x24, 0x20, 0x3e, 0x3d, 0x20, 0x30, 0x20,
-	0x26, 0x26, 0x20, 0x24, 0x20, 0x3c, 0x3d, 0x20, 0x32, 0x29, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a,
-	0x27, 0xe6, 0x80, 0xa7, 0xe5, 0x88, 0xab, 0xe5, 0x80, 0xbc, 0xe5, 0xbf, 0x85, 0xe9, 0xa1, 0xbb,
-	0xe4, 0xb8, 0xba, 0x6e, 0x75, 0x6c, 0x6c, 0xe6, 0x88, 0x96, 0xe5, 0x9c, 0xa8, 0x30, 0x2d, 0x32,
-	0xe4, 0xb9, 0x8b, 0xe9, 0x97, 0xb4, 0x27, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x22, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x48, 0x08, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42,
-	0x08, 0x0a, 0x06, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x68,
-	0x6f, 0x6e, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0b,
-	0x0a, 0x09, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f,
-	0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c,
-	0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x44,
-	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x69,
-	0x72, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x91, 0x02,
-	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x62, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0xd2, 0xbb, 0x18, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d,
-	0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x49,
-	0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae,
-	0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x7f, 0x0a, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x62, 0xca, 0xbb, 0x18, 0x06,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0xda, 0xbb, 0x18, 0x39, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28,
-	0x24, 0x29, 0x3c, 0x3d, 0x32, 0x30, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe5, 0x88,
-	0xa0, 0xe9, 0x99, 0xa4, 0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd,
-	0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0x32, 0x30, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7,
-	0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01,
-	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x22, 0xbd, 0x02, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x55, 0x0a, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44,
-	0x54, 0x4f, 0x42, 0x21, 0xfa, 0xbb, 0x18, 0x04, 0x74, 0x72, 0x75, 0x65, 0xca, 0xf3, 0x18, 0x15,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x64, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xb2, 0xbb, 0x18, 0x0f, 0x6f, 0x72,
-	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0xca, 0xf3, 0x18,
-	0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x48, 0x01, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x25, 0xb2, 0xbb, 0x18, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70,
-	0x61, 0x67, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x9b, 0x02, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
+	0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x87, 0x01, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x66, 0xca, 0xbb, 0x18, 0x09, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0xda, 0xbb, 0x18, 0x37, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28,
+	0x24, 0x29, 0x3c, 0x3d, 0x35, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe5, 0xa7, 0x93,
+	0xe6, 0xb0, 0x8f, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe8,
+	0xb6, 0x85, 0xe8, 0xbf, 0x87, 0x35, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6,
+	0x27, 0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48,
+	0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x8f,
+	0x01, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x6e, 0xca, 0xbb, 0x18, 0x09, 0x72, 0x65, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0xda, 0xbb, 0x18, 0x3f, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x31, 0x30,
+	0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0x9c, 0x9f, 0xe5, 0xae, 0x9e, 0xe5, 0xa7,
+	0x93, 0xe5, 0x90, 0x8d, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd,
+	0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0x31, 0x30, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7,
+	0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x61,
+	0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x48, 0x04, 0x52, 0x08, 0x72, 0x65, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0xb4, 0x01, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x80, 0x01, 0xca,
+	0xbb, 0x18, 0x12, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0xda, 0xbb, 0x18, 0x3f, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24,
+	0x29, 0x3c, 0x3d, 0x31, 0x30, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe8, 0x81, 0x8c,
+	0xe4, 0xb8, 0x9a, 0xe5, 0xa4, 0xb4, 0xe8, 0xa1, 0x94, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe4,
+	0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0x31, 0x30, 0x30, 0xe4, 0xb8,
+	0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18, 0x23, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48,
+	0x05, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x95, 0x01, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c,
+	0x6f, 0x79, 0x65, 0x65, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x70, 0xca, 0xbb,
+	0x18, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18,
+	0x3d, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x35, 0x30, 0x3b, 0x20, 0x6d,
+	0x73, 0x67, 0x3a, 0x27, 0xe5, 0x91, 0x98, 0xe5, 0xb7, 0xa5, 0xe5, 0xb7, 0xa5, 0xe5, 0x8f, 0xb7,
+	0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8,
+	0xbf, 0x87, 0x35, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca, 0xf3,
+	0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
+	0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x06,
+	0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12,
+	0x60, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x35, 0xca, 0xbb, 0x18, 0x0e, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0xca, 0xf3, 0x18, 0x1f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x07, 0x52,
+	0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x88, 0x01,
+	0x01, 0x12, 0x94, 0x01, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x77, 0xca, 0xbb, 0x18, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0xda, 0xbb,
+	0x18, 0x4e, 0x40, 0x3a, 0x24, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x20, 0x7c, 0x7c,
+	0x20, 0x28, 0x24, 0x20, 0x3e, 0x3d, 0x20, 0x30, 0x20, 0x26, 0x26, 0x20, 0x24, 0x20, 0x3c, 0x3d,
+	0x20, 0x32, 0x29, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe6, 0x80, 0xa7, 0xe5, 0x88, 0xab,
+	0xe5, 0x80, 0xbc, 0xe5, 0xbf, 0x85, 0xe9, 0xa1, 0xbb, 0xe4, 0xb8, 0xba, 0x6e, 0x75, 0x6c, 0x6c,
+	0xe6, 0x88, 0x96, 0xe5, 0x9c, 0xa8, 0x30, 0x2d, 0x32, 0xe4, 0xb9, 0x8b, 0xe9, 0x97, 0xb4, 0x27,
+	0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x08, 0x52, 0x06, 0x67,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x27, 0xca, 0xbb, 0x18, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x48, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x37, 0x0a, 0x07, 0x69, 0x66, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xba, 0xbb, 0x18, 0x08, 0x49, 0x66, 0x2d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0xca,
+	0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x0a, 0x52, 0x07, 0x69,
+	0x66, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x61,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x44, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x66, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x22,
+	0x91, 0x02, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x62, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0xd2, 0xbb, 0x18, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29,
+	0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0x94, 0xa8, 0xe6, 0x88,
+	0xb7, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7,
+	0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48,
+	0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x7f, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x62, 0xca, 0xbb,
+	0x18, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0xda, 0xbb, 0x18, 0x39, 0x40, 0x3a, 0x6c, 0x65,
+	0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x32, 0x30, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27,
+	0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0, 0xe4, 0xb8, 0x8d, 0xe8,
+	0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0x32, 0x30, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad,
+	0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x48, 0x01, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x81, 0x03, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69,
+	0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x22, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xca, 0xf3, 0x18, 0x09, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2b,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xca,
+	0xf3, 0x18, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x48,
+	0x01, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xca, 0xf3, 0x18, 0x0c,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x48, 0x02, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x10, 0xca, 0xf3, 0x18, 0x0c, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x72, 0x74, 0x22, 0x48, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0e, 0xca, 0xf3, 0x18, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x6e,
+	0x64, 0x22, 0x48, 0x04, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0f, 0xca, 0xf3, 0x18, 0x0b,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x48, 0x05, 0x52, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x79, 0x69, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0xca, 0xf3, 0x18, 0x0d, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x70, 0x69, 0x6e, 0x79, 0x69, 0x6e, 0x22, 0x48, 0x06, 0x52, 0x06, 0x70, 0x69, 0x6e,
+	0x79, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f,
+	0x65, 0x6e, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x70, 0x69, 0x6e, 0x79, 0x69, 0x6e, 0x22, 0xbd, 0x02, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12,
+	0x55, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x42, 0x21, 0xfa, 0xbb, 0x18, 0x04, 0x74, 0x72,
+	0x75, 0x65, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x00, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x64, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
+	0xb2, 0xbb, 0x18, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x25, 0xb2, 0xbb,
+	0x18, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x8a, 0x04, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54,
+	0x4f, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x14, 0xfa, 0xbb, 0x18, 0x04, 0x74, 0x72, 0x75, 0x65,
+	0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52, 0x0b,
+	0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x97,
+	0x01, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x7a, 0xda, 0xbb, 0x18, 0x51, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x30,
+	0x20, 0x7c, 0x7c, 0x20, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x28, 0x27, 0x5e, 0x28, 0x63, 0x73,
+	0x76, 0x7c, 0x78, 0x6c, 0x73, 0x78, 0x29, 0x24, 0x27, 0x2c, 0x24, 0x29, 0x3b, 0x20, 0x6d, 0x73,
+	0x67, 0x3a, 0x27, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe5,
+	0xbf, 0x85, 0xe9, 0xa1, 0xbb, 0xe6, 0x98, 0xaf, 0x20, 0x63, 0x73, 0x76, 0x20, 0xe6, 0x88, 0x96,
+	0x20, 0x78, 0x6c, 0x73, 0x78, 0x27, 0xe2, 0xbb, 0x18, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x27, 0xe2, 0xbb, 0x18, 0x07, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64,
+	0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x48, 0x02, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x9f,
+	0x01, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x7c, 0xda, 0xbb, 0x18, 0x4b, 0x40, 0x3a, 0x24, 0x20, 0x3d, 0x3d, 0x20, 0x6e,
+	0x75, 0x6c, 0x6c, 0x20, 0x7c, 0x7c, 0x20, 0x28, 0x24, 0x20, 0x3e, 0x3d, 0x20, 0x31, 0x20, 0x26,
+	0x26, 0x20, 0x24, 0x20, 0x3c, 0x3d, 0x20, 0x35, 0x30, 0x30, 0x29, 0x3b, 0x20, 0x6d, 0x73, 0x67,
+	0x3a, 0x27, 0xe6, 0x89, 0xb9, 0xe6, 0xac, 0xa1, 0xe5, 0xa4, 0xa7, 0xe5, 0xb0, 0x8f, 0xe5, 0xbf,
+	0x85, 0xe9, 0xa1, 0xbb, 0xe5, 0x9c, 0xa8, 0x31, 0x2d, 0x35, 0x30, 0x30, 0xe4, 0xb9, 0x8b, 0xe9,
+	0x97, 0xb4, 0x27, 0xe2, 0xbb, 0x18, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x48, 0x03, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0xa8, 0x02, 0x0a, 0x16, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x54, 0x4f, 0x12,
+	0x38, 0x0a, 0x09, 0x72, 0x6f, 0x77, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x6f,
+	0x77, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x48, 0x00, 0x52, 0x09, 0x72, 0x6f, 0x77,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xca, 0xf3, 0x18,
+	0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1b, 0xca, 0xf3, 0x18, 0x17, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x39,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c,
+	0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x6f,
+	0x77, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22,
+	0xb1, 0x04, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61,
 	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68,
 	0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73,
 	0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00,
-	0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a,
-	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x4e, 0x0a, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x44, 0x54, 0x4f, 0x42, 0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70,
-	0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61,
-	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22,
-	0xf0, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x55, 0x0a, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44,
-	0x54, 0x4f, 0x42, 0x21, 0xfa, 0xbb, 0x18, 0x04, 0x74, 0x72, 0x75, 0x65, 0xca, 0xf3, 0x18, 0x15,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x64, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xb2, 0xbb, 0x18, 0x0f, 0x6f, 0x72,
-	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0xca, 0xf3, 0x18,
+	0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a,
+	0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x12, 0xca,
+	0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x22, 0x48, 0x01, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x38,
+	0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x48, 0x02, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x52, 0x6f, 0x77, 0x73, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x15, 0xca, 0xf3, 0x18,
+	0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x72, 0x6f, 0x77,
+	0x73, 0x22, 0x48, 0x03, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x17, 0xca, 0xf3, 0x18, 0x13, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x22,
+	0x48, 0x04, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x16, 0xca, 0xf3, 0x18, 0x12, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x48, 0x05,
+	0x52, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x4f, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x54, 0x4f, 0x42,
+	0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x6f, 0x77, 0x73, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x52, 0x6f, 0x77, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x52, 0x6f, 0x77, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x52, 0x6f, 0x77, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52,
+	0x6f, 0x77, 0x73, 0x22, 0x84, 0x03, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x97, 0x01,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x7a,
+	0xb2, 0xbb, 0x18, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0xda, 0xbb, 0x18, 0x51, 0x40, 0x3a,
+	0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x72, 0x65, 0x67,
+	0x65, 0x78, 0x70, 0x28, 0x27, 0x5e, 0x28, 0x63, 0x73, 0x76, 0x7c, 0x78, 0x6c, 0x73, 0x78, 0x29,
+	0x24, 0x27, 0x2c, 0x24, 0x29, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe6, 0x96, 0x87, 0xe4,
+	0xbb, 0xb6, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe5, 0xbf, 0x85, 0xe9, 0xa1, 0xbb, 0xe6, 0x98,
+	0xaf, 0x20, 0x63, 0x73, 0x76, 0x20, 0xe6, 0x88, 0x96, 0x20, 0x78, 0x6c, 0x73, 0x78, 0x27, 0xca,
+	0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x00, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x64, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x37, 0xb2, 0xbb, 0x18, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x25, 0xb2,
+	0xbb, 0x18, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01,
+	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xfa, 0x02, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54,
+	0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x54, 0x4f, 0x42,
+	0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x12, 0x4e, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x19, 0xca, 0xf3,
+	0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x5d, 0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x44, 0x54, 0x4f, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0xf0, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54,
+	0x4f, 0x12, 0x55, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x42, 0x21, 0xfa, 0xbb, 0x18, 0x04,
+	0x74, 0x72, 0x75, 0x65, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61,
+	0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x00, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x64, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x37, 0xb2, 0xbb, 0x18, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x0e, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xfc, 0x02, 0x0a, 0x16, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x54,
+	0x4f, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x4e, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x19,
+	0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x44, 0x54, 0x4f, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0x81, 0x02, 0x0a, 0x1a, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x33, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xd2, 0xbb, 0x18, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22,
+	0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a,
+	0x09, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x23, 0xca, 0xbb, 0x18, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x48, 0x01, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0xca, 0xbb, 0x18, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x56, 0x0a,
+	0x14, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x33, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xd2, 0xbb, 0x18, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0xbb, 0x06, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x44, 0x54, 0x4f, 0x12, 0x22, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xca, 0xf3, 0x18, 0x09, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x2f, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x12, 0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x22, 0x48, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01,
+	0x12, 0x47, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x22, 0x48, 0x02, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x0c, 0x64, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x22, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x09, 0x69, 0x73,
+	0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca,
+	0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x22, 0x48, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x48, 0x06,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x65,
+	0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e,
+	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x54, 0x4f, 0x42,
+	0x21, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x48, 0x07, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x44,
+	0x54, 0x4f, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x48, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x12, 0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x48, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0xbb, 0x06, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x22, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xca, 0xf3, 0x18, 0x09, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x2f, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x12, 0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88,
+	0x01, 0x01, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x12, 0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x6f,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x02, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44,
+	0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xca, 0xf3, 0x18,
 	0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x48, 0x01, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42,
-	0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x22, 0x9d, 0x02, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a,
+	0x22, 0x48, 0x03, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46,
+	0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0a, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x20,
+	0xca, 0xf3, 0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f,
+	0x75, 0x6e, 0x74, 0x69, 0x6c, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x48, 0x05, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x88, 0x01,
+	0x01, 0x12, 0x42, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x06, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x07, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca, 0xf3,
+	0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x22, 0x48, 0x08, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x48, 0x09, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x42, 0x12,
+	0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x48, 0x0a, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x42, 0x11, 0x0a,
+	0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0xbd, 0x04, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f,
+	0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x55, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f,
+	0x42, 0x21, 0xfa, 0xbb, 0x18, 0x04, 0x74, 0x72, 0x75, 0x65, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x44,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27,
+	0xb2, 0xbb, 0x18, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0xca, 0xf3, 0x18, 0x18, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0xb2, 0xbb, 0x18, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52,
+	0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x64, 0x0a, 0x0e, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x37, 0xb2, 0xbb, 0x18, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x0e, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01,
+	0x12, 0x82, 0x01, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x74,
+	0x68, 0x69, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x47, 0xb2, 0xbb, 0x18, 0x17, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x77,
+	0x69, 0x74, 0x68, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0xca, 0xf3, 0x18,
+	0x28, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x15, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x6f,
+	0x6c, 0x65, 0x49, 0x44, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x22, 0xc2, 0x02, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f,
+	0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74,
+	0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08,
+	0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x63, 0x0a, 0x0b, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x54,
+	0x4f, 0x42, 0x20, 0xca, 0xf3, 0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x4e, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x19, 0xca, 0xf3, 0x18, 0x15,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0xb8, 0x0a, 0x0a, 0x13, 0x52, 0x6f, 0x6c, 0x65, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x22,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xca, 0xf3, 0x18, 0x09,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x2f, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x12, 0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x12, 0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72,
+	0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x02, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49,
+	0x44, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24,
+	0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1f, 0xca, 0xf3,
+	0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66, 0x72,
+	0x6f, 0x6d, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x06, 0x52,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a,
+	0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x20, 0xca, 0xf3, 0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x48, 0x07, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69,
+	0x6c, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xca, 0xf3, 0x18, 0x1d, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x08, 0x52, 0x0b,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x88, 0x01, 0x01, 0x12, 0x38,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b,
+	0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0xca, 0xf3, 0x18, 0x0d, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x48, 0x0a, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x69,
+	0x64, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xca, 0xf3, 0x18,
+	0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0b, 0x52, 0x09,
+	0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x42, 0x79, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x09,
+	0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x63, 0x69, 0x64,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x48, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x54, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0xca, 0xf3, 0x18, 0x21, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x48, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x22,
+	0x48, 0x0e, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x4b, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0f, 0x52, 0x0c, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x48, 0x10, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x6f,
+	0x6c, 0x65, 0x49, 0x44, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64,
+	0x42, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x41, 0x74,
+	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xa8, 0x04, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x55, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x42, 0x21,
+	0xfa, 0xbb, 0x18, 0x04, 0x74, 0x72, 0x75, 0x65, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x8d, 0x01, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x70, 0xb2,
+	0xbb, 0x18, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0xda, 0xbb, 0x18, 0x47, 0x40, 0x3a, 0x24,
+	0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x20, 0x7c, 0x7c, 0x20, 0x28, 0x24, 0x20, 0x3e,
+	0x3d, 0x20, 0x30, 0x20, 0x26, 0x26, 0x20, 0x24, 0x20, 0x3c, 0x3d, 0x20, 0x34, 0x29, 0x3b, 0x20,
+	0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0x94, 0xb3, 0xe8, 0xaf, 0xb7, 0xe7, 0x8a, 0xb6, 0xe6, 0x80,
+	0x81, 0xe5, 0xbf, 0x85, 0xe9, 0xa1, 0xbb, 0xe5, 0x9c, 0xa8, 0x30, 0x2d, 0x34, 0xe4, 0xb9, 0x8b,
+	0xe9, 0x97, 0xb4, 0x27, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48,
+	0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0xb2, 0xbb,
+	0x18, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88,
+	0x01, 0x01, 0x12, 0x44, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x27, 0xb2, 0xbb, 0x18, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0xca,
+	0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x06, 0x72,
+	0x6f, 0x6c, 0x65, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x58, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xb2,
+	0xbb, 0x18, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0xca,
+	0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x48, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x88,
+	0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0xab, 0x02, 0x0a,
+	0x20, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54,
+	0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42,
+	0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x44, 0x54, 0x4f, 0x42, 0x13, 0xca, 0xf3, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x4e, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x19,
+	0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x1d, 0x47,
+	0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x6b, 0x0a, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x48, 0xd2, 0xbb, 0x18, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xda, 0xbb,
+	0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20,
+	0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0x94, 0xb3, 0xe8, 0xaf, 0xb7, 0x49, 0x44, 0xe6, 0xa0, 0xbc,
+	0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18,
+	0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x22, 0xea, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x63, 0x69,
+	0x64, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x6b, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x48, 0xd2, 0xbb, 0x18, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e,
+	0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0x94,
+	0xb3, 0xe8, 0xaf, 0xb7, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6,
+	0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x2d, 0x22, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x88,
+	0x01, 0x01, 0x12, 0x46, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x27, 0xca, 0xbb, 0x18, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0xe5, 0x01, 0x0a, 0x1b, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
+	0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x5a, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x42, 0x1c, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xca, 0x03, 0x0a,
+	0x14, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x44, 0x54, 0x4f, 0x12, 0x2b, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x10, 0xca, 0xf3, 0x18, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x12, 0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x48, 0x01, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0x48, 0x02,
+	0x52, 0x09, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x31,
+	0x0a, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x12, 0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x22, 0x48, 0x03, 0x52, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x3e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x17, 0xca, 0xf3, 0x18, 0x13, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x22, 0x48,
+	0x04, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x50, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1d, 0xca, 0xf3,
+	0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x48, 0x05, 0x52, 0x11, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x64, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xb2, 0x08, 0x0a, 0x17, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x44, 0x54, 0x4f, 0x12, 0x22, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0xca, 0xf3, 0x18, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22,
+	0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xca, 0xf3, 0x18, 0x0b, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xca, 0xf3, 0x18, 0x1c, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x0e,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x0e, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12,
+	0x39, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1c, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52,
+	0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0a, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20,
+	0xca, 0xf3, 0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x48, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01,
+	0x01, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x11, 0xca, 0xf3, 0x18, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x48, 0x06, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x36, 0x0a, 0x05, 0x64, 0x75, 0x65, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x1b, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x75, 0x65, 0x5f,
+	0x61, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x07, 0x52,
+	0x05, 0x64, 0x75, 0x65, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x61, 0x75, 0x74,
+	0x6f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x42, 0x16, 0xca,
+	0xf3, 0x18, 0x12, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x22, 0x48, 0x08, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xca, 0xf3,
+	0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0a, 0x52, 0x08,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x42, 0x79, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1e, 0xca,
+	0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0b, 0x52,
+	0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x48, 0x0c, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x44, 0x54, 0x4f, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x48, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a,
+	0x03, 0x5f, 0x69, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x11, 0x0a,
+	0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x64, 0x75, 0x65, 0x41, 0x74, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x42, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x83,
+	0x09, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49,
+	0x74, 0x65, 0x6d, 0x44, 0x54, 0x4f, 0x12, 0x22, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0d, 0xca, 0xf3, 0x18, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64,
+	0x22, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x63, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16,
+	0xca, 0xf3, 0x18, 0x12, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x01, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xca,
+	0xf3, 0x18, 0x14, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x02, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xca, 0xf3, 0x18, 0x0e,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xca,
+	0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x72,
+	0x65, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xca,
+	0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x61, 0x6c, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52,
+	0x08, 0x72, 0x65, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x06,
+	0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xca, 0xf3,
+	0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x22,
+	0x48, 0x06, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a,
+	0x08, 0x72, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1e, 0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48,
+	0x07, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f,
+	0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1e, 0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x6f, 0x6c, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x48, 0x08, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x51, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x09, 0x52,
+	0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88,
+	0x01, 0x01, 0x12, 0x45, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x42, 0x20, 0xca, 0xf3, 0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0a, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x08, 0x64, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x42, 0x13, 0xca, 0xf3, 0x18,
+	0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x48, 0x0b, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x45, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x20, 0xca, 0xf3, 0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0c, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x64, 0x42, 0x79, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x42, 0x20, 0xca, 0xf3, 0x18, 0x1c,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0d, 0x52, 0x0a,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c,
+	0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0e, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69,
+	0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x44,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65,
+	0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x49,
+	0x44, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x42, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0xb8, 0x07, 0x0a, 0x24, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x84, 0x01,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6b, 0xca, 0xbb,
+	0x18, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0xda, 0xbb, 0x18, 0x50, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28,
+	0x24, 0x29, 0x3e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d,
+	0x31, 0x30, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe6, 0xb4, 0xbb, 0xe5, 0x8a, 0xa8,
+	0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe5, 0xbf, 0x85, 0xe9,
+	0xa1, 0xbb, 0xe5, 0x9c, 0xa8, 0x31, 0x2d, 0x31, 0x30, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97,
+	0xe7, 0xac, 0xa6, 0xe4, 0xb9, 0x8b, 0xe9, 0x97, 0xb4, 0x27, 0xca, 0xf3, 0x18, 0x0b, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x56, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xca, 0xbb, 0x18, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0xca, 0xf3, 0x18, 0x1c, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0xa0, 0x01, 0x0a,
+	0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x73, 0xca, 0xbb, 0x18, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x38, 0x40, 0x3a,
+	0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x6c, 0x65, 0x6e,
+	0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0xbb,
+	0x84, 0xe7, 0xbb, 0x87, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6,
+	0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x0e, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12,
+	0x80, 0x01, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x63, 0xca, 0xbb, 0x18, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18,
+	0x38, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x30, 0x20, 0x7c, 0x7c, 0x20,
+	0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a,
+	0x27, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4,
+	0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x88,
+	0x01, 0x01, 0x12, 0x93, 0x01, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6e, 0xca, 0xbb, 0x18, 0x0b, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x3b, 0x40, 0x3a, 0x6c, 0x65,
+	0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x24,
+	0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe5, 0xa4, 0x8d, 0xe6,
+	0xa0, 0xb8, 0xe4, 0xba, 0xba, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d,
+	0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x05, 0x64, 0x75, 0x65, 0x41,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x25, 0xca, 0xbb, 0x18, 0x06, 0x64, 0x75, 0x65,
+	0x5f, 0x61, 0x74, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x75, 0x65,
+	0x5f, 0x61, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x05,
+	0x52, 0x05, 0x64, 0x75, 0x65, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x54, 0x0a, 0x0a, 0x61, 0x75,
+	0x74, 0x6f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x42, 0x2f,
+	0xca, 0xbb, 0x18, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0xca,
+	0xf3, 0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48,
+	0x06, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x64, 0x75, 0x65, 0x41, 0x74,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x22,
+	0x99, 0x03, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x55, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f,
+	0x42, 0x21, 0xfa, 0xbb, 0x18, 0x04, 0x74, 0x72, 0x75, 0x65, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x8d,
+	0x01, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x70, 0xb2, 0xbb, 0x18, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0xda, 0xbb, 0x18, 0x47, 0x40,
+	0x3a, 0x24, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x20, 0x7c, 0x7c, 0x20, 0x28, 0x24,
+	0x20, 0x3e, 0x3d, 0x20, 0x30, 0x20, 0x26, 0x26, 0x20, 0x24, 0x20, 0x3c, 0x3d, 0x20, 0x32, 0x29,
+	0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe6, 0xb4, 0xbb, 0xe5, 0x8a, 0xa8, 0xe7, 0x8a, 0xb6,
+	0xe6, 0x80, 0x81, 0xe5, 0xbf, 0x85, 0xe9, 0xa1, 0xbb, 0xe5, 0x9c, 0xa8, 0x30, 0x2d, 0x32, 0xe4,
+	0xb9, 0x8b, 0xe9, 0x97, 0xb4, 0x27, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x48, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x64,
+	0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xb2, 0xbb, 0x18, 0x0f, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0xca, 0xf3, 0x18, 0x20, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48,
+	0x02, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xb6, 0x02, 0x0a, 0x24,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
+	0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x09, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca,
+	0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x73, 0x22, 0x52, 0x09, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x12, 0x4e,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68,
+	0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x22, 0xa2, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x6e, 0x0a, 0x0a, 0x63, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x49,
+	0xd2, 0xbb, 0x18, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x44, 0xda, 0xbb,
+	0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20,
+	0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe6, 0xb4, 0xbb, 0xe5, 0x8a, 0xa8, 0x49, 0x44, 0xe6, 0xa0, 0xbc,
+	0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18,
+	0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x44, 0x22, 0xf1, 0x01, 0x0a, 0x1f, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a,
 	0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18,
 	0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70,
 	0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01,
-	0x12, 0x4a, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a,
-	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x4e, 0x0a, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74,
-	0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09,
-	0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61,
-	0x67, 0x65, 0x22, 0x81, 0x02, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54,
-	0x4f, 0x12, 0x33, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x16, 0xd2, 0xbb, 0x18, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0xca, 0xf3, 0x18,
-	0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x23, 0xca, 0xbb, 0x18, 0x0a, 0x6e,
-	0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f,
-	0x6e, 0x3a, 0x22, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x48, 0x01,
-	0x52, 0x09, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x42,
-	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25,
-	0xca, 0xbb, 0x18, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73,
-	0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88,
-	0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x0c, 0x0a,
-	0x0a, 0x5f, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x56, 0x0a, 0x14, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x33,
-	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16,
-	0xd2, 0xbb, 0x18, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73,
-	0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0xfc,
-	0x05, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69,
-	0x70, 0x44, 0x54, 0x4f, 0x12, 0x22, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0d, 0xca, 0xf3, 0x18, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x48,
-	0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73,
-	0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x01, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0e, 0x6f, 0x72, 0x67,
-	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67,
-	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x02, 0x52,
-	0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88,
-	0x01, 0x01, 0x12, 0x4b, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73,
-	0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x0c,
-	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12,
-	0x42, 0x0a, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x73,
-	0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
-	0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x48, 0x05, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
-	0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x48, 0x06, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x65, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x54, 0x4f, 0x42, 0x21, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73,
-	0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x07, 0x52, 0x0c, 0x6f,
-	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x5d,
-	0x0a, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x44, 0x65,
-	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x54, 0x4f, 0x42, 0x1f, 0xca, 0xf3, 0x18,
-	0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x08, 0x52, 0x0a,
-	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a,
-	0x03, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42,
-	0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x44, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72,
-	0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42,
-	0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0f, 0x0a,
-	0x0d, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d,
-	0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xed, 0x01,
-	0x0a, 0x19, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x12, 0x61, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69,
+	0x67, 0x6e, 0x44, 0x54, 0x4f, 0x42, 0x1d, 0xca, 0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e,
+	0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x22, 0xf6, 0x03,
+	0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54,
+	0x4f, 0x12, 0x6e, 0x0a, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x49, 0xd2, 0xbb, 0x18, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24,
+	0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe6, 0xb4, 0xbb, 0xe5,
+	0x8a, 0xa8, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3,
+	0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22,
+	0x48, 0x00, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x44, 0x88, 0x01,
+	0x01, 0x12, 0x55, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x42, 0x21, 0xfa, 0xbb, 0x18, 0x04,
+	0x74, 0x72, 0x75, 0x65, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61,
+	0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x95, 0x01, 0x0a, 0x08, 0x64, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x74, 0xb2, 0xbb, 0x18,
+	0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0xda, 0xbb, 0x18, 0x47, 0x40, 0x3a, 0x24,
+	0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x20, 0x7c, 0x7c, 0x20, 0x28, 0x24, 0x20, 0x3e,
+	0x3d, 0x20, 0x30, 0x20, 0x26, 0x26, 0x20, 0x24, 0x20, 0x3c, 0x3d, 0x20, 0x34, 0x29, 0x3b, 0x20,
+	0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe5, 0xa4, 0x8d, 0xe6, 0xa0, 0xb8, 0xe7, 0xbb, 0x93, 0xe8, 0xae,
+	0xba, 0xe5, 0xbf, 0x85, 0xe9, 0xa1, 0xbb, 0xe5, 0x9c, 0xa8, 0x30, 0x2d, 0x34, 0xe4, 0xb9, 0x8b,
+	0xe9, 0x97, 0xb4, 0x27, 0xca, 0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x48, 0x02, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x44, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x27, 0xb2, 0xbb, 0x18, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0xca, 0xf3, 0x18,
+	0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x49, 0x44, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0xa2, 0x02, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x74, 0x65, 0x6d, 0x73,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62,
 	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a,
 	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48,
-	0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x61,
-	0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x44, 0x54, 0x4f, 0x42,
-	0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x73, 0x68, 0x69, 0x70, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x48, 0x01, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x88, 0x01,
-	0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x0d,
-	0x0a, 0x0b, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x22, 0xed, 0x01,
-	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
-	0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x62,
-	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45,
-	0xd2, 0xbb, 0x18, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a,
-	0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a,
-	0x27, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4,
-	0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f,
-	0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88,
-	0x01, 0x01, 0x12, 0x55, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x42, 0x21, 0xfa, 0xbb, 0x18,
-	0x04, 0x74, 0x72, 0x75, 0x65, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70,
-	0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0xb9, 0x02,
-	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
-	0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12,
-	0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61,
-	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca,
-	0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65,
-	0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88,
-	0x01, 0x01, 0x12, 0x5f, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69,
-	0x70, 0x44, 0x54, 0x4f, 0x42, 0x20, 0xca, 0xf3, 0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68,
-	0x69, 0x70, 0x73, 0x12, 0x4e, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x45,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x54, 0x4f, 0x42, 0x10, 0xca, 0xf3,
+	0x18, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x4e, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42,
+	0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0x8b, 0x03, 0x0a, 0x20,
+	0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f,
+	0x12, 0x62, 0x0a, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x45, 0xd2, 0xbb, 0x18, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b,
+	0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73,
+	0x67, 0x3a, 0x27, 0xe6, 0x9d, 0xa1, 0xe7, 0x9b, 0xae, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc,
+	0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49,
+	0x44, 0x88, 0x01, 0x01, 0x12, 0x96, 0x01, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x75, 0xca, 0xbb, 0x18, 0x08, 0x64, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0xda, 0xbb, 0x18, 0x52, 0x40, 0x3a, 0x24, 0x20, 0x3d, 0x3d, 0x20,
+	0x32, 0x20, 0x7c, 0x7c, 0x20, 0x24, 0x20, 0x3d, 0x3d, 0x20, 0x33, 0x3b, 0x20, 0x6d, 0x73, 0x67,
+	0x3a, 0x27, 0xe5, 0xa4, 0x8d, 0xe6, 0xa0, 0xb8, 0xe7, 0xbb, 0x93, 0xe8, 0xae, 0xba, 0xe5, 0x8f,
+	0xaa, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0x32, 0xef, 0xbc, 0x88, 0xe7, 0xa1, 0xae, 0xe8, 0xae,
+	0xa4, 0xe4, 0xbf, 0x9d, 0xe7, 0x95, 0x99, 0xef, 0xbc, 0x89, 0xe6, 0x88, 0x96, 0x33, 0xef, 0xbc,
+	0x88, 0xe6, 0x92, 0xa4, 0xe9, 0x94, 0x80, 0xef, 0xbc, 0x89, 0x27, 0xca, 0xf3, 0x18, 0x0f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x48, 0x01,
+	0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27,
+	0xca, 0xbb, 0x18, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0xca, 0xf3, 0x18, 0x18, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x44,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xd9, 0x01, 0x0a, 0x1b, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74,
+	0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52,
+	0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x54, 0x4f, 0x42, 0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x74, 0x65, 0x6d, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x88, 0x01, 0x01, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x22, 0xa4, 0x01, 0x0a, 0x23, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x6e, 0x0a,
+	0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x49, 0xd2, 0xbb, 0x18, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49,
+	0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33,
+	0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe6, 0xb4, 0xbb, 0xe5, 0x8a, 0xa8, 0x49, 0x44,
+	0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27,
+	0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52, 0x0a,
+	0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x44, 0x22, 0x9c, 0x04, 0x0a,
+	0x12, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x44, 0x54, 0x4f, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x11, 0xca, 0xf3, 0x18, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x48, 0x00, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x06, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1c, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d,
+	0x65, 0x6e, 0x75, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x48, 0x01, 0x52, 0x06, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x3f,
+	0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x75, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1e, 0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x65, 0x6e, 0x75,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x48, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x75, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x44, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xca, 0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x48, 0x05, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x42, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x48, 0x06, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x44, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65,
+	0x6e, 0x75, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x22, 0xe5, 0x03, 0x0a, 0x12,
+	0x52, 0x6f, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x44,
+	0x54, 0x4f, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x12, 0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x6f,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44,
+	0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x01, 0x52, 0x08, 0x72,
+	0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x72, 0x6f,
+	0x6c, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xca, 0xf3,
+	0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x08,
+	0x72, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x66,
+	0x75, 0x6c, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x16, 0xca, 0xf3, 0x18, 0x12, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x66, 0x75, 0x6c, 0x6c, 0x5f,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x48, 0x03, 0x52, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x44, 0x54, 0x4f, 0x42, 0x11, 0xca, 0xf3, 0x18, 0x0d, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x52, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x73, 0x12, 0x57, 0x0a, 0x10, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x26, 0xca, 0xf3, 0x18,
+	0x22, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x10, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72,
+	0x6f, 0x6c, 0x65, 0x49, 0x44, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x13,
+	0x0a, 0x11, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x96, 0x03, 0x0a, 0x14, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x0e,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12,
+	0x57, 0x0a, 0x10, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0xca, 0xf3, 0x18, 0x22, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x48, 0x01, 0x52, 0x10, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x44, 0x54, 0x4f, 0x42, 0x10, 0xca, 0xf3, 0x18, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x64,
+	0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x44,
+	0x54, 0x4f, 0x42, 0x22, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xfb, 0x02, 0x0a,
+	0x12, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x44, 0x54, 0x4f, 0x12, 0x2f, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x12, 0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xca, 0xf3, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x01, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x72, 0x65,
+	0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xca, 0xf3,
+	0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x08,
+	0x72, 0x65, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x62,
+	0x72, 0x65, 0x61, 0x6b, 0x47, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x16, 0xca, 0xf3, 0x18, 0x12, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x72, 0x65, 0x61, 0x6b,
+	0x5f, 0x67, 0x6c, 0x61, 0x73, 0x73, 0x22, 0x48, 0x03, 0x52, 0x0a, 0x62, 0x72, 0x65, 0x61, 0x6b,
+	0x47, 0x6c, 0x61, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x07, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x54, 0x4f, 0x42, 0x12, 0xca, 0xf3, 0x18, 0x0e, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x52, 0x07, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x47, 0x6c, 0x61, 0x73, 0x73, 0x22, 0xc7, 0x02, 0x0a, 0x1d, 0x47,
+	0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x74, 0x72,
+	0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x58, 0x0a, 0x0b,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x31, 0xb2, 0xbb, 0x18, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6c,
+	0x69, 0x6e, 0x65, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c,
+	0x69, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x29, 0xb2, 0xbb, 0x18, 0x08, 0x72, 0x6f, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x73, 0xca, 0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72,
+	0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x73, 0x12, 0x64, 0x0a, 0x0e, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x37, 0xb2, 0xbb, 0x18, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x0e,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01,
+	0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x6e,
+	0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x22, 0xe6, 0x02, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70,
+	0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0b, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x17, 0xca, 0xf3, 0x18, 0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x48, 0x01, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0b, 0x6d, 0x65,
+	0x6e, 0x75, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x17, 0xca, 0xf3, 0x18, 0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x65, 0x6e, 0x75, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x48, 0x02, 0x52, 0x0b, 0x6d, 0x65, 0x6e, 0x75,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x05, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x44, 0x54, 0x4f, 0x42, 0x10, 0xca, 0xf3, 0x18, 0x0c, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xf9, 0x02,
+	0x0a, 0x25, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x62, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0xd2, 0xbb, 0x18, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d,
+	0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7,
+	0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1,
+	0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x58, 0x0a, 0x0b, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x31, 0xb2, 0xbb, 0x18, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6c, 0x69,
+	0x6e, 0x65, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69,
+	0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x64, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xb2,
+	0xbb, 0x18, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xb3, 0x02, 0x0a, 0x26, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
+	0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xca, 0xf3,
+	0x18, 0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f,
+	0x6c, 0x69, 0x6e, 0x65, 0x22, 0x48, 0x01, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x4c, 0x69, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x50, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x44, 0x54, 0x4f, 0x42, 0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75,
+	0x73, 0x65, 0x72, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x22,
+	0xd1, 0x05, 0x0a, 0x20, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x44, 0x54, 0x4f, 0x12, 0x97, 0x01, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x7a, 0xb2, 0xbb, 0x18, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0xda, 0xbb, 0x18, 0x51, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x30,
+	0x20, 0x7c, 0x7c, 0x20, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x28, 0x27, 0x5e, 0x28, 0x63, 0x73,
+	0x76, 0x7c, 0x78, 0x6c, 0x73, 0x78, 0x29, 0x24, 0x27, 0x2c, 0x24, 0x29, 0x3b, 0x20, 0x6d, 0x73,
+	0x67, 0x3a, 0x27, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe5,
+	0xbf, 0x85, 0xe9, 0xa1, 0xbb, 0xe6, 0x98, 0xaf, 0x20, 0x63, 0x73, 0x76, 0x20, 0xe6, 0x88, 0x96,
+	0x20, 0x78, 0x6c, 0x73, 0x78, 0x27, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x48, 0x00, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x91,
+	0x01, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x78, 0xb2,
+	0xbb, 0x18, 0x04, 0x76, 0x69, 0x65, 0x77, 0xda, 0xbb, 0x18, 0x53, 0x40, 0x3a, 0x6c, 0x65, 0x6e,
+	0x28, 0x24, 0x29, 0x3d, 0x3d, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70,
+	0x28, 0x27, 0x5e, 0x28, 0x72, 0x6f, 0x6c, 0x65, 0x7c, 0x75, 0x73, 0x65, 0x72, 0x29, 0x24, 0x27,
+	0x2c, 0x24, 0x29, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe6, 0x8a, 0xa5, 0xe5, 0x91, 0x8a,
+	0xe8, 0xa7, 0x86, 0xe5, 0x9b, 0xbe, 0xe5, 0xbf, 0x85, 0xe9, 0xa1, 0xbb, 0xe6, 0x98, 0xaf, 0x20,
+	0x72, 0x6f, 0x6c, 0x65, 0x20, 0xe6, 0x88, 0x96, 0x20, 0x75, 0x73, 0x65, 0x72, 0x27, 0xca, 0xf3,
+	0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76, 0x69, 0x65, 0x77, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x88,
+	0x01, 0x01, 0x12, 0x58, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x6e,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xb2, 0xbb, 0x18, 0x0c, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x07,
+	0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x29, 0xb2,
+	0xbb, 0x18, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0xca, 0xf3, 0x18, 0x19, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44,
+	0x73, 0x12, 0x43, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x29, 0xb2, 0xbb, 0x18, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73,
+	0xca, 0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x64, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
+	0xb2, 0xbb, 0x18, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x76, 0x69, 0x65, 0x77,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x6e, 0x65,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x22, 0xf1, 0x03, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x44, 0x54, 0x4f, 0x12, 0x22, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xca, 0xf3, 0x18, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x16, 0xca, 0xf3, 0x18, 0x12, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x48, 0x01, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xca, 0xf3, 0x18, 0x0b, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x64,
+	0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x24, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca,
+	0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x22, 0x48, 0x05, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x67, 0x65, 0x41, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1d, 0xca, 0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x70, 0x75, 0x72, 0x67, 0x65, 0x5f, 0x61, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x06, 0x52, 0x07, 0x70, 0x75, 0x72, 0x67, 0x65, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x70, 0x75, 0x72, 0x67, 0x65, 0x41, 0x74, 0x22, 0xcc, 0x02, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0xbb, 0x01, 0x0a, 0x0a, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x95,
+	0x01, 0xd2, 0xbb, 0x18, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0xda,
+	0xbb, 0x18, 0x77, 0x40, 0x3a, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x28, 0x27, 0x5e, 0x28, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x7c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x7c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x29, 0x24,
+	0x27, 0x2c, 0x24, 0x29, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe5, 0x9b, 0x9e, 0xe6, 0x94,
+	0xb6, 0xe7, 0xab, 0x99, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xe5, 0xbf, 0x85, 0xe9, 0xa1, 0xbb,
+	0xe6, 0x98, 0xaf, 0x20, 0x75, 0x73, 0x65, 0x72, 0x73, 0xe3, 0x80, 0x81, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0xe6, 0x88, 0x96, 0x20, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f,
+	0x42, 0x21, 0xfa, 0xbb, 0x18, 0x04, 0x74, 0x72, 0x75, 0x65, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0xb0, 0x02, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74,
+	0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52,
+	0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x08,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x44, 0x54, 0x4f, 0x42, 0x1d, 0xca, 0xf3, 0x18, 0x19,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50, 0x61,
 	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x19, 0xca,
 	0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d,
 	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
 	0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x1e, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68,
-	0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x62, 0x0a, 0x06,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0xc5, 0x02, 0x0a, 0x17, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0xbb, 0x01, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x95, 0x01, 0xd2, 0xbb, 0x18,
+	0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0xda, 0xbb, 0x18, 0x77, 0x40,
+	0x3a, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x28, 0x27, 0x5e, 0x28, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x7c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x7c, 0x64,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x29, 0x24, 0x27, 0x2c, 0x24, 0x29,
+	0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe5, 0x9b, 0x9e, 0xe6, 0x94, 0xb6, 0xe7, 0xab, 0x99,
+	0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xe5, 0xbf, 0x85, 0xe9, 0xa1, 0xbb, 0xe6, 0x98, 0xaf, 0x20,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0xe3, 0x80, 0x81, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0xe6, 0x88, 0x96, 0x20, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x2d, 0x22, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x56, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x41, 0xd2, 0xbb, 0x18, 0x02, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e,
+	0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe8, 0xae,
+	0xb0, 0xe5, 0xbd, 0x95, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6,
+	0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x2d, 0x22, 0x48, 0x01, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69,
+	0x64, 0x22, 0xc7, 0x03, 0x0a, 0x1f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x63, 0x0a, 0x17, 0x72, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x24, 0xca, 0xf3, 0x18, 0x20, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x6f,
+	0x6c, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x48,
+	0x01, 0x52, 0x17, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x56, 0x0a,
+	0x13, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x22, 0x48, 0x02, 0x52, 0x13, 0x72,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x73, 0x88, 0x01, 0x01, 0x12, 0x53, 0x0a, 0x10, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64,
+	0x52, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x27, 0xca, 0xf3, 0x18, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x10, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x52, 0x6f, 0x6c, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x72, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x22, 0xed, 0x01, 0x0a, 0x19,
+	0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74,
+	0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52,
+	0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x61, 0x0a, 0x0a,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x44, 0x54, 0x4f, 0x42, 0x1f, 0xca,
+	0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01,
+	0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x88, 0x01, 0x01, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x22, 0xed, 0x01, 0x0a, 0x1c,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x62, 0x0a, 0x06,
 	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0xd2, 0xbb,
 	0x18, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65,
 	0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7,
 	0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d,
 	0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
 	0x22, 0x2d, 0x22, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01,
-	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0xf0, 0x03, 0x0a, 0x19,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x73, 0x0a, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x56, 0xb2, 0xbb, 0x18, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28,
+	0x12, 0x55, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x42, 0x21, 0xfa, 0xbb, 0x18, 0x04, 0x74,
+	0x72, 0x75, 0x65, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67,
+	0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0xb9, 0x02, 0x0a, 0x1d,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a,
+	0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18,
+	0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01,
+	0x12, 0x5f, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x44,
+	0x54, 0x4f, 0x42, 0x20, 0xca, 0xf3, 0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x73, 0x12, 0x4e, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x19, 0xca, 0xf3, 0x18,
+	0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x62, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0xd2, 0xbb, 0x18, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28,
 	0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0x94, 0xa8,
 	0xe6, 0x88, 0xb7, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad,
-	0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x93,
-	0x01, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x66, 0xb2, 0xbb, 0x18, 0x0f, 0x6f, 0x72, 0x67,
-	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x2b,
-	0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73,
-	0x67, 0x3a, 0x27, 0xe7, 0xbb, 0x84, 0xe7, 0xbb, 0x87, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc,
-	0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x20, 0x6a,
-	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48,
-	0x01, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x44, 0x88, 0x01, 0x01, 0x12, 0x98, 0x01, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6f, 0xb2, 0xbb, 0x18,
-	0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0xda, 0xbb,
-	0x18, 0x38, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x30, 0x20, 0x7c, 0x7c,
-	0x20, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67,
-	0x3a, 0x27, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f,
-	0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73,
-	0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x0c,
-	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42,
-	0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f,
-	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x0f, 0x0a,
-	0x0d, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0xa7,
-	0x09, 0x0a, 0x0f, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x54, 0x4f, 0x12, 0x22, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
-	0xca, 0xf3, 0x18, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52,
-	0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x63, 0x6f, 0x64, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48,
-	0x01, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xca, 0xf3, 0x18, 0x0b, 0x6a, 0x73,
-	0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x0c, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74,
-	0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xca, 0xf3, 0x18,
-	0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48,
-	0x04, 0x52, 0x0c, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x60, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x29, 0xca, 0xf3, 0x18, 0x25, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x13, 0x61, 0x63,
-	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x6f, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x6f,
-	0x67, 0x6f, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x06, 0x52,
-	0x04, 0x6c, 0x6f, 0x67, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x6e, 0x63, 0x65, 0x43, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x22,
-	0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e,
-	0x63, 0x65, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x43, 0x69, 0x74, 0x79,
-	0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x03, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x48, 0x07, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca,
-	0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x22, 0x48, 0x08, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x88, 0x01, 0x01, 0x12, 0x53, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e,
-	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x54, 0x4f, 0x42,
-	0x1b, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x72, 0x65, 0x6e,
-	0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x09, 0x52, 0x06,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x54, 0x0a, 0x08, 0x63, 0x68, 0x69,
-	0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x54, 0x4f, 0x42, 0x1d, 0xca, 0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12,
-	0x48, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x21, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69,
-	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0a, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x54, 0x0a, 0x0f, 0x64, 0x65, 0x70,
-	0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x25, 0xca, 0xf3, 0x18, 0x21, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65,
-	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2c, 0x6f,
-	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0b, 0x52, 0x0f, 0x64, 0x65, 0x70,
-	0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x39, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x6f, 0x49, 0x44, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1c, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x6f, 0x67, 0x6f, 0x5f,
-	0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0c, 0x52,
-	0x06, 0x6c, 0x6f, 0x67, 0x6f, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69,
-	0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70,
-	0x65, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6c, 0x6f,
-	0x67, 0x6f, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x09,
-	0x0a, 0x07, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x64, 0x65,
-	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x09, 0x0a,
-	0x07, 0x5f, 0x6c, 0x6f, 0x67, 0x6f, 0x49, 0x44, 0x22, 0xf1, 0x01, 0x0a, 0x17, 0x4f, 0x72, 0x67,
-	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d,
+	0x22, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0xf0, 0x03, 0x0a, 0x19, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x73, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x56, 0xb2, 0xbb, 0x18, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29,
+	0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0x94, 0xa8, 0xe6, 0x88,
+	0xb7, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7,
+	0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48,
+	0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x93, 0x01, 0x0a,
+	0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x66, 0xb2, 0xbb, 0x18, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a,
+	0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a,
+	0x27, 0xe7, 0xbb, 0x84, 0xe7, 0xbb, 0x87, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4,
+	0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52,
+	0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88,
+	0x01, 0x01, 0x12, 0x98, 0x01, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6f, 0xb2, 0xbb, 0x18, 0x0d, 0x64,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x38,
+	0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x6c,
+	0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27,
+	0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8,
+	0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x0c, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0xb6, 0x0a, 0x0a,
+	0x0f, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x54, 0x4f,
+	0x12, 0x22, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xca, 0xf3,
+	0x18, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x02, 0x69,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f,
+	0x64, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xca, 0xf3, 0x18, 0x0b, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x0c, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xca, 0xf3, 0x18, 0x1e, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52,
+	0x0c, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x60, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xca,
+	0xf3, 0x18, 0x25, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x13, 0x61, 0x63, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x32, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x6f, 0x67, 0x6f,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x06, 0x52, 0x04, 0x6c,
+	0x6f, 0x67, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e,
+	0x63, 0x65, 0x43, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x22, 0xca, 0xf3,
+	0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65,
+	0x5f, 0x63, 0x69, 0x74, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x43, 0x69, 0x74, 0x79, 0x12, 0x38,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x48, 0x07, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca, 0xf3, 0x18,
+	0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x22, 0x48, 0x08, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x53, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x54, 0x4f, 0x42, 0x1b, 0xca,
+	0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x09, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x54, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x54, 0x4f, 0x42, 0x1d, 0xca, 0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x48, 0x0a,
+	0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x21, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0a, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x54, 0x0a, 0x0f, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x25, 0xca, 0xf3, 0x18, 0x21, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0b, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a,
+	0x06, 0x6c, 0x6f, 0x67, 0x6f, 0x49, 0x44, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xca,
+	0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x6f, 0x67, 0x6f, 0x5f, 0x69, 0x64,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0c, 0x52, 0x06, 0x6c,
+	0x6f, 0x67, 0x6f, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0b, 0x6d, 0x66, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x42, 0x17, 0xca,
+	0xf3, 0x18, 0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x66, 0x61, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x48, 0x0d, 0x52, 0x0b, 0x6d, 0x66, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x42, 0x12, 0xca, 0xf3, 0x18, 0x0e, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x48, 0x0e, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f,
+	0x69, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6c,
+	0x6f, 0x67, 0x6f, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x64,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x67, 0x6f, 0x49, 0x44, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x66,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xf1, 0x01, 0x0a, 0x17, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54,
+	0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42,
+	0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x88, 0x01, 0x01, 0x12, 0x65, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x54, 0x4f, 0x42, 0x21, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa3, 0x07, 0x0a, 0x1c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x91, 0x01, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x78, 0xca, 0xbb, 0x18, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0xda, 0xbb, 0x18, 0x5d, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e,
+	0x30, 0x20, 0x26, 0x26, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x3d, 0x32, 0x20, 0x26,
+	0x26, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x31, 0x30, 0x30, 0x3b, 0x20, 0x6d,
+	0x73, 0x67, 0x3a, 0x27, 0xe7, 0xbb, 0x84, 0xe7, 0xbb, 0x87, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0,
+	0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe5, 0xbf, 0x85, 0xe9, 0xa1, 0xbb, 0xe5, 0x9c, 0xa8, 0x32,
+	0x2d, 0x31, 0x30, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0xe4, 0xb9, 0x8b,
+	0xe9, 0x97, 0xb4, 0x27, 0xca, 0xf3, 0x18, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x8b,
+	0x01, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x6a, 0xca, 0xbb, 0x18, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0xda, 0xbb, 0x18, 0x3b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x30, 0x20,
+	0x7c, 0x7c, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d,
+	0x73, 0x67, 0x3a, 0x27, 0xe7, 0x88, 0xb6, 0xe7, 0xbb, 0x84, 0xe7, 0xbb, 0x87, 0x49, 0x44, 0xe6,
+	0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca,
+	0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52,
+	0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x9f, 0x01, 0x0a,
+	0x0c, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x76, 0xca, 0xbb, 0x18, 0x0d, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0xda, 0xbb, 0x18, 0x3f, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28,
+	0x24, 0x29, 0x3c, 0x3d, 0x31, 0x30, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe6, 0x9c,
+	0xba, 0xe6, 0x9e, 0x84, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6,
+	0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0x31, 0x30, 0x30, 0xe4,
+	0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x0c, 0x66,
+	0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0xbc,
+	0x01, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x84, 0x01, 0xca,
+	0xbb, 0x18, 0x14, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0xda, 0xbb, 0x18, 0x3f, 0x40, 0x3a, 0x6c, 0x65, 0x6e,
+	0x28, 0x24, 0x29, 0x3c, 0x3d, 0x31, 0x30, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe8,
+	0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xe9, 0x95, 0xbf, 0xe5, 0xba,
+	0xa6, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0x31, 0x30, 0x30,
+	0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18, 0x25, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x13, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x57, 0x0a,
+	0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x43, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x33, 0xca, 0xbb, 0x18, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63,
+	0x65, 0x5f, 0x63, 0x69, 0x74, 0x79, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e,
+	0x63, 0x65, 0x43, 0x69, 0x74, 0x79, 0x12, 0x58, 0x0a, 0x0b, 0x6d, 0x66, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x31, 0xca, 0xbb, 0x18,
+	0x0c, 0x6d, 0x66, 0x61, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0xca, 0xf3, 0x18,
+	0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x66, 0x61, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04,
+	0x52, 0x0b, 0x6d, 0x66, 0x61, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x88, 0x01, 0x01,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x66, 0x61, 0x63, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61, 0x63, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x66, 0x61, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22,
+	0xaa, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x7a, 0x0a,
+	0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4d, 0xd2, 0xbb, 0x18, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c,
+	0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27,
+	0xe7, 0xbb, 0x84, 0xe7, 0xbb, 0x87, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8,
+	0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x84, 0x0a, 0x0a,
+	0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x7a, 0x0a,
+	0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4d, 0xd2, 0xbb, 0x18, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c,
+	0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27,
+	0xe7, 0xbb, 0x84, 0xe7, 0xbb, 0x87, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8,
+	0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x98, 0x01, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x7f, 0xca, 0xbb, 0x18, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0xda, 0xbb, 0x18, 0x5a, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d,
+	0x30, 0x20, 0x7c, 0x7c, 0x20, 0x28, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x3d, 0x32, 0x20,
+	0x26, 0x26, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x31, 0x30, 0x30, 0x29, 0x3b,
+	0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xe9, 0x95, 0xbf, 0xe5,
+	0xba, 0xa6, 0xe5, 0xbf, 0x85, 0xe9, 0xa1, 0xbb, 0xe5, 0x9c, 0xa8, 0x32, 0x2d, 0x31, 0x30, 0x30,
+	0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0xe4, 0xb9, 0x8b, 0xe9, 0x97, 0xb4, 0x27,
+	0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x8b, 0x01, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6a, 0xca, 0xbb, 0x18, 0x09, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x3b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28,
+	0x24, 0x29, 0x3d, 0x3d, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d,
+	0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0x88, 0xb6, 0xe7, 0xbb, 0x84,
+	0xe7, 0xbb, 0x87, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad,
+	0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x88,
+	0x01, 0x01, 0x12, 0x9f, 0x01, 0x0a, 0x0c, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x76, 0xca, 0xbb, 0x18, 0x0d, 0x66,
+	0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0xda, 0xbb, 0x18, 0x3f,
+	0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x31, 0x30, 0x30, 0x3b, 0x20, 0x6d,
+	0x73, 0x67, 0x3a, 0x27, 0xe6, 0x9c, 0xba, 0xe6, 0x9e, 0x84, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b,
+	0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8,
+	0xbf, 0x87, 0x31, 0x30, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca,
+	0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x48, 0x03, 0x52, 0x0c, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0xbc, 0x01, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x84, 0x01, 0xca, 0xbb, 0x18, 0x14, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0xda, 0xbb, 0x18,
+	0x3f, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x31, 0x30, 0x30, 0x3b, 0x20,
+	0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe7, 0x8a, 0xb6, 0xe6, 0x80,
+	0x81, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85,
+	0xe8, 0xbf, 0x87, 0x31, 0x30, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0x27,
+	0xca, 0xf3, 0x18, 0x25, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x13, 0x61, 0x63, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x78, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x43,
+	0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x33, 0xca, 0xbb, 0x18, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x6e, 0x63, 0x65, 0x5f, 0x63, 0x69, 0x74, 0x79, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x0c, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x43, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x58, 0x0a,
+	0x0b, 0x6d, 0x66, 0x61, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x31, 0xca, 0xbb, 0x18, 0x0c, 0x6d, 0x66, 0x61, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x66,
+	0x61, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x06, 0x52, 0x0b, 0x6d, 0x66, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x27, 0xca, 0xbb, 0x18, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x48, 0x07, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x37, 0x0a, 0x07, 0x69, 0x66, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xba, 0xbb, 0x18, 0x08, 0x49, 0x66, 0x2d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0xca, 0xf3,
+	0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x08, 0x52, 0x07, 0x69, 0x66,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x43, 0x69, 0x74, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x6d, 0x66, 0x61, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x66, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x22, 0xad, 0x01, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x44, 0x54, 0x4f, 0x12, 0x7a, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4d, 0xd2, 0xbb,
+	0x18, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36,
+	0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0xbb, 0x84, 0xe7, 0xbb, 0x87, 0x49, 0x44, 0xe6,
+	0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca,
+	0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52, 0x0e, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x22, 0xcf, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x44, 0x54, 0x4f, 0x12, 0x43, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xb2, 0xbb, 0x18, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0xca, 0xf3, 0x18, 0x11, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x22, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54,
+	0x4f, 0x42, 0x21, 0xfa, 0xbb, 0x18, 0x04, 0x74, 0x72, 0x75, 0x65, 0xca, 0xf3, 0x18, 0x15, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0x9b, 0x03, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x63, 0x0a, 0x0d, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x4f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x54, 0x4f, 0x42, 0x22, 0xca, 0xf3, 0x18,
+	0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52,
+	0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4e,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68,
+	0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x5d,
+	0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x44, 0x54, 0x4f,
+	0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x68, 0x69, 0x67, 0x68,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x52, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x22, 0xe7, 0x06, 0x0a, 0x0d, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x22, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0xca, 0xf3, 0x18, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22,
+	0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xca, 0xf3, 0x18,
+	0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x02, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x03, 0x52, 0x0e, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01,
+	0x12, 0x51, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04,
+	0x52, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x58, 0x0a, 0x12, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x28, 0xca, 0xf3, 0x18, 0x24, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x12, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x48, 0x05, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x22, 0x48, 0x06, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x65, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x54, 0x4f, 0x42, 0x21, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x07, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x42, 0x21, 0xca,
+	0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x48, 0x08, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x31, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x12, 0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x48, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe5, 0x01,
+	0x0a, 0x15, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70,
+	0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a, 0x0a, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x44, 0x54, 0x4f, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x93, 0x04, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x44, 0x54, 0x4f, 0x12, 0x89, 0x01, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5c, 0xca,
+	0xbb, 0x18, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d,
+	0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0xbb, 0x84, 0xe7, 0xbb, 0x87, 0x49,
+	0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae,
+	0x27, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x0e, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01,
+	0x12, 0x91, 0x01, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x78, 0xca, 0xbb, 0x18, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0xda, 0xbb, 0x18, 0x5d, 0x40, 0x3a, 0x6c,
+	0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x24,
+	0x29, 0x3e, 0x3d, 0x32, 0x20, 0x26, 0x26, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d,
+	0x31, 0x30, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8,
+	0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe5, 0xbf, 0x85, 0xe9,
+	0xa1, 0xbb, 0xe5, 0x9c, 0xa8, 0x32, 0x2d, 0x31, 0x30, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97,
+	0xe7, 0xac, 0xa6, 0xe4, 0xb9, 0x8b, 0xe9, 0x97, 0xb4, 0x27, 0xca, 0xf3, 0x18, 0x0b, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0xa5, 0x01, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x78, 0xca,
+	0xbb, 0x18, 0x0f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0xda, 0xbb, 0x18, 0x3d, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d,
+	0x35, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe7,
+	0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe4, 0xb8, 0x8d, 0xe8, 0x83,
+	0xbd, 0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0x35, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7,
+	0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x64, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x74, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4b, 0xd2,
+	0xbb, 0x18, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0xda,
+	0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b,
+	0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0x49, 0x44, 0xe6, 0xa0,
+	0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3,
+	0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x9b,
+	0x05, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x74, 0x0a,
+	0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x4b, 0xd2, 0xbb, 0x18, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24,
+	0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe9, 0x83, 0xa8, 0xe9,
+	0x97, 0xa8, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3,
+	0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22,
+	0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x88, 0x01, 0x01, 0x12, 0x98, 0x01, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x7f, 0xca, 0xbb, 0x18, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0xda, 0xbb, 0x18, 0x5a,
+	0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x28,
+	0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x3d, 0x32, 0x20, 0x26, 0x26, 0x20, 0x6c, 0x65, 0x6e,
+	0x28, 0x24, 0x29, 0x3c, 0x3d, 0x31, 0x30, 0x30, 0x29, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27,
+	0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe5, 0xbf, 0x85, 0xe9,
+	0xa1, 0xbb, 0xe5, 0x9c, 0xa8, 0x32, 0x2d, 0x31, 0x30, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97,
+	0xe7, 0xac, 0xa6, 0xe4, 0xb9, 0x8b, 0xe9, 0x97, 0xb4, 0x27, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0xa5,
+	0x01, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x78, 0xca, 0xbb, 0x18, 0x0f, 0x64, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0xda, 0xbb, 0x18, 0x3d,
+	0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x35, 0x30, 0x3b, 0x20, 0x6d, 0x73,
+	0x67, 0x3a, 0x27, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xe9,
+	0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8, 0xbf,
+	0x87, 0x35, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18,
+	0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x48, 0x02, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x27, 0xca, 0xbb, 0x18, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x48, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x37,
+	0x0a, 0x07, 0x69, 0x66, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xba, 0xbb, 0x18, 0x08, 0x49, 0x66, 0x2d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0xca, 0xf3, 0x18,
+	0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x04, 0x52, 0x07, 0x69, 0x66, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x66, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x22, 0xa3, 0x01, 0x0a,
+	0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x74, 0x0a, 0x0c, 0x64,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x4b, 0xd2, 0xbb, 0x18, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d,
+	0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8,
+	0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1,
+	0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00,
+	0x52, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01,
+	0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x22, 0x95, 0x02, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x7a, 0x0a, 0x0e, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x4d, 0xd2, 0xbb, 0x18, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e,
+	0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0xbb,
+	0x84, 0xe7, 0xbb, 0x87, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6,
+	0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x2d, 0x22, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f,
+	0x42, 0x21, 0xfa, 0xbb, 0x18, 0x04, 0x74, 0x72, 0x75, 0x65, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0x9c, 0x03, 0x0a, 0x25, 0x47,
+	0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61,
 	0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
 	0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61,
 	0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x65, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x54, 0x4f, 0x42, 0x21, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73,
-	0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x0c, 0x6f,
-	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0b,
-	0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
-	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb9, 0x06, 0x0a,
-	0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x91, 0x01,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x78, 0xca, 0xbb,
-	0x18, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0xda, 0xbb, 0x18, 0x5d, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28,
-	0x24, 0x29, 0x3e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x3d,
-	0x32, 0x20, 0x26, 0x26, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x31, 0x30, 0x30,
-	0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0xbb, 0x84, 0xe7, 0xbb, 0x87, 0xe5, 0x90, 0x8d,
-	0xe7, 0xa7, 0xb0, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe5, 0xbf, 0x85, 0xe9, 0xa1, 0xbb, 0xe5,
-	0x9c, 0xa8, 0x32, 0x2d, 0x31, 0x30, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6,
-	0xe4, 0xb9, 0x8b, 0xe9, 0x97, 0xb4, 0x27, 0xca, 0xf3, 0x18, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0x8b, 0x01, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x6a, 0xca, 0xbb, 0x18, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x3b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d,
-	0x3d, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36,
-	0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0x88, 0xb6, 0xe7, 0xbb, 0x84, 0xe7, 0xbb, 0x87,
-	0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1,
-	0xae, 0x27, 0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x48, 0x01, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12,
-	0x9f, 0x01, 0x0a, 0x0c, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x76, 0xca, 0xbb, 0x18, 0x0d, 0x66, 0x61, 0x63, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0xda, 0xbb, 0x18, 0x3f, 0x40, 0x3a, 0x6c,
-	0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x31, 0x30, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a,
-	0x27, 0xe6, 0x9c, 0xba, 0xe6, 0x9e, 0x84, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xe9, 0x95, 0xbf,
-	0xe5, 0xba, 0xa6, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0x31,
-	0x30, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18, 0x1e,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02,
-	0x52, 0x0c, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0xbc, 0x01, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x84, 0x01, 0xca, 0xbb, 0x18, 0x14, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0xda, 0xbb, 0x18, 0x3f, 0x40, 0x3a,
-	0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x31, 0x30, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67,
-	0x3a, 0x27, 0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xe9, 0x95,
-	0xbf, 0xe5, 0xba, 0xa6, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87,
-	0x31, 0x30, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18,
-	0x25, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x13, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01,
-	0x12, 0x57, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x43, 0x69, 0x74, 0x79,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x33, 0xca, 0xbb, 0x18, 0x0d, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x69, 0x74, 0x79, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f,
-	0x6e, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x69, 0x74, 0x79,
-	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x0c, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x6e, 0x63, 0x65, 0x43, 0x69, 0x74, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x42,
-	0x0f, 0x0a, 0x0d, 0x5f, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
-	0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74,
-	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x7a, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4d,
-	0xd2, 0xbb, 0x18, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d,
-	0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0xbb, 0x84, 0xe7, 0xbb, 0x87, 0x49,
-	0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae,
-	0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52,
-	0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88,
-	0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x81, 0x08, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x7a, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4d,
-	0xd2, 0xbb, 0x18, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d,
-	0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0xbb, 0x84, 0xe7, 0xbb, 0x87, 0x49,
-	0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae,
-	0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52,
-	0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88,
-	0x01, 0x01, 0x12, 0x98, 0x01, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x7f, 0xca, 0xbb, 0x18, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0xda, 0xbb, 0x18, 0x5a, 0x40,
-	0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x28, 0x6c,
-	0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x3d, 0x32, 0x20, 0x26, 0x26, 0x20, 0x6c, 0x65, 0x6e, 0x28,
-	0x24, 0x29, 0x3c, 0x3d, 0x31, 0x30, 0x30, 0x29, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe5,
-	0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe5, 0xbf, 0x85, 0xe9, 0xa1,
-	0xbb, 0xe5, 0x9c, 0xa8, 0x32, 0x2d, 0x31, 0x30, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7,
-	0xac, 0xa6, 0xe4, 0xb9, 0x8b, 0xe9, 0x97, 0xb4, 0x27, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f,
-	0x6e, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x8b, 0x01,
-	0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x6a, 0xca, 0xbb, 0x18, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0xda,
-	0xbb, 0x18, 0x3b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x30, 0x20, 0x7c,
-	0x7c, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73,
-	0x67, 0x3a, 0x27, 0xe7, 0x88, 0xb6, 0xe7, 0xbb, 0x84, 0xe7, 0xbb, 0x87, 0x49, 0x44, 0xe6, 0xa0,
-	0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3,
-	0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x08,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x9f, 0x01, 0x0a, 0x0c,
-	0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x76, 0xca, 0xbb, 0x18, 0x0d, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0xda, 0xbb, 0x18, 0x3f, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24,
-	0x29, 0x3c, 0x3d, 0x31, 0x30, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe6, 0x9c, 0xba,
-	0xe6, 0x9e, 0x84, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe4,
-	0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0x31, 0x30, 0x30, 0xe4, 0xb8,
-	0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x0c, 0x66, 0x61,
-	0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0xbc, 0x01,
-	0x0a, 0x13, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x84, 0x01, 0xca, 0xbb,
-	0x18, 0x14, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0xda, 0xbb, 0x18, 0x3f, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28,
-	0x24, 0x29, 0x3c, 0x3d, 0x31, 0x30, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe8, 0xae,
-	0xa4, 0xe8, 0xaf, 0x81, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6,
-	0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0x31, 0x30, 0x30, 0xe4,
-	0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18, 0x25, 0x6a, 0x73, 0x6f,
-	0x6e, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x48, 0x04, 0x52, 0x13, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x78, 0x0a, 0x0c,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x43, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x33,
-	0xca, 0xbb, 0x18, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x69, 0x74,
-	0x79, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x6e, 0x63, 0x65, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x43,
-	0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x42,
-	0x0f, 0x0a, 0x0d, 0x5f, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
-	0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61, 0x63, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x6e, 0x63, 0x65, 0x43, 0x69, 0x74, 0x79, 0x22, 0xad, 0x01, 0x0a, 0x1c, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x7a, 0x0a, 0x0e, 0x6f, 0x72,
-	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x4d, 0xd2, 0xbb, 0x18, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28,
-	0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0xbb, 0x84,
-	0xe7, 0xbb, 0x87, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad,
-	0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d,
-	0x22, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xcf, 0x01, 0x0a, 0x1b, 0x4c, 0x69,
-	0x73, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x43, 0x0a, 0x08, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xb2, 0xbb, 0x18,
-	0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0xca, 0xf3, 0x18, 0x11, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x3a, 0x22, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x48,
-	0x00, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x55,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68,
-	0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x42, 0x21, 0xfa, 0xbb, 0x18, 0x04, 0x74, 0x72, 0x75,
-	0x65, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x49, 0x44, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0xbc, 0x02, 0x0a, 0x1c,
-	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08,
-	0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22,
-	0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12,
-	0x63, 0x0a, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x54,
-	0x4f, 0x42, 0x22, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67,
-	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4e, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x5b, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x44, 0x54, 0x4f, 0x42, 0x20, 0xca, 0xf3, 0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x4e, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50,
 	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x19,
 	0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f,
 	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0xa8, 0x06, 0x0a, 0x0d, 0x44,
-	0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x22, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xca, 0xf3, 0x18, 0x09, 0x6a, 0x73,
-	0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01,
-	0x12, 0x32, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19,
-	0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x2c, 0x6f,
-	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0f, 0xca, 0xf3, 0x18, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x48, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x47,
-	0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x22, 0x48, 0x03, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x24, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x58, 0x0a, 0x12, 0x61, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x28, 0xca, 0xf3, 0x18, 0x24, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x71, 0x75, 0x69,
-	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x52, 0x12, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x71, 0x75, 0x69, 0x70,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f,
-	0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x48, 0x05,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x38,
-	0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x48, 0x06, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x65, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61,
-	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x54, 0x4f, 0x42, 0x21, 0xca, 0xf3, 0x18, 0x1d, 0x6a,
-	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x07, 0x52, 0x0c,
-	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
-	0x48, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x21, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69,
-	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x08, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64,
-	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe5, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12,
-	0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61,
-	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca,
-	0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65,
-	0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88,
-	0x01, 0x01, 0x12, 0x5d, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x54, 0x4f, 0x42,
-	0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x48, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01,
-	0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x0d,
-	0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x93, 0x04,
-	0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x89, 0x01, 0x0a,
-	0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5c, 0xca, 0xbb, 0x18, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a,
-	0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a,
-	0x27, 0xe7, 0xbb, 0x84, 0xe7, 0xbb, 0x87, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4,
-	0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f,
-	0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x91, 0x01, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x78, 0xca, 0xbb, 0x18, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0xda, 0xbb, 0x18, 0x5d, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x20,
-	0x26, 0x26, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x3d, 0x32, 0x20, 0x26, 0x26, 0x20,
-	0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x31, 0x30, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67,
-	0x3a, 0x27, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xe9, 0x95,
-	0xbf, 0xe5, 0xba, 0xa6, 0xe5, 0xbf, 0x85, 0xe9, 0xa1, 0xbb, 0xe5, 0x9c, 0xa8, 0x32, 0x2d, 0x31,
-	0x30, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0xe4, 0xb9, 0x8b, 0xe9, 0x97,
-	0xb4, 0x27, 0xca, 0xf3, 0x18, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0xa5, 0x01, 0x0a,
-	0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x78, 0xca, 0xbb, 0x18, 0x0f, 0x64, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0xda, 0xbb, 0x18, 0x3d, 0x40, 0x3a,
-	0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x35, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a,
-	0x27, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xe9, 0x95, 0xbf,
-	0xe5, 0xba, 0xa6, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0x35,
-	0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18, 0x20, 0x6a,
-	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48,
-	0x02, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12,
-	0x74, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4b, 0xd2, 0xbb, 0x18, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e,
-	0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe9, 0x83,
-	0xa8, 0xe9, 0x97, 0xa8, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6,
-	0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x2d, 0x22, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x82, 0x04, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x74, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4b, 0xd2, 0xbb, 0x18,
-	0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0xda, 0xbb, 0x18,
-	0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d,
-	0x73, 0x67, 0x3a, 0x27, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5,
-	0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x61,
-	0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x98, 0x01, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x7f, 0xca, 0xbb, 0x18, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0xda, 0xbb, 0x18, 0x5a, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29,
-	0x3d, 0x3d, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x28, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x3d,
-	0x32, 0x20, 0x26, 0x26, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3c, 0x3d, 0x31, 0x30, 0x30,
-	0x29, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xe9, 0x95,
-	0xbf, 0xe5, 0xba, 0xa6, 0xe5, 0xbf, 0x85, 0xe9, 0xa1, 0xbb, 0xe5, 0x9c, 0xa8, 0x32, 0x2d, 0x31,
-	0x30, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0xe4, 0xb9, 0x8b, 0xe9, 0x97,
-	0xb4, 0x27, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65,
-	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0xa5, 0x01, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x78, 0xca, 0xbb, 0x18, 0x0f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0xda, 0xbb, 0x18, 0x3d, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29,
-	0x3c, 0x3d, 0x35, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe9, 0x83, 0xa8, 0xe9, 0x97,
-	0xa8, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xe9, 0x95, 0xbf, 0xe5, 0xba, 0xa6, 0xe4, 0xb8, 0x8d,
-	0xe8, 0x83, 0xbd, 0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0x35, 0x30, 0xe4, 0xb8, 0xaa, 0xe5, 0xad,
-	0x97, 0xe7, 0xac, 0xa6, 0x27, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64,
-	0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2c, 0x6f,
-	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x0e, 0x64, 0x65, 0x70,
-	0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0f,
-	0x0a, 0x0d, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x42,
-	0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x64, 0x65, 0x70,
-	0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xa3, 0x01, 0x0a, 0x1a,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x74, 0x0a, 0x0c, 0x64, 0x65,
-	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x4b, 0xd2, 0xbb, 0x18, 0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d,
-	0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe9, 0x83, 0xa8, 0xe9, 0x97, 0xa8, 0x49,
-	0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae,
-	0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52,
-	0x0c, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01,
-	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49,
-	0x44, 0x22, 0x95, 0x02, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x7a, 0x0a, 0x0e, 0x6f, 0x72,
-	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x4d, 0xd2, 0xbb, 0x18, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28,
-	0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe7, 0xbb, 0x84,
-	0xe7, 0xbb, 0x87, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad,
-	0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d,
-	0x22, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x42,
-	0x21, 0xfa, 0xbb, 0x18, 0x04, 0x74, 0x72, 0x75, 0x65, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f,
-	0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a,
-	0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0xbd, 0x02, 0x0a, 0x25, 0x47, 0x65,
-	0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70,
-	0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54,
-	0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73,
-	0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x5b, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x44, 0x54, 0x4f, 0x42, 0x20, 0xca, 0xf3, 0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x12, 0x4e, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x19, 0xca,
-	0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f, 0x6d,
-	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0x86, 0x07, 0x0a, 0x13, 0x4f, 0x72,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x44, 0x54, 0x4f, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0xc5, 0x07, 0x0a, 0x13, 0x4f, 0x72,
 	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x6f, 0x44, 0x54,
 	0x4f, 0x12, 0x22, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xca,
 	0xf3, 0x18, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52, 0x02,
@@ -6490,603 +12130,607 @@ var file_http_identity_identity_model_proto_rawDesc = []byte{
 	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a,
 	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x16, 0xca, 0xf3, 0x18, 0x12, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x22, 0x48, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x65, 0x64, 0x42, 0x79, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca, 0xf3,
-	0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x22, 0x48, 0x0a, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x48, 0x0b, 0x52,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a,
-	0x03, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
-	0x16, 0x0a, 0x14, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x6c, 0x65,
-	0x49, 0x44, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x42,
-	0x0b, 0x0a, 0x09, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x0b, 0x0a, 0x09,
-	0x5f, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x65, 0x78,
-	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x75, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x65, 0x64, 0x42, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x22, 0xd9, 0x01, 0x0a, 0x1b, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
-	0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f,
-	0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x6f, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x4f,
-	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x6f, 0x44,
-	0x54, 0x4f, 0x42, 0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x6f,
-	0x67, 0x6f, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52,
-	0x04, 0x6c, 0x6f, 0x67, 0x6f, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6c, 0x6f, 0x67, 0x6f, 0x22, 0xd6,
-	0x02, 0x0a, 0x1d, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
-	0x72, 0x79, 0x4c, 0x6f, 0x67, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f,
-	0x12, 0x6d, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x4c, 0xca, 0xbb, 0x18, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0xda, 0xbb, 0x18, 0x27, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x3b,
-	0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe5, 0x90, 0x8d, 0xe4,
-	0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x10,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x48, 0x00, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
-	0x4e, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x42, 0x27, 0xca, 0xbb, 0x18, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0xca, 0xf3, 0x18, 0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x48, 0x01, 0x52,
-	0x0b, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x4c, 0x0a, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x2b, 0xca, 0xbb, 0x18, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02,
-	0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a,
-	0x09, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x66,
-	0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d,
-	0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4f,
-	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x61, 0x0a, 0x06, 0x6c, 0x6f, 0x67,
-	0x6f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x44, 0xd2, 0xbb, 0x18, 0x06, 0x6c,
-	0x6f, 0x67, 0x6f, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2a, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24,
-	0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0x4c, 0x6f, 0x67, 0x6f,
-	0x20, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7,
-	0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48,
-	0x00, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x6f, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07,
-	0x5f, 0x6c, 0x6f, 0x67, 0x6f, 0x49, 0x44, 0x22, 0x90, 0x01, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f,
-	0x67, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x61, 0x0a, 0x06,
-	0x6c, 0x6f, 0x67, 0x6f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x44, 0xd2, 0xbb,
-	0x18, 0x06, 0x6c, 0x6f, 0x67, 0x6f, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2a, 0x40, 0x3a, 0x6c, 0x65,
-	0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0x4c,
-	0x6f, 0x67, 0x6f, 0x20, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6,
-	0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x2d, 0x22, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x6f, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42,
-	0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x67, 0x6f, 0x49, 0x44, 0x22, 0xa6, 0x02, 0x0a, 0x20, 0x42,
-	0x69, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x6f, 0x54, 0x6f, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12,
-	0x7a, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4d, 0xd2, 0xbb, 0x18, 0x0e, 0x6f, 0x72, 0x67,
-	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40,
-	0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67,
-	0x3a, 0x27, 0xe7, 0xbb, 0x84, 0xe7, 0xbb, 0x87, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f,
-	0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73,
-	0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x68, 0x0a, 0x06, 0x6c,
-	0x6f, 0x67, 0x6f, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4b, 0xca, 0xbb, 0x18,
-	0x07, 0x6c, 0x6f, 0x67, 0x6f, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x2a, 0x40, 0x3a, 0x6c, 0x65,
-	0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0x4c,
-	0x6f, 0x67, 0x6f, 0x20, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6,
-	0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x6c, 0x6f, 0x67, 0x6f, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x6f,
-	0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x67,
-	0x6f, 0x49, 0x44, 0x22, 0x9c, 0x09, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
-	0x44, 0x54, 0x4f, 0x12, 0x22, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0d, 0xca, 0xf3, 0x18, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x48, 0x00,
-	0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
-	0x22, 0x48, 0x01, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x88, 0x01,
-	0x01, 0x12, 0x32, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x13, 0xca, 0xf3, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x72,
-	0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x02, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65,
-	0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x22, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01,
-	0x12, 0x3e, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x1d, 0xca, 0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x48, 0x04, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x51, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73,
-	0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x05,
-	0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x05, 0x42, 0x11, 0xca, 0xf3, 0x18, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x48, 0x06, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xca, 0xf3, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x48, 0x07, 0x52, 0x08, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0a, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xca,
-	0xf3, 0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48,
-	0x08, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x88, 0x01, 0x01,
-	0x12, 0x3b, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x16, 0xca, 0xf3, 0x18, 0x12, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x48, 0x09, 0x52, 0x0a,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x12,
-	0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x22, 0x48, 0x0a, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01,
-	0x12, 0x35, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x50, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x22, 0x48, 0x0b, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x49, 0x50, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41,
-	0x67, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0c, 0x52, 0x09, 0x75,
-	0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0b, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x21, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x48, 0x0d, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f,
-	0x64, 0x79, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x42, 0x16, 0xca, 0xf3, 0x18, 0x12, 0x6a,
-	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73,
-	0x22, 0x48, 0x0e, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x88,
-	0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x10, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x48, 0x0f, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03,
-	0x5f, 0x69, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x44, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x44, 0x42, 0x09, 0x0a,
-	0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x42,
-	0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a,
-	0x0a, 0x08, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x50, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x73, 0x65, 0x72,
-	0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x42, 0x6f, 0x64, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x22, 0xfc, 0x04, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74,
-	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x55,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68,
-	0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x42, 0x21, 0xfa, 0xbb, 0x18, 0x04, 0x74, 0x72, 0x75,
-	0x65, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0xb2, 0xbb, 0x18, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x06, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x25, 0xb2, 0xbb, 0x18,
-	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x22, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x48, 0x02, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
-	0x4a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x29, 0xb2, 0xbb, 0x18, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xca,
-	0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x08,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x27, 0xb2, 0xbb,
-	0x18, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f,
-	0x6e, 0x3a, 0x22, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x88, 0x01, 0x01, 0x12, 0x50, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x2d, 0xb2, 0xbb, 0x18, 0x0a, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x29, 0xb2, 0xbb, 0x18, 0x08, 0x65, 0x6e, 0x64, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0xca, 0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x6e,
-	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x48, 0x06, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42,
-	0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b,
-	0x0a, 0x09, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
-	0x65, 0x22, 0x8b, 0x02, 0x0a, 0x10, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x53, 0x74,
-	0x61, 0x74, 0x73, 0x44, 0x54, 0x4f, 0x12, 0x3b, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x16, 0xca, 0xf3, 0x18, 0x12,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x18, 0xca, 0xf3, 0x18, 0x14, 0x6a,
-	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x48, 0x01, 0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0d, 0x61, 0x76, 0x67, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1a, 0xca,
-	0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x76, 0x67, 0x5f, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x22, 0x48, 0x02, 0x52, 0x0d, 0x61, 0x76, 0x67,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a,
-	0x0b, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0f, 0x0a, 0x0d,
-	0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x10, 0x0a,
-	0x0e, 0x5f, 0x61, 0x76, 0x67, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22,
-	0x86, 0x03, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08,
-	0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22,
-	0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12,
-	0x54, 0x0a, 0x09, 0x61, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x41, 0x75,
-	0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x44, 0x54, 0x4f, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a,
-	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x09, 0x61, 0x75, 0x64, 0x69,
-	0x74, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x4e, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x61, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x22, 0x48, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x65, 0x64, 0x42, 0x79, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca, 0xf3,
+	0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x22, 0x48, 0x0a, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x48, 0x0b, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x42, 0x12,
+	0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x48, 0x0c, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x49, 0x44, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x75,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0xd9, 0x01, 0x0a, 0x1b, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54,
+	0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42,
-	0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e,
-	0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x44, 0x54, 0x4f,
-	0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x74,
-	0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x08,
-	0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x22, 0x0e, 0x0a, 0x0c, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc2, 0x09, 0x0a,
-	0x15, 0x4f, 0x49, 0x44, 0x43, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xca, 0xf3, 0x18, 0x0d, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x22, 0x48, 0x00, 0x52, 0x06, 0x69, 0x73, 0x73,
-	0x75, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a, 0x16, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x48, 0x01, 0x52, 0x15, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xca,
-	0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x48, 0x02, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x11,
-	0x75, 0x73, 0x65, 0x72, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f,
-	0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x65, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x22, 0x48, 0x03, 0x52, 0x10, 0x75, 0x73, 0x65, 0x72, 0x69, 0x6e, 0x66,
-	0x6f, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x54, 0x0a, 0x13,
-	0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xca, 0xf3, 0x18, 0x1a, 0x6a,
-	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x48, 0x04, 0x52, 0x12, 0x72, 0x65, 0x76,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x5d, 0x0a, 0x16, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x21, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x6e,
-	0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x22, 0x48, 0x05, 0x52, 0x15, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x88, 0x01,
-	0x01, 0x12, 0x33, 0x0a, 0x08, 0x6a, 0x77, 0x6b, 0x73, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x13, 0xca, 0xf3, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6a,
-	0x77, 0x6b, 0x73, 0x5f, 0x75, 0x72, 0x69, 0x22, 0x48, 0x06, 0x52, 0x07, 0x6a, 0x77, 0x6b, 0x73,
-	0x55, 0x72, 0x69, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a, 0x18, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x64, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x23, 0xca, 0xf3, 0x18, 0x1f, 0x6a, 0x73,
-	0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0x52, 0x16, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x53, 0x75, 0x70, 0x70,
-	0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x5a, 0x0a, 0x17, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
-	0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x42, 0x22, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x5f,
-	0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0x52, 0x15, 0x73, 0x75, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
-	0x64, 0x12, 0x81, 0x01, 0x0a, 0x25, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x73,
-	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x03, 0x28,
-	0x09, 0x42, 0x30, 0xca, 0xf3, 0x18, 0x2c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c,
-	0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x64, 0x22, 0x52, 0x20, 0x69, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e,
-	0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x53, 0x75, 0x70, 0x70,
-	0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x10, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x5f,
-	0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x42,
-	0x1b, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x63, 0x6f, 0x70, 0x65,
-	0x73, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0x52, 0x0f, 0x73, 0x63,
-	0x6f, 0x70, 0x65, 0x73, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x82, 0x01,
-	0x0a, 0x25, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x5f, 0x73, 0x75,
-	0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x42, 0x30, 0xca,
-	0xf3, 0x18, 0x2c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x73, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0x52,
-	0x21, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x75,
-	0x74, 0x68, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x42, 0x19, 0x0a,
-	0x17, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x14, 0x0a, 0x12, 0x5f,
-	0x75, 0x73, 0x65, 0x72, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x69, 0x6e,
-	0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6a, 0x77, 0x6b, 0x73, 0x5f, 0x75, 0x72,
-	0x69, 0x22, 0x45, 0x0a, 0x10, 0x4f, 0x49, 0x44, 0x43, 0x4a, 0x57, 0x4b, 0x53, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0f, 0xca, 0xf3, 0x18, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6b,
-	0x65, 0x79, 0x73, 0x22, 0x48, 0x00, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x88, 0x01, 0x01, 0x42,
-	0x07, 0x0a, 0x05, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x22, 0xc4, 0x07, 0x0a, 0x14, 0x4f, 0x49, 0x44,
-	0x43, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x82, 0x01, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x58, 0xb2, 0xbb, 0x18, 0x0d, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0xda, 0xbb, 0x18, 0x2b,
-	0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a,
-	0x27, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0xe4, 0xb8,
-	0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x14, 0x6a,
-	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x22, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x6e, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4c, 0xb2, 0xbb, 0x18, 0x09, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x27, 0x40, 0x3a, 0x6c, 0x65,
-	0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7,
-	0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x01, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x7d, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x55, 0xb2, 0xbb,
-	0x18, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0xda, 0xbb,
-	0x18, 0x2a, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d, 0x73,
-	0x67, 0x3a, 0x27, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0xe4,
-	0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x13,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75,
-	0x72, 0x69, 0x22, 0x48, 0x02, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55,
-	0x72, 0x69, 0x88, 0x01, 0x01, 0x12, 0x5b, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x40, 0xb2, 0xbb, 0x18, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0xda,
-	0xbb, 0x18, 0x23, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d,
-	0x73, 0x67, 0x3a, 0x27, 0x73, 0x63, 0x6f, 0x70, 0x65, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4,
-	0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x48, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x3e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x23, 0xb2, 0xbb, 0x18, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0xca, 0xf3, 0x18, 0x16,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x3e, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x23, 0xb2, 0xbb, 0x18, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0xca, 0xf3, 0x18, 0x16,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x61, 0x0a, 0x0e, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c,
-	0x65, 0x6e, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0xb2, 0xbb, 0x18, 0x0e,
-	0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0xca, 0xf3,
-	0x18, 0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x61,
-	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x48, 0x06, 0x52, 0x0d, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
-	0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x7c, 0x0a, 0x15, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68,
-	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0xb2, 0xbb, 0x18, 0x15, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63,
-	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0xca,
-	0xf3, 0x18, 0x26, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68,
-	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x2c, 0x6f,
-	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x07, 0x52, 0x13, 0x63, 0x6f, 0x64,
-	0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x5f, 0x75, 0x72, 0x69, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x42, 0x08,
-	0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6e, 0x6f, 0x6e,
-	0x63, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6c,
-	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63,
-	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22,
-	0xc9, 0x01, 0x0a, 0x15, 0x4f, 0x49, 0x44, 0x43, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74,
-	0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f,
-	0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52,
-	0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x0c,
-	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x17, 0xca, 0xf3, 0x18, 0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0x48, 0x01, 0x52, 0x0b, 0x72,
-	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a,
-	0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72,
-	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0xf7, 0x05, 0x0a, 0x10,
-	0x4f, 0x49, 0x44, 0x43, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x73, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x4f, 0xda, 0xbb, 0x18, 0x28, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28,
-	0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0x67, 0x72, 0x61, 0x6e, 0x74,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9,
-	0xba, 0x27, 0xe2, 0xbb, 0x18, 0x0a, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x22, 0x48, 0x00, 0x52, 0x09, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x21, 0xe2, 0xbb, 0x18, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xca, 0xf3, 0x18,
-	0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0x59, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72,
-	0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xe2, 0xbb, 0x18, 0x0c, 0x72, 0x65, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f,
-	0x6e, 0x3a, 0x22, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x0b, 0x72, 0x65,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x09,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x2b, 0xe2, 0xbb, 0x18, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0xca, 0xf3,
-	0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x08,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a, 0x0d, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x33, 0xe2, 0xbb, 0x18, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2c, 0x6f, 0x6d, 0x69,
-	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a, 0x0d, 0x63, 0x6f,
-	0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x33, 0xe2, 0xbb, 0x18, 0x0d, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f,
-	0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a, 0x0d, 0x72, 0x65, 0x66,
-	0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x33, 0xe2, 0xbb, 0x18, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x66,
-	0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x06, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x67, 0x72, 0x61,
-	0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72,
-	0x69, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x42,
-	0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xc6, 0x04, 0x0a, 0x11, 0x4f, 0x49, 0x44, 0x43, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x08, 0x62,
+	0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42,
+	0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x6f, 0x44, 0x54,
+	0x4f, 0x42, 0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x6f, 0x67,
+	0x6f, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04,
+	0x6c, 0x6f, 0x67, 0x6f, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6c, 0x6f, 0x67, 0x6f, 0x22, 0xd6, 0x02,
+	0x0a, 0x1d, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72,
+	0x79, 0x4c, 0x6f, 0x67, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12,
+	0x6d, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x4c, 0xca, 0xbb, 0x18, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0xda, 0xbb, 0x18, 0x27, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20,
+	0x6d, 0x73, 0x67, 0x3a, 0x27, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe5, 0x90, 0x8d, 0xe4, 0xb8,
+	0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x10, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48,
+	0x00, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4e,
+	0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x27, 0xca, 0xbb, 0x18, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0xca, 0xf3, 0x18, 0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x48, 0x01, 0x52, 0x0b,
+	0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4c,
+	0x0a, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x2b, 0xca, 0xbb, 0x18, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0xca,
+	0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52,
+	0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x66, 0x69,
+	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x69,
+	0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x61, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x6f,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x44, 0xd2, 0xbb, 0x18, 0x06, 0x6c, 0x6f,
+	0x67, 0x6f, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2a, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29,
+	0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0x4c, 0x6f, 0x67, 0x6f, 0x20,
+	0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1,
+	0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00,
+	0x52, 0x06, 0x6c, 0x6f, 0x67, 0x6f, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x6c, 0x6f, 0x67, 0x6f, 0x49, 0x44, 0x22, 0x90, 0x01, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x61, 0x0a, 0x06, 0x6c,
+	0x6f, 0x67, 0x6f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x44, 0xd2, 0xbb, 0x18,
+	0x06, 0x6c, 0x6f, 0x67, 0x6f, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2a, 0x40, 0x3a, 0x6c, 0x65, 0x6e,
+	0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0x4c, 0x6f,
+	0x67, 0x6f, 0x20, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad,
+	0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x2d,
+	0x22, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x6f, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x67, 0x6f, 0x49, 0x44, 0x22, 0xa6, 0x02, 0x0a, 0x20, 0x42, 0x69,
+	0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x6f, 0x54, 0x6f, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x7a,
+	0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4d, 0xd2, 0xbb, 0x18, 0x0e, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0xda, 0xbb, 0x18, 0x2b, 0x40, 0x3a,
+	0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a,
+	0x27, 0xe7, 0xbb, 0x84, 0xe7, 0xbb, 0x87, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4,
+	0xb8, 0x8d, 0xe6, 0xad, 0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x08, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x2d, 0x22, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x68, 0x0a, 0x06, 0x6c, 0x6f,
+	0x67, 0x6f, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4b, 0xca, 0xbb, 0x18, 0x07,
+	0x6c, 0x6f, 0x67, 0x6f, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x2a, 0x40, 0x3a, 0x6c, 0x65, 0x6e,
+	0x28, 0x24, 0x29, 0x3d, 0x3d, 0x33, 0x36, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0x4c, 0x6f,
+	0x67, 0x6f, 0x20, 0x49, 0x44, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe4, 0xb8, 0x8d, 0xe6, 0xad,
+	0xa3, 0xe7, 0xa1, 0xae, 0x27, 0xca, 0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c,
+	0x6f, 0x67, 0x6f, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x6f, 0x49,
+	0x44, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x6f, 0x67, 0x6f,
+	0x49, 0x44, 0x22, 0x9c, 0x09, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x44,
+	0x54, 0x4f, 0x12, 0x22, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
+	0xca, 0xf3, 0x18, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x48, 0x00, 0x52,
+	0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x22,
+	0x48, 0x01, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01,
+	0x12, 0x32, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x13, 0xca, 0xf3, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x02, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49,
+	0x44, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x48, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12,
+	0x3e, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1d, 0xca, 0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x48, 0x04, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x51, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52,
+	0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88,
+	0x01, 0x01, 0x12, 0x2e, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x11, 0xca, 0xf3, 0x18, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x48, 0x06, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xca, 0xf3, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x48, 0x07, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xca, 0xf3,
+	0x18, 0x1c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x08,
+	0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12,
+	0x3b, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x16, 0xca, 0xf3, 0x18, 0x12, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x48, 0x09, 0x52, 0x0a, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x12, 0xca,
+	0xf3, 0x18, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x22, 0x48, 0x0a, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x35, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x50, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x22, 0x48, 0x0b, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x50, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2c,
+	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x0c, 0x52, 0x09, 0x75, 0x73,
+	0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0b, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x21, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x48, 0x0d, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x42, 0x16, 0xca, 0xf3, 0x18, 0x12, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x22,
+	0x48, 0x0e, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x48, 0x0f, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f,
+	0x69, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x50, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x42, 0x6f, 0x64, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xfc, 0x04, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x12, 0x55, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x74,
+	0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x42, 0x21, 0xfa, 0xbb, 0x18, 0x04, 0x74, 0x72, 0x75, 0x65,
+	0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0xb2, 0xbb, 0x18, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x25, 0xb2, 0xbb, 0x18, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x48, 0x02, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x4a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x29, 0xb2, 0xbb, 0x18, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0xca, 0xf3,
+	0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x27, 0xb2, 0xbb, 0x18,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x50, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x2d, 0xb2, 0xbb, 0x18, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x29, 0xb2, 0xbb, 0x18, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0xca, 0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x48, 0x06, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x8b, 0x02, 0x0a, 0x10, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x44, 0x54, 0x4f, 0x12, 0x3b, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x16, 0xca, 0xf3, 0x18, 0x12, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x41, 0x0a, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x18, 0xca, 0xf3, 0x18, 0x14, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x48, 0x01, 0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0d, 0x61, 0x76, 0x67, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1a, 0xca, 0xf3,
+	0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x76, 0x67, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x22, 0x48, 0x02, 0x52, 0x0d, 0x61, 0x76, 0x67, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x61, 0x76, 0x67, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x86,
+	0x03, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x12, 0x51, 0x0a, 0x08, 0x62,
 	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a,
 	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48,
-	0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x3f,
-	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xca, 0xf3, 0x18, 0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x48, 0x01, 0x52,
-	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12,
-	0x39, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x48, 0x02, 0x52, 0x09, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78,
-	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15,
-	0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x73, 0x5f, 0x69, 0x6e, 0x22, 0x48, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
-	0x49, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xca, 0xf3,
-	0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x48, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xca, 0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x22, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x07, 0x69, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88,
-	0x01, 0x01, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x63, 0x6f,
-	0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x06, 0x52,
-	0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61,
-	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x73, 0x5f, 0x69, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
-	0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x64, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0xc4,
-	0x04, 0x0a, 0x14, 0x4f, 0x49, 0x44, 0x43, 0x55, 0x73, 0x65, 0x72, 0x69, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70,
-	0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x03, 0x73, 0x75,
-	0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xca, 0xf3, 0x18, 0x0a, 0x6a, 0x73, 0x6f,
-	0x6e, 0x3a, 0x22, 0x73, 0x75, 0x62, 0x22, 0x48, 0x01, 0x52, 0x03, 0x73, 0x75, 0x62, 0x88, 0x01,
-	0x01, 0x12, 0x32, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x19, 0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x5b, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72,
-	0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x27, 0xca, 0xf3, 0x18, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x72, 0x65,
-	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x11, 0x70, 0x72,
-	0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x35, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x0e, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x08, 0x42, 0x23, 0xca, 0xf3, 0x18, 0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x0d, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x07, 0x70, 0x69,
-	0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xca, 0xf3, 0x18,
-	0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2c, 0x6f,
-	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x06, 0x52, 0x07, 0x70, 0x69, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73, 0x75, 0x62, 0x42, 0x07, 0x0a, 0x05,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72,
-	0x72, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06,
-	0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x69,
-	0x63, 0x74, 0x75, 0x72, 0x65, 0x22, 0xf4, 0x01, 0x0a, 0x11, 0x4f, 0x49, 0x44, 0x43, 0x52, 0x65,
-	0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5b, 0x0a, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x40, 0xda, 0xbb, 0x18, 0x23,
-	0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a,
-	0x27, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7,
-	0xa9, 0xba, 0x27, 0xe2, 0xbb, 0x18, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xca, 0xf3, 0x18, 0x0c,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x48, 0x00, 0x52, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x64, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x37, 0xe2, 0xbb, 0x18, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x2c,
-	0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x0d, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08,
-	0x0a, 0x06, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x22, 0xf8, 0x01, 0x0a,
-	0x15, 0x4f, 0x49, 0x44, 0x43, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5b, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x40, 0xda, 0xbb, 0x18, 0x23, 0x40, 0x3a, 0x6c, 0x65, 0x6e,
-	0x28, 0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x27, 0xe2, 0xbb,
-	0x18, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xca, 0xf3, 0x18, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x88, 0x01, 0x01, 0x12, 0x64, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xe2, 0xbb,
-	0x18, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x68, 0x69, 0x6e,
-	0x74, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x22, 0xbc, 0x04, 0x0a, 0x16, 0x4f, 0x49, 0x44, 0x43,
-	0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f,
-	0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0xca, 0xf3, 0x18, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x22, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x48, 0x01, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73,
-	0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d,
-	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xca, 0xf3, 0x18, 0x19, 0x6a,
-	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d,
-	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x48, 0x04, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2f,
-	0x0a, 0x03, 0x73, 0x75, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xca, 0xf3, 0x18,
-	0x14, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x75, 0x62, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x03, 0x73, 0x75, 0x62, 0x88, 0x01, 0x01, 0x12,
-	0x2f, 0x0a, 0x03, 0x65, 0x78, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x18, 0xca, 0xf3,
-	0x18, 0x14, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x06, 0x52, 0x03, 0x65, 0x78, 0x70, 0x88, 0x01, 0x01,
-	0x12, 0x2f, 0x0a, 0x03, 0x69, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x18, 0xca,
-	0xf3, 0x18, 0x14, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x61, 0x74, 0x2c, 0x6f, 0x6d, 0x69,
-	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x07, 0x52, 0x03, 0x69, 0x61, 0x74, 0x88, 0x01,
-	0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x09,
-	0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x42, 0x06,
-	0x0a, 0x04, 0x5f, 0x73, 0x75, 0x62, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x65, 0x78, 0x70, 0x42, 0x06,
-	0x0a, 0x04, 0x5f, 0x69, 0x61, 0x74, 0x42, 0x4c, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x78, 0x75, 0x2f, 0x63, 0x6c,
-	0x6f, 0x75, 0x64, 0x77, 0x65, 0x67, 0x6f, 0x2d, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2d, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x54,
+	0x0a, 0x09, 0x61, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x44, 0x54, 0x4f, 0x42, 0x1f, 0xca, 0xf3, 0x18, 0x1b, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x09, 0x61, 0x75, 0x64, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x73, 0x12, 0x4e, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x19,
+	0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x44, 0x54, 0x4f, 0x42,
+	0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x54, 0x4f, 0x22, 0x0e, 0x0a, 0x0c, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc2, 0x09, 0x0a, 0x15,
+	0x4f, 0x49, 0x44, 0x43, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xca, 0xf3, 0x18, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x22, 0x48, 0x00, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a, 0x16, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x48, 0x01, 0x52, 0x15, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xca, 0xf3,
+	0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x48, 0x02, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x11, 0x75,
+	0x73, 0x65, 0x72, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xca, 0xf3, 0x18, 0x18, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x22, 0x48, 0x03, 0x52, 0x10, 0x75, 0x73, 0x65, 0x72, 0x69, 0x6e, 0x66, 0x6f,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x54, 0x0a, 0x13, 0x72,
+	0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x48, 0x04, 0x52, 0x12, 0x72, 0x65, 0x76, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x5d, 0x0a, 0x16, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x21, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x6e, 0x74,
+	0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x22, 0x48, 0x05, 0x52, 0x15, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x33, 0x0a, 0x08, 0x6a, 0x77, 0x6b, 0x73, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x13, 0xca, 0xf3, 0x18, 0x0f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6a, 0x77,
+	0x6b, 0x73, 0x5f, 0x75, 0x72, 0x69, 0x22, 0x48, 0x06, 0x52, 0x07, 0x6a, 0x77, 0x6b, 0x73, 0x55,
+	0x72, 0x69, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a, 0x18, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x23, 0xca, 0xf3, 0x18, 0x1f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0x52, 0x16, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x53, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x12, 0x5a, 0x0a, 0x17, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x09, 0x42, 0x22, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x5f, 0x73,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0x52, 0x15, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x12, 0x81, 0x01, 0x0a, 0x25, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x30, 0xca, 0xf3, 0x18, 0x2c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x67,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x22, 0x52, 0x20, 0x69, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x69,
+	0x6e, 0x67, 0x41, 0x6c, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x53, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x10, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x5f, 0x73,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1b,
+	0xca, 0xf3, 0x18, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73,
+	0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0x52, 0x0f, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x73, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x82, 0x01, 0x0a,
+	0x25, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f,
+	0x61, 0x75, 0x74, 0x68, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x5f, 0x73, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x42, 0x30, 0xca, 0xf3,
+	0x18, 0x2c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x73, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0x52, 0x21,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x75, 0x74,
+	0x68, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x42, 0x19, 0x0a, 0x17,
+	0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x42, 0x16, 0x0a, 0x14, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x69, 0x6e, 0x74,
+	0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6a, 0x77, 0x6b, 0x73, 0x5f, 0x75, 0x72, 0x69,
+	0x22, 0x45, 0x0a, 0x10, 0x4f, 0x49, 0x44, 0x43, 0x4a, 0x57, 0x4b, 0x53, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0f, 0xca, 0xf3, 0x18, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6b, 0x65,
+	0x79, 0x73, 0x22, 0x48, 0x00, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x88, 0x01, 0x01, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x22, 0xc4, 0x07, 0x0a, 0x14, 0x4f, 0x49, 0x44, 0x43,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x82, 0x01, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x58, 0xb2, 0xbb, 0x18, 0x0d, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0xda, 0xbb, 0x18, 0x2b, 0x40,
+	0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0xe4, 0xb8, 0x8d,
+	0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x14, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x6e, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4c, 0xb2, 0xbb, 0x18, 0x09, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0xda, 0xbb, 0x18, 0x27, 0x40, 0x3a, 0x6c, 0x65, 0x6e,
+	0x28, 0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9,
+	0xba, 0x27, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x48, 0x01, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x7d, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x55, 0xb2, 0xbb, 0x18,
+	0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0xda, 0xbb, 0x18,
+	0x2a, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67,
+	0x3a, 0x27, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0xe4, 0xb8,
+	0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x13, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72,
+	0x69, 0x22, 0x48, 0x02, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72,
+	0x69, 0x88, 0x01, 0x01, 0x12, 0x5b, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x40, 0xb2, 0xbb, 0x18, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0xda, 0xbb,
+	0x18, 0x23, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d, 0x73,
+	0x67, 0x3a, 0x27, 0x73, 0x63, 0x6f, 0x70, 0x65, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8,
+	0xba, 0xe7, 0xa9, 0xba, 0x27, 0xca, 0xf3, 0x18, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x22, 0x48, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x3e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x23, 0xb2, 0xbb, 0x18, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0xca, 0xf3, 0x18, 0x16, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x3e, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x23, 0xb2, 0xbb, 0x18, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0xca, 0xf3, 0x18, 0x16, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x61, 0x0a, 0x0e, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0xb2, 0xbb, 0x18, 0x0e, 0x63,
+	0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0xca, 0xf3, 0x18,
+	0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x48, 0x06, 0x52, 0x0d, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x7c, 0x0a, 0x15, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x43, 0xb2, 0xbb, 0x18, 0x15, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0xca, 0xf3,
+	0x18, 0x26, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x07, 0x52, 0x13, 0x63, 0x6f, 0x64, 0x65,
+	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x88,
+	0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f,
+	0x75, 0x72, 0x69, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0xc9,
+	0x01, 0x0a, 0x15, 0x4f, 0x49, 0x44, 0x43, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74,
+	0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08,
+	0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x0c, 0x72,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x17, 0xca, 0xf3, 0x18, 0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0x48, 0x01, 0x52, 0x0b, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0xf7, 0x05, 0x0a, 0x10, 0x4f,
+	0x49, 0x44, 0x43, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x73, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x4f, 0xda, 0xbb, 0x18, 0x28, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24,
+	0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba,
+	0x27, 0xe2, 0xbb, 0x18, 0x0a, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0xca,
+	0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x48, 0x00, 0x52, 0x09, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x21, 0xe2, 0xbb, 0x18, 0x04, 0x63, 0x6f, 0x64, 0x65, 0xca, 0xf3, 0x18, 0x15,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x59, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xe2, 0xbb, 0x18, 0x0c, 0x72, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0xca, 0xf3, 0x18, 0x1d, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x0b, 0x72, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x09, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b,
+	0xe2, 0xbb, 0x18, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0xca, 0xf3, 0x18,
+	0x1a, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a, 0x0d, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x33, 0xe2, 0xbb, 0x18, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a, 0x0d, 0x63, 0x6f, 0x64,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x33, 0xe2, 0xbb, 0x18, 0x0d, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x6f, 0x64,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x33, 0xe2, 0xbb, 0x18, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0xca, 0xf3, 0x18, 0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x06, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xc6, 0x04, 0x0a, 0x11, 0x4f, 0x49, 0x44, 0x43, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68,
+	0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00,
+	0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a,
+	0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x17, 0xca, 0xf3, 0x18, 0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x48, 0x01, 0x52, 0x0b,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x39,
+	0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x15, 0xca, 0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x48, 0x02, 0x52, 0x09, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xca,
+	0xf3, 0x18, 0x11, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x69, 0x6e, 0x22, 0x48, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xca, 0xf3, 0x18,
+	0x1e, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48,
+	0x04, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xca, 0xf3, 0x18, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x07, 0x69, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x06, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x69, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x64, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0xc4, 0x04,
+	0x0a, 0x14, 0x4f, 0x49, 0x44, 0x43, 0x55, 0x73, 0x65, 0x72, 0x69, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x44, 0x54, 0x4f, 0x42, 0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x03, 0x73, 0x75, 0x62,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xca, 0xf3, 0x18, 0x0a, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x73, 0x75, 0x62, 0x22, 0x48, 0x01, 0x52, 0x03, 0x73, 0x75, 0x62, 0x88, 0x01, 0x01,
+	0x12, 0x32, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19,
+	0xca, 0xf3, 0x18, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x5b, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65,
+	0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x27, 0xca, 0xf3, 0x18, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x11, 0x70, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x35, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x04, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x0e, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x23, 0xca, 0xf3, 0x18, 0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x0d, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x07, 0x70, 0x69, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xca, 0xf3, 0x18, 0x18,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x06, 0x52, 0x07, 0x70, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73, 0x75, 0x62, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72,
+	0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x69, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x22, 0xf4, 0x01, 0x0a, 0x11, 0x4f, 0x49, 0x44, 0x43, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5b, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x40, 0xda, 0xbb, 0x18, 0x23, 0x40,
+	0x3a, 0x6c, 0x65, 0x6e, 0x28, 0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9,
+	0xba, 0x27, 0xe2, 0xbb, 0x18, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xca, 0xf3, 0x18, 0x0c, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x48, 0x00, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x64, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x37, 0xe2, 0xbb, 0x18, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x5f, 0x68, 0x69, 0x6e, 0x74, 0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x0d, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x22, 0xf8, 0x01, 0x0a, 0x15,
+	0x4f, 0x49, 0x44, 0x43, 0x49, 0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5b, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x40, 0xda, 0xbb, 0x18, 0x23, 0x40, 0x3a, 0x6c, 0x65, 0x6e, 0x28,
+	0x24, 0x29, 0x3e, 0x30, 0x3b, 0x20, 0x6d, 0x73, 0x67, 0x3a, 0x27, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x27, 0xe2, 0xbb, 0x18,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0xca, 0xf3, 0x18, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x64, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x5f, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xe2, 0xbb, 0x18,
+	0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74,
+	0xca, 0xf3, 0x18, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x01, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x48, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x22, 0xbc, 0x04, 0x0a, 0x16, 0x4f, 0x49, 0x44, 0x43, 0x49,
+	0x6e, 0x74, 0x72, 0x6f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x51, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x54, 0x4f, 0x42,
+	0x14, 0xca, 0xf3, 0x18, 0x10, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x22, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0xca, 0xf3, 0x18, 0x0d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x48, 0x01, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xca, 0xf3, 0x18, 0x1a, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x02, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xca, 0xf3, 0x18, 0x19, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x03, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xca, 0xf3, 0x18, 0x16, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x48, 0x04, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a,
+	0x03, 0x73, 0x75, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xca, 0xf3, 0x18, 0x14,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x75, 0x62, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x48, 0x05, 0x52, 0x03, 0x73, 0x75, 0x62, 0x88, 0x01, 0x01, 0x12, 0x2f,
+	0x0a, 0x03, 0x65, 0x78, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x18, 0xca, 0xf3, 0x18,
+	0x14, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x06, 0x52, 0x03, 0x65, 0x78, 0x70, 0x88, 0x01, 0x01, 0x12,
+	0x2f, 0x0a, 0x03, 0x69, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x18, 0xca, 0xf3,
+	0x18, 0x14, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x61, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x48, 0x07, 0x52, 0x03, 0x69, 0x61, 0x74, 0x88, 0x01, 0x01,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x42, 0x06, 0x0a,
+	0x04, 0x5f, 0x73, 0x75, 0x62, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x65, 0x78, 0x70, 0x42, 0x06, 0x0a,
+	0x04, 0x5f, 0x69, 0x61, 0x74, 0x42, 0x4c, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x78, 0x75, 0x2f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x77, 0x65, 0x67, 0x6f, 0x2d, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2d, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7101,140 +12745,243 @@ func file_http_identity_identity_model_proto_rawDescGZIP() []byte {
 	return file_http_identity_identity_model_proto_rawDescData
 }
 
-var file_http_identity_identity_model_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
+var file_http_identity_identity_model_proto_msgTypes = make([]protoimpl.MessageInfo, 121)
 var file_http_identity_identity_model_proto_goTypes = []interface{}{
-	(*LoginRequestDTO)(nil),                       // 0: identity.LoginRequestDTO
-	(*RoleInfoDTO)(nil),                           // 1: identity.RoleInfoDTO
-	(*LoginResponseDTO)(nil),                      // 2: identity.LoginResponseDTO
-	(*LogoutRequestDTO)(nil),                      // 3: identity.LogoutRequestDTO
-	(*ChangePasswordRequestDTO)(nil),              // 4: identity.ChangePasswordRequestDTO
-	(*ResetPasswordRequestDTO)(nil),               // 5: identity.ResetPasswordRequestDTO
-	(*ForcePasswordChangeRequestDTO)(nil),         // 6: identity.ForcePasswordChangeRequestDTO
-	(*RefreshTokenRequestDTO)(nil),                // 7: identity.RefreshTokenRequestDTO
-	(*RefreshTokenResponseDTO)(nil),               // 8: identity.RefreshTokenResponseDTO
-	(*UserProfileDTO)(nil),                        // 9: identity.UserProfileDTO
-	(*UserProfileResponseDTO)(nil),                // 10: identity.UserProfileResponseDTO
-	(*CreateUserRequestDTO)(nil),                  // 11: identity.CreateUserRequestDTO
-	(*GetUserRequestDTO)(nil),                     // 12: identity.GetUserRequestDTO
-	(*UpdateUserRequestDTO)(nil),                  // 13: identity.UpdateUserRequestDTO
-	(*UpdateMeRequestDTO)(nil),                    // 14: identity.UpdateMeRequestDTO
-	(*DeleteUserRequestDTO)(nil),                  // 15: identity.DeleteUserRequestDTO
-	(*ListUsersRequestDTO)(nil),                   // 16: identity.ListUsersRequestDTO
-	(*ListUsersResponseDTO)(nil),                  // 17: identity.ListUsersResponseDTO
-	(*SearchUsersRequestDTO)(nil),                 // 18: identity.SearchUsersRequestDTO
-	(*SearchUsersResponseDTO)(nil),                // 19: identity.SearchUsersResponseDTO
-	(*ChangeUserStatusRequestDTO)(nil),            // 20: identity.ChangeUserStatusRequestDTO
-	(*UnlockUserRequestDTO)(nil),                  // 21: identity.UnlockUserRequestDTO
-	(*UserMembershipDTO)(nil),                     // 22: identity.UserMembershipDTO
-	(*UserMembershipResponseDTO)(nil),             // 23: identity.UserMembershipResponseDTO
-	(*GetUserMembershipsRequestDTO)(nil),          // 24: identity.GetUserMembershipsRequestDTO
-	(*GetUserMembershipsResponseDTO)(nil),         // 25: identity.GetUserMembershipsResponseDTO
-	(*GetPrimaryMembershipRequestDTO)(nil),        // 26: identity.GetPrimaryMembershipRequestDTO
-	(*CheckMembershipRequestDTO)(nil),             // 27: identity.CheckMembershipRequestDTO
-	(*OrganizationDTO)(nil),                       // 28: identity.OrganizationDTO
-	(*OrganizationResponseDTO)(nil),               // 29: identity.OrganizationResponseDTO
-	(*CreateOrganizationRequestDTO)(nil),          // 30: identity.CreateOrganizationRequestDTO
-	(*GetOrganizationRequestDTO)(nil),             // 31: identity.GetOrganizationRequestDTO
-	(*UpdateOrganizationRequestDTO)(nil),          // 32: identity.UpdateOrganizationRequestDTO
-	(*DeleteOrganizationRequestDTO)(nil),          // 33: identity.DeleteOrganizationRequestDTO
-	(*ListOrganizationsRequestDTO)(nil),           // 34: identity.ListOrganizationsRequestDTO
-	(*ListOrganizationsResponseDTO)(nil),          // 35: identity.ListOrganizationsResponseDTO
-	(*DepartmentDTO)(nil),                         // 36: identity.DepartmentDTO
-	(*DepartmentResponseDTO)(nil),                 // 37: identity.DepartmentResponseDTO
-	(*CreateDepartmentRequestDTO)(nil),            // 38: identity.CreateDepartmentRequestDTO
-	(*GetDepartmentRequestDTO)(nil),               // 39: identity.GetDepartmentRequestDTO
-	(*UpdateDepartmentRequestDTO)(nil),            // 40: identity.UpdateDepartmentRequestDTO
-	(*DeleteDepartmentRequestDTO)(nil),            // 41: identity.DeleteDepartmentRequestDTO
-	(*GetOrganizationDepartmentsRequestDTO)(nil),  // 42: identity.GetOrganizationDepartmentsRequestDTO
-	(*GetOrganizationDepartmentsResponseDTO)(nil), // 43: identity.GetOrganizationDepartmentsResponseDTO
-	(*OrganizationLogoDTO)(nil),                   // 44: identity.OrganizationLogoDTO
-	(*OrganizationLogoResponseDTO)(nil),           // 45: identity.OrganizationLogoResponseDTO
-	(*UploadTemporaryLogoRequestDTO)(nil),         // 46: identity.UploadTemporaryLogoRequestDTO
-	(*GetOrganizationLogoRequestDTO)(nil),         // 47: identity.GetOrganizationLogoRequestDTO
-	(*DeleteOrganizationLogoRequestDTO)(nil),      // 48: identity.DeleteOrganizationLogoRequestDTO
-	(*BindLogoToOrganizationRequestDTO)(nil),      // 49: identity.BindLogoToOrganizationRequestDTO
-	(*AuditLogDTO)(nil),                           // 50: identity.AuditLogDTO
-	(*ListAuditLogsRequestDTO)(nil),               // 51: identity.ListAuditLogsRequestDTO
-	(*AuditLogStatsDTO)(nil),                      // 52: identity.AuditLogStatsDTO
-	(*ListAuditLogsResponseDTO)(nil),              // 53: identity.ListAuditLogsResponseDTO
-	(*GetMeRequestDTO)(nil),                       // 54: identity.GetMeRequestDTO
-	(*EmptyRequest)(nil),                          // 55: identity.EmptyRequest
-	(*EmptyResponse)(nil),                         // 56: identity.EmptyResponse
-	(*OIDCDiscoveryResponse)(nil),                 // 57: identity.OIDCDiscoveryResponse
-	(*OIDCJWKSResponse)(nil),                      // 58: identity.OIDCJWKSResponse
-	(*OIDCAuthorizeRequest)(nil),                  // 59: identity.OIDCAuthorizeRequest
-	(*OIDCAuthorizeResponse)(nil),                 // 60: identity.OIDCAuthorizeResponse
-	(*OIDCTokenRequest)(nil),                      // 61: identity.OIDCTokenRequest
-	(*OIDCTokenResponse)(nil),                     // 62: identity.OIDCTokenResponse
-	(*OIDCUserinfoResponse)(nil),                  // 63: identity.OIDCUserinfoResponse
-	(*OIDCRevokeRequest)(nil),                     // 64: identity.OIDCRevokeRequest
-	(*OIDCIntrospectRequest)(nil),                 // 65: identity.OIDCIntrospectRequest
-	(*OIDCIntrospectResponse)(nil),                // 66: identity.OIDCIntrospectResponse
-	(*http_base.BaseResponseDTO)(nil),             // 67: http_base.BaseResponseDTO
-	(*http_base.TokenInfoDTO)(nil),                // 68: http_base.TokenInfoDTO
-	(*structpb.ListValue)(nil),                    // 69: google.protobuf.ListValue
-	(*http_base.PageRequestDTO)(nil),              // 70: http_base.PageRequestDTO
-	(*http_base.PageResponseDTO)(nil),             // 71: http_base.PageResponseDTO
+	(*LoginRequestDTO)(nil),                        // 0: identity.LoginRequestDTO
+	(*RoleInfoDTO)(nil),                            // 1: identity.RoleInfoDTO
+	(*LoginResponseDTO)(nil),                       // 2: identity.LoginResponseDTO
+	(*MFAChallengeDTO)(nil),                        // 3: identity.MFAChallengeDTO
+	(*MFAVerifyRequestDTO)(nil),                    // 4: identity.MFAVerifyRequestDTO
+	(*MFAEnrollRequestDTO)(nil),                    // 5: identity.MFAEnrollRequestDTO
+	(*StartMFAEnrollmentRequestDTO)(nil),           // 6: identity.StartMFAEnrollmentRequestDTO
+	(*MFAEnrollResponseDTO)(nil),                   // 7: identity.MFAEnrollResponseDTO
+	(*ConfirmMFARequestDTO)(nil),                   // 8: identity.ConfirmMFARequestDTO
+	(*ConfirmMFAResponseDTO)(nil),                  // 9: identity.ConfirmMFAResponseDTO
+	(*DisableMFARequestDTO)(nil),                   // 10: identity.DisableMFARequestDTO
+	(*LogoutRequestDTO)(nil),                       // 11: identity.LogoutRequestDTO
+	(*ChangePasswordRequestDTO)(nil),               // 12: identity.ChangePasswordRequestDTO
+	(*ResetPasswordRequestDTO)(nil),                // 13: identity.ResetPasswordRequestDTO
+	(*RequestPasswordResetRequestDTO)(nil),         // 14: identity.RequestPasswordResetRequestDTO
+	(*ConfirmPasswordResetRequestDTO)(nil),         // 15: identity.ConfirmPasswordResetRequestDTO
+	(*ForcePasswordChangeRequestDTO)(nil),          // 16: identity.ForcePasswordChangeRequestDTO
+	(*RefreshTokenRequestDTO)(nil),                 // 17: identity.RefreshTokenRequestDTO
+	(*RefreshTokenResponseDTO)(nil),                // 18: identity.RefreshTokenResponseDTO
+	(*SwitchTenantRequestDTO)(nil),                 // 19: identity.SwitchTenantRequestDTO
+	(*SwitchTenantResponseDTO)(nil),                // 20: identity.SwitchTenantResponseDTO
+	(*TenantOptionDTO)(nil),                        // 21: identity.TenantOptionDTO
+	(*UserProfileDTO)(nil),                         // 22: identity.UserProfileDTO
+	(*UserProfileResponseDTO)(nil),                 // 23: identity.UserProfileResponseDTO
+	(*CreateUserRequestDTO)(nil),                   // 24: identity.CreateUserRequestDTO
+	(*GetUserRequestDTO)(nil),                      // 25: identity.GetUserRequestDTO
+	(*UpdateUserRequestDTO)(nil),                   // 26: identity.UpdateUserRequestDTO
+	(*UpdateMeRequestDTO)(nil),                     // 27: identity.UpdateMeRequestDTO
+	(*DeleteUserRequestDTO)(nil),                   // 28: identity.DeleteUserRequestDTO
+	(*SearchHighlightDTO)(nil),                     // 29: identity.SearchHighlightDTO
+	(*ListUsersRequestDTO)(nil),                    // 30: identity.ListUsersRequestDTO
+	(*ImportUsersRequestDTO)(nil),                  // 31: identity.ImportUsersRequestDTO
+	(*UserImportRowResultDTO)(nil),                 // 32: identity.UserImportRowResultDTO
+	(*ImportUsersResponseDTO)(nil),                 // 33: identity.ImportUsersResponseDTO
+	(*ExportUsersRequestDTO)(nil),                  // 34: identity.ExportUsersRequestDTO
+	(*ListUsersResponseDTO)(nil),                   // 35: identity.ListUsersResponseDTO
+	(*SearchUsersRequestDTO)(nil),                  // 36: identity.SearchUsersRequestDTO
+	(*SearchUsersResponseDTO)(nil),                 // 37: identity.SearchUsersResponseDTO
+	(*ChangeUserStatusRequestDTO)(nil),             // 38: identity.ChangeUserStatusRequestDTO
+	(*UnlockUserRequestDTO)(nil),                   // 39: identity.UnlockUserRequestDTO
+	(*UserMembershipDTO)(nil),                      // 40: identity.UserMembershipDTO
+	(*UserRoleAssignmentDTO)(nil),                  // 41: identity.UserRoleAssignmentDTO
+	(*ListUserRoleAssignmentsRequestDTO)(nil),      // 42: identity.ListUserRoleAssignmentsRequestDTO
+	(*ListUserRoleAssignmentsResponseDTO)(nil),     // 43: identity.ListUserRoleAssignmentsResponseDTO
+	(*RoleGrantRequestDTO)(nil),                    // 44: identity.RoleGrantRequestDTO
+	(*ListRoleGrantRequestsRequestDTO)(nil),        // 45: identity.ListRoleGrantRequestsRequestDTO
+	(*ListRoleGrantRequestsResponseDTO)(nil),       // 46: identity.ListRoleGrantRequestsResponseDTO
+	(*GetRoleGrantRequestRequestDTO)(nil),          // 47: identity.GetRoleGrantRequestRequestDTO
+	(*DecideRoleGrantRequestDTO)(nil),              // 48: identity.DecideRoleGrantRequestDTO
+	(*RoleGrantRequestResponseDTO)(nil),            // 49: identity.RoleGrantRequestResponseDTO
+	(*AccessReviewStatsDTO)(nil),                   // 50: identity.AccessReviewStatsDTO
+	(*AccessReviewCampaignDTO)(nil),                // 51: identity.AccessReviewCampaignDTO
+	(*AccessReviewItemDTO)(nil),                    // 52: identity.AccessReviewItemDTO
+	(*CreateAccessReviewCampaignRequestDTO)(nil),   // 53: identity.CreateAccessReviewCampaignRequestDTO
+	(*ListAccessReviewCampaignsRequestDTO)(nil),    // 54: identity.ListAccessReviewCampaignsRequestDTO
+	(*ListAccessReviewCampaignsResponseDTO)(nil),   // 55: identity.ListAccessReviewCampaignsResponseDTO
+	(*GetAccessReviewCampaignRequestDTO)(nil),      // 56: identity.GetAccessReviewCampaignRequestDTO
+	(*AccessReviewCampaignResponseDTO)(nil),        // 57: identity.AccessReviewCampaignResponseDTO
+	(*ListAccessReviewItemsRequestDTO)(nil),        // 58: identity.ListAccessReviewItemsRequestDTO
+	(*ListAccessReviewItemsResponseDTO)(nil),       // 59: identity.ListAccessReviewItemsResponseDTO
+	(*DecideAccessReviewItemRequestDTO)(nil),       // 60: identity.DecideAccessReviewItemRequestDTO
+	(*AccessReviewItemResponseDTO)(nil),            // 61: identity.AccessReviewItemResponseDTO
+	(*CloseAccessReviewCampaignRequestDTO)(nil),    // 62: identity.CloseAccessReviewCampaignRequestDTO
+	(*PermissionGrantDTO)(nil),                     // 63: identity.PermissionGrantDTO
+	(*RolePermissionsDTO)(nil),                     // 64: identity.RolePermissionsDTO
+	(*TenantPermissionsDTO)(nil),                   // 65: identity.TenantPermissionsDTO
+	(*UserPermissionsDTO)(nil),                     // 66: identity.UserPermissionsDTO
+	(*GetPermissionMatrixRequestDTO)(nil),          // 67: identity.GetPermissionMatrixRequestDTO
+	(*GetPermissionMatrixResponseDTO)(nil),         // 68: identity.GetPermissionMatrixResponseDTO
+	(*GetUserEffectivePermissionsRequestDTO)(nil),  // 69: identity.GetUserEffectivePermissionsRequestDTO
+	(*GetUserEffectivePermissionsResponseDTO)(nil), // 70: identity.GetUserEffectivePermissionsResponseDTO
+	(*ExportPermissionReportRequestDTO)(nil),       // 71: identity.ExportPermissionReportRequestDTO
+	(*DeletedEntityDTO)(nil),                       // 72: identity.DeletedEntityDTO
+	(*ListDeletedEntitiesRequestDTO)(nil),          // 73: identity.ListDeletedEntitiesRequestDTO
+	(*ListDeletedEntitiesResponseDTO)(nil),         // 74: identity.ListDeletedEntitiesResponseDTO
+	(*DeletedEntityRequestDTO)(nil),                // 75: identity.DeletedEntityRequestDTO
+	(*RestoreDeletedEntityResponseDTO)(nil),        // 76: identity.RestoreDeletedEntityResponseDTO
+	(*UserMembershipResponseDTO)(nil),              // 77: identity.UserMembershipResponseDTO
+	(*GetUserMembershipsRequestDTO)(nil),           // 78: identity.GetUserMembershipsRequestDTO
+	(*GetUserMembershipsResponseDTO)(nil),          // 79: identity.GetUserMembershipsResponseDTO
+	(*GetPrimaryMembershipRequestDTO)(nil),         // 80: identity.GetPrimaryMembershipRequestDTO
+	(*CheckMembershipRequestDTO)(nil),              // 81: identity.CheckMembershipRequestDTO
+	(*OrganizationDTO)(nil),                        // 82: identity.OrganizationDTO
+	(*OrganizationResponseDTO)(nil),                // 83: identity.OrganizationResponseDTO
+	(*CreateOrganizationRequestDTO)(nil),           // 84: identity.CreateOrganizationRequestDTO
+	(*GetOrganizationRequestDTO)(nil),              // 85: identity.GetOrganizationRequestDTO
+	(*UpdateOrganizationRequestDTO)(nil),           // 86: identity.UpdateOrganizationRequestDTO
+	(*DeleteOrganizationRequestDTO)(nil),           // 87: identity.DeleteOrganizationRequestDTO
+	(*ListOrganizationsRequestDTO)(nil),            // 88: identity.ListOrganizationsRequestDTO
+	(*ListOrganizationsResponseDTO)(nil),           // 89: identity.ListOrganizationsResponseDTO
+	(*DepartmentDTO)(nil),                          // 90: identity.DepartmentDTO
+	(*DepartmentResponseDTO)(nil),                  // 91: identity.DepartmentResponseDTO
+	(*CreateDepartmentRequestDTO)(nil),             // 92: identity.CreateDepartmentRequestDTO
+	(*GetDepartmentRequestDTO)(nil),                // 93: identity.GetDepartmentRequestDTO
+	(*UpdateDepartmentRequestDTO)(nil),             // 94: identity.UpdateDepartmentRequestDTO
+	(*DeleteDepartmentRequestDTO)(nil),             // 95: identity.DeleteDepartmentRequestDTO
+	(*GetOrganizationDepartmentsRequestDTO)(nil),   // 96: identity.GetOrganizationDepartmentsRequestDTO
+	(*GetOrganizationDepartmentsResponseDTO)(nil),  // 97: identity.GetOrganizationDepartmentsResponseDTO
+	(*OrganizationLogoDTO)(nil),                    // 98: identity.OrganizationLogoDTO
+	(*OrganizationLogoResponseDTO)(nil),            // 99: identity.OrganizationLogoResponseDTO
+	(*UploadTemporaryLogoRequestDTO)(nil),          // 100: identity.UploadTemporaryLogoRequestDTO
+	(*GetOrganizationLogoRequestDTO)(nil),          // 101: identity.GetOrganizationLogoRequestDTO
+	(*DeleteOrganizationLogoRequestDTO)(nil),       // 102: identity.DeleteOrganizationLogoRequestDTO
+	(*BindLogoToOrganizationRequestDTO)(nil),       // 103: identity.BindLogoToOrganizationRequestDTO
+	(*AuditLogDTO)(nil),                            // 104: identity.AuditLogDTO
+	(*ListAuditLogsRequestDTO)(nil),                // 105: identity.ListAuditLogsRequestDTO
+	(*AuditLogStatsDTO)(nil),                       // 106: identity.AuditLogStatsDTO
+	(*ListAuditLogsResponseDTO)(nil),               // 107: identity.ListAuditLogsResponseDTO
+	(*GetMeRequestDTO)(nil),                        // 108: identity.GetMeRequestDTO
+	(*EmptyRequest)(nil),                           // 109: identity.EmptyRequest
+	(*EmptyResponse)(nil),                          // 110: identity.EmptyResponse
+	(*OIDCDiscoveryResponse)(nil),                  // 111: identity.OIDCDiscoveryResponse
+	(*OIDCJWKSResponse)(nil),                       // 112: identity.OIDCJWKSResponse
+	(*OIDCAuthorizeRequest)(nil),                   // 113: identity.OIDCAuthorizeRequest
+	(*OIDCAuthorizeResponse)(nil),                  // 114: identity.OIDCAuthorizeResponse
+	(*OIDCTokenRequest)(nil),                       // 115: identity.OIDCTokenRequest
+	(*OIDCTokenResponse)(nil),                      // 116: identity.OIDCTokenResponse
+	(*OIDCUserinfoResponse)(nil),                   // 117: identity.OIDCUserinfoResponse
+	(*OIDCRevokeRequest)(nil),                      // 118: identity.OIDCRevokeRequest
+	(*OIDCIntrospectRequest)(nil),                  // 119: identity.OIDCIntrospectRequest
+	(*OIDCIntrospectResponse)(nil),                 // 120: identity.OIDCIntrospectResponse
+	(*http_base.BaseResponseDTO)(nil),              // 121: http_base.BaseResponseDTO
+	(*http_base.TokenInfoDTO)(nil),                 // 122: http_base.TokenInfoDTO
+	(*structpb.ListValue)(nil),                     // 123: google.protobuf.ListValue
+	(*http_base.PageRequestDTO)(nil),               // 124: http_base.PageRequestDTO
+	(*http_base.PageResponseDTO)(nil),              // 125: http_base.PageResponseDTO
 }
 var file_http_identity_identity_model_proto_depIdxs = []int32{
-	67, // 0: identity.LoginResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
-	9,  // 1: identity.LoginResponseDTO.userProfile:type_name -> identity.UserProfileDTO
-	68, // 2: identity.LoginResponseDTO.tokenInfo:type_name -> http_base.TokenInfoDTO
-	22, // 3: identity.LoginResponseDTO.memberships:type_name -> identity.UserMembershipDTO
-	1,  // 4: identity.LoginResponseDTO.roles:type_name -> identity.RoleInfoDTO
-	67, // 5: identity.RefreshTokenResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
-	68, // 6: identity.RefreshTokenResponseDTO.tokenInfo:type_name -> http_base.TokenInfoDTO
-	67, // 7: identity.UserProfileResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
-	9,  // 8: identity.UserProfileResponseDTO.user:type_name -> identity.UserProfileDTO
-	69, // 9: identity.UpdateUserRequestDTO.roleIDs:type_name -> google.protobuf.ListValue
-	70, // 10: identity.ListUsersRequestDTO.page:type_name -> http_base.PageRequestDTO
-	67, // 11: identity.ListUsersResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
-	9,  // 12: identity.ListUsersResponseDTO.users:type_name -> identity.UserProfileDTO
-	71, // 13: identity.ListUsersResponseDTO.page:type_name -> http_base.PageResponseDTO
-	70, // 14: identity.SearchUsersRequestDTO.page:type_name -> http_base.PageRequestDTO
-	67, // 15: identity.SearchUsersResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
-	9,  // 16: identity.SearchUsersResponseDTO.users:type_name -> identity.UserProfileDTO
-	71, // 17: identity.SearchUsersResponseDTO.page:type_name -> http_base.PageResponseDTO
-	28, // 18: identity.UserMembershipDTO.organization:type_name -> identity.OrganizationDTO
-	36, // 19: identity.UserMembershipDTO.department:type_name -> identity.DepartmentDTO
-	67, // 20: identity.UserMembershipResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
-	22, // 21: identity.UserMembershipResponseDTO.membership:type_name -> identity.UserMembershipDTO
-	70, // 22: identity.GetUserMembershipsRequestDTO.page:type_name -> http_base.PageRequestDTO
-	67, // 23: identity.GetUserMembershipsResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
-	22, // 24: identity.GetUserMembershipsResponseDTO.memberships:type_name -> identity.UserMembershipDTO
-	71, // 25: identity.GetUserMembershipsResponseDTO.page:type_name -> http_base.PageResponseDTO
-	28, // 26: identity.OrganizationDTO.parent:type_name -> identity.OrganizationDTO
-	28, // 27: identity.OrganizationDTO.children:type_name -> identity.OrganizationDTO
-	67, // 28: identity.OrganizationResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
-	28, // 29: identity.OrganizationResponseDTO.organization:type_name -> identity.OrganizationDTO
-	69, // 30: identity.UpdateOrganizationRequestDTO.provinceCity:type_name -> google.protobuf.ListValue
-	70, // 31: identity.ListOrganizationsRequestDTO.page:type_name -> http_base.PageRequestDTO
-	67, // 32: identity.ListOrganizationsResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
-	28, // 33: identity.ListOrganizationsResponseDTO.organizations:type_name -> identity.OrganizationDTO
-	71, // 34: identity.ListOrganizationsResponseDTO.page:type_name -> http_base.PageResponseDTO
-	28, // 35: identity.DepartmentDTO.organization:type_name -> identity.OrganizationDTO
-	67, // 36: identity.DepartmentResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
-	36, // 37: identity.DepartmentResponseDTO.department:type_name -> identity.DepartmentDTO
-	70, // 38: identity.GetOrganizationDepartmentsRequestDTO.page:type_name -> http_base.PageRequestDTO
-	67, // 39: identity.GetOrganizationDepartmentsResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
-	36, // 40: identity.GetOrganizationDepartmentsResponseDTO.departments:type_name -> identity.DepartmentDTO
-	71, // 41: identity.GetOrganizationDepartmentsResponseDTO.page:type_name -> http_base.PageResponseDTO
-	67, // 42: identity.OrganizationLogoResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
-	44, // 43: identity.OrganizationLogoResponseDTO.logo:type_name -> identity.OrganizationLogoDTO
-	70, // 44: identity.ListAuditLogsRequestDTO.page:type_name -> http_base.PageRequestDTO
-	67, // 45: identity.ListAuditLogsResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
-	50, // 46: identity.ListAuditLogsResponseDTO.auditLogs:type_name -> identity.AuditLogDTO
-	71, // 47: identity.ListAuditLogsResponseDTO.page:type_name -> http_base.PageResponseDTO
-	52, // 48: identity.ListAuditLogsResponseDTO.stats:type_name -> identity.AuditLogStatsDTO
-	67, // 49: identity.OIDCAuthorizeResponse.baseResp:type_name -> http_base.BaseResponseDTO
-	67, // 50: identity.OIDCTokenResponse.baseResp:type_name -> http_base.BaseResponseDTO
-	67, // 51: identity.OIDCUserinfoResponse.baseResp:type_name -> http_base.BaseResponseDTO
-	67, // 52: identity.OIDCIntrospectResponse.baseResp:type_name -> http_base.BaseResponseDTO
-	53, // [53:53] is the sub-list for method output_type
-	53, // [53:53] is the sub-list for method input_type
-	53, // [53:53] is the sub-list for extension type_name
-	53, // [53:53] is the sub-list for extension extendee
-	0,  // [0:53] is the sub-list for field type_name
+	121, // 0: identity.LoginResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	22,  // 1: identity.LoginResponseDTO.userProfile:type_name -> identity.UserProfileDTO
+	122, // 2: identity.LoginResponseDTO.tokenInfo:type_name -> http_base.TokenInfoDTO
+	40,  // 3: identity.LoginResponseDTO.memberships:type_name -> identity.UserMembershipDTO
+	1,   // 4: identity.LoginResponseDTO.roles:type_name -> identity.RoleInfoDTO
+	3,   // 5: identity.LoginResponseDTO.mfaChallenge:type_name -> identity.MFAChallengeDTO
+	121, // 6: identity.MFAEnrollResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	121, // 7: identity.ConfirmMFAResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	121, // 8: identity.RefreshTokenResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	122, // 9: identity.RefreshTokenResponseDTO.tokenInfo:type_name -> http_base.TokenInfoDTO
+	121, // 10: identity.SwitchTenantResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	122, // 11: identity.SwitchTenantResponseDTO.tokenInfo:type_name -> http_base.TokenInfoDTO
+	1,   // 12: identity.SwitchTenantResponseDTO.roles:type_name -> identity.RoleInfoDTO
+	121, // 13: identity.UserProfileResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	22,  // 14: identity.UserProfileResponseDTO.user:type_name -> identity.UserProfileDTO
+	21,  // 15: identity.UserProfileResponseDTO.switchableTenants:type_name -> identity.TenantOptionDTO
+	123, // 16: identity.UpdateUserRequestDTO.roleIDs:type_name -> google.protobuf.ListValue
+	124, // 17: identity.ListUsersRequestDTO.page:type_name -> http_base.PageRequestDTO
+	121, // 18: identity.ImportUsersResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	32,  // 19: identity.ImportUsersResponseDTO.rows:type_name -> identity.UserImportRowResultDTO
+	121, // 20: identity.ListUsersResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	22,  // 21: identity.ListUsersResponseDTO.users:type_name -> identity.UserProfileDTO
+	125, // 22: identity.ListUsersResponseDTO.page:type_name -> http_base.PageResponseDTO
+	29,  // 23: identity.ListUsersResponseDTO.highlights:type_name -> identity.SearchHighlightDTO
+	124, // 24: identity.SearchUsersRequestDTO.page:type_name -> http_base.PageRequestDTO
+	121, // 25: identity.SearchUsersResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	22,  // 26: identity.SearchUsersResponseDTO.users:type_name -> identity.UserProfileDTO
+	125, // 27: identity.SearchUsersResponseDTO.page:type_name -> http_base.PageResponseDTO
+	29,  // 28: identity.SearchUsersResponseDTO.highlights:type_name -> identity.SearchHighlightDTO
+	82,  // 29: identity.UserMembershipDTO.organization:type_name -> identity.OrganizationDTO
+	90,  // 30: identity.UserMembershipDTO.department:type_name -> identity.DepartmentDTO
+	124, // 31: identity.ListUserRoleAssignmentsRequestDTO.page:type_name -> http_base.PageRequestDTO
+	121, // 32: identity.ListUserRoleAssignmentsResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	41,  // 33: identity.ListUserRoleAssignmentsResponseDTO.assignments:type_name -> identity.UserRoleAssignmentDTO
+	125, // 34: identity.ListUserRoleAssignmentsResponseDTO.page:type_name -> http_base.PageResponseDTO
+	124, // 35: identity.ListRoleGrantRequestsRequestDTO.page:type_name -> http_base.PageRequestDTO
+	121, // 36: identity.ListRoleGrantRequestsResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	44,  // 37: identity.ListRoleGrantRequestsResponseDTO.requests:type_name -> identity.RoleGrantRequestDTO
+	125, // 38: identity.ListRoleGrantRequestsResponseDTO.page:type_name -> http_base.PageResponseDTO
+	121, // 39: identity.RoleGrantRequestResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	44,  // 40: identity.RoleGrantRequestResponseDTO.request:type_name -> identity.RoleGrantRequestDTO
+	50,  // 41: identity.AccessReviewCampaignDTO.stats:type_name -> identity.AccessReviewStatsDTO
+	124, // 42: identity.ListAccessReviewCampaignsRequestDTO.page:type_name -> http_base.PageRequestDTO
+	121, // 43: identity.ListAccessReviewCampaignsResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	51,  // 44: identity.ListAccessReviewCampaignsResponseDTO.campaigns:type_name -> identity.AccessReviewCampaignDTO
+	125, // 45: identity.ListAccessReviewCampaignsResponseDTO.page:type_name -> http_base.PageResponseDTO
+	121, // 46: identity.AccessReviewCampaignResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	51,  // 47: identity.AccessReviewCampaignResponseDTO.campaign:type_name -> identity.AccessReviewCampaignDTO
+	124, // 48: identity.ListAccessReviewItemsRequestDTO.page:type_name -> http_base.PageRequestDTO
+	121, // 49: identity.ListAccessReviewItemsResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	52,  // 50: identity.ListAccessReviewItemsResponseDTO.items:type_name -> identity.AccessReviewItemDTO
+	125, // 51: identity.ListAccessReviewItemsResponseDTO.page:type_name -> http_base.PageResponseDTO
+	121, // 52: identity.AccessReviewItemResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	52,  // 53: identity.AccessReviewItemResponseDTO.item:type_name -> identity.AccessReviewItemDTO
+	63,  // 54: identity.RolePermissionsDTO.grants:type_name -> identity.PermissionGrantDTO
+	64,  // 55: identity.TenantPermissionsDTO.roles:type_name -> identity.RolePermissionsDTO
+	63,  // 56: identity.TenantPermissionsDTO.directGrants:type_name -> identity.PermissionGrantDTO
+	65,  // 57: identity.UserPermissionsDTO.tenants:type_name -> identity.TenantPermissionsDTO
+	121, // 58: identity.GetPermissionMatrixResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	64,  // 59: identity.GetPermissionMatrixResponseDTO.roles:type_name -> identity.RolePermissionsDTO
+	121, // 60: identity.GetUserEffectivePermissionsResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	66,  // 61: identity.GetUserEffectivePermissionsResponseDTO.user:type_name -> identity.UserPermissionsDTO
+	124, // 62: identity.ListDeletedEntitiesRequestDTO.page:type_name -> http_base.PageRequestDTO
+	121, // 63: identity.ListDeletedEntitiesResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	72,  // 64: identity.ListDeletedEntitiesResponseDTO.entities:type_name -> identity.DeletedEntityDTO
+	125, // 65: identity.ListDeletedEntitiesResponseDTO.page:type_name -> http_base.PageResponseDTO
+	121, // 66: identity.RestoreDeletedEntityResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	121, // 67: identity.UserMembershipResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	40,  // 68: identity.UserMembershipResponseDTO.membership:type_name -> identity.UserMembershipDTO
+	124, // 69: identity.GetUserMembershipsRequestDTO.page:type_name -> http_base.PageRequestDTO
+	121, // 70: identity.GetUserMembershipsResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	40,  // 71: identity.GetUserMembershipsResponseDTO.memberships:type_name -> identity.UserMembershipDTO
+	125, // 72: identity.GetUserMembershipsResponseDTO.page:type_name -> http_base.PageResponseDTO
+	82,  // 73: identity.OrganizationDTO.parent:type_name -> identity.OrganizationDTO
+	82,  // 74: identity.OrganizationDTO.children:type_name -> identity.OrganizationDTO
+	121, // 75: identity.OrganizationResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	82,  // 76: identity.OrganizationResponseDTO.organization:type_name -> identity.OrganizationDTO
+	123, // 77: identity.UpdateOrganizationRequestDTO.provinceCity:type_name -> google.protobuf.ListValue
+	124, // 78: identity.ListOrganizationsRequestDTO.page:type_name -> http_base.PageRequestDTO
+	121, // 79: identity.ListOrganizationsResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	82,  // 80: identity.ListOrganizationsResponseDTO.organizations:type_name -> identity.OrganizationDTO
+	125, // 81: identity.ListOrganizationsResponseDTO.page:type_name -> http_base.PageResponseDTO
+	29,  // 82: identity.ListOrganizationsResponseDTO.highlights:type_name -> identity.SearchHighlightDTO
+	82,  // 83: identity.DepartmentDTO.organization:type_name -> identity.OrganizationDTO
+	121, // 84: identity.DepartmentResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	90,  // 85: identity.DepartmentResponseDTO.department:type_name -> identity.DepartmentDTO
+	124, // 86: identity.GetOrganizationDepartmentsRequestDTO.page:type_name -> http_base.PageRequestDTO
+	121, // 87: identity.GetOrganizationDepartmentsResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	90,  // 88: identity.GetOrganizationDepartmentsResponseDTO.departments:type_name -> identity.DepartmentDTO
+	125, // 89: identity.GetOrganizationDepartmentsResponseDTO.page:type_name -> http_base.PageResponseDTO
+	29,  // 90: identity.GetOrganizationDepartmentsResponseDTO.highlights:type_name -> identity.SearchHighlightDTO
+	121, // 91: identity.OrganizationLogoResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	98,  // 92: identity.OrganizationLogoResponseDTO.logo:type_name -> identity.OrganizationLogoDTO
+	124, // 93: identity.ListAuditLogsRequestDTO.page:type_name -> http_base.PageRequestDTO
+	121, // 94: identity.ListAuditLogsResponseDTO.baseResp:type_name -> http_base.BaseResponseDTO
+	104, // 95: identity.ListAuditLogsResponseDTO.auditLogs:type_name -> identity.AuditLogDTO
+	125, // 96: identity.ListAuditLogsResponseDTO.page:type_name -> http_base.PageResponseDTO
+	106, // 97: identity.ListAuditLogsResponseDTO.stats:type_name -> identity.AuditLogStatsDTO
+	121, // 98: identity.OIDCAuthorizeResponse.baseResp:type_name -> http_base.BaseResponseDTO
+	121, // 99: identity.OIDCTokenResponse.baseResp:type_name -> http_base.BaseResponseDTO
+	121, // 100: identity.OIDCUserinfoResponse.baseResp:type_name -> http_base.BaseResponseDTO
+	121, // 101: identity.OIDCIntrospectResponse.baseResp:type_name -> http_base.BaseResponseDTO
+	102, // [102:102] is the sub-list for method output_type
+	102, // [102:102] is the sub-list for method input_type
+	102, // [102:102] is the sub-list for extension type_name
+	102, // [102:102] is the sub-list for extension extendee
+	0,   // [0:102] is the sub-list for field type_name
 }
 
 func init() { file_http_identity_identity_model_proto_init() }
@@ -7255,8 +13002,488 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoleInfoDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleInfoDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginResponseDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MFAChallengeDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MFAVerifyRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MFAEnrollRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartMFAEnrollmentRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MFAEnrollResponseDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmMFARequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmMFAResponseDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableMFARequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogoutRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePasswordRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetPasswordRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestPasswordResetRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmPasswordResetRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForcePasswordChangeRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenResponseDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwitchTenantRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwitchTenantResponseDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantOptionDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserProfileDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserProfileResponseDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMeRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUserRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchHighlightDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUsersRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportUsersRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserImportRowResultDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportUsersResponseDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUsersRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUsersResponseDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchUsersRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchUsersResponseDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeUserStatusRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockUserRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserMembershipDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserRoleAssignmentDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7267,8 +13494,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginResponseDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserRoleAssignmentsRequestDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7279,8 +13506,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogoutRequestDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserRoleAssignmentsResponseDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7291,8 +13518,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangePasswordRequestDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleGrantRequestDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7303,8 +13530,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetPasswordRequestDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoleGrantRequestsRequestDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7315,8 +13542,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ForcePasswordChangeRequestDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoleGrantRequestsResponseDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7327,8 +13554,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshTokenRequestDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoleGrantRequestRequestDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7339,8 +13566,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshTokenResponseDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecideRoleGrantRequestDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7351,8 +13578,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserProfileDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleGrantRequestResponseDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7363,8 +13590,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserProfileResponseDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessReviewStatsDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7375,8 +13602,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserRequestDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessReviewCampaignDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7387,8 +13614,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserRequestDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessReviewItemDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7399,8 +13626,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUserRequestDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAccessReviewCampaignRequestDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7411,8 +13638,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMeRequestDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAccessReviewCampaignsRequestDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7423,8 +13650,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteUserRequestDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAccessReviewCampaignsResponseDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7435,8 +13662,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUsersRequestDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccessReviewCampaignRequestDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7447,8 +13674,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUsersResponseDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessReviewCampaignResponseDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7459,8 +13686,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchUsersRequestDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAccessReviewItemsRequestDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7471,8 +13698,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchUsersResponseDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAccessReviewItemsResponseDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7483,8 +13710,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeUserStatusRequestDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecideAccessReviewItemRequestDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7495,8 +13722,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnlockUserRequestDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessReviewItemResponseDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7507,8 +13734,8 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserMembershipDTO); i {
+		file_http_identity_identity_model_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseAccessReviewCampaignRequestDTO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7519,7 +13746,175 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissionGrantDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolePermissionsDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantPermissionsDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserPermissionsDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPermissionMatrixRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPermissionMatrixResponseDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserEffectivePermissionsRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserEffectivePermissionsResponseDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportPermissionReportRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletedEntityDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeletedEntitiesRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeletedEntitiesResponseDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletedEntityRequestDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreDeletedEntityResponseDTO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_http_identity_identity_model_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserMembershipResponseDTO); i {
 			case 0:
 				return &v.state
@@ -7531,7 +13926,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserMembershipsRequestDTO); i {
 			case 0:
 				return &v.state
@@ -7543,7 +13938,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserMembershipsResponseDTO); i {
 			case 0:
 				return &v.state
@@ -7555,7 +13950,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPrimaryMembershipRequestDTO); i {
 			case 0:
 				return &v.state
@@ -7567,7 +13962,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckMembershipRequestDTO); i {
 			case 0:
 				return &v.state
@@ -7579,7 +13974,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OrganizationDTO); i {
 			case 0:
 				return &v.state
@@ -7591,7 +13986,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OrganizationResponseDTO); i {
 			case 0:
 				return &v.state
@@ -7603,7 +13998,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateOrganizationRequestDTO); i {
 			case 0:
 				return &v.state
@@ -7615,7 +14010,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetOrganizationRequestDTO); i {
 			case 0:
 				return &v.state
@@ -7627,7 +14022,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateOrganizationRequestDTO); i {
 			case 0:
 				return &v.state
@@ -7639,7 +14034,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteOrganizationRequestDTO); i {
 			case 0:
 				return &v.state
@@ -7651,7 +14046,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListOrganizationsRequestDTO); i {
 			case 0:
 				return &v.state
@@ -7663,7 +14058,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListOrganizationsResponseDTO); i {
 			case 0:
 				return &v.state
@@ -7675,7 +14070,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DepartmentDTO); i {
 			case 0:
 				return &v.state
@@ -7687,7 +14082,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DepartmentResponseDTO); i {
 			case 0:
 				return &v.state
@@ -7699,7 +14094,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateDepartmentRequestDTO); i {
 			case 0:
 				return &v.state
@@ -7711,7 +14106,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDepartmentRequestDTO); i {
 			case 0:
 				return &v.state
@@ -7723,7 +14118,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateDepartmentRequestDTO); i {
 			case 0:
 				return &v.state
@@ -7735,7 +14130,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteDepartmentRequestDTO); i {
 			case 0:
 				return &v.state
@@ -7747,7 +14142,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetOrganizationDepartmentsRequestDTO); i {
 			case 0:
 				return &v.state
@@ -7759,7 +14154,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetOrganizationDepartmentsResponseDTO); i {
 			case 0:
 				return &v.state
@@ -7771,7 +14166,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OrganizationLogoDTO); i {
 			case 0:
 				return &v.state
@@ -7783,7 +14178,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OrganizationLogoResponseDTO); i {
 			case 0:
 				return &v.state
@@ -7795,7 +14190,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UploadTemporaryLogoRequestDTO); i {
 			case 0:
 				return &v.state
@@ -7807,7 +14202,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetOrganizationLogoRequestDTO); i {
 			case 0:
 				return &v.state
@@ -7819,7 +14214,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteOrganizationLogoRequestDTO); i {
 			case 0:
 				return &v.state
@@ -7831,7 +14226,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BindLogoToOrganizationRequestDTO); i {
 			case 0:
 				return &v.state
@@ -7843,7 +14238,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AuditLogDTO); i {
 			case 0:
 				return &v.state
@@ -7855,7 +14250,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListAuditLogsRequestDTO); i {
 			case 0:
 				return &v.state
@@ -7867,7 +14262,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AuditLogStatsDTO); i {
 			case 0:
 				return &v.state
@@ -7879,7 +14274,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListAuditLogsResponseDTO); i {
 			case 0:
 				return &v.state
@@ -7891,7 +14286,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetMeRequestDTO); i {
 			case 0:
 				return &v.state
@@ -7903,7 +14298,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EmptyRequest); i {
 			case 0:
 				return &v.state
@@ -7915,7 +14310,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EmptyResponse); i {
 			case 0:
 				return &v.state
@@ -7927,7 +14322,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OIDCDiscoveryResponse); i {
 			case 0:
 				return &v.state
@@ -7939,7 +14334,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OIDCJWKSResponse); i {
 			case 0:
 				return &v.state
@@ -7951,7 +14346,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OIDCAuthorizeRequest); i {
 			case 0:
 				return &v.state
@@ -7963,7 +14358,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OIDCAuthorizeResponse); i {
 			case 0:
 				return &v.state
@@ -7975,7 +14370,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OIDCTokenRequest); i {
 			case 0:
 				return &v.state
@@ -7987,7 +14382,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OIDCTokenResponse); i {
 			case 0:
 				return &v.state
@@ -7999,7 +14394,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OIDCUserinfoResponse); i {
 			case 0:
 				return &v.state
@@ -8011,7 +14406,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OIDCRevokeRequest); i {
 			case 0:
 				return &v.state
@@ -8023,7 +14418,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OIDCIntrospectRequest); i {
 			case 0:
 				return &v.state
@@ -8035,7 +14430,7 @@ func file_http_identity_identity_model_proto_init() {
 				return nil
 			}
 		}
-		file_http_identity_identity_model_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_http_identity_identity_model_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OIDCIntrospectResponse); i {
 			case 0:
 				return &v.state
@@ -8054,7 +14449,6 @@ func file_http_identity_identity_model_proto_init() {
 	file_http_identity_identity_model_proto_msgTypes[3].OneofWrappers = []interface{}{}
 	file_http_identity_identity_model_proto_msgTypes[4].OneofWrappers = []interface{}{}
 	file_http_identity_identity_model_proto_msgTypes[5].OneofWrappers = []interface{}{}
-	file_http_identity_identity_model_proto_msgTypes[6].OneofWrappers = []interface{}{}
 	file_http_identity_identity_model_proto_msgTypes[7].OneofWrappers = []interface{}{}
 	file_http_identity_identity_model_proto_msgTypes[8].OneofWrappers = []interface{}{}
 	file_http_identity_identity_model_proto_msgTypes[9].OneofWrappers = []interface{}{}
@@ -8102,6 +14496,9 @@ func file_http_identity_identity_model_proto_init() {
 	file_http_identity_identity_model_proto_msgTypes[51].OneofWrappers = []interface{}{}
 	file_http_identity_identity_model_proto_msgTypes[52].OneofWrappers = []interface{}{}
 	file_http_identity_identity_model_proto_msgTypes[53].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[54].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[55].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[56].OneofWrappers = []interface{}{}
 	file_http_identity_identity_model_proto_msgTypes[57].OneofWrappers = []interface{}{}
 	file_http_identity_identity_model_proto_msgTypes[58].OneofWrappers = []interface{}{}
 	file_http_identity_identity_model_proto_msgTypes[59].OneofWrappers = []interface{}{}
@@ -8112,13 +14509,64 @@ func file_http_identity_identity_model_proto_init() {
 	file_http_identity_identity_model_proto_msgTypes[64].OneofWrappers = []interface{}{}
 	file_http_identity_identity_model_proto_msgTypes[65].OneofWrappers = []interface{}{}
 	file_http_identity_identity_model_proto_msgTypes[66].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[67].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[68].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[69].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[70].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[71].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[72].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[73].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[74].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[75].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[76].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[77].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[78].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[79].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[80].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[81].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[82].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[83].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[84].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[85].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[86].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[87].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[88].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[89].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[90].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[91].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[92].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[93].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[94].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[95].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[96].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[97].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[98].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[99].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[100].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[101].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[102].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[103].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[104].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[105].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[106].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[107].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[111].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[112].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[113].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[114].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[115].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[116].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[117].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[118].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[119].OneofWrappers = []interface{}{}
+	file_http_identity_identity_model_proto_msgTypes[120].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_http_identity_identity_model_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   67,
+			NumMessages:   121,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -47,6 +47,8 @@ func Register(r *server.Hertz) {
 				_organizations0.PUT("/:organizationID", append(_updateorganizationMw(), identity.UpdateOrganization)...)
 				_identity.GET("/users", append(_listusersMw(), identity.ListUsers)...)
 				_users := _identity.Group("/users", _usersMw()...)
+				_users.GET("/export", append(_exportusersMw(), identity.ExportUsers)...)
+				_users.POST("/import", append(_importusersMw(), identity.ImportUsers)...)
 				_users.GET("/search", append(_searchusersMw(), identity.SearchUsers)...)
 				{
 					_userid := _users.Group("/:userID", _useridMw()...)
@@ -59,6 +61,13 @@ func Register(r *server.Hertz) {
 				_users0 := _identity.Group("/users", _users0Mw()...)
 				_users0.GET("/me", append(_getmeMw(), identity.GetMe)...)
 				_users0.PUT("/me", append(_updatemeMw(), identity.UpdateMe)...)
+				{
+					_me := _users0.Group("/me", _meMw()...)
+					_me.POST("/mfa", append(_startmfaenrollmentMw(), identity.StartMFAEnrollment)...)
+					_mfa := _me.Group("/mfa", _mfaMw()...)
+					_mfa.POST("/confirm", append(_confirmmfaMw(), identity.ConfirmMFA)...)
+					_mfa.POST("/disable", append(_disablemfaMw(), identity.DisableMFA)...)
+				}
 				_users0.DELETE("/:userID", append(_deleteuserMw(), identity.DeleteUser)...)
 				_users0.GET("/:userID", append(_getuserMw(), identity.GetUser)...)
 				_users0.PUT("/:userID", append(_updateuserMw(), identity.UpdateUser)...)
@@ -66,11 +75,18 @@ func Register(r *server.Hertz) {
 					_auth := _identity.Group("/auth", _authMw()...)
 					_auth.POST("/login", append(_loginMw(), identity.Login)...)
 					_auth.POST("/logout", append(_logoutMw(), identity.Logout)...)
+					_mfa0 := _auth.Group("/mfa", _mfa0Mw()...)
+					_mfa0.POST("/enroll", append(_enrollmfachallengeMw(), identity.EnrollMFAChallenge)...)
+					_mfa0.POST("/verify", append(_verifymfaMw(), identity.VerifyMFA)...)
 					_auth.PUT("/password", append(_changepasswordMw(), identity.ChangePassword)...)
 					_password := _auth.Group("/password", _passwordMw()...)
 					_password.PUT("/force-change", append(_forcepasswordchangeMw(), identity.ForcePasswordChange)...)
+					_password.POST("/forgot", append(_requestpasswordresetMw(), identity.RequestPasswordReset)...)
 					_password.POST("/reset", append(_resetpasswordMw(), identity.ResetPassword)...)
+					_reset := _password.Group("/reset", _resetMw()...)
+					_reset.POST("/confirm", append(_confirmpasswordresetMw(), identity.ConfirmPasswordReset)...)
 					_auth.POST("/refresh", append(_refreshtokenMw(), identity.RefreshToken)...)
+					_auth.POST("/switch-tenant", append(_switchtenantMw(), identity.SwitchTenant)...)
 				}
 				{
 					_organization_logos := _identity.Group("/organization-logos", _organization_logosMw()...)
@@ -78,6 +94,54 @@ func Register(r *server.Hertz) {
 					_organization_logos.GET("/:logoID", append(_getorganizationlogoMw(), identity.GetOrganizationLogo)...)
 					_organization_logos.POST("/temporary", append(_uploadtemporarylogoMw(), identity.UploadTemporaryLogo)...)
 				}
+				{
+					_recycle_bin := _identity.Group("/recycle-bin", _recycle_binMw()...)
+					_recycle_bin.GET("/:entityType", append(_listdeletedentitiesMw(), identity.ListDeletedEntities)...)
+					_entitytype := _recycle_bin.Group("/:entityType", _entitytypeMw()...)
+					_entitytype.DELETE("/:id", append(_purgedeletedentityMw(), identity.PurgeDeletedEntity)...)
+					{
+						_id := _entitytype.Group("/:id", _idMw()...)
+						_id.POST("/restore", append(_restoredeletedentityMw(), identity.RestoreDeletedEntity)...)
+					}
+				}
+			}
+			{
+				_permission := _v1.Group("/permission", _permissionMw()...)
+				{
+					_access_review_items := _permission.Group("/access-review-items", _access_review_itemsMw()...)
+					{
+						_itemid := _access_review_items.Group("/:itemID", _itemidMw()...)
+						_itemid.POST("/decision", append(_decideaccessreviewitemMw(), identity.DecideAccessReviewItem)...)
+					}
+				}
+				_permission.GET("/access-reviews", append(_listaccessreviewcampaignsMw(), identity.ListAccessReviewCampaigns)...)
+				_access_reviews := _permission.Group("/access-reviews", _access_reviewsMw()...)
+				_access_reviews.GET("/:campaignID", append(_getaccessreviewcampaignMw(), identity.GetAccessReviewCampaign)...)
+				{
+					_campaignid := _access_reviews.Group("/:campaignID", _campaignidMw()...)
+					_campaignid.POST("/close", append(_closeaccessreviewcampaignMw(), identity.CloseAccessReviewCampaign)...)
+					_campaignid.GET("/items", append(_listaccessreviewitemsMw(), identity.ListAccessReviewItems)...)
+				}
+				_permission.POST("/access-reviews", append(_createaccessreviewcampaignMw(), identity.CreateAccessReviewCampaign)...)
+				_permission.GET("/matrix", append(_getpermissionmatrixMw(), identity.GetPermissionMatrix)...)
+				_matrix := _permission.Group("/matrix", _matrixMw()...)
+				_matrix.GET("/export", append(_exportpermissionreportMw(), identity.ExportPermissionReport)...)
+				_permission.GET("/role-grants", append(_listrolegrantrequestsMw(), identity.ListRoleGrantRequests)...)
+				_role_grants := _permission.Group("/role-grants", _role_grantsMw()...)
+				_role_grants.GET("/:requestID", append(_getrolegrantrequestMw(), identity.GetRoleGrantRequest)...)
+				{
+					_requestid := _role_grants.Group("/:requestID", _requestidMw()...)
+					_requestid.POST("/approve", append(_approverolegrantMw(), identity.ApproveRoleGrant)...)
+					_requestid.POST("/reject", append(_rejectrolegrantMw(), identity.RejectRoleGrant)...)
+				}
+				_permission.GET("/user-roles", append(_listuserroleassignmentsMw(), identity.ListUserRoleAssignments)...)
+				{
+					_users1 := _permission.Group("/users", _users1Mw()...)
+					{
+						_userid0 := _users1.Group("/:userID", _userid0Mw()...)
+						_userid0.GET("/effective", append(_getusereffectivepermissionsMw(), identity.GetUserEffectivePermissions)...)
+					}
+				}
 			}
 		}
 	}
@@ -6,6 +6,7 @@ require (
 	github.com/bytedance/gopkg v0.1.3
 	github.com/cloudwego/hertz v0.10.4
 	github.com/cloudwego/kitex v0.16.1
+	github.com/fsnotify/fsnotify v1.9.0
 	github.com/go-jose/go-jose/v4 v4.1.3
 	github.com/google/uuid v1.6.0
 	github.com/google/wire v0.7.0
@@ -60,7 +61,6 @@ require (
 	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
 	github.com/elastic/pkcs8 v1.0.0 // indirect
 	github.com/fatih/structtag v1.2.0 // indirect
-	github.com/fsnotify/fsnotify v1.9.0 // indirect
 	github.com/go-chi/chi/v5 v5.2.5 // indirect
 	github.com/go-logr/logr v1.4.3 // indirect
 	github.com/go-logr/stdr v1.2.2 // indirect
@@ -153,3 +153,5 @@ replace (
 replace github.com/masonsxu/cloudwego-microservice-demo/iamclient => ../iamclient
 
 replace github.com/masonsxu/cloudwego-microservice-demo/rpc/policy-srv => ../rpc/policy_srv
+
+replace github.com/masonsxu/cloudwego-microservice-demo/dbmigrate => ../dbmigrate
@@ -1,5 +1,7 @@
 package common
 
+import "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/errors"
+
 // field_helpers.go 提供网关层对象转换的通用工具函数
 // 用于 HTTP DTO 与 RPC Request/Response 之间的字段安全转换
 
@@ -163,3 +165,17 @@ func StringValue(ptr *string) string {
 
 	return *ptr
 }
+
+// ResolveVersion 解析更新请求的乐观锁版本号
+// 请求体中的 version 优先，未提供时回退到 If-Match 请求头；两者均无效时返回 nil，由下游返回参数错误
+func ResolveVersion(version *int64, ifMatch *string) *int64 {
+	if version != nil {
+		return version
+	}
+
+	if parsed, ok := errors.ParseETag(StringValue(ifMatch)); ok {
+		return &parsed
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package identity
+
+import (
+	identityModel "github.com/masonsxu/cloudwego-microservice-demo/gateway/biz/model/identity"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/application/assembler/common"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+)
+
+// accessReviewAssembler 访问复核组装器实现
+type accessReviewAssembler struct{}
+
+// NewAccessReviewAssembler 创建访问复核组装器
+func NewAccessReviewAssembler() IAccessReviewAssembler {
+	return &accessReviewAssembler{}
+}
+
+// ToHTTPAccessReviewCampaign 将 RPC AccessReviewCampaign 转换为 HTTP AccessReviewCampaignDTO
+func (a *accessReviewAssembler) ToHTTPAccessReviewCampaign(
+	rpc *identity_srv.AccessReviewCampaign,
+) *identityModel.AccessReviewCampaignDTO {
+	if rpc == nil {
+		return nil
+	}
+
+	dto := &identityModel.AccessReviewCampaignDTO{
+		Id:             common.CopyStringPtr(rpc.Id),
+		Name:           common.CopyStringPtr(rpc.Name),
+		Description:    common.CopyStringPtr(rpc.Description),
+		OrganizationID: common.CopyStringPtr(rpc.OrganizationID),
+		RoleID:         common.CopyStringPtr(rpc.RoleID),
+		ReviewerID:     common.CopyStringPtr(rpc.ReviewerID),
+		DueAt:          common.CopyInt64Ptr(rpc.DueAt),
+		AutoRevoke:     common.CopyBoolPtr(rpc.AutoRevoke),
+		CreatedBy:      common.CopyStringPtr(rpc.CreatedBy),
+		ClosedBy:       common.CopyStringPtr(rpc.ClosedBy),
+		ClosedAt:       common.CopyInt64Ptr(rpc.ClosedAt),
+		CreatedAt:      common.CopyInt64Ptr(rpc.CreatedAt),
+	}
+
+	// 转换枚举类型 Status 为 int32
+	if rpc.Status != nil {
+		status := int32(*rpc.Status)
+		dto.Status = &status
+	}
+
+	if rpc.Stats != nil {
+		dto.Stats = &identityModel.AccessReviewStatsDTO{
+			Total:             common.CopyInt32Ptr(rpc.Stats.Total),
+			Pending:           common.CopyInt32Ptr(rpc.Stats.Pending),
+			Certified:         common.CopyInt32Ptr(rpc.Stats.Certified),
+			Revoked:           common.CopyInt32Ptr(rpc.Stats.Revoked),
+			AutoRevoked:       common.CopyInt32Ptr(rpc.Stats.AutoRevoked),
+			CompletionPercent: common.CopyInt32Ptr(rpc.Stats.CompletionPercent),
+		}
+	}
+
+	return dto
+}
+
+// ToHTTPAccessReviewItem 将 RPC AccessReviewItem 转换为 HTTP AccessReviewItemDTO
+func (a *accessReviewAssembler) ToHTTPAccessReviewItem(
+	rpc *identity_srv.AccessReviewItem,
+) *identityModel.AccessReviewItemDTO {
+	if rpc == nil {
+		return nil
+	}
+
+	dto := &identityModel.AccessReviewItemDTO{
+		Id:             common.CopyStringPtr(rpc.Id),
+		CampaignID:     common.CopyStringPtr(rpc.CampaignID),
+		AssignmentID:   common.CopyStringPtr(rpc.AssignmentID),
+		UserID:         common.CopyStringPtr(rpc.UserID),
+		Username:       common.CopyStringPtr(rpc.Username),
+		RealName:       common.CopyStringPtr(rpc.RealName),
+		RoleID:         common.CopyStringPtr(rpc.RoleID),
+		RoleCode:       common.CopyStringPtr(rpc.RoleCode),
+		RoleName:       common.CopyStringPtr(rpc.RoleName),
+		OrganizationID: common.CopyStringPtr(rpc.OrganizationID),
+		ValidUntil:     common.CopyInt64Ptr(rpc.ValidUntil),
+		ReviewedBy:     common.CopyStringPtr(rpc.ReviewedBy),
+		ReviewedAt:     common.CopyInt64Ptr(rpc.ReviewedAt),
+		Comment:        common.CopyStringPtr(rpc.Comment),
+	}
+
+	// 转换枚举类型 Decision 为 int32
+	if rpc.Decision != nil {
+		decision := int32(*rpc.Decision)
+		dto.Decision = &decision
+	}
+
+	return dto
+}
+
+// ToRPCCreateAccessReviewCampaignRequest 将 HTTP CreateAccessReviewCampaignRequestDTO 转换为 RPC CreateAccessReviewCampaignRequest
+func (a *accessReviewAssembler) ToRPCCreateAccessReviewCampaignRequest(
+	dto *identityModel.CreateAccessReviewCampaignRequestDTO,
+	operatorID string,
+) *identity_srv.CreateAccessReviewCampaignRequest {
+	if dto == nil {
+		return nil
+	}
+
+	return &identity_srv.CreateAccessReviewCampaignRequest{
+		Name:           dto.Name,
+		Description:    dto.Description,
+		OrganizationID: dto.OrganizationID,
+		RoleID:         dto.RoleID,
+		ReviewerID:     dto.ReviewerID,
+		DueAt:          dto.DueAt,
+		AutoRevoke:     dto.AutoRevoke,
+		CreatedBy:      &operatorID,
+	}
+}
+
+// ToRPCListAccessReviewCampaignsRequest 将 HTTP ListAccessReviewCampaignsRequestDTO 转换为 RPC ListAccessReviewCampaignsRequest
+func (a *accessReviewAssembler) ToRPCListAccessReviewCampaignsRequest(
+	dto *identityModel.ListAccessReviewCampaignsRequestDTO,
+) *identity_srv.ListAccessReviewCampaignsRequest {
+	if dto == nil {
+		return nil
+	}
+
+	req := &identity_srv.ListAccessReviewCampaignsRequest{
+		Page:           ToRPCPageRequest(dto.Page),
+		OrganizationID: dto.OrganizationID,
+	}
+
+	// 转换 status 从 int32 到 AccessReviewCampaignStatus 枚举，0 表示不过滤
+	if dto.Status != nil && *dto.Status != 0 {
+		status := identity_srv.AccessReviewCampaignStatus(*dto.Status)
+		req.Status = &status
+	}
+
+	return req
+}
+
+// ToHTTPListAccessReviewCampaignsResponse 将 RPC ListAccessReviewCampaignsResponse 转换为 HTTP ListAccessReviewCampaignsResponseDTO
+func (a *accessReviewAssembler) ToHTTPListAccessReviewCampaignsResponse(
+	rpc *identity_srv.ListAccessReviewCampaignsResponse,
+) *identityModel.ListAccessReviewCampaignsResponseDTO {
+	if rpc == nil {
+		return nil
+	}
+
+	campaigns := make([]*identityModel.AccessReviewCampaignDTO, 0, len(rpc.Campaigns))
+	for _, campaign := range rpc.Campaigns {
+		if dto := a.ToHTTPAccessReviewCampaign(campaign); dto != nil {
+			campaigns = append(campaigns, dto)
+		}
+	}
+
+	return &identityModel.ListAccessReviewCampaignsResponseDTO{
+		Campaigns: campaigns,
+		Page:      ToHTTPPageResponse(rpc.Page),
+	}
+}
+
+// ToRPCListAccessReviewItemsRequest 将 HTTP ListAccessReviewItemsRequestDTO 转换为 RPC ListAccessReviewItemsRequest
+func (a *accessReviewAssembler) ToRPCListAccessReviewItemsRequest(
+	dto *identityModel.ListAccessReviewItemsRequestDTO,
+) *identity_srv.ListAccessReviewItemsRequest {
+	if dto == nil {
+		return nil
+	}
+
+	req := &identity_srv.ListAccessReviewItemsRequest{
+		CampaignID: dto.CampaignID,
+		Page:       ToRPCPageRequest(dto.Page),
+		UserID:     dto.UserID,
+	}
+
+	// 转换 decision 从 int32 到 AccessReviewDecision 枚举，0 表示不过滤
+	if dto.Decision != nil && *dto.Decision != 0 {
+		decision := identity_srv.AccessReviewDecision(*dto.Decision)
+		req.Decision = &decision
+	}
+
+	return req
+}
+
+// ToHTTPListAccessReviewItemsResponse 将 RPC ListAccessReviewItemsResponse 转换为 HTTP ListAccessReviewItemsResponseDTO
+func (a *accessReviewAssembler) ToHTTPListAccessReviewItemsResponse(
+	rpc *identity_srv.ListAccessReviewItemsResponse,
+) *identityModel.ListAccessReviewItemsResponseDTO {
+	if rpc == nil {
+		return nil
+	}
+
+	items := make([]*identityModel.AccessReviewItemDTO, 0, len(rpc.Items))
+	for _, item := range rpc.Items {
+		if dto := a.ToHTTPAccessReviewItem(item); dto != nil {
+			items = append(items, dto)
+		}
+	}
+
+	return &identityModel.ListAccessReviewItemsResponseDTO{
+		Items: items,
+		Page:  ToHTTPPageResponse(rpc.Page),
+	}
+}
+
+// ToRPCDecideAccessReviewItemRequest 将 HTTP DecideAccessReviewItemRequestDTO 转换为 RPC DecideAccessReviewItemRequest
+func (a *accessReviewAssembler) ToRPCDecideAccessReviewItemRequest(
+	dto *identityModel.DecideAccessReviewItemRequestDTO,
+	reviewerID string,
+) *identity_srv.DecideAccessReviewItemRequest {
+	if dto == nil {
+		return nil
+	}
+
+	req := &identity_srv.DecideAccessReviewItemRequest{
+		ItemID:     dto.ItemID,
+		ReviewerID: &reviewerID,
+		Comment:    dto.Comment,
+	}
+
+	if dto.Decision != nil {
+		decision := identity_srv.AccessReviewDecision(*dto.Decision)
+		req.Decision = &decision
+	}
+
+	return req
+}
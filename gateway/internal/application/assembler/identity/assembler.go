@@ -11,13 +11,17 @@ import (
 
 // identityAssembler 身份管理聚合组装器实现
 type identityAssembler struct {
-	authAssembler       IAuthAssembler
-	userAssembler       IUserAssembler
-	orgAssembler        IOrgAssembler
-	departmentAssembler IDepartmentAssembler
-	membershipAssembler IMembershipAssembler
-	logoAssembler       ILogoAssembler
-	auditLogAssembler   IAuditLogAssembler
+	authAssembler             IAuthAssembler
+	userAssembler             IUserAssembler
+	orgAssembler              IOrgAssembler
+	departmentAssembler       IDepartmentAssembler
+	membershipAssembler       IMembershipAssembler
+	logoAssembler             ILogoAssembler
+	auditLogAssembler         IAuditLogAssembler
+	recycleBinAssembler       IRecycleBinAssembler
+	permissionReportAssembler IPermissionReportAssembler
+	roleGrantAssembler        IRoleGrantAssembler
+	accessReviewAssembler     IAccessReviewAssembler
 }
 
 // NewIdentityAggregateAssembler 创建身份管理聚合组装器
@@ -29,15 +33,23 @@ func NewIdentityAggregateAssembler(
 	membershipAssembler IMembershipAssembler,
 	logoAssembler ILogoAssembler,
 	auditLogAssembler IAuditLogAssembler,
+	recycleBinAssembler IRecycleBinAssembler,
+	permissionReportAssembler IPermissionReportAssembler,
+	roleGrantAssembler IRoleGrantAssembler,
+	accessReviewAssembler IAccessReviewAssembler,
 ) Assembler {
 	return &identityAssembler{
-		authAssembler:       authAssembler,
-		userAssembler:       userAssembler,
-		orgAssembler:        orgAssembler,
-		departmentAssembler: departmentAssembler,
-		membershipAssembler: membershipAssembler,
-		logoAssembler:       logoAssembler,
-		auditLogAssembler:   auditLogAssembler,
+		authAssembler:             authAssembler,
+		userAssembler:             userAssembler,
+		orgAssembler:              orgAssembler,
+		departmentAssembler:       departmentAssembler,
+		membershipAssembler:       membershipAssembler,
+		logoAssembler:             logoAssembler,
+		auditLogAssembler:         auditLogAssembler,
+		recycleBinAssembler:       recycleBinAssembler,
+		permissionReportAssembler: permissionReportAssembler,
+		roleGrantAssembler:        roleGrantAssembler,
+		accessReviewAssembler:     accessReviewAssembler,
 	}
 }
 
@@ -49,6 +61,14 @@ func (a *identityAssembler) Department() IDepartmentAssembler { return a.departm
 func (a *identityAssembler) Membership() IMembershipAssembler { return a.membershipAssembler }
 func (a *identityAssembler) Logo() ILogoAssembler             { return a.logoAssembler }
 func (a *identityAssembler) AuditLog() IAuditLogAssembler     { return a.auditLogAssembler }
+func (a *identityAssembler) RecycleBin() IRecycleBinAssembler { return a.recycleBinAssembler }
+func (a *identityAssembler) PermissionReport() IPermissionReportAssembler {
+	return a.permissionReportAssembler
+}
+func (a *identityAssembler) RoleGrant() IRoleGrantAssembler { return a.roleGrantAssembler }
+func (a *identityAssembler) AccessReview() IAccessReviewAssembler {
+	return a.accessReviewAssembler
+}
 
 // 通用转换方法
 func (a *identityAssembler) ToHTTPPageResponse(
@@ -23,6 +23,7 @@ func (a *authAssembler) ToRPCLoginRequest(
 	return &identity_srv.LoginRequest{
 		Username: dto.Username,
 		Password: dto.Password,
+		ClientID: dto.ClientID,
 	}
 }
 
@@ -68,6 +69,37 @@ func (a *authAssembler) ToRPCResetPasswordRequest(
 	}
 }
 
+// ToRPCRequestPasswordResetRequest converts an HTTP RequestPasswordResetRequestDTO to an RPC RequestPasswordResetRequest.
+func (a *authAssembler) ToRPCRequestPasswordResetRequest(
+	dto *identity.RequestPasswordResetRequestDTO,
+	clientIP string,
+) *identity_srv.RequestPasswordResetRequest {
+	if dto == nil {
+		return nil
+	}
+
+	return &identity_srv.RequestPasswordResetRequest{
+		Identifier: dto.Identifier,
+		ClientIP:   &clientIP,
+	}
+}
+
+// ToRPCConfirmPasswordResetRequest converts an HTTP ConfirmPasswordResetRequestDTO to an RPC ConfirmPasswordResetRequest.
+func (a *authAssembler) ToRPCConfirmPasswordResetRequest(
+	dto *identity.ConfirmPasswordResetRequestDTO,
+	clientIP string,
+) *identity_srv.ConfirmPasswordResetRequest {
+	if dto == nil {
+		return nil
+	}
+
+	return &identity_srv.ConfirmPasswordResetRequest{
+		Token:       dto.Token,
+		NewPassword: dto.NewPassword,
+		ClientIP:    &clientIP,
+	}
+}
+
 // ToRPCForcePasswordChangeRequest converts an HTTP ForcePasswordChangeRequestDTO to an RPC ForcePasswordChangeRequest.
 func (a *authAssembler) ToRPCForcePasswordChangeRequest(
 	dto *identity.ForcePasswordChangeRequestDTO,
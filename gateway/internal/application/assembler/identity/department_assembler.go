@@ -35,6 +35,7 @@ func (a *departmentAssembler) ToHTTPDepartment(
 		// 审计字段
 		CreatedAt: common.CopyInt64Ptr(rpc.CreatedAt),
 		UpdatedAt: common.CopyInt64Ptr(rpc.UpdatedAt),
+		Version:   common.CopyInt64Ptr(rpc.Version),
 		// Organization:       rpc.Organization,
 		// MemberCount:        rpc.MemberCount,
 	}
@@ -84,6 +85,7 @@ func (a *departmentAssembler) ToRPCUpdateDeptRequest(
 
 	req := &identity_srv.UpdateDepartmentRequest{
 		DepartmentID: dto.DepartmentID,
+		Version:      common.ResolveVersion(dto.Version, dto.IfMatch),
 	}
 
 	if dto.Name != nil {
@@ -132,5 +134,6 @@ func (a *departmentAssembler) ToHTTPListDeptsResponse(
 	return &identity.GetOrganizationDepartmentsResponseDTO{
 		Departments: a.ToHTTPDepartments(rpc.Departments),
 		Page:        ToHTTPPageResponse(rpc.Page),
+		Highlights:  ToHTTPSearchHighlights(rpc.Highlights),
 	}
 }
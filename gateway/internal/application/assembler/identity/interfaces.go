@@ -19,6 +19,10 @@ type Assembler interface {
 	Membership() IMembershipAssembler
 	Logo() ILogoAssembler
 	AuditLog() IAuditLogAssembler
+	RecycleBin() IRecycleBinAssembler
+	PermissionReport() IPermissionReportAssembler
+	RoleGrant() IRoleGrantAssembler
+	AccessReview() IAccessReviewAssembler
 
 	// 通用转换方法（避免重复代码）
 	ToHTTPPageResponse(*rpc_base.PageResponse) *http_base.PageResponseDTO
@@ -37,6 +41,14 @@ type IAuthAssembler interface {
 	ToRPCForcePasswordChangeRequest(
 		*identityModel.ForcePasswordChangeRequestDTO,
 	) *identity_srv.ForcePasswordChangeRequest
+	ToRPCRequestPasswordResetRequest(
+		dto *identityModel.RequestPasswordResetRequestDTO,
+		clientIP string,
+	) *identity_srv.RequestPasswordResetRequest
+	ToRPCConfirmPasswordResetRequest(
+		dto *identityModel.ConfirmPasswordResetRequestDTO,
+		clientIP string,
+	) *identity_srv.ConfirmPasswordResetRequest
 	ToHTTPRoleInfos([]*identity_srv.RoleDefinition) []*identityModel.RoleInfoDTO
 }
 
@@ -79,6 +91,19 @@ type IUserAssembler interface {
 		*identityModel.ChangeUserStatusRequestDTO,
 	) *identity_srv.ChangeUserStatusRequest
 	ToRPCUnlockUserRequest(*identityModel.UnlockUserRequestDTO) *identity_srv.UnlockUserRequest
+	ToRPCImportUsersRequest(
+		dto *identityModel.ImportUsersRequestDTO,
+		operatorID string,
+	) *identity_srv.ImportUsersRequest
+	ToHTTPImportUsersResponse(*identity_srv.ImportUsersResponse) *identityModel.ImportUsersResponseDTO
+	ToRPCExportUsersRequest(*identityModel.ExportUsersRequestDTO) *identity_srv.ExportUsersRequest
+	ToHTTPUserRoleAssignments([]*identity_srv.UserRoleAssignment) []*identityModel.UserRoleAssignmentDTO
+	ToRPCListUserRoleAssignmentsRequest(
+		*identityModel.ListUserRoleAssignmentsRequestDTO,
+	) *identity_srv.UserRoleQueryRequest
+	ToHTTPListUserRoleAssignmentsResponse(
+		*identity_srv.UserRoleListResponse,
+	) *identityModel.ListUserRoleAssignmentsResponseDTO
 }
 
 type IOrgAssembler interface {
@@ -140,6 +165,91 @@ type IAuditLogAssembler interface {
 	) *identityModel.ListAuditLogsResponseDTO
 }
 
+type IRecycleBinAssembler interface {
+	ToRPCEntityType(string) *identity_srv.RecycleBinEntityType
+	ToHTTPDeletedEntity(*identity_srv.DeletedEntity) *identityModel.DeletedEntityDTO
+	ToRPCListDeletedEntitiesRequest(
+		*identityModel.ListDeletedEntitiesRequestDTO,
+	) *identity_srv.ListDeletedEntitiesRequest
+	ToHTTPListDeletedEntitiesResponse(
+		*identity_srv.ListDeletedEntitiesResponse,
+	) *identityModel.ListDeletedEntitiesResponseDTO
+	ToRPCRestoreDeletedEntityRequest(
+		*identityModel.DeletedEntityRequestDTO,
+	) *identity_srv.RestoreDeletedEntityRequest
+	ToHTTPRestoreDeletedEntityResponse(
+		*identity_srv.RestoreDeletedEntityResponse,
+	) *identityModel.RestoreDeletedEntityResponseDTO
+	ToRPCPurgeDeletedEntityRequest(
+		*identityModel.DeletedEntityRequestDTO,
+	) *identity_srv.PurgeDeletedEntityRequest
+}
+
+type IPermissionReportAssembler interface {
+	ToHTTPPermissionGrant(*identity_srv.PermissionGrant) *identityModel.PermissionGrantDTO
+	ToHTTPRolePermissions(*identity_srv.RolePermissions) *identityModel.RolePermissionsDTO
+	ToHTTPUserPermissions(*identity_srv.UserPermissions) *identityModel.UserPermissionsDTO
+	ToRPCGetPermissionMatrixRequest(
+		*identityModel.GetPermissionMatrixRequestDTO,
+	) *identity_srv.GetPermissionMatrixRequest
+	ToHTTPGetPermissionMatrixResponse(
+		*identity_srv.GetPermissionMatrixResponse,
+	) *identityModel.GetPermissionMatrixResponseDTO
+	ToRPCGetUserEffectivePermissionsRequest(
+		*identityModel.GetUserEffectivePermissionsRequestDTO,
+	) *identity_srv.GetUserEffectivePermissionsRequest
+	ToHTTPGetUserEffectivePermissionsResponse(
+		*identity_srv.GetUserEffectivePermissionsResponse,
+	) *identityModel.GetUserEffectivePermissionsResponseDTO
+	ToRPCExportPermissionReportRequest(
+		*identityModel.ExportPermissionReportRequestDTO,
+	) *identity_srv.ExportPermissionReportRequest
+}
+
+type IRoleGrantAssembler interface {
+	ToHTTPRoleGrantRequest(*identity_srv.RoleGrantRequest) *identityModel.RoleGrantRequestDTO
+	ToHTTPRoleGrantRequests([]*identity_srv.RoleGrantRequest) []*identityModel.RoleGrantRequestDTO
+	ToRPCListRoleGrantRequestsRequest(
+		*identityModel.ListRoleGrantRequestsRequestDTO,
+	) *identity_srv.ListRoleGrantRequestsRequest
+	ToHTTPListRoleGrantRequestsResponse(
+		*identity_srv.ListRoleGrantRequestsResponse,
+	) *identityModel.ListRoleGrantRequestsResponseDTO
+	ToRPCApproveRoleGrantRequest(
+		*identityModel.DecideRoleGrantRequestDTO,
+		string,
+	) *identity_srv.ApproveRoleGrantRequest
+	ToRPCRejectRoleGrantRequest(
+		*identityModel.DecideRoleGrantRequestDTO,
+		string,
+	) *identity_srv.RejectRoleGrantRequest
+}
+
+type IAccessReviewAssembler interface {
+	ToHTTPAccessReviewCampaign(*identity_srv.AccessReviewCampaign) *identityModel.AccessReviewCampaignDTO
+	ToHTTPAccessReviewItem(*identity_srv.AccessReviewItem) *identityModel.AccessReviewItemDTO
+	ToRPCCreateAccessReviewCampaignRequest(
+		*identityModel.CreateAccessReviewCampaignRequestDTO,
+		string,
+	) *identity_srv.CreateAccessReviewCampaignRequest
+	ToRPCListAccessReviewCampaignsRequest(
+		*identityModel.ListAccessReviewCampaignsRequestDTO,
+	) *identity_srv.ListAccessReviewCampaignsRequest
+	ToHTTPListAccessReviewCampaignsResponse(
+		*identity_srv.ListAccessReviewCampaignsResponse,
+	) *identityModel.ListAccessReviewCampaignsResponseDTO
+	ToRPCListAccessReviewItemsRequest(
+		*identityModel.ListAccessReviewItemsRequestDTO,
+	) *identity_srv.ListAccessReviewItemsRequest
+	ToHTTPListAccessReviewItemsResponse(
+		*identity_srv.ListAccessReviewItemsResponse,
+	) *identityModel.ListAccessReviewItemsResponseDTO
+	ToRPCDecideAccessReviewItemRequest(
+		*identityModel.DecideAccessReviewItemRequestDTO,
+		string,
+	) *identity_srv.DecideAccessReviewItemRequest
+}
+
 // ToHTTPPageResponse is a generic function to convert RPC PageResponse to HTTP PageResponseDTO.
 func ToHTTPPageResponse(rpc *rpc_base.PageResponse) *http_base.PageResponseDTO {
 	if rpc == nil {
@@ -153,6 +263,7 @@ func ToHTTPPageResponse(rpc *rpc_base.PageResponse) *http_base.PageResponseDTO {
 		TotalPages: rpc.TotalPages,
 		HasNext:    rpc.HasNext,
 		HasPrev:    rpc.HasPrev,
+		NextCursor: rpc.NextCursor,
 	}
 }
 
@@ -169,6 +280,7 @@ func ToRPCPageRequest(http *http_base.PageRequestDTO) *rpc_base.PageRequest {
 		Sort:         http.Sort,
 		IncludeTotal: http.IncludeTotal,
 		FetchAll:     http.FetchAll,
+		Cursor:       http.Cursor,
 	}
 
 	if len(http.Filter) > 0 {
@@ -181,3 +293,29 @@ func ToRPCPageRequest(http *http_base.PageRequestDTO) *rpc_base.PageRequest {
 
 	return req
 }
+
+// ToHTTPSearchHighlights is a generic function to convert RPC search highlights to HTTP SearchHighlightDTOs.
+func ToHTTPSearchHighlights(rpc []*identity_srv.SearchHighlight) []*identityModel.SearchHighlightDTO {
+	if len(rpc) == 0 {
+		return nil
+	}
+
+	highlights := make([]*identityModel.SearchHighlightDTO, 0, len(rpc))
+	for _, h := range rpc {
+		if h == nil {
+			continue
+		}
+
+		highlights = append(highlights, &identityModel.SearchHighlightDTO{
+			Id:     h.Id,
+			Field:  h.Field,
+			Value:  h.Value,
+			Start:  h.Start,
+			End:    h.End,
+			Rank:   h.Rank,
+			Pinyin: h.Pinyin,
+		})
+	}
+
+	return highlights
+}
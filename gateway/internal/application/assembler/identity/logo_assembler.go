@@ -38,6 +38,7 @@ func (a *logoAssembler) ToHTTPOrganizationLogo(
 		ExpiresAt: common.CopyInt64Ptr(rpc.ExpiresAt),
 		CreatedAt: common.CopyInt64Ptr(rpc.CreatedAt),
 		UpdatedAt: common.CopyInt64Ptr(rpc.UpdatedAt),
+		Version:   common.CopyInt64Ptr(rpc.Version),
 
 		// 下载URL（预签名）
 		DownloadUrl: common.CopyStringPtr(rpc.DownloadUrl),
@@ -34,6 +34,7 @@ func (a *membershipAssembler) ToHTTPUserMembership(
 		// 审计字段
 		CreatedAt: common.CopyInt64Ptr(rpc.CreatedAt),
 		UpdatedAt: common.CopyInt64Ptr(rpc.UpdatedAt),
+		Version:   common.CopyInt64Ptr(rpc.Version),
 		// Organization:   NewOrgAssembler().ToHTTPOrganization(rpc.Organization),
 		// Department:     NewOrgAssembler().ToHTTPDepartment(rpc.Department),
 	}
@@ -35,10 +35,12 @@ func (a *orgAssembler) ToHTTPOrganization(
 		Logo:                common.CopyStringPtr(rpc.Logo),
 		LogoID:              common.CopyStringPtr(rpc.LogoID),
 		ProvinceCity:        common.CopyStringSlice(rpc.ProvinceCity),
+		MfaRequired:         rpc.MfaRequired,
 
 		// 审计字段
 		CreatedAt: common.CopyInt64Ptr(rpc.CreatedAt),
 		UpdatedAt: common.CopyInt64Ptr(rpc.UpdatedAt),
+		Version:   common.CopyInt64Ptr(rpc.Version),
 	}
 }
 
@@ -85,6 +87,10 @@ func (a *orgAssembler) ToRPCCreateOrgRequest(
 		req.ProvinceCity = &core.StringListValue{Items: dto.ProvinceCity}
 	}
 
+	if dto.MfaRequired != nil {
+		req.MfaRequired = dto.MfaRequired
+	}
+
 	return req
 }
 
@@ -109,6 +115,7 @@ func (a *orgAssembler) ToRPCUpdateOrgRequest(
 
 	req := &identity_srv.UpdateOrganizationRequest{
 		OrganizationID: dto.OrganizationID,
+		Version:        common.ResolveVersion(dto.Version, dto.IfMatch),
 	}
 
 	if dto.Name != nil {
@@ -140,6 +147,10 @@ func (a *orgAssembler) ToRPCUpdateOrgRequest(
 		}
 	}
 
+	if dto.MfaRequired != nil {
+		req.MfaRequired = dto.MfaRequired
+	}
+
 	return req
 }
 
@@ -166,5 +177,6 @@ func (a *orgAssembler) ToHTTPListOrgsResponse(
 	return &identity.ListOrganizationsResponseDTO{
 		Organizations: a.ToHTTPOrganizations(rpc.Organizations),
 		Page:          ToHTTPPageResponse(rpc.Page),
+		Highlights:    ToHTTPSearchHighlights(rpc.Highlights),
 	}
 }
@@ -0,0 +1,219 @@
+package identity
+
+import (
+	"strings"
+
+	identityModel "github.com/masonsxu/cloudwego-microservice-demo/gateway/biz/model/identity"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/application/assembler/common"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+)
+
+// permissionGrantSources RPC 授权来源枚举与 HTTP 字符串的对应关系
+var permissionGrantSources = map[identity_srv.PermissionGrantSource]string{
+	identity_srv.PermissionGrantSource_PERMISSION_GRANT_SOURCE_MENU:   "menu",
+	identity_srv.PermissionGrantSource_PERMISSION_GRANT_SOURCE_POLICY: "policy",
+}
+
+// permissionReportViews HTTP 报告视图与 RPC 枚举的对应关系
+var permissionReportViews = map[string]identity_srv.PermissionReportView{
+	"role": identity_srv.PermissionReportView_PERMISSION_REPORT_VIEW_ROLE,
+	"user": identity_srv.PermissionReportView_PERMISSION_REPORT_VIEW_USER,
+}
+
+// permissionReportAssembler 权限报告组装器实现
+type permissionReportAssembler struct{}
+
+// NewPermissionReportAssembler 创建权限报告组装器
+func NewPermissionReportAssembler() IPermissionReportAssembler {
+	return &permissionReportAssembler{}
+}
+
+// ToHTTPPermissionGrant 将 RPC PermissionGrant 转换为 HTTP PermissionGrantDTO
+func (a *permissionReportAssembler) ToHTTPPermissionGrant(
+	rpc *identity_srv.PermissionGrant,
+) *identityModel.PermissionGrantDTO {
+	if rpc == nil {
+		return nil
+	}
+
+	dto := &identityModel.PermissionGrantDTO{
+		MenuID:    common.CopyStringPtr(rpc.MenuID),
+		MenuName:  common.CopyStringPtr(rpc.MenuName),
+		Resource:  common.CopyStringPtr(rpc.Resource),
+		Action:    common.CopyStringPtr(rpc.Action),
+		DataScope: common.CopyStringPtr(rpc.DataScope),
+	}
+
+	if source, ok := permissionGrantSources[rpc.GetSource()]; ok {
+		dto.Source = &source
+	}
+
+	if rpc.Permission != nil &&
+		rpc.GetPermission() != identity_srv.PermissionLevel_PERMISSION_LEVEL_UNSPECIFIED {
+		permission := strings.ToLower(
+			strings.TrimPrefix(rpc.GetPermission().String(), "PERMISSION_LEVEL_"),
+		)
+		dto.Permission = &permission
+	}
+
+	return dto
+}
+
+// ToHTTPRolePermissions 将 RPC RolePermissions 转换为 HTTP RolePermissionsDTO
+func (a *permissionReportAssembler) ToHTTPRolePermissions(
+	rpc *identity_srv.RolePermissions,
+) *identityModel.RolePermissionsDTO {
+	if rpc == nil {
+		return nil
+	}
+
+	return &identityModel.RolePermissionsDTO{
+		RoleID:           common.CopyStringPtr(rpc.RoleID),
+		RoleName:         common.CopyStringPtr(rpc.RoleName),
+		RoleCode:         common.CopyStringPtr(rpc.RoleCode),
+		FullAccess:       common.CopyBoolPtr(rpc.FullAccess),
+		Grants:           a.toHTTPPermissionGrants(rpc.Grants),
+		GlobalAssignment: common.CopyBoolPtr(rpc.GlobalAssignment),
+	}
+}
+
+// ToHTTPUserPermissions 将 RPC UserPermissions 转换为 HTTP UserPermissionsDTO
+func (a *permissionReportAssembler) ToHTTPUserPermissions(
+	rpc *identity_srv.UserPermissions,
+) *identityModel.UserPermissionsDTO {
+	if rpc == nil {
+		return nil
+	}
+
+	tenants := make([]*identityModel.TenantPermissionsDTO, 0, len(rpc.Tenants))
+	for _, tenant := range rpc.Tenants {
+		if tenant == nil {
+			continue
+		}
+
+		roles := make([]*identityModel.RolePermissionsDTO, 0, len(tenant.Roles))
+		for _, role := range tenant.Roles {
+			if dto := a.ToHTTPRolePermissions(role); dto != nil {
+				roles = append(roles, dto)
+			}
+		}
+
+		tenants = append(tenants, &identityModel.TenantPermissionsDTO{
+			OrganizationID:   common.CopyStringPtr(tenant.OrganizationID),
+			OrganizationName: common.CopyStringPtr(tenant.OrganizationName),
+			Roles:            roles,
+			DirectGrants:     a.toHTTPPermissionGrants(tenant.DirectGrants),
+		})
+	}
+
+	return &identityModel.UserPermissionsDTO{
+		UserID:     common.CopyStringPtr(rpc.UserID),
+		Username:   common.CopyStringPtr(rpc.Username),
+		RealName:   common.CopyStringPtr(rpc.RealName),
+		BreakGlass: common.CopyBoolPtr(rpc.BreakGlass),
+		Tenants:    tenants,
+	}
+}
+
+// ToRPCGetPermissionMatrixRequest 将 HTTP GetPermissionMatrixRequestDTO 转换为 RPC 请求
+func (a *permissionReportAssembler) ToRPCGetPermissionMatrixRequest(
+	dto *identityModel.GetPermissionMatrixRequestDTO,
+) *identity_srv.GetPermissionMatrixRequest {
+	if dto == nil {
+		return nil
+	}
+
+	return &identity_srv.GetPermissionMatrixRequest{
+		ProductLine:    common.CopyStringPtr(dto.ProductLine),
+		RoleIDs:        common.CopyStringSlice(dto.RoleIDs),
+		OrganizationID: common.CopyStringPtr(dto.OrganizationID),
+	}
+}
+
+// ToHTTPGetPermissionMatrixResponse 将 RPC 权限矩阵响应转换为 HTTP 响应
+func (a *permissionReportAssembler) ToHTTPGetPermissionMatrixResponse(
+	rpc *identity_srv.GetPermissionMatrixResponse,
+) *identityModel.GetPermissionMatrixResponseDTO {
+	if rpc == nil {
+		return nil
+	}
+
+	roles := make([]*identityModel.RolePermissionsDTO, 0, len(rpc.Roles))
+	for _, role := range rpc.Roles {
+		if dto := a.ToHTTPRolePermissions(role); dto != nil {
+			roles = append(roles, dto)
+		}
+	}
+
+	return &identityModel.GetPermissionMatrixResponseDTO{
+		ProductLine: common.CopyStringPtr(rpc.ProductLine),
+		MenuVersion: common.CopyInt32Ptr(rpc.MenuVersion),
+		Roles:       roles,
+	}
+}
+
+// ToRPCGetUserEffectivePermissionsRequest 将 HTTP 用户有效权限请求转换为 RPC 请求
+func (a *permissionReportAssembler) ToRPCGetUserEffectivePermissionsRequest(
+	dto *identityModel.GetUserEffectivePermissionsRequestDTO,
+) *identity_srv.GetUserEffectivePermissionsRequest {
+	if dto == nil {
+		return nil
+	}
+
+	return &identity_srv.GetUserEffectivePermissionsRequest{
+		UserID:         common.CopyStringPtr(dto.UserID),
+		ProductLine:    common.CopyStringPtr(dto.ProductLine),
+		OrganizationID: common.CopyStringPtr(dto.OrganizationID),
+	}
+}
+
+// ToHTTPGetUserEffectivePermissionsResponse 将 RPC 用户有效权限响应转换为 HTTP 响应
+func (a *permissionReportAssembler) ToHTTPGetUserEffectivePermissionsResponse(
+	rpc *identity_srv.GetUserEffectivePermissionsResponse,
+) *identityModel.GetUserEffectivePermissionsResponseDTO {
+	if rpc == nil {
+		return nil
+	}
+
+	return &identityModel.GetUserEffectivePermissionsResponseDTO{
+		ProductLine: common.CopyStringPtr(rpc.ProductLine),
+		User:        a.ToHTTPUserPermissions(rpc.User),
+	}
+}
+
+// ToRPCExportPermissionReportRequest 将 HTTP 权限报告导出请求转换为 RPC 请求
+func (a *permissionReportAssembler) ToRPCExportPermissionReportRequest(
+	dto *identityModel.ExportPermissionReportRequestDTO,
+) *identity_srv.ExportPermissionReportRequest {
+	if dto == nil {
+		return nil
+	}
+
+	req := &identity_srv.ExportPermissionReportRequest{
+		Format:         toRPCUserFileFormat(dto.Format),
+		ProductLine:    common.CopyStringPtr(dto.ProductLine),
+		RoleIDs:        common.CopyStringSlice(dto.RoleIDs),
+		UserIDs:        common.CopyStringSlice(dto.UserIDs),
+		OrganizationID: common.CopyStringPtr(dto.OrganizationID),
+	}
+
+	if dto.View != nil {
+		view := permissionReportViews[*dto.View]
+		req.View = &view
+	}
+
+	return req
+}
+
+func (a *permissionReportAssembler) toHTTPPermissionGrants(
+	grants []*identity_srv.PermissionGrant,
+) []*identityModel.PermissionGrantDTO {
+	dtos := make([]*identityModel.PermissionGrantDTO, 0, len(grants))
+	for _, grant := range grants {
+		if dto := a.ToHTTPPermissionGrant(grant); dto != nil {
+			dtos = append(dtos, dto)
+		}
+	}
+
+	return dtos
+}
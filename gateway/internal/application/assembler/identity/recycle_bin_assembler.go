@@ -0,0 +1,135 @@
+package identity
+
+import (
+	identityModel "github.com/masonsxu/cloudwego-microservice-demo/gateway/biz/model/identity"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/application/assembler/common"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+)
+
+// recycleBinEntityTypes HTTP 路径中的回收站类型与 RPC 枚举的对应关系
+var recycleBinEntityTypes = map[string]identity_srv.RecycleBinEntityType{
+	"users":         identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_USER,
+	"organizations": identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_ORGANIZATION,
+	"departments":   identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_DEPARTMENT,
+}
+
+// recycleBinAssembler 回收站组装器实现
+type recycleBinAssembler struct{}
+
+// NewRecycleBinAssembler 创建回收站组装器
+func NewRecycleBinAssembler() IRecycleBinAssembler {
+	return &recycleBinAssembler{}
+}
+
+// ToRPCEntityType 将 HTTP 路径中的回收站类型转换为 RPC 枚举，未知类型返回 UNSPECIFIED
+func (a *recycleBinAssembler) ToRPCEntityType(entityType string) *identity_srv.RecycleBinEntityType {
+	t := recycleBinEntityTypes[entityType]
+	return &t
+}
+
+// ToHTTPDeletedEntity 将 RPC DeletedEntity 转换为 HTTP DeletedEntityDTO
+func (a *recycleBinAssembler) ToHTTPDeletedEntity(
+	rpc *identity_srv.DeletedEntity,
+) *identityModel.DeletedEntityDTO {
+	if rpc == nil {
+		return nil
+	}
+
+	dto := &identityModel.DeletedEntityDTO{
+		Id:             common.CopyStringPtr(rpc.Id),
+		Name:           common.CopyStringPtr(rpc.Name),
+		Code:           common.CopyStringPtr(rpc.Code),
+		OrganizationID: common.CopyStringPtr(rpc.OrganizationID),
+		DeletedAt:      common.CopyInt64Ptr(rpc.DeletedAt),
+		PurgeAt:        common.CopyInt64Ptr(rpc.PurgeAt),
+	}
+
+	for name, t := range recycleBinEntityTypes {
+		if t == rpc.GetEntityType() {
+			entityType := name
+			dto.EntityType = &entityType
+
+			break
+		}
+	}
+
+	return dto
+}
+
+// ToRPCListDeletedEntitiesRequest 将 HTTP ListDeletedEntitiesRequestDTO 转换为 RPC 请求
+func (a *recycleBinAssembler) ToRPCListDeletedEntitiesRequest(
+	dto *identityModel.ListDeletedEntitiesRequestDTO,
+) *identity_srv.ListDeletedEntitiesRequest {
+	if dto == nil {
+		return nil
+	}
+
+	return &identity_srv.ListDeletedEntitiesRequest{
+		EntityType: a.ToRPCEntityType(dto.GetEntityType()),
+		Page:       ToRPCPageRequest(dto.Page),
+	}
+}
+
+// ToHTTPListDeletedEntitiesResponse 将 RPC ListDeletedEntitiesResponse 转换为 HTTP 响应
+func (a *recycleBinAssembler) ToHTTPListDeletedEntitiesResponse(
+	rpc *identity_srv.ListDeletedEntitiesResponse,
+) *identityModel.ListDeletedEntitiesResponseDTO {
+	if rpc == nil {
+		return nil
+	}
+
+	entities := make([]*identityModel.DeletedEntityDTO, 0, len(rpc.Entities))
+	for _, entity := range rpc.Entities {
+		if dto := a.ToHTTPDeletedEntity(entity); dto != nil {
+			entities = append(entities, dto)
+		}
+	}
+
+	return &identityModel.ListDeletedEntitiesResponseDTO{
+		Entities: entities,
+		Page:     ToHTTPPageResponse(rpc.Page),
+	}
+}
+
+// ToRPCRestoreDeletedEntityRequest 将 HTTP DeletedEntityRequestDTO 转换为 RPC 恢复请求
+func (a *recycleBinAssembler) ToRPCRestoreDeletedEntityRequest(
+	dto *identityModel.DeletedEntityRequestDTO,
+) *identity_srv.RestoreDeletedEntityRequest {
+	if dto == nil {
+		return nil
+	}
+
+	return &identity_srv.RestoreDeletedEntityRequest{
+		EntityType: a.ToRPCEntityType(dto.GetEntityType()),
+		Id:         dto.Id,
+	}
+}
+
+// ToHTTPRestoreDeletedEntityResponse 将 RPC RestoreDeletedEntityResponse 转换为 HTTP 响应
+func (a *recycleBinAssembler) ToHTTPRestoreDeletedEntityResponse(
+	rpc *identity_srv.RestoreDeletedEntityResponse,
+) *identityModel.RestoreDeletedEntityResponseDTO {
+	if rpc == nil {
+		return &identityModel.RestoreDeletedEntityResponseDTO{}
+	}
+
+	return &identityModel.RestoreDeletedEntityResponseDTO{
+		RestoredRoleAssignments: common.CopyInt32Ptr(rpc.RestoredRoleAssignments),
+		RestoredMemberships:     common.CopyInt32Ptr(rpc.RestoredMemberships),
+		SkippedRoleCodes:        rpc.SkippedRoleCodes,
+	}
+}
+
+// ToRPCPurgeDeletedEntityRequest 将 HTTP DeletedEntityRequestDTO 转换为 RPC 彻底删除请求
+func (a *recycleBinAssembler) ToRPCPurgeDeletedEntityRequest(
+	dto *identityModel.DeletedEntityRequestDTO,
+) *identity_srv.PurgeDeletedEntityRequest {
+	if dto == nil {
+		return nil
+	}
+
+	return &identity_srv.PurgeDeletedEntityRequest{
+		EntityType: a.ToRPCEntityType(dto.GetEntityType()),
+		Id:         dto.Id,
+	}
+}
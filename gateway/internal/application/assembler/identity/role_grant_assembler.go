@@ -0,0 +1,135 @@
+package identity
+
+import (
+	identityModel "github.com/masonsxu/cloudwego-microservice-demo/gateway/biz/model/identity"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/application/assembler/common"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+)
+
+// roleGrantAssembler 角色授权申请组装器实现
+type roleGrantAssembler struct{}
+
+// NewRoleGrantAssembler 创建角色授权申请组装器
+func NewRoleGrantAssembler() IRoleGrantAssembler {
+	return &roleGrantAssembler{}
+}
+
+// ToHTTPRoleGrantRequest 将 RPC RoleGrantRequest 转换为 HTTP RoleGrantRequestDTO
+func (a *roleGrantAssembler) ToHTTPRoleGrantRequest(
+	rpc *identity_srv.RoleGrantRequest,
+) *identityModel.RoleGrantRequestDTO {
+	if rpc == nil {
+		return nil
+	}
+
+	dto := &identityModel.RoleGrantRequestDTO{
+		Id:              common.CopyStringPtr(rpc.Id),
+		UserID:          common.CopyStringPtr(rpc.UserID),
+		RoleID:          common.CopyStringPtr(rpc.RoleID),
+		RoleCode:        common.CopyStringPtr(rpc.RoleCode),
+		RoleName:        common.CopyStringPtr(rpc.RoleName),
+		OrganizationID:  common.CopyStringPtr(rpc.OrganizationID),
+		ValidFrom:       common.CopyInt64Ptr(rpc.ValidFrom),
+		ValidUntil:      common.CopyInt64Ptr(rpc.ValidUntil),
+		RequestedBy:     common.CopyStringPtr(rpc.RequestedBy),
+		Reason:          common.CopyStringPtr(rpc.Reason),
+		DecidedBy:       common.CopyStringPtr(rpc.DecidedBy),
+		DecidedAt:       common.CopyInt64Ptr(rpc.DecidedAt),
+		DecisionComment: common.CopyStringPtr(rpc.DecisionComment),
+		ExpiresAt:       common.CopyInt64Ptr(rpc.ExpiresAt),
+		AssignmentID:    common.CopyStringPtr(rpc.AssignmentID),
+		CreatedAt:       common.CopyInt64Ptr(rpc.CreatedAt),
+	}
+
+	// 转换枚举类型 Status 为 int32
+	if rpc.Status != nil {
+		status := int32(*rpc.Status)
+		dto.Status = &status
+	}
+
+	return dto
+}
+
+// ToHTTPRoleGrantRequests 批量转换 RPC RoleGrantRequest 到 HTTP RoleGrantRequestDTO
+func (a *roleGrantAssembler) ToHTTPRoleGrantRequests(
+	rpcs []*identity_srv.RoleGrantRequest,
+) []*identityModel.RoleGrantRequestDTO {
+	dtos := make([]*identityModel.RoleGrantRequestDTO, 0, len(rpcs))
+	for _, rpc := range rpcs {
+		if dto := a.ToHTTPRoleGrantRequest(rpc); dto != nil {
+			dtos = append(dtos, dto)
+		}
+	}
+
+	return dtos
+}
+
+// ToRPCListRoleGrantRequestsRequest 将 HTTP ListRoleGrantRequestsRequestDTO 转换为 RPC ListRoleGrantRequestsRequest
+func (a *roleGrantAssembler) ToRPCListRoleGrantRequestsRequest(
+	dto *identityModel.ListRoleGrantRequestsRequestDTO,
+) *identity_srv.ListRoleGrantRequestsRequest {
+	if dto == nil {
+		return nil
+	}
+
+	req := &identity_srv.ListRoleGrantRequestsRequest{
+		Page:        ToRPCPageRequest(dto.Page),
+		UserID:      dto.UserID,
+		RoleID:      dto.RoleID,
+		RequestedBy: dto.RequestedBy,
+	}
+
+	// 转换 status 从 int32 到 RoleGrantRequestStatus 枚举，0 表示不过滤
+	if dto.Status != nil && *dto.Status != 0 {
+		status := identity_srv.RoleGrantRequestStatus(*dto.Status)
+		req.Status = &status
+	}
+
+	return req
+}
+
+// ToHTTPListRoleGrantRequestsResponse 将 RPC ListRoleGrantRequestsResponse 转换为 HTTP ListRoleGrantRequestsResponseDTO
+func (a *roleGrantAssembler) ToHTTPListRoleGrantRequestsResponse(
+	rpc *identity_srv.ListRoleGrantRequestsResponse,
+) *identityModel.ListRoleGrantRequestsResponseDTO {
+	if rpc == nil {
+		return nil
+	}
+
+	return &identityModel.ListRoleGrantRequestsResponseDTO{
+		Requests: a.ToHTTPRoleGrantRequests(rpc.Requests),
+		Page:     ToHTTPPageResponse(rpc.Page),
+	}
+}
+
+// ToRPCApproveRoleGrantRequest 将 HTTP DecideRoleGrantRequestDTO 转换为 RPC ApproveRoleGrantRequest
+func (a *roleGrantAssembler) ToRPCApproveRoleGrantRequest(
+	dto *identityModel.DecideRoleGrantRequestDTO,
+	approverID string,
+) *identity_srv.ApproveRoleGrantRequest {
+	if dto == nil {
+		return nil
+	}
+
+	return &identity_srv.ApproveRoleGrantRequest{
+		RequestID:  dto.RequestID,
+		ApproverID: &approverID,
+		Comment:    dto.Comment,
+	}
+}
+
+// ToRPCRejectRoleGrantRequest 将 HTTP DecideRoleGrantRequestDTO 转换为 RPC RejectRoleGrantRequest
+func (a *roleGrantAssembler) ToRPCRejectRoleGrantRequest(
+	dto *identityModel.DecideRoleGrantRequestDTO,
+	approverID string,
+) *identity_srv.RejectRoleGrantRequest {
+	if dto == nil {
+		return nil
+	}
+
+	return &identity_srv.RejectRoleGrantRequest{
+		RequestID:  dto.RequestID,
+		ApproverID: &approverID,
+		Comment:    dto.Comment,
+	}
+}
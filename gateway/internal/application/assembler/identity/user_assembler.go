@@ -45,6 +45,7 @@ func (a *userAssembler) ToHTTPUserProfile(
 		// 审计字段
 		CreatedAt:     common.CopyInt64Ptr(rpc.CreatedAt),
 		UpdatedAt:     common.CopyInt64Ptr(rpc.UpdatedAt),
+		Version:       common.CopyInt64Ptr(rpc.Version),
 		CreatedBy:     common.CopyStringPtr(rpc.CreatedBy),
 		UpdatedBy:     common.CopyStringPtr(rpc.UpdatedBy),
 		LastLoginTime: common.CopyInt64Ptr(rpc.LastLoginTime),
@@ -81,8 +82,9 @@ func (a *userAssembler) ToRPCCreateUserRequest(
 	}
 
 	req := &identity_srv.CreateUserRequest{
-		Username: dto.Username,
-		Password: dto.Password,
+		Username:       dto.Username,
+		Password:       dto.Password,
+		OrganizationID: dto.OrganizationID,
 	}
 
 	if dto.Email != nil {
@@ -149,7 +151,8 @@ func (a *userAssembler) ToRPCUpdateUserRequest(
 	}
 
 	req := &identity_srv.UpdateUserRequest{
-		UserID: dto.UserID,
+		UserID:  dto.UserID,
+		Version: common.ResolveVersion(dto.Version, dto.IfMatch),
 	}
 
 	if dto.Email != nil {
@@ -202,6 +205,7 @@ func (a *userAssembler) ToRPCUpdateMeRequest(
 
 	req := &identity_srv.UpdateUserRequest{
 		// UserID 将在 handler 层从上下文中获取并设置
+		Version: common.ResolveVersion(dto.Version, dto.IfMatch),
 	}
 
 	if dto.Email != nil {
@@ -279,8 +283,69 @@ func (a *userAssembler) ToHTTPListUsersResponse(
 	}
 
 	return &identityModel.ListUsersResponseDTO{
-		Users: a.ToHTTPUserProfiles(rpc.Users),
-		Page:  ToHTTPPageResponse(rpc.Page),
+		Users:      a.ToHTTPUserProfiles(rpc.Users),
+		Page:       ToHTTPPageResponse(rpc.Page),
+		Highlights: ToHTTPSearchHighlights(rpc.Highlights),
+	}
+}
+
+func (a *userAssembler) ToHTTPUserRoleAssignments(
+	rpc []*identity_srv.UserRoleAssignment,
+) []*identityModel.UserRoleAssignmentDTO {
+	if rpc == nil {
+		return nil
+	}
+
+	assignments := make([]*identityModel.UserRoleAssignmentDTO, 0, len(rpc))
+	for _, item := range rpc {
+		if item == nil {
+			continue
+		}
+
+		assignments = append(assignments, &identityModel.UserRoleAssignmentDTO{
+			Id:             item.Id,
+			UserID:         item.UserID,
+			RoleID:         item.RoleID,
+			OrganizationID: item.OrganizationID,
+			ValidFrom:      item.ValidFrom,
+			ValidUntil:     item.ValidUntil,
+			CreatedBy:      item.CreatedBy,
+			UpdatedBy:      item.UpdatedBy,
+			CreatedAt:      item.CreatedAt,
+			UpdatedAt:      item.UpdatedAt,
+			Version:        item.Version,
+		})
+	}
+
+	return assignments
+}
+
+func (a *userAssembler) ToRPCListUserRoleAssignmentsRequest(
+	dto *identityModel.ListUserRoleAssignmentsRequestDTO,
+) *identity_srv.UserRoleQueryRequest {
+	if dto == nil {
+		return nil
+	}
+
+	return &identity_srv.UserRoleQueryRequest{
+		Page:                  ToRPCPageRequest(dto.Page),
+		UserID:                dto.UserID,
+		RoleID:                dto.RoleID,
+		OrganizationID:        dto.OrganizationID,
+		ExpiringWithinSeconds: dto.ExpiringWithinSeconds,
+	}
+}
+
+func (a *userAssembler) ToHTTPListUserRoleAssignmentsResponse(
+	rpc *identity_srv.UserRoleListResponse,
+) *identityModel.ListUserRoleAssignmentsResponseDTO {
+	if rpc == nil {
+		return nil
+	}
+
+	return &identityModel.ListUserRoleAssignmentsResponseDTO{
+		Assignments: a.ToHTTPUserRoleAssignments(rpc.Assignments),
+		Page:        ToHTTPPageResponse(rpc.Page),
 	}
 }
 
@@ -305,8 +370,9 @@ func (a *userAssembler) ToHTTPSearchUsersResponse(
 	}
 
 	return &identityModel.SearchUsersResponseDTO{
-		Users: a.ToHTTPUserProfiles(rpc.Users),
-		Page:  ToHTTPPageResponse(rpc.Page),
+		Users:      a.ToHTTPUserProfiles(rpc.Users),
+		Page:       ToHTTPPageResponse(rpc.Page),
+		Highlights: ToHTTPSearchHighlights(rpc.Highlights),
 	}
 }
 
@@ -334,3 +400,85 @@ func (a *userAssembler) ToRPCUnlockUserRequest(
 		UserID: http.UserID,
 	}
 }
+
+func (a *userAssembler) ToRPCImportUsersRequest(
+	dto *identityModel.ImportUsersRequestDTO,
+	operatorID string,
+) *identity_srv.ImportUsersRequest {
+	if dto == nil {
+		return nil
+	}
+
+	return &identity_srv.ImportUsersRequest{
+		FileContent: dto.FileContent,
+		Format:      toRPCUserFileFormat(dto.Format),
+		DryRun:      dto.DryRun,
+		BatchSize:   dto.BatchSize,
+		OperatorID:  &operatorID,
+	}
+}
+
+func (a *userAssembler) ToHTTPImportUsersResponse(
+	rpc *identity_srv.ImportUsersResponse,
+) *identityModel.ImportUsersResponseDTO {
+	if rpc == nil {
+		return nil
+	}
+
+	rows := make([]*identityModel.UserImportRowResultDTO, 0, len(rpc.Rows))
+	for _, row := range rpc.Rows {
+		if row == nil {
+			continue
+		}
+
+		rows = append(rows, &identityModel.UserImportRowResultDTO{
+			RowNumber: row.RowNumber,
+			Username:  row.Username,
+			Errors:    row.Errors,
+			UserID:    row.UserID,
+		})
+	}
+
+	return &identityModel.ImportUsersResponseDTO{
+		DryRun:      rpc.DryRun,
+		TotalRows:   rpc.TotalRows,
+		ValidRows:   rpc.ValidRows,
+		CreatedRows: rpc.CreatedRows,
+		FailedRows:  rpc.FailedRows,
+		Rows:        rows,
+	}
+}
+
+func (a *userAssembler) ToRPCExportUsersRequest(
+	dto *identityModel.ExportUsersRequestDTO,
+) *identity_srv.ExportUsersRequest {
+	if dto == nil {
+		return nil
+	}
+
+	return &identity_srv.ExportUsersRequest{
+		Format:         toRPCUserFileFormat(dto.Format),
+		OrganizationID: dto.OrganizationID,
+		Status:         common.ConvertIdentityUserStatusPtrToRPCPtr(dto.Status),
+	}
+}
+
+// toRPCUserFileFormat 将 HTTP 层的文件格式字符串（csv/xlsx）转换为 RPC 枚举，未指定时返回 nil
+func toRPCUserFileFormat(format *string) *identity_srv.UserFileFormat {
+	if format == nil {
+		return nil
+	}
+
+	var value identity_srv.UserFileFormat
+
+	switch *format {
+	case "csv":
+		value = identity_srv.UserFileFormat_USER_FILE_FORMAT_CSV
+	case "xlsx":
+		value = identity_srv.UserFileFormat_USER_FILE_FORMAT_XLSX
+	default:
+		return nil
+	}
+
+	return &value
+}
@@ -1,12 +1,33 @@
 // Package middleware 提供网关路由级 ACL（粗粒度授权）中间件实现。
 //
 // 仅支持「path 前缀 + 角色 OR」风格的策略，不持有领域字段（部门、数据范围等）。
-// 任何超出此粒度的需求请走 PDP（policy_srv）。
+// 任何超出此粒度的需求请走 PDP（policy_srv）：开启 PDP 后，角色门禁放行的已认证请求
+// 再按「路由 → action/resource」映射向 policy_srv 批量决策，拒绝时在转发前返回 403。
 package middleware
 
-import "github.com/cloudwego/hertz/pkg/app"
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/iamclient"
+)
 
 // AuthZMiddlewareService 路由级 ACL 中间件接口
 type AuthZMiddlewareService interface {
 	MiddlewareFunc() app.HandlerFunc
+
+	// MatchPublic 按当前规则判断是否公开路由，供 JWT 中间件决定是否跳过认证
+	MatchPublic(method, path string) bool
+}
+
+// PermissionChecker PDP 批量决策入口
+//
+// 返回与 perms 一一对应的决策；主体由请求 header（jwt_middleware 注入的 X-User-*）还原。
+type PermissionChecker interface {
+	BatchCheck(
+		ctx context.Context,
+		header iamclient.HeaderGetter,
+		perms []Permission,
+	) ([]*iamclient.Decision, error)
 }
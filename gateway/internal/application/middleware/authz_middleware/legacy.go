@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CheckLegacySkipPaths 校验遗留的 JWT skip_paths 与 public 列表是否一致
+//
+// 公开路由已统一由 public 列表维护，JWT_SKIP_PATHS 仅在迁移期保留：配置了该项时
+// 两侧必须描述同一组路径，否则返回错误阻止启动，避免「JWT 放行但 ACL 拦截」或反之。
+//
+// skip_paths 语法：METHOD:/path（指定方法）、/prefix/*（前缀）、/path（任意方法）。
+// 比较按路径进行，忽略方法与参数名。
+func (r *Rules) CheckLegacySkipPaths(skipPaths []string) error {
+	if len(skipPaths) == 0 {
+		return nil
+	}
+
+	skipSet := make(map[string]string, len(skipPaths))
+	for _, p := range skipPaths {
+		skipSet[canonicalPath(legacySkipPattern(p))] = p
+	}
+
+	publicSet := make(map[string]string, len(r.Public))
+	for _, ep := range r.Public {
+		publicSet[canonicalPath(ep.Path)] = ep.Method + " " + ep.Path
+	}
+
+	onlySkip := difference(skipSet, publicSet)
+	onlyPublic := difference(publicSet, skipSet)
+
+	if len(onlySkip) == 0 && len(onlyPublic) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"JWT_SKIP_PATHS 与 authz public 列表不一致（仅在 skip_paths: %v；仅在 public: %v），"+
+			"公开路由请统一在 authz_rules.yaml 的 public 中维护并移除 JWT_SKIP_PATHS",
+		onlySkip, onlyPublic,
+	)
+}
+
+// legacySkipPattern 把 skip_paths 条目转换为路由模式
+func legacySkipPattern(p string) string {
+	p = strings.TrimSpace(p)
+
+	if _, path, ok := strings.Cut(p, ":"); ok && !strings.HasPrefix(p, "/") {
+		p = path
+	}
+
+	if strings.HasSuffix(p, "/*") {
+		return strings.TrimSuffix(p, "*") + "*rest"
+	}
+
+	return p
+}
+
+// canonicalPath 规范化路由模式：参数段统一为 :，通配剩余段统一为 **
+func canonicalPath(pattern string) string {
+	segs := splitPath(pattern)
+
+	for i, seg := range segs {
+		switch kindOf(seg) {
+		case segmentParam:
+			segs[i] = ":"
+		case segmentCatchAll:
+			segs[i] = "**"
+		}
+	}
+
+	return "/" + strings.Join(segs, "/")
+}
+
+// difference 返回仅出现在 a 中的原始条目（排序后）
+func difference(a, b map[string]string) []string {
+	var out []string
+
+	for key, raw := range a {
+		if _, ok := b[key]; !ok {
+			out = append(out, raw)
+		}
+	}
+
+	sort.Strings(out)
+
+	return out
+}
@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRules_CheckLegacySkipPaths(t *testing.T) {
+	rules, err := ParseRules([]byte(`
+public:
+  - "POST /api/v1/identity/auth/login"
+  - "GET  /api/v1/users/:id/avatar"
+  - "GET  /swagger/*any"
+`))
+	require.NoError(t, err)
+
+	t.Run("未配置时跳过校验", func(t *testing.T) {
+		assert.NoError(t, rules.CheckLegacySkipPaths(nil))
+	})
+
+	t.Run("两侧一致", func(t *testing.T) {
+		assert.NoError(t, rules.CheckLegacySkipPaths([]string{
+			"POST:/api/v1/identity/auth/login",
+			"/api/v1/users/:userID/avatar",
+			"/swagger/*",
+		}))
+	})
+
+	t.Run("仅在 skip_paths", func(t *testing.T) {
+		err := rules.CheckLegacySkipPaths([]string{
+			"POST:/api/v1/identity/auth/login",
+			"/api/v1/users/:id/avatar",
+			"/swagger/*",
+			"/api/v1/debug",
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "仅在 skip_paths: [/api/v1/debug]")
+	})
+
+	t.Run("仅在 public", func(t *testing.T) {
+		err := rules.CheckLegacySkipPaths([]string{"/api/v1/identity/auth/login"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "GET /swagger/*any")
+		assert.Contains(t, err.Error(), "GET /api/v1/users/:id/avatar")
+	})
+}
+
+func TestAuthZMiddleware_MatchPublicFollowsReload(t *testing.T) {
+	m := NewAuthZMiddleware(newRules(t), nil)
+	assert.True(t, m.MatchPublic("GET", "/healthz"))
+	assert.False(t, m.MatchPublic("GET", "/metrics"))
+
+	m.UpdateRules(&Rules{
+		Default: DefaultAllow,
+		Public:  []Endpoint{{Method: "GET", Path: "/metrics"}},
+	})
+	assert.False(t, m.MatchPublic("GET", "/healthz"))
+	assert.True(t, m.MatchPublic("GET", "/metrics"))
+}
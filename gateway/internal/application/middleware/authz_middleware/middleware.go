@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 	"strings"
+	"sync/atomic"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/rs/zerolog"
@@ -10,6 +11,7 @@ import (
 	jwtmw "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/application/middleware/jwt_middleware"
 	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/errors"
 	tracelog "github.com/masonsxu/cloudwego-microservice-demo/gateway/pkg/log"
+	"github.com/masonsxu/cloudwego-microservice-demo/iamclient"
 )
 
 // Outcome 决策结果
@@ -81,14 +83,50 @@ func Decide(rules *Rules, method, path, userID string, userRoles []string) Decis
 }
 
 // AuthZMiddlewareImpl 路由级 ACL 中间件实现
+//
+// 规则集以原子指针持有，热加载时整体替换，单个请求始终只看到同一版本的规则。
 type AuthZMiddlewareImpl struct {
-	rules  *Rules
+	rules  atomic.Pointer[Rules]
 	logger *zerolog.Logger
+
+	// PDP 决策（可选）：为 nil 时仅做路由级 ACL
+	pdp   PermissionChecker
+	menus []MenuPermission
 }
 
 // NewAuthZMiddleware 创建 authz 中间件实例
 func NewAuthZMiddleware(rules *Rules, logger *zerolog.Logger) *AuthZMiddlewareImpl {
-	return &AuthZMiddlewareImpl{rules: rules, logger: logger}
+	m := &AuthZMiddlewareImpl{logger: logger}
+	m.rules.Store(rules)
+
+	return m
+}
+
+// Rules 返回当前生效的规则集
+func (m *AuthZMiddlewareImpl) Rules() *Rules {
+	return m.rules.Load()
+}
+
+// UpdateRules 原子替换规则集，nil 被忽略
+func (m *AuthZMiddlewareImpl) UpdateRules(rules *Rules) {
+	if rules == nil {
+		return
+	}
+
+	m.rules.Store(rules)
+}
+
+// EnablePDP 开启 PDP 路由授权，须在开始处理请求前调用
+//
+// menus 为 menu.yaml api_paths 派生的映射，启动时加载一次；显式映射随规则热加载。
+func (m *AuthZMiddlewareImpl) EnablePDP(checker PermissionChecker, menus []MenuPermission) {
+	m.pdp = checker
+	m.menus = menus
+}
+
+// MatchPublic 按当前生效的规则判断 method+path 是否命中 public 列表
+func (m *AuthZMiddlewareImpl) MatchPublic(method, path string) bool {
+	return m.rules.Load().MatchPublic(method, path)
 }
 
 // MiddlewareFunc 返回中间件函数
@@ -99,10 +137,15 @@ func (m *AuthZMiddlewareImpl) MiddlewareFunc() app.HandlerFunc {
 		userID := c.Request.Header.Get(jwtmw.HeaderUserID)
 		userRoles := splitHeader(c.Request.Header.Get(jwtmw.HeaderUserRoles))
 
-		decision := Decide(m.rules, method, path, userID, userRoles)
+		rules := m.rules.Load()
+		decision := Decide(rules, method, path, userID, userRoles)
 
 		switch decision.Outcome {
 		case OutcomeAllow:
+			if m.pdp != nil && decision.MatchedRule != "public" && !m.checkPDP(ctx, c, rules, method, userID) {
+				return
+			}
+
 			c.Next(ctx)
 		case OutcomeUnauthorized:
 			tracelog.Event(ctx, m.logger.Warn()).
@@ -128,6 +171,101 @@ func (m *AuthZMiddlewareImpl) MiddlewareFunc() app.HandlerFunc {
 	}
 }
 
+// checkPDP 按路由映射向 PDP 批量决策，返回 false 表示请求已被中止
+//
+// 以 Hertz 路由模式（c.FullPath）而非原始路径查找映射；未注册的路由（404）
+// 与未映射的路由不经 PDP。PDP 不可用时拒绝请求，不做默认放行。
+// 这里没有紧急账号（break-glass）通道：紧急访问需要审计留痕，只在 identity_srv 中实现，
+// policy_srv 故障时由运维关闭 PDP 路由授权后再使用紧急账号。
+func (m *AuthZMiddlewareImpl) checkPDP(
+	ctx context.Context,
+	c *app.RequestContext,
+	rules *Rules,
+	method, userID string,
+) bool {
+	route := c.FullPath()
+	if route == "" {
+		return true
+	}
+
+	required, ok := ResolveRoutePermissions(rules, m.menus, method, route)
+	if !ok {
+		return true
+	}
+
+	perms := make([]Permission, len(required.Items))
+	for i, p := range required.Items {
+		perms[i] = Permission{
+			Action:   p.Action,
+			Resource: expandResource(p.Resource, c.Param),
+		}
+	}
+
+	decisions, err := m.pdp.BatchCheck(ctx, &c.Request.Header, perms)
+	if err != nil {
+		tracelog.Event(ctx, m.logger.Error()).
+			Str("component", "authz_middleware").
+			Str("method", method).
+			Str("route", route).
+			Str("user_id", userID).
+			Err(err).
+			Msg("authz PDP check failed")
+		errors.AbortWithError(c, errors.ErrServiceDown)
+
+		return false
+	}
+
+	denied, allowed := evaluatePDP(required.AnyOf, perms, decisions)
+	if allowed {
+		return true
+	}
+
+	tracelog.Event(ctx, m.logger.Warn()).
+		Str("component", "authz_middleware").
+		Str("method", method).
+		Str("route", route).
+		Str("user_id", userID).
+		Str("matched_rule", required.Source).
+		Str("action", denied.Action).
+		Str("resource", denied.Resource).
+		Msg("authz denied by PDP")
+	errors.AbortWithError(c, errors.ErrForbidden)
+
+	return false
+}
+
+// evaluatePDP 汇总批量决策：anyOf 时任一允许即放行，否则需全部允许
+//
+// 决策数量与权限数量不一致或出现 nil 决策时视为拒绝（fail closed）。
+// 拒绝时返回第一个被拒绝的权限，用于日志。
+func evaluatePDP(anyOf bool, perms []Permission, decisions []*iamclient.Decision) (Permission, bool) {
+	if len(perms) == 0 {
+		return Permission{}, false
+	}
+
+	if len(decisions) != len(perms) {
+		return perms[0], false
+	}
+
+	for i, d := range decisions {
+		allowed := d != nil && d.Allowed
+
+		if allowed && anyOf {
+			return Permission{}, true
+		}
+
+		if !allowed && !anyOf {
+			return perms[i], false
+		}
+	}
+
+	if anyOf {
+		return perms[0], false
+	}
+
+	return Permission{}, true
+}
+
 // splitHeader 将逗号分隔的 header 拆分为 trim 过的非空字符串切片
 func splitHeader(value string) []string {
 	if value == "" {
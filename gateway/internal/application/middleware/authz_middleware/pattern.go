@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"fmt"
+	"strings"
+)
+
+// segmentKind 路由模式段类型，取值越大越具体
+type segmentKind int
+
+const (
+	// segmentCatchAll *name：匹配剩余全部段，仅允许出现在末尾
+	segmentCatchAll segmentKind = iota
+	// segmentParam :name 或 *：匹配任意单个非空段
+	segmentParam
+	// segmentLiteral 字面量段：精确匹配
+	segmentLiteral
+)
+
+// splitPath 按 / 拆分路径，忽略首尾的 /
+func splitPath(p string) []string {
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return nil
+	}
+
+	return strings.Split(p, "/")
+}
+
+// kindOf 返回模式段类型
+func kindOf(seg string) segmentKind {
+	switch {
+	case seg == "*" || strings.HasPrefix(seg, ":"):
+		return segmentParam
+	case strings.HasPrefix(seg, "*"):
+		return segmentCatchAll
+	default:
+		return segmentLiteral
+	}
+}
+
+// validatePattern 校验 Hertz 风格的路由模式
+//
+// 支持 :name（单段参数）、*（单段通配）与 *name（剩余全部段，仅限末尾）。
+func validatePattern(pattern string) error {
+	segs := splitPath(pattern)
+
+	for i, seg := range segs {
+		if seg == ":" {
+			return fmt.Errorf("参数段缺少名称")
+		}
+
+		if kindOf(seg) == segmentCatchAll && i != len(segs)-1 {
+			return fmt.Errorf("通配段 %q 只能出现在末尾", seg)
+		}
+
+		if kindOf(seg) == segmentLiteral && strings.ContainsAny(seg, ":*") {
+			return fmt.Errorf("段 %q 中的 : 与 * 只能出现在段首", seg)
+		}
+	}
+
+	return nil
+}
+
+// matchPattern 判断 path 是否命中模式
+//
+// prefix=true 时按段前缀匹配（path 可以比模式更长），否则要求段数一致。
+func matchPattern(pattern, path string, prefix bool) bool {
+	patSegs := splitPath(pattern)
+	pathSegs := splitPath(path)
+
+	for i, seg := range patSegs {
+		kind := kindOf(seg)
+		if kind == segmentCatchAll {
+			return true
+		}
+
+		if i >= len(pathSegs) {
+			return false
+		}
+
+		if kind == segmentParam {
+			if pathSegs[i] == "" {
+				return false
+			}
+
+			continue
+		}
+
+		if seg != pathSegs[i] {
+			return false
+		}
+	}
+
+	return prefix || len(pathSegs) == len(patSegs)
+}
+
+// compareSpecificity 比较两个模式的具体程度，>0 表示 a 更具体
+//
+// 逐段比较：字面量 > 参数 > 通配；前缀相同时段数多者更具体。
+func compareSpecificity(a, b string) int {
+	segsA := splitPath(a)
+	segsB := splitPath(b)
+
+	for i := 0; i < len(segsA) && i < len(segsB); i++ {
+		if diff := int(kindOf(segsA[i])) - int(kindOf(segsB[i])); diff != 0 {
+			return diff
+		}
+	}
+
+	return len(segsA) - len(segsB)
+}
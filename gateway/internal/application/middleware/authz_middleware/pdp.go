@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/iamclient"
+)
+
+// iamPermissionChecker 基于 iamclient 的 PDP 决策实现，复用其批量 RPC 与 LRU 缓存
+type iamPermissionChecker struct {
+	client *iamclient.Client
+}
+
+// NewIAMPermissionChecker 创建基于 iamclient 的 PermissionChecker
+func NewIAMPermissionChecker(client *iamclient.Client) PermissionChecker {
+	return &iamPermissionChecker{client: client}
+}
+
+// BatchCheck 还原 Subject 后一次性询问 PDP，已缓存的决策不再发起 RPC
+func (p *iamPermissionChecker) BatchCheck(
+	ctx context.Context,
+	header iamclient.HeaderGetter,
+	perms []Permission,
+) ([]*iamclient.Decision, error) {
+	subject, err := p.client.SubjectFromHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]iamclient.CheckItem, len(perms))
+	for i, perm := range perms {
+		items[i] = iamclient.CheckItem{Action: perm.Action, Resource: perm.Resource}
+	}
+
+	return subject.BatchCheck(ctx, items)
+}
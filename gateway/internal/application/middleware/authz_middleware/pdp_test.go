@@ -0,0 +1,186 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/route/param"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	jwtmw "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/application/middleware/jwt_middleware"
+	"github.com/masonsxu/cloudwego-microservice-demo/iamclient"
+)
+
+// fakeChecker 按 allowed 集合（action resource）返回决策，记录每次批量请求
+type fakeChecker struct {
+	allowed map[string]bool
+	err     error
+	calls   [][]Permission
+}
+
+func (f *fakeChecker) BatchCheck(
+	_ context.Context,
+	_ iamclient.HeaderGetter,
+	perms []Permission,
+) ([]*iamclient.Decision, error) {
+	f.calls = append(f.calls, perms)
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	out := make([]*iamclient.Decision, len(perms))
+	for i, p := range perms {
+		out[i] = &iamclient.Decision{Allowed: f.allowed[p.Action+" "+p.Resource]}
+	}
+
+	return out, nil
+}
+
+func newPDPMiddleware(t *testing.T, checker PermissionChecker) *AuthZMiddlewareImpl {
+	t.Helper()
+
+	rules, err := ParseRules([]byte(`
+public:
+  - "POST /api/v1/identity/auth/login"
+permissions:
+  - route: "PUT /api/v1/identity/users/:userID/unlock"
+    require: ["unlock user:{userID}"]
+`))
+	require.NoError(t, err)
+
+	menus, err := ParseMenuPermissions([]byte(testMenuYAML))
+	require.NoError(t, err)
+
+	logger := zerolog.Nop()
+	m := NewAuthZMiddleware(rules, &logger)
+	m.EnablePDP(checker, menus)
+
+	return m
+}
+
+// runPDPRequest 模拟路由匹配后的请求：设置路由模式与参数，返回是否继续到下游
+func runPDPRequest(
+	m *AuthZMiddlewareImpl,
+	method, route, path string,
+	params param.Params,
+) (*app.RequestContext, bool) {
+	c := app.NewContext(0)
+	c.Request.SetMethod(method)
+	c.Request.SetRequestURI(path)
+	c.Request.Header.Set(jwtmw.HeaderUserID, "u-1")
+	c.SetFullPath(route)
+	c.Params = params
+
+	reached := false
+	c.SetHandlers([]app.HandlerFunc{
+		m.MiddlewareFunc(),
+		func(context.Context, *app.RequestContext) { reached = true },
+	})
+	c.Next(context.Background())
+
+	return c, reached
+}
+
+func TestPDP_ExplicitMappingExpandsParams(t *testing.T) {
+	checker := &fakeChecker{allowed: map[string]bool{"unlock user:u-42": true}}
+	m := newPDPMiddleware(t, checker)
+
+	_, reached := runPDPRequest(m, http.MethodPut, "/api/v1/identity/users/:userID/unlock",
+		"/api/v1/identity/users/u-42/unlock", param.Params{{Key: "userID", Value: "u-42"}})
+	assert.True(t, reached)
+	require.Len(t, checker.calls, 1)
+	assert.Equal(t, []Permission{{Action: "unlock", Resource: "user:u-42"}}, checker.calls[0])
+
+	c, reached := runPDPRequest(m, http.MethodPut, "/api/v1/identity/users/:userID/unlock",
+		"/api/v1/identity/users/u-7/unlock", param.Params{{Key: "userID", Value: "u-7"}})
+	assert.False(t, reached)
+	assert.Equal(t, http.StatusForbidden, c.Response.StatusCode())
+}
+
+func TestPDP_MenuMappingAnyOf(t *testing.T) {
+	checker := &fakeChecker{allowed: map[string]bool{"update menu:org_members": true}}
+	m := newPDPMiddleware(t, checker)
+
+	_, reached := runPDPRequest(m, http.MethodPut, "/api/v1/identity/users/:userID/status",
+		"/api/v1/identity/users/u-1/status", nil)
+	assert.True(t, reached)
+	require.Len(t, checker.calls, 1)
+	assert.Len(t, checker.calls[0], 2, "同等具体的菜单映射应合并为一次批量决策")
+
+	c, reached := runPDPRequest(m, http.MethodDelete, "/api/v1/identity/users/:userID",
+		"/api/v1/identity/users/u-1", nil)
+	assert.False(t, reached)
+	assert.Equal(t, http.StatusForbidden, c.Response.StatusCode())
+}
+
+func TestPDP_SkipsPublicUnmappedAndUnknownRoutes(t *testing.T) {
+	checker := &fakeChecker{}
+	m := newPDPMiddleware(t, checker)
+
+	_, reached := runPDPRequest(m, http.MethodPost, "/api/v1/identity/auth/login", "/api/v1/identity/auth/login", nil)
+	assert.True(t, reached)
+
+	_, reached = runPDPRequest(m, http.MethodGet, "/api/v1/identity/organizations", "/api/v1/identity/organizations", nil)
+	assert.True(t, reached)
+
+	_, reached = runPDPRequest(m, http.MethodGet, "", "/no/such/route", nil)
+	assert.True(t, reached)
+
+	assert.Empty(t, checker.calls)
+}
+
+func TestPDP_CheckerErrorFailsClosed(t *testing.T) {
+	checker := &fakeChecker{err: errors.New("policy_srv unavailable")}
+	m := newPDPMiddleware(t, checker)
+
+	c, reached := runPDPRequest(m, http.MethodGet, "/api/v1/identity/users", "/api/v1/identity/users", nil)
+	assert.False(t, reached)
+	assert.Equal(t, http.StatusServiceUnavailable, c.Response.StatusCode())
+}
+
+func TestPDP_DisabledByDefault(t *testing.T) {
+	rules, err := ParseRules(nil)
+	require.NoError(t, err)
+
+	logger := zerolog.Nop()
+	m := NewAuthZMiddleware(rules, &logger)
+
+	_, reached := runPDPRequest(m, http.MethodDelete, "/api/v1/identity/users/:userID", "/api/v1/identity/users/u-1", nil)
+	assert.True(t, reached)
+}
+
+func TestEvaluatePDP_FailsClosedOnMalformedDecisions(t *testing.T) {
+	perms := []Permission{
+		{Action: "read", Resource: "user"},
+		{Action: "update", Resource: "user"},
+	}
+	allow := &iamclient.Decision{Allowed: true}
+
+	tests := []struct {
+		name      string
+		anyOf     bool
+		decisions []*iamclient.Decision
+		allowed   bool
+	}{
+		{name: "all-of 全部允许", decisions: []*iamclient.Decision{allow, allow}, allowed: true},
+		{name: "all-of 决策数量不足", decisions: []*iamclient.Decision{allow}},
+		{name: "all-of 决策为空", decisions: nil},
+		{name: "all-of 决策数量过多", decisions: []*iamclient.Decision{allow, allow, allow}},
+		{name: "all-of nil 决策", decisions: []*iamclient.Decision{allow, nil}},
+		{name: "any-of nil 决策不算允许", anyOf: true, decisions: []*iamclient.Decision{nil, {Allowed: false}}},
+		{name: "any-of 决策数量不足", anyOf: true, decisions: []*iamclient.Decision{allow}},
+		{name: "any-of 任一允许", anyOf: true, decisions: []*iamclient.Decision{nil, allow}, allowed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, allowed := evaluatePDP(tt.anyOf, perms, tt.decisions)
+			assert.Equal(t, tt.allowed, allowed)
+		})
+	}
+}
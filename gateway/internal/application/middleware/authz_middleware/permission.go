@@ -0,0 +1,287 @@
+package middleware
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Permission PDP 决策所需的 action/resource 对
+//
+// Resource 可包含 {name} 占位符，请求时替换为同名 Hertz 路由参数的值，
+// 如 "user:{userID}"。
+type Permission struct {
+	Action   string
+	Resource string
+}
+
+// PermissionRule 路由到权限的显式映射（authz_rules.yaml 的 permissions 段）
+//
+// Require 之间为 AND 关系；Skip=true 表示该路由不经 PDP 决策（如只操作本人资源的 /users/me）。
+type PermissionRule struct {
+	Endpoint
+	Require []Permission
+	Skip    bool
+}
+
+// MenuPermission 由 menu.yaml api_paths 派生的路由映射
+//
+// Resource 为菜单或按钮的 perm_code，菜单未配置时取 menu:<菜单 id>，与 identity_srv
+// 中权限编码的默认取值一致；action 按 HTTP 方法推导。
+type MenuPermission struct {
+	Path     string
+	Resource string
+}
+
+// RoutePermissions 一条路由需要向 PDP 询问的权限
+type RoutePermissions struct {
+	Items  []Permission
+	AnyOf  bool   // true：任一允许即放行（菜单派生）；false：全部允许才放行（显式映射）
+	Source string // 命中来源，便于日志：permissions:<route> / menu:<api_path>
+}
+
+type rawPermissionRule struct {
+	Route   string   `yaml:"route"`
+	Require []string `yaml:"require"`
+	Skip    bool     `yaml:"skip"`
+}
+
+// parsePermissionRule 解析 permissions 段中的一条规则
+func parsePermissionRule(raw rawPermissionRule) (PermissionRule, error) {
+	ep, err := parseEndpoint(raw.Route)
+	if err != nil {
+		return PermissionRule{}, err
+	}
+
+	rule := PermissionRule{Endpoint: ep, Skip: raw.Skip}
+
+	switch {
+	case raw.Skip && len(raw.Require) > 0:
+		return PermissionRule{}, fmt.Errorf("skip 与 require 不能同时配置")
+	case !raw.Skip && len(raw.Require) == 0:
+		return PermissionRule{}, fmt.Errorf("缺少 require（不需要 PDP 决策时请写 skip: true）")
+	}
+
+	for _, r := range raw.Require {
+		parts := strings.Fields(r)
+		if len(parts) != 2 {
+			return PermissionRule{}, fmt.Errorf("require %q 格式错误，需为 'action resource'", r)
+		}
+
+		rule.Require = append(rule.Require, Permission{Action: parts[0], Resource: parts[1]})
+	}
+
+	return rule, nil
+}
+
+type rawMenu struct {
+	Menu []rawMenuNode `yaml:"menu"`
+}
+
+type rawMenuNode struct {
+	ID       string        `yaml:"id"`
+	PermCode string        `yaml:"perm_code"`
+	APIPaths []string      `yaml:"api_paths"`
+	Buttons  []rawMenuNode `yaml:"buttons"`
+	Children []rawMenuNode `yaml:"children"`
+}
+
+// resource 菜单/按钮对应的 PDP 资源
+func (n rawMenuNode) resource() string {
+	if n.PermCode != "" {
+		return n.PermCode
+	}
+
+	return "menu:" + n.ID
+}
+
+// LoadMenuPermissions 从 menu.yaml 读取各菜单的 api_paths
+func LoadMenuPermissions(path string) ([]MenuPermission, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("读取菜单文件失败: %w", err)
+	}
+
+	return ParseMenuPermissions(data)
+}
+
+// ParseMenuPermissions 解析 menu.yaml，按菜单树深度优先展开 api_paths（含按钮）
+func ParseMenuPermissions(data []byte) ([]MenuPermission, error) {
+	var raw rawMenu
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("解析菜单 YAML 失败: %w", err)
+	}
+
+	var out []MenuPermission
+
+	var walk func(nodes []rawMenuNode) error
+	walk = func(nodes []rawMenuNode) error {
+		for _, node := range nodes {
+			if len(node.APIPaths) > 0 && node.ID == "" {
+				return fmt.Errorf("配置了 api_paths 的菜单缺少 id")
+			}
+
+			for _, p := range node.APIPaths {
+				p = strings.TrimSpace(p)
+				if !strings.HasPrefix(p, "/") {
+					return fmt.Errorf("菜单 %s 的 api_path %q 必须以 / 开头", node.ID, p)
+				}
+
+				if err := validatePattern(p); err != nil {
+					return fmt.Errorf("菜单 %s 的 api_path %q 解析失败: %w", node.ID, p, err)
+				}
+
+				out = append(out, MenuPermission{Path: p, Resource: node.resource()})
+			}
+
+			if err := walk(node.Buttons); err != nil {
+				return err
+			}
+
+			if err := walk(node.Children); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if err := walk(raw.Menu); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// ResolveRoutePermissions 计算路由（method + Hertz 路由模式）需要的 PDP 权限
+//
+// 显式映射优先：命中 permissions 规则时按其 require（或 skip）决定；否则取
+// menu.yaml 中最具体的 api_path，同等具体的多个菜单任一允许即放行。
+// ok=false 表示该路由无需 PDP 决策（skip 或未映射）。
+func ResolveRoutePermissions(
+	rules *Rules,
+	menus []MenuPermission,
+	method, route string,
+) (RoutePermissions, bool) {
+	if rule, hit := matchPermissionRule(rules.Permissions, method, route); hit {
+		if rule.Skip {
+			return RoutePermissions{}, false
+		}
+
+		return RoutePermissions{
+			Items:  rule.Require,
+			Source: "permissions:" + rule.Method + " " + rule.Path,
+		}, true
+	}
+
+	var (
+		bestPath string
+		items    []Permission
+	)
+
+	action := actionForMethod(method)
+
+	for _, mp := range menus {
+		if !matchPattern(mp.Path, route, false) {
+			continue
+		}
+
+		cmp := 1
+		if items != nil {
+			cmp = compareSpecificity(mp.Path, bestPath)
+		}
+
+		switch {
+		case cmp > 0:
+			bestPath = mp.Path
+			items = []Permission{{Action: action, Resource: mp.Resource}}
+		case cmp == 0 && !containsResource(items, mp.Resource):
+			items = append(items, Permission{Action: action, Resource: mp.Resource})
+		}
+	}
+
+	if items == nil {
+		return RoutePermissions{}, false
+	}
+
+	return RoutePermissions{Items: items, AnyOf: true, Source: "menu:" + bestPath}, true
+}
+
+// matchPermissionRule 返回命中的最具体显式映射，规则与 matchEndpoint 一致
+func matchPermissionRule(rules []PermissionRule, method, route string) (PermissionRule, bool) {
+	eps := make([]Endpoint, len(rules))
+	for i, r := range rules {
+		eps[i] = r.Endpoint
+	}
+
+	best, hit := matchEndpoint(eps, method, route)
+	if !hit {
+		return PermissionRule{}, false
+	}
+
+	for _, r := range rules {
+		if r.Endpoint == best {
+			return r, true
+		}
+	}
+
+	return PermissionRule{}, false
+}
+
+// actionForMethod 按 HTTP 方法推导菜单派生映射的 action
+func actionForMethod(method string) string {
+	switch method {
+	case "GET", "HEAD":
+		return "read"
+	case "POST":
+		return "create"
+	case "PUT", "PATCH":
+		return "update"
+	case "DELETE":
+		return "delete"
+	default:
+		return strings.ToLower(method)
+	}
+}
+
+// containsResource items 中是否已有该 resource
+func containsResource(items []Permission, resource string) bool {
+	for _, p := range items {
+		if p.Resource == resource {
+			return true
+		}
+	}
+
+	return false
+}
+
+// expandResource 把 resource 中的 {name} 占位符替换为路由参数值
+func expandResource(resource string, param func(name string) string) string {
+	if !strings.Contains(resource, "{") {
+		return resource
+	}
+
+	var sb strings.Builder
+
+	for {
+		start := strings.IndexByte(resource, '{')
+		if start < 0 {
+			break
+		}
+
+		end := strings.IndexByte(resource[start:], '}')
+		if end < 0 {
+			break
+		}
+
+		sb.WriteString(resource[:start])
+		sb.WriteString(param(resource[start+1 : start+end]))
+		resource = resource[start+end+1:]
+	}
+
+	sb.WriteString(resource)
+
+	return sb.String()
+}
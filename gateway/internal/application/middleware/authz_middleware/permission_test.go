@@ -0,0 +1,172 @@
+package middleware
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMenuYAML = `
+menu:
+  - name: "系统设置"
+    id: "system_settings"
+    path: "/system-settings"
+    children:
+      - name: "用户管理"
+        id: "account_management"
+        api_paths:
+          - "/api/v1/identity/users"
+          - "/api/v1/identity/users/*"
+          - "/api/v1/identity/users/*/status"
+        buttons:
+          - name: "导出用户"
+            id: "account_export"
+            perm_code: "user:export"
+            api_paths:
+              - "/api/v1/identity/users/export"
+      - name: "组织成员"
+        id: "org_members"
+        api_paths:
+          - "/api/v1/identity/users/:id/status"
+`
+
+func TestParseMenuPermissions(t *testing.T) {
+	menus, err := ParseMenuPermissions([]byte(testMenuYAML))
+	require.NoError(t, err)
+	assert.Equal(t, []MenuPermission{
+		{Path: "/api/v1/identity/users", Resource: "menu:account_management"},
+		{Path: "/api/v1/identity/users/*", Resource: "menu:account_management"},
+		{Path: "/api/v1/identity/users/*/status", Resource: "menu:account_management"},
+		{Path: "/api/v1/identity/users/export", Resource: "user:export"},
+		{Path: "/api/v1/identity/users/:id/status", Resource: "menu:org_members"},
+	}, menus)
+}
+
+func TestParseMenuPermissions_Invalid(t *testing.T) {
+	cases := map[string]string{
+		"缺少 id":   "menu:\n  - name: x\n    api_paths: [\"/a\"]\n",
+		"相对路径":    "menu:\n  - id: x\n    api_paths: [\"a/b\"]\n",
+		"通配段不在末尾": "menu:\n  - id: x\n    api_paths: [\"/a/*rest/b\"]\n",
+	}
+
+	for name, yml := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseMenuPermissions([]byte(yml))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseRules_Permissions(t *testing.T) {
+	rules, err := ParseRules([]byte(`
+permissions:
+  - route: "PUT /api/v1/identity/users/:userID/status"
+    require:
+      - "change_status user:{userID}"
+  - route: "GET /api/v1/identity/users/me"
+    skip: true
+`))
+	require.NoError(t, err)
+	require.Len(t, rules.Permissions, 2)
+	assert.Equal(t, PermissionRule{
+		Endpoint: Endpoint{Method: "PUT", Path: "/api/v1/identity/users/:userID/status"},
+		Require:  []Permission{{Action: "change_status", Resource: "user:{userID}"}},
+	}, rules.Permissions[0])
+	assert.True(t, rules.Permissions[1].Skip)
+}
+
+func TestParseRules_PermissionsInvalid(t *testing.T) {
+	cases := map[string]string{
+		"缺少 require":        "permissions:\n  - route: \"GET /a\"\n",
+		"skip 与 require 并存": "permissions:\n  - route: \"GET /a\"\n    skip: true\n    require: [\"read a\"]\n",
+		"require 格式错误":      "permissions:\n  - route: \"GET /a\"\n    require: [\"read\"]\n",
+		"route 格式错误":        "permissions:\n  - route: \"/a\"\n    require: [\"read a\"]\n",
+	}
+
+	for name, yml := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseRules([]byte(yml))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestResolveRoutePermissions(t *testing.T) {
+	menus, err := ParseMenuPermissions([]byte(testMenuYAML))
+	require.NoError(t, err)
+
+	rules, err := ParseRules([]byte(`
+permissions:
+  - route: "PUT /api/v1/identity/users/:userID/unlock"
+    require: ["unlock user:{userID}", "read audit_log"]
+  - route: "* /api/v1/identity/users/me"
+    skip: true
+`))
+	require.NoError(t, err)
+
+	t.Run("菜单派生：action 由方法推导", func(t *testing.T) {
+		got, ok := ResolveRoutePermissions(rules, menus, "DELETE", "/api/v1/identity/users/:userID")
+		require.True(t, ok)
+		assert.True(t, got.AnyOf)
+		assert.Equal(t, "menu:/api/v1/identity/users/*", got.Source)
+		assert.Equal(t, []Permission{{Action: "delete", Resource: "menu:account_management"}}, got.Items)
+	})
+
+	t.Run("菜单派生：同等具体的多个菜单任一即可", func(t *testing.T) {
+		got, ok := ResolveRoutePermissions(rules, menus, "PUT", "/api/v1/identity/users/:userID/status")
+		require.True(t, ok)
+		assert.True(t, got.AnyOf)
+		assert.ElementsMatch(t, []Permission{
+			{Action: "update", Resource: "menu:account_management"},
+			{Action: "update", Resource: "menu:org_members"},
+		}, got.Items)
+	})
+
+	t.Run("显式映射优先且要求全部满足", func(t *testing.T) {
+		got, ok := ResolveRoutePermissions(rules, menus, "PUT", "/api/v1/identity/users/:userID/unlock")
+		require.True(t, ok)
+		assert.False(t, got.AnyOf)
+		assert.Equal(t, "permissions:PUT /api/v1/identity/users/:userID/unlock", got.Source)
+		assert.Len(t, got.Items, 2)
+	})
+
+	t.Run("skip 覆盖菜单映射", func(t *testing.T) {
+		_, ok := ResolveRoutePermissions(rules, menus, "GET", "/api/v1/identity/users/me")
+		assert.False(t, ok)
+	})
+
+	t.Run("未映射", func(t *testing.T) {
+		_, ok := ResolveRoutePermissions(rules, menus, "GET", "/api/v1/identity/organizations")
+		assert.False(t, ok)
+	})
+}
+
+func TestExpandResource(t *testing.T) {
+	params := map[string]string{"userID": "u-1", "orgID": "o-9"}
+	param := func(name string) string { return params[name] }
+
+	assert.Equal(t, "user", expandResource("user", param))
+	assert.Equal(t, "user:u-1", expandResource("user:{userID}", param))
+	assert.Equal(t, "org:o-9:user:u-1", expandResource("org:{orgID}:user:{userID}", param))
+	assert.Equal(t, "user:", expandResource("user:{missing}", param))
+	assert.Equal(t, "user:{broken", expandResource("user:{broken", param))
+}
+
+// TestLoadMenuPermissions_RepoMenuYAML 验证仓库根目录的 menu.yaml 能被网关解析
+func TestLoadMenuPermissions_RepoMenuYAML(t *testing.T) {
+	// 测试在 gateway/internal/application/middleware/authz_middleware/，menu.yaml 在仓库根目录
+	cwd, err := os.Getwd()
+	require.NoError(t, err)
+
+	menuPath := filepath.Join(cwd, "..", "..", "..", "..", "..", "menu.yaml")
+	if _, err := os.Stat(menuPath); err != nil {
+		t.Skipf("menu.yaml 不存在（%s），跳过：%v", menuPath, err)
+	}
+
+	menus, err := LoadMenuPermissions(menuPath)
+	require.NoError(t, err)
+	assert.NotEmpty(t, menus)
+}
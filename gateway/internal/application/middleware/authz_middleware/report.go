@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// Route 已注册的路由（method + Hertz 路由模式）
+type Route struct {
+	Method string
+	Path   string
+}
+
+// RouteReport 单条路由会命中的规则
+type RouteReport struct {
+	Route
+	Decision Decision
+}
+
+// BuildRouteReport 计算每条路由会命中的规则
+//
+// 路由模式本身被当作请求路径参与匹配（如 :userID 段可被规则中的 :id / * 命中），
+// 决策按「已认证但不具备任何角色」的身份计算，roles 规则体现为 Forbidden 及其 require。
+func BuildRouteReport(rules *Rules, routes []Route) []RouteReport {
+	sorted := append([]Route(nil), routes...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Path != sorted[j].Path {
+			return sorted[i].Path < sorted[j].Path
+		}
+
+		return sorted[i].Method < sorted[j].Method
+	})
+
+	reports := make([]RouteReport, 0, len(sorted))
+	for _, route := range sorted {
+		reports = append(reports, RouteReport{
+			Route:    route,
+			Decision: Decide(rules, route.Method, route.Path, "report", nil),
+		})
+	}
+
+	return reports
+}
+
+// WriteRouteReport 以表格形式输出路由与规则的对应关系
+func WriteRouteReport(w io.Writer, reports []RouteReport) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "METHOD\tPATH\tRULE\tREQUIRE")
+
+	counts := map[string]int{}
+
+	for _, r := range reports {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n",
+			r.Method, r.Path, r.Decision.MatchedRule, strings.Join(r.Decision.RequiredRole, ","))
+
+		// roles:<prefix> 归为 roles，其余规则名原样计数
+		category := r.Decision.MatchedRule
+		if strings.HasPrefix(category, "roles:") {
+			category = "roles"
+		}
+
+		counts[category]++
+	}
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "\n共 %d 条路由：public %d，authenticated %d，roles %d，default:allow %d，default:deny %d\n",
+		len(reports), counts["public"], counts["authenticated"], counts["roles"],
+		counts["default:allow"], counts["default:deny"])
+
+	return err
+}
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteRouteReport(t *testing.T) {
+	rules, err := ParseRules([]byte(`
+public:
+  - "POST /api/v1/identity/auth/login"
+authenticated:
+  - "GET /api/v1/identity/users/me"
+roles:
+  - prefix: /api/v1/identity/users/:userID/status
+    require: [user_admin]
+`))
+	require.NoError(t, err)
+
+	reports := BuildRouteReport(rules, []Route{
+		{Method: "PUT", Path: "/api/v1/identity/users/:userID/status"},
+		{Method: "GET", Path: "/api/v1/identity/users/me"},
+		{Method: "POST", Path: "/api/v1/identity/auth/login"},
+		{Method: "GET", Path: "/api/v1/identity/organizations"},
+	})
+
+	require.Len(t, reports, 4)
+	// 按路径排序输出
+	assert.Equal(t, "/api/v1/identity/auth/login", reports[0].Path)
+	assert.Equal(t, "public", reports[0].Decision.MatchedRule)
+	assert.Equal(t, "default:allow", reports[1].Decision.MatchedRule)
+	assert.Equal(t, "roles:/api/v1/identity/users/:userID/status", reports[2].Decision.MatchedRule)
+	assert.Equal(t, []string{"user_admin"}, reports[2].Decision.RequiredRole)
+	assert.Equal(t, "authenticated", reports[3].Decision.MatchedRule)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteRouteReport(&buf, reports))
+	assert.Contains(t, buf.String(), "user_admin")
+	assert.Contains(t, buf.String(), "共 4 条路由：public 1，authenticated 1，roles 1，default:allow 1，default:deny 0")
+}
@@ -24,28 +24,34 @@ type Rules struct {
 	Public        []Endpoint
 	Authenticated []Endpoint
 	Roles         []RolePrefix
+	Permissions   []PermissionRule // 路由 → PDP 权限的显式映射，仅在开启 PDP 时生效
 }
 
 // Endpoint method+path 端点声明
 //
 // Method 取值为大写 HTTP 方法（GET/POST/PUT/DELETE/PATCH 等），
-// "*" 表示任意方法。
+// "*" 表示任意方法。Path 为 Hertz 风格路由模式：:name / * 匹配单个段，
+// *name 匹配剩余全部段（仅限末尾）。
 type Endpoint struct {
 	Method string
 	Path   string
 }
 
 // RolePrefix 路径前缀级角色门禁，Require 之间为 OR 关系
+//
+// Prefix 同样支持 :name / * 段，按段边界做前缀匹配（/api/v1/admin 不会命中
+// /api/v1/administrators）。
 type RolePrefix struct {
 	Prefix  string
 	Require []string
 }
 
 type rawRules struct {
-	Default       string          `yaml:"default"`
-	Public        []string        `yaml:"public"`
-	Authenticated []string        `yaml:"authenticated"`
-	Roles         []rawRolePrefix `yaml:"roles"`
+	Default       string              `yaml:"default"`
+	Public        []string            `yaml:"public"`
+	Authenticated []string            `yaml:"authenticated"`
+	Roles         []rawRolePrefix     `yaml:"roles"`
+	Permissions   []rawPermissionRule `yaml:"permissions"`
 }
 
 type rawRolePrefix struct {
@@ -109,6 +115,10 @@ func ParseRules(data []byte) (*Rules, error) {
 			return nil, fmt.Errorf("roles prefix %q 必须以 / 开头", prefix)
 		}
 
+		if err := validatePattern(prefix); err != nil {
+			return nil, fmt.Errorf("roles prefix %q 解析失败: %w", prefix, err)
+		}
+
 		if len(r.Require) == 0 {
 			return nil, fmt.Errorf("roles 规则 prefix=%s 缺少 require", prefix)
 		}
@@ -119,6 +129,15 @@ func ParseRules(data []byte) (*Rules, error) {
 		})
 	}
 
+	for _, p := range raw.Permissions {
+		rule, err := parsePermissionRule(p)
+		if err != nil {
+			return nil, fmt.Errorf("permissions 规则 %q 解析失败: %w", p.Route, err)
+		}
+
+		rules.Permissions = append(rules.Permissions, rule)
+	}
+
 	return rules, nil
 }
 
@@ -141,30 +160,46 @@ func parseEndpoint(s string) (Endpoint, error) {
 		return Endpoint{}, fmt.Errorf("path 必须以 / 开头")
 	}
 
+	if err := validatePattern(path); err != nil {
+		return Endpoint{}, err
+	}
+
 	return Endpoint{Method: method, Path: path}, nil
 }
 
 // MatchPublic method+path 是否命中 public 列表
 func (r *Rules) MatchPublic(method, path string) bool {
-	return matchEndpoints(r.Public, method, path)
+	_, hit := matchEndpoint(r.Public, method, path)
+	return hit
 }
 
 // MatchAuthenticated 是否命中 authenticated 列表
 func (r *Rules) MatchAuthenticated(method, path string) bool {
-	return matchEndpoints(r.Authenticated, method, path)
+	_, hit := matchEndpoint(r.Authenticated, method, path)
+	return hit
 }
 
-// MatchRolePrefix 命中第一个匹配的角色规则；hit=false 表示无匹配
+// MatchRolePrefix 命中最具体的角色规则；hit=false 表示无匹配
 //
-// 规则按声明顺序匹配，建议把更具体的前缀写在前面。
+// 多条前缀同时命中时取最具体者（逐段比较：字面量 > 参数 > 通配，其次段数多者），
+// 具体程度相同时按声明顺序取第一条。
 func (r *Rules) MatchRolePrefix(path string) (RolePrefix, bool) {
+	var (
+		best RolePrefix
+		hit  bool
+	)
+
 	for _, rp := range r.Roles {
-		if strings.HasPrefix(path, rp.Prefix) {
-			return rp, true
+		if !matchPattern(rp.Prefix, path, true) {
+			continue
+		}
+
+		if !hit || compareSpecificity(rp.Prefix, best.Prefix) > 0 {
+			best, hit = rp, true
 		}
 	}
 
-	return RolePrefix{}, false
+	return best, hit
 }
 
 // HasAnyRole 用户角色与 require 是否有交集
@@ -187,12 +222,32 @@ func (rp RolePrefix) HasAnyRole(userRoles []string) bool {
 	return false
 }
 
-func matchEndpoints(eps []Endpoint, method, path string) bool {
+// matchEndpoint 返回命中的最具体端点；同等具体时精确方法优先于 *
+func matchEndpoint(eps []Endpoint, method, path string) (Endpoint, bool) {
+	var (
+		best Endpoint
+		hit  bool
+	)
+
 	for _, ep := range eps {
-		if (ep.Method == "*" || ep.Method == method) && ep.Path == path {
-			return true
+		if ep.Method != "*" && ep.Method != method {
+			continue
+		}
+
+		if !matchPattern(ep.Path, path, false) {
+			continue
+		}
+
+		if !hit {
+			best, hit = ep, true
+			continue
+		}
+
+		cmp := compareSpecificity(ep.Path, best.Path)
+		if cmp > 0 || (cmp == 0 && best.Method == "*" && ep.Method != "*") {
+			best = ep
 		}
 	}
 
-	return false
+	return best, hit
 }
@@ -154,6 +154,84 @@ func TestRules_MatchRolePrefix(t *testing.T) {
 	assert.False(t, hit)
 }
 
+func TestRules_MatchRoutePatterns(t *testing.T) {
+	rules := &Rules{
+		Public: []Endpoint{
+			{Method: "GET", Path: "/api/v1/identity/organizations/*/logo"},
+			{Method: "GET", Path: "/static/*filepath"},
+		},
+		Authenticated: []Endpoint{
+			{Method: "PUT", Path: "/api/v1/identity/users/:userID/status"},
+		},
+	}
+
+	assert.True(t, rules.MatchAuthenticated("PUT", "/api/v1/identity/users/123/status"))
+	assert.False(t, rules.MatchAuthenticated("PUT", "/api/v1/identity/users/123/status/extra"))
+	assert.False(t, rules.MatchAuthenticated("PUT", "/api/v1/identity/users//status"))
+
+	assert.True(t, rules.MatchPublic("GET", "/api/v1/identity/organizations/org-1/logo"))
+	assert.False(t, rules.MatchPublic("GET", "/api/v1/identity/organizations/org-1/departments"))
+
+	// *name 匹配剩余全部段
+	assert.True(t, rules.MatchPublic("GET", "/static/css/app.css"))
+	assert.True(t, rules.MatchPublic("GET", "/static/"))
+}
+
+func TestRules_MatchRolePrefix_MostSpecificWins(t *testing.T) {
+	rules := &Rules{
+		Roles: []RolePrefix{
+			// 声明顺序故意把宽泛的规则放在前面
+			{Prefix: "/api/v1/identity/", Require: []string{"identity_admin"}},
+			{Prefix: "/api/v1/identity/users/:userID", Require: []string{"user_admin"}},
+			{Prefix: "/api/v1/identity/users/me", Require: []string{"self"}},
+		},
+	}
+
+	rule, hit := rules.MatchRolePrefix("/api/v1/identity/users/me/mfa")
+	require.True(t, hit)
+	assert.Equal(t, "/api/v1/identity/users/me", rule.Prefix)
+
+	rule, hit = rules.MatchRolePrefix("/api/v1/identity/users/123/status")
+	require.True(t, hit)
+	assert.Equal(t, "/api/v1/identity/users/:userID", rule.Prefix)
+
+	rule, hit = rules.MatchRolePrefix("/api/v1/identity/organizations")
+	require.True(t, hit)
+	assert.Equal(t, "/api/v1/identity/", rule.Prefix)
+}
+
+func TestRules_MatchRolePrefix_SegmentBoundary(t *testing.T) {
+	rules := &Rules{
+		Roles: []RolePrefix{{Prefix: "/api/v1/admin", Require: []string{"admin"}}},
+	}
+
+	_, hit := rules.MatchRolePrefix("/api/v1/administrators")
+	assert.False(t, hit)
+
+	_, hit = rules.MatchRolePrefix("/api/v1/admin/users")
+	assert.True(t, hit)
+}
+
+func TestParseRules_InvalidPatterns(t *testing.T) {
+	cases := []struct {
+		name   string
+		yaml   string
+		errSub string
+	}{
+		{"empty param name", "public:\n  - \"GET /users/:\"", "参数段缺少名称"},
+		{"catch-all not last", "public:\n  - \"GET /static/*filepath/x\"", "只能出现在末尾"},
+		{"wildcard inside segment", "authenticated:\n  - \"GET /users/a*b\"", "只能出现在段首"},
+		{"role prefix catch-all not last", "roles:\n  - prefix: /a/*rest/b\n    require: [admin]", "只能出现在末尾"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseRules([]byte(tc.yaml))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.errSub)
+		})
+	}
+}
+
 func TestRolePrefix_HasAnyRole(t *testing.T) {
 	rule := RolePrefix{Require: []string{"admin", "superadmin"}}
 
@@ -199,4 +277,7 @@ func TestLoadRulesFromFile_DefaultRulesYAML(t *testing.T) {
 	assert.True(t, rules.MatchPublic("POST", "/api/v1/identity/auth/login"))
 	// JWKS 应公开
 	assert.True(t, rules.MatchPublic("GET", "/.well-known/jwks.json"))
+	// 本人资料不经 PDP
+	_, mapped := ResolveRoutePermissions(rules, nil, "GET", "/api/v1/identity/users/me")
+	assert.False(t, mapped)
 }
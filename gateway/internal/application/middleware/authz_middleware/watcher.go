@@ -0,0 +1,164 @@
+package middleware
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/rs/zerolog"
+)
+
+// reloadDebounce 合并编辑器保存 / ConfigMap 切换产生的一串文件事件
+const reloadDebounce = 200 * time.Millisecond
+
+// RulesWatcher 监听 authz_rules 文件并在内容变化时热加载
+//
+// 监听的是文件所在目录而非文件本身：编辑器「写临时文件再 rename」与
+// Kubernetes ConfigMap 的 ..data 软链切换都会替换 inode，直接监听文件会丢失后续事件。
+// 解析失败时保留旧规则并记录错误，不会因一次错误编辑放开或锁死整个网关。
+type RulesWatcher struct {
+	path     string
+	onChange func(*Rules)
+	logger   *zerolog.Logger
+
+	watcher *fsnotify.Watcher
+	last    []byte // 上次成功加载的文件内容，用于过滤无实际变化的事件
+
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// WatchRulesFile 开始监听规则文件，规则变化且解析成功时回调 onChange
+func WatchRulesFile(path string, logger *zerolog.Logger, onChange func(*Rules)) (*RulesWatcher, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("解析 authz_rules 路径失败: %w", err)
+	}
+
+	current, err := os.ReadFile(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("读取 authz_rules 文件失败: %w", err)
+	}
+
+	fw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("创建文件监听器失败: %w", err)
+	}
+
+	if err := fw.Add(filepath.Dir(absPath)); err != nil {
+		_ = fw.Close()
+		return nil, fmt.Errorf("监听 authz_rules 目录失败: %w", err)
+	}
+
+	w := &RulesWatcher{
+		path:     absPath,
+		onChange: onChange,
+		logger:   logger,
+		watcher:  fw,
+		last:     current,
+		done:     make(chan struct{}),
+	}
+
+	w.wg.Add(1)
+
+	go w.loop()
+
+	return w, nil
+}
+
+// Close 停止监听
+func (w *RulesWatcher) Close() error {
+	var err error
+
+	w.closeOnce.Do(func() {
+		close(w.done)
+		err = w.watcher.Close()
+		w.wg.Wait()
+	})
+
+	return err
+}
+
+func (w *RulesWatcher) loop() {
+	defer w.wg.Done()
+
+	timer := time.NewTimer(reloadDebounce)
+	timer.Stop()
+
+	for {
+		select {
+		case <-w.done:
+			timer.Stop()
+			return
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+
+			// 目录内其他文件的变化同样可能意味着软链被切换，统一走防抖后的内容比对
+			if event.Has(fsnotify.Chmod) && !event.Has(fsnotify.Write) {
+				continue
+			}
+
+			timer.Reset(reloadDebounce)
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+
+			w.logger.Warn().
+				Str("component", "authz_middleware").
+				Err(err).
+				Msg("AuthZ rules watcher error")
+		case <-timer.C:
+			w.reload()
+		}
+	}
+}
+
+// reload 重新读取并解析规则文件，内容无变化时不触发回调
+func (w *RulesWatcher) reload() {
+	data, err := os.ReadFile(w.path)
+	if err != nil {
+		// 替换过程中文件可能短暂不存在，保留旧规则，等待下一次事件
+		w.logger.Warn().
+			Str("component", "authz_middleware").
+			Str("rules_file", w.path).
+			Err(err).
+			Msg("AuthZ rules file unreadable, keeping previous rules")
+
+		return
+	}
+
+	if bytes.Equal(data, w.last) {
+		return
+	}
+
+	rules, err := ParseRules(data)
+	if err != nil {
+		w.logger.Error().
+			Str("component", "authz_middleware").
+			Str("rules_file", w.path).
+			Err(err).
+			Msg("AuthZ rules reload failed, keeping previous rules")
+
+		return
+	}
+
+	w.last = data
+	w.onChange(rules)
+
+	w.logger.Info().
+		Str("component", "authz_middleware").
+		Str("rules_file", w.path).
+		Int("public", len(rules.Public)).
+		Int("authenticated", len(rules.Authenticated)).
+		Int("roles", len(rules.Roles)).
+		Str("default", string(rules.Default)).
+		Msg("AuthZ rules reloaded")
+}
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeRulesFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	// 模拟编辑器「写临时文件再 rename」的原子替换
+	tmp := path + ".tmp"
+	require.NoError(t, os.WriteFile(tmp, []byte(content), 0o600))
+	require.NoError(t, os.Rename(tmp, path))
+}
+
+func TestWatchRulesFile_ReloadsAndKeepsOldOnError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "authz_rules.yaml")
+	writeRulesFile(t, path, "default: allow\n")
+
+	rules, err := LoadRulesFromFile(path)
+	require.NoError(t, err)
+
+	logger := zerolog.Nop()
+	mw := NewAuthZMiddleware(rules, &logger)
+
+	var reloads atomic.Int32
+
+	watcher, err := WatchRulesFile(path, &logger, func(r *Rules) {
+		reloads.Add(1)
+		mw.UpdateRules(r)
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = watcher.Close() })
+
+	writeRulesFile(t, path, "default: deny\n")
+	require.Eventually(t, func() bool {
+		return mw.Rules().Default == DefaultDeny
+	}, 5*time.Second, 20*time.Millisecond)
+
+	// 非法 YAML：保留上一版规则
+	writeRulesFile(t, path, "default: maybe\n")
+	time.Sleep(4 * reloadDebounce)
+	assert.Equal(t, DefaultDeny, mw.Rules().Default)
+	assert.Equal(t, int32(1), reloads.Load())
+
+	// 修正后恢复热加载
+	writeRulesFile(t, path, "default: allow\npublic:\n  - \"GET /healthz\"\n")
+	require.Eventually(t, func() bool {
+		return mw.Rules().MatchPublic("GET", "/healthz")
+	}, 5*time.Second, 20*time.Millisecond)
+}
+
+func TestWatchRulesFile_MissingFile(t *testing.T) {
+	logger := zerolog.Nop()
+
+	_, err := WatchRulesFile(filepath.Join(t.TempDir(), "missing.yaml"), &logger, func(*Rules) {})
+	require.Error(t, err)
+}
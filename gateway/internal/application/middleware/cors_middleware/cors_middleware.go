@@ -121,7 +121,7 @@ func (cm *CORSMiddleware) setAccessControlHeaders(c *app.RequestContext) {
 	}
 
 	// 设置 Expose-Headers（允许前端访问的响应头）
-	c.Header("Access-Control-Expose-Headers", "Content-Length, Content-Type, X-Request-ID")
+	c.Header("Access-Control-Expose-Headers", "Content-Length, Content-Type, Content-Disposition, X-Request-ID, ETag")
 
 	// 设置 Max-Age
 	c.Header("Access-Control-Max-Age", "86400") // 24小时
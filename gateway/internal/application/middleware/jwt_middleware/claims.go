@@ -27,6 +27,10 @@ func createPayloadFromLoginData(data map[string]interface{}) jwt.MapClaims {
 		claims[Roles] = roles
 	}
 
+	if amr, exists := data[AMR]; exists && amr != nil {
+		claims[AMR] = amr
+	}
+
 	return claims
 }
 
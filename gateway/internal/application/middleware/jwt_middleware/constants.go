@@ -15,12 +15,30 @@ const (
 
 	// Roles 角色 code 列表（不是 role ID）
 	Roles = "roles"
+
+	// AMR 本次登录使用的认证方式（RFC 8176），如 ["pwd"] 或 ["pwd","otp"]
+	AMR = "amr"
+
+	// OrigIssuedAt 令牌签发时间（Unix 秒），由 hertz-contrib/jwt 自动写入
+	OrigIssuedAt = "orig_iat"
+)
+
+// AMR 取值（RFC 8176）
+const (
+	// AMRPassword 密码认证
+	AMRPassword = "pwd"
+
+	// AMROTP 一次性口令（TOTP 或恢复码）
+	AMROTP = "otp"
 )
 
 // Context中存储登录用户信息的键名
 const (
 	// LoginUserContextKey 在 Context 中存储登录用户信息的键名
 	LoginUserContextKey = "login_user_info"
+
+	// LoginClaimsContextKey 在 Context 中存储登录签发的 claims 数据，供签发刷新令牌时快照
+	LoginClaimsContextKey = "login_user_claims"
 )
 
 // JWT 验证通过后注入下游的 HTTP Header（业务侧契约，提案 §5.2）
@@ -39,4 +57,10 @@ const (
 
 	// HeaderUserRoles 角色 code 列表（claims.roles），多个值以英文逗号 "," 拼接
 	HeaderUserRoles = "X-User-Roles"
+
+	// HeaderTokenJTI access token 标识，网关不注入，仅剥离客户端传入的值
+	HeaderTokenJTI = "X-Auth-Token-Jti"
 )
+
+// identityHeaders 全部身份 header，进入网关时一律剥离，只允许由验证通过的 claims 重新注入
+var identityHeaders = []string{HeaderUserID, HeaderUserName, HeaderTenantID, HeaderUserRoles, HeaderTokenJTI}
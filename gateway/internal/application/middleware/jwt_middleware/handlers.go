@@ -30,9 +30,30 @@ func createTokenInfo(token string, expire time.Time) *http_base.TokenInfoDTO {
 	}
 }
 
+// withRefreshToken 在Token信息中附加刷新令牌
+func withRefreshToken(
+	tokenInfo *http_base.TokenInfoDTO,
+	refreshToken string,
+	refreshExpire time.Time,
+) *http_base.TokenInfoDTO {
+	if refreshToken == "" {
+		return tokenInfo
+	}
+
+	refreshExpiresIn := int64(time.Until(refreshExpire).Seconds())
+	tokenInfo.RefreshToken = &refreshToken
+	tokenInfo.RefreshExpiresIn = &refreshExpiresIn
+
+	return tokenInfo
+}
+
 // loginResponseHandler 登录响应处理函数
-func loginResponseHandler(
-	_ context.Context,
+//
+// 在 access token 之外为本次登录创建刷新令牌族，claims 快照随族保存，
+// 刷新时据此重新签发 access token。刷新令牌签发失败时登录整体失败，
+// 避免客户端拿到无法续期的会话。
+func (m *JWTMiddlewareImpl) loginResponseHandler(
+	ctx context.Context,
 	c *app.RequestContext,
 	_ int,
 	token string,
@@ -41,6 +62,25 @@ func loginResponseHandler(
 	// 构造Token信息
 	tokenInfo := createTokenInfo(token, expire)
 
+	if claimsVal, exists := c.Get(LoginClaimsContextKey); exists {
+		if claims, ok := claimsVal.(map[string]interface{}); ok {
+			userID, _ := claims[IdentityKey].(string)
+
+			refreshToken, session, err := m.refreshStore.Issue(ctx, userID, claims, m.jwtConfig.MaxRefresh)
+			if err != nil {
+				tracelog.Event(ctx, m.logger.Error()).
+					Str("component", "jwt_middleware").
+					Err(err).
+					Msg("Failed to issue refresh token")
+				errors.AbortWithError(c, errors.ErrJWTCreationFail)
+
+				return
+			}
+
+			tokenInfo = withRefreshToken(tokenInfo, refreshToken, session.ExpiresAt)
+		}
+	}
+
 	// 从context中获取登录响应
 	if userVal, exists := c.Get(LoginUserContextKey); exists {
 		if loginResp, ok := userVal.(*identity.LoginResponseDTO); ok {
@@ -81,12 +121,13 @@ func logoutResponseHandler(
 func refreshResponseHandler(
 	_ context.Context,
 	c *app.RequestContext,
-	_ int,
 	token string,
 	expire time.Time,
+	refreshToken string,
+	refreshExpire time.Time,
 ) {
 	// 构造新的Token信息
-	tokenInfo := createTokenInfo(token, expire)
+	tokenInfo := withRefreshToken(createTokenInfo(token, expire), refreshToken, refreshExpire)
 
 	// 构造刷新Token响应
 	response := &identity.RefreshTokenResponseDTO{
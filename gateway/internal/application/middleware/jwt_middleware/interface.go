@@ -17,10 +17,28 @@ type JWTMiddlewareService interface {
 	LoginHandler(ctx context.Context, c *app.RequestContext)
 	LogoutHandler(ctx context.Context, c *app.RequestContext)
 	RefreshHandler(ctx context.Context, c *app.RequestContext)
+	SwitchTenantHandler(ctx context.Context, c *app.RequestContext)
+	MFAVerifyHandler(ctx context.Context, c *app.RequestContext)
+	MFAEnrollHandler(ctx context.Context, c *app.RequestContext)
+	ConfirmPasswordResetHandler(ctx context.Context, c *app.RequestContext)
 
 	// JWKSHandler 返回 JWKS 端点 handler
 	JWKSHandler() app.HandlerFunc
 }
 
+// PublicRouteMatcher 公开路由判定
+//
+// 公开路由只在 authz_rules.yaml 的 public 列表中维护，JWT 中间件据此跳过认证，
+// 由 authz 中间件实现（随规则热加载同步生效）。
+type PublicRouteMatcher interface {
+	MatchPublic(method, path string) bool
+}
+
 // TokenCacheService Token缓存服务接口（直接使用redis包的接口）
 type TokenCacheService = redis.TokenCacheService
+
+// RefreshTokenStore 刷新令牌存储接口（直接使用redis包的接口）
+type RefreshTokenStore = redis.RefreshTokenStore
+
+// MFAChallengeStore MFA 登录挑战存储接口（直接使用redis包的接口）
+type MFAChallengeStore = redis.MFAChallengeStore
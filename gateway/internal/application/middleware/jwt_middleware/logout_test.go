@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func logoutRequest(m *JWTMiddlewareImpl, accessToken string) *app.RequestContext {
+	c := app.NewContext(0)
+	c.Request.SetMethod(http.MethodPost)
+	c.Request.SetRequestURI("/api/v1/identity/auth/logout")
+	if accessToken != "" {
+		c.Request.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+
+	m.LogoutHandler(context.Background(), c)
+
+	return c
+}
+
+// protectedRequest 经过完整的 JWT 中间件（含吊销检查）访问受保护路由
+func protectedRequest(m *JWTMiddlewareImpl, accessToken string) *app.RequestContext {
+	c := app.NewContext(0)
+	c.Request.SetMethod(http.MethodGet)
+	c.Request.SetRequestURI("/api/v1/identity/users/me")
+	c.Request.Header.Set("Authorization", "Bearer "+accessToken)
+
+	m.MiddlewareFunc()(context.Background(), c)
+
+	return c
+}
+
+// 登出是公开路由，JWT 中间件不解析令牌；LogoutHandler 须自行校验令牌并吊销
+func TestLogoutHandler_RevokesAccessToken(t *testing.T) {
+	cache := &fakeTokenCache{revoked: map[string]time.Duration{}}
+	m := newTestJWTMiddlewareWithDeps(t, nil, cache, newFakeRefreshStore())
+
+	accessToken, _, err := m.mw.TokenGenerator(map[string]interface{}{
+		IdentityKey: "user-1",
+		Username:    "alice",
+	})
+	require.NoError(t, err)
+
+	c := protectedRequest(m, accessToken)
+	require.False(t, c.IsAborted(), "登出前令牌应有效")
+
+	c = logoutRequest(m, accessToken)
+	require.Equal(t, http.StatusOK, c.Response.StatusCode())
+	require.Contains(t, cache.revoked, accessToken, "登出应吊销 access token")
+	assert.Greater(t, cache.revoked[accessToken], time.Duration(0))
+
+	c = protectedRequest(m, accessToken)
+	assert.True(t, c.IsAborted())
+	assert.Equal(t, http.StatusUnauthorized, c.Response.StatusCode())
+}
+
+func TestLogoutHandler_InvalidTokenNotRevoked(t *testing.T) {
+	cache := &fakeTokenCache{revoked: map[string]time.Duration{}}
+	m := newTestJWTMiddlewareWithDeps(t, nil, cache, newFakeRefreshStore())
+
+	c := logoutRequest(m, "not-a-jwt")
+
+	assert.Equal(t, http.StatusOK, c.Response.StatusCode())
+	assert.Empty(t, cache.revoked)
+}
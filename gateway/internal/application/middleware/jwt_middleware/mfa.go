@@ -0,0 +1,257 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/biz/model/identity"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/errors"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/redis"
+	tracelog "github.com/masonsxu/cloudwego-microservice-demo/gateway/pkg/log"
+)
+
+// loginResponseFromContext 读取 Authenticator 写入 Context 的登录响应
+func loginResponseFromContext(c *app.RequestContext) *identity.LoginResponseDTO {
+	userVal, exists := c.Get(LoginUserContextKey)
+	if !exists {
+		return nil
+	}
+
+	loginResp, _ := userVal.(*identity.LoginResponseDTO)
+
+	return loginResp
+}
+
+// startMFAChallenge 密码校验通过但仍需第二因素：保存挑战并只返回挑战令牌
+func (m *JWTMiddlewareImpl) startMFAChallenge(
+	ctx context.Context,
+	c *app.RequestContext,
+	claims map[string]interface{},
+	loginResp *identity.LoginResponseDTO,
+) {
+	enrollment := loginResp.GetMfaChallenge().GetEnrollmentRequired()
+
+	// 挑战中保存完整登录响应（不含挑战本身），第二步通过后原样返回
+	loginResp.MfaChallenge = nil
+
+	respData, err := json.Marshal(loginResp)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInternal)
+		return
+	}
+
+	userID, _ := claims[IdentityKey].(string)
+
+	challengeToken, err := m.mfaStore.Create(ctx, &redis.MFAChallenge{
+		UserID:     userID,
+		Enrollment: enrollment,
+		Claims:     claims,
+		Response:   respData,
+	}, m.jwtConfig.MFAChallengeTTL)
+	if err != nil {
+		tracelog.Event(ctx, m.logger.Error()).
+			Str("component", "jwt_middleware").
+			Err(err).
+			Msg("Failed to create MFA challenge")
+		errors.AbortWithError(c, errors.ErrInternal)
+
+		return
+	}
+
+	expiresIn := int64(m.jwtConfig.MFAChallengeTTL.Seconds())
+
+	c.JSON(http.StatusOK, &identity.LoginResponseDTO{
+		BaseResp: loginResp.BaseResp,
+		MfaChallenge: &identity.MFAChallengeDTO{
+			ChallengeToken:     &challengeToken,
+			ExpiresIn:          &expiresIn,
+			EnrollmentRequired: &enrollment,
+		},
+	})
+}
+
+// loadMFAChallenge 读取挑战，无效时直接写回错误响应并返回 nil
+func (m *JWTMiddlewareImpl) loadMFAChallenge(
+	ctx context.Context,
+	c *app.RequestContext,
+	challengeToken string,
+) *redis.MFAChallenge {
+	challenge, err := m.mfaStore.Get(ctx, challengeToken)
+	if err != nil {
+		m.abortMFAStoreError(ctx, c, err, "Failed to load MFA challenge")
+		return nil
+	}
+
+	return challenge
+}
+
+// abortMFAStoreError 挑战存储出错时一律拒绝：挑战失效返回 ErrMFAChallengeInvalid，其余返回内部错误
+func (m *JWTMiddlewareImpl) abortMFAStoreError(
+	ctx context.Context,
+	c *app.RequestContext,
+	err error,
+	msg string,
+) {
+	if stderrors.Is(err, redis.ErrMFAChallengeInvalid) {
+		errors.AbortWithError(c, errors.ErrMFAChallengeInvalid)
+		return
+	}
+
+	tracelog.Event(ctx, m.logger.Error()).
+		Str("component", "jwt_middleware").
+		Err(err).
+		Msg(msg)
+	errors.AbortWithError(c, errors.ErrInternal)
+}
+
+// MFAEnrollHandler 在登录挑战中发起 MFA 登记
+//
+// 仅用于组织强制 MFA 但用户尚未登记的挑战：返回 TOTP 密钥与 otpauth URI，
+// 用户把密钥添加到验证器后，凭同一挑战令牌调用 MFAVerifyHandler 完成确认与登录。
+func (m *JWTMiddlewareImpl) MFAEnrollHandler(ctx context.Context, c *app.RequestContext) {
+	var req identity.MFAEnrollRequestDTO
+	if err := c.BindAndValidate(&req); err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	challenge := m.loadMFAChallenge(ctx, c, req.GetChallengeToken())
+	if challenge == nil {
+		return
+	}
+
+	if !challenge.Enrollment {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage("当前登录无需登记多因素认证"))
+		return
+	}
+
+	resp, err := m.authService.EnrollMFA(ctx, challenge.UserID)
+	if err != nil {
+		errors.HandleServiceError(c, err, "发起多因素认证登记失败")
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// MFAVerifyHandler 登录第二步：校验 TOTP 口令或恢复码后签发令牌
+//
+// 登记挑战在此确认登记并随响应返回一次性恢复码。每次校验前先原子地占用一次校验机会，
+// 并发猜测也无法超过上限；次数耗尽后挑战作废，需重新输入密码登录。
+// 校验通过后原子地兑换挑战，同一挑战只能签发一次令牌。挑战存储出错时一律拒绝。
+func (m *JWTMiddlewareImpl) MFAVerifyHandler(ctx context.Context, c *app.RequestContext) {
+	var req identity.MFAVerifyRequestDTO
+	if err := c.BindAndValidate(&req); err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	challengeToken := req.GetChallengeToken()
+
+	challenge := m.loadMFAChallenge(ctx, c, challengeToken)
+	if challenge == nil {
+		return
+	}
+
+	attempts, err := m.mfaStore.ReserveAttempt(ctx, challengeToken)
+	if err != nil {
+		m.abortMFAStoreError(ctx, c, err, "Failed to reserve MFA attempt")
+		return
+	}
+
+	if attempts > m.jwtConfig.MFAMaxAttempts {
+		m.exhaustMFAChallenge(ctx, c, challengeToken, attempts)
+		return
+	}
+
+	var recoveryCodes []string
+
+	if challenge.Enrollment {
+		resp, err := m.authService.ConfirmMFA(ctx, challenge.UserID, req.GetCode())
+		if err != nil {
+			m.handleMFAFailure(ctx, c, challengeToken, attempts, err)
+			return
+		}
+
+		recoveryCodes = resp.GetRecoveryCodes()
+	} else if err := m.authService.VerifyMFA(ctx, challenge.UserID, req.GetCode()); err != nil {
+		m.handleMFAFailure(ctx, c, challengeToken, attempts, err)
+		return
+	}
+
+	// 兑换失败说明挑战已被并发请求兑换或已过期，不得签发令牌
+	challenge, err = m.mfaStore.Consume(ctx, challengeToken)
+	if err != nil {
+		m.abortMFAStoreError(ctx, c, err, "Failed to consume MFA challenge")
+		return
+	}
+
+	var loginResp identity.LoginResponseDTO
+	if err := json.Unmarshal(challenge.Response, &loginResp); err != nil {
+		errors.AbortWithError(c, errors.ErrInternal)
+		return
+	}
+
+	loginResp.MfaRecoveryCodes = recoveryCodes
+	c.Set(LoginUserContextKey, &loginResp)
+
+	claims := challenge.Claims
+	claims[AMR] = []string{AMRPassword, AMROTP}
+
+	tracelog.Event(ctx, m.logger.Info()).
+		Str("component", "jwt_middleware").
+		Str("user_id", challenge.UserID).
+		Bool("enrollment", challenge.Enrollment).
+		Msg("MFA verification succeeded")
+
+	m.issueLoginToken(ctx, c, claims)
+}
+
+// handleMFAFailure 处理第二因素校验失败：本次已占用最后一次机会时作废挑战
+func (m *JWTMiddlewareImpl) handleMFAFailure(
+	ctx context.Context,
+	c *app.RequestContext,
+	challengeToken string,
+	attempts int,
+	err error,
+) {
+	var apiErr errors.APIError
+	if !stderrors.As(err, &apiErr) || apiErr.Code() != errors.CodeRPCInvalidMFACode {
+		errors.HandleServiceError(c, err, "多因素认证校验失败")
+		return
+	}
+
+	if attempts >= m.jwtConfig.MFAMaxAttempts {
+		m.exhaustMFAChallenge(ctx, c, challengeToken, attempts)
+		return
+	}
+
+	errors.HandleServiceError(c, err, "多因素认证校验失败")
+}
+
+// exhaustMFAChallenge 校验次数耗尽：删除挑战并拒绝
+//
+// 删除失败时挑战仍会因次数超限在后续占用时被拒绝，直至过期。
+func (m *JWTMiddlewareImpl) exhaustMFAChallenge(
+	ctx context.Context,
+	c *app.RequestContext,
+	challengeToken string,
+	attempts int,
+) {
+	if err := m.mfaStore.Delete(ctx, challengeToken); err != nil {
+		tracelog.Event(ctx, m.logger.Warn()).
+			Str("component", "jwt_middleware").
+			Err(err).
+			Msg("Failed to delete exhausted MFA challenge")
+	}
+
+	tracelog.Event(ctx, m.logger.Warn()).
+		Str("component", "jwt_middleware").
+		Int("attempts", attempts).
+		Msg("MFA challenge exhausted")
+	errors.AbortWithError(c, errors.ErrMFAChallengeInvalid)
+}
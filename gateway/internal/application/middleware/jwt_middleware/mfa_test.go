@@ -0,0 +1,317 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/biz/model/identity"
+	authservice "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/domain/service/identity"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/errors"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/redis"
+)
+
+const testMFACode = "123456"
+
+// fakeMFAStore 内存版 MFA 挑战存储，claims 经 JSON 往返以模拟 Redis 序列化
+type fakeMFAStore struct {
+	challenges map[string]*redis.MFAChallenge
+	seq        int
+
+	// storeErr 非空时 ReserveAttempt / Consume 返回该错误，模拟 Redis 故障
+	storeErr error
+}
+
+func newFakeMFAStore() *fakeMFAStore {
+	return &fakeMFAStore{challenges: map[string]*redis.MFAChallenge{}}
+}
+
+func (s *fakeMFAStore) Create(_ context.Context, challenge *redis.MFAChallenge, _ time.Duration) (string, error) {
+	data, err := json.Marshal(challenge)
+	if err != nil {
+		return "", err
+	}
+
+	var stored redis.MFAChallenge
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return "", err
+	}
+
+	s.seq++
+	token := "mfa-" + strconv.Itoa(s.seq)
+	s.challenges[token] = &stored
+
+	return token, nil
+}
+
+func (s *fakeMFAStore) Get(_ context.Context, token string) (*redis.MFAChallenge, error) {
+	challenge, ok := s.challenges[token]
+	if !ok {
+		return nil, redis.ErrMFAChallengeInvalid
+	}
+
+	return challenge, nil
+}
+
+func (s *fakeMFAStore) ReserveAttempt(_ context.Context, token string) (int, error) {
+	if s.storeErr != nil {
+		return 0, s.storeErr
+	}
+
+	challenge, ok := s.challenges[token]
+	if !ok {
+		return 0, redis.ErrMFAChallengeInvalid
+	}
+
+	challenge.Attempts++
+
+	return challenge.Attempts, nil
+}
+
+func (s *fakeMFAStore) Consume(_ context.Context, token string) (*redis.MFAChallenge, error) {
+	if s.storeErr != nil {
+		return nil, s.storeErr
+	}
+
+	challenge, ok := s.challenges[token]
+	if !ok {
+		return nil, redis.ErrMFAChallengeInvalid
+	}
+
+	delete(s.challenges, token)
+
+	return challenge, nil
+}
+
+func (s *fakeMFAStore) Delete(_ context.Context, token string) error {
+	delete(s.challenges, token)
+	return nil
+}
+
+// fakeMFAAuthService 仅实现登录与 MFA 相关方法
+type fakeMFAAuthService struct {
+	authservice.AuthService
+
+	mfaEnabled  bool
+	orgRequired bool
+}
+
+func (f *fakeMFAAuthService) Login(
+	_ context.Context,
+	req *identity.LoginRequestDTO,
+) (*identity.LoginResponseDTO, authservice.Permission, error) {
+	userID := "user-1"
+	username := req.GetUsername()
+	orgID := testOrgA
+	primary := true
+
+	resp := &identity.LoginResponseDTO{
+		UserProfile: &identity.UserProfileDTO{Id: &userID, Username: &username},
+		Memberships: []*identity.UserMembershipDTO{
+			{OrganizationID: &orgID, IsPrimary: &primary},
+		},
+		RoleIDs: []string{"doctor"},
+	}
+
+	if f.mfaEnabled || f.orgRequired {
+		enrollment := !f.mfaEnabled
+		resp.MfaChallenge = &identity.MFAChallengeDTO{EnrollmentRequired: &enrollment}
+	}
+
+	return resp, "", nil
+}
+
+func (f *fakeMFAAuthService) VerifyMFA(_ context.Context, _ string, code string) error {
+	if code != testMFACode {
+		return errors.NewAPIError(errors.CodeRPCInvalidMFACode, "验证码错误")
+	}
+
+	return nil
+}
+
+func (f *fakeMFAAuthService) ConfirmMFA(
+	_ context.Context,
+	_ string,
+	code string,
+) (*identity.ConfirmMFAResponseDTO, error) {
+	if code != testMFACode {
+		return nil, errors.NewAPIError(errors.CodeRPCInvalidMFACode, "验证码错误")
+	}
+
+	return &identity.ConfirmMFAResponseDTO{RecoveryCodes: []string{"abcd-efgh"}}, nil
+}
+
+func postJSON(body string, handler func(context.Context, *app.RequestContext)) *app.RequestContext {
+	c := app.NewContext(0)
+	c.Request.SetMethod(http.MethodPost)
+	c.Request.Header.SetContentTypeBytes([]byte("application/json"))
+	c.Request.SetBodyString(body)
+	c.Request.Header.SetContentLength(len(body))
+
+	handler(context.Background(), c)
+
+	return c
+}
+
+func loginRequest(m *JWTMiddlewareImpl) *app.RequestContext {
+	return postJSON(`{"username":"alice","password":"secret"}`, m.LoginHandler)
+}
+
+func verifyMFARequest(m *JWTMiddlewareImpl, challengeToken, code string) *app.RequestContext {
+	return postJSON(`{"challenge_token":"`+challengeToken+`","code":"`+code+`"}`, m.MFAVerifyHandler)
+}
+
+// accessTokenAMR 解析登录响应中的 access token 并返回 amr 声明
+func accessTokenAMR(t *testing.T, m *JWTMiddlewareImpl, c *app.RequestContext) []interface{} {
+	t.Helper()
+
+	var resp identity.LoginResponseDTO
+	require.NoError(t, json.Unmarshal(c.Response.Body(), &resp))
+	require.NotEmpty(t, resp.GetTokenInfo().GetAccessToken(), string(c.Response.Body()))
+
+	token, err := m.mw.ParseTokenString(resp.GetTokenInfo().GetAccessToken())
+	require.NoError(t, err)
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	require.True(t, ok)
+
+	amr, _ := claims[AMR].([]interface{})
+
+	return amr
+}
+
+// startChallenge 登录并返回 MFA 挑战
+func startChallenge(t *testing.T, m *JWTMiddlewareImpl) *identity.MFAChallengeDTO {
+	t.Helper()
+
+	c := loginRequest(m)
+	require.Equal(t, http.StatusOK, c.Response.StatusCode(), string(c.Response.Body()))
+
+	var resp identity.LoginResponseDTO
+	require.NoError(t, json.Unmarshal(c.Response.Body(), &resp))
+	require.NotNil(t, resp.GetMfaChallenge())
+	assert.Nil(t, resp.GetTokenInfo(), "挑战阶段不得签发令牌")
+	assert.Nil(t, resp.GetUserProfile(), "挑战阶段不得返回用户信息")
+
+	return resp.GetMfaChallenge()
+}
+
+func TestLoginHandler_PasswordOnly(t *testing.T) {
+	m := newTestJWTMiddlewareWithDeps(t, &fakeMFAAuthService{}, nil, newFakeRefreshStore())
+
+	c := loginRequest(m)
+	require.Equal(t, http.StatusOK, c.Response.StatusCode(), string(c.Response.Body()))
+
+	assert.Equal(t, []interface{}{AMRPassword}, accessTokenAMR(t, m, c))
+}
+
+func TestMFAVerifyHandler_IssuesTokenWithOTP(t *testing.T) {
+	m := newTestJWTMiddlewareWithDeps(t, &fakeMFAAuthService{mfaEnabled: true}, nil, newFakeRefreshStore())
+
+	challenge := startChallenge(t, m)
+	assert.False(t, challenge.GetEnrollmentRequired())
+	assert.Equal(t, int64(300), challenge.GetExpiresIn())
+
+	c := verifyMFARequest(m, challenge.GetChallengeToken(), testMFACode)
+	require.Equal(t, http.StatusOK, c.Response.StatusCode(), string(c.Response.Body()))
+
+	assert.Equal(t, []interface{}{AMRPassword, AMROTP}, accessTokenAMR(t, m, c))
+
+	var resp identity.LoginResponseDTO
+	require.NoError(t, json.Unmarshal(c.Response.Body(), &resp))
+	assert.Equal(t, "alice", resp.GetUserProfile().GetUsername())
+	assert.NotEmpty(t, resp.GetTokenInfo().GetRefreshToken())
+
+	// 挑战只能使用一次
+	c = verifyMFARequest(m, challenge.GetChallengeToken(), testMFACode)
+	assert.Equal(t, http.StatusUnauthorized, c.Response.StatusCode())
+	assert.Contains(t, string(c.Response.Body()), `"code":102012`)
+}
+
+func TestMFAVerifyHandler_AttemptsExhausted(t *testing.T) {
+	m := newTestJWTMiddlewareWithDeps(t, &fakeMFAAuthService{mfaEnabled: true}, nil, newFakeRefreshStore())
+
+	challenge := startChallenge(t, m)
+
+	// 前两次错误仅提示口令错误
+	for range 2 {
+		c := verifyMFARequest(m, challenge.GetChallengeToken(), "000000")
+		assert.Equal(t, http.StatusUnauthorized, c.Response.StatusCode())
+		assert.Contains(t, string(c.Response.Body()), `"code":201023`)
+	}
+
+	// 第三次达到上限，挑战作废
+	c := verifyMFARequest(m, challenge.GetChallengeToken(), "000000")
+	assert.Equal(t, http.StatusUnauthorized, c.Response.StatusCode())
+	assert.Contains(t, string(c.Response.Body()), `"code":102012`)
+
+	// 作废后即使口令正确也无法登录
+	c = verifyMFARequest(m, challenge.GetChallengeToken(), testMFACode)
+	assert.Contains(t, string(c.Response.Body()), `"code":102012`)
+}
+
+func TestMFAVerifyHandler_ReservedAttemptsExhausted(t *testing.T) {
+	m := newTestJWTMiddlewareWithDeps(t, &fakeMFAAuthService{mfaEnabled: true}, nil, newFakeRefreshStore())
+	store, ok := m.mfaStore.(*fakeMFAStore)
+	require.True(t, ok)
+
+	challenge := startChallenge(t, m)
+
+	// 并发请求已占满校验机会，即使口令正确也不得再校验
+	store.challenges[challenge.GetChallengeToken()].Attempts = m.jwtConfig.MFAMaxAttempts
+
+	c := verifyMFARequest(m, challenge.GetChallengeToken(), testMFACode)
+	assert.Equal(t, http.StatusUnauthorized, c.Response.StatusCode())
+	assert.Contains(t, string(c.Response.Body()), `"code":102012`)
+	assert.Empty(t, store.challenges)
+}
+
+func TestMFAVerifyHandler_StoreErrorFailsClosed(t *testing.T) {
+	m := newTestJWTMiddlewareWithDeps(t, &fakeMFAAuthService{mfaEnabled: true}, nil, newFakeRefreshStore())
+	store, ok := m.mfaStore.(*fakeMFAStore)
+	require.True(t, ok)
+
+	challenge := startChallenge(t, m)
+	store.storeErr = stderrors.New("redis unavailable")
+
+	c := verifyMFARequest(m, challenge.GetChallengeToken(), testMFACode)
+	assert.Equal(t, errors.GetHTTPStatus(errors.CodeInternalError), c.Response.StatusCode())
+
+	var resp identity.LoginResponseDTO
+	require.NoError(t, json.Unmarshal(c.Response.Body(), &resp))
+	assert.Nil(t, resp.GetTokenInfo())
+}
+
+func TestMFAVerifyHandler_EnrollmentReturnsRecoveryCodes(t *testing.T) {
+	m := newTestJWTMiddlewareWithDeps(t, &fakeMFAAuthService{orgRequired: true}, nil, newFakeRefreshStore())
+
+	challenge := startChallenge(t, m)
+	assert.True(t, challenge.GetEnrollmentRequired())
+
+	c := verifyMFARequest(m, challenge.GetChallengeToken(), testMFACode)
+	require.Equal(t, http.StatusOK, c.Response.StatusCode(), string(c.Response.Body()))
+
+	var resp identity.LoginResponseDTO
+	require.NoError(t, json.Unmarshal(c.Response.Body(), &resp))
+	assert.Equal(t, []string{"abcd-efgh"}, resp.GetMfaRecoveryCodes())
+	assert.Equal(t, []interface{}{AMRPassword, AMROTP}, accessTokenAMR(t, m, c))
+}
+
+func TestMFAEnrollHandler_RejectsNonEnrollmentChallenge(t *testing.T) {
+	m := newTestJWTMiddlewareWithDeps(t, &fakeMFAAuthService{mfaEnabled: true}, nil, newFakeRefreshStore())
+
+	challenge := startChallenge(t, m)
+
+	c := postJSON(`{"challenge_token":"`+challenge.GetChallengeToken()+`"}`, m.MFAEnrollHandler)
+
+	assert.Equal(t, errors.GetHTTPStatus(errors.CodeInvalidParams), c.Response.StatusCode())
+}
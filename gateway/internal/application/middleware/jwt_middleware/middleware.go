@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	stderrors "errors"
 	"net/http"
 	"time"
 
@@ -10,17 +11,24 @@ import (
 	"github.com/hertz-contrib/jwt"
 	"github.com/rs/zerolog"
 
-	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/application/middleware/common"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/biz/model/identity"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/application/context/auth_context"
+	authservice "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/domain/service/identity"
 	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/config"
 	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/errors"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/redis"
 	tracelog "github.com/masonsxu/cloudwego-microservice-demo/gateway/pkg/log"
 )
 
 // JWTMiddlewareImpl JWT中间件实现
 type JWTMiddlewareImpl struct {
+	authService    authservice.AuthService
 	jwtConfig      *config.JWTConfig
 	mw             *jwt.HertzJWTMiddleware
 	tokenCache     TokenCacheService
+	refreshStore   RefreshTokenStore
+	mfaStore       MFAChallengeStore
+	publicRoutes   PublicRouteMatcher
 	tokenExtractor TokenExtractor
 	logger         *zerolog.Logger
 }
@@ -28,13 +36,17 @@ type JWTMiddlewareImpl struct {
 // MiddlewareFunc 返回JWT认证中间件函数
 func (m *JWTMiddlewareImpl) MiddlewareFunc() app.HandlerFunc {
 	return func(ctx context.Context, c *app.RequestContext) {
-		// 检查是否需要跳过认证
-		shouldSkip := common.ShouldSkip(c, m.jwtConfig.SkipPaths)
+		// 身份 header 只能由网关根据验证通过的 claims 注入，公开路由同样不得透传客户端传入的值
+		stripIdentityHeaders(c)
+
+		// 公开路由（authz public 列表）跳过认证
+		method := string(c.Request.Method())
+		path := string(c.Request.URI().Path())
+		shouldSkip := m.publicRoutes.MatchPublic(method, path)
 		tracelog.Event(ctx, m.logger.Debug()).
 			Str("component", "jwt_middleware").
-			Str("method", string(c.Request.Method())).
-			Str("path", string(c.Request.URI().Path())).
-			Strs("skip_paths", m.jwtConfig.SkipPaths).
+			Str("method", method).
+			Str("path", path).
 			Bool("should_skip", shouldSkip).
 			Msg("JWT skip decision")
 
@@ -63,16 +75,85 @@ func (m *JWTMiddlewareImpl) MiddlewareFunc() app.HandlerFunc {
 }
 
 // LoginHandler 处理登录请求
+//
+// 密码校验通过后，若用户已启用 MFA 或所属组织强制 MFA，只返回 MFA 挑战而不签发令牌，
+// 客户端凭挑战令牌调用 MFAVerifyHandler 完成第二步；否则直接签发 amr=["pwd"] 的令牌。
 func (m *JWTMiddlewareImpl) LoginHandler(ctx context.Context, c *app.RequestContext) {
-	m.mw.LoginHandler(ctx, c)
+	data, err := m.mw.Authenticator(ctx, c)
+	if err != nil {
+		customHTTPStatusMessageFunc(err, ctx, c, m.logger)
+		return
+	}
+
+	claims, _ := data.(map[string]interface{})
+
+	if loginResp := loginResponseFromContext(c); loginResp != nil && loginResp.MfaChallenge != nil {
+		m.startMFAChallenge(ctx, c, claims, loginResp)
+		return
+	}
+
+	claims[AMR] = []string{AMRPassword}
+	m.issueLoginToken(ctx, c, claims)
+}
+
+// issueLoginToken 签发 access token 并写回登录响应（含刷新令牌族）
+func (m *JWTMiddlewareImpl) issueLoginToken(
+	ctx context.Context,
+	c *app.RequestContext,
+	claims map[string]interface{},
+) {
+	token, expire, err := m.mw.TokenGenerator(claims)
+	if err != nil {
+		tracelog.Event(ctx, m.logger.Error()).
+			Str("component", "jwt_middleware").
+			Err(err).
+			Msg("Failed to generate access token on login")
+		errors.AbortWithError(c, errors.ErrJWTCreationFail)
+
+		return
+	}
+
+	c.Set(LoginClaimsContextKey, claims)
+	m.setTokenCookie(c, token)
+	m.loginResponseHandler(ctx, c, http.StatusOK, token, expire)
+}
+
+// setTokenCookie 按配置把 access token 写入 Cookie
+func (m *JWTMiddlewareImpl) setTokenCookie(c *app.RequestContext, token string) {
+	if !m.jwtConfig.Cookie.SendCookie {
+		return
+	}
+
+	c.SetCookie(
+		m.jwtConfig.Cookie.CookieName,
+		token,
+		int(m.jwtConfig.Cookie.CookieMaxAge.Seconds()),
+		m.jwtConfig.Cookie.CookiePath,
+		m.jwtConfig.Cookie.CookieDomain,
+		protocol.CookieSameSite(parseSameSite(m.jwtConfig.Cookie.CookieSameSite)),
+		m.jwtConfig.Cookie.SecureCookie,
+		m.jwtConfig.Cookie.CookieHTTPOnly,
+	)
 }
 
 // LogoutHandler 处理登出请求
-// 从请求中提取Token并吊销，清除Cookie，即使Token无效也返回成功响应
+// 从请求中提取Token并吊销，同时吊销请求体中刷新令牌所属的令牌族，
+// 清除Cookie，即使Token无效也返回成功响应
 func (m *JWTMiddlewareImpl) LogoutHandler(ctx context.Context, c *app.RequestContext) {
 	// 从请求中提取token字符串
 	tokenString := m.tokenExtractor.ExtractToken(c)
 
+	// 吊销刷新令牌族（登出不因请求体校验失败而失败）
+	var req identity.LogoutRequestDTO
+	if err := c.Bind(&req); err == nil && req.GetRefreshToken() != "" {
+		if err := m.refreshStore.Revoke(ctx, req.GetRefreshToken()); err != nil {
+			tracelog.Event(ctx, m.logger.Error()).
+				Str("component", "jwt_middleware").
+				Err(err).
+				Msg("Failed to revoke refresh token family during logout")
+		}
+	}
+
 	// 清除Cookie（无论是否有token，都清除Cookie）
 	if m.jwtConfig.Cookie.SendCookie && m.jwtConfig.Cookie.CookieName != "" {
 		c.SetCookie(
@@ -100,12 +181,14 @@ func (m *JWTMiddlewareImpl) LogoutHandler(ctx context.Context, c *app.RequestCon
 		return
 	}
 
-	// 使用 hertz-contrib/jwt 提供的 ExtractClaims 获取 claims
-	claims := jwt.ExtractClaims(ctx, c)
-	if claims == nil {
-		tracelog.Event(ctx, m.logger.Warn()).
+	// 登出是公开路由，JWT 中间件不会解析令牌（上下文中没有 JWT_PAYLOAD），
+	// 这里自行校验签名并取出 claims；无效或已过期的令牌无需吊销
+	claims, err := m.mw.GetClaimsFromJWT(ctx, c)
+	if err != nil {
+		tracelog.Event(ctx, m.logger.Debug()).
 			Str("component", "jwt_middleware").
-			Msg("Failed to extract claims from token")
+			Err(err).
+			Msg("Token invalid or expired during logout, no need to revoke")
 		logoutResponseHandler(ctx, c, http.StatusOK)
 
 		return
@@ -180,8 +263,273 @@ func (m *JWTMiddlewareImpl) LogoutHandler(ctx context.Context, c *app.RequestCon
 }
 
 // RefreshHandler 处理刷新Token请求
+//
+// 使用不透明刷新令牌换取新的 access token，并一次性轮换刷新令牌。
+// 已轮换过的刷新令牌再次出现视为泄露，整个令牌族被吊销，客户端必须重新登录。
+// 新 access token 的租户角色按用户当前状态重新计算，不沿用登录时的 claims 快照：
+// 用户被停用/删除、退出租户或角色到期/被回收后，刷新即失败并吊销令牌族。
 func (m *JWTMiddlewareImpl) RefreshHandler(ctx context.Context, c *app.RequestContext) {
-	m.mw.RefreshHandler(ctx, c)
+	var req identity.RefreshTokenRequestDTO
+	if err := c.BindAndValidate(&req); err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	refreshToken, session, err := m.refreshStore.Rotate(ctx, req.GetRefreshToken())
+	if err != nil {
+		switch {
+		case stderrors.Is(err, redis.ErrRefreshTokenReused):
+			tracelog.Event(ctx, m.logger.Warn()).
+				Str("component", "jwt_middleware").
+				Msg("Refresh token reuse detected, session revoked")
+			errors.AbortWithError(c, errors.ErrRefreshTokenReused)
+		case stderrors.Is(err, redis.ErrRefreshTokenInvalid):
+			errors.AbortWithError(c, errors.ErrRefreshTokenInvalid)
+		default:
+			tracelog.Event(ctx, m.logger.Error()).
+				Str("component", "jwt_middleware").
+				Err(err).
+				Msg("Failed to rotate refresh token")
+			errors.AbortWithError(c, errors.ErrInternal)
+		}
+
+		return
+	}
+
+	claims, err := m.reloadSessionClaims(ctx, session)
+	if err != nil {
+		// 新刷新令牌尚未返回给客户端，吊销令牌族只是清理；客户端需重新登录
+		if revokeErr := m.refreshStore.Revoke(ctx, refreshToken); revokeErr != nil {
+			tracelog.Event(ctx, m.logger.Warn()).
+				Str("component", "jwt_middleware").
+				Err(revokeErr).
+				Msg("Failed to revoke refresh token family after session reload failure")
+		}
+
+		tracelog.Event(ctx, m.logger.Info()).
+			Str("component", "jwt_middleware").
+			Str("user_id", session.UserID).
+			Err(err).
+			Msg("Refresh rejected: user status, tenant or roles no longer allow the session")
+		errors.HandleServiceError(c, err, "刷新会话失败")
+
+		return
+	}
+
+	token, expire, err := m.mw.TokenGenerator(claims)
+	if err != nil {
+		tracelog.Event(ctx, m.logger.Error()).
+			Str("component", "jwt_middleware").
+			Err(err).
+			Msg("Failed to generate access token on refresh")
+		errors.AbortWithError(c, errors.ErrJWTCreationFail)
+
+		return
+	}
+
+	m.setTokenCookie(c, token)
+
+	refreshResponseHandler(ctx, c, token, expire, refreshToken, session.ExpiresAt)
+}
+
+// reloadSessionClaims 按刷新会话的用户与租户，从 identity_srv 重新计算 access token 的 claims
+//
+// 用户与租户沿用令牌族创建时的值（切换租户会创建新的令牌族），认证方式沿用快照
+func (m *JWTMiddlewareImpl) reloadSessionClaims(
+	ctx context.Context,
+	session *redis.RefreshSession,
+) (map[string]interface{}, error) {
+	tenant, _ := session.Claims[Tenant].(string)
+
+	username, roleCodes, err := m.authService.RefreshSession(ctx, session.UserID, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := map[string]interface{}{
+		IdentityKey: session.UserID,
+		Roles:       roleCodes,
+	}
+
+	if username != "" {
+		claims[Username] = username
+	}
+
+	if tenant != "" {
+		claims[Tenant] = tenant
+	}
+
+	if amr, exists := session.Claims[AMR]; exists && amr != nil {
+		claims[AMR] = amr
+	}
+
+	return claims, nil
+}
+
+// SwitchTenantHandler 处理切换租户请求
+//
+// 校验当前用户是目标组织的成员后，以目标租户及其角色重新签发 access token，
+// 并创建新的刷新令牌族。旧的 access token 与请求中携带的刷新令牌族一并吊销，
+// 避免原租户的凭证继续可用。
+func (m *JWTMiddlewareImpl) SwitchTenantHandler(ctx context.Context, c *app.RequestContext) {
+	var req identity.SwitchTenantRequestDTO
+	if err := c.BindAndValidate(&req); err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	authCtx, ok := auth_context.GetAuthContext(c)
+	if !ok {
+		errors.AbortWithError(c, errors.ErrJWTValidationFail)
+		return
+	}
+
+	userID, ok := authCtx.GetUserProfileID()
+	if !ok {
+		errors.AbortWithError(c, errors.ErrJWTValidationFail)
+		return
+	}
+
+	resp, err := m.authService.SwitchTenant(ctx, userID, req.GetOrganizationID())
+	if err != nil {
+		errors.HandleServiceError(c, err, "切换租户失败")
+		return
+	}
+
+	claims := map[string]interface{}{
+		IdentityKey: userID,
+		Tenant:      resp.GetOrganizationID(),
+		Roles:       resp.GetRoleIDs(),
+	}
+	if username, ok := authCtx.GetUsername(); ok {
+		claims[Username] = username
+	}
+
+	// 切换租户不重新认证，沿用当前令牌的认证方式
+	if amr, exists := jwt.ExtractClaims(ctx, c)[AMR]; exists && amr != nil {
+		claims[AMR] = amr
+	}
+
+	token, expire, err := m.mw.TokenGenerator(claims)
+	if err != nil {
+		tracelog.Event(ctx, m.logger.Error()).
+			Str("component", "jwt_middleware").
+			Err(err).
+			Msg("Failed to generate access token on tenant switch")
+		errors.AbortWithError(c, errors.ErrJWTCreationFail)
+
+		return
+	}
+
+	refreshToken, session, err := m.refreshStore.Issue(ctx, userID, claims, m.jwtConfig.MaxRefresh)
+	if err != nil {
+		tracelog.Event(ctx, m.logger.Error()).
+			Str("component", "jwt_middleware").
+			Err(err).
+			Msg("Failed to issue refresh token on tenant switch")
+		errors.AbortWithError(c, errors.ErrJWTCreationFail)
+
+		return
+	}
+
+	m.revokeSwitchedSession(ctx, c, req.GetRefreshToken())
+
+	m.setTokenCookie(c, token)
+
+	tracelog.Event(ctx, m.logger.Info()).
+		Str("component", "jwt_middleware").
+		Str("user_id", userID).
+		Str("tenant", resp.GetOrganizationID()).
+		Msg("Tenant switched")
+
+	resp.TokenInfo = withRefreshToken(createTokenInfo(token, expire), refreshToken, session.ExpiresAt)
+	c.JSON(http.StatusOK, resp)
+}
+
+// revokeSwitchedSession 吊销切换租户前的会话凭证（失败仅记录日志，不影响切换结果）
+func (m *JWTMiddlewareImpl) revokeSwitchedSession(
+	ctx context.Context,
+	c *app.RequestContext,
+	refreshToken string,
+) {
+	if refreshToken != "" {
+		if err := m.refreshStore.Revoke(ctx, refreshToken); err != nil {
+			tracelog.Event(ctx, m.logger.Warn()).
+				Str("component", "jwt_middleware").
+				Err(err).
+				Msg("Failed to revoke previous refresh token family on tenant switch")
+		}
+	}
+
+	tokenString := m.tokenExtractor.ExtractToken(c)
+	if tokenString == "" {
+		return
+	}
+
+	exp, ok := extractInt64Claim(jwt.ExtractClaims(ctx, c), "exp")
+	if !ok {
+		return
+	}
+
+	remaining := time.Until(time.Unix(exp, 0))
+	if remaining <= 0 {
+		return
+	}
+
+	if err := m.tokenCache.RevokeToken(ctx, tokenString, remaining); err != nil {
+		tracelog.Event(ctx, m.logger.Warn()).
+			Str("component", "jwt_middleware").
+			Err(err).
+			Msg("Failed to revoke previous access token on tenant switch")
+	}
+}
+
+// ConfirmPasswordResetHandler 处理凭一次性令牌设置新密码的请求
+//
+// 密码重置成功后吊销该用户的全部会话；吊销失败仅记录日志，不影响重置结果。
+func (m *JWTMiddlewareImpl) ConfirmPasswordResetHandler(ctx context.Context, c *app.RequestContext) {
+	var req identity.ConfirmPasswordResetRequestDTO
+	if err := c.BindAndValidate(&req); err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	resp, userID, err := m.authService.ConfirmPasswordReset(ctx, &req, c.ClientIP())
+	if err != nil {
+		errors.HandleServiceError(c, err, "重置密码失败")
+		return
+	}
+
+	if err := m.revokeUserSessions(ctx, userID); err != nil {
+		tracelog.Event(ctx, m.logger.Error()).
+			Str("component", "jwt_middleware").
+			Str("user_id", userID).
+			Err(err).
+			Msg("Failed to revoke user sessions after password reset")
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// revokeUserSessions 吊销用户的全部会话
+//
+// 刷新令牌族直接删除；access token 无法逐个枚举，改为记录吊销截止时间，
+// 由 authorizator 拒绝此前签发的令牌，截止时间保留一个 access token 有效期即可。
+func (m *JWTMiddlewareImpl) revokeUserSessions(ctx context.Context, userID string) error {
+	// 两类凭证相互独立，一类失败不影响另一类的吊销
+	refreshErr := m.refreshStore.RevokeUserSessions(ctx, userID)
+	accessErr := m.tokenCache.RevokeUserTokensIssuedBefore(ctx, userID, time.Now(), m.jwtConfig.Timeout)
+
+	if err := stderrors.Join(refreshErr, accessErr); err != nil {
+		return err
+	}
+
+	tracelog.Event(ctx, m.logger.Info()).
+		Str("component", "jwt_middleware").
+		Str("user_id", userID).
+		Msg("User sessions revoked")
+
+	return nil
 }
 
 // JWKSHandler 返回 JWKS 端点 handler
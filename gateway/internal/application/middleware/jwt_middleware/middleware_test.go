@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddlewareFunc_SkipsPublicRoutes(t *testing.T) {
+	m := newTestJWTMiddlewareWithDeps(t, nil, &fakeTokenCache{}, newFakeRefreshStore())
+
+	// 公开路由：无 token 也放行
+	c := app.NewContext(0)
+	c.Request.SetMethod(http.MethodPost)
+	c.Request.SetRequestURI("/api/v1/identity/auth/refresh")
+	m.MiddlewareFunc()(context.Background(), c)
+	assert.False(t, c.IsAborted())
+
+	// 非公开路由：无 token 被拒绝
+	c = app.NewContext(0)
+	c.Request.SetMethod(http.MethodGet)
+	c.Request.SetRequestURI("/api/v1/identity/users/me")
+	m.MiddlewareFunc()(context.Background(), c)
+	assert.True(t, c.IsAborted())
+	assert.Equal(t, http.StatusUnauthorized, c.Response.StatusCode())
+}
+
+// superadminGate 模拟 policy_srv 按 X-User-Roles 构造 Subject 并判定 superadmin 权限
+func superadminGate(_ context.Context, c *app.RequestContext) {
+	for _, role := range splitRoles(c.Request.Header.Get(HeaderUserRoles)) {
+		if role == "superadmin" {
+			c.Status(http.StatusOK)
+			return
+		}
+	}
+
+	c.AbortWithStatus(http.StatusForbidden)
+}
+
+func splitRoles(value string) []string {
+	if value == "" {
+		return nil
+	}
+
+	return strings.Split(value, ",")
+}
+
+func TestMiddlewareFunc_SpoofedRolesHeaderDenied(t *testing.T) {
+	m := newTestJWTMiddlewareWithDeps(t, nil, &fakeTokenCache{}, newFakeRefreshStore())
+
+	// 不带角色的合法 token
+	accessToken, _, err := m.mw.TokenGenerator(map[string]interface{}{IdentityKey: "user-1"})
+	require.NoError(t, err)
+
+	c := app.NewContext(0)
+	c.Request.SetMethod(http.MethodGet)
+	c.Request.SetRequestURI("/api/v1/identity/users")
+	c.Request.Header.Set("Authorization", "Bearer "+accessToken)
+	c.Request.Header.Set(HeaderUserID, "admin-1")
+	c.Request.Header.Set(HeaderUserRoles, "superadmin")
+	c.Request.Header.Set(HeaderTenantID, testOrgB)
+	c.SetHandlers([]app.HandlerFunc{m.MiddlewareFunc(), superadminGate})
+	c.Next(context.Background())
+
+	assert.Equal(t, http.StatusForbidden, c.Response.StatusCode())
+	assert.Equal(t, "user-1", c.Request.Header.Get(HeaderUserID))
+	assert.Empty(t, c.Request.Header.Get(HeaderUserRoles))
+	assert.Empty(t, c.Request.Header.Get(HeaderTenantID))
+
+	// 公开路由同样不得透传客户端传入的身份 header
+	c = app.NewContext(0)
+	c.Request.SetMethod(http.MethodPost)
+	c.Request.SetRequestURI("/api/v1/identity/auth/refresh")
+	c.Request.Header.Set(HeaderUserRoles, "superadmin")
+	c.SetHandlers([]app.HandlerFunc{m.MiddlewareFunc(), superadminGate})
+	c.Next(context.Background())
+
+	assert.Equal(t, http.StatusForbidden, c.Response.StatusCode())
+	assert.Empty(t, c.Request.Header.Get(HeaderUserRoles))
+}
@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/biz/model/http_base"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/biz/model/identity"
+	authservice "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/domain/service/identity"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/errors"
+)
+
+// fakeResetAuthService 仅实现 ConfirmPasswordReset，令牌 "valid" 对应 user-1
+type fakeResetAuthService struct {
+	authservice.AuthService
+}
+
+func (f *fakeResetAuthService) ConfirmPasswordReset(
+	_ context.Context,
+	req *identity.ConfirmPasswordResetRequestDTO,
+	_ string,
+) (*http_base.OperationStatusResponseDTO, string, error) {
+	if req.GetToken() != "valid" {
+		return nil, "", errors.ErrInvalidParams.WithMessage("重置链接无效或已过期")
+	}
+
+	return &http_base.OperationStatusResponseDTO{}, "user-1", nil
+}
+
+func confirmResetRequest(m *JWTMiddlewareImpl, body string) *app.RequestContext {
+	c := app.NewContext(0)
+	c.Request.SetMethod(http.MethodPost)
+	c.Request.SetRequestURI("/api/v1/identity/auth/password/reset/confirm")
+	c.Request.Header.SetContentTypeBytes([]byte("application/json"))
+	c.Request.SetBodyString(body)
+	c.Request.Header.SetContentLength(len(body))
+
+	m.ConfirmPasswordResetHandler(context.Background(), c)
+
+	return c
+}
+
+// authenticatedRequest 携带 access token 经过 JWT 认证
+func authenticatedRequest(m *JWTMiddlewareImpl, accessToken string) *app.RequestContext {
+	c := app.NewContext(0)
+	c.Request.SetMethod(http.MethodGet)
+	c.Request.SetRequestURI("/api/v1/identity/users/me")
+	c.Request.Header.Set("Authorization", "Bearer "+accessToken)
+
+	m.mw.MiddlewareFunc()(context.Background(), c)
+
+	return c
+}
+
+func TestConfirmPasswordResetHandler_RevokesUserSessions(t *testing.T) {
+	store := newFakeRefreshStore()
+	cache := &fakeTokenCache{revoked: map[string]time.Duration{}}
+	m := newTestJWTMiddlewareWithDeps(t, &fakeResetAuthService{}, cache, store)
+
+	_, _, err := store.Issue(context.Background(), "user-1", map[string]interface{}{}, time.Hour)
+	require.NoError(t, err)
+
+	c := confirmResetRequest(m, `{"token":"valid","new_password":"N3w-Passw0rd"}`)
+	require.Equal(t, http.StatusOK, c.Response.StatusCode(), string(c.Response.Body()))
+
+	assert.NotContains(t, store.sessions, "family-user-1", "刷新令牌族应被吊销")
+	assert.NotZero(t, cache.revokedBefore["user-1"], "应记录 access token 吊销截止时间")
+}
+
+func TestConfirmPasswordResetHandler_InvalidTokenKeepsSessions(t *testing.T) {
+	store := newFakeRefreshStore()
+	cache := &fakeTokenCache{revoked: map[string]time.Duration{}}
+	m := newTestJWTMiddlewareWithDeps(t, &fakeResetAuthService{}, cache, store)
+
+	_, _, err := store.Issue(context.Background(), "user-1", map[string]interface{}{}, time.Hour)
+	require.NoError(t, err)
+
+	c := confirmResetRequest(m, `{"token":"stale","new_password":"N3w-Passw0rd"}`)
+	assert.Equal(t, http.StatusBadRequest, c.Response.StatusCode())
+
+	assert.Contains(t, store.sessions, "family-user-1")
+	assert.Empty(t, cache.revokedBefore)
+}
+
+func TestAuthorizator_RejectsTokensIssuedBeforeRevocation(t *testing.T) {
+	cache := &fakeTokenCache{revoked: map[string]time.Duration{}}
+	m := newTestJWTMiddlewareWithDeps(t, nil, cache, newFakeRefreshStore())
+
+	accessToken, _, err := m.mw.TokenGenerator(map[string]interface{}{
+		IdentityKey: "user-1",
+		Username:    "alice",
+	})
+	require.NoError(t, err)
+
+	c := authenticatedRequest(m, accessToken)
+	require.False(t, c.IsAborted(), "未吊销时应放行")
+
+	// 吊销截止时间晚于令牌签发时间
+	require.NoError(t, cache.RevokeUserTokensIssuedBefore(
+		context.Background(), "user-1", time.Now().Add(time.Second), time.Minute))
+
+	c = authenticatedRequest(m, accessToken)
+	assert.True(t, c.IsAborted())
+	assert.Equal(t, http.StatusUnauthorized, c.Response.StatusCode())
+
+	// 吊销之后签发的令牌不受影响
+	cache.revokedBefore["user-1"] = time.Now().Add(-time.Minute).Unix()
+
+	c = authenticatedRequest(m, accessToken)
+	assert.False(t, c.IsAborted())
+}
@@ -51,8 +51,12 @@ func validateJWTConfig(cfg *config.JWTConfig) error {
 		)
 	}
 
-	if len(cfg.SkipPaths) == 0 {
-		return fmt.Errorf("JWT skip paths cannot be empty")
+	if cfg.MFAChallengeTTL <= 0 {
+		return fmt.Errorf("JWT MFA challenge ttl must be greater than 0")
+	}
+
+	if cfg.MFAMaxAttempts <= 0 {
+		return fmt.Errorf("JWT MFA max attempts must be greater than 0")
 	}
 
 	if cfg.Cookie.SendCookie {
@@ -87,12 +91,19 @@ func JWTMiddlewareProvider(
 	authService authservice.AuthService,
 	jwtConfig *config.JWTConfig,
 	tokenCache TokenCacheService,
+	refreshStore RefreshTokenStore,
+	mfaStore MFAChallengeStore,
+	publicRoutes PublicRouteMatcher,
 	logger *hertzZerolog.Logger,
 ) (JWTMiddlewareService, error) {
 	if err := validateJWTConfig(jwtConfig); err != nil {
 		return nil, fmt.Errorf("JWT配置验证失败: %w", err)
 	}
 
+	if publicRoutes == nil {
+		return nil, fmt.Errorf("JWT配置验证失败: 缺少公开路由判定（authz public 规则）")
+	}
+
 	tokenExtractor := NewDefaultTokenExtractor(jwtConfig)
 
 	unwrapped := logger.Unwrap()
@@ -102,8 +113,22 @@ func JWTMiddlewareProvider(
 		return customHTTPStatusMessageFunc(e, ctx, c, zlogger)
 	}
 
+	impl := &JWTMiddlewareImpl{
+		authService:    authService,
+		jwtConfig:      jwtConfig,
+		tokenCache:     tokenCache,
+		refreshStore:   refreshStore,
+		mfaStore:       mfaStore,
+		publicRoutes:   publicRoutes,
+		tokenExtractor: tokenExtractor,
+		logger:         zlogger,
+	}
+
 	// RS256: hertz-contrib/jwt 强制要求同时提供私钥（签发）和公钥（验签）
 	// readKeys() 会依次调用 privateKey() + publicKey()，缺一不可
+	//
+	// MaxRefresh 仅作为刷新令牌族的会话上限使用：刷新走 RefreshHandler 的
+	// 不透明令牌轮换，不再使用 hertz-contrib/jwt 的滑动刷新。
 	mw, err := jwt.New(&jwt.HertzJWTMiddleware{
 		Realm:            jwtConfig.Realm,
 		SigningAlgorithm: "RS256",
@@ -132,14 +157,13 @@ func JWTMiddlewareProvider(
 		PayloadFunc:     payloadFunc,
 		IdentityHandler: identityHandler,
 		Authenticator:   authenticatorWithoutAbort(authService),
-		Authorizator:    authorizator,
+		Authorizator:    impl.authorizator,
 
 		HTTPStatusMessageFunc: httpStatusMessageFunc,
 
-		Unauthorized:    unauthorizedHandler,
-		LoginResponse:   loginResponseHandler,
-		LogoutResponse:  logoutResponseHandler,
-		RefreshResponse: refreshResponseHandler,
+		Unauthorized:   unauthorizedHandler,
+		LoginResponse:  impl.loginResponseHandler,
+		LogoutResponse: logoutResponseHandler,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("创建JWT中间件失败: %w", err)
@@ -149,11 +173,7 @@ func JWTMiddlewareProvider(
 		return nil, fmt.Errorf("初始化JWT中间件失败: %w", err)
 	}
 
-	return &JWTMiddlewareImpl{
-		jwtConfig:      jwtConfig,
-		mw:             mw,
-		tokenCache:     tokenCache,
-		tokenExtractor: tokenExtractor,
-		logger:         zlogger,
-	}, nil
+	impl.mw = mw
+
+	return impl, nil
 }
@@ -0,0 +1,361 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/golang-jwt/jwt/v4"
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/biz/model/identity"
+	authservice "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/domain/service/identity"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/config"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/errors"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/redis"
+)
+
+// fakeRefreshStore 内存版刷新令牌存储，模拟一次性轮换与重放检测
+type fakeRefreshStore struct {
+	sessions map[string]*redis.RefreshSession // family -> session
+	tokens   map[string]string                // token -> family
+	used     map[string]bool
+	seq      int
+}
+
+func newFakeRefreshStore() *fakeRefreshStore {
+	return &fakeRefreshStore{
+		sessions: map[string]*redis.RefreshSession{},
+		tokens:   map[string]string{},
+		used:     map[string]bool{},
+	}
+}
+
+func (s *fakeRefreshStore) next() string {
+	s.seq++
+	return "rt-" + string(rune('a'+s.seq))
+}
+
+func (s *fakeRefreshStore) Issue(
+	_ context.Context,
+	userID string,
+	claims map[string]interface{},
+	ttl time.Duration,
+) (string, *redis.RefreshSession, error) {
+	session := &redis.RefreshSession{
+		FamilyID:  "family-" + userID,
+		UserID:    userID,
+		Claims:    claims,
+		ExpiresAt: time.Now().Add(ttl),
+	}
+	s.sessions[session.FamilyID] = session
+
+	token := s.next()
+	s.tokens[token] = session.FamilyID
+
+	return token, session, nil
+}
+
+func (s *fakeRefreshStore) Rotate(_ context.Context, token string) (string, *redis.RefreshSession, error) {
+	familyID, ok := s.tokens[token]
+	if !ok {
+		return "", nil, redis.ErrRefreshTokenInvalid
+	}
+
+	if s.used[token] {
+		delete(s.sessions, familyID)
+		return "", nil, redis.ErrRefreshTokenReused
+	}
+
+	s.used[token] = true
+
+	session, ok := s.sessions[familyID]
+	if !ok {
+		return "", nil, redis.ErrRefreshTokenInvalid
+	}
+
+	newToken := s.next()
+	s.tokens[newToken] = familyID
+
+	return newToken, session, nil
+}
+
+func (s *fakeRefreshStore) Revoke(_ context.Context, token string) error {
+	delete(s.sessions, s.tokens[token])
+	return nil
+}
+
+func (s *fakeRefreshStore) RevokeUserSessions(_ context.Context, userID string) error {
+	delete(s.sessions, "family-"+userID)
+	return nil
+}
+
+// publicPaths 测试用公开路由判定，按路径精确匹配（忽略方法）
+type publicPaths []string
+
+func (p publicPaths) MatchPublic(_, path string) bool {
+	return slices.Contains(p, path)
+}
+
+// writeTestRSAKeys 生成临时 RSA 密钥对（仓库内的示例密钥仅为占位符）
+func writeTestRSAKeys(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	pubDER, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	privPath := filepath.Join(dir, "private.pem")
+	pubPath := filepath.Join(dir, "public.pem")
+
+	require.NoError(t, os.WriteFile(privPath, pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}), 0o600))
+	require.NoError(t, os.WriteFile(pubPath, pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: pubDER,
+	}), 0o600))
+
+	return privPath, pubPath
+}
+
+// fakeSessionAuthService 仅实现 RefreshSession，按 roles 返回用户在各租户下当前的角色
+type fakeSessionAuthService struct {
+	authservice.AuthService
+
+	roles map[string][]string // tenant -> role codes，缺失表示已不是该租户成员
+	err   error
+}
+
+func (f *fakeSessionAuthService) RefreshSession(
+	_ context.Context,
+	_ string,
+	organizationID string,
+) (string, []string, error) {
+	if f.err != nil {
+		return "", nil, f.err
+	}
+
+	roles, ok := f.roles[organizationID]
+	if !ok {
+		return "", nil, errors.ErrTenantAccessDenied
+	}
+
+	return "alice", roles, nil
+}
+
+func newTestJWTMiddleware(t *testing.T, store RefreshTokenStore) *JWTMiddlewareImpl {
+	t.Helper()
+
+	authService := &fakeSessionAuthService{roles: map[string][]string{
+		"":      {"user"},
+		"org-1": {"admin"},
+	}}
+
+	return newTestJWTMiddlewareWithDeps(t, authService, nil, store)
+}
+
+func newTestJWTMiddlewareWithDeps(
+	t *testing.T,
+	authService authservice.AuthService,
+	tokenCache TokenCacheService,
+	store RefreshTokenStore,
+) *JWTMiddlewareImpl {
+	t.Helper()
+
+	privPath, pubPath := writeTestRSAKeys(t)
+
+	cfg := &config.JWTConfig{
+		Realm:         "test",
+		PrivKeyPath:   privPath,
+		PubKeyPath:    pubPath,
+		Timeout:       15 * time.Minute,
+		MaxRefresh:    time.Hour,
+		IdentityKey:   IdentityKey,
+		TokenLookup:   "header:Authorization",
+		TokenHeadName: "Bearer",
+
+		MFAChallengeTTL: 5 * time.Minute,
+		MFAMaxAttempts:  3,
+	}
+
+	mw, err := JWTMiddlewareProvider(
+		authService, cfg, tokenCache, store, newFakeMFAStore(),
+		publicPaths{"/api/v1/identity/auth/refresh"}, hertzZerolog.New(),
+	)
+	require.NoError(t, err)
+
+	return mw.(*JWTMiddlewareImpl)
+}
+
+func refreshRequest(m *JWTMiddlewareImpl, refreshToken string) *app.RequestContext {
+	c := app.NewContext(0)
+	c.Request.SetMethod(http.MethodPost)
+	c.Request.Header.SetContentTypeBytes([]byte("application/json"))
+	body := `{"refresh_token":"` + refreshToken + `"}`
+	c.Request.SetBodyString(body)
+	c.Request.Header.SetContentLength(len(body))
+
+	m.RefreshHandler(context.Background(), c)
+
+	return c
+}
+
+func TestRefreshHandler_RotatesToken(t *testing.T) {
+	store := newFakeRefreshStore()
+	m := newTestJWTMiddleware(t, store)
+
+	first, _, err := store.Issue(context.Background(), "user-1", map[string]interface{}{
+		IdentityKey: "user-1",
+		Username:    "alice",
+		Tenant:      "org-1",
+		Roles:       []interface{}{"admin"},
+	}, time.Hour)
+	require.NoError(t, err)
+
+	c := refreshRequest(m, first)
+	require.Equal(t, http.StatusOK, c.Response.StatusCode())
+
+	var resp identity.RefreshTokenResponseDTO
+	require.NoError(t, json.Unmarshal(c.Response.Body(), &resp))
+	assert.NotEmpty(t, resp.GetTokenInfo().GetAccessToken())
+	assert.NotEmpty(t, resp.GetTokenInfo().GetRefreshToken())
+	assert.NotEqual(t, first, resp.GetTokenInfo().GetRefreshToken())
+	assert.Positive(t, resp.GetTokenInfo().GetRefreshExpiresIn())
+
+	token, err := m.mw.ParseTokenString(resp.GetTokenInfo().GetAccessToken())
+	require.NoError(t, err)
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	require.True(t, ok)
+
+	assert.Equal(t, "user-1", claims[IdentityKey])
+	assert.Equal(t, "org-1", claims[Tenant])
+}
+
+func TestRefreshHandler_ReloadsRoles(t *testing.T) {
+	store := newFakeRefreshStore()
+	authService := &fakeSessionAuthService{roles: map[string][]string{"org-1": {"nurse"}}}
+	m := newTestJWTMiddlewareWithDeps(t, authService, nil, store)
+
+	// 登录时的快照仍带 admin，刷新时该角色已到期或被回收
+	first, _, err := store.Issue(context.Background(), "user-1", map[string]interface{}{
+		IdentityKey: "user-1",
+		Tenant:      "org-1",
+		Roles:       []interface{}{"admin"},
+		AMR:         []interface{}{AMRPassword, AMROTP},
+	}, time.Hour)
+	require.NoError(t, err)
+
+	c := refreshRequest(m, first)
+	require.Equal(t, http.StatusOK, c.Response.StatusCode())
+
+	var resp identity.RefreshTokenResponseDTO
+	require.NoError(t, json.Unmarshal(c.Response.Body(), &resp))
+
+	token, err := m.mw.ParseTokenString(resp.GetTokenInfo().GetAccessToken())
+	require.NoError(t, err)
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	require.True(t, ok)
+
+	assert.Equal(t, []interface{}{"nurse"}, claims[Roles])
+	assert.Equal(t, []interface{}{AMRPassword, AMROTP}, claims[AMR])
+}
+
+func TestRefreshHandler_RejectedSessionRevokesFamily(t *testing.T) {
+	tests := []struct {
+		name        string
+		authService *fakeSessionAuthService
+		wantStatus  int
+	}{
+		{
+			name:        "user disabled or deleted",
+			authService: &fakeSessionAuthService{err: errors.NewAPIError(errors.CodeRPCUserSuspended, "用户已停用")},
+			wantStatus:  http.StatusForbidden,
+		},
+		{
+			name:        "no longer a tenant member",
+			authService: &fakeSessionAuthService{roles: map[string][]string{}},
+			wantStatus:  http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newFakeRefreshStore()
+			m := newTestJWTMiddlewareWithDeps(t, tt.authService, nil, store)
+
+			first, _, err := store.Issue(context.Background(), "user-1", map[string]interface{}{
+				IdentityKey: "user-1",
+				Tenant:      "org-1",
+				Roles:       []interface{}{"admin"},
+			}, time.Hour)
+			require.NoError(t, err)
+
+			c := refreshRequest(m, first)
+			assert.Equal(t, tt.wantStatus, c.Response.StatusCode())
+			assert.Empty(t, store.sessions, "refresh token family should be revoked")
+		})
+	}
+}
+
+func TestRefreshHandler_ReuseRevokesFamily(t *testing.T) {
+	store := newFakeRefreshStore()
+	m := newTestJWTMiddleware(t, store)
+
+	first, _, err := store.Issue(context.Background(), "user-1", map[string]interface{}{
+		IdentityKey: "user-1",
+	}, time.Hour)
+	require.NoError(t, err)
+
+	c := refreshRequest(m, first)
+	require.Equal(t, http.StatusOK, c.Response.StatusCode())
+
+	var resp identity.RefreshTokenResponseDTO
+	require.NoError(t, json.Unmarshal(c.Response.Body(), &resp))
+	second := resp.GetTokenInfo().GetRefreshToken()
+
+	// 旧令牌被重放：整个令牌族被吊销
+	c = refreshRequest(m, first)
+	assert.Equal(t, http.StatusUnauthorized, c.Response.StatusCode())
+	assert.Contains(t, string(c.Response.Body()), `"code":102011`)
+
+	// 合法持有者的新令牌也随之失效
+	c = refreshRequest(m, second)
+	assert.Equal(t, http.StatusUnauthorized, c.Response.StatusCode())
+	assert.Contains(t, string(c.Response.Body()), `"code":102010`)
+}
+
+func TestRefreshHandler_UnknownToken(t *testing.T) {
+	m := newTestJWTMiddleware(t, newFakeRefreshStore())
+
+	c := refreshRequest(m, "does-not-exist")
+
+	assert.Equal(t, http.StatusUnauthorized, c.Response.StatusCode())
+	assert.Contains(t, string(c.Response.Body()), `"code":102010`)
+}
+
+func TestRefreshHandler_MissingToken(t *testing.T) {
+	m := newTestJWTMiddleware(t, newFakeRefreshStore())
+
+	c := refreshRequest(m, "")
+
+	assert.Equal(t, errors.GetHTTPStatus(errors.CodeInvalidParams), c.Response.StatusCode())
+}
@@ -0,0 +1,182 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/biz/model/identity"
+	authservice "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/domain/service/identity"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/errors"
+)
+
+const (
+	testOrgA = "11111111-1111-1111-1111-111111111111"
+	testOrgB = "22222222-2222-2222-2222-222222222222"
+)
+
+// fakeTenantAuthService 仅实现 SwitchTenant，成员关系由 members 决定
+type fakeTenantAuthService struct {
+	authservice.AuthService
+
+	members map[string][]string // orgID -> role codes
+}
+
+func (f *fakeTenantAuthService) SwitchTenant(
+	_ context.Context,
+	_ string,
+	organizationID string,
+) (*identity.SwitchTenantResponseDTO, error) {
+	roles, ok := f.members[organizationID]
+	if !ok {
+		return nil, errors.ErrTenantAccessDenied
+	}
+
+	return &identity.SwitchTenantResponseDTO{
+		OrganizationID: &organizationID,
+		RoleIDs:        roles,
+	}, nil
+}
+
+// fakeTokenCache 仅记录被吊销的 access token 与用户级吊销截止时间
+type fakeTokenCache struct {
+	TokenCacheService
+
+	revoked       map[string]time.Duration
+	revokedBefore map[string]int64
+}
+
+func (f *fakeTokenCache) RevokeToken(_ context.Context, token string, expiration time.Duration) error {
+	f.revoked[token] = expiration
+	return nil
+}
+
+func (f *fakeTokenCache) IsTokenRevoked(_ context.Context, token string) (bool, error) {
+	_, ok := f.revoked[token]
+	return ok, nil
+}
+
+func (f *fakeTokenCache) RevokeUserTokensIssuedBefore(
+	_ context.Context,
+	userID string,
+	before time.Time,
+	_ time.Duration,
+) error {
+	if f.revokedBefore == nil {
+		f.revokedBefore = map[string]int64{}
+	}
+
+	f.revokedBefore[userID] = before.Unix()
+
+	return nil
+}
+
+func (f *fakeTokenCache) UserTokensRevokedBefore(_ context.Context, userID string) (int64, error) {
+	return f.revokedBefore[userID], nil
+}
+
+func switchTenantRequest(
+	t *testing.T,
+	m *JWTMiddlewareImpl,
+	accessToken string,
+	body string,
+) *app.RequestContext {
+	t.Helper()
+
+	c := app.NewContext(0)
+	c.Request.SetMethod(http.MethodPost)
+	c.Request.SetRequestURI("/api/v1/identity/auth/switch-tenant")
+	c.Request.Header.Set("Authorization", "Bearer "+accessToken)
+	c.Request.Header.SetContentTypeBytes([]byte("application/json"))
+	c.Request.SetBodyString(body)
+	c.Request.Header.SetContentLength(len(body))
+
+	// 先经过 JWT 认证建立身份上下文，再进入切换处理器
+	m.mw.MiddlewareFunc()(context.Background(), c)
+	require.False(t, c.IsAborted(), string(c.Response.Body()))
+
+	m.SwitchTenantHandler(context.Background(), c)
+
+	return c
+}
+
+func newSwitchTenantFixture(t *testing.T) (*JWTMiddlewareImpl, *fakeRefreshStore, *fakeTokenCache, string) {
+	t.Helper()
+
+	store := newFakeRefreshStore()
+	cache := &fakeTokenCache{revoked: map[string]time.Duration{}}
+	authSvc := &fakeTenantAuthService{members: map[string][]string{
+		testOrgA: {"doctor"},
+		testOrgB: {"consultant"},
+	}}
+
+	m := newTestJWTMiddlewareWithDeps(t, authSvc, cache, store)
+
+	accessToken, _, err := m.mw.TokenGenerator(map[string]interface{}{
+		IdentityKey: "user-1",
+		Username:    "alice",
+		Tenant:      testOrgA,
+		Roles:       []string{"doctor"},
+	})
+	require.NoError(t, err)
+
+	return m, store, cache, accessToken
+}
+
+func TestSwitchTenantHandler_ReissuesTokenForTenant(t *testing.T) {
+	m, store, cache, accessToken := newSwitchTenantFixture(t)
+
+	oldRefresh, _, err := store.Issue(context.Background(), "user-1", map[string]interface{}{}, time.Hour)
+	require.NoError(t, err)
+
+	c := switchTenantRequest(t, m, accessToken,
+		`{"organization_id":"`+testOrgB+`","refresh_token":"`+oldRefresh+`"}`)
+	require.Equal(t, http.StatusOK, c.Response.StatusCode(), string(c.Response.Body()))
+
+	var resp identity.SwitchTenantResponseDTO
+	require.NoError(t, json.Unmarshal(c.Response.Body(), &resp))
+	assert.Equal(t, testOrgB, resp.GetOrganizationID())
+	assert.Equal(t, []string{"consultant"}, resp.GetRoleIDs())
+	assert.NotEmpty(t, resp.GetTokenInfo().GetRefreshToken())
+
+	token, err := m.mw.ParseTokenString(resp.GetTokenInfo().GetAccessToken())
+	require.NoError(t, err)
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	require.True(t, ok)
+	assert.Equal(t, "user-1", claims[IdentityKey])
+	assert.Equal(t, "alice", claims[Username])
+	assert.Equal(t, testOrgB, claims[Tenant])
+	assert.Equal(t, []interface{}{"consultant"}, claims[Roles])
+
+	// 原租户的 access token 与刷新令牌族均被吊销
+	assert.Contains(t, cache.revoked, accessToken)
+	_, _, err = store.Rotate(context.Background(), oldRefresh)
+	assert.Error(t, err)
+}
+
+func TestSwitchTenantHandler_NotMember(t *testing.T) {
+	m, _, cache, accessToken := newSwitchTenantFixture(t)
+
+	c := switchTenantRequest(t, m, accessToken,
+		`{"organization_id":"33333333-3333-3333-3333-333333333333"}`)
+
+	assert.Equal(t, http.StatusForbidden, c.Response.StatusCode())
+	assert.Contains(t, string(c.Response.Body()), `"code":103002`)
+	assert.Empty(t, cache.revoked)
+}
+
+func TestSwitchTenantHandler_InvalidOrganizationID(t *testing.T) {
+	m, _, _, accessToken := newSwitchTenantFixture(t)
+
+	c := switchTenantRequest(t, m, accessToken, `{"organization_id":"bad"}`)
+
+	assert.Equal(t, http.StatusBadRequest, c.Response.StatusCode())
+}
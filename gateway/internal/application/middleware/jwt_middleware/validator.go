@@ -12,6 +12,7 @@ import (
 	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/application/context/auth_context"
 	authservice "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/domain/service/identity"
 	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/errors"
+	tracelog "github.com/masonsxu/cloudwego-microservice-demo/gateway/pkg/log"
 )
 
 // identityHandler 身份处理函数，从 JWT Claims 提取用户信息并设置认证上下文（目标态最小字段）
@@ -21,6 +22,7 @@ import (
 func identityHandler(ctx context.Context, c *app.RequestContext) interface{} {
 	claims := jwt.ExtractClaims(ctx, c)
 	if claims == nil {
+		stripIdentityHeaders(c)
 		return nil
 	}
 
@@ -60,7 +62,11 @@ func identityHandler(ctx context.Context, c *app.RequestContext) interface{} {
 
 // injectIdentityHeaders 把验证通过的身份信息注入请求 header，
 // 供同链路下游中间件 / 未来业务系统统一读取。
+//
+// 先剥离客户端传入的全部身份 header，claims 缺失的字段不会残留伪造值。
 func injectIdentityHeaders(c *app.RequestContext, claims *http_base.JWTClaimsDTO) {
+	stripIdentityHeaders(c)
+
 	if claims == nil {
 		return
 	}
@@ -82,9 +88,44 @@ func injectIdentityHeaders(c *app.RequestContext, claims *http_base.JWTClaimsDTO
 	}
 }
 
+// stripIdentityHeaders 删除请求中的全部身份 header
+func stripIdentityHeaders(c *app.RequestContext) {
+	for _, header := range identityHeaders {
+		c.Request.Header.Del(header)
+	}
+}
+
 // authorizator 授权函数（目标态：不检查 Status，仅做基础校验）
-func authorizator(data interface{}, ctx context.Context, c *app.RequestContext) bool {
-	return true
+//
+// 用户重置密码后，此前签发的 access token 一律视为已吊销；
+// 吊销状态查询失败时放行，与单个 token 的吊销检查保持一致。
+func (m *JWTMiddlewareImpl) authorizator(
+	data interface{},
+	ctx context.Context,
+	c *app.RequestContext,
+) bool {
+	jwtClaims, ok := data.(*http_base.JWTClaimsDTO)
+	if !ok || jwtClaims.GetUserProfileID() == "" {
+		return true
+	}
+
+	issuedAt, ok := extractInt64Claim(jwt.ExtractClaims(ctx, c), OrigIssuedAt)
+	if !ok {
+		return true
+	}
+
+	revokedBefore, err := m.tokenCache.UserTokensRevokedBefore(ctx, jwtClaims.GetUserProfileID())
+	if err != nil || revokedBefore == 0 || issuedAt >= revokedBefore {
+		return true
+	}
+
+	tracelog.Event(ctx, m.logger.Warn()).
+		Str("component", "jwt_middleware").
+		Str("user_id", jwtClaims.GetUserProfileID()).
+		Msg("Access denied: token issued before user sessions were revoked")
+	errors.AbortWithError(c, errors.ErrJWTTokenExpired)
+
+	return false
 }
 
 // authenticatorWithoutAbort 认证函数（不调用 AbortWithError）
@@ -110,6 +151,7 @@ func authenticatorWithoutAbort(
 		userData := buildUserDataMap(resp)
 
 		c.Set(LoginUserContextKey, resp)
+		c.Set(LoginClaimsContextKey, userData)
 
 		return userData, nil
 	}
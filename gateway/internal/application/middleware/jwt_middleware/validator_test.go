@@ -92,3 +92,20 @@ func TestInjectIdentityHeaders_SingleRole(t *testing.T) {
 
 	assert.Equal(t, "admin", ctx.Request.Header.Get(HeaderUserRoles))
 }
+
+func TestInjectIdentityHeaders_StripsSpoofedHeaders(t *testing.T) {
+	ctx := &app.RequestContext{}
+	ctx.Request.Header = protocol.RequestHeader{}
+	ctx.Request.Header.Set(HeaderUserName, "mallory")
+	ctx.Request.Header.Set(HeaderTenantID, "org-spoofed")
+	ctx.Request.Header.Set(HeaderUserRoles, "superadmin")
+	ctx.Request.Header.Set(HeaderTokenJTI, "jti-spoofed")
+
+	injectIdentityHeaders(ctx, &http_base.JWTClaimsDTO{UserProfileID: ptrString("user-1")})
+
+	assert.Equal(t, "user-1", ctx.Request.Header.Get(HeaderUserID))
+	assert.Empty(t, ctx.Request.Header.Get(HeaderUserName))
+	assert.Empty(t, ctx.Request.Header.Get(HeaderTenantID))
+	assert.Empty(t, ctx.Request.Header.Get(HeaderUserRoles))
+	assert.Empty(t, ctx.Request.Header.Get(HeaderTokenJTI))
+}
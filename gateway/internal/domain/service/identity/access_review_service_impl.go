@@ -0,0 +1,203 @@
+package identity
+
+import (
+	"context"
+
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/biz/model/identity"
+	identityassembler "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/application/assembler/identity"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/domain/common"
+	identitycli "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/client/identity_cli"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+)
+
+// accessReviewServiceImpl 访问复核服务实现
+type accessReviewServiceImpl struct {
+	*common.BaseService
+	identityClient identitycli.IdentityClient
+	assembler      identityassembler.Assembler
+}
+
+// NewAccessReviewService 创建新的访问复核服务实例
+func NewAccessReviewService(
+	identityClient identitycli.IdentityClient,
+	assembler identityassembler.Assembler,
+	logger *hertzZerolog.Logger,
+) AccessReviewService {
+	return &accessReviewServiceImpl{
+		BaseService:    common.NewBaseService(logger),
+		identityClient: identityClient,
+		assembler:      assembler,
+	}
+}
+
+// =================================================================
+// 访问复核模块 (Access Review)
+// =================================================================
+
+func (s *accessReviewServiceImpl) ListAccessReviewCampaigns(
+	ctx context.Context,
+	req *identity.ListAccessReviewCampaignsRequestDTO,
+) (*identity.ListAccessReviewCampaignsResponseDTO, error) {
+	// 转换请求
+	rpcReq := s.assembler.AccessReview().ToRPCListAccessReviewCampaignsRequest(req)
+
+	// 调用RPC服务
+	result, err := s.ProcessRPCCall(ctx, "查询访问复核活动",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.ListAccessReviewCampaigns(ctx, rpcReq)
+		},
+		"status", req.Status,
+		"organization_id", req.OrganizationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.ListAccessReviewCampaignsResponse)
+
+	// 转换响应
+	httpResp := s.assembler.AccessReview().ToHTTPListAccessReviewCampaignsResponse(rpcResp)
+	httpResp.BaseResp = s.ResponseBuilder().BuildSuccessResponse()
+
+	return httpResp, nil
+}
+
+func (s *accessReviewServiceImpl) CreateAccessReviewCampaign(
+	ctx context.Context,
+	req *identity.CreateAccessReviewCampaignRequestDTO,
+	operatorID string,
+) (*identity.AccessReviewCampaignResponseDTO, error) {
+	// 转换请求
+	rpcReq := s.assembler.AccessReview().ToRPCCreateAccessReviewCampaignRequest(req, operatorID)
+
+	// 调用RPC服务
+	result, err := s.ProcessRPCCall(ctx, "创建访问复核活动",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.CreateAccessReviewCampaign(ctx, rpcReq)
+		},
+		"organization_id", req.OrganizationID,
+		"role_id", req.RoleID,
+		"operator_id", operatorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.CreateAccessReviewCampaignResponse)
+
+	return &identity.AccessReviewCampaignResponseDTO{
+		BaseResp: s.ResponseBuilder().BuildSuccessResponse(),
+		Campaign: s.assembler.AccessReview().ToHTTPAccessReviewCampaign(rpcResp.Campaign),
+	}, nil
+}
+
+func (s *accessReviewServiceImpl) GetAccessReviewCampaign(
+	ctx context.Context,
+	req *identity.GetAccessReviewCampaignRequestDTO,
+) (*identity.AccessReviewCampaignResponseDTO, error) {
+	result, err := s.ProcessRPCCall(ctx, "获取访问复核活动",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.GetAccessReviewCampaign(ctx, &identity_srv.GetAccessReviewCampaignRequest{
+				CampaignID: req.CampaignID,
+			})
+		},
+		"campaign_id", req.CampaignID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.GetAccessReviewCampaignResponse)
+
+	return &identity.AccessReviewCampaignResponseDTO{
+		BaseResp: s.ResponseBuilder().BuildSuccessResponse(),
+		Campaign: s.assembler.AccessReview().ToHTTPAccessReviewCampaign(rpcResp.Campaign),
+	}, nil
+}
+
+func (s *accessReviewServiceImpl) ListAccessReviewItems(
+	ctx context.Context,
+	req *identity.ListAccessReviewItemsRequestDTO,
+) (*identity.ListAccessReviewItemsResponseDTO, error) {
+	// 转换请求
+	rpcReq := s.assembler.AccessReview().ToRPCListAccessReviewItemsRequest(req)
+
+	// 调用RPC服务
+	result, err := s.ProcessRPCCall(ctx, "查询访问复核条目",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.ListAccessReviewItems(ctx, rpcReq)
+		},
+		"campaign_id", req.CampaignID,
+		"decision", req.Decision,
+		"user_id", req.UserID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.ListAccessReviewItemsResponse)
+
+	// 转换响应
+	httpResp := s.assembler.AccessReview().ToHTTPListAccessReviewItemsResponse(rpcResp)
+	httpResp.BaseResp = s.ResponseBuilder().BuildSuccessResponse()
+
+	return httpResp, nil
+}
+
+func (s *accessReviewServiceImpl) CloseAccessReviewCampaign(
+	ctx context.Context,
+	req *identity.CloseAccessReviewCampaignRequestDTO,
+	operatorID string,
+) (*identity.AccessReviewCampaignResponseDTO, error) {
+	result, err := s.ProcessRPCCall(ctx, "关闭访问复核活动",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.CloseAccessReviewCampaign(ctx, &identity_srv.CloseAccessReviewCampaignRequest{
+				CampaignID: req.CampaignID,
+				ClosedBy:   &operatorID,
+			})
+		},
+		"campaign_id", req.CampaignID,
+		"operator_id", operatorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.CloseAccessReviewCampaignResponse)
+
+	return &identity.AccessReviewCampaignResponseDTO{
+		BaseResp: s.ResponseBuilder().BuildSuccessResponse(),
+		Campaign: s.assembler.AccessReview().ToHTTPAccessReviewCampaign(rpcResp.Campaign),
+	}, nil
+}
+
+func (s *accessReviewServiceImpl) DecideAccessReviewItem(
+	ctx context.Context,
+	req *identity.DecideAccessReviewItemRequestDTO,
+	reviewerID string,
+) (*identity.AccessReviewItemResponseDTO, error) {
+	// 转换请求
+	rpcReq := s.assembler.AccessReview().ToRPCDecideAccessReviewItemRequest(req, reviewerID)
+
+	// 调用RPC服务
+	result, err := s.ProcessRPCCall(ctx, "复核访问复核条目",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.DecideAccessReviewItem(ctx, rpcReq)
+		},
+		"item_id", req.ItemID,
+		"decision", req.Decision,
+		"reviewer_id", reviewerID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.DecideAccessReviewItemResponse)
+
+	return &identity.AccessReviewItemResponseDTO{
+		BaseResp: s.ResponseBuilder().BuildSuccessResponse(),
+		Item:     s.assembler.AccessReview().ToHTTPAccessReviewItem(rpcResp.Item),
+	}, nil
+}
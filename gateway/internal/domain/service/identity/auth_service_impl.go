@@ -10,6 +10,7 @@ import (
 	identityConv "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/application/assembler/identity"
 	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/domain/common"
 	identitycli "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/client/identity_cli"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/errors"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
 )
 
@@ -84,6 +85,14 @@ func (s *authServiceImpl) Login(
 		httpResp.Roles = []*identity.RoleInfoDTO{}
 	}
 
+	// 需要第二因素时先放入挑战占位，由 JWT 中间件补全挑战令牌并拦截令牌签发
+	if rpcResp.GetMfaRequired() || rpcResp.GetMfaEnrollmentRequired() {
+		enrollmentRequired := !rpcResp.GetMfaRequired()
+		httpResp.MfaChallenge = &identity.MFAChallengeDTO{
+			EnrollmentRequired: &enrollmentRequired,
+		}
+	}
+
 	return httpResp, "", nil
 }
 
@@ -139,6 +148,58 @@ func (s *authServiceImpl) ResetPassword(
 	return s.ResponseBuilder().BuildOperationStatusResponse(), nil
 }
 
+func (s *authServiceImpl) RequestPasswordReset(
+	ctx context.Context,
+	req *identity.RequestPasswordResetRequestDTO,
+	clientIP string,
+) (*http_base.OperationStatusResponseDTO, error) {
+	// 使用BaseService模板处理RPC调用
+	err := s.ProcessRPCVoidCall(ctx, "发起找回密码",
+		func(ctx context.Context) error {
+			// 转换为RPC请求
+			rpcReq := s.assembler.Auth().ToRPCRequestPasswordResetRequest(req, clientIP)
+
+			// 调用RPC服务
+			_, err := s.identityClient.RequestPasswordReset(ctx, rpcReq)
+
+			return err
+		},
+		"client_ip", clientIP,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// 使用ResponseBuilder构建响应
+	return s.ResponseBuilder().BuildOperationStatusResponse(), nil
+}
+
+func (s *authServiceImpl) ConfirmPasswordReset(
+	ctx context.Context,
+	req *identity.ConfirmPasswordResetRequestDTO,
+	clientIP string,
+) (*http_base.OperationStatusResponseDTO, string, error) {
+	// 使用BaseService模板处理RPC调用
+	result, err := s.ProcessRPCCall(ctx, "确认找回密码",
+		func(ctx context.Context) (interface{}, error) {
+			// 转换为RPC请求
+			rpcReq := s.assembler.Auth().ToRPCConfirmPasswordResetRequest(req, clientIP)
+
+			// 调用RPC服务
+			return s.identityClient.ConfirmPasswordReset(ctx, rpcReq)
+		},
+		"client_ip", clientIP,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+
+	rpcResp := result.(*identity_srv.ConfirmPasswordResetResponse)
+
+	// 使用ResponseBuilder构建响应
+	return s.ResponseBuilder().BuildOperationStatusResponse(), rpcResp.GetUserID(), nil
+}
+
 func (s *authServiceImpl) ForcePasswordChange(
 	ctx context.Context,
 	req *identity.ForcePasswordChangeRequestDTO,
@@ -163,3 +224,167 @@ func (s *authServiceImpl) ForcePasswordChange(
 	// 使用ResponseBuilder构建响应
 	return s.ResponseBuilder().BuildOperationStatusResponse(), nil
 }
+
+func (s *authServiceImpl) SwitchTenant(
+	ctx context.Context,
+	userID string,
+	organizationID string,
+) (*identity.SwitchTenantResponseDTO, error) {
+	// 1. 校验用户是目标组织的活跃成员
+	result, err := s.ProcessRPCCall(ctx, "校验租户成员关系",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.CheckMembership(ctx, &identity_srv.CheckMembershipRequest{
+				UserID:         &userID,
+				OrganizationID: &organizationID,
+			})
+		},
+		"user_id", userID, "organization_id", organizationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.(*identity_srv.CheckMembershipResponse).GetIsMember() {
+		return nil, errors.ErrTenantAccessDenied
+	}
+
+	// 2. 获取用户在目标租户下生效的角色
+	result, err = s.ProcessRPCCall(ctx, "获取租户角色",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.GetUserTenantRoles(ctx, &identity_srv.GetUserTenantRolesRequest{
+				UserID:         &userID,
+				OrganizationID: &organizationID,
+			})
+		},
+		"user_id", userID, "organization_id", organizationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.GetUserTenantRolesResponse)
+
+	httpResp := &identity.SwitchTenantResponseDTO{
+		BaseResp:       s.ResponseBuilder().BuildSuccessResponse(),
+		OrganizationID: &organizationID,
+		RoleIDs:        rpcResp.RoleCodes,
+		Roles:          s.assembler.Auth().ToHTTPRoleInfos(rpcResp.RoleDetails),
+	}
+
+	if httpResp.RoleIDs == nil {
+		httpResp.RoleIDs = []string{}
+	}
+
+	return httpResp, nil
+}
+
+func (s *authServiceImpl) RefreshSession(
+	ctx context.Context,
+	userID string,
+	organizationID string,
+) (string, []string, error) {
+	result, err := s.ProcessRPCCall(ctx, "刷新会话",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.RefreshSession(ctx, &identity_srv.RefreshSessionRequest{
+				UserID:         &userID,
+				OrganizationID: &organizationID,
+			})
+		},
+		"user_id", userID, "organization_id", organizationID,
+	)
+	if err != nil {
+		return "", nil, err
+	}
+
+	rpcResp := result.(*identity_srv.RefreshSessionResponse)
+
+	return rpcResp.GetUsername(), rpcResp.GetRoleCodes(), nil
+}
+
+// =================================================================
+// 2. 多因素认证模块 (MFA)
+// =================================================================
+
+func (s *authServiceImpl) EnrollMFA(
+	ctx context.Context,
+	userID string,
+) (*identity.MFAEnrollResponseDTO, error) {
+	result, err := s.ProcessRPCCall(ctx, "发起MFA登记",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.EnrollMFA(ctx, &identity_srv.EnrollMFARequest{
+				UserID: &userID,
+			})
+		},
+		"user_id", userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.EnrollMFAResponse)
+
+	return &identity.MFAEnrollResponseDTO{
+		BaseResp:        s.ResponseBuilder().BuildSuccessResponse(),
+		Secret:          rpcResp.Secret,
+		ProvisioningURI: rpcResp.ProvisioningURI,
+	}, nil
+}
+
+func (s *authServiceImpl) ConfirmMFA(
+	ctx context.Context,
+	userID, code string,
+) (*identity.ConfirmMFAResponseDTO, error) {
+	result, err := s.ProcessRPCCall(ctx, "确认MFA登记",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.ConfirmMFA(ctx, &identity_srv.ConfirmMFARequest{
+				UserID: &userID,
+				Code:   &code,
+			})
+		},
+		"user_id", userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity.ConfirmMFAResponseDTO{
+		BaseResp:      s.ResponseBuilder().BuildSuccessResponse(),
+		RecoveryCodes: result.(*identity_srv.ConfirmMFAResponse).RecoveryCodes,
+	}, nil
+}
+
+func (s *authServiceImpl) VerifyMFA(ctx context.Context, userID, code string) error {
+	return s.ProcessRPCVoidCall(ctx, "校验MFA口令",
+		func(ctx context.Context) error {
+			_, err := s.identityClient.VerifyMFA(ctx, &identity_srv.VerifyMFARequest{
+				UserID: &userID,
+				Code:   &code,
+			})
+
+			return err
+		},
+		"user_id", userID,
+	)
+}
+
+func (s *authServiceImpl) DisableMFA(
+	ctx context.Context,
+	userID, code string,
+) (*http_base.OperationStatusResponseDTO, error) {
+	err := s.ProcessRPCVoidCall(ctx, "停用MFA",
+		func(ctx context.Context) error {
+			_, err := s.identityClient.DisableMFA(ctx, &identity_srv.DisableMFARequest{
+				UserID: &userID,
+				Code:   &code,
+			})
+
+			return err
+		},
+		"user_id", userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ResponseBuilder().BuildOperationStatusResponse(), nil
+}
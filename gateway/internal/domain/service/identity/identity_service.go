@@ -18,6 +18,10 @@ type Service interface {
 	DepartmentService
 	LogoService
 	AuditLogService
+	RecycleBinService
+	PermissionReportService
+	RoleGrantService
+	AccessReviewService
 }
 
 // =================================================================
@@ -50,6 +54,58 @@ type AuthService interface {
 		ctx context.Context,
 		req *identity.ForcePasswordChangeRequestDTO,
 	) (*http_base.OperationStatusResponseDTO, error)
+
+	// RequestPasswordReset 发起找回密码 - 向账号绑定的邮箱发送一次性重置链接
+	// 无论账号是否存在都返回成功，避免账号枚举
+	RequestPasswordReset(
+		ctx context.Context,
+		req *identity.RequestPasswordResetRequestDTO,
+		clientIP string,
+	) (*http_base.OperationStatusResponseDTO, error)
+
+	// ConfirmPasswordReset 确认找回密码 - 凭一次性令牌设置新密码，返回被重置密码的用户ID
+	ConfirmPasswordReset(
+		ctx context.Context,
+		req *identity.ConfirmPasswordResetRequestDTO,
+		clientIP string,
+	) (*http_base.OperationStatusResponseDTO, string, error)
+
+	// SwitchTenant 切换租户 - 校验成员关系并返回用户在目标租户下的角色（不含令牌）
+	SwitchTenant(
+		ctx context.Context,
+		userID string,
+		organizationID string,
+	) (*identity.SwitchTenantResponseDTO, error)
+
+	// RefreshSession 刷新会话 - 按用户当前状态重新计算租户下的角色，返回用户名与 role code 列表
+	// 用户已停用/删除、已不是该租户成员或没有可用角色时返回错误
+	RefreshSession(
+		ctx context.Context,
+		userID string,
+		organizationID string,
+	) (string, []string, error)
+
+	// EnrollMFA 发起 MFA 登记 - 生成 TOTP 密钥，确认前不生效
+	EnrollMFA(ctx context.Context, userID string) (*identity.MFAEnrollResponseDTO, error)
+
+	// ConfirmMFA 确认 MFA 登记 - 校验验证器口令后启用，返回一次性恢复码
+	ConfirmMFA(ctx context.Context, userID, code string) (*identity.ConfirmMFAResponseDTO, error)
+
+	// VerifyMFA 校验第二因素 - 登录第二步，接受 TOTP 口令或恢复码
+	VerifyMFA(ctx context.Context, userID, code string) error
+
+	// DisableMFA 停用 MFA - 需提供有效的 TOTP 口令或恢复码
+	DisableMFA(
+		ctx context.Context,
+		userID, code string,
+	) (*http_base.OperationStatusResponseDTO, error)
+}
+
+// ExportedFile 导出文件，由处理器直接作为附件写回响应
+type ExportedFile struct {
+	FileName    string
+	ContentType string
+	Content     []byte
 }
 
 // UserService 用户管理服务接口
@@ -67,8 +123,12 @@ type UserService interface {
 		req *identity.GetUserRequestDTO,
 	) (*identity.UserProfileResponseDTO, error)
 
-	// GetMe 获取当前用户信息 - 获取当前登录用户的详细信息
-	GetMe(ctx context.Context, userID string) (*identity.UserProfileResponseDTO, error)
+	// GetMe 获取当前用户信息 - 获取当前登录用户的详细信息及可切换的租户列表
+	GetMe(
+		ctx context.Context,
+		userID string,
+		currentTenantID string,
+	) (*identity.UserProfileResponseDTO, error)
 
 	// UpdateUser 更新用户信息 - 更新指定用户的基本信息
 	UpdateUser(
@@ -113,6 +173,25 @@ type UserService interface {
 		ctx context.Context,
 		req *identity.UnlockUserRequestDTO,
 	) (*http_base.OperationStatusResponseDTO, error)
+
+	// ImportUsers 批量导入用户 - 解析 CSV/XLSX 文件，逐行校验后按批次创建用户
+	ImportUsers(
+		ctx context.Context,
+		req *identity.ImportUsersRequestDTO,
+		operatorID string,
+	) (*identity.ImportUsersResponseDTO, error)
+
+	// ExportUsers 导出用户 - 按筛选条件导出用户为 CSV/XLSX 文件
+	ExportUsers(
+		ctx context.Context,
+		req *identity.ExportUsersRequestDTO,
+	) (*ExportedFile, error)
+
+	// ListUserRoleAssignments 查询用户角色分配 - 分页查询角色分配记录，支持游标分页
+	ListUserRoleAssignments(
+		ctx context.Context,
+		req *identity.ListUserRoleAssignmentsRequestDTO,
+	) (*identity.ListUserRoleAssignmentsResponseDTO, error)
 }
 
 // MembershipService 成员关系管理服务接口
@@ -232,3 +311,116 @@ type AuditLogService interface {
 		req *identity.ListAuditLogsRequestDTO,
 	) (*identity.ListAuditLogsResponseDTO, error)
 }
+
+// RecycleBinService 回收站服务接口
+type RecycleBinService interface {
+	// ListDeletedEntities 查询回收站 - 分页查询已删除的用户、组织或部门
+	ListDeletedEntities(
+		ctx context.Context,
+		req *identity.ListDeletedEntitiesRequestDTO,
+	) (*identity.ListDeletedEntitiesResponseDTO, error)
+
+	// RestoreDeletedEntity 恢复回收站记录 - 恢复用户时一并恢复级联删除的角色分配与成员关系
+	RestoreDeletedEntity(
+		ctx context.Context,
+		req *identity.DeletedEntityRequestDTO,
+	) (*identity.RestoreDeletedEntityResponseDTO, error)
+
+	// PurgeDeletedEntity 彻底删除回收站记录 - 物理删除，不可恢复
+	PurgeDeletedEntity(
+		ctx context.Context,
+		req *identity.DeletedEntityRequestDTO,
+	) (*http_base.OperationStatusResponseDTO, error)
+}
+
+// PermissionReportService 权限报告服务接口
+type PermissionReportService interface {
+	// GetPermissionMatrix 查询角色权限矩阵 - 每个角色的菜单权限级别、数据范围及策略规则
+	GetPermissionMatrix(
+		ctx context.Context,
+		req *identity.GetPermissionMatrixRequestDTO,
+	) (*identity.GetPermissionMatrixResponseDTO, error)
+
+	// GetUserEffectivePermissions 查询用户有效权限 - 按租户列出授予权限的角色
+	GetUserEffectivePermissions(
+		ctx context.Context,
+		req *identity.GetUserEffectivePermissionsRequestDTO,
+	) (*identity.GetUserEffectivePermissionsResponseDTO, error)
+
+	// ExportPermissionReport 导出权限报告 - 角色视图或用户视图，CSV/XLSX
+	ExportPermissionReport(
+		ctx context.Context,
+		req *identity.ExportPermissionReportRequestDTO,
+	) (*ExportedFile, error)
+}
+
+// RoleGrantService 角色授权审批服务接口
+type RoleGrantService interface {
+	// ListRoleGrantRequests 分页查询敏感角色授权申请
+	ListRoleGrantRequests(
+		ctx context.Context,
+		req *identity.ListRoleGrantRequestsRequestDTO,
+	) (*identity.ListRoleGrantRequestsResponseDTO, error)
+
+	// GetRoleGrantRequest 获取单个授权申请
+	GetRoleGrantRequest(
+		ctx context.Context,
+		req *identity.GetRoleGrantRequestRequestDTO,
+	) (*identity.RoleGrantRequestResponseDTO, error)
+
+	// ApproveRoleGrant 审批通过授权申请 - 生成角色分配，审批人不能是申请人或被授权用户
+	ApproveRoleGrant(
+		ctx context.Context,
+		req *identity.DecideRoleGrantRequestDTO,
+		approverID string,
+	) (*identity.RoleGrantRequestResponseDTO, error)
+
+	// RejectRoleGrant 驳回授权申请
+	RejectRoleGrant(
+		ctx context.Context,
+		req *identity.DecideRoleGrantRequestDTO,
+		approverID string,
+	) (*identity.RoleGrantRequestResponseDTO, error)
+}
+
+// AccessReviewService 访问复核服务接口
+type AccessReviewService interface {
+	// ListAccessReviewCampaigns 分页查询访问复核活动（含完成情况）
+	ListAccessReviewCampaigns(
+		ctx context.Context,
+		req *identity.ListAccessReviewCampaignsRequestDTO,
+	) (*identity.ListAccessReviewCampaignsResponseDTO, error)
+
+	// CreateAccessReviewCampaign 创建访问复核活动 - 按范围生成待复核条目
+	CreateAccessReviewCampaign(
+		ctx context.Context,
+		req *identity.CreateAccessReviewCampaignRequestDTO,
+		operatorID string,
+	) (*identity.AccessReviewCampaignResponseDTO, error)
+
+	// GetAccessReviewCampaign 获取单个访问复核活动
+	GetAccessReviewCampaign(
+		ctx context.Context,
+		req *identity.GetAccessReviewCampaignRequestDTO,
+	) (*identity.AccessReviewCampaignResponseDTO, error)
+
+	// ListAccessReviewItems 分页查询活动下的复核条目
+	ListAccessReviewItems(
+		ctx context.Context,
+		req *identity.ListAccessReviewItemsRequestDTO,
+	) (*identity.ListAccessReviewItemsResponseDTO, error)
+
+	// CloseAccessReviewCampaign 关闭访问复核活动 - 按活动设置自动撤销未复核条目
+	CloseAccessReviewCampaign(
+		ctx context.Context,
+		req *identity.CloseAccessReviewCampaignRequestDTO,
+		operatorID string,
+	) (*identity.AccessReviewCampaignResponseDTO, error)
+
+	// DecideAccessReviewItem 复核单个条目 - 确认保留或撤销角色分配
+	DecideAccessReviewItem(
+		ctx context.Context,
+		req *identity.DecideAccessReviewItemRequestDTO,
+		reviewerID string,
+	) (*identity.AccessReviewItemResponseDTO, error)
+}
@@ -10,13 +10,17 @@ import (
 // identityServiceImpl 身份管理聚合服务实现
 // 实现所有子服务接口，提供统一的服务入口
 type identityServiceImpl struct {
-	authService       AuthService
-	userService       UserService
-	membershipService MembershipService
-	orgService        OrganizationService
-	deptService       DepartmentService
-	logoService       LogoService
-	auditLogService   AuditLogService
+	authService             AuthService
+	userService             UserService
+	membershipService       MembershipService
+	orgService              OrganizationService
+	deptService             DepartmentService
+	logoService             LogoService
+	auditLogService         AuditLogService
+	recycleBinService       RecycleBinService
+	permissionReportService PermissionReportService
+	roleGrantService        RoleGrantService
+	accessReviewService     AccessReviewService
 }
 
 // NewService 创建身份管理聚合服务
@@ -28,15 +32,23 @@ func NewService(
 	deptService DepartmentService,
 	logoService LogoService,
 	auditLogService AuditLogService,
+	recycleBinService RecycleBinService,
+	permissionReportService PermissionReportService,
+	roleGrantService RoleGrantService,
+	accessReviewService AccessReviewService,
 ) Service {
 	return &identityServiceImpl{
-		authService:       authService,
-		userService:       userService,
-		membershipService: membershipService,
-		orgService:        orgService,
-		deptService:       deptService,
-		logoService:       logoService,
-		auditLogService:   auditLogService,
+		authService:             authService,
+		userService:             userService,
+		membershipService:       membershipService,
+		orgService:              orgService,
+		deptService:             deptService,
+		logoService:             logoService,
+		auditLogService:         auditLogService,
+		recycleBinService:       recycleBinService,
+		permissionReportService: permissionReportService,
+		roleGrantService:        roleGrantService,
+		accessReviewService:     accessReviewService,
 	}
 }
 
@@ -73,6 +85,63 @@ func (s *identityServiceImpl) ForcePasswordChange(
 	return s.authService.ForcePasswordChange(ctx, req)
 }
 
+func (s *identityServiceImpl) RequestPasswordReset(
+	ctx context.Context,
+	req *identity.RequestPasswordResetRequestDTO,
+	clientIP string,
+) (*http_base.OperationStatusResponseDTO, error) {
+	return s.authService.RequestPasswordReset(ctx, req, clientIP)
+}
+
+func (s *identityServiceImpl) ConfirmPasswordReset(
+	ctx context.Context,
+	req *identity.ConfirmPasswordResetRequestDTO,
+	clientIP string,
+) (*http_base.OperationStatusResponseDTO, string, error) {
+	return s.authService.ConfirmPasswordReset(ctx, req, clientIP)
+}
+
+func (s *identityServiceImpl) SwitchTenant(
+	ctx context.Context,
+	userID string,
+	organizationID string,
+) (*identity.SwitchTenantResponseDTO, error) {
+	return s.authService.SwitchTenant(ctx, userID, organizationID)
+}
+
+func (s *identityServiceImpl) RefreshSession(
+	ctx context.Context,
+	userID string,
+	organizationID string,
+) (string, []string, error) {
+	return s.authService.RefreshSession(ctx, userID, organizationID)
+}
+
+func (s *identityServiceImpl) EnrollMFA(
+	ctx context.Context,
+	userID string,
+) (*identity.MFAEnrollResponseDTO, error) {
+	return s.authService.EnrollMFA(ctx, userID)
+}
+
+func (s *identityServiceImpl) ConfirmMFA(
+	ctx context.Context,
+	userID, code string,
+) (*identity.ConfirmMFAResponseDTO, error) {
+	return s.authService.ConfirmMFA(ctx, userID, code)
+}
+
+func (s *identityServiceImpl) VerifyMFA(ctx context.Context, userID, code string) error {
+	return s.authService.VerifyMFA(ctx, userID, code)
+}
+
+func (s *identityServiceImpl) DisableMFA(
+	ctx context.Context,
+	userID, code string,
+) (*http_base.OperationStatusResponseDTO, error) {
+	return s.authService.DisableMFA(ctx, userID, code)
+}
+
 // =================================================================
 // UserService 接口实现 - 委托给 userService
 // =================================================================
@@ -95,8 +164,9 @@ func (s *identityServiceImpl) GetUser(
 func (s *identityServiceImpl) GetMe(
 	ctx context.Context,
 	userID string,
+	currentTenantID string,
 ) (*identity.UserProfileResponseDTO, error) {
-	return s.userService.GetMe(ctx, userID)
+	return s.userService.GetMe(ctx, userID, currentTenantID)
 }
 
 func (s *identityServiceImpl) UpdateUser(
@@ -136,6 +206,13 @@ func (s *identityServiceImpl) SearchUsers(
 	return s.userService.SearchUsers(ctx, req)
 }
 
+func (s *identityServiceImpl) ListUserRoleAssignments(
+	ctx context.Context,
+	req *identity.ListUserRoleAssignmentsRequestDTO,
+) (*identity.ListUserRoleAssignmentsResponseDTO, error) {
+	return s.userService.ListUserRoleAssignments(ctx, req)
+}
+
 func (s *identityServiceImpl) ChangeUserStatus(
 	ctx context.Context,
 	req *identity.ChangeUserStatusRequestDTO,
@@ -150,6 +227,21 @@ func (s *identityServiceImpl) UnlockUser(
 	return s.userService.UnlockUser(ctx, req)
 }
 
+func (s *identityServiceImpl) ImportUsers(
+	ctx context.Context,
+	req *identity.ImportUsersRequestDTO,
+	operatorID string,
+) (*identity.ImportUsersResponseDTO, error) {
+	return s.userService.ImportUsers(ctx, req, operatorID)
+}
+
+func (s *identityServiceImpl) ExportUsers(
+	ctx context.Context,
+	req *identity.ExportUsersRequestDTO,
+) (*ExportedFile, error) {
+	return s.userService.ExportUsers(ctx, req)
+}
+
 // =================================================================
 // MembershipService 接口实现 - 委托给 membershipService
 // =================================================================
@@ -290,3 +382,136 @@ func (s *identityServiceImpl) ListAuditLogs(
 ) (*identity.ListAuditLogsResponseDTO, error) {
 	return s.auditLogService.ListAuditLogs(ctx, req)
 }
+
+// =================================================================
+// RecycleBinService 接口实现 - 委托给 recycleBinService
+// =================================================================
+
+func (s *identityServiceImpl) ListDeletedEntities(
+	ctx context.Context,
+	req *identity.ListDeletedEntitiesRequestDTO,
+) (*identity.ListDeletedEntitiesResponseDTO, error) {
+	return s.recycleBinService.ListDeletedEntities(ctx, req)
+}
+
+func (s *identityServiceImpl) RestoreDeletedEntity(
+	ctx context.Context,
+	req *identity.DeletedEntityRequestDTO,
+) (*identity.RestoreDeletedEntityResponseDTO, error) {
+	return s.recycleBinService.RestoreDeletedEntity(ctx, req)
+}
+
+func (s *identityServiceImpl) PurgeDeletedEntity(
+	ctx context.Context,
+	req *identity.DeletedEntityRequestDTO,
+) (*http_base.OperationStatusResponseDTO, error) {
+	return s.recycleBinService.PurgeDeletedEntity(ctx, req)
+}
+
+// =================================================================
+// PermissionReportService 接口实现 - 委托给 permissionReportService
+// =================================================================
+
+func (s *identityServiceImpl) GetPermissionMatrix(
+	ctx context.Context,
+	req *identity.GetPermissionMatrixRequestDTO,
+) (*identity.GetPermissionMatrixResponseDTO, error) {
+	return s.permissionReportService.GetPermissionMatrix(ctx, req)
+}
+
+func (s *identityServiceImpl) GetUserEffectivePermissions(
+	ctx context.Context,
+	req *identity.GetUserEffectivePermissionsRequestDTO,
+) (*identity.GetUserEffectivePermissionsResponseDTO, error) {
+	return s.permissionReportService.GetUserEffectivePermissions(ctx, req)
+}
+
+func (s *identityServiceImpl) ExportPermissionReport(
+	ctx context.Context,
+	req *identity.ExportPermissionReportRequestDTO,
+) (*ExportedFile, error) {
+	return s.permissionReportService.ExportPermissionReport(ctx, req)
+}
+
+// =================================================================
+// RoleGrantService 接口实现 - 委托给 roleGrantService
+// =================================================================
+
+func (s *identityServiceImpl) ListRoleGrantRequests(
+	ctx context.Context,
+	req *identity.ListRoleGrantRequestsRequestDTO,
+) (*identity.ListRoleGrantRequestsResponseDTO, error) {
+	return s.roleGrantService.ListRoleGrantRequests(ctx, req)
+}
+
+func (s *identityServiceImpl) GetRoleGrantRequest(
+	ctx context.Context,
+	req *identity.GetRoleGrantRequestRequestDTO,
+) (*identity.RoleGrantRequestResponseDTO, error) {
+	return s.roleGrantService.GetRoleGrantRequest(ctx, req)
+}
+
+func (s *identityServiceImpl) ApproveRoleGrant(
+	ctx context.Context,
+	req *identity.DecideRoleGrantRequestDTO,
+	approverID string,
+) (*identity.RoleGrantRequestResponseDTO, error) {
+	return s.roleGrantService.ApproveRoleGrant(ctx, req, approverID)
+}
+
+func (s *identityServiceImpl) RejectRoleGrant(
+	ctx context.Context,
+	req *identity.DecideRoleGrantRequestDTO,
+	approverID string,
+) (*identity.RoleGrantRequestResponseDTO, error) {
+	return s.roleGrantService.RejectRoleGrant(ctx, req, approverID)
+}
+
+// =================================================================
+// AccessReviewService 接口实现 - 委托给 accessReviewService
+// =================================================================
+
+func (s *identityServiceImpl) ListAccessReviewCampaigns(
+	ctx context.Context,
+	req *identity.ListAccessReviewCampaignsRequestDTO,
+) (*identity.ListAccessReviewCampaignsResponseDTO, error) {
+	return s.accessReviewService.ListAccessReviewCampaigns(ctx, req)
+}
+
+func (s *identityServiceImpl) CreateAccessReviewCampaign(
+	ctx context.Context,
+	req *identity.CreateAccessReviewCampaignRequestDTO,
+	operatorID string,
+) (*identity.AccessReviewCampaignResponseDTO, error) {
+	return s.accessReviewService.CreateAccessReviewCampaign(ctx, req, operatorID)
+}
+
+func (s *identityServiceImpl) GetAccessReviewCampaign(
+	ctx context.Context,
+	req *identity.GetAccessReviewCampaignRequestDTO,
+) (*identity.AccessReviewCampaignResponseDTO, error) {
+	return s.accessReviewService.GetAccessReviewCampaign(ctx, req)
+}
+
+func (s *identityServiceImpl) ListAccessReviewItems(
+	ctx context.Context,
+	req *identity.ListAccessReviewItemsRequestDTO,
+) (*identity.ListAccessReviewItemsResponseDTO, error) {
+	return s.accessReviewService.ListAccessReviewItems(ctx, req)
+}
+
+func (s *identityServiceImpl) CloseAccessReviewCampaign(
+	ctx context.Context,
+	req *identity.CloseAccessReviewCampaignRequestDTO,
+	operatorID string,
+) (*identity.AccessReviewCampaignResponseDTO, error) {
+	return s.accessReviewService.CloseAccessReviewCampaign(ctx, req, operatorID)
+}
+
+func (s *identityServiceImpl) DecideAccessReviewItem(
+	ctx context.Context,
+	req *identity.DecideAccessReviewItemRequestDTO,
+	reviewerID string,
+) (*identity.AccessReviewItemResponseDTO, error) {
+	return s.accessReviewService.DecideAccessReviewItem(ctx, req, reviewerID)
+}
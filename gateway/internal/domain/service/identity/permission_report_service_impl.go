@@ -0,0 +1,117 @@
+package identity
+
+import (
+	"context"
+
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/biz/model/identity"
+	identityassembler "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/application/assembler/identity"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/domain/common"
+	identitycli "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/client/identity_cli"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+)
+
+// permissionReportServiceImpl 权限报告服务实现
+type permissionReportServiceImpl struct {
+	*common.BaseService
+	identityClient identitycli.IdentityClient
+	assembler      identityassembler.Assembler
+}
+
+// NewPermissionReportService 创建新的权限报告服务实例
+func NewPermissionReportService(
+	identityClient identitycli.IdentityClient,
+	assembler identityassembler.Assembler,
+	logger *hertzZerolog.Logger,
+) PermissionReportService {
+	return &permissionReportServiceImpl{
+		BaseService:    common.NewBaseService(logger),
+		identityClient: identityClient,
+		assembler:      assembler,
+	}
+}
+
+// =================================================================
+// 权限报告模块 (Permission Report)
+// =================================================================
+
+func (s *permissionReportServiceImpl) GetPermissionMatrix(
+	ctx context.Context,
+	req *identity.GetPermissionMatrixRequestDTO,
+) (*identity.GetPermissionMatrixResponseDTO, error) {
+	// 转换请求
+	rpcReq := s.assembler.PermissionReport().ToRPCGetPermissionMatrixRequest(req)
+
+	// 调用RPC服务
+	result, err := s.ProcessRPCCall(ctx, "查询权限矩阵",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.GetPermissionMatrix(ctx, rpcReq)
+		},
+		"product_line", req.ProductLine,
+		"role_count", len(req.RoleIDs),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.GetPermissionMatrixResponse)
+
+	// 转换响应
+	httpResp := s.assembler.PermissionReport().ToHTTPGetPermissionMatrixResponse(rpcResp)
+	httpResp.BaseResp = s.ResponseBuilder().BuildSuccessResponse()
+
+	return httpResp, nil
+}
+
+func (s *permissionReportServiceImpl) GetUserEffectivePermissions(
+	ctx context.Context,
+	req *identity.GetUserEffectivePermissionsRequestDTO,
+) (*identity.GetUserEffectivePermissionsResponseDTO, error) {
+	// 转换请求
+	rpcReq := s.assembler.PermissionReport().ToRPCGetUserEffectivePermissionsRequest(req)
+
+	// 调用RPC服务
+	result, err := s.ProcessRPCCall(ctx, "查询用户有效权限",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.GetUserEffectivePermissions(ctx, rpcReq)
+		},
+		"user_id", req.UserID,
+		"organization_id", req.OrganizationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.GetUserEffectivePermissionsResponse)
+
+	// 转换响应
+	httpResp := s.assembler.PermissionReport().ToHTTPGetUserEffectivePermissionsResponse(rpcResp)
+	httpResp.BaseResp = s.ResponseBuilder().BuildSuccessResponse()
+
+	return httpResp, nil
+}
+
+func (s *permissionReportServiceImpl) ExportPermissionReport(
+	ctx context.Context,
+	req *identity.ExportPermissionReportRequestDTO,
+) (*ExportedFile, error) {
+	result, err := s.ProcessRPCCall(ctx, "导出权限报告",
+		func(ctx context.Context) (interface{}, error) {
+			rpcReq := s.assembler.PermissionReport().ToRPCExportPermissionReportRequest(req)
+			return s.identityClient.ExportPermissionReport(ctx, rpcReq)
+		},
+		"format", req.Format, "view", req.View,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.ExportPermissionReportResponse)
+
+	return &ExportedFile{
+		FileName:    rpcResp.GetFileName(),
+		ContentType: rpcResp.GetContentType(),
+		Content:     rpcResp.GetFileContent(),
+	}, nil
+}
@@ -0,0 +1,116 @@
+package identity
+
+import (
+	"context"
+
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/biz/model/http_base"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/biz/model/identity"
+	identityassembler "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/application/assembler/identity"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/domain/common"
+	identitycli "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/client/identity_cli"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+)
+
+// recycleBinServiceImpl 回收站服务实现
+type recycleBinServiceImpl struct {
+	*common.BaseService
+	identityClient identitycli.IdentityClient
+	assembler      identityassembler.Assembler
+}
+
+// NewRecycleBinService 创建新的回收站服务实例
+func NewRecycleBinService(
+	identityClient identitycli.IdentityClient,
+	assembler identityassembler.Assembler,
+	logger *hertzZerolog.Logger,
+) RecycleBinService {
+	return &recycleBinServiceImpl{
+		BaseService:    common.NewBaseService(logger),
+		identityClient: identityClient,
+		assembler:      assembler,
+	}
+}
+
+// =================================================================
+// 回收站模块 (Recycle Bin)
+// =================================================================
+
+func (s *recycleBinServiceImpl) ListDeletedEntities(
+	ctx context.Context,
+	req *identity.ListDeletedEntitiesRequestDTO,
+) (*identity.ListDeletedEntitiesResponseDTO, error) {
+	// 转换请求
+	rpcReq := s.assembler.RecycleBin().ToRPCListDeletedEntitiesRequest(req)
+
+	// 调用RPC服务
+	result, err := s.ProcessRPCCall(ctx, "查询回收站",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.ListDeletedEntities(ctx, rpcReq)
+		},
+		"entity_type", req.EntityType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.ListDeletedEntitiesResponse)
+
+	// 转换响应
+	httpResp := s.assembler.RecycleBin().ToHTTPListDeletedEntitiesResponse(rpcResp)
+	httpResp.BaseResp = s.ResponseBuilder().BuildSuccessResponse()
+
+	return httpResp, nil
+}
+
+func (s *recycleBinServiceImpl) RestoreDeletedEntity(
+	ctx context.Context,
+	req *identity.DeletedEntityRequestDTO,
+) (*identity.RestoreDeletedEntityResponseDTO, error) {
+	// 转换请求
+	rpcReq := s.assembler.RecycleBin().ToRPCRestoreDeletedEntityRequest(req)
+
+	// 调用RPC服务
+	result, err := s.ProcessRPCCall(ctx, "恢复回收站记录",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.RestoreDeletedEntity(ctx, rpcReq)
+		},
+		"entity_type", req.EntityType,
+		"id", req.Id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.RestoreDeletedEntityResponse)
+
+	// 转换响应
+	httpResp := s.assembler.RecycleBin().ToHTTPRestoreDeletedEntityResponse(rpcResp)
+	httpResp.BaseResp = s.ResponseBuilder().BuildSuccessResponse()
+
+	return httpResp, nil
+}
+
+func (s *recycleBinServiceImpl) PurgeDeletedEntity(
+	ctx context.Context,
+	req *identity.DeletedEntityRequestDTO,
+) (*http_base.OperationStatusResponseDTO, error) {
+	// 转换请求
+	rpcReq := s.assembler.RecycleBin().ToRPCPurgeDeletedEntityRequest(req)
+
+	// 调用RPC服务
+	err := s.ProcessRPCVoidCall(ctx, "彻底删除回收站记录",
+		func(ctx context.Context) error {
+			_, err := s.identityClient.PurgeDeletedEntity(ctx, rpcReq)
+			return err
+		},
+		"entity_type", req.EntityType,
+		"id", req.Id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ResponseBuilder().BuildOperationStatusResponse(), nil
+}
@@ -0,0 +1,146 @@
+package identity
+
+import (
+	"context"
+
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/biz/model/identity"
+	identityassembler "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/application/assembler/identity"
+	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/domain/common"
+	identitycli "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/client/identity_cli"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+)
+
+// roleGrantServiceImpl 角色授权审批服务实现
+type roleGrantServiceImpl struct {
+	*common.BaseService
+	identityClient identitycli.IdentityClient
+	assembler      identityassembler.Assembler
+}
+
+// NewRoleGrantService 创建新的角色授权审批服务实例
+func NewRoleGrantService(
+	identityClient identitycli.IdentityClient,
+	assembler identityassembler.Assembler,
+	logger *hertzZerolog.Logger,
+) RoleGrantService {
+	return &roleGrantServiceImpl{
+		BaseService:    common.NewBaseService(logger),
+		identityClient: identityClient,
+		assembler:      assembler,
+	}
+}
+
+// =================================================================
+// 角色授权审批模块 (Role Grant)
+// =================================================================
+
+func (s *roleGrantServiceImpl) ListRoleGrantRequests(
+	ctx context.Context,
+	req *identity.ListRoleGrantRequestsRequestDTO,
+) (*identity.ListRoleGrantRequestsResponseDTO, error) {
+	// 转换请求
+	rpcReq := s.assembler.RoleGrant().ToRPCListRoleGrantRequestsRequest(req)
+
+	// 调用RPC服务
+	result, err := s.ProcessRPCCall(ctx, "查询角色授权申请",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.ListRoleGrantRequests(ctx, rpcReq)
+		},
+		"status", req.Status,
+		"user_id", req.UserID,
+		"role_id", req.RoleID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.ListRoleGrantRequestsResponse)
+
+	// 转换响应
+	httpResp := s.assembler.RoleGrant().ToHTTPListRoleGrantRequestsResponse(rpcResp)
+	httpResp.BaseResp = s.ResponseBuilder().BuildSuccessResponse()
+
+	return httpResp, nil
+}
+
+func (s *roleGrantServiceImpl) GetRoleGrantRequest(
+	ctx context.Context,
+	req *identity.GetRoleGrantRequestRequestDTO,
+) (*identity.RoleGrantRequestResponseDTO, error) {
+	result, err := s.ProcessRPCCall(ctx, "获取角色授权申请",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.GetRoleGrantRequest(ctx, &identity_srv.GetRoleGrantRequestRequest{
+				RequestID: req.RequestID,
+			})
+		},
+		"request_id", req.RequestID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.GetRoleGrantRequestResponse)
+
+	return &identity.RoleGrantRequestResponseDTO{
+		BaseResp: s.ResponseBuilder().BuildSuccessResponse(),
+		Request:  s.assembler.RoleGrant().ToHTTPRoleGrantRequest(rpcResp.Request),
+	}, nil
+}
+
+func (s *roleGrantServiceImpl) ApproveRoleGrant(
+	ctx context.Context,
+	req *identity.DecideRoleGrantRequestDTO,
+	approverID string,
+) (*identity.RoleGrantRequestResponseDTO, error) {
+	// 转换请求
+	rpcReq := s.assembler.RoleGrant().ToRPCApproveRoleGrantRequest(req, approverID)
+
+	// 调用RPC服务
+	result, err := s.ProcessRPCCall(ctx, "审批角色授权申请",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.ApproveRoleGrant(ctx, rpcReq)
+		},
+		"request_id", req.RequestID,
+		"approver_id", approverID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.ApproveRoleGrantResponse)
+
+	return &identity.RoleGrantRequestResponseDTO{
+		BaseResp: s.ResponseBuilder().BuildSuccessResponse(),
+		Request:  s.assembler.RoleGrant().ToHTTPRoleGrantRequest(rpcResp.Request),
+	}, nil
+}
+
+func (s *roleGrantServiceImpl) RejectRoleGrant(
+	ctx context.Context,
+	req *identity.DecideRoleGrantRequestDTO,
+	approverID string,
+) (*identity.RoleGrantRequestResponseDTO, error) {
+	// 转换请求
+	rpcReq := s.assembler.RoleGrant().ToRPCRejectRoleGrantRequest(req, approverID)
+
+	// 调用RPC服务
+	result, err := s.ProcessRPCCall(ctx, "驳回角色授权申请",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.RejectRoleGrant(ctx, rpcReq)
+		},
+		"request_id", req.RequestID,
+		"approver_id", approverID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.RejectRoleGrantResponse)
+
+	return &identity.RoleGrantRequestResponseDTO{
+		BaseResp: s.ResponseBuilder().BuildSuccessResponse(),
+		Request:  s.assembler.RoleGrant().ToHTTPRoleGrantRequest(rpcResp.Request),
+	}, nil
+}
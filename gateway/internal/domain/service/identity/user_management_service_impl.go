@@ -115,6 +115,7 @@ func (s *userManagementServiceImpl) GetUser(
 func (s *userManagementServiceImpl) GetMe(
 	ctx context.Context,
 	userID string,
+	currentTenantID string,
 ) (*identity.UserProfileResponseDTO, error) {
 	result, err := s.ProcessRPCCall(ctx, "获取当前用户信息",
 		func(ctx context.Context) (interface{}, error) {
@@ -136,8 +137,9 @@ func (s *userManagementServiceImpl) GetMe(
 	s.enrichUserProfileWithRoles(ctx, httpUserProfile)
 
 	httpResp := &identity.UserProfileResponseDTO{
-		BaseResp: s.ResponseBuilder().BuildSuccessResponse(),
-		User:     httpUserProfile,
+		BaseResp:          s.ResponseBuilder().BuildSuccessResponse(),
+		User:              httpUserProfile,
+		SwitchableTenants: s.listSwitchableTenants(ctx, userID, currentTenantID),
 	}
 
 	return httpResp, nil
@@ -255,6 +257,28 @@ func (s *userManagementServiceImpl) DeleteUser(
 	return s.ResponseBuilder().BuildOperationStatusResponse(), nil
 }
 
+func (s *userManagementServiceImpl) ListUserRoleAssignments(
+	ctx context.Context,
+	req *identity.ListUserRoleAssignmentsRequestDTO,
+) (*identity.ListUserRoleAssignmentsResponseDTO, error) {
+	result, err := s.ProcessRPCCall(ctx, "查询用户角色分配",
+		func(ctx context.Context) (interface{}, error) {
+			rpcReq := s.assembler.User().ToRPCListUserRoleAssignmentsRequest(req)
+			return s.identityClient.ListUserRoleAssignments(ctx, rpcReq)
+		},
+		"page", req.Page, "user_id", req.UserID, "role_id", req.RoleID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.UserRoleListResponse)
+	httpResp := s.assembler.User().ToHTTPListUserRoleAssignmentsResponse(rpcResp)
+	httpResp.BaseResp = s.ResponseBuilder().BuildSuccessResponse()
+
+	return httpResp, nil
+}
+
 func (s *userManagementServiceImpl) ListUsers(
 	ctx context.Context,
 	req *identity.ListUsersRequestDTO,
@@ -348,6 +372,53 @@ func (s *userManagementServiceImpl) UnlockUser(
 	return s.ResponseBuilder().BuildOperationStatusResponse(), nil
 }
 
+func (s *userManagementServiceImpl) ImportUsers(
+	ctx context.Context,
+	req *identity.ImportUsersRequestDTO,
+	operatorID string,
+) (*identity.ImportUsersResponseDTO, error) {
+	result, err := s.ProcessRPCCall(ctx, "批量导入用户",
+		func(ctx context.Context) (interface{}, error) {
+			rpcReq := s.assembler.User().ToRPCImportUsersRequest(req, operatorID)
+			return s.identityClient.ImportUsers(ctx, rpcReq)
+		},
+		"format", req.Format, "dry_run", req.DryRun, "operator_id", operatorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.ImportUsersResponse)
+	httpResp := s.assembler.User().ToHTTPImportUsersResponse(rpcResp)
+	httpResp.BaseResp = s.ResponseBuilder().BuildSuccessResponse()
+
+	return httpResp, nil
+}
+
+func (s *userManagementServiceImpl) ExportUsers(
+	ctx context.Context,
+	req *identity.ExportUsersRequestDTO,
+) (*ExportedFile, error) {
+	result, err := s.ProcessRPCCall(ctx, "导出用户",
+		func(ctx context.Context) (interface{}, error) {
+			rpcReq := s.assembler.User().ToRPCExportUsersRequest(req)
+			return s.identityClient.ExportUsers(ctx, rpcReq)
+		},
+		"format", req.Format, "organization_id", req.OrganizationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.ExportUsersResponse)
+
+	return &ExportedFile{
+		FileName:    rpcResp.GetFileName(),
+		ContentType: rpcResp.GetContentType(),
+		Content:     rpcResp.GetFileContent(),
+	}, nil
+}
+
 // =================================================================
 // 私有辅助方法 (Private Helper Methods)
 // =================================================================
@@ -390,6 +461,64 @@ func (s *userManagementServiceImpl) enrichUserProfileWithRoles(
 	}
 }
 
+// listSwitchableTenants 列出用户可切换的租户（活跃成员关系所在的组织）
+// 查询失败不影响 GetMe 主流程，仅记录警告并返回空列表
+func (s *userManagementServiceImpl) listSwitchableTenants(
+	ctx context.Context,
+	userID string,
+	currentTenantID string,
+) []*identity.TenantOptionDTO {
+	activeOnly := true
+	withOrganizationName := true
+
+	// 组织名称随成员关系一次返回，不再逐个组织调用 GetOrganization
+	membershipResp, err := s.identityClient.GetUserMemberships(
+		ctx,
+		&identity_srv.GetUserMembershipsRequest{
+			UserID:               &userID,
+			ActiveOnly:           &activeOnly,
+			WithOrganizationName: &withOrganizationName,
+		},
+	)
+	if err != nil {
+		s.Log(ctx).Warn().Err(err).Str("user_id", userID).Msg("获取用户可切换租户失败")
+		return []*identity.TenantOptionDTO{}
+	}
+
+	// 同一组织下可能有多个部门成员关系，按组织去重
+	tenants := make([]*identity.TenantOptionDTO, 0, len(membershipResp.GetMemberships()))
+	indexByOrg := make(map[string]*identity.TenantOptionDTO)
+
+	for _, m := range membershipResp.GetMemberships() {
+		orgID := m.GetOrganizationID()
+		if orgID == "" {
+			continue
+		}
+
+		if existing, ok := indexByOrg[orgID]; ok {
+			if m.GetIsPrimary() {
+				existing.IsPrimary = m.IsPrimary
+			}
+
+			continue
+		}
+
+		isPrimary := m.GetIsPrimary()
+		isCurrent := orgID == currentTenantID
+		tenant := &identity.TenantOptionDTO{
+			OrganizationID:   &orgID,
+			OrganizationName: m.OrganizationName,
+			IsPrimary:        &isPrimary,
+			IsCurrent:        &isCurrent,
+		}
+
+		indexByOrg[orgID] = tenant
+		tenants = append(tenants, tenant)
+	}
+
+	return tenants
+}
+
 // enrichUserProfilesWithRolesBatch 批量为用户档案填充角色ID列表
 // 使用批量查询避免 N+1 查询问题
 func (s *userManagementServiceImpl) enrichUserProfilesWithRolesBatch(
@@ -497,7 +626,7 @@ func (s *userManagementServiceImpl) assignRolesToUser(
 			continue
 		}
 
-		_, err := s.identityClient.AssignRoleToUser(ctx, &identity_srv.AssignRoleToUserRequest{
+		resp, err := s.identityClient.AssignRoleToUser(ctx, &identity_srv.AssignRoleToUserRequest{
 			UserID:     userID,
 			RoleID:     &roleID,
 			AssignedBy: &operatorID,
@@ -507,9 +636,20 @@ func (s *userManagementServiceImpl) assignRolesToUser(
 				Str("user_id", *userID).
 				Str("role_id", roleID).
 				Msg("分配角色失败")
-		} else {
-			successCount++
+
+			continue
 		}
+
+		// 敏感角色不会立即生效，而是转为待审批的授权申请
+		if resp.GetPendingApproval() {
+			s.Log(ctx).Info().
+				Str("user_id", *userID).
+				Str("role_id", roleID).
+				Str("grant_request_id", resp.GetGrantRequestID()).
+				Msg("敏感角色已提交授权申请，等待审批")
+		}
+
+		successCount++
 	}
 
 	s.Log(ctx).Info().
@@ -171,9 +171,12 @@ func buildRetryPolicies() map[string]retry.Policy {
 		"ListUsers":   backupPolicy(300),
 		"SearchUsers": backupPolicy(300),
 		"UpdateUser":  failurePolicy(),
+		// 导出耗时与数据量相关，不使用备份请求，仅失败重试
+		"ExportUsers": failurePolicy(),
 
 		// ===== 认证模块 =====
-		"Login": failurePolicy(),
+		"Login":          failurePolicy(),
+		"RefreshSession": backupPolicy(200),
 
 		// ===== 成员关系模块 =====
 		"GetMembership":        backupPolicy(200),
@@ -205,8 +208,12 @@ func buildRetryPolicies() map[string]retry.Policy {
 		"ListUserRoleAssignments":   backupPolicy(200),
 		"GetUsersByRole":            backupPolicy(300),
 		"BatchGetUserRoles":         backupPolicy(300),
+		"GetUserTenantRoles":        backupPolicy(200),
 		"UpdateUserRoleAssignment":  failurePolicy(),
 
+		// ===== 回收站模块 =====
+		"ListDeletedEntities": backupPolicy(300),
+
 		// ===== 菜单与权限模块 =====
 		"GetMenuTree":            backupPolicy(200),
 		"GetRoleMenuTree":        backupPolicy(200),
@@ -215,15 +222,34 @@ func buildRetryPolicies() map[string]retry.Policy {
 		"HasMenuPermission":      backupPolicy(100),
 		"GetUserMenuPermissions": backupPolicy(200),
 
+		// ===== 权限报告模块 =====
+		"GetPermissionMatrix":         backupPolicy(300),
+		"GetUserEffectivePermissions": backupPolicy(300),
+		// 导出耗时与数据量相关，不使用备份请求，仅失败重试
+		"ExportPermissionReport": failurePolicy(),
+
+		// ===== 角色授权审批模块 =====
+		"ListRoleGrantRequests": backupPolicy(300),
+		"GetRoleGrantRequest":   backupPolicy(200),
+
+		// ===== 访问复核模块 =====
+		"ListAccessReviewCampaigns": backupPolicy(300),
+		"GetAccessReviewCampaign":   backupPolicy(200),
+		"ListAccessReviewItems":     backupPolicy(300),
+
 		// 注意：以下方法为非幂等操作，不配置重试
-		// CreateUser, DeleteUser, ChangeUserStatus, UnlockUser
+		// CreateUser, DeleteUser, ChangeUserStatus, UnlockUser, ImportUsers
 		// ChangePassword, ResetPassword, ForcePasswordChange
+		// RequestPasswordReset, ConfirmPasswordReset
 		// AddMembership, RemoveMembership
 		// CreateOrganization, DeleteOrganization
 		// CreateDepartment, DeleteDepartment
 		// UploadTemporaryLogo, DeleteOrganizationLogo, BindLogoToOrganization
 		// CreateRoleDefinition, DeleteRoleDefinition
 		// AssignRoleToUser, RevokeRoleFromUser, BatchBindUsersToRole
+		// ApproveRoleGrant, RejectRoleGrant
+		// CreateAccessReviewCampaign, DecideAccessReviewItem, CloseAccessReviewCampaign
 		// UploadMenu, ConfigureRoleMenus
+		// RestoreDeletedEntity, PurgeDeletedEntity
 	}
 }
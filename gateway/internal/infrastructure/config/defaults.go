@@ -50,6 +50,7 @@ func setDefaults(v *viper.Viper) {
 		"Content-Type",
 		"Authorization",
 		"X-Requested-With",
+		"If-Match",
 	})
 	// 启用 credentials 支持跨域 Cookie
 	v.SetDefault("middleware.cors.allow_credentials", true)
@@ -67,7 +68,8 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("middleware.jwt.signing_key", "")
 	v.SetDefault("middleware.jwt.priv_key_path", "./config/keys/private.pem")
 	v.SetDefault("middleware.jwt.pub_key_path", "./config/keys/public.pem")
-	v.SetDefault("middleware.jwt.timeout", 30*time.Minute)
+	// access token 短有效期，依赖刷新令牌轮换续期；max_refresh 为刷新令牌族的会话上限
+	v.SetDefault("middleware.jwt.timeout", 15*time.Minute)
 	v.SetDefault("middleware.jwt.max_refresh", 7*24*time.Hour)
 	v.SetDefault("middleware.jwt.identity_key", "identity")
 	v.SetDefault("middleware.jwt.realm", "API Gateway")
@@ -77,25 +79,9 @@ func setDefaults(v *viper.Viper) {
 	)
 	v.SetDefault("middleware.jwt.token_head_name", "Bearer")
 	v.SetDefault("middleware.jwt.send_authorization", false)
-	// JWT 跳过认证的路径列表（默认跳过健康检查、指标、OIDC 公开端点、登录/刷新）
-	v.SetDefault("middleware.jwt.skip_paths", []string{
-		"/.well-known/jwks.json",
-		"/.well-known/openid-configuration",
-		"/keys",
-		"/oauth/token",
-		"/authorize",
-		"/authorize/callback",
-		"/login",
-		"/revoke",
-		"/oauth/introspect",
-		"/userinfo",
-		"/api/v1/identity/auth/login",
-		"/api/v1/identity/auth/refresh",
-		"/ping",
-		"/health",
-		"/metrics",
-		"/swagger/*",
-	})
+	v.SetDefault("middleware.jwt.mfa_challenge_ttl", 5*time.Minute)
+	v.SetDefault("middleware.jwt.mfa_max_attempts", 5)
+	// JWT 跳过认证的路径不再单独配置：公开路由统一取自 authz_rules.yaml 的 public 列表
 
 	// Cookie默认值
 	v.SetDefault("middleware.jwt.cookie.send_cookie", true)
@@ -137,6 +123,12 @@ func setDefaults(v *viper.Viper) {
 	// gateway/config/ → ./config/，因此默认相对路径在两个场景下都成立。
 	v.SetDefault("middleware.authz.enabled", true)
 	v.SetDefault("middleware.authz.rules_file", "./config/authz_rules.yaml")
+	v.SetDefault("middleware.authz.watch", true)
+	// PDP 路由授权默认关闭；镜像构建时把仓库根目录的 menu.yaml 拷贝到 ./config/
+	v.SetDefault("middleware.authz.pdp.enabled", false)
+	v.SetDefault("middleware.authz.pdp.menu_file", "./config/menu.yaml")
+	v.SetDefault("middleware.authz.pdp.policy_service", "policy-service")
+	v.SetDefault("middleware.authz.pdp.cache_ttl", 30*time.Second)
 
 	// Redis 默认值
 	v.SetDefault("redis.address", "localhost:6379")
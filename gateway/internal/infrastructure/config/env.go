@@ -283,6 +283,16 @@ func mapJWTEnvVars(v *viper.Viper) {
 	mapToViper(v, "JWT_SKIP_PATHS", "middleware.jwt.skip_paths", func(value string) interface{} {
 		return splitAndTrim(value, ",")
 	})
+	mapToViper(v, "JWT_MFA_CHALLENGE_TTL", "middleware.jwt.mfa_challenge_ttl", func(value string) interface{} {
+		return parseDurationWithDefault(value, 5*time.Minute)
+	})
+	mapToViper(v, "JWT_MFA_MAX_ATTEMPTS", "middleware.jwt.mfa_max_attempts", func(value string) interface{} {
+		if val, err := strconv.Atoi(value); err == nil {
+			return val
+		}
+
+		return 5
+	})
 
 	// Cookie配置映射
 	mapCookieEnvVars(v)
@@ -364,12 +374,24 @@ func mapOIDCEnvVars(v *viper.Viper) {
 
 // mapAuthZEnvVars 映射路由级 ACL（authz_middleware）相关环境变量
 //
-// 环境变量：AUTHZ_ENABLED, AUTHZ_RULES_FILE
+// 环境变量：AUTHZ_ENABLED, AUTHZ_RULES_FILE, AUTHZ_WATCH,
+// AUTHZ_PDP_ENABLED, AUTHZ_PDP_MENU_FILE, AUTHZ_PDP_POLICY_SERVICE, AUTHZ_PDP_CACHE_TTL
 func mapAuthZEnvVars(v *viper.Viper) {
 	mapToViper(v, "AUTHZ_ENABLED", "middleware.authz.enabled", func(value string) interface{} {
 		return value == "true"
 	})
 	mapToViper(v, "AUTHZ_RULES_FILE", "middleware.authz.rules_file", nil)
+	mapToViper(v, "AUTHZ_WATCH", "middleware.authz.watch", func(value string) interface{} {
+		return value == "true"
+	})
+	mapToViper(v, "AUTHZ_PDP_ENABLED", "middleware.authz.pdp.enabled", func(value string) interface{} {
+		return value == "true"
+	})
+	mapToViper(v, "AUTHZ_PDP_MENU_FILE", "middleware.authz.pdp.menu_file", nil)
+	mapToViper(v, "AUTHZ_PDP_POLICY_SERVICE", "middleware.authz.pdp.policy_service", nil)
+	mapToViper(v, "AUTHZ_PDP_CACHE_TTL", "middleware.authz.pdp.cache_ttl", func(value string) interface{} {
+		return parseDurationWithDefault(value, 30*time.Second)
+	})
 }
 
 // mapLogEnvVars 映射日志相关环境变量
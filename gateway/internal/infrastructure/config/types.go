@@ -117,20 +117,24 @@ type OIDCConfig struct {
 // JWT_IDENTITY_KEY, JWT_REALM, JWT_TOKEN_LOOKUP, JWT_TOKEN_HEAD_NAME, JWT_SEND_AUTHORIZATION,
 // JWT_SKIP_PATHS, JWT_COOKIE_SEND_COOKIE, JWT_COOKIE_COOKIE_NAME, JWT_COOKIE_COOKIE_DOMAIN,
 // JWT_COOKIE_COOKIE_PATH, JWT_COOKIE_COOKIE_MAX_AGE, JWT_COOKIE_COOKIE_SAME_SITE,
-// JWT_COOKIE_SECURE_COOKIE, JWT_COOKIE_HTTP_ONLY
+// JWT_COOKIE_SECURE_COOKIE, JWT_COOKIE_HTTP_ONLY, JWT_MFA_CHALLENGE_TTL, JWT_MFA_MAX_ATTEMPTS
 // 用于配置 JWT 认证和 Cookie 相关设置
 type JWTConfig struct {
 	Realm             string        `mapstructure:"realm"`              // 认证领域
 	PrivKeyPath       string        `mapstructure:"priv_key_path"`      // RS256 私钥文件路径
 	PubKeyPath        string        `mapstructure:"pub_key_path"`       // RS256 公钥文件路径
 	Timeout           time.Duration `mapstructure:"timeout"`            // access-token 有效期(秒)
-	MaxRefresh        time.Duration `mapstructure:"max_refresh"`        // refresh-token 有效期(秒)
+	MaxRefresh        time.Duration `mapstructure:"max_refresh"`        // refresh-token 族会话上限(秒)，轮换不延长
 	IdentityKey       string        `mapstructure:"identity_key"`       // JWT中存储用户标识的键
-	SkipPaths         []string      `mapstructure:"skip_paths"`         // 跳过认证的路径列表
+	SkipPaths         []string      `mapstructure:"skip_paths"`         // Deprecated: 公开路由取自 authz public 列表，配置时仅做一致性校验
 	TokenLookup       string        `mapstructure:"token_lookup"`       // 获取token的lookup方式
 	TokenHeadName     string        `mapstructure:"token_head_name"`    // token头前缀
 	SendAuthorization bool          `mapstructure:"send_authorization"` // 是否在响应中返回 Authorization header
 
+	// 多因素认证登录挑战
+	MFAChallengeTTL time.Duration `mapstructure:"mfa_challenge_ttl"` // 密码通过后等待第二因素的有效期
+	MFAMaxAttempts  int           `mapstructure:"mfa_max_attempts"`  // 单个挑战允许的口令错误次数，耗尽后需重新登录
+
 	// Cookie配置（前后端分离架构）
 	Cookie CookieConfig `mapstructure:"cookie"` // Cookie配置
 }
@@ -149,14 +153,28 @@ type CookieConfig struct {
 }
 
 // AuthZConfig 路由级 ACL 配置（替代 Casbin，对应 authz_middleware）
-// 相关环境变量：AUTHZ_ENABLED, AUTHZ_RULES_FILE
+// 相关环境变量：AUTHZ_ENABLED, AUTHZ_RULES_FILE, AUTHZ_WATCH
 //
 // Enabled=false 时跳过路由级 ACL，所有已认证请求直接放行；用于本地调试或紧急
 // 关闭策略校验。RulesFile 是 YAML 文件路径，相对路径相对于进程工作目录解析
-// （容器中 WORKDIR=/app）。
+// （容器中 WORKDIR=/app）。Watch=true 时监听规则文件，变化后热加载（解析失败保留旧规则）。
 type AuthZConfig struct {
-	Enabled   bool   `mapstructure:"enabled"`
-	RulesFile string `mapstructure:"rules_file"`
+	Enabled   bool           `mapstructure:"enabled"`
+	RulesFile string         `mapstructure:"rules_file"`
+	Watch     bool           `mapstructure:"watch"`
+	PDP       AuthZPDPConfig `mapstructure:"pdp"`
+}
+
+// AuthZPDPConfig 网关 PDP 路由授权配置（默认关闭）
+// 相关环境变量：AUTHZ_PDP_ENABLED, AUTHZ_PDP_MENU_FILE, AUTHZ_PDP_POLICY_SERVICE, AUTHZ_PDP_CACHE_TTL
+//
+// 开启后，路由级 ACL 放行的已认证请求按「路由 → action/resource」映射经 iamclient 向
+// policy_srv 决策；映射取自 MenuFile 中各菜单的 api_paths 与 authz_rules.yaml 的 permissions 段。
+type AuthZPDPConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	MenuFile      string        `mapstructure:"menu_file"`
+	PolicyService string        `mapstructure:"policy_service"` // policy_srv 注册名
+	CacheTTL      time.Duration `mapstructure:"cache_ttl"`      // 决策缓存有效期
 }
 
 // LogConfig 日志配置
@@ -7,8 +7,9 @@ import "fmt"
 // APIError API 层业务错误结构体
 // 采用私有字段设计，通过方法访问，确保数据安全性
 type APIError struct {
-	code    int32  // 错误码
-	message string // 错误消息
+	code           int32  // 错误码
+	message        string // 错误消息
+	currentVersion int64  // 版本冲突时记录的当前版本号，以 ETag 响应头返回
 }
 
 // Error 实现 error 接口
@@ -30,6 +31,17 @@ func (e APIError) WithMessage(message string) APIError {
 	return e
 }
 
+// WithCurrentVersion 记录版本冲突时的当前版本号，返回新的错误实例
+func (e APIError) WithCurrentVersion(version int64) APIError {
+	e.currentVersion = version
+	return e
+}
+
+// CurrentVersion 获取版本冲突时的当前版本号，非版本冲突错误返回 0
+func (e APIError) CurrentVersion() int64 {
+	return e.currentVersion
+}
+
 // Code 获取错误码
 func (e APIError) Code() int32 {
 	return e.code
@@ -78,18 +90,22 @@ const (
 	CodeMethodNotAllowed = 100006 // 请求方法不被允许
 
 	// JWT认证相关错误 (102xxx)
-	CodeJWTTokenMissing    = 102001 // JWT令牌缺失
-	CodeJWTTokenInvalid    = 102002 // JWT令牌格式无效
-	CodeJWTTokenExpired    = 102003 // JWT令牌已过期
-	CodeJWTTokenNotActive  = 102004 // JWT令牌未生效（nbf校验失败）
-	CodeJWTTokenMalformed  = 102005 // JWT令牌结构错误
-	CodeJWTValidationFail  = 102006 // JWT验证失败（通用验证错误）
-	CodeJWTSigningError    = 102007 // JWT签名生成失败
-	CodeJWTCreationFail    = 102008 // JWT令牌创建失败
-	CodeInvalidCredentials = 102009 // 认证凭据无效（用户名密码错误）
+	CodeJWTTokenMissing     = 102001 // JWT令牌缺失
+	CodeJWTTokenInvalid     = 102002 // JWT令牌格式无效
+	CodeJWTTokenExpired     = 102003 // JWT令牌已过期
+	CodeJWTTokenNotActive   = 102004 // JWT令牌未生效（nbf校验失败）
+	CodeJWTTokenMalformed   = 102005 // JWT令牌结构错误
+	CodeJWTValidationFail   = 102006 // JWT验证失败（通用验证错误）
+	CodeJWTSigningError     = 102007 // JWT签名生成失败
+	CodeJWTCreationFail     = 102008 // JWT令牌创建失败
+	CodeInvalidCredentials  = 102009 // 认证凭据无效（用户名密码错误）
+	CodeRefreshTokenInvalid = 102010 // 刷新令牌无效或已过期
+	CodeRefreshTokenReused  = 102011 // 刷新令牌被重复使用（令牌族已吊销）
+	CodeMFAChallengeInvalid = 102012 // MFA 登录挑战无效、已过期或错误次数耗尽
 
 	// 授权和权限相关错误 (103xxx)
 	CodeUserNoAvailableRoles = 103001 // 用户无可用角色
+	CodeTenantAccessDenied   = 103002 // 非目标租户成员，无法切换
 
 	// 网关特有错误 (110xxx)
 	CodeGatewayTimeout = 110001 // 网关超时
@@ -112,12 +128,20 @@ const (
 	// 这些错误码来自下游 RPC 服务，但需要在网关层映射为特定的 HTTP 状态码
 	// 而非默认的 HTTP 200
 
+	// 通用 RPC 业务错误 (200xxx)
+	CodeRPCVersionConflict = 200102 // 数据版本冲突（乐观锁校验失败）
 	// 用户认证相关的 RPC 业务错误 (201xxx - identity_srv)
-	CodeRPCUserNotFound       = 201001 // 用户不存在
-	CodeRPCUserInactive       = 201012 // 用户未激活
-	CodeRPCInvalidCredentials = 201016 // 用户名或密码错误
-	CodeRPCUserSuspended      = 201017 // 用户已停用
-	CodeRPCMustChangePassword = 201018 // 需要修改密码
+	CodeRPCUserNotFound              = 201001 // 用户不存在
+	CodeRPCUserInactive              = 201012 // 用户未激活
+	CodeRPCInvalidCredentials        = 201016 // 用户名或密码错误
+	CodeRPCUserSuspended             = 201017 // 用户已停用
+	CodeRPCMustChangePassword        = 201018 // 需要修改密码
+	CodeRPCMFANotEnabled             = 201021 // 未启用多因素认证
+	CodeRPCMFAAlreadyEnabled         = 201022 // 已启用多因素认证
+	CodeRPCInvalidMFACode            = 201023 // 验证码无效或已使用
+	CodeRPCMFAEnrollmentNotStarted   = 201024 // 未发起多因素认证登记
+	CodeRPCPasswordResetTokenInvalid = 201025 // 重置链接无效或已过期
+	CodeRPCPasswordPolicyViolation   = 201026 // 密码不符合安全策略
 	// 角色分配相关的 RPC 业务错误 (207xxx - identity_srv)
 	CodeRPCUserNoAvailableRoles = 207016 // 用户没有可用角色
 	// 数据源配置相关的 RPC 业务错误 (208xxx - cancer_srv)
@@ -137,18 +161,22 @@ var (
 	ErrMethodNotAllowed = NewAPIError(CodeMethodNotAllowed, "请求方法不被允许")
 
 	// JWT认证相关错误
-	ErrJWTTokenMissing    = NewAPIError(CodeJWTTokenMissing, "令牌缺失")
-	ErrJWTTokenInvalid    = NewAPIError(CodeJWTTokenInvalid, "令牌格式无效")
-	ErrJWTTokenExpired    = NewAPIError(CodeJWTTokenExpired, "令牌已过期")
-	ErrJWTTokenNotActive  = NewAPIError(CodeJWTTokenNotActive, "令牌未生效")
-	ErrJWTTokenMalformed  = NewAPIError(CodeJWTTokenMalformed, "令牌结构错误")
-	ErrJWTValidationFail  = NewAPIError(CodeJWTValidationFail, "令牌验证失败")
-	ErrJWTSigningError    = NewAPIError(CodeJWTSigningError, "令牌签名生成失败")
-	ErrJWTCreationFail    = NewAPIError(CodeJWTCreationFail, "令牌创建失败")
-	ErrInvalidCredentials = NewAPIError(CodeInvalidCredentials, "用户名或密码错误")
+	ErrJWTTokenMissing     = NewAPIError(CodeJWTTokenMissing, "令牌缺失")
+	ErrJWTTokenInvalid     = NewAPIError(CodeJWTTokenInvalid, "令牌格式无效")
+	ErrJWTTokenExpired     = NewAPIError(CodeJWTTokenExpired, "令牌已过期")
+	ErrJWTTokenNotActive   = NewAPIError(CodeJWTTokenNotActive, "令牌未生效")
+	ErrJWTTokenMalformed   = NewAPIError(CodeJWTTokenMalformed, "令牌结构错误")
+	ErrJWTValidationFail   = NewAPIError(CodeJWTValidationFail, "令牌验证失败")
+	ErrJWTSigningError     = NewAPIError(CodeJWTSigningError, "令牌签名生成失败")
+	ErrJWTCreationFail     = NewAPIError(CodeJWTCreationFail, "令牌创建失败")
+	ErrInvalidCredentials  = NewAPIError(CodeInvalidCredentials, "用户名或密码错误")
+	ErrRefreshTokenInvalid = NewAPIError(CodeRefreshTokenInvalid, "刷新令牌无效或已过期")
+	ErrRefreshTokenReused  = NewAPIError(CodeRefreshTokenReused, "刷新令牌已失效，请重新登录")
+	ErrMFAChallengeInvalid = NewAPIError(CodeMFAChallengeInvalid, "登录验证已失效，请重新登录")
 
 	// 授权和权限相关错误
 	ErrUserNoAvailableRoles = NewAPIError(CodeUserNoAvailableRoles, "用户无可用角色，无法登录")
+	ErrTenantAccessDenied   = NewAPIError(CodeTenantAccessDenied, "您不是该组织的成员，无法切换")
 	// 数据源配置相关的 RPC 业务错误 (208xxx - cancer_srv)
 	ErrDataSourceInUse = NewAPIError(CodeRPCDataSourceInUse, "数据源正在被字典配置使用，无法删除")
 
@@ -0,0 +1,38 @@
+// Package errors 提供了 Hertz 网关层的统一错误处理机制
+// 本文件提供乐观锁版本号与 ETag / If-Match 头之间的转换
+package errors
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// FormatETag 将实体版本号格式化为强 ETag，例如 "3"
+func FormatETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// ParseETag 解析 ETag / If-Match 中的版本号
+// 兼容弱校验前缀 W/ 与未加引号的写法；无法解析或版本号非正数时返回 false
+func ParseETag(value string) (int64, bool) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "W/")
+	value = strings.Trim(value, `"`)
+
+	version, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, false
+	}
+
+	return version, true
+}
+
+// SetETag 以实体版本号设置 ETag 响应头
+func SetETag(c *app.RequestContext, version int64) {
+	if version <= 0 {
+		return
+	}
+
+	c.Header("ETag", FormatETag(version))
+}
@@ -0,0 +1,47 @@
+package errors
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseETag(t *testing.T) {
+	cases := []struct {
+		value   string
+		version int64
+		ok      bool
+	}{
+		{value: `"3"`, version: 3, ok: true},
+		{value: `W/"7"`, version: 7, ok: true},
+		{value: "12", version: 12, ok: true},
+		{value: "", ok: false},
+		{value: `"0"`, ok: false},
+		{value: `"abc"`, ok: false},
+	}
+
+	for _, tc := range cases {
+		version, ok := ParseETag(tc.value)
+		assert.Equal(t, tc.ok, ok, tc.value)
+		assert.Equal(t, tc.version, version, tc.value)
+	}
+
+	assert.Equal(t, `"5"`, FormatETag(5))
+}
+
+func TestProcessRPCError_VersionConflict(t *testing.T) {
+	rpcErr := kerrors.NewBizStatusErrorWithExtra(CodeRPCVersionConflict, "数据已被他人修改", map[string]string{
+		currentVersionExtraKey: "4",
+	})
+
+	err := ProcessRPCError(rpcErr, "更新失败")
+
+	apiErr, ok := err.(APIError)
+	require.True(t, ok)
+	assert.Equal(t, int32(CodeRPCVersionConflict), apiErr.Code())
+	assert.Equal(t, int64(4), apiErr.CurrentVersion())
+	assert.Equal(t, http.StatusConflict, GetHTTPStatus(apiErr.Code()))
+}
@@ -24,15 +24,21 @@ var httpStatusMap = map[int32]int{
 	CodeMethodNotAllowed: http.StatusMethodNotAllowed,
 
 	// JWT认证相关错误
-	CodeJWTTokenMissing:    http.StatusUnauthorized,
-	CodeJWTTokenInvalid:    http.StatusUnauthorized,
-	CodeJWTTokenExpired:    http.StatusUnauthorized,
-	CodeJWTTokenNotActive:  http.StatusUnauthorized,
-	CodeJWTTokenMalformed:  http.StatusBadRequest,
-	CodeJWTValidationFail:  http.StatusUnauthorized,
-	CodeJWTSigningError:    http.StatusInternalServerError,
-	CodeJWTCreationFail:    http.StatusInternalServerError,
-	CodeInvalidCredentials: http.StatusUnauthorized,
+	CodeJWTTokenMissing:     http.StatusUnauthorized,
+	CodeJWTTokenInvalid:     http.StatusUnauthorized,
+	CodeJWTTokenExpired:     http.StatusUnauthorized,
+	CodeJWTTokenNotActive:   http.StatusUnauthorized,
+	CodeJWTTokenMalformed:   http.StatusBadRequest,
+	CodeJWTValidationFail:   http.StatusUnauthorized,
+	CodeJWTSigningError:     http.StatusInternalServerError,
+	CodeJWTCreationFail:     http.StatusInternalServerError,
+	CodeInvalidCredentials:  http.StatusUnauthorized,
+	CodeRefreshTokenInvalid: http.StatusUnauthorized,
+	CodeRefreshTokenReused:  http.StatusUnauthorized,
+	CodeMFAChallengeInvalid: http.StatusUnauthorized,
+
+	// 授权和权限相关错误
+	CodeTenantAccessDenied: http.StatusForbidden,
 
 	// 网关特有错误
 	CodeGatewayTimeout: http.StatusGatewayTimeout,
@@ -41,11 +47,18 @@ var httpStatusMap = map[int32]int{
 
 	// RPC 业务层认证相关错误 (201xxx - identity_srv)
 	// 这些错误来自下游 RPC 服务，需要在网关层映射为正确的 HTTP 状态码
-	CodeRPCUserInactive:         http.StatusForbidden,    // 用户未激活
-	CodeRPCInvalidCredentials:   http.StatusUnauthorized, // 用户名或密码错误
-	CodeRPCUserSuspended:        http.StatusForbidden,    // 用户已停用
-	CodeRPCMustChangePassword:   http.StatusForbidden,    // 需要修改密码
-	CodeRPCUserNoAvailableRoles: http.StatusForbidden,    // 用户没有可用角色
+	CodeRPCVersionConflict:           http.StatusConflict,     // 数据版本冲突
+	CodeRPCUserInactive:              http.StatusForbidden,    // 用户未激活
+	CodeRPCInvalidCredentials:        http.StatusUnauthorized, // 用户名或密码错误
+	CodeRPCUserSuspended:             http.StatusForbidden,    // 用户已停用
+	CodeRPCMustChangePassword:        http.StatusForbidden,    // 需要修改密码
+	CodeRPCMFANotEnabled:             http.StatusBadRequest,   // 未启用多因素认证
+	CodeRPCMFAAlreadyEnabled:         http.StatusConflict,     // 已启用多因素认证
+	CodeRPCInvalidMFACode:            http.StatusUnauthorized, // 验证码无效或已使用
+	CodeRPCMFAEnrollmentNotStarted:   http.StatusBadRequest,   // 未发起多因素认证登记
+	CodeRPCPasswordResetTokenInvalid: http.StatusBadRequest,   // 重置链接无效或已过期
+	CodeRPCPasswordPolicyViolation:   http.StatusBadRequest,   // 密码不符合安全策略
+	CodeRPCUserNoAvailableRoles:      http.StatusForbidden,    // 用户没有可用角色
 }
 
 // AbortWithError 中断请求并返回错误响应
@@ -55,6 +68,11 @@ var httpStatusMap = map[int32]int{
 func AbortWithError(c *app.RequestContext, err APIError) {
 	httpStatus := GetHTTPStatus(err.Code())
 
+	// 版本冲突时通过 ETag 返回当前版本，便于客户端刷新后重试
+	if err.CurrentVersion() > 0 {
+		SetETag(c, err.CurrentVersion())
+	}
+
 	// 使用 OperationStatusResponseDTO 结构
 	code := err.Code()
 	message := err.Message()
@@ -6,6 +6,9 @@ import (
 	"github.com/cloudwego/kitex/pkg/kerrors"
 )
 
+// currentVersionExtraKey 下游版本冲突错误中记录当前版本号的附加信息键
+const currentVersionExtraKey = "current_version"
+
 // ProcessRPCError 统一处理 RPC 调用错误，返回适合的 APIError
 //
 // 处理逻辑：
@@ -33,7 +36,14 @@ func ProcessRPCError(err error, fallbackMessage string) error {
 	// 使用 kerrors.FromBizStatusError 提取业务异常
 	if bizErr, isBizErr := kerrors.FromBizStatusError(err); isBizErr {
 		// 直接将 RPC 业务错误转换为 API 错误，保持原始错误码和消息
-		return NewAPIError(bizErr.BizStatusCode(), bizErr.BizMessage())
+		apiErr := NewAPIError(bizErr.BizStatusCode(), bizErr.BizMessage())
+
+		// 版本冲突时透传下游返回的当前版本号
+		if version, ok := ParseETag(bizErr.BizExtra()[currentVersionExtraKey]); ok {
+			apiErr = apiErr.WithCurrentVersion(version)
+		}
+
+		return apiErr
 	}
 
 	// 其他类型错误按系统错误处理
@@ -0,0 +1,233 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+
+	tracelog "github.com/masonsxu/cloudwego-microservice-demo/gateway/pkg/log"
+)
+
+// ErrMFAChallengeInvalid MFA 挑战不存在、已过期或已被使用
+var ErrMFAChallengeInvalid = errors.New("mfa challenge invalid")
+
+// reserveMFAAttemptScript 挑战存在时原子地占用一次校验机会，返回累计次数
+//
+// 挑战不存在时返回 nil，避免 HINCRBY 在挑战恰好过期时创建不带 TTL 的残留键。
+var reserveMFAAttemptScript = goredis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return false
+end
+return redis.call('HINCRBY', KEYS[1], 'attempts', 1)
+`)
+
+// consumeMFAChallengeScript 原子地读取并删除挑战，保证同一挑战只能兑换一次
+//
+// 挑战不存在时返回 nil。
+var consumeMFAChallengeScript = goredis.NewScript(`
+local fields = redis.call('HGETALL', KEYS[1])
+if #fields == 0 then
+	return false
+end
+redis.call('DEL', KEYS[1])
+return fields
+`)
+
+// MFAChallenge 登录第二因素挑战
+//
+// 密码校验通过后创建，保存签发令牌所需的 claims 与登录响应，
+// 第二因素校验通过后原子地兑换（读取并删除）挑战，再据此签发令牌。
+type MFAChallenge struct {
+	UserID string `json:"user_id"`
+
+	// Enrollment 为 true 表示用户尚未登记 MFA，需要先登记再提交口令
+	Enrollment bool `json:"enrollment"`
+
+	Claims   map[string]interface{} `json:"claims"`
+	Response json.RawMessage        `json:"response"`
+
+	// Attempts 已占用的校验次数（每次校验前先占用，无论结果）
+	Attempts int `json:"attempts"`
+}
+
+// MFAChallengeStore MFA 挑战存储接口
+//
+// 挑战令牌为不透明随机串，Redis 中仅保存其 SHA256 哈希。
+type MFAChallengeStore interface {
+	// Create 创建挑战并返回挑战令牌，ttl 为挑战有效期
+	Create(ctx context.Context, challenge *MFAChallenge, ttl time.Duration) (string, error)
+
+	// Get 读取挑战，不存在或已过期时返回 ErrMFAChallengeInvalid
+	Get(ctx context.Context, token string) (*MFAChallenge, error)
+
+	// ReserveAttempt 校验口令前原子地占用一次校验机会，返回含本次在内的累计次数，
+	// 挑战不存在或已过期时返回 ErrMFAChallengeInvalid
+	ReserveAttempt(ctx context.Context, token string) (int, error)
+
+	// Consume 原子地读取并删除挑战，仅一个调用方能成功兑换，
+	// 挑战不存在或已被兑换时返回 ErrMFAChallengeInvalid
+	Consume(ctx context.Context, token string) (*MFAChallenge, error)
+
+	// Delete 删除挑战（校验次数耗尽）
+	Delete(ctx context.Context, token string) error
+}
+
+// MFAChallengeCache MFA 挑战存储的 Redis 实现
+type MFAChallengeCache struct {
+	client *Client
+	logger *zerolog.Logger
+}
+
+// NewMFAChallengeStore 创建 MFA 挑战存储
+func NewMFAChallengeStore(client *Client, logger *hertzZerolog.Logger) MFAChallengeStore {
+	var zlogger *zerolog.Logger
+
+	if logger != nil {
+		unwrapped := logger.Unwrap()
+		zlogger = &unwrapped
+	} else {
+		nop := zerolog.Nop()
+		zlogger = &nop
+	}
+
+	return &MFAChallengeCache{
+		client: client,
+		logger: zlogger,
+	}
+}
+
+// getMFAChallengeKey 获取 MFA 挑战存储的Redis Key
+func getMFAChallengeKey(tokenHash string) string {
+	return fmt.Sprintf("gateway:mfa:challenge:%s", tokenHash)
+}
+
+// Create 创建挑战并返回挑战令牌
+func (mc *MFAChallengeCache) Create(
+	ctx context.Context,
+	challenge *MFAChallenge,
+	ttl time.Duration,
+) (string, error) {
+	claimsData, err := json.Marshal(challenge.Claims)
+	if err != nil {
+		return "", fmt.Errorf("序列化MFA挑战失败: %w", err)
+	}
+
+	token, err := generateRefreshToken()
+	if err != nil {
+		return "", fmt.Errorf("生成MFA挑战令牌失败: %w", err)
+	}
+
+	key := getMFAChallengeKey(hashRefreshToken(token))
+
+	pipe := mc.client.GetClient().TxPipeline()
+	pipe.HSet(ctx, key,
+		"user_id", challenge.UserID,
+		"enrollment", strconv.FormatBool(challenge.Enrollment),
+		"claims", string(claimsData),
+		"response", string(challenge.Response),
+		"attempts", 0,
+	)
+	pipe.Expire(ctx, key, ttl)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		tracelog.Event(ctx, mc.logger.Error()).
+			Err(err).
+			Str("user_id", challenge.UserID).
+			Msg("Failed to create MFA challenge")
+
+		return "", fmt.Errorf("创建MFA挑战失败: %w", err)
+	}
+
+	return token, nil
+}
+
+// Get 读取挑战
+func (mc *MFAChallengeCache) Get(ctx context.Context, token string) (*MFAChallenge, error) {
+	fields, err := mc.client.GetClient().HGetAll(ctx, getMFAChallengeKey(hashRefreshToken(token))).Result()
+	if err != nil {
+		return nil, fmt.Errorf("获取MFA挑战失败: %w", err)
+	}
+
+	if len(fields) == 0 {
+		return nil, ErrMFAChallengeInvalid
+	}
+
+	return parseMFAChallenge(fields)
+}
+
+// ReserveAttempt 占用一次校验机会
+func (mc *MFAChallengeCache) ReserveAttempt(ctx context.Context, token string) (int, error) {
+	key := getMFAChallengeKey(hashRefreshToken(token))
+
+	attempts, err := reserveMFAAttemptScript.Run(ctx, mc.client.GetClient(), []string{key}).Int()
+	if errors.Is(err, goredis.Nil) {
+		return 0, ErrMFAChallengeInvalid
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("占用MFA校验次数失败: %w", err)
+	}
+
+	return attempts, nil
+}
+
+// Consume 兑换挑战
+func (mc *MFAChallengeCache) Consume(ctx context.Context, token string) (*MFAChallenge, error) {
+	key := getMFAChallengeKey(hashRefreshToken(token))
+
+	result, err := consumeMFAChallengeScript.Run(ctx, mc.client.GetClient(), []string{key}).StringSlice()
+	if errors.Is(err, goredis.Nil) {
+		return nil, ErrMFAChallengeInvalid
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("兑换MFA挑战失败: %w", err)
+	}
+
+	fields := make(map[string]string, len(result)/2)
+	for i := 0; i+1 < len(result); i += 2 {
+		fields[result[i]] = result[i+1]
+	}
+
+	return parseMFAChallenge(fields)
+}
+
+// parseMFAChallenge 将 Redis Hash 字段还原为挑战
+func parseMFAChallenge(fields map[string]string) (*MFAChallenge, error) {
+	challenge := &MFAChallenge{
+		UserID:     fields["user_id"],
+		Enrollment: fields["enrollment"] == "true",
+		Response:   json.RawMessage(fields["response"]),
+	}
+
+	if err := json.Unmarshal([]byte(fields["claims"]), &challenge.Claims); err != nil {
+		return nil, fmt.Errorf("解析MFA挑战失败: %w", err)
+	}
+
+	if attempts, err := strconv.Atoi(fields["attempts"]); err == nil {
+		challenge.Attempts = attempts
+	}
+
+	return challenge, nil
+}
+
+// Delete 删除挑战
+func (mc *MFAChallengeCache) Delete(ctx context.Context, token string) error {
+	if err := mc.client.Del(ctx, getMFAChallengeKey(hashRefreshToken(token))); err != nil {
+		return fmt.Errorf("删除MFA挑战失败: %w", err)
+	}
+
+	return nil
+}
+
+// ProvideMFAChallengeStore 提供 MFA 挑战存储
+func ProvideMFAChallengeStore(client *Client, logger *hertzZerolog.Logger) MFAChallengeStore {
+	return NewMFAChallengeStore(client, logger)
+}
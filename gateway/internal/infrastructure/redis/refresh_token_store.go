@@ -0,0 +1,368 @@
+package redis
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+
+	tracelog "github.com/masonsxu/cloudwego-microservice-demo/gateway/pkg/log"
+)
+
+// 刷新令牌相关错误
+var (
+	// ErrRefreshTokenInvalid 刷新令牌不存在、已过期或所属令牌族已被吊销
+	ErrRefreshTokenInvalid = errors.New("refresh token invalid")
+
+	// ErrRefreshTokenReused 已轮换过的刷新令牌被再次使用（疑似泄露），整个令牌族已被吊销
+	ErrRefreshTokenReused = errors.New("refresh token reused")
+)
+
+// refreshTokenBytes 刷新令牌随机字节数（256 bit）
+const refreshTokenBytes = 32
+
+// consumeRefreshTokenScript 原子地读取刷新令牌所属族并标记为已使用
+//
+// 返回 {family_id, used}：used 为 "1" 表示该令牌此前已被轮换过（重放）。
+// 令牌不存在时返回 nil。
+var consumeRefreshTokenScript = goredis.NewScript(`
+local fid = redis.call('HGET', KEYS[1], 'family_id')
+if not fid then
+	return false
+end
+local used = redis.call('HGET', KEYS[1], 'used')
+redis.call('HSET', KEYS[1], 'used', '1')
+return {fid, used}
+`)
+
+// RefreshSession 刷新令牌族（一次登录会话）
+//
+// 同一次登录签发的所有刷新令牌属于同一个族，族的过期时间在登录时确定（绝对会话上限），
+// 轮换只更换令牌，不延长会话。
+type RefreshSession struct {
+	FamilyID  string                 `json:"family_id"`
+	UserID    string                 `json:"user_id"`
+	Claims    map[string]interface{} `json:"claims"`
+	ExpiresAt time.Time              `json:"expires_at"`
+}
+
+// RefreshTokenStore 刷新令牌存储接口
+//
+// 刷新令牌为不透明随机串，Redis 中仅保存其 SHA256 哈希。每个令牌只能使用一次：
+// 轮换后旧令牌被标记为已使用，再次出现即视为泄露，吊销整个令牌族。
+type RefreshTokenStore interface {
+	// Issue 为新登录创建令牌族并签发首个刷新令牌，ttl 为会话绝对有效期
+	Issue(
+		ctx context.Context,
+		userID string,
+		claims map[string]interface{},
+		ttl time.Duration,
+	) (string, *RefreshSession, error)
+
+	// Rotate 使用刷新令牌换取同族的新刷新令牌，旧令牌立即失效
+	Rotate(ctx context.Context, token string) (string, *RefreshSession, error)
+
+	// Revoke 吊销刷新令牌所属的整个令牌族（登出）
+	Revoke(ctx context.Context, token string) error
+
+	// RevokeUserSessions 吊销用户的所有令牌族（登出所有设备、重置密码等）
+	RevokeUserSessions(ctx context.Context, userID string) error
+}
+
+// RefreshTokenCache 刷新令牌存储的 Redis 实现
+type RefreshTokenCache struct {
+	client *Client
+	logger *zerolog.Logger
+}
+
+// NewRefreshTokenStore 创建刷新令牌存储
+func NewRefreshTokenStore(client *Client, logger *hertzZerolog.Logger) RefreshTokenStore {
+	var zlogger *zerolog.Logger
+
+	if logger != nil {
+		unwrapped := logger.Unwrap()
+		zlogger = &unwrapped
+	} else {
+		nop := zerolog.Nop()
+		zlogger = &nop
+	}
+
+	return &RefreshTokenCache{
+		client: client,
+		logger: zlogger,
+	}
+}
+
+// hashRefreshToken 对刷新令牌进行SHA256哈希处理，避免明文存储
+func hashRefreshToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// generateRefreshToken 生成不透明刷新令牌
+func generateRefreshToken() (string, error) {
+	buf := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// getRefreshTokenKey 获取刷新令牌存储的Redis Key
+func getRefreshTokenKey(tokenHash string) string {
+	return fmt.Sprintf("gateway:refresh:token:%s", tokenHash)
+}
+
+// getRefreshFamilyKey 获取令牌族存储的Redis Key
+func getRefreshFamilyKey(familyID string) string {
+	return fmt.Sprintf("gateway:refresh:family:%s", familyID)
+}
+
+// getUserRefreshFamiliesKey 获取用户令牌族集合的Redis Key
+func getUserRefreshFamiliesKey(userID string) string {
+	return fmt.Sprintf("gateway:user:%s:refresh_families", userID)
+}
+
+// Issue 为新登录创建令牌族并签发首个刷新令牌
+func (rc *RefreshTokenCache) Issue(
+	ctx context.Context,
+	userID string,
+	claims map[string]interface{},
+	ttl time.Duration,
+) (string, *RefreshSession, error) {
+	session := &RefreshSession{
+		FamilyID:  uuid.NewString(),
+		UserID:    userID,
+		Claims:    claims,
+		ExpiresAt: time.Now().Add(ttl),
+	}
+
+	claimsData, err := json.Marshal(claims)
+	if err != nil {
+		return "", nil, fmt.Errorf("序列化刷新会话失败: %w", err)
+	}
+
+	token, err := generateRefreshToken()
+	if err != nil {
+		return "", nil, fmt.Errorf("生成刷新令牌失败: %w", err)
+	}
+
+	tokenHash := hashRefreshToken(token)
+	familyKey := getRefreshFamilyKey(session.FamilyID)
+	userFamiliesKey := getUserRefreshFamiliesKey(userID)
+
+	pipe := rc.client.GetClient().TxPipeline()
+	pipe.HSet(ctx, familyKey,
+		"user_id", userID,
+		"claims", string(claimsData),
+		"current", tokenHash,
+		"expires_at", session.ExpiresAt.Unix(),
+	)
+	pipe.Expire(ctx, familyKey, ttl)
+	pipe.HSet(ctx, getRefreshTokenKey(tokenHash), "family_id", session.FamilyID, "used", "0")
+	pipe.Expire(ctx, getRefreshTokenKey(tokenHash), ttl)
+	pipe.SAdd(ctx, userFamiliesKey, session.FamilyID)
+	pipe.Expire(ctx, userFamiliesKey, ttl)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		tracelog.Event(ctx, rc.logger.Error()).Err(err).Str("user_id", userID).Msg("Failed to issue refresh token")
+		return "", nil, fmt.Errorf("签发刷新令牌失败: %w", err)
+	}
+
+	tracelog.Event(ctx, rc.logger.Info()).
+		Str("user_id", userID).
+		Str("family_id", session.FamilyID).
+		Dur("ttl", ttl).
+		Msg("Refresh token family issued")
+
+	return token, session, nil
+}
+
+// Rotate 使用刷新令牌换取同族的新刷新令牌
+//
+// 旧令牌被原子地标记为已使用；若该令牌此前已被使用过，说明令牌已泄露，
+// 吊销整个令牌族并返回 ErrRefreshTokenReused。
+func (rc *RefreshTokenCache) Rotate(ctx context.Context, token string) (string, *RefreshSession, error) {
+	tokenHash := hashRefreshToken(token)
+
+	result, err := consumeRefreshTokenScript.Run(ctx, rc.client.GetClient(), []string{getRefreshTokenKey(tokenHash)}).
+		StringSlice()
+	if errors.Is(err, goredis.Nil) {
+		return "", nil, ErrRefreshTokenInvalid
+	}
+
+	if err != nil {
+		tracelog.Event(ctx, rc.logger.Error()).Err(err).Msg("Failed to consume refresh token")
+		return "", nil, fmt.Errorf("校验刷新令牌失败: %w", err)
+	}
+
+	familyID, used := result[0], result[1]
+
+	session, err := rc.loadSession(ctx, familyID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if used == "1" {
+		tracelog.Event(ctx, rc.logger.Warn()).
+			Str("family_id", familyID).
+			Bool("family_active", session != nil).
+			Msg("Refresh token reuse detected, revoking token family")
+
+		if session != nil {
+			if err := rc.revokeFamily(ctx, familyID, session.UserID); err != nil {
+				return "", nil, err
+			}
+		}
+
+		return "", nil, ErrRefreshTokenReused
+	}
+
+	if session == nil {
+		return "", nil, ErrRefreshTokenInvalid
+	}
+
+	ttl := time.Until(session.ExpiresAt)
+	if ttl <= 0 {
+		return "", nil, ErrRefreshTokenInvalid
+	}
+
+	newToken, err := generateRefreshToken()
+	if err != nil {
+		return "", nil, fmt.Errorf("生成刷新令牌失败: %w", err)
+	}
+
+	newHash := hashRefreshToken(newToken)
+
+	pipe := rc.client.GetClient().TxPipeline()
+	pipe.HSet(ctx, getRefreshFamilyKey(familyID), "current", newHash)
+	pipe.HSet(ctx, getRefreshTokenKey(newHash), "family_id", familyID, "used", "0")
+	pipe.Expire(ctx, getRefreshTokenKey(newHash), ttl)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		tracelog.Event(ctx, rc.logger.Error()).Err(err).Str("family_id", familyID).Msg("Failed to rotate refresh token")
+		return "", nil, fmt.Errorf("轮换刷新令牌失败: %w", err)
+	}
+
+	tracelog.Event(ctx, rc.logger.Debug()).
+		Str("user_id", session.UserID).
+		Str("family_id", familyID).
+		Msg("Refresh token rotated")
+
+	return newToken, session, nil
+}
+
+// Revoke 吊销刷新令牌所属的整个令牌族
+func (rc *RefreshTokenCache) Revoke(ctx context.Context, token string) error {
+	familyID, err := rc.client.GetClient().HGet(ctx, getRefreshTokenKey(hashRefreshToken(token)), "family_id").Result()
+	if errors.Is(err, goredis.Nil) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("获取刷新令牌信息失败: %w", err)
+	}
+
+	session, err := rc.loadSession(ctx, familyID)
+	if err != nil || session == nil {
+		return err
+	}
+
+	return rc.revokeFamily(ctx, familyID, session.UserID)
+}
+
+// RevokeUserSessions 吊销用户的所有令牌族
+func (rc *RefreshTokenCache) RevokeUserSessions(ctx context.Context, userID string) error {
+	userFamiliesKey := getUserRefreshFamiliesKey(userID)
+
+	familyIDs, err := rc.client.SMembers(ctx, userFamiliesKey)
+	if err != nil {
+		tracelog.Event(ctx, rc.logger.Error()).Err(err).Str("user_id", userID).Msg("Failed to get refresh token families")
+		return fmt.Errorf("获取用户刷新令牌族失败: %w", err)
+	}
+
+	keys := make([]string, 0, len(familyIDs)+1)
+	for _, familyID := range familyIDs {
+		keys = append(keys, getRefreshFamilyKey(familyID))
+	}
+
+	keys = append(keys, userFamiliesKey)
+
+	if err := rc.client.Del(ctx, keys...); err != nil {
+		tracelog.Event(ctx, rc.logger.Error()).Err(err).Str("user_id", userID).Msg("Failed to revoke refresh token families")
+		return fmt.Errorf("吊销用户刷新令牌族失败: %w", err)
+	}
+
+	tracelog.Event(ctx, rc.logger.Info()).
+		Str("user_id", userID).
+		Int("family_count", len(familyIDs)).
+		Msg("User refresh token families revoked")
+
+	return nil
+}
+
+// loadSession 读取令牌族，族不存在（已吊销或过期）时返回 nil
+func (rc *RefreshTokenCache) loadSession(ctx context.Context, familyID string) (*RefreshSession, error) {
+	fields, err := rc.client.GetClient().HGetAll(ctx, getRefreshFamilyKey(familyID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("获取刷新令牌族失败: %w", err)
+	}
+
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	session := &RefreshSession{
+		FamilyID: familyID,
+		UserID:   fields["user_id"],
+	}
+
+	if err := json.Unmarshal([]byte(fields["claims"]), &session.Claims); err != nil {
+		return nil, fmt.Errorf("解析刷新会话失败: %w", err)
+	}
+
+	expiresAt, err := strconv.ParseInt(fields["expires_at"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("解析刷新会话过期时间失败: %w", err)
+	}
+
+	session.ExpiresAt = time.Unix(expiresAt, 0)
+
+	return session, nil
+}
+
+// revokeFamily 删除令牌族，族内所有刷新令牌随之失效
+func (rc *RefreshTokenCache) revokeFamily(ctx context.Context, familyID, userID string) error {
+	pipe := rc.client.GetClient().TxPipeline()
+	pipe.Del(ctx, getRefreshFamilyKey(familyID))
+	pipe.SRem(ctx, getUserRefreshFamiliesKey(userID), familyID)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		tracelog.Event(ctx, rc.logger.Error()).Err(err).Str("family_id", familyID).Msg("Failed to revoke refresh token family")
+		return fmt.Errorf("吊销刷新令牌族失败: %w", err)
+	}
+
+	tracelog.Event(ctx, rc.logger.Info()).
+		Str("user_id", userID).
+		Str("family_id", familyID).
+		Msg("Refresh token family revoked")
+
+	return nil
+}
+
+// ProvideRefreshTokenStore 提供刷新令牌存储
+func ProvideRefreshTokenStore(client *Client, logger *hertzZerolog.Logger) RefreshTokenStore {
+	return NewRefreshTokenStore(client, logger)
+}
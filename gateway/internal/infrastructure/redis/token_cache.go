@@ -4,11 +4,13 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	goredis "github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog"
 
 	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/config"
@@ -40,6 +42,18 @@ type TokenCacheService interface {
 
 	// RevokeToken 吊销token，expiration为token剩余有效期
 	RevokeToken(ctx context.Context, token string, expiration time.Duration) error
+
+	// RevokeUserTokensIssuedBefore 吊销用户在 before 之前签发的全部 access token（重置密码等），
+	// expiration 应不短于 access token 的有效期
+	RevokeUserTokensIssuedBefore(
+		ctx context.Context,
+		userID string,
+		before time.Time,
+		expiration time.Duration,
+	) error
+
+	// UserTokensRevokedBefore 获取用户 access token 的吊销截止时间（Unix 秒），未设置时返回 0
+	UserTokensRevokedBefore(ctx context.Context, userID string) (int64, error)
 }
 
 // TokenCache Token缓存服务实现
@@ -303,6 +317,51 @@ func (tc *TokenCache) IsTokenRevoked(ctx context.Context, token string) (bool, e
 	return exists, nil
 }
 
+// getUserRevokedBeforeKey 获取用户Token吊销截止时间的Redis Key
+func (tc *TokenCache) getUserRevokedBeforeKey(userID string) string {
+	return fmt.Sprintf("gateway:user:%s:revoked_before", userID)
+}
+
+// RevokeUserTokensIssuedBefore 吊销用户在指定时间之前签发的全部Token
+func (tc *TokenCache) RevokeUserTokensIssuedBefore(
+	ctx context.Context,
+	userID string,
+	before time.Time,
+	expiration time.Duration,
+) error {
+	key := tc.getUserRevokedBeforeKey(userID)
+
+	err := tc.client.GetClient().Set(ctx, key, before.Unix(), expiration).Err()
+	if err != nil {
+		tracelog.Event(ctx, tc.logger.Error()).Err(err).Str("user_id", userID).Msg("Failed to revoke user tokens")
+		return fmt.Errorf("吊销用户Token失败: %w", err)
+	}
+
+	tracelog.Event(ctx, tc.logger.Info()).
+		Str("user_id", userID).
+		Time("before", before).
+		Msg("User tokens revoked successfully")
+
+	return nil
+}
+
+// UserTokensRevokedBefore 获取用户Token吊销截止时间
+func (tc *TokenCache) UserTokensRevokedBefore(ctx context.Context, userID string) (int64, error) {
+	key := tc.getUserRevokedBeforeKey(userID)
+
+	before, err := tc.client.GetClient().Get(ctx, key).Int64()
+	if errors.Is(err, goredis.Nil) {
+		return 0, nil
+	}
+
+	if err != nil {
+		tracelog.Event(ctx, tc.logger.Error()).Err(err).Str("user_id", userID).Msg("Failed to get user token revocation")
+		return 0, fmt.Errorf("获取用户Token吊销状态失败: %w", err)
+	}
+
+	return before, nil
+}
+
 // ProvideRedisClient 提供Redis客户端
 func ProvideRedisClient(cfg *config.RedisConfig) (*Client, error) {
 	return NewClient(cfg)
@@ -18,6 +18,10 @@ var ApplicationSet = wire.NewSet(
 	identityassembler.NewMembershipAssembler,
 	identityassembler.NewLogoAssembler,
 	identityassembler.NewAuditLogAssembler,
+	identityassembler.NewRecycleBinAssembler,
+	identityassembler.NewPermissionReportAssembler,
+	identityassembler.NewRoleGrantAssembler,
+	identityassembler.NewAccessReviewAssembler,
 
 	// 聚合 assembler
 	identityassembler.NewIdentityAggregateAssembler,
@@ -24,6 +24,10 @@ var DomainServiceSet = wire.NewSet(
 	ProvideDepartmentService,
 	ProvideLogoService,
 	ProvideAuditLogService,
+	ProvideRecycleBinService,
+	ProvidePermissionReportService,
+	ProvideRoleGrantService,
+	ProvideAccessReviewService,
 
 	ProvideOIDCStorage,
 	ProvideOIDCService,
@@ -94,6 +98,42 @@ func ProvideAuditLogService(
 	return identityservice.NewAuditLogService(identityClient, assembler, logger)
 }
 
+// ProvideRecycleBinService 提供回收站服务
+func ProvideRecycleBinService(
+	identityClient identitycli.IdentityClient,
+	assembler identityassembler.Assembler,
+	logger *hertzZerolog.Logger,
+) identityservice.RecycleBinService {
+	return identityservice.NewRecycleBinService(identityClient, assembler, logger)
+}
+
+// ProvidePermissionReportService 提供权限报告服务
+func ProvidePermissionReportService(
+	identityClient identitycli.IdentityClient,
+	assembler identityassembler.Assembler,
+	logger *hertzZerolog.Logger,
+) identityservice.PermissionReportService {
+	return identityservice.NewPermissionReportService(identityClient, assembler, logger)
+}
+
+// ProvideRoleGrantService 提供角色授权审批服务
+func ProvideRoleGrantService(
+	identityClient identitycli.IdentityClient,
+	assembler identityassembler.Assembler,
+	logger *hertzZerolog.Logger,
+) identityservice.RoleGrantService {
+	return identityservice.NewRoleGrantService(identityClient, assembler, logger)
+}
+
+// ProvideAccessReviewService 提供访问复核服务
+func ProvideAccessReviewService(
+	identityClient identitycli.IdentityClient,
+	assembler identityassembler.Assembler,
+	logger *hertzZerolog.Logger,
+) identityservice.AccessReviewService {
+	return identityservice.NewAccessReviewService(identityClient, assembler, logger)
+}
+
 // ProvideIdentityService 提供统一身份管理服务
 func ProvideIdentityService(
 	authService identityservice.AuthService,
@@ -103,6 +143,10 @@ func ProvideIdentityService(
 	deptService identityservice.DepartmentService,
 	logoService identityservice.LogoService,
 	auditLogService identityservice.AuditLogService,
+	recycleBinService identityservice.RecycleBinService,
+	permissionReportService identityservice.PermissionReportService,
+	roleGrantService identityservice.RoleGrantService,
+	accessReviewService identityservice.AccessReviewService,
 ) identityservice.Service {
 	return identityservice.NewService(
 		authService,
@@ -112,6 +156,10 @@ func ProvideIdentityService(
 		deptService,
 		logoService,
 		auditLogService,
+		recycleBinService,
+		permissionReportService,
+		roleGrantService,
+		accessReviewService,
 	)
 }
 
@@ -24,6 +24,8 @@ var InfrastructureSet = wire.NewSet(
 	ProvideRedisConfig,
 	ProvideRedisClient,
 	ProvideTokenCache,
+	ProvideRefreshTokenStore,
+	ProvideMFAChallengeStore,
 	ProvidePolicyCache,
 )
 
@@ -108,6 +110,18 @@ func ProvideTokenCache(client *redis.Client, logger *hertzZerolog.Logger) redis.
 	return redis.NewTokenCache(client, logger)
 }
 
+// ProvideRefreshTokenStore 提供刷新令牌存储
+// 创建基于 Redis 的不透明刷新令牌存储，支持一次性轮换与重放检测
+func ProvideRefreshTokenStore(client *redis.Client, logger *hertzZerolog.Logger) redis.RefreshTokenStore {
+	return redis.NewRefreshTokenStore(client, logger)
+}
+
+// ProvideMFAChallengeStore 提供 MFA 挑战存储
+// 保存密码校验通过、等待第二因素的登录挑战
+func ProvideMFAChallengeStore(client *redis.Client, logger *hertzZerolog.Logger) redis.MFAChallengeStore {
+	return redis.NewMFAChallengeStore(client, logger)
+}
+
 // ProvidePolicyCache 提供策略缓存服务
 // 创建 Casbin 策略缓存服务实例，用于缓存权限检查结果
 func ProvidePolicyCache(client *redis.Client, logger *hertzZerolog.Logger) redis.PolicyCacheService {
@@ -17,6 +17,7 @@ import (
 	identityService "github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/domain/service/identity"
 	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/config"
 	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/infrastructure/redis"
+	"github.com/masonsxu/cloudwego-microservice-demo/iamclient"
 )
 
 // MiddlewareSet 中间件层依赖注入集合
@@ -82,9 +83,21 @@ func ProvideJWTMiddleware(
 	identityService identityService.Service,
 	jwtConfig *config.JWTConfig,
 	tokenCache redis.TokenCacheService,
+	refreshStore redis.RefreshTokenStore,
+	mfaStore redis.MFAChallengeStore,
+	authzMiddleware authzmw.AuthZMiddlewareService,
 	logger *hertzZerolog.Logger,
 ) jwtmdw.JWTMiddlewareService {
-	middleware, err := jwtmdw.JWTMiddlewareProvider(identityService, jwtConfig, tokenCache, logger)
+	// 公开路由以 authz public 列表为唯一来源
+	middleware, err := jwtmdw.JWTMiddlewareProvider(
+		identityService,
+		jwtConfig,
+		tokenCache,
+		refreshStore,
+		mfaStore,
+		authzMiddleware,
+		logger,
+	)
 	if err != nil {
 		zl := logger.Unwrap()
 		zl.Error().Err(err).Msg("Failed to create JWT middleware")
@@ -153,6 +166,22 @@ func ProvideAuthZRules(
 		panic(err)
 	}
 
+	// 迁移期校验：仍配置了 JWT_SKIP_PATHS 时必须与 public 列表一致
+	if err := rules.CheckLegacySkipPaths(cfg.Middleware.JWT.SkipPaths); err != nil {
+		zl.Error().Err(err).Str("rules_file", rulesFile).Msg("JWT skip paths disagree with authz public rules")
+		panic(err)
+	}
+
+	if len(cfg.Middleware.JWT.SkipPaths) > 0 {
+		zl.Warn().
+			Strs("skip_paths", cfg.Middleware.JWT.SkipPaths).
+			Msg("JWT_SKIP_PATHS is deprecated, public routes are taken from authz rules")
+	}
+
+	zl.Debug().
+		Strs("public", endpointStrings(rules.Public)).
+		Msg("AuthZ public routes (JWT authentication skipped)")
+
 	zl.Info().
 		Str("rules_file", rulesFile).
 		Int("public", len(rules.Public)).
@@ -164,17 +193,85 @@ func ProvideAuthZRules(
 	return rules
 }
 
+// endpointStrings 把端点列表格式化为 "METHOD /path"，用于日志
+func endpointStrings(eps []authzmw.Endpoint) []string {
+	out := make([]string, 0, len(eps))
+	for _, ep := range eps {
+		out = append(out, ep.Method+" "+ep.Path)
+	}
+
+	return out
+}
+
 // ProvideAuthZMiddleware 提供路由级 ACL 中间件
+//
+// 开启 watch 时监听规则文件并热加载；监听器启动失败只记录告警，
+// 已加载的规则继续生效，不影响网关启动。
+// 开启 PDP 时加载菜单映射并创建 iamclient，任一失败 panic：授权链路不完整时不应启动。
 func ProvideAuthZMiddleware(
+	cfg *config.Configuration,
 	rules *authzmw.Rules,
 	logger *hertzZerolog.Logger,
-) authzmw.AuthZMiddlewareService {
+) (authzmw.AuthZMiddlewareService, func()) {
 	zl := logger.Unwrap()
 	mw := authzmw.NewAuthZMiddleware(rules, &zl)
 
+	var cleanups []func()
+
+	if pdpCfg := cfg.Middleware.AuthZ.PDP; pdpCfg.Enabled {
+		menus, err := authzmw.LoadMenuPermissions(pdpCfg.MenuFile)
+		if err != nil {
+			zl.Error().Err(err).Str("menu_file", pdpCfg.MenuFile).Msg("Failed to load menu permissions for PDP")
+			panic(err)
+		}
+
+		cli, err := iamclient.New(iamclient.Config{
+			EtcdEndpoints: []string{cfg.Etcd.Address},
+			PolicyService: pdpCfg.PolicyService,
+			CallerService: cfg.Server.Name,
+			CacheTTL:      pdpCfg.CacheTTL,
+		})
+		if err != nil {
+			zl.Error().Err(err).Msg("Failed to create IAM client for PDP")
+			panic(err)
+		}
+
+		cleanups = append(cleanups, func() { _ = cli.Close() })
+
+		mw.EnablePDP(authzmw.NewIAMPermissionChecker(cli), menus)
+
+		zl.Info().
+			Str("menu_file", pdpCfg.MenuFile).
+			Int("menu_routes", len(menus)).
+			Int("permissions", len(rules.Permissions)).
+			Str("policy_service", pdpCfg.PolicyService).
+			Msg("AuthZ PDP route authorization enabled")
+	}
+
+	if cfg.Middleware.AuthZ.Watch {
+		rulesFile := cfg.Middleware.AuthZ.RulesFile
+
+		watcher, err := authzmw.WatchRulesFile(rulesFile, &zl, mw.UpdateRules)
+		if err != nil {
+			zl.Warn().Err(err).Str("rules_file", rulesFile).Msg("AuthZ rules hot reload disabled")
+		} else {
+			cleanups = append(cleanups, func() {
+				if err := watcher.Close(); err != nil {
+					zl.Warn().Err(err).Msg("Failed to stop AuthZ rules watcher")
+				}
+			})
+
+			zl.Info().Str("rules_file", rulesFile).Msg("AuthZ rules hot reload enabled")
+		}
+	}
+
 	zl.Info().Msg("AuthZ middleware created successfully")
 
-	return mw
+	return mw, func() {
+		for i := len(cleanups) - 1; i >= 0; i-- {
+			cleanups[i]()
+		}
+	}
 }
 
 // ProvideAccessLogMiddleware 提供访问日志中间件
@@ -30,7 +30,11 @@ func InitializeApp() (*AppContainer, func(), error) {
 	iMembershipAssembler := identity.NewMembershipAssembler()
 	iLogoAssembler := identity.NewLogoAssembler()
 	iAuditLogAssembler := identity.NewAuditLogAssembler()
-	assembler := identity.NewIdentityAggregateAssembler(iAuthAssembler, iUserAssembler, iOrgAssembler, iDepartmentAssembler, iMembershipAssembler, iLogoAssembler, iAuditLogAssembler)
+	iRecycleBinAssembler := identity.NewRecycleBinAssembler()
+	iPermissionReportAssembler := identity.NewPermissionReportAssembler()
+	iRoleGrantAssembler := identity.NewRoleGrantAssembler()
+	iAccessReviewAssembler := identity.NewAccessReviewAssembler()
+	assembler := identity.NewIdentityAggregateAssembler(iAuthAssembler, iUserAssembler, iOrgAssembler, iDepartmentAssembler, iMembershipAssembler, iLogoAssembler, iAuditLogAssembler, iRecycleBinAssembler, iPermissionReportAssembler, iRoleGrantAssembler, iAccessReviewAssembler)
 	authService := ProvideAuthService(identityClient, assembler, logger)
 	userService := ProvideUserService(identityClient, assembler, logger)
 	membershipService := ProvideMembershipService(identityClient, assembler, logger)
@@ -38,7 +42,11 @@ func InitializeApp() (*AppContainer, func(), error) {
 	departmentService := ProvideDepartmentService(identityClient, assembler, logger)
 	logoService := ProvideLogoService(identityClient, assembler, logger)
 	auditLogService := ProvideAuditLogService(identityClient, assembler, logger)
-	service := ProvideIdentityService(authService, userService, membershipService, organizationService, departmentService, logoService, auditLogService)
+	recycleBinService := ProvideRecycleBinService(identityClient, assembler, logger)
+	permissionReportService := ProvidePermissionReportService(identityClient, assembler, logger)
+	roleGrantService := ProvideRoleGrantService(identityClient, assembler, logger)
+	accessReviewService := ProvideAccessReviewService(identityClient, assembler, logger)
+	service := ProvideIdentityService(authService, userService, membershipService, organizationService, departmentService, logoService, auditLogService, recycleBinService, permissionReportService, roleGrantService, accessReviewService)
 	serviceContainer := NewServiceContainer(service)
 	traceMiddlewareService := ProvideTraceMiddleware(logger)
 	corsMiddlewareService := ProvideCORSMiddleware(configuration, logger)
@@ -51,10 +59,12 @@ func InitializeApp() (*AppContainer, func(), error) {
 		return nil, nil, err
 	}
 	tokenCacheService := ProvideTokenCache(client, logger)
-	jwtMiddlewareService := ProvideJWTMiddleware(service, jwtConfig, tokenCacheService, logger)
-	responseHeaderMiddlewareService := ProvideResponseHeaderMiddleware()
+	refreshTokenStore := ProvideRefreshTokenStore(client, logger)
+	mfaChallengeStore := ProvideMFAChallengeStore(client, logger)
 	authzRules := ProvideAuthZRules(configuration, logger)
-	authzMiddlewareService := ProvideAuthZMiddleware(authzRules, logger)
+	authzMiddlewareService, cleanup2 := ProvideAuthZMiddleware(configuration, authzRules, logger)
+	jwtMiddlewareService := ProvideJWTMiddleware(service, jwtConfig, tokenCacheService, refreshTokenStore, mfaChallengeStore, authzMiddlewareService, logger)
+	responseHeaderMiddlewareService := ProvideResponseHeaderMiddleware()
 	identityPropagationService := ProvideIdentityPropagationMiddleware(logger)
 	accessLogMiddlewareService := ProvideAccessLogMiddleware(logger)
 	middlewareContainer := NewMiddlewareContainer(traceMiddlewareService, corsMiddlewareService, errorHandlerMiddlewareService, jwtMiddlewareService, responseHeaderMiddlewareService, authzMiddlewareService, identityPropagationService, accessLogMiddlewareService)
@@ -67,6 +77,7 @@ func InitializeApp() (*AppContainer, func(), error) {
 	handlerRegistry := ProvideHandlerRegistry(serverFactory, tracer, middlewareContainer, serviceContainer, oidcService, logger)
 	appContainer := NewAppContainer(configuration, logger, serviceContainer, middlewareContainer, handlerRegistry)
 	return appContainer, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -3,7 +3,9 @@
 package main
 
 import (
+	"flag"
 	"log"
+	"os"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/masonsxu/cloudwego-microservice-demo/gateway/internal/wire"
@@ -12,7 +14,16 @@ import (
 // 保留空实现以满足生成代码引用 app 包
 var _ app.HandlerFunc
 
+// checkAuthZ 非空时只校验 authz 规则文件并输出路由命中报告，不启动服务
+var checkAuthZ = flag.String("check-authz", "", "校验 authz 规则文件，列出每条已注册路由命中的规则后退出")
+
 func main() {
+	flag.Parse()
+
+	if *checkAuthZ != "" {
+		os.Exit(runAuthZCheck(*checkAuthZ))
+	}
+
 	// 统一初始化所有依赖（只初始化一次）
 	// Wire 自动管理依赖图和生命周期
 	container, cleanup, err := wire.InitializeApp()
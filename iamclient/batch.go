@@ -0,0 +1,124 @@
+package iamclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	policy "github.com/masonsxu/cloudwego-microservice-demo/rpc/policy-srv/kitex_gen/policy_srv"
+)
+
+// CheckItem 是批量决策中的单个 action/resource 对。
+type CheckItem struct {
+	Action   string
+	Resource string
+}
+
+// BatchCheck 一次询问 PDP 多个 action/resource 对，返回与 items 一一对应的决策。
+//
+// 行为：
+//   - 每一项先查本地 LRU 缓存（key 规则与 Check 相同，两者共享缓存）；
+//   - 未命中的项合并为一次 policy_srv BatchCheck RPC，结果写回缓存；
+//   - 全部命中缓存时不发起 RPC；
+//   - opts 中的资源属性作用于所有项。
+func (s *Subject) BatchCheck(ctx context.Context, items []CheckItem, opts ...CheckOpt) ([]*Decision, error) {
+	if s == nil {
+		return nil, errors.New("iamclient: nil subject")
+	}
+
+	if s.client == nil {
+		return nil, errors.New("iamclient: subject was not created by iamclient.Client")
+	}
+
+	o := &checkOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	decisions := make([]*Decision, len(items))
+	keys := make([]string, len(items))
+
+	var (
+		missIdx   []int
+		missItems []*policy.CheckItem
+	)
+
+	for i, item := range items {
+		keys[i] = s.cacheKey(item.Action, item.Resource, o.resourceAttrs)
+
+		if !o.skipCache && s.client.cache != nil {
+			if d, ok := s.client.cache.get(keys[i]); ok {
+				decisions[i] = d
+				continue
+			}
+		}
+
+		missIdx = append(missIdx, i)
+		missItems = append(missItems, &policy.CheckItem{
+			Action:             item.Action,
+			Resource:           item.Resource,
+			ResourceAttributes: o.resourceAttrs,
+		})
+	}
+
+	if len(missItems) == 0 {
+		return decisions, nil
+	}
+
+	resp, err := s.client.policy.BatchCheck(ctx, &policy.BatchCheckRequest{
+		Subject: &policy.Subject{
+			UserId: s.UserID,
+			Tenant: s.TenantID,
+			Roles:  s.Roles,
+		},
+		Items: missItems,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("iamclient: call policy_srv: %w", err)
+	}
+
+	results := resp.GetResults()
+	if len(results) != len(missItems) {
+		return nil, fmt.Errorf("iamclient: policy_srv returned %d results for %d items",
+			len(results), len(missItems))
+	}
+
+	for j, idx := range missIdx {
+		d := &Decision{
+			Allowed:       results[j].GetAllowed(),
+			Reason:        results[j].GetReason(),
+			DataScopeHint: results[j].GetDataScopeHint(),
+		}
+		decisions[idx] = d
+
+		if !o.skipCache && s.client.cache != nil {
+			s.client.cache.set(keys[idx], d)
+		}
+	}
+
+	return decisions, nil
+}
+
+// MustBatchCheck 是 BatchCheck 的便捷封装：要求所有项均被允许。
+//
+//   - 全部允许返回 nil；
+//   - 任一项拒绝返回第一个被拒项的 *PermissionDeniedError；
+//   - 网络/RPC 错误原样返回。
+func (s *Subject) MustBatchCheck(ctx context.Context, items []CheckItem, opts ...CheckOpt) error {
+	decisions, err := s.BatchCheck(ctx, items, opts...)
+	if err != nil {
+		return err
+	}
+
+	for i, d := range decisions {
+		if !d.Allowed {
+			return &PermissionDeniedError{
+				Action:   items[i].Action,
+				Resource: items[i].Resource,
+				Reason:   d.Reason,
+			}
+		}
+	}
+
+	return nil
+}
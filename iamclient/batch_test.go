@@ -0,0 +1,133 @@
+package iamclient
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	policy "github.com/masonsxu/cloudwego-microservice-demo/rpc/policy-srv/kitex_gen/policy_srv"
+)
+
+// allowReadOnly 仅放行 read 动作，按请求顺序返回结果
+func allowReadOnly(_ context.Context, req *policy.BatchCheckRequest) (*policy.BatchCheckResponse, error) {
+	results := make([]*policy.CheckResult, 0, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		results = append(results, &policy.CheckResult{
+			Action:        item.GetAction(),
+			Resource:      item.GetResource(),
+			Allowed:       item.GetAction() == "read",
+			Reason:        "batch:" + item.GetResource(),
+			DataScopeHint: "self",
+		})
+	}
+
+	return &policy.BatchCheckResponse{Results: results}, nil
+}
+
+func TestBatchCheck_SingleRPCPreservesOrder(t *testing.T) {
+	fake := &fakePolicyClient{batchFn: allowReadOnly}
+	s := newTestSubject(newTestClient(t, fake))
+
+	decisions, err := s.BatchCheck(context.Background(), []CheckItem{
+		{Action: "read", Resource: "patient:1"},
+		{Action: "delete", Resource: "patient:2"},
+	})
+	require.NoError(t, err)
+	require.Len(t, decisions, 2)
+	assert.True(t, decisions[0].Allowed)
+	assert.Equal(t, "self", decisions[0].DataScopeHint)
+	assert.False(t, decisions[1].Allowed)
+	assert.Equal(t, "batch:patient:2", decisions[1].Reason)
+
+	assert.Equal(t, 1, fake.batchCalls)
+	assert.Equal(t, "u1", fake.lastBatch.GetSubject().GetUserId())
+	assert.Equal(t, "org-1", fake.lastBatch.GetSubject().GetTenant())
+	assert.Len(t, fake.lastBatch.GetItems(), 2)
+}
+
+func TestBatchCheck_OnlyMissesGoToRPC(t *testing.T) {
+	fake := &fakePolicyClient{batchFn: allowReadOnly}
+	s := newTestSubject(newTestClient(t, fake))
+
+	_, err := s.BatchCheck(context.Background(), []CheckItem{{Action: "read", Resource: "patient:1"}})
+	require.NoError(t, err)
+
+	decisions, err := s.BatchCheck(context.Background(), []CheckItem{
+		{Action: "read", Resource: "patient:1"},
+		{Action: "read", Resource: "patient:3"},
+	})
+	require.NoError(t, err)
+	require.Len(t, decisions, 2)
+	assert.True(t, decisions[0].Allowed)
+	assert.True(t, decisions[1].Allowed)
+
+	require.Equal(t, 2, fake.batchCalls)
+	require.Len(t, fake.lastBatch.GetItems(), 1)
+	assert.Equal(t, "patient:3", fake.lastBatch.GetItems()[0].GetResource())
+
+	// 全部命中缓存时不再发起 RPC
+	_, err = s.BatchCheck(context.Background(), []CheckItem{
+		{Action: "read", Resource: "patient:1"},
+		{Action: "read", Resource: "patient:3"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, fake.batchCalls)
+}
+
+func TestBatchCheck_SharesCacheWithCheck(t *testing.T) {
+	fake := &fakePolicyClient{batchFn: allowReadOnly}
+	s := newTestSubject(newTestClient(t, fake))
+
+	_, err := s.BatchCheck(context.Background(), []CheckItem{{Action: "read", Resource: "patient:1"}})
+	require.NoError(t, err)
+
+	d, err := s.Check(context.Background(), "read", "patient:1")
+	require.NoError(t, err)
+	assert.True(t, d.Allowed)
+	assert.Equal(t, "self", d.DataScopeHint)
+	assert.Equal(t, 0, fake.checkCalls)
+}
+
+func TestBatchCheck_ResultCountMismatch(t *testing.T) {
+	fake := &fakePolicyClient{
+		batchFn: func(context.Context, *policy.BatchCheckRequest) (*policy.BatchCheckResponse, error) {
+			return &policy.BatchCheckResponse{}, nil
+		},
+	}
+	s := newTestSubject(newTestClient(t, fake))
+
+	decisions, err := s.BatchCheck(context.Background(), []CheckItem{{Action: "read", Resource: "patient:1"}})
+	assert.Nil(t, decisions)
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "0 results for 1 items"))
+}
+
+func TestMustBatchCheck(t *testing.T) {
+	fake := &fakePolicyClient{batchFn: allowReadOnly}
+	s := newTestSubject(newTestClient(t, fake))
+
+	require.NoError(t, s.MustBatchCheck(context.Background(), []CheckItem{{Action: "read", Resource: "patient:1"}}))
+
+	err := s.MustBatchCheck(context.Background(), []CheckItem{
+		{Action: "read", Resource: "patient:1"},
+		{Action: "update", Resource: "patient:1"},
+	})
+	require.ErrorIs(t, err, ErrPermissionDenied)
+
+	var pde *PermissionDeniedError
+	require.ErrorAs(t, err, &pde)
+	assert.Equal(t, "update", pde.Action)
+	assert.Equal(t, "patient:1", pde.Resource)
+
+	rpcErr := errors.New("transport closed")
+	fake.batchFn = func(context.Context, *policy.BatchCheckRequest) (*policy.BatchCheckResponse, error) {
+		return nil, rpcErr
+	}
+	err = s.MustBatchCheck(context.Background(), []CheckItem{{Action: "read", Resource: "patient:9"}})
+	assert.ErrorIs(t, err, rpcErr)
+	assert.NotErrorIs(t, err, ErrPermissionDenied)
+}
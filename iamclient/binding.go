@@ -0,0 +1,39 @@
+package iamclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	policy "github.com/masonsxu/cloudwego-microservice-demo/rpc/policy-srv/kitex_gen/policy_srv"
+)
+
+// GlobalDomain 全局域：在 policy_srv 的 g 规则中表示"所有租户下生效"。
+const GlobalDomain = "*"
+
+// groupingPType g 规则（用户 → 角色 → 域）的 ptype。
+const groupingPType = "g"
+
+// RevokeRoleBinding 删除 policy_srv 中用户在指定域下的角色绑定（g 规则）。
+//
+// 规则格式与 PDP 决策保持一致：g(user:<userID>, <roleCode>, <domain>)，
+// domain 为空时按 GlobalDomain 处理。规则本就不存在时视为成功（幂等）。
+func (c *Client) RevokeRoleBinding(ctx context.Context, userID, roleCode, domain string) error {
+	if userID == "" || roleCode == "" {
+		return errors.New("iamclient: userID and roleCode are required")
+	}
+
+	if domain == "" {
+		domain = GlobalDomain
+	}
+
+	_, err := c.policy.DeletePolicy(ctx, &policy.DeletePolicyRequest{
+		Ptype: groupingPType,
+		Rule:  []string{"user:" + userID, roleCode, domain},
+	})
+	if err != nil {
+		return fmt.Errorf("iamclient: revoke role binding: %w", err)
+	}
+
+	return nil
+}
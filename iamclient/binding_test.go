@@ -0,0 +1,59 @@
+package iamclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	policy "github.com/masonsxu/cloudwego-microservice-demo/rpc/policy-srv/kitex_gen/policy_srv"
+)
+
+func TestRevokeRoleBinding_BuildsGroupingRule(t *testing.T) {
+	fake := &fakePolicyClient{
+		deleteFn: func(context.Context, *policy.DeletePolicyRequest) (*policy.DeletePolicyResponse, error) {
+			return &policy.DeletePolicyResponse{Success: true}, nil
+		},
+	}
+	c := newTestClient(t, fake)
+
+	require.NoError(t, c.RevokeRoleBinding(context.Background(), "u1", "role:oncall", "org-1"))
+	require.NotNil(t, fake.lastDeleteReq)
+	assert.Equal(t, "g", fake.lastDeleteReq.GetPtype())
+	assert.Equal(t, []string{"user:u1", "role:oncall", "org-1"}, fake.lastDeleteReq.GetRule())
+}
+
+func TestRevokeRoleBinding_EmptyDomainIsGlobal(t *testing.T) {
+	fake := &fakePolicyClient{
+		deleteFn: func(context.Context, *policy.DeletePolicyRequest) (*policy.DeletePolicyResponse, error) {
+			// 规则不存在时 policy_srv 返回 Success=false，仍视为成功
+			return &policy.DeletePolicyResponse{Success: false}, nil
+		},
+	}
+	c := newTestClient(t, fake)
+
+	require.NoError(t, c.RevokeRoleBinding(context.Background(), "u1", "role:oncall", ""))
+	assert.Equal(t, []string{"user:u1", "role:oncall", GlobalDomain}, fake.lastDeleteReq.GetRule())
+}
+
+func TestRevokeRoleBinding_PropagatesRPCError(t *testing.T) {
+	fake := &fakePolicyClient{
+		deleteFn: func(context.Context, *policy.DeletePolicyRequest) (*policy.DeletePolicyResponse, error) {
+			return nil, errors.New("boom")
+		},
+	}
+	c := newTestClient(t, fake)
+
+	err := c.RevokeRoleBinding(context.Background(), "u1", "role:oncall", "org-1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "boom")
+}
+
+func TestRevokeRoleBinding_RequiresUserAndRole(t *testing.T) {
+	c := newTestClient(t, &fakePolicyClient{})
+
+	require.Error(t, c.RevokeRoleBinding(context.Background(), "", "role:oncall", "org-1"))
+	require.Error(t, c.RevokeRoleBinding(context.Background(), "u1", "", "org-1"))
+}
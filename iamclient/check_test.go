@@ -20,6 +20,16 @@ type fakePolicyClient struct {
 	checkCalls   int
 	lastCheckReq *policy.CheckRequest
 	lastCheckCtx context.Context //nolint:containedctx // 测试桩需要回溯传入的 ctx
+
+	batchFn    func(ctx context.Context, req *policy.BatchCheckRequest) (*policy.BatchCheckResponse, error)
+	batchCalls int
+	lastBatch  *policy.BatchCheckRequest
+
+	deleteFn      func(ctx context.Context, req *policy.DeletePolicyRequest) (*policy.DeletePolicyResponse, error)
+	lastDeleteReq *policy.DeletePolicyRequest
+
+	listFn      func(ctx context.Context, req *policy.ListPermissionsRequest) (*policy.ListPermissionsResponse, error)
+	lastListReq *policy.ListPermissionsRequest
 }
 
 func (f *fakePolicyClient) Check(
@@ -35,15 +45,27 @@ func (f *fakePolicyClient) Check(
 }
 
 func (f *fakePolicyClient) BatchCheck(
-	context.Context, *policy.BatchCheckRequest, ...callopt.Option,
+	ctx context.Context, req *policy.BatchCheckRequest, _ ...callopt.Option,
 ) (*policy.BatchCheckResponse, error) {
-	return nil, errors.New("not used")
+	f.batchCalls++
+	f.lastBatch = req
+
+	if f.batchFn == nil {
+		return nil, errors.New("not used")
+	}
+
+	return f.batchFn(ctx, req)
 }
 
 func (f *fakePolicyClient) ListPermissions(
-	context.Context, *policy.ListPermissionsRequest, ...callopt.Option,
+	ctx context.Context, req *policy.ListPermissionsRequest, _ ...callopt.Option,
 ) (*policy.ListPermissionsResponse, error) {
-	return nil, errors.New("not used")
+	f.lastListReq = req
+	if f.listFn == nil {
+		return nil, errors.New("not used")
+	}
+
+	return f.listFn(ctx, req)
 }
 
 func (f *fakePolicyClient) UpsertPolicy(
@@ -53,9 +75,14 @@ func (f *fakePolicyClient) UpsertPolicy(
 }
 
 func (f *fakePolicyClient) DeletePolicy(
-	context.Context, *policy.DeletePolicyRequest, ...callopt.Option,
+	ctx context.Context, req *policy.DeletePolicyRequest, _ ...callopt.Option,
 ) (*policy.DeletePolicyResponse, error) {
-	return nil, errors.New("not used")
+	f.lastDeleteReq = req
+	if f.deleteFn == nil {
+		return nil, errors.New("not used")
+	}
+
+	return f.deleteFn(ctx, req)
 }
 
 func (f *fakePolicyClient) ReloadPolicies(
@@ -3,7 +3,7 @@
 // 提供两大能力：
 //  1. Subject 还原：从网关注入的 HTTP header 或 Kitex metadata 还原调用主体身份
 //     （UserID / Tenant / Roles / Jti / RequestID）。
-//  2. 权限决策：通过 PDP 服务（rpc/policy_srv）做单点鉴权，内置 LRU 缓存。
+//  2. 权限决策：通过 PDP 服务（rpc/policy_srv）做单点 / 批量鉴权，内置 LRU 缓存。
 //
 // 业务侧禁止再次解析 JWT、禁止持有 Casbin Enforcer、禁止直连 identity_srv 数据库。
 //
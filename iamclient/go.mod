@@ -63,3 +63,5 @@ require (
 )
 
 replace github.com/masonsxu/cloudwego-microservice-demo/rpc/policy-srv => ../rpc/policy_srv
+
+replace github.com/masonsxu/cloudwego-microservice-demo/dbmigrate => ../dbmigrate
@@ -0,0 +1,63 @@
+package iamclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	policy "github.com/masonsxu/cloudwego-microservice-demo/rpc/policy-srv/kitex_gen/policy_srv"
+)
+
+// Wildcard 策略中的通配符：resource / action 为 "*" 时匹配任意值。
+const Wildcard = "*"
+
+// Permission 是 policy_srv 中授予主体的一条权限规则（已展开角色继承）。
+type Permission struct {
+	Resource  string
+	Action    string
+	DataScope string
+}
+
+// GrantsAll 报告该规则是否对任意资源的任意动作放行（如 superadmin 通配策略）。
+func (p Permission) GrantsAll() bool {
+	return p.Resource == Wildcard && p.Action == Wildcard
+}
+
+// ListPermissions 查询主体在 policy_srv 中的全部权限规则（含角色继承）。
+//
+// 与 Subject.Check 不同，主体由调用方显式给出而非从请求还原，用于服务端在签发
+// token 之前（如登录时计算菜单）或针对角色本身（userID 为空）评估权限；
+// 结果不经过决策缓存。tenantID 为空时仅匹配全局域规则。
+func (c *Client) ListPermissions(
+	ctx context.Context,
+	userID, tenantID string,
+	roles []string,
+) ([]Permission, error) {
+	if userID == "" && len(roles) == 0 {
+		return nil, errors.New("iamclient: userID or roles is required")
+	}
+
+	resp, err := c.policy.ListPermissions(ctx, &policy.ListPermissionsRequest{
+		Subject: &policy.Subject{
+			UserId: userID,
+			Tenant: tenantID,
+			Roles:  roles,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("iamclient: list permissions: %w", err)
+	}
+
+	items := resp.GetPermissions()
+	perms := make([]Permission, 0, len(items))
+
+	for _, item := range items {
+		perms = append(perms, Permission{
+			Resource:  item.GetResource(),
+			Action:    item.GetAction(),
+			DataScope: item.GetDataScope(),
+		})
+	}
+
+	return perms, nil
+}
@@ -0,0 +1,63 @@
+package iamclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	policy "github.com/masonsxu/cloudwego-microservice-demo/rpc/policy-srv/kitex_gen/policy_srv"
+)
+
+func TestListPermissions_BuildsSubjectAndMapsItems(t *testing.T) {
+	fake := &fakePolicyClient{
+		listFn: func(context.Context, *policy.ListPermissionsRequest) (*policy.ListPermissionsResponse, error) {
+			return &policy.ListPermissionsResponse{Permissions: []*policy.PermissionItem{
+				{Resource: "*", Action: "*", DataScope: "all"},
+				{Resource: "user", Action: "read", DataScope: "org"},
+			}}, nil
+		},
+	}
+	c := newTestClient(t, fake)
+
+	perms, err := c.ListPermissions(context.Background(), "u1", "org-1", []string{"role:superadmin"})
+	require.NoError(t, err)
+
+	require.NotNil(t, fake.lastListReq)
+	assert.Equal(t, "u1", fake.lastListReq.GetSubject().GetUserId())
+	assert.Equal(t, "org-1", fake.lastListReq.GetSubject().GetTenant())
+	assert.Equal(t, []string{"role:superadmin"}, fake.lastListReq.GetSubject().GetRoles())
+
+	require.Len(t, perms, 2)
+	assert.True(t, perms[0].GrantsAll())
+	assert.Equal(t, "all", perms[0].DataScope)
+	assert.False(t, perms[1].GrantsAll())
+}
+
+func TestListPermissions_PropagatesRPCError(t *testing.T) {
+	fake := &fakePolicyClient{
+		listFn: func(context.Context, *policy.ListPermissionsRequest) (*policy.ListPermissionsResponse, error) {
+			return nil, errors.New("boom")
+		},
+	}
+	c := newTestClient(t, fake)
+
+	_, err := c.ListPermissions(context.Background(), "", "", []string{"role:doctor"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "boom")
+}
+
+func TestListPermissions_RequiresSubject(t *testing.T) {
+	c := newTestClient(t, &fakePolicyClient{})
+
+	_, err := c.ListPermissions(context.Background(), "", "org-1", nil)
+	require.Error(t, err)
+}
+
+func TestPermission_GrantsAll(t *testing.T) {
+	assert.True(t, Permission{Resource: Wildcard, Action: Wildcard}.GrantsAll())
+	assert.False(t, Permission{Resource: Wildcard, Action: "read"}.GrantsAll())
+	assert.False(t, Permission{Resource: "menu", Action: Wildcard}.GrantsAll())
+}
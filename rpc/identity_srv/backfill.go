@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/searchtokens"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/config"
+)
+
+// runBackfillSearch 执行 backfill-search 子命令，返回进程退出码
+//
+// 重算用户、组织、部门的 search_tokens（拼音检索文本），可重复执行：
+//
+//	identity-srv backfill-search [-batch 500]
+func runBackfillSearch(args []string) int {
+	fs := flag.NewFlagSet("backfill-search", flag.ContinueOnError)
+	batch := fs.Int("batch", searchtokens.DefaultBatchSize, "每批扫描行数")
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "加载配置失败: %v\n", err)
+		return 1
+	}
+
+	logger, err := config.CreateLogger(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "初始化日志失败: %v\n", err)
+		return 1
+	}
+
+	db, err := config.OpenDB(&cfg.Database, &cfg.Server, logger)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "连接数据库失败: %v\n", err)
+		return 1
+	}
+
+	if sqlDB, err := db.DB(); err == nil {
+		defer sqlDB.Close()
+	}
+
+	logf := func(format string, args ...any) {
+		fmt.Fprintf(os.Stdout, format+"\n", args...)
+	}
+
+	if _, err := searchtokens.Backfill(context.Background(), db, *batch, logf); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	return 0
+}
@@ -8,4 +8,7 @@ import (
 // Converter defines the interface for converting user role assignment data.
 type Converter interface {
 	ModelToThrift(*models.UserRoleAssignment) *identity_srv.UserRoleAssignment
+
+	// GrantRequestToThrift 转换敏感角色授权申请，role 用于补充角色编码与名称，可为 nil
+	GrantRequestToThrift(*models.RoleGrantRequest, *models.RoleDefinition) *identity_srv.RoleGrantRequest
 }
@@ -32,8 +32,18 @@ func (c *ConverterImpl) ModelToThrift(
 		RoleID:    &roleID,
 		CreatedAt: &model.CreatedAt,
 		UpdatedAt: &model.UpdatedAt,
+		Version:   &model.Version,
 	}
 
+	// 全局分配不输出组织ID
+	if !model.IsGlobal() {
+		organizationID := model.OrganizationID.String()
+		result.OrganizationID = &organizationID
+	}
+
+	result.ValidFrom = model.ValidFrom
+	result.ValidUntil = model.ValidUntil
+
 	// 安全处理可选的 CreatedBy 字段
 	if model.CreatedBy != nil {
 		createdBy := model.CreatedBy.String()
@@ -48,3 +58,60 @@ func (c *ConverterImpl) ModelToThrift(
 
 	return result
 }
+
+// GrantRequestToThrift converts a models.RoleGrantRequest to an identity_srv.RoleGrantRequest.
+func (c *ConverterImpl) GrantRequestToThrift(
+	model *models.RoleGrantRequest,
+	role *models.RoleDefinition,
+) *identity_srv.RoleGrantRequest {
+	if model == nil {
+		return nil
+	}
+
+	id := model.ID.String()
+	userID := model.UserID.String()
+	roleID := model.RoleID.String()
+	status := identity_srv.RoleGrantRequestStatus(model.Status)
+
+	result := &identity_srv.RoleGrantRequest{
+		Id:         &id,
+		UserID:     &userID,
+		RoleID:     &roleID,
+		ValidFrom:  model.ValidFrom,
+		ValidUntil: model.ValidUntil,
+		Reason:     &model.Reason,
+		Status:     &status,
+		DecidedAt:  model.DecidedAt,
+		ExpiresAt:  &model.ExpiresAt,
+		CreatedAt:  &model.CreatedAt,
+	}
+
+	if role != nil {
+		result.RoleCode = &role.RoleCode
+		result.RoleName = &role.Name
+	}
+
+	// 全局申请不输出组织ID
+	if model.OrganizationID != nil {
+		organizationID := model.OrganizationID.String()
+		result.OrganizationID = &organizationID
+	}
+
+	if model.RequestedBy != nil {
+		requestedBy := model.RequestedBy.String()
+		result.RequestedBy = &requestedBy
+	}
+
+	if model.DecidedBy != nil {
+		decidedBy := model.DecidedBy.String()
+		result.DecidedBy = &decidedBy
+		result.DecisionComment = &model.DecisionComment
+	}
+
+	if model.AssignmentID != nil {
+		assignmentID := model.AssignmentID.String()
+		result.AssignmentID = &assignmentID
+	}
+
+	return result
+}
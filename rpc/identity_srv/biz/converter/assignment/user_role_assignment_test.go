@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
 )
 
@@ -33,6 +34,7 @@ func TestModelToThrift_FullModel(t *testing.T) {
 	id := uuid.New()
 	userID := uuid.New()
 	roleID := uuid.New()
+	organizationID := uuid.New()
 	createdBy := uuid.New()
 	updatedBy := uuid.New()
 
@@ -40,16 +42,20 @@ func TestModelToThrift_FullModel(t *testing.T) {
 
 	var updatedAt int64 = 1703558400000 // 2023-12-26 00:00:00 UTC
 
+	var validUntil int64 = 1704067200000 // 2024-01-01 00:00:00 UTC
+
 	model := &models.UserRoleAssignment{
 		BaseModel: models.BaseModel{
 			ID:        id,
 			CreatedAt: createdAt,
 			UpdatedAt: updatedAt,
 		},
-		UserID:    userID,
-		RoleID:    roleID,
-		CreatedBy: &createdBy,
-		UpdatedBy: &updatedBy,
+		UserID:         userID,
+		RoleID:         roleID,
+		OrganizationID: &organizationID,
+		ValidUntil:     &validUntil,
+		CreatedBy:      &createdBy,
+		UpdatedBy:      &updatedBy,
 	}
 
 	result := converter.ModelToThrift(model)
@@ -71,6 +77,20 @@ func TestModelToThrift_FullModel(t *testing.T) {
 		t.Errorf("RoleID = %v, want %v", safeDeref(result.RoleID), roleID.String())
 	}
 
+	// 验证 OrganizationID 转换
+	if result.OrganizationID == nil || *result.OrganizationID != organizationID.String() {
+		t.Errorf("OrganizationID = %v, want %v", safeDeref(result.OrganizationID), organizationID.String())
+	}
+
+	// 验证生效窗口转换
+	if result.ValidFrom != nil {
+		t.Errorf("ValidFrom = %v, want nil", *result.ValidFrom)
+	}
+
+	if result.ValidUntil == nil || *result.ValidUntil != validUntil {
+		t.Errorf("ValidUntil = %v, want %v", safeDerefInt64(result.ValidUntil), validUntil)
+	}
+
 	// 验证 CreatedBy 转换
 	if result.CreatedBy == nil || *result.CreatedBy != createdBy.String() {
 		t.Errorf("CreatedBy = %v, want %v", safeDeref(result.CreatedBy), createdBy.String())
@@ -134,7 +154,11 @@ func TestModelToThrift_OptionalFieldsNil(t *testing.T) {
 		t.Errorf("RoleID = %v, want %v", safeDeref(result.RoleID), roleID.String())
 	}
 
-	// 验证可选字段为 nil
+	// 验证可选字段为 nil（全局分配不输出组织ID）
+	if result.OrganizationID != nil {
+		t.Errorf("OrganizationID = %v, want nil", *result.OrganizationID)
+	}
+
 	if result.CreatedBy != nil {
 		t.Errorf("CreatedBy = %v, want nil", *result.CreatedBy)
 	}
@@ -227,3 +251,43 @@ func safeDerefInt64(i *int64) int64 {
 
 	return *i
 }
+
+func TestGrantRequestToThrift(t *testing.T) {
+	converter := NewConverter()
+
+	require.Nil(t, converter.GrantRequestToThrift(nil, nil))
+
+	requestedBy := uuid.New()
+	decidedBy := uuid.New()
+	assignmentID := uuid.New()
+	decidedAt := int64(1703558400000)
+
+	model := &models.RoleGrantRequest{
+		BaseModel:       models.BaseModel{ID: uuid.New(), CreatedAt: 1703472000000},
+		UserID:          uuid.New(),
+		RoleID:          uuid.New(),
+		RequestedBy:     &requestedBy,
+		Reason:          "值班需要",
+		Status:          models.RoleGrantRequestStatusApproved,
+		ExpiresAt:       1703731200000,
+		DecidedBy:       &decidedBy,
+		DecidedAt:       &decidedAt,
+		DecisionComment: "同意",
+		AssignmentID:    &assignmentID,
+	}
+	role := &models.RoleDefinition{Name: "admin", RoleCode: "role:admin"}
+
+	result := converter.GrantRequestToThrift(model, role)
+
+	require.NotNil(t, result)
+	require.Equal(t, model.ID.String(), result.GetId())
+	require.Equal(t, "role:admin", result.GetRoleCode())
+	require.Equal(t, "admin", result.GetRoleName())
+	require.Nil(t, result.OrganizationID, "全局申请不输出组织ID")
+	require.Equal(t, requestedBy.String(), result.GetRequestedBy())
+	require.Equal(t, identity_srv.RoleGrantRequestStatus_ROLE_GRANT_REQUEST_STATUS_APPROVED, result.GetStatus())
+	require.Equal(t, decidedBy.String(), result.GetDecidedBy())
+	require.Equal(t, "同意", result.GetDecisionComment())
+	require.Equal(t, assignmentID.String(), result.GetAssignmentID())
+	require.Equal(t, int64(1703731200000), result.GetExpiresAt())
+}
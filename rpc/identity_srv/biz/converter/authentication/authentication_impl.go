@@ -41,6 +41,7 @@ func (c *ConverterImpl) ModelUserMembershipsToThrift(
 				OrganizationID: &orgID,
 				CreatedAt:      &membership.CreatedAt,
 				UpdatedAt:      &membership.UpdatedAt,
+				Version:        &membership.Version,
 			}
 
 			// 可选字段处理
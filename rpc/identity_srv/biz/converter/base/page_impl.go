@@ -30,14 +30,28 @@ func (c *ConverterImpl) PageResponseToThrift(p *models.PageResult) *rpc_base.Pag
 		}
 	}
 
-	return &rpc_base.PageResponse{
-		Total:      &p.Total,
-		Page:       &p.Page,
-		Limit:      &p.Limit,
-		TotalPages: &p.TotalPages,
-		HasNext:    &p.HasNext,
-		HasPrev:    &p.HasPrev,
+	resp := &rpc_base.PageResponse{
+		Limit:   &p.Limit,
+		HasNext: &p.HasNext,
+		HasPrev: &p.HasPrev,
 	}
+
+	// 未统计总数时不返回 total/totalPages，避免调用方把 0 当成真实总数
+	if !p.TotalSkipped {
+		resp.Total = &p.Total
+		resp.TotalPages = &p.TotalPages
+	}
+
+	// 游标分页没有页码
+	if p.Page > 0 {
+		resp.Page = &p.Page
+	}
+
+	if p.NextCursor != "" {
+		resp.NextCursor = &p.NextCursor
+	}
+
+	return resp
 }
 
 // PageRequestToQueryOptions 将 Thrift 分页请求转换为 QueryOptions
@@ -96,5 +110,14 @@ func (c *ConverterImpl) PageRequestToQueryOptions(
 		opts.WithFetchAll(true)
 	}
 
+	// 游标分页：设置了 cursor（首页为空字符串）即切换为游标分页
+	if req.Cursor != nil {
+		opts.WithCursor(strings.TrimSpace(*req.Cursor))
+	}
+
+	if req.IncludeTotal != nil {
+		opts.WithIncludeTotal(*req.IncludeTotal)
+	}
+
 	return opts
 }
@@ -137,6 +137,27 @@ func TestConverterImpl_PageResponseToThrift(t *testing.T) {
 		assert.Equal(t, false, *result.HasNext)
 		assert.Equal(t, false, *result.HasPrev)
 	})
+
+	t.Run("游标分页结果转换（未统计总数）", func(t *testing.T) {
+		pageResult := &models.PageResult{
+			Limit:        20,
+			HasNext:      true,
+			HasPrev:      true,
+			NextCursor:   "next",
+			TotalSkipped: true,
+		}
+
+		result := converter.PageResponseToThrift(pageResult)
+
+		require.NotNil(t, result)
+		assert.Nil(t, result.Total)
+		assert.Nil(t, result.TotalPages)
+		assert.Nil(t, result.Page)
+		assert.Equal(t, int32(20), *result.Limit)
+		assert.Equal(t, "next", result.GetNextCursor())
+		assert.True(t, *result.HasNext)
+		assert.True(t, *result.HasPrev)
+	})
 }
 
 func TestConverterImpl_PageRequestToQueryOptions(t *testing.T) {
@@ -334,6 +355,50 @@ func TestConverterImpl_PageRequestToQueryOptions(t *testing.T) {
 		assert.Equal(t, true, result.FetchAll)
 	})
 
+	t.Run("游标分页参数转换", func(t *testing.T) {
+		cursor := base.EncodeCursor(base.Cursor{CreatedAt: 1700000000000, ID: "0b7f3c1e-5d2a-4c1b-9f3e-2a1b3c4d5e6f"})
+		req := &rpc_base.PageRequest{Cursor: &cursor}
+
+		result := converter.PageRequestToQueryOptions(req)
+
+		require.NotNil(t, result)
+		assert.True(t, result.Keyset)
+		assert.Equal(t, cursor, result.Cursor)
+		assert.False(t, result.ShouldCount(), "游标分页默认不统计总数")
+		assert.NoError(t, result.Validate())
+	})
+
+	t.Run("游标分页首页与显式统计总数", func(t *testing.T) {
+		empty := ""
+		includeTotal := true
+		req := &rpc_base.PageRequest{Cursor: &empty, IncludeTotal: &includeTotal}
+
+		result := converter.PageRequestToQueryOptions(req)
+
+		assert.True(t, result.Keyset)
+		assert.Empty(t, result.Cursor)
+		assert.True(t, result.ShouldCount())
+	})
+
+	t.Run("非法游标校验失败", func(t *testing.T) {
+		cursor := "not-a-cursor"
+		req := &rpc_base.PageRequest{Cursor: &cursor}
+
+		result := converter.PageRequestToQueryOptions(req)
+
+		assert.ErrorIs(t, result.Validate(), base.ErrInvalidCursor)
+	})
+
+	t.Run("页码分页关闭总数统计", func(t *testing.T) {
+		includeTotal := false
+		req := &rpc_base.PageRequest{IncludeTotal: &includeTotal}
+
+		result := converter.PageRequestToQueryOptions(req)
+
+		assert.False(t, result.Keyset)
+		assert.False(t, result.ShouldCount())
+	})
+
 	t.Run("FetchAll为false的处理", func(t *testing.T) {
 		fetchAll := false
 		req := &rpc_base.PageRequest{
@@ -0,0 +1,46 @@
+package base
+
+import (
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/search"
+)
+
+// Searchable 可计算搜索命中信息的模型（UserProfile、Organization、Department）
+type Searchable interface {
+	SearchID() string
+	SearchFields() []search.Field
+}
+
+// SearchHighlightsToThrift 计算每条记录在搜索词上的命中信息
+//
+// term 为空时返回 nil；未命中任何展示字段的记录（如仅命中 search_tokens 中的旧值）不返回命中信息。
+func SearchHighlightsToThrift[T Searchable](term string, items []T) []*identity_srv.SearchHighlight {
+	term = search.Normalize(term)
+	if term == "" {
+		return nil
+	}
+
+	highlights := make([]*identity_srv.SearchHighlight, 0, len(items))
+
+	for _, item := range items {
+		h := search.Match(term, item.SearchFields()...)
+		if h == nil {
+			continue
+		}
+
+		id := item.SearchID()
+		start, end, rank := int32(h.Start), int32(h.End), int32(h.Rank)
+
+		highlights = append(highlights, &identity_srv.SearchHighlight{
+			Id:     &id,
+			Field:  &h.Field,
+			Value:  &h.Value,
+			Start:  &start,
+			End:    &end,
+			Rank:   &rank,
+			Pinyin: &h.Pinyin,
+		})
+	}
+
+	return highlights
+}
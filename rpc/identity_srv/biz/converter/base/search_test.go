@@ -0,0 +1,36 @@
+package base
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+)
+
+func TestSearchHighlightsToThrift(t *testing.T) {
+	hit := &models.UserProfile{Username: "u001", RealName: "张三"}
+	hit.ID = uuid.New()
+	miss := &models.UserProfile{Username: "lisi"}
+	miss.ID = uuid.New()
+
+	t.Run("空搜索词不返回命中信息", func(t *testing.T) {
+		assert.Nil(t, SearchHighlightsToThrift(" ", []*models.UserProfile{hit, miss}))
+	})
+
+	t.Run("拼音命中映射回汉字区间", func(t *testing.T) {
+		highlights := SearchHighlightsToThrift("Zhang San", []*models.UserProfile{hit, miss})
+		require.Len(t, highlights, 1)
+
+		h := highlights[0]
+		assert.Equal(t, hit.ID.String(), h.GetId())
+		assert.Equal(t, "real_name", h.GetField())
+		assert.Equal(t, "张三", h.GetValue())
+		assert.Equal(t, int32(0), h.GetStart())
+		assert.Equal(t, int32(2), h.GetEnd())
+		assert.Equal(t, int32(0), h.GetRank())
+		assert.True(t, h.GetPinyin())
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/membership"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/menu"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/organization"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/recyclebin"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/user"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
@@ -85,6 +86,10 @@ type Converter interface {
 	UserRoleAssignment() assignment.Converter
 	AuditLog() auditlog.Converter
 
+	// RecycleBin 回收站转换器
+	// 负责已软删除的用户、组织、部门 Model → 回收站条目 DTO 的转换
+	RecycleBin() recyclebin.Converter
+
 	// ============================================================================
 	// 基础设施转换器 - 通用工具
 	// ============================================================================
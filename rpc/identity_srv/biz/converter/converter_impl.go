@@ -12,6 +12,7 @@ import (
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/membership"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/menu"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/organization"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/recyclebin"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/user"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
@@ -51,6 +52,7 @@ type Impl struct {
 	roleDefinitionConverter     definition.Converter
 	userRoleAssignmentConverter assignment.Converter
 	auditLogConverter           auditlog.Converter
+	recycleBinConverter         recyclebin.Converter
 	// ============================================================================
 	// 基础设施转换器 - 通用工具
 	// ============================================================================
@@ -94,6 +96,7 @@ func NewConverter() Converter {
 		roleDefinitionConverter:     definition.NewConverter(enumConverter),
 		userRoleAssignmentConverter: assignment.NewConverter(),
 		auditLogConverter:           auditlog.NewConverter(),
+		recycleBinConverter:         recyclebin.NewConverter(),
 		// 基础设施转换器
 		enumConverter: enumConverter,
 		baseConverter: baseConverter,
@@ -162,6 +165,11 @@ func (c *Impl) AuditLog() auditlog.Converter {
 	return c.auditLogConverter
 }
 
+// RecycleBin 返回回收站转换器
+func (c *Impl) RecycleBin() recyclebin.Converter {
+	return c.recycleBinConverter
+}
+
 // ============================================================================
 // 子转换器访问方法 - 基础设施
 // ============================================================================
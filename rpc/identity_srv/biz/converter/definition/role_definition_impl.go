@@ -64,12 +64,18 @@ func (c *ConverterImpl) ModelToThrift(
 		UpdatedBy:    updatedBy,
 		CreatedAt:    &model.CreatedAt,
 		UpdatedAt:    &model.UpdatedAt,
+		Version:      &model.Version,
 		UserCount:    &model.UserCount,
 		// Casbin 扩展字段
 		RoleCode:     convutil.StringPtr(model.RoleCode),
 		ParentRoleID: parentRoleID,
 		DepartmentID: departmentID,
 		DefaultScope: &dataScope,
+		// 职责分离约束
+		ExclusiveGroups: append([]string{}, model.ExclusiveGroups...),
+		MaxAssignments:  &model.MaxAssignments,
+		// 授权审批
+		RequiresApproval: &model.RequiresApproval,
 	}
 }
 
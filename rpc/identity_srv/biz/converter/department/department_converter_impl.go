@@ -38,6 +38,7 @@ func (c *ConverterImpl) ModelDepartmentToThrift(
 		OrganizationID: &orgID,
 		CreatedAt:      &model.CreatedAt,
 		UpdatedAt:      &model.UpdatedAt,
+		Version:        &model.Version,
 	}
 
 	// 处理可选的部门类型
@@ -38,6 +38,7 @@ func (c *ConverterImpl) ModelToThrift(
 		MimeType:  &model.MimeType,
 		CreatedAt: &model.CreatedAt,
 		UpdatedAt: &model.UpdatedAt,
+		Version:   &model.Version,
 	}
 
 	// BoundOrganizationID (可选)
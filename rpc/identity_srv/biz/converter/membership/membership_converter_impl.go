@@ -44,6 +44,7 @@ func (c *ConverterImpl) ModelUserMembershipToThrift(
 		IsPrimary:      convutil.BoolPtr(model.IsPrimary),
 		CreatedAt:      &model.CreatedAt,
 		UpdatedAt:      &model.UpdatedAt,
+		Version:        &model.Version,
 	}
 
 	// 处理可选的部门ID
@@ -20,14 +20,34 @@ func (c *ConverterImpl) ModelToThrift(model *models.Menu) *identity_srv.MenuNode
 		return nil
 	}
 
-	return &identity_srv.MenuNode{
+	node := &identity_srv.MenuNode{
 		Id:        &model.SemanticID, // 使用语义化ID而非UUID
 		Name:      &model.Name,
 		Path:      &model.Path,
 		Icon:      &model.Icon,
 		Component: &model.Component,
-		Children:  c.ModelsToThrift(model.Children), // Recursively convert children
+		ApiPaths:  model.GetApiPaths(),
 	}
+
+	if permCode := model.GetCasbinObject(); permCode != "" {
+		node.PermCode = &permCode
+	}
+
+	// 按钮级节点与子菜单同在 Children 中存储，对外拆分为 Buttons，避免出现在导航菜单里
+	var children, buttons []*models.Menu
+
+	for _, child := range model.Children {
+		if child.IsButton {
+			buttons = append(buttons, child)
+		} else {
+			children = append(children, child)
+		}
+	}
+
+	node.Children = c.ModelsToThrift(children) // Recursively convert children
+	node.Buttons = c.ModelsToThrift(buttons)
+
+	return node
 }
 
 // ModelsToThrift converts a slice of menu models to a slice of thrift representations.
@@ -31,7 +31,7 @@ func TestConverterImpl_ModelToThrift(t *testing.T) {
 		creatorID := uuid.New()
 
 		model := &models.Menu{
-			BaseModel: models.BaseModel{
+			UnversionedModel: models.UnversionedModel{
 				ID:        rootID,
 				CreatedAt: now,
 				UpdatedAt: now,
@@ -67,7 +67,7 @@ func TestConverterImpl_ModelToThrift(t *testing.T) {
 		creatorID := uuid.New()
 
 		childMenu := &models.Menu{
-			BaseModel: models.BaseModel{
+			UnversionedModel: models.UnversionedModel{
 				ID:        childID,
 				CreatedAt: now,
 				UpdatedAt: now,
@@ -86,7 +86,7 @@ func TestConverterImpl_ModelToThrift(t *testing.T) {
 		}
 
 		parentMenu := &models.Menu{
-			BaseModel: models.BaseModel{
+			UnversionedModel: models.UnversionedModel{
 				ID:        parentID,
 				CreatedAt: now,
 				UpdatedAt: now,
@@ -132,7 +132,7 @@ func TestConverterImpl_ModelToThrift(t *testing.T) {
 
 		// 第三级菜单
 		level2Menu := &models.Menu{
-			BaseModel: models.BaseModel{
+			UnversionedModel: models.UnversionedModel{
 				ID:        level2ID,
 				CreatedAt: now,
 				UpdatedAt: now,
@@ -152,7 +152,7 @@ func TestConverterImpl_ModelToThrift(t *testing.T) {
 
 		// 第二级菜单
 		level1Menu := &models.Menu{
-			BaseModel: models.BaseModel{
+			UnversionedModel: models.UnversionedModel{
 				ID:        level1ID,
 				CreatedAt: now,
 				UpdatedAt: now,
@@ -172,7 +172,7 @@ func TestConverterImpl_ModelToThrift(t *testing.T) {
 
 		// 第一级菜单
 		rootMenu := &models.Menu{
-			BaseModel: models.BaseModel{
+			UnversionedModel: models.UnversionedModel{
 				ID:        rootID,
 				CreatedAt: now,
 				UpdatedAt: now,
@@ -216,7 +216,7 @@ func TestConverterImpl_ModelToThrift(t *testing.T) {
 		creatorID := uuid.New()
 
 		model := &models.Menu{
-			BaseModel: models.BaseModel{
+			UnversionedModel: models.UnversionedModel{
 				ID:        menuID,
 				CreatedAt: now,
 				UpdatedAt: now,
@@ -243,6 +243,44 @@ func TestConverterImpl_ModelToThrift(t *testing.T) {
 		assert.Equal(t, "", *result.Component) // 空值被保留
 		assert.Equal(t, "", *result.Icon)      // 空值被保留
 	})
+
+	t.Run("权限编码、API路径与按钮拆分", func(t *testing.T) {
+		menuID := uuid.New()
+
+		model := &models.Menu{
+			UnversionedModel: models.UnversionedModel{ID: menuID},
+			SemanticID:       "account_management",
+			Name:             "用户管理",
+			Path:             "accounts",
+			ApiPaths: []*models.MenuApiPath{
+				{MenuID: menuID, Path: "/api/v1/identity/users", Sort: 0},
+				{MenuID: menuID, Path: "/api/v1/identity/users/*", Sort: 1},
+			},
+			Children: []*models.Menu{
+				{SemanticID: "account_detail", Name: "用户详情", Path: "detail", ParentID: &menuID},
+				{
+					SemanticID: "account_export",
+					Name:       "导出用户",
+					ParentID:   &menuID,
+					PermCode:   "user:export",
+					IsButton:   true,
+				},
+			},
+		}
+
+		result := converter.ModelToThrift(model)
+
+		require.NotNil(t, result)
+		assert.Equal(t, "menu:account_management", result.GetPermCode()) // 未配置时使用默认权限编码
+		assert.Equal(t, []string{"/api/v1/identity/users", "/api/v1/identity/users/*"}, result.ApiPaths)
+
+		require.Len(t, result.Children, 1)
+		assert.Equal(t, "account_detail", result.Children[0].GetId())
+
+		require.Len(t, result.Buttons, 1)
+		assert.Equal(t, "account_export", result.Buttons[0].GetId())
+		assert.Equal(t, "user:export", result.Buttons[0].GetPermCode())
+	})
 }
 
 func TestConverterImpl_ModelsToThrift(t *testing.T) {
@@ -265,7 +303,7 @@ func TestConverterImpl_ModelsToThrift(t *testing.T) {
 		creatorID := uuid.New()
 
 		menu1 := &models.Menu{
-			BaseModel: models.BaseModel{
+			UnversionedModel: models.UnversionedModel{
 				ID:        menu1ID,
 				CreatedAt: now,
 				UpdatedAt: now,
@@ -284,7 +322,7 @@ func TestConverterImpl_ModelsToThrift(t *testing.T) {
 		}
 
 		menu2 := &models.Menu{
-			BaseModel: models.BaseModel{
+			UnversionedModel: models.UnversionedModel{
 				ID:        menu2ID,
 				CreatedAt: now,
 				UpdatedAt: now,
@@ -325,7 +363,7 @@ func TestConverterImpl_ModelsToThrift(t *testing.T) {
 		creatorID := uuid.New()
 
 		menu := &models.Menu{
-			BaseModel: models.BaseModel{
+			UnversionedModel: models.UnversionedModel{
 				ID:        menuID,
 				CreatedAt: now,
 				UpdatedAt: now,
@@ -381,98 +419,98 @@ func TestConverterImpl_RecursiveMenuStructure(t *testing.T) {
 
 		// 权限管理 -> 权限配置
 		permissionConfig := &models.Menu{
-			BaseModel:  models.BaseModel{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
-			SemanticID: "permission-config",
-			Version:    1,
-			Name:       "权限配置",
-			Path:       "/system/permission/config",
-			Component:  "System/Permission/Config",
-			Icon:       "config",
-			Children:   []*models.Menu{},
+			UnversionedModel: models.UnversionedModel{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+			SemanticID:       "permission-config",
+			Version:          1,
+			Name:             "权限配置",
+			Path:             "/system/permission/config",
+			Component:        "System/Permission/Config",
+			Icon:             "config",
+			Children:         []*models.Menu{},
 		}
 
 		// 权限管理 -> 权限列表
 		permissionList := &models.Menu{
-			BaseModel:  models.BaseModel{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
-			SemanticID: "permission-list",
-			Version:    1,
-			Name:       "权限列表",
-			Path:       "/system/permission/list",
-			Component:  "System/Permission/List",
-			Icon:       "list",
-			Children:   []*models.Menu{},
+			UnversionedModel: models.UnversionedModel{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+			SemanticID:       "permission-list",
+			Version:          1,
+			Name:             "权限列表",
+			Path:             "/system/permission/list",
+			Component:        "System/Permission/List",
+			Icon:             "list",
+			Children:         []*models.Menu{},
 		}
 
 		// 权限管理
 		permission := &models.Menu{
-			BaseModel:  models.BaseModel{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
-			SemanticID: "permission",
-			Version:    1,
-			Name:       "权限管理",
-			Path:       "/system/permission",
-			Component:  "System/Permission",
-			Icon:       "permission",
-			Children:   []*models.Menu{permissionList, permissionConfig},
+			UnversionedModel: models.UnversionedModel{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+			SemanticID:       "permission",
+			Version:          1,
+			Name:             "权限管理",
+			Path:             "/system/permission",
+			Component:        "System/Permission",
+			Icon:             "permission",
+			Children:         []*models.Menu{permissionList, permissionConfig},
 		}
 
 		// 用户管理 -> 用户详情
 		userDetail := &models.Menu{
-			BaseModel:  models.BaseModel{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
-			SemanticID: "user-detail",
-			Version:    1,
-			Name:       "用户详情",
-			Path:       "/system/user/detail",
-			Component:  "System/User/Detail",
-			Icon:       "detail",
-			Children:   []*models.Menu{},
+			UnversionedModel: models.UnversionedModel{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+			SemanticID:       "user-detail",
+			Version:          1,
+			Name:             "用户详情",
+			Path:             "/system/user/detail",
+			Component:        "System/User/Detail",
+			Icon:             "detail",
+			Children:         []*models.Menu{},
 		}
 
 		// 用户管理 -> 用户列表
 		userList := &models.Menu{
-			BaseModel:  models.BaseModel{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
-			SemanticID: "user-list",
-			Version:    1,
-			Name:       "用户列表",
-			Path:       "/system/user/list",
-			Component:  "System/User/List",
-			Icon:       "list",
-			Children:   []*models.Menu{},
+			UnversionedModel: models.UnversionedModel{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+			SemanticID:       "user-list",
+			Version:          1,
+			Name:             "用户列表",
+			Path:             "/system/user/list",
+			Component:        "System/User/List",
+			Icon:             "list",
+			Children:         []*models.Menu{},
 		}
 
 		// 用户管理
 		user := &models.Menu{
-			BaseModel:  models.BaseModel{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
-			SemanticID: "user",
-			Version:    1,
-			Name:       "用户管理",
-			Path:       "/system/user",
-			Component:  "System/User",
-			Icon:       "user",
-			Children:   []*models.Menu{userList, userDetail},
+			UnversionedModel: models.UnversionedModel{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+			SemanticID:       "user",
+			Version:          1,
+			Name:             "用户管理",
+			Path:             "/system/user",
+			Component:        "System/User",
+			Icon:             "user",
+			Children:         []*models.Menu{userList, userDetail},
 		}
 
 		// 角色管理
 		role := &models.Menu{
-			BaseModel:  models.BaseModel{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
-			SemanticID: "role",
-			Version:    1,
-			Name:       "角色管理",
-			Path:       "/system/role",
-			Component:  "System/Role",
-			Icon:       "role",
-			Children:   []*models.Menu{},
+			UnversionedModel: models.UnversionedModel{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+			SemanticID:       "role",
+			Version:          1,
+			Name:             "角色管理",
+			Path:             "/system/role",
+			Component:        "System/Role",
+			Icon:             "role",
+			Children:         []*models.Menu{},
 		}
 
 		// 系统
 		system := &models.Menu{
-			BaseModel:  models.BaseModel{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
-			SemanticID: "system",
-			Version:    1,
-			Name:       "系统管理",
-			Path:       "/system",
-			Component:  "System",
-			Icon:       "system",
-			Children:   []*models.Menu{user, role, permission},
+			UnversionedModel: models.UnversionedModel{ID: uuid.New(), CreatedAt: now, UpdatedAt: now},
+			SemanticID:       "system",
+			Version:          1,
+			Name:             "系统管理",
+			Path:             "/system",
+			Component:        "System",
+			Icon:             "system",
+			Children:         []*models.Menu{user, role, permission},
 		}
 
 		result := converter.ModelToThrift(system)
@@ -541,7 +579,7 @@ func TestConverterImpl_EdgeCases(t *testing.T) {
 		for i := 0; i < 5; i++ {
 			level := i + 1
 			menu := &models.Menu{
-				BaseModel: models.BaseModel{
+				UnversionedModel: models.UnversionedModel{
 					ID:        uuid.New(),
 					CreatedAt: now,
 					UpdatedAt: now,
@@ -596,7 +634,7 @@ func TestConverterImpl_EdgeCases(t *testing.T) {
 
 		for i := 0; i < 100; i++ {
 			child := &models.Menu{
-				BaseModel: models.BaseModel{
+				UnversionedModel: models.UnversionedModel{
 					ID:        uuid.New(),
 					CreatedAt: now,
 					UpdatedAt: now,
@@ -613,7 +651,7 @@ func TestConverterImpl_EdgeCases(t *testing.T) {
 		}
 
 		parent := &models.Menu{
-			BaseModel: models.BaseModel{
+			UnversionedModel: models.UnversionedModel{
 				ID:        uuid.New(),
 				CreatedAt: now,
 				UpdatedAt: now,
@@ -652,7 +690,7 @@ func TestConverterImpl_CompleteRoundTrip(t *testing.T) {
 
 		// 使用语义化ID而非UUID
 		model := &models.Menu{
-			BaseModel: models.BaseModel{
+			UnversionedModel: models.UnversionedModel{
 				ID:        menuID, // 这是UUID，但不应该被使用
 				CreatedAt: now,
 				UpdatedAt: now,
@@ -687,7 +725,7 @@ func BenchmarkConverterImpl_ModelToThrift(b *testing.B) {
 	menuID := uuid.New()
 
 	model := &models.Menu{
-		BaseModel: models.BaseModel{
+		UnversionedModel: models.UnversionedModel{
 			ID:        menuID,
 			CreatedAt: now,
 			UpdatedAt: now,
@@ -717,7 +755,7 @@ func BenchmarkConverterImpl_ModelsToThrift(b *testing.B) {
 
 	for i := 0; i < 10; i++ {
 		menu := &models.Menu{
-			BaseModel: models.BaseModel{
+			UnversionedModel: models.UnversionedModel{
 				ID:        uuid.New(),
 				CreatedAt: now,
 				UpdatedAt: now,
@@ -750,7 +788,7 @@ func BenchmarkConverterImpl_RecursiveMenu(b *testing.B) {
 
 	createMenu = func(depth int) *models.Menu {
 		menu := &models.Menu{
-			BaseModel: models.BaseModel{
+			UnversionedModel: models.UnversionedModel{
 				ID:        uuid.New(),
 				CreatedAt: now,
 				UpdatedAt: now,
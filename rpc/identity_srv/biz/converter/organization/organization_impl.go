@@ -32,10 +32,12 @@ func (c *ConverterImpl) ModelOrganizationToThrift(
 	name := model.Name
 
 	dto := &identity_srv.Organization{
-		Id:        &id,
-		Name:      &name,
-		CreatedAt: &model.CreatedAt,
-		UpdatedAt: &model.UpdatedAt,
+		Id:          &id,
+		Name:        &name,
+		MfaRequired: &model.MFARequired,
+		CreatedAt:   &model.CreatedAt,
+		UpdatedAt:   &model.UpdatedAt,
+		Version:     &model.Version,
 	}
 
 	// 处理可选的组织代码
@@ -86,6 +88,7 @@ func (c *ConverterImpl) ThriftOrganizationToModel(
 		FacilityType:        convutil.StringValue(dto.FacilityType),
 		AccreditationStatus: convutil.StringValue(dto.AccreditationStatus),
 		ProvinceCity:        models.StringSlice(dto.ProvinceCity),
+		MFARequired:         dto.GetMfaRequired(),
 	}
 
 	// 处理可选的父组织ID
@@ -158,6 +161,10 @@ func (c *ConverterImpl) CreateRequestToModel(
 		model.ProvinceCity = models.StringSlice(req.ProvinceCity.GetItems())
 	}
 
+	if req.MfaRequired != nil {
+		model.MFARequired = *req.MfaRequired
+	}
+
 	return model
 }
 
@@ -194,5 +201,9 @@ func (c *ConverterImpl) ApplyUpdateToModel(
 		updated.ProvinceCity = models.StringSlice(req.ProvinceCity.GetItems())
 	}
 
+	if req.MfaRequired != nil {
+		updated.MFARequired = *req.MfaRequired
+	}
+
 	return &updated
 }
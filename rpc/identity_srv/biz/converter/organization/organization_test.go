@@ -591,6 +591,32 @@ func TestConverterImpl_ApplyUpdateToModel(t *testing.T) {
 		assert.Equal(t, "原始状态", result.AccreditationStatus)        // nil 不更新
 		assert.Equal(t, models.StringSlice{}, result.ProvinceCity) // 空列表
 	})
+
+	t.Run("MFA强制策略更新", func(t *testing.T) {
+		existing := &models.Organization{
+			BaseModel:   models.BaseModel{ID: uuid.New()},
+			Name:        "原始名称",
+			MFARequired: true,
+		}
+
+		// 未传 mfaRequired 时保持不变
+		result := converter.ApplyUpdateToModel(existing, &identity_srv.UpdateOrganizationRequest{})
+		require.NotNil(t, result)
+		assert.True(t, result.MFARequired)
+
+		// 显式关闭
+		disabled := false
+		result = converter.ApplyUpdateToModel(
+			existing,
+			&identity_srv.UpdateOrganizationRequest{MfaRequired: &disabled},
+		)
+		require.NotNil(t, result)
+		assert.False(t, result.MFARequired)
+
+		dto := converter.ModelOrganizationToThrift(existing)
+		require.NotNil(t, dto)
+		assert.True(t, dto.GetMfaRequired())
+	})
 }
 
 func TestConverterImpl_ModelToThrift_Alias(t *testing.T) {
@@ -44,6 +44,7 @@ func (c *ConverterImpl) ModelUserProfileToThrift(
 		Status:    &status,
 		CreatedAt: &model.CreatedAt,
 		UpdatedAt: &model.UpdatedAt,
+		Version:   &model.Version,
 	}
 
 	// 处理可选字符串字段
@@ -0,0 +1,83 @@
+package accessreview
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+)
+
+// CampaignQueryConditions 访问复核活动查询条件
+// 对应 IDL 中的 ListAccessReviewCampaignsRequest
+type CampaignQueryConditions struct {
+	Status         *models.AccessReviewCampaignStatus `json:"status,omitempty"`
+	OrganizationID *string                            `json:"organization_id,omitempty"`
+
+	// Page 分页查询选项
+	Page *base.QueryOptions `json:"page,omitempty"`
+}
+
+// ItemQueryConditions 访问复核条目查询条件
+// 对应 IDL 中的 ListAccessReviewItemsRequest
+type ItemQueryConditions struct {
+	CampaignID string                       `json:"campaign_id"`
+	Decision   *models.AccessReviewDecision `json:"decision,omitempty"`
+	UserID     *string                      `json:"user_id,omitempty"`
+
+	// Page 分页查询选项
+	Page *base.QueryOptions `json:"page,omitempty"`
+}
+
+// ItemDecision 待复核条目的复核结果
+type ItemDecision struct {
+	Decision   models.AccessReviewDecision
+	ReviewedBy *uuid.UUID
+	ReviewedAt int64
+	Comment    string
+}
+
+// DecisionCounts 单个活动各复核结论的条目数
+type DecisionCounts map[models.AccessReviewDecision]int64
+
+// AccessReviewCampaignRepository 访问复核活动仓储接口
+type AccessReviewCampaignRepository interface {
+	// 嵌入基础仓储接口
+	base.BaseRepository[models.AccessReviewCampaign]
+
+	// FindWithConditions 按条件分页查询活动，默认按创建时间倒序
+	FindWithConditions(
+		ctx context.Context,
+		conditions *CampaignQueryConditions,
+	) ([]*models.AccessReviewCampaign, *models.PageResult, error)
+
+	// Close 把仍在进行中的活动置为已关闭，返回是否关闭成功；
+	// 返回 false 表示活动已被关闭（并发关闭时只有一方成功）
+	Close(ctx context.Context, id string, closedBy *uuid.UUID, closedAt int64) (bool, error)
+
+	// FindOverdueOpen 查询已过截止时间但仍在进行中的活动，按截止时间升序，最多 limit 条
+	FindOverdueOpen(ctx context.Context, nowMillis int64, limit int) ([]*models.AccessReviewCampaign, error)
+}
+
+// AccessReviewItemRepository 访问复核条目仓储接口
+type AccessReviewItemRepository interface {
+	// 嵌入基础仓储接口
+	base.BaseRepository[models.AccessReviewItem]
+
+	// FindWithConditions 按条件分页查询活动下的条目
+	FindWithConditions(
+		ctx context.Context,
+		conditions *ItemQueryConditions,
+	) ([]*models.AccessReviewItem, *models.PageResult, error)
+
+	// FindPending 查询活动下仍待复核的条目，最多 limit 条
+	FindPending(ctx context.Context, campaignID string, limit int) ([]*models.AccessReviewItem, error)
+
+	// Decide 把仍待复核的条目流转为 decision.Decision，返回是否流转成功；
+	// 返回 false 表示条目已被其他复核人处理
+	Decide(ctx context.Context, id string, decision ItemDecision) (bool, error)
+
+	// CountByDecision 批量统计活动下各复核结论的条目数，返回 map[campaignID]DecisionCounts
+	CountByDecision(ctx context.Context, campaignIDs []string) (map[string]DecisionCounts, error)
+}
@@ -0,0 +1,239 @@
+package accessreview
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+)
+
+// ============================================================================
+// 访问复核活动
+// ============================================================================
+
+// AccessReviewCampaignRepositoryImpl 访问复核活动仓储实现
+type AccessReviewCampaignRepositoryImpl struct {
+	base.BaseRepository[models.AccessReviewCampaign]
+	db *gorm.DB
+}
+
+// NewAccessReviewCampaignRepository 创建访问复核活动仓储实例
+func NewAccessReviewCampaignRepository(db *gorm.DB) AccessReviewCampaignRepository {
+	return &AccessReviewCampaignRepositoryImpl{
+		BaseRepository: base.NewBaseRepository[models.AccessReviewCampaign](db),
+		db:             db,
+	}
+}
+
+// FindWithConditions 按条件分页查询活动
+func (r *AccessReviewCampaignRepositoryImpl) FindWithConditions(
+	ctx context.Context,
+	conditions *CampaignQueryConditions,
+) ([]*models.AccessReviewCampaign, *models.PageResult, error) {
+	opts := pageOptions(nil)
+	if conditions != nil {
+		opts = pageOptions(conditions.Page)
+	}
+
+	baseRepo := r.BaseRepository.(*base.BaseRepositoryImpl[models.AccessReviewCampaign])
+
+	qb := baseRepo.NewQueryBuilder(ctx).WithSoftDelete(opts)
+
+	if conditions != nil {
+		qb = qb.WhereEqual("organization_id", conditions.OrganizationID)
+
+		if conditions.Status != nil {
+			status := *conditions.Status
+
+			qb = qb.WhereCustom(func(db *gorm.DB) *gorm.DB {
+				return db.Where("status = ?", status)
+			})
+		}
+	}
+
+	return qb.WithOrder(opts).FindWithPagination(opts)
+}
+
+// Close 条件更新：仅当活动仍在进行中时写入关闭信息
+func (r *AccessReviewCampaignRepositoryImpl) Close(
+	ctx context.Context,
+	id string,
+	closedBy *uuid.UUID,
+	closedAt int64,
+) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.AccessReviewCampaign{}).
+		Where("id = ? AND status = ?", id, models.AccessReviewCampaignStatusOpen).
+		Updates(map[string]interface{}{
+			"status":    models.AccessReviewCampaignStatusClosed,
+			"closed_by": closedBy,
+			"closed_at": closedAt,
+			"version":   gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("关闭访问复核活动失败: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// FindOverdueOpen 查询已过截止时间的进行中活动
+func (r *AccessReviewCampaignRepositoryImpl) FindOverdueOpen(
+	ctx context.Context,
+	nowMillis int64,
+	limit int,
+) ([]*models.AccessReviewCampaign, error) {
+	var campaigns []*models.AccessReviewCampaign
+
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND due_at IS NOT NULL AND due_at <= ?", models.AccessReviewCampaignStatusOpen, nowMillis).
+		Order("due_at ASC").
+		Limit(limit).
+		Find(&campaigns).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询到期访问复核活动失败: %w", err)
+	}
+
+	return campaigns, nil
+}
+
+// ============================================================================
+// 访问复核条目
+// ============================================================================
+
+// AccessReviewItemRepositoryImpl 访问复核条目仓储实现
+type AccessReviewItemRepositoryImpl struct {
+	base.BaseRepository[models.AccessReviewItem]
+	db *gorm.DB
+}
+
+// NewAccessReviewItemRepository 创建访问复核条目仓储实例
+func NewAccessReviewItemRepository(db *gorm.DB) AccessReviewItemRepository {
+	return &AccessReviewItemRepositoryImpl{
+		BaseRepository: base.NewBaseRepository[models.AccessReviewItem](db),
+		db:             db,
+	}
+}
+
+// FindWithConditions 按条件分页查询活动下的条目
+func (r *AccessReviewItemRepositoryImpl) FindWithConditions(
+	ctx context.Context,
+	conditions *ItemQueryConditions,
+) ([]*models.AccessReviewItem, *models.PageResult, error) {
+	opts := pageOptions(conditions.Page)
+
+	baseRepo := r.BaseRepository.(*base.BaseRepositoryImpl[models.AccessReviewItem])
+
+	qb := baseRepo.NewQueryBuilder(ctx).WithSoftDelete(opts).
+		WhereEqual("campaign_id", conditions.CampaignID).
+		WhereEqual("user_id", conditions.UserID)
+
+	if conditions.Decision != nil {
+		decision := *conditions.Decision
+
+		qb = qb.WhereCustom(func(db *gorm.DB) *gorm.DB {
+			return db.Where("decision = ?", decision)
+		})
+	}
+
+	return qb.WithOrder(opts).FindWithPagination(opts)
+}
+
+// FindPending 查询活动下仍待复核的条目
+func (r *AccessReviewItemRepositoryImpl) FindPending(
+	ctx context.Context,
+	campaignID string,
+	limit int,
+) ([]*models.AccessReviewItem, error) {
+	var items []*models.AccessReviewItem
+
+	err := r.db.WithContext(ctx).
+		Where("campaign_id = ? AND decision = ?", campaignID, models.AccessReviewDecisionPending).
+		Order("created_at ASC, id ASC").
+		Limit(limit).
+		Find(&items).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询待复核条目失败: %w", err)
+	}
+
+	return items, nil
+}
+
+// Decide 条件更新：仅当条目仍待复核时写入复核结果
+func (r *AccessReviewItemRepositoryImpl) Decide(
+	ctx context.Context,
+	id string,
+	decision ItemDecision,
+) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.AccessReviewItem{}).
+		Where("id = ? AND decision = ?", id, models.AccessReviewDecisionPending).
+		Updates(map[string]interface{}{
+			"decision":    decision.Decision,
+			"reviewed_by": decision.ReviewedBy,
+			"reviewed_at": decision.ReviewedAt,
+			"comment":     decision.Comment,
+			"version":     gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("更新访问复核条目失败: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// CountByDecision 批量统计活动下各复核结论的条目数
+func (r *AccessReviewItemRepositoryImpl) CountByDecision(
+	ctx context.Context,
+	campaignIDs []string,
+) (map[string]DecisionCounts, error) {
+	counts := make(map[string]DecisionCounts, len(campaignIDs))
+	if len(campaignIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		CampaignID string
+		Decision   models.AccessReviewDecision
+		Count      int64
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&models.AccessReviewItem{}).
+		Select("campaign_id, decision, COUNT(*) AS count").
+		Where("campaign_id IN ?", campaignIDs).
+		Group("campaign_id, decision").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("统计访问复核条目失败: %w", err)
+	}
+
+	for _, row := range rows {
+		if counts[row.CampaignID] == nil {
+			counts[row.CampaignID] = make(DecisionCounts)
+		}
+
+		counts[row.CampaignID][row.Decision] = row.Count
+	}
+
+	return counts, nil
+}
+
+// pageOptions 由调用方的分页选项构造查询选项，未指定时使用默认值
+func pageOptions(page *base.QueryOptions) *base.QueryOptions {
+	opts := base.NewQueryOptions()
+	if page == nil {
+		return opts
+	}
+
+	opts = opts.WithPage(page.Page, page.PageSize).WithOrder(page.OrderBy, page.OrderDesc)
+	opts.Keyset = page.Keyset
+	opts.Cursor = page.Cursor
+	opts.IncludeTotal = page.IncludeTotal
+
+	return opts
+}
@@ -2,6 +2,7 @@ package assignment
 
 import (
 	"context"
+	"time"
 
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
@@ -16,6 +17,12 @@ type UserRoleAssignmentQueryConditions struct {
 	// RoleID 角色ID，用于查询指定角色的分配情况
 	RoleID *string `json:"role_id,omitempty"`
 
+	// OrganizationID 组织范围，语义见 ApplyOrganizationScope
+	OrganizationID *string `json:"organization_id,omitempty"`
+
+	// ExpiringBefore 仅查询尚未到期、且在该时间（毫秒时间戳）之前到期的分配
+	ExpiringBefore *int64 `json:"expiring_before,omitempty"`
+
 	// Page 分页查询选项
 	Page *base.QueryOptions `json:"page,omitempty"`
 }
@@ -46,11 +53,11 @@ type UserRoleAssignmentRepository interface {
 		page *base.QueryOptions,
 	) ([]*models.UserRoleAssignment, *models.PageResult, error)
 
-	// FindByUserAndRole 查询指定用户和角色的分配记录
-	// 用于验证用户是否拥有某个角色，或获取具体的分配详情
+	// FindByUserAndRole 查询指定用户和角色在指定组织下的分配记录
+	// organizationID 精确匹配，为空表示查询全局分配
 	FindByUserAndRole(
 		ctx context.Context,
-		userID, roleID string,
+		userID, roleID, organizationID string,
 	) (*models.UserRoleAssignment, error)
 
 	// GetLastUserRoleAssignment 获取用户最后一次的角色分配信息
@@ -70,10 +77,12 @@ type UserRoleAssignmentRepository interface {
 	// GetActiveRoleIDsWithStatus 获取用户所有处于指定状态的角色ID列表
 	// 此方法会联表查询 user_role_assignments 和 role_definitions
 	// 只返回角色状态匹配的角色ID（通常用于获取 Active 状态的角色）
-	// 专门用于登录等需要验证角色可用性的场景
+	// 专门用于登录等需要验证角色可用性的场景，organizationID 语义见 ApplyOrganizationScope
+	// 只包含当前处于生效时间窗口内的分配
 	GetActiveRoleIDsWithStatus(
 		ctx context.Context,
 		userID string,
+		organizationID *string,
 		status models.RoleStatus,
 	) ([]string, error)
 
@@ -84,6 +93,40 @@ type UserRoleAssignmentRepository interface {
 		conditions *UserRoleAssignmentQueryConditions,
 	) ([]*models.UserRoleAssignment, *models.PageResult, error)
 
+	// FindExpired 查询已到期（valid_until <= nowMillis）但尚未回收的分配
+	// 按到期时间升序返回，最多 limit 条，供后台回收任务分批处理
+	FindExpired(
+		ctx context.Context,
+		nowMillis int64,
+		limit int,
+	) ([]*models.UserRoleAssignment, error)
+
+	// FindEffectiveByUserIDs 查询用户当前生效的角色分配（角色为启用状态且处于生效时间窗口内）
+	// userIDs 为空表示全部用户，organizationID 语义见 ApplyOrganizationScope；按用户、组织排序
+	FindEffectiveByUserIDs(
+		ctx context.Context,
+		userIDs []string,
+		organizationID *string,
+	) ([]*models.UserRoleAssignment, error)
+
+	// FindUnexpiredByRoleIDs 查询指定角色尚未到期的分配（含尚未开始生效的），用于职责分离约束校验
+	// userIDs 为空表示全部用户；不区分组织范围；按用户、角色排序
+	FindUnexpiredByRoleIDs(
+		ctx context.Context,
+		roleIDs []string,
+		userIDs []string,
+		nowMillis int64,
+	) ([]*models.UserRoleAssignment, error)
+
+	// FindUnexpiredInScope 查询复核范围内尚未到期的分配（含尚未开始生效的），用于生成访问复核条目
+	// roleID 为空表示全部角色；organizationID 为空表示全部分配（含全局分配），否则仅该组织下的分配；
+	// 按用户、角色排序
+	FindUnexpiredInScope(
+		ctx context.Context,
+		roleID, organizationID string,
+		nowMillis int64,
+	) ([]*models.UserRoleAssignment, error)
+
 	// GetRolesByUserIDs 批量查询多个用户的角色分配
 	// 返回: map[userID][]roleID，避免 N+1 查询问题
 	GetRolesByUserIDs(
@@ -95,9 +138,10 @@ type UserRoleAssignmentRepository interface {
 	// 业务验证方法
 	// ============================================================================
 
-	// CheckUserRoleExists 检查用户是否已分配指定角色
-	// 用于分配前的重复性验证，避免重复分配
-	CheckUserRoleExists(ctx context.Context, userID, roleID string) (bool, error)
+	// CheckUserRoleExists 检查用户在指定组织下是否已拥有该角色
+	// 用于分配前的重复性验证：organizationID 为空时只检查全局分配，
+	// 否则全局分配或该组织下的分配均视为已拥有
+	CheckUserRoleExists(ctx context.Context, userID, roleID, organizationID string) (bool, error)
 
 	// CountByUserID 统计指定用户的角色分配数量
 	// 用于用户角色概览和权限分析
@@ -125,11 +169,11 @@ type UserRoleAssignmentRepository interface {
 	BatchRevokeUserRoles(ctx context.Context, assignmentIDs []string) error
 
 	// GetAllUserIDsByRoleID 获取指定角色下所有用户ID（不分页）
-	// 用于获取某个角色下所有用户的场景
-	GetAllUserIDsByRoleID(ctx context.Context, roleID string) ([]string, error)
+	// organizationID 语义见 ApplyOrganizationScope
+	GetAllUserIDsByRoleID(ctx context.Context, roleID string, organizationID *string) ([]string, error)
 
-	// ReplaceRoleUsers 批量替换角色的用户绑定（事务操作）
-	// 先删除该角色下所有旧的用户绑定，再创建新的用户绑定
+	// ReplaceRoleUsers 批量替换角色的全局用户绑定（事务操作）
+	// 先删除该角色下所有旧的全局用户绑定，再创建新的全局用户绑定；组织级分配不受影响
 	// 用于批量更新角色用户的场景，确保数据一致性
 	ReplaceRoleUsers(
 		ctx context.Context,
@@ -137,4 +181,21 @@ type UserRoleAssignmentRepository interface {
 		userIDs []string,
 		operatorID string,
 	) error
+
+	// ============================================================================
+	// 回收站
+	// ============================================================================
+
+	// FindDeletedByUser 查询删除用户时（deletedAt 时刻）级联删除的角色分配，按创建时间排序
+	// 同一用户、角色、组织已存在有效分配的不返回，恢复时避免违反唯一索引
+	FindDeletedByUser(ctx context.Context, userID string, deletedAt time.Time) ([]*models.UserRoleAssignment, error)
+
+	// PurgeByUser 物理删除用户的全部角色分配（含已软删除记录）
+	PurgeByUser(ctx context.Context, userID string) error
+
+	// CountByOrganization 统计指定组织下有效的组织级角色分配数量
+	CountByOrganization(ctx context.Context, organizationID string) (int64, error)
+
+	// PurgeDeletedByOrganization 物理删除指定组织下已软删除的组织级角色分配
+	PurgeDeletedByOrganization(ctx context.Context, organizationID string) error
 }
@@ -2,6 +2,7 @@ package assignment
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -25,6 +26,41 @@ func NewUserRoleAssignmentRepository(db *gorm.DB) UserRoleAssignmentRepository {
 	}
 }
 
+// ApplyOrganizationScope 按组织范围过滤角色分配
+//   - organizationID 为 nil：不过滤（包含全部组织的分配）
+//   - organizationID 为空字符串：仅全局分配
+//   - 其他：全局分配以及该组织下的分配
+func ApplyOrganizationScope(query *gorm.DB, organizationID *string) *gorm.DB {
+	if organizationID == nil {
+		return query
+	}
+
+	if *organizationID == "" {
+		return query.Where("user_role_assignments.organization_id IS NULL")
+	}
+
+	return query.Where(
+		"(user_role_assignments.organization_id IS NULL OR user_role_assignments.organization_id = ?)",
+		*organizationID,
+	)
+}
+
+// ApplyEffectiveWindow 只保留在指定时间（毫秒时间戳）处于生效窗口内的分配
+func ApplyEffectiveWindow(query *gorm.DB, nowMillis int64) *gorm.DB {
+	return query.
+		Where("(user_role_assignments.valid_from IS NULL OR user_role_assignments.valid_from <= ?)", nowMillis).
+		Where("(user_role_assignments.valid_until IS NULL OR user_role_assignments.valid_until > ?)", nowMillis)
+}
+
+// applyExactOrganization 精确匹配组织，空字符串表示全局分配
+func applyExactOrganization(query *gorm.DB, organizationID string) *gorm.DB {
+	if organizationID == "" {
+		return query.Where("organization_id IS NULL")
+	}
+
+	return query.Where("organization_id = ?", organizationID)
+}
+
 // ============================================================================
 // 核心查询方法
 // ============================================================================
@@ -68,13 +104,13 @@ func (r *UserRoleAssignmentRepositoryImpl) FindByRoleID(
 // FindByUserAndRole 查询指定用户和角色的分配记录
 func (r *UserRoleAssignmentRepositoryImpl) FindByUserAndRole(
 	ctx context.Context,
-	userID, roleID string,
+	userID, roleID, organizationID string,
 ) (*models.UserRoleAssignment, error) {
 	var assignment models.UserRoleAssignment
 
-	err := r.db.WithContext(ctx).
-		Where("user_id = ? AND role_id = ?", userID, roleID).
-		First(&assignment).Error
+	query := r.db.WithContext(ctx).Where("user_id = ? AND role_id = ?", userID, roleID)
+
+	err := applyExactOrganization(query, organizationID).First(&assignment).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, nil
@@ -140,19 +176,24 @@ func (r *UserRoleAssignmentRepositoryImpl) GetActiveRolesByUserID(
 func (r *UserRoleAssignmentRepositoryImpl) GetActiveRoleIDsWithStatus(
 	ctx context.Context,
 	userID string,
+	organizationID *string,
 	status models.RoleStatus,
 ) ([]string, error) {
 	var roleIDs []string
 
 	// 联表查询：user_role_assignments JOIN role_definitions
-	// 只返回匹配指定状态的角色ID
-	err := r.db.WithContext(ctx).
+	// 只返回匹配指定状态的角色ID；同一角色可能同时存在全局和组织级分配，需去重
+	query := r.db.WithContext(ctx).
 		Model(&models.UserRoleAssignment{}).
-		Select("user_role_assignments.role_id").
+		Distinct("user_role_assignments.role_id").
 		Joins("JOIN role_definitions ON role_definitions.id = user_role_assignments.role_id").
 		Where("user_role_assignments.user_id = ?", userID).
 		Where("role_definitions.status = ?", status).
-		Where("role_definitions.deleted_at IS NULL").
+		Where("role_definitions.deleted_at IS NULL")
+
+	query = ApplyEffectiveWindow(query, time.Now().UnixMilli())
+
+	err := ApplyOrganizationScope(query, organizationID).
 		Pluck("user_role_assignments.role_id", &roleIDs).Error
 	if err != nil {
 		return nil, err
@@ -167,23 +208,142 @@ func (r *UserRoleAssignmentRepositoryImpl) FindWithConditions(
 	conditions *UserRoleAssignmentQueryConditions,
 ) ([]*models.UserRoleAssignment, *models.PageResult, error) {
 	opts := base.NewQueryOptions()
+	if conditions != nil && conditions.Page != nil {
+		opts = opts.WithPage(conditions.Page.Page, conditions.Page.PageSize).
+			WithOrder(conditions.Page.OrderBy, conditions.Page.OrderDesc)
+		opts.Keyset = conditions.Page.Keyset
+		opts.Cursor = conditions.Page.Cursor
+		opts.IncludeTotal = conditions.Page.IncludeTotal
+	}
+
+	// 组织范围包含 OR 条件，使用 QueryBuilder 构建查询
+	baseRepo := r.BaseRepository.(*base.BaseRepositoryImpl[models.UserRoleAssignment])
+
+	qb := baseRepo.NewQueryBuilder(ctx).WithSoftDelete(opts)
 
 	if conditions != nil {
-		if conditions.UserID != nil {
-			opts = opts.WithFilter("user_id", *conditions.UserID)
-		}
+		organizationID := conditions.OrganizationID
 
-		if conditions.RoleID != nil {
-			opts = opts.WithFilter("role_id", *conditions.RoleID)
-		}
+		qb = qb.WhereEqual("user_id", conditions.UserID).
+			WhereEqual("role_id", conditions.RoleID).
+			WhereCustom(func(db *gorm.DB) *gorm.DB {
+				return ApplyOrganizationScope(db, organizationID)
+			})
+
+		if conditions.ExpiringBefore != nil {
+			expiringBefore := *conditions.ExpiringBefore
+			nowMillis := time.Now().UnixMilli()
 
-		if conditions.Page != nil {
-			opts = opts.WithPage(conditions.Page.Page, conditions.Page.PageSize).
-				WithOrder(conditions.Page.OrderBy, conditions.Page.OrderDesc)
+			qb = qb.WhereCustom(func(db *gorm.DB) *gorm.DB {
+				return db.Where("valid_until > ? AND valid_until <= ?", nowMillis, expiringBefore)
+			})
 		}
 	}
 
-	return r.FindAll(ctx, opts)
+	return qb.WithOrder(opts).FindWithPagination(opts)
+}
+
+// FindExpired 查询已到期但尚未回收的分配
+func (r *UserRoleAssignmentRepositoryImpl) FindExpired(
+	ctx context.Context,
+	nowMillis int64,
+	limit int,
+) ([]*models.UserRoleAssignment, error) {
+	var assignments []*models.UserRoleAssignment
+
+	err := r.db.WithContext(ctx).
+		Where("valid_until IS NOT NULL AND valid_until <= ?", nowMillis).
+		Order("valid_until ASC").
+		Limit(limit).
+		Find(&assignments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+// FindEffectiveByUserIDs 查询用户当前生效的角色分配
+func (r *UserRoleAssignmentRepositoryImpl) FindEffectiveByUserIDs(
+	ctx context.Context,
+	userIDs []string,
+	organizationID *string,
+) ([]*models.UserRoleAssignment, error) {
+	var assignments []*models.UserRoleAssignment
+
+	query := r.db.WithContext(ctx).
+		Joins("JOIN role_definitions ON role_definitions.id = user_role_assignments.role_id").
+		Where("role_definitions.status = ?", models.RoleStatusActive).
+		Where("role_definitions.deleted_at IS NULL")
+
+	if len(userIDs) > 0 {
+		query = query.Where("user_role_assignments.user_id IN ?", userIDs)
+	}
+
+	query = ApplyEffectiveWindow(query, time.Now().UnixMilli())
+
+	err := ApplyOrganizationScope(query, organizationID).
+		Order("user_role_assignments.user_id ASC, user_role_assignments.organization_id ASC").
+		Find(&assignments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+// FindUnexpiredByRoleIDs 查询指定角色尚未到期的分配
+func (r *UserRoleAssignmentRepositoryImpl) FindUnexpiredByRoleIDs(
+	ctx context.Context,
+	roleIDs []string,
+	userIDs []string,
+	nowMillis int64,
+) ([]*models.UserRoleAssignment, error) {
+	if len(roleIDs) == 0 {
+		return nil, nil
+	}
+
+	var assignments []*models.UserRoleAssignment
+
+	query := r.db.WithContext(ctx).
+		Where("role_id IN ?", roleIDs).
+		Where("(valid_until IS NULL OR valid_until > ?)", nowMillis)
+
+	if len(userIDs) > 0 {
+		query = query.Where("user_id IN ?", userIDs)
+	}
+
+	if err := query.Order("user_id ASC, role_id ASC").Find(&assignments).Error; err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+// FindUnexpiredInScope 查询复核范围内尚未到期的分配
+func (r *UserRoleAssignmentRepositoryImpl) FindUnexpiredInScope(
+	ctx context.Context,
+	roleID, organizationID string,
+	nowMillis int64,
+) ([]*models.UserRoleAssignment, error) {
+	var assignments []*models.UserRoleAssignment
+
+	query := r.db.WithContext(ctx).
+		Where("(valid_until IS NULL OR valid_until > ?)", nowMillis)
+
+	if roleID != "" {
+		query = query.Where("role_id = ?", roleID)
+	}
+
+	if organizationID != "" {
+		query = query.Where("organization_id = ?", organizationID)
+	}
+
+	if err := query.Order("user_id ASC, role_id ASC").Find(&assignments).Error; err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
 }
 
 // GetRolesByUserIDs 批量查询多个用户的角色分配
@@ -226,14 +386,15 @@ func (r *UserRoleAssignmentRepositoryImpl) GetRolesByUserIDs(
 // CheckUserRoleExists 检查用户是否已分配指定角色
 func (r *UserRoleAssignmentRepositoryImpl) CheckUserRoleExists(
 	ctx context.Context,
-	userID, roleID string,
+	userID, roleID, organizationID string,
 ) (bool, error) {
 	var count int64
 
-	err := r.db.WithContext(ctx).
+	query := r.db.WithContext(ctx).
 		Model(&models.UserRoleAssignment{}).
-		Where("user_id = ? AND role_id = ?", userID, roleID).
-		Count(&count).Error
+		Where("user_id = ? AND role_id = ?", userID, roleID)
+
+	err := ApplyOrganizationScope(query, &organizationID).Count(&count).Error
 	if err != nil {
 		return false, err
 	}
@@ -307,26 +468,26 @@ func (r *UserRoleAssignmentRepositoryImpl) BatchRevokeUserRoles(
 func (r *UserRoleAssignmentRepositoryImpl) GetAllUserIDsByRoleID(
 	ctx context.Context,
 	roleID string,
+	organizationID *string,
 ) ([]string, error) {
-	var assignments []models.UserRoleAssignment
+	var userIDs []string
 
-	err := r.db.WithContext(ctx).
-		Select("user_id").
-		Where("role_id = ?", roleID).
-		Find(&assignments).Error
+	// 同一用户可能同时拥有全局和组织级分配，需去重
+	query := r.db.WithContext(ctx).
+		Model(&models.UserRoleAssignment{}).
+		Distinct("user_role_assignments.user_id").
+		Where("role_id = ?", roleID)
+
+	err := ApplyOrganizationScope(query, organizationID).
+		Pluck("user_role_assignments.user_id", &userIDs).Error
 	if err != nil {
 		return nil, err
 	}
 
-	userIDs := make([]string, 0, len(assignments))
-	for _, assignment := range assignments {
-		userIDs = append(userIDs, assignment.UserID.String())
-	}
-
 	return userIDs, nil
 }
 
-// ReplaceRoleUsers 批量替换角色的用户绑定（事务操作）
+// ReplaceRoleUsers 批量替换角色的全局用户绑定（事务操作）
 func (r *UserRoleAssignmentRepositoryImpl) ReplaceRoleUsers(
 	ctx context.Context,
 	roleID string,
@@ -335,8 +496,9 @@ func (r *UserRoleAssignmentRepositoryImpl) ReplaceRoleUsers(
 ) error {
 	// 使用事务确保数据一致性
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 1. 删除该角色下所有旧的用户绑定
-		if err := tx.Where("role_id = ?", roleID).Delete(&models.UserRoleAssignment{}).Error; err != nil {
+		// 1. 删除该角色下所有旧的全局用户绑定（组织级分配保持不变）
+		if err := tx.Where("role_id = ? AND organization_id IS NULL", roleID).
+			Delete(&models.UserRoleAssignment{}).Error; err != nil {
 			return err
 		}
 
@@ -368,3 +530,64 @@ func (r *UserRoleAssignmentRepositoryImpl) ReplaceRoleUsers(
 		return nil
 	})
 }
+
+// ============================================================================
+// 回收站
+// ============================================================================
+
+// FindDeletedByUser 查询删除用户时级联删除的角色分配
+// 删除用户时角色分配与用户档案使用同一删除时间戳，据此区分此前单独撤销的分配
+func (r *UserRoleAssignmentRepositoryImpl) FindDeletedByUser(
+	ctx context.Context,
+	userID string,
+	deletedAt time.Time,
+) ([]*models.UserRoleAssignment, error) {
+	var assignments []*models.UserRoleAssignment
+
+	err := r.db.WithContext(ctx).Unscoped().
+		Where("user_id = ? AND deleted_at = ?", userID, deletedAt).
+		Where(`NOT EXISTS (SELECT 1 FROM user_role_assignments active
+			WHERE active.deleted_at IS NULL
+			AND active.user_id = user_role_assignments.user_id
+			AND active.role_id = user_role_assignments.role_id
+			AND active.organization_id IS NOT DISTINCT FROM user_role_assignments.organization_id)`).
+		Order("created_at").
+		Find(&assignments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+// PurgeByUser 物理删除用户的全部角色分配
+func (r *UserRoleAssignmentRepositoryImpl) PurgeByUser(ctx context.Context, userID string) error {
+	return r.db.WithContext(ctx).Unscoped().
+		Where("user_id = ?", userID).
+		Delete(&models.UserRoleAssignment{}).Error
+}
+
+// CountByOrganization 统计指定组织下有效的组织级角色分配数量
+func (r *UserRoleAssignmentRepositoryImpl) CountByOrganization(
+	ctx context.Context,
+	organizationID string,
+) (int64, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).
+		Model(&models.UserRoleAssignment{}).
+		Where("organization_id = ?", organizationID).
+		Count(&count).Error
+
+	return count, err
+}
+
+// PurgeDeletedByOrganization 物理删除指定组织下已软删除的组织级角色分配
+func (r *UserRoleAssignmentRepositoryImpl) PurgeDeletedByOrganization(
+	ctx context.Context,
+	organizationID string,
+) error {
+	return r.db.WithContext(ctx).Unscoped().
+		Where("organization_id = ? AND deleted_at IS NOT NULL", organizationID).
+		Delete(&models.UserRoleAssignment{}).Error
+}
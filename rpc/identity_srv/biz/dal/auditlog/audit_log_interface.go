@@ -40,5 +40,6 @@ type AuditLogQueryConditions struct {
 	Success   *bool
 	StartTime *int64
 	EndTime   *int64
+	Scope     *base.DataScopeFilter // 行级数据范围（nil 不限制）
 	Page      *base.QueryOptions
 }
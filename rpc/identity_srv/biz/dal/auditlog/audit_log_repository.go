@@ -41,32 +41,64 @@ func (r *AuditLogRepositoryImpl) FindWithConditions(
 		opts = conditions.Page
 	}
 
+	if err := opts.Validate(); err != nil {
+		return nil, nil, err
+	}
+
 	// 构建查询
 	query := r.db.WithContext(ctx).Model(&models.AuditLog{})
 	query = r.applyFilterConditions(query, conditions)
 
-	// 计算总数
-	var total int64
+	// 计算总数（游标分页默认跳过，避免大表 COUNT(*)）
+	var countQuery *gorm.DB
+	if opts.ShouldCount() {
+		countQuery = r.db.WithContext(ctx).Model(&models.AuditLog{})
+		countQuery = r.applyFilterConditions(countQuery, conditions)
+	}
+
+	if opts.Keyset {
+		logs, pageResult, err := base.FindKeysetPage[models.AuditLog](query, countQuery, "", opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("查询审计日志列表失败: %w", err)
+		}
 
-	countQuery := r.db.WithContext(ctx).Model(&models.AuditLog{})
-	countQuery = r.applyFilterConditions(countQuery, conditions)
+		return logs, pageResult, nil
+	}
+
+	var total int64
 
-	if err := countQuery.Count(&total).Error; err != nil {
-		return nil, nil, fmt.Errorf("统计审计日志总数失败: %w", err)
+	if countQuery != nil {
+		if err := countQuery.Count(&total).Error; err != nil {
+			return nil, nil, fmt.Errorf("统计审计日志总数失败: %w", err)
+		}
 	}
 
 	// 排序（默认按创建时间降序）
 	orderClause := r.buildOrderClause(opts)
 	query = query.Order(orderClause)
 
-	// 分页查询
+	// 分页查询；不统计总数时多取一条用于判断是否有下一页
 	var logs []*models.AuditLog
 
+	limit := int(opts.PageSize)
+	if countQuery == nil {
+		limit++
+	}
+
 	offset := (opts.Page - 1) * opts.PageSize
-	if err := query.Offset(int(offset)).Limit(int(opts.PageSize)).Find(&logs).Error; err != nil {
+	if err := query.Offset(int(offset)).Limit(limit).Find(&logs).Error; err != nil {
 		return nil, nil, fmt.Errorf("查询审计日志列表失败: %w", err)
 	}
 
+	if countQuery == nil {
+		hasNext := len(logs) > int(opts.PageSize)
+		if hasNext {
+			logs = logs[:opts.PageSize]
+		}
+
+		return logs, models.NewPageResultWithoutTotal(opts.Page, opts.PageSize, hasNext), nil
+	}
+
 	pageResult := models.NewPageResult(int32(total), opts.Page, opts.PageSize)
 
 	return logs, pageResult, nil
@@ -105,9 +137,44 @@ func (r *AuditLogRepositoryImpl) applyFilterConditions(
 		query = query.Where("created_at <= ?", *conditions.EndTime)
 	}
 
+	if !conditions.Scope.Unrestricted() {
+		query = r.applyDataScope(query, conditions.Scope)
+	}
+
 	return query
 }
 
+// applyDataScope 把数据范围翻译为审计日志的行级条件
+//
+// 审计日志不记录部门，部门范围按操作人当前所属部门（活跃成员关系）匹配。
+func (r *AuditLogRepositoryImpl) applyDataScope(
+	query *gorm.DB,
+	scope *base.DataScopeFilter,
+) *gorm.DB {
+	switch scope.Level {
+	case models.DataScopeSelf:
+		return query.Where("user_id = ?", scope.UserID)
+	case models.DataScopeDept:
+		if len(scope.DepartmentIDs) == 0 {
+			return base.DenyAll(query)
+		}
+
+		return query.Where(
+			"user_id IN (SELECT user_id FROM user_memberships "+
+				"WHERE department_id IN ? AND status = ? AND deleted_at IS NULL)",
+			scope.DepartmentIDs, models.MembershipStatusActive,
+		)
+	case models.DataScopeOrg:
+		if len(scope.OrganizationIDs) == 0 {
+			return base.DenyAll(query)
+		}
+
+		return query.Where("organization_id IN ?", scope.OrganizationIDs)
+	default:
+		return base.DenyAll(query)
+	}
+}
+
 // buildOrderClause 构建排序子句
 func (r *AuditLogRepositoryImpl) buildOrderClause(opts *base.QueryOptions) string {
 	orderBy := "created_at"
@@ -0,0 +1,129 @@
+package base
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+)
+
+// ErrInvalidCursor 分页游标无法解析（被篡改或来自其他版本）
+var ErrInvalidCursor = errors.New("invalid pagination cursor")
+
+// Cursor 游标分页位置：上一页最后一条记录的 (created_at, id)
+//
+// 对调用方不透明，以 base64url(JSON) 形式传递。
+type Cursor struct {
+	CreatedAt int64  `json:"t"`
+	ID        string `json:"i"`
+}
+
+// cursorKeyer 支持游标分页的实体（BaseModel、AuditLog）
+type cursorKeyer interface {
+	CursorKey() (createdAt int64, id string)
+}
+
+// EncodeCursor 编码游标
+func EncodeCursor(c Cursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeCursor 解码游标；空字符串表示首页，返回 nil
+func DecodeCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	if _, err := uuid.Parse(c.ID); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &c, nil
+}
+
+// ApplyKeyset 追加游标条件与 (created_at, id) 排序
+//
+// table 非空时为列名加表名前缀，避免 JOIN 其他同样有 created_at / id 的表时列名歧义。
+func ApplyKeyset(db *gorm.DB, table string, cursor *Cursor, desc bool) *gorm.DB {
+	createdAt, id := "created_at", "id"
+	if table != "" {
+		createdAt, id = table+".created_at", table+".id"
+	}
+
+	op, dir := ">", "ASC"
+	if desc {
+		op, dir = "<", "DESC"
+	}
+
+	if cursor != nil {
+		db = db.Where(fmt.Sprintf("(%s, %s) %s (?, ?)", createdAt, id, op), cursor.CreatedAt, cursor.ID)
+	}
+
+	return db.Order(fmt.Sprintf("%s %s, %s %s", createdAt, dir, id, dir))
+}
+
+// FindKeysetPage 执行一页游标分页查询
+//
+// query 不应包含排序；countQuery 为 nil 时不统计总数。
+// 多取一条判断是否还有下一页，NextCursor 取本页最后一条记录的排序键。
+func FindKeysetPage[T any](
+	query, countQuery *gorm.DB,
+	table string,
+	opts *QueryOptions,
+) ([]*T, *models.PageResult, error) {
+	cursor, err := DecodeCursor(opts.Cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := &models.PageResult{
+		Limit:        opts.PageSize,
+		HasPrev:      cursor != nil,
+		TotalSkipped: countQuery == nil,
+	}
+
+	if countQuery != nil {
+		var total int64
+		if err := countQuery.Count(&total).Error; err != nil {
+			return nil, nil, err
+		}
+
+		result.Total = int32(total)
+		result.TotalPages = (result.Total + opts.PageSize - 1) / opts.PageSize
+	}
+
+	var entities []*T
+
+	query = ApplyKeyset(query, table, cursor, opts.OrderDesc)
+	if err := query.Limit(int(opts.PageSize) + 1).Find(&entities).Error; err != nil {
+		return nil, nil, err
+	}
+
+	if len(entities) > int(opts.PageSize) {
+		entities = entities[:opts.PageSize]
+		result.HasNext = true
+
+		if last, ok := any(entities[len(entities)-1]).(cursorKeyer); ok {
+			createdAt, id := last.CursorKey()
+			result.NextCursor = EncodeCursor(Cursor{CreatedAt: createdAt, ID: id})
+		}
+	}
+
+	return entities, result, nil
+}
@@ -0,0 +1,55 @@
+package base
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+)
+
+// DataScopeFilter 行级数据范围过滤条件
+//
+// 由 logic 层根据 PDP 的 data_scope 提示与调用方身份解析得到，各仓储按自身表结构翻译：
+//   - Self：仅 UserID 本人的记录
+//   - Dept：DepartmentIDs 内的记录（部门暂无层级，子树即部门本身）
+//   - Org：OrganizationIDs 内的记录（调用方当前租户）
+//   - All：不限制
+//
+// nil 表示调用链上没有数据范围（内部调用），同样不限制。
+type DataScopeFilter struct {
+	Level           models.DataScopeType
+	UserID          string
+	DepartmentIDs   []string
+	OrganizationIDs []string
+}
+
+// Unrestricted 是否不需要追加任何行级条件
+func (f *DataScopeFilter) Unrestricted() bool {
+	return f == nil || f.Level == models.DataScopeAll
+}
+
+// DenyAll 返回恒为假的条件，用于范围解析为空集（如调用方不属于任何部门）
+func DenyAll(db *gorm.DB) *gorm.DB {
+	return db.Where("1 = 0")
+}
+
+// WithDataScope 按数据范围追加行级条件
+//
+// apply 负责把过滤条件翻译为具体表的 WHERE 子句；filter 为 nil 或 All 时跳过。
+//
+// 示例：
+//
+//	qb.WithDataScope(conditions.Scope, r.applyDataScope)
+func (qb *QueryBuilder[T]) WithDataScope(
+	filter *DataScopeFilter,
+	apply func(*gorm.DB, *DataScopeFilter) *gorm.DB,
+) *QueryBuilder[T] {
+	if filter.Unrestricted() || apply == nil {
+		return qb
+	}
+
+	qb.conditions = append(qb.conditions, func(db *gorm.DB) *gorm.DB {
+		return apply(db, filter)
+	})
+
+	return qb
+}
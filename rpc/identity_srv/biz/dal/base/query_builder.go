@@ -6,6 +6,7 @@ import (
 
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // QueryBuilder 查询构建器，提供流式 API 构建复杂查询
@@ -33,6 +34,9 @@ type QueryBuilder[T any] struct {
 	// 排序选项
 	orderBy   string
 	orderDesc bool
+
+	// 优先于 orderBy 的排序表达式（如搜索匹配质量）
+	rank []clause.Expr
 }
 
 // NewQueryBuilder 创建查询构建器实例
@@ -163,14 +167,20 @@ func (qb *QueryBuilder[T]) buildQuery() *gorm.DB {
 		query = query.Preload(preload)
 	}
 
-	// 应用排序
+	// 应用排序：匹配质量优先，orderBy 作为次级排序
+	orderClause := ""
 	if qb.orderBy != "" {
-		orderClause := qb.orderBy
+		orderClause = qb.orderBy
 		if qb.orderDesc {
 			orderClause += " DESC"
 		} else {
 			orderClause += " ASC"
 		}
+	}
+
+	if len(qb.rank) > 0 {
+		query = query.Order(OrderByRank(orderClause, qb.rank...))
+	} else if orderClause != "" {
 		query = query.Order(orderClause)
 	}
 
@@ -191,6 +201,9 @@ func (qb *QueryBuilder[T]) buildCountQuery() *gorm.DB {
 
 // FindWithPagination 执行分页查询
 // 这是最常用的方法，自动处理计数和分页
+//
+// opts.Keyset 为 true 时走游标分页（忽略 WithOrder 设置的排序）；
+// opts.ShouldCount 为 false 时跳过 COUNT(*)，通过多取一条判断是否有下一页。
 func (qb *QueryBuilder[T]) FindWithPagination(opts *QueryOptions) ([]*T, *models.PageResult, error) {
 	if opts == nil {
 		opts = NewQueryOptions()
@@ -201,11 +214,19 @@ func (qb *QueryBuilder[T]) FindWithPagination(opts *QueryOptions) ([]*T, *models
 		return nil, nil, err
 	}
 
+	if opts.Keyset {
+		return qb.findWithCursor(opts)
+	}
+
 	// 计算总数（使用 buildCountQuery，避免不必要的 JOIN 和排序）
 	var total int64
-	countQuery := qb.buildCountQuery()
-	if err := countQuery.Count(&total).Error; err != nil {
-		return nil, nil, err
+
+	countTotal := opts.ShouldCount() || opts.FetchAll
+	if countTotal {
+		countQuery := qb.buildCountQuery()
+		if err := countQuery.Count(&total).Error; err != nil {
+			return nil, nil, err
+		}
 	}
 
 	// 执行查询（使用 buildQuery，包含预加载和排序）
@@ -218,19 +239,48 @@ func (qb *QueryBuilder[T]) FindWithPagination(opts *QueryOptions) ([]*T, *models
 			return nil, nil, err
 		}
 	} else {
-		// 分页查询
+		// 分页查询；不统计总数时多取一条用于判断是否有下一页
+		limit := int(opts.PageSize)
+		if !countTotal {
+			limit++
+		}
+
 		offset := (opts.Page - 1) * opts.PageSize
-		if err := query.Offset(int(offset)).Limit(int(opts.PageSize)).Find(&entities).Error; err != nil {
+		if err := query.Offset(int(offset)).Limit(limit).Find(&entities).Error; err != nil {
 			return nil, nil, err
 		}
 	}
 
+	if !countTotal {
+		hasNext := len(entities) > int(opts.PageSize)
+		if hasNext {
+			entities = entities[:opts.PageSize]
+		}
+
+		return entities, models.NewPageResultWithoutTotal(opts.Page, opts.PageSize, hasNext), nil
+	}
+
 	// 构建分页结果
 	pageResult := models.NewPageResult(int32(total), opts.Page, opts.PageSize)
 
 	return entities, pageResult, nil
 }
 
+// findWithCursor 游标分页：按 (created_at, id) 排序，条件为严格位于游标之后
+func (qb *QueryBuilder[T]) findWithCursor(opts *QueryOptions) ([]*T, *models.PageResult, error) {
+	var countQuery *gorm.DB
+	if opts.ShouldCount() {
+		countQuery = qb.buildCountQuery()
+	}
+
+	query := qb.buildCountQuery()
+	for _, preload := range qb.preloads {
+		query = query.Preload(preload)
+	}
+
+	return FindKeysetPage[T](query, countQuery, qb.baseRepo.modelSchema.tableName, opts)
+}
+
 // Count 执行计数查询（不加载数据）
 func (qb *QueryBuilder[T]) Count() (int64, error) {
 	var count int64
@@ -7,10 +7,12 @@ import (
 	"reflect"
 	"strings"
 	"sync"
+	"time"
 
 	"gorm.io/gorm"
 
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
 )
 
 // ============================================================================
@@ -110,6 +112,8 @@ type BaseRepository[T any] interface {
 	SoftDelete(ctx context.Context, id string) error
 	HardDelete(ctx context.Context, id string) error
 	Restore(ctx context.Context, id string) error
+	GetDeletedByID(ctx context.Context, id string) (*T, error)
+	FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*T, error)
 
 	// 批量操作
 	BatchCreate(ctx context.Context, entities []*T) error
@@ -138,6 +142,14 @@ type QueryOptions struct {
 	OrderDesc bool   `json:"order_desc"` // 是否降序
 	FetchAll  bool   `json:"fetch_all"`  // 是否获取所有数据（不分页）
 
+	// 游标（键集）分页：Keyset 为 true 时忽略 Page/OrderBy/FetchAll，按 (created_at, id) 稳定排序，
+	// 方向沿用 OrderDesc；Cursor 为上一页返回的 NextCursor，首页为空
+	Keyset bool   `json:"keyset"`
+	Cursor string `json:"cursor"`
+
+	// IncludeTotal 是否统计总数；nil 时页码分页统计、游标分页不统计（大表上 COUNT(*) 代价高）
+	IncludeTotal *bool `json:"include_total"`
+
 	// 过滤条件
 	Filters map[string]interface{} `json:"filters"` // 字段过滤条件
 	Search  string                 `json:"search"`  // 全文搜索关键词
@@ -238,6 +250,29 @@ func (opts *QueryOptions) WithPreload(associations ...string) *QueryOptions {
 	return opts
 }
 
+// WithCursor 切换为游标分页，cursor 为上一页返回的 NextCursor（首页传空字符串）
+func (opts *QueryOptions) WithCursor(cursor string) *QueryOptions {
+	opts.Keyset = true
+	opts.Cursor = cursor
+
+	return opts
+}
+
+// WithIncludeTotal 显式指定是否统计总数
+func (opts *QueryOptions) WithIncludeTotal(include bool) *QueryOptions {
+	opts.IncludeTotal = &include
+	return opts
+}
+
+// ShouldCount 是否需要统计总数
+func (opts *QueryOptions) ShouldCount() bool {
+	if opts.IncludeTotal != nil {
+		return *opts.IncludeTotal
+	}
+
+	return !opts.Keyset
+}
+
 // WithFetchAll 设置是否获取所有数据（不分页）
 func (opts *QueryOptions) WithFetchAll(fetchAll bool) *QueryOptions {
 	opts.FetchAll = fetchAll
@@ -252,6 +287,12 @@ func (opts *QueryOptions) Validate() error {
 		return ErrConflictingQueryOptions
 	}
 
+	if opts.Keyset {
+		if _, err := DecodeCursor(opts.Cursor); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -293,12 +334,64 @@ func (r *BaseRepositoryImpl[T]) GetByID(ctx context.Context, id string) (*T, err
 }
 
 // Update 更新实体
+// 嵌入 BaseModel 的实体按乐观锁写入：仅当库中版本号与实体版本号一致时更新，成功后版本号加一；
+// 版本号不一致返回 errno.VersionConflictError，记录不存在返回 gorm.ErrRecordNotFound。
+// 实体未携带版本号（非查询所得）时以库中当前版本号为准。
 func (r *BaseRepositoryImpl[T]) Update(ctx context.Context, entity *T) error {
-	if err := r.db.WithContext(ctx).Save(entity).Error; err != nil {
+	versioned, ok := any(entity).(models.Versioned)
+	if !ok {
+		return r.db.WithContext(ctx).Save(entity).Error
+	}
+
+	db := r.db.WithContext(ctx)
+
+	expected := versioned.GetVersion()
+	if expected <= 0 {
+		current, err := r.currentVersion(db, entity)
+		if err != nil {
+			return err
+		}
+
+		expected = current
+	}
+
+	versioned.SetVersion(expected + 1)
+
+	// Select("*") 与 Save 一样写入全部字段（包括零值），主键与创建时间保持不变
+	result := db.Model(entity).
+		Where("version = ?", expected).
+		Select("*").
+		Omit("id", "created_at").
+		Updates(entity)
+	if result.Error != nil {
+		versioned.SetVersion(expected)
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	versioned.SetVersion(expected)
+
+	current, err := r.currentVersion(db, entity)
+	if err != nil {
 		return err
 	}
 
-	return nil
+	return errno.NewVersionConflictError(current)
+}
+
+// currentVersion 查询实体在库中的当前版本号
+func (r *BaseRepositoryImpl[T]) currentVersion(db *gorm.DB, entity *T) (int64, error) {
+	id := reflect.Indirect(reflect.ValueOf(entity)).FieldByName("ID").Interface()
+
+	var latest T
+	if err := db.Select("version").Where("id = ?", id).Take(&latest).Error; err != nil {
+		return 0, err
+	}
+
+	return any(&latest).(models.Versioned).GetVersion(), nil
 }
 
 // Delete 软删除实体
@@ -575,11 +668,11 @@ func (r *BaseRepositoryImpl[T]) Restore(ctx context.Context, id string) error {
 		return gorm.ErrUnsupportedDriver
 	}
 
-	// 使用 Unscoped 查找已删除的记录并恢复
+	// 使用 Unscoped 查找已删除的记录并恢复；UpdateColumn 不触发模型钩子（空模型过不了字段校验）
 	deletedAtCol := r.getDeletedAtColumn()
 	result := r.db.WithContext(ctx).Unscoped().Model(new(T)).
 		Where(fmt.Sprintf("id = ? AND %s IS NOT NULL", deletedAtCol), id).
-		Update(deletedAtCol, nil)
+		UpdateColumn(deletedAtCol, nil)
 
 	if result.Error != nil {
 		return result.Error
@@ -592,6 +685,50 @@ func (r *BaseRepositoryImpl[T]) Restore(ctx context.Context, id string) error {
 	return nil
 }
 
+// GetDeletedByID 获取已软删除的实体（未删除或不存在时返回 gorm.ErrRecordNotFound）
+func (r *BaseRepositoryImpl[T]) GetDeletedByID(ctx context.Context, id string) (*T, error) {
+	if !r.hasSoftDelete() {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	var entity T
+
+	err := r.db.WithContext(ctx).Unscoped().
+		Where(fmt.Sprintf("id = ? AND %s IS NOT NULL", r.getDeletedAtColumn()), id).
+		First(&entity).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity, nil
+}
+
+// FindDeletedBefore 查询删除时间早于 before 的已软删除实体，按删除时间升序，最多 limit 条
+func (r *BaseRepositoryImpl[T]) FindDeletedBefore(
+	ctx context.Context,
+	before time.Time,
+	limit int,
+) ([]*T, error) {
+	if !r.hasSoftDelete() {
+		return make([]*T, 0), nil
+	}
+
+	deletedAtCol := r.getDeletedAtColumn()
+
+	var entities []*T
+
+	err := r.db.WithContext(ctx).Unscoped().
+		Where(fmt.Sprintf("%s IS NOT NULL AND %s < ?", deletedAtCol, deletedAtCol), before).
+		Order(deletedAtCol).
+		Limit(limit).
+		Find(&entities).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return entities, nil
+}
+
 // BatchSoftDelete 批量软删除实体
 func (r *BaseRepositoryImpl[T]) BatchSoftDelete(ctx context.Context, ids []string) error {
 	if len(ids) == 0 {
@@ -638,7 +775,7 @@ func (r *BaseRepositoryImpl[T]) BatchRestore(ctx context.Context, ids []string)
 	deletedAtCol := r.getDeletedAtColumn()
 	result := r.db.WithContext(ctx).Unscoped().Model(new(T)).
 		Where(fmt.Sprintf("id IN ? AND %s IS NOT NULL", deletedAtCol), ids).
-		Update(deletedAtCol, nil)
+		UpdateColumn(deletedAtCol, nil)
 
 	if result.Error != nil {
 		return result.Error
@@ -2,17 +2,24 @@ package dal
 
 import (
 	"context"
+	"time"
 
 	"gorm.io/gorm"
 
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/accessreview"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/assignment"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/auditlog"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/definition"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/department"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/grantrequest"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/logo"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/membership"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/menu"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/mfa"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/organization"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/passwordhistory"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/passwordpolicy"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/passwordreset"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/rolemenu"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/user"
 )
@@ -49,12 +56,33 @@ type DAL interface {
 	// UserRoleAssignment 用户角色分配仓储
 	UserRoleAssignment() assignment.UserRoleAssignmentRepository
 
+	// RoleGrantRequest 敏感角色授权申请仓储
+	RoleGrantRequest() grantrequest.RoleGrantRequestRepository
+
+	// AccessReviewCampaign 访问复核活动仓储
+	AccessReviewCampaign() accessreview.AccessReviewCampaignRepository
+
+	// AccessReviewItem 访问复核条目仓储
+	AccessReviewItem() accessreview.AccessReviewItemRepository
+
 	// RoleMenuPermission 角色菜单权限仓储
 	RoleMenuPermission() rolemenu.RoleMenuPermissionRepository
 
 	// AuditLog 审计日志仓储
 	AuditLog() auditlog.AuditLogRepository
 
+	// MFA 多因素认证仓储
+	MFA() mfa.MFARepository
+
+	// PasswordResetToken 找回密码令牌仓储
+	PasswordResetToken() passwordreset.PasswordResetTokenRepository
+
+	// PasswordHistory 历史密码仓储
+	PasswordHistory() passwordhistory.PasswordHistoryRepository
+
+	// PasswordPolicy 组织密码策略仓储
+	PasswordPolicy() passwordpolicy.PasswordPolicyRepository
+
 	// ============================================================================
 	// 事务管理
 	// ============================================================================
@@ -80,6 +108,10 @@ type DAL interface {
 
 	// WithDB 使用指定数据库连接创建新的DAL实例
 	WithDB(db *gorm.DB) DAL
+
+	// WithNow 返回以 now 作为当前时间的DAL实例（沿用当前连接或事务）
+	// 软删除与自动时间戳均取 now，用于让一组级联写入共享同一时间戳
+	WithNow(now time.Time) DAL
 }
 
 // NewDAL 创建DAL实例
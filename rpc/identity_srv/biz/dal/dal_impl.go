@@ -2,18 +2,25 @@ package dal
 
 import (
 	"context"
+	"time"
 
 	"gorm.io/gorm"
 
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/accessreview"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/assignment"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/auditlog"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/definition"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/department"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/grantrequest"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/logo"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/membership"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/menu"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/mfa"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/organization"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/passwordhistory"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/passwordpolicy"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/passwordreset"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/rolemenu"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/user"
 )
@@ -28,16 +35,23 @@ type DALImpl struct {
 	txManager base.TransactionManager
 
 	// 各实体仓储
-	userProfileRepo        user.UserProfileRepository
-	userMembershipRepo     membership.UserMembershipRepository
-	organizationRepo       organization.OrganizationRepository
-	departmentRepo         department.DepartmentRepository
-	logoRepo               logo.LogoRepository
-	menuRepo               menu.MenuRepository
-	roleDefinitionRepo     definition.RoleDefinitionRepository
-	userRoleAssignmentRepo assignment.UserRoleAssignmentRepository
-	roleMenuPermissionRepo rolemenu.RoleMenuPermissionRepository
-	auditLogRepo           auditlog.AuditLogRepository
+	userProfileRepo          user.UserProfileRepository
+	userMembershipRepo       membership.UserMembershipRepository
+	organizationRepo         organization.OrganizationRepository
+	departmentRepo           department.DepartmentRepository
+	logoRepo                 logo.LogoRepository
+	menuRepo                 menu.MenuRepository
+	roleDefinitionRepo       definition.RoleDefinitionRepository
+	userRoleAssignmentRepo   assignment.UserRoleAssignmentRepository
+	roleGrantRequestRepo     grantrequest.RoleGrantRequestRepository
+	accessReviewCampaignRepo accessreview.AccessReviewCampaignRepository
+	accessReviewItemRepo     accessreview.AccessReviewItemRepository
+	roleMenuPermissionRepo   rolemenu.RoleMenuPermissionRepository
+	auditLogRepo             auditlog.AuditLogRepository
+	mfaRepo                  mfa.MFARepository
+	passwordResetTokenRepo   passwordreset.PasswordResetTokenRepository
+	passwordHistoryRepo      passwordhistory.PasswordHistoryRepository
+	passwordPolicyRepo       passwordpolicy.PasswordPolicyRepository
 
 	// 事务状态
 	isTransaction bool
@@ -46,19 +60,26 @@ type DALImpl struct {
 // newDALImpl 创建DAL实现实例（内部使用）
 func newDALImpl(db *gorm.DB) DAL {
 	return &DALImpl{
-		db:                     db,
-		txManager:              base.NewTransactionManager(db),
-		userProfileRepo:        user.NewUserProfileRepository(db),
-		userMembershipRepo:     membership.NewUserMembershipRepository(db),
-		organizationRepo:       organization.NewOrganizationRepository(db),
-		departmentRepo:         department.NewDepartmentRepository(db),
-		logoRepo:               logo.NewLogoRepository(db),
-		menuRepo:               menu.NewMenuRepository(db),
-		roleDefinitionRepo:     definition.NewRoleDefinitionRepository(db),
-		userRoleAssignmentRepo: assignment.NewUserRoleAssignmentRepository(db),
-		roleMenuPermissionRepo: rolemenu.NewRoleMenuPermissionRepository(db),
-		auditLogRepo:           auditlog.NewAuditLogRepository(db),
-		isTransaction:          false,
+		db:                       db,
+		txManager:                base.NewTransactionManager(db),
+		userProfileRepo:          user.NewUserProfileRepository(db),
+		userMembershipRepo:       membership.NewUserMembershipRepository(db),
+		organizationRepo:         organization.NewOrganizationRepository(db),
+		departmentRepo:           department.NewDepartmentRepository(db),
+		logoRepo:                 logo.NewLogoRepository(db),
+		menuRepo:                 menu.NewMenuRepository(db),
+		roleDefinitionRepo:       definition.NewRoleDefinitionRepository(db),
+		userRoleAssignmentRepo:   assignment.NewUserRoleAssignmentRepository(db),
+		roleGrantRequestRepo:     grantrequest.NewRoleGrantRequestRepository(db),
+		accessReviewCampaignRepo: accessreview.NewAccessReviewCampaignRepository(db),
+		accessReviewItemRepo:     accessreview.NewAccessReviewItemRepository(db),
+		roleMenuPermissionRepo:   rolemenu.NewRoleMenuPermissionRepository(db),
+		auditLogRepo:             auditlog.NewAuditLogRepository(db),
+		mfaRepo:                  mfa.NewMFARepository(db),
+		passwordResetTokenRepo:   passwordreset.NewPasswordResetTokenRepository(db),
+		passwordHistoryRepo:      passwordhistory.NewPasswordHistoryRepository(db),
+		passwordPolicyRepo:       passwordpolicy.NewPasswordPolicyRepository(db),
+		isTransaction:            false,
 	}
 }
 
@@ -106,6 +127,21 @@ func (dal *DALImpl) UserRoleAssignment() assignment.UserRoleAssignmentRepository
 	return dal.userRoleAssignmentRepo
 }
 
+// RoleGrantRequest 获取敏感角色授权申请仓储
+func (dal *DALImpl) RoleGrantRequest() grantrequest.RoleGrantRequestRepository {
+	return dal.roleGrantRequestRepo
+}
+
+// AccessReviewCampaign 获取访问复核活动仓储
+func (dal *DALImpl) AccessReviewCampaign() accessreview.AccessReviewCampaignRepository {
+	return dal.accessReviewCampaignRepo
+}
+
+// AccessReviewItem 获取访问复核条目仓储
+func (dal *DALImpl) AccessReviewItem() accessreview.AccessReviewItemRepository {
+	return dal.accessReviewItemRepo
+}
+
 // RoleMenuPermission 获取角色菜单权限仓储
 func (dal *DALImpl) RoleMenuPermission() rolemenu.RoleMenuPermissionRepository {
 	return dal.roleMenuPermissionRepo
@@ -116,6 +152,26 @@ func (dal *DALImpl) AuditLog() auditlog.AuditLogRepository {
 	return dal.auditLogRepo
 }
 
+// MFA 获取多因素认证仓储
+func (dal *DALImpl) MFA() mfa.MFARepository {
+	return dal.mfaRepo
+}
+
+// PasswordResetToken 获取找回密码令牌仓储
+func (dal *DALImpl) PasswordResetToken() passwordreset.PasswordResetTokenRepository {
+	return dal.passwordResetTokenRepo
+}
+
+// PasswordHistory 获取历史密码仓储
+func (dal *DALImpl) PasswordHistory() passwordhistory.PasswordHistoryRepository {
+	return dal.passwordHistoryRepo
+}
+
+// PasswordPolicy 获取组织密码策略仓储
+func (dal *DALImpl) PasswordPolicy() passwordpolicy.PasswordPolicyRepository {
+	return dal.passwordPolicyRepo
+}
+
 // ============================================================================
 // 事务管理实现
 // ============================================================================
@@ -178,22 +234,39 @@ func (dal *DALImpl) DB() *gorm.DB {
 // WithDB 使用指定数据库连接创建新的DAL实例
 func (dal *DALImpl) WithDB(db *gorm.DB) DAL {
 	return &DALImpl{
-		db:                     db,
-		txManager:              base.NewTransactionManager(db),
-		userProfileRepo:        user.NewUserProfileRepository(db),
-		userMembershipRepo:     membership.NewUserMembershipRepository(db),
-		organizationRepo:       organization.NewOrganizationRepository(db),
-		departmentRepo:         department.NewDepartmentRepository(db),
-		logoRepo:               logo.NewLogoRepository(db),
-		menuRepo:               menu.NewMenuRepository(db),
-		roleDefinitionRepo:     definition.NewRoleDefinitionRepository(db),
-		userRoleAssignmentRepo: assignment.NewUserRoleAssignmentRepository(db),
-		roleMenuPermissionRepo: rolemenu.NewRoleMenuPermissionRepository(db),
-		auditLogRepo:           auditlog.NewAuditLogRepository(db),
-		isTransaction:          dal.isTransaction,
+		db:                       db,
+		txManager:                base.NewTransactionManager(db),
+		userProfileRepo:          user.NewUserProfileRepository(db),
+		userMembershipRepo:       membership.NewUserMembershipRepository(db),
+		organizationRepo:         organization.NewOrganizationRepository(db),
+		departmentRepo:           department.NewDepartmentRepository(db),
+		logoRepo:                 logo.NewLogoRepository(db),
+		menuRepo:                 menu.NewMenuRepository(db),
+		roleDefinitionRepo:       definition.NewRoleDefinitionRepository(db),
+		userRoleAssignmentRepo:   assignment.NewUserRoleAssignmentRepository(db),
+		roleGrantRequestRepo:     grantrequest.NewRoleGrantRequestRepository(db),
+		accessReviewCampaignRepo: accessreview.NewAccessReviewCampaignRepository(db),
+		accessReviewItemRepo:     accessreview.NewAccessReviewItemRepository(db),
+		roleMenuPermissionRepo:   rolemenu.NewRoleMenuPermissionRepository(db),
+		auditLogRepo:             auditlog.NewAuditLogRepository(db),
+		mfaRepo:                  mfa.NewMFARepository(db),
+		passwordResetTokenRepo:   passwordreset.NewPasswordResetTokenRepository(db),
+		passwordHistoryRepo:      passwordhistory.NewPasswordHistoryRepository(db),
+		passwordPolicyRepo:       passwordpolicy.NewPasswordPolicyRepository(db),
+		isTransaction:            dal.isTransaction,
 	}
 }
 
+// WithNow 返回以 now 作为当前时间的DAL实例
+func (dal *DALImpl) WithNow(now time.Time) DAL {
+	nowDAL := dal.WithDB(dal.db.Session(&gorm.Session{
+		NowFunc: func() time.Time { return now },
+	})).(*DALImpl)
+	nowDAL.isTransaction = dal.isTransaction
+
+	return nowDAL
+}
+
 // NewDALImpl 创建DAL实现实例
 func NewDALImpl(db *gorm.DB) DAL {
 	return newDALImpl(db)
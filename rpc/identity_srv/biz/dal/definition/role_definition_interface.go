@@ -37,6 +37,12 @@ type RoleDefinitionRepository interface {
 	// 由于角色名称具有唯一索引，此方法返回单个结果或 nil
 	FindByName(ctx context.Context, name string) (*models.RoleDefinition, error)
 
+	// FindByRoleCodes 根据 Casbin 角色编码批量查询角色定义，不存在的编码直接忽略
+	FindByRoleCodes(ctx context.Context, roleCodes []string) ([]*models.RoleDefinition, error)
+
+	// FindConstrained 查询配置了职责分离约束（互斥角色组或最大分配人数）的角色，按角色编码排序
+	FindConstrained(ctx context.Context) ([]*models.RoleDefinition, error)
+
 	// CheckNameExists 检查指定角色名称是否已存在
 	// 用于创建角色前的唯一性验证，避免数据库约束冲突
 	CheckNameExists(ctx context.Context, name string) (bool, error)
@@ -49,6 +49,41 @@ func (r *RoleDefinitionRepositoryImpl) FindByName(
 	return &role, nil
 }
 
+// FindByRoleCodes 根据 Casbin 角色编码批量查询角色定义
+func (r *RoleDefinitionRepositoryImpl) FindByRoleCodes(
+	ctx context.Context,
+	roleCodes []string,
+) ([]*models.RoleDefinition, error) {
+	if len(roleCodes) == 0 {
+		return nil, nil
+	}
+
+	var roles []*models.RoleDefinition
+
+	if err := r.db.WithContext(ctx).Where("role_code IN ?", roleCodes).Find(&roles).Error; err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+// FindConstrained 查询配置了职责分离约束的角色
+func (r *RoleDefinitionRepositoryImpl) FindConstrained(
+	ctx context.Context,
+) ([]*models.RoleDefinition, error) {
+	var roles []*models.RoleDefinition
+
+	err := r.db.WithContext(ctx).
+		Where("max_assignments > 0 OR jsonb_array_length(exclusive_groups) > 0").
+		Order("role_code ASC").
+		Find(&roles).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
 // CheckNameExists 检查指定角色名称是否已存在
 func (r *RoleDefinitionRepositoryImpl) CheckNameExists(
 	ctx context.Context,
@@ -84,6 +84,9 @@ type DepartmentRepository interface {
 	// BatchDeleteByOrganization 批量删除指定组织的所有部门
 	BatchDeleteByOrganization(ctx context.Context, organizationID string) error
 
+	// PurgeDeletedByOrganization 物理删除指定组织下已软删除的部门
+	PurgeDeletedByOrganization(ctx context.Context, organizationID string) error
+
 	// ============================================================================
 	// 统计分析
 	// ============================================================================
@@ -117,10 +120,12 @@ type DepartmentRepository interface {
 // DepartmentQueryConditions 部门查询条件
 // 支持多条件组合查询，提供灵活的查询能力
 type DepartmentQueryConditions struct {
-	Name           *string // 部门名称（模糊匹配）
-	OrganizationID *string // 组织ID
-	DepartmentType *string // 部门类型
-	EquipmentID    *string // 设备ID（需JOIN department_equipment表）
+	ID             *string               // 部门ID（精确匹配）
+	Name           *string               // 部门名称（模糊匹配）
+	OrganizationID *string               // 组织ID
+	DepartmentType *string               // 部门类型
+	EquipmentID    *string               // 设备ID（需JOIN department_equipment表）
+	Scope          *base.DataScopeFilter // 行级数据范围（nil 不限制）
 	Page           *base.QueryOptions
 }
 
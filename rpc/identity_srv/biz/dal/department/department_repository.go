@@ -345,6 +345,20 @@ func (r *DepartmentRepositoryImpl) BatchDeleteByOrganization(
 	return nil
 }
 
+// PurgeDeletedByOrganization 物理删除指定组织下已软删除的部门
+func (r *DepartmentRepositoryImpl) PurgeDeletedByOrganization(
+	ctx context.Context,
+	organizationID string,
+) error {
+	if err := r.db.WithContext(ctx).Unscoped().
+		Where("organization_id = ? AND deleted_at IS NOT NULL", organizationID).
+		Delete(&models.Department{}).Error; err != nil {
+		return fmt.Errorf("清除组织已删除部门失败: %w", err)
+	}
+
+	return nil
+}
+
 // ============================================================================
 // 统计分析实现
 // ============================================================================
@@ -492,9 +506,9 @@ func (r *DepartmentRepositoryImpl) FindWithConditions(
 	query := r.db.WithContext(ctx).Model(&models.Department{})
 	query = r.applyFilterConditions(query, conditions, opts)
 
-	// 处理搜索条件
+	// 处理搜索条件（拼音感知，见 base.ApplyTokenSearch）
 	if opts.Search != "" {
-		query = r.applySearchConditions(query, opts.Search)
+		query = base.ApplyTokenSearch(query, "departments", opts.Search)
 	}
 
 	// 处理预加载
@@ -509,16 +523,20 @@ func (r *DepartmentRepositoryImpl) FindWithConditions(
 	countQuery = r.applyFilterConditions(countQuery, conditions, opts)
 
 	if opts.Search != "" {
-		countQuery = r.applySearchConditions(countQuery, opts.Search)
+		countQuery = base.ApplyTokenSearch(countQuery, "departments", opts.Search)
 	}
 
 	if err := countQuery.Count(&total).Error; err != nil {
 		return nil, nil, fmt.Errorf("统计部门总数失败: %w", err)
 	}
 
-	// 处理排序
+	// 处理排序：搜索时匹配质量优先
 	orderClause := r.buildOrderClause(opts)
-	query = query.Order(orderClause)
+	if opts.Search != "" {
+		query = query.Order(base.OrderByRank(orderClause, base.TokenSearchRank("departments", opts.Search)))
+	} else {
+		query = query.Order(orderClause)
+	}
 
 	// 分页查询
 	var departments []*models.Department
@@ -557,6 +575,10 @@ func (r *DepartmentRepositoryImpl) applyFilterConditions(
 
 	// 处理精确匹配条件
 	if conditions != nil {
+		if conditions.ID != nil {
+			query = query.Where("id = ?", *conditions.ID)
+		}
+
 		if conditions.OrganizationID != nil {
 			query = query.Where("organization_id = ?", *conditions.OrganizationID)
 		}
@@ -569,29 +591,50 @@ func (r *DepartmentRepositoryImpl) applyFilterConditions(
 		if conditions.Name != nil && *conditions.Name != "" {
 			query = query.Where("name LIKE ?", "%"+*conditions.Name+"%")
 		}
+
+		if !conditions.Scope.Unrestricted() {
+			query = r.applyDataScope(query, conditions.Scope)
+		}
 	}
 
 	return query
 }
 
-// ============================================================================
-// 私有辅助方法
-// ============================================================================
-
-// applySearchConditions 应用搜索条件
-func (r *DepartmentRepositoryImpl) applySearchConditions(
+// applyDataScope 把数据范围翻译为部门的行级条件
+//
+// 本人范围只能看到调用方有活跃成员关系的部门；组织范围按 organization_id 匹配。
+func (r *DepartmentRepositoryImpl) applyDataScope(
 	query *gorm.DB,
-	searchTerm string,
+	scope *base.DataScopeFilter,
 ) *gorm.DB {
-	searchPattern := "%" + searchTerm + "%"
+	switch scope.Level {
+	case models.DataScopeSelf:
+		return query.Where(
+			"id IN (SELECT department_id FROM user_memberships "+
+				"WHERE user_id = ? AND status = ? AND deleted_at IS NULL)",
+			scope.UserID, models.MembershipStatusActive,
+		)
+	case models.DataScopeDept:
+		if len(scope.DepartmentIDs) == 0 {
+			return base.DenyAll(query)
+		}
 
-	// ⚠️ 重要：使用括号包裹 OR 条件，避免与其他 AND 条件产生优先级问题
-	return query.Where(
-		"(name LIKE ? OR department_type LIKE ?)",
-		searchPattern, searchPattern,
-	)
+		return query.Where("id IN ?", scope.DepartmentIDs)
+	case models.DataScopeOrg:
+		if len(scope.OrganizationIDs) == 0 {
+			return base.DenyAll(query)
+		}
+
+		return query.Where("organization_id IN ?", scope.OrganizationIDs)
+	default:
+		return base.DenyAll(query)
+	}
 }
 
+// ============================================================================
+// 私有辅助方法
+// ============================================================================
+
 // buildOrderClause 构建排序子句
 func (r *DepartmentRepositoryImpl) buildOrderClause(opts *base.QueryOptions) string {
 	orderBy := "created_at"
@@ -0,0 +1,53 @@
+package grantrequest
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+)
+
+// RoleGrantRequestQueryConditions 角色授权申请查询条件
+// 对应 IDL 中的 ListRoleGrantRequestsRequest
+type RoleGrantRequestQueryConditions struct {
+	Status      *models.RoleGrantRequestStatus `json:"status,omitempty"`
+	UserID      *string                        `json:"user_id,omitempty"`
+	RoleID      *string                        `json:"role_id,omitempty"`
+	RequestedBy *string                        `json:"requested_by,omitempty"`
+
+	// Page 分页查询选项
+	Page *base.QueryOptions `json:"page,omitempty"`
+}
+
+// RoleGrantDecision 待审批申请的状态流转结果
+type RoleGrantDecision struct {
+	Status       models.RoleGrantRequestStatus
+	DecidedBy    *uuid.UUID
+	DecidedAt    int64
+	Comment      string
+	AssignmentID *uuid.UUID
+}
+
+// RoleGrantRequestRepository 敏感角色授权申请仓储接口
+type RoleGrantRequestRepository interface {
+	// 嵌入基础仓储接口
+	base.BaseRepository[models.RoleGrantRequest]
+
+	// FindWithConditions 按条件分页查询申请，默认按创建时间倒序
+	FindWithConditions(
+		ctx context.Context,
+		conditions *RoleGrantRequestQueryConditions,
+	) ([]*models.RoleGrantRequest, *models.PageResult, error)
+
+	// ExistsPending 用户在指定组织范围（精确匹配，空字符串为全局）下是否已有该角色的待审批申请
+	ExistsPending(ctx context.Context, userID, roleID, organizationID string) (bool, error)
+
+	// Decide 把仍处于待审批状态的申请流转为 decision.Status，返回是否流转成功；
+	// 返回 false 表示申请已被其他审批人处理或已过期（并发审批时只有一方成功）
+	Decide(ctx context.Context, id string, decision RoleGrantDecision) (bool, error)
+
+	// FindExpiredPending 查询审批截止时间已过但仍为待审批的申请，按截止时间升序，最多 limit 条
+	FindExpiredPending(ctx context.Context, nowMillis int64, limit int) ([]*models.RoleGrantRequest, error)
+}
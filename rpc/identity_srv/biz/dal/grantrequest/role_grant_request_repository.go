@@ -0,0 +1,127 @@
+package grantrequest
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+)
+
+// RoleGrantRequestRepositoryImpl 敏感角色授权申请仓储实现
+type RoleGrantRequestRepositoryImpl struct {
+	base.BaseRepository[models.RoleGrantRequest]
+	db *gorm.DB
+}
+
+// NewRoleGrantRequestRepository 创建角色授权申请仓储实例
+func NewRoleGrantRequestRepository(db *gorm.DB) RoleGrantRequestRepository {
+	return &RoleGrantRequestRepositoryImpl{
+		BaseRepository: base.NewBaseRepository[models.RoleGrantRequest](db),
+		db:             db,
+	}
+}
+
+// FindWithConditions 按条件分页查询申请
+func (r *RoleGrantRequestRepositoryImpl) FindWithConditions(
+	ctx context.Context,
+	conditions *RoleGrantRequestQueryConditions,
+) ([]*models.RoleGrantRequest, *models.PageResult, error) {
+	opts := base.NewQueryOptions()
+	if conditions != nil && conditions.Page != nil {
+		opts = opts.WithPage(conditions.Page.Page, conditions.Page.PageSize).
+			WithOrder(conditions.Page.OrderBy, conditions.Page.OrderDesc)
+		opts.Keyset = conditions.Page.Keyset
+		opts.Cursor = conditions.Page.Cursor
+		opts.IncludeTotal = conditions.Page.IncludeTotal
+	}
+
+	baseRepo := r.BaseRepository.(*base.BaseRepositoryImpl[models.RoleGrantRequest])
+
+	qb := baseRepo.NewQueryBuilder(ctx).WithSoftDelete(opts)
+
+	if conditions != nil {
+		qb = qb.WhereEqual("user_id", conditions.UserID).
+			WhereEqual("role_id", conditions.RoleID).
+			WhereEqual("requested_by", conditions.RequestedBy)
+
+		if conditions.Status != nil {
+			status := *conditions.Status
+
+			qb = qb.WhereCustom(func(db *gorm.DB) *gorm.DB {
+				return db.Where("status = ?", status)
+			})
+		}
+	}
+
+	return qb.WithOrder(opts).FindWithPagination(opts)
+}
+
+// ExistsPending 是否已有相同范围的待审批申请
+func (r *RoleGrantRequestRepositoryImpl) ExistsPending(
+	ctx context.Context,
+	userID, roleID, organizationID string,
+) (bool, error) {
+	query := r.db.WithContext(ctx).
+		Model(&models.RoleGrantRequest{}).
+		Where("user_id = ? AND role_id = ? AND status = ?", userID, roleID, models.RoleGrantRequestStatusPending)
+
+	if organizationID == "" {
+		query = query.Where("organization_id IS NULL")
+	} else {
+		query = query.Where("organization_id = ?", organizationID)
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return false, fmt.Errorf("查询待审批申请失败: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// Decide 条件更新：仅当申请仍为待审批时写入审批结果
+func (r *RoleGrantRequestRepositoryImpl) Decide(
+	ctx context.Context,
+	id string,
+	decision RoleGrantDecision,
+) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.RoleGrantRequest{}).
+		Where("id = ? AND status = ?", id, models.RoleGrantRequestStatusPending).
+		Updates(map[string]interface{}{
+			"status":           decision.Status,
+			"decided_by":       decision.DecidedBy,
+			"decided_at":       decision.DecidedAt,
+			"decision_comment": decision.Comment,
+			"assignment_id":    decision.AssignmentID,
+			"version":          gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("更新角色授权申请状态失败: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// FindExpiredPending 查询已过审批期限的待审批申请
+func (r *RoleGrantRequestRepositoryImpl) FindExpiredPending(
+	ctx context.Context,
+	nowMillis int64,
+	limit int,
+) ([]*models.RoleGrantRequest, error) {
+	var requests []*models.RoleGrantRequest
+
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND expires_at <= ?", models.RoleGrantRequestStatusPending, nowMillis).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&requests).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询过期角色授权申请失败: %w", err)
+	}
+
+	return requests, nil
+}
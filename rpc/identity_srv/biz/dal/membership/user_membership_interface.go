@@ -2,6 +2,7 @@ package membership
 
 import (
 	"context"
+	"time"
 
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
@@ -39,6 +40,13 @@ type UserMembershipRepository interface {
 		userIDs []string,
 	) (map[string]*models.UserMembership, error)
 
+	// GetActiveMembershipsByUserIDs 批量获取多个用户的活跃成员关系，主成员关系排在最前
+	// 返回: map[userID][]*UserMembership，避免 N+1 查询问题
+	GetActiveMembershipsByUserIDs(
+		ctx context.Context,
+		userIDs []string,
+	) (map[string][]*models.UserMembership, error)
+
 	// CountByDepartmentID 统计部门的成员数量
 	CountByDepartmentID(ctx context.Context, departmentID string) (int64, error)
 
@@ -56,6 +64,9 @@ type UserMembershipRepository interface {
 	// GetUserOrganizations 获取用户所属的所有组织ID列表
 	GetUserOrganizations(ctx context.Context, userID string) ([]string, error)
 
+	// GetUserDepartments 获取用户有活跃成员关系的部门ID列表，organizationID 非空时限定在该组织内
+	GetUserDepartments(ctx context.Context, userID, organizationID string) ([]string, error)
+
 	// GetOrganizationUsers 获取组织的所有用户ID列表
 	GetOrganizationUsers(ctx context.Context, organizationID string) ([]string, error)
 
@@ -95,6 +106,22 @@ type UserMembershipRepository interface {
 	// BatchDeleteByOrganization 批量删除组织的所有成员关系
 	BatchDeleteByOrganization(ctx context.Context, organizationID string) error
 
+	// ============================================================================
+	// 回收站
+	// ============================================================================
+
+	// RestoreByUser 恢复删除用户时（deletedAt 时刻）级联删除的成员关系，返回恢复数量
+	RestoreByUser(ctx context.Context, userID string, deletedAt time.Time) (int64, error)
+
+	// PurgeByUser 物理删除用户的全部成员关系（含已软删除记录）
+	PurgeByUser(ctx context.Context, userID string) error
+
+	// PurgeDeletedByOrganization 物理删除组织下已软删除的成员关系
+	PurgeDeletedByOrganization(ctx context.Context, organizationID string) error
+
+	// PurgeDeletedByDepartment 物理删除部门下已软删除的成员关系
+	PurgeDeletedByDepartment(ctx context.Context, departmentID string) error
+
 	// ============================================================================
 	// 数据完整性检查
 	// ============================================================================
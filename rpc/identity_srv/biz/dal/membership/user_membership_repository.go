@@ -3,6 +3,7 @@ package membership
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -197,6 +198,36 @@ func (r *UserMembershipRepositoryImpl) GetPrimaryMembershipsByUserIDs(
 	return result, nil
 }
 
+// GetActiveMembershipsByUserIDs 批量获取多个用户的活跃成员关系，主成员关系排在最前
+func (r *UserMembershipRepositoryImpl) GetActiveMembershipsByUserIDs(
+	ctx context.Context,
+	userIDs []string,
+) (map[string][]*models.UserMembership, error) {
+	// 空列表直接返回空map
+	if len(userIDs) == 0 {
+		return make(map[string][]*models.UserMembership), nil
+	}
+
+	var memberships []*models.UserMembership
+
+	err := r.db.WithContext(ctx).
+		Where("user_id IN ? AND status = ?", userIDs, models.MembershipStatusActive).
+		Order("is_primary DESC, created_at ASC").
+		Find(&memberships).Error
+	if err != nil {
+		return nil, fmt.Errorf("批量查询用户成员关系失败: %w", err)
+	}
+
+	// 转换为 map[userID][]*UserMembership，保持查询顺序
+	result := make(map[string][]*models.UserMembership, len(userIDs))
+	for _, membership := range memberships {
+		userID := membership.UserID.String()
+		result[userID] = append(result[userID], membership)
+	}
+
+	return result, nil
+}
+
 // ============================================================================
 // 成员关系列表查询实现
 // ============================================================================
@@ -292,6 +323,29 @@ func (r *UserMembershipRepositoryImpl) GetUserOrganizations(
 	return organizationIDs, nil
 }
 
+// GetUserDepartments 获取用户有活跃成员关系的部门ID列表
+func (r *UserMembershipRepositoryImpl) GetUserDepartments(
+	ctx context.Context,
+	userID, organizationID string,
+) ([]string, error) {
+	var departmentIDs []string
+
+	query := r.db.WithContext(ctx).
+		Model(&models.UserMembership{}).
+		Distinct("department_id").
+		Where("user_id = ? AND status = ? AND department_id IS NOT NULL", userID, models.MembershipStatusActive)
+
+	if organizationID != "" {
+		query = query.Where("organization_id = ?", organizationID)
+	}
+
+	if err := query.Find(&departmentIDs).Error; err != nil {
+		return nil, fmt.Errorf("获取用户部门列表失败: %w", err)
+	}
+
+	return departmentIDs, nil
+}
+
 // GetOrganizationUsers 获取组织的所有用户ID列表
 func (r *UserMembershipRepositoryImpl) GetOrganizationUsers(
 	ctx context.Context,
@@ -432,6 +486,67 @@ func (r *UserMembershipRepositoryImpl) BatchDeleteByOrganization(
 	return nil
 }
 
+// ============================================================================
+// 回收站实现
+// ============================================================================
+
+// RestoreByUser 恢复删除用户时级联删除的成员关系
+// 删除用户时成员关系与用户档案使用同一删除时间戳，据此区分此前单独移除的成员关系
+func (r *UserMembershipRepositoryImpl) RestoreByUser(
+	ctx context.Context,
+	userID string,
+	deletedAt time.Time,
+) (int64, error) {
+	result := r.db.WithContext(ctx).Unscoped().
+		Model(&models.UserMembership{}).
+		Where("user_id = ? AND deleted_at = ?", userID, deletedAt).
+		UpdateColumn("deleted_at", nil)
+	if result.Error != nil {
+		return 0, fmt.Errorf("恢复用户成员关系失败: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+// PurgeByUser 物理删除用户的全部成员关系
+func (r *UserMembershipRepositoryImpl) PurgeByUser(ctx context.Context, userID string) error {
+	if err := r.db.WithContext(ctx).Unscoped().
+		Where("user_id = ?", userID).
+		Delete(&models.UserMembership{}).Error; err != nil {
+		return fmt.Errorf("清除用户成员关系失败: %w", err)
+	}
+
+	return nil
+}
+
+// PurgeDeletedByOrganization 物理删除组织下已软删除的成员关系
+func (r *UserMembershipRepositoryImpl) PurgeDeletedByOrganization(
+	ctx context.Context,
+	organizationID string,
+) error {
+	if err := r.db.WithContext(ctx).Unscoped().
+		Where("organization_id = ? AND deleted_at IS NOT NULL", organizationID).
+		Delete(&models.UserMembership{}).Error; err != nil {
+		return fmt.Errorf("清除组织成员关系失败: %w", err)
+	}
+
+	return nil
+}
+
+// PurgeDeletedByDepartment 物理删除部门下已软删除的成员关系
+func (r *UserMembershipRepositoryImpl) PurgeDeletedByDepartment(
+	ctx context.Context,
+	departmentID string,
+) error {
+	if err := r.db.WithContext(ctx).Unscoped().
+		Where("department_id = ? AND deleted_at IS NOT NULL", departmentID).
+		Delete(&models.UserMembership{}).Error; err != nil {
+		return fmt.Errorf("清除部门成员关系失败: %w", err)
+	}
+
+	return nil
+}
+
 // ============================================================================
 // 数据完整性检查实现
 // ============================================================================
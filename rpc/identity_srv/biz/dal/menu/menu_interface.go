@@ -27,6 +27,12 @@ type MenuRepository interface {
 	// GetLatestMenuTree retrieves the full menu tree for the most recent version of a product line.
 	GetLatestMenuTree(ctx context.Context, productLine string) ([]*models.Menu, error)
 
+	// ListVersions 列出指定产品线的全部版本摘要，按版本号倒序
+	ListVersions(ctx context.Context, productLine string) ([]*models.MenuVersionSummary, error)
+
+	// GetMenusByVersion 获取指定版本的全部节点（平铺列表，含 API 路径），版本不存在时返回空列表
+	GetMenusByVersion(ctx context.Context, productLine string, version int) ([]*models.Menu, error)
+
 	// GetBySemanticID 根据语义ID和版本查询菜单
 	// productLine: 产品线标识
 	// semanticID: 语义化菜单ID (来自menu.yaml)
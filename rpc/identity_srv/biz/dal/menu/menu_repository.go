@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
@@ -35,11 +36,21 @@ func NewMenuRepository(db *gorm.DB) MenuRepository {
 func (r *menuRepository) CreateMenuTree(ctx context.Context, menus []*models.Menu) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// Using CreateInBatches for efficient bulk insertion.
-		if err := tx.CreateInBatches(menus, 100).Error; err != nil {
+		// 关联的 API 路径在菜单全部写入后单独批量插入
+		if err := tx.Omit(clause.Associations).CreateInBatches(menus, 100).Error; err != nil {
 			return err
 		}
 
-		return nil
+		var apiPaths []*models.MenuApiPath
+		for _, menu := range menus {
+			apiPaths = append(apiPaths, menu.ApiPaths...)
+		}
+
+		if len(apiPaths) == 0 {
+			return nil
+		}
+
+		return tx.CreateInBatches(apiPaths, 500).Error
 	})
 }
 
@@ -103,10 +114,49 @@ func (r *menuRepository) GetLatestMenuTree(ctx context.Context, productLine stri
 	}
 
 	// Step 2: Fetch all nodes for the latest version.
+	menus, err := r.GetMenusByVersion(ctx, productLine, maxVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 3: Build the tree from the flat list.
+	return BuildMenuTree(menus), nil
+}
+
+// ListVersions 列出指定产品线的全部版本摘要，按版本号倒序
+func (r *menuRepository) ListVersions(ctx context.Context, productLine string) ([]*models.MenuVersionSummary, error) {
+	var versions []*models.MenuVersionSummary
+
+	// 同一版本的所有记录由一次上传写入，内容哈希与创建者一致
+	err := r.db.WithContext(ctx).
+		Model(&models.Menu{}).
+		Select("version, content_hash, COUNT(*) AS node_count, "+
+			"MIN(created_at) AS created_at, MAX(created_by::text) AS created_by").
+		Where("product_line = ?", productLine).
+		Group("version, content_hash").
+		Order("version DESC").
+		Scan(&versions).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// GetMenusByVersion 获取指定版本的全部节点（平铺列表，含 API 路径）
+func (r *menuRepository) GetMenusByVersion(
+	ctx context.Context,
+	productLine string,
+	version int,
+) ([]*models.Menu, error) {
 	var menus []*models.Menu
 
-	err = r.db.WithContext(ctx).
-		Where("product_line = ? AND version = ?", productLine, maxVersion).
+	err := r.db.WithContext(ctx).
+		Preload("ApiPaths", func(db *gorm.DB) *gorm.DB {
+			return db.Order("sort ASC")
+		}).
+		Where("product_line = ? AND version = ?", productLine, version).
 		Order("sort ASC").
 		Find(&menus).
 		Error
@@ -114,7 +164,12 @@ func (r *menuRepository) GetLatestMenuTree(ctx context.Context, productLine stri
 		return nil, err
 	}
 
-	// Step 3: Build the tree from the flat list.
+	return menus, nil
+}
+
+// BuildMenuTree 将平铺的菜单节点按 ParentID 组装为树，返回根节点列表
+// 同级节点保持输入顺序；父节点不在列表中的节点会被丢弃。
+func BuildMenuTree(menus []*models.Menu) []*models.Menu {
 	menuMap := make(map[uuid.UUID]*models.Menu)
 
 	var rootNodes []*models.Menu
@@ -135,7 +190,7 @@ func (r *menuRepository) GetLatestMenuTree(ctx context.Context, productLine stri
 		}
 	}
 
-	return rootNodes, nil
+	return rootNodes
 }
 
 // GetBySemanticID 根据语义ID和版本查询菜单
@@ -0,0 +1,44 @@
+package mfa
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+)
+
+// MFARepository 多因素认证仓储接口
+// 管理用户 TOTP 配置与一次性恢复码
+type MFARepository interface {
+	// ============================================================================
+	// TOTP 配置
+	// ============================================================================
+
+	// GetByUserID 获取用户的 MFA 配置，不存在时返回 gorm.ErrRecordNotFound
+	GetByUserID(ctx context.Context, userID string) (*models.UserMFA, error)
+
+	// SavePending 写入待确认的密钥（未启用），已有记录时覆盖密钥并重置使用状态
+	SavePending(ctx context.Context, userID, secret string) error
+
+	// Enable 启用 MFA，并记录确认时使用的时间步
+	Enable(ctx context.Context, userID string, step int64, enabledAt int64) error
+
+	// AdvanceLastUsedStep 仅当 step 大于已记录的时间步时更新，返回是否更新成功；
+	// 返回 false 表示该口令已被使用过（重放）
+	AdvanceLastUsedStep(ctx context.Context, userID string, step int64) (bool, error)
+
+	// DeleteByUserID 删除用户的 MFA 配置及全部恢复码
+	DeleteByUserID(ctx context.Context, userID string) error
+
+	// ============================================================================
+	// 恢复码
+	// ============================================================================
+
+	// ReplaceRecoveryCodes 用新的一组恢复码哈希替换用户现有恢复码
+	ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error
+
+	// ConsumeRecoveryCode 把未使用的恢复码标记为已使用，返回是否命中
+	ConsumeRecoveryCode(ctx context.Context, userID, codeHash string, usedAt int64) (bool, error)
+
+	// CountUnusedRecoveryCodes 统计用户剩余可用的恢复码数量
+	CountUnusedRecoveryCodes(ctx context.Context, userID string) (int64, error)
+}
@@ -0,0 +1,209 @@
+package mfa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+)
+
+// MFARepositoryImpl 多因素认证仓储实现
+type MFARepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewMFARepository 创建多因素认证仓储实例
+func NewMFARepository(db *gorm.DB) MFARepository {
+	return &MFARepositoryImpl{
+		db: db,
+	}
+}
+
+// ============================================================================
+// TOTP 配置
+// ============================================================================
+
+// GetByUserID 获取用户的 MFA 配置
+func (r *MFARepositoryImpl) GetByUserID(ctx context.Context, userID string) (*models.UserMFA, error) {
+	var record models.UserMFA
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		First(&record).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, gorm.ErrRecordNotFound
+		}
+
+		return nil, fmt.Errorf("查询用户MFA配置失败: %w", err)
+	}
+
+	return &record, nil
+}
+
+// SavePending 写入待确认的密钥（未启用）
+func (r *MFARepositoryImpl) SavePending(ctx context.Context, userID, secret string) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("用户ID格式无效: %w", err)
+	}
+
+	record := &models.UserMFA{
+		UserID: uid,
+		Secret: secret,
+	}
+
+	err = r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"secret":         secret,
+				"enabled":        false,
+				"enabled_at":     nil,
+				"last_used_step": 0,
+				"updated_at":     models.GetCurrentTimestamp(),
+			}),
+		}).
+		Create(record).Error
+	if err != nil {
+		return fmt.Errorf("保存MFA密钥失败: %w", err)
+	}
+
+	return nil
+}
+
+// Enable 启用 MFA
+func (r *MFARepositoryImpl) Enable(ctx context.Context, userID string, step int64, enabledAt int64) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.UserMFA{}).
+		Where("user_id = ? AND enabled = ?", userID, false).
+		Updates(map[string]interface{}{
+			"enabled":        true,
+			"enabled_at":     enabledAt,
+			"last_used_step": step,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("启用MFA失败: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// AdvanceLastUsedStep 条件更新最近使用的时间步，防止同一口令被重复使用
+func (r *MFARepositoryImpl) AdvanceLastUsedStep(
+	ctx context.Context,
+	userID string,
+	step int64,
+) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.UserMFA{}).
+		Where("user_id = ? AND enabled = ? AND last_used_step < ?", userID, true, step).
+		Update("last_used_step", step)
+	if result.Error != nil {
+		return false, fmt.Errorf("更新MFA时间步失败: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// DeleteByUserID 删除用户的 MFA 配置及全部恢复码
+func (r *MFARepositoryImpl) DeleteByUserID(ctx context.Context, userID string) error {
+	if err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("user_id = ?", userID).
+		Delete(&models.MFARecoveryCode{}).Error; err != nil {
+		return fmt.Errorf("删除MFA恢复码失败: %w", err)
+	}
+
+	if err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("user_id = ?", userID).
+		Delete(&models.UserMFA{}).Error; err != nil {
+		return fmt.Errorf("删除MFA配置失败: %w", err)
+	}
+
+	return nil
+}
+
+// ============================================================================
+// 恢复码
+// ============================================================================
+
+// ReplaceRecoveryCodes 用新的一组恢复码哈希替换用户现有恢复码
+func (r *MFARepositoryImpl) ReplaceRecoveryCodes(
+	ctx context.Context,
+	userID string,
+	codeHashes []string,
+) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("用户ID格式无效: %w", err)
+	}
+
+	if err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("user_id = ?", uid).
+		Delete(&models.MFARecoveryCode{}).Error; err != nil {
+		return fmt.Errorf("清理旧恢复码失败: %w", err)
+	}
+
+	if len(codeHashes) == 0 {
+		return nil
+	}
+
+	codes := make([]*models.MFARecoveryCode, 0, len(codeHashes))
+	for _, hash := range codeHashes {
+		codes = append(codes, &models.MFARecoveryCode{
+			UserID:   uid,
+			CodeHash: hash,
+		})
+	}
+
+	if err := r.db.WithContext(ctx).Create(&codes).Error; err != nil {
+		return fmt.Errorf("保存恢复码失败: %w", err)
+	}
+
+	return nil
+}
+
+// ConsumeRecoveryCode 把未使用的恢复码标记为已使用
+//
+// 通过 used_at IS NULL 条件更新保证并发下同一恢复码只能被消费一次。
+func (r *MFARepositoryImpl) ConsumeRecoveryCode(
+	ctx context.Context,
+	userID, codeHash string,
+	usedAt int64,
+) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.MFARecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", usedAt)
+	if result.Error != nil {
+		return false, fmt.Errorf("使用恢复码失败: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// CountUnusedRecoveryCodes 统计用户剩余可用的恢复码数量
+func (r *MFARepositoryImpl) CountUnusedRecoveryCodes(ctx context.Context, userID string) (int64, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).
+		Model(&models.MFARecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("统计可用恢复码失败: %w", err)
+	}
+
+	return count, nil
+}
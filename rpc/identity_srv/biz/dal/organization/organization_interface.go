@@ -61,10 +61,12 @@ type OrganizationRepository interface {
 // OrganizationQueryConditions 组织查询条件
 // 支持多条件组合查询，提供灵活的查询能力
 type OrganizationQueryConditions struct {
-	Name         *string            // 组织名称（模糊匹配）
-	Code         *string            // 组织代码（精确匹配）
-	ParentID     *string            // 父组织ID（nil表示查询根组织）
-	FacilityType *string            // 机构类型
-	ProvinceCity *string            // 省市
-	Page         *base.QueryOptions // 分页、排序、搜索参数
+	ID           *string               // 组织ID（精确匹配）
+	Name         *string               // 组织名称（模糊匹配）
+	Code         *string               // 组织代码（精确匹配）
+	ParentID     *string               // 父组织ID（nil表示查询根组织）
+	FacilityType *string               // 机构类型
+	ProvinceCity *string               // 省市
+	Scope        *base.DataScopeFilter // 行级数据范围（nil 不限制）
+	Page         *base.QueryOptions    // 分页、排序、搜索参数
 }
@@ -44,10 +44,10 @@ func (r *OrganizationRepositoryImpl) FindAll(
 
 	// 使用 QueryBuilder 构建查询
 	qb := baseRepo.NewQueryBuilder(ctx).
-		WithSoftDelete(opts).                             // 应用软删除过滤
-		WithSearch(opts.Search, r.applySearchConditions). // 自定义搜索逻辑
-		WithPreload(opts.Preloads...).                    // 预加载关联
-		WithOrder(opts)                                   // 排序
+		WithSoftDelete(opts).          // 应用软删除过滤
+		WithTokenSearch(opts.Search).  // 拼音感知搜索，按匹配质量排序
+		WithPreload(opts.Preloads...). // 预加载关联
+		WithOrder(opts)                // 排序
 
 	// 应用过滤条件
 	for field, value := range opts.Filters {
@@ -227,13 +227,14 @@ func (r *OrganizationRepositoryImpl) FindWithConditions(
 
 	// 应用精确匹配条件（WhereEqual 会自动跳过 nil 值）
 	if conditions != nil {
-		qb = r.applyOrganizationConditions(qb, conditions)
+		qb = r.applyOrganizationConditions(qb, conditions).
+			WithDataScope(conditions.Scope, r.applyDataScope)
 	}
 
 	// 应用搜索、预加载和排序
-	qb = qb.WithSearch(opts.Search, r.applySearchConditions). // 自定义搜索逻辑
-									WithPreload(opts.Preloads...). // 预加载关联
-									WithOrder(opts)                // 排序
+	qb = qb.WithTokenSearch(opts.Search). // 拼音感知搜索，按匹配质量排序
+						WithPreload(opts.Preloads...). // 预加载关联
+						WithOrder(opts)                // 排序
 
 	// 执行分页查询（自动处理计数和分页）
 	return qb.FindWithPagination(opts)
@@ -244,7 +245,8 @@ func (r *OrganizationRepositoryImpl) applyOrganizationConditions(
 	qb *base.QueryBuilder[models.Organization],
 	conditions *OrganizationQueryConditions,
 ) *base.QueryBuilder[models.Organization] {
-	qb = qb.WhereEqual("code", conditions.Code).
+	qb = qb.WhereEqual("id", conditions.ID).
+		WhereEqual("code", conditions.Code).
 		WhereEqual("facility_type", conditions.FacilityType).
 		WhereEqual("province_city", conditions.ProvinceCity)
 
@@ -270,17 +272,16 @@ func (r *OrganizationRepositoryImpl) applyOrganizationConditions(
 	return qb
 }
 
-// applySearchConditions 应用搜索条件
-// 定义组织特有的多字段搜索逻辑
-func (r *OrganizationRepositoryImpl) applySearchConditions(
-	query *gorm.DB,
-	searchTerm string,
+// applyDataScope 把数据范围翻译为组织的行级条件
+//
+// 组织范围可见调用方所在组织及其直接子组织；本人与部门范围不可见任何组织。
+func (r *OrganizationRepositoryImpl) applyDataScope(
+	db *gorm.DB,
+	scope *base.DataScopeFilter,
 ) *gorm.DB {
-	searchPattern := "%" + searchTerm + "%"
+	if scope.Level != models.DataScopeOrg || len(scope.OrganizationIDs) == 0 {
+		return base.DenyAll(db)
+	}
 
-	// ⚠️ 重要：使用括号包裹 OR 条件，避免与其他 AND 条件产生优先级问题
-	return query.Where(
-		"(name LIKE ? OR code LIKE ? OR facility_type LIKE ?)",
-		searchPattern, searchPattern, searchPattern,
-	)
+	return db.Where("id IN ? OR parent_id IN ?", scope.OrganizationIDs, scope.OrganizationIDs)
 }
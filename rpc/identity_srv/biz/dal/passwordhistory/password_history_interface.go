@@ -0,0 +1,22 @@
+package passwordhistory
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+)
+
+// PasswordHistoryRepository 历史密码仓储接口
+type PasswordHistoryRepository interface {
+	// Create 追加一条历史密码
+	Create(ctx context.Context, history *models.PasswordHistory) error
+
+	// ListRecentHashes 按时间倒序返回用户最近 limit 个密码哈希
+	ListRecentHashes(ctx context.Context, userID string, limit int) ([]string, error)
+
+	// Prune 只保留用户最近 keep 条历史密码，更早的记录物理删除
+	Prune(ctx context.Context, userID string, keep int) error
+
+	// DeleteByUserID 物理删除用户的全部历史密码（彻底删除用户）
+	DeleteByUserID(ctx context.Context, userID string) error
+}
@@ -0,0 +1,97 @@
+package passwordhistory
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+)
+
+// PasswordHistoryRepositoryImpl 历史密码仓储实现
+type PasswordHistoryRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewPasswordHistoryRepository 创建历史密码仓储实例
+func NewPasswordHistoryRepository(db *gorm.DB) PasswordHistoryRepository {
+	return &PasswordHistoryRepositoryImpl{
+		db: db,
+	}
+}
+
+// Create 追加一条历史密码
+func (r *PasswordHistoryRepositoryImpl) Create(
+	ctx context.Context,
+	history *models.PasswordHistory,
+) error {
+	if err := r.db.WithContext(ctx).Create(history).Error; err != nil {
+		return fmt.Errorf("保存历史密码失败: %w", err)
+	}
+
+	return nil
+}
+
+// ListRecentHashes 按时间倒序返回用户最近 limit 个密码哈希
+func (r *PasswordHistoryRepositoryImpl) ListRecentHashes(
+	ctx context.Context,
+	userID string,
+	limit int,
+) ([]string, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	var hashes []string
+
+	err := r.db.WithContext(ctx).
+		Model(&models.PasswordHistory{}).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Pluck("password_hash", &hashes).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询历史密码失败: %w", err)
+	}
+
+	return hashes, nil
+}
+
+// Prune 只保留用户最近 keep 条历史密码
+// 历史密码只用于复用检查，超出保留数量后不再需要，物理删除以减少哈希留存
+func (r *PasswordHistoryRepositoryImpl) Prune(
+	ctx context.Context,
+	userID string,
+	keep int,
+) error {
+	db := r.db.WithContext(ctx)
+
+	query := db.Unscoped().Where("user_id = ?", userID)
+	if keep > 0 {
+		recent := db.Model(&models.PasswordHistory{}).
+			Select("id").
+			Where("user_id = ?", userID).
+			Order("created_at DESC, id DESC").
+			Limit(keep)
+		query = query.Where("id NOT IN (?)", recent)
+	}
+
+	if err := query.Delete(&models.PasswordHistory{}).Error; err != nil {
+		return fmt.Errorf("清理历史密码失败: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteByUserID 物理删除用户的全部历史密码
+func (r *PasswordHistoryRepositoryImpl) DeleteByUserID(ctx context.Context, userID string) error {
+	if err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("user_id = ?", userID).
+		Delete(&models.PasswordHistory{}).Error; err != nil {
+		return fmt.Errorf("删除历史密码失败: %w", err)
+	}
+
+	return nil
+}
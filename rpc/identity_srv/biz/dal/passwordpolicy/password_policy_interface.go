@@ -0,0 +1,19 @@
+package passwordpolicy
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+)
+
+// PasswordPolicyRepository 组织密码策略仓储接口
+type PasswordPolicyRepository interface {
+	// GetByOrganizationID 获取组织单独配置的密码策略，未配置时返回 gorm.ErrRecordNotFound
+	GetByOrganizationID(ctx context.Context, organizationID string) (*models.OrganizationPasswordPolicy, error)
+
+	// Save 写入组织密码策略，已存在时覆盖
+	Save(ctx context.Context, policy *models.OrganizationPasswordPolicy) error
+
+	// DeleteByOrganizationID 物理删除组织的密码策略，组织恢复沿用全局默认策略
+	DeleteByOrganizationID(ctx context.Context, organizationID string) error
+}
@@ -0,0 +1,85 @@
+package passwordpolicy
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+)
+
+// PasswordPolicyRepositoryImpl 组织密码策略仓储实现
+type PasswordPolicyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewPasswordPolicyRepository 创建组织密码策略仓储实例
+func NewPasswordPolicyRepository(db *gorm.DB) PasswordPolicyRepository {
+	return &PasswordPolicyRepositoryImpl{
+		db: db,
+	}
+}
+
+// GetByOrganizationID 获取组织单独配置的密码策略
+func (r *PasswordPolicyRepositoryImpl) GetByOrganizationID(
+	ctx context.Context,
+	organizationID string,
+) (*models.OrganizationPasswordPolicy, error) {
+	var policy models.OrganizationPasswordPolicy
+
+	err := r.db.WithContext(ctx).
+		Where("organization_id = ?", organizationID).
+		First(&policy).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, gorm.ErrRecordNotFound
+		}
+
+		return nil, fmt.Errorf("查询组织密码策略失败: %w", err)
+	}
+
+	return &policy, nil
+}
+
+// Save 写入组织密码策略，按组织ID覆盖已有配置
+func (r *PasswordPolicyRepositoryImpl) Save(
+	ctx context.Context,
+	policy *models.OrganizationPasswordPolicy,
+) error {
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "organization_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"min_length":       policy.MinLength,
+				"min_char_classes": policy.MinCharClasses,
+				"history_size":     policy.HistorySize,
+				"max_age_hours":    policy.MaxAgeHours,
+				"updated_at":       models.GetCurrentTimestamp(),
+			}),
+		}).
+		Create(policy).Error
+	if err != nil {
+		return fmt.Errorf("保存组织密码策略失败: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteByOrganizationID 物理删除组织的密码策略
+// 组织ID上有唯一索引，软删除会阻止重新配置
+func (r *PasswordPolicyRepositoryImpl) DeleteByOrganizationID(
+	ctx context.Context,
+	organizationID string,
+) error {
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("organization_id = ?", organizationID).
+		Delete(&models.OrganizationPasswordPolicy{}).Error
+	if err != nil {
+		return fmt.Errorf("删除组织密码策略失败: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package passwordreset
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+)
+
+// PasswordResetTokenRepository 找回密码令牌仓储接口
+// 令牌只以 SHA-256 哈希形式存储，按哈希查找
+type PasswordResetTokenRepository interface {
+	// Create 保存新签发的令牌
+	Create(ctx context.Context, token *models.PasswordResetToken) error
+
+	// GetByHash 按令牌哈希查找，不存在时返回 gorm.ErrRecordNotFound
+	GetByHash(ctx context.Context, tokenHash string) (*models.PasswordResetToken, error)
+
+	// CountSince 统计用户在 since（毫秒时间戳）之后签发的令牌数，用于按账号限流
+	CountSince(ctx context.Context, userID string, since int64) (int64, error)
+
+	// Consume 把未使用且未过期的令牌标记为已使用，返回是否成功；
+	// 返回 false 表示令牌已被使用、已失效或已过期
+	Consume(ctx context.Context, id string, usedAt int64) (bool, error)
+
+	// InvalidateByUser 使用户所有尚未使用的令牌失效
+	InvalidateByUser(ctx context.Context, userID string, usedAt int64) error
+
+	// DeleteByUserID 物理删除用户的全部令牌（彻底删除用户）
+	DeleteByUserID(ctx context.Context, userID string) error
+}
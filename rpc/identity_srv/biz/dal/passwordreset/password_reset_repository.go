@@ -0,0 +1,120 @@
+package passwordreset
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+)
+
+// PasswordResetTokenRepositoryImpl 找回密码令牌仓储实现
+type PasswordResetTokenRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewPasswordResetTokenRepository 创建找回密码令牌仓储实例
+func NewPasswordResetTokenRepository(db *gorm.DB) PasswordResetTokenRepository {
+	return &PasswordResetTokenRepositoryImpl{
+		db: db,
+	}
+}
+
+// Create 保存新签发的令牌
+func (r *PasswordResetTokenRepositoryImpl) Create(
+	ctx context.Context,
+	token *models.PasswordResetToken,
+) error {
+	if err := r.db.WithContext(ctx).Create(token).Error; err != nil {
+		return fmt.Errorf("保存找回密码令牌失败: %w", err)
+	}
+
+	return nil
+}
+
+// GetByHash 按令牌哈希查找
+func (r *PasswordResetTokenRepositoryImpl) GetByHash(
+	ctx context.Context,
+	tokenHash string,
+) (*models.PasswordResetToken, error) {
+	var token models.PasswordResetToken
+
+	err := r.db.WithContext(ctx).
+		Where("token_hash = ?", tokenHash).
+		First(&token).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, gorm.ErrRecordNotFound
+		}
+
+		return nil, fmt.Errorf("查询找回密码令牌失败: %w", err)
+	}
+
+	return &token, nil
+}
+
+// CountSince 统计用户在 since 之后签发的令牌数
+func (r *PasswordResetTokenRepositoryImpl) CountSince(
+	ctx context.Context,
+	userID string,
+	since int64,
+) (int64, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).
+		Model(&models.PasswordResetToken{}).
+		Where("user_id = ? AND created_at >= ?", userID, since).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("统计找回密码令牌失败: %w", err)
+	}
+
+	return count, nil
+}
+
+// Consume 条件更新令牌为已使用，保证同一令牌只能成功使用一次
+func (r *PasswordResetTokenRepositoryImpl) Consume(
+	ctx context.Context,
+	id string,
+	usedAt int64,
+) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.PasswordResetToken{}).
+		Where("id = ? AND used_at IS NULL AND expires_at > ?", id, usedAt).
+		Update("used_at", usedAt)
+	if result.Error != nil {
+		return false, fmt.Errorf("使用找回密码令牌失败: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// InvalidateByUser 使用户所有尚未使用的令牌失效
+func (r *PasswordResetTokenRepositoryImpl) InvalidateByUser(
+	ctx context.Context,
+	userID string,
+	usedAt int64,
+) error {
+	err := r.db.WithContext(ctx).
+		Model(&models.PasswordResetToken{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Update("used_at", usedAt).Error
+	if err != nil {
+		return fmt.Errorf("作废找回密码令牌失败: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteByUserID 物理删除用户的全部令牌
+func (r *PasswordResetTokenRepositoryImpl) DeleteByUserID(ctx context.Context, userID string) error {
+	if err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("user_id = ?", userID).
+		Delete(&models.PasswordResetToken{}).Error; err != nil {
+		return fmt.Errorf("删除找回密码令牌失败: %w", err)
+	}
+
+	return nil
+}
@@ -34,11 +34,29 @@ type RoleMenuPermissionRepository interface {
 	// GetByRoleIDs 批量获取多个角色的菜单权限
 	GetByRoleIDs(ctx context.Context, roleIDs []uuid.UUID) ([]*models.RoleMenuPermission, error)
 
+	// GetByRoleAndProductLine 获取指定角色在某个产品线下的菜单权限
+	GetByRoleAndProductLine(ctx context.Context, roleID uuid.UUID, productLine string) ([]*models.RoleMenuPermission, error)
+
+	// GetByRoleIDsAndProductLine 批量获取多个角色在某个产品线下的菜单权限
+	GetByRoleIDsAndProductLine(
+		ctx context.Context,
+		roleIDs []uuid.UUID,
+		productLine string,
+	) ([]*models.RoleMenuPermission, error)
+
+	// GetByMenuIDs 获取产品线下引用指定菜单的全部角色菜单权限（预加载角色）
+	GetByMenuIDs(ctx context.Context, productLine string, menuIDs []string) ([]*models.RoleMenuPermission, error)
+
 	// GetByRoleAndMenu 获取指定角色和菜单的权限
 	GetByRoleAndMenu(ctx context.Context, roleID uuid.UUID, menuID string) (*models.RoleMenuPermission, error)
 
-	// SyncRoleMenus 同步角色菜单权限（先删除旧的，再创建新的）
-	SyncRoleMenus(ctx context.Context, roleID uuid.UUID, permissions []*models.RoleMenuPermission) error
+	// SyncRoleMenus 同步角色在某个产品线下的菜单权限（先删除该产品线的旧权限，再创建新的）
+	SyncRoleMenus(
+		ctx context.Context,
+		roleID uuid.UUID,
+		productLine string,
+		permissions []*models.RoleMenuPermission,
+	) error
 
 	// GetMenuIDsByRoleID 获取指定角色有权限的菜单ID列表
 	GetMenuIDsByRoleID(ctx context.Context, roleID uuid.UUID) ([]string, error)
@@ -46,14 +64,19 @@ type RoleMenuPermissionRepository interface {
 	// GetMenuIDsByRoleIDs 批量获取多个角色有权限的菜单ID列表（去重）
 	GetMenuIDsByRoleIDs(ctx context.Context, roleIDs []uuid.UUID) ([]string, error)
 
-	// HasPermission 检查角色是否具有指定菜单的指定权限
+	// HasPermission 检查角色是否具有产品线下指定菜单的指定权限
 	HasPermission(
 		ctx context.Context,
 		roleID uuid.UUID,
+		productLine string,
 		menuID string,
 		permissionType models.MenuPermissionType,
 	) (bool, error)
 
-	// GetMergedPermissions 获取多个角色的合并权限（每个菜单取最高权限）
-	GetMergedPermissions(ctx context.Context, roleIDs []uuid.UUID) ([]models.MenuPermissionInfo, error)
+	// GetMergedPermissions 获取多个角色在某个产品线下的合并权限（每个菜单取最高权限）
+	GetMergedPermissions(
+		ctx context.Context,
+		roleIDs []uuid.UUID,
+		productLine string,
+	) ([]models.MenuPermissionInfo, error)
 }
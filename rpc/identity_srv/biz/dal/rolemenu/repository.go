@@ -101,6 +101,71 @@ func (r *roleMenuPermissionRepository) GetByRoleIDs(
 	return permissions, nil
 }
 
+// GetByRoleAndProductLine 获取指定角色在某个产品线下的菜单权限
+func (r *roleMenuPermissionRepository) GetByRoleAndProductLine(
+	ctx context.Context,
+	roleID uuid.UUID,
+	productLine string,
+) ([]*models.RoleMenuPermission, error) {
+	var permissions []*models.RoleMenuPermission
+
+	err := r.db.WithContext(ctx).
+		Where("role_id = ? AND product_line = ?", roleID, productLine).
+		Find(&permissions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
+// GetByRoleIDsAndProductLine 批量获取多个角色在某个产品线下的菜单权限
+func (r *roleMenuPermissionRepository) GetByRoleIDsAndProductLine(
+	ctx context.Context,
+	roleIDs []uuid.UUID,
+	productLine string,
+) ([]*models.RoleMenuPermission, error) {
+	if len(roleIDs) == 0 {
+		return []*models.RoleMenuPermission{}, nil
+	}
+
+	var permissions []*models.RoleMenuPermission
+
+	err := r.db.WithContext(ctx).
+		Where("role_id IN ? AND product_line = ?", roleIDs, productLine).
+		Order("role_id ASC, menu_id ASC").
+		Find(&permissions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
+// GetByMenuIDs 获取产品线下引用指定菜单的全部角色菜单权限（预加载角色）
+func (r *roleMenuPermissionRepository) GetByMenuIDs(
+	ctx context.Context,
+	productLine string,
+	menuIDs []string,
+) ([]*models.RoleMenuPermission, error) {
+	if len(menuIDs) == 0 {
+		return []*models.RoleMenuPermission{}, nil
+	}
+
+	var permissions []*models.RoleMenuPermission
+
+	err := r.db.WithContext(ctx).
+		Preload("Role").
+		Where("product_line = ? AND menu_id IN ?", productLine, menuIDs).
+		Order("menu_id ASC, role_id ASC").
+		Find(&permissions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
 // GetByRoleAndMenu 获取指定角色和菜单的权限
 func (r *roleMenuPermissionRepository) GetByRoleAndMenu(
 	ctx context.Context,
@@ -119,23 +184,27 @@ func (r *roleMenuPermissionRepository) GetByRoleAndMenu(
 	return &permission, nil
 }
 
-// SyncRoleMenus 同步角色菜单权限（先删除旧的，再创建新的）
+// SyncRoleMenus 同步角色在某个产品线下的菜单权限（先删除该产品线的旧权限，再创建新的）
 func (r *roleMenuPermissionRepository) SyncRoleMenus(
 	ctx context.Context,
 	roleID uuid.UUID,
+	productLine string,
 	permissions []*models.RoleMenuPermission,
 ) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 1. 删除角色的所有旧权限（使用硬删除，避免唯一索引冲突）
-		if err := tx.Unscoped().Delete(&models.RoleMenuPermission{}, "role_id = ?", roleID).Error; err != nil {
+		// 1. 删除角色在该产品线下的旧权限（使用硬删除，避免唯一索引冲突）
+		if err := tx.Unscoped().
+			Delete(&models.RoleMenuPermission{}, "role_id = ? AND product_line = ?", roleID, productLine).
+			Error; err != nil {
 			return err
 		}
 
 		// 2. 创建新权限
 		if len(permissions) > 0 {
-			// 确保所有权限都属于该角色
+			// 确保所有权限都属于该角色和产品线
 			for _, perm := range permissions {
 				perm.RoleID = roleID
+				perm.ProductLine = productLine
 			}
 
 			if err := tx.CreateInBatches(permissions, 100).Error; err != nil {
@@ -182,10 +251,11 @@ func (r *roleMenuPermissionRepository) GetMenuIDsByRoleIDs(ctx context.Context,
 	return menuIDs, nil
 }
 
-// HasPermission 检查角色是否具有指定菜单的指定权限
+// HasPermission 检查角色是否具有产品线下指定菜单的指定权限
 func (r *roleMenuPermissionRepository) HasPermission(
 	ctx context.Context,
 	roleID uuid.UUID,
+	productLine string,
 	menuID string,
 	permissionType models.MenuPermissionType,
 ) (bool, error) {
@@ -193,7 +263,8 @@ func (r *roleMenuPermissionRepository) HasPermission(
 
 	err := r.db.WithContext(ctx).
 		Model(&models.RoleMenuPermission{}).
-		Where("role_id = ? AND menu_id = ? AND permission_type >= ?", roleID, menuID, permissionType).
+		Where("role_id = ? AND product_line = ? AND menu_id = ? AND permission_type >= ?",
+			roleID, productLine, menuID, permissionType).
 		Count(&count).Error
 	if err != nil {
 		return false, err
@@ -202,17 +273,22 @@ func (r *roleMenuPermissionRepository) HasPermission(
 	return count > 0, nil
 }
 
-// GetMergedPermissions 获取多个角色的合并权限（每个菜单取最高权限）
+// GetMergedPermissions 获取多个角色在某个产品线下的合并权限（每个菜单取最高权限）
 func (r *roleMenuPermissionRepository) GetMergedPermissions(
 	ctx context.Context,
 	roleIDs []uuid.UUID,
+	productLine string,
 ) ([]models.MenuPermissionInfo, error) {
 	if len(roleIDs) == 0 {
 		return []models.MenuPermissionInfo{}, nil
 	}
 
-	// 查询所有权限
-	permissions, err := r.GetByRoleIDs(ctx, roleIDs)
+	// 查询该产品线下的所有权限
+	var permissions []*models.RoleMenuPermission
+
+	err := r.db.WithContext(ctx).
+		Where("role_id IN ? AND product_line = ?", roleIDs, productLine).
+		Find(&permissions).Error
 	if err != nil {
 		return nil, err
 	}
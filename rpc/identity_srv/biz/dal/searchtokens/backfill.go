@@ -0,0 +1,136 @@
+// Package searchtokens 回填 search_tokens 检索文本
+//
+// 新写入的记录由模型钩子生成检索文本；存量记录（以及拼音规则调整后的全部记录）
+// 需要经此回填。按主键分批扫描，只更新内容有变化的行，可重复执行。
+package searchtokens
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+)
+
+// DefaultBatchSize 默认每批扫描行数
+const DefaultBatchSize = 500
+
+// TableStats 单表回填结果
+type TableStats struct {
+	Table   string
+	Scanned int
+	Updated int
+}
+
+// Backfill 依次回填 user_profiles、organizations、departments（含已软删除的记录）
+//
+// 使用 UpdateColumn 直接写列，不触发模型钩子、不修改 updated_at。
+func Backfill(
+	ctx context.Context,
+	db *gorm.DB,
+	batchSize int,
+	logf func(format string, args ...any),
+) ([]TableStats, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	steps := []func() (TableStats, error){
+		func() (TableStats, error) {
+			return backfillTable(ctx, db, "user_profiles", batchSize, func(u *models.UserProfile) (uuid.UUID, string, bool) {
+				old := u.SearchTokens
+				u.RefreshSearchTokens()
+
+				return u.ID, u.SearchTokens, u.SearchTokens != old
+			})
+		},
+		func() (TableStats, error) {
+			return backfillTable(ctx, db, "organizations", batchSize, func(o *models.Organization) (uuid.UUID, string, bool) {
+				old := o.SearchTokens
+				o.RefreshSearchTokens()
+
+				return o.ID, o.SearchTokens, o.SearchTokens != old
+			})
+		},
+		func() (TableStats, error) {
+			return backfillTable(ctx, db, "departments", batchSize, func(d *models.Department) (uuid.UUID, string, bool) {
+				old := d.SearchTokens
+				d.RefreshSearchTokens()
+
+				return d.ID, d.SearchTokens, d.SearchTokens != old
+			})
+		},
+	}
+
+	results := make([]TableStats, 0, len(steps))
+
+	for _, step := range steps {
+		stats, err := step()
+		if err != nil {
+			return results, err
+		}
+
+		if logf != nil {
+			logf("search_tokens 回填 %s：扫描 %d 行，更新 %d 行", stats.Table, stats.Scanned, stats.Updated)
+		}
+
+		results = append(results, stats)
+	}
+
+	return results, nil
+}
+
+// backfillTable 按主键分批扫描一张表；refresh 重算检索文本并返回 (主键, 新值, 是否变化)
+func backfillTable[T any](
+	ctx context.Context,
+	db *gorm.DB,
+	table string,
+	batchSize int,
+	refresh func(*T) (uuid.UUID, string, bool),
+) (TableStats, error) {
+	stats := TableStats{Table: table}
+	lastID := uuid.Nil
+
+	for {
+		var rows []*T
+
+		err := db.WithContext(ctx).Unscoped().
+			Where("id > ?", lastID).
+			Order("id").
+			Limit(batchSize).
+			Find(&rows).Error
+		if err != nil {
+			return stats, fmt.Errorf("读取 %s 失败: %w", table, err)
+		}
+
+		if len(rows) == 0 {
+			return stats, nil
+		}
+
+		err = db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			for _, row := range rows {
+				id, tokens, changed := refresh(row)
+				lastID = id
+
+				if !changed {
+					continue
+				}
+
+				if err := tx.Table(table).Where("id = ?", id).UpdateColumn("search_tokens", tokens).Error; err != nil {
+					return fmt.Errorf("更新 %s(%s) 失败: %w", table, id, err)
+				}
+
+				stats.Updated++
+			}
+
+			return nil
+		})
+		if err != nil {
+			return stats, err
+		}
+
+		stats.Scanned += len(rows)
+	}
+}
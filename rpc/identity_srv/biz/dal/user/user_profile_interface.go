@@ -88,10 +88,12 @@ type UserProfileRepository interface {
 // UserProfileQueryConditions 用户档案查询条件
 // 支持多条件组合查询，提供灵活的查询能力
 type UserProfileQueryConditions struct {
-	Username *string            // 用户名（精确匹配）
-	Email    *string            // 邮箱（精确匹配）
-	Phone    *string            // 手机号（精确匹配）
-	Status   *models.UserStatus // 用户状态
-	OrgID    *string            // 组织ID（通过成员关系查询）
-	Page     *base.QueryOptions // 分页、排序、搜索参数
+	ID       *string               // 用户ID（精确匹配）
+	Username *string               // 用户名（精确匹配）
+	Email    *string               // 邮箱（精确匹配）
+	Phone    *string               // 手机号（精确匹配）
+	Status   *models.UserStatus    // 用户状态
+	OrgID    *string               // 组织ID（通过成员关系查询）
+	Scope    *base.DataScopeFilter // 行级数据范围（nil 不限制）
+	Page     *base.QueryOptions    // 分页、排序、搜索参数
 }
@@ -131,7 +131,10 @@ func (r *UserProfileRepositoryImpl) UpdateStatus(
 	result := r.db.WithContext(ctx).
 		Model(&models.UserProfile{}).
 		Where("id = ?", userID).
-		Update("status", status)
+		Updates(map[string]interface{}{
+			"status":  status,
+			"version": gorm.Expr("version + 1"), // 状态变更同样使编辑中的旧版本失效
+		})
 
 	if result.Error != nil {
 		return fmt.Errorf("更新用户状态失败: %w", result.Error)
@@ -155,8 +158,9 @@ func (r *UserProfileRepositoryImpl) UpdatePassword(
 		Where("id = ?", userID).
 		Updates(map[string]interface{}{
 			"password_hash":        passwordHash,
-			"must_change_password": false, // Reset the must_change_password flag
-			"login_attempts":       0,     // Also reset login attempts on password change
+			"password_changed_at":  models.GetCurrentTimestamp(), // 重新计算密码使用期限
+			"must_change_password": false,                        // Reset the must_change_password flag
+			"login_attempts":       0,                            // Also reset login attempts on password change
 		})
 
 	if result.Error != nil {
@@ -323,13 +327,14 @@ func (r *UserProfileRepositoryImpl) FindWithConditions(
 
 	// 应用精确匹配条件（需要表名前缀，因为可能有 JOIN）
 	if conditions != nil {
-		qb = r.applyUserProfileConditions(qb, conditions)
+		qb = r.applyUserProfileConditions(qb, conditions).
+			WithDataScope(conditions.Scope, r.applyDataScope)
 	}
 
 	// 应用搜索、预加载和排序
-	qb = qb.WithSearch(opts.Search, r.applySearchConditions). // 自定义搜索逻辑
-									WithPreload(opts.Preloads...). // 预加载关联
-									WithOrder(opts)                // 排序
+	qb = qb.WithTokenSearch(opts.Search). // 拼音感知搜索，按匹配质量排序
+						WithPreload(opts.Preloads...). // 预加载关联
+						WithOrder(opts)                // 排序
 
 	// 执行分页查询（自动处理计数和分页）
 	return qb.FindWithPagination(opts)
@@ -344,6 +349,12 @@ func (r *UserProfileRepositoryImpl) applyUserProfileConditions(
 	qb *base.QueryBuilder[models.UserProfile],
 	conditions *UserProfileQueryConditions,
 ) *base.QueryBuilder[models.UserProfile] {
+	if conditions.ID != nil {
+		qb = qb.WhereCustom(func(db *gorm.DB) *gorm.DB {
+			return db.Where("user_profiles.id = ?", *conditions.ID)
+		})
+	}
+
 	if conditions.Username != nil {
 		qb = qb.WhereCustom(func(db *gorm.DB) *gorm.DB {
 			return db.Where("user_profiles.username = ?", *conditions.Username)
@@ -371,27 +382,39 @@ func (r *UserProfileRepositoryImpl) applyUserProfileConditions(
 	return qb
 }
 
-// applySearchConditions 应用搜索条件
-func (r *UserProfileRepositoryImpl) applySearchConditions(
-	query *gorm.DB,
-	searchTerm string,
+// applyDataScope 把数据范围翻译为用户档案的行级条件
+//
+// 部门/组织维度经 user_memberships 子查询匹配（仅活跃成员关系），
+// 避免与 OrgID 过滤的 JOIN 重复。已删除用户（回收站）按删除用户时
+// 一并级联删除的成员关系匹配，二者共享删除时间戳（见 DeleteUser）。
+func (r *UserProfileRepositoryImpl) applyDataScope(
+	db *gorm.DB,
+	scope *base.DataScopeFilter,
 ) *gorm.DB {
-	searchPattern := "%" + searchTerm + "%"
-
-	// 在所有相关文本字段上应用搜索条件，并明确指定表名
-	// ⚠️ 重要：使用括号包裹 OR 条件，避免与其他 AND 条件产生优先级问题
-	return query.Where(
-		`(user_profiles.username LIKE ? OR
-		  user_profiles.email LIKE ? OR
-		  user_profiles.first_name LIKE ? OR
-		  user_profiles.last_name LIKE ? OR
-		  user_profiles.real_name LIKE ?)`,
-		searchPattern,
-		searchPattern,
-		searchPattern,
-		searchPattern,
-		searchPattern,
-	)
+	const memberSubquery = "user_profiles.id IN (SELECT user_id FROM user_memberships " +
+		"WHERE %s IN ? AND status = ? AND (user_memberships.deleted_at IS NULL " +
+		"OR user_memberships.deleted_at = user_profiles.deleted_at))"
+
+	switch scope.Level {
+	case models.DataScopeSelf:
+		return db.Where("user_profiles.id = ?", scope.UserID)
+	case models.DataScopeDept:
+		if len(scope.DepartmentIDs) == 0 {
+			return base.DenyAll(db)
+		}
+
+		return db.Where(fmt.Sprintf(memberSubquery, "department_id"),
+			scope.DepartmentIDs, models.MembershipStatusActive)
+	case models.DataScopeOrg:
+		if len(scope.OrganizationIDs) == 0 {
+			return base.DenyAll(db)
+		}
+
+		return db.Where(fmt.Sprintf(memberSubquery, "organization_id"),
+			scope.OrganizationIDs, models.MembershipStatusActive)
+	default:
+		return base.DenyAll(db)
+	}
 }
 
 // WithTx 使用指定事务创建新的仓储实例
@@ -497,9 +520,9 @@ func (r *UserProfileRepositoryImpl) SoftDelete(ctx context.Context, id string) e
 // HardDelete 物理删除用户（增强保护）
 // 重写基类方法，添加系统用户保护
 func (r *UserProfileRepositoryImpl) HardDelete(ctx context.Context, id string) error {
-	// 系统用户绝对禁止物理删除
-	user, err := r.GetByID(ctx, id)
-	if err != nil {
+	// 系统用户绝对禁止物理删除（含已软删除的记录，回收站清除时需能查到）
+	var user models.UserProfile
+	if err := r.db.WithContext(ctx).Unscoped().Where("id = ?", id).First(&user).Error; err != nil {
 		return err
 	}
 
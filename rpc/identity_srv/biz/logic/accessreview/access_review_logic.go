@@ -0,0 +1,58 @@
+package accessreview
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/assignment"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+)
+
+// AccessReviewLogic 访问复核业务逻辑接口
+// 负责按组织/角色范围发起周期性复核活动，逐条确认或撤销角色分配，并在活动关闭时处理未复核条目
+type AccessReviewLogic interface {
+	// CreateAccessReviewCampaign 创建复核活动，并把范围内当前有效的角色分配快照为待复核条目
+	// 对应 IDL: CreateAccessReviewCampaign(1: CreateAccessReviewCampaignRequest req)
+	CreateAccessReviewCampaign(
+		ctx context.Context,
+		req *identity_srv.CreateAccessReviewCampaignRequest,
+	) (*identity_srv.AccessReviewCampaign, error)
+
+	// ListAccessReviewCampaigns 分页查询复核活动（含完成情况统计）
+	// 对应 IDL: ListAccessReviewCampaigns(1: ListAccessReviewCampaignsRequest req)
+	ListAccessReviewCampaigns(
+		ctx context.Context,
+		req *identity_srv.ListAccessReviewCampaignsRequest,
+	) (*identity_srv.ListAccessReviewCampaignsResponse, error)
+
+	// GetAccessReviewCampaign 获取单个复核活动（含完成情况统计）
+	// 对应 IDL: GetAccessReviewCampaign(1: GetAccessReviewCampaignRequest req)
+	GetAccessReviewCampaign(ctx context.Context, campaignID string) (*identity_srv.AccessReviewCampaign, error)
+
+	// ListAccessReviewItems 分页查询活动下的复核条目
+	// 对应 IDL: ListAccessReviewItems(1: ListAccessReviewItemsRequest req)
+	ListAccessReviewItems(
+		ctx context.Context,
+		req *identity_srv.ListAccessReviewItemsRequest,
+	) (*identity_srv.ListAccessReviewItemsResponse, error)
+
+	// DecideAccessReviewItem 复核单个条目：确认保留或撤销角色分配
+	// 撤销时先通过 revoker 删除 policy_srv 中的 g 规则，再删除角色分配
+	// 对应 IDL: DecideAccessReviewItem(1: DecideAccessReviewItemRequest req)
+	DecideAccessReviewItem(
+		ctx context.Context,
+		req *identity_srv.DecideAccessReviewItemRequest,
+		revoker assignment.RoleBindingRevoker,
+	) (*identity_srv.AccessReviewItem, error)
+
+	// CloseAccessReviewCampaign 手动关闭复核活动，活动开启自动撤销时撤销全部未复核的分配
+	// 对应 IDL: CloseAccessReviewCampaign(1: CloseAccessReviewCampaignRequest req)
+	CloseAccessReviewCampaign(
+		ctx context.Context,
+		req *identity_srv.CloseAccessReviewCampaignRequest,
+		revoker assignment.RoleBindingRevoker,
+	) (*identity_srv.AccessReviewCampaign, error)
+
+	// CloseOverdueAccessReviewCampaigns 关闭已过截止时间的活动，由后台任务周期调用
+	// 返回本轮关闭的活动数
+	CloseOverdueAccessReviewCampaigns(ctx context.Context, revoker assignment.RoleBindingRevoker) (int, error)
+}
@@ -0,0 +1,925 @@
+package accessreview
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/convutil"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/accessreview"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/assignment"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+	tracelog "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/log"
+)
+
+// reviewBatchSize 关闭活动时每批处理的未复核条目数，以及每轮自动关闭的到期活动数
+const reviewBatchSize = 100
+
+// autoRevokeComment 活动关闭时自动撤销条目的复核意见
+const autoRevokeComment = "复核活动关闭时仍未复核，自动撤销"
+
+// LogicImpl 访问复核业务逻辑实现
+type LogicImpl struct {
+	dal       dal.DAL
+	converter converter.Converter
+}
+
+// NewLogic 创建访问复核业务逻辑实例
+func NewLogic(dal dal.DAL, converter converter.Converter) AccessReviewLogic {
+	return &LogicImpl{
+		dal:       dal,
+		converter: converter,
+	}
+}
+
+// ============================================================================
+// 复核活动
+// ============================================================================
+
+// CreateAccessReviewCampaign 创建复核活动
+//
+// 范围内系统用户持有的系统角色不可撤销，不生成复核条目；范围内没有可复核的分配时拒绝创建。
+// 活动与条目在同一事务中写入。
+func (l *LogicImpl) CreateAccessReviewCampaign(
+	ctx context.Context,
+	req *identity_srv.CreateAccessReviewCampaignRequest,
+) (*identity_srv.AccessReviewCampaign, error) {
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, errno.ErrInvalidParams.WithMessage("复核活动名称不能为空")
+	}
+
+	now := time.Now().UnixMilli()
+	if req.DueAt != nil && req.GetDueAt() <= now {
+		return nil, errno.ErrInvalidParams.WithMessage("截止时间必须晚于当前时间")
+	}
+
+	campaign := &models.AccessReviewCampaign{
+		Name:        name,
+		Description: req.GetDescription(),
+		Status:      models.AccessReviewCampaignStatusOpen,
+		DueAt:       req.DueAt,
+		AutoRevoke:  req.GetAutoRevoke(),
+	}
+
+	var err error
+
+	if campaign.CreatedBy, err = parseOptionalID(req.GetCreatedBy(), "创建人ID"); err != nil {
+		return nil, err
+	}
+
+	if err := l.resolveScope(ctx, req, campaign); err != nil {
+		return nil, err
+	}
+
+	items, err := l.snapshotAssignments(ctx, req.GetRoleID(), req.GetOrganizationID(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) == 0 {
+		return nil, errno.ErrAccessReviewEmptyScope
+	}
+
+	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		if err := txDAL.AccessReviewCampaign().Create(ctx, campaign); err != nil {
+			return errno.ErrOperationFailed.WithMessage("创建访问复核活动失败: " + err.Error())
+		}
+
+		for _, item := range items {
+			item.CampaignID = campaign.ID
+		}
+
+		if err := txDAL.AccessReviewItem().BatchCreate(ctx, items); err != nil {
+			return errno.ErrOperationFailed.WithMessage("生成访问复核条目失败: " + err.Error())
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tracelog.Ctx(ctx).Info().
+		Str("campaign_id", campaign.ID.String()).
+		Str("organization_id", req.GetOrganizationID()).
+		Str("role_id", req.GetRoleID()).
+		Int("items", len(items)).
+		Msg("访问复核活动已创建")
+
+	counts := accessreview.DecisionCounts{models.AccessReviewDecisionPending: int64(len(items))}
+
+	return campaignToThrift(campaign, counts), nil
+}
+
+// ListAccessReviewCampaigns 分页查询复核活动
+func (l *LogicImpl) ListAccessReviewCampaigns(
+	ctx context.Context,
+	req *identity_srv.ListAccessReviewCampaignsRequest,
+) (*identity_srv.ListAccessReviewCampaignsResponse, error) {
+	opts := l.converter.Base().PageRequestToQueryOptions(req.Page)
+	if err := opts.Validate(); err != nil {
+		return nil, errno.ErrInvalidParams.WithMessage("分页参数无效: " + err.Error())
+	}
+
+	conditions := &accessreview.CampaignQueryConditions{
+		OrganizationID: req.OrganizationID,
+		Page:           opts,
+	}
+
+	if req.Status != nil &&
+		req.GetStatus() != identity_srv.AccessReviewCampaignStatus_ACCESS_REVIEW_CAMPAIGN_STATUS_UNSPECIFIED {
+		status := models.AccessReviewCampaignStatus(req.GetStatus())
+		conditions.Status = &status
+	}
+
+	campaigns, pageResult, err := l.dal.AccessReviewCampaign().FindWithConditions(ctx, conditions)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("查询访问复核活动失败: " + err.Error())
+	}
+
+	campaignIDs := make([]string, 0, len(campaigns))
+	for _, c := range campaigns {
+		campaignIDs = append(campaignIDs, c.ID.String())
+	}
+
+	counts, err := l.dal.AccessReviewItem().CountByDecision(ctx, campaignIDs)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("统计访问复核进度失败: " + err.Error())
+	}
+
+	result := make([]*identity_srv.AccessReviewCampaign, 0, len(campaigns))
+	for _, c := range campaigns {
+		result = append(result, campaignToThrift(c, counts[c.ID.String()]))
+	}
+
+	return &identity_srv.ListAccessReviewCampaignsResponse{
+		Campaigns: result,
+		Page:      l.converter.Base().PageResponseToThrift(pageResult),
+	}, nil
+}
+
+// GetAccessReviewCampaign 获取单个复核活动
+func (l *LogicImpl) GetAccessReviewCampaign(
+	ctx context.Context,
+	campaignID string,
+) (*identity_srv.AccessReviewCampaign, error) {
+	campaign, err := l.loadCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.campaignWithStats(ctx, campaign)
+}
+
+// CloseAccessReviewCampaign 手动关闭复核活动
+//
+// 开启自动撤销时，任一条目撤销失败都会中止关闭并返回错误，活动保持进行中，可重试关闭。
+func (l *LogicImpl) CloseAccessReviewCampaign(
+	ctx context.Context,
+	req *identity_srv.CloseAccessReviewCampaignRequest,
+	revoker assignment.RoleBindingRevoker,
+) (*identity_srv.AccessReviewCampaign, error) {
+	closedBy, err := parseRequiredID(req.GetClosedBy(), "关闭人ID")
+	if err != nil {
+		return nil, err
+	}
+
+	campaign, err := l.loadCampaign(ctx, req.GetCampaignID())
+	if err != nil {
+		return nil, err
+	}
+
+	if !campaign.IsOpen() {
+		return nil, errno.ErrAccessReviewCampaignClosed
+	}
+
+	if err := l.closeCampaign(ctx, campaign, &closedBy, revoker); err != nil {
+		return nil, err
+	}
+
+	return l.campaignWithStats(ctx, campaign)
+}
+
+// CloseOverdueAccessReviewCampaigns 关闭已过截止时间的活动
+//
+// 单个活动关闭失败只记录日志并跳过，留到下一轮。
+func (l *LogicImpl) CloseOverdueAccessReviewCampaigns(
+	ctx context.Context,
+	revoker assignment.RoleBindingRevoker,
+) (int, error) {
+	overdue, err := l.dal.AccessReviewCampaign().FindOverdueOpen(ctx, time.Now().UnixMilli(), reviewBatchSize)
+	if err != nil {
+		return 0, errno.ErrOperationFailed.WithMessage("查询到期访问复核活动失败: " + err.Error())
+	}
+
+	closedCount := 0
+
+	for _, campaign := range overdue {
+		if err := l.closeCampaign(ctx, campaign, nil, revoker); err != nil {
+			tracelog.Ctx(ctx).Warn().
+				Err(err).
+				Str("campaign_id", campaign.ID.String()).
+				Msg("到期访问复核活动关闭失败，下一轮重试")
+
+			continue
+		}
+
+		closedCount++
+	}
+
+	return closedCount, nil
+}
+
+// closeCampaign 按需自动撤销未复核条目后关闭活动；closedBy 为 nil 表示到期自动关闭
+func (l *LogicImpl) closeCampaign(
+	ctx context.Context,
+	campaign *models.AccessReviewCampaign,
+	closedBy *uuid.UUID,
+	revoker assignment.RoleBindingRevoker,
+) error {
+	revoked := 0
+
+	if campaign.AutoRevoke {
+		var err error
+		if revoked, err = l.autoRevokePending(ctx, campaign, revoker); err != nil {
+			return err
+		}
+	}
+
+	closedAt := time.Now().UnixMilli()
+
+	ok, err := l.dal.AccessReviewCampaign().Close(ctx, campaign.ID.String(), closedBy, closedAt)
+	if err != nil {
+		return errno.ErrOperationFailed.WithMessage("关闭访问复核活动失败: " + err.Error())
+	}
+
+	if !ok {
+		return errno.ErrAccessReviewCampaignClosed
+	}
+
+	campaign.Status = models.AccessReviewCampaignStatusClosed
+	campaign.ClosedBy = closedBy
+	campaign.ClosedAt = &closedAt
+
+	event := tracelog.Ctx(ctx).Info().
+		Str("campaign_id", campaign.ID.String()).
+		Int("auto_revoked", revoked)
+	if closedBy != nil {
+		event = event.Str("closed_by", closedBy.String())
+	}
+
+	event.Msg("访问复核活动已关闭")
+
+	return nil
+}
+
+// autoRevokePending 撤销活动下全部未复核条目对应的角色分配，返回撤销的条目数
+func (l *LogicImpl) autoRevokePending(
+	ctx context.Context,
+	campaign *models.AccessReviewCampaign,
+	revoker assignment.RoleBindingRevoker,
+) (int, error) {
+	revoked := 0
+
+	for {
+		pending, err := l.dal.AccessReviewItem().FindPending(ctx, campaign.ID.String(), reviewBatchSize)
+		if err != nil {
+			return revoked, errno.ErrOperationFailed.WithMessage("查询待复核条目失败: " + err.Error())
+		}
+
+		if len(pending) == 0 {
+			return revoked, nil
+		}
+
+		roles, err := l.rolesByID(ctx, pending)
+		if err != nil {
+			return revoked, err
+		}
+
+		for _, item := range pending {
+			decision := accessreview.ItemDecision{
+				Decision:   models.AccessReviewDecisionAutoRevoked,
+				ReviewedAt: time.Now().UnixMilli(),
+				Comment:    autoRevokeComment,
+			}
+
+			if err := l.revokeItem(ctx, item, roles[item.RoleID], decision, revoker); err != nil {
+				// 条目已被复核人并发处理，不再自动撤销
+				if errors.Is(err, errno.ErrAccessReviewItemDecided) {
+					continue
+				}
+
+				return revoked, err
+			}
+
+			revoked++
+		}
+	}
+}
+
+// ============================================================================
+// 复核条目
+// ============================================================================
+
+// ListAccessReviewItems 分页查询活动下的复核条目
+func (l *LogicImpl) ListAccessReviewItems(
+	ctx context.Context,
+	req *identity_srv.ListAccessReviewItemsRequest,
+) (*identity_srv.ListAccessReviewItemsResponse, error) {
+	campaign, err := l.loadCampaign(ctx, req.GetCampaignID())
+	if err != nil {
+		return nil, err
+	}
+
+	opts := l.converter.Base().PageRequestToQueryOptions(req.Page)
+	if err := opts.Validate(); err != nil {
+		return nil, errno.ErrInvalidParams.WithMessage("分页参数无效: " + err.Error())
+	}
+
+	conditions := &accessreview.ItemQueryConditions{
+		CampaignID: campaign.ID.String(),
+		UserID:     req.UserID,
+		Page:       opts,
+	}
+
+	if req.Decision != nil &&
+		req.GetDecision() != identity_srv.AccessReviewDecision_ACCESS_REVIEW_DECISION_UNSPECIFIED {
+		decision := models.AccessReviewDecision(req.GetDecision())
+		conditions.Decision = &decision
+	}
+
+	items, pageResult, err := l.dal.AccessReviewItem().FindWithConditions(ctx, conditions)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("查询访问复核条目失败: " + err.Error())
+	}
+
+	result, err := l.itemsToThrift(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity_srv.ListAccessReviewItemsResponse{
+		Items: result,
+		Page:  l.converter.Base().PageResponseToThrift(pageResult),
+	}, nil
+}
+
+// DecideAccessReviewItem 复核单个条目
+//
+// 仅活动进行中可复核；活动指定了复核人时只能由其复核，且任何人都不能复核自己的角色分配。
+// 撤销时条目流转、分配删除与 g 规则撤销在同一事务中完成，g 规则撤销失败则整体回滚。
+func (l *LogicImpl) DecideAccessReviewItem(
+	ctx context.Context,
+	req *identity_srv.DecideAccessReviewItemRequest,
+	revoker assignment.RoleBindingRevoker,
+) (*identity_srv.AccessReviewItem, error) {
+	reviewerID, err := parseRequiredID(req.GetReviewerID(), "复核人ID")
+	if err != nil {
+		return nil, err
+	}
+
+	var decision models.AccessReviewDecision
+
+	switch req.GetDecision() {
+	case identity_srv.AccessReviewDecision_ACCESS_REVIEW_DECISION_CERTIFIED:
+		decision = models.AccessReviewDecisionCertified
+	case identity_srv.AccessReviewDecision_ACCESS_REVIEW_DECISION_REVOKED:
+		decision = models.AccessReviewDecisionRevoked
+	default:
+		return nil, errno.ErrInvalidParams.WithMessage("复核结论只能为确认保留或撤销")
+	}
+
+	item, err := l.loadItem(ctx, req.GetItemID())
+	if err != nil {
+		return nil, err
+	}
+
+	campaign, err := l.loadCampaign(ctx, item.CampaignID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if !campaign.IsOpen() {
+		return nil, errno.ErrAccessReviewCampaignClosed
+	}
+
+	if campaign.ReviewerID != nil && *campaign.ReviewerID != reviewerID {
+		return nil, errno.ErrAccessReviewNotReviewer
+	}
+
+	if reviewerID == item.UserID {
+		return nil, errno.ErrAccessReviewSelfReview
+	}
+
+	if !item.IsPending() {
+		return nil, errno.ErrAccessReviewItemDecided
+	}
+
+	itemDecision := accessreview.ItemDecision{
+		Decision:   decision,
+		ReviewedBy: &reviewerID,
+		ReviewedAt: time.Now().UnixMilli(),
+		Comment:    req.GetComment(),
+	}
+
+	if decision == models.AccessReviewDecisionRevoked {
+		roles, err := l.rolesByID(ctx, []*models.AccessReviewItem{item})
+		if err != nil {
+			return nil, err
+		}
+
+		err = l.revokeItem(ctx, item, roles[item.RoleID], itemDecision, revoker)
+		if err != nil {
+			return nil, err
+		}
+	} else if err := decideItem(ctx, l.dal, item, itemDecision); err != nil {
+		return nil, err
+	}
+
+	tracelog.Ctx(ctx).Info().
+		Str("campaign_id", campaign.ID.String()).
+		Str("item_id", item.ID.String()).
+		Str("assignment_id", item.AssignmentID.String()).
+		Str("reviewer_id", reviewerID.String()).
+		Int8("decision", int8(decision)).
+		Msg("访问复核条目已复核")
+
+	result, err := l.itemsToThrift(ctx, []*models.AccessReviewItem{item})
+	if err != nil {
+		return nil, err
+	}
+
+	return result[0], nil
+}
+
+// revokeItem 撤销条目对应的角色分配
+//
+// 先条件流转条目，确保并发复核时只有一方生效；角色已删除时不存在对应的 g 规则，跳过撤销。
+func (l *LogicImpl) revokeItem(
+	ctx context.Context,
+	item *models.AccessReviewItem,
+	role *models.RoleDefinition,
+	decision accessreview.ItemDecision,
+	revoker assignment.RoleBindingRevoker,
+) error {
+	return l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		if err := decideItem(ctx, txDAL, item, decision); err != nil {
+			return err
+		}
+
+		if err := txDAL.UserRoleAssignment().Delete(ctx, item.AssignmentID.String()); err != nil {
+			return errno.ErrOperationFailed.WithMessage("删除角色分配失败: " + err.Error())
+		}
+
+		if role == nil || revoker == nil {
+			return nil
+		}
+
+		domain := ""
+		if item.OrganizationID != nil {
+			domain = item.OrganizationID.String()
+		}
+
+		if err := revoker.RevokeRoleBinding(ctx, item.UserID.String(), role.RoleCode, domain); err != nil {
+			return errno.ErrOperationFailed.WithMessage("撤销策略角色绑定失败: " + err.Error())
+		}
+
+		return nil
+	})
+}
+
+// decideItem 流转待复核条目，条目已被处理时返回 ErrAccessReviewItemDecided
+func decideItem(
+	ctx context.Context,
+	d dal.DAL,
+	item *models.AccessReviewItem,
+	decision accessreview.ItemDecision,
+) error {
+	ok, err := d.AccessReviewItem().Decide(ctx, item.ID.String(), decision)
+	if err != nil {
+		return errno.ErrOperationFailed.WithMessage("更新访问复核条目失败: " + err.Error())
+	}
+
+	if !ok {
+		return errno.ErrAccessReviewItemDecided
+	}
+
+	item.Decision = decision.Decision
+	item.ReviewedBy = decision.ReviewedBy
+	item.ReviewedAt = &decision.ReviewedAt
+	item.Comment = decision.Comment
+
+	return nil
+}
+
+// ============================================================================
+// 内部辅助
+// ============================================================================
+
+// resolveScope 校验复核范围与指定复核人并写入活动
+func (l *LogicImpl) resolveScope(
+	ctx context.Context,
+	req *identity_srv.CreateAccessReviewCampaignRequest,
+	campaign *models.AccessReviewCampaign,
+) error {
+	var err error
+
+	if campaign.OrganizationID, err = parseOptionalID(req.GetOrganizationID(), "组织ID"); err != nil {
+		return err
+	}
+
+	if campaign.OrganizationID != nil {
+		if _, err := l.dal.Organization().GetByID(ctx, req.GetOrganizationID()); err != nil {
+			if errno.IsRecordNotFound(err) {
+				return errno.ErrOrganizationNotFound
+			}
+
+			return errno.ErrOperationFailed.WithMessage("查询组织失败: " + err.Error())
+		}
+	}
+
+	if campaign.RoleID, err = parseOptionalID(req.GetRoleID(), "角色ID"); err != nil {
+		return err
+	}
+
+	if campaign.RoleID != nil {
+		if _, err := l.dal.RoleDefinition().GetByID(ctx, req.GetRoleID()); err != nil {
+			if errno.IsRecordNotFound(err) {
+				return errno.ErrRoleDefinitionNotFound
+			}
+
+			return errno.ErrOperationFailed.WithMessage("查询角色信息失败: " + err.Error())
+		}
+	}
+
+	if campaign.ReviewerID, err = parseOptionalID(req.GetReviewerID(), "复核人ID"); err != nil {
+		return err
+	}
+
+	if campaign.ReviewerID != nil {
+		if _, err := l.dal.UserProfile().GetByID(ctx, req.GetReviewerID()); err != nil {
+			if errno.IsRecordNotFound(err) {
+				return errno.ErrUserNotFound.WithMessage("指定的复核人不存在")
+			}
+
+			return errno.ErrOperationFailed.WithMessage("查询复核人失败: " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+// snapshotAssignments 把复核范围内尚未到期的分配转为待复核条目，
+// 系统用户持有的系统角色不可撤销，不纳入复核
+func (l *LogicImpl) snapshotAssignments(
+	ctx context.Context,
+	roleID, organizationID string,
+	now int64,
+) ([]*models.AccessReviewItem, error) {
+	assignments, err := l.dal.UserRoleAssignment().FindUnexpiredInScope(ctx, roleID, organizationID, now)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("查询复核范围内的角色分配失败: " + err.Error())
+	}
+
+	if len(assignments) == 0 {
+		return nil, nil
+	}
+
+	userIDs := make([]string, 0, len(assignments))
+	roleIDs := make([]string, 0, len(assignments))
+
+	for _, a := range assignments {
+		userIDs = append(userIDs, a.UserID.String())
+		roleIDs = append(roleIDs, a.RoleID.String())
+	}
+
+	users, err := l.usersByID(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := l.batchGetRoles(ctx, roleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*models.AccessReviewItem, 0, len(assignments))
+
+	for _, a := range assignments {
+		user, role := users[a.UserID], roles[a.RoleID]
+		if user != nil && user.IsSystemUser && role != nil && role.IsSystemRole {
+			continue
+		}
+
+		items = append(items, &models.AccessReviewItem{
+			AssignmentID:   a.ID,
+			UserID:         a.UserID,
+			RoleID:         a.RoleID,
+			OrganizationID: a.OrganizationID,
+			ValidUntil:     a.ValidUntil,
+			Decision:       models.AccessReviewDecisionPending,
+		})
+	}
+
+	return items, nil
+}
+
+// campaignWithStats 查询活动的完成情况并转换为 Thrift 结构
+func (l *LogicImpl) campaignWithStats(
+	ctx context.Context,
+	campaign *models.AccessReviewCampaign,
+) (*identity_srv.AccessReviewCampaign, error) {
+	campaignID := campaign.ID.String()
+
+	counts, err := l.dal.AccessReviewItem().CountByDecision(ctx, []string{campaignID})
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("统计访问复核进度失败: " + err.Error())
+	}
+
+	return campaignToThrift(campaign, counts[campaignID]), nil
+}
+
+// loadCampaign 按ID查询活动，不存在时返回 ErrAccessReviewCampaignNotFound
+func (l *LogicImpl) loadCampaign(ctx context.Context, campaignID string) (*models.AccessReviewCampaign, error) {
+	if _, err := uuid.Parse(campaignID); err != nil {
+		return nil, errno.ErrInvalidParams.WithMessage("复核活动ID格式无效")
+	}
+
+	campaign, err := l.dal.AccessReviewCampaign().GetByID(ctx, campaignID)
+	if err != nil {
+		if errno.IsRecordNotFound(err) {
+			return nil, errno.ErrAccessReviewCampaignNotFound
+		}
+
+		return nil, errno.ErrOperationFailed.WithMessage("查询访问复核活动失败: " + err.Error())
+	}
+
+	return campaign, nil
+}
+
+// loadItem 按ID查询条目，不存在时返回 ErrAccessReviewItemNotFound
+func (l *LogicImpl) loadItem(ctx context.Context, itemID string) (*models.AccessReviewItem, error) {
+	if _, err := uuid.Parse(itemID); err != nil {
+		return nil, errno.ErrInvalidParams.WithMessage("复核条目ID格式无效")
+	}
+
+	item, err := l.dal.AccessReviewItem().GetByID(ctx, itemID)
+	if err != nil {
+		if errno.IsRecordNotFound(err) {
+			return nil, errno.ErrAccessReviewItemNotFound
+		}
+
+		return nil, errno.ErrOperationFailed.WithMessage("查询访问复核条目失败: " + err.Error())
+	}
+
+	return item, nil
+}
+
+// itemsToThrift 批量补充用户与角色信息后转换为 Thrift 结构
+func (l *LogicImpl) itemsToThrift(
+	ctx context.Context,
+	items []*models.AccessReviewItem,
+) ([]*identity_srv.AccessReviewItem, error) {
+	result := make([]*identity_srv.AccessReviewItem, 0, len(items))
+	if len(items) == 0 {
+		return result, nil
+	}
+
+	userIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		userIDs = append(userIDs, item.UserID.String())
+	}
+
+	users, err := l.usersByID(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := l.rolesByID(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		result = append(result, itemToThrift(item, users[item.UserID], roles[item.RoleID]))
+	}
+
+	return result, nil
+}
+
+// rolesByID 批量查询条目涉及的角色，返回 map[roleID]role
+func (l *LogicImpl) rolesByID(
+	ctx context.Context,
+	items []*models.AccessReviewItem,
+) (map[uuid.UUID]*models.RoleDefinition, error) {
+	roleIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		roleIDs = append(roleIDs, item.RoleID.String())
+	}
+
+	return l.batchGetRoles(ctx, roleIDs)
+}
+
+// batchGetRoles 去重后批量查询角色，返回 map[roleID]role
+func (l *LogicImpl) batchGetRoles(
+	ctx context.Context,
+	roleIDs []string,
+) (map[uuid.UUID]*models.RoleDefinition, error) {
+	roleIDs = dedupe(roleIDs)
+
+	roles := make(map[uuid.UUID]*models.RoleDefinition, len(roleIDs))
+	if len(roleIDs) == 0 {
+		return roles, nil
+	}
+
+	roleModels, err := l.dal.RoleDefinition().BatchGetByIDs(ctx, roleIDs)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("获取角色详情失败: " + err.Error())
+	}
+
+	for _, role := range roleModels {
+		if role != nil {
+			roles[role.ID] = role
+		}
+	}
+
+	return roles, nil
+}
+
+// usersByID 去重后批量查询用户，返回 map[userID]user
+func (l *LogicImpl) usersByID(
+	ctx context.Context,
+	userIDs []string,
+) (map[uuid.UUID]*models.UserProfile, error) {
+	userIDs = dedupe(userIDs)
+
+	users := make(map[uuid.UUID]*models.UserProfile, len(userIDs))
+	if len(userIDs) == 0 {
+		return users, nil
+	}
+
+	userModels, err := l.dal.UserProfile().BatchGetByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("获取用户详情失败: " + err.Error())
+	}
+
+	for _, user := range userModels {
+		if user != nil {
+			users[user.ID] = user
+		}
+	}
+
+	return users, nil
+}
+
+// ============================================================================
+// 模型转换
+// ============================================================================
+
+// campaignToThrift 把活动模型与条目统计转换为 Thrift 结构
+func campaignToThrift(
+	campaign *models.AccessReviewCampaign,
+	counts accessreview.DecisionCounts,
+) *identity_srv.AccessReviewCampaign {
+	status := identity_srv.AccessReviewCampaignStatus(campaign.Status)
+
+	return &identity_srv.AccessReviewCampaign{
+		Id:             convutil.StringPtr(campaign.ID.String()),
+		Name:           convutil.StringPtr(campaign.Name),
+		Description:    convutil.StringPtr(campaign.Description),
+		OrganizationID: uuidPtrToString(campaign.OrganizationID),
+		RoleID:         uuidPtrToString(campaign.RoleID),
+		ReviewerID:     uuidPtrToString(campaign.ReviewerID),
+		Status:         &status,
+		DueAt:          campaign.DueAt,
+		AutoRevoke:     convutil.BoolPtr(campaign.AutoRevoke),
+		CreatedBy:      uuidPtrToString(campaign.CreatedBy),
+		ClosedBy:       uuidPtrToString(campaign.ClosedBy),
+		ClosedAt:       campaign.ClosedAt,
+		CreatedAt:      &campaign.CreatedAt,
+		Stats:          statsToThrift(counts),
+	}
+}
+
+// statsToThrift 由各复核结论的条目数计算完成情况，已作出结论（含自动撤销）的条目视为已完成
+func statsToThrift(counts accessreview.DecisionCounts) *identity_srv.AccessReviewStats {
+	pending := int32(counts[models.AccessReviewDecisionPending])
+	certified := int32(counts[models.AccessReviewDecisionCertified])
+	revoked := int32(counts[models.AccessReviewDecisionRevoked])
+	autoRevoked := int32(counts[models.AccessReviewDecisionAutoRevoked])
+	total := pending + certified + revoked + autoRevoked
+
+	completion := int32(0)
+	if total > 0 {
+		completion = (total - pending) * 100 / total
+	}
+
+	return &identity_srv.AccessReviewStats{
+		Total:             &total,
+		Pending:           &pending,
+		Certified:         &certified,
+		Revoked:           &revoked,
+		AutoRevoked:       &autoRevoked,
+		CompletionPercent: &completion,
+	}
+}
+
+// itemToThrift 把条目模型转换为 Thrift 结构；用户或角色已删除时对应字段留空
+func itemToThrift(
+	item *models.AccessReviewItem,
+	user *models.UserProfile,
+	role *models.RoleDefinition,
+) *identity_srv.AccessReviewItem {
+	decision := identity_srv.AccessReviewDecision(item.Decision)
+
+	result := &identity_srv.AccessReviewItem{
+		Id:             convutil.StringPtr(item.ID.String()),
+		CampaignID:     convutil.StringPtr(item.CampaignID.String()),
+		AssignmentID:   convutil.StringPtr(item.AssignmentID.String()),
+		UserID:         convutil.StringPtr(item.UserID.String()),
+		RoleID:         convutil.StringPtr(item.RoleID.String()),
+		OrganizationID: uuidPtrToString(item.OrganizationID),
+		ValidUntil:     item.ValidUntil,
+		Decision:       &decision,
+		ReviewedBy:     uuidPtrToString(item.ReviewedBy),
+		ReviewedAt:     item.ReviewedAt,
+	}
+
+	if item.Comment != "" {
+		result.Comment = convutil.StringPtr(item.Comment)
+	}
+
+	if user != nil {
+		result.Username = convutil.StringPtr(user.Username)
+		result.RealName = convutil.StringPtr(user.RealName)
+	}
+
+	if role != nil {
+		result.RoleCode = convutil.StringPtr(role.RoleCode)
+		result.RoleName = convutil.StringPtr(role.Name)
+	}
+
+	return result
+}
+
+// uuidPtrToString 可选 UUID 转为可选字符串
+func uuidPtrToString(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+
+	return convutil.StringPtr(id.String())
+}
+
+// parseRequiredID 解析必填的 UUID 参数
+func parseRequiredID(s, field string) (uuid.UUID, error) {
+	if s == "" {
+		return uuid.Nil, errno.ErrInvalidParams.WithMessage(field + "不能为空")
+	}
+
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil, errno.ErrInvalidParams.WithMessage(field + "格式无效")
+	}
+
+	return id, nil
+}
+
+// parseOptionalID 解析可选的 UUID 参数，空串返回 nil
+func parseOptionalID(s, field string) (*uuid.UUID, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	id, err := parseRequiredID(s, field)
+	if err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// dedupe 保持顺序去除重复的 ID
+func dedupe(ids []string) []string {
+	seen := make(map[string]struct{}, len(ids))
+	result := make([]string, 0, len(ids))
+
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+		result = append(result, id)
+	}
+
+	return result
+}
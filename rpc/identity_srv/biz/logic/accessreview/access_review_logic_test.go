@@ -0,0 +1,587 @@
+package accessreview
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/accessreview"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/mock"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+)
+
+// setupTest 初始化测试环境
+func setupTest(t *testing.T) (*LogicImpl, *mock.TestMocks) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mocks := mock.NewTestMocks(ctrl)
+	logic := &LogicImpl{
+		dal:       mocks.DAL,
+		converter: mocks.Converter,
+	}
+
+	return logic, mocks
+}
+
+// assertErrCode 断言错误码匹配
+func assertErrCode(t *testing.T, expected errno.ErrNo, actual error) {
+	t.Helper()
+
+	errNo, ok := actual.(errno.ErrNo)
+	require.True(t, ok, "expected errno.ErrNo, got %T: %v", actual, actual)
+	assert.Equal(t, expected.ErrCode, errNo.ErrCode)
+}
+
+// fakeRevoker 记录被撤销的 g 规则，可按用户注入失败
+type fakeRevoker struct {
+	revoked [][3]string
+	failFor string
+}
+
+func (f *fakeRevoker) RevokeRoleBinding(_ context.Context, userID, roleCode, domain string) error {
+	if userID == f.failFor {
+		return errors.New("policy_srv unavailable")
+	}
+
+	f.revoked = append(f.revoked, [3]string{userID, roleCode, domain})
+
+	return nil
+}
+
+func testRole(code string) *models.RoleDefinition {
+	return &models.RoleDefinition{
+		BaseModel: models.BaseModel{ID: uuid.New()},
+		Name:      code,
+		RoleCode:  "role:" + code,
+	}
+}
+
+func openCampaign() *models.AccessReviewCampaign {
+	return &models.AccessReviewCampaign{
+		BaseModel: models.BaseModel{ID: uuid.New()},
+		Name:      "2026 Q4 复核",
+		Status:    models.AccessReviewCampaignStatusOpen,
+	}
+}
+
+func pendingItem(campaign *models.AccessReviewCampaign, role *models.RoleDefinition) *models.AccessReviewItem {
+	return &models.AccessReviewItem{
+		BaseModel:    models.BaseModel{ID: uuid.New()},
+		CampaignID:   campaign.ID,
+		AssignmentID: uuid.New(),
+		UserID:       uuid.New(),
+		RoleID:       role.ID,
+		Decision:     models.AccessReviewDecisionPending,
+	}
+}
+
+// ============================================================================
+// 创建活动
+// ============================================================================
+
+func TestCreateAccessReviewCampaign_SnapshotsAssignmentsInScope(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	orgID := uuid.New()
+	role := testRole("nurse")
+	systemRole := &models.RoleDefinition{BaseModel: models.BaseModel{ID: uuid.New()}, IsSystemRole: true}
+	user := &models.UserProfile{BaseModel: models.BaseModel{ID: uuid.New()}, Username: "alice"}
+	systemUser := &models.UserProfile{BaseModel: models.BaseModel{ID: uuid.New()}, IsSystemUser: true}
+	createdBy := uuid.New().String()
+
+	assignments := []*models.UserRoleAssignment{
+		{BaseModel: models.BaseModel{ID: uuid.New()}, UserID: user.ID, RoleID: role.ID, OrganizationID: &orgID},
+		{BaseModel: models.BaseModel{ID: uuid.New()}, UserID: systemUser.ID, RoleID: role.ID, OrganizationID: &orgID},
+		{BaseModel: models.BaseModel{ID: uuid.New()}, UserID: systemUser.ID, RoleID: systemRole.ID, OrganizationID: &orgID},
+	}
+
+	mocks.OrgRepo.EXPECT().GetByID(ctx, orgID.String()).Return(&models.Organization{}, nil)
+	mocks.AssignmentRepo.EXPECT().FindUnexpiredInScope(ctx, "", orgID.String(), gomock.Any()).Return(assignments, nil)
+	mocks.UserRepo.EXPECT().BatchGetByIDs(ctx, gomock.Any()).Return([]*models.UserProfile{user, systemUser}, nil)
+	mocks.DefinitionRepo.EXPECT().BatchGetByIDs(ctx, gomock.Any()).
+		Return([]*models.RoleDefinition{role, systemRole}, nil)
+
+	campaignID := uuid.New()
+
+	mocks.CampaignRepo.EXPECT().Create(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, c *models.AccessReviewCampaign) error {
+			c.ID = campaignID
+			return nil
+		})
+
+	var created []*models.AccessReviewItem
+
+	mocks.ReviewItemRepo.EXPECT().BatchCreate(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, items []*models.AccessReviewItem) error {
+			created = items
+			return nil
+		})
+
+	orgIDStr := orgID.String()
+	name := " 2026 Q4 复核 "
+	autoRevoke := true
+
+	campaign, err := logic.CreateAccessReviewCampaign(ctx, &identity_srv.CreateAccessReviewCampaignRequest{
+		Name:           &name,
+		OrganizationID: &orgIDStr,
+		AutoRevoke:     &autoRevoke,
+		CreatedBy:      &createdBy,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, campaignID.String(), campaign.GetId())
+	assert.Equal(t, "2026 Q4 复核", campaign.GetName())
+	assert.Equal(t, identity_srv.AccessReviewCampaignStatus_ACCESS_REVIEW_CAMPAIGN_STATUS_OPEN, campaign.GetStatus())
+	assert.True(t, campaign.GetAutoRevoke())
+	assert.Equal(t, createdBy, campaign.GetCreatedBy())
+	assert.Equal(t, int32(2), campaign.GetStats().GetTotal())
+	assert.Equal(t, int32(2), campaign.GetStats().GetPending())
+	assert.Equal(t, int32(0), campaign.GetStats().GetCompletionPercent())
+
+	// 系统用户持有的系统角色不纳入复核
+	require.Len(t, created, 2)
+
+	for _, item := range created {
+		assert.Equal(t, campaignID, item.CampaignID)
+		assert.Equal(t, models.AccessReviewDecisionPending, item.Decision)
+		assert.Equal(t, role.ID, item.RoleID)
+	}
+}
+
+func TestCreateAccessReviewCampaign_Validation(t *testing.T) {
+	ctx := context.Background()
+	name := "复核"
+
+	t.Run("名称为空", func(t *testing.T) {
+		logic, _ := setupTest(t)
+		blank := "  "
+
+		_, err := logic.CreateAccessReviewCampaign(ctx, &identity_srv.CreateAccessReviewCampaignRequest{Name: &blank})
+
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+
+	t.Run("截止时间已过", func(t *testing.T) {
+		logic, _ := setupTest(t)
+		dueAt := time.Now().Add(-time.Hour).UnixMilli()
+
+		_, err := logic.CreateAccessReviewCampaign(ctx, &identity_srv.CreateAccessReviewCampaignRequest{
+			Name:  &name,
+			DueAt: &dueAt,
+		})
+
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+
+	t.Run("角色不存在", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		roleID := uuid.New().String()
+
+		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := logic.CreateAccessReviewCampaign(ctx, &identity_srv.CreateAccessReviewCampaignRequest{
+			Name:   &name,
+			RoleID: &roleID,
+		})
+
+		assertErrCode(t, errno.ErrRoleDefinitionNotFound, err)
+	})
+
+	t.Run("范围内没有可复核的分配", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+
+		mocks.AssignmentRepo.EXPECT().FindUnexpiredInScope(ctx, "", "", gomock.Any()).Return(nil, nil)
+
+		_, err := logic.CreateAccessReviewCampaign(ctx, &identity_srv.CreateAccessReviewCampaignRequest{Name: &name})
+
+		assertErrCode(t, errno.ErrAccessReviewEmptyScope, err)
+	})
+}
+
+// ============================================================================
+// 复核条目
+// ============================================================================
+
+func TestDecideAccessReviewItem_Certify(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	role := testRole("nurse")
+	campaign := openCampaign()
+	item := pendingItem(campaign, role)
+	reviewerID := uuid.New()
+	reviewer := reviewerID.String()
+	itemID := item.ID.String()
+	comment := "岗位仍需要"
+	decision := identity_srv.AccessReviewDecision_ACCESS_REVIEW_DECISION_CERTIFIED
+
+	mocks.ReviewItemRepo.EXPECT().GetByID(ctx, itemID).Return(item, nil)
+	mocks.CampaignRepo.EXPECT().GetByID(ctx, campaign.ID.String()).Return(campaign, nil)
+	mocks.ReviewItemRepo.EXPECT().Decide(ctx, itemID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, d accessreview.ItemDecision) (bool, error) {
+			assert.Equal(t, models.AccessReviewDecisionCertified, d.Decision)
+			assert.Equal(t, reviewerID, *d.ReviewedBy)
+			assert.Equal(t, comment, d.Comment)
+
+			return true, nil
+		})
+	mocks.UserRepo.EXPECT().BatchGetByIDs(ctx, []string{item.UserID.String()}).Return(nil, nil)
+	mocks.DefinitionRepo.EXPECT().BatchGetByIDs(ctx, []string{role.ID.String()}).
+		Return([]*models.RoleDefinition{role}, nil)
+
+	revoker := &fakeRevoker{}
+
+	result, err := logic.DecideAccessReviewItem(ctx, &identity_srv.DecideAccessReviewItemRequest{
+		ItemID:     &itemID,
+		Decision:   &decision,
+		ReviewerID: &reviewer,
+		Comment:    &comment,
+	}, revoker)
+
+	require.NoError(t, err)
+	assert.Equal(t, decision, result.GetDecision())
+	assert.Equal(t, reviewer, result.GetReviewedBy())
+	assert.Equal(t, role.RoleCode, result.GetRoleCode())
+	assert.Empty(t, revoker.revoked)
+}
+
+func TestDecideAccessReviewItem_RevokeRemovesAssignmentAndBinding(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	role := testRole("nurse")
+	orgID := uuid.New()
+	campaign := openCampaign()
+	item := pendingItem(campaign, role)
+	item.OrganizationID = &orgID
+	reviewer := uuid.New().String()
+	itemID := item.ID.String()
+	decision := identity_srv.AccessReviewDecision_ACCESS_REVIEW_DECISION_REVOKED
+
+	mocks.ReviewItemRepo.EXPECT().GetByID(ctx, itemID).Return(item, nil)
+	mocks.CampaignRepo.EXPECT().GetByID(ctx, campaign.ID.String()).Return(campaign, nil)
+	mocks.DefinitionRepo.EXPECT().BatchGetByIDs(ctx, []string{role.ID.String()}).
+		Return([]*models.RoleDefinition{role}, nil).Times(2)
+	mocks.ReviewItemRepo.EXPECT().Decide(ctx, itemID, gomock.Any()).Return(true, nil)
+	mocks.AssignmentRepo.EXPECT().Delete(ctx, item.AssignmentID.String()).Return(nil)
+	mocks.UserRepo.EXPECT().BatchGetByIDs(ctx, gomock.Any()).Return(nil, nil)
+
+	revoker := &fakeRevoker{}
+
+	result, err := logic.DecideAccessReviewItem(ctx, &identity_srv.DecideAccessReviewItemRequest{
+		ItemID:     &itemID,
+		Decision:   &decision,
+		ReviewerID: &reviewer,
+	}, revoker)
+
+	require.NoError(t, err)
+	assert.Equal(t, decision, result.GetDecision())
+	assert.Equal(t, [][3]string{{item.UserID.String(), role.RoleCode, orgID.String()}}, revoker.revoked)
+}
+
+func TestDecideAccessReviewItem_RevokeFailsClosed(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	role := testRole("nurse")
+	campaign := openCampaign()
+	item := pendingItem(campaign, role)
+	reviewer := uuid.New().String()
+	itemID := item.ID.String()
+	decision := identity_srv.AccessReviewDecision_ACCESS_REVIEW_DECISION_REVOKED
+
+	mocks.ReviewItemRepo.EXPECT().GetByID(ctx, itemID).Return(item, nil)
+	mocks.CampaignRepo.EXPECT().GetByID(ctx, campaign.ID.String()).Return(campaign, nil)
+	mocks.DefinitionRepo.EXPECT().BatchGetByIDs(ctx, gomock.Any()).Return([]*models.RoleDefinition{role}, nil)
+	mocks.ReviewItemRepo.EXPECT().Decide(ctx, itemID, gomock.Any()).Return(true, nil)
+	mocks.AssignmentRepo.EXPECT().Delete(ctx, item.AssignmentID.String()).Return(nil)
+
+	_, err := logic.DecideAccessReviewItem(ctx, &identity_srv.DecideAccessReviewItemRequest{
+		ItemID:     &itemID,
+		Decision:   &decision,
+		ReviewerID: &reviewer,
+	}, &fakeRevoker{failFor: item.UserID.String()})
+
+	// 返回错误即事务回滚，条目与分配保持原状
+	assertErrCode(t, errno.ErrOperationFailed, err)
+}
+
+func TestDecideAccessReviewItem_Rejections(t *testing.T) {
+	ctx := context.Background()
+	role := testRole("nurse")
+	certified := identity_srv.AccessReviewDecision_ACCESS_REVIEW_DECISION_CERTIFIED
+
+	t.Run("不支持的复核结论", func(t *testing.T) {
+		logic, _ := setupTest(t)
+		itemID := uuid.New().String()
+		reviewer := uuid.New().String()
+		autoRevoked := identity_srv.AccessReviewDecision_ACCESS_REVIEW_DECISION_AUTO_REVOKED
+
+		_, err := logic.DecideAccessReviewItem(ctx, &identity_srv.DecideAccessReviewItemRequest{
+			ItemID:     &itemID,
+			Decision:   &autoRevoked,
+			ReviewerID: &reviewer,
+		}, nil)
+
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+
+	tests := []struct {
+		name     string
+		prepare  func(c *models.AccessReviewCampaign, item *models.AccessReviewItem) string
+		expected errno.ErrNo
+	}{
+		{
+			name: "活动已关闭",
+			prepare: func(c *models.AccessReviewCampaign, _ *models.AccessReviewItem) string {
+				c.Status = models.AccessReviewCampaignStatusClosed
+				return uuid.New().String()
+			},
+			expected: errno.ErrAccessReviewCampaignClosed,
+		},
+		{
+			name: "非指定复核人",
+			prepare: func(c *models.AccessReviewCampaign, _ *models.AccessReviewItem) string {
+				reviewerID := uuid.New()
+				c.ReviewerID = &reviewerID
+
+				return uuid.New().String()
+			},
+			expected: errno.ErrAccessReviewNotReviewer,
+		},
+		{
+			name: "复核自己的分配",
+			prepare: func(_ *models.AccessReviewCampaign, item *models.AccessReviewItem) string {
+				return item.UserID.String()
+			},
+			expected: errno.ErrAccessReviewSelfReview,
+		},
+		{
+			name: "条目已复核",
+			prepare: func(_ *models.AccessReviewCampaign, item *models.AccessReviewItem) string {
+				item.Decision = models.AccessReviewDecisionCertified
+				return uuid.New().String()
+			},
+			expected: errno.ErrAccessReviewItemDecided,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logic, mocks := setupTest(t)
+
+			campaign := openCampaign()
+			item := pendingItem(campaign, role)
+			reviewer := tt.prepare(campaign, item)
+			itemID := item.ID.String()
+
+			mocks.ReviewItemRepo.EXPECT().GetByID(ctx, itemID).Return(item, nil)
+			mocks.CampaignRepo.EXPECT().GetByID(ctx, campaign.ID.String()).Return(campaign, nil)
+
+			_, err := logic.DecideAccessReviewItem(ctx, &identity_srv.DecideAccessReviewItemRequest{
+				ItemID:     &itemID,
+				Decision:   &certified,
+				ReviewerID: &reviewer,
+			}, &fakeRevoker{})
+
+			assertErrCode(t, tt.expected, err)
+		})
+	}
+}
+
+func TestDecideAccessReviewItem_ConcurrentDecision(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	campaign := openCampaign()
+	item := pendingItem(campaign, testRole("nurse"))
+	reviewer := uuid.New().String()
+	itemID := item.ID.String()
+	decision := identity_srv.AccessReviewDecision_ACCESS_REVIEW_DECISION_CERTIFIED
+
+	mocks.ReviewItemRepo.EXPECT().GetByID(ctx, itemID).Return(item, nil)
+	mocks.CampaignRepo.EXPECT().GetByID(ctx, campaign.ID.String()).Return(campaign, nil)
+	mocks.ReviewItemRepo.EXPECT().Decide(ctx, itemID, gomock.Any()).Return(false, nil)
+
+	_, err := logic.DecideAccessReviewItem(ctx, &identity_srv.DecideAccessReviewItemRequest{
+		ItemID:     &itemID,
+		Decision:   &decision,
+		ReviewerID: &reviewer,
+	}, nil)
+
+	assertErrCode(t, errno.ErrAccessReviewItemDecided, err)
+}
+
+// ============================================================================
+// 关闭活动
+// ============================================================================
+
+func TestCloseAccessReviewCampaign_AutoRevokesPendingItems(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	role := testRole("nurse")
+	campaign := openCampaign()
+	campaign.AutoRevoke = true
+	campaignID := campaign.ID.String()
+	first, second := pendingItem(campaign, role), pendingItem(campaign, role)
+	closedBy := uuid.New().String()
+
+	mocks.CampaignRepo.EXPECT().GetByID(ctx, campaignID).Return(campaign, nil)
+
+	gomock.InOrder(
+		mocks.ReviewItemRepo.EXPECT().FindPending(ctx, campaignID, reviewBatchSize).
+			Return([]*models.AccessReviewItem{first, second}, nil),
+		mocks.ReviewItemRepo.EXPECT().FindPending(ctx, campaignID, reviewBatchSize).Return(nil, nil),
+	)
+
+	mocks.DefinitionRepo.EXPECT().BatchGetByIDs(ctx, []string{role.ID.String()}).
+		Return([]*models.RoleDefinition{role}, nil)
+	// second 已被复核人并发处理，跳过
+	mocks.ReviewItemRepo.EXPECT().Decide(ctx, first.ID.String(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, d accessreview.ItemDecision) (bool, error) {
+			assert.Equal(t, models.AccessReviewDecisionAutoRevoked, d.Decision)
+			assert.Nil(t, d.ReviewedBy)
+
+			return true, nil
+		})
+	mocks.ReviewItemRepo.EXPECT().Decide(ctx, second.ID.String(), gomock.Any()).Return(false, nil)
+	mocks.AssignmentRepo.EXPECT().Delete(ctx, first.AssignmentID.String()).Return(nil)
+	mocks.CampaignRepo.EXPECT().Close(ctx, campaignID, gomock.Any(), gomock.Any()).Return(true, nil)
+	mocks.ReviewItemRepo.EXPECT().CountByDecision(ctx, []string{campaignID}).
+		Return(map[string]accessreview.DecisionCounts{campaignID: {
+			models.AccessReviewDecisionCertified:   1,
+			models.AccessReviewDecisionAutoRevoked: 1,
+		}}, nil)
+
+	revoker := &fakeRevoker{}
+
+	result, err := logic.CloseAccessReviewCampaign(ctx, &identity_srv.CloseAccessReviewCampaignRequest{
+		CampaignID: &campaignID,
+		ClosedBy:   &closedBy,
+	}, revoker)
+
+	require.NoError(t, err)
+	assert.Equal(t, identity_srv.AccessReviewCampaignStatus_ACCESS_REVIEW_CAMPAIGN_STATUS_CLOSED, result.GetStatus())
+	assert.Equal(t, closedBy, result.GetClosedBy())
+	assert.Equal(t, int32(100), result.GetStats().GetCompletionPercent())
+	assert.Equal(t, [][3]string{{first.UserID.String(), role.RoleCode, ""}}, revoker.revoked)
+}
+
+func TestCloseAccessReviewCampaign_RevokeFailureKeepsCampaignOpen(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	role := testRole("nurse")
+	campaign := openCampaign()
+	campaign.AutoRevoke = true
+	campaignID := campaign.ID.String()
+	item := pendingItem(campaign, role)
+	closedBy := uuid.New().String()
+
+	mocks.CampaignRepo.EXPECT().GetByID(ctx, campaignID).Return(campaign, nil)
+	mocks.ReviewItemRepo.EXPECT().FindPending(ctx, campaignID, reviewBatchSize).
+		Return([]*models.AccessReviewItem{item}, nil)
+	mocks.DefinitionRepo.EXPECT().BatchGetByIDs(ctx, gomock.Any()).Return([]*models.RoleDefinition{role}, nil)
+	mocks.ReviewItemRepo.EXPECT().Decide(ctx, item.ID.String(), gomock.Any()).Return(true, nil)
+	mocks.AssignmentRepo.EXPECT().Delete(ctx, item.AssignmentID.String()).Return(nil)
+
+	_, err := logic.CloseAccessReviewCampaign(ctx, &identity_srv.CloseAccessReviewCampaignRequest{
+		CampaignID: &campaignID,
+		ClosedBy:   &closedBy,
+	}, &fakeRevoker{failFor: item.UserID.String()})
+
+	// 未调用 Close，活动保持进行中
+	assertErrCode(t, errno.ErrOperationFailed, err)
+}
+
+func TestCloseAccessReviewCampaign_AlreadyClosed(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	campaign := openCampaign()
+	campaign.Status = models.AccessReviewCampaignStatusClosed
+	campaignID := campaign.ID.String()
+	closedBy := uuid.New().String()
+
+	mocks.CampaignRepo.EXPECT().GetByID(ctx, campaignID).Return(campaign, nil)
+
+	_, err := logic.CloseAccessReviewCampaign(ctx, &identity_srv.CloseAccessReviewCampaignRequest{
+		CampaignID: &campaignID,
+		ClosedBy:   &closedBy,
+	}, nil)
+
+	assertErrCode(t, errno.ErrAccessReviewCampaignClosed, err)
+}
+
+func TestCloseOverdueAccessReviewCampaigns(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	keep := openCampaign()
+	failed := openCampaign()
+
+	mocks.CampaignRepo.EXPECT().FindOverdueOpen(ctx, gomock.Any(), reviewBatchSize).
+		Return([]*models.AccessReviewCampaign{keep, failed}, nil)
+	mocks.CampaignRepo.EXPECT().Close(ctx, keep.ID.String(), nil, gomock.Any()).Return(true, nil)
+	mocks.CampaignRepo.EXPECT().Close(ctx, failed.ID.String(), nil, gomock.Any()).
+		Return(false, errors.New("db down"))
+
+	count, err := logic.CloseOverdueAccessReviewCampaigns(ctx, &fakeRevoker{})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, models.AccessReviewCampaignStatusClosed, keep.Status)
+	assert.Nil(t, keep.ClosedBy)
+	assert.True(t, failed.IsOpen())
+}
+
+// ============================================================================
+// 查询
+// ============================================================================
+
+func TestListAccessReviewCampaigns_AttachesStats(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	campaign := openCampaign()
+	campaignID := campaign.ID.String()
+	status := identity_srv.AccessReviewCampaignStatus_ACCESS_REVIEW_CAMPAIGN_STATUS_OPEN
+
+	mocks.CampaignRepo.EXPECT().FindWithConditions(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, c *accessreview.CampaignQueryConditions) (
+			[]*models.AccessReviewCampaign, *models.PageResult, error,
+		) {
+			require.NotNil(t, c.Status)
+			assert.Equal(t, models.AccessReviewCampaignStatusOpen, *c.Status)
+
+			return []*models.AccessReviewCampaign{campaign}, &models.PageResult{Total: 1}, nil
+		})
+	mocks.ReviewItemRepo.EXPECT().CountByDecision(ctx, []string{campaignID}).
+		Return(map[string]accessreview.DecisionCounts{campaignID: {
+			models.AccessReviewDecisionPending:   3,
+			models.AccessReviewDecisionCertified: 1,
+		}}, nil)
+
+	resp, err := logic.ListAccessReviewCampaigns(ctx, &identity_srv.ListAccessReviewCampaignsRequest{Status: &status})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Campaigns, 1)
+
+	stats := resp.Campaigns[0].GetStats()
+	assert.Equal(t, int32(4), stats.GetTotal())
+	assert.Equal(t, int32(3), stats.GetPending())
+	assert.Equal(t, int32(1), stats.GetCertified())
+	assert.Equal(t, int32(25), stats.GetCompletionPercent())
+}
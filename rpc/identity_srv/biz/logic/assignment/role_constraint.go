@@ -0,0 +1,480 @@
+package assignment
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	assignmentDal "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/assignment"
+	definitionDal "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/definition"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+)
+
+// ============================================================================
+// 职责分离约束
+// ============================================================================
+
+// RoleGrant 即将写入的角色分配：用户、角色及生效时间窗口，nil 表示不限
+type RoleGrant struct {
+	UserID     string
+	Role       *models.RoleDefinition
+	ValidFrom  *int64
+	ValidUntil *int64
+}
+
+// rivalRole 与待分配角色处于同一互斥组的角色
+type rivalRole struct {
+	role  *models.RoleDefinition
+	group string
+}
+
+// RoleConstraintChecker 职责分离约束检查器，角色分配、审批、批量导入与回收站恢复共用同一套规则：
+//   - 最大分配人数：按持有未到期分配的去重用户计数，存量已超额时只拒绝继续增加人数
+//   - 互斥角色组：用户持有同组其他角色且生效时间窗口重叠时拒绝，不区分组织范围
+//
+// 检查器按角色缓存库中现有分配，只在一次操作内使用；事务内写入时应传入事务内的仓储。
+type RoleConstraintChecker struct {
+	assignments assignmentDal.UserRoleAssignmentRepository
+	roles       definitionDal.RoleDefinitionRepository
+	now         int64
+
+	holders     map[uuid.UUID][]*models.UserRoleAssignment // 角色 -> 未到期分配
+	constrained []*models.RoleDefinition                   // 配置了约束的角色，nil 表示尚未查询
+	reserved    []RoleGrant                                // 已通过校验、尚未写库的分配
+}
+
+// NewRoleConstraintChecker 创建职责分离约束检查器
+func NewRoleConstraintChecker(
+	assignments assignmentDal.UserRoleAssignmentRepository,
+	roles definitionDal.RoleDefinitionRepository,
+) *RoleConstraintChecker {
+	return &RoleConstraintChecker{
+		assignments: assignments,
+		roles:       roles,
+		now:         time.Now().UnixMilli(),
+		holders:     make(map[uuid.UUID][]*models.UserRoleAssignment),
+	}
+}
+
+// roleConstraints 基于当前 DAL 创建约束检查器
+func (l *LogicImpl) roleConstraints() *RoleConstraintChecker {
+	return NewRoleConstraintChecker(l.dal.UserRoleAssignment(), l.dal.RoleDefinition())
+}
+
+// Check 校验写入 grants 后是否违反职责分离约束，违反时返回 ErrRoleConstraintViolation
+//
+// grants 之间、grants 与 Reserve 登记的分配之间同样按上述规则校验。
+// ignore 用于排除即将被替换或修改的现有分配，为 nil 表示不排除。
+func (c *RoleConstraintChecker) Check(
+	ctx context.Context,
+	grants []RoleGrant,
+	ignore func(*models.UserRoleAssignment) bool,
+) error {
+	if ignore == nil {
+		ignore = func(*models.UserRoleAssignment) bool { return false }
+	}
+
+	if err := c.checkMaxAssignments(ctx, grants, ignore); err != nil {
+		return err
+	}
+
+	return c.checkExclusiveGroups(ctx, grants, ignore)
+}
+
+// Reserve 登记已通过校验但尚未写库的分配，计入之后的 Check
+//
+// 用于批量导入：逐行校验时前面通过校验的行同样占用分配人数。
+func (c *RoleConstraintChecker) Reserve(grants []RoleGrant) {
+	c.reserved = append(c.reserved, grants...)
+}
+
+func (c *RoleConstraintChecker) checkMaxAssignments(
+	ctx context.Context,
+	grants []RoleGrant,
+	ignore func(*models.UserRoleAssignment) bool,
+) error {
+	checked := make(map[uuid.UUID]bool, len(grants))
+
+	for _, g := range grants {
+		role := g.Role
+		if role.MaxAssignments <= 0 || checked[role.ID] {
+			continue
+		}
+
+		checked[role.ID] = true
+
+		existing, err := c.roleHolders(ctx, role)
+		if err != nil {
+			return err
+		}
+
+		before := make(map[string]bool, len(existing))
+		after := make(map[string]bool, len(existing)+len(grants))
+
+		for _, a := range existing {
+			before[a.UserID.String()] = true
+
+			if !ignore(a) {
+				after[a.UserID.String()] = true
+			}
+		}
+
+		for _, r := range c.reserved {
+			if r.Role.ID == role.ID {
+				before[r.UserID] = true
+				after[r.UserID] = true
+			}
+		}
+
+		for _, other := range grants {
+			if other.Role.ID == role.ID {
+				after[other.UserID] = true
+			}
+		}
+
+		if len(after) > int(role.MaxAssignments) && len(after) > len(before) {
+			return errno.ErrRoleConstraintViolation.WithMessage(fmt.Sprintf(
+				"角色 %s 最多分配给 %d 个用户，分配后将达到 %d 个", role.Name, role.MaxAssignments, len(after),
+			))
+		}
+	}
+
+	return nil
+}
+
+func (c *RoleConstraintChecker) checkExclusiveGroups(
+	ctx context.Context,
+	grants []RoleGrant,
+	ignore func(*models.UserRoleAssignment) bool,
+) error {
+	// 先在待写入的分配之间检查，无需查库
+	for i, g := range grants {
+		if len(g.Role.ExclusiveGroups) == 0 {
+			continue
+		}
+
+		for _, other := range slices.Concat(c.reserved, grants[:i]) {
+			if other.UserID != g.UserID || other.Role.ID == g.Role.ID ||
+				!windowsOverlap(g.ValidFrom, g.ValidUntil, other.ValidFrom, other.ValidUntil) {
+				continue
+			}
+
+			if group, ok := sharedGroup(g.Role.ExclusiveGroups, other.Role.ExclusiveGroups); ok {
+				return errno.ErrRoleConstraintViolation.WithMessage(fmt.Sprintf(
+					"角色 %s 与角色 %s 互斥（互斥组 %s），不能同时分配", other.Role.Name, g.Role.Name, group,
+				))
+			}
+		}
+	}
+
+	rivals := make(map[uuid.UUID]map[uuid.UUID]rivalRole, len(grants))
+	rivalIDs := make([]string, 0)
+	grantsByUser := make(map[string][]RoleGrant, len(grants))
+	userIDs := make([]string, 0, len(grants))
+
+	for _, g := range grants {
+		if len(g.Role.ExclusiveGroups) == 0 {
+			continue
+		}
+
+		if _, ok := rivals[g.Role.ID]; !ok {
+			roleRivals, err := c.rivalRoles(ctx, g.Role)
+			if err != nil {
+				return err
+			}
+
+			rivals[g.Role.ID] = roleRivals
+
+			for id := range roleRivals {
+				if !slices.Contains(rivalIDs, id.String()) {
+					rivalIDs = append(rivalIDs, id.String())
+				}
+			}
+		}
+
+		if _, ok := grantsByUser[g.UserID]; !ok {
+			userIDs = append(userIDs, g.UserID)
+		}
+
+		grantsByUser[g.UserID] = append(grantsByUser[g.UserID], g)
+	}
+
+	if len(rivalIDs) == 0 {
+		return nil
+	}
+
+	existing, err := c.assignments.FindUnexpiredByRoleIDs(ctx, rivalIDs, userIDs, c.now)
+	if err != nil {
+		return errno.ErrOperationFailed.WithMessage("查询角色分配失败: " + err.Error())
+	}
+
+	for _, a := range existing {
+		if ignore(a) {
+			continue
+		}
+
+		for _, g := range grantsByUser[a.UserID.String()] {
+			rival, ok := rivals[g.Role.ID][a.RoleID]
+			if !ok || !windowsOverlap(g.ValidFrom, g.ValidUntil, a.ValidFrom, a.ValidUntil) {
+				continue
+			}
+
+			return errno.ErrRoleConstraintViolation.WithMessage(fmt.Sprintf(
+				"用户 %s 已持有与角色 %s 互斥的角色 %s（互斥组 %s）",
+				g.UserID, g.Role.Name, rival.role.Name, rival.group,
+			))
+		}
+	}
+
+	return nil
+}
+
+// roleHolders 查询角色尚未到期的分配，按角色缓存
+func (c *RoleConstraintChecker) roleHolders(
+	ctx context.Context,
+	role *models.RoleDefinition,
+) ([]*models.UserRoleAssignment, error) {
+	if holders, ok := c.holders[role.ID]; ok {
+		return holders, nil
+	}
+
+	holders, err := c.assignments.FindUnexpiredByRoleIDs(ctx, []string{role.ID.String()}, nil, c.now)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("查询角色分配失败: " + err.Error())
+	}
+
+	c.holders[role.ID] = holders
+
+	return holders, nil
+}
+
+// rivalRoles 返回与 role 处于同一互斥组的其他角色
+func (c *RoleConstraintChecker) rivalRoles(
+	ctx context.Context,
+	role *models.RoleDefinition,
+) (map[uuid.UUID]rivalRole, error) {
+	if c.constrained == nil {
+		constrained, err := c.roles.FindConstrained(ctx)
+		if err != nil {
+			return nil, errno.ErrOperationFailed.WithMessage("查询角色约束失败: " + err.Error())
+		}
+
+		c.constrained = append(make([]*models.RoleDefinition, 0, len(constrained)), constrained...)
+	}
+
+	rivals := make(map[uuid.UUID]rivalRole)
+
+	for _, other := range c.constrained {
+		if other.ID == role.ID {
+			continue
+		}
+
+		if group, ok := sharedGroup(role.ExclusiveGroups, other.ExclusiveGroups); ok {
+			rivals[other.ID] = rivalRole{role: other, group: group}
+		}
+	}
+
+	return rivals, nil
+}
+
+// ListRoleConstraintViolations 扫描已存在的角色约束违例
+// 约束总是在写入分配时校验，违例只会来自约束配置之前已存在的分配
+func (l *LogicImpl) ListRoleConstraintViolations(
+	ctx context.Context,
+	req *identity_srv.ListRoleConstraintViolationsRequest,
+) (*identity_srv.ListRoleConstraintViolationsResponse, error) {
+	roleID := req.GetRoleID()
+	if roleID != "" {
+		if _, err := uuid.Parse(roleID); err != nil {
+			return nil, errno.ErrInvalidParams.WithMessage("角色ID格式无效")
+		}
+	}
+
+	roles, err := l.dal.RoleDefinition().FindConstrained(ctx)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("查询角色约束失败: " + err.Error())
+	}
+
+	roleIDs := make([]string, 0, len(roles))
+	for _, role := range roles {
+		roleIDs = append(roleIDs, role.ID.String())
+	}
+
+	assignments, err := l.dal.UserRoleAssignment().FindUnexpiredByRoleIDs(ctx, roleIDs, nil, time.Now().UnixMilli())
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("查询角色分配失败: " + err.Error())
+	}
+
+	byRole := make(map[uuid.UUID][]*models.UserRoleAssignment, len(roles))
+	for _, a := range assignments {
+		byRole[a.RoleID] = append(byRole[a.RoleID], a)
+	}
+
+	violations := append(maxAssignmentViolations(roles, byRole), exclusiveViolations(roles, byRole)...)
+
+	if roleID != "" {
+		violations = slices.DeleteFunc(violations, func(v *identity_srv.RoleConstraintViolation) bool {
+			return !slices.Contains(v.RoleIDs, roleID)
+		})
+	}
+
+	return &identity_srv.ListRoleConstraintViolationsResponse{Violations: violations}, nil
+}
+
+// maxAssignmentViolations 持有人数超过上限的角色，按角色编码排序
+func maxAssignmentViolations(
+	roles []*models.RoleDefinition,
+	byRole map[uuid.UUID][]*models.UserRoleAssignment,
+) []*identity_srv.RoleConstraintViolation {
+	var violations []*identity_srv.RoleConstraintViolation
+
+	for _, role := range roles {
+		if role.MaxAssignments <= 0 {
+			continue
+		}
+
+		userIDs := distinctUserIDs(byRole[role.ID])
+		if len(userIDs) <= int(role.MaxAssignments) {
+			continue
+		}
+
+		constraintType := identity_srv.RoleConstraintType_ROLE_CONSTRAINT_TYPE_MAX_ASSIGNMENTS
+		maxAssignments := role.MaxAssignments
+		assignedCount := int32(len(userIDs))
+
+		violations = append(violations, &identity_srv.RoleConstraintViolation{
+			Type:           &constraintType,
+			RoleIDs:        []string{role.ID.String()},
+			RoleCodes:      []string{role.RoleCode},
+			UserIDs:        userIDs,
+			MaxAssignments: &maxAssignments,
+			AssignedCount:  &assignedCount,
+		})
+	}
+
+	return violations
+}
+
+// exclusiveViolations 同时持有同一互斥组内多个角色（生效时间窗口重叠）的用户，按互斥组、用户排序
+func exclusiveViolations(
+	roles []*models.RoleDefinition,
+	byRole map[uuid.UUID][]*models.UserRoleAssignment,
+) []*identity_srv.RoleConstraintViolation {
+	members := make(map[string][]*models.RoleDefinition)
+
+	for _, role := range roles {
+		for _, group := range role.ExclusiveGroups {
+			members[group] = append(members[group], role)
+		}
+	}
+
+	groups := make([]string, 0, len(members))
+	for group := range members {
+		groups = append(groups, group)
+	}
+
+	slices.Sort(groups)
+
+	var violations []*identity_srv.RoleConstraintViolation
+
+	for _, group := range groups {
+		if len(members[group]) < 2 {
+			continue
+		}
+
+		byUser := make(map[string][]*models.UserRoleAssignment)
+		for _, role := range members[group] {
+			for _, a := range byRole[role.ID] {
+				byUser[a.UserID.String()] = append(byUser[a.UserID.String()], a)
+			}
+		}
+
+		userIDs := make([]string, 0, len(byUser))
+		for userID := range byUser {
+			userIDs = append(userIDs, userID)
+		}
+
+		slices.Sort(userIDs)
+
+		for _, userID := range userIDs {
+			held := overlappingRoles(byUser[userID])
+			if len(held) < 2 {
+				continue
+			}
+
+			constraintType := identity_srv.RoleConstraintType_ROLE_CONSTRAINT_TYPE_EXCLUSIVE
+			violation := &identity_srv.RoleConstraintViolation{
+				Type:           &constraintType,
+				ExclusiveGroup: &group,
+				UserIDs:        []string{userID},
+			}
+
+			for _, role := range members[group] {
+				if held[role.ID] {
+					violation.RoleIDs = append(violation.RoleIDs, role.ID.String())
+					violation.RoleCodes = append(violation.RoleCodes, role.RoleCode)
+				}
+			}
+
+			violations = append(violations, violation)
+		}
+	}
+
+	return violations
+}
+
+// overlappingRoles 返回与其他角色的分配在生效时间窗口上重叠的角色
+func overlappingRoles(assignments []*models.UserRoleAssignment) map[uuid.UUID]bool {
+	held := make(map[uuid.UUID]bool)
+
+	for i, a := range assignments {
+		for _, b := range assignments[i+1:] {
+			if a.RoleID != b.RoleID && windowsOverlap(a.ValidFrom, a.ValidUntil, b.ValidFrom, b.ValidUntil) {
+				held[a.RoleID] = true
+				held[b.RoleID] = true
+			}
+		}
+	}
+
+	return held
+}
+
+// sharedGroup 返回两个角色共同所属的第一个互斥组
+func sharedGroup(a, b []string) (string, bool) {
+	for _, group := range a {
+		if slices.Contains(b, group) {
+			return group, true
+		}
+	}
+
+	return "", false
+}
+
+// windowsOverlap 判断两个生效时间窗口 [from, until) 是否重叠，nil 表示不限
+func windowsOverlap(aFrom, aUntil, bFrom, bUntil *int64) bool {
+	aStartsBeforeBEnds := aFrom == nil || bUntil == nil || *aFrom < *bUntil
+	bStartsBeforeAEnds := bFrom == nil || aUntil == nil || *bFrom < *aUntil
+
+	return aStartsBeforeBEnds && bStartsBeforeAEnds
+}
+
+func distinctUserIDs(assignments []*models.UserRoleAssignment) []string {
+	seen := make(map[string]bool, len(assignments))
+	userIDs := make([]string, 0, len(assignments))
+
+	for _, a := range assignments {
+		if id := a.UserID.String(); !seen[id] {
+			seen[id] = true
+			userIDs = append(userIDs, id)
+		}
+	}
+
+	slices.Sort(userIDs)
+
+	return userIDs
+}
@@ -0,0 +1,349 @@
+package assignment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/core"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+)
+
+func constrainedRole(name string, maxAssignments int32, groups ...string) *models.RoleDefinition {
+	return &models.RoleDefinition{
+		BaseModel:       models.BaseModel{ID: uuid.New()},
+		Name:            name,
+		RoleCode:        "role:" + name,
+		ExclusiveGroups: groups,
+		MaxAssignments:  maxAssignments,
+	}
+}
+
+func int64Ptr(v int64) *int64 { return &v }
+
+// ============================================================================
+// 分配时校验
+// ============================================================================
+
+func TestAssignRoleToUser_ExclusiveRoleConflict(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	prescriber := constrainedRole("prescriber", 0, "dispensing")
+	approver := constrainedRole("pharmacist_approver", 0, "dispensing")
+	userID := uuid.New()
+	roleID := approver.ID.String()
+	assignedBy := uuid.New().String()
+	uid := userID.String()
+
+	mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, uid, roleID, "").Return(false, nil)
+	mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(approver, nil)
+	mocks.DefinitionRepo.EXPECT().FindConstrained(ctx).Return([]*models.RoleDefinition{prescriber, approver}, nil)
+	mocks.AssignmentRepo.EXPECT().
+		FindUnexpiredByRoleIDs(ctx, []string{prescriber.ID.String()}, []string{uid}, gomock.Any()).
+		Return([]*models.UserRoleAssignment{{UserID: userID, RoleID: prescriber.ID}}, nil)
+
+	result, err := logic.AssignRoleToUser(ctx, &identity_srv.AssignRoleToUserRequest{
+		UserID:     &uid,
+		RoleID:     &roleID,
+		AssignedBy: &assignedBy,
+	})
+
+	assert.Nil(t, result)
+	assertErrCode(t, errno.ErrRoleConstraintViolation, err)
+	assert.Contains(t, err.Error(), "dispensing")
+}
+
+func TestAssignRoleToUser_ExclusiveRoleWindowsDoNotOverlap(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	prescriber := constrainedRole("prescriber", 0, "dispensing")
+	approver := constrainedRole("pharmacist_approver", 0, "dispensing")
+	userID := uuid.New()
+	roleID := approver.ID.String()
+	assignedBy := uuid.New().String()
+	uid := userID.String()
+
+	// 现有处方权限在新分配生效前到期
+	existingUntil := int64(4_000_000_000_000)
+	validFrom := existingUntil
+
+	mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, uid, roleID, "").Return(false, nil)
+	mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(approver, nil)
+	mocks.DefinitionRepo.EXPECT().FindConstrained(ctx).Return([]*models.RoleDefinition{prescriber, approver}, nil)
+	mocks.AssignmentRepo.EXPECT().
+		FindUnexpiredByRoleIDs(ctx, []string{prescriber.ID.String()}, []string{uid}, gomock.Any()).
+		Return([]*models.UserRoleAssignment{
+			{UserID: userID, RoleID: prescriber.ID, ValidUntil: int64Ptr(existingUntil)},
+		}, nil)
+	mocks.AssignmentRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+	result, err := logic.AssignRoleToUser(ctx, &identity_srv.AssignRoleToUserRequest{
+		UserID:     &uid,
+		RoleID:     &roleID,
+		AssignedBy: &assignedBy,
+		ValidFrom:  &validFrom,
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, result)
+}
+
+func TestAssignRoleToUser_MaxAssignmentsExceeded(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	superadmin := constrainedRole("superadmin", 2)
+	roleID := superadmin.ID.String()
+	uid := uuid.New().String()
+	assignedBy := uuid.New().String()
+
+	mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, uid, roleID, "").Return(false, nil)
+	mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(superadmin, nil)
+	mocks.AssignmentRepo.EXPECT().
+		FindUnexpiredByRoleIDs(ctx, []string{roleID}, nil, gomock.Any()).
+		Return([]*models.UserRoleAssignment{
+			{UserID: uuid.New(), RoleID: superadmin.ID},
+			{UserID: uuid.New(), RoleID: superadmin.ID},
+		}, nil)
+
+	result, err := logic.AssignRoleToUser(ctx, &identity_srv.AssignRoleToUserRequest{
+		UserID:     &uid,
+		RoleID:     &roleID,
+		AssignedBy: &assignedBy,
+	})
+
+	assert.Nil(t, result)
+	assertErrCode(t, errno.ErrRoleConstraintViolation, err)
+}
+
+func TestBatchBindUsersToRole_MaxAssignmentsIgnoresReplacedGlobalAssignments(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	superadmin := constrainedRole("superadmin", 2)
+	roleID := superadmin.ID.String()
+	orgID := uuid.New()
+	orgHolder := uuid.New()
+	newUser := uuid.New().String()
+
+	mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(superadmin, nil)
+	mocks.AssignmentRepo.EXPECT().
+		FindUnexpiredByRoleIDs(ctx, []string{roleID}, nil, gomock.Any()).
+		Return([]*models.UserRoleAssignment{
+			// 两个全局分配将被替换，组织级分配保留
+			{UserID: uuid.New(), RoleID: superadmin.ID},
+			{UserID: uuid.New(), RoleID: superadmin.ID},
+			{UserID: orgHolder, RoleID: superadmin.ID, OrganizationID: &orgID},
+		}, nil)
+	mocks.AssignmentRepo.EXPECT().ReplaceRoleUsers(ctx, roleID, []string{newUser}, "").Return(nil)
+
+	resp, err := logic.BatchBindUsersToRole(ctx, &identity_srv.BatchBindUsersToRoleRequest{
+		RoleID:  &roleID,
+		UserIDs: &core.StringListValue{Items: []string{newUser}},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.GetSuccess())
+}
+
+func TestBatchBindUsersToRole_MaxAssignmentsExceeded(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	superadmin := constrainedRole("superadmin", 1)
+	roleID := superadmin.ID.String()
+	orgID := uuid.New()
+
+	mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(superadmin, nil)
+	mocks.AssignmentRepo.EXPECT().
+		FindUnexpiredByRoleIDs(ctx, []string{roleID}, nil, gomock.Any()).
+		Return([]*models.UserRoleAssignment{
+			{UserID: uuid.New(), RoleID: superadmin.ID, OrganizationID: &orgID},
+		}, nil)
+
+	_, err := logic.BatchBindUsersToRole(ctx, &identity_srv.BatchBindUsersToRoleRequest{
+		RoleID:  &roleID,
+		UserIDs: &core.StringListValue{Items: []string{uuid.New().String()}},
+	})
+
+	assertErrCode(t, errno.ErrRoleConstraintViolation, err)
+}
+
+func TestUpdateUserRoleAssignment_ExcludesAssignmentBeingUpdated(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	superadmin := constrainedRole("superadmin", 1)
+	roleID := superadmin.ID.String()
+	assignment := &models.UserRoleAssignment{
+		BaseModel: models.BaseModel{ID: uuid.New()},
+		UserID:    uuid.New(),
+		RoleID:    superadmin.ID,
+	}
+	assignmentID := assignment.ID.String()
+	newUser := uuid.New().String()
+
+	mocks.AssignmentRepo.EXPECT().GetByID(ctx, assignmentID).Return(assignment, nil)
+	mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(superadmin, nil)
+	mocks.AssignmentRepo.EXPECT().
+		FindUnexpiredByRoleIDs(ctx, []string{roleID}, nil, gomock.Any()).
+		Return([]*models.UserRoleAssignment{
+			{BaseModel: models.BaseModel{ID: assignment.ID}, UserID: uuid.New(), RoleID: superadmin.ID},
+		}, nil)
+	mocks.AssignmentRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+
+	err := logic.UpdateUserRoleAssignment(ctx, &identity_srv.UpdateUserRoleAssignmentRequest{
+		AssignmentID: &assignmentID,
+		UserID:       &newUser,
+	})
+
+	require.NoError(t, err)
+}
+
+// ============================================================================
+// ListRoleConstraintViolations 测试
+// ============================================================================
+
+func TestRoleConstraintChecker_ReservedGrantsCount(t *testing.T) {
+	_, mocks := setupTest(t)
+	ctx := context.Background()
+
+	approver := constrainedRole("approver", 2)
+	payer := constrainedRole("payer", 0, "finance")
+	auditor := constrainedRole("auditor", 0, "finance")
+	holder := uuid.New()
+
+	// 库中已有 1 人持有，同一检查器内只查询一次
+	mocks.AssignmentRepo.EXPECT().
+		FindUnexpiredByRoleIDs(ctx, []string{approver.ID.String()}, gomock.Nil(), gomock.Any()).
+		Return([]*models.UserRoleAssignment{{UserID: holder, RoleID: approver.ID}}, nil)
+
+	checker := NewRoleConstraintChecker(mocks.AssignmentRepo, mocks.DefinitionRepo)
+
+	first := []RoleGrant{{UserID: uuid.NewString(), Role: approver}}
+	require.NoError(t, checker.Check(ctx, first, nil))
+	checker.Reserve(first)
+
+	// 登记的分配计入人数上限
+	err := checker.Check(ctx, []RoleGrant{{UserID: uuid.NewString(), Role: approver}}, nil)
+	assertErrCode(t, errno.ErrRoleConstraintViolation, err)
+	assert.Contains(t, err.Error(), "分配后将达到 3 个")
+
+	// 同一批待写入分配之间的互斥无需查库
+	userID := uuid.NewString()
+	err = checker.Check(ctx, []RoleGrant{{UserID: userID, Role: payer}, {UserID: userID, Role: auditor}}, nil)
+	assertErrCode(t, errno.ErrRoleConstraintViolation, err)
+	assert.Contains(t, err.Error(), "互斥组 finance")
+}
+
+func TestListRoleConstraintViolations(t *testing.T) {
+	prescriber := constrainedRole("prescriber", 0, "dispensing")
+	approver := constrainedRole("pharmacist_approver", 0, "dispensing")
+	superadmin := constrainedRole("superadmin", 1)
+
+	dualUser := uuid.New()
+	sequentialUser := uuid.New()
+	adminA, adminB := uuid.New(), uuid.New()
+
+	assignments := []*models.UserRoleAssignment{
+		{UserID: dualUser, RoleID: prescriber.ID},
+		{UserID: dualUser, RoleID: approver.ID},
+		// 先后持有，窗口不重叠
+		{UserID: sequentialUser, RoleID: prescriber.ID, ValidUntil: int64Ptr(4_000_000_000_000)},
+		{UserID: sequentialUser, RoleID: approver.ID, ValidFrom: int64Ptr(4_000_000_000_000)},
+		{UserID: adminA, RoleID: superadmin.ID},
+		{UserID: adminB, RoleID: superadmin.ID},
+	}
+
+	setup := func(t *testing.T) (*LogicImpl, context.Context) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		roles := []*models.RoleDefinition{approver, prescriber, superadmin}
+		mocks.DefinitionRepo.EXPECT().FindConstrained(ctx).Return(roles, nil)
+		mocks.AssignmentRepo.EXPECT().
+			FindUnexpiredByRoleIDs(
+				ctx,
+				[]string{approver.ID.String(), prescriber.ID.String(), superadmin.ID.String()},
+				nil,
+				gomock.Any(),
+			).
+			Return(assignments, nil)
+
+		return logic, ctx
+	}
+
+	t.Run("扫描全部违例", func(t *testing.T) {
+		logic, ctx := setup(t)
+
+		resp, err := logic.ListRoleConstraintViolations(ctx, &identity_srv.ListRoleConstraintViolationsRequest{})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Violations, 2)
+
+		maxViolation := resp.Violations[0]
+		assert.Equal(t, identity_srv.RoleConstraintType_ROLE_CONSTRAINT_TYPE_MAX_ASSIGNMENTS, maxViolation.GetType())
+		assert.Equal(t, []string{superadmin.RoleCode}, maxViolation.RoleCodes)
+		assert.Equal(t, int32(1), maxViolation.GetMaxAssignments())
+		assert.Equal(t, int32(2), maxViolation.GetAssignedCount())
+		assert.ElementsMatch(t, []string{adminA.String(), adminB.String()}, maxViolation.UserIDs)
+
+		exclusive := resp.Violations[1]
+		assert.Equal(t, identity_srv.RoleConstraintType_ROLE_CONSTRAINT_TYPE_EXCLUSIVE, exclusive.GetType())
+		assert.Equal(t, "dispensing", exclusive.GetExclusiveGroup())
+		assert.Equal(t, []string{approver.RoleCode, prescriber.RoleCode}, exclusive.RoleCodes)
+		assert.Equal(t, []string{dualUser.String()}, exclusive.UserIDs)
+	})
+
+	t.Run("按角色过滤", func(t *testing.T) {
+		logic, ctx := setup(t)
+		roleID := prescriber.ID.String()
+
+		resp, err := logic.ListRoleConstraintViolations(ctx, &identity_srv.ListRoleConstraintViolationsRequest{
+			RoleID: &roleID,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Violations, 1)
+		assert.Equal(t, identity_srv.RoleConstraintType_ROLE_CONSTRAINT_TYPE_EXCLUSIVE, resp.Violations[0].GetType())
+	})
+
+	t.Run("角色ID格式无效", func(t *testing.T) {
+		logic, _ := setupTest(t)
+		roleID := "not-a-uuid"
+
+		_, err := logic.ListRoleConstraintViolations(context.Background(), &identity_srv.ListRoleConstraintViolationsRequest{
+			RoleID: &roleID,
+		})
+
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+}
+
+func TestWindowsOverlap(t *testing.T) {
+	tests := []struct {
+		name                         string
+		aFrom, aUntil, bFrom, bUntil *int64
+		want                         bool
+	}{
+		{name: "均不限", want: true},
+		{name: "a 在 b 开始前结束", aUntil: int64Ptr(100), bFrom: int64Ptr(100), want: false},
+		{name: "b 在 a 开始前结束", aFrom: int64Ptr(200), bUntil: int64Ptr(200), want: false},
+		{name: "部分重叠", aFrom: int64Ptr(100), aUntil: int64Ptr(300), bFrom: int64Ptr(200), want: true},
+		{name: "包含", aFrom: int64Ptr(100), aUntil: int64Ptr(400), bFrom: int64Ptr(200), bUntil: int64Ptr(300), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, windowsOverlap(tt.aFrom, tt.aUntil, tt.bFrom, tt.bUntil))
+		})
+	}
+}
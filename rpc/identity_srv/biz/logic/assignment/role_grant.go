@@ -0,0 +1,542 @@
+package assignment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/convutil"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/grantrequest"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+	tracelog "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/log"
+)
+
+// ============================================================================
+// 敏感角色授权审批
+// ============================================================================
+
+// defaultApprovalTTL 未配置审批期限时使用的默认值
+const defaultApprovalTTL = 72 * time.Hour
+
+// roleGrantAuditResource 授权申请审计日志的资源名
+const roleGrantAuditResource = "role_grant"
+
+// requestRoleGrant 分配敏感角色时创建待审批申请，申请与审计日志在同一事务中写入
+func (l *LogicImpl) requestRoleGrant(
+	ctx context.Context,
+	role *models.RoleDefinition,
+	req *identity_srv.AssignRoleToUserRequest,
+	orgUUID *uuid.UUID,
+) (*identity_srv.UserRoleAssignmentResponse, error) {
+	userID := req.GetUserID()
+
+	pending, err := l.dal.RoleGrantRequest().ExistsPending(ctx, userID, role.ID.String(), req.GetOrganizationID())
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("查询待审批申请失败: " + err.Error())
+	}
+
+	if pending {
+		return nil, errno.ErrRoleGrantRequestDuplicate
+	}
+
+	ttl := l.approvalTTL
+	if ttl <= 0 {
+		ttl = defaultApprovalTTL
+	}
+
+	grantRequest := &models.RoleGrantRequest{
+		UserID:         uuid.MustParse(userID),
+		RoleID:         role.ID,
+		OrganizationID: orgUUID,
+		ValidFrom:      req.ValidFrom,
+		ValidUntil:     req.ValidUntil,
+		RequestedBy:    parseOptionalUUID(req.GetAssignedBy()),
+		Reason:         req.GetReason(),
+		Status:         models.RoleGrantRequestStatusPending,
+		ExpiresAt:      time.Now().Add(ttl).UnixMilli(),
+	}
+
+	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		if err := txDAL.RoleGrantRequest().Create(ctx, grantRequest); err != nil {
+			return errno.ErrOperationFailed.WithMessage("创建角色授权申请失败: " + err.Error())
+		}
+
+		return recordRoleGrantAudit(
+			ctx, txDAL, models.AuditActionRoleGrantRequest, grantRequest.RequestedBy, grantRequest,
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tracelog.Ctx(ctx).Info().
+		Str("grant_request_id", grantRequest.ID.String()).
+		Str("user_id", userID).
+		Str("role_id", role.ID.String()).
+		Msg("敏感角色分配已转为待审批申请")
+
+	return &identity_srv.UserRoleAssignmentResponse{
+		PendingApproval: convutil.BoolPtr(true),
+		GrantRequestID:  convutil.StringPtr(grantRequest.ID.String()),
+	}, nil
+}
+
+// ensureGrantedWithoutApproval 批量绑定敏感角色时，只允许保留或移除已有的全局分配，
+// 新增的用户必须逐个通过 AssignRoleToUser 发起审批
+func (l *LogicImpl) ensureGrantedWithoutApproval(
+	ctx context.Context,
+	role *models.RoleDefinition,
+	userIDs []string,
+) error {
+	if !role.IsSensitive() || len(userIDs) == 0 {
+		return nil
+	}
+
+	existing, err := l.dal.UserRoleAssignment().FindUnexpiredByRoleIDs(
+		ctx, []string{role.ID.String()}, userIDs, time.Now().UnixMilli(),
+	)
+	if err != nil {
+		return errno.ErrOperationFailed.WithMessage("查询角色分配失败: " + err.Error())
+	}
+
+	held := make(map[string]bool, len(existing))
+	for _, a := range existing {
+		if a.IsGlobal() {
+			held[a.UserID.String()] = true
+		}
+	}
+
+	for _, userID := range userIDs {
+		if !held[userID] {
+			return errno.ErrRoleApprovalRequired.WithMessage(fmt.Sprintf(
+				"角色 %s 需经审批才能分配，请为用户 %s 单独发起授权申请", role.Name, userID,
+			))
+		}
+	}
+
+	return nil
+}
+
+// ListRoleGrantRequests 分页查询角色授权申请
+func (l *LogicImpl) ListRoleGrantRequests(
+	ctx context.Context,
+	req *identity_srv.ListRoleGrantRequestsRequest,
+) (*identity_srv.ListRoleGrantRequestsResponse, error) {
+	opts := l.converter.Base().PageRequestToQueryOptions(req.Page)
+	if err := opts.Validate(); err != nil {
+		return nil, errno.ErrInvalidParams.WithMessage("分页参数无效: " + err.Error())
+	}
+
+	conditions := &grantrequest.RoleGrantRequestQueryConditions{
+		UserID:      req.UserID,
+		RoleID:      req.RoleID,
+		RequestedBy: req.RequestedBy,
+		Page:        opts,
+	}
+
+	if req.Status != nil && req.GetStatus() != identity_srv.RoleGrantRequestStatus_ROLE_GRANT_REQUEST_STATUS_UNSPECIFIED {
+		status := models.RoleGrantRequestStatus(req.GetStatus())
+		conditions.Status = &status
+	}
+
+	requests, pageResult, err := l.dal.RoleGrantRequest().FindWithConditions(ctx, conditions)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("查询角色授权申请失败: " + err.Error())
+	}
+
+	roles, err := l.grantRequestRoles(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*identity_srv.RoleGrantRequest, 0, len(requests))
+	for _, r := range requests {
+		result = append(result, l.converter.UserRoleAssignment().GrantRequestToThrift(r, roles[r.RoleID]))
+	}
+
+	return &identity_srv.ListRoleGrantRequestsResponse{
+		Requests: result,
+		Page:     l.converter.Base().PageResponseToThrift(pageResult),
+	}, nil
+}
+
+// GetRoleGrantRequest 获取单个角色授权申请
+func (l *LogicImpl) GetRoleGrantRequest(
+	ctx context.Context,
+	requestID string,
+) (*identity_srv.RoleGrantRequest, error) {
+	grantRequest, err := l.loadGrantRequest(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := l.grantRequestRoles(ctx, []*models.RoleGrantRequest{grantRequest})
+	if err != nil {
+		return nil, err
+	}
+
+	return l.converter.UserRoleAssignment().GrantRequestToThrift(grantRequest, roles[grantRequest.RoleID]), nil
+}
+
+// ApproveRoleGrant 审批通过：重新校验分配条件后生成角色分配
+//
+// 申请人与被授权用户本人不能审批；审批时申请已过期则置为过期并拒绝。
+// 角色分配、状态流转与审计日志在同一事务中写入，并发审批时只有一方成功。
+func (l *LogicImpl) ApproveRoleGrant(
+	ctx context.Context,
+	req *identity_srv.ApproveRoleGrantRequest,
+) (*identity_srv.RoleGrantRequest, error) {
+	approverID, err := parseApproverID(req.GetApproverID())
+	if err != nil {
+		return nil, err
+	}
+
+	grantRequest, err := l.loadPendingGrantRequest(ctx, req.GetRequestID())
+	if err != nil {
+		return nil, err
+	}
+
+	if approverID == grantRequest.UserID ||
+		(grantRequest.RequestedBy != nil && approverID == *grantRequest.RequestedBy) {
+		return nil, errno.ErrRoleGrantSelfApproval
+	}
+
+	role, err := l.dal.RoleDefinition().GetByID(ctx, grantRequest.RoleID.String())
+	if err != nil {
+		if errno.IsRecordNotFound(err) {
+			return nil, errno.ErrRoleDefinitionNotFound
+		}
+
+		return nil, errno.ErrOperationFailed.WithMessage("查询角色信息失败: " + err.Error())
+	}
+
+	// 申请期间可能已有其他途径的分配、时间窗口已过或约束配置已变化，审批时重新校验
+	if err := validateValidityWindow(grantRequest.ValidFrom, grantRequest.ValidUntil); err != nil {
+		return nil, err
+	}
+
+	organizationID := ""
+	if grantRequest.OrganizationID != nil {
+		organizationID = grantRequest.OrganizationID.String()
+	}
+
+	userID := grantRequest.UserID.String()
+
+	exists, err := l.dal.UserRoleAssignment().CheckUserRoleExists(ctx, userID, role.ID.String(), organizationID)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("检查角色分配状态失败: " + err.Error())
+	}
+
+	if exists {
+		return nil, errno.ErrRoleAssignmentAlreadyExists
+	}
+
+	grant := RoleGrant{
+		UserID:     userID,
+		Role:       role,
+		ValidFrom:  grantRequest.ValidFrom,
+		ValidUntil: grantRequest.ValidUntil,
+	}
+	if err := l.roleConstraints().Check(ctx, []RoleGrant{grant}, nil); err != nil {
+		return nil, err
+	}
+
+	assignment := &models.UserRoleAssignment{
+		UserID:         grantRequest.UserID,
+		RoleID:         grantRequest.RoleID,
+		OrganizationID: grantRequest.OrganizationID,
+		ValidFrom:      grantRequest.ValidFrom,
+		ValidUntil:     grantRequest.ValidUntil,
+		CreatedBy:      grantRequest.RequestedBy,
+		UpdatedBy:      &approverID,
+	}
+
+	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		if err := txDAL.UserRoleAssignment().Create(ctx, assignment); err != nil {
+			return errno.ErrOperationFailed.WithMessage("创建角色分配失败: " + err.Error())
+		}
+
+		return decideGrantRequest(ctx, txDAL, grantRequest, grantrequest.RoleGrantDecision{
+			Status:       models.RoleGrantRequestStatusApproved,
+			DecidedBy:    &approverID,
+			DecidedAt:    time.Now().UnixMilli(),
+			Comment:      req.GetComment(),
+			AssignmentID: &assignment.ID,
+		}, models.AuditActionRoleGrantApprove)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tracelog.Ctx(ctx).Info().
+		Str("grant_request_id", grantRequest.ID.String()).
+		Str("assignment_id", assignment.ID.String()).
+		Str("approver_id", approverID.String()).
+		Msg("敏感角色授权申请已通过")
+
+	return l.converter.UserRoleAssignment().GrantRequestToThrift(grantRequest, role), nil
+}
+
+// RejectRoleGrant 驳回待审批的角色授权申请
+func (l *LogicImpl) RejectRoleGrant(
+	ctx context.Context,
+	req *identity_srv.RejectRoleGrantRequest,
+) (*identity_srv.RoleGrantRequest, error) {
+	approverID, err := parseApproverID(req.GetApproverID())
+	if err != nil {
+		return nil, err
+	}
+
+	grantRequest, err := l.loadPendingGrantRequest(ctx, req.GetRequestID())
+	if err != nil {
+		return nil, err
+	}
+
+	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		return decideGrantRequest(ctx, txDAL, grantRequest, grantrequest.RoleGrantDecision{
+			Status:    models.RoleGrantRequestStatusRejected,
+			DecidedBy: &approverID,
+			DecidedAt: time.Now().UnixMilli(),
+			Comment:   req.GetComment(),
+		}, models.AuditActionRoleGrantReject)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tracelog.Ctx(ctx).Info().
+		Str("grant_request_id", grantRequest.ID.String()).
+		Str("approver_id", approverID.String()).
+		Msg("敏感角色授权申请已驳回")
+
+	roles, err := l.grantRequestRoles(ctx, []*models.RoleGrantRequest{grantRequest})
+	if err != nil {
+		return nil, err
+	}
+
+	return l.converter.UserRoleAssignment().GrantRequestToThrift(grantRequest, roles[grantRequest.RoleID]), nil
+}
+
+// ExpireRoleGrantRequests 把超过审批期限的待审批申请置为过期
+//
+// 单条失败只记录日志并跳过，留到下一轮；已被审批人处理的申请不会被覆盖。
+func (l *LogicImpl) ExpireRoleGrantRequests(ctx context.Context) (int, error) {
+	now := time.Now().UnixMilli()
+
+	expired, err := l.dal.RoleGrantRequest().FindExpiredPending(ctx, now, expireBatchSize)
+	if err != nil {
+		return 0, errno.ErrOperationFailed.WithMessage("查询过期角色授权申请失败: " + err.Error())
+	}
+
+	expiredCount := 0
+
+	for _, grantRequest := range expired {
+		if err := l.expireGrantRequest(ctx, grantRequest, now); err != nil {
+			tracelog.Ctx(ctx).Warn().
+				Err(err).
+				Str("grant_request_id", grantRequest.ID.String()).
+				Msg("角色授权申请过期处理失败，下一轮重试")
+
+			continue
+		}
+
+		expiredCount++
+	}
+
+	return expiredCount, nil
+}
+
+// expireGrantRequest 把单条申请置为过期并写入审计日志
+func (l *LogicImpl) expireGrantRequest(ctx context.Context, grantRequest *models.RoleGrantRequest, now int64) error {
+	return l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		return decideGrantRequest(ctx, txDAL, grantRequest, grantrequest.RoleGrantDecision{
+			Status:    models.RoleGrantRequestStatusExpired,
+			DecidedAt: now,
+		}, models.AuditActionRoleGrantExpire)
+	})
+}
+
+// loadGrantRequest 按ID查询申请，不存在时返回 ErrRoleGrantRequestNotFound
+func (l *LogicImpl) loadGrantRequest(ctx context.Context, requestID string) (*models.RoleGrantRequest, error) {
+	if _, err := uuid.Parse(requestID); err != nil {
+		return nil, errno.ErrInvalidParams.WithMessage("申请ID格式无效")
+	}
+
+	grantRequest, err := l.dal.RoleGrantRequest().GetByID(ctx, requestID)
+	if err != nil {
+		if errno.IsRecordNotFound(err) {
+			return nil, errno.ErrRoleGrantRequestNotFound
+		}
+
+		return nil, errno.ErrOperationFailed.WithMessage("查询角色授权申请失败: " + err.Error())
+	}
+
+	return grantRequest, nil
+}
+
+// loadPendingGrantRequest 查询仍可审批的申请；已过审批期限的申请就地置为过期后拒绝
+func (l *LogicImpl) loadPendingGrantRequest(
+	ctx context.Context,
+	requestID string,
+) (*models.RoleGrantRequest, error) {
+	grantRequest, err := l.loadGrantRequest(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !grantRequest.IsPending() {
+		return nil, errno.ErrRoleGrantRequestNotPending
+	}
+
+	if now := time.Now().UnixMilli(); grantRequest.ExpiresAt <= now {
+		if err := l.expireGrantRequest(ctx, grantRequest, now); err != nil {
+			return nil, err
+		}
+
+		return nil, errno.ErrRoleGrantRequestNotPending.WithMessage("角色授权申请已超过审批期限")
+	}
+
+	return grantRequest, nil
+}
+
+// grantRequestRoles 批量查询申请涉及的角色，返回 map[roleID]role
+func (l *LogicImpl) grantRequestRoles(
+	ctx context.Context,
+	requests []*models.RoleGrantRequest,
+) (map[uuid.UUID]*models.RoleDefinition, error) {
+	roleIDs := make([]string, 0, len(requests))
+	seen := make(map[uuid.UUID]bool, len(requests))
+
+	for _, r := range requests {
+		if !seen[r.RoleID] {
+			seen[r.RoleID] = true
+			roleIDs = append(roleIDs, r.RoleID.String())
+		}
+	}
+
+	roles := make(map[uuid.UUID]*models.RoleDefinition, len(roleIDs))
+	if len(roleIDs) == 0 {
+		return roles, nil
+	}
+
+	roleModels, err := l.dal.RoleDefinition().BatchGetByIDs(ctx, roleIDs)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("获取角色详情失败: " + err.Error())
+	}
+
+	for _, role := range roleModels {
+		if role != nil {
+			roles[role.ID] = role
+		}
+	}
+
+	return roles, nil
+}
+
+// decideGrantRequest 流转待审批申请的状态并写入审计日志，申请已被处理时返回 ErrRoleGrantRequestNotPending
+func decideGrantRequest(
+	ctx context.Context,
+	txDAL dal.DAL,
+	grantRequest *models.RoleGrantRequest,
+	decision grantrequest.RoleGrantDecision,
+	action models.AuditAction,
+) error {
+	ok, err := txDAL.RoleGrantRequest().Decide(ctx, grantRequest.ID.String(), decision)
+	if err != nil {
+		return errno.ErrOperationFailed.WithMessage("更新角色授权申请失败: " + err.Error())
+	}
+
+	if !ok {
+		return errno.ErrRoleGrantRequestNotPending
+	}
+
+	grantRequest.Status = decision.Status
+	grantRequest.DecidedBy = decision.DecidedBy
+	grantRequest.DecidedAt = &decision.DecidedAt
+	grantRequest.DecisionComment = decision.Comment
+	grantRequest.AssignmentID = decision.AssignmentID
+
+	return recordRoleGrantAudit(ctx, txDAL, action, decision.DecidedBy, grantRequest)
+}
+
+// recordRoleGrantAudit 写入授权申请审计日志，写入失败时整个操作回滚，保证每一步都留痕
+func recordRoleGrantAudit(
+	ctx context.Context,
+	txDAL dal.DAL,
+	action models.AuditAction,
+	operatorID *uuid.UUID,
+	grantRequest *models.RoleGrantRequest,
+) error {
+	detail := map[string]interface{}{
+		"user_id": grantRequest.UserID.String(),
+		"role_id": grantRequest.RoleID.String(),
+		"status":  grantRequest.Status,
+	}
+
+	if grantRequest.OrganizationID != nil {
+		detail["organization_id"] = grantRequest.OrganizationID.String()
+	}
+
+	if grantRequest.Reason != "" {
+		detail["reason"] = grantRequest.Reason
+	}
+
+	if grantRequest.DecisionComment != "" {
+		detail["comment"] = grantRequest.DecisionComment
+	}
+
+	if grantRequest.AssignmentID != nil {
+		detail["assignment_id"] = grantRequest.AssignmentID.String()
+	}
+
+	body, err := json.Marshal(detail)
+	if err != nil {
+		return errno.ErrOperationFailed.WithMessage("序列化审计详情失败: " + err.Error())
+	}
+
+	log := &models.AuditLog{
+		UserID:         operatorID,
+		OrganizationID: grantRequest.OrganizationID,
+		Action:         action,
+		Resource:       roleGrantAuditResource,
+		ResourceID:     grantRequest.ID.String(),
+		Success:        true,
+		RequestBody:    string(body),
+	}
+
+	if err := txDAL.AuditLog().Create(ctx, log); err != nil {
+		return errno.ErrOperationFailed.WithMessage("记录审计日志失败: " + err.Error())
+	}
+
+	return nil
+}
+
+// parseApproverID 解析审批人ID，审批人必填
+func parseApproverID(approverID string) (uuid.UUID, error) {
+	if approverID == "" {
+		return uuid.Nil, errno.ErrInvalidParams.WithMessage("审批人ID不能为空")
+	}
+
+	id, err := uuid.Parse(approverID)
+	if err != nil {
+		return uuid.Nil, errno.ErrInvalidParams.WithMessage("审批人ID格式无效")
+	}
+
+	return id, nil
+}
+
+// parseOptionalUUID 解析可选的 UUID 字符串，空串或格式错误时返回 nil
+func parseOptionalUUID(s string) *uuid.UUID {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return nil
+	}
+
+	return &id
+}
@@ -0,0 +1,502 @@
+package assignment
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/grantrequest"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/core"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+)
+
+func sensitiveRole(name string) *models.RoleDefinition {
+	return &models.RoleDefinition{
+		BaseModel:        models.BaseModel{ID: uuid.New()},
+		Name:             name,
+		RoleCode:         "role:" + name,
+		RequiresApproval: true,
+	}
+}
+
+func pendingGrantRequest(role *models.RoleDefinition) *models.RoleGrantRequest {
+	requestedBy := uuid.New()
+
+	return &models.RoleGrantRequest{
+		BaseModel:   models.BaseModel{ID: uuid.New()},
+		UserID:      uuid.New(),
+		RoleID:      role.ID,
+		RequestedBy: &requestedBy,
+		Status:      models.RoleGrantRequestStatusPending,
+		ExpiresAt:   time.Now().Add(time.Hour).UnixMilli(),
+	}
+}
+
+// ============================================================================
+// 发起申请
+// ============================================================================
+
+func TestAssignRoleToUser_SensitiveRoleCreatesPendingRequest(t *testing.T) {
+	logic, mocks := setupTest(t)
+	logic.approvalTTL = 24 * time.Hour
+	ctx := context.Background()
+
+	role := sensitiveRole("auditor")
+	userID := uuid.New().String()
+	roleID := role.ID.String()
+	assignedBy := uuid.New().String()
+	reason := "季度审计"
+
+	mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, userID, roleID, "").Return(false, nil)
+	mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(role, nil)
+	mocks.GrantRepo.EXPECT().ExistsPending(ctx, userID, roleID, "").Return(false, nil)
+
+	var created *models.RoleGrantRequest
+
+	mocks.GrantRepo.EXPECT().Create(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, r *models.RoleGrantRequest) error {
+			created = r
+			return nil
+		})
+	mocks.AuditLogRepo.EXPECT().Create(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+			assert.Equal(t, models.AuditActionRoleGrantRequest, log.Action)
+			assert.Equal(t, "role_grant", log.Resource)
+			assert.Equal(t, assignedBy, log.UserID.String())
+			assert.Contains(t, log.RequestBody, reason)
+
+			return nil
+		})
+
+	before := time.Now()
+	result, err := logic.AssignRoleToUser(ctx, &identity_srv.AssignRoleToUserRequest{
+		UserID:     &userID,
+		RoleID:     &roleID,
+		AssignedBy: &assignedBy,
+		Reason:     &reason,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.GetPendingApproval())
+	assert.Empty(t, result.GetAssignmentID())
+	assert.Equal(t, created.ID.String(), result.GetGrantRequestID())
+
+	require.NotNil(t, created)
+	assert.Equal(t, models.RoleGrantRequestStatusPending, created.Status)
+	assert.Equal(t, assignedBy, created.RequestedBy.String())
+	assert.GreaterOrEqual(t, created.ExpiresAt, before.Add(24*time.Hour).UnixMilli())
+}
+
+func TestAssignRoleToUser_SystemRoleRequiresApproval(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	role := &models.RoleDefinition{BaseModel: models.BaseModel{ID: uuid.New()}, Name: "admin", IsSystemRole: true}
+	userID := uuid.New().String()
+	roleID := role.ID.String()
+	assignedBy := uuid.New().String()
+
+	mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, userID, roleID, "").Return(false, nil)
+	mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(role, nil)
+	mocks.GrantRepo.EXPECT().ExistsPending(ctx, userID, roleID, "").Return(false, nil)
+	mocks.GrantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+	mocks.AuditLogRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+	result, err := logic.AssignRoleToUser(ctx, &identity_srv.AssignRoleToUserRequest{
+		UserID:     &userID,
+		RoleID:     &roleID,
+		AssignedBy: &assignedBy,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.GetPendingApproval())
+}
+
+func TestAssignRoleToUser_DuplicatePendingRequest(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	role := sensitiveRole("auditor")
+	userID := uuid.New().String()
+	roleID := role.ID.String()
+	assignedBy := uuid.New().String()
+
+	mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, userID, roleID, "").Return(false, nil)
+	mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(role, nil)
+	mocks.GrantRepo.EXPECT().ExistsPending(ctx, userID, roleID, "").Return(true, nil)
+
+	result, err := logic.AssignRoleToUser(ctx, &identity_srv.AssignRoleToUserRequest{
+		UserID:     &userID,
+		RoleID:     &roleID,
+		AssignedBy: &assignedBy,
+	})
+
+	assert.Nil(t, result)
+	assertErrCode(t, errno.ErrRoleGrantRequestDuplicate, err)
+}
+
+func TestAssignRoleToUser_AuditFailureAbortsRequest(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	role := sensitiveRole("auditor")
+	userID := uuid.New().String()
+	roleID := role.ID.String()
+	assignedBy := uuid.New().String()
+
+	mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, userID, roleID, "").Return(false, nil)
+	mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(role, nil)
+	mocks.GrantRepo.EXPECT().ExistsPending(ctx, userID, roleID, "").Return(false, nil)
+	mocks.GrantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+	mocks.AuditLogRepo.EXPECT().Create(ctx, gomock.Any()).Return(errors.New("db down"))
+
+	result, err := logic.AssignRoleToUser(ctx, &identity_srv.AssignRoleToUserRequest{
+		UserID:     &userID,
+		RoleID:     &roleID,
+		AssignedBy: &assignedBy,
+	})
+
+	assert.Nil(t, result)
+	assertErrCode(t, errno.ErrOperationFailed, err)
+}
+
+// ============================================================================
+// 绕过审批的写入路径
+// ============================================================================
+
+func TestBatchBindUsersToRole_SensitiveRoleRejectsNewUsers(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	role := sensitiveRole("auditor")
+	roleID := role.ID.String()
+	holder := uuid.New()
+	newcomer := uuid.New().String()
+	userIDs := []string{holder.String(), newcomer}
+
+	mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(role, nil)
+	mocks.AssignmentRepo.EXPECT().
+		FindUnexpiredByRoleIDs(ctx, []string{roleID}, userIDs, gomock.Any()).
+		Return([]*models.UserRoleAssignment{{UserID: holder, RoleID: role.ID}}, nil)
+
+	result, err := logic.BatchBindUsersToRole(ctx, &identity_srv.BatchBindUsersToRoleRequest{
+		RoleID:  &roleID,
+		UserIDs: &core.StringListValue{Items: userIDs},
+	})
+
+	assert.Nil(t, result)
+	assertErrCode(t, errno.ErrRoleApprovalRequired, err)
+	assert.Contains(t, err.Error(), newcomer)
+}
+
+func TestBatchBindUsersToRole_SensitiveRoleKeepsExistingHolders(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	role := sensitiveRole("auditor")
+	roleID := role.ID.String()
+	holder := uuid.New()
+	userIDs := []string{holder.String()}
+
+	mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(role, nil)
+	mocks.AssignmentRepo.EXPECT().
+		FindUnexpiredByRoleIDs(ctx, []string{roleID}, userIDs, gomock.Any()).
+		Return([]*models.UserRoleAssignment{{UserID: holder, RoleID: role.ID}}, nil)
+	mocks.AssignmentRepo.EXPECT().ReplaceRoleUsers(ctx, roleID, userIDs, "").Return(nil)
+
+	result, err := logic.BatchBindUsersToRole(ctx, &identity_srv.BatchBindUsersToRoleRequest{
+		RoleID:  &roleID,
+		UserIDs: &core.StringListValue{Items: userIDs},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.GetSuccess())
+}
+
+func TestUpdateUserRoleAssignment_SensitiveRoleRequiresApproval(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	role := sensitiveRole("auditor")
+	assignmentID := uuid.New().String()
+	roleID := role.ID.String()
+
+	mocks.AssignmentRepo.EXPECT().GetByID(ctx, assignmentID).Return(&models.UserRoleAssignment{
+		BaseModel: models.BaseModel{ID: uuid.MustParse(assignmentID)},
+		UserID:    uuid.New(),
+		RoleID:    uuid.New(),
+	}, nil)
+	mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(role, nil)
+
+	err := logic.UpdateUserRoleAssignment(ctx, &identity_srv.UpdateUserRoleAssignmentRequest{
+		AssignmentID: &assignmentID,
+		RoleID:       &roleID,
+	})
+
+	assertErrCode(t, errno.ErrRoleApprovalRequired, err)
+}
+
+// ============================================================================
+// 审批
+// ============================================================================
+
+func TestApproveRoleGrant_CreatesAssignment(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	role := sensitiveRole("auditor")
+	request := pendingGrantRequest(role)
+	requestID := request.ID.String()
+	approverID := uuid.New().String()
+	comment := "同意"
+	assignmentID := uuid.New()
+
+	mocks.GrantRepo.EXPECT().GetByID(ctx, requestID).Return(request, nil)
+	mocks.DefinitionRepo.EXPECT().GetByID(ctx, role.ID.String()).Return(role, nil)
+	mocks.AssignmentRepo.EXPECT().
+		CheckUserRoleExists(ctx, request.UserID.String(), role.ID.String(), "").
+		Return(false, nil)
+	mocks.AssignmentRepo.EXPECT().Create(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, a *models.UserRoleAssignment) error {
+			assert.Equal(t, request.UserID, a.UserID)
+			assert.Equal(t, request.RequestedBy, a.CreatedBy)
+			assert.Equal(t, approverID, a.UpdatedBy.String())
+			a.ID = assignmentID
+
+			return nil
+		})
+	mocks.GrantRepo.EXPECT().Decide(ctx, requestID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, d grantrequest.RoleGrantDecision) (bool, error) {
+			assert.Equal(t, models.RoleGrantRequestStatusApproved, d.Status)
+			assert.Equal(t, approverID, d.DecidedBy.String())
+			assert.Equal(t, comment, d.Comment)
+			assert.Equal(t, assignmentID, *d.AssignmentID)
+
+			return true, nil
+		})
+	mocks.AuditLogRepo.EXPECT().Create(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+			assert.Equal(t, models.AuditActionRoleGrantApprove, log.Action)
+			assert.Equal(t, requestID, log.ResourceID)
+			assert.Contains(t, log.RequestBody, assignmentID.String())
+
+			return nil
+		})
+
+	result, err := logic.ApproveRoleGrant(ctx, &identity_srv.ApproveRoleGrantRequest{
+		RequestID:  &requestID,
+		ApproverID: &approverID,
+		Comment:    &comment,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, identity_srv.RoleGrantRequestStatus_ROLE_GRANT_REQUEST_STATUS_APPROVED, result.GetStatus())
+	assert.Equal(t, assignmentID.String(), result.GetAssignmentID())
+	assert.Equal(t, "role:auditor", result.GetRoleCode())
+}
+
+func TestApproveRoleGrant_SelfApprovalForbidden(t *testing.T) {
+	role := sensitiveRole("auditor")
+
+	tests := []struct {
+		name     string
+		approver func(*models.RoleGrantRequest) string
+	}{
+		{"申请人", func(r *models.RoleGrantRequest) string { return r.RequestedBy.String() }},
+		{"被授权用户", func(r *models.RoleGrantRequest) string { return r.UserID.String() }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logic, mocks := setupTest(t)
+			ctx := context.Background()
+
+			request := pendingGrantRequest(role)
+			requestID := request.ID.String()
+			approverID := tt.approver(request)
+
+			mocks.GrantRepo.EXPECT().GetByID(ctx, requestID).Return(request, nil)
+
+			result, err := logic.ApproveRoleGrant(ctx, &identity_srv.ApproveRoleGrantRequest{
+				RequestID:  &requestID,
+				ApproverID: &approverID,
+			})
+
+			assert.Nil(t, result)
+			assertErrCode(t, errno.ErrRoleGrantSelfApproval, err)
+		})
+	}
+}
+
+func TestApproveRoleGrant_ExpiredRequestIsClosed(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	role := sensitiveRole("auditor")
+	request := pendingGrantRequest(role)
+	request.ExpiresAt = time.Now().Add(-time.Minute).UnixMilli()
+	requestID := request.ID.String()
+	approverID := uuid.New().String()
+
+	mocks.GrantRepo.EXPECT().GetByID(ctx, requestID).Return(request, nil)
+	mocks.GrantRepo.EXPECT().Decide(ctx, requestID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, d grantrequest.RoleGrantDecision) (bool, error) {
+			assert.Equal(t, models.RoleGrantRequestStatusExpired, d.Status)
+			assert.Nil(t, d.DecidedBy)
+
+			return true, nil
+		})
+	mocks.AuditLogRepo.EXPECT().Create(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+			assert.Equal(t, models.AuditActionRoleGrantExpire, log.Action)
+			return nil
+		})
+
+	result, err := logic.ApproveRoleGrant(ctx, &identity_srv.ApproveRoleGrantRequest{
+		RequestID:  &requestID,
+		ApproverID: &approverID,
+	})
+
+	assert.Nil(t, result)
+	assertErrCode(t, errno.ErrRoleGrantRequestNotPending, err)
+}
+
+func TestApproveRoleGrant_AlreadyDecided(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	role := sensitiveRole("auditor")
+	request := pendingGrantRequest(role)
+	request.Status = models.RoleGrantRequestStatusRejected
+	requestID := request.ID.String()
+	approverID := uuid.New().String()
+
+	mocks.GrantRepo.EXPECT().GetByID(ctx, requestID).Return(request, nil)
+
+	result, err := logic.ApproveRoleGrant(ctx, &identity_srv.ApproveRoleGrantRequest{
+		RequestID:  &requestID,
+		ApproverID: &approverID,
+	})
+
+	assert.Nil(t, result)
+	assertErrCode(t, errno.ErrRoleGrantRequestNotPending, err)
+}
+
+func TestApproveRoleGrant_ConcurrentDecisionRollsBack(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	role := sensitiveRole("auditor")
+	request := pendingGrantRequest(role)
+	requestID := request.ID.String()
+	approverID := uuid.New().String()
+
+	mocks.GrantRepo.EXPECT().GetByID(ctx, requestID).Return(request, nil)
+	mocks.DefinitionRepo.EXPECT().GetByID(ctx, role.ID.String()).Return(role, nil)
+	mocks.AssignmentRepo.EXPECT().
+		CheckUserRoleExists(ctx, request.UserID.String(), role.ID.String(), "").
+		Return(false, nil)
+	mocks.AssignmentRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+	// 另一审批人已先处理：条件更新未命中，事务回滚且不写审计
+	mocks.GrantRepo.EXPECT().Decide(ctx, requestID, gomock.Any()).Return(false, nil)
+
+	result, err := logic.ApproveRoleGrant(ctx, &identity_srv.ApproveRoleGrantRequest{
+		RequestID:  &requestID,
+		ApproverID: &approverID,
+	})
+
+	assert.Nil(t, result)
+	assertErrCode(t, errno.ErrRoleGrantRequestNotPending, err)
+}
+
+func TestApproveRoleGrant_RequestNotFound(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	requestID := uuid.New().String()
+	approverID := uuid.New().String()
+
+	mocks.GrantRepo.EXPECT().GetByID(ctx, requestID).Return(nil, gorm.ErrRecordNotFound)
+
+	result, err := logic.ApproveRoleGrant(ctx, &identity_srv.ApproveRoleGrantRequest{
+		RequestID:  &requestID,
+		ApproverID: &approverID,
+	})
+
+	assert.Nil(t, result)
+	assertErrCode(t, errno.ErrRoleGrantRequestNotFound, err)
+}
+
+func TestRejectRoleGrant(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	role := sensitiveRole("auditor")
+	request := pendingGrantRequest(role)
+	requestID := request.ID.String()
+	approverID := uuid.New().String()
+	comment := "职责不符"
+
+	mocks.GrantRepo.EXPECT().GetByID(ctx, requestID).Return(request, nil)
+	mocks.GrantRepo.EXPECT().Decide(ctx, requestID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, d grantrequest.RoleGrantDecision) (bool, error) {
+			assert.Equal(t, models.RoleGrantRequestStatusRejected, d.Status)
+			assert.Nil(t, d.AssignmentID)
+
+			return true, nil
+		})
+	mocks.AuditLogRepo.EXPECT().Create(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+			assert.Equal(t, models.AuditActionRoleGrantReject, log.Action)
+			assert.Contains(t, log.RequestBody, comment)
+
+			return nil
+		})
+	mocks.DefinitionRepo.EXPECT().BatchGetByIDs(ctx, []string{role.ID.String()}).
+		Return([]*models.RoleDefinition{role}, nil)
+
+	result, err := logic.RejectRoleGrant(ctx, &identity_srv.RejectRoleGrantRequest{
+		RequestID:  &requestID,
+		ApproverID: &approverID,
+		Comment:    &comment,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, identity_srv.RoleGrantRequestStatus_ROLE_GRANT_REQUEST_STATUS_REJECTED, result.GetStatus())
+	assert.Equal(t, comment, result.GetDecisionComment())
+}
+
+// ============================================================================
+// 过期处理
+// ============================================================================
+
+func TestExpireRoleGrantRequests(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	role := sensitiveRole("auditor")
+	first := pendingGrantRequest(role)
+	second := pendingGrantRequest(role)
+
+	mocks.GrantRepo.EXPECT().FindExpiredPending(ctx, gomock.Any(), expireBatchSize).
+		Return([]*models.RoleGrantRequest{first, second}, nil)
+	mocks.GrantRepo.EXPECT().Decide(ctx, first.ID.String(), gomock.Any()).Return(true, nil)
+	mocks.AuditLogRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+	// 第二条已被审批人抢先处理，跳过
+	mocks.GrantRepo.EXPECT().Decide(ctx, second.ID.String(), gomock.Any()).Return(false, nil)
+
+	count, err := logic.ExpireRoleGrantRequests(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, models.RoleGrantRequestStatusExpired, first.Status)
+}
@@ -6,6 +6,12 @@ import (
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
 )
 
+// RoleBindingRevoker 撤销 policy_srv 中的用户角色绑定（Casbin g 规则）
+// 生产实现为 iamclient.Client；domain 为空表示全局域
+type RoleBindingRevoker interface {
+	RevokeRoleBinding(ctx context.Context, userID, roleCode, domain string) error
+}
+
 // RoleAssignmentLogic 用户角色分配管理业务逻辑接口
 // 负责用户角色分配的创建、更新、查询、删除等核心业务功能
 type RoleAssignmentLogic interface {
@@ -68,4 +74,65 @@ type RoleAssignmentLogic interface {
 		ctx context.Context,
 		req *identity_srv.BatchGetUserRolesRequest,
 	) (*identity_srv.BatchGetUserRolesResponse, error)
+
+	// GetUserTenantRoles 获取用户在指定租户下生效的角色（role code + 详情）
+	// 对应 IDL: GetUserTenantRoles(1: GetUserTenantRolesRequest req)
+	GetUserTenantRoles(
+		ctx context.Context,
+		req *identity_srv.GetUserTenantRolesRequest,
+	) (*identity_srv.GetUserTenantRolesResponse, error)
+
+	// ============================================================================
+	// 职责分离约束
+	// ============================================================================
+
+	// ListRoleConstraintViolations 扫描已存在的互斥角色与分配人数上限违例
+	// 对应 IDL: ListRoleConstraintViolations(1: ListRoleConstraintViolationsRequest req)
+	ListRoleConstraintViolations(
+		ctx context.Context,
+		req *identity_srv.ListRoleConstraintViolationsRequest,
+	) (*identity_srv.ListRoleConstraintViolationsResponse, error)
+
+	// ============================================================================
+	// 敏感角色授权审批
+	// ============================================================================
+
+	// ListRoleGrantRequests 分页查询角色授权申请
+	// 对应 IDL: ListRoleGrantRequests(1: ListRoleGrantRequestsRequest req)
+	ListRoleGrantRequests(
+		ctx context.Context,
+		req *identity_srv.ListRoleGrantRequestsRequest,
+	) (*identity_srv.ListRoleGrantRequestsResponse, error)
+
+	// GetRoleGrantRequest 获取单个角色授权申请
+	// 对应 IDL: GetRoleGrantRequest(1: GetRoleGrantRequestRequest req)
+	GetRoleGrantRequest(
+		ctx context.Context,
+		requestID string,
+	) (*identity_srv.RoleGrantRequest, error)
+
+	// ApproveRoleGrant 审批通过并生成角色分配
+	// 对应 IDL: ApproveRoleGrant(1: ApproveRoleGrantRequest req)
+	ApproveRoleGrant(
+		ctx context.Context,
+		req *identity_srv.ApproveRoleGrantRequest,
+	) (*identity_srv.RoleGrantRequest, error)
+
+	// RejectRoleGrant 驳回角色授权申请
+	// 对应 IDL: RejectRoleGrant(1: RejectRoleGrantRequest req)
+	RejectRoleGrant(
+		ctx context.Context,
+		req *identity_srv.RejectRoleGrantRequest,
+	) (*identity_srv.RoleGrantRequest, error)
+
+	// ============================================================================
+	// 临时授权回收（后台任务，无对应 IDL）
+	// ============================================================================
+
+	// ExpireRoleAssignments 回收已到期的角色分配：先删除 policy_srv 中对应的 g 规则，
+	// 再删除分配记录；返回本次回收的数量
+	ExpireRoleAssignments(ctx context.Context, revoker RoleBindingRevoker) (int, error)
+
+	// ExpireRoleGrantRequests 把超过审批期限的待审批申请置为过期；返回本次处理的数量
+	ExpireRoleGrantRequests(ctx context.Context) (int, error)
 }
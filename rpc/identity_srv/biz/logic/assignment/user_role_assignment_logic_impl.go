@@ -3,6 +3,7 @@ package assignment
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -16,20 +17,28 @@ import (
 	tracelog "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/log"
 )
 
+// expireBatchSize 每轮回收到期分配的最大条数，剩余部分留给下一轮
+const expireBatchSize = 200
+
 // LogicImpl 用户角色分配业务逻辑实现
 type LogicImpl struct {
 	dal       dal.DAL
 	converter converter.Converter
+
+	// approvalTTL 敏感角色授权申请的审批期限
+	approvalTTL time.Duration
 }
 
 // NewUserRoleAssignmentLogic 创建用户角色分配业务逻辑实例
 func NewLogic(
 	dal dal.DAL,
 	converter converter.Converter,
+	approvalTTL time.Duration,
 ) RoleAssignmentLogic {
 	return &LogicImpl{
-		dal:       dal,
-		converter: converter,
+		dal:         dal,
+		converter:   converter,
+		approvalTTL: approvalTTL,
 	}
 }
 
@@ -41,9 +50,21 @@ func (l *LogicImpl) AssignRoleToUser(
 	userID := *req.UserID
 	roleID := *req.RoleID
 	assignedByID := *req.AssignedBy
+	organizationID := req.GetOrganizationID()
+
+	// 校验生效时间窗口
+	if err := validateValidityWindow(req.ValidFrom, req.ValidUntil); err != nil {
+		return nil, err
+	}
 
-	// 检查用户是否已分配该角色，避免重复分配
-	exists, err := l.dal.UserRoleAssignment().CheckUserRoleExists(ctx, userID, roleID)
+	// 组织级分配需校验组织存在；为空表示全局分配
+	orgUUID, err := l.resolveOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 检查用户是否已在该范围内拥有该角色（全局分配覆盖所有组织），避免重复分配
+	exists, err := l.dal.UserRoleAssignment().CheckUserRoleExists(ctx, userID, roleID, organizationID)
 	if err != nil {
 		return nil, errno.ErrOperationFailed.WithMessage("检查角色分配状态失败: " + err.Error())
 	}
@@ -62,10 +83,24 @@ func (l *LogicImpl) AssignRoleToUser(
 		return nil, errno.ErrRoleDefinitionNotFound
 	}
 
+	// 校验职责分离约束（互斥角色、分配人数上限）
+	grant := RoleGrant{UserID: userID, Role: role, ValidFrom: req.ValidFrom, ValidUntil: req.ValidUntil}
+	if err := l.roleConstraints().Check(ctx, []RoleGrant{grant}, nil); err != nil {
+		return nil, err
+	}
+
+	// 敏感角色不直接分配，生成待审批申请，审批通过后才写入分配
+	if role.IsSensitive() {
+		return l.requestRoleGrant(ctx, role, req, orgUUID)
+	}
+
 	// 创建角色分配记录
 	assignment := &models.UserRoleAssignment{
-		UserID: uuid.MustParse(userID),
-		RoleID: uuid.MustParse(roleID),
+		UserID:         uuid.MustParse(userID),
+		RoleID:         uuid.MustParse(roleID),
+		OrganizationID: orgUUID,
+		ValidFrom:      req.ValidFrom,
+		ValidUntil:     req.ValidUntil,
 	}
 
 	if assignedByID != "" {
@@ -83,6 +118,45 @@ func (l *LogicImpl) AssignRoleToUser(
 	}, nil
 }
 
+// validateValidityWindow 校验生效时间窗口：截止时间必须晚于开始时间且晚于当前时间
+func validateValidityWindow(validFrom, validUntil *int64) error {
+	if validUntil == nil {
+		return nil
+	}
+
+	if validFrom != nil && *validUntil <= *validFrom {
+		return errno.ErrInvalidParams.WithMessage("生效截止时间必须晚于开始时间")
+	}
+
+	if *validUntil <= time.Now().UnixMilli() {
+		return errno.ErrInvalidParams.WithMessage("生效截止时间必须晚于当前时间")
+	}
+
+	return nil
+}
+
+// resolveOrganization 解析并校验角色分配的组织范围，空字符串表示全局分配（返回 nil）
+func (l *LogicImpl) resolveOrganization(ctx context.Context, organizationID string) (*uuid.UUID, error) {
+	if organizationID == "" {
+		return nil, nil
+	}
+
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, errno.ErrInvalidParams.WithMessage("组织ID格式无效")
+	}
+
+	if _, err := l.dal.Organization().GetByID(ctx, organizationID); err != nil {
+		if errno.IsRecordNotFound(err) {
+			return nil, errno.ErrOrganizationNotFound
+		}
+
+		return nil, errno.ErrOperationFailed.WithMessage("查询组织信息失败: " + err.Error())
+	}
+
+	return &orgUUID, nil
+}
+
 // UpdateUserRoleAssignment 更新用户的角色分配信息
 func (l *LogicImpl) UpdateUserRoleAssignment(
 	ctx context.Context,
@@ -119,6 +193,39 @@ func (l *LogicImpl) UpdateUserRoleAssignment(
 		assignment.UpdatedBy = &updatedByUUID
 	}
 
+	// 用户或角色变更时，按变更后的用户与角色校验职责分离约束，被修改的分配本身不计入
+	if req.UserID != nil || req.RoleID != nil {
+		role, err := l.dal.RoleDefinition().GetByID(ctx, assignment.RoleID.String())
+		if err != nil {
+			if errno.IsRecordNotFound(err) {
+				return errno.ErrRoleDefinitionNotFound
+			}
+
+			return errno.ErrOperationFailed.WithMessage("查询角色信息失败: " + err.Error())
+		}
+
+		// 改派到敏感角色或把敏感角色改派给其他用户等同于新授权，必须走审批
+		if role.IsSensitive() {
+			return errno.ErrRoleApprovalRequired.WithMessage(
+				fmt.Sprintf("角色 %s 需经审批才能分配，请通过角色分配发起授权申请", role.Name),
+			)
+		}
+
+		grant := RoleGrant{
+			UserID:     assignment.UserID.String(),
+			Role:       role,
+			ValidFrom:  assignment.ValidFrom,
+			ValidUntil: assignment.ValidUntil,
+		}
+
+		err = l.roleConstraints().Check(ctx, []RoleGrant{grant}, func(a *models.UserRoleAssignment) bool {
+			return a.ID == assignment.ID
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	// 保存更新
 	if err := l.dal.UserRoleAssignment().Update(ctx, assignment); err != nil {
 		return errno.ErrOperationFailed.WithMessage("更新角色分配失败: " + err.Error())
@@ -171,7 +278,12 @@ func (l *LogicImpl) RevokeRoleFromUser(
 	}
 
 	// 3. 查找用户和角色的分配记录
-	assignment, err := l.dal.UserRoleAssignment().FindByUserAndRole(ctx, userID, roleID)
+	assignment, err := l.dal.UserRoleAssignment().FindByUserAndRole(
+		ctx,
+		userID,
+		roleID,
+		req.GetOrganizationID(),
+	)
 	if err != nil {
 		return errno.ErrOperationFailed.WithMessage("查询角色分配失败: " + err.Error())
 	}
@@ -223,8 +335,13 @@ func (l *LogicImpl) ListUserRoleAssignments(
 	ctx context.Context,
 	req *identity_srv.UserRoleQueryRequest,
 ) (*identity_srv.UserRoleListResponse, error) {
+	opts := l.converter.Base().PageRequestToQueryOptions(req.Page)
+	if err := opts.Validate(); err != nil {
+		return nil, errno.ErrInvalidParams.WithMessage("分页参数无效: " + err.Error())
+	}
+
 	// 构建查询条件
-	conditions := &assignmentDal.UserRoleAssignmentQueryConditions{}
+	conditions := &assignmentDal.UserRoleAssignmentQueryConditions{Page: opts}
 
 	if req.UserID != nil {
 		userID := *req.UserID
@@ -236,6 +353,21 @@ func (l *LogicImpl) ListUserRoleAssignments(
 		conditions.RoleID = &roleID
 	}
 
+	if req.OrganizationID != nil {
+		organizationID := *req.OrganizationID
+		conditions.OrganizationID = &organizationID
+	}
+
+	// 即将到期的临时授权：在未来 N 秒内到期
+	if req.ExpiringWithinSeconds != nil {
+		if *req.ExpiringWithinSeconds <= 0 {
+			return nil, errno.ErrInvalidParams.WithMessage("到期时间窗口必须大于0")
+		}
+
+		expiringBefore := time.Now().Add(time.Duration(*req.ExpiringWithinSeconds) * time.Second).UnixMilli()
+		conditions.ExpiringBefore = &expiringBefore
+	}
+
 	// 查询角色分配记录
 	assignments, pageResult, err := l.dal.UserRoleAssignment().FindWithConditions(ctx, conditions)
 	if err != nil {
@@ -279,8 +411,8 @@ func (l *LogicImpl) GetUsersByRole(
 		return nil, errno.ErrRoleDefinitionNotFound
 	}
 
-	// 获取该角色下所有用户ID
-	userIDs, err := l.dal.UserRoleAssignment().GetAllUserIDsByRoleID(ctx, roleID)
+	// 获取该角色下所有用户ID（指定组织时包含全局分配与该组织下的分配）
+	userIDs, err := l.dal.UserRoleAssignment().GetAllUserIDsByRoleID(ctx, roleID, req.OrganizationID)
 	if err != nil {
 		return nil, errno.ErrOperationFailed.WithMessage("查询角色用户列表失败: " + err.Error())
 	}
@@ -323,6 +455,24 @@ func (l *LogicImpl) BatchBindUsersToRole(
 		return nil, errno.ErrRoleDefinitionNotFound
 	}
 
+	// 敏感角色不能通过批量绑定新增用户
+	if err := l.ensureGrantedWithoutApproval(ctx, role, userIDs); err != nil {
+		return nil, err
+	}
+
+	// 校验职责分离约束：该角色现有的全局分配将被整体替换，不计入
+	grants := make([]RoleGrant, 0, len(userIDs))
+	for _, id := range userIDs {
+		grants = append(grants, RoleGrant{UserID: id, Role: role})
+	}
+
+	err = l.roleConstraints().Check(ctx, grants, func(a *models.UserRoleAssignment) bool {
+		return a.RoleID == role.ID && a.IsGlobal()
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	// 批量替换角色的用户绑定（事务操作）
 	err = l.dal.UserRoleAssignment().ReplaceRoleUsers(ctx, roleID, userIDs, operatorID)
 	if err != nil {
@@ -362,3 +512,164 @@ func (l *LogicImpl) BatchGetUserRoles(
 		UserRoles: userRoles,
 	}, nil
 }
+
+// GetUserTenantRoles 获取用户在指定租户下生效的角色
+//
+// 生效角色为全局分配与该组织下分配的并集；成员关系校验由调用方（网关）通过 CheckMembership 完成。
+func (l *LogicImpl) GetUserTenantRoles(
+	ctx context.Context,
+	req *identity_srv.GetUserTenantRolesRequest,
+) (*identity_srv.GetUserTenantRolesResponse, error) {
+	if req.UserID == nil || *req.UserID == "" {
+		return nil, errno.ErrInvalidParams.WithMessage("用户ID不能为空")
+	}
+
+	if req.OrganizationID == nil || *req.OrganizationID == "" {
+		return nil, errno.ErrInvalidParams.WithMessage("组织ID不能为空")
+	}
+
+	roleIDs, err := l.dal.UserRoleAssignment().GetActiveRoleIDsWithStatus(
+		ctx,
+		*req.UserID,
+		req.OrganizationID,
+		models.RoleStatusActive,
+	)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("获取用户角色列表失败: " + err.Error())
+	}
+
+	if len(roleIDs) == 0 {
+		return nil, errno.ErrNoActiveRoles.WithMessage("用户在该组织下没有可用的角色")
+	}
+
+	roleModels, err := l.dal.RoleDefinition().BatchGetByIDs(ctx, roleIDs)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("获取角色详情失败: " + err.Error())
+	}
+
+	roleCodes := make([]string, 0, len(roleModels))
+
+	for _, m := range roleModels {
+		if m != nil && m.RoleCode != "" {
+			roleCodes = append(roleCodes, m.RoleCode)
+		}
+	}
+
+	if len(roleCodes) == 0 {
+		tracelog.Ctx(ctx).Error().
+			Str("user_id", *req.UserID).
+			Str("organization_id", *req.OrganizationID).
+			Strs("role_ids", roleIDs).
+			Msg("角色详情中未找到任何有效 role code")
+
+		return nil, errno.ErrNoActiveRoles.WithMessage("用户角色缺少有效 role code，无法签发 token")
+	}
+
+	return &identity_srv.GetUserTenantRolesResponse{
+		RoleCodes:   roleCodes,
+		RoleDetails: l.converter.RoleDefinition().ModelsToThrift(roleModels),
+	}, nil
+}
+
+// ExpireRoleAssignments 回收已到期的角色分配
+//
+// 单条回收失败（g 规则删除失败、记录删除失败）只记录日志并跳过，记录保留到下一轮重试；
+// 到期分配在登录和菜单计算中已被忽略，延迟回收不会放大权限。
+// 多副本同时执行时 g 规则删除与软删除均为幂等操作。
+func (l *LogicImpl) ExpireRoleAssignments(
+	ctx context.Context,
+	revoker RoleBindingRevoker,
+) (int, error) {
+	expired, err := l.dal.UserRoleAssignment().FindExpired(ctx, time.Now().UnixMilli(), expireBatchSize)
+	if err != nil {
+		return 0, errno.ErrOperationFailed.WithMessage("查询到期角色分配失败: " + err.Error())
+	}
+
+	if len(expired) == 0 {
+		return 0, nil
+	}
+
+	roleCodes, err := l.roleCodesByID(ctx, expired)
+	if err != nil {
+		return 0, err
+	}
+
+	expiredCount := 0
+
+	for _, assignment := range expired {
+		userID := assignment.UserID.String()
+		roleID := assignment.RoleID.String()
+
+		domain := ""
+		if !assignment.IsGlobal() {
+			domain = assignment.OrganizationID.String()
+		}
+
+		if roleCode := roleCodes[roleID]; roleCode != "" && revoker != nil {
+			if err := revoker.RevokeRoleBinding(ctx, userID, roleCode, domain); err != nil {
+				tracelog.Ctx(ctx).Warn().
+					Err(err).
+					Str("assignment_id", assignment.ID.String()).
+					Str("user_id", userID).
+					Str("role_code", roleCode).
+					Msg("撤销到期角色的 g 规则失败，下一轮重试")
+
+				continue
+			}
+		}
+
+		if err := l.dal.UserRoleAssignment().Delete(ctx, assignment.ID.String()); err != nil {
+			tracelog.Ctx(ctx).Warn().
+				Err(err).
+				Str("assignment_id", assignment.ID.String()).
+				Msg("删除到期角色分配失败，下一轮重试")
+
+			continue
+		}
+
+		expiredCount++
+
+		tracelog.Ctx(ctx).Info().
+			Str("assignment_id", assignment.ID.String()).
+			Str("user_id", userID).
+			Str("role_id", roleID).
+			Str("organization_id", domain).
+			Int64("valid_until", *assignment.ValidUntil).
+			Msg("到期角色分配已回收")
+	}
+
+	return expiredCount, nil
+}
+
+// roleCodesByID 批量查询分配涉及角色的 role code，返回 map[roleID]roleCode
+func (l *LogicImpl) roleCodesByID(
+	ctx context.Context,
+	assignments []*models.UserRoleAssignment,
+) (map[string]string, error) {
+	seen := make(map[string]struct{}, len(assignments))
+	roleIDs := make([]string, 0, len(assignments))
+
+	for _, assignment := range assignments {
+		roleID := assignment.RoleID.String()
+		if _, ok := seen[roleID]; ok {
+			continue
+		}
+
+		seen[roleID] = struct{}{}
+		roleIDs = append(roleIDs, roleID)
+	}
+
+	roles, err := l.dal.RoleDefinition().BatchGetByIDs(ctx, roleIDs)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("获取角色详情失败: " + err.Error())
+	}
+
+	roleCodes := make(map[string]string, len(roles))
+	for _, role := range roles {
+		if role != nil {
+			roleCodes[role.ID.String()] = role.RoleCode
+		}
+	}
+
+	return roleCodes, nil
+}
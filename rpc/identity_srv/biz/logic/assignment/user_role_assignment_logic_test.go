@@ -4,16 +4,20 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter"
+	assignmentDal "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/assignment"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/mock"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/core"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/rpc_base"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
 )
@@ -60,7 +64,7 @@ func TestLogicImpl_AssignRoleToUser(t *testing.T) {
 			AssignedBy: &assignedBy,
 		}
 
-		mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, userID, roleID).Return(false, nil)
+		mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, userID, roleID, "").Return(false, nil)
 		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(&models.RoleDefinition{
 			BaseModel: models.BaseModel{ID: uuid.MustParse(roleID)},
 			Name:      "admin",
@@ -85,7 +89,7 @@ func TestLogicImpl_AssignRoleToUser(t *testing.T) {
 			AssignedBy: &emptyAssignedBy,
 		}
 
-		mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, userID, roleID).Return(false, nil)
+		mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, userID, roleID, "").Return(false, nil)
 		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(&models.RoleDefinition{
 			BaseModel: models.BaseModel{ID: uuid.MustParse(roleID)},
 			Name:      "admin",
@@ -108,7 +112,7 @@ func TestLogicImpl_AssignRoleToUser(t *testing.T) {
 			AssignedBy: &assignedBy,
 		}
 
-		mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, userID, roleID).Return(true, nil)
+		mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, userID, roleID, "").Return(true, nil)
 
 		result, err := logic.AssignRoleToUser(ctx, req)
 
@@ -126,7 +130,7 @@ func TestLogicImpl_AssignRoleToUser(t *testing.T) {
 			AssignedBy: &assignedBy,
 		}
 
-		mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, userID, roleID).Return(false, errors.New("db error"))
+		mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, userID, roleID, "").Return(false, errors.New("db error"))
 
 		result, err := logic.AssignRoleToUser(ctx, req)
 
@@ -144,7 +148,7 @@ func TestLogicImpl_AssignRoleToUser(t *testing.T) {
 			AssignedBy: &assignedBy,
 		}
 
-		mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, userID, roleID).Return(false, nil)
+		mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, userID, roleID, "").Return(false, nil)
 		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(nil, nil)
 
 		result, err := logic.AssignRoleToUser(ctx, req)
@@ -163,7 +167,7 @@ func TestLogicImpl_AssignRoleToUser(t *testing.T) {
 			AssignedBy: &assignedBy,
 		}
 
-		mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, userID, roleID).Return(false, nil)
+		mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, userID, roleID, "").Return(false, nil)
 		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(nil, errors.New("db error"))
 
 		result, err := logic.AssignRoleToUser(ctx, req)
@@ -182,7 +186,7 @@ func TestLogicImpl_AssignRoleToUser(t *testing.T) {
 			AssignedBy: &assignedBy,
 		}
 
-		mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, userID, roleID).Return(false, nil)
+		mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, userID, roleID, "").Return(false, nil)
 		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(&models.RoleDefinition{
 			BaseModel: models.BaseModel{ID: uuid.MustParse(roleID)},
 			Name:      "admin",
@@ -194,6 +198,140 @@ func TestLogicImpl_AssignRoleToUser(t *testing.T) {
 		assert.Nil(t, result)
 		assertErrCode(t, errno.ErrOperationFailed, err)
 	})
+
+	t.Run("成功分配组织级角色", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		orgID := uuid.New().String()
+		req := &identity_srv.AssignRoleToUserRequest{
+			UserID:         &userID,
+			RoleID:         &roleID,
+			AssignedBy:     &assignedBy,
+			OrganizationID: &orgID,
+		}
+
+		mocks.OrgRepo.EXPECT().GetByID(ctx, orgID).Return(&models.Organization{}, nil)
+		mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, userID, roleID, orgID).Return(false, nil)
+		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(&models.RoleDefinition{
+			BaseModel: models.BaseModel{ID: uuid.MustParse(roleID)},
+			Name:      "admin",
+		}, nil)
+		mocks.AssignmentRepo.EXPECT().Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, a *models.UserRoleAssignment) error {
+				require.NotNil(t, a.OrganizationID)
+				assert.Equal(t, orgID, a.OrganizationID.String())
+				assert.False(t, a.IsGlobal())
+
+				return nil
+			})
+
+		result, err := logic.AssignRoleToUser(ctx, req)
+
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+
+	t.Run("成功分配临时角色", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		validFrom := time.Now().UnixMilli()
+		validUntil := time.Now().Add(7 * 24 * time.Hour).UnixMilli()
+		req := &identity_srv.AssignRoleToUserRequest{
+			UserID:     &userID,
+			RoleID:     &roleID,
+			AssignedBy: &assignedBy,
+			ValidFrom:  &validFrom,
+			ValidUntil: &validUntil,
+		}
+
+		mocks.AssignmentRepo.EXPECT().CheckUserRoleExists(ctx, userID, roleID, "").Return(false, nil)
+		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(&models.RoleDefinition{
+			BaseModel: models.BaseModel{ID: uuid.MustParse(roleID)},
+			Name:      "oncall-admin",
+		}, nil)
+		mocks.AssignmentRepo.EXPECT().Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, a *models.UserRoleAssignment) error {
+				assert.Equal(t, &validFrom, a.ValidFrom)
+				assert.Equal(t, &validUntil, a.ValidUntil)
+
+				return nil
+			})
+
+		result, err := logic.AssignRoleToUser(ctx, req)
+
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+
+	t.Run("生效窗口无效", func(t *testing.T) {
+		logic, _ := setupTest(t)
+		ctx := context.Background()
+
+		now := time.Now()
+		future := now.Add(time.Hour).UnixMilli()
+		past := now.Add(-time.Hour).UnixMilli()
+		later := now.Add(2 * time.Hour).UnixMilli()
+
+		windows := map[string][2]*int64{
+			"截止时间早于开始时间": {&later, &future},
+			"截止时间已过去":    {nil, &past},
+		}
+
+		for name, window := range windows {
+			req := &identity_srv.AssignRoleToUserRequest{
+				UserID:     &userID,
+				RoleID:     &roleID,
+				AssignedBy: &assignedBy,
+				ValidFrom:  window[0],
+				ValidUntil: window[1],
+			}
+
+			result, err := logic.AssignRoleToUser(ctx, req)
+
+			assert.Nil(t, result, name)
+			assertErrCode(t, errno.ErrInvalidParams, err)
+		}
+	})
+
+	t.Run("组织ID格式无效", func(t *testing.T) {
+		logic, _ := setupTest(t)
+		ctx := context.Background()
+
+		invalidOrgID := "not-a-uuid"
+		req := &identity_srv.AssignRoleToUserRequest{
+			UserID:         &userID,
+			RoleID:         &roleID,
+			AssignedBy:     &assignedBy,
+			OrganizationID: &invalidOrgID,
+		}
+
+		result, err := logic.AssignRoleToUser(ctx, req)
+
+		assert.Nil(t, result)
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+
+	t.Run("组织不存在", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		orgID := uuid.New().String()
+		req := &identity_srv.AssignRoleToUserRequest{
+			UserID:         &userID,
+			RoleID:         &roleID,
+			AssignedBy:     &assignedBy,
+			OrganizationID: &orgID,
+		}
+
+		mocks.OrgRepo.EXPECT().GetByID(ctx, orgID).Return(nil, gorm.ErrRecordNotFound)
+
+		result, err := logic.AssignRoleToUser(ctx, req)
+
+		assert.Nil(t, result)
+		assertErrCode(t, errno.ErrOrganizationNotFound, err)
+	})
 }
 
 // ============================================================================
@@ -224,6 +362,7 @@ func TestLogicImpl_UpdateUserRoleAssignment(t *testing.T) {
 		}
 
 		mocks.AssignmentRepo.EXPECT().GetByID(ctx, assignmentID).Return(existingAssignment, nil)
+		mocks.DefinitionRepo.EXPECT().GetByID(ctx, gomock.Any()).Return(&models.RoleDefinition{Name: "admin"}, nil)
 		mocks.AssignmentRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 
 		err := logic.UpdateUserRoleAssignment(ctx, req)
@@ -247,6 +386,7 @@ func TestLogicImpl_UpdateUserRoleAssignment(t *testing.T) {
 		}
 
 		mocks.AssignmentRepo.EXPECT().GetByID(ctx, assignmentID).Return(existingAssignment, nil)
+		mocks.DefinitionRepo.EXPECT().GetByID(ctx, gomock.Any()).Return(&models.RoleDefinition{Name: "admin"}, nil)
 		mocks.AssignmentRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 
 		err := logic.UpdateUserRoleAssignment(ctx, req)
@@ -325,6 +465,7 @@ func TestLogicImpl_UpdateUserRoleAssignment(t *testing.T) {
 		}
 
 		mocks.AssignmentRepo.EXPECT().GetByID(ctx, assignmentID).Return(existingAssignment, nil)
+		mocks.DefinitionRepo.EXPECT().GetByID(ctx, gomock.Any()).Return(&models.RoleDefinition{Name: "admin"}, nil)
 		mocks.AssignmentRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
 
 		err := logic.UpdateUserRoleAssignment(ctx, req)
@@ -358,7 +499,7 @@ func TestLogicImpl_RevokeRoleFromUser(t *testing.T) {
 		}
 
 		mocks.UserRepo.EXPECT().IsSystemUser(ctx, userID).Return(false, nil)
-		mocks.AssignmentRepo.EXPECT().FindByUserAndRole(ctx, userID, roleID).Return(assignment, nil)
+		mocks.AssignmentRepo.EXPECT().FindByUserAndRole(ctx, userID, roleID, "").Return(assignment, nil)
 		mocks.AssignmentRepo.EXPECT().Delete(ctx, assignmentUUID.String()).Return(nil)
 
 		err := logic.RevokeRoleFromUser(ctx, req)
@@ -388,7 +529,7 @@ func TestLogicImpl_RevokeRoleFromUser(t *testing.T) {
 			Name:         "custom_role",
 			IsSystemRole: false,
 		}, nil)
-		mocks.AssignmentRepo.EXPECT().FindByUserAndRole(ctx, userID, roleID).Return(assignment, nil)
+		mocks.AssignmentRepo.EXPECT().FindByUserAndRole(ctx, userID, roleID, "").Return(assignment, nil)
 		mocks.AssignmentRepo.EXPECT().Delete(ctx, assignmentUUID.String()).Return(nil)
 
 		err := logic.RevokeRoleFromUser(ctx, req)
@@ -516,7 +657,7 @@ func TestLogicImpl_RevokeRoleFromUser(t *testing.T) {
 		}
 
 		mocks.UserRepo.EXPECT().IsSystemUser(ctx, userID).Return(false, nil)
-		mocks.AssignmentRepo.EXPECT().FindByUserAndRole(ctx, userID, roleID).Return(nil, nil)
+		mocks.AssignmentRepo.EXPECT().FindByUserAndRole(ctx, userID, roleID, "").Return(nil, nil)
 
 		err := logic.RevokeRoleFromUser(ctx, req)
 
@@ -533,7 +674,7 @@ func TestLogicImpl_RevokeRoleFromUser(t *testing.T) {
 		}
 
 		mocks.UserRepo.EXPECT().IsSystemUser(ctx, userID).Return(false, nil)
-		mocks.AssignmentRepo.EXPECT().FindByUserAndRole(ctx, userID, roleID).Return(nil, errors.New("db error"))
+		mocks.AssignmentRepo.EXPECT().FindByUserAndRole(ctx, userID, roleID, "").Return(nil, errors.New("db error"))
 
 		err := logic.RevokeRoleFromUser(ctx, req)
 
@@ -557,7 +698,7 @@ func TestLogicImpl_RevokeRoleFromUser(t *testing.T) {
 		}
 
 		mocks.UserRepo.EXPECT().IsSystemUser(ctx, userID).Return(false, nil)
-		mocks.AssignmentRepo.EXPECT().FindByUserAndRole(ctx, userID, roleID).Return(assignment, nil)
+		mocks.AssignmentRepo.EXPECT().FindByUserAndRole(ctx, userID, roleID, "").Return(assignment, nil)
 		mocks.AssignmentRepo.EXPECT().Delete(ctx, assignmentUUID.String()).Return(errors.New("db error"))
 
 		err := logic.RevokeRoleFromUser(ctx, req)
@@ -695,6 +836,85 @@ func TestLogicImpl_ListUserRoleAssignments(t *testing.T) {
 		assert.Len(t, result.Assignments, 1)
 	})
 
+	t.Run("按组织过滤", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		orgID := uuid.New().String()
+		req := &identity_srv.UserRoleQueryRequest{
+			UserID:         &userID,
+			OrganizationID: &orgID,
+		}
+
+		mocks.AssignmentRepo.EXPECT().
+			FindWithConditions(ctx, gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context,
+				conditions *assignmentDal.UserRoleAssignmentQueryConditions,
+			) ([]*models.UserRoleAssignment, *models.PageResult, error) {
+				require.NotNil(t, conditions.OrganizationID)
+				assert.Equal(t, orgID, *conditions.OrganizationID)
+
+				return []*models.UserRoleAssignment{}, &models.PageResult{Total: 0, Page: 1, Limit: 20, TotalPages: 1}, nil
+			})
+
+		result, err := logic.ListUserRoleAssignments(ctx, req)
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Assignments)
+	})
+
+	t.Run("查询即将到期的授权", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		within := int64(24 * 3600)
+		req := &identity_srv.UserRoleQueryRequest{
+			ExpiringWithinSeconds: &within,
+		}
+
+		validUntil := time.Now().Add(time.Hour).UnixMilli()
+		assignments := []*models.UserRoleAssignment{
+			{
+				BaseModel:  models.BaseModel{ID: uuid.New()},
+				UserID:     uuid.MustParse(userID),
+				RoleID:     uuid.MustParse(roleID),
+				ValidUntil: &validUntil,
+			},
+		}
+
+		mocks.AssignmentRepo.EXPECT().
+			FindWithConditions(ctx, gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context,
+				conditions *assignmentDal.UserRoleAssignmentQueryConditions,
+			) ([]*models.UserRoleAssignment, *models.PageResult, error) {
+				require.NotNil(t, conditions.ExpiringBefore)
+				assert.Greater(t, *conditions.ExpiringBefore, validUntil)
+
+				return assignments, &models.PageResult{Total: 1, Page: 1, Limit: 20, TotalPages: 1}, nil
+			})
+
+		result, err := logic.ListUserRoleAssignments(ctx, req)
+
+		require.NoError(t, err)
+		require.Len(t, result.Assignments, 1)
+		assert.Equal(t, validUntil, result.Assignments[0].GetValidUntil())
+	})
+
+	t.Run("到期时间窗口无效", func(t *testing.T) {
+		logic, _ := setupTest(t)
+		ctx := context.Background()
+
+		within := int64(0)
+		result, err := logic.ListUserRoleAssignments(ctx, &identity_srv.UserRoleQueryRequest{
+			ExpiringWithinSeconds: &within,
+		})
+
+		assert.Nil(t, result)
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+
 	t.Run("空条件查询", func(t *testing.T) {
 		logic, mocks := setupTest(t)
 		ctx := context.Background()
@@ -728,6 +948,48 @@ func TestLogicImpl_ListUserRoleAssignments(t *testing.T) {
 		assert.Nil(t, result)
 		assertErrCode(t, errno.ErrOperationFailed, err)
 	})
+
+	t.Run("游标分页参数透传", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		cursor := ""
+		limit := int32(50)
+		includeTotal := false
+
+		mocks.AssignmentRepo.EXPECT().
+			FindWithConditions(ctx, gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context,
+				cond *assignmentDal.UserRoleAssignmentQueryConditions,
+			) ([]*models.UserRoleAssignment, *models.PageResult, error) {
+				require.NotNil(t, cond.Page)
+				assert.True(t, cond.Page.Keyset)
+				assert.Equal(t, int32(50), cond.Page.PageSize)
+				assert.False(t, cond.Page.ShouldCount())
+
+				return []*models.UserRoleAssignment{}, &models.PageResult{Limit: 50, TotalSkipped: true}, nil
+			})
+
+		result, err := logic.ListUserRoleAssignments(ctx, &identity_srv.UserRoleQueryRequest{
+			Page: &rpc_base.PageRequest{Limit: &limit, Cursor: &cursor, IncludeTotal: &includeTotal},
+		})
+
+		require.NoError(t, err)
+		assert.Nil(t, result.Page.Total)
+	})
+
+	t.Run("非法游标", func(t *testing.T) {
+		logic, _ := setupTest(t)
+
+		cursor := "not-a-cursor"
+		result, err := logic.ListUserRoleAssignments(context.Background(), &identity_srv.UserRoleQueryRequest{
+			Page: &rpc_base.PageRequest{Cursor: &cursor},
+		})
+
+		assert.Nil(t, result)
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
 }
 
 // ============================================================================
@@ -751,7 +1013,7 @@ func TestLogicImpl_GetUsersByRole(t *testing.T) {
 			BaseModel: models.BaseModel{ID: uuid.MustParse(roleID)},
 			Name:      "admin",
 		}, nil)
-		mocks.AssignmentRepo.EXPECT().GetAllUserIDsByRoleID(ctx, roleID).Return(userIDs, nil)
+		mocks.AssignmentRepo.EXPECT().GetAllUserIDsByRoleID(ctx, roleID, gomock.Nil()).Return(userIDs, nil)
 
 		result, err := logic.GetUsersByRole(ctx, req)
 
@@ -761,6 +1023,30 @@ func TestLogicImpl_GetUsersByRole(t *testing.T) {
 		assert.Len(t, result.UserIDs, 2)
 	})
 
+	t.Run("按组织获取角色下用户列表", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		orgID := uuid.New().String()
+		userIDs := []string{uuid.New().String()}
+
+		req := &identity_srv.GetUsersByRoleRequest{
+			RoleID:         &roleID,
+			OrganizationID: &orgID,
+		}
+
+		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(&models.RoleDefinition{
+			BaseModel: models.BaseModel{ID: uuid.MustParse(roleID)},
+			Name:      "admin",
+		}, nil)
+		mocks.AssignmentRepo.EXPECT().GetAllUserIDsByRoleID(ctx, roleID, &orgID).Return(userIDs, nil)
+
+		result, err := logic.GetUsersByRole(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, userIDs, result.UserIDs)
+	})
+
 	t.Run("角色下无用户", func(t *testing.T) {
 		logic, mocks := setupTest(t)
 		ctx := context.Background()
@@ -773,7 +1059,7 @@ func TestLogicImpl_GetUsersByRole(t *testing.T) {
 			BaseModel: models.BaseModel{ID: uuid.MustParse(roleID)},
 			Name:      "admin",
 		}, nil)
-		mocks.AssignmentRepo.EXPECT().GetAllUserIDsByRoleID(ctx, roleID).Return([]string{}, nil)
+		mocks.AssignmentRepo.EXPECT().GetAllUserIDsByRoleID(ctx, roleID, gomock.Nil()).Return([]string{}, nil)
 
 		result, err := logic.GetUsersByRole(ctx, req)
 
@@ -853,7 +1139,7 @@ func TestLogicImpl_GetUsersByRole(t *testing.T) {
 			BaseModel: models.BaseModel{ID: uuid.MustParse(roleID)},
 			Name:      "admin",
 		}, nil)
-		mocks.AssignmentRepo.EXPECT().GetAllUserIDsByRoleID(ctx, roleID).Return(nil, errors.New("db error"))
+		mocks.AssignmentRepo.EXPECT().GetAllUserIDsByRoleID(ctx, roleID, gomock.Nil()).Return(nil, errors.New("db error"))
 
 		result, err := logic.GetUsersByRole(ctx, req)
 
@@ -1114,6 +1400,198 @@ func TestLogicImpl_BatchGetUserRoles(t *testing.T) {
 	})
 }
 
+// ============================================================================
+// GetUserTenantRoles 测试
+// ============================================================================
+
+func TestLogicImpl_GetUserTenantRoles(t *testing.T) {
+	userID := uuid.New().String()
+	orgID := uuid.New().String()
+	roleID := uuid.New().String()
+
+	t.Run("成功返回role code", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		mocks.AssignmentRepo.EXPECT().
+			GetActiveRoleIDsWithStatus(ctx, userID, &orgID, models.RoleStatusActive).
+			Return([]string{roleID}, nil)
+		mocks.DefinitionRepo.EXPECT().BatchGetByIDs(ctx, []string{roleID}).
+			Return([]*models.RoleDefinition{{
+				BaseModel: models.BaseModel{ID: uuid.MustParse(roleID)},
+				Name:      "医生",
+				RoleCode:  "doctor",
+			}}, nil)
+
+		result, err := logic.GetUserTenantRoles(ctx, &identity_srv.GetUserTenantRolesRequest{
+			UserID:         &userID,
+			OrganizationID: &orgID,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"doctor"}, result.RoleCodes)
+		assert.Len(t, result.RoleDetails, 1)
+	})
+
+	t.Run("缺少组织ID", func(t *testing.T) {
+		logic, _ := setupTest(t)
+
+		result, err := logic.GetUserTenantRoles(context.Background(),
+			&identity_srv.GetUserTenantRolesRequest{UserID: &userID})
+
+		assert.Nil(t, result)
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+
+	t.Run("没有活跃角色", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		mocks.AssignmentRepo.EXPECT().
+			GetActiveRoleIDsWithStatus(ctx, userID, &orgID, models.RoleStatusActive).
+			Return([]string{}, nil)
+
+		result, err := logic.GetUserTenantRoles(ctx, &identity_srv.GetUserTenantRolesRequest{
+			UserID:         &userID,
+			OrganizationID: &orgID,
+		})
+
+		assert.Nil(t, result)
+		assertErrCode(t, errno.ErrNoActiveRoles, err)
+	})
+
+	t.Run("角色缺少role code", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		mocks.AssignmentRepo.EXPECT().
+			GetActiveRoleIDsWithStatus(ctx, userID, &orgID, models.RoleStatusActive).
+			Return([]string{roleID}, nil)
+		mocks.DefinitionRepo.EXPECT().BatchGetByIDs(ctx, []string{roleID}).
+			Return([]*models.RoleDefinition{{
+				BaseModel: models.BaseModel{ID: uuid.MustParse(roleID)},
+				Name:      "legacy",
+			}}, nil)
+
+		result, err := logic.GetUserTenantRoles(ctx, &identity_srv.GetUserTenantRolesRequest{
+			UserID:         &userID,
+			OrganizationID: &orgID,
+		})
+
+		assert.Nil(t, result)
+		assertErrCode(t, errno.ErrNoActiveRoles, err)
+	})
+}
+
+// ============================================================================
+// ExpireRoleAssignments 测试
+// ============================================================================
+
+// fakeRevoker 记录被撤销的 g 规则，可按用户注入失败
+type fakeRevoker struct {
+	revoked [][3]string
+	failFor string
+}
+
+func (f *fakeRevoker) RevokeRoleBinding(_ context.Context, userID, roleCode, domain string) error {
+	if userID == f.failFor {
+		return errors.New("policy_srv unavailable")
+	}
+
+	f.revoked = append(f.revoked, [3]string{userID, roleCode, domain})
+
+	return nil
+}
+
+func TestLogicImpl_ExpireRoleAssignments(t *testing.T) {
+	roleID := uuid.New()
+	expiredAt := time.Now().Add(-time.Minute).UnixMilli()
+	role := &models.RoleDefinition{
+		BaseModel: models.BaseModel{ID: roleID},
+		RoleCode:  "role:oncall",
+	}
+
+	newExpired := func(orgID *uuid.UUID) *models.UserRoleAssignment {
+		return &models.UserRoleAssignment{
+			BaseModel:      models.BaseModel{ID: uuid.New()},
+			UserID:         uuid.New(),
+			RoleID:         roleID,
+			OrganizationID: orgID,
+			ValidUntil:     &expiredAt,
+		}
+	}
+
+	t.Run("回收到期分配并删除g规则", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		orgID := uuid.New()
+		global := newExpired(nil)
+		scoped := newExpired(&orgID)
+
+		mocks.AssignmentRepo.EXPECT().FindExpired(ctx, gomock.Any(), expireBatchSize).
+			Return([]*models.UserRoleAssignment{global, scoped}, nil)
+		mocks.DefinitionRepo.EXPECT().BatchGetByIDs(ctx, []string{roleID.String()}).
+			Return([]*models.RoleDefinition{role}, nil)
+		mocks.AssignmentRepo.EXPECT().Delete(ctx, global.ID.String()).Return(nil)
+		mocks.AssignmentRepo.EXPECT().Delete(ctx, scoped.ID.String()).Return(nil)
+
+		revoker := &fakeRevoker{}
+		count, err := logic.ExpireRoleAssignments(ctx, revoker)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		assert.Equal(t, [][3]string{
+			{global.UserID.String(), "role:oncall", ""},
+			{scoped.UserID.String(), "role:oncall", orgID.String()},
+		}, revoker.revoked)
+	})
+
+	t.Run("g规则删除失败时保留记录", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		failed := newExpired(nil)
+		ok := newExpired(nil)
+
+		mocks.AssignmentRepo.EXPECT().FindExpired(ctx, gomock.Any(), expireBatchSize).
+			Return([]*models.UserRoleAssignment{failed, ok}, nil)
+		mocks.DefinitionRepo.EXPECT().BatchGetByIDs(ctx, []string{roleID.String()}).
+			Return([]*models.RoleDefinition{role}, nil)
+		mocks.AssignmentRepo.EXPECT().Delete(ctx, ok.ID.String()).Return(nil)
+
+		count, err := logic.ExpireRoleAssignments(ctx, &fakeRevoker{failFor: failed.UserID.String()})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("没有到期分配", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		mocks.AssignmentRepo.EXPECT().FindExpired(ctx, gomock.Any(), expireBatchSize).Return(nil, nil)
+
+		count, err := logic.ExpireRoleAssignments(ctx, &fakeRevoker{})
+
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("查询到期分配失败", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		mocks.AssignmentRepo.EXPECT().FindExpired(ctx, gomock.Any(), expireBatchSize).
+			Return(nil, errors.New("db error"))
+
+		count, err := logic.ExpireRoleAssignments(ctx, &fakeRevoker{})
+
+		assert.Zero(t, count)
+		assertErrCode(t, errno.ErrOperationFailed, err)
+	})
+}
+
 // ============================================================================
 // NewLogic 构造函数测试
 // ============================================================================
@@ -1122,7 +1600,7 @@ func TestNewLogic(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mocks := mock.NewTestMocks(ctrl)
 
-	logic := NewLogic(mocks.DAL, converter.NewConverter())
+	logic := NewLogic(mocks.DAL, converter.NewConverter(), time.Hour)
 
 	assert.NotNil(t, logic)
 }
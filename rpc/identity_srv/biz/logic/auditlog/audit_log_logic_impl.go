@@ -6,6 +6,7 @@ import (
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
 	auditlogDAL "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/auditlog"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/datascope"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
@@ -56,6 +57,9 @@ func (l *LogicImpl) ListAuditLogs(
 
 	// 转换分页参数
 	opts := l.converter.Base().PageRequestToQueryOptions(req.Page)
+	if err := opts.Validate(); err != nil {
+		return nil, errno.ErrInvalidParams.WithMessage("分页参数无效: " + err.Error())
+	}
 
 	// 构建查询条件
 	conditions := &auditlogDAL.AuditLogQueryConditions{
@@ -87,22 +91,34 @@ func (l *LogicImpl) ListAuditLogs(
 		conditions.EndTime = req.EndTime
 	}
 
-	// 查询
-	logs, pageResult, err := l.dal.AuditLog().FindWithConditions(ctx, conditions)
+	// 按调用方数据范围限定可见日志（统计同样受限）
+	scope, err := datascope.Resolve(ctx, l.dal)
 	if err != nil {
-		return nil, errno.ErrOperationFailed.WithMessage("查询审计日志失败: " + err.Error())
+		return nil, errno.ErrOperationFailed.WithMessage(err.Error())
 	}
 
-	// 查询全局统计数据
-	stats, err := l.dal.AuditLog().GetStatsByConditions(ctx, conditions)
+	conditions.Scope = scope
+
+	// 查询
+	logs, pageResult, err := l.dal.AuditLog().FindWithConditions(ctx, conditions)
 	if err != nil {
-		return nil, errno.ErrOperationFailed.WithMessage("查询审计日志统计失败: " + err.Error())
+		return nil, errno.ErrOperationFailed.WithMessage("查询审计日志失败: " + err.Error())
 	}
 
-	// 转换结果
-	return &identity_srv.ListAuditLogsResponse{
+	resp := &identity_srv.ListAuditLogsResponse{
 		AuditLogs: l.converter.AuditLog().ModelsToThrift(logs),
 		Page:      l.converter.Base().PageResponseToThrift(pageResult),
-		Stats:     l.converter.AuditLog().StatsToThrift(stats),
-	}, nil
+	}
+
+	// 查询全局统计数据（与总数一样需要全表扫描，不统计总数时一并跳过）
+	if opts.ShouldCount() {
+		stats, err := l.dal.AuditLog().GetStatsByConditions(ctx, conditions)
+		if err != nil {
+			return nil, errno.ErrOperationFailed.WithMessage("查询审计日志统计失败: " + err.Error())
+		}
+
+		resp.Stats = l.converter.AuditLog().StatsToThrift(stats)
+	}
+
+	return resp, nil
 }
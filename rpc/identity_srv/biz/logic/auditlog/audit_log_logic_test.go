@@ -0,0 +1,223 @@
+package auditlog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	auditlogDAL "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/auditlog"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/datascope"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/mock"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/rpc_base"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+)
+
+// setupTest 初始化测试环境
+func setupTest(t *testing.T) (*LogicImpl, *mock.TestMocks) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mocks := mock.NewTestMocks(ctrl)
+	logic := &LogicImpl{
+		dal:       mocks.DAL,
+		converter: mocks.Converter,
+	}
+
+	return logic, mocks
+}
+
+// ============================================================================
+// ListAuditLogs 测试
+// ============================================================================
+
+func TestLogicImpl_ListAuditLogs(t *testing.T) {
+	t.Run("成功查询审计日志", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		logs := []*models.AuditLog{{ID: uuid.New()}, {ID: uuid.New()}}
+		pageResult := &models.PageResult{Total: 2, Page: 1, Limit: 20, TotalPages: 1}
+
+		mocks.AuditLogRepo.EXPECT().
+			FindWithConditions(ctx, gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context,
+				cond *auditlogDAL.AuditLogQueryConditions,
+			) ([]*models.AuditLog, *models.PageResult, error) {
+				assert.Nil(t, cond.Scope)
+				return logs, pageResult, nil
+			})
+		mocks.AuditLogRepo.EXPECT().
+			GetStatsByConditions(ctx, gomock.Any()).
+			Return(&auditlogDAL.AuditLogStats{TotalCount: 2, SuccessCount: 2}, nil)
+
+		result, err := logic.ListAuditLogs(ctx, &identity_srv.ListAuditLogsRequest{})
+
+		require.NoError(t, err)
+		assert.Len(t, result.AuditLogs, 2)
+		assert.NotNil(t, result.Stats)
+	})
+
+	t.Run("请求为nil", func(t *testing.T) {
+		logic, _ := setupTest(t)
+
+		result, err := logic.ListAuditLogs(context.Background(), nil)
+
+		assert.Nil(t, result)
+		assert.Error(t, err)
+	})
+
+	t.Run("数据库查询错误", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		mocks.AuditLogRepo.EXPECT().
+			FindWithConditions(ctx, gomock.Any()).
+			Return(nil, nil, gorm.ErrInvalidDB)
+
+		result, err := logic.ListAuditLogs(ctx, &identity_srv.ListAuditLogsRequest{})
+
+		assert.Nil(t, result)
+
+		errNo, ok := err.(errno.ErrNo)
+		require.True(t, ok)
+		assert.Equal(t, errno.ErrOperationFailed.ErrCode, errNo.ErrCode)
+	})
+
+	t.Run("游标分页跳过总数与统计", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		cursor := ""
+		pageResult := &models.PageResult{Limit: 20, HasNext: true, NextCursor: "next", TotalSkipped: true}
+
+		mocks.AuditLogRepo.EXPECT().
+			FindWithConditions(ctx, gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context,
+				cond *auditlogDAL.AuditLogQueryConditions,
+			) ([]*models.AuditLog, *models.PageResult, error) {
+				assert.True(t, cond.Page.Keyset)
+				assert.False(t, cond.Page.ShouldCount())
+				return []*models.AuditLog{{ID: uuid.New()}}, pageResult, nil
+			})
+
+		result, err := logic.ListAuditLogs(ctx, &identity_srv.ListAuditLogsRequest{
+			Page: &rpc_base.PageRequest{Cursor: &cursor},
+		})
+
+		require.NoError(t, err)
+		assert.Nil(t, result.Stats)
+		assert.Nil(t, result.Page.Total)
+		assert.Equal(t, "next", result.Page.GetNextCursor())
+	})
+
+	t.Run("非法游标", func(t *testing.T) {
+		logic, _ := setupTest(t)
+
+		cursor := "%%%"
+		result, err := logic.ListAuditLogs(context.Background(), &identity_srv.ListAuditLogsRequest{
+			Page: &rpc_base.PageRequest{Cursor: &cursor},
+		})
+
+		assert.Nil(t, result)
+
+		errNo, ok := err.(errno.ErrNo)
+		require.True(t, ok)
+		assert.Equal(t, errno.ErrInvalidParams.ErrCode, errNo.ErrCode)
+	})
+}
+
+func TestLogicImpl_ListAuditLogs_DataScope(t *testing.T) {
+	callerID := uuid.New().String()
+	tenantID := uuid.New().String()
+	deptID := uuid.New().String()
+
+	tests := []struct {
+		name   string
+		hint   string
+		expect func(m *mock.TestMocks)
+		want   *base.DataScopeFilter
+	}{
+		{
+			name: "self 仅本人操作",
+			hint: "self",
+			want: &base.DataScopeFilter{Level: models.DataScopeSelf, UserID: callerID},
+		},
+		{
+			name: "dept 限定调用方部门成员",
+			hint: "dept",
+			expect: func(m *mock.TestMocks) {
+				m.MembershipRepo.EXPECT().
+					GetUserDepartments(gomock.Any(), callerID, tenantID).
+					Return([]string{deptID}, nil)
+			},
+			want: &base.DataScopeFilter{
+				Level:         models.DataScopeDept,
+				UserID:        callerID,
+				DepartmentIDs: []string{deptID},
+			},
+		},
+		{
+			name: "org 限定调用方租户",
+			hint: "org",
+			want: &base.DataScopeFilter{
+				Level:           models.DataScopeOrg,
+				UserID:          callerID,
+				OrganizationIDs: []string{tenantID},
+			},
+		},
+		{
+			name: "all 不限制",
+			hint: "all",
+			want: &base.DataScopeFilter{Level: models.DataScopeAll, UserID: callerID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logic, mocks := setupTest(t)
+			ctx := datascope.WithScope(context.Background(), datascope.Scope{
+				Hint:     tt.hint,
+				UserID:   callerID,
+				TenantID: tenantID,
+			})
+
+			if tt.expect != nil {
+				tt.expect(mocks)
+			}
+
+			// 列表与统计使用同一份条件，二者都必须受数据范围约束
+			mocks.AuditLogRepo.EXPECT().
+				FindWithConditions(ctx, gomock.Any()).
+				DoAndReturn(func(
+					_ context.Context,
+					cond *auditlogDAL.AuditLogQueryConditions,
+				) ([]*models.AuditLog, *models.PageResult, error) {
+					assert.Equal(t, tt.want, cond.Scope)
+					return []*models.AuditLog{}, &models.PageResult{Page: 1, Limit: 20}, nil
+				})
+			mocks.AuditLogRepo.EXPECT().
+				GetStatsByConditions(ctx, gomock.Any()).
+				DoAndReturn(func(
+					_ context.Context,
+					cond *auditlogDAL.AuditLogQueryConditions,
+				) (*auditlogDAL.AuditLogStats, error) {
+					assert.Equal(t, tt.want, cond.Scope)
+					return &auditlogDAL.AuditLogStats{}, nil
+				})
+
+			_, err := logic.ListAuditLogs(ctx, &identity_srv.ListAuditLogsRequest{})
+
+			require.NoError(t, err)
+		})
+	}
+}
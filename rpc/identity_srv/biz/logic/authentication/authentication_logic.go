@@ -26,4 +26,10 @@ type AuthenticationLogic interface {
 
 	// ForcePasswordChange 强制用户修改密码
 	ForcePasswordChange(ctx context.Context, req *identity_srv.ForcePasswordChangeRequest) error
+
+	// RefreshSession 按用户当前的状态、租户成员关系与角色重新计算刷新会话的 claims
+	RefreshSession(
+		ctx context.Context,
+		req *identity_srv.RefreshSessionRequest,
+	) (*identity_srv.RefreshSessionResponse, error)
 }
@@ -3,11 +3,14 @@ package authentication
 import (
 	"context"
 
+	"github.com/google/uuid"
+
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/convutil"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
 	membershipDAL "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/membership"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/menu"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/passwordpolicy"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
@@ -16,9 +19,10 @@ import (
 
 // LogicImpl 用户认证逻辑实现
 type LogicImpl struct {
-	dal       dal.DAL
-	converter converter.Converter
-	menuLogic menu.MenuLogic
+	dal            dal.DAL
+	converter      converter.Converter
+	menuLogic      menu.MenuLogic
+	passwordPolicy *passwordpolicy.Enforcer
 }
 
 // NewLogic 创建用户认证逻辑实现
@@ -26,11 +30,13 @@ func NewLogic(
 	dal dal.DAL,
 	converter converter.Converter,
 	menuLogic menu.MenuLogic,
+	passwordPolicy *passwordpolicy.Enforcer,
 ) AuthenticationLogic {
 	return &LogicImpl{
-		dal:       dal,
-		converter: converter,
-		menuLogic: menuLogic,
+		dal:            dal,
+		converter:      converter,
+		menuLogic:      menuLogic,
+		passwordPolicy: passwordPolicy,
 	}
 }
 
@@ -69,6 +75,25 @@ func (l *LogicImpl) Login(
 		return nil, errno.ErrUserSuspended
 	}
 
+	// 密码超过所属组织密码策略的最长使用期限时自动标记为必须修改密码
+	if !userProfile.MustChangePassword {
+		expired, err := l.passwordExpired(ctx, userProfile)
+		if err != nil {
+			return nil, err
+		}
+
+		if expired {
+			if err := l.dal.UserProfile().SetMustChangePassword(ctx, userProfile.ID.String(), true); err != nil {
+				tracelog.Ctx(ctx).Warn().
+					Err(err).
+					Str("user_id", userProfile.ID.String()).
+					Msg("密码已过期，设置强制修改密码标志失败")
+			}
+
+			userProfile.MustChangePassword = true
+		}
+	}
+
 	// 检查是否需要强制修改密码
 	if userProfile.MustChangePassword {
 		return nil, errno.ErrMustChangePassword
@@ -112,11 +137,22 @@ func (l *LogicImpl) Login(
 	// 构建登录响应
 	resp := l.converter.BuildLoginResponse(userProfile, memberships)
 
-	// 获取用户菜单树和权限信息
+	// 角色只按当前租户计算：与网关写入 JWT tenant claim 的规则一致，取主成员关系所在组织；
+	// 没有主成员关系时只计算全局角色
+	tenantID := activeTenantID(memberships)
+
+	// 获取用户菜单树和权限信息（按客户端标识选择菜单产品线）
 	menuResp, err := l.menuLogic.GetUserMenuTree(ctx, &identity_srv.GetUserMenuTreeRequest{
-		UserID: &userID,
+		UserID:         &userID,
+		OrganizationID: &tenantID,
+		ClientID:       req.ClientID,
 	})
 	if err != nil {
+		// 未知的客户端标识属于请求参数错误，原样返回
+		if errNo, ok := err.(errno.ErrNo); ok && errNo.ErrCode == errno.ErrInvalidParams.ErrCode {
+			return nil, err
+		}
+
 		return nil, errno.ErrOperationFailed.WithMessage("获取用户权限失败: " + err.Error())
 	}
 
@@ -129,7 +165,9 @@ func (l *LogicImpl) Login(
 	permissions, err := l.menuLogic.GetUserMenuPermissions(
 		ctx,
 		&identity_srv.GetUserMenuPermissionsRequest{
-			UserID: &userID,
+			UserID:         &userID,
+			OrganizationID: &tenantID,
+			ClientID:       req.ClientID,
 		},
 	)
 	if err != nil {
@@ -147,9 +185,73 @@ func (l *LogicImpl) Login(
 		return nil, err
 	}
 
+	// 密码校验通过后标记是否还需第二因素：已启用 MFA 的用户必须校验，
+	// 未启用但所属组织强制 MFA 的用户必须先完成登记，均由网关拦截令牌签发
+	if err := l.populateMFAFlags(ctx, resp, userID, memberships); err != nil {
+		return nil, err
+	}
+
 	return resp, nil
 }
 
+// populateMFAFlags 设置登录响应中的 MFA 标记
+func (l *LogicImpl) populateMFAFlags(
+	ctx context.Context,
+	resp *identity_srv.LoginResponse,
+	userID string,
+	memberships []*models.UserMembership,
+) error {
+	record, err := l.dal.MFA().GetByUserID(ctx, userID)
+	if err != nil && !errno.IsRecordNotFound(err) {
+		return errno.ErrOperationFailed.WithMessage("获取MFA配置失败: " + err.Error())
+	}
+
+	if record != nil && record.Enabled {
+		mfaRequired := true
+		resp.MfaRequired = &mfaRequired
+
+		return nil
+	}
+
+	orgIDs := make([]string, 0, len(memberships))
+	for _, m := range memberships {
+		if m != nil && m.OrganizationID != uuid.Nil {
+			orgIDs = append(orgIDs, m.OrganizationID.String())
+		}
+	}
+
+	if len(orgIDs) == 0 {
+		return nil
+	}
+
+	orgs, err := l.dal.Organization().BatchGetByIDs(ctx, orgIDs)
+	if err != nil {
+		return errno.ErrOperationFailed.WithMessage("获取组织MFA策略失败: " + err.Error())
+	}
+
+	for _, org := range orgs {
+		if org != nil && org.MFARequired {
+			enrollmentRequired := true
+			resp.MfaEnrollmentRequired = &enrollmentRequired
+
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// activeTenantID 返回登录后的当前租户（主成员关系所在组织），没有时返回空字符串
+func activeTenantID(memberships []*models.UserMembership) string {
+	for _, m := range memberships {
+		if m != nil && m.IsPrimary && m.OrganizationID != uuid.Nil {
+			return m.OrganizationID.String()
+		}
+	}
+
+	return ""
+}
+
 // populateRoleDetailsAndCodes 拉取 RoleDefinition 详情，填充 resp.RoleDetails，
 // 并把 resp.RoleIDs 设置为 role code 列表。
 //
@@ -166,18 +268,33 @@ func (l *LogicImpl) populateRoleDetailsAndCodes(
 		return nil
 	}
 
+	roleModels, roleCodes, err := l.roleCodes(ctx, roleIDs, userID)
+	if err != nil {
+		return err
+	}
+
+	resp.RoleDetails = l.converter.RoleDefinition().ModelsToThrift(roleModels)
+	resp.RoleIDs = roleCodes
+
+	return nil
+}
+
+// roleCodes 按角色ID拉取角色详情并提取 role code，拿不到任何 role code 时返回错误
+func (l *LogicImpl) roleCodes(
+	ctx context.Context,
+	roleIDs []string,
+	userID string,
+) ([]*models.RoleDefinition, []string, error) {
 	roleModels, err := l.dal.RoleDefinition().BatchGetByIDs(ctx, roleIDs)
 	if err != nil {
 		tracelog.Ctx(ctx).Error().
 			Err(err).
 			Str("user_id", userID).
-			Msg("获取角色详情失败，登录中止")
+			Msg("获取角色详情失败，令牌签发中止")
 
-		return errno.ErrOperationFailed.WithMessage("获取角色详情失败: " + err.Error())
+		return nil, nil, errno.ErrOperationFailed.WithMessage("获取角色详情失败: " + err.Error())
 	}
 
-	resp.RoleDetails = l.converter.RoleDefinition().ModelsToThrift(roleModels)
-
 	roleCodes := make([]string, 0, len(roleModels))
 
 	for _, m := range roleModels {
@@ -190,14 +307,76 @@ func (l *LogicImpl) populateRoleDetailsAndCodes(
 		tracelog.Ctx(ctx).Error().
 			Str("user_id", userID).
 			Strs("role_ids", roleIDs).
-			Msg("角色详情中未找到任何有效 role code，登录中止")
+			Msg("角色详情中未找到任何有效 role code，令牌签发中止")
 
-		return errno.ErrNoActiveRoles.WithMessage("用户角色缺少有效 role code，无法签发 token")
+		return nil, nil, errno.ErrNoActiveRoles.WithMessage("用户角色缺少有效 role code，无法签发 token")
 	}
 
-	resp.RoleIDs = roleCodes
+	return roleModels, roleCodes, nil
+}
 
-	return nil
+// RefreshSession 按用户当前状态重新计算刷新会话的 claims
+//
+// 与登录使用相同的规则：用户必须处于活跃状态，租户必须仍是活跃成员关系所在组织，
+// 角色只计算该租户下（含全局）当前生效的分配。任一条件不满足即拒绝刷新，
+// 避免登录时的 claims 快照在整个刷新令牌族有效期内延续。
+func (l *LogicImpl) RefreshSession(
+	ctx context.Context,
+	req *identity_srv.RefreshSessionRequest,
+) (*identity_srv.RefreshSessionResponse, error) {
+	userID := req.GetUserID()
+	if userID == "" {
+		return nil, errno.ErrInvalidParams.WithMessage("用户ID不能为空")
+	}
+
+	userProfile, err := l.dal.UserProfile().GetByID(ctx, userID)
+	if err != nil {
+		if errno.IsRecordNotFound(err) {
+			return nil, errno.ErrUserNotFound
+		}
+
+		return nil, errno.ErrOperationFailed.WithMessage("获取用户档案失败: " + err.Error())
+	}
+
+	if !userProfile.IsActive() {
+		if userProfile.Status == models.UserStatusSuspended {
+			return nil, errno.ErrUserSuspended
+		}
+
+		return nil, errno.ErrUserInactive
+	}
+
+	tenantID := req.GetOrganizationID()
+	if tenantID != "" {
+		if _, err := l.dal.UserMembership().GetByUserAndOrganization(ctx, userID, tenantID); err != nil {
+			if errno.IsRecordNotFound(err) {
+				return nil, errno.ErrMembershipNotFound.WithMessage("用户已不是当前租户的成员")
+			}
+
+			return nil, errno.ErrOperationFailed.WithMessage("获取用户成员关系失败: " + err.Error())
+		}
+	}
+
+	roleIDs, err := l.dal.UserRoleAssignment().GetActiveRoleIDsWithStatus(
+		ctx, userID, &tenantID, models.RoleStatusActive,
+	)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("获取用户角色列表失败: " + err.Error())
+	}
+
+	if len(roleIDs) == 0 {
+		return nil, errno.ErrNoActiveRoles.WithMessage("用户没有可用的角色，无法刷新会话")
+	}
+
+	_, roleCodes, err := l.roleCodes(ctx, roleIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity_srv.RefreshSessionResponse{
+		Username:  &userProfile.Username,
+		RoleCodes: roleCodes,
+	}, nil
 }
 
 // ChangePassword 修改用户密码
@@ -213,6 +392,15 @@ func (l *LogicImpl) ChangePassword(
 		return errno.ErrInvalidParams.WithMessage("新密码不能为空")
 	}
 
+	orgID, err := l.passwordPolicy.OrganizationOf(ctx, l.dal, *req.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := l.passwordPolicy.Validate(ctx, l.dal, orgID, *req.NewPassword); err != nil {
+		return err
+	}
+
 	// 获取用户档案
 	profile, err := l.dal.UserProfile().GetByID(ctx, *req.UserID)
 	if err != nil {
@@ -224,19 +412,27 @@ func (l *LogicImpl) ChangePassword(
 	}
 
 	// 验证旧密码
-	if !convutil.VerifyPassword(*req.OldPassword, profile.PasswordHash) {
+	if req.OldPassword == nil || !convutil.VerifyPassword(*req.OldPassword, profile.PasswordHash) {
 		return errno.ErrInvalidPassword
 	}
 
+	if err := l.passwordPolicy.CheckReuse(ctx, l.dal, orgID, profile, *req.NewPassword); err != nil {
+		return err
+	}
+
 	// 生成新密码哈希
 	newPasswordHash, err := convutil.HashPassword(*req.NewPassword)
 	if err != nil {
 		return errno.ErrOperationFailed.WithMessage("密码哈希生成失败: " + err.Error())
 	}
 
-	// 更新密码
+	// 更新密码并记录历史
 	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
-		return txDAL.UserProfile().UpdatePassword(ctx, *req.UserID, newPasswordHash)
+		if err := txDAL.UserProfile().UpdatePassword(ctx, *req.UserID, newPasswordHash); err != nil {
+			return err
+		}
+
+		return l.passwordPolicy.Record(ctx, txDAL, orgID, profile.ID, newPasswordHash)
 	})
 	if err != nil {
 		return errno.ErrOperationFailed.WithMessage("更新密码失败: " + err.Error())
@@ -258,15 +454,41 @@ func (l *LogicImpl) ResetPassword(
 		return errno.ErrInvalidParams.WithMessage("新密码不能为空")
 	}
 
+	orgID, err := l.passwordPolicy.OrganizationOf(ctx, l.dal, *req.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := l.passwordPolicy.Validate(ctx, l.dal, orgID, *req.NewPassword); err != nil {
+		return err
+	}
+
+	profile, err := l.dal.UserProfile().GetByID(ctx, *req.UserID)
+	if err != nil {
+		if errno.IsRecordNotFound(err) {
+			return errno.ErrUserNotFound
+		}
+
+		return errno.ErrOperationFailed.WithMessage("获取用户档案失败: " + err.Error())
+	}
+
+	if err := l.passwordPolicy.CheckReuse(ctx, l.dal, orgID, profile, *req.NewPassword); err != nil {
+		return err
+	}
+
 	// 生成新密码哈希
 	newPasswordHash, err := convutil.HashPassword(*req.NewPassword)
 	if err != nil {
 		return errno.ErrOperationFailed.WithMessage("密码哈希生成失败: " + err.Error())
 	}
 
-	// 重置密码
+	// 重置密码并记录历史
 	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
-		return txDAL.UserProfile().UpdatePassword(ctx, *req.UserID, newPasswordHash)
+		if err := txDAL.UserProfile().UpdatePassword(ctx, *req.UserID, newPasswordHash); err != nil {
+			return err
+		}
+
+		return l.passwordPolicy.Record(ctx, txDAL, orgID, profile.ID, newPasswordHash)
 	})
 	if err != nil {
 		return errno.ErrOperationFailed.WithMessage("重置密码失败: " + err.Error())
@@ -275,6 +497,16 @@ func (l *LogicImpl) ResetPassword(
 	return nil
 }
 
+// passwordExpired 按用户主成员关系所属组织的密码策略判断当前密码是否已过期
+func (l *LogicImpl) passwordExpired(ctx context.Context, profile *models.UserProfile) (bool, error) {
+	orgID, err := l.passwordPolicy.OrganizationOf(ctx, l.dal, profile.ID.String())
+	if err != nil {
+		return false, err
+	}
+
+	return l.passwordPolicy.Expired(ctx, l.dal, orgID, profile)
+}
+
 // ForcePasswordChange 强制用户修改密码
 func (l *LogicImpl) ForcePasswordChange(
 	ctx context.Context,
@@ -0,0 +1,338 @@
+package authentication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/passwordpolicy"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/mock"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/password"
+)
+
+// setupTest 初始化测试环境：至少 8 位、3 种字符，禁止复用最近 3 个密码，90 天过期
+func setupTest(t *testing.T) (*LogicImpl, *mock.TestMocks) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mocks := mock.NewTestMocks(ctrl)
+	logic := &LogicImpl{
+		dal:       mocks.DAL,
+		converter: mocks.Converter,
+		passwordPolicy: passwordpolicy.NewEnforcer(
+			password.NewPolicy(8, 3, 3, 90*24*time.Hour, nil),
+		),
+	}
+
+	return logic, mocks
+}
+
+// assertErrCode 断言错误码匹配
+func assertErrCode(t *testing.T, expected errno.ErrNo, actual error) {
+	t.Helper()
+
+	errNo, ok := actual.(errno.ErrNo)
+	require.True(t, ok, "expected errno.ErrNo, got %T: %v", actual, actual)
+	assert.Equal(t, expected.ErrCode, errNo.ErrCode)
+}
+
+func strPtr(s string) *string { return &s }
+
+// expectNoOrganization 用户没有主成员关系，密码策略回落到全局默认值
+func expectNoOrganization(mocks *mock.TestMocks, userID string) {
+	mocks.MembershipRepo.EXPECT().GetPrimaryMembership(gomock.Any(), userID).
+		Return(nil, gorm.ErrRecordNotFound)
+}
+
+func userWithPassword(t *testing.T, plain string, changedAgo time.Duration) *models.UserProfile {
+	t.Helper()
+
+	hash, err := password.HashPassword(plain)
+	require.NoError(t, err)
+
+	changedAt := time.Now().Add(-changedAgo).UnixMilli()
+
+	return &models.UserProfile{
+		BaseModel:         models.BaseModel{ID: uuid.New()},
+		Username:          "alice",
+		PasswordHash:      hash,
+		Status:            models.UserStatusActive,
+		PasswordChangedAt: &changedAt,
+	}
+}
+
+// ============================================================================
+// Login 密码过期测试
+// ============================================================================
+
+func TestLogin_PasswordAgedOut(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("aged out password sets must change flag", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		user := userWithPassword(t, "Current-Pass1", 91*24*time.Hour)
+
+		mocks.UserRepo.EXPECT().GetByUsername(gomock.Any(), "alice").Return(user, nil)
+		expectNoOrganization(mocks, user.ID.String())
+		mocks.UserRepo.EXPECT().SetMustChangePassword(gomock.Any(), user.ID.String(), true).Return(nil)
+
+		_, err := logic.Login(ctx, &identity_srv.LoginRequest{
+			Username: strPtr("alice"),
+			Password: strPtr("Current-Pass1"),
+		})
+		assertErrCode(t, errno.ErrMustChangePassword, err)
+	})
+
+	t.Run("wrong password does not reveal expiry", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		user := userWithPassword(t, "Current-Pass1", 91*24*time.Hour)
+
+		mocks.UserRepo.EXPECT().GetByUsername(gomock.Any(), "alice").Return(user, nil)
+		mocks.UserRepo.EXPECT().IncrementLoginAttempts(gomock.Any(), user.ID.String()).Return(nil)
+
+		_, err := logic.Login(ctx, &identity_srv.LoginRequest{
+			Username: strPtr("alice"),
+			Password: strPtr("Wrong-Pass1"),
+		})
+		assertErrCode(t, errno.ErrInvalidCredentials, err)
+	})
+
+	t.Run("already flagged user is not updated again", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		user := userWithPassword(t, "Current-Pass1", 91*24*time.Hour)
+		user.MustChangePassword = true
+
+		mocks.UserRepo.EXPECT().GetByUsername(gomock.Any(), "alice").Return(user, nil)
+
+		_, err := logic.Login(ctx, &identity_srv.LoginRequest{
+			Username: strPtr("alice"),
+			Password: strPtr("Current-Pass1"),
+		})
+		assertErrCode(t, errno.ErrMustChangePassword, err)
+	})
+}
+
+// ============================================================================
+// ChangePassword / ResetPassword 密码策略测试
+// ============================================================================
+
+func TestChangePassword_Policy(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("weak password rejected before lookup", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		userID := uuid.NewString()
+
+		expectNoOrganization(mocks, userID)
+
+		err := logic.ChangePassword(ctx, &identity_srv.ChangePasswordRequest{
+			UserID:      strPtr(userID),
+			OldPassword: strPtr("Current-Pass1"),
+			NewPassword: strPtr("weakpass"),
+		})
+		assertErrCode(t, errno.ErrPasswordPolicyViolation, err)
+	})
+
+	t.Run("organization policy overrides global minimum length", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		userID := uuid.NewString()
+		orgID := uuid.New()
+
+		mocks.MembershipRepo.EXPECT().GetPrimaryMembership(gomock.Any(), userID).
+			Return(&models.UserMembership{OrganizationID: orgID}, nil)
+		mocks.PolicyRepo.EXPECT().GetByOrganizationID(gomock.Any(), orgID.String()).
+			Return(&models.OrganizationPasswordPolicy{MinLength: 16, MinCharClasses: 3, HistorySize: 3}, nil)
+
+		err := logic.ChangePassword(ctx, &identity_srv.ChangePasswordRequest{
+			UserID:      strPtr(userID),
+			OldPassword: strPtr("Current-Pass1"),
+			NewPassword: strPtr("Brand-New-Pass1"),
+		})
+		assertErrCode(t, errno.ErrPasswordPolicyViolation, err)
+	})
+
+	t.Run("recently used password rejected", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		user := userWithPassword(t, "Current-Pass1", time.Hour)
+		older, err := password.HashPassword("Older-Pass1")
+		require.NoError(t, err)
+
+		expectNoOrganization(mocks, user.ID.String())
+		mocks.UserRepo.EXPECT().GetByID(gomock.Any(), user.ID.String()).Return(user, nil)
+		mocks.HistoryRepo.EXPECT().ListRecentHashes(gomock.Any(), user.ID.String(), 3).
+			Return([]string{older}, nil)
+
+		err = logic.ChangePassword(ctx, &identity_srv.ChangePasswordRequest{
+			UserID:      strPtr(user.ID.String()),
+			OldPassword: strPtr("Current-Pass1"),
+			NewPassword: strPtr("Older-Pass1"),
+		})
+		assertErrCode(t, errno.ErrPasswordPolicyViolation, err)
+	})
+
+	t.Run("success records history", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		user := userWithPassword(t, "Current-Pass1", time.Hour)
+
+		expectNoOrganization(mocks, user.ID.String())
+		mocks.UserRepo.EXPECT().GetByID(gomock.Any(), user.ID.String()).Return(user, nil)
+		mocks.HistoryRepo.EXPECT().ListRecentHashes(gomock.Any(), user.ID.String(), 3).Return(nil, nil)
+		mocks.UserRepo.EXPECT().UpdatePassword(gomock.Any(), user.ID.String(), gomock.Any()).Return(nil)
+		mocks.HistoryRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, h *models.PasswordHistory) error {
+				assert.Equal(t, user.ID, h.UserID)
+				assert.True(t, password.VerifyPassword("Brand-New-Pass1", h.PasswordHash))
+
+				return nil
+			})
+		mocks.HistoryRepo.EXPECT().Prune(gomock.Any(), user.ID.String(), 3).Return(nil)
+
+		err := logic.ChangePassword(ctx, &identity_srv.ChangePasswordRequest{
+			UserID:      strPtr(user.ID.String()),
+			OldPassword: strPtr("Current-Pass1"),
+			NewPassword: strPtr("Brand-New-Pass1"),
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func TestResetPassword_Policy(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("weak password rejected", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		userID := uuid.NewString()
+
+		expectNoOrganization(mocks, userID)
+
+		err := logic.ResetPassword(ctx, &identity_srv.ResetPasswordRequest{
+			UserID:      strPtr(userID),
+			NewPassword: strPtr("12345678"),
+		})
+		assertErrCode(t, errno.ErrPasswordPolicyViolation, err)
+	})
+
+	t.Run("current password cannot be reused", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		user := userWithPassword(t, "Current-Pass1", time.Hour)
+
+		expectNoOrganization(mocks, user.ID.String())
+		mocks.UserRepo.EXPECT().GetByID(gomock.Any(), user.ID.String()).Return(user, nil)
+		mocks.HistoryRepo.EXPECT().ListRecentHashes(gomock.Any(), user.ID.String(), 3).Return(nil, nil)
+
+		err := logic.ResetPassword(ctx, &identity_srv.ResetPasswordRequest{
+			UserID:      strPtr(user.ID.String()),
+			NewPassword: strPtr("Current-Pass1"),
+		})
+		assertErrCode(t, errno.ErrPasswordPolicyViolation, err)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		userID := uuid.NewString()
+
+		expectNoOrganization(mocks, userID)
+		mocks.UserRepo.EXPECT().GetByID(gomock.Any(), userID).Return(nil, gorm.ErrRecordNotFound)
+
+		err := logic.ResetPassword(ctx, &identity_srv.ResetPasswordRequest{
+			UserID:      strPtr(userID),
+			NewPassword: strPtr("Brand-New-Pass1"),
+		})
+		assertErrCode(t, errno.ErrUserNotFound, err)
+	})
+}
+
+// ============================================================================
+// RefreshSession 测试
+// ============================================================================
+
+func TestRefreshSession(t *testing.T) {
+	ctx := context.Background()
+	orgID := uuid.NewString()
+
+	t.Run("reloads tenant roles", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		user := userWithPassword(t, "Current-Pass1", time.Hour)
+		userID := user.ID.String()
+		role := &models.RoleDefinition{BaseModel: models.BaseModel{ID: uuid.New()}, RoleCode: "nurse"}
+
+		mocks.UserRepo.EXPECT().GetByID(gomock.Any(), userID).Return(user, nil)
+		mocks.MembershipRepo.EXPECT().GetByUserAndOrganization(gomock.Any(), userID, orgID).
+			Return(&models.UserMembership{}, nil)
+		mocks.AssignmentRepo.EXPECT().
+			GetActiveRoleIDsWithStatus(gomock.Any(), userID, &orgID, models.RoleStatusActive).
+			Return([]string{role.ID.String()}, nil)
+		mocks.DefinitionRepo.EXPECT().BatchGetByIDs(gomock.Any(), []string{role.ID.String()}).
+			Return([]*models.RoleDefinition{role}, nil)
+
+		resp, err := logic.RefreshSession(ctx, &identity_srv.RefreshSessionRequest{
+			UserID:         &userID,
+			OrganizationID: &orgID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"nurse"}, resp.GetRoleCodes())
+		assert.Equal(t, "alice", resp.GetUsername())
+	})
+
+	t.Run("suspended user rejected", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		user := userWithPassword(t, "Current-Pass1", time.Hour)
+		user.Status = models.UserStatusSuspended
+		userID := user.ID.String()
+
+		mocks.UserRepo.EXPECT().GetByID(gomock.Any(), userID).Return(user, nil)
+
+		_, err := logic.RefreshSession(ctx, &identity_srv.RefreshSessionRequest{UserID: &userID})
+		assertErrCode(t, errno.ErrUserSuspended, err)
+	})
+
+	t.Run("deleted user rejected", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		userID := uuid.NewString()
+
+		mocks.UserRepo.EXPECT().GetByID(gomock.Any(), userID).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := logic.RefreshSession(ctx, &identity_srv.RefreshSessionRequest{UserID: &userID})
+		assertErrCode(t, errno.ErrUserNotFound, err)
+	})
+
+	t.Run("left tenant rejected", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		user := userWithPassword(t, "Current-Pass1", time.Hour)
+		userID := user.ID.String()
+
+		mocks.UserRepo.EXPECT().GetByID(gomock.Any(), userID).Return(user, nil)
+		mocks.MembershipRepo.EXPECT().GetByUserAndOrganization(gomock.Any(), userID, orgID).
+			Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := logic.RefreshSession(ctx, &identity_srv.RefreshSessionRequest{
+			UserID:         &userID,
+			OrganizationID: &orgID,
+		})
+		assertErrCode(t, errno.ErrMembershipNotFound, err)
+	})
+
+	t.Run("expired or revoked roles rejected", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		user := userWithPassword(t, "Current-Pass1", time.Hour)
+		userID := user.ID.String()
+		noTenant := ""
+
+		mocks.UserRepo.EXPECT().GetByID(gomock.Any(), userID).Return(user, nil)
+		mocks.AssignmentRepo.EXPECT().
+			GetActiveRoleIDsWithStatus(gomock.Any(), userID, &noTenant, models.RoleStatusActive).
+			Return(nil, nil)
+
+		_, err := logic.RefreshSession(ctx, &identity_srv.RefreshSessionRequest{UserID: &userID})
+		assertErrCode(t, errno.ErrNoActiveRoles, err)
+	})
+}
@@ -0,0 +1,121 @@
+// Package breakglass 实现紧急账号（break-glass）机制。
+//
+// 超级管理员的全量权限由 policy_srv 通配策略表达，当 policy_srv 不可用或策略被误改时，
+// 配置的紧急账号绕过 PDP 决策获得全部权限与不限数据范围，用于恢复系统。
+// 紧急账号按用户ID识别：用户ID由服务端生成且不可修改，删除后同名新建的账号不会继承紧急权限。
+// 每次使用都写入一条审计日志；审计写入失败时不放行，保证紧急访问始终留痕。
+//
+// 紧急通道只存在于 identity_srv 的权限检查中。网关开启 PDP 路由授权（AUTHZ_PDP_ENABLED）时
+// 仍按 policy_srv 决策且不可用时拒绝请求，紧急账号经网关访问前需先关闭网关 PDP。
+package breakglass
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/auditlog"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/config"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+)
+
+// Access 一次紧急访问，Action/Resource 为被绕过的权限检查
+type Access struct {
+	UserID    string
+	Username  string
+	TenantID  string
+	RequestID string
+	Action    string
+	Resource  string
+}
+
+// Guard 紧急账号判定与使用审计
+type Guard struct {
+	userIDs   map[uuid.UUID]struct{}
+	auditLogs auditlog.AuditLogRepository
+}
+
+// NewGuard 创建紧急账号守卫，未配置紧急账号时 IsAccount 恒为 false
+// 配置了无效的用户ID时返回错误，避免紧急账号在需要时才发现不可用
+func NewGuard(cfg config.BreakGlassConfig, auditLogs auditlog.AuditLogRepository) (*Guard, error) {
+	userIDs := make(map[uuid.UUID]struct{}, len(cfg.UserIDs))
+
+	for _, raw := range cfg.UserIDs {
+		if raw == "" {
+			continue
+		}
+
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("紧急账号用户ID无效 %q: %w", raw, err)
+		}
+
+		userIDs[id] = struct{}{}
+	}
+
+	return &Guard{
+		userIDs:   userIDs,
+		auditLogs: auditLogs,
+	}, nil
+}
+
+// Enabled 是否配置了紧急账号
+func (g *Guard) Enabled() bool {
+	return g != nil && len(g.userIDs) > 0
+}
+
+// IsAccount 判断用户ID是否为紧急账号
+func (g *Guard) IsAccount(userID string) bool {
+	if !g.Enabled() {
+		return false
+	}
+
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return false
+	}
+
+	_, ok := g.userIDs[id]
+
+	return ok
+}
+
+// Record 写入紧急访问审计日志，返回错误时调用方必须拒绝本次访问
+func (g *Guard) Record(ctx context.Context, access Access) error {
+	detail, err := json.Marshal(map[string]string{
+		"action":   access.Action,
+		"resource": access.Resource,
+	})
+	if err != nil {
+		return fmt.Errorf("序列化紧急访问详情失败: %w", err)
+	}
+
+	log := &models.AuditLog{
+		RequestID:      access.RequestID,
+		UserID:         parseUUID(access.UserID),
+		Username:       access.Username,
+		OrganizationID: parseUUID(access.TenantID),
+		Action:         models.AuditActionBreakGlass,
+		Resource:       access.Resource,
+		Success:        true,
+		RequestBody:    string(detail),
+	}
+
+	if err := g.auditLogs.Create(ctx, log); err != nil {
+		return fmt.Errorf("记录紧急访问审计日志失败: %w", err)
+	}
+
+	return nil
+}
+
+// parseUUID 解析可选的 UUID 字符串，空串或格式错误时返回 nil
+func parseUUID(s string) *uuid.UUID {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return nil
+	}
+
+	return &id
+}
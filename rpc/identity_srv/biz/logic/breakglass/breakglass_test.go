@@ -0,0 +1,95 @@
+package breakglass
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/mock"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/config"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+)
+
+func TestGuard_IsAccount(t *testing.T) {
+	emergencyID := "3f1c7f3e-5a0f-4c1e-9d4b-6b1f6f2a9c10"
+
+	t.Run("未配置时不启用", func(t *testing.T) {
+		guard, err := NewGuard(config.BreakGlassConfig{}, nil)
+		require.NoError(t, err)
+
+		assert.False(t, guard.Enabled())
+		assert.False(t, guard.IsAccount(emergencyID))
+	})
+
+	t.Run("nil 守卫不启用", func(t *testing.T) {
+		var guard *Guard
+
+		assert.False(t, guard.Enabled())
+		assert.False(t, guard.IsAccount(emergencyID))
+	})
+
+	t.Run("仅匹配配置的用户ID", func(t *testing.T) {
+		guard, err := NewGuard(config.BreakGlassConfig{UserIDs: []string{emergencyID, ""}}, nil)
+		require.NoError(t, err)
+
+		assert.True(t, guard.Enabled())
+		assert.True(t, guard.IsAccount(emergencyID))
+		assert.True(t, guard.IsAccount(strings.ToUpper(emergencyID)), "用户ID大小写不敏感")
+		assert.False(t, guard.IsAccount("8c2d5b1a-0e4f-4a7b-9c3d-2f6e1a5b7c90"))
+		assert.False(t, guard.IsAccount("emergency"), "不按用户名识别")
+		assert.False(t, guard.IsAccount(""))
+	})
+
+	t.Run("用户ID无效时拒绝创建", func(t *testing.T) {
+		_, err := NewGuard(config.BreakGlassConfig{UserIDs: []string{"emergency"}}, nil)
+
+		require.Error(t, err)
+	})
+}
+
+func TestGuard_Record(t *testing.T) {
+	ctx := context.Background()
+	userID := "3f1c7f3e-5a0f-4c1e-9d4b-6b1f6f2a9c10"
+
+	t.Run("写入紧急访问审计日志", func(t *testing.T) {
+		mocks := mock.NewTestMocks(gomock.NewController(t))
+		guard, err := NewGuard(config.BreakGlassConfig{UserIDs: []string{userID}}, mocks.AuditLogRepo)
+		require.NoError(t, err)
+
+		mocks.AuditLogRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+				assert.Equal(t, models.AuditActionBreakGlass, log.Action)
+				require.NotNil(t, log.UserID)
+				assert.Equal(t, userID, log.UserID.String())
+				assert.Nil(t, log.OrganizationID)
+				assert.Equal(t, "user", log.Resource)
+				assert.JSONEq(t, `{"action":"delete","resource":"user"}`, log.RequestBody)
+				assert.True(t, log.Success)
+
+				return nil
+			})
+
+		require.NoError(t, guard.Record(ctx, Access{
+			UserID:   userID,
+			Username: "emergency",
+			Action:   "delete",
+			Resource: "user",
+		}))
+	})
+
+	t.Run("审计写入失败返回错误", func(t *testing.T) {
+		mocks := mock.NewTestMocks(gomock.NewController(t))
+		guard, err := NewGuard(config.BreakGlassConfig{UserIDs: []string{userID}}, mocks.AuditLogRepo)
+		require.NoError(t, err)
+
+		mocks.AuditLogRepo.EXPECT().Create(ctx, gomock.Any()).Return(errors.New("db down"))
+
+		require.Error(t, guard.Record(ctx, Access{UserID: userID, Username: "emergency"}))
+	})
+}
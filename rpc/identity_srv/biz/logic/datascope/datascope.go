@@ -0,0 +1,98 @@
+// Package datascope 在 handler 与 logic 之间传递 PDP 决策给出的数据范围，并解析为仓储层的行级过滤条件。
+//
+// handler 在权限检查后把 policy_srv 的 DataScopeHint 与调用方身份写入 ctx，
+// 列表类 logic 通过 Resolve 取得 *base.DataScopeFilter 交给仓储翻译为 WHERE 条件；
+// ctx 中没有数据范围（内部调用）时不做限制。
+package datascope
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+)
+
+// Scope 一次请求的数据范围
+type Scope struct {
+	Hint     string   // policy_srv 返回的 data_scope 提示：self/dept/org/all，可为空
+	UserID   string   // 调用方用户ID
+	TenantID string   // 调用方当前租户（组织）ID，可为空
+	Roles    []string // 调用方角色编码，提示为空时按角色默认数据范围兜底
+}
+
+type scopeKey struct{}
+
+// WithScope 把数据范围写入 ctx
+func WithScope(ctx context.Context, scope Scope) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+// FromContext 读取 ctx 中的数据范围
+func FromContext(ctx context.Context) (Scope, bool) {
+	scope, ok := ctx.Value(scopeKey{}).(Scope)
+	return scope, ok
+}
+
+// Resolve 把 ctx 中的数据范围解析为仓储层过滤条件
+//
+// 级别确定顺序：PDP 提示 → 调用方角色 DefaultScope 的最大值 → 本人（最小权限）。
+// 部门范围取调用方在当前租户内的部门（租户为空时取全部部门）；
+// 组织范围取当前租户（租户为空时取调用方所属全部组织）。
+// ctx 中没有数据范围时返回 nil，表示不限制。
+func Resolve(ctx context.Context, d dal.DAL) (*base.DataScopeFilter, error) {
+	scope, ok := FromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	level, err := resolveLevel(ctx, d, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &base.DataScopeFilter{Level: level, UserID: scope.UserID}
+
+	switch level {
+	case models.DataScopeDept:
+		filter.DepartmentIDs, err = d.UserMembership().GetUserDepartments(ctx, scope.UserID, scope.TenantID)
+		if err != nil {
+			return nil, fmt.Errorf("解析部门数据范围失败: %w", err)
+		}
+	case models.DataScopeOrg:
+		if scope.TenantID != "" {
+			filter.OrganizationIDs = []string{scope.TenantID}
+			break
+		}
+
+		filter.OrganizationIDs, err = d.UserMembership().GetUserOrganizations(ctx, scope.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("解析组织数据范围失败: %w", err)
+		}
+	}
+
+	return filter, nil
+}
+
+// resolveLevel 确定数据范围级别
+func resolveLevel(ctx context.Context, d dal.DAL, scope Scope) (models.DataScopeType, error) {
+	if level, ok := models.ParseDataScopeType(scope.Hint); ok {
+		return level, nil
+	}
+
+	roles, err := d.RoleDefinition().FindByRoleCodes(ctx, scope.Roles)
+	if err != nil {
+		return 0, fmt.Errorf("查询角色默认数据范围失败: %w", err)
+	}
+
+	level := models.DataScopeSelf
+
+	for _, role := range roles {
+		if role.DefaultScope > level {
+			level = role.DefaultScope
+		}
+	}
+
+	return level, nil
+}
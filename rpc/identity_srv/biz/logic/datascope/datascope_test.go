@@ -0,0 +1,139 @@
+package datascope
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/mock"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+)
+
+const (
+	callerID = "u-1"
+	tenantID = "org-1"
+)
+
+func TestResolve(t *testing.T) {
+	t.Run("ctx 无数据范围时不限制", func(t *testing.T) {
+		mocks := mock.NewTestMocks(gomock.NewController(t))
+
+		filter, err := Resolve(context.Background(), mocks.DAL)
+
+		require.NoError(t, err)
+		assert.Nil(t, filter)
+		assert.True(t, filter.Unrestricted())
+	})
+
+	t.Run("self 仅本人", func(t *testing.T) {
+		mocks := mock.NewTestMocks(gomock.NewController(t))
+		ctx := WithScope(context.Background(), Scope{Hint: "self", UserID: callerID, TenantID: tenantID})
+
+		filter, err := Resolve(ctx, mocks.DAL)
+
+		require.NoError(t, err)
+		assert.Equal(t, &base.DataScopeFilter{Level: models.DataScopeSelf, UserID: callerID}, filter)
+	})
+
+	t.Run("dept 取当前租户内的部门", func(t *testing.T) {
+		mocks := mock.NewTestMocks(gomock.NewController(t))
+		ctx := WithScope(context.Background(), Scope{Hint: "dept", UserID: callerID, TenantID: tenantID})
+
+		mocks.MembershipRepo.EXPECT().
+			GetUserDepartments(ctx, callerID, tenantID).
+			Return([]string{"d-1", "d-2"}, nil)
+
+		filter, err := Resolve(ctx, mocks.DAL)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.DataScopeDept, filter.Level)
+		assert.Equal(t, []string{"d-1", "d-2"}, filter.DepartmentIDs)
+	})
+
+	t.Run("org 取当前租户", func(t *testing.T) {
+		mocks := mock.NewTestMocks(gomock.NewController(t))
+		ctx := WithScope(context.Background(), Scope{Hint: "org", UserID: callerID, TenantID: tenantID})
+
+		filter, err := Resolve(ctx, mocks.DAL)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.DataScopeOrg, filter.Level)
+		assert.Equal(t, []string{tenantID}, filter.OrganizationIDs)
+	})
+
+	t.Run("org 无租户时取所属全部组织", func(t *testing.T) {
+		mocks := mock.NewTestMocks(gomock.NewController(t))
+		ctx := WithScope(context.Background(), Scope{Hint: "org", UserID: callerID})
+
+		mocks.MembershipRepo.EXPECT().
+			GetUserOrganizations(ctx, callerID).
+			Return([]string{"org-1", "org-2"}, nil)
+
+		filter, err := Resolve(ctx, mocks.DAL)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"org-1", "org-2"}, filter.OrganizationIDs)
+	})
+
+	t.Run("all 不限制", func(t *testing.T) {
+		mocks := mock.NewTestMocks(gomock.NewController(t))
+		ctx := WithScope(context.Background(), Scope{Hint: "all", UserID: callerID, TenantID: tenantID})
+
+		filter, err := Resolve(ctx, mocks.DAL)
+
+		require.NoError(t, err)
+		assert.True(t, filter.Unrestricted())
+	})
+
+	t.Run("提示为空时按角色默认数据范围取最大值", func(t *testing.T) {
+		mocks := mock.NewTestMocks(gomock.NewController(t))
+		roles := []string{"nurse", "head_nurse"}
+		ctx := WithScope(context.Background(), Scope{UserID: callerID, TenantID: tenantID, Roles: roles})
+
+		mocks.DefinitionRepo.EXPECT().
+			FindByRoleCodes(ctx, roles).
+			Return([]*models.RoleDefinition{
+				{RoleCode: "nurse", DefaultScope: models.DataScopeSelf},
+				{RoleCode: "head_nurse", DefaultScope: models.DataScopeDept},
+			}, nil)
+		mocks.MembershipRepo.EXPECT().
+			GetUserDepartments(ctx, callerID, tenantID).
+			Return([]string{"d-1"}, nil)
+
+		filter, err := Resolve(ctx, mocks.DAL)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.DataScopeDept, filter.Level)
+	})
+
+	t.Run("提示为空且无角色定义时退化为本人", func(t *testing.T) {
+		mocks := mock.NewTestMocks(gomock.NewController(t))
+		ctx := WithScope(context.Background(), Scope{UserID: callerID})
+
+		mocks.DefinitionRepo.EXPECT().FindByRoleCodes(ctx, gomock.Nil()).Return(nil, nil)
+
+		filter, err := Resolve(ctx, mocks.DAL)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.DataScopeSelf, filter.Level)
+	})
+
+	t.Run("部门查询失败", func(t *testing.T) {
+		mocks := mock.NewTestMocks(gomock.NewController(t))
+		ctx := WithScope(context.Background(), Scope{Hint: "dept", UserID: callerID})
+
+		mocks.MembershipRepo.EXPECT().
+			GetUserDepartments(ctx, callerID, "").
+			Return(nil, errors.New("db down"))
+
+		filter, err := Resolve(ctx, mocks.DAL)
+
+		assert.Nil(t, filter)
+		assert.ErrorContains(t, err, "db down")
+	})
+}
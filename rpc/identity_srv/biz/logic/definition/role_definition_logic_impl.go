@@ -2,6 +2,10 @@ package definition
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
@@ -58,12 +62,25 @@ func (l *LogicImpl) CreateRoleDefinition(
 		})
 	}
 
+	// 校验职责分离约束
+	exclusiveGroups, err := normalizeExclusiveGroups(req.GetExclusiveGroups())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetMaxAssignments() < 0 {
+		return nil, errno.ErrInvalidParams.WithMessage("最大分配人数不能为负数")
+	}
+
 	// 创建角色定义记录
 	roleDefinition := &models.RoleDefinition{
-		Name:        *req.Name,
-		Description: *req.Description,
-		Status:      models.RoleStatusInactive, // 默认未激活状态
-		Permissions: identitys,
+		Name:             *req.Name,
+		Description:      *req.Description,
+		Status:           models.RoleStatusInactive, // 默认未激活状态
+		Permissions:      identitys,
+		ExclusiveGroups:  exclusiveGroups,
+		MaxAssignments:   req.GetMaxAssignments(),
+		RequiresApproval: req.GetRequiresApproval(),
 	}
 	if req.IsSystemRole != nil {
 		roleDefinition.IsSystemRole = *req.IsSystemRole
@@ -105,6 +122,11 @@ func (l *LogicImpl) UpdateRoleDefinition(
 		return nil, errno.ErrSystemRoleCannotModify
 	}
 
+	// 乐观锁：请求版本号必须与当前版本一致，避免覆盖他人的修改
+	if err := errno.CheckVersion(req.Version, role.Version); err != nil {
+		return nil, err
+	}
+
 	// 更新字段
 	if req.Description != nil {
 		role.Description = *req.Description
@@ -150,8 +172,34 @@ func (l *LogicImpl) UpdateRoleDefinition(
 		role.Permissions = identitys
 	}
 
+	// 职责分离约束只约束之后的分配，存量违例通过 ListRoleConstraintViolations 扫描
+	if req.ExclusiveGroups != nil {
+		exclusiveGroups, err := normalizeExclusiveGroups(req.ExclusiveGroups.GetItems())
+		if err != nil {
+			return nil, err
+		}
+
+		role.ExclusiveGroups = exclusiveGroups
+	}
+
+	if req.MaxAssignments != nil {
+		if *req.MaxAssignments < 0 {
+			return nil, errno.ErrInvalidParams.WithMessage("最大分配人数不能为负数")
+		}
+
+		role.MaxAssignments = *req.MaxAssignments
+	}
+
+	if req.RequiresApproval != nil {
+		role.RequiresApproval = *req.RequiresApproval
+	}
+
 	// 保存更新
 	if err := l.dal.RoleDefinition().Update(ctx, role); err != nil {
+		if errors.Is(err, errno.ErrVersionConflict) {
+			return nil, err
+		}
+
 		return nil, errno.ErrOperationFailed.WithMessage("更新角色定义失败: " + err.Error())
 	}
 
@@ -309,3 +357,25 @@ func (l *LogicImpl) ListRoleDefinitions(
 		Page:  l.converter.Base().PageResponseToThrift(pageResult),
 	}, nil
 }
+
+// normalizeExclusiveGroups 去除互斥组名称首尾空白并去重，名称不能为空且不超过 50 个字符
+func normalizeExclusiveGroups(groups []string) (models.StringSlice, error) {
+	normalized := make(models.StringSlice, 0, len(groups))
+
+	for _, group := range groups {
+		group = strings.TrimSpace(group)
+		if group == "" {
+			return nil, errno.ErrInvalidParams.WithMessage("互斥组名称不能为空")
+		}
+
+		if utf8.RuneCountInString(group) > 50 {
+			return nil, errno.ErrInvalidParams.WithMessage("互斥组名称不能超过50个字符")
+		}
+
+		if !slices.Contains(normalized, group) {
+			normalized = append(normalized, group)
+		}
+	}
+
+	return normalized, nil
+}
@@ -182,6 +182,69 @@ func TestLogicImpl_CreateRoleDefinition(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotNil(t, result)
 	})
+
+	t.Run("创建带职责分离约束的角色_互斥组去空白去重", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		maxAssignments := int32(3)
+		req := &identity_srv.RoleDefinitionCreateRequest{
+			Name:            &roleName,
+			Description:     &roleDesc,
+			ExclusiveGroups: []string{" dispensing ", "dispensing", "audit"},
+			MaxAssignments:  &maxAssignments,
+		}
+
+		mocks.DefinitionRepo.EXPECT().CheckNameExists(ctx, roleName).Return(false, nil)
+		mocks.DefinitionRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, role *models.RoleDefinition) error {
+				assert.Equal(t, models.StringSlice{"dispensing", "audit"}, role.ExclusiveGroups)
+				assert.Equal(t, int32(3), role.MaxAssignments)
+
+				return nil
+			})
+
+		result, err := logic.CreateRoleDefinition(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"dispensing", "audit"}, result.ExclusiveGroups)
+		assert.Equal(t, int32(3), result.GetMaxAssignments())
+	})
+
+	t.Run("互斥组名称为空", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		req := &identity_srv.RoleDefinitionCreateRequest{
+			Name:            &roleName,
+			Description:     &roleDesc,
+			ExclusiveGroups: []string{"  "},
+		}
+
+		mocks.DefinitionRepo.EXPECT().CheckNameExists(ctx, roleName).Return(false, nil)
+
+		_, err := logic.CreateRoleDefinition(ctx, req)
+
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+
+	t.Run("最大分配人数为负数", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		maxAssignments := int32(-1)
+		req := &identity_srv.RoleDefinitionCreateRequest{
+			Name:           &roleName,
+			Description:    &roleDesc,
+			MaxAssignments: &maxAssignments,
+		}
+
+		mocks.DefinitionRepo.EXPECT().CheckNameExists(ctx, roleName).Return(false, nil)
+
+		_, err := logic.CreateRoleDefinition(ctx, req)
+
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
 }
 
 // ============================================================================
@@ -193,13 +256,14 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 	newName := "超级管理员"
 	newDesc := "更新后的描述"
 	activeStatus := core.RoleStatus(models.RoleStatusActive)
+	version := int64(1)
 
 	t.Run("成功更新角色定义", func(t *testing.T) {
 		logic, mocks := setupTest(t)
 		ctx := context.Background()
 
 		existingRole := &models.RoleDefinition{
-			BaseModel:    models.BaseModel{ID: uuid.MustParse(roleID)},
+			BaseModel:    models.BaseModel{ID: uuid.MustParse(roleID), Version: 1},
 			Name:         "管理员",
 			Description:  "旧描述",
 			IsSystemRole: false,
@@ -208,6 +272,7 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 		req := &identity_srv.RoleDefinitionUpdateRequest{
 			RoleDefinitionID: &roleID,
 			Description:      &newDesc,
+			Version:          &version,
 		}
 
 		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(existingRole, nil)
@@ -224,7 +289,7 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 		ctx := context.Background()
 
 		existingRole := &models.RoleDefinition{
-			BaseModel:    models.BaseModel{ID: uuid.MustParse(roleID)},
+			BaseModel:    models.BaseModel{ID: uuid.MustParse(roleID), Version: 1},
 			Name:         "管理员",
 			IsSystemRole: false,
 		}
@@ -232,6 +297,7 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 		req := &identity_srv.RoleDefinitionUpdateRequest{
 			RoleDefinitionID: &roleID,
 			Name:             &newName,
+			Version:          &version,
 		}
 
 		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(existingRole, nil)
@@ -249,7 +315,7 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 		ctx := context.Background()
 
 		existingRole := &models.RoleDefinition{
-			BaseModel:    models.BaseModel{ID: uuid.MustParse(roleID)},
+			BaseModel:    models.BaseModel{ID: uuid.MustParse(roleID), Version: 1},
 			Name:         "管理员",
 			IsSystemRole: false,
 		}
@@ -258,6 +324,7 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 		req := &identity_srv.RoleDefinitionUpdateRequest{
 			RoleDefinitionID: &roleID,
 			Name:             &newName,
+			Version:          &version,
 		}
 
 		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(existingRole, nil)
@@ -275,20 +342,21 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 		ctx := context.Background()
 
 		existingRole := &models.RoleDefinition{
-			BaseModel:    models.BaseModel{ID: uuid.MustParse(roleID)},
+			BaseModel:    models.BaseModel{ID: uuid.MustParse(roleID), Version: 1},
 			Name:         "管理员",
 			IsSystemRole: false,
 		}
 
 		otherRoleID := uuid.New()
 		otherRole := &models.RoleDefinition{
-			BaseModel: models.BaseModel{ID: otherRoleID},
+			BaseModel: models.BaseModel{ID: otherRoleID, Version: 1},
 			Name:      newName,
 		}
 
 		req := &identity_srv.RoleDefinitionUpdateRequest{
 			RoleDefinitionID: &roleID,
 			Name:             &newName,
+			Version:          &version,
 		}
 
 		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(existingRole, nil)
@@ -305,7 +373,7 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 		ctx := context.Background()
 
 		existingRole := &models.RoleDefinition{
-			BaseModel:    models.BaseModel{ID: uuid.MustParse(roleID)},
+			BaseModel:    models.BaseModel{ID: uuid.MustParse(roleID), Version: 1},
 			Name:         "管理员",
 			IsSystemRole: false,
 		}
@@ -313,6 +381,7 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 		req := &identity_srv.RoleDefinitionUpdateRequest{
 			RoleDefinitionID: &roleID,
 			Name:             &newName,
+			Version:          &version,
 		}
 
 		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(existingRole, nil)
@@ -329,7 +398,7 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 		ctx := context.Background()
 
 		existingRole := &models.RoleDefinition{
-			BaseModel:    models.BaseModel{ID: uuid.MustParse(roleID)},
+			BaseModel:    models.BaseModel{ID: uuid.MustParse(roleID), Version: 1},
 			Name:         "管理员",
 			Status:       models.RoleStatusInactive,
 			IsSystemRole: false,
@@ -338,6 +407,7 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 		req := &identity_srv.RoleDefinitionUpdateRequest{
 			RoleDefinitionID: &roleID,
 			Status:           &activeStatus,
+			Version:          &version,
 		}
 
 		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(existingRole, nil)
@@ -358,7 +428,7 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 		ctx := context.Background()
 
 		existingRole := &models.RoleDefinition{
-			BaseModel:    models.BaseModel{ID: uuid.MustParse(roleID)},
+			BaseModel:    models.BaseModel{ID: uuid.MustParse(roleID), Version: 1},
 			Name:         "管理员",
 			IsSystemRole: false,
 		}
@@ -371,6 +441,7 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 			Permissions: &identity_srv.PermissionListValue{Items: []*identity_srv.Permission{
 				{Resource: &newResource, Action: &newAction},
 			}},
+			Version: &version,
 		}
 
 		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(existingRole, nil)
@@ -389,12 +460,45 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 		assert.NotNil(t, result)
 	})
 
+	t.Run("更新职责分离约束_空列表清空互斥组", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		existingRole := &models.RoleDefinition{
+			BaseModel:       models.BaseModel{ID: uuid.MustParse(roleID), Version: 1},
+			Name:            "药师审核",
+			ExclusiveGroups: models.StringSlice{"dispensing"},
+		}
+
+		maxAssignments := int32(5)
+		req := &identity_srv.RoleDefinitionUpdateRequest{
+			RoleDefinitionID: &roleID,
+			ExclusiveGroups:  &core.StringListValue{Items: []string{}},
+			MaxAssignments:   &maxAssignments,
+			Version:          &version,
+		}
+
+		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(existingRole, nil)
+		mocks.DefinitionRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, role *models.RoleDefinition) error {
+				assert.Empty(t, role.ExclusiveGroups)
+				assert.Equal(t, int32(5), role.MaxAssignments)
+
+				return nil
+			})
+
+		_, err := logic.UpdateRoleDefinition(ctx, req)
+
+		require.NoError(t, err)
+	})
+
 	t.Run("角色定义ID为空", func(t *testing.T) {
 		logic, _ := setupTest(t)
 		ctx := context.Background()
 
 		req := &identity_srv.RoleDefinitionUpdateRequest{
 			Description: &newDesc,
+			Version:     &version,
 		}
 
 		result, err := logic.UpdateRoleDefinition(ctx, req)
@@ -410,6 +514,7 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 		req := &identity_srv.RoleDefinitionUpdateRequest{
 			RoleDefinitionID: &roleID,
 			Description:      &newDesc,
+			Version:          &version,
 		}
 
 		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(nil, nil)
@@ -427,6 +532,7 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 		req := &identity_srv.RoleDefinitionUpdateRequest{
 			RoleDefinitionID: &roleID,
 			Description:      &newDesc,
+			Version:          &version,
 		}
 
 		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(nil, gorm.ErrInvalidDB)
@@ -442,7 +548,7 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 		ctx := context.Background()
 
 		systemRole := &models.RoleDefinition{
-			BaseModel:    models.BaseModel{ID: uuid.MustParse(roleID)},
+			BaseModel:    models.BaseModel{ID: uuid.MustParse(roleID), Version: 1},
 			Name:         "系统管理员",
 			IsSystemRole: true,
 		}
@@ -450,6 +556,7 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 		req := &identity_srv.RoleDefinitionUpdateRequest{
 			RoleDefinitionID: &roleID,
 			Description:      &newDesc,
+			Version:          &version,
 		}
 
 		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(systemRole, nil)
@@ -465,7 +572,7 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 		ctx := context.Background()
 
 		existingRole := &models.RoleDefinition{
-			BaseModel:    models.BaseModel{ID: uuid.MustParse(roleID)},
+			BaseModel:    models.BaseModel{ID: uuid.MustParse(roleID), Version: 1},
 			Name:         "管理员",
 			IsSystemRole: false,
 		}
@@ -473,6 +580,7 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 		req := &identity_srv.RoleDefinitionUpdateRequest{
 			RoleDefinitionID: &roleID,
 			Description:      &newDesc,
+			Version:          &version,
 		}
 
 		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(existingRole, nil)
@@ -483,6 +591,31 @@ func TestLogicImpl_UpdateRoleDefinition(t *testing.T) {
 		assert.Nil(t, result)
 		assert.Error(t, err)
 	})
+
+	t.Run("保存时并发修改返回版本冲突", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		existingRole := &models.RoleDefinition{
+			BaseModel:    models.BaseModel{ID: uuid.MustParse(roleID), Version: 1},
+			Name:         "管理员",
+			IsSystemRole: false,
+		}
+
+		req := &identity_srv.RoleDefinitionUpdateRequest{
+			RoleDefinitionID: &roleID,
+			Description:      &newDesc,
+			Version:          &version,
+		}
+
+		mocks.DefinitionRepo.EXPECT().GetByID(ctx, roleID).Return(existingRole, nil)
+		mocks.DefinitionRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errno.NewVersionConflictError(2))
+
+		result, err := logic.UpdateRoleDefinition(ctx, req)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errno.ErrVersionConflict)
+	})
 }
 
 // ============================================================================
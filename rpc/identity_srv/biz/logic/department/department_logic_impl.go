@@ -4,8 +4,10 @@ import (
 	"context"
 
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter"
+	convbase "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/base"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
 	departmentDAL "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/department"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/datascope"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
@@ -111,6 +113,11 @@ func (l *LogicImpl) UpdateDepartment(
 		return nil, errno.ErrOperationFailed.WithMessage("获取部门信息失败: " + err.Error())
 	}
 
+	// 乐观锁：请求版本号必须与当前版本一致，避免覆盖他人的修改
+	if err := errno.CheckVersion(req.Version, existingDept.Version); err != nil {
+		return nil, err
+	}
+
 	// 应用更新
 	updatedDept := l.converter.Department().ApplyUpdateToModel(existingDept, req)
 
@@ -191,6 +198,14 @@ func (l *LogicImpl) GetDepartmentsByOrganization(
 		OrganizationID: req.OrganizationID,
 	}
 
+	// 按调用方数据范围限定可见部门
+	scope, err := datascope.Resolve(ctx, l.dal)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(err.Error())
+	}
+
+	conditions.Scope = scope
+
 	// 使用 FindWithConditions 查询
 	departments, pageResult, err := l.dal.Department().FindWithConditions(ctx, conditions)
 	if err != nil {
@@ -203,6 +218,7 @@ func (l *LogicImpl) GetDepartmentsByOrganization(
 	return &identity_srv.GetOrganizationDepartmentsResponse{
 		Departments: departmentList,
 		Page:        l.converter.Base().PageResponseToThrift(pageResult),
+		Highlights:  convbase.SearchHighlightsToThrift(opts.Search, departments),
 	}, nil
 }
 
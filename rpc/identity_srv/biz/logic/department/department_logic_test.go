@@ -11,7 +11,9 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
 	departmentDAL "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/department"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/datascope"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/mock"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/rpc_base"
@@ -225,13 +227,14 @@ func TestLogicImpl_UpdateDepartment(t *testing.T) {
 	deptID := uuid.New().String()
 	orgID := uuid.New()
 	newName := "内科"
+	version := int64(1)
 
 	t.Run("成功更新部门", func(t *testing.T) {
 		logic, mocks := setupTest(t)
 		ctx := context.Background()
 
 		existingDept := &models.Department{
-			BaseModel:      models.BaseModel{ID: uuid.MustParse(deptID)},
+			BaseModel:      models.BaseModel{ID: uuid.MustParse(deptID), Version: 1},
 			Name:           "急诊科",
 			OrganizationID: orgID,
 		}
@@ -239,6 +242,7 @@ func TestLogicImpl_UpdateDepartment(t *testing.T) {
 		req := &identity_srv.UpdateDepartmentRequest{
 			DepartmentID: &deptID,
 			Name:         &newName,
+			Version:      &version,
 		}
 
 		mocks.DeptRepo.EXPECT().GetByID(ctx, deptID).Return(existingDept, nil)
@@ -257,6 +261,7 @@ func TestLogicImpl_UpdateDepartment(t *testing.T) {
 		req := &identity_srv.UpdateDepartmentRequest{
 			DepartmentID: &deptID,
 			Name:         &newName,
+			Version:      &version,
 		}
 
 		mocks.DeptRepo.EXPECT().GetByID(ctx, deptID).Return(nil, gorm.ErrRecordNotFound)
@@ -272,7 +277,8 @@ func TestLogicImpl_UpdateDepartment(t *testing.T) {
 		ctx := context.Background()
 
 		req := &identity_srv.UpdateDepartmentRequest{
-			Name: &newName,
+			Name:    &newName,
+			Version: &version,
 		}
 
 		result, err := logic.UpdateDepartment(ctx, req)
@@ -286,7 +292,7 @@ func TestLogicImpl_UpdateDepartment(t *testing.T) {
 		ctx := context.Background()
 
 		existingDept := &models.Department{
-			BaseModel:      models.BaseModel{ID: uuid.MustParse(deptID)},
+			BaseModel:      models.BaseModel{ID: uuid.MustParse(deptID), Version: 1},
 			Name:           "急诊科",
 			OrganizationID: orgID,
 		}
@@ -294,6 +300,7 @@ func TestLogicImpl_UpdateDepartment(t *testing.T) {
 		req := &identity_srv.UpdateDepartmentRequest{
 			DepartmentID: &deptID,
 			Name:         &newName,
+			Version:      &version,
 		}
 
 		mocks.DeptRepo.EXPECT().GetByID(ctx, deptID).Return(existingDept, nil)
@@ -490,6 +497,84 @@ func TestLogicImpl_GetDepartmentsByOrganization(t *testing.T) {
 	})
 }
 
+func TestLogicImpl_GetDepartmentsByOrganization_DataScope(t *testing.T) {
+	orgID := uuid.New().String()
+	callerID := uuid.New().String()
+	deptID := uuid.New().String()
+
+	tests := []struct {
+		name   string
+		hint   string
+		expect func(m *mock.TestMocks)
+		want   *base.DataScopeFilter
+	}{
+		{
+			name: "self 仅调用方所在部门",
+			hint: "self",
+			want: &base.DataScopeFilter{Level: models.DataScopeSelf, UserID: callerID},
+		},
+		{
+			name: "dept 限定调用方部门",
+			hint: "dept",
+			expect: func(m *mock.TestMocks) {
+				m.MembershipRepo.EXPECT().
+					GetUserDepartments(gomock.Any(), callerID, orgID).
+					Return([]string{deptID}, nil)
+			},
+			want: &base.DataScopeFilter{
+				Level:         models.DataScopeDept,
+				UserID:        callerID,
+				DepartmentIDs: []string{deptID},
+			},
+		},
+		{
+			name: "org 限定调用方租户",
+			hint: "org",
+			want: &base.DataScopeFilter{
+				Level:           models.DataScopeOrg,
+				UserID:          callerID,
+				OrganizationIDs: []string{orgID},
+			},
+		},
+		{
+			name: "all 不限制",
+			hint: "all",
+			want: &base.DataScopeFilter{Level: models.DataScopeAll, UserID: callerID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logic, mocks := setupTest(t)
+			ctx := datascope.WithScope(context.Background(), datascope.Scope{
+				Hint:     tt.hint,
+				UserID:   callerID,
+				TenantID: orgID,
+			})
+
+			if tt.expect != nil {
+				tt.expect(mocks)
+			}
+
+			mocks.DeptRepo.EXPECT().
+				FindWithConditions(ctx, gomock.Any()).
+				DoAndReturn(func(
+					_ context.Context,
+					cond *departmentDAL.DepartmentQueryConditions,
+				) ([]*models.Department, *models.PageResult, error) {
+					assert.Equal(t, tt.want, cond.Scope)
+					return []*models.Department{}, &models.PageResult{Total: 0, Page: 1, Limit: 20}, nil
+				})
+
+			_, err := logic.GetDepartmentsByOrganization(ctx, &identity_srv.GetOrganizationDepartmentsRequest{
+				OrganizationID: &orgID,
+			})
+
+			require.NoError(t, err)
+		})
+	}
+}
+
 // ============================================================================
 // NewLogic 构造函数测试
 // ============================================================================
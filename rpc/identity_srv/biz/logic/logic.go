@@ -1,6 +1,7 @@
 package logic
 
 import (
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/accessreview"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/assignment"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/auditlog"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/authentication"
@@ -9,7 +10,12 @@ import (
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/logo"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/membership"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/menu"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/mfa"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/organization"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/passwordpolicy"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/passwordreset"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/permissionreport"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/recyclebin"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/user"
 )
 
@@ -25,6 +31,14 @@ type Logic interface {
 	// 负责用户登录、密码修改、密码重置、密码强制修改等功能
 	authentication.AuthenticationLogic
 
+	// MFA 多因素认证
+	// 负责 TOTP 登记、确认、校验、停用以及恢复码管理
+	mfa.MFALogic
+
+	// PasswordReset 自助找回密码
+	// 负责签发一次性重置令牌、发送重置链接以及凭令牌设置新密码
+	passwordreset.PasswordResetLogic
+
 	// UserProfile 用户档案管理
 	// 负责用户个人信息、认证状态、资质等核心档案数据的管理
 	user.ProfileLogic
@@ -37,6 +51,10 @@ type Logic interface {
 	// 负责机构和组织的层级结构管理，包括创建、更新、查询和关系维护
 	organization.OrganizationLogic
 
+	// PasswordPolicy 组织密码策略
+	// 负责组织单独配置的密码规则管理，未配置的组织沿用全局默认策略
+	passwordpolicy.PasswordPolicyLogic
+
 	// Department 部门管理
 	// 负责机构内部门的创建、管理和成员关系维护
 	department.DepartmentLogic
@@ -56,6 +74,10 @@ type Logic interface {
 	// 负责用户角色分配的创建、更新、查询、删除等核心业务功能
 	assignment.RoleAssignmentLogic
 
+	// AccessReview 访问复核
+	// 负责周期性复核活动的创建、逐条确认或撤销角色分配以及活动关闭
+	accessreview.AccessReviewLogic
+
 	// ============================================================================
 	// 菜单管理模块 - 基于新的菜单权限架构
 	// ============================================================================
@@ -64,6 +86,10 @@ type Logic interface {
 	// 负责菜单配置的上传、解析、存储以及用户菜单树的构建和权限过滤
 	menu.MenuLogic
 
+	// PermissionReport 权限报告
+	// 负责角色 × 菜单/策略权限矩阵、用户有效权限查询与导出
+	permissionreport.PermissionReportLogic
+
 	// ============================================================================
 	// 审计日志模块
 	// ============================================================================
@@ -71,4 +97,12 @@ type Logic interface {
 	// AuditLog 审计日志
 	// 负责审计日志的创建和查询
 	auditlog.AuditLogLogic
+
+	// ============================================================================
+	// 回收站模块
+	// ============================================================================
+
+	// RecycleBin 回收站
+	// 负责已删除用户、组织、部门的查询、恢复与彻底删除
+	recyclebin.RecycleBinLogic
 }
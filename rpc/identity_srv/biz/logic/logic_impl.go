@@ -3,16 +3,24 @@ package logic
 import (
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
+	accessReviewLogic "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/accessreview"
 	roleAssignLogic "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/assignment"
 	auditLogLogic "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/auditlog"
 	authenticationLogic "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/authentication"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/breakglass"
 	roleDefLogic "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/definition"
 	departmentLogic "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/department"
 	logoLogic "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/logo"
 	membershipLogic "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/membership"
 	menuLogic "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/menu"
+	mfaLogic "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/mfa"
 	orgLogic "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/organization"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/passwordpolicy"
+	passwordResetLogic "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/passwordreset"
+	permissionReportLogic "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/permissionreport"
+	recycleBinLogic "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/recyclebin"
 	userLogic "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/user"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/notifier"
 	rustfsclient "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/rustfs_client"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/config"
 )
@@ -30,6 +38,12 @@ type Impl struct {
 	// 用户认证管理
 	authenticationLogic.AuthenticationLogic
 
+	// 多因素认证
+	mfaLogic.MFALogic
+
+	// 自助找回密码
+	passwordResetLogic.PasswordResetLogic
+
 	// 用户档案管理
 	userLogic.ProfileLogic
 
@@ -39,6 +53,9 @@ type Impl struct {
 	// 组织管理
 	orgLogic.OrganizationLogic
 
+	// 组织密码策略
+	passwordpolicy.PasswordPolicyLogic
+
 	// 部门管理
 	departmentLogic.DepartmentLogic
 
@@ -54,6 +71,9 @@ type Impl struct {
 	// 用户角色分配管理
 	roleAssignLogic.RoleAssignmentLogic
 
+	// 访问复核
+	accessReviewLogic.AccessReviewLogic
+
 	// ============================================================================
 	// 菜单管理 - 基于新的菜单权限架构
 	// ============================================================================
@@ -61,17 +81,36 @@ type Impl struct {
 	// 菜单管理
 	menuLogic.MenuLogic
 
+	// 权限报告
+	permissionReportLogic.PermissionReportLogic
+
 	// ============================================================================
 	// 审计日志 - 记录系统操作
 	// ============================================================================
 
 	// 审计日志
 	auditLogLogic.AuditLogLogic
+
+	// ============================================================================
+	// 回收站 - 已删除数据的恢复与清除
+	// ============================================================================
+
+	// 回收站
+	recycleBinLogic.RecycleBinLogic
 }
 
 // NewLogicImpl 创建业务逻辑层实例
 // 基于新的DAL架构和模块化设计，初始化所有业务逻辑模块
-func NewLogicImpl(dal dal.DAL, cfg *config.Config) Logic {
+// policy 用于按 policy_srv 策略计算全量菜单授权与权限报告，breakGlass 为紧急账号守卫，
+// resetNotifier 为找回密码链接的投递渠道（可为 nil），passwordPolicy 在所有设置密码的路径上执行密码策略
+func NewLogicImpl(
+	dal dal.DAL,
+	cfg *config.Config,
+	policy menuLogic.PermissionLister,
+	breakGlass *breakglass.Guard,
+	resetNotifier notifier.Notifier,
+	passwordPolicy *passwordpolicy.Enforcer,
+) Logic {
 	// 创建转换器实例
 	conv := converter.NewConverter()
 
@@ -108,6 +147,8 @@ func NewLogicImpl(dal dal.DAL, cfg *config.Config) Logic {
 		conv,
 		dal.UserRoleAssignment(),
 		cfg,
+		policy,
+		breakGlass,
 	)
 
 	return &Impl{
@@ -122,10 +163,22 @@ func NewLogicImpl(dal dal.DAL, cfg *config.Config) Logic {
 			dal,
 			conv,
 			menuLogicImpl,
+			passwordPolicy,
+		),
+
+		// 多因素认证逻辑
+		MFALogic: mfaLogic.NewLogic(dal, cfg.MFA.Issuer),
+
+		// 自助找回密码逻辑
+		PasswordResetLogic: passwordResetLogic.NewLogic(
+			dal,
+			resetNotifier,
+			cfg.PasswordReset,
+			passwordPolicy,
 		),
 
 		// 用户档案逻辑（替代传统的user模块）
-		ProfileLogic: userLogic.NewLogic(dal, conv),
+		ProfileLogic: userLogic.NewLogic(dal, conv, passwordPolicy),
 
 		// 用户成员关系逻辑（新增模块）
 		MembershipLogic: membershipLogic.NewLogic(dal, conv),
@@ -133,6 +186,9 @@ func NewLogicImpl(dal dal.DAL, cfg *config.Config) Logic {
 		// 组织管理逻辑（重构现有organization模块）
 		OrganizationLogic: orgLogicImpl,
 
+		// 组织密码策略逻辑
+		PasswordPolicyLogic: passwordpolicy.NewLogic(dal, passwordPolicy),
+
 		// 部门管理逻辑（新增模块）
 		DepartmentLogic: departmentLogic.NewLogic(dal, conv),
 
@@ -146,7 +202,10 @@ func NewLogicImpl(dal dal.DAL, cfg *config.Config) Logic {
 		RoleDefinitionLogic: roleDefLogic.NewLogic(dal, conv),
 
 		// 用户角色分配逻辑
-		RoleAssignmentLogic: roleAssignLogic.NewLogic(dal, conv),
+		RoleAssignmentLogic: roleAssignLogic.NewLogic(dal, conv, cfg.RoleAssignment.ApprovalTTL),
+
+		// 访问复核逻辑
+		AccessReviewLogic: accessReviewLogic.NewLogic(dal, conv),
 
 		// ============================================================================
 		// 菜单管理初始化 - 使用新的菜单权限架构
@@ -155,15 +214,31 @@ func NewLogicImpl(dal dal.DAL, cfg *config.Config) Logic {
 		// 菜单逻辑
 		MenuLogic: menuLogicImpl,
 
+		// 权限报告逻辑
+		PermissionReportLogic: permissionReportLogic.NewLogic(dal, policy, breakGlass),
+
 		// ============================================================================
 		// 审计日志初始化
 		// ============================================================================
 
 		AuditLogLogic: auditLogLogic.NewLogic(dal, conv),
+
+		// ============================================================================
+		// 回收站初始化
+		// ============================================================================
+
+		RecycleBinLogic: recycleBinLogic.NewLogic(dal, conv, cfg.RecycleBin.Retention),
 	}
 }
 
 // NewLogic 创建业务逻辑层实例（工厂函数）
-func NewLogic(dal dal.DAL, cfg *config.Config) Logic {
-	return NewLogicImpl(dal, cfg)
+func NewLogic(
+	dal dal.DAL,
+	cfg *config.Config,
+	policy menuLogic.PermissionLister,
+	breakGlass *breakglass.Guard,
+	resetNotifier notifier.Notifier,
+	passwordPolicy *passwordpolicy.Enforcer,
+) Logic {
+	return NewLogicImpl(dal, cfg, policy, breakGlass, resetNotifier, passwordPolicy)
 }
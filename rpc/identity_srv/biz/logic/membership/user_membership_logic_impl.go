@@ -322,8 +322,9 @@ func (l *LogicImpl) GetUserMemberships(
 		conditions.DepartmentID = req.DepartmentID
 	}
 
-	// 如果没有指定任何过滤条件，默认查询活跃的成员关系
-	if req.UserID == nil && req.OrganizationID == nil && req.DepartmentID == nil {
+	// 显式要求或没有指定任何过滤条件时，只查询活跃的成员关系
+	if req.GetActiveOnly() ||
+		(req.UserID == nil && req.OrganizationID == nil && req.DepartmentID == nil) {
 		activeStatus := models.MembershipStatusActive
 		conditions.Status = &activeStatus
 	}
@@ -339,6 +340,12 @@ func (l *LogicImpl) GetUserMemberships(
 	// 5. 转换结果
 	membershipList := l.converter.Membership().ModelUserMembershipsToThrift(memberships)
 
+	if req.GetWithOrganizationName() {
+		if err := l.fillOrganizationNames(ctx, membershipList); err != nil {
+			return nil, err
+		}
+	}
+
 	// 5. 构建响应
 	response := &identity_srv.GetUserMembershipsResponse{
 		Memberships: membershipList,
@@ -352,6 +359,48 @@ func (l *LogicImpl) GetUserMemberships(
 	return response, nil
 }
 
+// fillOrganizationNames 批量查询成员关系所属组织，填充组织名称
+func (l *LogicImpl) fillOrganizationNames(
+	ctx context.Context,
+	memberships []*identity_srv.UserMembership,
+) error {
+	orgIDs := make([]string, 0, len(memberships))
+	seen := make(map[string]bool, len(memberships))
+
+	for _, m := range memberships {
+		if orgID := m.GetOrganizationID(); orgID != "" && !seen[orgID] {
+			seen[orgID] = true
+			orgIDs = append(orgIDs, orgID)
+		}
+	}
+
+	if len(orgIDs) == 0 {
+		return nil
+	}
+
+	orgs, err := l.dal.Organization().BatchGetByIDs(ctx, orgIDs)
+	if err != nil {
+		return errno.ErrOperationFailed.WithMessage(
+			fmt.Sprintf("查询成员关系所属组织失败: %v", err),
+		)
+	}
+
+	names := make(map[string]string, len(orgs))
+	for _, org := range orgs {
+		if org != nil {
+			names[org.ID.String()] = org.Name
+		}
+	}
+
+	for _, m := range memberships {
+		if name, ok := names[m.GetOrganizationID()]; ok {
+			m.OrganizationName = &name
+		}
+	}
+
+	return nil
+}
+
 // GetPrimaryMembership 获取用户的主要成员关系
 //
 // 主要成员关系用于确定用户的默认组织归属和权限上下文。
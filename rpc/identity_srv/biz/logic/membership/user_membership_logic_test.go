@@ -736,6 +736,64 @@ func TestLogicImpl_GetUserMemberships(t *testing.T) {
 		assert.Len(t, result.Memberships, 1)
 	})
 
+	t.Run("withOrganizationName批量填充组织名称", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		withName := true
+		req := &identity_srv.GetUserMembershipsRequest{
+			UserID:               &userID,
+			WithOrganizationName: &withName,
+		}
+
+		org := &models.Organization{BaseModel: models.BaseModel{ID: uuid.MustParse(orgID)}, Name: "第一医院"}
+		memberships := []*models.UserMembership{
+			{BaseModel: models.BaseModel{ID: uuid.New()}, UserID: uuid.MustParse(userID), OrganizationID: org.ID},
+			{BaseModel: models.BaseModel{ID: uuid.New()}, UserID: uuid.MustParse(userID), OrganizationID: org.ID},
+		}
+
+		mocks.MembershipRepo.EXPECT().FindWithConditions(ctx, gomock.Any()).Return(memberships, nil, nil)
+		// 同一组织只查询一次，且整批一次查询
+		mocks.OrgRepo.EXPECT().BatchGetByIDs(ctx, []string{orgID}).Return([]*models.Organization{org}, nil)
+
+		result, err := logic.GetUserMemberships(ctx, req)
+
+		require.NoError(t, err)
+		require.Len(t, result.Memberships, 2)
+
+		for _, m := range result.Memberships {
+			assert.Equal(t, "第一医院", m.GetOrganizationName())
+		}
+	})
+
+	t.Run("activeOnly只查询活跃成员关系", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		activeOnly := true
+		req := &identity_srv.GetUserMembershipsRequest{
+			UserID:     &userID,
+			ActiveOnly: &activeOnly,
+		}
+
+		mocks.MembershipRepo.EXPECT().
+			FindWithConditions(ctx, gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context,
+				cond *membershipDAL.UserMembershipQueryConditions,
+			) ([]*models.UserMembership, *models.PageResult, error) {
+				require.NotNil(t, cond.Status)
+				assert.Equal(t, models.MembershipStatusActive, *cond.Status)
+
+				return []*models.UserMembership{}, nil, nil
+			})
+
+		result, err := logic.GetUserMemberships(ctx, req)
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Memberships)
+	})
+
 	t.Run("成功按组织ID查询成员关系", func(t *testing.T) {
 		logic, mocks := setupTest(t)
 		ctx := context.Background()
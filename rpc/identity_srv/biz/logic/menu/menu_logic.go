@@ -3,23 +3,62 @@ package menu
 import (
 	"context"
 
+	"github.com/masonsxu/cloudwego-microservice-demo/iamclient"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
 )
 
+// PermissionLister 查询主体在 policy_srv 中的权限规则（含角色继承）
+// 生产实现为 iamclient.Client；userID 为空表示仅按角色查询
+type PermissionLister interface {
+	ListPermissions(ctx context.Context, userID, tenantID string, roles []string) ([]iamclient.Permission, error)
+}
+
 // MenuLogic 菜单管理逻辑接口
 // 负责菜单配置的上传、解析、存储以及用户菜单树的构建和权限过滤
 type MenuLogic interface {
 	// UploadMenu 上传并解析菜单配置文件 (menu.yaml)
 	//	@param	ctx	上下文
 	//	@param	req	包含	YAML	文件内容的请求
-	UploadMenu(ctx context.Context, req *identity_srv.UploadMenuRequest) error
+	//	@return	新版本号、与当前版本的差异及将失效的角色菜单授权
+	UploadMenu(
+		ctx context.Context,
+		req *identity_srv.UploadMenuRequest,
+	) (*identity_srv.UploadMenuResponse, error)
+
+	// ListMenuVersions 列出产品线的菜单版本历史
+	//	@param	ctx	上下文
+	//	@param	req	包含产品线的请求
+	//	@return	按版本号倒序的版本摘要及当前生效版本
+	ListMenuVersions(
+		ctx context.Context,
+		req *identity_srv.ListMenuVersionsRequest,
+	) (*identity_srv.ListMenuVersionsResponse, error)
+
+	// DiffMenuVersions 以 SemanticID 为键比较两个菜单版本
+	//	@param	ctx	上下文
+	//	@param	req	包含起止版本号的请求
+	//	@return	新增、移除、移动、重命名及其他变化的节点，以及将失效的角色菜单授权
+	DiffMenuVersions(
+		ctx context.Context,
+		req *identity_srv.DiffMenuVersionsRequest,
+	) (*identity_srv.DiffMenuVersionsResponse, error)
+
+	// ActivateMenuVersion 恢复历史菜单版本（复制为新的最新版本）
+	//	@param	ctx	上下文
+	//	@param	req	包含目标版本号的请求
+	//	@return	切换后的生效版本、差异及将失效的角色菜单授权
+	ActivateMenuVersion(
+		ctx context.Context,
+		req *identity_srv.ActivateMenuVersionRequest,
+	) (*identity_srv.ActivateMenuVersionResponse, error)
 
 	// GetMenuTree 获取指定用户的菜单树
 	//	@param	ctx	上下文
-	//	@param	req	包含用户标识等信息的请求
-	//	@return	用户可见的菜单树结构
+	//	@param	req	包含产品线的请求
+	//	@return	该产品线最新版本的完整菜单树
 	GetMenuTree(
 		ctx context.Context,
+		req *identity_srv.GetMenuTreeRequest,
 	) (*identity_srv.GetMenuTreeResponse, error)
 
 	// ConfigureRoleMenus 配置角色的菜单权限
@@ -4,15 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
+	"github.com/masonsxu/cloudwego-microservice-demo/iamclient"
+
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/convutil"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/assignment"
-	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/menu"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/breakglass"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/parser"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/config"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
@@ -21,11 +24,14 @@ import (
 )
 
 // LogicImpl 菜单管理逻辑实现
+// 全部菜单的完全控制权限只由 policy_srv 通配策略（或紧急账号）给出，与 PDP 决策同源
 type LogicImpl struct {
 	dal                  dal.DAL
 	converter            converter.Converter
 	userRoleAssignmentDA assignment.UserRoleAssignmentRepository
 	config               *config.Config
+	policy               PermissionLister
+	breakGlass           *breakglass.Guard
 }
 
 // NewLogic 创建菜单管理逻辑实现
@@ -34,12 +40,16 @@ func NewLogic(
 	converter converter.Converter,
 	userRoleAssignmentDA assignment.UserRoleAssignmentRepository,
 	config *config.Config,
+	policy PermissionLister,
+	breakGlass *breakglass.Guard,
 ) MenuLogic {
 	return &LogicImpl{
 		dal:                  dal,
 		converter:            converter,
 		userRoleAssignmentDA: userRoleAssignmentDA,
 		config:               config,
+		policy:               policy,
+		breakGlass:           breakGlass,
 	}
 }
 
@@ -47,8 +57,8 @@ func NewLogic(
 // 权限级别枚举转换辅助函数
 // =============================================================================
 
-// toThriftPermissionLevel 将内部权限类型转换为 Thrift 枚举
-func toThriftPermissionLevel(p models.MenuPermissionType) identity_srv.PermissionLevel {
+// ToThriftPermissionLevel 将内部权限类型转换为 Thrift 枚举
+func ToThriftPermissionLevel(p models.MenuPermissionType) identity_srv.PermissionLevel {
 	switch p {
 	case models.PermissionNone:
 		return identity_srv.PermissionLevel_PERMISSION_LEVEL_UNSPECIFIED
@@ -65,7 +75,7 @@ func toThriftPermissionLevel(p models.MenuPermissionType) identity_srv.Permissio
 
 // toThriftPermissionLevelPtr 将内部权限类型转换为 Thrift 枚举指针
 func toThriftPermissionLevelPtr(p models.MenuPermissionType) *identity_srv.PermissionLevel {
-	level := toThriftPermissionLevel(p)
+	level := ToThriftPermissionLevel(p)
 	return &level
 }
 
@@ -86,59 +96,88 @@ func parsePermissionTypeFromThrift(level identity_srv.PermissionLevel) models.Me
 }
 
 // UploadMenu 上传并解析菜单配置文件 (menu.yaml)
+// 写入前与当前版本比较，返回差异以及将因节点移除而失效的角色菜单授权；试运行时不写入。
 func (l *LogicImpl) UploadMenu(
 	ctx context.Context,
 	req *identity_srv.UploadMenuRequest,
-) error {
+) (*identity_srv.UploadMenuResponse, error) {
 	if req.YamlContent == nil || *req.YamlContent == "" {
-		return errno.ErrInvalidParams.WithMessage("YAML内容不能为空")
+		return nil, errno.ErrInvalidParams.WithMessage("YAML内容不能为空")
 	}
 
-	productLine := menu.DefaultProductLine
-	if req.ProductLine != nil && *req.ProductLine != "" {
-		productLine = *req.ProductLine
-	}
+	productLine := resolveProductLine(req.ProductLine)
 
 	// 使用parser模块解析YAML内容并转换为模型
 	// 先用版本号0解析，获取哈希值
 	menuModels, contentHash, err := parser.ParseAndFlattenMenu(*req.YamlContent, productLine, 0)
 	if err != nil {
-		return errno.ErrInvalidParams.WithMessage(fmt.Sprintf("解析菜单YAML失败: %s", err.Error()))
+		return nil, errno.ErrInvalidParams.WithMessage(fmt.Sprintf("解析菜单YAML失败: %s", err.Error()))
 	}
 
-	// 去重检查：查询该产品线最新版本的哈希
-	latestHash, err := l.dal.Menu().GetLatestContentHash(ctx, productLine)
-	if err == nil && latestHash == contentHash {
-		// 内容相同，静默返回成功（不创建新版本）
-		return nil
+	// 获取当前版本号
+	maxVersion, err := l.dal.Menu().GetMaxVersion(ctx, productLine)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("获取版本号失败: %s", err.Error()))
 	}
 
-	// 获取新版本号（自增）
-	maxVersion, err := l.dal.Menu().GetMaxVersion(ctx, productLine)
+	resp := &identity_srv.UploadMenuResponse{
+		Version:        convutil.Int32Ptr(int32(maxVersion)),
+		Created:        convutil.BoolPtr(false),
+		Changes:        []*identity_srv.MenuDiffEntry{},
+		OrphanedGrants: []*identity_srv.OrphanedMenuGrant{},
+	}
+
+	var current []*models.Menu
+	if maxVersion > 0 {
+		current, err = l.dal.Menu().GetMenusByVersion(ctx, productLine, maxVersion)
+		if err != nil {
+			return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("获取当前菜单版本失败: %s", err.Error()))
+		}
+	}
+
+	// 去重检查：内容与最新版本相同，静默返回成功（不创建新版本）
+	if len(current) > 0 && current[0].ContentHash == contentHash {
+		return resp, nil
+	}
+
+	changes, removed := diffMenus(current, menuModels)
+	resp.Changes = changes
+
+	resp.OrphanedGrants, err = l.findOrphanedGrants(ctx, productLine, removed)
 	if err != nil {
-		return errno.ErrOperationFailed.WithMessage(fmt.Sprintf("获取版本号失败: %s", err.Error()))
+		return nil, err
+	}
+
+	if convutil.BoolValue(req.DryRun) {
+		return resp, nil
 	}
 
 	newVersion := maxVersion + 1
+	operatorID := parseOperatorID(req.OperatorID)
 
 	// 更新所有菜单的版本号
 	for _, menu := range menuModels {
 		menu.Version = newVersion
+		menu.CreatedBy = operatorID
 	}
 
 	// 使用dal模块将菜单数据保存到数据库
 	if err := l.dal.Menu().CreateMenuTree(ctx, menuModels); err != nil {
-		return errno.ErrOperationFailed.WithMessage(fmt.Sprintf("保存菜单数据失败: %s", err.Error()))
+		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("保存菜单数据失败: %s", err.Error()))
 	}
 
-	return nil
+	resp.Version = convutil.Int32Ptr(int32(newVersion))
+	resp.Created = convutil.BoolPtr(true)
+
+	return resp, nil
 }
 
 // GetMenuTree 获取完整菜单树
 func (l *LogicImpl) GetMenuTree(
 	ctx context.Context,
+	req *identity_srv.GetMenuTreeRequest,
 ) (*identity_srv.GetMenuTreeResponse, error) {
-	menuModels, err := l.dal.Menu().GetLatestMenuTree(ctx, menu.DefaultProductLine)
+	menuModels, err := l.dal.Menu().GetLatestMenuTree(ctx, resolveProductLine(req.ProductLine))
 	if err != nil {
 		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("获取菜单树失败: %s", err.Error()))
 	}
@@ -190,8 +229,9 @@ func (l *LogicImpl) ConfigureRoleMenus(
 		permissions = append(permissions, permission)
 	}
 
-	// 同步角色菜单权限
-	if err := l.dal.RoleMenuPermission().SyncRoleMenus(ctx, roleID, permissions); err != nil {
+	// 同步角色在该产品线下的菜单权限
+	productLine := resolveProductLine(req.ProductLine)
+	if err := l.dal.RoleMenuPermission().SyncRoleMenus(ctx, roleID, productLine, permissions); err != nil {
 		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("配置角色菜单权限失败: %s", err.Error()))
 	}
 
@@ -218,7 +258,7 @@ func (l *LogicImpl) GetRoleMenuTree(
 	}
 
 	// 构建带权限标记的完整菜单树
-	menuNodes, err := l.buildMenuTreeWithPermissions(ctx, roleID)
+	menuNodes, err := l.buildMenuTreeWithPermissions(ctx, roleID, resolveProductLine(req.ProductLine))
 	if err != nil {
 		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("构建菜单树失败: %s", err.Error()))
 	}
@@ -238,10 +278,16 @@ func (l *LogicImpl) GetUserMenuTree(
 		return nil, errno.ErrInvalidParams.WithMessage("用户ID不能为空")
 	}
 
-	// 1. 获取用户的所有活跃角色ID
+	productLine, err := l.resolveUserProductLine(req.ProductLine, req.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 获取用户的所有活跃角色ID（指定组织时仅包含全局角色与该组织下的角色）
 	roleIDs, err := l.userRoleAssignmentDA.GetActiveRoleIDsWithStatus(
 		ctx,
 		*req.UserID,
+		req.OrganizationID,
 		models.RoleStatusActive,
 	)
 	if err != nil {
@@ -250,7 +296,15 @@ func (l *LogicImpl) GetUserMenuTree(
 		)
 	}
 
-	if len(roleIDs) == 0 {
+	// 2. 检查是否拥有全部菜单（紧急账号或策略通配授权）
+	fullAccess, err := l.userHasFullMenuAccess(ctx, *req.UserID, req.OrganizationID, roleIDs)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(
+			fmt.Sprintf("检查全量菜单权限失败: %s", err.Error()),
+		)
+	}
+
+	if !fullAccess && len(roleIDs) == 0 {
 		return &identity_srv.GetUserMenuTreeResponse{
 			MenuTree: []*identity_srv.MenuNode{},
 			UserID:   req.UserID,
@@ -258,19 +312,11 @@ func (l *LogicImpl) GetUserMenuTree(
 		}, nil
 	}
 
-	// 2. 检查是否有超管角色
-	isSuperAdmin, err := l.checkSuperAdminRoles(ctx, roleIDs)
-	if err != nil {
-		return nil, errno.ErrOperationFailed.WithMessage(
-			fmt.Sprintf("检查超管角色失败: %s", err.Error()),
-		)
-	}
-
 	var menuNodes []*identity_srv.MenuNode
 
-	if isSuperAdmin {
-		// 超管用户：返回完整菜单树
-		menuNodes, err = l.getAllMenusWithoutPermissionMarks(ctx)
+	if fullAccess {
+		// 全量授权：返回完整菜单树
+		menuNodes, err = l.getAllMenusWithoutPermissionMarks(ctx, productLine)
 		if err != nil {
 			return nil, errno.ErrOperationFailed.WithMessage(
 				fmt.Sprintf("获取完整菜单树失败: %s", err.Error()),
@@ -284,7 +330,7 @@ func (l *LogicImpl) GetUserMenuTree(
 		}
 
 		// 获取合并后的权限
-		mergedPermissions, err := l.dal.RoleMenuPermission().GetMergedPermissions(ctx, roleUUIDs)
+		mergedPermissions, err := l.dal.RoleMenuPermission().GetMergedPermissions(ctx, roleUUIDs, productLine)
 		if err != nil {
 			return nil, errno.ErrOperationFailed.WithMessage(
 				fmt.Sprintf("获取用户菜单权限失败: %s", err.Error()),
@@ -298,7 +344,7 @@ func (l *LogicImpl) GetUserMenuTree(
 		}
 
 		// 获取完整菜单树
-		menuModels, err := l.dal.Menu().GetLatestMenuTree(ctx, menu.DefaultProductLine)
+		menuModels, err := l.dal.Menu().GetLatestMenuTree(ctx, productLine)
 		if err != nil {
 			return nil, errno.ErrOperationFailed.WithMessage(
 				fmt.Sprintf("获取完整菜单树失败: %s", err.Error()),
@@ -332,19 +378,21 @@ func (l *LogicImpl) GetRoleMenuPermissions(
 		return nil, errno.ErrInvalidParams.WithMessage("无效的角色ID格式")
 	}
 
-	// 检查是否为超管角色
-	isSuperAdmin, err := l.isSuperAdminRole(ctx, *req.RoleID)
+	// 检查角色策略是否授予全部菜单
+	fullAccess, err := l.roleHasFullMenuAccess(ctx, *req.RoleID)
 	if err != nil {
-		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("检查超管角色失败: %s", err.Error()))
+		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("检查全量菜单权限失败: %s", err.Error()))
 	}
 
-	if isSuperAdmin {
-		// 超管角色：返回所有菜单的完全控制权限
-		return l.buildSuperAdminPermissionsResponse(ctx, req.RoleID)
+	productLine := resolveProductLine(req.ProductLine)
+
+	if fullAccess {
+		// 通配授权角色：返回所有菜单的完全控制权限
+		return l.buildFullPermissionsResponse(ctx, req.RoleID, productLine)
 	}
 
 	// 普通角色：获取数据库配置的权限
-	permissions, err := l.dal.RoleMenuPermission().GetByRoleID(ctx, roleID)
+	permissions, err := l.dal.RoleMenuPermission().GetByRoleAndProductLine(ctx, roleID, productLine)
 	if err != nil {
 		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("获取角色菜单权限失败: %s", err.Error()))
 	}
@@ -377,13 +425,13 @@ func (l *LogicImpl) HasMenuPermission(
 		return nil, errno.ErrInvalidParams.WithMessage("菜单ID不能为空")
 	}
 
-	// 检查是否为超管角色
-	isSuperAdmin, err := l.isSuperAdminRole(ctx, *req.RoleID)
+	// 检查角色策略是否授予全部菜单
+	fullAccess, err := l.roleHasFullMenuAccess(ctx, *req.RoleID)
 	if err != nil {
-		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("检查超管角色失败: %s", err.Error()))
+		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("检查全量菜单权限失败: %s", err.Error()))
 	}
 
-	if isSuperAdmin {
+	if fullAccess {
 		return &identity_srv.HasMenuPermissionResponse{
 			HasPermission: convutil.BoolPtr(true),
 			RoleID:        req.RoleID,
@@ -404,7 +452,13 @@ func (l *LogicImpl) HasMenuPermission(
 	}
 
 	// 检查权限
-	hasPermission, err := l.dal.RoleMenuPermission().HasPermission(ctx, roleID, *req.MenuID, requiredPermType)
+	hasPermission, err := l.dal.RoleMenuPermission().HasPermission(
+		ctx,
+		roleID,
+		resolveProductLine(req.ProductLine),
+		*req.MenuID,
+		requiredPermType,
+	)
 	if err != nil {
 		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("检查菜单权限失败: %s", err.Error()))
 	}
@@ -426,10 +480,16 @@ func (l *LogicImpl) GetUserMenuPermissions(
 		return nil, errno.ErrInvalidParams.WithMessage("用户ID不能为空")
 	}
 
-	// 获取用户的所有活跃角色ID
+	productLine, err := l.resolveUserProductLine(req.ProductLine, req.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 获取用户的所有活跃角色ID（指定组织时仅包含全局角色与该组织下的角色）
 	roleIDs, err := l.userRoleAssignmentDA.GetActiveRoleIDsWithStatus(
 		ctx,
 		*req.UserID,
+		req.OrganizationID,
 		models.RoleStatusActive,
 	)
 	if err != nil {
@@ -438,7 +498,15 @@ func (l *LogicImpl) GetUserMenuPermissions(
 		)
 	}
 
-	if len(roleIDs) == 0 {
+	// 检查是否拥有全部菜单（紧急账号或策略通配授权）
+	fullAccess, err := l.userHasFullMenuAccess(ctx, *req.UserID, req.OrganizationID, roleIDs)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(
+			fmt.Sprintf("检查全量菜单权限失败: %s", err.Error()),
+		)
+	}
+
+	if !fullAccess && len(roleIDs) == 0 {
 		return &identity_srv.GetUserMenuPermissionsResponse{
 			Permissions: []*identity_srv.MenuPermission{},
 			UserID:      req.UserID,
@@ -446,22 +514,14 @@ func (l *LogicImpl) GetUserMenuPermissions(
 		}, nil
 	}
 
-	// 检查是否有超管角色
-	isSuperAdmin, err := l.checkSuperAdminRoles(ctx, roleIDs)
-	if err != nil {
-		return nil, errno.ErrOperationFailed.WithMessage(
-			fmt.Sprintf("检查超管角色失败: %s", err.Error()),
-		)
-	}
-
 	var thriftPermissions []*identity_srv.MenuPermission
 
-	if isSuperAdmin {
-		// 超管用户：返回所有菜单的完全控制权限
-		thriftPermissions, err = l.buildSuperAdminPermissions(ctx)
+	if fullAccess {
+		// 全量授权：返回所有菜单的完全控制权限
+		thriftPermissions, err = l.buildFullPermissions(ctx, productLine)
 		if err != nil {
 			return nil, errno.ErrOperationFailed.WithMessage(
-				fmt.Sprintf("构建超管权限列表失败: %s", err.Error()),
+				fmt.Sprintf("构建全量权限列表失败: %s", err.Error()),
 			)
 		}
 	} else {
@@ -471,7 +531,7 @@ func (l *LogicImpl) GetUserMenuPermissions(
 			roleUUIDs[i], _ = uuid.Parse(id)
 		}
 
-		mergedPermissions, err := l.dal.RoleMenuPermission().GetMergedPermissions(ctx, roleUUIDs)
+		mergedPermissions, err := l.dal.RoleMenuPermission().GetMergedPermissions(ctx, roleUUIDs, productLine)
 		if err != nil {
 			return nil, errno.ErrOperationFailed.WithMessage(
 				fmt.Sprintf("获取用户菜单权限失败: %s", err.Error()),
@@ -498,74 +558,126 @@ func (l *LogicImpl) GetUserMenuPermissions(
 // 内部辅助方法
 // =============================================================================
 
-// isSuperAdminRole 检查角色是否为超管角色
-func (l *LogicImpl) isSuperAdminRole(ctx context.Context, roleID string) (bool, error) {
-	if len(l.config.SuperAdmin.RoleNames) == 0 {
-		return false, nil
+// userHasFullMenuAccess 判断用户是否拥有全部菜单的完全控制权限
+// 紧急账号直接放行并记录审计；其余情况按用户及其角色在 policy_srv 中是否有通配策略判断
+func (l *LogicImpl) userHasFullMenuAccess(
+	ctx context.Context,
+	userID string,
+	organizationID *string,
+	roleIDs []string,
+) (bool, error) {
+	tenantID := convutil.StringValue(organizationID)
+
+	if l.breakGlass.IsAccount(userID) {
+		user, err := l.dal.UserProfile().GetByID(ctx, userID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, err
+		}
+
+		// 已删除的紧急账号不再放行
+		if user != nil {
+			if err := l.breakGlass.Record(ctx, breakglass.Access{
+				UserID:   userID,
+				Username: user.Username,
+				TenantID: tenantID,
+				Action:   "read",
+				Resource: "menu",
+			}); err != nil {
+				return false, err
+			}
+
+			return true, nil
+		}
 	}
 
-	roleDefinition, err := l.dal.RoleDefinition().GetByID(ctx, roleID)
+	roleCodes, err := l.roleCodesByIDs(ctx, roleIDs)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return false, nil
-		}
+		return false, err
+	}
 
+	return l.policyGrantsAll(ctx, userID, tenantID, roleCodes)
+}
+
+// roleHasFullMenuAccess 判断角色在 policy_srv 中是否有通配策略
+func (l *LogicImpl) roleHasFullMenuAccess(ctx context.Context, roleID string) (bool, error) {
+	roleCodes, err := l.roleCodesByIDs(ctx, []string{roleID})
+	if err != nil {
 		return false, err
 	}
 
-	if roleDefinition == nil {
+	if len(roleCodes) == 0 {
 		return false, nil
 	}
 
-	superAdminRoles := make(map[string]bool)
-	for _, roleName := range l.config.SuperAdmin.RoleNames {
-		superAdminRoles[roleName] = true
+	return l.policyGrantsAll(ctx, "", "", roleCodes)
+}
+
+// roleCodesByIDs 查询角色ID对应的 Casbin 角色编码，不存在的角色直接忽略
+func (l *LogicImpl) roleCodesByIDs(ctx context.Context, roleIDs []string) ([]string, error) {
+	if len(roleIDs) == 0 {
+		return nil, nil
 	}
 
-	return superAdminRoles[roleDefinition.Name], nil
-}
+	roles, err := l.dal.RoleDefinition().BatchGetByIDs(ctx, roleIDs)
+	if err != nil {
+		return nil, err
+	}
 
-// checkSuperAdminRoles 检查角色列表中是否有超管角色
-func (l *LogicImpl) checkSuperAdminRoles(ctx context.Context, roleIDs []string) (bool, error) {
-	for _, roleID := range roleIDs {
-		isSuperAdmin, err := l.isSuperAdminRole(ctx, roleID)
-		if err != nil {
-			return false, err
+	roleCodes := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if role.RoleCode != "" {
+			roleCodes = append(roleCodes, role.RoleCode)
 		}
+	}
 
-		if isSuperAdmin {
-			return true, nil
-		}
+	return roleCodes, nil
+}
+
+// policyGrantsAll 判断主体在 policy_srv 中是否有对任意资源任意动作放行的通配策略
+// （如 p, role:superadmin, *, *, *, all），与 PDP 决策使用同一份规则
+func (l *LogicImpl) policyGrantsAll(
+	ctx context.Context,
+	userID, tenantID string,
+	roleCodes []string,
+) (bool, error) {
+	if userID == "" && len(roleCodes) == 0 {
+		return false, nil
 	}
 
-	return false, nil
+	permissions, err := l.policy.ListPermissions(ctx, userID, tenantID, roleCodes)
+	if err != nil {
+		return false, err
+	}
+
+	return slices.ContainsFunc(permissions, iamclient.Permission.GrantsAll), nil
 }
 
 // buildMenuTreeWithPermissions 构建带权限标记的完整菜单树
 func (l *LogicImpl) buildMenuTreeWithPermissions(
 	ctx context.Context,
 	roleID uuid.UUID,
+	productLine string,
 ) ([]*identity_srv.MenuNode, error) {
-	// 检查是否为超管角色
-	isSuperAdmin, err := l.isSuperAdminRole(ctx, roleID.String())
+	// 检查角色策略是否授予全部菜单
+	fullAccess, err := l.roleHasFullMenuAccess(ctx, roleID.String())
 	if err != nil {
 		return nil, err
 	}
 
-	if isSuperAdmin {
-		return l.getAllMenusWithFullPermissions(ctx)
+	if fullAccess {
+		return l.getAllMenusWithFullPermissions(ctx, productLine)
 	}
 
 	// 获取完整菜单树
-	menuModels, err := l.dal.Menu().GetLatestMenuTree(ctx, menu.DefaultProductLine)
+	menuModels, err := l.dal.Menu().GetLatestMenuTree(ctx, productLine)
 	if err != nil {
 		return nil, err
 	}
 
 	fullMenuTree := l.converter.Menu().ModelsToThrift(menuModels)
 
-	// 获取角色的菜单权限
-	permissions, err := l.dal.RoleMenuPermission().GetByRoleID(ctx, roleID)
+	// 获取角色在该产品线下的菜单权限
+	permissions, err := l.dal.RoleMenuPermission().GetByRoleAndProductLine(ctx, roleID, productLine)
 	if err != nil {
 		return nil, err
 	}
@@ -607,6 +719,10 @@ func (l *LogicImpl) markMenuPermissions(
 			newNode.Children = l.markMenuPermissions(node.Children, permMap)
 		}
 
+		if len(node.Buttons) > 0 {
+			newNode.Buttons = l.markMenuPermissions(node.Buttons, permMap)
+		}
+
 		result = append(result, &newNode)
 	}
 
@@ -635,7 +751,11 @@ func (l *LogicImpl) filterAuthorizedMenus(
 				Path:      node.Path,
 				Icon:      node.Icon,
 				Component: node.Component,
+				PermCode:  node.PermCode,
+				ApiPaths:  node.ApiPaths,
 				Children:  authorizedChildren,
+				// 按钮只保留直接授权的项，按钮授权不会使所属菜单可见
+				Buttons: l.filterAuthorizedMenus(node.Buttons, permMap),
 			}
 			authorizedMenus = append(authorizedMenus, authorizedNode)
 		}
@@ -647,8 +767,9 @@ func (l *LogicImpl) filterAuthorizedMenus(
 // getAllMenusWithFullPermissions 获取所有菜单并标记为完整权限
 func (l *LogicImpl) getAllMenusWithFullPermissions(
 	ctx context.Context,
+	productLine string,
 ) ([]*identity_srv.MenuNode, error) {
-	menuModels, err := l.dal.Menu().GetLatestMenuTree(ctx, menu.DefaultProductLine)
+	menuModels, err := l.dal.Menu().GetLatestMenuTree(ctx, productLine)
 	if err != nil {
 		return nil, err
 	}
@@ -661,8 +782,9 @@ func (l *LogicImpl) getAllMenusWithFullPermissions(
 // getAllMenusWithoutPermissionMarks 获取所有菜单但不添加权限标记
 func (l *LogicImpl) getAllMenusWithoutPermissionMarks(
 	ctx context.Context,
+	productLine string,
 ) ([]*identity_srv.MenuNode, error) {
-	menuModels, err := l.dal.Menu().GetLatestMenuTree(ctx, menu.DefaultProductLine)
+	menuModels, err := l.dal.Menu().GetLatestMenuTree(ctx, productLine)
 	if err != nil {
 		return nil, err
 	}
@@ -685,17 +807,22 @@ func (l *LogicImpl) markAllMenusWithFullPermission(
 			newNode.Children = l.markAllMenusWithFullPermission(node.Children)
 		}
 
+		if len(node.Buttons) > 0 {
+			newNode.Buttons = l.markAllMenusWithFullPermission(node.Buttons)
+		}
+
 		result = append(result, &newNode)
 	}
 
 	return result
 }
 
-// buildSuperAdminPermissions 构建超管用户的权限列表
-func (l *LogicImpl) buildSuperAdminPermissions(
+// buildFullPermissions 构建全部菜单的完全控制权限列表
+func (l *LogicImpl) buildFullPermissions(
 	ctx context.Context,
+	productLine string,
 ) ([]*identity_srv.MenuPermission, error) {
-	menuModels, err := l.dal.Menu().GetLatestMenuTree(ctx, menu.DefaultProductLine)
+	menuModels, err := l.dal.Menu().GetLatestMenuTree(ctx, productLine)
 	if err != nil {
 		return nil, err
 	}
@@ -722,12 +849,13 @@ func (l *LogicImpl) collectMenuPermissions(
 	}
 }
 
-// buildSuperAdminPermissionsResponse 构建超管角色权限响应
-func (l *LogicImpl) buildSuperAdminPermissionsResponse(
+// buildFullPermissionsResponse 构建全量授权角色的权限响应
+func (l *LogicImpl) buildFullPermissionsResponse(
 	ctx context.Context,
 	roleID *string,
+	productLine string,
 ) (*identity_srv.GetRoleMenuPermissionsResponse, error) {
-	permissions, err := l.buildSuperAdminPermissions(ctx)
+	permissions, err := l.buildFullPermissions(ctx, productLine)
 	if err != nil {
 		return nil, err
 	}
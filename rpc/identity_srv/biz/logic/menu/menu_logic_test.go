@@ -0,0 +1,305 @@
+package menu
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/iamclient"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/convutil"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/breakglass"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/mock"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/config"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+)
+
+// fakePermissionLister 按角色编码返回预置的 policy_srv 权限规则
+type fakePermissionLister struct {
+	byRole map[string][]iamclient.Permission
+	err    error
+	calls  int
+}
+
+func (f *fakePermissionLister) ListPermissions(
+	_ context.Context,
+	_, _ string,
+	roles []string,
+) ([]iamclient.Permission, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	var perms []iamclient.Permission
+	for _, role := range roles {
+		perms = append(perms, f.byRole[role]...)
+	}
+
+	return perms, nil
+}
+
+// superadminPolicy 与 policy_srv 默认种子一致的通配策略
+var superadminPolicy = map[string][]iamclient.Permission{
+	"role:superadmin": {{Resource: "*", Action: "*", DataScope: "all"}},
+	"role:nurse":      {{Resource: "patient", Action: "read", DataScope: "dept"}},
+}
+
+// ============================================================================
+// 产品线解析测试
+// ============================================================================
+
+func TestLogicImpl_resolveUserProductLine(t *testing.T) {
+	logic := &LogicImpl{config: &config.Config{
+		Menu: config.MenuConfig{ClientProductLines: map[string]string{"nurse-mobile": "nurse_app"}},
+	}}
+
+	t.Run("显式产品线优先", func(t *testing.T) {
+		productLine, err := logic.resolveUserProductLine(convutil.StringPtr("kiosk"), convutil.StringPtr("nurse-mobile"))
+		require.NoError(t, err)
+		assert.Equal(t, "kiosk", productLine)
+	})
+
+	t.Run("客户端标识映射不区分大小写", func(t *testing.T) {
+		productLine, err := logic.resolveUserProductLine(nil, convutil.StringPtr("Nurse-Mobile"))
+		require.NoError(t, err)
+		assert.Equal(t, "nurse_app", productLine)
+	})
+
+	t.Run("未指定时使用默认产品线", func(t *testing.T) {
+		productLine, err := logic.resolveUserProductLine(nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "default", productLine)
+	})
+
+	t.Run("未知的客户端标识", func(t *testing.T) {
+		_, err := logic.resolveUserProductLine(nil, convutil.StringPtr("unknown-app"))
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+}
+
+// ============================================================================
+// GetUserMenuTree 测试
+// ============================================================================
+
+func TestLogicImpl_GetUserMenuTree_ProductLine(t *testing.T) {
+	logic, mocks := setupTest(t)
+	logic.userRoleAssignmentDA = mocks.AssignmentRepo
+	logic.policy = &fakePermissionLister{byRole: superadminPolicy}
+	logic.config = &config.Config{
+		Menu: config.MenuConfig{ClientProductLines: map[string]string{"nurse-mobile": "nurse_app"}},
+	}
+	ctx := context.Background()
+
+	userID := uuid.New().String()
+	roleID := uuid.New()
+
+	menus := newVersion(1, "h1").
+		add("ward_round", "查房", "", 0).
+		add("handover", "交班", "", 1).
+		menus
+	for _, m := range menus {
+		m.ProductLine = "nurse_app"
+	}
+
+	mocks.AssignmentRepo.EXPECT().
+		GetActiveRoleIDsWithStatus(ctx, userID, gomock.Nil(), models.RoleStatusActive).
+		Return([]string{roleID.String()}, nil)
+	mocks.DefinitionRepo.EXPECT().
+		BatchGetByIDs(ctx, []string{roleID.String()}).
+		Return([]*models.RoleDefinition{{RoleCode: "role:nurse"}}, nil)
+	mocks.RoleMenuRepo.EXPECT().
+		GetMergedPermissions(ctx, []uuid.UUID{roleID}, "nurse_app").
+		Return([]models.MenuPermissionInfo{{MenuID: "ward_round", PermissionType: models.PermissionView}}, nil)
+	mocks.MenuRepo.EXPECT().GetLatestMenuTree(ctx, "nurse_app").Return(menus, nil)
+
+	resp, err := logic.GetUserMenuTree(ctx, &identity_srv.GetUserMenuTreeRequest{
+		UserID:   &userID,
+		ClientID: convutil.StringPtr("nurse-mobile"),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, resp.MenuTree, 1)
+	assert.Equal(t, "ward_round", resp.MenuTree[0].GetId())
+}
+
+// ============================================================================
+// 全量菜单授权测试（policy_srv 通配策略 / 紧急账号）
+// ============================================================================
+
+func TestLogicImpl_GetUserMenuTree_PolicyFullAccess(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New().String()
+	roleID := uuid.New().String()
+
+	menus := newVersion(1, "h1").
+		add("system", "系统管理", "", 0).
+		add("users", "用户管理", "system", 0).
+		menus
+
+	t.Run("通配策略返回完整菜单树且不查询角色授权", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		logic.userRoleAssignmentDA = mocks.AssignmentRepo
+		logic.policy = &fakePermissionLister{byRole: superadminPolicy}
+
+		mocks.AssignmentRepo.EXPECT().
+			GetActiveRoleIDsWithStatus(ctx, userID, gomock.Nil(), models.RoleStatusActive).
+			Return([]string{roleID}, nil)
+		mocks.DefinitionRepo.EXPECT().
+			BatchGetByIDs(ctx, []string{roleID}).
+			Return([]*models.RoleDefinition{{Name: "superadmin", RoleCode: "role:superadmin"}}, nil)
+		mocks.MenuRepo.EXPECT().GetLatestMenuTree(ctx, "default").Return(menus, nil)
+
+		resp, err := logic.GetUserMenuTree(ctx, &identity_srv.GetUserMenuTreeRequest{UserID: &userID})
+		require.NoError(t, err)
+
+		assert.Len(t, resp.MenuTree, len(menus))
+	})
+
+	t.Run("角色名为 superadmin 但无通配策略时按角色授权过滤", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		logic.userRoleAssignmentDA = mocks.AssignmentRepo
+		logic.policy = &fakePermissionLister{}
+
+		mocks.AssignmentRepo.EXPECT().
+			GetActiveRoleIDsWithStatus(ctx, userID, gomock.Nil(), models.RoleStatusActive).
+			Return([]string{roleID}, nil)
+		mocks.DefinitionRepo.EXPECT().
+			BatchGetByIDs(ctx, []string{roleID}).
+			Return([]*models.RoleDefinition{{Name: "superadmin", RoleCode: "role:superadmin"}}, nil)
+		mocks.RoleMenuRepo.EXPECT().
+			GetMergedPermissions(ctx, gomock.Len(1), "default").
+			Return(nil, nil)
+		mocks.MenuRepo.EXPECT().GetLatestMenuTree(ctx, "default").Return(menus, nil)
+
+		resp, err := logic.GetUserMenuTree(ctx, &identity_srv.GetUserMenuTreeRequest{UserID: &userID})
+		require.NoError(t, err)
+		assert.Empty(t, resp.MenuTree)
+	})
+
+	t.Run("policy_srv 不可用时失败而非放行", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		logic.userRoleAssignmentDA = mocks.AssignmentRepo
+		logic.policy = &fakePermissionLister{err: errors.New("unavailable")}
+
+		mocks.AssignmentRepo.EXPECT().
+			GetActiveRoleIDsWithStatus(ctx, userID, gomock.Nil(), models.RoleStatusActive).
+			Return([]string{roleID}, nil)
+		mocks.DefinitionRepo.EXPECT().
+			BatchGetByIDs(ctx, []string{roleID}).
+			Return([]*models.RoleDefinition{{RoleCode: "role:superadmin"}}, nil)
+
+		_, err := logic.GetUserMenuTree(ctx, &identity_srv.GetUserMenuTreeRequest{UserID: &userID})
+		assertErrCode(t, errno.ErrOperationFailed, err)
+	})
+}
+
+// newBreakGlassGuard 创建以 userID 为紧急账号的守卫
+func newBreakGlassGuard(t *testing.T, userID string, mocks *mock.TestMocks) *breakglass.Guard {
+	t.Helper()
+
+	guard, err := breakglass.NewGuard(config.BreakGlassConfig{UserIDs: []string{userID}}, mocks.AuditLogRepo)
+	require.NoError(t, err)
+
+	return guard
+}
+
+func TestLogicImpl_GetUserMenuPermissions_BreakGlass(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New().String()
+
+	menus := newVersion(1, "h1").
+		add("system", "系统管理", "", 0).
+		menus
+
+	t.Run("紧急账号不经策略获得全部菜单并记录审计", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		policy := &fakePermissionLister{err: errors.New("unavailable")}
+		logic.userRoleAssignmentDA = mocks.AssignmentRepo
+		logic.policy = policy
+		logic.breakGlass = newBreakGlassGuard(t, userID, mocks)
+
+		mocks.AssignmentRepo.EXPECT().
+			GetActiveRoleIDsWithStatus(ctx, userID, gomock.Nil(), models.RoleStatusActive).
+			Return([]string{}, nil)
+		mocks.UserRepo.EXPECT().GetByID(ctx, userID).Return(&models.UserProfile{Username: "emergency"}, nil)
+		mocks.AuditLogRepo.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+				assert.Equal(t, models.AuditActionBreakGlass, log.Action)
+				assert.Equal(t, "emergency", log.Username)
+				assert.Equal(t, "menu", log.Resource)
+
+				return nil
+			})
+		mocks.MenuRepo.EXPECT().GetLatestMenuTree(ctx, "default").Return(menus, nil)
+
+		resp, err := logic.GetUserMenuPermissions(ctx, &identity_srv.GetUserMenuPermissionsRequest{UserID: &userID})
+		require.NoError(t, err)
+
+		require.Len(t, resp.Permissions, 1)
+		assert.Equal(t, identity_srv.PermissionLevel_PERMISSION_LEVEL_FULL, resp.Permissions[0].GetPermission())
+		assert.Zero(t, policy.calls)
+	})
+
+	t.Run("审计写入失败时拒绝", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		logic.userRoleAssignmentDA = mocks.AssignmentRepo
+		logic.breakGlass = newBreakGlassGuard(t, userID, mocks)
+
+		mocks.AssignmentRepo.EXPECT().
+			GetActiveRoleIDsWithStatus(ctx, userID, gomock.Nil(), models.RoleStatusActive).
+			Return([]string{}, nil)
+		mocks.UserRepo.EXPECT().GetByID(ctx, userID).Return(&models.UserProfile{Username: "emergency"}, nil)
+		mocks.AuditLogRepo.EXPECT().Create(ctx, gomock.Any()).Return(errors.New("db down"))
+
+		_, err := logic.GetUserMenuPermissions(ctx, &identity_srv.GetUserMenuPermissionsRequest{UserID: &userID})
+		assertErrCode(t, errno.ErrOperationFailed, err)
+	})
+}
+
+func TestLogicImpl_HasMenuPermission_PolicyFullAccess(t *testing.T) {
+	logic, mocks := setupTest(t)
+	logic.policy = &fakePermissionLister{byRole: superadminPolicy}
+	ctx := context.Background()
+	roleID := uuid.New().String()
+
+	mocks.DefinitionRepo.EXPECT().
+		BatchGetByIDs(ctx, []string{roleID}).
+		Return([]*models.RoleDefinition{{RoleCode: "role:superadmin"}}, nil)
+
+	resp, err := logic.HasMenuPermission(ctx, &identity_srv.HasMenuPermissionRequest{
+		RoleID: &roleID,
+		MenuID: convutil.StringPtr("any_menu"),
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.GetHasPermission())
+}
+
+// ============================================================================
+// ConfigureRoleMenus 测试
+// ============================================================================
+
+func TestLogicImpl_ConfigureRoleMenus_ProductLine(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+	roleID := uuid.New()
+
+	mocks.RoleMenuRepo.EXPECT().
+		SyncRoleMenus(ctx, roleID, "nurse_app", gomock.Len(1)).
+		Return(nil)
+
+	resp, err := logic.ConfigureRoleMenus(ctx, &identity_srv.ConfigureRoleMenusRequest{
+		RoleID:      convutil.StringPtr(roleID.String()),
+		ProductLine: convutil.StringPtr("nurse_app"),
+		MenuConfigs: []*identity_srv.MenuConfig{{MenuID: convutil.StringPtr("ward_round")}},
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.GetSuccess())
+}
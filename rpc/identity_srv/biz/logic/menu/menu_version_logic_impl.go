@@ -0,0 +1,539 @@
+package menu
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/convutil"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/menu"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+)
+
+// =============================================================================
+// 菜单版本管理
+// 最新版本即生效版本：回滚不修改历史记录，而是把目标版本的内容复制为新的最新版本，
+// 因此 GetLatestMenuTree 等按最新版本读取的逻辑无需感知回滚。
+// 角色菜单授权以 SemanticID 关联菜单，跨版本自动沿用；版本切换后不再存在的
+// SemanticID 上的授权即为失效授权。
+// =============================================================================
+
+// ListMenuVersions 列出产品线的菜单版本历史
+func (l *LogicImpl) ListMenuVersions(
+	ctx context.Context,
+	req *identity_srv.ListMenuVersionsRequest,
+) (*identity_srv.ListMenuVersionsResponse, error) {
+	productLine := resolveProductLine(req.ProductLine)
+
+	summaries, err := l.dal.Menu().ListVersions(ctx, productLine)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("获取菜单版本列表失败: %s", err.Error()))
+	}
+
+	// 记录每个内容哈希最早出现的版本，用于标识回滚或重复上传产生的版本
+	firstByHash := make(map[string]int, len(summaries))
+	for _, s := range summaries {
+		if v, ok := firstByHash[s.ContentHash]; !ok || s.Version < v {
+			firstByHash[s.ContentHash] = s.Version
+		}
+	}
+
+	resp := &identity_srv.ListMenuVersionsResponse{
+		Versions: make([]*identity_srv.MenuVersionInfo, 0, len(summaries)),
+	}
+
+	for i, s := range summaries {
+		info := &identity_srv.MenuVersionInfo{
+			Version:     convutil.Int32Ptr(int32(s.Version)),
+			ContentHash: convutil.StringPtr(s.ContentHash),
+			NodeCount:   convutil.Int32Ptr(int32(s.NodeCount)),
+			CreatedAt:   convutil.Int64Ptr(s.CreatedAt),
+			Active:      convutil.BoolPtr(i == 0),
+		}
+
+		if s.CreatedBy != nil {
+			info.CreatedBy = convutil.StringPtr(s.CreatedBy.String())
+		}
+
+		if first := firstByHash[s.ContentHash]; first != s.Version {
+			info.SameContentAs = convutil.Int32Ptr(int32(first))
+		}
+
+		resp.Versions = append(resp.Versions, info)
+	}
+
+	if len(summaries) > 0 {
+		resp.ActiveVersion = convutil.Int32Ptr(int32(summaries[0].Version))
+	}
+
+	return resp, nil
+}
+
+// DiffMenuVersions 比较两个菜单版本，并报告切换后将失效的角色菜单授权
+func (l *LogicImpl) DiffMenuVersions(
+	ctx context.Context,
+	req *identity_srv.DiffMenuVersionsRequest,
+) (*identity_srv.DiffMenuVersionsResponse, error) {
+	if req.ToVersion == nil || *req.ToVersion <= 0 {
+		return nil, errno.ErrInvalidParams.WithMessage("目标版本号不能为空")
+	}
+
+	productLine := resolveProductLine(req.ProductLine)
+
+	fromVersion := int(convutil.Int32Value(req.FromVersion))
+	if fromVersion <= 0 {
+		maxVersion, err := l.dal.Menu().GetMaxVersion(ctx, productLine)
+		if err != nil {
+			return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("获取版本号失败: %s", err.Error()))
+		}
+
+		fromVersion = maxVersion
+	}
+
+	fromMenus, err := l.loadMenuVersion(ctx, productLine, fromVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	toMenus, err := l.loadMenuVersion(ctx, productLine, int(*req.ToVersion))
+	if err != nil {
+		return nil, err
+	}
+
+	changes, removed := diffMenus(fromMenus, toMenus)
+
+	orphaned, err := l.findOrphanedGrants(ctx, productLine, removed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity_srv.DiffMenuVersionsResponse{
+		FromVersion:    convutil.Int32Ptr(int32(fromVersion)),
+		ToVersion:      req.ToVersion,
+		Changes:        changes,
+		OrphanedGrants: orphaned,
+	}, nil
+}
+
+// ActivateMenuVersion 恢复历史菜单版本
+// 目标版本的内容被复制为新的最新版本，原有版本记录保持不变。
+func (l *LogicImpl) ActivateMenuVersion(
+	ctx context.Context,
+	req *identity_srv.ActivateMenuVersionRequest,
+) (*identity_srv.ActivateMenuVersionResponse, error) {
+	if req.Version == nil || *req.Version <= 0 {
+		return nil, errno.ErrInvalidParams.WithMessage("版本号不能为空")
+	}
+
+	productLine := resolveProductLine(req.ProductLine)
+
+	maxVersion, err := l.dal.Menu().GetMaxVersion(ctx, productLine)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("获取版本号失败: %s", err.Error()))
+	}
+
+	target, err := l.loadMenuVersion(ctx, productLine, int(*req.Version))
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := l.loadMenuVersion(ctx, productLine, maxVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, removed := diffMenus(current, target)
+
+	orphaned, err := l.findOrphanedGrants(ctx, productLine, removed)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &identity_srv.ActivateMenuVersionResponse{
+		ActiveVersion:  convutil.Int32Ptr(int32(maxVersion)),
+		Activated:      convutil.BoolPtr(false),
+		Changes:        changes,
+		OrphanedGrants: orphaned,
+	}
+
+	// 目标内容与当前生效版本一致时无需切换
+	if convutil.BoolValue(req.DryRun) || target[0].ContentHash == current[0].ContentHash {
+		return resp, nil
+	}
+
+	newVersion := maxVersion + 1
+
+	cloned := cloneMenuVersion(target, newVersion, parseOperatorID(req.OperatorID))
+	if err := l.dal.Menu().CreateMenuTree(ctx, cloned); err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("恢复菜单版本失败: %s", err.Error()))
+	}
+
+	resp.ActiveVersion = convutil.Int32Ptr(int32(newVersion))
+	resp.Activated = convutil.BoolPtr(true)
+
+	return resp, nil
+}
+
+// loadMenuVersion 加载指定版本的全部节点，版本不存在时返回参数错误
+func (l *LogicImpl) loadMenuVersion(ctx context.Context, productLine string, version int) ([]*models.Menu, error) {
+	menus, err := l.dal.Menu().GetMenusByVersion(ctx, productLine, version)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("获取菜单版本失败: %s", err.Error()))
+	}
+
+	if len(menus) == 0 {
+		return nil, errno.ErrInvalidParams.WithMessage(fmt.Sprintf("菜单版本 %d 不存在", version))
+	}
+
+	return menus, nil
+}
+
+// findOrphanedGrants 查询引用了被移除菜单的角色菜单授权
+func (l *LogicImpl) findOrphanedGrants(
+	ctx context.Context,
+	productLine string,
+	removedIDs []string,
+) ([]*identity_srv.OrphanedMenuGrant, error) {
+	if len(removedIDs) == 0 {
+		return []*identity_srv.OrphanedMenuGrant{}, nil
+	}
+
+	grants, err := l.dal.RoleMenuPermission().GetByMenuIDs(ctx, productLine, removedIDs)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("查询角色菜单授权失败: %s", err.Error()))
+	}
+
+	result := make([]*identity_srv.OrphanedMenuGrant, 0, len(grants))
+	for _, g := range grants {
+		item := &identity_srv.OrphanedMenuGrant{
+			RoleID:     convutil.StringPtr(g.RoleID.String()),
+			MenuID:     convutil.StringPtr(g.MenuID),
+			Permission: toThriftPermissionLevelPtr(g.PermissionType),
+		}
+
+		if g.Role != nil {
+			item.RoleName = convutil.StringPtr(g.Role.Name)
+		}
+
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+// resolveProductLine 解析请求中的产品线，未指定时使用默认产品线
+func resolveProductLine(productLine *string) string {
+	if productLine != nil && *productLine != "" {
+		return *productLine
+	}
+
+	return menu.DefaultProductLine
+}
+
+// resolveUserProductLine 解析用户菜单请求的产品线
+// 显式指定的产品线优先；否则按配置把客户端标识（不区分大小写）映射为产品线，未知的客户端标识返回参数错误。
+func (l *LogicImpl) resolveUserProductLine(productLine, clientID *string) (string, error) {
+	if productLine != nil && *productLine != "" {
+		return *productLine, nil
+	}
+
+	if clientID == nil || *clientID == "" {
+		return menu.DefaultProductLine, nil
+	}
+
+	if l.config != nil {
+		for id, line := range l.config.Menu.ClientProductLines {
+			if strings.EqualFold(id, *clientID) {
+				return line, nil
+			}
+		}
+	}
+
+	return "", errno.ErrInvalidParams.WithMessage(fmt.Sprintf("未知的客户端标识: %s", *clientID))
+}
+
+// parseOperatorID 解析操作人ID，格式无效时视为未指定
+func parseOperatorID(operatorID *string) *uuid.UUID {
+	if operatorID == nil {
+		return nil
+	}
+
+	id, err := uuid.Parse(*operatorID)
+	if err != nil {
+		return nil
+	}
+
+	return &id
+}
+
+// cloneMenuVersion 将菜单版本复制为新版本
+// 重新分配节点与 API 路径的主键并改写父子关系，按父节点在前的顺序返回以满足外键约束。
+func cloneMenuVersion(menus []*models.Menu, version int, operatorID *uuid.UUID) []*models.Menu {
+	ordered := FlattenMenuTree(menus)
+	idMap := make(map[uuid.UUID]uuid.UUID, len(ordered))
+
+	cloned := make([]*models.Menu, 0, len(ordered))
+	for _, m := range ordered {
+		newID := uuid.New()
+		idMap[m.ID] = newID
+
+		c := &models.Menu{
+			ProductLine: m.ProductLine,
+			SemanticID:  m.SemanticID,
+			Version:     version,
+			ContentHash: m.ContentHash,
+			Name:        m.Name,
+			Path:        m.Path,
+			Component:   m.Component,
+			Icon:        m.Icon,
+			Sort:        m.Sort,
+			CreatedBy:   operatorID,
+			PermCode:    m.PermCode,
+			ApiPath:     m.ApiPath,
+			IsButton:    m.IsButton,
+		}
+		c.ID = newID
+
+		if m.ParentID != nil {
+			parentID := idMap[*m.ParentID]
+			c.ParentID = &parentID
+		}
+
+		for _, p := range m.ApiPaths {
+			c.ApiPaths = append(c.ApiPaths, &models.MenuApiPath{
+				ID:     uuid.New(),
+				MenuID: newID,
+				Path:   p.Path,
+				Sort:   p.Sort,
+			})
+		}
+
+		cloned = append(cloned, c)
+	}
+
+	return cloned
+}
+
+// FlattenMenuTree 按深度优先（父节点在前、同级按排序）展开平铺的菜单节点
+func FlattenMenuTree(menus []*models.Menu) []*models.Menu {
+	sorted := slices.Clone(menus)
+	slices.SortStableFunc(sorted, func(a, b *models.Menu) int { return a.Sort - b.Sort })
+
+	children := make(map[uuid.UUID][]*models.Menu, len(sorted))
+	ids := make(map[uuid.UUID]bool, len(sorted))
+
+	for _, m := range sorted {
+		ids[m.ID] = true
+	}
+
+	var roots []*models.Menu
+
+	for _, m := range sorted {
+		if m.ParentID == nil || !ids[*m.ParentID] {
+			roots = append(roots, m)
+			continue
+		}
+
+		children[*m.ParentID] = append(children[*m.ParentID], m)
+	}
+
+	result := make([]*models.Menu, 0, len(sorted))
+
+	var walk func(nodes []*models.Menu)
+	walk = func(nodes []*models.Menu) {
+		for _, n := range nodes {
+			result = append(result, n)
+			walk(children[n.ID])
+		}
+	}
+	walk(roots)
+
+	return result
+}
+
+// diffMenus 以 SemanticID 为键比较两个版本的菜单
+// 返回差异项（先按新版本顺序列出新增和变化的节点，再按旧版本顺序列出移除的节点）
+// 以及被移除节点的 SemanticID 列表。
+func diffMenus(from, to []*models.Menu) ([]*identity_srv.MenuDiffEntry, []string) {
+	from = FlattenMenuTree(from)
+	to = FlattenMenuTree(to)
+
+	fromByID := indexBySemanticID(from)
+	toByID := indexBySemanticID(to)
+	fromParents := parentSemanticIDs(from)
+	toParents := parentSemanticIDs(to)
+	fromRanks := keptSiblingRanks(from, fromParents, toByID, toParents)
+	toRanks := keptSiblingRanks(to, toParents, fromByID, fromParents)
+
+	changes := make([]*identity_srv.MenuDiffEntry, 0)
+
+	for _, n := range to {
+		o, ok := fromByID[n.SemanticID]
+		if !ok {
+			changes = append(changes, &identity_srv.MenuDiffEntry{
+				SemanticID:  convutil.StringPtr(n.SemanticID),
+				Changes:     []identity_srv.MenuChangeType{identity_srv.MenuChangeType_MENU_CHANGE_TYPE_ADDED},
+				NewName:     convutil.StringPtr(n.Name),
+				NewParentID: optionalString(toParents[n.SemanticID]),
+				IsButton:    convutil.BoolPtr(n.IsButton),
+			})
+
+			continue
+		}
+
+		var types []identity_srv.MenuChangeType
+
+		if fromParents[n.SemanticID] != toParents[n.SemanticID] {
+			types = append(types, identity_srv.MenuChangeType_MENU_CHANGE_TYPE_MOVED)
+		}
+
+		if o.Name != n.Name {
+			types = append(types, identity_srv.MenuChangeType_MENU_CHANGE_TYPE_RENAMED)
+		}
+
+		fields := changedMenuFields(o, n)
+		if fromRanks[n.SemanticID] != toRanks[n.SemanticID] {
+			fields = append(fields, "sort")
+		}
+
+		if len(fields) > 0 {
+			types = append(types, identity_srv.MenuChangeType_MENU_CHANGE_TYPE_MODIFIED)
+		}
+
+		if len(types) == 0 {
+			continue
+		}
+
+		changes = append(changes, &identity_srv.MenuDiffEntry{
+			SemanticID:    convutil.StringPtr(n.SemanticID),
+			Changes:       types,
+			OldName:       convutil.StringPtr(o.Name),
+			NewName:       convutil.StringPtr(n.Name),
+			OldParentID:   optionalString(fromParents[n.SemanticID]),
+			NewParentID:   optionalString(toParents[n.SemanticID]),
+			ChangedFields: fields,
+			IsButton:      convutil.BoolPtr(n.IsButton),
+		})
+	}
+
+	removed := make([]string, 0)
+
+	for _, o := range from {
+		if _, ok := toByID[o.SemanticID]; ok {
+			continue
+		}
+
+		removed = append(removed, o.SemanticID)
+		changes = append(changes, &identity_srv.MenuDiffEntry{
+			SemanticID:  convutil.StringPtr(o.SemanticID),
+			Changes:     []identity_srv.MenuChangeType{identity_srv.MenuChangeType_MENU_CHANGE_TYPE_REMOVED},
+			OldName:     convutil.StringPtr(o.Name),
+			OldParentID: optionalString(fromParents[o.SemanticID]),
+			IsButton:    convutil.BoolPtr(o.IsButton),
+		})
+	}
+
+	return changes, removed
+}
+
+// changedMenuFields 返回名称与父节点以外发生变化的字段
+func changedMenuFields(o, n *models.Menu) []string {
+	var fields []string
+
+	if o.Path != n.Path {
+		fields = append(fields, "path")
+	}
+
+	if o.Component != n.Component {
+		fields = append(fields, "component")
+	}
+
+	if o.Icon != n.Icon {
+		fields = append(fields, "icon")
+	}
+
+	if o.GetCasbinObject() != n.GetCasbinObject() {
+		fields = append(fields, "perm_code")
+	}
+
+	if !slices.Equal(o.GetApiPaths(), n.GetApiPaths()) {
+		fields = append(fields, "api_paths")
+	}
+
+	if o.IsButton != n.IsButton {
+		fields = append(fields, "is_button")
+	}
+
+	return fields
+}
+
+// indexBySemanticID 按 SemanticID 索引菜单节点
+func indexBySemanticID(menus []*models.Menu) map[string]*models.Menu {
+	index := make(map[string]*models.Menu, len(menus))
+	for _, m := range menus {
+		index[m.SemanticID] = m
+	}
+
+	return index
+}
+
+// parentSemanticIDs 返回每个节点父节点的 SemanticID，顶级节点为空字符串
+func parentSemanticIDs(menus []*models.Menu) map[string]string {
+	byID := make(map[uuid.UUID]string, len(menus))
+	for _, m := range menus {
+		byID[m.ID] = m.SemanticID
+	}
+
+	parents := make(map[string]string, len(menus))
+	for _, m := range menus {
+		if m.ParentID != nil {
+			parents[m.SemanticID] = byID[*m.ParentID]
+		} else {
+			parents[m.SemanticID] = ""
+		}
+	}
+
+	return parents
+}
+
+// keptSiblingRanks 计算在另一版本中父节点不变的节点在同级保留节点中的位次
+// 只比较这些节点的相对顺序，避免新增或删除兄弟节点导致大量误报排序变化。
+func keptSiblingRanks(
+	menus []*models.Menu,
+	parents map[string]string,
+	other map[string]*models.Menu,
+	otherParents map[string]string,
+) map[string]int {
+	ranks := make(map[string]int, len(menus))
+	next := make(map[string]int)
+
+	for _, m := range menus {
+		if _, ok := other[m.SemanticID]; !ok {
+			continue
+		}
+
+		parent := parents[m.SemanticID]
+		if otherParents[m.SemanticID] != parent {
+			continue
+		}
+
+		ranks[m.SemanticID] = next[parent]
+		next[parent]++
+	}
+
+	return ranks
+}
+
+// optionalString 空字符串返回 nil
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
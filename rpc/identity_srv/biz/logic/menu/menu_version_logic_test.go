@@ -0,0 +1,382 @@
+package menu
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/convutil"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/mock"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+)
+
+// setupTest 初始化测试环境
+func setupTest(t *testing.T) (*LogicImpl, *mock.TestMocks) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mocks := mock.NewTestMocks(ctrl)
+	logic := &LogicImpl{
+		dal:       mocks.DAL,
+		converter: mocks.Converter,
+	}
+
+	return logic, mocks
+}
+
+// assertErrCode 断言错误码匹配
+func assertErrCode(t *testing.T, expected errno.ErrNo, actual error) {
+	t.Helper()
+
+	errNo, ok := actual.(errno.ErrNo)
+	require.True(t, ok, "expected errno.ErrNo, got %T: %v", actual, actual)
+	assert.Equal(t, expected.ErrCode, errNo.ErrCode)
+}
+
+// menuVersionBuilder 构造某一版本的平铺菜单节点
+type menuVersionBuilder struct {
+	version int
+	hash    string
+	menus   []*models.Menu
+	ids     map[string]uuid.UUID
+}
+
+func newVersion(version int, hash string) *menuVersionBuilder {
+	return &menuVersionBuilder{version: version, hash: hash, ids: map[string]uuid.UUID{}}
+}
+
+func (b *menuVersionBuilder) add(semanticID, name, parent string, sort int, apiPaths ...string) *menuVersionBuilder {
+	m := &models.Menu{
+		ProductLine: "default",
+		SemanticID:  semanticID,
+		Version:     b.version,
+		ContentHash: b.hash,
+		Name:        name,
+		Path:        "/" + semanticID,
+		Sort:        sort,
+	}
+	m.ID = uuid.New()
+
+	if parent != "" {
+		parentID := b.ids[parent]
+		m.ParentID = &parentID
+	}
+
+	for i, p := range apiPaths {
+		m.ApiPaths = append(m.ApiPaths, &models.MenuApiPath{ID: uuid.New(), MenuID: m.ID, Path: p, Sort: i})
+	}
+
+	b.ids[semanticID] = m.ID
+	b.menus = append(b.menus, m)
+
+	return b
+}
+
+func changeOf(changes []*identity_srv.MenuDiffEntry, semanticID string) *identity_srv.MenuDiffEntry {
+	for _, c := range changes {
+		if c.GetSemanticID() == semanticID {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// ============================================================================
+// diffMenus 测试
+// ============================================================================
+
+func TestDiffMenus(t *testing.T) {
+	from := newVersion(1, "h1").
+		add("system", "系统管理", "", 0).
+		add("users", "用户管理", "system", 0, "/api/v1/identity/users").
+		add("roles", "角色管理", "system", 1).
+		add("logs", "日志", "", 1).
+		menus
+
+	to := newVersion(2, "h2").
+		add("system", "系统管理", "", 0).
+		add("logs", "审计日志", "system", 0).
+		add("users", "用户管理", "system", 1, "/api/v1/identity/users", "/api/v1/identity/users/import").
+		add("reports", "报表", "", 1).
+		menus
+
+	changes, removed := diffMenus(from, to)
+
+	assert.Equal(t, []string{"roles"}, removed)
+	require.Len(t, changes, 4)
+
+	logs := changeOf(changes, "logs")
+	require.NotNil(t, logs)
+	assert.Equal(t, []identity_srv.MenuChangeType{
+		identity_srv.MenuChangeType_MENU_CHANGE_TYPE_MOVED,
+		identity_srv.MenuChangeType_MENU_CHANGE_TYPE_RENAMED,
+	}, logs.Changes)
+	assert.Equal(t, "日志", logs.GetOldName())
+	assert.Equal(t, "审计日志", logs.GetNewName())
+	assert.Nil(t, logs.OldParentID)
+	assert.Equal(t, "system", logs.GetNewParentID())
+
+	users := changeOf(changes, "users")
+	require.NotNil(t, users)
+	assert.Equal(t, []identity_srv.MenuChangeType{identity_srv.MenuChangeType_MENU_CHANGE_TYPE_MODIFIED}, users.Changes)
+	assert.Equal(t, []string{"api_paths"}, users.ChangedFields, "新增兄弟节点引起的序号变化不应计入排序变化")
+
+	reports := changeOf(changes, "reports")
+	require.NotNil(t, reports)
+	assert.Equal(t, []identity_srv.MenuChangeType{identity_srv.MenuChangeType_MENU_CHANGE_TYPE_ADDED}, reports.Changes)
+
+	roles := changes[len(changes)-1]
+	assert.Equal(t, "roles", roles.GetSemanticID())
+	assert.Equal(t, []identity_srv.MenuChangeType{identity_srv.MenuChangeType_MENU_CHANGE_TYPE_REMOVED}, roles.Changes)
+	assert.Equal(t, "system", roles.GetOldParentID())
+
+	assert.Nil(t, changeOf(changes, "system"), "未变化的节点不应出现在差异中")
+}
+
+func TestDiffMenus_Reorder(t *testing.T) {
+	from := newVersion(1, "h1").
+		add("a", "A", "", 0).
+		add("b", "B", "", 1).
+		menus
+	to := newVersion(2, "h2").
+		add("b", "B", "", 0).
+		add("a", "A", "", 1).
+		menus
+
+	changes, removed := diffMenus(from, to)
+
+	assert.Empty(t, removed)
+	require.Len(t, changes, 2)
+
+	for _, c := range changes {
+		assert.Equal(t, []string{"sort"}, c.ChangedFields)
+	}
+}
+
+// ============================================================================
+// cloneMenuVersion 测试
+// ============================================================================
+
+func TestCloneMenuVersion(t *testing.T) {
+	// 子节点排在父节点之前，复制结果应调整为父节点在前
+	source := newVersion(3, "h3").
+		add("system", "系统管理", "", 1).
+		add("users", "用户管理", "system", 0, "/api/v1/identity/users").
+		menus
+	source[0], source[1] = source[1], source[0]
+
+	operatorID := uuid.New()
+	cloned := cloneMenuVersion(source, 7, &operatorID)
+
+	require.Len(t, cloned, 2)
+	assert.Equal(t, "system", cloned[0].SemanticID)
+	assert.Equal(t, "users", cloned[1].SemanticID)
+
+	for i, c := range cloned {
+		assert.Equal(t, 7, c.Version)
+		assert.Equal(t, "h3", c.ContentHash)
+		assert.Equal(t, &operatorID, c.CreatedBy)
+		assert.NotEqual(t, source[1-i].ID, c.ID, "复制的节点应使用新主键")
+	}
+
+	require.NotNil(t, cloned[1].ParentID)
+	assert.Equal(t, cloned[0].ID, *cloned[1].ParentID)
+	require.Len(t, cloned[1].ApiPaths, 1)
+	assert.Equal(t, cloned[1].ID, cloned[1].ApiPaths[0].MenuID)
+	assert.Equal(t, "/api/v1/identity/users", cloned[1].ApiPaths[0].Path)
+}
+
+// ============================================================================
+// UploadMenu 测试
+// ============================================================================
+
+func TestLogicImpl_UploadMenu(t *testing.T) {
+	yaml := "menu:\n  - id: system\n    name: 系统管理\n    path: /system\n"
+
+	current := newVersion(2, "old").
+		add("system", "系统管理", "", 0).
+		add("legacy", "旧菜单", "", 1).
+		menus
+
+	roleID := uuid.New()
+	grants := []*models.RoleMenuPermission{{
+		RoleID:         roleID,
+		MenuID:         "legacy",
+		PermissionType: models.PermissionView,
+		Role:           &models.RoleDefinition{Name: "审计员"},
+	}}
+
+	t.Run("试运行返回差异和将失效的授权且不写入", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		mocks.MenuRepo.EXPECT().GetMaxVersion(ctx, "default").Return(2, nil)
+		mocks.MenuRepo.EXPECT().GetMenusByVersion(ctx, "default", 2).Return(current, nil)
+		mocks.RoleMenuRepo.EXPECT().GetByMenuIDs(ctx, "default", []string{"legacy"}).Return(grants, nil)
+
+		resp, err := logic.UploadMenu(ctx, &identity_srv.UploadMenuRequest{
+			YamlContent: &yaml,
+			DryRun:      convutil.BoolPtr(true),
+		})
+		require.NoError(t, err)
+
+		assert.False(t, resp.GetCreated())
+		assert.Equal(t, int32(2), resp.GetVersion())
+		require.Len(t, resp.Changes, 1)
+		assert.Equal(t, "legacy", resp.Changes[0].GetSemanticID())
+		require.Len(t, resp.OrphanedGrants, 1)
+		assert.Equal(t, roleID.String(), resp.OrphanedGrants[0].GetRoleID())
+		assert.Equal(t, "审计员", resp.OrphanedGrants[0].GetRoleName())
+		assert.Equal(t, identity_srv.PermissionLevel_PERMISSION_LEVEL_READ, resp.OrphanedGrants[0].GetPermission())
+	})
+
+	t.Run("创建新版本并记录操作人", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+		operatorID := uuid.New()
+
+		mocks.MenuRepo.EXPECT().GetMaxVersion(ctx, "default").Return(2, nil)
+		mocks.MenuRepo.EXPECT().GetMenusByVersion(ctx, "default", 2).Return(current, nil)
+		mocks.RoleMenuRepo.EXPECT().GetByMenuIDs(ctx, "default", []string{"legacy"}).Return(nil, nil)
+		mocks.MenuRepo.EXPECT().CreateMenuTree(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, menus []*models.Menu) error {
+				require.Len(t, menus, 1)
+				assert.Equal(t, 3, menus[0].Version)
+				assert.Equal(t, &operatorID, menus[0].CreatedBy)
+
+				return nil
+			})
+
+		resp, err := logic.UploadMenu(ctx, &identity_srv.UploadMenuRequest{
+			YamlContent: &yaml,
+			OperatorID:  convutil.StringPtr(operatorID.String()),
+		})
+		require.NoError(t, err)
+
+		assert.True(t, resp.GetCreated())
+		assert.Equal(t, int32(3), resp.GetVersion())
+	})
+
+	t.Run("首次上传全部为新增", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		mocks.MenuRepo.EXPECT().GetMaxVersion(ctx, "default").Return(0, nil)
+		mocks.MenuRepo.EXPECT().CreateMenuTree(ctx, gomock.Any()).Return(nil)
+
+		resp, err := logic.UploadMenu(ctx, &identity_srv.UploadMenuRequest{YamlContent: &yaml})
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(1), resp.GetVersion())
+		require.Len(t, resp.Changes, 1)
+		assert.Equal(t, identity_srv.MenuChangeType_MENU_CHANGE_TYPE_ADDED, resp.Changes[0].Changes[0])
+		assert.Empty(t, resp.OrphanedGrants)
+	})
+}
+
+// ============================================================================
+// ListMenuVersions 测试
+// ============================================================================
+
+func TestLogicImpl_ListMenuVersions(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+	operatorID := uuid.New()
+
+	mocks.MenuRepo.EXPECT().ListVersions(ctx, "default").Return([]*models.MenuVersionSummary{
+		{Version: 3, ContentHash: "h1", NodeCount: 4, CreatedAt: 300, CreatedBy: &operatorID},
+		{Version: 2, ContentHash: "h2", NodeCount: 5, CreatedAt: 200},
+		{Version: 1, ContentHash: "h1", NodeCount: 4, CreatedAt: 100},
+	}, nil)
+
+	resp, err := logic.ListMenuVersions(ctx, &identity_srv.ListMenuVersionsRequest{})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(3), resp.GetActiveVersion())
+	require.Len(t, resp.Versions, 3)
+	assert.True(t, resp.Versions[0].GetActive())
+	assert.Equal(t, int32(1), resp.Versions[0].GetSameContentAs())
+	assert.Equal(t, operatorID.String(), resp.Versions[0].GetCreatedBy())
+	assert.False(t, resp.Versions[1].GetActive())
+	assert.Nil(t, resp.Versions[1].SameContentAs)
+	assert.Nil(t, resp.Versions[2].SameContentAs)
+}
+
+// ============================================================================
+// ActivateMenuVersion 测试
+// ============================================================================
+
+func TestLogicImpl_ActivateMenuVersion(t *testing.T) {
+	v1 := newVersion(1, "h1").
+		add("system", "系统管理", "", 0).
+		add("users", "用户管理", "system", 0).
+		menus
+	v2 := newVersion(2, "h2").
+		add("system", "系统管理", "", 0).
+		menus
+
+	t.Run("复制历史版本为新版本", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		mocks.MenuRepo.EXPECT().GetMaxVersion(ctx, "default").Return(2, nil)
+		mocks.MenuRepo.EXPECT().GetMenusByVersion(ctx, "default", 1).Return(v1, nil)
+		mocks.MenuRepo.EXPECT().GetMenusByVersion(ctx, "default", 2).Return(v2, nil)
+		mocks.MenuRepo.EXPECT().CreateMenuTree(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, menus []*models.Menu) error {
+				require.Len(t, menus, 2)
+				assert.Equal(t, 3, menus[0].Version)
+				assert.Equal(t, "h1", menus[0].ContentHash)
+
+				return nil
+			})
+
+		resp, err := logic.ActivateMenuVersion(ctx, &identity_srv.ActivateMenuVersionRequest{
+			Version: convutil.Int32Ptr(1),
+		})
+		require.NoError(t, err)
+
+		assert.True(t, resp.GetActivated())
+		assert.Equal(t, int32(3), resp.GetActiveVersion())
+		require.Len(t, resp.Changes, 1)
+		assert.Equal(t, "users", resp.Changes[0].GetSemanticID())
+		assert.Empty(t, resp.OrphanedGrants)
+	})
+
+	t.Run("目标内容与当前版本相同时不切换", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		mocks.MenuRepo.EXPECT().GetMaxVersion(ctx, "default").Return(2, nil)
+		mocks.MenuRepo.EXPECT().GetMenusByVersion(ctx, "default", 2).Return(v2, nil).Times(2)
+
+		resp, err := logic.ActivateMenuVersion(ctx, &identity_srv.ActivateMenuVersionRequest{
+			Version: convutil.Int32Ptr(2),
+		})
+		require.NoError(t, err)
+
+		assert.False(t, resp.GetActivated())
+		assert.Equal(t, int32(2), resp.GetActiveVersion())
+		assert.Empty(t, resp.Changes)
+	})
+
+	t.Run("版本不存在", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		mocks.MenuRepo.EXPECT().GetMaxVersion(ctx, "default").Return(2, nil)
+		mocks.MenuRepo.EXPECT().GetMenusByVersion(ctx, "default", 9).Return([]*models.Menu{}, nil)
+
+		_, err := logic.ActivateMenuVersion(ctx, &identity_srv.ActivateMenuVersionRequest{
+			Version: convutil.Int32Ptr(9),
+		})
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+}
@@ -0,0 +1,36 @@
+package mfa
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+)
+
+// MFALogic 多因素认证业务逻辑接口
+// 负责 TOTP 登记、确认、校验与停用，以及一次性恢复码的签发与消费
+type MFALogic interface {
+	// EnrollMFA 发起 TOTP 登记，生成新密钥与 otpauth URI（确认前不生效）
+	// 对应 IDL: EnrollMFA(1: EnrollMFARequest req)
+	EnrollMFA(
+		ctx context.Context,
+		req *identity_srv.EnrollMFARequest,
+	) (*identity_srv.EnrollMFAResponse, error)
+
+	// ConfirmMFA 用验证器口令确认登记，启用 MFA 并返回一次性恢复码
+	// 对应 IDL: ConfirmMFA(1: ConfirmMFARequest req)
+	ConfirmMFA(
+		ctx context.Context,
+		req *identity_srv.ConfirmMFARequest,
+	) (*identity_srv.ConfirmMFAResponse, error)
+
+	// VerifyMFA 校验第二因素（TOTP 口令或恢复码）
+	// 对应 IDL: VerifyMFA(1: VerifyMFARequest req)
+	VerifyMFA(
+		ctx context.Context,
+		req *identity_srv.VerifyMFARequest,
+	) (*identity_srv.VerifyMFAResponse, error)
+
+	// DisableMFA 校验第二因素后停用 MFA，并清除全部恢复码
+	// 对应 IDL: DisableMFA(1: DisableMFARequest req)
+	DisableMFA(ctx context.Context, req *identity_srv.DisableMFARequest) error
+}
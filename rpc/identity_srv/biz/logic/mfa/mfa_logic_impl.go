@@ -0,0 +1,294 @@
+package mfa
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+	tracelog "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/log"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/totp"
+)
+
+const (
+	// recoveryCodeCount 每次签发的恢复码数量
+	recoveryCodeCount = 10
+
+	// recoveryCodeBytes 单个恢复码的随机字节数（编码后 8 个字符）
+	recoveryCodeBytes = 5
+
+	// totpSkew 允许的时钟偏差（前后各一个时间步）
+	totpSkew = 1
+)
+
+// recoveryEncoding 恢复码编码：小写 Base32，避免易混淆字符的大小写问题
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// LogicImpl 多因素认证业务逻辑实现
+type LogicImpl struct {
+	dal    dal.DAL
+	issuer string
+
+	// now 当前时间，测试中可替换
+	now func() time.Time
+}
+
+// NewLogic 创建多因素认证业务逻辑实例
+func NewLogic(dal dal.DAL, issuer string) MFALogic {
+	return &LogicImpl{
+		dal:    dal,
+		issuer: issuer,
+		now:    time.Now,
+	}
+}
+
+// EnrollMFA 发起 TOTP 登记
+func (l *LogicImpl) EnrollMFA(
+	ctx context.Context,
+	req *identity_srv.EnrollMFARequest,
+) (*identity_srv.EnrollMFAResponse, error) {
+	userID, err := parseUserID(req.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := l.dal.UserProfile().GetByID(ctx, userID)
+	if err != nil {
+		if errno.IsRecordNotFound(err) {
+			return nil, errno.ErrUserNotFound
+		}
+
+		return nil, errno.ErrOperationFailed.WithMessage("获取用户档案失败: " + err.Error())
+	}
+
+	existing, err := l.dal.MFA().GetByUserID(ctx, userID)
+	if err != nil && !errno.IsRecordNotFound(err) {
+		return nil, errno.ErrOperationFailed.WithMessage("获取MFA配置失败: " + err.Error())
+	}
+
+	if existing != nil && existing.Enabled {
+		return nil, errno.ErrMFAAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(err.Error())
+	}
+
+	if err := l.dal.MFA().SavePending(ctx, userID, secret); err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("保存MFA密钥失败: " + err.Error())
+	}
+
+	uri := totp.ProvisioningURI(l.issuer, user.Username, secret)
+
+	return &identity_srv.EnrollMFAResponse{
+		Secret:          &secret,
+		ProvisioningURI: &uri,
+	}, nil
+}
+
+// ConfirmMFA 确认登记并启用 MFA
+func (l *LogicImpl) ConfirmMFA(
+	ctx context.Context,
+	req *identity_srv.ConfirmMFARequest,
+) (*identity_srv.ConfirmMFAResponse, error) {
+	userID, err := parseUserID(req.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := l.dal.MFA().GetByUserID(ctx, userID)
+	if err != nil {
+		if errno.IsRecordNotFound(err) {
+			return nil, errno.ErrMFAEnrollmentNotStarted
+		}
+
+		return nil, errno.ErrOperationFailed.WithMessage("获取MFA配置失败: " + err.Error())
+	}
+
+	if record.Enabled {
+		return nil, errno.ErrMFAAlreadyEnabled
+	}
+
+	step, ok := totp.Validate(record.Secret, req.GetCode(), l.now(), totpSkew)
+	if !ok {
+		return nil, errno.ErrInvalidMFACode
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("生成恢复码失败: " + err.Error())
+	}
+
+	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		if err := txDAL.MFA().Enable(ctx, userID, step, l.now().UnixMilli()); err != nil {
+			return err
+		}
+
+		return txDAL.MFA().ReplaceRecoveryCodes(ctx, userID, hashes)
+	})
+	if err != nil {
+		if errno.IsRecordNotFound(err) {
+			// 并发确认：另一请求已先启用
+			return nil, errno.ErrMFAAlreadyEnabled
+		}
+
+		return nil, errno.ErrOperationFailed.WithMessage("启用MFA失败: " + err.Error())
+	}
+
+	tracelog.Ctx(ctx).Info().Str("user_id", userID).Msg("用户已启用多因素认证")
+
+	return &identity_srv.ConfirmMFAResponse{RecoveryCodes: codes}, nil
+}
+
+// VerifyMFA 校验第二因素
+func (l *LogicImpl) VerifyMFA(
+	ctx context.Context,
+	req *identity_srv.VerifyMFARequest,
+) (*identity_srv.VerifyMFAResponse, error) {
+	userID, err := parseUserID(req.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+
+	method, err := l.verifySecondFactor(ctx, userID, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	remaining, err := l.dal.MFA().CountUnusedRecoveryCodes(ctx, userID)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("统计可用恢复码失败: " + err.Error())
+	}
+
+	methodStr := string(method)
+	remainingCodes := int32(remaining)
+
+	return &identity_srv.VerifyMFAResponse{
+		Method:                 &methodStr,
+		RemainingRecoveryCodes: &remainingCodes,
+	}, nil
+}
+
+// DisableMFA 停用 MFA
+func (l *LogicImpl) DisableMFA(ctx context.Context, req *identity_srv.DisableMFARequest) error {
+	userID, err := parseUserID(req.GetUserID())
+	if err != nil {
+		return err
+	}
+
+	if _, err := l.verifySecondFactor(ctx, userID, req.GetCode()); err != nil {
+		return err
+	}
+
+	if err := l.dal.MFA().DeleteByUserID(ctx, userID); err != nil {
+		return errno.ErrOperationFailed.WithMessage("停用MFA失败: " + err.Error())
+	}
+
+	tracelog.Ctx(ctx).Info().Str("user_id", userID).Msg("用户已停用多因素认证")
+
+	return nil
+}
+
+// verifySecondFactor 校验 TOTP 口令或恢复码，返回命中的因素类型
+//
+// TOTP 口令通过时间步条件更新防重放；恢复码通过条件更新保证只能使用一次。
+func (l *LogicImpl) verifySecondFactor(
+	ctx context.Context,
+	userID, code string,
+) (models.MFAMethod, error) {
+	record, err := l.dal.MFA().GetByUserID(ctx, userID)
+	if err != nil {
+		if errno.IsRecordNotFound(err) {
+			return "", errno.ErrMFANotEnabled
+		}
+
+		return "", errno.ErrOperationFailed.WithMessage("获取MFA配置失败: " + err.Error())
+	}
+
+	if !record.Enabled {
+		return "", errno.ErrMFANotEnabled
+	}
+
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return "", errno.ErrInvalidParams.WithMessage("验证码不能为空")
+	}
+
+	if step, ok := totp.Validate(record.Secret, code, l.now(), totpSkew); ok {
+		advanced, err := l.dal.MFA().AdvanceLastUsedStep(ctx, userID, step)
+		if err != nil {
+			return "", errno.ErrOperationFailed.WithMessage(err.Error())
+		}
+
+		if !advanced {
+			return "", errno.ErrInvalidMFACode
+		}
+
+		return models.MFAMethodTOTP, nil
+	}
+
+	consumed, err := l.dal.MFA().ConsumeRecoveryCode(ctx, userID, hashRecoveryCode(code), l.now().UnixMilli())
+	if err != nil {
+		return "", errno.ErrOperationFailed.WithMessage(err.Error())
+	}
+
+	if !consumed {
+		return "", errno.ErrInvalidMFACode
+	}
+
+	tracelog.Ctx(ctx).Warn().Str("user_id", userID).Msg("用户使用恢复码完成多因素认证")
+
+	return models.MFAMethodRecoveryCode, nil
+}
+
+// parseUserID 校验用户ID
+func parseUserID(userID string) (string, error) {
+	if userID == "" {
+		return "", errno.ErrInvalidParams.WithMessage("用户ID不能为空")
+	}
+
+	if _, err := uuid.Parse(userID); err != nil {
+		return "", errno.ErrInvalidParams.WithMessage("用户ID格式无效")
+	}
+
+	return userID, nil
+}
+
+// generateRecoveryCodes 生成一组恢复码，返回明文（xxxx-xxxx 格式）与对应哈希
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+
+	for range recoveryCodeCount {
+		buf := make([]byte, recoveryCodeBytes)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+
+		raw := strings.ToLower(recoveryEncoding.EncodeToString(buf))
+		code := raw[:4] + "-" + raw[4:]
+
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode 规范化（忽略大小写与分隔符）后计算 SHA-256
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,294 @@
+package mfa
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/mock"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/totp"
+)
+
+// testSecret RFC 6238 附录 B 的 SHA1 测试密钥（Base32）
+const testSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+var fixedNow = time.Unix(1700000000, 0)
+
+// setupTest 初始化测试环境
+func setupTest(t *testing.T) (*LogicImpl, *mock.TestMocks) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mocks := mock.NewTestMocks(ctrl)
+	logic := &LogicImpl{
+		dal:    mocks.DAL,
+		issuer: "CloudWeGo IAM",
+		now:    func() time.Time { return fixedNow },
+	}
+
+	return logic, mocks
+}
+
+// assertErrCode 断言错误码匹配
+func assertErrCode(t *testing.T, expected errno.ErrNo, actual error) {
+	t.Helper()
+
+	errNo, ok := actual.(errno.ErrNo)
+	require.True(t, ok, "expected errno.ErrNo, got %T: %v", actual, actual)
+	assert.Equal(t, expected.ErrCode, errNo.ErrCode)
+}
+
+// currentCode 返回 fixedNow 对应的 TOTP 口令
+func currentCode(t *testing.T) (string, int64) {
+	t.Helper()
+
+	step := totp.Step(fixedNow)
+	code, err := totp.Code(testSecret, step)
+	require.NoError(t, err)
+
+	return code, step
+}
+
+// ============================================================================
+// EnrollMFA 测试
+// ============================================================================
+
+func TestLogicImpl_EnrollMFA(t *testing.T) {
+	userID := uuid.New().String()
+
+	t.Run("成功发起登记", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		mocks.UserRepo.EXPECT().GetByID(ctx, userID).Return(&models.UserProfile{Username: "alice"}, nil)
+		mocks.MFARepo.EXPECT().GetByUserID(ctx, userID).Return(nil, gorm.ErrRecordNotFound)
+		mocks.MFARepo.EXPECT().SavePending(ctx, userID, gomock.Any()).Return(nil)
+
+		resp, err := logic.EnrollMFA(ctx, &identity_srv.EnrollMFARequest{UserID: &userID})
+
+		require.NoError(t, err)
+		assert.NotEmpty(t, resp.GetSecret())
+		assert.True(t, strings.HasPrefix(resp.GetProvisioningURI(), "otpauth://totp/"))
+		assert.Contains(t, resp.GetProvisioningURI(), "alice")
+	})
+
+	t.Run("已启用时拒绝重新登记", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		mocks.UserRepo.EXPECT().GetByID(ctx, userID).Return(&models.UserProfile{Username: "alice"}, nil)
+		mocks.MFARepo.EXPECT().GetByUserID(ctx, userID).Return(&models.UserMFA{Enabled: true}, nil)
+
+		_, err := logic.EnrollMFA(ctx, &identity_srv.EnrollMFARequest{UserID: &userID})
+
+		assertErrCode(t, errno.ErrMFAAlreadyEnabled, err)
+	})
+
+	t.Run("用户不存在", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		mocks.UserRepo.EXPECT().GetByID(ctx, userID).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := logic.EnrollMFA(ctx, &identity_srv.EnrollMFARequest{UserID: &userID})
+
+		assertErrCode(t, errno.ErrUserNotFound, err)
+	})
+
+	t.Run("用户ID无效", func(t *testing.T) {
+		logic, _ := setupTest(t)
+		badID := "not-a-uuid"
+
+		_, err := logic.EnrollMFA(context.Background(), &identity_srv.EnrollMFARequest{UserID: &badID})
+
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+}
+
+// ============================================================================
+// ConfirmMFA 测试
+// ============================================================================
+
+func TestLogicImpl_ConfirmMFA(t *testing.T) {
+	userID := uuid.New().String()
+
+	t.Run("成功确认并签发恢复码", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+		code, step := currentCode(t)
+
+		var savedHashes []string
+
+		mocks.MFARepo.EXPECT().GetByUserID(ctx, userID).Return(&models.UserMFA{Secret: testSecret}, nil)
+		mocks.MFARepo.EXPECT().Enable(ctx, userID, step, fixedNow.UnixMilli()).Return(nil)
+		mocks.MFARepo.EXPECT().ReplaceRecoveryCodes(ctx, userID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, hashes []string) error {
+				savedHashes = hashes
+				return nil
+			})
+
+		resp, err := logic.ConfirmMFA(ctx, &identity_srv.ConfirmMFARequest{UserID: &userID, Code: &code})
+
+		require.NoError(t, err)
+		require.Len(t, resp.RecoveryCodes, recoveryCodeCount)
+		require.Len(t, savedHashes, recoveryCodeCount)
+
+		// 只保存哈希，且与明文一一对应
+		for i, c := range resp.RecoveryCodes {
+			assert.NotEqual(t, c, savedHashes[i])
+			assert.Equal(t, hashRecoveryCode(c), savedHashes[i])
+		}
+	})
+
+	t.Run("口令错误", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+		code := "000000"
+
+		mocks.MFARepo.EXPECT().GetByUserID(ctx, userID).Return(&models.UserMFA{Secret: testSecret}, nil)
+
+		_, err := logic.ConfirmMFA(ctx, &identity_srv.ConfirmMFARequest{UserID: &userID, Code: &code})
+
+		assertErrCode(t, errno.ErrInvalidMFACode, err)
+	})
+
+	t.Run("未发起登记", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+		code, _ := currentCode(t)
+
+		mocks.MFARepo.EXPECT().GetByUserID(ctx, userID).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := logic.ConfirmMFA(ctx, &identity_srv.ConfirmMFARequest{UserID: &userID, Code: &code})
+
+		assertErrCode(t, errno.ErrMFAEnrollmentNotStarted, err)
+	})
+}
+
+// ============================================================================
+// VerifyMFA 测试
+// ============================================================================
+
+func TestLogicImpl_VerifyMFA(t *testing.T) {
+	userID := uuid.New().String()
+	enabled := &models.UserMFA{Secret: testSecret, Enabled: true}
+
+	t.Run("TOTP口令校验通过", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+		code, step := currentCode(t)
+
+		mocks.MFARepo.EXPECT().GetByUserID(ctx, userID).Return(enabled, nil)
+		mocks.MFARepo.EXPECT().AdvanceLastUsedStep(ctx, userID, step).Return(true, nil)
+		mocks.MFARepo.EXPECT().CountUnusedRecoveryCodes(ctx, userID).Return(int64(10), nil)
+
+		resp, err := logic.VerifyMFA(ctx, &identity_srv.VerifyMFARequest{UserID: &userID, Code: &code})
+
+		require.NoError(t, err)
+		assert.Equal(t, string(models.MFAMethodTOTP), resp.GetMethod())
+		assert.Equal(t, int32(10), resp.GetRemainingRecoveryCodes())
+	})
+
+	t.Run("TOTP口令重放被拒绝", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+		code, step := currentCode(t)
+
+		mocks.MFARepo.EXPECT().GetByUserID(ctx, userID).Return(enabled, nil)
+		mocks.MFARepo.EXPECT().AdvanceLastUsedStep(ctx, userID, step).Return(false, nil)
+
+		_, err := logic.VerifyMFA(ctx, &identity_srv.VerifyMFARequest{UserID: &userID, Code: &code})
+
+		assertErrCode(t, errno.ErrInvalidMFACode, err)
+	})
+
+	t.Run("恢复码校验通过（忽略大小写与分隔符）", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+		code := "ABCD-EFGH"
+
+		mocks.MFARepo.EXPECT().GetByUserID(ctx, userID).Return(enabled, nil)
+		mocks.MFARepo.EXPECT().
+			ConsumeRecoveryCode(ctx, userID, hashRecoveryCode("abcdefgh"), fixedNow.UnixMilli()).
+			Return(true, nil)
+		mocks.MFARepo.EXPECT().CountUnusedRecoveryCodes(ctx, userID).Return(int64(9), nil)
+
+		resp, err := logic.VerifyMFA(ctx, &identity_srv.VerifyMFARequest{UserID: &userID, Code: &code})
+
+		require.NoError(t, err)
+		assert.Equal(t, string(models.MFAMethodRecoveryCode), resp.GetMethod())
+		assert.Equal(t, int32(9), resp.GetRemainingRecoveryCodes())
+	})
+
+	t.Run("恢复码已使用", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+		code := "abcd-efgh"
+
+		mocks.MFARepo.EXPECT().GetByUserID(ctx, userID).Return(enabled, nil)
+		mocks.MFARepo.EXPECT().ConsumeRecoveryCode(ctx, userID, gomock.Any(), gomock.Any()).Return(false, nil)
+
+		_, err := logic.VerifyMFA(ctx, &identity_srv.VerifyMFARequest{UserID: &userID, Code: &code})
+
+		assertErrCode(t, errno.ErrInvalidMFACode, err)
+	})
+
+	t.Run("未启用MFA", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+		code, _ := currentCode(t)
+
+		mocks.MFARepo.EXPECT().GetByUserID(ctx, userID).Return(&models.UserMFA{Secret: testSecret}, nil)
+
+		_, err := logic.VerifyMFA(ctx, &identity_srv.VerifyMFARequest{UserID: &userID, Code: &code})
+
+		assertErrCode(t, errno.ErrMFANotEnabled, err)
+	})
+}
+
+// ============================================================================
+// DisableMFA 测试
+// ============================================================================
+
+func TestLogicImpl_DisableMFA(t *testing.T) {
+	userID := uuid.New().String()
+
+	t.Run("校验通过后停用", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+		code, step := currentCode(t)
+
+		mocks.MFARepo.EXPECT().GetByUserID(ctx, userID).
+			Return(&models.UserMFA{Secret: testSecret, Enabled: true}, nil)
+		mocks.MFARepo.EXPECT().AdvanceLastUsedStep(ctx, userID, step).Return(true, nil)
+		mocks.MFARepo.EXPECT().DeleteByUserID(ctx, userID).Return(nil)
+
+		err := logic.DisableMFA(ctx, &identity_srv.DisableMFARequest{UserID: &userID, Code: &code})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("口令错误不停用", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+		code := "000000"
+
+		mocks.MFARepo.EXPECT().GetByUserID(ctx, userID).
+			Return(&models.UserMFA{Secret: testSecret, Enabled: true}, nil)
+		mocks.MFARepo.EXPECT().ConsumeRecoveryCode(ctx, userID, gomock.Any(), gomock.Any()).Return(false, nil)
+
+		err := logic.DisableMFA(ctx, &identity_srv.DisableMFARequest{UserID: &userID, Code: &code})
+
+		assertErrCode(t, errno.ErrInvalidMFACode, err)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/jackc/pgx/v5/pgconn"
 
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter"
+	convbase "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/base"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
 	rustfsclient "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/rustfs_client"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
@@ -170,6 +171,11 @@ func (l *LogicImpl) UpdateOrganization(
 		return nil, errno.ErrOperationFailed.WithMessage("获取组织信息失败: " + err.Error())
 	}
 
+	// 乐观锁：请求版本号必须与当前版本一致，避免覆盖他人的修改
+	if err := errno.CheckVersion(req.Version, existingOrg.Version); err != nil {
+		return nil, err
+	}
+
 	// 应用更新
 	updatedOrg := l.converter.Organization().ApplyUpdateToModel(existingOrg, req)
 
@@ -287,6 +293,7 @@ func (l *LogicImpl) ListOrganizations(
 	return &identity_srv.ListOrganizationsResponse{
 		Organizations: orgDTOs,
 		Page:          l.converter.Base().PageResponseToThrift(pageResult),
+		Highlights:    convbase.SearchHighlightsToThrift(opts.Search, organizations),
 	}, nil
 }
 
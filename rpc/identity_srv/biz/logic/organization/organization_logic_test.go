@@ -309,19 +309,21 @@ func TestLogicImpl_GetOrganization(t *testing.T) {
 func TestLogicImpl_UpdateOrganization(t *testing.T) {
 	orgID := uuid.New().String()
 	newName := "更新后的组织"
+	version := int64(1)
 
 	t.Run("成功更新组织", func(t *testing.T) {
 		logic, mocks := setupTest(t)
 		ctx := context.Background()
 
 		existingOrg := &models.Organization{
-			BaseModel: models.BaseModel{ID: uuid.MustParse(orgID)},
+			BaseModel: models.BaseModel{ID: uuid.MustParse(orgID), Version: 1},
 			Name:      "原组织名称",
 		}
 
 		req := &identity_srv.UpdateOrganizationRequest{
 			OrganizationID: &orgID,
 			Name:           &newName,
+			Version:        &version,
 		}
 
 		mocks.OrgRepo.EXPECT().GetByID(ctx, orgID).Return(existingOrg, nil)
@@ -343,6 +345,7 @@ func TestLogicImpl_UpdateOrganization(t *testing.T) {
 		req := &identity_srv.UpdateOrganizationRequest{
 			OrganizationID: &orgID,
 			Name:           &newName,
+			Version:        &version,
 		}
 
 		mocks.OrgRepo.EXPECT().GetByID(ctx, orgID).Return(nil, gorm.ErrRecordNotFound)
@@ -358,7 +361,8 @@ func TestLogicImpl_UpdateOrganization(t *testing.T) {
 		ctx := context.Background()
 
 		req := &identity_srv.UpdateOrganizationRequest{
-			Name: &newName,
+			Name:    &newName,
+			Version: &version,
 		}
 
 		result, err := logic.UpdateOrganization(ctx, req)
@@ -372,13 +376,14 @@ func TestLogicImpl_UpdateOrganization(t *testing.T) {
 		ctx := context.Background()
 
 		existingOrg := &models.Organization{
-			BaseModel: models.BaseModel{ID: uuid.MustParse(orgID)},
+			BaseModel: models.BaseModel{ID: uuid.MustParse(orgID), Version: 1},
 			Name:      "原组织名称",
 		}
 
 		req := &identity_srv.UpdateOrganizationRequest{
 			OrganizationID: &orgID,
 			Name:           &newName,
+			Version:        &version,
 		}
 
 		mocks.OrgRepo.EXPECT().GetByID(ctx, orgID).Return(existingOrg, nil)
@@ -397,6 +402,7 @@ func TestLogicImpl_UpdateOrganization(t *testing.T) {
 		req := &identity_srv.UpdateOrganizationRequest{
 			OrganizationID: &orgID,
 			Name:           &newName,
+			Version:        &version,
 		}
 
 		mocks.OrgRepo.EXPECT().GetByID(ctx, orgID).Return(nil, gorm.ErrInvalidDB)
@@ -414,6 +420,7 @@ func TestLogicImpl_UpdateOrganization(t *testing.T) {
 		req := &identity_srv.UpdateOrganizationRequest{
 			OrganizationID: &orgID,
 			ProvinceCity:   &core.StringListValue{Items: []string{"北京", ""}},
+			Version:        &version,
 		}
 
 		result, err := logic.UpdateOrganization(ctx, req)
@@ -434,6 +441,7 @@ func TestLogicImpl_UpdateOrganization(t *testing.T) {
 		req := &identity_srv.UpdateOrganizationRequest{
 			OrganizationID: &orgID,
 			ProvinceCity:   &core.StringListValue{Items: provinceCity},
+			Version:        &version,
 		}
 
 		result, err := logic.UpdateOrganization(ctx, req)
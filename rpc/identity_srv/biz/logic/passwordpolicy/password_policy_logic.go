@@ -0,0 +1,32 @@
+package passwordpolicy
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+)
+
+// PasswordPolicyLogic 组织密码策略管理接口
+// 负责查询组织生效的密码策略、为组织单独配置规则以及恢复沿用全局默认策略
+type PasswordPolicyLogic interface {
+	// GetOrganizationPasswordPolicy 获取组织生效的密码策略，未单独配置时返回全局默认策略
+	// 对应 IDL: GetOrganizationPasswordPolicy(1: GetOrganizationPasswordPolicyRequest req)
+	GetOrganizationPasswordPolicy(
+		ctx context.Context,
+		organizationID string,
+	) (*identity_srv.OrganizationPasswordPolicy, error)
+
+	// UpdateOrganizationPasswordPolicy 为组织单独配置密码策略，未传的规则沿用当前生效的值
+	// 对应 IDL: UpdateOrganizationPasswordPolicy(1: UpdateOrganizationPasswordPolicyRequest req)
+	UpdateOrganizationPasswordPolicy(
+		ctx context.Context,
+		req *identity_srv.UpdateOrganizationPasswordPolicyRequest,
+	) (*identity_srv.OrganizationPasswordPolicy, error)
+
+	// ResetOrganizationPasswordPolicy 删除组织的单独配置，恢复沿用全局默认策略
+	// 对应 IDL: ResetOrganizationPasswordPolicy(1: ResetOrganizationPasswordPolicyRequest req)
+	ResetOrganizationPasswordPolicy(
+		ctx context.Context,
+		organizationID string,
+	) (*identity_srv.OrganizationPasswordPolicy, error)
+}
@@ -0,0 +1,137 @@
+package passwordpolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/mock"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/password"
+)
+
+// setupLogicTest 初始化测试环境，全局默认策略：最短 8 位、2 种字符、历史 5 个、90 天过期
+func setupLogicTest(t *testing.T) (*LogicImpl, *mock.TestMocks) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mocks := mock.NewTestMocks(ctrl)
+	logic := &LogicImpl{
+		dal:      mocks.DAL,
+		enforcer: NewEnforcer(password.NewPolicy(8, 2, 5, 90*24*time.Hour, nil)),
+	}
+
+	return logic, mocks
+}
+
+func int32Of(v int32) *int32 {
+	return &v
+}
+
+func TestLogicImpl_GetOrganizationPasswordPolicy(t *testing.T) {
+	ctx := context.Background()
+	orgID := uuid.NewString()
+
+	t.Run("未单独配置时返回全局默认策略", func(t *testing.T) {
+		logic, mocks := setupLogicTest(t)
+
+		mocks.OrgRepo.EXPECT().ExistsByID(gomock.Any(), orgID).Return(true, nil)
+		mocks.PolicyRepo.EXPECT().GetByOrganizationID(gomock.Any(), orgID).Return(nil, gorm.ErrRecordNotFound)
+
+		policy, err := logic.GetOrganizationPasswordPolicy(ctx, orgID)
+
+		require.NoError(t, err)
+		assert.True(t, policy.GetInherited())
+		assert.Equal(t, int32(8), policy.GetMinLength())
+		assert.Equal(t, int32(5), policy.GetHistorySize())
+		assert.Equal(t, int32(90*24), policy.GetMaxAgeHours())
+	})
+
+	t.Run("组织不存在", func(t *testing.T) {
+		logic, mocks := setupLogicTest(t)
+
+		mocks.OrgRepo.EXPECT().ExistsByID(gomock.Any(), orgID).Return(false, nil)
+
+		_, err := logic.GetOrganizationPasswordPolicy(ctx, orgID)
+
+		assertErrCode(t, errno.ErrOrganizationNotFound, err)
+	})
+
+	t.Run("组织ID格式无效", func(t *testing.T) {
+		logic, _ := setupLogicTest(t)
+
+		_, err := logic.GetOrganizationPasswordPolicy(ctx, "not-a-uuid")
+
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+}
+
+func TestLogicImpl_UpdateOrganizationPasswordPolicy(t *testing.T) {
+	ctx := context.Background()
+	orgID := uuid.NewString()
+
+	t.Run("未传的规则沿用当前生效的值", func(t *testing.T) {
+		logic, mocks := setupLogicTest(t)
+
+		mocks.OrgRepo.EXPECT().ExistsByID(gomock.Any(), orgID).Return(true, nil)
+		mocks.PolicyRepo.EXPECT().GetByOrganizationID(gomock.Any(), orgID).Return(nil, gorm.ErrRecordNotFound)
+		mocks.PolicyRepo.EXPECT().Save(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, record *models.OrganizationPasswordPolicy) error {
+				assert.Equal(t, orgID, record.OrganizationID.String())
+				assert.Equal(t, int32(12), record.MinLength)
+				assert.Equal(t, int32(2), record.MinCharClasses)
+				assert.Equal(t, int32(10), record.HistorySize)
+				assert.Equal(t, int32(90*24), record.MaxAgeHours)
+
+				return nil
+			})
+		mocks.PolicyRepo.EXPECT().GetByOrganizationID(gomock.Any(), orgID).
+			Return(&models.OrganizationPasswordPolicy{MinLength: 12, MinCharClasses: 2, HistorySize: 10}, nil)
+
+		policy, err := logic.UpdateOrganizationPasswordPolicy(ctx, &identity_srv.UpdateOrganizationPasswordPolicyRequest{
+			OrganizationID: &orgID,
+			MinLength:      int32Of(12),
+			HistorySize:    int32Of(10),
+		})
+
+		require.NoError(t, err)
+		assert.False(t, policy.GetInherited())
+		assert.Equal(t, int32(12), policy.GetMinLength())
+	})
+
+	t.Run("取值超出范围", func(t *testing.T) {
+		logic, mocks := setupLogicTest(t)
+
+		mocks.OrgRepo.EXPECT().ExistsByID(gomock.Any(), orgID).Return(true, nil)
+		mocks.PolicyRepo.EXPECT().GetByOrganizationID(gomock.Any(), orgID).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := logic.UpdateOrganizationPasswordPolicy(ctx, &identity_srv.UpdateOrganizationPasswordPolicyRequest{
+			OrganizationID: &orgID,
+			MinCharClasses: int32Of(5),
+		})
+
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+}
+
+func TestLogicImpl_ResetOrganizationPasswordPolicy(t *testing.T) {
+	logic, mocks := setupLogicTest(t)
+	orgID := uuid.NewString()
+
+	mocks.OrgRepo.EXPECT().ExistsByID(gomock.Any(), orgID).Return(true, nil)
+	mocks.PolicyRepo.EXPECT().DeleteByOrganizationID(gomock.Any(), orgID).Return(nil)
+	mocks.PolicyRepo.EXPECT().GetByOrganizationID(gomock.Any(), orgID).Return(nil, gorm.ErrRecordNotFound)
+
+	policy, err := logic.ResetOrganizationPasswordPolicy(context.Background(), orgID)
+
+	require.NoError(t, err)
+	assert.True(t, policy.GetInherited())
+}
@@ -0,0 +1,209 @@
+// Package passwordpolicy 在所有设置密码的路径上执行组织级密码策略。
+//
+// 创建用户、批量导入、修改密码、管理员重置与自助找回均通过 Enforcer 校验新密码强度、
+// 检查历史密码复用并在同一事务内记录历史；登录时按最长使用期限判断密码是否过期。
+// 组织可单独配置长度、字符种类、历史与期限规则，未配置的组织沿用全局默认策略。
+package passwordpolicy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/config"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/password"
+)
+
+// Enforcer 密码策略执行器
+//
+// 全局默认策略来自 PASSWORD_POLICY_* 配置；组织单独配置的规则覆盖默认策略中的长度、字符种类、
+// 历史与期限，禁用密码字典始终为全局配置。organizationID 为空时使用全局默认策略。
+type Enforcer struct {
+	defaults *password.Policy
+	now      func() time.Time
+}
+
+// NewEnforcer 创建密码策略执行器，defaults 为全局默认策略
+func NewEnforcer(defaults *password.Policy) *Enforcer {
+	return &Enforcer{
+		defaults: defaults,
+		now:      time.Now,
+	}
+}
+
+// NewEnforcerFromConfig 按配置创建密码策略执行器，配置了字典文件时在此加载，加载失败返回错误
+func NewEnforcerFromConfig(cfg config.PasswordPolicyConfig) (*Enforcer, error) {
+	if cfg.MinCharClasses < 0 || cfg.MinCharClasses > 4 {
+		return nil, fmt.Errorf("密码策略字符种类数必须在 0-4 之间: %d", cfg.MinCharClasses)
+	}
+
+	var dictionary *password.Dictionary
+
+	if cfg.DictionaryFile != "" {
+		var err error
+
+		dictionary, err = password.LoadDictionary(cfg.DictionaryFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewEnforcer(password.NewPolicy(
+		cfg.MinLength,
+		cfg.MinCharClasses,
+		cfg.HistorySize,
+		cfg.MaxAge,
+		dictionary,
+	)), nil
+}
+
+// Defaults 返回全局默认密码策略
+func (e *Enforcer) Defaults() *password.Policy {
+	return e.defaults
+}
+
+// PolicyFor 返回组织生效的密码策略，组织未单独配置时返回全局默认策略
+func (e *Enforcer) PolicyFor(
+	ctx context.Context,
+	d dal.DAL,
+	organizationID string,
+) (*password.Policy, error) {
+	if organizationID == "" {
+		return e.defaults, nil
+	}
+
+	record, err := d.PasswordPolicy().GetByOrganizationID(ctx, organizationID)
+	if err != nil {
+		if errno.IsRecordNotFound(err) {
+			return e.defaults, nil
+		}
+
+		return nil, errno.ErrOperationFailed.WithMessage("获取组织密码策略失败: " + err.Error())
+	}
+
+	return e.defaults.WithRules(
+		int(record.MinLength),
+		int(record.MinCharClasses),
+		int(record.HistorySize),
+		time.Duration(record.MaxAgeHours)*time.Hour,
+	), nil
+}
+
+// OrganizationOf 返回决定用户密码策略的组织：用户主成员关系所属组织，没有主成员关系时返回空字符串
+func (e *Enforcer) OrganizationOf(ctx context.Context, d dal.DAL, userID string) (string, error) {
+	membership, err := d.UserMembership().GetPrimaryMembership(ctx, userID)
+	if err != nil {
+		if errno.IsRecordNotFound(err) {
+			return "", nil
+		}
+
+		return "", errno.ErrOperationFailed.WithMessage("获取用户主要成员关系失败: " + err.Error())
+	}
+
+	return membership.OrganizationID.String(), nil
+}
+
+// Validate 按组织密码策略校验新密码强度（长度、字符种类、禁用字典）
+func (e *Enforcer) Validate(
+	ctx context.Context,
+	d dal.DAL,
+	organizationID string,
+	newPassword string,
+) error {
+	policy, err := e.PolicyFor(ctx, d, organizationID)
+	if err != nil {
+		return err
+	}
+
+	if err := policy.Check(newPassword); err != nil {
+		return errno.ErrPasswordPolicyViolation.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// CheckReuse 校验新密码不是用户当前密码或最近 N 个历史密码之一，N 取组织密码策略
+// 策略迁移前设置的密码没有历史记录，因此当前密码哈希始终参与比较
+func (e *Enforcer) CheckReuse(
+	ctx context.Context,
+	d dal.DAL,
+	organizationID string,
+	profile *models.UserProfile,
+	newPassword string,
+) error {
+	policy, err := e.PolicyFor(ctx, d, organizationID)
+	if err != nil {
+		return err
+	}
+
+	if policy.HistorySize <= 0 {
+		return nil
+	}
+
+	hashes, err := d.PasswordHistory().ListRecentHashes(ctx, profile.ID.String(), policy.HistorySize)
+	if err != nil {
+		return errno.ErrOperationFailed.WithMessage("获取历史密码失败: " + err.Error())
+	}
+
+	hashes = append(hashes, profile.PasswordHash)
+
+	if password.MatchesAny(newPassword, hashes) {
+		return errno.ErrPasswordPolicyViolation.WithMessage(
+			fmt.Sprintf("%s（最近 %d 次）", password.ErrRecentlyUsed.Error(), policy.HistorySize),
+		)
+	}
+
+	return nil
+}
+
+// Record 追加一条历史密码并按组织密码策略清理超出保留数量的记录，应与密码更新在同一事务内调用
+// 未启用历史检查时不记录
+func (e *Enforcer) Record(
+	ctx context.Context,
+	txDAL dal.DAL,
+	organizationID string,
+	userID uuid.UUID,
+	passwordHash string,
+) error {
+	policy, err := e.PolicyFor(ctx, txDAL, organizationID)
+	if err != nil {
+		return err
+	}
+
+	if policy.HistorySize <= 0 {
+		return nil
+	}
+
+	if err := txDAL.PasswordHistory().Create(ctx, &models.PasswordHistory{
+		UserID:       userID,
+		PasswordHash: passwordHash,
+	}); err != nil {
+		return err
+	}
+
+	return txDAL.PasswordHistory().Prune(ctx, userID.String(), policy.HistorySize)
+}
+
+// Expired 判断用户当前密码是否已超过组织密码策略的最长使用期限
+func (e *Enforcer) Expired(
+	ctx context.Context,
+	d dal.DAL,
+	organizationID string,
+	profile *models.UserProfile,
+) (bool, error) {
+	if profile.PasswordChangedAt == nil {
+		return false, nil
+	}
+
+	policy, err := e.PolicyFor(ctx, d, organizationID)
+	if err != nil {
+		return false, err
+	}
+
+	return policy.Expired(time.UnixMilli(*profile.PasswordChangedAt), e.now()), nil
+}
@@ -0,0 +1,314 @@
+package passwordpolicy
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/mock"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/config"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/password"
+)
+
+// assertErrCode 断言错误码匹配
+func assertErrCode(t *testing.T, expected errno.ErrNo, actual error) {
+	t.Helper()
+
+	errNo, ok := actual.(errno.ErrNo)
+	require.True(t, ok, "expected errno.ErrNo, got %T: %v", actual, actual)
+	assert.Equal(t, expected.ErrCode, errNo.ErrCode)
+}
+
+func mustHash(t *testing.T, plain string) string {
+	t.Helper()
+
+	hash, err := password.HashPassword(plain)
+	require.NoError(t, err)
+
+	return hash
+}
+
+func TestNewEnforcerFromConfig(t *testing.T) {
+	t.Run("loads dictionary file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "dictionary.txt")
+		require.NoError(t, os.WriteFile(path, []byte("Company2024!\n"), 0o600))
+
+		enforcer, err := NewEnforcerFromConfig(config.PasswordPolicyConfig{
+			MinLength:      8,
+			MinCharClasses: 3,
+			DictionaryFile: path,
+		})
+		require.NoError(t, err)
+
+		ctx := context.Background()
+		assertErrCode(t, errno.ErrPasswordPolicyViolation, enforcer.Validate(ctx, nil, "", "company2024!"))
+		assert.NoError(t, enforcer.Validate(ctx, nil, "", "Unique-Pass9"))
+	})
+
+	t.Run("missing dictionary file fails", func(t *testing.T) {
+		_, err := NewEnforcerFromConfig(config.PasswordPolicyConfig{
+			DictionaryFile: filepath.Join(t.TempDir(), "missing.txt"),
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid char classes fails", func(t *testing.T) {
+		_, err := NewEnforcerFromConfig(config.PasswordPolicyConfig{MinCharClasses: 5})
+		assert.Error(t, err)
+	})
+}
+
+func TestEnforcer_Validate(t *testing.T) {
+	ctx := context.Background()
+	enforcer := NewEnforcer(password.NewPolicy(10, 3, 0, 0, nil))
+
+	t.Run("global policy without organization", func(t *testing.T) {
+		err := enforcer.Validate(ctx, nil, "", "short1A")
+		assertErrCode(t, errno.ErrPasswordPolicyViolation, err)
+		assert.Contains(t, err.(errno.ErrNo).Message(), "10")
+
+		assertErrCode(t, errno.ErrPasswordPolicyViolation, enforcer.Validate(ctx, nil, "", "alllowercase1"))
+		assert.NoError(t, enforcer.Validate(ctx, nil, "", "Mixed-Case-1"))
+	})
+
+	t.Run("organization policy overrides global rules", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mocks := mock.NewTestMocks(ctrl)
+		orgID := uuid.NewString()
+
+		mocks.PolicyRepo.EXPECT().GetByOrganizationID(gomock.Any(), orgID).
+			Return(&models.OrganizationPasswordPolicy{MinLength: 14, MinCharClasses: 1}, nil).Times(2)
+
+		err := enforcer.Validate(ctx, mocks.DAL, orgID, "Mixed-Case-1")
+		assertErrCode(t, errno.ErrPasswordPolicyViolation, err)
+		assert.Contains(t, err.(errno.ErrNo).Message(), "14")
+
+		assert.NoError(t, enforcer.Validate(ctx, mocks.DAL, orgID, "alllowercase-long"))
+	})
+}
+
+func TestEnforcer_PolicyFor(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "dictionary.txt")
+	require.NoError(t, os.WriteFile(path, []byte("Company2024!\n"), 0o600))
+
+	dictionary, err := password.LoadDictionary(path)
+	require.NoError(t, err)
+
+	enforcer := NewEnforcer(password.NewPolicy(8, 2, 5, 0, dictionary))
+	orgID := uuid.NewString()
+
+	t.Run("unconfigured organization falls back to global policy", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mocks := mock.NewTestMocks(ctrl)
+
+		mocks.PolicyRepo.EXPECT().GetByOrganizationID(gomock.Any(), orgID).Return(nil, gorm.ErrRecordNotFound)
+
+		policy, err := enforcer.PolicyFor(ctx, mocks.DAL, orgID)
+		require.NoError(t, err)
+		assert.Same(t, enforcer.Defaults(), policy)
+	})
+
+	t.Run("organization rules keep global dictionary", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mocks := mock.NewTestMocks(ctrl)
+
+		mocks.PolicyRepo.EXPECT().GetByOrganizationID(gomock.Any(), orgID).
+			Return(&models.OrganizationPasswordPolicy{
+				MinLength:      12,
+				MinCharClasses: 0,
+				HistorySize:    10,
+				MaxAgeHours:    720,
+			}, nil)
+
+		policy, err := enforcer.PolicyFor(ctx, mocks.DAL, orgID)
+		require.NoError(t, err)
+		assert.Equal(t, 12, policy.MinLength)
+		assert.Equal(t, 10, policy.HistorySize)
+		assert.Equal(t, 30*24*time.Hour, policy.MaxAge)
+		assert.ErrorIs(t, policy.Check("company2024!"), password.ErrInDictionary)
+	})
+
+	t.Run("lookup failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mocks := mock.NewTestMocks(ctrl)
+
+		mocks.PolicyRepo.EXPECT().GetByOrganizationID(gomock.Any(), orgID).Return(nil, errors.New("db down"))
+
+		_, err := enforcer.PolicyFor(ctx, mocks.DAL, orgID)
+		assertErrCode(t, errno.ErrOperationFailed, err)
+	})
+}
+
+func TestEnforcer_OrganizationOf(t *testing.T) {
+	ctx := context.Background()
+	enforcer := NewEnforcer(&password.Policy{})
+	userID := uuid.NewString()
+
+	t.Run("primary membership organization", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mocks := mock.NewTestMocks(ctrl)
+		orgID := uuid.New()
+
+		mocks.MembershipRepo.EXPECT().GetPrimaryMembership(gomock.Any(), userID).
+			Return(&models.UserMembership{OrganizationID: orgID}, nil)
+
+		got, err := enforcer.OrganizationOf(ctx, mocks.DAL, userID)
+		require.NoError(t, err)
+		assert.Equal(t, orgID.String(), got)
+	})
+
+	t.Run("no primary membership uses global policy", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mocks := mock.NewTestMocks(ctrl)
+
+		mocks.MembershipRepo.EXPECT().GetPrimaryMembership(gomock.Any(), userID).Return(nil, gorm.ErrRecordNotFound)
+
+		got, err := enforcer.OrganizationOf(ctx, mocks.DAL, userID)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+}
+
+func TestEnforcer_CheckReuse(t *testing.T) {
+	ctx := context.Background()
+	enforcer := NewEnforcer(password.NewPolicy(0, 0, 3, 0, nil))
+
+	newProfile := func() *models.UserProfile {
+		return &models.UserProfile{
+			BaseModel:    models.BaseModel{ID: uuid.New()},
+			PasswordHash: mustHash(t, "Current-Pass1"),
+		}
+	}
+
+	t.Run("rejects password in history", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mocks := mock.NewTestMocks(ctrl)
+		profile := newProfile()
+
+		mocks.HistoryRepo.EXPECT().ListRecentHashes(gomock.Any(), profile.ID.String(), 3).
+			Return([]string{mustHash(t, "Older-Pass1")}, nil)
+
+		err := enforcer.CheckReuse(ctx, mocks.DAL, "", profile, "Older-Pass1")
+		assertErrCode(t, errno.ErrPasswordPolicyViolation, err)
+	})
+
+	t.Run("rejects current password without history", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mocks := mock.NewTestMocks(ctrl)
+		profile := newProfile()
+
+		mocks.HistoryRepo.EXPECT().ListRecentHashes(gomock.Any(), gomock.Any(), 3).Return(nil, nil)
+
+		err := enforcer.CheckReuse(ctx, mocks.DAL, "", profile, "Current-Pass1")
+		assertErrCode(t, errno.ErrPasswordPolicyViolation, err)
+	})
+
+	t.Run("accepts new password", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mocks := mock.NewTestMocks(ctrl)
+		profile := newProfile()
+
+		mocks.HistoryRepo.EXPECT().ListRecentHashes(gomock.Any(), gomock.Any(), 3).
+			Return([]string{mustHash(t, "Older-Pass1")}, nil)
+
+		assert.NoError(t, enforcer.CheckReuse(ctx, mocks.DAL, "", profile, "Brand-New-Pass1"))
+	})
+
+	t.Run("history query failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mocks := mock.NewTestMocks(ctrl)
+
+		mocks.HistoryRepo.EXPECT().ListRecentHashes(gomock.Any(), gomock.Any(), 3).
+			Return(nil, errors.New("db down"))
+
+		err := enforcer.CheckReuse(ctx, mocks.DAL, "", newProfile(), "Brand-New-Pass1")
+		assertErrCode(t, errno.ErrOperationFailed, err)
+	})
+
+	t.Run("disabled history skips lookup", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mocks := mock.NewTestMocks(ctrl)
+
+		disabled := NewEnforcer(&password.Policy{})
+		assert.NoError(t, disabled.CheckReuse(ctx, mocks.DAL, "", newProfile(), "Current-Pass1"))
+	})
+}
+
+func TestEnforcer_Record(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("appends and prunes history", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mocks := mock.NewTestMocks(ctrl)
+		enforcer := NewEnforcer(password.NewPolicy(0, 0, 5, 0, nil))
+
+		gomock.InOrder(
+			mocks.HistoryRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, h *models.PasswordHistory) error {
+					assert.Equal(t, userID, h.UserID)
+					assert.Equal(t, "hash", h.PasswordHash)
+
+					return nil
+				}),
+			mocks.HistoryRepo.EXPECT().Prune(gomock.Any(), userID.String(), 5).Return(nil),
+		)
+
+		assert.NoError(t, enforcer.Record(ctx, mocks.DAL, "", userID, "hash"))
+	})
+
+	t.Run("disabled history records nothing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mocks := mock.NewTestMocks(ctrl)
+
+		assert.NoError(t, NewEnforcer(&password.Policy{}).Record(ctx, mocks.DAL, "", userID, "hash"))
+	})
+}
+
+func TestEnforcer_Expired(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	enforcer := NewEnforcer(password.NewPolicy(0, 0, 0, 90*24*time.Hour, nil))
+	enforcer.now = func() time.Time { return now }
+
+	changedAt := func(d time.Duration) *models.UserProfile {
+		ts := now.Add(-d).UnixMilli()
+		return &models.UserProfile{PasswordChangedAt: &ts}
+	}
+
+	expired := func(d dal.DAL, orgID string, profile *models.UserProfile) bool {
+		t.Helper()
+
+		ok, err := enforcer.Expired(context.Background(), d, orgID, profile)
+		require.NoError(t, err)
+
+		return ok
+	}
+
+	assert.True(t, expired(nil, "", changedAt(91*24*time.Hour)))
+	assert.False(t, expired(nil, "", changedAt(89*24*time.Hour)))
+	assert.False(t, expired(nil, "", &models.UserProfile{}), "修改时间未知时不视为过期")
+
+	t.Run("organization max age", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mocks := mock.NewTestMocks(ctrl)
+		orgID := uuid.NewString()
+
+		mocks.PolicyRepo.EXPECT().GetByOrganizationID(gomock.Any(), orgID).
+			Return(&models.OrganizationPasswordPolicy{MinLength: 8, MaxAgeHours: 30 * 24}, nil)
+
+		assert.True(t, expired(mocks.DAL, orgID, changedAt(31*24*time.Hour)))
+	})
+}
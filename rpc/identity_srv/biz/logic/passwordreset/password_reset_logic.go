@@ -0,0 +1,23 @@
+package passwordreset
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+)
+
+// PasswordResetLogic 自助找回密码业务逻辑接口
+// 负责签发一次性重置令牌、通过通知渠道发送重置链接，以及凭令牌设置新密码
+type PasswordResetLogic interface {
+	// RequestPasswordReset 按用户名、邮箱或手机号发起找回密码
+	// 账号不存在、不可用、未绑定邮箱或触发限流时同样返回成功，避免账号枚举
+	// 对应 IDL: RequestPasswordReset(1: RequestPasswordResetRequest req)
+	RequestPasswordReset(ctx context.Context, req *identity_srv.RequestPasswordResetRequest) error
+
+	// ConfirmPasswordReset 校验令牌并设置新密码，返回被重置密码的用户ID
+	// 对应 IDL: ConfirmPasswordReset(1: ConfirmPasswordResetRequest req)
+	ConfirmPasswordReset(
+		ctx context.Context,
+		req *identity_srv.ConfirmPasswordResetRequest,
+	) (string, error)
+}
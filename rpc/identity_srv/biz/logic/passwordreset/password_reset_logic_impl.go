@@ -0,0 +1,395 @@
+package passwordreset
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/convutil"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/passwordpolicy"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/notifier"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/config"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+	tracelog "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/log"
+)
+
+const (
+	// resetTokenBytes 令牌随机字节数（Base64URL 编码后 43 个字符）
+	resetTokenBytes = 32
+
+	// resetAuditResource 审计日志中的资源类型
+	resetAuditResource = "password_reset"
+
+	// tokenPlaceholder 重置链接模板中的令牌占位符
+	tokenPlaceholder = "{token}"
+)
+
+// LogicImpl 自助找回密码业务逻辑实现
+type LogicImpl struct {
+	dal            dal.DAL
+	notifier       notifier.Notifier
+	cfg            config.PasswordResetConfig
+	passwordPolicy *passwordpolicy.Enforcer
+
+	// now 当前时间，测试中可替换
+	now func() time.Time
+}
+
+// NewLogic 创建自助找回密码业务逻辑实例
+// notifier 为 nil 时发起找回会返回错误，确认重置不受影响
+func NewLogic(
+	dal dal.DAL,
+	notifier notifier.Notifier,
+	cfg config.PasswordResetConfig,
+	passwordPolicy *passwordpolicy.Enforcer,
+) PasswordResetLogic {
+	return &LogicImpl{
+		dal:            dal,
+		notifier:       notifier,
+		cfg:            cfg,
+		passwordPolicy: passwordPolicy,
+		now:            time.Now,
+	}
+}
+
+// RequestPasswordReset 发起找回密码
+func (l *LogicImpl) RequestPasswordReset(
+	ctx context.Context,
+	req *identity_srv.RequestPasswordResetRequest,
+) error {
+	identifier := strings.TrimSpace(req.GetIdentifier())
+	if identifier == "" {
+		return errno.ErrInvalidParams.WithMessage("用户名、邮箱或手机号不能为空")
+	}
+
+	if l.notifier == nil {
+		return errno.ErrOperationFailed.WithMessage("未配置通知渠道，无法发送重置链接")
+	}
+
+	user, err := l.findUser(ctx, identifier)
+	if err != nil {
+		return err
+	}
+
+	// 以下情况均静默返回成功，调用方无法据此判断账号是否存在
+	switch {
+	case user == nil:
+		tracelog.Ctx(ctx).Info().Msg("找回密码：未找到匹配账号，忽略请求")
+		return nil
+	case !user.IsActive():
+		tracelog.Ctx(ctx).Info().
+			Str("user_id", user.ID.String()).
+			Msg("找回密码：账号不可用，忽略请求")
+
+		return nil
+	case user.Email == "":
+		tracelog.Ctx(ctx).Info().
+			Str("user_id", user.ID.String()).
+			Msg("找回密码：账号未绑定邮箱，忽略请求")
+
+		return nil
+	}
+
+	userID := user.ID.String()
+	now := l.now()
+
+	limited, err := l.rateLimited(ctx, userID, now)
+	if err != nil {
+		return err
+	}
+
+	if limited {
+		tracelog.Ctx(ctx).Warn().
+			Str("user_id", userID).
+			Int("limit", l.cfg.RateLimitMax).
+			Dur("window", l.cfg.RateLimitWindow).
+			Msg("找回密码：触发账号限流，忽略请求")
+
+		return nil
+	}
+
+	token, tokenHash, err := generateToken()
+	if err != nil {
+		return errno.ErrOperationFailed.WithMessage("生成重置令牌失败: " + err.Error())
+	}
+
+	record := &models.PasswordResetToken{
+		UserID:    user.ID,
+		TokenHash: tokenHash,
+		ExpiresAt: now.Add(l.cfg.TokenTTL).UnixMilli(),
+		RequestIP: req.GetClientIP(),
+	}
+
+	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		// 新令牌签发后旧令牌立即失效，只有最近一次通知中的链接可用
+		if err := txDAL.PasswordResetToken().InvalidateByUser(ctx, userID, now.UnixMilli()); err != nil {
+			return errno.ErrOperationFailed.WithMessage("作废旧重置令牌失败: " + err.Error())
+		}
+
+		if err := txDAL.PasswordResetToken().Create(ctx, record); err != nil {
+			return errno.ErrOperationFailed.WithMessage("保存重置令牌失败: " + err.Error())
+		}
+
+		return recordAudit(ctx, txDAL, models.AuditActionPasswordResetRequest, user, record, req.GetClientIP())
+	})
+	if err != nil {
+		return err
+	}
+
+	// 发送失败只记录日志：返回错误会暴露账号存在，用户可稍后重新发起
+	if err := l.notifier.Send(ctx, l.buildMessage(user, token)); err != nil {
+		tracelog.Ctx(ctx).Error().
+			Err(err).
+			Str("user_id", userID).
+			Msg("找回密码：发送重置链接失败")
+	}
+
+	return nil
+}
+
+// ConfirmPasswordReset 凭令牌设置新密码
+func (l *LogicImpl) ConfirmPasswordReset(
+	ctx context.Context,
+	req *identity_srv.ConfirmPasswordResetRequest,
+) (string, error) {
+	token := strings.TrimSpace(req.GetToken())
+	if token == "" {
+		return "", errno.ErrInvalidParams.WithMessage("重置令牌不能为空")
+	}
+
+	newPassword := req.GetNewPassword()
+	if newPassword == "" {
+		return "", errno.ErrInvalidParams.WithMessage("新密码不能为空")
+	}
+
+	record, err := l.dal.PasswordResetToken().GetByHash(ctx, hashToken(token))
+	if err != nil {
+		if errno.IsRecordNotFound(err) {
+			return "", errno.ErrPasswordResetTokenInvalid
+		}
+
+		return "", errno.ErrOperationFailed.WithMessage("获取重置令牌失败: " + err.Error())
+	}
+
+	now := l.now().UnixMilli()
+	if !record.IsUsable(now) {
+		return "", errno.ErrPasswordResetTokenInvalid
+	}
+
+	userID := record.UserID.String()
+
+	user, err := l.dal.UserProfile().GetByID(ctx, userID)
+	if err != nil {
+		if errno.IsRecordNotFound(err) {
+			return "", errno.ErrPasswordResetTokenInvalid
+		}
+
+		return "", errno.ErrOperationFailed.WithMessage("获取用户档案失败: " + err.Error())
+	}
+
+	// 签发后账号被停用、锁定或删除的，令牌随之作废
+	if !user.IsActive() {
+		return "", errno.ErrPasswordResetTokenInvalid
+	}
+
+	// 密码策略取决于用户所属组织，令牌确定用户后才能校验；
+	// 校验在核销令牌之前，未通过时令牌仍可换一个密码重试
+	orgID, err := l.passwordPolicy.OrganizationOf(ctx, l.dal, userID)
+	if err != nil {
+		return "", err
+	}
+
+	if err := l.passwordPolicy.Validate(ctx, l.dal, orgID, newPassword); err != nil {
+		return "", err
+	}
+
+	if err := l.passwordPolicy.CheckReuse(ctx, l.dal, orgID, user, newPassword); err != nil {
+		return "", err
+	}
+
+	newPasswordHash, err := convutil.HashPassword(newPassword)
+	if err != nil {
+		return "", errno.ErrOperationFailed.WithMessage("密码哈希生成失败: " + err.Error())
+	}
+
+	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		// 条件更新保证并发确认时只有一个请求成功
+		consumed, err := txDAL.PasswordResetToken().Consume(ctx, record.ID.String(), now)
+		if err != nil {
+			return errno.ErrOperationFailed.WithMessage("核销重置令牌失败: " + err.Error())
+		}
+
+		if !consumed {
+			return errno.ErrPasswordResetTokenInvalid
+		}
+
+		if err := txDAL.UserProfile().UpdatePassword(ctx, userID, newPasswordHash); err != nil {
+			return errno.ErrOperationFailed.WithMessage("更新密码失败: " + err.Error())
+		}
+
+		if err := l.passwordPolicy.Record(ctx, txDAL, orgID, user.ID, newPasswordHash); err != nil {
+			return errno.ErrOperationFailed.WithMessage("记录历史密码失败: " + err.Error())
+		}
+
+		if err := txDAL.PasswordResetToken().InvalidateByUser(ctx, userID, now); err != nil {
+			return errno.ErrOperationFailed.WithMessage("作废其余重置令牌失败: " + err.Error())
+		}
+
+		return recordAudit(ctx, txDAL, models.AuditActionPasswordReset, user, record, req.GetClientIP())
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return userID, nil
+}
+
+// findUser 依次按用户名、邮箱、手机号查找用户，均未命中时返回 nil
+func (l *LogicImpl) findUser(ctx context.Context, identifier string) (*models.UserProfile, error) {
+	lookups := []func(context.Context, string) (*models.UserProfile, error){
+		l.dal.UserProfile().GetByUsername,
+		l.dal.UserProfile().GetByEmail,
+		l.dal.UserProfile().GetByPhone,
+	}
+
+	for _, lookup := range lookups {
+		user, err := lookup(ctx, identifier)
+		if err != nil {
+			if isUserNotFound(err) {
+				continue
+			}
+
+			return nil, errno.ErrOperationFailed.WithMessage("查询用户失败: " + err.Error())
+		}
+
+		if user != nil {
+			return user, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// rateLimited 判断用户在限流窗口内签发的令牌数是否已达上限，上限为 0 时不限流
+func (l *LogicImpl) rateLimited(ctx context.Context, userID string, now time.Time) (bool, error) {
+	if l.cfg.RateLimitMax <= 0 || l.cfg.RateLimitWindow <= 0 {
+		return false, nil
+	}
+
+	since := now.Add(-l.cfg.RateLimitWindow).UnixMilli()
+
+	count, err := l.dal.PasswordResetToken().CountSince(ctx, userID, since)
+	if err != nil {
+		return false, errno.ErrOperationFailed.WithMessage("统计重置请求失败: " + err.Error())
+	}
+
+	return count >= int64(l.cfg.RateLimitMax), nil
+}
+
+// buildMessage 组装包含重置链接的通知
+func (l *LogicImpl) buildMessage(user *models.UserProfile, token string) *notifier.Message {
+	link := strings.ReplaceAll(l.cfg.URL, tokenPlaceholder, url.QueryEscape(token))
+
+	name := user.RealName
+	if name == "" {
+		name = user.Username
+	}
+
+	body := fmt.Sprintf(
+		"%s，您好：\n\n"+
+			"我们收到了重置账号 %s 密码的请求。请在 %s 内打开以下链接设置新密码：\n\n"+
+			"%s\n\n"+
+			"该链接只能使用一次。如果这不是您本人的操作，请忽略本邮件，您的密码不会改变。\n",
+		name, user.Username, formatTTL(l.cfg.TokenTTL), link,
+	)
+
+	return &notifier.Message{
+		To:      user.Email,
+		Subject: "重置密码",
+		Body:    body,
+	}
+}
+
+// recordAudit 在事务内记录找回密码审计日志，操作人即账号本人
+func recordAudit(
+	ctx context.Context,
+	txDAL dal.DAL,
+	action models.AuditAction,
+	user *models.UserProfile,
+	record *models.PasswordResetToken,
+	clientIP string,
+) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"expires_at": record.ExpiresAt,
+	})
+	if err != nil {
+		return errno.ErrOperationFailed.WithMessage("序列化审计详情失败: " + err.Error())
+	}
+
+	log := &models.AuditLog{
+		UserID:      &user.ID,
+		Username:    user.Username,
+		Action:      action,
+		Resource:    resetAuditResource,
+		ResourceID:  record.ID.String(),
+		Success:     true,
+		ClientIP:    clientIP,
+		RequestBody: string(body),
+	}
+
+	if err := txDAL.AuditLog().Create(ctx, log); err != nil {
+		return errno.ErrOperationFailed.WithMessage("记录审计日志失败: " + err.Error())
+	}
+
+	return nil
+}
+
+// generateToken 生成令牌明文及其哈希
+func generateToken() (string, string, error) {
+	buf := make([]byte, resetTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	return token, hashToken(token), nil
+}
+
+// hashToken 计算令牌的 SHA-256 十六进制哈希
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// isUserNotFound 判断查询结果是否为“用户不存在”
+// 用户名查询返回 gorm.ErrRecordNotFound，邮箱/手机号查询返回 errno.ErrUserNotFound
+func isUserNotFound(err error) bool {
+	if errno.IsRecordNotFound(err) {
+		return true
+	}
+
+	var errNo errno.ErrNo
+
+	return errors.As(err, &errNo) && errNo.ErrCode == errno.ErrUserNotFound.ErrCode
+}
+
+// formatTTL 把有效期格式化为便于阅读的中文时长
+func formatTTL(ttl time.Duration) string {
+	if ttl >= time.Hour && ttl%time.Hour == 0 {
+		return fmt.Sprintf("%d 小时", int(ttl/time.Hour))
+	}
+
+	return fmt.Sprintf("%d 分钟", int(ttl/time.Minute))
+}
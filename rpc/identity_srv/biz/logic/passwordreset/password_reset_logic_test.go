@@ -0,0 +1,440 @@
+package passwordreset
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/passwordpolicy"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/mock"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/notifier"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/config"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/password"
+)
+
+var fixedNow = time.Unix(1700000000, 0)
+
+// fakeNotifier 记录发送的通知
+type fakeNotifier struct {
+	sent []*notifier.Message
+	err  error
+}
+
+func (f *fakeNotifier) Send(_ context.Context, msg *notifier.Message) error {
+	f.sent = append(f.sent, msg)
+	return f.err
+}
+
+// setupTest 初始化测试环境
+func setupTest(t *testing.T) (*LogicImpl, *mock.TestMocks, *fakeNotifier) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mocks := mock.NewTestMocks(ctrl)
+	sender := &fakeNotifier{}
+	logic := &LogicImpl{
+		dal:      mocks.DAL,
+		notifier: sender,
+		cfg: config.PasswordResetConfig{
+			TokenTTL:        30 * time.Minute,
+			RateLimitWindow: time.Hour,
+			RateLimitMax:    3,
+			URL:             "https://iam.example.com/reset-password?token={token}",
+		},
+		passwordPolicy: passwordpolicy.NewEnforcer(&password.Policy{}),
+		now:            func() time.Time { return fixedNow },
+	}
+
+	return logic, mocks, sender
+}
+
+// assertErrCode 断言错误码匹配
+func assertErrCode(t *testing.T, expected errno.ErrNo, actual error) {
+	t.Helper()
+
+	errNo, ok := actual.(errno.ErrNo)
+	require.True(t, ok, "expected errno.ErrNo, got %T: %v", actual, actual)
+	assert.Equal(t, expected.ErrCode, errNo.ErrCode)
+}
+
+// expectNoOrganization 用户没有主成员关系，密码策略回落到全局默认值
+func expectNoOrganization(mocks *mock.TestMocks, userID string) {
+	mocks.MembershipRepo.EXPECT().GetPrimaryMembership(gomock.Any(), userID).
+		Return(nil, gorm.ErrRecordNotFound)
+}
+
+func activeUser() *models.UserProfile {
+	user := &models.UserProfile{
+		Username: "alice",
+		Email:    "alice@example.com",
+		Status:   models.UserStatusActive,
+	}
+	user.ID = uuid.New()
+
+	return user
+}
+
+func strPtr(s string) *string { return &s }
+
+// ============================================================================
+// RequestPasswordReset 测试
+// ============================================================================
+
+func TestLogicImpl_RequestPasswordReset(t *testing.T) {
+	t.Run("按邮箱找到用户并发送重置链接", func(t *testing.T) {
+		logic, mocks, sender := setupTest(t)
+		ctx := context.Background()
+		user := activeUser()
+
+		var saved *models.PasswordResetToken
+
+		mocks.UserRepo.EXPECT().GetByUsername(ctx, user.Email).Return(nil, gorm.ErrRecordNotFound)
+		mocks.UserRepo.EXPECT().GetByEmail(ctx, user.Email).Return(user, nil)
+		mocks.ResetTokenRepo.EXPECT().
+			CountSince(ctx, user.ID.String(), fixedNow.Add(-time.Hour).UnixMilli()).
+			Return(int64(0), nil)
+		mocks.ResetTokenRepo.EXPECT().InvalidateByUser(ctx, user.ID.String(), fixedNow.UnixMilli()).Return(nil)
+		mocks.ResetTokenRepo.EXPECT().Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, token *models.PasswordResetToken) error {
+				saved = token
+				return nil
+			})
+		mocks.AuditLogRepo.EXPECT().Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+				assert.Equal(t, models.AuditActionPasswordResetRequest, log.Action)
+				assert.Equal(t, "10.0.0.1", log.ClientIP)
+				return nil
+			})
+
+		err := logic.RequestPasswordReset(ctx, &identity_srv.RequestPasswordResetRequest{
+			Identifier: strPtr(" " + user.Email + " "),
+			ClientIP:   strPtr("10.0.0.1"),
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, saved)
+		assert.Equal(t, user.ID, saved.UserID)
+		assert.Equal(t, fixedNow.Add(30*time.Minute).UnixMilli(), saved.ExpiresAt)
+
+		require.Len(t, sender.sent, 1)
+		assert.Equal(t, user.Email, sender.sent[0].To)
+		assert.Contains(t, sender.sent[0].Body, "30 分钟")
+
+		// 通知中的令牌明文与库中哈希对应，且库中不保存明文
+		_, token, found := strings.Cut(sender.sent[0].Body, "token=")
+		require.True(t, found)
+		token = strings.Fields(token)[0]
+		assert.Equal(t, hashToken(token), saved.TokenHash)
+		assert.NotContains(t, saved.TokenHash, token)
+	})
+
+	t.Run("账号不存在时静默成功", func(t *testing.T) {
+		logic, mocks, sender := setupTest(t)
+		ctx := context.Background()
+
+		mocks.UserRepo.EXPECT().GetByUsername(ctx, "ghost").Return(nil, gorm.ErrRecordNotFound)
+		mocks.UserRepo.EXPECT().GetByEmail(ctx, "ghost").Return(nil, errno.ErrUserNotFound)
+		mocks.UserRepo.EXPECT().GetByPhone(ctx, "ghost").Return(nil, errno.ErrUserNotFound)
+
+		err := logic.RequestPasswordReset(ctx, &identity_srv.RequestPasswordResetRequest{
+			Identifier: strPtr("ghost"),
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, sender.sent)
+	})
+
+	t.Run("账号已停用时静默成功", func(t *testing.T) {
+		logic, mocks, sender := setupTest(t)
+		ctx := context.Background()
+		user := activeUser()
+		user.Status = models.UserStatusSuspended
+
+		mocks.UserRepo.EXPECT().GetByUsername(ctx, "alice").Return(user, nil)
+
+		err := logic.RequestPasswordReset(ctx, &identity_srv.RequestPasswordResetRequest{
+			Identifier: strPtr("alice"),
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, sender.sent)
+	})
+
+	t.Run("触发限流时静默成功且不签发令牌", func(t *testing.T) {
+		logic, mocks, sender := setupTest(t)
+		ctx := context.Background()
+		user := activeUser()
+
+		mocks.UserRepo.EXPECT().GetByUsername(ctx, "alice").Return(user, nil)
+		mocks.ResetTokenRepo.EXPECT().CountSince(ctx, user.ID.String(), gomock.Any()).Return(int64(3), nil)
+
+		err := logic.RequestPasswordReset(ctx, &identity_srv.RequestPasswordResetRequest{
+			Identifier: strPtr("alice"),
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, sender.sent)
+	})
+
+	t.Run("发送失败不暴露给调用方", func(t *testing.T) {
+		logic, mocks, sender := setupTest(t)
+		ctx := context.Background()
+		user := activeUser()
+		sender.err = errors.New("smtp unavailable")
+
+		mocks.UserRepo.EXPECT().GetByUsername(ctx, "alice").Return(user, nil)
+		mocks.ResetTokenRepo.EXPECT().CountSince(ctx, gomock.Any(), gomock.Any()).Return(int64(0), nil)
+		mocks.ResetTokenRepo.EXPECT().InvalidateByUser(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		mocks.ResetTokenRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mocks.AuditLogRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		err := logic.RequestPasswordReset(ctx, &identity_srv.RequestPasswordResetRequest{
+			Identifier: strPtr("alice"),
+		})
+
+		require.NoError(t, err)
+		assert.Len(t, sender.sent, 1)
+	})
+
+	t.Run("查询失败返回错误", func(t *testing.T) {
+		logic, mocks, _ := setupTest(t)
+		ctx := context.Background()
+
+		mocks.UserRepo.EXPECT().GetByUsername(ctx, "alice").Return(nil, errors.New("db down"))
+
+		err := logic.RequestPasswordReset(ctx, &identity_srv.RequestPasswordResetRequest{
+			Identifier: strPtr("alice"),
+		})
+
+		assertErrCode(t, errno.ErrOperationFailed, err)
+	})
+
+	t.Run("未配置通知渠道", func(t *testing.T) {
+		logic, _, _ := setupTest(t)
+		logic.notifier = nil
+
+		err := logic.RequestPasswordReset(context.Background(), &identity_srv.RequestPasswordResetRequest{
+			Identifier: strPtr("alice"),
+		})
+
+		assertErrCode(t, errno.ErrOperationFailed, err)
+	})
+
+	t.Run("标识为空", func(t *testing.T) {
+		logic, _, _ := setupTest(t)
+
+		err := logic.RequestPasswordReset(context.Background(), &identity_srv.RequestPasswordResetRequest{
+			Identifier: strPtr("  "),
+		})
+
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+}
+
+// ============================================================================
+// ConfirmPasswordReset 测试
+// ============================================================================
+
+func TestLogicImpl_ConfirmPasswordReset(t *testing.T) {
+	const token = "reset-token"
+
+	newRecord := func(userID uuid.UUID) *models.PasswordResetToken {
+		record := &models.PasswordResetToken{
+			UserID:    userID,
+			TokenHash: hashToken(token),
+			ExpiresAt: fixedNow.Add(time.Minute).UnixMilli(),
+		}
+		record.ID = uuid.New()
+
+		return record
+	}
+
+	t.Run("成功重置密码并作废令牌", func(t *testing.T) {
+		logic, mocks, _ := setupTest(t)
+		ctx := context.Background()
+		user := activeUser()
+		record := newRecord(user.ID)
+		userID := user.ID.String()
+		nowMillis := fixedNow.UnixMilli()
+
+		mocks.ResetTokenRepo.EXPECT().GetByHash(ctx, hashToken(token)).Return(record, nil)
+		mocks.UserRepo.EXPECT().GetByID(ctx, userID).Return(user, nil)
+		expectNoOrganization(mocks, userID)
+		mocks.ResetTokenRepo.EXPECT().Consume(ctx, record.ID.String(), nowMillis).Return(true, nil)
+		mocks.UserRepo.EXPECT().UpdatePassword(ctx, userID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, hash string) error {
+				assert.True(t, password.VerifyPassword("N3w-Passw0rd", hash))
+				return nil
+			})
+		mocks.ResetTokenRepo.EXPECT().InvalidateByUser(ctx, userID, nowMillis).Return(nil)
+		mocks.AuditLogRepo.EXPECT().Create(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, log *models.AuditLog) error {
+				assert.Equal(t, models.AuditActionPasswordReset, log.Action)
+				assert.Equal(t, record.ID.String(), log.ResourceID)
+				return nil
+			})
+
+		got, err := logic.ConfirmPasswordReset(ctx, &identity_srv.ConfirmPasswordResetRequest{
+			Token:       strPtr(token),
+			NewPassword: strPtr("N3w-Passw0rd"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, userID, got)
+	})
+
+	t.Run("令牌不存在", func(t *testing.T) {
+		logic, mocks, _ := setupTest(t)
+		ctx := context.Background()
+
+		mocks.ResetTokenRepo.EXPECT().GetByHash(ctx, hashToken(token)).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := logic.ConfirmPasswordReset(ctx, &identity_srv.ConfirmPasswordResetRequest{
+			Token:       strPtr(token),
+			NewPassword: strPtr("N3w-Passw0rd"),
+		})
+
+		assertErrCode(t, errno.ErrPasswordResetTokenInvalid, err)
+	})
+
+	t.Run("令牌已过期", func(t *testing.T) {
+		logic, mocks, _ := setupTest(t)
+		ctx := context.Background()
+		record := newRecord(uuid.New())
+		record.ExpiresAt = fixedNow.UnixMilli()
+
+		mocks.ResetTokenRepo.EXPECT().GetByHash(ctx, hashToken(token)).Return(record, nil)
+
+		_, err := logic.ConfirmPasswordReset(ctx, &identity_srv.ConfirmPasswordResetRequest{
+			Token:       strPtr(token),
+			NewPassword: strPtr("N3w-Passw0rd"),
+		})
+
+		assertErrCode(t, errno.ErrPasswordResetTokenInvalid, err)
+	})
+
+	t.Run("并发确认时仅一次成功", func(t *testing.T) {
+		logic, mocks, _ := setupTest(t)
+		ctx := context.Background()
+		user := activeUser()
+		record := newRecord(user.ID)
+
+		mocks.ResetTokenRepo.EXPECT().GetByHash(ctx, hashToken(token)).Return(record, nil)
+		mocks.UserRepo.EXPECT().GetByID(ctx, user.ID.String()).Return(user, nil)
+		expectNoOrganization(mocks, user.ID.String())
+		mocks.ResetTokenRepo.EXPECT().Consume(ctx, record.ID.String(), gomock.Any()).Return(false, nil)
+
+		_, err := logic.ConfirmPasswordReset(ctx, &identity_srv.ConfirmPasswordResetRequest{
+			Token:       strPtr(token),
+			NewPassword: strPtr("N3w-Passw0rd"),
+		})
+
+		assertErrCode(t, errno.ErrPasswordResetTokenInvalid, err)
+	})
+
+	t.Run("账号已停用", func(t *testing.T) {
+		logic, mocks, _ := setupTest(t)
+		ctx := context.Background()
+		user := activeUser()
+		user.Status = models.UserStatusLocked
+		record := newRecord(user.ID)
+
+		mocks.ResetTokenRepo.EXPECT().GetByHash(ctx, hashToken(token)).Return(record, nil)
+		mocks.UserRepo.EXPECT().GetByID(ctx, user.ID.String()).Return(user, nil)
+
+		_, err := logic.ConfirmPasswordReset(ctx, &identity_srv.ConfirmPasswordResetRequest{
+			Token:       strPtr(token),
+			NewPassword: strPtr("N3w-Passw0rd"),
+		})
+
+		assertErrCode(t, errno.ErrPasswordResetTokenInvalid, err)
+	})
+
+	t.Run("新密码不符合策略", func(t *testing.T) {
+		logic, mocks, _ := setupTest(t)
+		ctx := context.Background()
+		user := activeUser()
+		record := newRecord(user.ID)
+
+		mocks.ResetTokenRepo.EXPECT().GetByHash(ctx, hashToken(token)).Return(record, nil)
+		mocks.UserRepo.EXPECT().GetByID(ctx, user.ID.String()).Return(user, nil)
+		expectNoOrganization(mocks, user.ID.String())
+
+		_, err := logic.ConfirmPasswordReset(ctx, &identity_srv.ConfirmPasswordResetRequest{
+			Token:       strPtr(token),
+			NewPassword: strPtr("123"),
+		})
+
+		assertErrCode(t, errno.ErrPasswordPolicyViolation, err)
+	})
+
+	t.Run("复用历史密码时拒绝且不核销令牌", func(t *testing.T) {
+		logic, mocks, _ := setupTest(t)
+		logic.passwordPolicy = passwordpolicy.NewEnforcer(password.NewPolicy(0, 0, 3, 0, nil))
+		ctx := context.Background()
+		user := activeUser()
+		record := newRecord(user.ID)
+		previous, err := password.HashPassword("N3w-Passw0rd")
+		require.NoError(t, err)
+
+		mocks.ResetTokenRepo.EXPECT().GetByHash(ctx, hashToken(token)).Return(record, nil)
+		mocks.UserRepo.EXPECT().GetByID(ctx, user.ID.String()).Return(user, nil)
+		expectNoOrganization(mocks, user.ID.String())
+		mocks.HistoryRepo.EXPECT().ListRecentHashes(ctx, user.ID.String(), 3).Return([]string{previous}, nil)
+
+		_, err = logic.ConfirmPasswordReset(ctx, &identity_srv.ConfirmPasswordResetRequest{
+			Token:       strPtr(token),
+			NewPassword: strPtr("N3w-Passw0rd"),
+		})
+
+		assertErrCode(t, errno.ErrPasswordPolicyViolation, err)
+	})
+
+	t.Run("成功重置时记录历史密码", func(t *testing.T) {
+		logic, mocks, _ := setupTest(t)
+		logic.passwordPolicy = passwordpolicy.NewEnforcer(password.NewPolicy(0, 0, 3, 0, nil))
+		ctx := context.Background()
+		user := activeUser()
+		record := newRecord(user.ID)
+		userID := user.ID.String()
+
+		mocks.ResetTokenRepo.EXPECT().GetByHash(ctx, hashToken(token)).Return(record, nil)
+		mocks.UserRepo.EXPECT().GetByID(ctx, userID).Return(user, nil)
+		expectNoOrganization(mocks, userID)
+		mocks.HistoryRepo.EXPECT().ListRecentHashes(ctx, userID, 3).Return(nil, nil)
+		mocks.ResetTokenRepo.EXPECT().Consume(ctx, record.ID.String(), gomock.Any()).Return(true, nil)
+		mocks.UserRepo.EXPECT().UpdatePassword(ctx, userID, gomock.Any()).Return(nil)
+		mocks.HistoryRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		mocks.HistoryRepo.EXPECT().Prune(ctx, userID, 3).Return(nil)
+		mocks.ResetTokenRepo.EXPECT().InvalidateByUser(ctx, userID, gomock.Any()).Return(nil)
+		mocks.AuditLogRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		_, err := logic.ConfirmPasswordReset(ctx, &identity_srv.ConfirmPasswordResetRequest{
+			Token:       strPtr(token),
+			NewPassword: strPtr("N3w-Passw0rd"),
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("令牌为空", func(t *testing.T) {
+		logic, _, _ := setupTest(t)
+
+		_, err := logic.ConfirmPasswordReset(context.Background(), &identity_srv.ConfirmPasswordResetRequest{
+			NewPassword: strPtr("N3w-Passw0rd"),
+		})
+
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+}
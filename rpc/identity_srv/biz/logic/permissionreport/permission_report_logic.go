@@ -0,0 +1,31 @@
+package permissionreport
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+)
+
+// PermissionReportLogic 权限报告业务逻辑接口
+// 汇总角色菜单授权与 policy_srv 策略规则，用于权限审查与导出
+type PermissionReportLogic interface {
+	// GetPermissionMatrix 角色 × 菜单/策略权限矩阵
+	// 策略含通配规则的角色列出产品线全部菜单（完全控制、所有组织）
+	GetPermissionMatrix(
+		ctx context.Context,
+		req *identity_srv.GetPermissionMatrixRequest,
+	) (*identity_srv.GetPermissionMatrixResponse, error)
+
+	// GetUserEffectivePermissions 用户在各租户下的有效权限及授予该权限的角色
+	// 只计算当前生效的分配（角色启用且处于生效时间窗口内）
+	GetUserEffectivePermissions(
+		ctx context.Context,
+		req *identity_srv.GetUserEffectivePermissionsRequest,
+	) (*identity_srv.GetUserEffectivePermissionsResponse, error)
+
+	// ExportPermissionReport 按角色视图或用户视图导出权限报告，每条授权一行
+	ExportPermissionReport(
+		ctx context.Context,
+		req *identity_srv.ExportPermissionReportRequest,
+	) (*identity_srv.ExportPermissionReportResponse, error)
+}
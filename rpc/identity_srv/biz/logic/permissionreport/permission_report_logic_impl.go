@@ -0,0 +1,611 @@
+package permissionreport
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/iamclient"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/convutil"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
+	menuDAL "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/menu"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/breakglass"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/menu"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+)
+
+// LogicImpl 权限报告业务逻辑实现
+type LogicImpl struct {
+	dal        dal.DAL
+	policy     menu.PermissionLister
+	breakGlass *breakglass.Guard
+}
+
+// NewLogic 创建权限报告业务逻辑实例
+// policy 用于查询角色与用户在 policy_srv 中的策略规则，breakGlass 用于标记紧急账号
+func NewLogic(dal dal.DAL, policy menu.PermissionLister, breakGlass *breakglass.Guard) PermissionReportLogic {
+	return &LogicImpl{
+		dal:        dal,
+		policy:     policy,
+		breakGlass: breakGlass,
+	}
+}
+
+// ============================================================================
+// 角色视图
+// ============================================================================
+
+// GetPermissionMatrix 角色 × 菜单/策略权限矩阵
+func (l *LogicImpl) GetPermissionMatrix(
+	ctx context.Context,
+	req *identity_srv.GetPermissionMatrixRequest,
+) (*identity_srv.GetPermissionMatrixResponse, error) {
+	if req == nil {
+		req = &identity_srv.GetPermissionMatrixRequest{}
+	}
+
+	report, err := l.newReport(ctx, req.ProductLine)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := l.loadRoles(ctx, req.GetRoleIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := report.loadMenuGrants(ctx, roles); err != nil {
+		return nil, err
+	}
+
+	rows := make([]*identity_srv.RolePermissions, 0, len(roles))
+
+	for _, role := range roles {
+		row, err := report.rolePermissions(ctx, role, req.GetOrganizationID())
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, row)
+	}
+
+	return &identity_srv.GetPermissionMatrixResponse{
+		ProductLine: &report.productLine,
+		MenuVersion: &report.menuVersion,
+		Roles:       rows,
+	}, nil
+}
+
+// loadRoles 加载报告涉及的角色：指定角色（含未启用的角色）或全部启用的角色，按编码排序
+func (l *LogicImpl) loadRoles(ctx context.Context, roleIDs []string) ([]*models.RoleDefinition, error) {
+	var (
+		roles []*models.RoleDefinition
+		err   error
+	)
+
+	if len(roleIDs) > 0 {
+		for _, id := range roleIDs {
+			if _, parseErr := uuid.Parse(id); parseErr != nil {
+				return nil, errno.ErrInvalidParams.WithMessage(fmt.Sprintf("无效的角色ID: %s", id))
+			}
+		}
+
+		roles, err = l.dal.RoleDefinition().BatchGetByIDs(ctx, roleIDs)
+	} else {
+		roles, _, err = l.dal.RoleDefinition().ListActiveRoles(
+			ctx,
+			base.NewQueryOptions().WithFetchAll(true),
+		)
+	}
+
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("查询角色失败: %s", err.Error()))
+	}
+
+	slices.SortFunc(roles, func(a, b *models.RoleDefinition) int {
+		return cmp.Or(cmp.Compare(a.RoleCode, b.RoleCode), cmp.Compare(a.Name, b.Name))
+	})
+
+	return roles, nil
+}
+
+// ============================================================================
+// 用户视图
+// ============================================================================
+
+// GetUserEffectivePermissions 用户在各租户下的有效权限
+func (l *LogicImpl) GetUserEffectivePermissions(
+	ctx context.Context,
+	req *identity_srv.GetUserEffectivePermissionsRequest,
+) (*identity_srv.GetUserEffectivePermissionsResponse, error) {
+	if req == nil || req.GetUserID() == "" {
+		return nil, errno.ErrInvalidParams.WithMessage("用户ID不能为空")
+	}
+
+	if _, err := uuid.Parse(req.GetUserID()); err != nil {
+		return nil, errno.ErrInvalidParams.WithMessage("无效的用户ID")
+	}
+
+	profile, err := l.dal.UserProfile().GetByID(ctx, req.GetUserID())
+	if err != nil {
+		if errno.IsRecordNotFound(err) {
+			return nil, errno.ErrUserNotFound
+		}
+
+		return nil, errno.ErrOperationFailed.WithMessage("获取用户档案失败: " + err.Error())
+	}
+
+	report, err := l.newReport(ctx, req.ProductLine)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := report.usersPermissions(ctx, []*models.UserProfile{profile}, req.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity_srv.GetUserEffectivePermissionsResponse{
+		ProductLine: &report.productLine,
+		User:        users[0],
+	}, nil
+}
+
+// ============================================================================
+// 报告构建
+// ============================================================================
+
+// report 单次报告的构建上下文，缓存菜单、角色菜单授权与策略规则
+// 导出全部用户时同一角色在同一租户下只查询一次 policy_srv
+type report struct {
+	l           *LogicImpl
+	productLine string
+	menuVersion int32
+	// menus 当前生效版本按树顺序展开的菜单（含按钮）
+	menus     []*models.Menu
+	menuOrder map[string]int
+	// menuGrants 角色ID → 该产品线下的角色菜单授权
+	menuGrants map[uuid.UUID][]*models.RoleMenuPermission
+	// policies 租户与主体 → 策略规则
+	policies map[policyKey][]iamclient.Permission
+}
+
+type policyKey struct {
+	tenantID string
+	userID   string
+	roleCode string
+}
+
+// newReport 加载产品线当前生效版本的菜单
+func (l *LogicImpl) newReport(ctx context.Context, productLine *string) (*report, error) {
+	line := convutil.StringValue(productLine)
+	if line == "" {
+		line = menuDAL.DefaultProductLine
+	}
+
+	menus, err := l.dal.Menu().GetLatestMenuTree(ctx, line)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("获取菜单树失败: %s", err.Error()))
+	}
+
+	r := &report{
+		l:           l,
+		productLine: line,
+		menus:       menu.FlattenMenuTree(menus),
+		menuGrants:  make(map[uuid.UUID][]*models.RoleMenuPermission),
+		policies:    make(map[policyKey][]iamclient.Permission),
+	}
+
+	r.menuOrder = make(map[string]int, len(r.menus))
+	for i, m := range r.menus {
+		r.menuOrder[m.SemanticID] = i
+		r.menuVersion = int32(m.Version)
+	}
+
+	return r, nil
+}
+
+// loadMenuGrants 批量加载角色在产品线下的菜单授权，已加载的角色跳过
+func (r *report) loadMenuGrants(ctx context.Context, roles []*models.RoleDefinition) error {
+	roleIDs := make([]uuid.UUID, 0, len(roles))
+
+	for _, role := range roles {
+		if _, ok := r.menuGrants[role.ID]; !ok {
+			roleIDs = append(roleIDs, role.ID)
+		}
+	}
+
+	if len(roleIDs) == 0 {
+		return nil
+	}
+
+	grants, err := r.l.dal.RoleMenuPermission().GetByRoleIDsAndProductLine(ctx, roleIDs, r.productLine)
+	if err != nil {
+		return errno.ErrOperationFailed.WithMessage(fmt.Sprintf("查询角色菜单授权失败: %s", err.Error()))
+	}
+
+	for _, id := range roleIDs {
+		r.menuGrants[id] = []*models.RoleMenuPermission{}
+	}
+
+	for _, g := range grants {
+		r.menuGrants[g.RoleID] = append(r.menuGrants[g.RoleID], g)
+	}
+
+	return nil
+}
+
+// rolePermissions 角色在租户下的全部授权：先菜单授权（按菜单树顺序），后策略规则
+func (r *report) rolePermissions(
+	ctx context.Context,
+	role *models.RoleDefinition,
+	tenantID string,
+) (*identity_srv.RolePermissions, error) {
+	policies, err := r.policyPermissions(ctx, tenantID, "", role.RoleCode)
+	if err != nil {
+		return nil, err
+	}
+
+	fullAccess := slices.ContainsFunc(policies, iamclient.Permission.GrantsAll)
+
+	var grants []*identity_srv.PermissionGrant
+	if fullAccess {
+		grants = r.fullMenuGrants()
+	} else {
+		grants = r.roleMenuGrants(role.ID)
+	}
+
+	grants = append(grants, policyGrants(policies)...)
+
+	return &identity_srv.RolePermissions{
+		RoleID:     convutil.StringPtr(role.ID.String()),
+		RoleName:   convutil.StringPtr(role.Name),
+		RoleCode:   convutil.StringPtr(role.RoleCode),
+		FullAccess: convutil.BoolPtr(fullAccess),
+		Grants:     grants,
+	}, nil
+}
+
+// policyPermissions 查询主体在租户下的策略规则；角色未配置编码时没有策略
+func (r *report) policyPermissions(
+	ctx context.Context,
+	tenantID, userID, roleCode string,
+) ([]iamclient.Permission, error) {
+	if userID == "" && roleCode == "" {
+		return nil, nil
+	}
+
+	key := policyKey{tenantID: tenantID, userID: userID, roleCode: roleCode}
+	if perms, ok := r.policies[key]; ok {
+		return perms, nil
+	}
+
+	var roles []string
+	if roleCode != "" {
+		roles = []string{roleCode}
+	}
+
+	perms, err := r.l.policy.ListPermissions(ctx, userID, tenantID, roles)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("查询策略规则失败: %s", err.Error()))
+	}
+
+	slices.SortFunc(perms, func(a, b iamclient.Permission) int {
+		return cmp.Or(cmp.Compare(a.Resource, b.Resource), cmp.Compare(a.Action, b.Action))
+	})
+
+	r.policies[key] = perms
+
+	return perms, nil
+}
+
+// fullMenuGrants 通配策略角色的菜单授权：全部菜单、完全控制、所有组织
+func (r *report) fullMenuGrants() []*identity_srv.PermissionGrant {
+	grants := make([]*identity_srv.PermissionGrant, 0, len(r.menus))
+
+	for _, m := range r.menus {
+		grants = append(grants, menuGrant(m.SemanticID, m.Name, models.PermissionFull, models.DataScopeAllOrgs))
+	}
+
+	return grants
+}
+
+// roleMenuGrants 角色菜单授权，按菜单树顺序排列；当前版本已不存在的菜单排在最后且名称为空
+func (r *report) roleMenuGrants(roleID uuid.UUID) []*identity_srv.PermissionGrant {
+	items := slices.Clone(r.menuGrants[roleID])
+
+	slices.SortStableFunc(items, func(a, b *models.RoleMenuPermission) int {
+		return cmp.Compare(r.menuRank(a.MenuID), r.menuRank(b.MenuID))
+	})
+
+	grants := make([]*identity_srv.PermissionGrant, 0, len(items))
+
+	for _, item := range items {
+		name := ""
+		if i, ok := r.menuOrder[item.MenuID]; ok {
+			name = r.menus[i].Name
+		}
+
+		grants = append(grants, menuGrant(item.MenuID, name, item.PermissionType, item.DataScope))
+	}
+
+	return grants
+}
+
+func (r *report) menuRank(menuID string) int {
+	if i, ok := r.menuOrder[menuID]; ok {
+		return i
+	}
+
+	return len(r.menus)
+}
+
+// usersPermissions 计算多个用户的有效权限，按传入顺序返回
+// organizationID 为空表示全部租户：用户活跃成员关系所属组织与组织级分配所属组织的并集
+func (r *report) usersPermissions(
+	ctx context.Context,
+	profiles []*models.UserProfile,
+	organizationID *string,
+) ([]*identity_srv.UserPermissions, error) {
+	userIDs := make([]string, 0, len(profiles))
+	for _, p := range profiles {
+		userIDs = append(userIDs, p.ID.String())
+	}
+
+	var scope *string
+	if convutil.StringValue(organizationID) != "" {
+		scope = organizationID
+	}
+
+	assignments, err := r.l.dal.UserRoleAssignment().FindEffectiveByUserIDs(ctx, userIDs, scope)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("查询角色分配失败: %s", err.Error()))
+	}
+
+	memberships, err := r.l.dal.UserMembership().GetActiveMembershipsByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("查询成员关系失败: %s", err.Error()))
+	}
+
+	roles, err := r.assignedRoles(ctx, assignments)
+	if err != nil {
+		return nil, err
+	}
+
+	byUser := make(map[string][]*models.UserRoleAssignment, len(profiles))
+	for _, a := range assignments {
+		byUser[a.UserID.String()] = append(byUser[a.UserID.String()], a)
+	}
+
+	tenantsByUser := make(map[string][]string, len(profiles))
+	orgIDs := make(map[string]struct{})
+
+	for _, p := range profiles {
+		userID := p.ID.String()
+
+		tenants := []string{convutil.StringValue(organizationID)}
+		if scope == nil {
+			tenants = userTenants(memberships[userID], byUser[userID])
+		}
+
+		tenantsByUser[userID] = tenants
+
+		for _, t := range tenants {
+			if t != "" {
+				orgIDs[t] = struct{}{}
+			}
+		}
+	}
+
+	orgNames, err := r.organizationNames(ctx, orgIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*identity_srv.UserPermissions, 0, len(profiles))
+
+	for _, p := range profiles {
+		userID := p.ID.String()
+
+		user := &identity_srv.UserPermissions{
+			UserID:     convutil.StringPtr(userID),
+			Username:   convutil.StringPtr(p.Username),
+			RealName:   convutil.StringPtr(p.RealName),
+			BreakGlass: convutil.BoolPtr(r.l.breakGlass.IsAccount(userID)),
+		}
+
+		for _, tenantID := range tenantsByUser[userID] {
+			tenant, err := r.tenantPermissions(ctx, userID, tenantID, byUser[userID], roles)
+			if err != nil {
+				return nil, err
+			}
+
+			tenant.OrganizationName = convutil.StringPtr(orgNames[tenantID])
+			user.Tenants = append(user.Tenants, tenant)
+		}
+
+		result = append(result, user)
+	}
+
+	return result, nil
+}
+
+// tenantPermissions 用户在单个租户下的有效权限：全局分配与该组织分配的角色，以及直接授予用户的策略
+func (r *report) tenantPermissions(
+	ctx context.Context,
+	userID, tenantID string,
+	assignments []*models.UserRoleAssignment,
+	roles map[uuid.UUID]*models.RoleDefinition,
+) (*identity_srv.TenantPermissions, error) {
+	tenant := &identity_srv.TenantPermissions{
+		OrganizationID: convutil.StringPtr(tenantID),
+		Roles:          []*identity_srv.RolePermissions{},
+	}
+
+	// 同一角色可能同时存在全局和组织级分配，合并为一行，任一为全局分配即标记为全局
+	global := make(map[uuid.UUID]bool)
+
+	var order []uuid.UUID
+
+	for _, a := range assignments {
+		if !a.IsGlobal() && a.OrganizationID.String() != tenantID {
+			continue
+		}
+
+		if _, seen := global[a.RoleID]; !seen {
+			order = append(order, a.RoleID)
+		}
+
+		global[a.RoleID] = global[a.RoleID] || a.IsGlobal()
+	}
+
+	for _, roleID := range order {
+		role, ok := roles[roleID]
+		if !ok {
+			continue
+		}
+
+		row, err := r.rolePermissions(ctx, role, tenantID)
+		if err != nil {
+			return nil, err
+		}
+
+		row.GlobalAssignment = convutil.BoolPtr(global[roleID])
+		tenant.Roles = append(tenant.Roles, row)
+	}
+
+	direct, err := r.policyPermissions(ctx, tenantID, userID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	tenant.DirectGrants = policyGrants(direct)
+
+	return tenant, nil
+}
+
+// assignedRoles 加载分配涉及的角色及其菜单授权
+func (r *report) assignedRoles(
+	ctx context.Context,
+	assignments []*models.UserRoleAssignment,
+) (map[uuid.UUID]*models.RoleDefinition, error) {
+	roleIDs := make([]string, 0, len(assignments))
+	seen := make(map[uuid.UUID]bool, len(assignments))
+
+	for _, a := range assignments {
+		if !seen[a.RoleID] {
+			seen[a.RoleID] = true
+			roleIDs = append(roleIDs, a.RoleID.String())
+		}
+	}
+
+	list, err := r.l.dal.RoleDefinition().BatchGetByIDs(ctx, roleIDs)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("查询角色失败: %s", err.Error()))
+	}
+
+	if err := r.loadMenuGrants(ctx, list); err != nil {
+		return nil, err
+	}
+
+	roles := make(map[uuid.UUID]*models.RoleDefinition, len(list))
+	for _, role := range list {
+		roles[role.ID] = role
+	}
+
+	return roles, nil
+}
+
+// organizationNames 批量查询组织名称
+func (r *report) organizationNames(ctx context.Context, ids map[string]struct{}) (map[string]string, error) {
+	names := make(map[string]string, len(ids))
+	if len(ids) == 0 {
+		return names, nil
+	}
+
+	orgs, err := r.l.dal.Organization().BatchGetByIDs(ctx, slices.Sorted(maps.Keys(ids)))
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("查询组织失败: %s", err.Error()))
+	}
+
+	for _, org := range orgs {
+		names[org.ID.String()] = org.Name
+	}
+
+	return names, nil
+}
+
+// ============================================================================
+// 辅助函数
+// ============================================================================
+
+// userTenants 用户的租户列表：活跃成员关系所属组织（主成员关系在前）与组织级分配所属组织的并集；
+// 两者都没有时返回空租户，即只有全局分配生效
+func userTenants(memberships []*models.UserMembership, assignments []*models.UserRoleAssignment) []string {
+	var tenants []string
+
+	seen := make(map[string]bool)
+	add := func(id string) {
+		if !seen[id] {
+			seen[id] = true
+			tenants = append(tenants, id)
+		}
+	}
+
+	for _, m := range memberships {
+		add(m.OrganizationID.String())
+	}
+
+	for _, a := range assignments {
+		if !a.IsGlobal() {
+			add(a.OrganizationID.String())
+		}
+	}
+
+	if len(tenants) == 0 {
+		return []string{""}
+	}
+
+	return tenants
+}
+
+func menuGrant(
+	menuID, menuName string,
+	permission models.MenuPermissionType,
+	scope models.DataScope,
+) *identity_srv.PermissionGrant {
+	source := identity_srv.PermissionGrantSource_PERMISSION_GRANT_SOURCE_MENU
+	level := menu.ToThriftPermissionLevel(permission)
+
+	return &identity_srv.PermissionGrant{
+		Source:     &source,
+		MenuID:     convutil.StringPtr(menuID),
+		MenuName:   convutil.StringPtr(menuName),
+		Permission: &level,
+		DataScope:  convutil.StringPtr(scope.String()),
+	}
+}
+
+func policyGrants(perms []iamclient.Permission) []*identity_srv.PermissionGrant {
+	grants := make([]*identity_srv.PermissionGrant, 0, len(perms))
+
+	for _, p := range perms {
+		source := identity_srv.PermissionGrantSource_PERMISSION_GRANT_SOURCE_POLICY
+		grants = append(grants, &identity_srv.PermissionGrant{
+			Source:    &source,
+			Resource:  convutil.StringPtr(p.Resource),
+			Action:    convutil.StringPtr(p.Action),
+			DataScope: convutil.StringPtr(p.DataScope),
+		})
+	}
+
+	return grants
+}
@@ -0,0 +1,430 @@
+package permissionreport
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/iamclient"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/convutil"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/breakglass"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/mock"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/config"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/tabular"
+)
+
+// fakePermissionLister 按租户返回预置的角色与用户策略规则，租户未配置时使用全局域（空租户）
+type fakePermissionLister struct {
+	byRole map[string][]iamclient.Permission
+	byUser map[string][]iamclient.Permission
+	calls  int
+}
+
+func (f *fakePermissionLister) ListPermissions(
+	_ context.Context,
+	userID, tenantID string,
+	roles []string,
+) ([]iamclient.Permission, error) {
+	f.calls++
+
+	var perms []iamclient.Permission
+	if userID != "" {
+		perms = append(perms, f.byUser[userID+"@"+tenantID]...)
+	}
+
+	for _, role := range roles {
+		perms = append(perms, f.byRole[role]...)
+	}
+
+	return perms, nil
+}
+
+// breakGlassUserID 测试中配置为紧急账号的用户ID
+var breakGlassUserID = uuid.New()
+
+// setupTest 初始化测试环境
+func setupTest(t *testing.T) (*LogicImpl, *mock.TestMocks, *fakePermissionLister) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mocks := mock.NewTestMocks(ctrl)
+	policy := &fakePermissionLister{
+		byRole: map[string][]iamclient.Permission{
+			"role:superadmin": {{Resource: "*", Action: "*", DataScope: "all"}},
+			"role:nurse":      {{Resource: "patient", Action: "read", DataScope: "dept"}},
+		},
+		byUser: map[string][]iamclient.Permission{},
+	}
+	guard, err := breakglass.NewGuard(
+		config.BreakGlassConfig{UserIDs: []string{breakGlassUserID.String()}},
+		mocks.AuditLogRepo,
+	)
+	require.NoError(t, err)
+
+	logic := &LogicImpl{
+		dal:        mocks.DAL,
+		policy:     policy,
+		breakGlass: guard,
+	}
+
+	return logic, mocks, policy
+}
+
+// assertErrCode 断言错误码匹配
+func assertErrCode(t *testing.T, expected errno.ErrNo, actual error) {
+	t.Helper()
+
+	errNo, ok := actual.(errno.ErrNo)
+	require.True(t, ok, "expected errno.ErrNo, got %T: %v", actual, actual)
+	assert.Equal(t, expected.ErrCode, errNo.ErrCode)
+}
+
+// testMenus 两级菜单：dashboard、patients > patients_edit（按钮）
+func testMenus() []*models.Menu {
+	dashboard := &models.Menu{SemanticID: "dashboard", Name: "工作台", Version: 3, Sort: 1}
+	dashboard.ID = uuid.New()
+
+	patients := &models.Menu{SemanticID: "patients", Name: "患者", Version: 3, Sort: 2}
+	patients.ID = uuid.New()
+
+	edit := &models.Menu{SemanticID: "patients_edit", Name: "编辑患者", Version: 3, Sort: 1, IsButton: true}
+	edit.ID = uuid.New()
+	edit.ParentID = &patients.ID
+
+	// 乱序返回，验证按菜单树展开
+	return []*models.Menu{edit, patients, dashboard}
+}
+
+func newRole(code, name string) *models.RoleDefinition {
+	role := &models.RoleDefinition{RoleCode: code, Name: name, Status: models.RoleStatusActive}
+	role.ID = uuid.New()
+
+	return role
+}
+
+func newAssignment(userID, roleID uuid.UUID, organizationID *uuid.UUID) *models.UserRoleAssignment {
+	a := &models.UserRoleAssignment{UserID: userID, RoleID: roleID, OrganizationID: organizationID}
+	a.ID = uuid.New()
+
+	return a
+}
+
+// ============================================================================
+// GetPermissionMatrix 测试
+// ============================================================================
+
+func TestLogicImpl_GetPermissionMatrix(t *testing.T) {
+	logic, mocks, _ := setupTest(t)
+	ctx := context.Background()
+
+	admin := newRole("role:superadmin", "超级管理员")
+	nurse := newRole("role:nurse", "护士")
+
+	mocks.MenuRepo.EXPECT().GetLatestMenuTree(gomock.Any(), "default").Return(testMenus(), nil)
+	mocks.DefinitionRepo.EXPECT().ListActiveRoles(gomock.Any(), gomock.Any()).
+		Return([]*models.RoleDefinition{admin, nurse}, models.NewPageResult(2, 1, 20), nil)
+	mocks.RoleMenuRepo.EXPECT().
+		GetByRoleIDsAndProductLine(gomock.Any(), gomock.Len(2), "default").
+		Return([]*models.RoleMenuPermission{
+			{RoleID: nurse.ID, MenuID: "removed", PermissionType: models.PermissionView, DataScope: models.DataScopeOwnOrg},
+			{RoleID: nurse.ID, MenuID: "patients", PermissionType: models.PermissionEdit, DataScope: models.DataScopeOwnOrg},
+		}, nil)
+
+	resp, err := logic.GetPermissionMatrix(ctx, &identity_srv.GetPermissionMatrixRequest{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "default", resp.GetProductLine())
+	assert.Equal(t, int32(3), resp.GetMenuVersion())
+	require.Len(t, resp.GetRoles(), 2)
+
+	// 按角色编码排序
+	nurseRow, adminRow := resp.GetRoles()[0], resp.GetRoles()[1]
+	assert.Equal(t, "role:nurse", nurseRow.GetRoleCode())
+	assert.Equal(t, "role:superadmin", adminRow.GetRoleCode())
+
+	t.Run("通配策略角色拥有全部菜单", func(t *testing.T) {
+		assert.True(t, adminRow.GetFullAccess())
+		require.Len(t, adminRow.GetGrants(), 4)
+
+		var menuIDs []string
+		for _, g := range adminRow.GetGrants()[:3] {
+			assert.Equal(t, identity_srv.PermissionLevel_PERMISSION_LEVEL_FULL, g.GetPermission())
+			assert.Equal(t, "all_orgs", g.GetDataScope())
+			menuIDs = append(menuIDs, g.GetMenuID())
+		}
+
+		assert.Equal(t, []string{"dashboard", "patients", "patients_edit"}, menuIDs)
+
+		wildcard := adminRow.GetGrants()[3]
+		assert.Equal(t, identity_srv.PermissionGrantSource_PERMISSION_GRANT_SOURCE_POLICY, wildcard.GetSource())
+		assert.Equal(t, "*", wildcard.GetResource())
+	})
+
+	t.Run("菜单授权按菜单树排序，已移除的菜单排在最后", func(t *testing.T) {
+		assert.False(t, nurseRow.GetFullAccess())
+		require.Len(t, nurseRow.GetGrants(), 3)
+
+		patients := nurseRow.GetGrants()[0]
+		assert.Equal(t, "patients", patients.GetMenuID())
+		assert.Equal(t, "患者", patients.GetMenuName())
+		assert.Equal(t, identity_srv.PermissionLevel_PERMISSION_LEVEL_WRITE, patients.GetPermission())
+		assert.Equal(t, "own_org", patients.GetDataScope())
+
+		removed := nurseRow.GetGrants()[1]
+		assert.Equal(t, "removed", removed.GetMenuID())
+		assert.Empty(t, removed.GetMenuName())
+
+		policy := nurseRow.GetGrants()[2]
+		assert.Equal(t, "patient", policy.GetResource())
+		assert.Equal(t, "read", policy.GetAction())
+		assert.Equal(t, "dept", policy.GetDataScope())
+	})
+}
+
+func TestLogicImpl_GetPermissionMatrix_InvalidRoleID(t *testing.T) {
+	logic, mocks, _ := setupTest(t)
+
+	mocks.MenuRepo.EXPECT().GetLatestMenuTree(gomock.Any(), "nurse_app").Return(nil, nil)
+
+	_, err := logic.GetPermissionMatrix(context.Background(), &identity_srv.GetPermissionMatrixRequest{
+		ProductLine: convutil.StringPtr("nurse_app"),
+		RoleIDs:     []string{"not-a-uuid"},
+	})
+	assertErrCode(t, errno.ErrInvalidParams, err)
+}
+
+// ============================================================================
+// GetUserEffectivePermissions 测试
+// ============================================================================
+
+func TestLogicImpl_GetUserEffectivePermissions(t *testing.T) {
+	logic, mocks, policy := setupTest(t)
+	ctx := context.Background()
+
+	profile := &models.UserProfile{Username: "alice", RealName: "Alice"}
+	profile.ID = uuid.New()
+
+	orgA, orgB := uuid.New(), uuid.New()
+	admin := newRole("role:superadmin", "超级管理员")
+	nurse := newRole("role:nurse", "护士")
+
+	policy.byUser[profile.ID.String()+"@"+orgB.String()] = []iamclient.Permission{
+		{Resource: "report", Action: "export", DataScope: "org"},
+	}
+
+	mocks.UserRepo.EXPECT().GetByID(gomock.Any(), profile.ID.String()).Return(profile, nil)
+	mocks.MenuRepo.EXPECT().GetLatestMenuTree(gomock.Any(), "default").Return(testMenus(), nil)
+	mocks.AssignmentRepo.EXPECT().
+		FindEffectiveByUserIDs(gomock.Any(), []string{profile.ID.String()}, nil).
+		Return([]*models.UserRoleAssignment{
+			newAssignment(profile.ID, nurse.ID, nil),
+			newAssignment(profile.ID, admin.ID, &orgB),
+			newAssignment(profile.ID, nurse.ID, &orgB),
+		}, nil)
+	mocks.MembershipRepo.EXPECT().
+		GetActiveMembershipsByUserIDs(gomock.Any(), []string{profile.ID.String()}).
+		Return(map[string][]*models.UserMembership{
+			profile.ID.String(): {{UserID: profile.ID, OrganizationID: orgA}},
+		}, nil)
+	mocks.DefinitionRepo.EXPECT().BatchGetByIDs(gomock.Any(), gomock.Len(2)).
+		Return([]*models.RoleDefinition{nurse, admin}, nil)
+	mocks.RoleMenuRepo.EXPECT().GetByRoleIDsAndProductLine(gomock.Any(), gomock.Len(2), "default").
+		Return([]*models.RoleMenuPermission{
+			{RoleID: nurse.ID, MenuID: "dashboard", PermissionType: models.PermissionView, DataScope: models.DataScopeOwnOrg},
+		}, nil)
+
+	orgAModel := &models.Organization{Name: "一院"}
+	orgAModel.ID = orgA
+	orgBModel := &models.Organization{Name: "二院"}
+	orgBModel.ID = orgB
+	mocks.OrgRepo.EXPECT().BatchGetByIDs(gomock.Any(), gomock.Len(2)).
+		Return([]*models.Organization{orgAModel, orgBModel}, nil)
+
+	resp, err := logic.GetUserEffectivePermissions(ctx, &identity_srv.GetUserEffectivePermissionsRequest{
+		UserID: convutil.StringPtr(profile.ID.String()),
+	})
+	require.NoError(t, err)
+
+	user := resp.GetUser()
+	assert.Equal(t, "alice", user.GetUsername())
+	assert.False(t, user.GetBreakGlass())
+	require.Len(t, user.GetTenants(), 2)
+
+	t.Run("成员关系所属组织只有全局分配生效", func(t *testing.T) {
+		tenant := user.GetTenants()[0]
+		assert.Equal(t, orgA.String(), tenant.GetOrganizationID())
+		assert.Equal(t, "一院", tenant.GetOrganizationName())
+		require.Len(t, tenant.GetRoles(), 1)
+		assert.Equal(t, "role:nurse", tenant.GetRoles()[0].GetRoleCode())
+		assert.True(t, tenant.GetRoles()[0].GetGlobalAssignment())
+		assert.Empty(t, tenant.GetDirectGrants())
+	})
+
+	t.Run("组织级分配所属组织合并全局与组织分配", func(t *testing.T) {
+		tenant := user.GetTenants()[1]
+		assert.Equal(t, orgB.String(), tenant.GetOrganizationID())
+		require.Len(t, tenant.GetRoles(), 2)
+
+		nurseRow, adminRow := tenant.GetRoles()[0], tenant.GetRoles()[1]
+		assert.Equal(t, "role:nurse", nurseRow.GetRoleCode())
+		assert.True(t, nurseRow.GetGlobalAssignment(), "同时存在全局分配时标记为全局")
+		assert.Equal(t, "role:superadmin", adminRow.GetRoleCode())
+		assert.False(t, adminRow.GetGlobalAssignment())
+		assert.True(t, adminRow.GetFullAccess())
+
+		require.Len(t, tenant.GetDirectGrants(), 1)
+		assert.Equal(t, "report", tenant.GetDirectGrants()[0].GetResource())
+	})
+
+	// 两个租户各查询一次角色与用户直接策略：nurse×2 + superadmin×1 + 用户×2
+	assert.Equal(t, 5, policy.calls)
+}
+
+func TestLogicImpl_GetUserEffectivePermissions_BreakGlassWithoutTenant(t *testing.T) {
+	logic, mocks, _ := setupTest(t)
+
+	profile := &models.UserProfile{Username: "breakglass"}
+	profile.ID = breakGlassUserID
+
+	mocks.UserRepo.EXPECT().GetByID(gomock.Any(), profile.ID.String()).Return(profile, nil)
+	mocks.MenuRepo.EXPECT().GetLatestMenuTree(gomock.Any(), "default").Return(testMenus(), nil)
+	mocks.AssignmentRepo.EXPECT().FindEffectiveByUserIDs(gomock.Any(), gomock.Any(), nil).Return(nil, nil)
+	mocks.MembershipRepo.EXPECT().GetActiveMembershipsByUserIDs(gomock.Any(), gomock.Any()).Return(nil, nil)
+	mocks.DefinitionRepo.EXPECT().BatchGetByIDs(gomock.Any(), gomock.Len(0)).Return(nil, nil)
+
+	resp, err := logic.GetUserEffectivePermissions(context.Background(), &identity_srv.GetUserEffectivePermissionsRequest{
+		UserID: convutil.StringPtr(profile.ID.String()),
+	})
+	require.NoError(t, err)
+
+	user := resp.GetUser()
+	assert.True(t, user.GetBreakGlass())
+	require.Len(t, user.GetTenants(), 1)
+	assert.Empty(t, user.GetTenants()[0].GetOrganizationID())
+	assert.Empty(t, user.GetTenants()[0].GetRoles())
+}
+
+func TestLogicImpl_GetUserEffectivePermissions_UserNotFound(t *testing.T) {
+	logic, mocks, _ := setupTest(t)
+
+	userID := uuid.New().String()
+	mocks.UserRepo.EXPECT().GetByID(gomock.Any(), userID).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := logic.GetUserEffectivePermissions(context.Background(), &identity_srv.GetUserEffectivePermissionsRequest{
+		UserID: &userID,
+	})
+	assertErrCode(t, errno.ErrUserNotFound, err)
+}
+
+// ============================================================================
+// ExportPermissionReport 测试
+// ============================================================================
+
+func TestLogicImpl_ExportPermissionReport_RoleViewCSV(t *testing.T) {
+	logic, mocks, _ := setupTest(t)
+
+	nurse := newRole("role:nurse", "护士")
+	empty := newRole("role:guest", "访客")
+
+	mocks.MenuRepo.EXPECT().GetLatestMenuTree(gomock.Any(), "default").Return(testMenus(), nil)
+	mocks.DefinitionRepo.EXPECT().BatchGetByIDs(gomock.Any(), gomock.Len(2)).
+		Return([]*models.RoleDefinition{nurse, empty}, nil)
+	mocks.RoleMenuRepo.EXPECT().GetByRoleIDsAndProductLine(gomock.Any(), gomock.Len(2), "default").
+		Return([]*models.RoleMenuPermission{
+			{RoleID: nurse.ID, MenuID: "dashboard", PermissionType: models.PermissionView, DataScope: models.DataScopeOwnOrg},
+		}, nil)
+
+	resp, err := logic.ExportPermissionReport(context.Background(), &identity_srv.ExportPermissionReportRequest{
+		RoleIDs: []string{nurse.ID.String(), empty.ID.String()},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, tabular.CSVContentType, resp.GetContentType())
+	assert.Contains(t, resp.GetFileName(), "permission_matrix_")
+	assert.Equal(t, int32(3), resp.GetTotalRows())
+
+	rows, err := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(resp.GetFileContent(), tabular.UTF8BOM))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+
+	assert.Equal(t, roleHeader, rows[0])
+	// 没有任何授权的角色输出一行空授权
+	assert.Equal(t, []string{"role:guest", "访客", "否", "", "", "", "", "", "", ""}, rows[1])
+	assert.Equal(t, []string{"role:nurse", "护士", "否", "菜单", "dashboard", "工作台", "read", "", "", "own_org"}, rows[2])
+	assert.Equal(t, []string{"role:nurse", "护士", "否", "策略", "", "", "", "patient", "read", "dept"}, rows[3])
+}
+
+func TestLogicImpl_ExportPermissionReport_UserViewXLSX(t *testing.T) {
+	logic, mocks, _ := setupTest(t)
+
+	orgID := uuid.New()
+	nurse := newRole("role:nurse", "护士")
+
+	bob := &models.UserProfile{Username: "bob"}
+	bob.ID = uuid.New()
+	alice := &models.UserProfile{Username: "alice"}
+	alice.ID = uuid.New()
+
+	assignments := []*models.UserRoleAssignment{
+		newAssignment(bob.ID, nurse.ID, nil),
+		newAssignment(alice.ID, nurse.ID, &orgID),
+	}
+
+	organizationID := orgID.String()
+
+	// 未指定用户时先按组织查询持有有效分配的用户，再批量计算
+	mocks.AssignmentRepo.EXPECT().FindEffectiveByUserIDs(gomock.Any(), gomock.Nil(), &organizationID).
+		Return(assignments, nil)
+	mocks.UserRepo.EXPECT().BatchGetByIDs(gomock.Any(), gomock.Len(2)).
+		Return([]*models.UserProfile{bob, alice}, nil)
+	mocks.MenuRepo.EXPECT().GetLatestMenuTree(gomock.Any(), "default").Return(testMenus(), nil)
+	mocks.AssignmentRepo.EXPECT().FindEffectiveByUserIDs(gomock.Any(), gomock.Len(2), &organizationID).
+		Return(assignments, nil)
+	mocks.MembershipRepo.EXPECT().GetActiveMembershipsByUserIDs(gomock.Any(), gomock.Len(2)).Return(nil, nil)
+	mocks.DefinitionRepo.EXPECT().BatchGetByIDs(gomock.Any(), gomock.Len(1)).
+		Return([]*models.RoleDefinition{nurse}, nil)
+	mocks.RoleMenuRepo.EXPECT().GetByRoleIDsAndProductLine(gomock.Any(), gomock.Len(1), "default").Return(nil, nil)
+
+	org := &models.Organization{Name: "一院"}
+	org.ID = orgID
+	mocks.OrgRepo.EXPECT().BatchGetByIDs(gomock.Any(), []string{organizationID}).
+		Return([]*models.Organization{org}, nil)
+
+	format := identity_srv.UserFileFormat_USER_FILE_FORMAT_XLSX
+	view := identity_srv.PermissionReportView_PERMISSION_REPORT_VIEW_USER
+
+	resp, err := logic.ExportPermissionReport(context.Background(), &identity_srv.ExportPermissionReportRequest{
+		Format:         &format,
+		View:           &view,
+		OrganizationID: &organizationID,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, tabular.XLSXContentType, resp.GetContentType())
+	assert.Contains(t, resp.GetFileName(), "user_permissions_")
+	assert.NotEmpty(t, resp.GetFileContent())
+	// 每个用户一个租户、一个角色、一条策略授权
+	assert.Equal(t, int32(2), resp.GetTotalRows())
+}
+
+func TestLogicImpl_ExportPermissionReport_InvalidView(t *testing.T) {
+	logic, _, _ := setupTest(t)
+
+	view := identity_srv.PermissionReportView(99)
+
+	_, err := logic.ExportPermissionReport(context.Background(), &identity_srv.ExportPermissionReportRequest{
+		View: &view,
+	})
+	assertErrCode(t, errno.ErrInvalidParams, err)
+}
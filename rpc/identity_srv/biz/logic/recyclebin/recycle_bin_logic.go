@@ -0,0 +1,32 @@
+package recyclebin
+
+import (
+	"context"
+	"time"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+)
+
+// RecycleBinLogic 回收站业务逻辑接口
+// 负责已软删除的用户、组织、部门的查询、恢复、彻底删除以及保留期到期后的自动清除
+type RecycleBinLogic interface {
+	// ListDeletedEntities 分页查询回收站中指定类型的记录，默认按删除时间倒序
+	ListDeletedEntities(
+		ctx context.Context,
+		req *identity_srv.ListDeletedEntitiesRequest,
+	) (*identity_srv.ListDeletedEntitiesResponse, error)
+
+	// RestoreDeletedEntity 恢复回收站中的记录
+	// 恢复用户时在同一事务内恢复删除用户时级联删除的角色分配与成员关系
+	RestoreDeletedEntity(
+		ctx context.Context,
+		req *identity_srv.RestoreDeletedEntityRequest,
+	) (*identity_srv.RestoreDeletedEntityResponse, error)
+
+	// PurgeDeletedEntity 彻底删除回收站中的记录（物理删除，不可恢复）
+	PurgeDeletedEntity(ctx context.Context, req *identity_srv.PurgeDeletedEntityRequest) error
+
+	// PurgeExpiredEntities 彻底删除删除时间早于 before 的记录，返回清除数量
+	// 仍被未删除数据引用的记录跳过，留待下一轮
+	PurgeExpiredEntities(ctx context.Context, before time.Time) (int, error)
+}
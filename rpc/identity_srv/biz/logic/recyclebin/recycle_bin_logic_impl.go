@@ -0,0 +1,643 @@
+package recyclebin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/department"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/organization"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/user"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/assignment"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/datascope"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+	tracelog "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/log"
+)
+
+// purgeBatchSize 自动清除时每种类型单轮最多处理的记录数
+const purgeBatchSize = 500
+
+// LogicImpl 回收站业务逻辑实现
+type LogicImpl struct {
+	dal       dal.DAL
+	converter converter.Converter
+	retention time.Duration
+}
+
+// NewLogic 创建回收站业务逻辑实例
+// retention 为保留期，仅用于计算条目的自动清除时间；<= 0 表示不自动清除
+func NewLogic(dal dal.DAL, converter converter.Converter, retention time.Duration) RecycleBinLogic {
+	return &LogicImpl{
+		dal:       dal,
+		converter: converter,
+		retention: retention,
+	}
+}
+
+// ============================================================================
+// 查询
+// ============================================================================
+
+// ListDeletedEntities 分页查询回收站中指定类型的记录
+func (l *LogicImpl) ListDeletedEntities(
+	ctx context.Context,
+	req *identity_srv.ListDeletedEntitiesRequest,
+) (*identity_srv.ListDeletedEntitiesResponse, error) {
+	if req == nil {
+		return nil, errno.ErrInvalidParams.WithMessage("请求不能为空")
+	}
+
+	opts := l.converter.Base().PageRequestToQueryOptions(req.Page)
+	if err := opts.Validate(); err != nil {
+		return nil, errno.ErrInvalidParams.WithMessage("分页参数无效: " + err.Error())
+	}
+
+	opts.DeletedOnly = true
+
+	// 未指定排序时按删除时间倒序，最近删除的排在最前
+	if req.Page.GetSort() == "" {
+		opts.WithOrder("deleted_at", true)
+	}
+
+	// 按调用方数据范围限定可见记录，与对应实体的常规列表一致
+	scope, err := datascope.Resolve(ctx, l.dal)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(err.Error())
+	}
+
+	entities := make([]*identity_srv.DeletedEntity, 0)
+
+	var pageResult *models.PageResult
+
+	switch req.GetEntityType() {
+	case identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_USER:
+		users, page, err := l.dal.UserProfile().
+			FindWithConditions(ctx, &user.UserProfileQueryConditions{Scope: scope, Page: opts})
+		if err != nil {
+			return nil, errno.ErrOperationFailed.WithMessage("查询已删除用户失败: " + err.Error())
+		}
+
+		for _, u := range users {
+			entities = append(entities, l.converter.RecycleBin().UserToThrift(u, l.retention))
+		}
+
+		pageResult = page
+
+	case identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_ORGANIZATION:
+		orgs, page, err := l.dal.Organization().
+			FindWithConditions(ctx, &organization.OrganizationQueryConditions{Scope: scope, Page: opts})
+		if err != nil {
+			return nil, errno.ErrOperationFailed.WithMessage("查询已删除组织失败: " + err.Error())
+		}
+
+		for _, org := range orgs {
+			entities = append(entities, l.converter.RecycleBin().OrganizationToThrift(org, l.retention))
+		}
+
+		pageResult = page
+
+	case identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_DEPARTMENT:
+		depts, page, err := l.dal.Department().
+			FindWithConditions(ctx, &department.DepartmentQueryConditions{Scope: scope, Page: opts})
+		if err != nil {
+			return nil, errno.ErrOperationFailed.WithMessage("查询已删除部门失败: " + err.Error())
+		}
+
+		for _, dept := range depts {
+			entities = append(entities, l.converter.RecycleBin().DepartmentToThrift(dept, l.retention))
+		}
+
+		pageResult = page
+
+	default:
+		return nil, errno.ErrInvalidParams.WithMessage("不支持的回收站类型")
+	}
+
+	return &identity_srv.ListDeletedEntitiesResponse{
+		Entities: entities,
+		Page:     l.converter.Base().PageResponseToThrift(pageResult),
+	}, nil
+}
+
+// ============================================================================
+// 恢复
+// ============================================================================
+
+// RestoreDeletedEntity 恢复回收站中的记录
+func (l *LogicImpl) RestoreDeletedEntity(
+	ctx context.Context,
+	req *identity_srv.RestoreDeletedEntityRequest,
+) (*identity_srv.RestoreDeletedEntityResponse, error) {
+	if req == nil || req.GetId() == "" {
+		return nil, errno.ErrInvalidParams.WithMessage("记录ID不能为空")
+	}
+
+	id := req.GetId()
+
+	if err := l.checkInScope(ctx, req.GetEntityType(), id); err != nil {
+		return nil, err
+	}
+
+	switch req.GetEntityType() {
+	case identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_USER:
+		return l.restoreUser(ctx, id)
+
+	case identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_ORGANIZATION:
+		org, err := l.dal.Organization().GetDeletedByID(ctx, id)
+		if err != nil {
+			return nil, l.wrapLookupError(err)
+		}
+
+		// 上级组织已删除时恢复出来的会是悬空节点
+		if org.ParentID != uuid.Nil {
+			exists, err := l.dal.Organization().ExistsByID(ctx, org.ParentID.String())
+			if err != nil {
+				return nil, errno.ErrOperationFailed.WithMessage(err.Error())
+			}
+
+			if !exists {
+				return nil, errno.ErrRestoreParentDeleted
+			}
+		}
+
+		if err := l.dal.Organization().Restore(ctx, id); err != nil {
+			return nil, l.wrapLookupError(err)
+		}
+
+	case identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_DEPARTMENT:
+		dept, err := l.dal.Department().GetDeletedByID(ctx, id)
+		if err != nil {
+			return nil, l.wrapLookupError(err)
+		}
+
+		exists, err := l.dal.Organization().ExistsByID(ctx, dept.OrganizationID.String())
+		if err != nil {
+			return nil, errno.ErrOperationFailed.WithMessage(err.Error())
+		}
+
+		if !exists {
+			return nil, errno.ErrRestoreParentDeleted
+		}
+
+		if err := l.dal.Department().Restore(ctx, id); err != nil {
+			return nil, l.wrapLookupError(err)
+		}
+
+	default:
+		return nil, errno.ErrInvalidParams.WithMessage("不支持的回收站类型")
+	}
+
+	tracelog.Ctx(ctx).Info().
+		Str("entity_type", req.GetEntityType().String()).
+		Str("entity_id", id).
+		Msg("回收站记录已恢复")
+
+	return &identity_srv.RestoreDeletedEntityResponse{}, nil
+}
+
+// restoreUser 恢复用户及删除用户时级联删除的角色分配与成员关系
+// 级联数据与用户共享删除时间戳（见 DeleteUser），据此区分本次删除与更早的单独删除
+func (l *LogicImpl) restoreUser(
+	ctx context.Context,
+	userID string,
+) (*identity_srv.RestoreDeletedEntityResponse, error) {
+	profile, err := l.dal.UserProfile().GetDeletedByID(ctx, userID)
+	if err != nil {
+		return nil, l.wrapLookupError(err)
+	}
+
+	deletedAt := profile.DeletedAt.Time
+
+	var (
+		assignments int
+		skipped     []string
+		memberships int64
+	)
+
+	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		if err := txDAL.UserProfile().Restore(ctx, userID); err != nil {
+			return err
+		}
+
+		var err error
+
+		memberships, err = txDAL.UserMembership().RestoreByUser(ctx, userID, deletedAt)
+		if err != nil {
+			return err
+		}
+
+		assignments, skipped, err = l.restoreUserAssignments(ctx, txDAL, userID, deletedAt)
+
+		return err
+	})
+	if err != nil {
+		var errNo errno.ErrNo
+		if errors.As(err, &errNo) {
+			return nil, errNo
+		}
+
+		if errno.IsRecordNotFound(err) {
+			return nil, errno.ErrDeletedEntityNotFound
+		}
+
+		return nil, errno.ErrOperationFailed.WithMessage("恢复用户失败: " + err.Error())
+	}
+
+	tracelog.Ctx(ctx).Info().
+		Str("user_id", userID).
+		Str("username", profile.Username).
+		Int("role_assignments", assignments).
+		Strs("skipped_roles", skipped).
+		Int64("memberships", memberships).
+		Msg("回收站用户已恢复")
+
+	restoredAssignments := int32(assignments)
+	restoredMemberships := int32(memberships)
+
+	return &identity_srv.RestoreDeletedEntityResponse{
+		RestoredRoleAssignments: &restoredAssignments,
+		RestoredMemberships:     &restoredMemberships,
+		SkippedRoleCodes:        skipped,
+	}, nil
+}
+
+// restoreUserAssignments 恢复删除用户时级联删除的角色分配，返回恢复数量与未恢复的角色编码
+//
+// 恢复等同于重新授权，与 AssignRoleToUser 执行相同的检查：敏感角色需经审批、
+// 违反职责分离约束的分配都不恢复，由管理员重新发起分配；角色已删除的分配直接忽略。
+// 逐条恢复，后续分配的互斥检查能看到前面已恢复的分配。
+func (l *LogicImpl) restoreUserAssignments(
+	ctx context.Context,
+	txDAL dal.DAL,
+	userID string,
+	deletedAt time.Time,
+) (int, []string, error) {
+	deleted, err := txDAL.UserRoleAssignment().FindDeletedByUser(ctx, userID, deletedAt)
+	if err != nil || len(deleted) == 0 {
+		return 0, nil, err
+	}
+
+	roleIDs := make([]string, 0, len(deleted))
+	for _, a := range deleted {
+		roleIDs = append(roleIDs, a.RoleID.String())
+	}
+
+	roles, err := txDAL.RoleDefinition().BatchGetByIDs(ctx, roleIDs)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	roleByID := make(map[uuid.UUID]*models.RoleDefinition, len(roles))
+	for _, role := range roles {
+		roleByID[role.ID] = role
+	}
+
+	// 同一检查器内逐条校验，已恢复的分配登记后计入后续分配的约束
+	constraints := assignment.NewRoleConstraintChecker(txDAL.UserRoleAssignment(), txDAL.RoleDefinition())
+	restored := 0
+
+	var skipped []string
+
+	for _, a := range deleted {
+		role := roleByID[a.RoleID]
+		if role == nil {
+			continue
+		}
+
+		if role.IsSensitive() {
+			skipped = append(skipped, role.RoleCode)
+			continue
+		}
+
+		grants := []assignment.RoleGrant{{UserID: userID, Role: role, ValidFrom: a.ValidFrom, ValidUntil: a.ValidUntil}}
+		if err := constraints.Check(ctx, grants, nil); err != nil {
+			var errNo errno.ErrNo
+			if errors.As(err, &errNo) && errNo.Code() == errno.ErrRoleConstraintViolation.Code() {
+				skipped = append(skipped, role.RoleCode)
+				continue
+			}
+
+			return 0, nil, err
+		}
+
+		if err := txDAL.UserRoleAssignment().Restore(ctx, a.ID.String()); err != nil {
+			return 0, nil, err
+		}
+
+		constraints.Reserve(grants)
+		restored++
+	}
+
+	return restored, skipped, nil
+}
+
+// ============================================================================
+// 彻底删除
+// ============================================================================
+
+// PurgeDeletedEntity 彻底删除回收站中的记录
+func (l *LogicImpl) PurgeDeletedEntity(
+	ctx context.Context,
+	req *identity_srv.PurgeDeletedEntityRequest,
+) error {
+	if req == nil || req.GetId() == "" {
+		return errno.ErrInvalidParams.WithMessage("记录ID不能为空")
+	}
+
+	var purge func(ctx context.Context, id string) error
+
+	switch req.GetEntityType() {
+	case identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_USER:
+		purge = l.purgeUser
+	case identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_ORGANIZATION:
+		purge = l.purgeOrganization
+	case identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_DEPARTMENT:
+		purge = l.purgeDepartment
+	default:
+		return errno.ErrInvalidParams.WithMessage("不支持的回收站类型")
+	}
+
+	if err := l.checkInScope(ctx, req.GetEntityType(), req.GetId()); err != nil {
+		return err
+	}
+
+	if err := purge(ctx, req.GetId()); err != nil {
+		return err
+	}
+
+	tracelog.Ctx(ctx).Info().
+		Str("entity_type", req.GetEntityType().String()).
+		Str("entity_id", req.GetId()).
+		Msg("回收站记录已彻底删除")
+
+	return nil
+}
+
+// purgeUser 物理删除已删除用户及其已删除的角色分配、成员关系、MFA 数据、历史密码与找回密码令牌
+func (l *LogicImpl) purgeUser(ctx context.Context, userID string) error {
+	if _, err := l.dal.UserProfile().GetDeletedByID(ctx, userID); err != nil {
+		return l.wrapLookupError(err)
+	}
+
+	err := l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		if err := txDAL.UserRoleAssignment().PurgeByUser(ctx, userID); err != nil {
+			return err
+		}
+
+		if err := txDAL.UserMembership().PurgeByUser(ctx, userID); err != nil {
+			return err
+		}
+
+		if err := txDAL.MFA().DeleteByUserID(ctx, userID); err != nil {
+			return err
+		}
+
+		if err := txDAL.PasswordHistory().DeleteByUserID(ctx, userID); err != nil {
+			return err
+		}
+
+		if err := txDAL.PasswordResetToken().DeleteByUserID(ctx, userID); err != nil {
+			return err
+		}
+
+		return txDAL.UserProfile().HardDelete(ctx, userID)
+	})
+
+	return l.wrapPurgeError("彻底删除用户失败", err)
+}
+
+// purgeOrganization 物理删除已删除组织及其下已删除的部门、成员关系与角色分配
+// 仍有未删除的子组织、部门、成员关系或角色分配时拒绝
+func (l *LogicImpl) purgeOrganization(ctx context.Context, orgID string) error {
+	if _, err := l.dal.Organization().GetDeletedByID(ctx, orgID); err != nil {
+		return l.wrapLookupError(err)
+	}
+
+	err := l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		counters := []func(ctx context.Context, id string) (int64, error){
+			txDAL.Organization().CountChildren,
+			txDAL.Department().CountByOrganization,
+			txDAL.UserMembership().CountByOrganization,
+			txDAL.UserRoleAssignment().CountByOrganization,
+		}
+
+		for _, count := range counters {
+			n, err := count(ctx, orgID)
+			if err != nil {
+				return err
+			}
+
+			if n > 0 {
+				return errno.ErrPurgeEntityInUse
+			}
+		}
+
+		if err := txDAL.Department().PurgeDeletedByOrganization(ctx, orgID); err != nil {
+			return err
+		}
+
+		if err := txDAL.UserMembership().PurgeDeletedByOrganization(ctx, orgID); err != nil {
+			return err
+		}
+
+		if err := txDAL.UserRoleAssignment().PurgeDeletedByOrganization(ctx, orgID); err != nil {
+			return err
+		}
+
+		if err := txDAL.PasswordPolicy().DeleteByOrganizationID(ctx, orgID); err != nil {
+			return err
+		}
+
+		return txDAL.Organization().HardDelete(ctx, orgID)
+	})
+
+	return l.wrapPurgeError("彻底删除组织失败", err)
+}
+
+// purgeDepartment 物理删除已删除部门及其下已删除的成员关系
+// 仍有未删除的成员关系时拒绝
+func (l *LogicImpl) purgeDepartment(ctx context.Context, deptID string) error {
+	if _, err := l.dal.Department().GetDeletedByID(ctx, deptID); err != nil {
+		return l.wrapLookupError(err)
+	}
+
+	err := l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		members, err := txDAL.UserMembership().CountByDepartmentID(ctx, deptID)
+		if err != nil {
+			return err
+		}
+
+		if members > 0 {
+			return errno.ErrPurgeEntityInUse
+		}
+
+		if err := txDAL.UserMembership().PurgeDeletedByDepartment(ctx, deptID); err != nil {
+			return err
+		}
+
+		return txDAL.Department().HardDelete(ctx, deptID)
+	})
+
+	return l.wrapPurgeError("彻底删除部门失败", err)
+}
+
+// ============================================================================
+// 自动清除
+// ============================================================================
+
+// PurgeExpiredEntities 彻底删除删除时间早于 before 的记录
+// 按部门、用户、组织的顺序处理，使组织清除时其下部门已先行清除
+func (l *LogicImpl) PurgeExpiredEntities(ctx context.Context, before time.Time) (int, error) {
+	depts, err := l.dal.Department().FindDeletedBefore(ctx, before, purgeBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("查询到期部门失败: %w", err)
+	}
+
+	users, err := l.dal.UserProfile().FindDeletedBefore(ctx, before, purgeBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("查询到期用户失败: %w", err)
+	}
+
+	orgs, err := l.dal.Organization().FindDeletedBefore(ctx, before, purgeBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("查询到期组织失败: %w", err)
+	}
+
+	type target struct {
+		entityType string
+		id         string
+		purge      func(ctx context.Context, id string) error
+	}
+
+	targets := make([]target, 0, len(depts)+len(users)+len(orgs))
+	for _, dept := range depts {
+		targets = append(targets, target{"department", dept.ID.String(), l.purgeDepartment})
+	}
+
+	for _, u := range users {
+		targets = append(targets, target{"user", u.ID.String(), l.purgeUser})
+	}
+
+	for _, org := range orgs {
+		targets = append(targets, target{"organization", org.ID.String(), l.purgeOrganization})
+	}
+
+	purged := 0
+
+	for _, t := range targets {
+		if err := t.purge(ctx, t.id); err != nil {
+			if errors.Is(err, errno.ErrPurgeEntityInUse) {
+				tracelog.Ctx(ctx).Warn().
+					Str("entity_type", t.entityType).
+					Str("entity_id", t.id).
+					Msg("回收站记录仍被引用，跳过自动清除")
+
+				continue
+			}
+
+			return purged, fmt.Errorf("自动清除 %s %s 失败: %w", t.entityType, t.id, err)
+		}
+
+		purged++
+	}
+
+	return purged, nil
+}
+
+// ============================================================================
+// 私有辅助方法
+// ============================================================================
+
+// checkInScope 校验回收站记录在调用方数据范围内，范围外的记录按不存在处理
+// 与 ListDeletedEntities 使用同一组仓储过滤条件，保证可见即可操作
+func (l *LogicImpl) checkInScope(
+	ctx context.Context,
+	entityType identity_srv.RecycleBinEntityType,
+	id string,
+) error {
+	scope, err := datascope.Resolve(ctx, l.dal)
+	if err != nil {
+		return errno.ErrOperationFailed.WithMessage(err.Error())
+	}
+
+	if scope.Unrestricted() {
+		return nil
+	}
+
+	opts := base.NewQueryOptions()
+	opts.PageSize = 1
+	opts.DeletedOnly = true
+
+	var found bool
+
+	switch entityType {
+	case identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_USER:
+		users, _, err := l.dal.UserProfile().FindWithConditions(ctx,
+			&user.UserProfileQueryConditions{ID: &id, Scope: scope, Page: opts})
+		if err != nil {
+			return errno.ErrOperationFailed.WithMessage("查询回收站记录失败: " + err.Error())
+		}
+
+		found = len(users) > 0
+
+	case identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_ORGANIZATION:
+		orgs, _, err := l.dal.Organization().FindWithConditions(ctx,
+			&organization.OrganizationQueryConditions{ID: &id, Scope: scope, Page: opts})
+		if err != nil {
+			return errno.ErrOperationFailed.WithMessage("查询回收站记录失败: " + err.Error())
+		}
+
+		found = len(orgs) > 0
+
+	case identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_DEPARTMENT:
+		depts, _, err := l.dal.Department().FindWithConditions(ctx,
+			&department.DepartmentQueryConditions{ID: &id, Scope: scope, Page: opts})
+		if err != nil {
+			return errno.ErrOperationFailed.WithMessage("查询回收站记录失败: " + err.Error())
+		}
+
+		found = len(depts) > 0
+
+	default:
+		return errno.ErrInvalidParams.WithMessage("不支持的回收站类型")
+	}
+
+	if !found {
+		return errno.ErrDeletedEntityNotFound
+	}
+
+	return nil
+}
+
+// wrapLookupError 将回收站记录查询错误转换为业务错误
+func (l *LogicImpl) wrapLookupError(err error) error {
+	if errno.IsRecordNotFound(err) {
+		return errno.ErrDeletedEntityNotFound
+	}
+
+	return errno.ErrOperationFailed.WithMessage("查询回收站记录失败: " + err.Error())
+}
+
+// wrapPurgeError 将彻底删除事务中的错误转换为业务错误，保留引用冲突与系统用户保护
+func (l *LogicImpl) wrapPurgeError(message string, err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, errno.ErrPurgeEntityInUse):
+		return errno.ErrPurgeEntityInUse
+	case errors.Is(err, errno.ErrSystemUserCannotDelete):
+		return errno.ErrSystemUserCannotDelete
+	case errno.IsRecordNotFound(err):
+		return errno.ErrDeletedEntityNotFound
+	default:
+		return errno.ErrOperationFailed.WithMessage(message + ": " + err.Error())
+	}
+}
@@ -0,0 +1,372 @@
+package recyclebin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/department"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/organization"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/user"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/datascope"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/mock"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+)
+
+// setupTest 初始化测试环境
+func setupTest(t *testing.T) (*LogicImpl, *mock.TestMocks) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mocks := mock.NewTestMocks(ctrl)
+	logic := &LogicImpl{
+		dal:       mocks.DAL,
+		converter: mocks.Converter,
+		retention: 30 * 24 * time.Hour,
+	}
+
+	return logic, mocks
+}
+
+// assertErrCode 断言错误码匹配
+func assertErrCode(t *testing.T, expected errno.ErrNo, actual error) {
+	t.Helper()
+
+	errNo, ok := actual.(errno.ErrNo)
+	require.True(t, ok, "expected errno.ErrNo, got %T: %v", actual, actual)
+	assert.Equal(t, expected.ErrCode, errNo.ErrCode)
+}
+
+// deletedModel 构造已软删除的基础模型
+func deletedModel(deletedAt time.Time) models.BaseModel {
+	return models.BaseModel{
+		ID:        uuid.New(),
+		DeletedAt: gorm.DeletedAt{Time: deletedAt, Valid: true},
+	}
+}
+
+func entityType(t identity_srv.RecycleBinEntityType) *identity_srv.RecycleBinEntityType {
+	return &t
+}
+
+// ============================================================================
+// ListDeletedEntities 测试
+// ============================================================================
+
+func TestLogicImpl_ListDeletedEntities(t *testing.T) {
+	t.Run("用户按删除时间倒序并计算自动清除时间", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		deletedAt := time.Now().Add(-time.Hour)
+		profile := &models.UserProfile{BaseModel: deletedModel(deletedAt), Username: "alice"}
+
+		mocks.UserRepo.EXPECT().FindWithConditions(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context,
+				cond *user.UserProfileQueryConditions,
+			) ([]*models.UserProfile, *models.PageResult, error) {
+				assert.True(t, cond.Page.DeletedOnly)
+				assert.Equal(t, "deleted_at", cond.Page.OrderBy)
+				assert.True(t, cond.Page.OrderDesc)
+
+				return []*models.UserProfile{profile}, models.NewPageResult(1, 1, 20), nil
+			})
+
+		resp, err := logic.ListDeletedEntities(ctx, &identity_srv.ListDeletedEntitiesRequest{
+			EntityType: entityType(identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_USER),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Entities, 1)
+
+		entity := resp.Entities[0]
+		assert.Equal(t, "alice", entity.GetName(), "姓名为空时以用户名展示")
+		assert.Equal(t, "alice", entity.GetCode())
+		assert.Equal(t, deletedAt.UnixMilli(), entity.GetDeletedAt())
+		assert.Equal(t, deletedAt.Add(30*24*time.Hour).UnixMilli(), entity.GetPurgeAt())
+	})
+
+	t.Run("按调用方数据范围过滤", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := datascope.WithScope(context.Background(),
+			datascope.Scope{Hint: "org", UserID: "u-1", TenantID: "org-1"})
+
+		mocks.DeptRepo.EXPECT().FindWithConditions(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context,
+				cond *department.DepartmentQueryConditions,
+			) ([]*models.Department, *models.PageResult, error) {
+				assert.True(t, cond.Page.DeletedOnly)
+				assert.Equal(t, &base.DataScopeFilter{
+					Level:           models.DataScopeOrg,
+					UserID:          "u-1",
+					OrganizationIDs: []string{"org-1"},
+				}, cond.Scope)
+
+				return nil, models.NewPageResult(0, 1, 20), nil
+			})
+
+		resp, err := logic.ListDeletedEntities(ctx, &identity_srv.ListDeletedEntitiesRequest{
+			EntityType: entityType(identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_DEPARTMENT),
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, resp.Entities)
+	})
+
+	t.Run("未指定类型返回参数错误", func(t *testing.T) {
+		logic, _ := setupTest(t)
+
+		_, err := logic.ListDeletedEntities(context.Background(), &identity_srv.ListDeletedEntitiesRequest{})
+
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+}
+
+// ============================================================================
+// RestoreDeletedEntity 测试
+// ============================================================================
+
+func TestLogicImpl_RestoreDeletedEntity(t *testing.T) {
+	t.Run("恢复用户时按删除时间戳恢复级联数据", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		deletedAt := time.Now().Truncate(time.Microsecond)
+		profile := &models.UserProfile{BaseModel: deletedModel(deletedAt), Username: "alice"}
+		userID := profile.ID.String()
+
+		role := &models.RoleDefinition{BaseModel: models.BaseModel{ID: uuid.New()}, RoleCode: "doctor"}
+		assignments := []*models.UserRoleAssignment{
+			{BaseModel: deletedModel(deletedAt), UserID: profile.ID, RoleID: role.ID},
+			{BaseModel: deletedModel(deletedAt), UserID: profile.ID, RoleID: uuid.New()},
+		}
+
+		mocks.UserRepo.EXPECT().GetDeletedByID(gomock.Any(), userID).Return(profile, nil)
+		mocks.UserRepo.EXPECT().Restore(gomock.Any(), userID).Return(nil)
+		mocks.MembershipRepo.EXPECT().RestoreByUser(gomock.Any(), userID, deletedAt).Return(int64(1), nil)
+		mocks.AssignmentRepo.EXPECT().FindDeletedByUser(gomock.Any(), userID, deletedAt).Return(assignments, nil)
+		mocks.DefinitionRepo.EXPECT().BatchGetByIDs(gomock.Any(), gomock.Len(2)).
+			Return([]*models.RoleDefinition{role}, nil)
+		mocks.AssignmentRepo.EXPECT().Restore(gomock.Any(), assignments[0].ID.String()).Return(nil)
+
+		resp, err := logic.RestoreDeletedEntity(ctx, &identity_srv.RestoreDeletedEntityRequest{
+			EntityType: entityType(identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_USER),
+			Id:         &userID,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), resp.GetRestoredRoleAssignments())
+		assert.Equal(t, int32(1), resp.GetRestoredMemberships())
+		assert.Empty(t, resp.GetSkippedRoleCodes())
+	})
+
+	t.Run("敏感角色与违反职责分离约束的分配不随用户恢复", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		deletedAt := time.Now().Truncate(time.Microsecond)
+		profile := &models.UserProfile{BaseModel: deletedModel(deletedAt), Username: "alice"}
+		userID := profile.ID.String()
+
+		sensitive := &models.RoleDefinition{
+			BaseModel:        models.BaseModel{ID: uuid.New()},
+			RoleCode:         "auditor",
+			RequiresApproval: true,
+		}
+		limited := &models.RoleDefinition{
+			BaseModel:      models.BaseModel{ID: uuid.New()},
+			RoleCode:       "cashier",
+			Name:           "出纳",
+			MaxAssignments: 1,
+		}
+		assignments := []*models.UserRoleAssignment{
+			{BaseModel: deletedModel(deletedAt), UserID: profile.ID, RoleID: sensitive.ID},
+			{BaseModel: deletedModel(deletedAt), UserID: profile.ID, RoleID: limited.ID},
+		}
+		holder := &models.UserRoleAssignment{UserID: uuid.New(), RoleID: limited.ID}
+
+		mocks.UserRepo.EXPECT().GetDeletedByID(gomock.Any(), userID).Return(profile, nil)
+		mocks.UserRepo.EXPECT().Restore(gomock.Any(), userID).Return(nil)
+		mocks.MembershipRepo.EXPECT().RestoreByUser(gomock.Any(), userID, deletedAt).Return(int64(0), nil)
+		mocks.AssignmentRepo.EXPECT().FindDeletedByUser(gomock.Any(), userID, deletedAt).Return(assignments, nil)
+		mocks.DefinitionRepo.EXPECT().BatchGetByIDs(gomock.Any(), gomock.Len(2)).
+			Return([]*models.RoleDefinition{sensitive, limited}, nil)
+		mocks.AssignmentRepo.EXPECT().
+			FindUnexpiredByRoleIDs(gomock.Any(), []string{limited.ID.String()}, gomock.Nil(), gomock.Any()).
+			Return([]*models.UserRoleAssignment{holder}, nil)
+
+		resp, err := logic.RestoreDeletedEntity(ctx, &identity_srv.RestoreDeletedEntityRequest{
+			EntityType: entityType(identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_USER),
+			Id:         &userID,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), resp.GetRestoredRoleAssignments())
+		assert.Equal(t, []string{"auditor", "cashier"}, resp.GetSkippedRoleCodes())
+	})
+
+	t.Run("记录不在回收站中", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		id := uuid.NewString()
+
+		mocks.UserRepo.EXPECT().GetDeletedByID(gomock.Any(), id).Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := logic.RestoreDeletedEntity(context.Background(), &identity_srv.RestoreDeletedEntityRequest{
+			EntityType: entityType(identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_USER),
+			Id:         &id,
+		})
+
+		assertErrCode(t, errno.ErrDeletedEntityNotFound, err)
+	})
+
+	t.Run("数据范围外的记录按不存在处理", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := datascope.WithScope(context.Background(), datascope.Scope{Hint: "self", UserID: "u-1"})
+		id := uuid.NewString()
+
+		mocks.UserRepo.EXPECT().FindWithConditions(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context,
+				cond *user.UserProfileQueryConditions,
+			) ([]*models.UserProfile, *models.PageResult, error) {
+				assert.Equal(t, id, *cond.ID)
+				assert.True(t, cond.Page.DeletedOnly)
+				assert.Equal(t, models.DataScopeSelf, cond.Scope.Level)
+
+				return nil, models.NewPageResult(0, 1, 1), nil
+			})
+
+		_, err := logic.RestoreDeletedEntity(ctx, &identity_srv.RestoreDeletedEntityRequest{
+			EntityType: entityType(identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_USER),
+			Id:         &id,
+		})
+
+		assertErrCode(t, errno.ErrDeletedEntityNotFound, err)
+	})
+
+	t.Run("所属组织已删除时拒绝恢复部门", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+
+		dept := &models.Department{BaseModel: deletedModel(time.Now()), OrganizationID: uuid.New()}
+		id := dept.ID.String()
+
+		mocks.DeptRepo.EXPECT().GetDeletedByID(gomock.Any(), id).Return(dept, nil)
+		mocks.OrgRepo.EXPECT().ExistsByID(gomock.Any(), dept.OrganizationID.String()).Return(false, nil)
+
+		_, err := logic.RestoreDeletedEntity(context.Background(), &identity_srv.RestoreDeletedEntityRequest{
+			EntityType: entityType(identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_DEPARTMENT),
+			Id:         &id,
+		})
+
+		assertErrCode(t, errno.ErrRestoreParentDeleted, err)
+	})
+}
+
+// ============================================================================
+// PurgeDeletedEntity / PurgeExpiredEntities 测试
+// ============================================================================
+
+func TestLogicImpl_PurgeDeletedEntity(t *testing.T) {
+	t.Run("部门仍有成员时拒绝彻底删除", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+
+		dept := &models.Department{BaseModel: deletedModel(time.Now())}
+		id := dept.ID.String()
+
+		mocks.DeptRepo.EXPECT().GetDeletedByID(gomock.Any(), id).Return(dept, nil)
+		mocks.MembershipRepo.EXPECT().CountByDepartmentID(gomock.Any(), id).Return(int64(3), nil)
+
+		err := logic.PurgeDeletedEntity(context.Background(), &identity_srv.PurgeDeletedEntityRequest{
+			EntityType: entityType(identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_DEPARTMENT),
+			Id:         &id,
+		})
+
+		assertErrCode(t, errno.ErrPurgeEntityInUse, err)
+	})
+
+	t.Run("数据范围外的组织不允许彻底删除", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := datascope.WithScope(context.Background(),
+			datascope.Scope{Hint: "org", UserID: "u-1", TenantID: "org-1"})
+		id := uuid.NewString()
+
+		mocks.OrgRepo.EXPECT().FindWithConditions(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context,
+				cond *organization.OrganizationQueryConditions,
+			) ([]*models.Organization, *models.PageResult, error) {
+				assert.Equal(t, id, *cond.ID)
+				assert.Equal(t, []string{"org-1"}, cond.Scope.OrganizationIDs)
+
+				return nil, models.NewPageResult(0, 1, 1), nil
+			})
+
+		err := logic.PurgeDeletedEntity(ctx, &identity_srv.PurgeDeletedEntityRequest{
+			EntityType: entityType(identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_ORGANIZATION),
+			Id:         &id,
+		})
+
+		assertErrCode(t, errno.ErrDeletedEntityNotFound, err)
+	})
+
+	t.Run("彻底删除用户时清理关联数据", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+
+		profile := &models.UserProfile{BaseModel: deletedModel(time.Now())}
+		id := profile.ID.String()
+
+		mocks.UserRepo.EXPECT().GetDeletedByID(gomock.Any(), id).Return(profile, nil)
+		mocks.AssignmentRepo.EXPECT().PurgeByUser(gomock.Any(), id).Return(nil)
+		mocks.MembershipRepo.EXPECT().PurgeByUser(gomock.Any(), id).Return(nil)
+		mocks.MFARepo.EXPECT().DeleteByUserID(gomock.Any(), id).Return(nil)
+		mocks.HistoryRepo.EXPECT().DeleteByUserID(gomock.Any(), id).Return(nil)
+		mocks.ResetTokenRepo.EXPECT().DeleteByUserID(gomock.Any(), id).Return(nil)
+		mocks.UserRepo.EXPECT().HardDelete(gomock.Any(), id).Return(nil)
+
+		err := logic.PurgeDeletedEntity(context.Background(), &identity_srv.PurgeDeletedEntityRequest{
+			EntityType: entityType(identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_USER),
+			Id:         &id,
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func TestLogicImpl_PurgeExpiredEntities(t *testing.T) {
+	t.Run("跳过仍被引用的记录", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		before := time.Now()
+
+		inUse := &models.Department{BaseModel: deletedModel(before.Add(-time.Hour))}
+		free := &models.Department{BaseModel: deletedModel(before.Add(-time.Hour))}
+
+		mocks.DeptRepo.EXPECT().FindDeletedBefore(gomock.Any(), before, purgeBatchSize).
+			Return([]*models.Department{inUse, free}, nil)
+		mocks.UserRepo.EXPECT().FindDeletedBefore(gomock.Any(), before, purgeBatchSize).Return(nil, nil)
+		mocks.OrgRepo.EXPECT().FindDeletedBefore(gomock.Any(), before, purgeBatchSize).Return(nil, nil)
+
+		mocks.DeptRepo.EXPECT().GetDeletedByID(gomock.Any(), inUse.ID.String()).Return(inUse, nil)
+		mocks.MembershipRepo.EXPECT().CountByDepartmentID(gomock.Any(), inUse.ID.String()).Return(int64(1), nil)
+
+		mocks.DeptRepo.EXPECT().GetDeletedByID(gomock.Any(), free.ID.String()).Return(free, nil)
+		mocks.MembershipRepo.EXPECT().CountByDepartmentID(gomock.Any(), free.ID.String()).Return(int64(0), nil)
+		mocks.MembershipRepo.EXPECT().PurgeDeletedByDepartment(gomock.Any(), free.ID.String()).Return(nil)
+		mocks.DeptRepo.EXPECT().HardDelete(gomock.Any(), free.ID.String()).Return(nil)
+
+		count, err := logic.PurgeExpiredEntities(context.Background(), before)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+}
@@ -0,0 +1,253 @@
+package user
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/tabular"
+)
+
+// ============================================================================
+// 用户导入/导出文件读写
+// ============================================================================
+
+const (
+	// userFileSheetName XLSX 导出时的工作表名称，导入时读取第一个工作表
+	userFileSheetName = "用户"
+
+	// xlsxUnzipSizeLimit XLSX 解压后的大小上限，防止压缩炸弹
+	xlsxUnzipSizeLimit = 64 << 20
+)
+
+// 文件列键，表头既可使用键也可使用中文名（见 userFileColumns）
+const (
+	colUsername           = "username"
+	colPassword           = "password"
+	colRealName           = "real_name"
+	colLastName           = "last_name"
+	colFirstName          = "first_name"
+	colEmail              = "email"
+	colPhone              = "phone"
+	colGender             = "gender"
+	colProfessionalTitle  = "professional_title"
+	colEmployeeID         = "employee_id"
+	colMustChangePassword = "must_change_password"
+	colStatus             = "status"
+	colOrganizationCodes  = "organization_codes"
+	colDepartmentNames    = "department_names"
+	colRoleCodes          = "role_codes"
+)
+
+// userFileColumn 导入/导出文件的列定义
+type userFileColumn struct {
+	key   string
+	label string
+	// importOnly 仅导入使用（如密码），exportOnly 仅导出使用（如状态），导入时忽略
+	importOnly bool
+	exportOnly bool
+}
+
+// userFileColumns 列顺序即导出顺序；导入按表头匹配，不要求顺序，未知列忽略
+var userFileColumns = []userFileColumn{
+	{key: colUsername, label: "用户名"},
+	{key: colPassword, label: "密码", importOnly: true},
+	{key: colRealName, label: "姓名"},
+	{key: colLastName, label: "姓"},
+	{key: colFirstName, label: "名"},
+	{key: colEmail, label: "邮箱"},
+	{key: colPhone, label: "手机号"},
+	{key: colGender, label: "性别"},
+	{key: colProfessionalTitle, label: "职称"},
+	{key: colEmployeeID, label: "工号"},
+	{key: colMustChangePassword, label: "首次登录修改密码"},
+	{key: colStatus, label: "状态", exportOnly: true},
+	{key: colOrganizationCodes, label: "组织代码"},
+	{key: colDepartmentNames, label: "部门名称"},
+	{key: colRoleCodes, label: "角色编码"},
+}
+
+// resolveUserFileHeader 将表头映射为列键到列下标，缺少用户名或密码列时返回错误
+func resolveUserFileHeader(header []string) (map[string]int, error) {
+	index := make(map[string]int, len(header))
+
+	for i, cell := range header {
+		name := strings.ToLower(strings.TrimSpace(cell))
+
+		for _, col := range userFileColumns {
+			if col.exportOnly {
+				continue
+			}
+
+			if name == col.key || name == col.label {
+				if _, dup := index[col.key]; dup {
+					return nil, fmt.Errorf("表头列重复: %s", strings.TrimSpace(cell))
+				}
+
+				index[col.key] = i
+			}
+		}
+	}
+
+	for _, required := range []string{colUsername, colPassword} {
+		if _, ok := index[required]; !ok {
+			return nil, fmt.Errorf("缺少必填列: %s", required)
+		}
+	}
+
+	return index, nil
+}
+
+// readUserFile 读取导入文件的全部行（含表头），跳过完全空白的行
+// 返回的 rowNumbers[i] 为 rows[i] 在文件中的行号（从 1 开始），用于逐行报告
+func readUserFile(
+	format identity_srv.UserFileFormat,
+	content []byte,
+) (rows [][]string, rowNumbers []int, err error) {
+	var (
+		raw     [][]string
+		numbers []int
+	)
+
+	switch format {
+	case identity_srv.UserFileFormat_USER_FILE_FORMAT_CSV:
+		raw, numbers, err = readCSV(content)
+	case identity_srv.UserFileFormat_USER_FILE_FORMAT_XLSX:
+		raw, numbers, err = readXLSX(content)
+	default:
+		return nil, nil, fmt.Errorf("不支持的文件格式")
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i, row := range raw {
+		if isBlankRow(row) {
+			continue
+		}
+
+		rows = append(rows, row)
+		rowNumbers = append(rowNumbers, numbers[i])
+	}
+
+	return rows, rowNumbers, nil
+}
+
+func readCSV(content []byte) ([][]string, []int, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(content, tabular.UTF8BOM)))
+	// 允许各行列数不一致，缺失的列按空值处理
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var (
+		rows    [][]string
+		numbers []int
+	)
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, numbers, nil
+		}
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("解析 CSV 失败: %w", err)
+		}
+
+		// CSV 解析会跳过空行，行号取记录首个字段所在行
+		line, _ := reader.FieldPos(0)
+
+		rows = append(rows, record)
+		numbers = append(numbers, line)
+	}
+}
+
+func readXLSX(content []byte) ([][]string, []int, error) {
+	file, err := excelize.OpenReader(bytes.NewReader(content), excelize.Options{
+		UnzipSizeLimit:    xlsxUnzipSizeLimit,
+		UnzipXMLSizeLimit: xlsxUnzipSizeLimit,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("解析 XLSX 失败: %w", err)
+	}
+
+	defer func() { _ = file.Close() }()
+
+	sheets := file.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, nil, fmt.Errorf("XLSX 文件不包含工作表")
+	}
+
+	rows, err := file.GetRows(sheets[0])
+	if err != nil {
+		return nil, nil, fmt.Errorf("读取工作表失败: %w", err)
+	}
+
+	// GetRows 保留中间的空行，下标即行号
+	numbers := make([]int, len(rows))
+	for i := range rows {
+		numbers[i] = i + 1
+	}
+
+	return rows, numbers, nil
+}
+
+// writeUserFile 将表头与数据行写为指定格式的文件，返回文件内容与 Content-Type
+func writeUserFile(
+	format identity_srv.UserFileFormat,
+	header []string,
+	rows [][]string,
+) ([]byte, string, error) {
+	switch format {
+	case identity_srv.UserFileFormat_USER_FILE_FORMAT_CSV:
+		content, err := tabular.WriteCSV(header, rows)
+		return content, tabular.CSVContentType, err
+	case identity_srv.UserFileFormat_USER_FILE_FORMAT_XLSX:
+		content, err := tabular.WriteXLSX(userFileSheetName, header, rows)
+		return content, tabular.XLSXContentType, err
+	default:
+		return nil, "", fmt.Errorf("不支持的文件格式")
+	}
+}
+
+// userFileExtension 导出文件扩展名
+func userFileExtension(format identity_srv.UserFileFormat) string {
+	if format == identity_srv.UserFileFormat_USER_FILE_FORMAT_XLSX {
+		return "xlsx"
+	}
+
+	return "csv"
+}
+
+// splitCellList 拆分单元格中的多值列表（分号、逗号，含全角），保留空项以便按位置对齐
+func splitCellList(value string) []string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+
+	parts := strings.Split(strings.NewReplacer("；", ";", "，", ";", ",", ";").Replace(value), ";")
+
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+
+	return parts
+}
+
+func isBlankRow(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+
+	return true
+}
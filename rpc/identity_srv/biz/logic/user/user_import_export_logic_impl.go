@@ -0,0 +1,954 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/user"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/assignment"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/datascope"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/core"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+	tracelog "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/log"
+)
+
+const (
+	// defaultImportBatchSize 未指定批次大小时每个事务提交的行数
+	defaultImportBatchSize = 100
+	// maxImportBatchSize 单个事务最多提交的行数
+	maxImportBatchSize = 500
+	// maxImportRows 单个文件最多导入的数据行数
+	maxImportRows = 5000
+	// exportPageSize 导出时每次游标查询的用户数
+	exportPageSize = 500
+)
+
+// importRow 导入文件中的一行及其解析、校验结果
+type importRow struct {
+	result *identity_srv.UserImportRowResult
+	req    *identity_srv.CreateUserRequest
+
+	// userID 预先生成的用户ID，校验职责分离约束时标识该行，写入时作为新用户主键
+	userID uuid.UUID
+
+	organizationCodes []string
+	departmentNames   []string
+	roleCodes         []string
+
+	// 校验通过后解析出的成员关系与角色，第一个成员关系为主成员关系
+	memberships []importMembership
+	roles       []*models.RoleDefinition
+}
+
+// importMembership 导入行解析出的成员关系，departmentID 为空表示仅加入组织
+type importMembership struct {
+	organizationID uuid.UUID
+	departmentID   uuid.UUID
+}
+
+// ============================================================================
+// 批量导入
+// ============================================================================
+
+// ImportUsers 从 CSV/XLSX 文件批量导入用户
+func (l *LogicImpl) ImportUsers(
+	ctx context.Context,
+	req *identity_srv.ImportUsersRequest,
+	canAssignRoles bool,
+) (*identity_srv.ImportUsersResponse, error) {
+	if req == nil || len(req.FileContent) == 0 {
+		return nil, errno.ErrInvalidParams.WithMessage("导入文件不能为空")
+	}
+
+	batchSize := int(req.GetBatchSize())
+	if batchSize <= 0 {
+		batchSize = defaultImportBatchSize
+	}
+
+	if batchSize > maxImportBatchSize {
+		return nil, errno.ErrInvalidParams.WithMessage(
+			fmt.Sprintf("批次大小不能超过 %d", maxImportBatchSize),
+		)
+	}
+
+	records, rowNumbers, err := readUserFile(req.GetFormat(), req.FileContent)
+	if err != nil {
+		return nil, errno.ErrInvalidParams.WithMessage(err.Error())
+	}
+
+	if len(records) == 0 {
+		return nil, errno.ErrInvalidParams.WithMessage("导入文件缺少表头")
+	}
+
+	columns, err := resolveUserFileHeader(records[0])
+	if err != nil {
+		return nil, errno.ErrInvalidParams.WithMessage(err.Error())
+	}
+
+	records, rowNumbers = records[1:], rowNumbers[1:]
+	if len(records) == 0 {
+		return nil, errno.ErrInvalidParams.WithMessage("导入文件没有数据行")
+	}
+
+	if len(records) > maxImportRows {
+		return nil, errno.ErrInvalidParams.WithMessage(
+			fmt.Sprintf("单次最多导入 %d 行，当前 %d 行", maxImportRows, len(records)),
+		)
+	}
+
+	rows, err := l.validateImportRows(ctx, columns, records, rowNumbers, canAssignRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	validRows := make([]*importRow, 0, len(rows))
+
+	for _, row := range rows {
+		if len(row.result.Errors) == 0 {
+			validRows = append(validRows, row)
+		}
+	}
+
+	createdRows := 0
+	if !req.GetDryRun() {
+		createdRows = l.commitImportRows(ctx, validRows, batchSize, req.GetOperatorID())
+	}
+
+	results := make([]*identity_srv.UserImportRowResult, 0, len(rows))
+	failedRows := 0
+
+	for _, row := range rows {
+		if len(row.result.Errors) > 0 {
+			failedRows++
+		}
+
+		results = append(results, row.result)
+	}
+
+	return &identity_srv.ImportUsersResponse{
+		DryRun:      req.DryRun,
+		TotalRows:   int32Ptr(len(rows)),
+		ValidRows:   int32Ptr(len(validRows)),
+		CreatedRows: int32Ptr(createdRows),
+		FailedRows:  int32Ptr(failedRows),
+		Rows:        results,
+	}, nil
+}
+
+// validateImportRows 逐行解析并校验，校验规则与 CreateUser 一致，另外检查文件内的重复
+// 行内问题记入该行结果；查询失败等非数据问题直接返回错误，终止本次导入
+func (l *LogicImpl) validateImportRows(
+	ctx context.Context,
+	columns map[string]int,
+	records [][]string,
+	rowNumbers []int,
+	canAssignRoles bool,
+) ([]*importRow, error) {
+	lookup := newImportLookup(l.dal)
+	// 规则与 AssignRoleToUser 一致；前面通过校验的行登记后计入分配人数，试运行与正式导入结果一致
+	constraints := assignment.NewRoleConstraintChecker(l.dal.UserRoleAssignment(), l.dal.RoleDefinition())
+	rows := make([]*importRow, 0, len(records))
+
+	// 文件内已出现的用户名、邮箱、手机号 -> 首次出现的行号
+	seen := map[string]map[string]int{
+		colUsername: {},
+		colEmail:    {},
+		colPhone:    {},
+	}
+
+	for i, record := range records {
+		row := parseImportRow(record, columns, rowNumbers[i])
+		rows = append(rows, row)
+
+		if err := l.validateImportRow(ctx, row, lookup, canAssignRoles); err != nil {
+			return nil, err
+		}
+
+		for _, field := range []struct {
+			key   string
+			label string
+			value *string
+		}{
+			{colUsername, "用户名", row.req.Username},
+			{colEmail, "邮箱", row.req.Email},
+			{colPhone, "手机号", row.req.Phone},
+		} {
+			if field.value == nil {
+				continue
+			}
+
+			value := strings.ToLower(*field.value)
+			if first, dup := seen[field.key][value]; dup {
+				row.addError(fmt.Sprintf("%s与第 %d 行重复: %s", field.label, first, *field.value))
+				continue
+			}
+
+			seen[field.key][value] = rowNumbers[i]
+		}
+
+		// 职责分离约束只对其余校验都通过的行检查，计数才与最终会写入的分配一致
+		if len(row.result.Errors) == 0 && len(row.roles) > 0 {
+			grants := make([]assignment.RoleGrant, 0, len(row.roles))
+			for _, role := range row.roles {
+				grants = append(grants, assignment.RoleGrant{UserID: row.userID.String(), Role: role})
+			}
+
+			if err := constraints.Check(ctx, grants, nil); err != nil {
+				if err := row.addErrNo(err); err != nil {
+					return nil, err
+				}
+			} else {
+				constraints.Reserve(grants)
+			}
+		}
+	}
+
+	return rows, nil
+}
+
+// validateImportRow 校验单行：组织/部门引用、创建参数、唯一性与角色引用
+func (l *LogicImpl) validateImportRow(
+	ctx context.Context,
+	row *importRow,
+	lookup *importLookup,
+	canAssignRoles bool,
+) error {
+	// 先解析成员关系：初始密码按主成员关系（第一个组织）的密码策略校验
+	if err := l.resolveImportMemberships(ctx, row, lookup); err != nil {
+		return err
+	}
+
+	if len(row.memberships) > 0 {
+		orgID := row.memberships[0].organizationID.String()
+		row.req.OrganizationID = &orgID
+	}
+
+	// 与 CreateUser 相同的参数校验；模型层的字段规则（如用户名长度）在写库时才会触发，这里一并提前校验
+	if err := l.validateCreateUserRequest(ctx, row.req); err != nil {
+		if err := row.addErrNo(err); err != nil {
+			return err
+		}
+	} else if err := (&models.UserProfile{Username: row.req.GetUsername()}).ValidateForCreate(); err != nil {
+		row.addError(err.Error())
+	} else if err := l.checkCreateUniqueConstraints(ctx, row.req); err != nil {
+		if err := row.addErrNo(err); err != nil {
+			return err
+		}
+	}
+
+	// 导入写入的角色分配与 AssignRoleToUser 同样需要 assign role_assignment 权限
+	if !canAssignRoles && slices.ContainsFunc(row.roleCodes, func(code string) bool { return code != "" }) {
+		row.addError("缺少 assign role_assignment 权限，不能随导入分配角色")
+		return nil
+	}
+
+	return l.resolveImportRoles(ctx, row, lookup)
+}
+
+// resolveImportMemberships 按位置对应组织代码与部门名称，解析为成员关系
+func (l *LogicImpl) resolveImportMemberships(
+	ctx context.Context,
+	row *importRow,
+	lookup *importLookup,
+) error {
+	if len(row.departmentNames) > len(row.organizationCodes) {
+		row.addError("部门名称数量多于组织代码，无法确定部门所属组织")
+		return nil
+	}
+
+	seen := make(map[importMembership]bool, len(row.organizationCodes))
+
+	for i, code := range row.organizationCodes {
+		deptName := ""
+		if i < len(row.departmentNames) {
+			deptName = row.departmentNames[i]
+		}
+
+		if code == "" {
+			if deptName != "" {
+				row.addError(fmt.Sprintf("部门缺少对应的组织代码: %s", deptName))
+			}
+
+			continue
+		}
+
+		org, err := lookup.organization(ctx, code)
+		if err != nil {
+			return err
+		}
+
+		if org == nil {
+			row.addError(fmt.Sprintf("组织不存在: %s", code))
+			continue
+		}
+
+		membership := importMembership{organizationID: org.ID}
+
+		if deptName != "" {
+			dept, err := lookup.department(ctx, org.ID, deptName)
+			if err != nil {
+				return err
+			}
+
+			if dept == nil {
+				row.addError(fmt.Sprintf("部门不存在: %s/%s", code, deptName))
+				continue
+			}
+
+			membership.departmentID = dept.ID
+		}
+
+		if seen[membership] {
+			continue
+		}
+
+		seen[membership] = true
+		row.memberships = append(row.memberships, membership)
+	}
+
+	return nil
+}
+
+// resolveImportRoles 解析角色编码（也接受角色名称），重复的角色只分配一次
+func (l *LogicImpl) resolveImportRoles(
+	ctx context.Context,
+	row *importRow,
+	lookup *importLookup,
+) error {
+	seen := make(map[uuid.UUID]bool, len(row.roleCodes))
+
+	for _, code := range row.roleCodes {
+		if code == "" {
+			continue
+		}
+
+		role, err := lookup.role(ctx, code)
+		if err != nil {
+			return err
+		}
+
+		if role == nil {
+			row.addError(fmt.Sprintf("角色不存在: %s", code))
+			continue
+		}
+
+		if seen[role.ID] {
+			continue
+		}
+
+		seen[role.ID] = true
+
+		// 导入不经审批直接写入分配，敏感角色需在导入后单独发起授权申请
+		if role.IsSensitive() {
+			if err := row.addErrNo(errno.ErrRoleApprovalRequired.WithMessage(fmt.Sprintf(
+				"角色 %s 需经审批才能分配，请在导入后为该用户单独发起授权申请", role.Name,
+			))); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		row.roles = append(row.roles, role)
+	}
+
+	return nil
+}
+
+// commitImportRows 按批次提交通过校验的行，每批一个事务，返回成功创建的用户数
+// 某一批失败时整批回滚并把原因记入该批每一行，继续提交后续批次
+func (l *LogicImpl) commitImportRows(
+	ctx context.Context,
+	rows []*importRow,
+	batchSize int,
+	operatorID string,
+) int {
+	var createdBy *uuid.UUID
+	if id, err := uuid.Parse(operatorID); err == nil {
+		createdBy = &id
+	}
+
+	created := 0
+
+	for start := 0; start < len(rows); start += batchSize {
+		batch := rows[start:min(start+batchSize, len(rows))]
+		userIDs := make([]string, len(batch))
+
+		err := l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+			for i, row := range batch {
+				userID, err := l.createImportedUser(ctx, txDAL, row, createdBy)
+				if err != nil {
+					return fmt.Errorf("第 %d 行写入失败: %w", row.result.GetRowNumber(), err)
+				}
+
+				userIDs[i] = userID
+			}
+
+			return nil
+		})
+		if err != nil {
+			tracelog.Ctx(ctx).Warn().
+				Err(err).
+				Int("batch_start_row", int(batch[0].result.GetRowNumber())).
+				Int("batch_size", len(batch)).
+				Msg("用户导入批次提交失败")
+
+			for _, row := range batch {
+				row.addError("所在批次提交失败，本批次未导入: " + err.Error())
+			}
+
+			continue
+		}
+
+		for i, row := range batch {
+			row.result.UserID = &userIDs[i]
+		}
+
+		created += len(batch)
+	}
+
+	return created
+}
+
+// createImportedUser 在事务中创建一行对应的用户、成员关系与角色分配
+func (l *LogicImpl) createImportedUser(
+	ctx context.Context,
+	txDAL dal.DAL,
+	row *importRow,
+	createdBy *uuid.UUID,
+) (string, error) {
+	profile := l.converter.UserProfile().CreateUserRequestToModel(row.req)
+	profile.ID = row.userID
+
+	if err := txDAL.UserProfile().Create(ctx, profile); err != nil {
+		return "", err
+	}
+
+	if err := l.passwordPolicy.Record(ctx, txDAL, row.req.GetOrganizationID(), profile.ID, profile.PasswordHash); err != nil {
+		return "", err
+	}
+
+	for i, m := range row.memberships {
+		membership := &models.UserMembership{
+			UserID:         profile.ID,
+			OrganizationID: m.organizationID,
+			DepartmentID:   m.departmentID,
+			Status:         models.MembershipStatusActive,
+			IsPrimary:      i == 0,
+		}
+
+		if err := txDAL.UserMembership().Create(ctx, membership); err != nil {
+			return "", err
+		}
+	}
+
+	for _, role := range row.roles {
+		assignment := &models.UserRoleAssignment{
+			UserID:    profile.ID,
+			RoleID:    role.ID,
+			CreatedBy: createdBy,
+		}
+
+		if err := txDAL.UserRoleAssignment().Create(ctx, assignment); err != nil {
+			return "", err
+		}
+	}
+
+	return profile.ID.String(), nil
+}
+
+// parseImportRow 把一行单元格解析为创建请求，格式问题记入行结果
+func parseImportRow(record []string, columns map[string]int, rowNumber int) *importRow {
+	raw := func(key string) string {
+		idx, ok := columns[key]
+		if !ok || idx >= len(record) {
+			return ""
+		}
+
+		return record[idx]
+	}
+
+	cell := func(key string) string { return strings.TrimSpace(raw(key)) }
+
+	optional := func(key string) *string {
+		if value := cell(key); value != "" {
+			return &value
+		}
+
+		return nil
+	}
+
+	req := &identity_srv.CreateUserRequest{
+		Username:          optional(colUsername),
+		Email:             optional(colEmail),
+		Phone:             optional(colPhone),
+		FirstName:         optional(colFirstName),
+		LastName:          optional(colLastName),
+		RealName:          optional(colRealName),
+		ProfessionalTitle: optional(colProfessionalTitle),
+		EmployeeID:        optional(colEmployeeID),
+	}
+
+	// 密码按原样使用，不去除首尾空白
+	if password := raw(colPassword); password != "" {
+		req.Password = &password
+	}
+
+	row := &importRow{
+		result: &identity_srv.UserImportRowResult{
+			RowNumber: int32Ptr(rowNumber),
+			Username:  req.Username,
+		},
+		req:               req,
+		userID:            uuid.New(),
+		organizationCodes: splitCellList(cell(colOrganizationCodes)),
+		departmentNames:   splitCellList(cell(colDepartmentNames)),
+		roleCodes:         splitCellList(cell(colRoleCodes)),
+	}
+
+	if value := cell(colGender); value != "" {
+		if gender, ok := parseImportGender(value); ok {
+			req.Gender = &gender
+		} else {
+			row.addError("性别无效: " + value)
+		}
+	}
+
+	if value := cell(colMustChangePassword); value != "" {
+		if mustChange, ok := parseImportBool(value); ok {
+			req.MustChangePassword = &mustChange
+		} else {
+			row.addError("首次登录修改密码取值无效: " + value)
+		}
+	}
+
+	return row
+}
+
+// addError 记录一条行内错误
+func (r *importRow) addError(message string) {
+	r.result.Errors = append(r.result.Errors, message)
+}
+
+// addErrNo 把业务错误记入行结果；查询失败等非数据问题原样返回，由调用方终止导入
+func (r *importRow) addErrNo(err error) error {
+	var errNo errno.ErrNo
+	if errors.As(err, &errNo) && errNo.Code() != errno.ErrOperationFailed.Code() {
+		r.addError(errNo.Message())
+		return nil
+	}
+
+	return err
+}
+
+// ============================================================================
+// 批量导出
+// ============================================================================
+
+// ExportUsers 导出调用方数据范围内的用户及其成员关系与角色
+func (l *LogicImpl) ExportUsers(
+	ctx context.Context,
+	req *identity_srv.ExportUsersRequest,
+) (*identity_srv.ExportUsersResponse, error) {
+	format := identity_srv.UserFileFormat_USER_FILE_FORMAT_CSV
+	if req != nil && req.GetFormat() != identity_srv.UserFileFormat_USER_FILE_FORMAT_UNSPECIFIED {
+		format = req.GetFormat()
+	}
+
+	conditions := &user.UserProfileQueryConditions{}
+
+	if req != nil {
+		if req.Status != nil {
+			status := models.UserStatus(*req.Status)
+			conditions.Status = &status
+		}
+
+		if req.GetOrganizationID() != "" {
+			conditions.OrgID = req.OrganizationID
+		}
+	}
+
+	scope, err := datascope.Resolve(ctx, l.dal)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(err.Error())
+	}
+
+	conditions.Scope = scope
+
+	// 按创建时间正序游标遍历，导出过程中新增的用户不会打乱已读取的页
+	opts := base.NewQueryOptions().WithCursor("")
+	opts.PageSize = exportPageSize
+	opts.OrderDesc = false
+	conditions.Page = opts
+
+	header := make([]string, 0, len(userFileColumns))
+
+	for _, col := range userFileColumns {
+		if !col.importOnly {
+			header = append(header, col.label)
+		}
+	}
+
+	var records [][]string
+
+	for {
+		profiles, page, err := l.dal.UserProfile().FindWithConditions(ctx, conditions)
+		if err != nil {
+			return nil, errno.ErrOperationFailed.WithMessage("查询用户失败: " + err.Error())
+		}
+
+		pageRecords, err := l.buildExportRecords(ctx, profiles)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, pageRecords...)
+
+		if page == nil || page.NextCursor == "" {
+			break
+		}
+
+		opts.Cursor = page.NextCursor
+	}
+
+	content, contentType, err := writeUserFile(format, header, records)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("生成导出文件失败: " + err.Error())
+	}
+
+	fileName := fmt.Sprintf("users_%s.%s", time.Now().Format("20060102150405"), userFileExtension(format))
+
+	return &identity_srv.ExportUsersResponse{
+		FileContent: content,
+		FileName:    &fileName,
+		ContentType: &contentType,
+		TotalRows:   int32Ptr(len(records)),
+	}, nil
+}
+
+// buildExportRecords 批量查询一页用户的成员关系与角色并生成导出行
+func (l *LogicImpl) buildExportRecords(
+	ctx context.Context,
+	profiles []*models.UserProfile,
+) ([][]string, error) {
+	if len(profiles) == 0 {
+		return nil, nil
+	}
+
+	userIDs := make([]string, len(profiles))
+	for i, profile := range profiles {
+		userIDs[i] = profile.ID.String()
+	}
+
+	memberships, err := l.dal.UserMembership().GetActiveMembershipsByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("查询成员关系失败: " + err.Error())
+	}
+
+	roleIDsByUser, err := l.dal.UserRoleAssignment().GetRolesByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("查询角色分配失败: " + err.Error())
+	}
+
+	var orgIDs, deptIDs, roleIDs []string
+
+	for _, list := range memberships {
+		for _, m := range list {
+			orgIDs = append(orgIDs, m.OrganizationID.String())
+			if m.DepartmentID != uuid.Nil {
+				deptIDs = append(deptIDs, m.DepartmentID.String())
+			}
+		}
+	}
+
+	for _, ids := range roleIDsByUser {
+		roleIDs = append(roleIDs, ids...)
+	}
+
+	orgCodes, err := l.exportOrganizationCodes(ctx, orgIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	deptNames, err := l.exportDepartmentNames(ctx, deptIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	roleCodes, err := l.exportRoleCodes(ctx, roleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([][]string, 0, len(profiles))
+
+	for _, profile := range profiles {
+		userID := profile.ID.String()
+
+		var orgs, depts, roles []string
+
+		hasDept := false
+
+		for _, m := range memberships[userID] {
+			orgs = append(orgs, orgCodes[m.OrganizationID.String()])
+			dept := deptNames[m.DepartmentID.String()]
+			hasDept = hasDept || dept != ""
+			depts = append(depts, dept)
+		}
+
+		if !hasDept {
+			depts = nil
+		}
+
+		seenRoles := make(map[string]bool)
+
+		for _, roleID := range roleIDsByUser[userID] {
+			if code := roleCodes[roleID]; code != "" && !seenRoles[code] {
+				seenRoles[code] = true
+				roles = append(roles, code)
+			}
+		}
+
+		values := map[string]string{
+			colUsername:           profile.Username,
+			colRealName:           profile.RealName,
+			colLastName:           profile.LastName,
+			colFirstName:          profile.FirstName,
+			colEmail:              profile.Email,
+			colPhone:              profile.Phone,
+			colGender:             formatExportGender(profile.Gender),
+			colProfessionalTitle:  profile.ProfessionalTitle,
+			colEmployeeID:         profile.EmployeeID,
+			colMustChangePassword: formatExportBool(profile.MustChangePassword),
+			colStatus:             formatExportStatus(profile.Status),
+			colOrganizationCodes:  strings.Join(orgs, ";"),
+			colDepartmentNames:    strings.Join(depts, ";"),
+			colRoleCodes:          strings.Join(roles, ";"),
+		}
+
+		record := make([]string, 0, len(userFileColumns))
+
+		for _, col := range userFileColumns {
+			if !col.importOnly {
+				record = append(record, values[col.key])
+			}
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// exportOrganizationCodes 组织ID -> 组织代码
+func (l *LogicImpl) exportOrganizationCodes(ctx context.Context, ids []string) (map[string]string, error) {
+	orgs, err := l.dal.Organization().BatchGetByIDs(ctx, ids)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("查询组织失败: " + err.Error())
+	}
+
+	codes := make(map[string]string, len(orgs))
+	for _, org := range orgs {
+		codes[org.ID.String()] = org.Code
+	}
+
+	return codes, nil
+}
+
+// exportDepartmentNames 部门ID -> 部门名称
+func (l *LogicImpl) exportDepartmentNames(ctx context.Context, ids []string) (map[string]string, error) {
+	depts, err := l.dal.Department().BatchGetByIDs(ctx, ids)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("查询部门失败: " + err.Error())
+	}
+
+	names := make(map[string]string, len(depts))
+	for _, dept := range depts {
+		names[dept.ID.String()] = dept.Name
+	}
+
+	return names, nil
+}
+
+// exportRoleCodes 角色ID -> 角色编码，没有编码的角色使用名称（导入时两者均可识别）
+func (l *LogicImpl) exportRoleCodes(ctx context.Context, ids []string) (map[string]string, error) {
+	roles, err := l.dal.RoleDefinition().BatchGetByIDs(ctx, ids)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("查询角色失败: " + err.Error())
+	}
+
+	codes := make(map[string]string, len(roles))
+
+	for _, role := range roles {
+		code := role.RoleCode
+		if code == "" {
+			code = role.Name
+		}
+
+		codes[role.ID.String()] = code
+	}
+
+	return codes, nil
+}
+
+// ============================================================================
+// 导入引用查询缓存
+// ============================================================================
+
+// importLookup 导入校验时组织、部门、角色的查询缓存，同一文件中的重复引用只查询一次
+// 未找到的记录以 nil 缓存
+type importLookup struct {
+	dal           dal.DAL
+	organizations map[string]*models.Organization
+	departments   map[string]*models.Department
+	roles         map[string]*models.RoleDefinition
+}
+
+func newImportLookup(d dal.DAL) *importLookup {
+	return &importLookup{
+		dal:           d,
+		organizations: make(map[string]*models.Organization),
+		departments:   make(map[string]*models.Department),
+		roles:         make(map[string]*models.RoleDefinition),
+	}
+}
+
+// organization 按组织代码查询组织，不存在时返回 nil
+func (c *importLookup) organization(ctx context.Context, code string) (*models.Organization, error) {
+	if org, ok := c.organizations[code]; ok {
+		return org, nil
+	}
+
+	org, err := c.dal.Organization().GetByCode(ctx, code)
+	if err != nil && !errno.IsRecordNotFound(err) {
+		return nil, errno.ErrOperationFailed.WithMessage("查询组织失败: " + err.Error())
+	}
+
+	c.organizations[code] = org
+
+	return org, nil
+}
+
+// department 按组织与部门名称查询部门，不存在时返回 nil
+func (c *importLookup) department(
+	ctx context.Context,
+	organizationID uuid.UUID,
+	name string,
+) (*models.Department, error) {
+	key := organizationID.String() + "/" + name
+	if dept, ok := c.departments[key]; ok {
+		return dept, nil
+	}
+
+	dept, err := c.dal.Department().GetByName(ctx, name, organizationID.String())
+	if err != nil && !errno.IsRecordNotFound(err) {
+		return nil, errno.ErrOperationFailed.WithMessage("查询部门失败: " + err.Error())
+	}
+
+	c.departments[key] = dept
+
+	return dept, nil
+}
+
+// role 按角色编码查询角色，编码未命中时按角色名称查询，不存在时返回 nil
+func (c *importLookup) role(ctx context.Context, code string) (*models.RoleDefinition, error) {
+	if role, ok := c.roles[code]; ok {
+		return role, nil
+	}
+
+	roles, err := c.dal.RoleDefinition().FindByRoleCodes(ctx, []string{code})
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("查询角色失败: " + err.Error())
+	}
+
+	var role *models.RoleDefinition
+	if len(roles) > 0 {
+		role = roles[0]
+	} else {
+		role, err = c.dal.RoleDefinition().FindByName(ctx, code)
+		if err != nil && !errno.IsRecordNotFound(err) {
+			return nil, errno.ErrOperationFailed.WithMessage("查询角色失败: " + err.Error())
+		}
+	}
+
+	c.roles[code] = role
+
+	return role, nil
+}
+
+// ============================================================================
+// 单元格取值解析与格式化
+// ============================================================================
+
+func parseImportGender(value string) (core.Gender, bool) {
+	switch strings.ToLower(value) {
+	case "男", "male", "m", "1":
+		return core.Gender_GENDER_MALE, true
+	case "女", "female", "f", "2":
+		return core.Gender_GENDER_FEMALE, true
+	case "未知", "unknown", "0":
+		return core.Gender_GENDER_UNSPECIFIED, true
+	default:
+		return core.Gender_GENDER_UNSPECIFIED, false
+	}
+}
+
+func parseImportBool(value string) (bool, bool) {
+	switch strings.ToLower(value) {
+	case "是", "true", "yes", "y", "1":
+		return true, true
+	case "否", "false", "no", "n", "0":
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+func formatExportGender(gender models.Gender) string {
+	switch gender {
+	case models.GenderMale:
+		return "男"
+	case models.GenderFemale:
+		return "女"
+	default:
+		return ""
+	}
+}
+
+func formatExportBool(value bool) string {
+	if value {
+		return "是"
+	}
+
+	return "否"
+}
+
+func formatExportStatus(status models.UserStatus) string {
+	switch status {
+	case models.UserStatusActive:
+		return "活跃"
+	case models.UserStatusInactive:
+		return "未激活"
+	case models.UserStatusSuspended:
+		return "暂停"
+	case models.UserStatusLocked:
+		return "锁定"
+	default:
+		return ""
+	}
+}
+
+func int32Ptr(v int) *int32 {
+	n := int32(v)
+	return &n
+}
@@ -0,0 +1,356 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/passwordpolicy"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/password"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/tabular"
+)
+
+// ============================================================================
+// ImportUsers 测试
+// ============================================================================
+
+func TestLogicImpl_ImportUsers(t *testing.T) {
+	csvFormat := identity_srv.UserFileFormat_USER_FILE_FORMAT_CSV
+	dryRun := true
+
+	t.Run("试运行返回逐行校验结果且不写库", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		org := &models.Organization{BaseModel: models.BaseModel{ID: uuid.New()}, Code: "H001"}
+		dept := &models.Department{BaseModel: models.BaseModel{ID: uuid.New()}, Name: "心内科"}
+		role := &models.RoleDefinition{BaseModel: models.BaseModel{ID: uuid.New()}, RoleCode: "doctor"}
+
+		content := "用户名,密码,邮箱,性别,组织代码,部门名称,角色编码\n" +
+			"zhangsan,Passw0rd!,zs@example.com,男,H001,心内科,doctor\n" +
+			"lisi,,ls@example.com,女,H001,,\n" +
+			"\n" +
+			"ZhangSan,Passw0rd!,zs@example.com,未填,H999,,nurse\n"
+
+		mocks.UserRepo.EXPECT().CheckUsernameExists(gomock.Any(), "zhangsan").Return(false, nil)
+		mocks.UserRepo.EXPECT().CheckEmailExists(gomock.Any(), "zs@example.com").Return(false, nil).Times(2)
+		mocks.UserRepo.EXPECT().CheckUsernameExists(gomock.Any(), "ZhangSan").Return(false, nil)
+		mocks.OrgRepo.EXPECT().GetByCode(gomock.Any(), "H001").Return(org, nil) // 同一组织只查询一次
+		mocks.OrgRepo.EXPECT().GetByCode(gomock.Any(), "H999").Return(nil, gorm.ErrRecordNotFound)
+		mocks.DeptRepo.EXPECT().GetByName(gomock.Any(), "心内科", org.ID.String()).Return(dept, nil)
+		// 初始密码按第一个组织的密码策略校验，组织未单独配置时使用全局默认值
+		mocks.PolicyRepo.EXPECT().GetByOrganizationID(gomock.Any(), org.ID.String()).
+			Return(nil, gorm.ErrRecordNotFound)
+		mocks.DefinitionRepo.EXPECT().FindByRoleCodes(gomock.Any(), []string{"doctor"}).
+			Return([]*models.RoleDefinition{role}, nil)
+		mocks.DefinitionRepo.EXPECT().FindByRoleCodes(gomock.Any(), []string{"nurse"}).
+			Return(nil, nil)
+		mocks.DefinitionRepo.EXPECT().FindByName(gomock.Any(), "nurse").Return(nil, nil)
+
+		resp, err := logic.ImportUsers(ctx, &identity_srv.ImportUsersRequest{
+			FileContent: []byte(content),
+			Format:      &csvFormat,
+			DryRun:      &dryRun,
+		}, true)
+
+		require.NoError(t, err)
+		assert.True(t, resp.GetDryRun())
+		assert.Equal(t, int32(3), resp.GetTotalRows())
+		assert.Equal(t, int32(1), resp.GetValidRows())
+		assert.Equal(t, int32(0), resp.GetCreatedRows())
+		assert.Equal(t, int32(2), resp.GetFailedRows())
+		require.Len(t, resp.Rows, 3)
+
+		assert.Equal(t, int32(2), resp.Rows[0].GetRowNumber())
+		assert.Empty(t, resp.Rows[0].Errors)
+		assert.Empty(t, resp.Rows[0].GetUserID())
+
+		assert.Equal(t, int32(3), resp.Rows[1].GetRowNumber())
+		assert.Equal(t, []string{"密码不能为空"}, resp.Rows[1].Errors)
+
+		// 空行跳过但保留原始行号
+		assert.Equal(t, int32(5), resp.Rows[2].GetRowNumber())
+		assert.ElementsMatch(t, []string{
+			"性别无效: 未填",
+			"组织不存在: H999",
+			"角色不存在: nurse",
+			"用户名与第 2 行重复: ZhangSan",
+			"邮箱与第 2 行重复: zs@example.com",
+		}, resp.Rows[2].Errors)
+	})
+
+	t.Run("按批次提交，失败批次整批回滚", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		content := "username,password,role_codes\n" +
+			"user01,Passw0rd!,doctor\n" +
+			"user02,Passw0rd!,\n" +
+			"user03,Passw0rd!,\n"
+
+		role := &models.RoleDefinition{BaseModel: models.BaseModel{ID: uuid.New()}, RoleCode: "doctor"}
+		operatorID := uuid.New()
+
+		mocks.UserRepo.EXPECT().CheckUsernameExists(gomock.Any(), gomock.Any()).Return(false, nil).Times(3)
+		mocks.DefinitionRepo.EXPECT().FindByRoleCodes(gomock.Any(), []string{"doctor"}).
+			Return([]*models.RoleDefinition{role}, nil)
+
+		mocks.UserRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, profile *models.UserProfile) error {
+				if profile.Username == "user03" {
+					return errors.New("duplicate key")
+				}
+
+				profile.ID = uuid.New()
+
+				return nil
+			}).Times(3)
+		mocks.AssignmentRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, assignment *models.UserRoleAssignment) error {
+				assert.Equal(t, role.ID, assignment.RoleID)
+				assert.Equal(t, &operatorID, assignment.CreatedBy)
+
+				return nil
+			})
+
+		batchSize := int32(2)
+		resp, err := logic.ImportUsers(ctx, &identity_srv.ImportUsersRequest{
+			FileContent: []byte(content),
+			Format:      &csvFormat,
+			BatchSize:   &batchSize,
+			OperatorID:  stringPtr(operatorID.String()),
+		}, true)
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), resp.GetValidRows())
+		assert.Equal(t, int32(2), resp.GetCreatedRows())
+		assert.Equal(t, int32(1), resp.GetFailedRows())
+		assert.NotEmpty(t, resp.Rows[0].GetUserID())
+		assert.NotEmpty(t, resp.Rows[1].GetUserID())
+		assert.Empty(t, resp.Rows[2].GetUserID())
+		require.Len(t, resp.Rows[2].Errors, 1)
+		assert.Contains(t, resp.Rows[2].Errors[0], "第 4 行写入失败")
+	})
+
+	t.Run("角色约束计入文件内前面各行的分配", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+
+		approver := &models.RoleDefinition{
+			BaseModel:      models.BaseModel{ID: uuid.New()},
+			RoleCode:       "approver",
+			Name:           "审批人",
+			MaxAssignments: 2,
+		}
+		payer := &models.RoleDefinition{
+			BaseModel:       models.BaseModel{ID: uuid.New()},
+			RoleCode:        "payer",
+			Name:            "付款人",
+			ExclusiveGroups: []string{"finance"},
+		}
+		auditor := &models.RoleDefinition{
+			BaseModel:       models.BaseModel{ID: uuid.New()},
+			RoleCode:        "auditor",
+			Name:            "审计员",
+			ExclusiveGroups: []string{"finance"},
+		}
+
+		content := "username,password,role_codes\n" +
+			"user01,Passw0rd!,approver\n" +
+			"user02,Passw0rd!,approver\n" +
+			"user03,Passw0rd!,payer;auditor\n"
+
+		mocks.UserRepo.EXPECT().CheckUsernameExists(gomock.Any(), gomock.Any()).Return(false, nil).Times(3)
+		for _, role := range []*models.RoleDefinition{approver, payer, auditor} {
+			mocks.DefinitionRepo.EXPECT().FindByRoleCodes(gomock.Any(), []string{role.RoleCode}).
+				Return([]*models.RoleDefinition{role}, nil)
+		}
+		// 库中已有 1 人持有（同一用户两条分配只算一人），每个角色只查询一次
+		holder := uuid.New()
+		mocks.AssignmentRepo.EXPECT().
+			FindUnexpiredByRoleIDs(gomock.Any(), []string{approver.ID.String()}, gomock.Nil(), gomock.Any()).
+			Return([]*models.UserRoleAssignment{{UserID: holder}, {UserID: holder}}, nil)
+
+		resp, err := logic.ImportUsers(context.Background(), &identity_srv.ImportUsersRequest{
+			FileContent: []byte(content),
+			Format:      &csvFormat,
+			DryRun:      &dryRun,
+		}, true)
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), resp.GetValidRows())
+		assert.Empty(t, resp.Rows[0].Errors)
+		assert.Equal(t, []string{"角色 审批人 最多分配给 2 个用户，分配后将达到 3 个"}, resp.Rows[1].Errors)
+		assert.Equal(t, []string{"角色 付款人 与角色 审计员 互斥（互斥组 finance），不能同时分配"}, resp.Rows[2].Errors)
+	})
+
+	t.Run("敏感角色需经审批不能随导入分配", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+
+		admin := &models.RoleDefinition{
+			BaseModel:    models.BaseModel{ID: uuid.New()},
+			RoleCode:     "admin",
+			Name:         "管理员",
+			IsSystemRole: true,
+		}
+
+		mocks.UserRepo.EXPECT().CheckUsernameExists(gomock.Any(), "user01").Return(false, nil)
+		mocks.DefinitionRepo.EXPECT().FindByRoleCodes(gomock.Any(), []string{"admin"}).
+			Return([]*models.RoleDefinition{admin}, nil)
+
+		resp, err := logic.ImportUsers(context.Background(), &identity_srv.ImportUsersRequest{
+			FileContent: []byte("username,password,role_codes\nuser01,Passw0rd!,admin\n"),
+			Format:      &csvFormat,
+			DryRun:      &dryRun,
+		}, true)
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), resp.GetValidRows())
+		assert.Equal(t, []string{"角色 管理员 需经审批才能分配，请在导入后为该用户单独发起授权申请"}, resp.Rows[0].Errors)
+	})
+
+	t.Run("缺少角色分配权限时拒绝带角色的行", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+
+		content := "username,password,role_codes\n" +
+			"user01,Passw0rd!,doctor\n" +
+			"user02,Passw0rd!,\n"
+
+		mocks.UserRepo.EXPECT().CheckUsernameExists(gomock.Any(), gomock.Any()).Return(false, nil).Times(2)
+
+		resp, err := logic.ImportUsers(context.Background(), &identity_srv.ImportUsersRequest{
+			FileContent: []byte(content),
+			Format:      &csvFormat,
+			DryRun:      &dryRun,
+		}, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), resp.GetValidRows())
+		assert.Equal(t, []string{"缺少 assign role_assignment 权限，不能随导入分配角色"}, resp.Rows[0].Errors)
+		assert.Empty(t, resp.Rows[1].Errors)
+	})
+
+	t.Run("缺少必填列", func(t *testing.T) {
+		logic, _ := setupTest(t)
+
+		_, err := logic.ImportUsers(context.Background(), &identity_srv.ImportUsersRequest{
+			FileContent: []byte("username,email\nuser01,a@example.com\n"),
+			Format:      &csvFormat,
+		}, true)
+
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+
+	t.Run("查询失败终止导入", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+
+		mocks.UserRepo.EXPECT().CheckUsernameExists(gomock.Any(), "user01").
+			Return(false, errors.New("connection refused"))
+
+		_, err := logic.ImportUsers(context.Background(), &identity_srv.ImportUsersRequest{
+			FileContent: []byte("username,password\nuser01,Passw0rd!\n"),
+			Format:      &csvFormat,
+		}, true)
+
+		assertErrCode(t, errno.ErrOperationFailed, err)
+	})
+
+	t.Run("不符合密码策略的行记入行错误", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		logic.passwordPolicy = passwordpolicy.NewEnforcer(password.NewPolicy(10, 3, 0, 0, nil))
+
+		mocks.UserRepo.EXPECT().CheckUsernameExists(gomock.Any(), "user02").Return(false, nil)
+
+		resp, err := logic.ImportUsers(context.Background(), &identity_srv.ImportUsersRequest{
+			FileContent: []byte("username,password\nuser01,password1\nuser02,Str0ng-Password\n"),
+			Format:      &csvFormat,
+			DryRun:      &dryRun,
+		}, true)
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), resp.GetValidRows())
+		require.Len(t, resp.Rows[0].Errors, 1)
+		assert.Contains(t, resp.Rows[0].Errors[0], "长度至少为 10 位")
+		assert.Empty(t, resp.Rows[1].Errors)
+	})
+}
+
+// ============================================================================
+// ExportUsers 测试
+// ============================================================================
+
+func TestLogicImpl_ExportUsers(t *testing.T) {
+	logic, mocks := setupTest(t)
+	ctx := context.Background()
+
+	org := &models.Organization{BaseModel: models.BaseModel{ID: uuid.New()}, Code: "H001"}
+	dept := &models.Department{BaseModel: models.BaseModel{ID: uuid.New()}, Name: "心内科"}
+	role := &models.RoleDefinition{BaseModel: models.BaseModel{ID: uuid.New()}, RoleCode: "doctor"}
+	profile := &models.UserProfile{
+		BaseModel: models.BaseModel{ID: uuid.New()},
+		Username:  "zhangsan",
+		RealName:  "张三",
+		Phone:     "013800000000",
+		Gender:    models.GenderMale,
+		Status:    models.UserStatusActive,
+	}
+	userID := profile.ID.String()
+
+	mocks.UserRepo.EXPECT().FindWithConditions(gomock.Any(), gomock.Any()).
+		Return([]*models.UserProfile{profile}, &models.PageResult{}, nil)
+	mocks.MembershipRepo.EXPECT().GetActiveMembershipsByUserIDs(gomock.Any(), []string{userID}).
+		Return(map[string][]*models.UserMembership{
+			userID: {{UserID: profile.ID, OrganizationID: org.ID, DepartmentID: dept.ID, IsPrimary: true}},
+		}, nil)
+	mocks.AssignmentRepo.EXPECT().GetRolesByUserIDs(gomock.Any(), []string{userID}).
+		Return(map[string][]string{userID: {role.ID.String()}}, nil)
+	mocks.OrgRepo.EXPECT().BatchGetByIDs(gomock.Any(), []string{org.ID.String()}).
+		Return([]*models.Organization{org}, nil)
+	mocks.DeptRepo.EXPECT().BatchGetByIDs(gomock.Any(), []string{dept.ID.String()}).
+		Return([]*models.Department{dept}, nil)
+	mocks.DefinitionRepo.EXPECT().BatchGetByIDs(gomock.Any(), []string{role.ID.String()}).
+		Return([]*models.RoleDefinition{role}, nil)
+
+	xlsx := identity_srv.UserFileFormat_USER_FILE_FORMAT_XLSX
+	resp, err := logic.ExportUsers(ctx, &identity_srv.ExportUsersRequest{Format: &xlsx})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), resp.GetTotalRows())
+	assert.Equal(t, tabular.XLSXContentType, resp.GetContentType())
+	assert.Contains(t, resp.GetFileName(), ".xlsx")
+
+	// 导出文件可按导入规则重新解析
+	rows, _, err := readUserFile(xlsx, resp.FileContent)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	header := rows[0]
+	assert.NotContains(t, header, "密码")
+
+	record := make(map[string]string, len(header))
+	for i, label := range header {
+		if i < len(rows[1]) {
+			record[label] = rows[1][i]
+		}
+	}
+
+	assert.Equal(t, "zhangsan", record["用户名"])
+	assert.Equal(t, "013800000000", record["手机号"])
+	assert.Equal(t, "男", record["性别"])
+	assert.Equal(t, "活跃", record["状态"])
+	assert.Equal(t, "H001", record["组织代码"])
+	assert.Equal(t, "心内科", record["部门名称"])
+	assert.Equal(t, "doctor", record["角色编码"])
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
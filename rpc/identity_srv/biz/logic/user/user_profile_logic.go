@@ -55,4 +55,23 @@ type ProfileLogic interface {
 
 	// UnlockUser 解锁用户
 	UnlockUser(ctx context.Context, req *identity_srv.UnlockUserRequest) error
+
+	// ============================================================================
+	// 批量导入导出
+	// ============================================================================
+
+	// ImportUsers 从 CSV/XLSX 文件批量导入用户及其成员关系与角色分配
+	// 所有行按 CreateUser 的规则校验，dryRun 时只返回逐行校验结果，否则按批次分事务提交；
+	// canAssignRoles 为 false（调用方缺少 assign role_assignment 权限）时带角色编码的行被拒绝
+	ImportUsers(
+		ctx context.Context,
+		req *identity_srv.ImportUsersRequest,
+		canAssignRoles bool,
+	) (*identity_srv.ImportUsersResponse, error)
+
+	// ExportUsers 导出调用方数据范围内的用户及其成员关系与角色，格式与导入模板一致
+	ExportUsers(
+		ctx context.Context,
+		req *identity_srv.ExportUsersRequest,
+	) (*identity_srv.ExportUsersResponse, error)
 }
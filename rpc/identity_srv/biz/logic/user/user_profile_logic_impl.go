@@ -3,12 +3,18 @@ package user
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter"
+	convbase "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/base"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/assignment"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/user"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/datascope"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/passwordpolicy"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/rpc_base"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
@@ -18,18 +24,21 @@ import (
 
 // LogicImpl 用户档案业务逻辑实现
 type LogicImpl struct {
-	dal       dal.DAL
-	converter converter.Converter
+	dal            dal.DAL
+	converter      converter.Converter
+	passwordPolicy *passwordpolicy.Enforcer
 }
 
 // NewLogic 创建用户档案业务逻辑实例
 func NewLogic(
 	dal dal.DAL,
 	converter converter.Converter,
+	passwordPolicy *passwordpolicy.Enforcer,
 ) ProfileLogic {
 	return &LogicImpl{
-		dal:       dal,
-		converter: converter,
+		dal:            dal,
+		converter:      converter,
+		passwordPolicy: passwordPolicy,
 	}
 }
 
@@ -43,54 +52,25 @@ func (l *LogicImpl) CreateUser(
 	req *identity_srv.CreateUserRequest,
 ) (*identity_srv.UserProfile, error) {
 	// 参数验证
-	if err := l.validateCreateUserRequest(req); err != nil {
+	if err := l.validateCreateUserRequest(ctx, req); err != nil {
 		return nil, err
 	}
 
-	// 检查用户名是否已存在
-	exists, err := l.dal.UserProfile().CheckUsernameExists(ctx, *req.Username)
-	if err != nil {
-		return nil, errno.ErrOperationFailed.WithMessage("检查用户名是否存在失败: " + err.Error())
-	}
-
-	if exists {
-		return nil, errno.ErrUsernameAlreadyExists
-	}
-
-	// 检查邮箱是否已存在
-	if req.Email != nil {
-		exists, err := l.dal.UserProfile().CheckEmailExists(ctx, *req.Email)
-		if err != nil {
-			return nil, errno.ErrOperationFailed.WithMessage("检查邮箱是否存在失败: " + err.Error())
-		}
-
-		if exists {
-			return nil, errno.ErrEmailAlreadyExists
-		}
-	}
-
-	// 检查手机号是否已存在
-	if req.Phone != nil {
-		exists, err := l.dal.UserProfile().CheckPhoneExists(ctx, *req.Phone)
-		if err != nil {
-			return nil, errno.ErrOperationFailed.WithMessage("检查手机号是否存在失败: " + err.Error())
-		}
-
-		if exists {
-			return nil, errno.ErrPhoneAlreadyExists
-		}
+	// 检查用户名、邮箱、手机号唯一性
+	if err := l.checkCreateUniqueConstraints(ctx, req); err != nil {
+		return nil, err
 	}
 
 	// 转换请求为模型
 	userProfile := l.converter.UserProfile().CreateUserRequestToModel(req)
 
-	// 在事务中创建用户档案
-	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+	// 在事务中创建用户档案并记录初始密码
+	err := l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
 		if err := txDAL.UserProfile().Create(ctx, userProfile); err != nil {
 			return err
 		}
 
-		return nil
+		return l.passwordPolicy.Record(ctx, txDAL, req.GetOrganizationID(), userProfile.ID, userProfile.PasswordHash)
 	})
 	if err != nil {
 		return nil, err
@@ -162,6 +142,11 @@ func (l *LogicImpl) UpdateUser(
 		return nil, errno.ErrOperationFailed.WithMessage("获取用户档案失败: " + err.Error())
 	}
 
+	// 乐观锁：请求版本号必须与当前版本一致，避免覆盖他人的修改
+	if err := errno.CheckVersion(req.Version, existingProfile.Version); err != nil {
+		return nil, err
+	}
+
 	// 系统用户保护检查
 	if existingProfile.IsSystemUser {
 		// 记录系统用户修改操作（用于审计）
@@ -239,7 +224,12 @@ func (l *LogicImpl) DeleteUser(
 	}
 
 	// 3. 执行软删除（级联清理关联数据）
+	// 用户及其级联数据共享同一删除时间戳，回收站恢复时据此找回本次删除的关联数据
+	deletedAt := time.Now().Truncate(time.Microsecond)
+
 	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		txDAL = txDAL.WithNow(deletedAt)
+
 		// 3a. 清理用户的角色分配记录
 		opts := base.NewQueryOptions()
 		opts.FetchAll = true
@@ -299,6 +289,9 @@ func (l *LogicImpl) ListUsers(
 	}
 
 	opts := l.converter.Base().PageRequestToQueryOptions(pageReq)
+	if err := opts.Validate(); err != nil {
+		return nil, errno.ErrInvalidParams.WithMessage("分页参数无效: " + err.Error())
+	}
 
 	// 构建查询条件
 	conditions := &user.UserProfileQueryConditions{
@@ -317,6 +310,14 @@ func (l *LogicImpl) ListUsers(
 		}
 	}
 
+	// 按调用方数据范围限定可见用户
+	scope, err := datascope.Resolve(ctx, l.dal)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(err.Error())
+	}
+
+	conditions.Scope = scope
+
 	// 查询用户档案列表
 	profiles, pageResult, err := l.dal.UserProfile().FindWithConditions(ctx, conditions)
 	if err != nil {
@@ -335,8 +336,9 @@ func (l *LogicImpl) ListUsers(
 	}
 
 	return &identity_srv.ListUsersResponse{
-		Users: userProfiles,
-		Page:  l.converter.Base().PageResponseToThrift(pageResult),
+		Users:      userProfiles,
+		Page:       l.converter.Base().PageResponseToThrift(pageResult),
+		Highlights: convbase.SearchHighlightsToThrift(opts.Search, profiles),
 	}, nil
 }
 
@@ -363,6 +365,14 @@ func (l *LogicImpl) SearchUsers(
 		conditions.OrgID = req.OrganizationID
 	}
 
+	// 按调用方数据范围限定可见用户
+	scope, err := datascope.Resolve(ctx, l.dal)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(err.Error())
+	}
+
+	conditions.Scope = scope
+
 	// 查询用户档案列表
 	profiles, pageResult, err := l.dal.UserProfile().FindWithConditions(ctx, conditions)
 	if err != nil {
@@ -381,8 +391,9 @@ func (l *LogicImpl) SearchUsers(
 	}
 
 	return &identity_srv.SearchUsersResponse{
-		Users: userProfiles,
-		Page:  l.converter.Base().PageResponseToThrift(pageResult),
+		Users:      userProfiles,
+		Page:       l.converter.Base().PageResponseToThrift(pageResult),
+		Highlights: convbase.SearchHighlightsToThrift(opts.Search, profiles),
 	}, nil
 }
 
@@ -424,8 +435,9 @@ func (l *LogicImpl) convertProfilesToThrift(
 	return userProfiles
 }
 
-// validateCreateUserRequest 验证创建用户请求
+// validateCreateUserRequest 验证创建用户请求，初始密码按用户将加入的组织的密码策略校验
 func (l *LogicImpl) validateCreateUserRequest(
+	ctx context.Context,
 	req *identity_srv.CreateUserRequest,
 ) error {
 	if req.Username == nil || *req.Username == "" {
@@ -436,6 +448,54 @@ func (l *LogicImpl) validateCreateUserRequest(
 		return errno.ErrInvalidParams.WithMessage("密码不能为空")
 	}
 
+	if orgID := req.GetOrganizationID(); orgID != "" {
+		if _, err := uuid.Parse(orgID); err != nil {
+			return errno.ErrInvalidParams.WithMessage("组织ID格式无效")
+		}
+	}
+
+	return l.passwordPolicy.Validate(ctx, l.dal, req.GetOrganizationID(), *req.Password)
+}
+
+// checkCreateUniqueConstraints 检查新建用户的用户名、邮箱、手机号唯一性
+func (l *LogicImpl) checkCreateUniqueConstraints(
+	ctx context.Context,
+	req *identity_srv.CreateUserRequest,
+) error {
+	// 检查用户名是否已存在
+	exists, err := l.dal.UserProfile().CheckUsernameExists(ctx, *req.Username)
+	if err != nil {
+		return errno.ErrOperationFailed.WithMessage("检查用户名是否存在失败: " + err.Error())
+	}
+
+	if exists {
+		return errno.ErrUsernameAlreadyExists
+	}
+
+	// 检查邮箱是否已存在
+	if req.Email != nil {
+		exists, err := l.dal.UserProfile().CheckEmailExists(ctx, *req.Email)
+		if err != nil {
+			return errno.ErrOperationFailed.WithMessage("检查邮箱是否存在失败: " + err.Error())
+		}
+
+		if exists {
+			return errno.ErrEmailAlreadyExists
+		}
+	}
+
+	// 检查手机号是否已存在
+	if req.Phone != nil {
+		exists, err := l.dal.UserProfile().CheckPhoneExists(ctx, *req.Phone)
+		if err != nil {
+			return errno.ErrOperationFailed.WithMessage("检查手机号是否存在失败: " + err.Error())
+		}
+
+		if exists {
+			return errno.ErrPhoneAlreadyExists
+		}
+	}
+
 	return nil
 }
 
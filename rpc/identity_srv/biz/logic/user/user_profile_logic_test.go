@@ -11,13 +11,17 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
 	userDAL "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/user"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/datascope"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/passwordpolicy"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/mock"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/core"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/rpc_base"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/password"
 )
 
 // setupTest 初始化测试环境
@@ -27,8 +31,9 @@ func setupTest(t *testing.T) (*LogicImpl, *mock.TestMocks) {
 	ctrl := gomock.NewController(t)
 	mocks := mock.NewTestMocks(ctrl)
 	logic := &LogicImpl{
-		dal:       mocks.DAL,
-		converter: mocks.Converter,
+		dal:            mocks.DAL,
+		converter:      mocks.Converter,
+		passwordPolicy: passwordpolicy.NewEnforcer(&password.Policy{}),
 	}
 
 	return logic, mocks
@@ -254,6 +259,97 @@ func TestLogicImpl_CreateUser(t *testing.T) {
 	})
 }
 
+func TestLogicImpl_CreateUser_PasswordPolicy(t *testing.T) {
+	username := "testuser"
+
+	// setupPolicyTest 至少 10 位、3 种字符，保留最近 5 个历史密码
+	setupPolicyTest := func(t *testing.T) (*LogicImpl, *mock.TestMocks) {
+		logic, mocks := setupTest(t)
+		logic.passwordPolicy = passwordpolicy.NewEnforcer(password.NewPolicy(10, 3, 5, 0, nil))
+
+		return logic, mocks
+	}
+
+	t.Run("密码不符合策略", func(t *testing.T) {
+		logic, _ := setupPolicyTest(t)
+		weak := "password123"
+
+		result, err := logic.CreateUser(context.Background(), &identity_srv.CreateUserRequest{
+			Username: &username,
+			Password: &weak,
+		})
+
+		assert.Nil(t, result)
+		assertErrCode(t, errno.ErrPasswordPolicyViolation, err)
+	})
+
+	t.Run("指定组织时按组织密码策略校验", func(t *testing.T) {
+		logic, mocks := setupPolicyTest(t)
+		ctx := context.Background()
+		orgID := uuid.NewString()
+		strong := "Str0ng-Password"
+
+		// 组织要求至少 16 位，全局策略下合格的密码在该组织内被拒绝
+		mocks.PolicyRepo.EXPECT().GetByOrganizationID(gomock.Any(), orgID).
+			Return(&models.OrganizationPasswordPolicy{MinLength: 16, MinCharClasses: 3, HistorySize: 5}, nil)
+
+		result, err := logic.CreateUser(ctx, &identity_srv.CreateUserRequest{
+			Username:       &username,
+			Password:       &strong,
+			OrganizationID: &orgID,
+		})
+
+		assert.Nil(t, result)
+		assertErrCode(t, errno.ErrPasswordPolicyViolation, err)
+	})
+
+	t.Run("组织ID格式无效", func(t *testing.T) {
+		logic, _ := setupPolicyTest(t)
+		strong := "Str0ng-Password"
+		orgID := "not-a-uuid"
+
+		result, err := logic.CreateUser(context.Background(), &identity_srv.CreateUserRequest{
+			Username:       &username,
+			Password:       &strong,
+			OrganizationID: &orgID,
+		})
+
+		assert.Nil(t, result)
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+
+	t.Run("创建成功并记录初始密码", func(t *testing.T) {
+		logic, mocks := setupPolicyTest(t)
+		ctx := context.Background()
+		strong := "Str0ng-Password"
+
+		mocks.UserRepo.EXPECT().CheckUsernameExists(ctx, username).Return(false, nil)
+		mocks.UserRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, profile *models.UserProfile) error {
+				profile.ID = uuid.New()
+				return nil
+			})
+		mocks.HistoryRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, h *models.PasswordHistory) error {
+				assert.NotEqual(t, uuid.Nil, h.UserID)
+				assert.True(t, password.VerifyPassword(strong, h.PasswordHash))
+
+				return nil
+			})
+		mocks.HistoryRepo.EXPECT().Prune(gomock.Any(), gomock.Any(), 5).Return(nil)
+		mocks.MembershipRepo.EXPECT().GetPrimaryMembership(gomock.Any(), gomock.Any()).
+			Return(nil, gorm.ErrRecordNotFound)
+
+		result, err := logic.CreateUser(ctx, &identity_srv.CreateUserRequest{
+			Username: &username,
+			Password: &strong,
+		})
+
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
 // ============================================================================
 // GetUser 测试
 // ============================================================================
@@ -331,13 +427,14 @@ func TestLogicImpl_UpdateUser(t *testing.T) {
 	newEmail := "new@example.com"
 	newPhone := "+9876543210"
 	newRealName := "张三"
+	version := int64(1)
 
 	t.Run("成功更新用户", func(t *testing.T) {
 		logic, mocks := setupTest(t)
 		ctx := context.Background()
 
 		existingProfile := &models.UserProfile{
-			BaseModel: models.BaseModel{ID: uuid.MustParse(userID)},
+			BaseModel: models.BaseModel{ID: uuid.MustParse(userID), Version: 1},
 			Username:  "testuser",
 			Email:     "old@example.com",
 		}
@@ -346,6 +443,7 @@ func TestLogicImpl_UpdateUser(t *testing.T) {
 			UserID:   &userID,
 			Email:    &newEmail,
 			RealName: &newRealName,
+			Version:  &version,
 		}
 
 		mocks.UserRepo.EXPECT().GetByID(ctx, userID).Return(existingProfile, nil)
@@ -365,8 +463,9 @@ func TestLogicImpl_UpdateUser(t *testing.T) {
 		ctx := context.Background()
 
 		req := &identity_srv.UpdateUserRequest{
-			UserID: &userID,
-			Email:  &newEmail,
+			UserID:  &userID,
+			Email:   &newEmail,
+			Version: &version,
 		}
 
 		mocks.UserRepo.EXPECT().GetByID(ctx, userID).Return(nil, gorm.ErrRecordNotFound)
@@ -377,12 +476,62 @@ func TestLogicImpl_UpdateUser(t *testing.T) {
 		assertErrCode(t, errno.ErrUserNotFound, err)
 	})
 
+	t.Run("版本号过期返回版本冲突及当前版本", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		existingProfile := &models.UserProfile{
+			BaseModel: models.BaseModel{ID: uuid.MustParse(userID), Version: 3},
+			Username:  "testuser",
+		}
+
+		req := &identity_srv.UpdateUserRequest{
+			UserID:  &userID,
+			Email:   &newEmail,
+			Version: &version,
+		}
+
+		mocks.UserRepo.EXPECT().GetByID(ctx, userID).Return(existingProfile, nil)
+
+		result, err := logic.UpdateUser(ctx, req)
+
+		assert.Nil(t, result)
+		require.ErrorIs(t, err, errno.ErrVersionConflict)
+
+		var conflict errno.VersionConflictError
+		require.ErrorAs(t, err, &conflict)
+		assert.Equal(t, int64(3), conflict.CurrentVersion)
+	})
+
+	t.Run("未携带版本号", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := context.Background()
+
+		existingProfile := &models.UserProfile{
+			BaseModel: models.BaseModel{ID: uuid.MustParse(userID), Version: 1},
+			Username:  "testuser",
+		}
+
+		req := &identity_srv.UpdateUserRequest{
+			UserID: &userID,
+			Email:  &newEmail,
+		}
+
+		mocks.UserRepo.EXPECT().GetByID(ctx, userID).Return(existingProfile, nil)
+
+		result, err := logic.UpdateUser(ctx, req)
+
+		assert.Nil(t, result)
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+
 	t.Run("用户ID为空", func(t *testing.T) {
 		logic, _ := setupTest(t)
 		ctx := context.Background()
 
 		req := &identity_srv.UpdateUserRequest{
-			Email: &newEmail,
+			Email:   &newEmail,
+			Version: &version,
 		}
 
 		result, err := logic.UpdateUser(ctx, req)
@@ -396,13 +545,14 @@ func TestLogicImpl_UpdateUser(t *testing.T) {
 		ctx := context.Background()
 
 		existingProfile := &models.UserProfile{
-			BaseModel: models.BaseModel{ID: uuid.MustParse(userID)},
+			BaseModel: models.BaseModel{ID: uuid.MustParse(userID), Version: 1},
 			Username:  "testuser",
 		}
 
 		req := &identity_srv.UpdateUserRequest{
-			UserID: &userID,
-			Email:  &newEmail,
+			UserID:  &userID,
+			Email:   &newEmail,
+			Version: &version,
 		}
 
 		mocks.UserRepo.EXPECT().GetByID(ctx, userID).Return(existingProfile, nil)
@@ -419,13 +569,14 @@ func TestLogicImpl_UpdateUser(t *testing.T) {
 		ctx := context.Background()
 
 		existingProfile := &models.UserProfile{
-			BaseModel: models.BaseModel{ID: uuid.MustParse(userID)},
+			BaseModel: models.BaseModel{ID: uuid.MustParse(userID), Version: 1},
 			Username:  "testuser",
 		}
 
 		req := &identity_srv.UpdateUserRequest{
-			UserID: &userID,
-			Phone:  &newPhone,
+			UserID:  &userID,
+			Phone:   &newPhone,
+			Version: &version,
 		}
 
 		mocks.UserRepo.EXPECT().GetByID(ctx, userID).Return(existingProfile, nil)
@@ -442,13 +593,14 @@ func TestLogicImpl_UpdateUser(t *testing.T) {
 		ctx := context.Background()
 
 		existingProfile := &models.UserProfile{
-			BaseModel: models.BaseModel{ID: uuid.MustParse(userID)},
+			BaseModel: models.BaseModel{ID: uuid.MustParse(userID), Version: 1},
 			Username:  "testuser",
 		}
 
 		req := &identity_srv.UpdateUserRequest{
-			UserID: &userID,
-			Email:  &newEmail,
+			UserID:  &userID,
+			Email:   &newEmail,
+			Version: &version,
 		}
 
 		mocks.UserRepo.EXPECT().GetByID(ctx, userID).Return(existingProfile, nil)
@@ -465,13 +617,14 @@ func TestLogicImpl_UpdateUser(t *testing.T) {
 		ctx := context.Background()
 
 		existingProfile := &models.UserProfile{
-			BaseModel: models.BaseModel{ID: uuid.MustParse(userID)},
+			BaseModel: models.BaseModel{ID: uuid.MustParse(userID), Version: 1},
 			Username:  "testuser",
 		}
 
 		req := &identity_srv.UpdateUserRequest{
-			UserID: &userID,
-			Phone:  &newPhone,
+			UserID:  &userID,
+			Phone:   &newPhone,
+			Version: &version,
 		}
 
 		mocks.UserRepo.EXPECT().GetByID(ctx, userID).Return(existingProfile, nil)
@@ -488,8 +641,9 @@ func TestLogicImpl_UpdateUser(t *testing.T) {
 		ctx := context.Background()
 
 		req := &identity_srv.UpdateUserRequest{
-			UserID: &userID,
-			Email:  &newEmail,
+			UserID:  &userID,
+			Email:   &newEmail,
+			Version: &version,
 		}
 
 		mocks.UserRepo.EXPECT().GetByID(ctx, userID).Return(nil, gorm.ErrInvalidDB)
@@ -505,13 +659,14 @@ func TestLogicImpl_UpdateUser(t *testing.T) {
 		ctx := context.Background()
 
 		existingProfile := &models.UserProfile{
-			BaseModel: models.BaseModel{ID: uuid.MustParse(userID)},
+			BaseModel: models.BaseModel{ID: uuid.MustParse(userID), Version: 1},
 			Username:  "testuser",
 		}
 
 		req := &identity_srv.UpdateUserRequest{
 			UserID:   &userID,
 			RealName: &newRealName,
+			Version:  &version,
 		}
 
 		mocks.UserRepo.EXPECT().GetByID(ctx, userID).Return(existingProfile, nil)
@@ -528,7 +683,7 @@ func TestLogicImpl_UpdateUser(t *testing.T) {
 		ctx := context.Background()
 
 		existingProfile := &models.UserProfile{
-			BaseModel:    models.BaseModel{ID: uuid.MustParse(userID)},
+			BaseModel:    models.BaseModel{ID: uuid.MustParse(userID), Version: 1},
 			Username:     "admin",
 			IsSystemUser: true,
 		}
@@ -536,6 +691,7 @@ func TestLogicImpl_UpdateUser(t *testing.T) {
 		req := &identity_srv.UpdateUserRequest{
 			UserID:   &userID,
 			RealName: &newRealName,
+			Version:  &version,
 		}
 
 		mocks.UserRepo.EXPECT().GetByID(ctx, userID).Return(existingProfile, nil)
@@ -905,6 +1061,111 @@ func TestLogicImpl_SearchUsers(t *testing.T) {
 	})
 }
 
+// ============================================================================
+// 数据范围测试
+// ============================================================================
+
+func TestLogicImpl_ListUsers_DataScope(t *testing.T) {
+	callerID := uuid.New().String()
+	tenantID := uuid.New().String()
+	deptID := uuid.New().String()
+
+	tests := []struct {
+		name   string
+		hint   string
+		expect func(m *mock.TestMocks)
+		want   *base.DataScopeFilter
+	}{
+		{
+			name: "self 仅本人",
+			hint: "self",
+			want: &base.DataScopeFilter{Level: models.DataScopeSelf, UserID: callerID},
+		},
+		{
+			name: "dept 限定调用方部门",
+			hint: "dept",
+			expect: func(m *mock.TestMocks) {
+				m.MembershipRepo.EXPECT().
+					GetUserDepartments(gomock.Any(), callerID, tenantID).
+					Return([]string{deptID}, nil)
+			},
+			want: &base.DataScopeFilter{
+				Level:         models.DataScopeDept,
+				UserID:        callerID,
+				DepartmentIDs: []string{deptID},
+			},
+		},
+		{
+			name: "org 限定调用方租户",
+			hint: "org",
+			want: &base.DataScopeFilter{
+				Level:           models.DataScopeOrg,
+				UserID:          callerID,
+				OrganizationIDs: []string{tenantID},
+			},
+		},
+		{
+			name: "all 不限制",
+			hint: "all",
+			want: &base.DataScopeFilter{Level: models.DataScopeAll, UserID: callerID},
+		},
+	}
+
+	calls := map[string]func(l *LogicImpl, ctx context.Context) error{
+		"ListUsers": func(l *LogicImpl, ctx context.Context) error {
+			_, err := l.ListUsers(ctx, &identity_srv.ListUsersRequest{})
+			return err
+		},
+		"SearchUsers": func(l *LogicImpl, ctx context.Context) error {
+			_, err := l.SearchUsers(ctx, &identity_srv.SearchUsersRequest{})
+			return err
+		},
+	}
+
+	for endpoint, call := range calls {
+		for _, tt := range tests {
+			t.Run(endpoint+"/"+tt.name, func(t *testing.T) {
+				logic, mocks := setupTest(t)
+				ctx := datascope.WithScope(context.Background(), datascope.Scope{
+					Hint:     tt.hint,
+					UserID:   callerID,
+					TenantID: tenantID,
+				})
+
+				if tt.expect != nil {
+					tt.expect(mocks)
+				}
+
+				mocks.UserRepo.EXPECT().
+					FindWithConditions(ctx, gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						cond *userDAL.UserProfileQueryConditions,
+					) ([]*models.UserProfile, *models.PageResult, error) {
+						assert.Equal(t, tt.want, cond.Scope)
+						return []*models.UserProfile{}, &models.PageResult{Page: 1, Limit: 20}, nil
+					})
+
+				require.NoError(t, call(logic, ctx))
+			})
+		}
+	}
+
+	t.Run("数据范围解析失败", func(t *testing.T) {
+		logic, mocks := setupTest(t)
+		ctx := datascope.WithScope(context.Background(), datascope.Scope{Hint: "dept", UserID: callerID})
+
+		mocks.MembershipRepo.EXPECT().
+			GetUserDepartments(gomock.Any(), callerID, "").
+			Return(nil, gorm.ErrInvalidDB)
+
+		result, err := logic.ListUsers(ctx, &identity_srv.ListUsersRequest{})
+
+		assert.Nil(t, result)
+		assertErrCode(t, errno.ErrOperationFailed, err)
+	})
+}
+
 // ============================================================================
 // ChangeUserStatus 测试
 // ============================================================================
@@ -1139,7 +1400,7 @@ func TestNewLogic(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mocks := mock.NewTestMocks(ctrl)
 
-	logic := NewLogic(mocks.DAL, converter.NewConverter())
+	logic := NewLogic(mocks.DAL, converter.NewConverter(), passwordpolicy.NewEnforcer(&password.Policy{}))
 
 	assert.NotNil(t, logic)
 }
@@ -0,0 +1,754 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: biz/dal/accessreview/access_review_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=biz/dal/accessreview/access_review_interface.go -destination=biz/mock/accessreview_repo_mock.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	accessreview "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/accessreview"
+	base "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
+	models "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockAccessReviewCampaignRepository is a mock of AccessReviewCampaignRepository interface.
+type MockAccessReviewCampaignRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessReviewCampaignRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAccessReviewCampaignRepositoryMockRecorder is the mock recorder for MockAccessReviewCampaignRepository.
+type MockAccessReviewCampaignRepositoryMockRecorder struct {
+	mock *MockAccessReviewCampaignRepository
+}
+
+// NewMockAccessReviewCampaignRepository creates a new mock instance.
+func NewMockAccessReviewCampaignRepository(ctrl *gomock.Controller) *MockAccessReviewCampaignRepository {
+	mock := &MockAccessReviewCampaignRepository{ctrl: ctrl}
+	mock.recorder = &MockAccessReviewCampaignRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessReviewCampaignRepository) EXPECT() *MockAccessReviewCampaignRepositoryMockRecorder {
+	return m.recorder
+}
+
+// BatchCreate mocks base method.
+func (m *MockAccessReviewCampaignRepository) BatchCreate(ctx context.Context, entities []*models.AccessReviewCampaign) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCreate", ctx, entities)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchCreate indicates an expected call of BatchCreate.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) BatchCreate(ctx, entities any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCreate", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).BatchCreate), ctx, entities)
+}
+
+// BatchDelete mocks base method.
+func (m *MockAccessReviewCampaignRepository) BatchDelete(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchDelete", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchDelete indicates an expected call of BatchDelete.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) BatchDelete(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDelete", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).BatchDelete), ctx, ids)
+}
+
+// BatchGetByIDs mocks base method.
+func (m *MockAccessReviewCampaignRepository) BatchGetByIDs(ctx context.Context, ids []string) ([]*models.AccessReviewCampaign, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetByIDs", ctx, ids)
+	ret0, _ := ret[0].([]*models.AccessReviewCampaign)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetByIDs indicates an expected call of BatchGetByIDs.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) BatchGetByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetByIDs", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).BatchGetByIDs), ctx, ids)
+}
+
+// BatchHardDelete mocks base method.
+func (m *MockAccessReviewCampaignRepository) BatchHardDelete(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchHardDelete", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchHardDelete indicates an expected call of BatchHardDelete.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) BatchHardDelete(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchHardDelete", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).BatchHardDelete), ctx, ids)
+}
+
+// BatchRestore mocks base method.
+func (m *MockAccessReviewCampaignRepository) BatchRestore(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchRestore", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchRestore indicates an expected call of BatchRestore.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) BatchRestore(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchRestore", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).BatchRestore), ctx, ids)
+}
+
+// BatchSoftDelete mocks base method.
+func (m *MockAccessReviewCampaignRepository) BatchSoftDelete(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchSoftDelete", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchSoftDelete indicates an expected call of BatchSoftDelete.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) BatchSoftDelete(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchSoftDelete", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).BatchSoftDelete), ctx, ids)
+}
+
+// BatchUpdate mocks base method.
+func (m *MockAccessReviewCampaignRepository) BatchUpdate(ctx context.Context, entities []*models.AccessReviewCampaign) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpdate", ctx, entities)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchUpdate indicates an expected call of BatchUpdate.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) BatchUpdate(ctx, entities any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpdate", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).BatchUpdate), ctx, entities)
+}
+
+// Close mocks base method.
+func (m *MockAccessReviewCampaignRepository) Close(ctx context.Context, id string, closedBy *uuid.UUID, closedAt int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", ctx, id, closedBy, closedAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) Close(ctx, id, closedBy, closedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).Close), ctx, id, closedBy, closedAt)
+}
+
+// Count mocks base method.
+func (m *MockAccessReviewCampaignRepository) Count(ctx context.Context, opts *base.QueryOptions) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, opts)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) Count(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).Count), ctx, opts)
+}
+
+// Create mocks base method.
+func (m *MockAccessReviewCampaignRepository) Create(ctx context.Context, entity *models.AccessReviewCampaign) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) Create(ctx, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).Create), ctx, entity)
+}
+
+// Delete mocks base method.
+func (m *MockAccessReviewCampaignRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).Delete), ctx, id)
+}
+
+// Exists mocks base method.
+func (m *MockAccessReviewCampaignRepository) Exists(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) Exists(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).Exists), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockAccessReviewCampaignRepository) FindAll(ctx context.Context, opts *base.QueryOptions) ([]*models.AccessReviewCampaign, *models.PageResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, opts)
+	ret0, _ := ret[0].([]*models.AccessReviewCampaign)
+	ret1, _ := ret[1].(*models.PageResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) FindAll(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).FindAll), ctx, opts)
+}
+
+// FindDeletedBefore mocks base method.
+func (m *MockAccessReviewCampaignRepository) FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*models.AccessReviewCampaign, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedBefore", ctx, before, limit)
+	ret0, _ := ret[0].([]*models.AccessReviewCampaign)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedBefore indicates an expected call of FindDeletedBefore.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) FindDeletedBefore(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedBefore", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).FindDeletedBefore), ctx, before, limit)
+}
+
+// FindOverdueOpen mocks base method.
+func (m *MockAccessReviewCampaignRepository) FindOverdueOpen(ctx context.Context, nowMillis int64, limit int) ([]*models.AccessReviewCampaign, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOverdueOpen", ctx, nowMillis, limit)
+	ret0, _ := ret[0].([]*models.AccessReviewCampaign)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOverdueOpen indicates an expected call of FindOverdueOpen.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) FindOverdueOpen(ctx, nowMillis, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOverdueOpen", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).FindOverdueOpen), ctx, nowMillis, limit)
+}
+
+// FindWithConditions mocks base method.
+func (m *MockAccessReviewCampaignRepository) FindWithConditions(ctx context.Context, conditions *accessreview.CampaignQueryConditions) ([]*models.AccessReviewCampaign, *models.PageResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWithConditions", ctx, conditions)
+	ret0, _ := ret[0].([]*models.AccessReviewCampaign)
+	ret1, _ := ret[1].(*models.PageResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindWithConditions indicates an expected call of FindWithConditions.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) FindWithConditions(ctx, conditions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWithConditions", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).FindWithConditions), ctx, conditions)
+}
+
+// GetByID mocks base method.
+func (m *MockAccessReviewCampaignRepository) GetByID(ctx context.Context, id string) (*models.AccessReviewCampaign, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*models.AccessReviewCampaign)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).GetByID), ctx, id)
+}
+
+// GetDeletedByID mocks base method.
+func (m *MockAccessReviewCampaignRepository) GetDeletedByID(ctx context.Context, id string) (*models.AccessReviewCampaign, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedByID", ctx, id)
+	ret0, _ := ret[0].(*models.AccessReviewCampaign)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedByID indicates an expected call of GetDeletedByID.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) GetDeletedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedByID", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).GetDeletedByID), ctx, id)
+}
+
+// HardDelete mocks base method.
+func (m *MockAccessReviewCampaignRepository) HardDelete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HardDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HardDelete indicates an expected call of HardDelete.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) HardDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HardDelete", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).HardDelete), ctx, id)
+}
+
+// Restore mocks base method.
+func (m *MockAccessReviewCampaignRepository) Restore(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).Restore), ctx, id)
+}
+
+// SoftDelete mocks base method.
+func (m *MockAccessReviewCampaignRepository) SoftDelete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDelete indicates an expected call of SoftDelete.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) SoftDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDelete", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).SoftDelete), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockAccessReviewCampaignRepository) Update(ctx context.Context, entity *models.AccessReviewCampaign) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) Update(ctx, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).Update), ctx, entity)
+}
+
+// WithTx mocks base method.
+func (m *MockAccessReviewCampaignRepository) WithTx(tx *gorm.DB) base.BaseRepository[models.AccessReviewCampaign] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(base.BaseRepository[models.AccessReviewCampaign])
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockAccessReviewCampaignRepositoryMockRecorder) WithTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockAccessReviewCampaignRepository)(nil).WithTx), tx)
+}
+
+// MockAccessReviewItemRepository is a mock of AccessReviewItemRepository interface.
+type MockAccessReviewItemRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessReviewItemRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAccessReviewItemRepositoryMockRecorder is the mock recorder for MockAccessReviewItemRepository.
+type MockAccessReviewItemRepositoryMockRecorder struct {
+	mock *MockAccessReviewItemRepository
+}
+
+// NewMockAccessReviewItemRepository creates a new mock instance.
+func NewMockAccessReviewItemRepository(ctrl *gomock.Controller) *MockAccessReviewItemRepository {
+	mock := &MockAccessReviewItemRepository{ctrl: ctrl}
+	mock.recorder = &MockAccessReviewItemRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessReviewItemRepository) EXPECT() *MockAccessReviewItemRepositoryMockRecorder {
+	return m.recorder
+}
+
+// BatchCreate mocks base method.
+func (m *MockAccessReviewItemRepository) BatchCreate(ctx context.Context, entities []*models.AccessReviewItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCreate", ctx, entities)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchCreate indicates an expected call of BatchCreate.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) BatchCreate(ctx, entities any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCreate", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).BatchCreate), ctx, entities)
+}
+
+// BatchDelete mocks base method.
+func (m *MockAccessReviewItemRepository) BatchDelete(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchDelete", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchDelete indicates an expected call of BatchDelete.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) BatchDelete(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDelete", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).BatchDelete), ctx, ids)
+}
+
+// BatchGetByIDs mocks base method.
+func (m *MockAccessReviewItemRepository) BatchGetByIDs(ctx context.Context, ids []string) ([]*models.AccessReviewItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetByIDs", ctx, ids)
+	ret0, _ := ret[0].([]*models.AccessReviewItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetByIDs indicates an expected call of BatchGetByIDs.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) BatchGetByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetByIDs", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).BatchGetByIDs), ctx, ids)
+}
+
+// BatchHardDelete mocks base method.
+func (m *MockAccessReviewItemRepository) BatchHardDelete(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchHardDelete", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchHardDelete indicates an expected call of BatchHardDelete.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) BatchHardDelete(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchHardDelete", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).BatchHardDelete), ctx, ids)
+}
+
+// BatchRestore mocks base method.
+func (m *MockAccessReviewItemRepository) BatchRestore(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchRestore", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchRestore indicates an expected call of BatchRestore.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) BatchRestore(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchRestore", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).BatchRestore), ctx, ids)
+}
+
+// BatchSoftDelete mocks base method.
+func (m *MockAccessReviewItemRepository) BatchSoftDelete(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchSoftDelete", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchSoftDelete indicates an expected call of BatchSoftDelete.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) BatchSoftDelete(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchSoftDelete", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).BatchSoftDelete), ctx, ids)
+}
+
+// BatchUpdate mocks base method.
+func (m *MockAccessReviewItemRepository) BatchUpdate(ctx context.Context, entities []*models.AccessReviewItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpdate", ctx, entities)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchUpdate indicates an expected call of BatchUpdate.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) BatchUpdate(ctx, entities any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpdate", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).BatchUpdate), ctx, entities)
+}
+
+// Count mocks base method.
+func (m *MockAccessReviewItemRepository) Count(ctx context.Context, opts *base.QueryOptions) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, opts)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) Count(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).Count), ctx, opts)
+}
+
+// CountByDecision mocks base method.
+func (m *MockAccessReviewItemRepository) CountByDecision(ctx context.Context, campaignIDs []string) (map[string]accessreview.DecisionCounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByDecision", ctx, campaignIDs)
+	ret0, _ := ret[0].(map[string]accessreview.DecisionCounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByDecision indicates an expected call of CountByDecision.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) CountByDecision(ctx, campaignIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByDecision", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).CountByDecision), ctx, campaignIDs)
+}
+
+// Create mocks base method.
+func (m *MockAccessReviewItemRepository) Create(ctx context.Context, entity *models.AccessReviewItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) Create(ctx, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).Create), ctx, entity)
+}
+
+// Decide mocks base method.
+func (m *MockAccessReviewItemRepository) Decide(ctx context.Context, id string, decision accessreview.ItemDecision) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decide", ctx, id, decision)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decide indicates an expected call of Decide.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) Decide(ctx, id, decision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decide", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).Decide), ctx, id, decision)
+}
+
+// Delete mocks base method.
+func (m *MockAccessReviewItemRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).Delete), ctx, id)
+}
+
+// Exists mocks base method.
+func (m *MockAccessReviewItemRepository) Exists(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) Exists(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).Exists), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockAccessReviewItemRepository) FindAll(ctx context.Context, opts *base.QueryOptions) ([]*models.AccessReviewItem, *models.PageResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, opts)
+	ret0, _ := ret[0].([]*models.AccessReviewItem)
+	ret1, _ := ret[1].(*models.PageResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) FindAll(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).FindAll), ctx, opts)
+}
+
+// FindDeletedBefore mocks base method.
+func (m *MockAccessReviewItemRepository) FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*models.AccessReviewItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedBefore", ctx, before, limit)
+	ret0, _ := ret[0].([]*models.AccessReviewItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedBefore indicates an expected call of FindDeletedBefore.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) FindDeletedBefore(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedBefore", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).FindDeletedBefore), ctx, before, limit)
+}
+
+// FindPending mocks base method.
+func (m *MockAccessReviewItemRepository) FindPending(ctx context.Context, campaignID string, limit int) ([]*models.AccessReviewItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPending", ctx, campaignID, limit)
+	ret0, _ := ret[0].([]*models.AccessReviewItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPending indicates an expected call of FindPending.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) FindPending(ctx, campaignID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPending", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).FindPending), ctx, campaignID, limit)
+}
+
+// FindWithConditions mocks base method.
+func (m *MockAccessReviewItemRepository) FindWithConditions(ctx context.Context, conditions *accessreview.ItemQueryConditions) ([]*models.AccessReviewItem, *models.PageResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWithConditions", ctx, conditions)
+	ret0, _ := ret[0].([]*models.AccessReviewItem)
+	ret1, _ := ret[1].(*models.PageResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindWithConditions indicates an expected call of FindWithConditions.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) FindWithConditions(ctx, conditions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWithConditions", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).FindWithConditions), ctx, conditions)
+}
+
+// GetByID mocks base method.
+func (m *MockAccessReviewItemRepository) GetByID(ctx context.Context, id string) (*models.AccessReviewItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*models.AccessReviewItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).GetByID), ctx, id)
+}
+
+// GetDeletedByID mocks base method.
+func (m *MockAccessReviewItemRepository) GetDeletedByID(ctx context.Context, id string) (*models.AccessReviewItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedByID", ctx, id)
+	ret0, _ := ret[0].(*models.AccessReviewItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedByID indicates an expected call of GetDeletedByID.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) GetDeletedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedByID", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).GetDeletedByID), ctx, id)
+}
+
+// HardDelete mocks base method.
+func (m *MockAccessReviewItemRepository) HardDelete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HardDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HardDelete indicates an expected call of HardDelete.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) HardDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HardDelete", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).HardDelete), ctx, id)
+}
+
+// Restore mocks base method.
+func (m *MockAccessReviewItemRepository) Restore(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).Restore), ctx, id)
+}
+
+// SoftDelete mocks base method.
+func (m *MockAccessReviewItemRepository) SoftDelete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDelete indicates an expected call of SoftDelete.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) SoftDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDelete", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).SoftDelete), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockAccessReviewItemRepository) Update(ctx context.Context, entity *models.AccessReviewItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) Update(ctx, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).Update), ctx, entity)
+}
+
+// WithTx mocks base method.
+func (m *MockAccessReviewItemRepository) WithTx(tx *gorm.DB) base.BaseRepository[models.AccessReviewItem] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(base.BaseRepository[models.AccessReviewItem])
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockAccessReviewItemRepositoryMockRecorder) WithTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockAccessReviewItemRepository)(nil).WithTx), tx)
+}
@@ -12,6 +12,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	assignment "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/assignment"
 	base "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
@@ -172,18 +173,18 @@ func (mr *MockUserRoleAssignmentRepositoryMockRecorder) BatchUpdate(ctx, entitie
 }
 
 // CheckUserRoleExists mocks base method.
-func (m *MockUserRoleAssignmentRepository) CheckUserRoleExists(ctx context.Context, userID, roleID string) (bool, error) {
+func (m *MockUserRoleAssignmentRepository) CheckUserRoleExists(ctx context.Context, userID, roleID, organizationID string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckUserRoleExists", ctx, userID, roleID)
+	ret := m.ctrl.Call(m, "CheckUserRoleExists", ctx, userID, roleID, organizationID)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CheckUserRoleExists indicates an expected call of CheckUserRoleExists.
-func (mr *MockUserRoleAssignmentRepositoryMockRecorder) CheckUserRoleExists(ctx, userID, roleID any) *gomock.Call {
+func (mr *MockUserRoleAssignmentRepositoryMockRecorder) CheckUserRoleExists(ctx, userID, roleID, organizationID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUserRoleExists", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).CheckUserRoleExists), ctx, userID, roleID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUserRoleExists", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).CheckUserRoleExists), ctx, userID, roleID, organizationID)
 }
 
 // Count mocks base method.
@@ -201,6 +202,21 @@ func (mr *MockUserRoleAssignmentRepositoryMockRecorder) Count(ctx, opts any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).Count), ctx, opts)
 }
 
+// CountByOrganization mocks base method.
+func (m *MockUserRoleAssignmentRepository) CountByOrganization(ctx context.Context, organizationID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByOrganization", ctx, organizationID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByOrganization indicates an expected call of CountByOrganization.
+func (mr *MockUserRoleAssignmentRepositoryMockRecorder) CountByOrganization(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByOrganization", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).CountByOrganization), ctx, organizationID)
+}
+
 // CountByRoleID mocks base method.
 func (m *MockUserRoleAssignmentRepository) CountByRoleID(ctx context.Context, roleID string) (int64, error) {
 	m.ctrl.T.Helper()
@@ -307,18 +323,18 @@ func (mr *MockUserRoleAssignmentRepositoryMockRecorder) FindByRoleID(ctx, roleID
 }
 
 // FindByUserAndRole mocks base method.
-func (m *MockUserRoleAssignmentRepository) FindByUserAndRole(ctx context.Context, userID, roleID string) (*models.UserRoleAssignment, error) {
+func (m *MockUserRoleAssignmentRepository) FindByUserAndRole(ctx context.Context, userID, roleID, organizationID string) (*models.UserRoleAssignment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByUserAndRole", ctx, userID, roleID)
+	ret := m.ctrl.Call(m, "FindByUserAndRole", ctx, userID, roleID, organizationID)
 	ret0, _ := ret[0].(*models.UserRoleAssignment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByUserAndRole indicates an expected call of FindByUserAndRole.
-func (mr *MockUserRoleAssignmentRepositoryMockRecorder) FindByUserAndRole(ctx, userID, roleID any) *gomock.Call {
+func (mr *MockUserRoleAssignmentRepositoryMockRecorder) FindByUserAndRole(ctx, userID, roleID, organizationID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserAndRole", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).FindByUserAndRole), ctx, userID, roleID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserAndRole", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).FindByUserAndRole), ctx, userID, roleID, organizationID)
 }
 
 // FindByUserID mocks base method.
@@ -337,6 +353,96 @@ func (mr *MockUserRoleAssignmentRepositoryMockRecorder) FindByUserID(ctx, userID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).FindByUserID), ctx, userID, page)
 }
 
+// FindDeletedBefore mocks base method.
+func (m *MockUserRoleAssignmentRepository) FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*models.UserRoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedBefore", ctx, before, limit)
+	ret0, _ := ret[0].([]*models.UserRoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedBefore indicates an expected call of FindDeletedBefore.
+func (mr *MockUserRoleAssignmentRepositoryMockRecorder) FindDeletedBefore(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedBefore", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).FindDeletedBefore), ctx, before, limit)
+}
+
+// FindDeletedByUser mocks base method.
+func (m *MockUserRoleAssignmentRepository) FindDeletedByUser(ctx context.Context, userID string, deletedAt time.Time) ([]*models.UserRoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByUser", ctx, userID, deletedAt)
+	ret0, _ := ret[0].([]*models.UserRoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByUser indicates an expected call of FindDeletedByUser.
+func (mr *MockUserRoleAssignmentRepositoryMockRecorder) FindDeletedByUser(ctx, userID, deletedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByUser", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).FindDeletedByUser), ctx, userID, deletedAt)
+}
+
+// FindEffectiveByUserIDs mocks base method.
+func (m *MockUserRoleAssignmentRepository) FindEffectiveByUserIDs(ctx context.Context, userIDs []string, organizationID *string) ([]*models.UserRoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEffectiveByUserIDs", ctx, userIDs, organizationID)
+	ret0, _ := ret[0].([]*models.UserRoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEffectiveByUserIDs indicates an expected call of FindEffectiveByUserIDs.
+func (mr *MockUserRoleAssignmentRepositoryMockRecorder) FindEffectiveByUserIDs(ctx, userIDs, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEffectiveByUserIDs", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).FindEffectiveByUserIDs), ctx, userIDs, organizationID)
+}
+
+// FindExpired mocks base method.
+func (m *MockUserRoleAssignmentRepository) FindExpired(ctx context.Context, nowMillis int64, limit int) ([]*models.UserRoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExpired", ctx, nowMillis, limit)
+	ret0, _ := ret[0].([]*models.UserRoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExpired indicates an expected call of FindExpired.
+func (mr *MockUserRoleAssignmentRepositoryMockRecorder) FindExpired(ctx, nowMillis, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExpired", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).FindExpired), ctx, nowMillis, limit)
+}
+
+// FindUnexpiredByRoleIDs mocks base method.
+func (m *MockUserRoleAssignmentRepository) FindUnexpiredByRoleIDs(ctx context.Context, roleIDs, userIDs []string, nowMillis int64) ([]*models.UserRoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUnexpiredByRoleIDs", ctx, roleIDs, userIDs, nowMillis)
+	ret0, _ := ret[0].([]*models.UserRoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUnexpiredByRoleIDs indicates an expected call of FindUnexpiredByRoleIDs.
+func (mr *MockUserRoleAssignmentRepositoryMockRecorder) FindUnexpiredByRoleIDs(ctx, roleIDs, userIDs, nowMillis any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUnexpiredByRoleIDs", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).FindUnexpiredByRoleIDs), ctx, roleIDs, userIDs, nowMillis)
+}
+
+// FindUnexpiredInScope mocks base method.
+func (m *MockUserRoleAssignmentRepository) FindUnexpiredInScope(ctx context.Context, roleID, organizationID string, nowMillis int64) ([]*models.UserRoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUnexpiredInScope", ctx, roleID, organizationID, nowMillis)
+	ret0, _ := ret[0].([]*models.UserRoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUnexpiredInScope indicates an expected call of FindUnexpiredInScope.
+func (mr *MockUserRoleAssignmentRepositoryMockRecorder) FindUnexpiredInScope(ctx, roleID, organizationID, nowMillis any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUnexpiredInScope", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).FindUnexpiredInScope), ctx, roleID, organizationID, nowMillis)
+}
+
 // FindWithConditions mocks base method.
 func (m *MockUserRoleAssignmentRepository) FindWithConditions(ctx context.Context, conditions *assignment.UserRoleAssignmentQueryConditions) ([]*models.UserRoleAssignment, *models.PageResult, error) {
 	m.ctrl.T.Helper()
@@ -354,18 +460,18 @@ func (mr *MockUserRoleAssignmentRepositoryMockRecorder) FindWithConditions(ctx,
 }
 
 // GetActiveRoleIDsWithStatus mocks base method.
-func (m *MockUserRoleAssignmentRepository) GetActiveRoleIDsWithStatus(ctx context.Context, userID string, status models.RoleStatus) ([]string, error) {
+func (m *MockUserRoleAssignmentRepository) GetActiveRoleIDsWithStatus(ctx context.Context, userID string, organizationID *string, status models.RoleStatus) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetActiveRoleIDsWithStatus", ctx, userID, status)
+	ret := m.ctrl.Call(m, "GetActiveRoleIDsWithStatus", ctx, userID, organizationID, status)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetActiveRoleIDsWithStatus indicates an expected call of GetActiveRoleIDsWithStatus.
-func (mr *MockUserRoleAssignmentRepositoryMockRecorder) GetActiveRoleIDsWithStatus(ctx, userID, status any) *gomock.Call {
+func (mr *MockUserRoleAssignmentRepositoryMockRecorder) GetActiveRoleIDsWithStatus(ctx, userID, organizationID, status any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveRoleIDsWithStatus", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).GetActiveRoleIDsWithStatus), ctx, userID, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveRoleIDsWithStatus", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).GetActiveRoleIDsWithStatus), ctx, userID, organizationID, status)
 }
 
 // GetActiveRolesByUserID mocks base method.
@@ -384,18 +490,18 @@ func (mr *MockUserRoleAssignmentRepositoryMockRecorder) GetActiveRolesByUserID(c
 }
 
 // GetAllUserIDsByRoleID mocks base method.
-func (m *MockUserRoleAssignmentRepository) GetAllUserIDsByRoleID(ctx context.Context, roleID string) ([]string, error) {
+func (m *MockUserRoleAssignmentRepository) GetAllUserIDsByRoleID(ctx context.Context, roleID string, organizationID *string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllUserIDsByRoleID", ctx, roleID)
+	ret := m.ctrl.Call(m, "GetAllUserIDsByRoleID", ctx, roleID, organizationID)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllUserIDsByRoleID indicates an expected call of GetAllUserIDsByRoleID.
-func (mr *MockUserRoleAssignmentRepositoryMockRecorder) GetAllUserIDsByRoleID(ctx, roleID any) *gomock.Call {
+func (mr *MockUserRoleAssignmentRepositoryMockRecorder) GetAllUserIDsByRoleID(ctx, roleID, organizationID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUserIDsByRoleID", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).GetAllUserIDsByRoleID), ctx, roleID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUserIDsByRoleID", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).GetAllUserIDsByRoleID), ctx, roleID, organizationID)
 }
 
 // GetByID mocks base method.
@@ -413,6 +519,21 @@ func (mr *MockUserRoleAssignmentRepositoryMockRecorder) GetByID(ctx, id any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).GetByID), ctx, id)
 }
 
+// GetDeletedByID mocks base method.
+func (m *MockUserRoleAssignmentRepository) GetDeletedByID(ctx context.Context, id string) (*models.UserRoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedByID", ctx, id)
+	ret0, _ := ret[0].(*models.UserRoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedByID indicates an expected call of GetDeletedByID.
+func (mr *MockUserRoleAssignmentRepositoryMockRecorder) GetDeletedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedByID", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).GetDeletedByID), ctx, id)
+}
+
 // GetLastUserRoleAssignment mocks base method.
 func (m *MockUserRoleAssignmentRepository) GetLastUserRoleAssignment(ctx context.Context, userID string) (*models.UserRoleAssignment, error) {
 	m.ctrl.T.Helper()
@@ -457,6 +578,34 @@ func (mr *MockUserRoleAssignmentRepositoryMockRecorder) HardDelete(ctx, id any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HardDelete", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).HardDelete), ctx, id)
 }
 
+// PurgeByUser mocks base method.
+func (m *MockUserRoleAssignmentRepository) PurgeByUser(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeByUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeByUser indicates an expected call of PurgeByUser.
+func (mr *MockUserRoleAssignmentRepositoryMockRecorder) PurgeByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeByUser", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).PurgeByUser), ctx, userID)
+}
+
+// PurgeDeletedByOrganization mocks base method.
+func (m *MockUserRoleAssignmentRepository) PurgeDeletedByOrganization(ctx context.Context, organizationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedByOrganization", ctx, organizationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDeletedByOrganization indicates an expected call of PurgeDeletedByOrganization.
+func (mr *MockUserRoleAssignmentRepositoryMockRecorder) PurgeDeletedByOrganization(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedByOrganization", reflect.TypeOf((*MockUserRoleAssignmentRepository)(nil).PurgeDeletedByOrganization), ctx, organizationID)
+}
+
 // ReplaceRoleUsers mocks base method.
 func (m *MockUserRoleAssignmentRepository) ReplaceRoleUsers(ctx context.Context, roleID string, userIDs []string, operatorID string) error {
 	m.ctrl.T.Helper()
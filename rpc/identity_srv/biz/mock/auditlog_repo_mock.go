@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: biz/dal/auditlog/audit_log_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=biz/dal/auditlog/audit_log_interface.go -destination=biz/mock/auditlog_repo_mock.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	auditlog "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/auditlog"
+	models "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuditLogRepository is a mock of AuditLogRepository interface.
+type MockAuditLogRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLogRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditLogRepositoryMockRecorder is the mock recorder for MockAuditLogRepository.
+type MockAuditLogRepositoryMockRecorder struct {
+	mock *MockAuditLogRepository
+}
+
+// NewMockAuditLogRepository creates a new mock instance.
+func NewMockAuditLogRepository(ctrl *gomock.Controller) *MockAuditLogRepository {
+	mock := &MockAuditLogRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditLogRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogRepository) EXPECT() *MockAuditLogRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAuditLogRepository) Create(ctx context.Context, log *models.AuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, log)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAuditLogRepositoryMockRecorder) Create(ctx, log any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuditLogRepository)(nil).Create), ctx, log)
+}
+
+// FindWithConditions mocks base method.
+func (m *MockAuditLogRepository) FindWithConditions(ctx context.Context, conditions *auditlog.AuditLogQueryConditions) ([]*models.AuditLog, *models.PageResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWithConditions", ctx, conditions)
+	ret0, _ := ret[0].([]*models.AuditLog)
+	ret1, _ := ret[1].(*models.PageResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindWithConditions indicates an expected call of FindWithConditions.
+func (mr *MockAuditLogRepositoryMockRecorder) FindWithConditions(ctx, conditions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWithConditions", reflect.TypeOf((*MockAuditLogRepository)(nil).FindWithConditions), ctx, conditions)
+}
+
+// GetStatsByConditions mocks base method.
+func (m *MockAuditLogRepository) GetStatsByConditions(ctx context.Context, conditions *auditlog.AuditLogQueryConditions) (*auditlog.AuditLogStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatsByConditions", ctx, conditions)
+	ret0, _ := ret[0].(*auditlog.AuditLogStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatsByConditions indicates an expected call of GetStatsByConditions.
+func (mr *MockAuditLogRepositoryMockRecorder) GetStatsByConditions(ctx, conditions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatsByConditions", reflect.TypeOf((*MockAuditLogRepository)(nil).GetStatsByConditions), ctx, conditions)
+}
@@ -13,6 +13,7 @@ import (
 	reflect "reflect"
 
 	assignment "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/assignment"
+	auditlog "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/auditlog"
 	authentication "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/authentication"
 	base "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/base"
 	definition "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/definition"
@@ -22,6 +23,7 @@ import (
 	membership "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/membership"
 	menu "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/menu"
 	organization "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/organization"
+	recyclebin "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/recyclebin"
 	user "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter/user"
 	identity_srv "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
 	models "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
@@ -52,6 +54,20 @@ func (m *MockConverter) EXPECT() *MockConverterMockRecorder {
 	return m.recorder
 }
 
+// AuditLog mocks base method.
+func (m *MockConverter) AuditLog() auditlog.Converter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditLog")
+	ret0, _ := ret[0].(auditlog.Converter)
+	return ret0
+}
+
+// AuditLog indicates an expected call of AuditLog.
+func (mr *MockConverterMockRecorder) AuditLog() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditLog", reflect.TypeOf((*MockConverter)(nil).AuditLog))
+}
+
 // Authentication mocks base method.
 func (m *MockConverter) Authentication() authentication.Converter {
 	m.ctrl.T.Helper()
@@ -178,6 +194,20 @@ func (mr *MockConverterMockRecorder) Organization() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Organization", reflect.TypeOf((*MockConverter)(nil).Organization))
 }
 
+// RecycleBin mocks base method.
+func (m *MockConverter) RecycleBin() recyclebin.Converter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecycleBin")
+	ret0, _ := ret[0].(recyclebin.Converter)
+	return ret0
+}
+
+// RecycleBin indicates an expected call of RecycleBin.
+func (mr *MockConverterMockRecorder) RecycleBin() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecycleBin", reflect.TypeOf((*MockConverter)(nil).RecycleBin))
+}
+
 // RoleDefinition mocks base method.
 func (m *MockConverter) RoleDefinition() definition.Converter {
 	m.ctrl.T.Helper()
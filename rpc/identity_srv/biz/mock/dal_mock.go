@@ -12,16 +12,23 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	dal "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
+	accessreview "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/accessreview"
 	assignment "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/assignment"
 	auditlog "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/auditlog"
 	definition "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/definition"
 	department "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/department"
+	grantrequest "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/grantrequest"
 	logo "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/logo"
 	membership "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/membership"
 	menu "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/menu"
+	mfa "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/mfa"
 	organization "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/organization"
+	passwordhistory "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/passwordhistory"
+	passwordpolicy "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/passwordpolicy"
+	passwordreset "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/passwordreset"
 	rolemenu "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/rolemenu"
 	user "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/user"
 	gomock "go.uber.org/mock/gomock"
@@ -52,6 +59,34 @@ func (m *MockDAL) EXPECT() *MockDALMockRecorder {
 	return m.recorder
 }
 
+// AccessReviewCampaign mocks base method.
+func (m *MockDAL) AccessReviewCampaign() accessreview.AccessReviewCampaignRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccessReviewCampaign")
+	ret0, _ := ret[0].(accessreview.AccessReviewCampaignRepository)
+	return ret0
+}
+
+// AccessReviewCampaign indicates an expected call of AccessReviewCampaign.
+func (mr *MockDALMockRecorder) AccessReviewCampaign() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccessReviewCampaign", reflect.TypeOf((*MockDAL)(nil).AccessReviewCampaign))
+}
+
+// AccessReviewItem mocks base method.
+func (m *MockDAL) AccessReviewItem() accessreview.AccessReviewItemRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccessReviewItem")
+	ret0, _ := ret[0].(accessreview.AccessReviewItemRepository)
+	return ret0
+}
+
+// AccessReviewItem indicates an expected call of AccessReviewItem.
+func (mr *MockDALMockRecorder) AccessReviewItem() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccessReviewItem", reflect.TypeOf((*MockDAL)(nil).AccessReviewItem))
+}
+
 // AuditLog mocks base method.
 func (m *MockDAL) AuditLog() auditlog.AuditLogRepository {
 	m.ctrl.T.Helper()
@@ -137,6 +172,20 @@ func (mr *MockDALMockRecorder) Logo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logo", reflect.TypeOf((*MockDAL)(nil).Logo))
 }
 
+// MFA mocks base method.
+func (m *MockDAL) MFA() mfa.MFARepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MFA")
+	ret0, _ := ret[0].(mfa.MFARepository)
+	return ret0
+}
+
+// MFA indicates an expected call of MFA.
+func (mr *MockDALMockRecorder) MFA() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFA", reflect.TypeOf((*MockDAL)(nil).MFA))
+}
+
 // Menu mocks base method.
 func (m *MockDAL) Menu() menu.MenuRepository {
 	m.ctrl.T.Helper()
@@ -165,6 +214,48 @@ func (mr *MockDALMockRecorder) Organization() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Organization", reflect.TypeOf((*MockDAL)(nil).Organization))
 }
 
+// PasswordHistory mocks base method.
+func (m *MockDAL) PasswordHistory() passwordhistory.PasswordHistoryRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PasswordHistory")
+	ret0, _ := ret[0].(passwordhistory.PasswordHistoryRepository)
+	return ret0
+}
+
+// PasswordHistory indicates an expected call of PasswordHistory.
+func (mr *MockDALMockRecorder) PasswordHistory() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PasswordHistory", reflect.TypeOf((*MockDAL)(nil).PasswordHistory))
+}
+
+// PasswordPolicy mocks base method.
+func (m *MockDAL) PasswordPolicy() passwordpolicy.PasswordPolicyRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PasswordPolicy")
+	ret0, _ := ret[0].(passwordpolicy.PasswordPolicyRepository)
+	return ret0
+}
+
+// PasswordPolicy indicates an expected call of PasswordPolicy.
+func (mr *MockDALMockRecorder) PasswordPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PasswordPolicy", reflect.TypeOf((*MockDAL)(nil).PasswordPolicy))
+}
+
+// PasswordResetToken mocks base method.
+func (m *MockDAL) PasswordResetToken() passwordreset.PasswordResetTokenRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PasswordResetToken")
+	ret0, _ := ret[0].(passwordreset.PasswordResetTokenRepository)
+	return ret0
+}
+
+// PasswordResetToken indicates an expected call of PasswordResetToken.
+func (mr *MockDALMockRecorder) PasswordResetToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PasswordResetToken", reflect.TypeOf((*MockDAL)(nil).PasswordResetToken))
+}
+
 // RoleDefinition mocks base method.
 func (m *MockDAL) RoleDefinition() definition.RoleDefinitionRepository {
 	m.ctrl.T.Helper()
@@ -179,6 +270,20 @@ func (mr *MockDALMockRecorder) RoleDefinition() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RoleDefinition", reflect.TypeOf((*MockDAL)(nil).RoleDefinition))
 }
 
+// RoleGrantRequest mocks base method.
+func (m *MockDAL) RoleGrantRequest() grantrequest.RoleGrantRequestRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RoleGrantRequest")
+	ret0, _ := ret[0].(grantrequest.RoleGrantRequestRepository)
+	return ret0
+}
+
+// RoleGrantRequest indicates an expected call of RoleGrantRequest.
+func (mr *MockDALMockRecorder) RoleGrantRequest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RoleGrantRequest", reflect.TypeOf((*MockDAL)(nil).RoleGrantRequest))
+}
+
 // RoleMenuPermission mocks base method.
 func (m *MockDAL) RoleMenuPermission() rolemenu.RoleMenuPermissionRepository {
 	m.ctrl.T.Helper()
@@ -263,6 +368,20 @@ func (mr *MockDALMockRecorder) WithDB(db any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithDB", reflect.TypeOf((*MockDAL)(nil).WithDB), db)
 }
 
+// WithNow mocks base method.
+func (m *MockDAL) WithNow(now time.Time) dal.DAL {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithNow", now)
+	ret0, _ := ret[0].(dal.DAL)
+	return ret0
+}
+
+// WithNow indicates an expected call of WithNow.
+func (mr *MockDALMockRecorder) WithNow(now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithNow", reflect.TypeOf((*MockDAL)(nil).WithNow), now)
+}
+
 // WithTransaction mocks base method.
 func (m *MockDAL) WithTransaction(ctx context.Context, fn func(context.Context, dal.DAL) error) error {
 	m.ctrl.T.Helper()
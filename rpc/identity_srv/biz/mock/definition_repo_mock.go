@@ -12,6 +12,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	base "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
 	definition "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/definition"
@@ -262,6 +263,21 @@ func (mr *MockRoleDefinitionRepositoryMockRecorder) FindByName(ctx, name any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByName", reflect.TypeOf((*MockRoleDefinitionRepository)(nil).FindByName), ctx, name)
 }
 
+// FindByRoleCodes mocks base method.
+func (m *MockRoleDefinitionRepository) FindByRoleCodes(ctx context.Context, roleCodes []string) ([]*models.RoleDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByRoleCodes", ctx, roleCodes)
+	ret0, _ := ret[0].([]*models.RoleDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByRoleCodes indicates an expected call of FindByRoleCodes.
+func (mr *MockRoleDefinitionRepositoryMockRecorder) FindByRoleCodes(ctx, roleCodes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByRoleCodes", reflect.TypeOf((*MockRoleDefinitionRepository)(nil).FindByRoleCodes), ctx, roleCodes)
+}
+
 // FindByStatus mocks base method.
 func (m *MockRoleDefinitionRepository) FindByStatus(ctx context.Context, status models.RoleStatus, page *base.QueryOptions) ([]*models.RoleDefinition, *models.PageResult, error) {
 	m.ctrl.T.Helper()
@@ -294,6 +310,36 @@ func (mr *MockRoleDefinitionRepositoryMockRecorder) FindBySystemRole(ctx, isSyst
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySystemRole", reflect.TypeOf((*MockRoleDefinitionRepository)(nil).FindBySystemRole), ctx, isSystemRole, page)
 }
 
+// FindConstrained mocks base method.
+func (m *MockRoleDefinitionRepository) FindConstrained(ctx context.Context) ([]*models.RoleDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindConstrained", ctx)
+	ret0, _ := ret[0].([]*models.RoleDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindConstrained indicates an expected call of FindConstrained.
+func (mr *MockRoleDefinitionRepositoryMockRecorder) FindConstrained(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindConstrained", reflect.TypeOf((*MockRoleDefinitionRepository)(nil).FindConstrained), ctx)
+}
+
+// FindDeletedBefore mocks base method.
+func (m *MockRoleDefinitionRepository) FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*models.RoleDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedBefore", ctx, before, limit)
+	ret0, _ := ret[0].([]*models.RoleDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedBefore indicates an expected call of FindDeletedBefore.
+func (mr *MockRoleDefinitionRepositoryMockRecorder) FindDeletedBefore(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedBefore", reflect.TypeOf((*MockRoleDefinitionRepository)(nil).FindDeletedBefore), ctx, before, limit)
+}
+
 // FindWithConditions mocks base method.
 func (m *MockRoleDefinitionRepository) FindWithConditions(ctx context.Context, conditions *definition.RoleDefinitionQueryConditions) ([]*models.RoleDefinition, *models.PageResult, error) {
 	m.ctrl.T.Helper()
@@ -325,6 +371,21 @@ func (mr *MockRoleDefinitionRepositoryMockRecorder) GetByID(ctx, id any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRoleDefinitionRepository)(nil).GetByID), ctx, id)
 }
 
+// GetDeletedByID mocks base method.
+func (m *MockRoleDefinitionRepository) GetDeletedByID(ctx context.Context, id string) (*models.RoleDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedByID", ctx, id)
+	ret0, _ := ret[0].(*models.RoleDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedByID indicates an expected call of GetDeletedByID.
+func (mr *MockRoleDefinitionRepositoryMockRecorder) GetDeletedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedByID", reflect.TypeOf((*MockRoleDefinitionRepository)(nil).GetDeletedByID), ctx, id)
+}
+
 // HardDelete mocks base method.
 func (m *MockRoleDefinitionRepository) HardDelete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
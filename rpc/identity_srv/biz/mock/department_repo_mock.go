@@ -12,6 +12,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	base "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
 	department "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/department"
@@ -353,6 +354,21 @@ func (mr *MockDepartmentRepositoryMockRecorder) FindAll(ctx, opts any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockDepartmentRepository)(nil).FindAll), ctx, opts)
 }
 
+// FindDeletedBefore mocks base method.
+func (m *MockDepartmentRepository) FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*models.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedBefore", ctx, before, limit)
+	ret0, _ := ret[0].([]*models.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedBefore indicates an expected call of FindDeletedBefore.
+func (mr *MockDepartmentRepositoryMockRecorder) FindDeletedBefore(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedBefore", reflect.TypeOf((*MockDepartmentRepository)(nil).FindDeletedBefore), ctx, before, limit)
+}
+
 // FindWithConditions mocks base method.
 func (m *MockDepartmentRepository) FindWithConditions(ctx context.Context, conditions *department.DepartmentQueryConditions) ([]*models.Department, *models.PageResult, error) {
 	m.ctrl.T.Helper()
@@ -399,6 +415,21 @@ func (mr *MockDepartmentRepositoryMockRecorder) GetByName(ctx, name, organizatio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockDepartmentRepository)(nil).GetByName), ctx, name, organizationID)
 }
 
+// GetDeletedByID mocks base method.
+func (m *MockDepartmentRepository) GetDeletedByID(ctx context.Context, id string) (*models.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedByID", ctx, id)
+	ret0, _ := ret[0].(*models.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedByID indicates an expected call of GetDeletedByID.
+func (mr *MockDepartmentRepositoryMockRecorder) GetDeletedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedByID", reflect.TypeOf((*MockDepartmentRepository)(nil).GetDeletedByID), ctx, id)
+}
+
 // GetDepartmentEquipment mocks base method.
 func (m *MockDepartmentRepository) GetDepartmentEquipment(ctx context.Context, departmentID string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -488,6 +519,20 @@ func (mr *MockDepartmentRepositoryMockRecorder) HasMembers(ctx, departmentID any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasMembers", reflect.TypeOf((*MockDepartmentRepository)(nil).HasMembers), ctx, departmentID)
 }
 
+// PurgeDeletedByOrganization mocks base method.
+func (m *MockDepartmentRepository) PurgeDeletedByOrganization(ctx context.Context, organizationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedByOrganization", ctx, organizationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDeletedByOrganization indicates an expected call of PurgeDeletedByOrganization.
+func (mr *MockDepartmentRepositoryMockRecorder) PurgeDeletedByOrganization(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedByOrganization", reflect.TypeOf((*MockDepartmentRepository)(nil).PurgeDeletedByOrganization), ctx, organizationID)
+}
+
 // RemoveEquipment mocks base method.
 func (m *MockDepartmentRepository) RemoveEquipment(ctx context.Context, departmentID string, equipmentIDs []string) error {
 	m.ctrl.T.Helper()
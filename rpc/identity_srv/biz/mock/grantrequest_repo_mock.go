@@ -0,0 +1,395 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: biz/dal/grantrequest/role_grant_request_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=biz/dal/grantrequest/role_grant_request_interface.go -destination=biz/mock/grantrequest_repo_mock.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	base "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
+	grantrequest "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/grantrequest"
+	models "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockRoleGrantRequestRepository is a mock of RoleGrantRequestRepository interface.
+type MockRoleGrantRequestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleGrantRequestRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRoleGrantRequestRepositoryMockRecorder is the mock recorder for MockRoleGrantRequestRepository.
+type MockRoleGrantRequestRepositoryMockRecorder struct {
+	mock *MockRoleGrantRequestRepository
+}
+
+// NewMockRoleGrantRequestRepository creates a new mock instance.
+func NewMockRoleGrantRequestRepository(ctrl *gomock.Controller) *MockRoleGrantRequestRepository {
+	mock := &MockRoleGrantRequestRepository{ctrl: ctrl}
+	mock.recorder = &MockRoleGrantRequestRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleGrantRequestRepository) EXPECT() *MockRoleGrantRequestRepositoryMockRecorder {
+	return m.recorder
+}
+
+// BatchCreate mocks base method.
+func (m *MockRoleGrantRequestRepository) BatchCreate(ctx context.Context, entities []*models.RoleGrantRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchCreate", ctx, entities)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchCreate indicates an expected call of BatchCreate.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) BatchCreate(ctx, entities any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCreate", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).BatchCreate), ctx, entities)
+}
+
+// BatchDelete mocks base method.
+func (m *MockRoleGrantRequestRepository) BatchDelete(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchDelete", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchDelete indicates an expected call of BatchDelete.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) BatchDelete(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDelete", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).BatchDelete), ctx, ids)
+}
+
+// BatchGetByIDs mocks base method.
+func (m *MockRoleGrantRequestRepository) BatchGetByIDs(ctx context.Context, ids []string) ([]*models.RoleGrantRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchGetByIDs", ctx, ids)
+	ret0, _ := ret[0].([]*models.RoleGrantRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGetByIDs indicates an expected call of BatchGetByIDs.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) BatchGetByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGetByIDs", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).BatchGetByIDs), ctx, ids)
+}
+
+// BatchHardDelete mocks base method.
+func (m *MockRoleGrantRequestRepository) BatchHardDelete(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchHardDelete", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchHardDelete indicates an expected call of BatchHardDelete.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) BatchHardDelete(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchHardDelete", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).BatchHardDelete), ctx, ids)
+}
+
+// BatchRestore mocks base method.
+func (m *MockRoleGrantRequestRepository) BatchRestore(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchRestore", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchRestore indicates an expected call of BatchRestore.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) BatchRestore(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchRestore", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).BatchRestore), ctx, ids)
+}
+
+// BatchSoftDelete mocks base method.
+func (m *MockRoleGrantRequestRepository) BatchSoftDelete(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchSoftDelete", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchSoftDelete indicates an expected call of BatchSoftDelete.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) BatchSoftDelete(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchSoftDelete", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).BatchSoftDelete), ctx, ids)
+}
+
+// BatchUpdate mocks base method.
+func (m *MockRoleGrantRequestRepository) BatchUpdate(ctx context.Context, entities []*models.RoleGrantRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchUpdate", ctx, entities)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchUpdate indicates an expected call of BatchUpdate.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) BatchUpdate(ctx, entities any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpdate", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).BatchUpdate), ctx, entities)
+}
+
+// Count mocks base method.
+func (m *MockRoleGrantRequestRepository) Count(ctx context.Context, opts *base.QueryOptions) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, opts)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) Count(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).Count), ctx, opts)
+}
+
+// Create mocks base method.
+func (m *MockRoleGrantRequestRepository) Create(ctx context.Context, entity *models.RoleGrantRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) Create(ctx, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).Create), ctx, entity)
+}
+
+// Decide mocks base method.
+func (m *MockRoleGrantRequestRepository) Decide(ctx context.Context, id string, decision grantrequest.RoleGrantDecision) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decide", ctx, id, decision)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decide indicates an expected call of Decide.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) Decide(ctx, id, decision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decide", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).Decide), ctx, id, decision)
+}
+
+// Delete mocks base method.
+func (m *MockRoleGrantRequestRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).Delete), ctx, id)
+}
+
+// Exists mocks base method.
+func (m *MockRoleGrantRequestRepository) Exists(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) Exists(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).Exists), ctx, id)
+}
+
+// ExistsPending mocks base method.
+func (m *MockRoleGrantRequestRepository) ExistsPending(ctx context.Context, userID, roleID, organizationID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsPending", ctx, userID, roleID, organizationID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsPending indicates an expected call of ExistsPending.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) ExistsPending(ctx, userID, roleID, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsPending", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).ExistsPending), ctx, userID, roleID, organizationID)
+}
+
+// FindAll mocks base method.
+func (m *MockRoleGrantRequestRepository) FindAll(ctx context.Context, opts *base.QueryOptions) ([]*models.RoleGrantRequest, *models.PageResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, opts)
+	ret0, _ := ret[0].([]*models.RoleGrantRequest)
+	ret1, _ := ret[1].(*models.PageResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) FindAll(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).FindAll), ctx, opts)
+}
+
+// FindDeletedBefore mocks base method.
+func (m *MockRoleGrantRequestRepository) FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*models.RoleGrantRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedBefore", ctx, before, limit)
+	ret0, _ := ret[0].([]*models.RoleGrantRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedBefore indicates an expected call of FindDeletedBefore.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) FindDeletedBefore(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedBefore", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).FindDeletedBefore), ctx, before, limit)
+}
+
+// FindExpiredPending mocks base method.
+func (m *MockRoleGrantRequestRepository) FindExpiredPending(ctx context.Context, nowMillis int64, limit int) ([]*models.RoleGrantRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExpiredPending", ctx, nowMillis, limit)
+	ret0, _ := ret[0].([]*models.RoleGrantRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExpiredPending indicates an expected call of FindExpiredPending.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) FindExpiredPending(ctx, nowMillis, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExpiredPending", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).FindExpiredPending), ctx, nowMillis, limit)
+}
+
+// FindWithConditions mocks base method.
+func (m *MockRoleGrantRequestRepository) FindWithConditions(ctx context.Context, conditions *grantrequest.RoleGrantRequestQueryConditions) ([]*models.RoleGrantRequest, *models.PageResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWithConditions", ctx, conditions)
+	ret0, _ := ret[0].([]*models.RoleGrantRequest)
+	ret1, _ := ret[1].(*models.PageResult)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindWithConditions indicates an expected call of FindWithConditions.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) FindWithConditions(ctx, conditions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWithConditions", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).FindWithConditions), ctx, conditions)
+}
+
+// GetByID mocks base method.
+func (m *MockRoleGrantRequestRepository) GetByID(ctx context.Context, id string) (*models.RoleGrantRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*models.RoleGrantRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).GetByID), ctx, id)
+}
+
+// GetDeletedByID mocks base method.
+func (m *MockRoleGrantRequestRepository) GetDeletedByID(ctx context.Context, id string) (*models.RoleGrantRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedByID", ctx, id)
+	ret0, _ := ret[0].(*models.RoleGrantRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedByID indicates an expected call of GetDeletedByID.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) GetDeletedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedByID", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).GetDeletedByID), ctx, id)
+}
+
+// HardDelete mocks base method.
+func (m *MockRoleGrantRequestRepository) HardDelete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HardDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HardDelete indicates an expected call of HardDelete.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) HardDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HardDelete", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).HardDelete), ctx, id)
+}
+
+// Restore mocks base method.
+func (m *MockRoleGrantRequestRepository) Restore(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).Restore), ctx, id)
+}
+
+// SoftDelete mocks base method.
+func (m *MockRoleGrantRequestRepository) SoftDelete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDelete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDelete indicates an expected call of SoftDelete.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) SoftDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDelete", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).SoftDelete), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockRoleGrantRequestRepository) Update(ctx context.Context, entity *models.RoleGrantRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) Update(ctx, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).Update), ctx, entity)
+}
+
+// WithTx mocks base method.
+func (m *MockRoleGrantRequestRepository) WithTx(tx *gorm.DB) base.BaseRepository[models.RoleGrantRequest] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(base.BaseRepository[models.RoleGrantRequest])
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockRoleGrantRequestRepositoryMockRecorder) WithTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockRoleGrantRequestRepository)(nil).WithTx), tx)
+}
@@ -12,6 +12,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	base "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
@@ -276,6 +277,21 @@ func (mr *MockLogoRepositoryMockRecorder) FindAll(ctx, opts any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockLogoRepository)(nil).FindAll), ctx, opts)
 }
 
+// FindDeletedBefore mocks base method.
+func (m *MockLogoRepository) FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*models.OrganizationLogo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedBefore", ctx, before, limit)
+	ret0, _ := ret[0].([]*models.OrganizationLogo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedBefore indicates an expected call of FindDeletedBefore.
+func (mr *MockLogoRepositoryMockRecorder) FindDeletedBefore(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedBefore", reflect.TypeOf((*MockLogoRepository)(nil).FindDeletedBefore), ctx, before, limit)
+}
+
 // GetByFileID mocks base method.
 func (m *MockLogoRepository) GetByFileID(ctx context.Context, fileID string) (*models.OrganizationLogo, error) {
 	m.ctrl.T.Helper()
@@ -321,6 +337,21 @@ func (mr *MockLogoRepositoryMockRecorder) GetByOrganizationID(ctx, organizationI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOrganizationID", reflect.TypeOf((*MockLogoRepository)(nil).GetByOrganizationID), ctx, organizationID)
 }
 
+// GetDeletedByID mocks base method.
+func (m *MockLogoRepository) GetDeletedByID(ctx context.Context, id string) (*models.OrganizationLogo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedByID", ctx, id)
+	ret0, _ := ret[0].(*models.OrganizationLogo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedByID indicates an expected call of GetDeletedByID.
+func (mr *MockLogoRepositoryMockRecorder) GetDeletedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedByID", reflect.TypeOf((*MockLogoRepository)(nil).GetDeletedByID), ctx, id)
+}
+
 // HardDelete mocks base method.
 func (m *MockLogoRepository) HardDelete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
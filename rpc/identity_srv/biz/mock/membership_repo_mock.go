@@ -12,6 +12,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	base "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
 	membership "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/membership"
@@ -333,6 +334,21 @@ func (mr *MockUserMembershipRepositoryMockRecorder) FindAll(ctx, opts any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockUserMembershipRepository)(nil).FindAll), ctx, opts)
 }
 
+// FindDeletedBefore mocks base method.
+func (m *MockUserMembershipRepository) FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*models.UserMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedBefore", ctx, before, limit)
+	ret0, _ := ret[0].([]*models.UserMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedBefore indicates an expected call of FindDeletedBefore.
+func (mr *MockUserMembershipRepositoryMockRecorder) FindDeletedBefore(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedBefore", reflect.TypeOf((*MockUserMembershipRepository)(nil).FindDeletedBefore), ctx, before, limit)
+}
+
 // FindUsersByOrganizationHierarchy mocks base method.
 func (m *MockUserMembershipRepository) FindUsersByOrganizationHierarchy(ctx context.Context, rootOrganizationID string, opts *base.QueryOptions) ([]*models.UserMembership, *models.PageResult, error) {
 	m.ctrl.T.Helper()
@@ -365,6 +381,21 @@ func (mr *MockUserMembershipRepositoryMockRecorder) FindWithConditions(ctx, cond
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWithConditions", reflect.TypeOf((*MockUserMembershipRepository)(nil).FindWithConditions), ctx, conditions)
 }
 
+// GetActiveMembershipsByUserIDs mocks base method.
+func (m *MockUserMembershipRepository) GetActiveMembershipsByUserIDs(ctx context.Context, userIDs []string) (map[string][]*models.UserMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveMembershipsByUserIDs", ctx, userIDs)
+	ret0, _ := ret[0].(map[string][]*models.UserMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveMembershipsByUserIDs indicates an expected call of GetActiveMembershipsByUserIDs.
+func (mr *MockUserMembershipRepositoryMockRecorder) GetActiveMembershipsByUserIDs(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveMembershipsByUserIDs", reflect.TypeOf((*MockUserMembershipRepository)(nil).GetActiveMembershipsByUserIDs), ctx, userIDs)
+}
+
 // GetByID mocks base method.
 func (m *MockUserMembershipRepository) GetByID(ctx context.Context, id string) (*models.UserMembership, error) {
 	m.ctrl.T.Helper()
@@ -410,6 +441,21 @@ func (mr *MockUserMembershipRepositoryMockRecorder) GetByUserAndOrganization(ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserAndOrganization", reflect.TypeOf((*MockUserMembershipRepository)(nil).GetByUserAndOrganization), ctx, userID, organizationID)
 }
 
+// GetDeletedByID mocks base method.
+func (m *MockUserMembershipRepository) GetDeletedByID(ctx context.Context, id string) (*models.UserMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedByID", ctx, id)
+	ret0, _ := ret[0].(*models.UserMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedByID indicates an expected call of GetDeletedByID.
+func (mr *MockUserMembershipRepositoryMockRecorder) GetDeletedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedByID", reflect.TypeOf((*MockUserMembershipRepository)(nil).GetDeletedByID), ctx, id)
+}
+
 // GetMembershipStatistics mocks base method.
 func (m *MockUserMembershipRepository) GetMembershipStatistics(ctx context.Context, organizationID string) (*membership.MembershipStatistics, error) {
 	m.ctrl.T.Helper()
@@ -470,6 +516,21 @@ func (mr *MockUserMembershipRepositoryMockRecorder) GetPrimaryMembershipsByUserI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrimaryMembershipsByUserIDs", reflect.TypeOf((*MockUserMembershipRepository)(nil).GetPrimaryMembershipsByUserIDs), ctx, userIDs)
 }
 
+// GetUserDepartments mocks base method.
+func (m *MockUserMembershipRepository) GetUserDepartments(ctx context.Context, userID, organizationID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserDepartments", ctx, userID, organizationID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserDepartments indicates an expected call of GetUserDepartments.
+func (mr *MockUserMembershipRepositoryMockRecorder) GetUserDepartments(ctx, userID, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDepartments", reflect.TypeOf((*MockUserMembershipRepository)(nil).GetUserDepartments), ctx, userID, organizationID)
+}
+
 // GetUserOrganizations mocks base method.
 func (m *MockUserMembershipRepository) GetUserOrganizations(ctx context.Context, userID string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -499,6 +560,48 @@ func (mr *MockUserMembershipRepositoryMockRecorder) HardDelete(ctx, id any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HardDelete", reflect.TypeOf((*MockUserMembershipRepository)(nil).HardDelete), ctx, id)
 }
 
+// PurgeByUser mocks base method.
+func (m *MockUserMembershipRepository) PurgeByUser(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeByUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeByUser indicates an expected call of PurgeByUser.
+func (mr *MockUserMembershipRepositoryMockRecorder) PurgeByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeByUser", reflect.TypeOf((*MockUserMembershipRepository)(nil).PurgeByUser), ctx, userID)
+}
+
+// PurgeDeletedByDepartment mocks base method.
+func (m *MockUserMembershipRepository) PurgeDeletedByDepartment(ctx context.Context, departmentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedByDepartment", ctx, departmentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDeletedByDepartment indicates an expected call of PurgeDeletedByDepartment.
+func (mr *MockUserMembershipRepositoryMockRecorder) PurgeDeletedByDepartment(ctx, departmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedByDepartment", reflect.TypeOf((*MockUserMembershipRepository)(nil).PurgeDeletedByDepartment), ctx, departmentID)
+}
+
+// PurgeDeletedByOrganization mocks base method.
+func (m *MockUserMembershipRepository) PurgeDeletedByOrganization(ctx context.Context, organizationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedByOrganization", ctx, organizationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDeletedByOrganization indicates an expected call of PurgeDeletedByOrganization.
+func (mr *MockUserMembershipRepositoryMockRecorder) PurgeDeletedByOrganization(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedByOrganization", reflect.TypeOf((*MockUserMembershipRepository)(nil).PurgeDeletedByOrganization), ctx, organizationID)
+}
+
 // Restore mocks base method.
 func (m *MockUserMembershipRepository) Restore(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -513,6 +616,21 @@ func (mr *MockUserMembershipRepositoryMockRecorder) Restore(ctx, id any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockUserMembershipRepository)(nil).Restore), ctx, id)
 }
 
+// RestoreByUser mocks base method.
+func (m *MockUserMembershipRepository) RestoreByUser(ctx context.Context, userID string, deletedAt time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreByUser", ctx, userID, deletedAt)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreByUser indicates an expected call of RestoreByUser.
+func (mr *MockUserMembershipRepositoryMockRecorder) RestoreByUser(ctx, userID, deletedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreByUser", reflect.TypeOf((*MockUserMembershipRepository)(nil).RestoreByUser), ctx, userID, deletedAt)
+}
+
 // SetPrimaryMembership mocks base method.
 func (m *MockUserMembershipRepository) SetPrimaryMembership(ctx context.Context, userID, membershipID string) error {
 	m.ctrl.T.Helper()
@@ -12,6 +12,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	base "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
@@ -231,6 +232,21 @@ func (mr *MockMenuRepositoryMockRecorder) FindAll(ctx, opts any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockMenuRepository)(nil).FindAll), ctx, opts)
 }
 
+// FindDeletedBefore mocks base method.
+func (m *MockMenuRepository) FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]**models.Menu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedBefore", ctx, before, limit)
+	ret0, _ := ret[0].([]**models.Menu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedBefore indicates an expected call of FindDeletedBefore.
+func (mr *MockMenuRepositoryMockRecorder) FindDeletedBefore(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedBefore", reflect.TypeOf((*MockMenuRepository)(nil).FindDeletedBefore), ctx, before, limit)
+}
+
 // GetByID mocks base method.
 func (m *MockMenuRepository) GetByID(ctx context.Context, id string) (**models.Menu, error) {
 	m.ctrl.T.Helper()
@@ -276,6 +292,21 @@ func (mr *MockMenuRepositoryMockRecorder) GetBySemanticIDs(ctx, productLine, sem
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySemanticIDs", reflect.TypeOf((*MockMenuRepository)(nil).GetBySemanticIDs), ctx, productLine, semanticIDs)
 }
 
+// GetDeletedByID mocks base method.
+func (m *MockMenuRepository) GetDeletedByID(ctx context.Context, id string) (**models.Menu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedByID", ctx, id)
+	ret0, _ := ret[0].(**models.Menu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedByID indicates an expected call of GetDeletedByID.
+func (mr *MockMenuRepositoryMockRecorder) GetDeletedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedByID", reflect.TypeOf((*MockMenuRepository)(nil).GetDeletedByID), ctx, id)
+}
+
 // GetLatestContentHash mocks base method.
 func (m *MockMenuRepository) GetLatestContentHash(ctx context.Context, productLine string) (string, error) {
 	m.ctrl.T.Helper()
@@ -336,6 +367,21 @@ func (mr *MockMenuRepositoryMockRecorder) GetMaxVersion(ctx, productLine any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxVersion", reflect.TypeOf((*MockMenuRepository)(nil).GetMaxVersion), ctx, productLine)
 }
 
+// GetMenusByVersion mocks base method.
+func (m *MockMenuRepository) GetMenusByVersion(ctx context.Context, productLine string, version int) ([]*models.Menu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMenusByVersion", ctx, productLine, version)
+	ret0, _ := ret[0].([]*models.Menu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMenusByVersion indicates an expected call of GetMenusByVersion.
+func (mr *MockMenuRepositoryMockRecorder) GetMenusByVersion(ctx, productLine, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMenusByVersion", reflect.TypeOf((*MockMenuRepository)(nil).GetMenusByVersion), ctx, productLine, version)
+}
+
 // HardDelete mocks base method.
 func (m *MockMenuRepository) HardDelete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -350,6 +396,21 @@ func (mr *MockMenuRepositoryMockRecorder) HardDelete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HardDelete", reflect.TypeOf((*MockMenuRepository)(nil).HardDelete), ctx, id)
 }
 
+// ListVersions mocks base method.
+func (m *MockMenuRepository) ListVersions(ctx context.Context, productLine string) ([]*models.MenuVersionSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVersions", ctx, productLine)
+	ret0, _ := ret[0].([]*models.MenuVersionSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersions indicates an expected call of ListVersions.
+func (mr *MockMenuRepositoryMockRecorder) ListVersions(ctx, productLine any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockMenuRepository)(nil).ListVersions), ctx, productLine)
+}
+
 // Restore mocks base method.
 func (m *MockMenuRepository) Restore(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -12,6 +12,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	base "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
 	organization "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/organization"
@@ -267,6 +268,21 @@ func (mr *MockOrganizationRepositoryMockRecorder) FindAll(ctx, opts any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockOrganizationRepository)(nil).FindAll), ctx, opts)
 }
 
+// FindDeletedBefore mocks base method.
+func (m *MockOrganizationRepository) FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*models.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedBefore", ctx, before, limit)
+	ret0, _ := ret[0].([]*models.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedBefore indicates an expected call of FindDeletedBefore.
+func (mr *MockOrganizationRepositoryMockRecorder) FindDeletedBefore(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedBefore", reflect.TypeOf((*MockOrganizationRepository)(nil).FindDeletedBefore), ctx, before, limit)
+}
+
 // FindWithConditions mocks base method.
 func (m *MockOrganizationRepository) FindWithConditions(ctx context.Context, conditions *organization.OrganizationQueryConditions) ([]*models.Organization, *models.PageResult, error) {
 	m.ctrl.T.Helper()
@@ -313,6 +329,21 @@ func (mr *MockOrganizationRepositoryMockRecorder) GetByID(ctx, id any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockOrganizationRepository)(nil).GetByID), ctx, id)
 }
 
+// GetDeletedByID mocks base method.
+func (m *MockOrganizationRepository) GetDeletedByID(ctx context.Context, id string) (*models.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedByID", ctx, id)
+	ret0, _ := ret[0].(*models.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedByID indicates an expected call of GetDeletedByID.
+func (mr *MockOrganizationRepositoryMockRecorder) GetDeletedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedByID", reflect.TypeOf((*MockOrganizationRepository)(nil).GetDeletedByID), ctx, id)
+}
+
 // HardDelete mocks base method.
 func (m *MockOrganizationRepository) HardDelete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: biz/dal/passwordpolicy/password_policy_interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source=biz/dal/passwordpolicy/password_policy_interface.go -destination=biz/mock/passwordpolicy_repo_mock.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPasswordPolicyRepository is a mock of PasswordPolicyRepository interface.
+type MockPasswordPolicyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordPolicyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPasswordPolicyRepositoryMockRecorder is the mock recorder for MockPasswordPolicyRepository.
+type MockPasswordPolicyRepositoryMockRecorder struct {
+	mock *MockPasswordPolicyRepository
+}
+
+// NewMockPasswordPolicyRepository creates a new mock instance.
+func NewMockPasswordPolicyRepository(ctrl *gomock.Controller) *MockPasswordPolicyRepository {
+	mock := &MockPasswordPolicyRepository{ctrl: ctrl}
+	mock.recorder = &MockPasswordPolicyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordPolicyRepository) EXPECT() *MockPasswordPolicyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByOrganizationID mocks base method.
+func (m *MockPasswordPolicyRepository) DeleteByOrganizationID(ctx context.Context, organizationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByOrganizationID", ctx, organizationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByOrganizationID indicates an expected call of DeleteByOrganizationID.
+func (mr *MockPasswordPolicyRepositoryMockRecorder) DeleteByOrganizationID(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByOrganizationID", reflect.TypeOf((*MockPasswordPolicyRepository)(nil).DeleteByOrganizationID), ctx, organizationID)
+}
+
+// GetByOrganizationID mocks base method.
+func (m *MockPasswordPolicyRepository) GetByOrganizationID(ctx context.Context, organizationID string) (*models.OrganizationPasswordPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByOrganizationID", ctx, organizationID)
+	ret0, _ := ret[0].(*models.OrganizationPasswordPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByOrganizationID indicates an expected call of GetByOrganizationID.
+func (mr *MockPasswordPolicyRepositoryMockRecorder) GetByOrganizationID(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOrganizationID", reflect.TypeOf((*MockPasswordPolicyRepository)(nil).GetByOrganizationID), ctx, organizationID)
+}
+
+// Save mocks base method.
+func (m *MockPasswordPolicyRepository) Save(ctx context.Context, policy *models.OrganizationPasswordPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockPasswordPolicyRepositoryMockRecorder) Save(ctx, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockPasswordPolicyRepository)(nil).Save), ctx, policy)
+}
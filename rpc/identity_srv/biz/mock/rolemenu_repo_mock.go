@@ -113,6 +113,21 @@ func (mr *MockRoleMenuPermissionRepositoryMockRecorder) GetByID(ctx, id any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRoleMenuPermissionRepository)(nil).GetByID), ctx, id)
 }
 
+// GetByMenuIDs mocks base method.
+func (m *MockRoleMenuPermissionRepository) GetByMenuIDs(ctx context.Context, productLine string, menuIDs []string) ([]*models.RoleMenuPermission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByMenuIDs", ctx, productLine, menuIDs)
+	ret0, _ := ret[0].([]*models.RoleMenuPermission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByMenuIDs indicates an expected call of GetByMenuIDs.
+func (mr *MockRoleMenuPermissionRepositoryMockRecorder) GetByMenuIDs(ctx, productLine, menuIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByMenuIDs", reflect.TypeOf((*MockRoleMenuPermissionRepository)(nil).GetByMenuIDs), ctx, productLine, menuIDs)
+}
+
 // GetByRoleAndMenu mocks base method.
 func (m *MockRoleMenuPermissionRepository) GetByRoleAndMenu(ctx context.Context, roleID uuid.UUID, menuID string) (*models.RoleMenuPermission, error) {
 	m.ctrl.T.Helper()
@@ -128,6 +143,21 @@ func (mr *MockRoleMenuPermissionRepositoryMockRecorder) GetByRoleAndMenu(ctx, ro
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByRoleAndMenu", reflect.TypeOf((*MockRoleMenuPermissionRepository)(nil).GetByRoleAndMenu), ctx, roleID, menuID)
 }
 
+// GetByRoleAndProductLine mocks base method.
+func (m *MockRoleMenuPermissionRepository) GetByRoleAndProductLine(ctx context.Context, roleID uuid.UUID, productLine string) ([]*models.RoleMenuPermission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByRoleAndProductLine", ctx, roleID, productLine)
+	ret0, _ := ret[0].([]*models.RoleMenuPermission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByRoleAndProductLine indicates an expected call of GetByRoleAndProductLine.
+func (mr *MockRoleMenuPermissionRepositoryMockRecorder) GetByRoleAndProductLine(ctx, roleID, productLine any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByRoleAndProductLine", reflect.TypeOf((*MockRoleMenuPermissionRepository)(nil).GetByRoleAndProductLine), ctx, roleID, productLine)
+}
+
 // GetByRoleID mocks base method.
 func (m *MockRoleMenuPermissionRepository) GetByRoleID(ctx context.Context, roleID uuid.UUID) ([]*models.RoleMenuPermission, error) {
 	m.ctrl.T.Helper()
@@ -158,6 +188,21 @@ func (mr *MockRoleMenuPermissionRepositoryMockRecorder) GetByRoleIDs(ctx, roleID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByRoleIDs", reflect.TypeOf((*MockRoleMenuPermissionRepository)(nil).GetByRoleIDs), ctx, roleIDs)
 }
 
+// GetByRoleIDsAndProductLine mocks base method.
+func (m *MockRoleMenuPermissionRepository) GetByRoleIDsAndProductLine(ctx context.Context, roleIDs []uuid.UUID, productLine string) ([]*models.RoleMenuPermission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByRoleIDsAndProductLine", ctx, roleIDs, productLine)
+	ret0, _ := ret[0].([]*models.RoleMenuPermission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByRoleIDsAndProductLine indicates an expected call of GetByRoleIDsAndProductLine.
+func (mr *MockRoleMenuPermissionRepositoryMockRecorder) GetByRoleIDsAndProductLine(ctx, roleIDs, productLine any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByRoleIDsAndProductLine", reflect.TypeOf((*MockRoleMenuPermissionRepository)(nil).GetByRoleIDsAndProductLine), ctx, roleIDs, productLine)
+}
+
 // GetMenuIDsByRoleID mocks base method.
 func (m *MockRoleMenuPermissionRepository) GetMenuIDsByRoleID(ctx context.Context, roleID uuid.UUID) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -189,47 +234,47 @@ func (mr *MockRoleMenuPermissionRepositoryMockRecorder) GetMenuIDsByRoleIDs(ctx,
 }
 
 // GetMergedPermissions mocks base method.
-func (m *MockRoleMenuPermissionRepository) GetMergedPermissions(ctx context.Context, roleIDs []uuid.UUID) ([]models.MenuPermissionInfo, error) {
+func (m *MockRoleMenuPermissionRepository) GetMergedPermissions(ctx context.Context, roleIDs []uuid.UUID, productLine string) ([]models.MenuPermissionInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMergedPermissions", ctx, roleIDs)
+	ret := m.ctrl.Call(m, "GetMergedPermissions", ctx, roleIDs, productLine)
 	ret0, _ := ret[0].([]models.MenuPermissionInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMergedPermissions indicates an expected call of GetMergedPermissions.
-func (mr *MockRoleMenuPermissionRepositoryMockRecorder) GetMergedPermissions(ctx, roleIDs any) *gomock.Call {
+func (mr *MockRoleMenuPermissionRepositoryMockRecorder) GetMergedPermissions(ctx, roleIDs, productLine any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMergedPermissions", reflect.TypeOf((*MockRoleMenuPermissionRepository)(nil).GetMergedPermissions), ctx, roleIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMergedPermissions", reflect.TypeOf((*MockRoleMenuPermissionRepository)(nil).GetMergedPermissions), ctx, roleIDs, productLine)
 }
 
 // HasPermission mocks base method.
-func (m *MockRoleMenuPermissionRepository) HasPermission(ctx context.Context, roleID uuid.UUID, menuID string, permissionType models.MenuPermissionType) (bool, error) {
+func (m *MockRoleMenuPermissionRepository) HasPermission(ctx context.Context, roleID uuid.UUID, productLine, menuID string, permissionType models.MenuPermissionType) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HasPermission", ctx, roleID, menuID, permissionType)
+	ret := m.ctrl.Call(m, "HasPermission", ctx, roleID, productLine, menuID, permissionType)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // HasPermission indicates an expected call of HasPermission.
-func (mr *MockRoleMenuPermissionRepositoryMockRecorder) HasPermission(ctx, roleID, menuID, permissionType any) *gomock.Call {
+func (mr *MockRoleMenuPermissionRepositoryMockRecorder) HasPermission(ctx, roleID, productLine, menuID, permissionType any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPermission", reflect.TypeOf((*MockRoleMenuPermissionRepository)(nil).HasPermission), ctx, roleID, menuID, permissionType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPermission", reflect.TypeOf((*MockRoleMenuPermissionRepository)(nil).HasPermission), ctx, roleID, productLine, menuID, permissionType)
 }
 
 // SyncRoleMenus mocks base method.
-func (m *MockRoleMenuPermissionRepository) SyncRoleMenus(ctx context.Context, roleID uuid.UUID, permissions []*models.RoleMenuPermission) error {
+func (m *MockRoleMenuPermissionRepository) SyncRoleMenus(ctx context.Context, roleID uuid.UUID, productLine string, permissions []*models.RoleMenuPermission) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SyncRoleMenus", ctx, roleID, permissions)
+	ret := m.ctrl.Call(m, "SyncRoleMenus", ctx, roleID, productLine, permissions)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SyncRoleMenus indicates an expected call of SyncRoleMenus.
-func (mr *MockRoleMenuPermissionRepositoryMockRecorder) SyncRoleMenus(ctx, roleID, permissions any) *gomock.Call {
+func (mr *MockRoleMenuPermissionRepositoryMockRecorder) SyncRoleMenus(ctx, roleID, productLine, permissions any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncRoleMenus", reflect.TypeOf((*MockRoleMenuPermissionRepository)(nil).SyncRoleMenus), ctx, roleID, permissions)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncRoleMenus", reflect.TypeOf((*MockRoleMenuPermissionRepository)(nil).SyncRoleMenus), ctx, roleID, productLine, permissions)
 }
 
 // Update mocks base method.
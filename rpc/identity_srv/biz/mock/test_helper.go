@@ -22,9 +22,17 @@ type TestMocks struct {
 	MembershipRepo *MockUserMembershipRepository
 	DefinitionRepo *MockRoleDefinitionRepository
 	AssignmentRepo *MockUserRoleAssignmentRepository
+	GrantRepo      *MockRoleGrantRequestRepository
+	CampaignRepo   *MockAccessReviewCampaignRepository
+	ReviewItemRepo *MockAccessReviewItemRepository
 	MenuRepo       *MockMenuRepository
 	RoleMenuRepo   *MockRoleMenuPermissionRepository
 	LogoRepo       *MockLogoRepository
+	MFARepo        *MockMFARepository
+	ResetTokenRepo *MockPasswordResetTokenRepository
+	HistoryRepo    *MockPasswordHistoryRepository
+	PolicyRepo     *MockPasswordPolicyRepository
+	AuditLogRepo   *MockAuditLogRepository
 }
 
 // NewTestMocks 创建完整的测试 Mock 环境
@@ -46,9 +54,17 @@ func NewTestMocks(ctrl *gomock.Controller) *TestMocks {
 		MembershipRepo: NewMockUserMembershipRepository(ctrl),
 		DefinitionRepo: NewMockRoleDefinitionRepository(ctrl),
 		AssignmentRepo: NewMockUserRoleAssignmentRepository(ctrl),
+		GrantRepo:      NewMockRoleGrantRequestRepository(ctrl),
+		CampaignRepo:   NewMockAccessReviewCampaignRepository(ctrl),
+		ReviewItemRepo: NewMockAccessReviewItemRepository(ctrl),
 		MenuRepo:       NewMockMenuRepository(ctrl),
 		RoleMenuRepo:   NewMockRoleMenuPermissionRepository(ctrl),
 		LogoRepo:       NewMockLogoRepository(ctrl),
+		MFARepo:        NewMockMFARepository(ctrl),
+		ResetTokenRepo: NewMockPasswordResetTokenRepository(ctrl),
+		HistoryRepo:    NewMockPasswordHistoryRepository(ctrl),
+		PolicyRepo:     NewMockPasswordPolicyRepository(ctrl),
+		AuditLogRepo:   NewMockAuditLogRepository(ctrl),
 	}
 
 	// 配置 DAL 子仓储访问方法（AnyTimes 避免测试中每次都需要 EXPECT）
@@ -58,9 +74,17 @@ func NewTestMocks(ctrl *gomock.Controller) *TestMocks {
 	m.DAL.EXPECT().UserMembership().Return(m.MembershipRepo).AnyTimes()
 	m.DAL.EXPECT().RoleDefinition().Return(m.DefinitionRepo).AnyTimes()
 	m.DAL.EXPECT().UserRoleAssignment().Return(m.AssignmentRepo).AnyTimes()
+	m.DAL.EXPECT().RoleGrantRequest().Return(m.GrantRepo).AnyTimes()
+	m.DAL.EXPECT().AccessReviewCampaign().Return(m.CampaignRepo).AnyTimes()
+	m.DAL.EXPECT().AccessReviewItem().Return(m.ReviewItemRepo).AnyTimes()
 	m.DAL.EXPECT().Menu().Return(m.MenuRepo).AnyTimes()
 	m.DAL.EXPECT().RoleMenuPermission().Return(m.RoleMenuRepo).AnyTimes()
 	m.DAL.EXPECT().Logo().Return(m.LogoRepo).AnyTimes()
+	m.DAL.EXPECT().MFA().Return(m.MFARepo).AnyTimes()
+	m.DAL.EXPECT().PasswordResetToken().Return(m.ResetTokenRepo).AnyTimes()
+	m.DAL.EXPECT().PasswordHistory().Return(m.HistoryRepo).AnyTimes()
+	m.DAL.EXPECT().PasswordPolicy().Return(m.PolicyRepo).AnyTimes()
+	m.DAL.EXPECT().AuditLog().Return(m.AuditLogRepo).AnyTimes()
 
 	// 配置 WithTransaction：直接执行回调函数，使用同一个 MockDAL
 	m.DAL.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
@@ -68,5 +92,8 @@ func NewTestMocks(ctrl *gomock.Controller) *TestMocks {
 			return fn(ctx, m.DAL)
 		}).AnyTimes()
 
+	// 配置 WithNow：固定时间戳的 DAL 仍使用同一个 MockDAL
+	m.DAL.EXPECT().WithNow(gomock.Any()).Return(m.DAL).AnyTimes()
+
 	return m
 }
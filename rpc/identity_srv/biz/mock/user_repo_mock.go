@@ -12,6 +12,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	base "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/base"
 	user "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal/user"
@@ -292,35 +293,19 @@ func (mr *MockUserProfileRepositoryMockRecorder) FindAll(ctx, opts any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockUserProfileRepository)(nil).FindAll), ctx, opts)
 }
 
-// FindByMedicalLicense mocks base method.
-func (m *MockUserProfileRepository) FindByMedicalLicense(ctx context.Context, licenseNumber string) (*models.UserProfile, error) {
+// FindDeletedBefore mocks base method.
+func (m *MockUserProfileRepository) FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]*models.UserProfile, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByMedicalLicense", ctx, licenseNumber)
-	ret0, _ := ret[0].(*models.UserProfile)
+	ret := m.ctrl.Call(m, "FindDeletedBefore", ctx, before, limit)
+	ret0, _ := ret[0].([]*models.UserProfile)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindByMedicalLicense indicates an expected call of FindByMedicalLicense.
-func (mr *MockUserProfileRepositoryMockRecorder) FindByMedicalLicense(ctx, licenseNumber any) *gomock.Call {
+// FindDeletedBefore indicates an expected call of FindDeletedBefore.
+func (mr *MockUserProfileRepositoryMockRecorder) FindDeletedBefore(ctx, before, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMedicalLicense", reflect.TypeOf((*MockUserProfileRepository)(nil).FindByMedicalLicense), ctx, licenseNumber)
-}
-
-// FindBySpecialty mocks base method.
-func (m *MockUserProfileRepository) FindBySpecialty(ctx context.Context, specialty string, opts *base.QueryOptions) ([]*models.UserProfile, *models.PageResult, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindBySpecialty", ctx, specialty, opts)
-	ret0, _ := ret[0].([]*models.UserProfile)
-	ret1, _ := ret[1].(*models.PageResult)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
-}
-
-// FindBySpecialty indicates an expected call of FindBySpecialty.
-func (mr *MockUserProfileRepositoryMockRecorder) FindBySpecialty(ctx, specialty, opts any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySpecialty", reflect.TypeOf((*MockUserProfileRepository)(nil).FindBySpecialty), ctx, specialty, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedBefore", reflect.TypeOf((*MockUserProfileRepository)(nil).FindDeletedBefore), ctx, before, limit)
 }
 
 // FindSystemUsers mocks base method.
@@ -414,6 +399,21 @@ func (mr *MockUserProfileRepositoryMockRecorder) GetByUsername(ctx, username any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUsername", reflect.TypeOf((*MockUserProfileRepository)(nil).GetByUsername), ctx, username)
 }
 
+// GetDeletedByID mocks base method.
+func (m *MockUserProfileRepository) GetDeletedByID(ctx context.Context, id string) (*models.UserProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedByID", ctx, id)
+	ret0, _ := ret[0].(*models.UserProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedByID indicates an expected call of GetDeletedByID.
+func (mr *MockUserProfileRepositoryMockRecorder) GetDeletedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedByID", reflect.TypeOf((*MockUserProfileRepository)(nil).GetDeletedByID), ctx, id)
+}
+
 // HardDelete mocks base method.
 func (m *MockUserProfileRepository) HardDelete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,64 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// fileRecord file 渠道的单行输出格式
+type fileRecord struct {
+	Time    int64  `json:"time"`
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// fileNotifier 把通知逐行追加到本地文件（JSON Lines），便于本地联调时读取链接
+type fileNotifier struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileNotifier 创建文件通知发送器
+func NewFileNotifier(path string) Notifier {
+	return &fileNotifier{path: path}
+}
+
+// Send 追加一行 JSON 记录，文件与目录不存在时自动创建
+func (n *fileNotifier) Send(_ context.Context, msg *Message) error {
+	line, err := json.Marshal(fileRecord{
+		Time:    time.Now().UnixMilli(),
+		To:      msg.To,
+		Subject: msg.Subject,
+		Body:    msg.Body,
+	})
+	if err != nil {
+		return fmt.Errorf("序列化通知失败: %w", err)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if dir := filepath.Dir(n.path); dir != "" {
+		if err := os.MkdirAll(dir, 0o750); err != nil {
+			return fmt.Errorf("创建通知目录失败: %w", err)
+		}
+	}
+
+	f, err := os.OpenFile(n.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("打开通知文件失败: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("写入通知文件失败: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package notifier
+
+import (
+	"context"
+
+	tracelog "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/log"
+)
+
+// logNotifier 把通知写入服务日志而不实际发送，仅用于本地开发
+type logNotifier struct{}
+
+// NewLogNotifier 创建日志通知发送器
+func NewLogNotifier() Notifier {
+	return &logNotifier{}
+}
+
+// Send 以 info 级别记录通知全文
+func (n *logNotifier) Send(ctx context.Context, msg *Message) error {
+	tracelog.Ctx(ctx).Info().
+		Str("to", msg.To).
+		Str("subject", msg.Subject).
+		Str("body", msg.Body).
+		Msg("通知已写入日志（log 渠道不实际发送）")
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/config"
+)
+
+// 通知渠道类型
+const (
+	TypeLog  = "log"
+	TypeFile = "file"
+	TypeSMTP = "smtp"
+)
+
+// Message 一条待发送的通知
+type Message struct {
+	To      string // 收件地址（邮箱）
+	Subject string
+	Body    string // 纯文本正文
+}
+
+// Notifier 通知发送接口
+// 业务逻辑只依赖该接口，具体渠道由 NOTIFIER_TYPE 配置选择
+type Notifier interface {
+	// Send 发送一条通知，返回错误表示未能送达
+	Send(ctx context.Context, msg *Message) error
+}
+
+// NewNotifier 按配置创建通知发送器
+func NewNotifier(cfg *config.NotifierConfig) (Notifier, error) {
+	switch strings.ToLower(strings.TrimSpace(cfg.Type)) {
+	case "", TypeLog:
+		return NewLogNotifier(), nil
+	case TypeFile:
+		if cfg.FilePath == "" {
+			return nil, fmt.Errorf("file 通知渠道缺少输出文件路径（NOTIFIER_FILE_PATH）")
+		}
+
+		return NewFileNotifier(cfg.FilePath), nil
+	case TypeSMTP:
+		if cfg.SMTP.Host == "" || cfg.SMTP.From == "" {
+			return nil, fmt.Errorf("smtp 通知渠道缺少 SMTP_HOST 或 SMTP_FROM")
+		}
+
+		return NewSMTPNotifier(&cfg.SMTP), nil
+	default:
+		return nil, fmt.Errorf("不支持的通知渠道: %s", cfg.Type)
+	}
+}
@@ -0,0 +1,99 @@
+package notifier
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/config"
+)
+
+func TestNewNotifier(t *testing.T) {
+	t.Run("默认使用 log 渠道", func(t *testing.T) {
+		n, err := NewNotifier(&config.NotifierConfig{})
+		require.NoError(t, err)
+		assert.IsType(t, &logNotifier{}, n)
+	})
+
+	t.Run("file 渠道缺少路径时报错", func(t *testing.T) {
+		_, err := NewNotifier(&config.NotifierConfig{Type: TypeFile})
+		assert.Error(t, err)
+	})
+
+	t.Run("smtp 渠道缺少发件人时报错", func(t *testing.T) {
+		_, err := NewNotifier(&config.NotifierConfig{
+			Type: TypeSMTP,
+			SMTP: config.SMTPConfig{Host: "smtp.example.com"},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("不支持的渠道", func(t *testing.T) {
+		_, err := NewNotifier(&config.NotifierConfig{Type: "sms"})
+		assert.Error(t, err)
+	})
+}
+
+func TestFileNotifier_Send(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out", "notifications.log")
+	n := NewFileNotifier(path)
+
+	require.NoError(t, n.Send(context.Background(), &Message{To: "a@example.com", Subject: "s1", Body: "b1"}))
+	require.NoError(t, n.Send(context.Background(), &Message{To: "b@example.com", Subject: "s2", Body: "b2"}))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+
+	var record fileRecord
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &record))
+	assert.Equal(t, "b@example.com", record.To)
+	assert.Equal(t, "s2", record.Subject)
+	assert.Equal(t, "b2", record.Body)
+}
+
+func TestBuildMIMEMessage(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("编码主题与正文", func(t *testing.T) {
+		body := strings.Repeat("重置密码链接 ", 20)
+		raw, err := buildMIMEMessage("noreply@example.com", &Message{
+			To:      "user@example.com",
+			Subject: "重置密码",
+			Body:    body,
+		}, now)
+		require.NoError(t, err)
+
+		header, encoded, found := strings.Cut(string(raw), "\r\n\r\n")
+		require.True(t, found)
+		assert.Contains(t, header, "To: user@example.com")
+		assert.Contains(t, header, "Subject: =?UTF-8?b?")
+		assert.Contains(t, header, "Content-Transfer-Encoding: base64")
+
+		for _, line := range strings.Split(strings.TrimSpace(encoded), "\r\n") {
+			assert.LessOrEqual(t, len(line), 76)
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(strings.TrimSpace(encoded), "\r\n", ""))
+		require.NoError(t, err)
+		assert.Equal(t, body, string(decoded))
+	})
+
+	t.Run("拒绝头部换行注入", func(t *testing.T) {
+		_, err := buildMIMEMessage("noreply@example.com", &Message{
+			To:      "user@example.com\r\nBcc: evil@example.com",
+			Subject: "s",
+		}, now)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,150 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/config"
+)
+
+// defaultSMTPTimeout 未配置超时时间时的默认值
+const defaultSMTPTimeout = 10 * time.Second
+
+// smtpNotifier 通过 SMTP 发送纯文本邮件
+type smtpNotifier struct {
+	cfg     config.SMTPConfig
+	timeout time.Duration
+}
+
+// NewSMTPNotifier 创建 SMTP 通知发送器
+func NewSMTPNotifier(cfg *config.SMTPConfig) Notifier {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultSMTPTimeout
+	}
+
+	return &smtpNotifier{cfg: *cfg, timeout: timeout}
+}
+
+// Send 建立连接（隐式 TLS 或按需 STARTTLS）、认证并投递一封邮件
+func (n *smtpNotifier) Send(ctx context.Context, msg *Message) error {
+	body, err := buildMIMEMessage(n.cfg.From, msg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, n.timeout)
+	defer cancel()
+
+	conn, err := n.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("连接SMTP服务器失败: %w", err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, n.cfg.Host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("建立SMTP会话失败: %w", err)
+	}
+	defer client.Close()
+
+	if !n.cfg.UseTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(&tls.Config{ServerName: n.cfg.Host}); err != nil {
+				return fmt.Errorf("STARTTLS 失败: %w", err)
+			}
+		}
+	}
+
+	if n.cfg.Username != "" {
+		auth := smtp.PlainAuth("", n.cfg.Username, n.cfg.Password, n.cfg.Host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("SMTP认证失败: %w", err)
+		}
+	}
+
+	if err := client.Mail(n.cfg.From); err != nil {
+		return fmt.Errorf("设置发件人失败: %w", err)
+	}
+
+	if err := client.Rcpt(msg.To); err != nil {
+		return fmt.Errorf("设置收件人失败: %w", err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("发送邮件内容失败: %w", err)
+	}
+
+	if _, err := w.Write(body); err != nil {
+		_ = w.Close()
+		return fmt.Errorf("发送邮件内容失败: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("发送邮件内容失败: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// dial 按配置建立明文或隐式 TLS 连接
+func (n *smtpNotifier) dial(ctx context.Context) (net.Conn, error) {
+	addr := net.JoinHostPort(n.cfg.Host, strconv.Itoa(n.cfg.Port))
+	dialer := &net.Dialer{Timeout: n.timeout}
+
+	if n.cfg.UseTLS {
+		tlsDialer := &tls.Dialer{
+			NetDialer: dialer,
+			Config:    &tls.Config{ServerName: n.cfg.Host},
+		}
+
+		return tlsDialer.DialContext(ctx, "tcp", addr)
+	}
+
+	return dialer.DialContext(ctx, "tcp", addr)
+}
+
+// buildMIMEMessage 构建 UTF-8 纯文本邮件，主题按 RFC 2047 编码，正文 base64 编码
+// 头部字段中出现换行时拒绝发送，防止邮件头注入
+func buildMIMEMessage(from string, msg *Message, now time.Time) ([]byte, error) {
+	for _, v := range []string{from, msg.To, msg.Subject} {
+		if strings.ContainsAny(v, "\r\n") {
+			return nil, fmt.Errorf("邮件头部包含非法换行符")
+		}
+	}
+
+	var buf bytes.Buffer
+
+	buf.WriteString("From: " + from + "\r\n")
+	buf.WriteString("To: " + msg.To + "\r\n")
+	buf.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", msg.Subject) + "\r\n")
+	buf.WriteString("Date: " + now.Format(time.RFC1123Z) + "\r\n")
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: base64\r\n")
+	buf.WriteString("\r\n")
+
+	encoded := base64.StdEncoding.EncodeToString([]byte(msg.Body))
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+
+	buf.WriteString(encoded + "\r\n")
+
+	return buf.Bytes(), nil
+}
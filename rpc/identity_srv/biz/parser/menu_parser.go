@@ -4,8 +4,13 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"slices"
 	"sort"
+	"strings"
+	"unicode"
 
 	"github.com/google/uuid"
 	"gopkg.in/yaml.v3"
@@ -13,14 +18,40 @@ import (
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
 )
 
+const (
+	// maxPermCodeLength 与 menus.perm_code 列长度一致
+	maxPermCodeLength = 100
+	// maxApiPathLength 与 menu_api_paths.path 列长度一致
+	maxApiPathLength = 255
+)
+
 // YamlMenuNode 定义了 menu.yaml 文件中单个节点的结构。
 type YamlMenuNode struct {
-	Name      string          `yaml:"name"`
-	ID        string          `yaml:"id"` // 这是来自 ayml 的字符串ID，并非数据库的UUID主键
-	Path      string          `yaml:"path"`
-	Icon      string          `yaml:"icon"`
-	Component string          `yaml:"component"`
-	Children  []*YamlMenuNode `yaml:"children"`
+	Name      string            `yaml:"name"`
+	ID        string            `yaml:"id"` // 这是来自 ayml 的字符串ID，并非数据库的UUID主键
+	Path      string            `yaml:"path"`
+	Icon      string            `yaml:"icon"`
+	Component string            `yaml:"component"`
+	PermCode  string            `yaml:"perm_code"` // 为空时默认 menu:<id>
+	ApiPaths  []string          `yaml:"api_paths"`
+	Buttons   []*YamlMenuButton `yaml:"buttons"`
+	Children  []*YamlMenuNode   `yaml:"children"`
+
+	// 解析时记录的 YAML 行号，用于校验错误定位
+	line         int
+	apiPathLines []int
+}
+
+// YamlMenuButton 定义了菜单节点下按钮级权限项的结构。
+// 按钮不出现在导航菜单中，只用于前端控制操作按钮与后端接口授权。
+type YamlMenuButton struct {
+	Name     string   `yaml:"name"`
+	ID       string   `yaml:"id"`
+	PermCode string   `yaml:"perm_code"` // 按钮必须显式配置权限编码
+	ApiPaths []string `yaml:"api_paths"`
+
+	line         int
+	apiPathLines []int
 }
 
 // YamlMenuContainer 是 menu.yaml 文件的根对象结构。
@@ -28,14 +59,124 @@ type YamlMenuContainer struct {
 	Menu []*YamlMenuNode `yaml:"menu"`
 }
 
+// ValidationError 菜单配置校验错误，Line 为 YAML 中的行号（从 1 开始）
+type ValidationError struct {
+	Line int
+	Msg  string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("第 %d 行: %s", e.Line, e.Msg)
+}
+
+func validationErrorf(line int, format string, args ...interface{}) error {
+	return &ValidationError{Line: line, Msg: fmt.Sprintf(format, args...)}
+}
+
+var (
+	menuNodeFields   = []string{"name", "id", "path", "icon", "component", "perm_code", "api_paths", "buttons", "children"}
+	menuButtonFields = []string{"name", "id", "perm_code", "api_paths"}
+)
+
+// UnmarshalYAML 在解码的同时记录行号，并拒绝未知字段（拼写错误的字段不再被静默忽略）
+func (n *YamlMenuNode) UnmarshalYAML(value *yaml.Node) error {
+	if err := checkMappingFields(value, "菜单节点", menuNodeFields); err != nil {
+		return err
+	}
+
+	type plain YamlMenuNode
+	if err := value.Decode((*plain)(n)); err != nil {
+		return err
+	}
+
+	n.line = value.Line
+	n.apiPathLines = sequenceItemLines(value, "api_paths")
+
+	return nil
+}
+
+// UnmarshalYAML 在解码的同时记录行号，并拒绝未知字段（按钮不允许配置 children 等菜单字段）
+func (b *YamlMenuButton) UnmarshalYAML(value *yaml.Node) error {
+	if err := checkMappingFields(value, "按钮节点", menuButtonFields); err != nil {
+		return err
+	}
+
+	type plain YamlMenuButton
+	if err := value.Decode((*plain)(b)); err != nil {
+		return err
+	}
+
+	b.line = value.Line
+	b.apiPathLines = sequenceItemLines(value, "api_paths")
+
+	return nil
+}
+
+// checkMappingFields 校验节点为映射且只包含允许的字段，字段不可重复
+func checkMappingFields(value *yaml.Node, kind string, allowed []string) error {
+	if value.Kind != yaml.MappingNode {
+		return validationErrorf(value.Line, "%s必须是键值映射", kind)
+	}
+
+	seen := make(map[string]bool, len(value.Content)/2)
+
+	for i := 0; i+1 < len(value.Content); i += 2 {
+		key := value.Content[i]
+
+		if !slices.Contains(allowed, key.Value) {
+			return validationErrorf(key.Line, "%s包含未知字段 %q，可用字段: %s",
+				kind, key.Value, strings.Join(allowed, ", "))
+		}
+
+		if seen[key.Value] {
+			return validationErrorf(key.Line, "%s字段 %q 重复", kind, key.Value)
+		}
+
+		seen[key.Value] = true
+	}
+
+	return nil
+}
+
+// sequenceItemLines 返回映射中指定序列字段各元素的行号
+func sequenceItemLines(value *yaml.Node, field string) []int {
+	for i := 0; i+1 < len(value.Content); i += 2 {
+		if value.Content[i].Value != field || value.Content[i+1].Kind != yaml.SequenceNode {
+			continue
+		}
+
+		items := value.Content[i+1].Content
+		lines := make([]int, len(items))
+
+		for j, item := range items {
+			lines[j] = item.Line
+		}
+
+		return lines
+	}
+
+	return nil
+}
+
 // menuHashNode 用于哈希计算的规范化结构
+// 新增字段均为 omitempty，未配置时与旧版本哈希保持一致
 type menuHashNode struct {
-	SemanticID string         `json:"id"`
-	Name       string         `json:"name"`
-	Path       string         `json:"path"`
-	Component  string         `json:"component"`
-	Icon       string         `json:"icon"`
-	Children   []menuHashNode `json:"children"`
+	SemanticID string           `json:"id"`
+	Name       string           `json:"name"`
+	Path       string           `json:"path"`
+	Component  string           `json:"component"`
+	Icon       string           `json:"icon"`
+	PermCode   string           `json:"perm_code,omitempty"`
+	ApiPaths   []string         `json:"api_paths,omitempty"`
+	Buttons    []menuHashButton `json:"buttons,omitempty"`
+	Children   []menuHashNode   `json:"children"`
+}
+
+type menuHashButton struct {
+	SemanticID string   `json:"id"`
+	Name       string   `json:"name"`
+	PermCode   string   `json:"perm_code"`
+	ApiPaths   []string `json:"api_paths,omitempty"`
 }
 
 // CalculateContentHash 计算菜单内容的 SHA256 哈希
@@ -72,6 +213,9 @@ func toHashNodes(nodes []*YamlMenuNode) []menuHashNode {
 			Path:       n.Path,
 			Component:  n.Component,
 			Icon:       n.Icon,
+			PermCode:   n.PermCode,
+			ApiPaths:   sortedCopy(n.ApiPaths),
+			Buttons:    toHashButtons(n.Buttons),
 			Children:   toHashNodes(n.Children),
 		}
 	}
@@ -84,98 +228,134 @@ func toHashNodes(nodes []*YamlMenuNode) []menuHashNode {
 	return result
 }
 
+func toHashButtons(buttons []*YamlMenuButton) []menuHashButton {
+	if len(buttons) == 0 {
+		return nil
+	}
+
+	result := make([]menuHashButton, len(buttons))
+
+	for i, b := range buttons {
+		result[i] = menuHashButton{
+			SemanticID: b.ID,
+			Name:       b.Name,
+			PermCode:   b.PermCode,
+			ApiPaths:   sortedCopy(b.ApiPaths),
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].SemanticID < result[j].SemanticID
+	})
+
+	return result
+}
+
 // ParseAndFlattenMenu 解析YAML格式的菜单内容，并将其扁平化为 models.Menu 的列表，
 // 以便进行数据库插入。该函数会分配版本号并处理父子关系。
 // 注意：
 // - 此函数假定 models.Menu 结构体允许在代码中预设其 UUID
 // - version 参数由调用方设置（可以是临时值，后续由 Logic 层更新）
 // - productLine 和 version 的校验应在 Logic 层或 Gateway 层处理
+// - 按钮展开为 IsButton=true 的子节点，api_paths 写入各节点的 ApiPaths
+// - 校验失败时返回 *ValidationError，带出错位置的行号
 func ParseAndFlattenMenu(yamlContent string, productLine string, version int) ([]*models.Menu, string, error) {
 	var container YamlMenuContainer
-	if err := yaml.Unmarshal([]byte(yamlContent), &container); err != nil {
+
+	decoder := yaml.NewDecoder(strings.NewReader(yamlContent))
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(&container); err != nil && !errors.Is(err, io.EOF) {
 		return nil, "", fmt.Errorf("解析菜单YAML失败: %w", err)
 	}
 
 	// 计算内容哈希
 	contentHash := CalculateContentHash(container.Menu)
 
-	flatList := make([]*models.Menu, 0)
-	semanticIDSet := make(map[string]bool) // 用于检测同一版本中的重复语义ID
-
-	if err := flattenNodes(
-		container.Menu,
-		nil,
-		productLine,
-		version,
-		contentHash,
-		&flatList,
-		semanticIDSet,
-	); err != nil {
+	f := &menuFlattener{
+		productLine: productLine,
+		version:     version,
+		contentHash: contentHash,
+		semanticIDs: make(map[string]int),
+		permCodes:   make(map[string]int),
+	}
+
+	if err := f.flattenNodes(container.Menu, nil); err != nil {
 		return nil, "", err
 	}
 
-	return flatList, contentHash, nil
+	return f.menus, contentHash, nil
+}
+
+// menuFlattener 遍历菜单树，生成一组可供入库的 models.Menu 对象
+// semanticIDs、permCodes 记录首次出现的行号，用于检测同一版本中的重复项
+type menuFlattener struct {
+	productLine string
+	version     int
+	contentHash string
+
+	menus       []*models.Menu
+	semanticIDs map[string]int
+	permCodes   map[string]int
 }
 
 // flattenNodes 是一个递归辅助函数，用于遍历菜单树。
-// 它将遍历结果填充到 flatList 中，生成一组可供入库的 models.Menu 对象。
-func flattenNodes(
-	nodes []*YamlMenuNode,
-	parentID *uuid.UUID,
-	productLine string,
-	version int,
-	contentHash string,
-	flatList *[]*models.Menu,
-	semanticIDSet map[string]bool,
-) error {
+func (f *menuFlattener) flattenNodes(nodes []*YamlMenuNode, parentID *uuid.UUID) error {
 	for i, node := range nodes {
+		if node == nil {
+			continue
+		}
+
 		if node.Name == "" || node.Path == "" {
-			return fmt.Errorf("菜单节点缺少必要字段 (name, path): %+v", node)
+			return validationErrorf(node.line, "菜单节点缺少必要字段 (name, path): id=%s", node.ID)
 		}
 
 		if node.ID == "" {
-			return fmt.Errorf("菜单节点缺少语义化ID: %+v", node)
+			return validationErrorf(node.line, "菜单节点缺少语义化ID: name=%s", node.Name)
 		}
 
-		// 检查语义ID重复
-		if semanticIDSet[node.ID] {
-			return fmt.Errorf("检测到重复的语义化ID: %s", node.ID)
+		permCode := node.PermCode
+		if permCode == "" {
+			permCode = "menu:" + node.ID
 		}
 
-		semanticIDSet[node.ID] = true
+		if err := f.register(node.line, node.ID, permCode); err != nil {
+			return err
+		}
 
 		// 在应用层生成UUID，以便在插入前建立父子关系。
 		newID := uuid.New()
 
-		menuModel := &models.Menu{
-			BaseModel: models.BaseModel{
+		apiPaths, err := buildApiPaths(newID, node.ID, node.ApiPaths, node.apiPathLines, node.line)
+		if err != nil {
+			return err
+		}
+
+		f.menus = append(f.menus, &models.Menu{
+			UnversionedModel: models.UnversionedModel{
 				ID: newID,
 			},
-			ProductLine: productLine,
+			ProductLine: f.productLine,
 			SemanticID:  node.ID,
-			Version:     version,
-			ContentHash: contentHash,
+			Version:     f.version,
+			ContentHash: f.contentHash,
 			Name:        node.Name,
 			Path:        node.Path,
 			Component:   node.Component,
 			Icon:        node.Icon,
 			ParentID:    parentID,
 			Sort:        i,
-		}
+			PermCode:    permCode,
+			ApiPaths:    apiPaths,
+		})
 
-		*flatList = append(*flatList, menuModel)
+		if err := f.flattenButtons(node.Buttons, newID); err != nil {
+			return err
+		}
 
 		if len(node.Children) > 0 {
 			// 递归调用子节点，并将当前节点的新ID作为其父ID传入
-			if err := flattenNodes(
-				node.Children,
-				&newID,
-				productLine,
-				version,
-				contentHash,
-				flatList,
-				semanticIDSet,
-			); err != nil {
+			if err := f.flattenNodes(node.Children, &newID); err != nil {
 				return err
 			}
 		}
@@ -183,3 +363,124 @@ func flattenNodes(
 
 	return nil
 }
+
+// flattenButtons 将按钮展开为挂在所属菜单下的 IsButton 节点
+func (f *menuFlattener) flattenButtons(buttons []*YamlMenuButton, parentID uuid.UUID) error {
+	for i, button := range buttons {
+		if button == nil {
+			continue
+		}
+
+		if button.Name == "" || button.ID == "" || button.PermCode == "" {
+			return validationErrorf(button.line, "按钮缺少必要字段 (name, id, perm_code): id=%s", button.ID)
+		}
+
+		if err := f.register(button.line, button.ID, button.PermCode); err != nil {
+			return err
+		}
+
+		newID := uuid.New()
+
+		apiPaths, err := buildApiPaths(newID, button.ID, button.ApiPaths, button.apiPathLines, button.line)
+		if err != nil {
+			return err
+		}
+
+		pid := parentID
+		f.menus = append(f.menus, &models.Menu{
+			UnversionedModel: models.UnversionedModel{
+				ID: newID,
+			},
+			ProductLine: f.productLine,
+			SemanticID:  button.ID,
+			Version:     f.version,
+			ContentHash: f.contentHash,
+			Name:        button.Name,
+			ParentID:    &pid,
+			Sort:        i,
+			PermCode:    button.PermCode,
+			IsButton:    true,
+			ApiPaths:    apiPaths,
+		})
+	}
+
+	return nil
+}
+
+// register 登记语义ID与权限编码，二者在同一版本内均不可重复
+func (f *menuFlattener) register(line int, semanticID, permCode string) error {
+	if first, dup := f.semanticIDs[semanticID]; dup {
+		return validationErrorf(line, "检测到重复的语义化ID: %s（首次出现于第 %d 行）", semanticID, first)
+	}
+
+	f.semanticIDs[semanticID] = line
+
+	if len(permCode) > maxPermCodeLength || strings.IndexFunc(permCode, unicode.IsSpace) >= 0 {
+		return validationErrorf(line, "权限编码 %q 格式错误：不能包含空白字符且长度不超过 %d", permCode, maxPermCodeLength)
+	}
+
+	if first, dup := f.permCodes[permCode]; dup {
+		return validationErrorf(line, "检测到重复的权限编码: %s（首次出现于第 %d 行）", permCode, first)
+	}
+
+	f.permCodes[permCode] = line
+
+	return nil
+}
+
+// buildApiPaths 校验节点的 api_paths 并转换为子表记录
+func buildApiPaths(menuID uuid.UUID, semanticID string, paths []string, lines []int, nodeLine int) ([]*models.MenuApiPath, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	result := make([]*models.MenuApiPath, 0, len(paths))
+	seen := make(map[string]int, len(paths))
+
+	for i, raw := range paths {
+		line := nodeLine
+		if i < len(lines) {
+			line = lines[i]
+		}
+
+		path := strings.TrimSpace(raw)
+
+		switch {
+		case !strings.HasPrefix(path, "/"):
+			return nil, validationErrorf(line, "%s 的 api_path %q 必须以 / 开头", semanticID, raw)
+		case strings.IndexFunc(path, unicode.IsSpace) >= 0:
+			return nil, validationErrorf(line, "%s 的 api_path %q 不能包含空白字符", semanticID, raw)
+		case len(path) > maxApiPathLength:
+			return nil, validationErrorf(line, "%s 的 api_path 长度不能超过 %d", semanticID, maxApiPathLength)
+		}
+
+		if first, dup := seen[path]; dup {
+			return nil, validationErrorf(line, "%s 的 api_path %s 重复（首次出现于第 %d 行）", semanticID, path, first)
+		}
+
+		seen[path] = line
+
+		result = append(result, &models.MenuApiPath{
+			MenuID: menuID,
+			Path:   path,
+			Sort:   i,
+		})
+	}
+
+	return result, nil
+}
+
+func sortedCopy(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make([]string, len(values))
+	for i, v := range values {
+		result[i] = strings.TrimSpace(v)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
@@ -149,6 +149,187 @@ menu:
 	assert.Error(t, err)
 }
 
+func TestParseAndFlattenMenu_PermCodeApiPathsAndButtons(t *testing.T) {
+	yamlContent := `
+menu:
+  - name: "用户管理"
+    id: "account_management"
+    path: "accounts"
+    api_paths:
+      - "/api/v1/identity/users"
+      - " /api/v1/identity/users/* "
+    buttons:
+      - name: "导出用户"
+        id: "account_export"
+        perm_code: "user:export"
+        api_paths:
+          - "/api/v1/identity/users/export"
+  - name: "审计日志"
+    id: "audit_logs"
+    path: "audit-logs"
+    perm_code: "audit:read"
+`
+
+	menus, _, err := ParseAndFlattenMenu(yamlContent, "default", 1)
+	require.NoError(t, err)
+	require.Len(t, menus, 3)
+
+	account := findMenuBySemanticID(menus, "account_management")
+	require.NotNil(t, account)
+	assert.Equal(t, "menu:account_management", account.PermCode)
+	assert.False(t, account.IsButton)
+	assert.Equal(t, []string{"/api/v1/identity/users", "/api/v1/identity/users/*"}, account.GetApiPaths())
+
+	for _, p := range account.ApiPaths {
+		assert.Equal(t, account.ID, p.MenuID)
+	}
+
+	button := findMenuBySemanticID(menus, "account_export")
+	require.NotNil(t, button)
+	assert.True(t, button.IsButton)
+	assert.Equal(t, "user:export", button.PermCode)
+	assert.Equal(t, account.ID, *button.ParentID)
+	assert.Equal(t, []string{"/api/v1/identity/users/export"}, button.GetApiPaths())
+
+	audit := findMenuBySemanticID(menus, "audit_logs")
+	require.NotNil(t, audit)
+	assert.Equal(t, "audit:read", audit.PermCode)
+	assert.Empty(t, audit.ApiPaths)
+}
+
+func TestParseAndFlattenMenu_ValidationErrorsWithLine(t *testing.T) {
+	cases := []struct {
+		name    string
+		yaml    string
+		line    int
+		message string
+	}{
+		{
+			name: "重复的 api_path",
+			yaml: `menu:
+  - name: "用户管理"
+    id: "account_management"
+    path: "accounts"
+    api_paths:
+      - "/api/v1/identity/users"
+      - "/api/v1/identity/users"
+`,
+			line:    7,
+			message: "重复（首次出现于第 6 行）",
+		},
+		{
+			name: "api_path 不以 / 开头",
+			yaml: `menu:
+  - name: "用户管理"
+    id: "account_management"
+    path: "accounts"
+    api_paths:
+      - "api/v1/identity/users"
+`,
+			line:    6,
+			message: "必须以 / 开头",
+		},
+		{
+			name: "重复的权限编码",
+			yaml: `menu:
+  - name: "用户管理"
+    id: "account_management"
+    path: "accounts"
+    perm_code: "user:manage"
+    buttons:
+      - name: "管理"
+        id: "account_manage"
+        perm_code: "user:manage"
+`,
+			line:    7,
+			message: "检测到重复的权限编码: user:manage（首次出现于第 2 行）",
+		},
+		{
+			name: "按钮缺少权限编码",
+			yaml: `menu:
+  - name: "用户管理"
+    id: "account_management"
+    path: "accounts"
+    buttons:
+      - name: "导出"
+        id: "account_export"
+`,
+			line:    6,
+			message: "按钮缺少必要字段",
+		},
+		{
+			name: "按钮与菜单语义ID重复",
+			yaml: `menu:
+  - name: "用户管理"
+    id: "account_management"
+    path: "accounts"
+    buttons:
+      - name: "导出"
+        id: "account_management"
+        perm_code: "user:export"
+`,
+			line:    6,
+			message: "检测到重复的语义化ID",
+		},
+		{
+			name: "未知字段",
+			yaml: `menu:
+  - name: "用户管理"
+    id: "account_management"
+    path: "accounts"
+    api_path: "/api/v1/identity/users"
+`,
+			line:    5,
+			message: "未知字段 \"api_path\"",
+		},
+		{
+			name: "按钮不支持 children",
+			yaml: `menu:
+  - name: "用户管理"
+    id: "account_management"
+    path: "accounts"
+    buttons:
+      - name: "导出"
+        id: "account_export"
+        perm_code: "user:export"
+        children: []
+`,
+			line:    9,
+			message: "按钮节点包含未知字段",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := ParseAndFlattenMenu(tc.yaml, "default", 1)
+			require.Error(t, err)
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tc.line, validationErr.Line)
+			assert.Contains(t, validationErr.Msg, tc.message)
+		})
+	}
+}
+
+func TestCalculateContentHash_NewFields(t *testing.T) {
+	base := []*YamlMenuNode{{ID: "a", Name: "A", Path: "/a"}}
+	withApiPaths := []*YamlMenuNode{{ID: "a", Name: "A", Path: "/a", ApiPaths: []string{"/api/a"}}}
+	withButtons := []*YamlMenuNode{{ID: "a", Name: "A", Path: "/a", Buttons: []*YamlMenuButton{
+		{ID: "a_btn", Name: "按钮", PermCode: "a:btn"},
+	}}}
+
+	hash := CalculateContentHash(base)
+	assert.NotEqual(t, hash, CalculateContentHash(withApiPaths))
+	assert.NotEqual(t, hash, CalculateContentHash(withButtons))
+
+	// api_paths 顺序不影响哈希
+	assert.Equal(t,
+		CalculateContentHash([]*YamlMenuNode{{ID: "a", Name: "A", Path: "/a", ApiPaths: []string{"/x", "/y"}}}),
+		CalculateContentHash([]*YamlMenuNode{{ID: "a", Name: "A", Path: "/a", ApiPaths: []string{"/y", "/x"}}}),
+	)
+}
+
 // findMenuBySemanticID 辅助函数，根据语义ID查找菜单
 func findMenuBySemanticID(menus []*models.Menu, semanticID string) *models.Menu {
 	for _, menu := range menus {
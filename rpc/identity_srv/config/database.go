@@ -2,14 +2,11 @@ package config
 
 import (
 	"fmt"
-	"log"
 
 	"github.com/rs/zerolog"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
-
-	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
 )
 
 // InitDB 初始化数据库连接，提供给wire使用的函数
@@ -18,10 +15,48 @@ func InitDB(cfg *Config, loggerSvc *zerolog.Logger) (*gorm.DB, error) {
 }
 
 // NewDB initializes and returns a new GORM database instance.
+//
+// 连接成功后按 MigrateOnBoot 执行或校验版本化迁移，再执行种子数据初始化。
 func NewDB(
 	cfg *DatabaseConfig,
 	serverCfg *ServerConfig,
 	loggerSvc *zerolog.Logger,
+) (*gorm.DB, error) {
+	db, err := OpenDB(cfg, serverCfg, loggerSvc)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := migrateOnBoot(db, cfg, loggerSvc); err != nil {
+		return nil, err
+	}
+
+	// 执行种子数据初始化（幂等）
+	if err := SeedDatabase(db, loggerSvc, cfg); err != nil {
+		// Seeder 失败只记录警告，不阻止服务启动
+		loggerSvc.Warn().Err(err).Msg("⚠️  种子数据初始化失败")
+	}
+
+	loggerSvc.Info().
+		Str("host", cfg.Host).
+		Int("port", cfg.Port).
+		Str("database", cfg.DBName).
+		Int("max_idle_conns", cfg.MaxIdleConns).
+		Int("max_open_conns", cfg.MaxOpenConns).
+		Dur("max_conn_lifetime", cfg.ConnMaxLifetime).
+		Dur("max_conn_idle_time", cfg.ConnMaxIdleTime).
+		Msg("Database connected successfully")
+
+	return db, nil
+}
+
+// OpenDB 建立数据库连接并配置连接池，不执行迁移与种子数据
+//
+// migrate 子命令直接使用此函数，避免启动流程中的自动迁移。
+func OpenDB(
+	cfg *DatabaseConfig,
+	serverCfg *ServerConfig,
+	loggerSvc *zerolog.Logger,
 ) (*gorm.DB, error) {
 	var dialector gorm.Dialector
 
@@ -88,92 +123,5 @@ func NewDB(
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	if err := autoMigrate(db); err != nil {
-		return nil, fmt.Errorf("数据库自动迁移失败: %v", err)
-	}
-
-	// 执行种子数据初始化（幂等）
-	if err := SeedDatabase(db, loggerSvc, cfg); err != nil {
-		// Seeder 失败只记录警告，不阻止服务启动
-		loggerSvc.Warn().Err(err).Msg("⚠️  种子数据初始化失败")
-	}
-
-	loggerSvc.Info().
-		Str("host", cfg.Host).
-		Int("port", cfg.Port).
-		Str("database", cfg.DBName).
-		Int("max_idle_conns", cfg.MaxIdleConns).
-		Int("max_open_conns", cfg.MaxOpenConns).
-		Dur("max_conn_lifetime", cfg.ConnMaxLifetime).
-		Dur("max_conn_idle_time", cfg.ConnMaxIdleTime).
-		Msg("Database connected successfully")
-
 	return db, nil
 }
-
-func autoMigrate(db *gorm.DB) error {
-	if db == nil {
-		return fmt.Errorf("数据库连接未初始化")
-	}
-
-	log.Println("开始数据库自动迁移...")
-
-	// 按照依赖顺序创建表结构
-	// 分布式系统中不使用数据库外键约束，通过应用层维护数据一致性
-	err := db.AutoMigrate(
-		&models.UserProfile{},
-		&models.UserMembership{},
-		&models.Organization{},
-		&models.Department{},
-		&models.OrganizationLogo{},
-		&models.RoleDefinition{},
-		&models.UserRoleAssignment{},
-		&models.Menu{},
-		&models.RoleMenuPermission{},
-		&models.AuditLog{},
-	)
-	if err != nil {
-		return fmt.Errorf("自动迁移失败: %v", err)
-	}
-
-	// 清理旧的唯一约束索引（如果存在）
-	// 旧的索引: idx_semantic_version (semantic_id, version)
-	// 新的索引: idx_product_semantic_version (product_line, semantic_id, version)
-	if err := cleanupOldMenuIndexes(db); err != nil {
-		log.Printf("警告: 清理旧索引失败: %v", err)
-		// 不中断迁移，继续执行
-	}
-
-	log.Println("数据库自动迁移完成")
-
-	return nil
-}
-
-// cleanupOldMenuIndexes 清理 Menu 表的旧索引
-func cleanupOldMenuIndexes(db *gorm.DB) error {
-	// 检查是否存在旧的唯一约束索引 idx_semantic_version
-	var indexExists bool
-
-	err := db.Raw(`
-		SELECT EXISTS (
-			SELECT 1 FROM pg_indexes
-			WHERE tablename = 'menus'
-			AND indexname = 'idx_semantic_version'
-		)
-	`).Scan(&indexExists).Error
-	if err != nil {
-		return fmt.Errorf("检查旧索引失败: %v", err)
-	}
-
-	if indexExists {
-		log.Println("发现旧的唯一约束索引 idx_semantic_version，正在删除...")
-
-		if err := db.Exec("DROP INDEX IF EXISTS idx_semantic_version").Error; err != nil {
-			return fmt.Errorf("删除旧索引失败: %v", err)
-		}
-
-		log.Println("成功删除旧索引 idx_semantic_version")
-	}
-
-	return nil
-}
@@ -34,6 +34,7 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("database.conn_max_idle_time", 5*time.Minute)
 	v.SetDefault("database.sslmode", "disable")
 	v.SetDefault("database.timezone", "Asia/Shanghai")
+	v.SetDefault("database.migrate_on_boot", true)
 
 	// etcd配置默认值
 	v.SetDefault("etcd.address", "localhost:2379")
@@ -73,6 +74,35 @@ func setDefaults(v *viper.Viper) {
 		"image/svg+xml", // SVG 图片
 	})
 
-	// 超级管理员配置默认值
-	v.SetDefault("super_admin.role_names", []string{"superadmin"})
+	// 紧急账号配置默认值（默认不启用）
+	v.SetDefault("break_glass.usernames", []string{})
+
+	// 角色分配配置默认值
+	v.SetDefault("role_assignment.expiry_interval", time.Minute)
+	v.SetDefault("role_assignment.approval_ttl", 72*time.Hour)
+
+	// 回收站配置默认值（保留 30 天）
+	v.SetDefault("recycle_bin.retention", 30*24*time.Hour)
+	v.SetDefault("recycle_bin.purge_interval", time.Hour)
+
+	// 多因素认证配置默认值
+	v.SetDefault("mfa.issuer", "CloudWeGo IAM")
+
+	// 密码策略默认值（至少 8 位、两种字符，禁止复用最近 5 个密码，不限使用期限）
+	v.SetDefault("password_policy.min_length", 8)
+	v.SetDefault("password_policy.min_char_classes", 2)
+	v.SetDefault("password_policy.history_size", 5)
+	v.SetDefault("password_policy.max_age", time.Duration(0))
+
+	// 自助找回密码配置默认值（令牌 30 分钟有效，每小时最多签发 3 个）
+	v.SetDefault("password_reset.token_ttl", 30*time.Minute)
+	v.SetDefault("password_reset.rate_limit_window", time.Hour)
+	v.SetDefault("password_reset.rate_limit_max", 3)
+	v.SetDefault("password_reset.url", "http://localhost:5173/reset-password?token={token}")
+
+	// 通知发送配置默认值（默认写入服务日志，生产环境应配置 smtp）
+	v.SetDefault("notifier.type", "log")
+	v.SetDefault("notifier.file_path", "logs/notifications.log")
+	v.SetDefault("notifier.smtp.port", 587)
+	v.SetDefault("notifier.smtp.timeout", 10*time.Second)
 }
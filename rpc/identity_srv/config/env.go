@@ -63,8 +63,29 @@ func mapEnvVarsToConfig(v *viper.Viper) {
 	// Logo存储配置映射
 	mapLogoStorageEnvVars(v)
 
-	// 超级管理员配置映射
-	mapSuperAdminEnvVars(v)
+	// 紧急账号配置映射
+	mapBreakGlassEnvVars(v)
+
+	// 角色分配配置映射
+	mapRoleAssignmentEnvVars(v)
+
+	// 多因素认证配置映射
+	mapToViper(v, "MFA_ISSUER", "mfa.issuer", nil)
+
+	// 密码策略配置映射
+	mapPasswordPolicyEnvVars(v)
+
+	// 自助找回密码配置映射
+	mapPasswordResetEnvVars(v)
+
+	// 通知发送配置映射
+	mapNotifierEnvVars(v)
+
+	// 回收站配置映射
+	mapRecycleBinEnvVars(v)
+
+	// 菜单配置映射
+	mapMenuEnvVars(v)
 }
 
 // mapDatabaseEnvVars 映射数据库相关环境变量
@@ -97,6 +118,10 @@ func mapDatabaseEnvVars(v *viper.Viper) {
 			return parseDurationWithDefault(value, 5*time.Minute)
 		},
 	)
+
+	mapToViper(v, "DB_MIGRATE_ON_BOOT", "database.migrate_on_boot", func(value string) interface{} {
+		return value == "true"
+	})
 }
 
 // mapServerEnvVars 映射服务器相关环境变量
@@ -258,18 +283,18 @@ func mapLogoStorageEnvVars(v *viper.Viper) {
 	)
 }
 
-// mapSuperAdminEnvVars 映射超级管理员相关环境变量
-func mapSuperAdminEnvVars(v *viper.Viper) {
+// mapBreakGlassEnvVars 映射紧急账号相关环境变量
+func mapBreakGlassEnvVars(v *viper.Viper) {
 	mapToViper(
 		v,
-		"SUPER_ADMIN_ROLE_NAMES",
-		"super_admin.role_names",
+		"BREAK_GLASS_USER_IDS",
+		"break_glass.user_ids",
 		func(value string) interface{} {
-			roles := strings.Split(value, ",")
+			userIDs := strings.Split(value, ",")
 
-			result := make([]string, 0, len(roles))
-			for _, r := range roles {
-				trimmed := strings.TrimSpace(r)
+			result := make([]string, 0, len(userIDs))
+			for _, id := range userIDs {
+				trimmed := strings.TrimSpace(id)
 				if trimmed != "" {
 					result = append(result, trimmed)
 				}
@@ -280,6 +305,31 @@ func mapSuperAdminEnvVars(v *viper.Viper) {
 	)
 }
 
+// mapMenuEnvVars 映射菜单相关环境变量
+// MENU_CLIENT_PRODUCT_LINES 格式为逗号分隔的 客户端标识=产品线，如 "nurse-mobile=nurse_app,admin-web=default"
+func mapMenuEnvVars(v *viper.Viper) {
+	mapToViper(
+		v,
+		"MENU_CLIENT_PRODUCT_LINES",
+		"menu.client_product_lines",
+		func(value string) interface{} {
+			result := make(map[string]string)
+
+			for _, pair := range strings.Split(value, ",") {
+				clientID, productLine, ok := strings.Cut(pair, "=")
+				clientID = strings.TrimSpace(clientID)
+				productLine = strings.TrimSpace(productLine)
+
+				if ok && clientID != "" && productLine != "" {
+					result[clientID] = productLine
+				}
+			}
+
+			return result
+		},
+	)
+}
+
 // loadDotEnvFirst 在给定路径列表中查找首个 .env 并加载到环境变量（若未找到则忽略）。
 func loadDotEnvFirst(paths []string) {
 	for _, p := range paths {
@@ -320,3 +370,133 @@ func loadDotEnvFile(filePath string) error {
 
 	return nil
 }
+
+// mapRoleAssignmentEnvVars 映射角色分配相关环境变量
+func mapRoleAssignmentEnvVars(v *viper.Viper) {
+	mapToViper(
+		v,
+		"ROLE_ASSIGNMENT_EXPIRY_INTERVAL",
+		"role_assignment.expiry_interval",
+		func(value string) interface{} {
+			return parseDurationWithDefault(value, time.Minute)
+		},
+	)
+	mapToViper(
+		v,
+		"ROLE_ASSIGNMENT_APPROVAL_TTL",
+		"role_assignment.approval_ttl",
+		func(value string) interface{} {
+			return parseDurationWithDefault(value, 72*time.Hour)
+		},
+	)
+}
+
+// mapPasswordPolicyEnvVars 映射密码策略相关环境变量
+func mapPasswordPolicyEnvVars(v *viper.Viper) {
+	mapToViper(v, "PASSWORD_POLICY_MIN_LENGTH", "password_policy.min_length", func(value string) interface{} {
+		if val, err := strconv.Atoi(value); err == nil {
+			return val
+		}
+
+		return 8
+	})
+	mapToViper(
+		v,
+		"PASSWORD_POLICY_MIN_CHAR_CLASSES",
+		"password_policy.min_char_classes",
+		func(value string) interface{} {
+			if val, err := strconv.Atoi(value); err == nil {
+				return val
+			}
+
+			return 2
+		},
+	)
+	mapToViper(v, "PASSWORD_POLICY_DICTIONARY_FILE", "password_policy.dictionary_file", nil)
+	mapToViper(v, "PASSWORD_POLICY_HISTORY_SIZE", "password_policy.history_size", func(value string) interface{} {
+		if val, err := strconv.Atoi(value); err == nil {
+			return val
+		}
+
+		return 5
+	})
+	mapToViper(v, "PASSWORD_POLICY_MAX_AGE", "password_policy.max_age", func(value string) interface{} {
+		return parseDurationWithDefault(value, 0)
+	})
+}
+
+// mapPasswordResetEnvVars 映射自助找回密码相关环境变量
+func mapPasswordResetEnvVars(v *viper.Viper) {
+	mapToViper(
+		v,
+		"PASSWORD_RESET_TOKEN_TTL",
+		"password_reset.token_ttl",
+		func(value string) interface{} {
+			return parseDurationWithDefault(value, 30*time.Minute)
+		},
+	)
+	mapToViper(
+		v,
+		"PASSWORD_RESET_RATE_LIMIT_WINDOW",
+		"password_reset.rate_limit_window",
+		func(value string) interface{} {
+			return parseDurationWithDefault(value, time.Hour)
+		},
+	)
+	mapToViper(
+		v,
+		"PASSWORD_RESET_RATE_LIMIT_MAX",
+		"password_reset.rate_limit_max",
+		func(value string) interface{} {
+			if val, err := strconv.Atoi(value); err == nil {
+				return val
+			}
+
+			return 3
+		},
+	)
+	mapToViper(v, "PASSWORD_RESET_URL", "password_reset.url", nil)
+}
+
+// mapNotifierEnvVars 映射通知发送相关环境变量
+func mapNotifierEnvVars(v *viper.Viper) {
+	mapToViper(v, "NOTIFIER_TYPE", "notifier.type", nil)
+	mapToViper(v, "NOTIFIER_FILE_PATH", "notifier.file_path", nil)
+	mapToViper(v, "SMTP_HOST", "notifier.smtp.host", nil)
+	mapToViper(v, "SMTP_PORT", "notifier.smtp.port", func(value string) interface{} {
+		if val, err := strconv.Atoi(value); err == nil {
+			return val
+		}
+
+		return 587
+	})
+	mapToViper(v, "SMTP_USERNAME", "notifier.smtp.username", nil)
+	mapToViper(v, "SMTP_PASSWORD", "notifier.smtp.password", nil)
+	mapToViper(v, "SMTP_FROM", "notifier.smtp.from", nil)
+	mapToViper(v, "SMTP_USE_TLS", "notifier.smtp.use_tls", func(value string) interface{} {
+		return value == "true"
+	})
+	mapToViper(v, "SMTP_TIMEOUT", "notifier.smtp.timeout", func(value string) interface{} {
+		return parseDurationWithDefault(value, 10*time.Second)
+	})
+}
+
+// mapRecycleBinEnvVars 映射回收站相关环境变量
+func mapRecycleBinEnvVars(v *viper.Viper) {
+	mapToViper(
+		v,
+		"RECYCLE_BIN_RETENTION",
+		"recycle_bin.retention",
+		func(value string) interface{} {
+			return parseDurationWithDefault(value, 30*24*time.Hour)
+		},
+	)
+	mapToViper(
+		v,
+		"RECYCLE_BIN_PURGE_INTERVAL",
+		"recycle_bin.purge_interval",
+		func(value string) interface{} {
+			return parseDurationWithDefault(value, time.Hour)
+		},
+	)
+}
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/dbmigrate"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/migrations"
+)
+
+// NewMigrator 基于已建立的连接创建版本化迁移执行器（迁移脚本 embed 在 migrations 包中）
+func NewMigrator(db *gorm.DB, loggerSvc *zerolog.Logger) (*dbmigrate.Migrator, error) {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("获取底层SQL DB失败: %w", err)
+	}
+
+	list, err := dbmigrate.Load(migrations.FS, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	return dbmigrate.New(sqlDB, list, dbmigrate.WithLogger(func(format string, args ...any) {
+		loggerSvc.Info().Msgf(format, args...)
+	})), nil
+}
+
+// migrateOnBoot 启动时执行或校验迁移
+//
+// MigrateOnBoot 开启时在迁移锁内执行未执行的迁移，多副本同时启动只有一个会真正执行；
+// 关闭时只校验 schema 版本，存在未执行、被修改或缺失的迁移则拒绝启动。
+func migrateOnBoot(db *gorm.DB, cfg *DatabaseConfig, loggerSvc *zerolog.Logger) error {
+	m, err := NewMigrator(db, loggerSvc)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	if !cfg.MigrateOnBoot {
+		if err := m.Verify(ctx); err != nil {
+			return fmt.Errorf("数据库 schema 版本校验失败（可执行 migrate up 升级）: %w", err)
+		}
+
+		return nil
+	}
+
+	done, err := m.Up(ctx)
+	if err != nil {
+		return fmt.Errorf("数据库迁移失败: %w", err)
+	}
+
+	loggerSvc.Info().Int("applied", len(done)).Msg("数据库迁移完成")
+
+	return nil
+}
@@ -1,9 +1,14 @@
 package config
 
 import (
-	_ "embed"
+	"embed"
 	"fmt"
+	"io/fs"
 	"log"
+	"maps"
+	"path"
+	"slices"
+	"strings"
 
 	"gorm.io/gorm"
 
@@ -11,45 +16,85 @@ import (
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
 )
 
-//go:embed seed_menu.yaml
-var seedMenuYAML string
+// seedMenuFS 种子菜单配置，每个产品线一个文件：seed_menus/<产品线>.yaml
+//
+//go:embed seed_menus/*.yaml
+var seedMenuFS embed.FS
 
 const (
-	// seedProductLine 种子数据使用的产品线标识
-	seedProductLine = "default"
+	// seedMenuDir 种子菜单配置目录
+	seedMenuDir = "seed_menus"
 
 	// seedMenuVersion 种子数据使用的初始版本号
 	seedMenuVersion = 1
 )
 
-// seedDefaultMenus 初始化默认菜单数据
-// 仅在数据库中完全没有菜单数据时插入，已有数据一律跳过
+// seedDefaultMenus 初始化各产品线的默认菜单数据
+// 按产品线分别判断：仅在该产品线完全没有菜单数据时插入，已有数据一律跳过
 func seedDefaultMenus(db *gorm.DB) error {
 	log.Println("正在检查默认菜单数据...")
 
-	// 检查是否已有菜单数据
+	seeds, err := loadSeedMenus()
+	if err != nil {
+		return err
+	}
+
+	// 按产品线名称排序，保证初始化顺序稳定
+	for _, productLine := range slices.Sorted(maps.Keys(seeds)) {
+		if err := seedProductLineMenus(db, productLine, seeds[productLine]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// seedProductLineMenus 初始化单个产品线的菜单数据
+func seedProductLineMenus(db *gorm.DB, productLine, yamlContent string) error {
+	// 检查该产品线是否已有菜单数据
 	var count int64
-	if err := db.Model(&models.Menu{}).Count(&count).Error; err != nil {
-		return fmt.Errorf("查询菜单数据失败: %w", err)
+	if err := db.Model(&models.Menu{}).Where("product_line = ?", productLine).Count(&count).Error; err != nil {
+		return fmt.Errorf("查询产品线 %s 菜单数据失败: %w", productLine, err)
 	}
 
 	if count > 0 {
-		log.Printf("菜单数据已是最新（共 %d 条），跳过初始化", count)
+		log.Printf("产品线 %s 菜单数据已存在（共 %d 条），跳过初始化", productLine, count)
 		return nil
 	}
 
 	// 解析嵌入的 YAML 菜单配置
-	menus, _, err := parser.ParseAndFlattenMenu(seedMenuYAML, seedProductLine, seedMenuVersion)
+	menus, _, err := parser.ParseAndFlattenMenu(yamlContent, productLine, seedMenuVersion)
 	if err != nil {
-		return fmt.Errorf("解析菜单配置失败: %w", err)
+		return fmt.Errorf("解析产品线 %s 菜单配置失败: %w", productLine, err)
 	}
 
 	// 批量插入菜单数据
 	if err := db.Create(&menus).Error; err != nil {
-		return fmt.Errorf("插入菜单数据失败: %w", err)
+		return fmt.Errorf("插入产品线 %s 菜单数据失败: %w", productLine, err)
 	}
 
-	log.Printf("默认菜单数据初始化成功（共 %d 条）", len(menus))
+	log.Printf("产品线 %s 菜单数据初始化成功（共 %d 条）", productLine, len(menus))
 
 	return nil
 }
+
+// loadSeedMenus 读取嵌入的种子菜单配置，返回产品线到 YAML 内容的映射
+func loadSeedMenus() (map[string]string, error) {
+	files, err := fs.Glob(seedMenuFS, seedMenuDir+"/*.yaml")
+	if err != nil {
+		return nil, fmt.Errorf("读取种子菜单目录失败: %w", err)
+	}
+
+	seeds := make(map[string]string, len(files))
+	for _, file := range files {
+		content, err := seedMenuFS.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("读取种子菜单 %s 失败: %w", file, err)
+		}
+
+		productLine := strings.TrimSuffix(path.Base(file), path.Ext(file))
+		seeds[productLine] = string(content)
+	}
+
+	return seeds, nil
+}
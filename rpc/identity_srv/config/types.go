@@ -13,12 +13,21 @@ type Config struct {
 	Log         LogConfig         `mapstructure:"log"`
 	Tracing     TracingConfig     `mapstructure:"tracing"`
 	LogoStorage LogoStorageConfig `mapstructure:"logo_storage"`
-	SuperAdmin  SuperAdminConfig  `mapstructure:"super_admin"`
+	BreakGlass  BreakGlassConfig  `mapstructure:"break_glass"`
+
+	RoleAssignment RoleAssignmentConfig `mapstructure:"role_assignment"`
+	MFA            MFAConfig            `mapstructure:"mfa"`
+	PasswordPolicy PasswordPolicyConfig `mapstructure:"password_policy"`
+	PasswordReset  PasswordResetConfig  `mapstructure:"password_reset"`
+	Notifier       NotifierConfig       `mapstructure:"notifier"`
+	RecycleBin     RecycleBinConfig     `mapstructure:"recycle_bin"`
+	Menu           MenuConfig           `mapstructure:"menu"`
 }
 
 // DatabaseConfig 数据库配置
 // 相关环境变量：DB_HOST, DB_PORT, DB_USERNAME, DB_PASSWORD, DB_NAME,
-// DB_SSLMODE, DB_TIMEZONE, DB_DRIVER, DB_MAX_IDLE_CONNS, DB_MAX_OPEN_CONNS, DB_CONN_MAX_LIFETIME
+// DB_SSLMODE, DB_TIMEZONE, DB_DRIVER, DB_MAX_IDLE_CONNS, DB_MAX_OPEN_CONNS, DB_CONN_MAX_LIFETIME,
+// DB_MIGRATE_ON_BOOT
 type DatabaseConfig struct {
 	// 基础配置
 	Driver   string `mapstructure:"driver"`
@@ -35,6 +44,10 @@ type DatabaseConfig struct {
 	MaxOpenConns    int           `mapstructure:"max_open_conns"`     // 最大打开连接数
 	ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime"`  // 连接最大生命周期(分钟)
 	ConnMaxIdleTime time.Duration `mapstructure:"conn_max_idle_time"` // 连接最大空闲时间(分钟)
+
+	// MigrateOnBoot 启动时自动执行未执行的迁移（默认开启）
+	// 关闭后启动时仅校验 schema 版本，不一致则拒绝启动，需先执行 `identity-srv migrate up`
+	MigrateOnBoot bool `mapstructure:"migrate_on_boot"`
 }
 
 // ServerConfig 服务器配置
@@ -114,10 +127,111 @@ type LogoStorageConfig struct {
 	AllowedFileTypes []string `mapstructure:"allowed_file_types"` // 允许的图片类型（如 image/png, image/jpeg）
 }
 
-// SuperAdminConfig 超级管理员配置
-// 相关环境变量：SUPER_ADMIN_ROLE_NAMES
-type SuperAdminConfig struct {
-	// RoleNames 超管角色名称列表，这些角色将拥有所有菜单的完整权限
-	// 支持多个角色名称，例如：["super_admin", "system_admin"]
-	RoleNames []string `mapstructure:"role_names"`
+// BreakGlassConfig 紧急账号（break-glass）配置
+// 相关环境变量：BREAK_GLASS_USER_IDS
+type BreakGlassConfig struct {
+	// UserIDs 紧急账号用户ID列表，这些账号绕过 policy_srv 决策获得全部权限，
+	// 每次使用都会写入审计日志；为空表示不启用。按不可变的用户ID而非用户名识别，
+	// 避免有建用户权限的人注册同名账号获得紧急权限。超级管理员的全量权限由
+	// policy_srv 通配策略表达，不在此配置
+	UserIDs []string `mapstructure:"user_ids"`
+}
+
+// RoleAssignmentConfig 角色分配配置
+// 相关环境变量：ROLE_ASSIGNMENT_EXPIRY_INTERVAL, ROLE_ASSIGNMENT_APPROVAL_TTL
+type RoleAssignmentConfig struct {
+	// ExpiryInterval 到期临时授权与过期授权申请的回收周期（<=0 表示关闭自动回收）
+	ExpiryInterval time.Duration `mapstructure:"expiry_interval"`
+
+	// ApprovalTTL 敏感角色授权申请的审批期限，过期未处理的申请自动失效
+	ApprovalTTL time.Duration `mapstructure:"approval_ttl"`
+}
+
+// RecycleBinConfig 回收站配置
+// 相关环境变量：RECYCLE_BIN_RETENTION, RECYCLE_BIN_PURGE_INTERVAL
+type RecycleBinConfig struct {
+	// Retention 已删除数据的保留期，超过后自动彻底删除（<=0 表示不自动清除）
+	Retention time.Duration `mapstructure:"retention"`
+
+	// PurgeInterval 自动清除的执行周期（<=0 表示关闭自动清除）
+	PurgeInterval time.Duration `mapstructure:"purge_interval"`
+}
+
+// MFAConfig 多因素认证配置
+// 相关环境变量：MFA_ISSUER
+type MFAConfig struct {
+	// Issuer 验证器应用中展示的签发方名称（写入 otpauth URI）
+	Issuer string `mapstructure:"issuer"`
+}
+
+// PasswordPolicyConfig 组织级密码策略配置，创建用户、导入、修改、重置与找回密码均按此校验
+// 相关环境变量：PASSWORD_POLICY_MIN_LENGTH, PASSWORD_POLICY_MIN_CHAR_CLASSES,
+// PASSWORD_POLICY_DICTIONARY_FILE, PASSWORD_POLICY_HISTORY_SIZE, PASSWORD_POLICY_MAX_AGE
+type PasswordPolicyConfig struct {
+	// MinLength 密码最短长度（按字符计，不低于 6）
+	MinLength int `mapstructure:"min_length"`
+
+	// MinCharClasses 至少包含的字符种类数：大写字母、小写字母、数字、特殊字符（0-4）
+	MinCharClasses int `mapstructure:"min_char_classes"`
+
+	// DictionaryFile 禁用密码字典文件（常见密码表或 HIBP 格式的 SHA-1 泄露密码库），为空表示不检查
+	DictionaryFile string `mapstructure:"dictionary_file"`
+
+	// HistorySize 禁止复用最近 N 个密码（含当前密码），0 表示不检查
+	HistorySize int `mapstructure:"history_size"`
+
+	// MaxAge 密码最长使用期限，超过后登录时自动要求修改密码，0 表示永不过期
+	MaxAge time.Duration `mapstructure:"max_age"`
+}
+
+// PasswordResetConfig 自助找回密码配置
+// 相关环境变量：PASSWORD_RESET_TOKEN_TTL, PASSWORD_RESET_RATE_LIMIT_WINDOW,
+// PASSWORD_RESET_RATE_LIMIT_MAX, PASSWORD_RESET_URL
+type PasswordResetConfig struct {
+	// TokenTTL 找回密码令牌的有效期
+	TokenTTL time.Duration `mapstructure:"token_ttl"`
+
+	// RateLimitWindow 与 RateLimitMax 共同限制单个账号的令牌签发频率：
+	// 窗口内已签发 RateLimitMax 个令牌后，新的请求被静默忽略（<=0 表示不限流）
+	RateLimitWindow time.Duration `mapstructure:"rate_limit_window"`
+	RateLimitMax    int           `mapstructure:"rate_limit_max"`
+
+	// URL 通知中的重置链接模板，{token} 会被替换为令牌明文
+	URL string `mapstructure:"url"`
+}
+
+// NotifierConfig 通知发送配置
+// 相关环境变量：NOTIFIER_TYPE, NOTIFIER_FILE_PATH, SMTP_HOST, SMTP_PORT,
+// SMTP_USERNAME, SMTP_PASSWORD, SMTP_FROM, SMTP_USE_TLS, SMTP_TIMEOUT
+type NotifierConfig struct {
+	// Type 通知渠道：log（写入服务日志）/ file（追加写入本地文件）/ smtp（发送邮件）
+	// log 与 file 会记录通知正文（含找回密码链接），仅用于本地开发
+	Type string `mapstructure:"type"`
+
+	// FilePath file 渠道的输出文件，每条通知一行 JSON
+	FilePath string `mapstructure:"file_path"`
+
+	SMTP SMTPConfig `mapstructure:"smtp"`
+}
+
+// SMTPConfig SMTP 邮件发送配置
+type SMTPConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"` // 为空时不进行认证
+	Password string `mapstructure:"password"`
+	From     string `mapstructure:"from"` // 发件人地址
+
+	// UseTLS 使用隐式 TLS 连接（如 465 端口）；为 false 时若服务器支持则升级为 STARTTLS
+	UseTLS bool `mapstructure:"use_tls"`
+
+	Timeout time.Duration `mapstructure:"timeout"` // 连接与发送的超时时间
+}
+
+// MenuConfig 菜单配置
+// 相关环境变量：MENU_CLIENT_PRODUCT_LINES
+type MenuConfig struct {
+	// ClientProductLines 客户端标识到菜单产品线的映射，登录时按客户端标识选择菜单树
+	// 例如：{"nurse-mobile": "nurse_app"}；未携带客户端标识时使用 default 产品线
+	ClientProductLines map[string]string `mapstructure:"client_product_lines"`
 }
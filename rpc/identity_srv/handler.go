@@ -6,22 +6,27 @@ import (
 
 	"github.com/masonsxu/cloudwego-microservice-demo/iamclient"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/breakglass"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/datascope"
 	identity_srv "github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/models"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/errno"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/wire"
 )
 
 // IdentityServiceImpl implements the last service interface defined in the IDL.
 type IdentityServiceImpl struct {
-	logic logic.Logic
-	iam   *iamclient.Client
+	logic      logic.Logic
+	iam        *iamclient.Client
+	breakGlass *breakglass.Guard
 }
 
 // NewIdentityServiceImpl 从应用容器创建 IdentityServiceImpl 实例
 func NewIdentityServiceImpl(container *wire.AppContainer) *IdentityServiceImpl {
 	return &IdentityServiceImpl{
-		logic: container.Logic,
-		iam:   container.IAMClient,
+		logic:      container.Logic,
+		iam:        container.IAMClient,
+		breakGlass: container.BreakGlass,
 	}
 }
 
@@ -29,6 +34,7 @@ func NewIdentityServiceImpl(container *wire.AppContainer) *IdentityServiceImpl {
 //
 // 行为：
 //   - Subject 还原失败 → ErrUnauthenticated（Kitex BizStatusError）
+//   - 紧急账号 → 记录审计后放行，不请求 PDP
 //   - PDP 拒绝 → ErrPermissionDenied
 //   - 网络/RPC 错误 → ErrOperationFailed（fail-closed，绝不放行）
 func (s *IdentityServiceImpl) requirePerm(
@@ -41,6 +47,10 @@ func (s *IdentityServiceImpl) requirePerm(
 		return errno.ToKitexError(errno.ErrUnauthenticated.WithMessage(err.Error()))
 	}
 
+	if bypass, err := s.breakGlassBypass(ctx, subject, action, resource); bypass || err != nil {
+		return err
+	}
+
 	if err := subject.MustCheck(ctx, action, resource, opts...); err != nil {
 		if errors.Is(err, iamclient.ErrPermissionDenied) {
 			return errno.ToKitexError(errno.ErrPermissionDenied.WithMessage(err.Error()))
@@ -52,6 +62,27 @@ func (s *IdentityServiceImpl) requirePerm(
 	return nil
 }
 
+// hasPerm 请求 PDP 决策但不中止请求：拒绝时返回 false，供 logic 按权限裁剪可执行的操作。
+//
+// Subject 还原失败 → ErrUnauthenticated；网络/RPC 错误 → ErrOperationFailed（fail-closed）。
+func (s *IdentityServiceImpl) hasPerm(ctx context.Context, action, resource string) (bool, error) {
+	subject, err := s.iam.SubjectFromContext(ctx)
+	if err != nil {
+		return false, errno.ToKitexError(errno.ErrUnauthenticated.WithMessage(err.Error()))
+	}
+
+	if bypass, err := s.breakGlassBypass(ctx, subject, action, resource); bypass || err != nil {
+		return bypass, err
+	}
+
+	decision, err := subject.Check(ctx, action, resource)
+	if err != nil {
+		return false, errno.ToKitexError(errno.ErrOperationFailed.WithMessage("authz failed: " + err.Error()))
+	}
+
+	return decision.Allowed, nil
+}
+
 // requirePermSelfOrCheck 改自己资源（caller_id == targetUserID）直接放行，否则走 PDP。
 //
 // 用于 ChangePassword 等"自己改自己/管理员改他人"双语义场景（提案 §11 Q1 决策 A）。
@@ -68,6 +99,10 @@ func (s *IdentityServiceImpl) requirePermSelfOrCheck(
 		return nil
 	}
 
+	if bypass, err := s.breakGlassBypass(ctx, subject, action, resource); bypass || err != nil {
+		return err
+	}
+
 	if err := subject.MustCheck(ctx, action, resource); err != nil {
 		if errors.Is(err, iamclient.ErrPermissionDenied) {
 			return errno.ToKitexError(errno.ErrPermissionDenied.WithMessage(err.Error()))
@@ -79,6 +114,87 @@ func (s *IdentityServiceImpl) requirePermSelfOrCheck(
 	return nil
 }
 
+// withDataScope 请求 PDP 决策，并把决策给出的数据范围写入 ctx 供列表类 logic 过滤。
+//
+// 行为：
+//   - Subject 还原失败 → ErrUnauthenticated
+//   - 紧急账号 → 记录审计后按不限数据范围放行
+//   - PDP 拒绝：required=true 时 ErrPermissionDenied；否则降级为仅本人数据
+//     （用于原本不做权限检查的列表接口，保证调用方至少能看到自己）
+//   - 网络/RPC 错误 → ErrOperationFailed（fail-closed）
+func (s *IdentityServiceImpl) withDataScope(
+	ctx context.Context,
+	action, resource string,
+	required bool,
+) (context.Context, error) {
+	subject, err := s.iam.SubjectFromContext(ctx)
+	if err != nil {
+		return nil, errno.ToKitexError(errno.ErrUnauthenticated.WithMessage(err.Error()))
+	}
+
+	bypass, err := s.breakGlassBypass(ctx, subject, action, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	if bypass {
+		return datascope.WithScope(ctx, datascope.Scope{
+			Hint:     models.DataScopeAll.String(),
+			UserID:   subject.UserID,
+			TenantID: subject.TenantID,
+			Roles:    subject.Roles,
+		}), nil
+	}
+
+	decision, err := subject.Check(ctx, action, resource)
+	if err != nil {
+		return nil, errno.ToKitexError(errno.ErrOperationFailed.WithMessage("authz failed: " + err.Error()))
+	}
+
+	scope := datascope.Scope{
+		Hint:     decision.DataScopeHint,
+		UserID:   subject.UserID,
+		TenantID: subject.TenantID,
+		Roles:    subject.Roles,
+	}
+
+	if !decision.Allowed {
+		if required {
+			return nil, errno.ToKitexError(errno.ErrPermissionDenied.WithMessage(decision.Reason))
+		}
+
+		scope.Hint = models.DataScopeSelf.String()
+	}
+
+	return datascope.WithScope(ctx, scope), nil
+}
+
+// breakGlassBypass 紧急账号绕过 PDP 决策。
+//
+// 非紧急账号返回 false；紧急账号先写审计日志再放行，审计写入失败 → ErrOperationFailed（不放行）。
+func (s *IdentityServiceImpl) breakGlassBypass(
+	ctx context.Context,
+	subject *iamclient.Subject,
+	action, resource string,
+) (bool, error) {
+	if !s.breakGlass.IsAccount(subject.UserID) {
+		return false, nil
+	}
+
+	if err := s.breakGlass.Record(ctx, breakglass.Access{
+		UserID:    subject.UserID,
+		Username:  subject.UserName,
+		TenantID:  subject.TenantID,
+		RequestID: subject.RequestID,
+		Action:    action,
+		Resource:  resource,
+	}); err != nil {
+		return false, errno.ToKitexError(errno.ErrOperationFailed.WithMessage(err.Error()))
+	}
+
+	return true, nil
+}
+
 // derefStr 安全解引用 *string，nil 时返回空串。
 func derefStr(p *string) string {
 	if p == nil {
@@ -160,6 +276,11 @@ func (s *IdentityServiceImpl) ListUsers(
 	ctx context.Context,
 	req *identity_srv.ListUsersRequest,
 ) (resp *identity_srv.ListUsersResponse, err error) {
+	ctx, err = s.withDataScope(ctx, "read", "user", false)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err = s.logic.ListUsers(ctx, req)
 	if err != nil {
 		return nil, errno.ToKitexError(err)
@@ -173,6 +294,11 @@ func (s *IdentityServiceImpl) SearchUsers(
 	ctx context.Context,
 	req *identity_srv.SearchUsersRequest,
 ) (resp *identity_srv.SearchUsersResponse, err error) {
+	ctx, err = s.withDataScope(ctx, "read", "user", false)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err = s.logic.SearchUsers(ctx, req)
 	if err != nil {
 		return nil, errno.ToKitexError(err)
@@ -213,6 +339,47 @@ func (s *IdentityServiceImpl) UnlockUser(
 	return &identity_srv.UnlockUserResponse{}, nil
 }
 
+// ImportUsers implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) ImportUsers(
+	ctx context.Context,
+	req *identity_srv.ImportUsersRequest,
+) (resp *identity_srv.ImportUsersResponse, err error) {
+	if err := s.requirePerm(ctx, "import", "user"); err != nil {
+		return nil, err
+	}
+
+	// 文件中的角色编码会直接写入角色分配，调用方缺少分配权限时由 logic 拒绝带角色的行
+	canAssignRoles, err := s.hasPerm(ctx, "assign", "role_assignment")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = s.logic.ImportUsers(ctx, req, canAssignRoles)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// ExportUsers implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) ExportUsers(
+	ctx context.Context,
+	req *identity_srv.ExportUsersRequest,
+) (resp *identity_srv.ExportUsersResponse, err error) {
+	ctx, err = s.withDataScope(ctx, "export", "user", true)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = s.logic.ExportUsers(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
 // ===========================================================================
 // Authentication
 // ===========================================================================
@@ -281,6 +448,113 @@ func (s *IdentityServiceImpl) ForcePasswordChange(
 	return &identity_srv.ForcePasswordChangeResponse{}, nil
 }
 
+// EnrollMFA implements the IdentityServiceImpl interface.
+//
+// 与 Login 相同不做 PDP 校验：由网关在已认证会话或登录挑战中代为发起，
+// userID 由网关从可信上下文（JWT 或挑战令牌）确定，不接受客户端指定。
+func (s *IdentityServiceImpl) EnrollMFA(
+	ctx context.Context,
+	req *identity_srv.EnrollMFARequest,
+) (resp *identity_srv.EnrollMFAResponse, err error) {
+	resp, err = s.logic.EnrollMFA(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// ConfirmMFA implements the IdentityServiceImpl interface.
+//
+// 与 EnrollMFA 相同，属于网关登录流程的可信调用。
+func (s *IdentityServiceImpl) ConfirmMFA(
+	ctx context.Context,
+	req *identity_srv.ConfirmMFARequest,
+) (resp *identity_srv.ConfirmMFAResponse, err error) {
+	resp, err = s.logic.ConfirmMFA(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// VerifyMFA implements the IdentityServiceImpl interface.
+//
+// 登录第二步，调用时尚未签发令牌，因此与 Login 一样不做 PDP 校验。
+func (s *IdentityServiceImpl) VerifyMFA(
+	ctx context.Context,
+	req *identity_srv.VerifyMFARequest,
+) (resp *identity_srv.VerifyMFAResponse, err error) {
+	resp, err = s.logic.VerifyMFA(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// DisableMFA implements the IdentityServiceImpl interface.
+//
+// 本人停用直接放行；代他人停用需 PDP 决策 `disable_mfa` on `user:{id}`。
+func (s *IdentityServiceImpl) DisableMFA(
+	ctx context.Context,
+	req *identity_srv.DisableMFARequest,
+) (resp *identity_srv.DisableMFAResponse, err error) {
+	target := derefStr(req.UserID)
+	if err := s.requirePermSelfOrCheck(ctx, target, "disable_mfa", "user:"+target); err != nil {
+		return nil, err
+	}
+
+	if err := s.logic.DisableMFA(ctx, req); err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return &identity_srv.DisableMFAResponse{}, nil
+}
+
+// RequestPasswordReset implements the IdentityServiceImpl interface.
+//
+// 自助找回密码发生在登录前，无操作者身份，不做 PDP 校验。
+func (s *IdentityServiceImpl) RequestPasswordReset(
+	ctx context.Context,
+	req *identity_srv.RequestPasswordResetRequest,
+) (resp *identity_srv.RequestPasswordResetResponse, err error) {
+	if err := s.logic.RequestPasswordReset(ctx, req); err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return &identity_srv.RequestPasswordResetResponse{}, nil
+}
+
+// ConfirmPasswordReset implements the IdentityServiceImpl interface.
+//
+// 持有效令牌即视为本人，不做 PDP 校验。
+func (s *IdentityServiceImpl) ConfirmPasswordReset(
+	ctx context.Context,
+	req *identity_srv.ConfirmPasswordResetRequest,
+) (resp *identity_srv.ConfirmPasswordResetResponse, err error) {
+	userID, err := s.logic.ConfirmPasswordReset(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return &identity_srv.ConfirmPasswordResetResponse{UserID: &userID}, nil
+}
+
+// RefreshSession implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) RefreshSession(
+	ctx context.Context,
+	req *identity_srv.RefreshSessionRequest,
+) (resp *identity_srv.RefreshSessionResponse, err error) {
+	resp, err = s.logic.RefreshSession(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
 // ===========================================================================
 // OrgManagement
 // ===========================================================================
@@ -492,6 +766,11 @@ func (s *IdentityServiceImpl) GetOrganizationDepartments(
 	ctx context.Context,
 	req *identity_srv.GetOrganizationDepartmentsRequest,
 ) (resp *identity_srv.GetOrganizationDepartmentsResponse, err error) {
+	ctx, err = s.withDataScope(ctx, "read", "department", false)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err = s.logic.GetDepartmentsByOrganization(ctx, req)
 	if err != nil {
 		return nil, errno.ToKitexError(err)
@@ -602,6 +881,57 @@ func (s *IdentityServiceImpl) BindLogoToOrganization(
 	return &identity_srv.BindLogoToOrganizationResponse{OrganizationLogo: organizationLogo}, nil
 }
 
+// GetOrganizationPasswordPolicy implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) GetOrganizationPasswordPolicy(
+	ctx context.Context,
+	req *identity_srv.GetOrganizationPasswordPolicyRequest,
+) (resp *identity_srv.GetOrganizationPasswordPolicyResponse, err error) {
+	if err := s.requirePerm(ctx, "read", "organization:"+req.GetOrganizationID()); err != nil {
+		return nil, err
+	}
+
+	policy, err := s.logic.GetOrganizationPasswordPolicy(ctx, req.GetOrganizationID())
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return &identity_srv.GetOrganizationPasswordPolicyResponse{Policy: policy}, nil
+}
+
+// UpdateOrganizationPasswordPolicy implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) UpdateOrganizationPasswordPolicy(
+	ctx context.Context,
+	req *identity_srv.UpdateOrganizationPasswordPolicyRequest,
+) (resp *identity_srv.UpdateOrganizationPasswordPolicyResponse, err error) {
+	if err := s.requirePerm(ctx, "update", "organization:"+req.GetOrganizationID()); err != nil {
+		return nil, err
+	}
+
+	policy, err := s.logic.UpdateOrganizationPasswordPolicy(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return &identity_srv.UpdateOrganizationPasswordPolicyResponse{Policy: policy}, nil
+}
+
+// ResetOrganizationPasswordPolicy implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) ResetOrganizationPasswordPolicy(
+	ctx context.Context,
+	req *identity_srv.ResetOrganizationPasswordPolicyRequest,
+) (resp *identity_srv.ResetOrganizationPasswordPolicyResponse, err error) {
+	if err := s.requirePerm(ctx, "update", "organization:"+req.GetOrganizationID()); err != nil {
+		return nil, err
+	}
+
+	policy, err := s.logic.ResetOrganizationPasswordPolicy(ctx, req.GetOrganizationID())
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return &identity_srv.ResetOrganizationPasswordPolicyResponse{Policy: policy}, nil
+}
+
 // CreateRoleDefinition implements the IdentityServiceImpl interface.
 func (s *IdentityServiceImpl) CreateRoleDefinition(
 	ctx context.Context,
@@ -798,6 +1128,217 @@ func (s *IdentityServiceImpl) BatchGetUserRoles(
 	return resp, nil
 }
 
+// GetUserTenantRoles implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) GetUserTenantRoles(
+	ctx context.Context,
+	req *identity_srv.GetUserTenantRolesRequest,
+) (resp *identity_srv.GetUserTenantRolesResponse, err error) {
+	resp, err = s.logic.GetUserTenantRoles(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// ListRoleConstraintViolations implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) ListRoleConstraintViolations(
+	ctx context.Context,
+	req *identity_srv.ListRoleConstraintViolationsRequest,
+) (resp *identity_srv.ListRoleConstraintViolationsResponse, err error) {
+	if err := s.requirePerm(ctx, "read", "role_assignment"); err != nil {
+		return nil, err
+	}
+
+	resp, err = s.logic.ListRoleConstraintViolations(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// ListRoleGrantRequests implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) ListRoleGrantRequests(
+	ctx context.Context,
+	req *identity_srv.ListRoleGrantRequestsRequest,
+) (resp *identity_srv.ListRoleGrantRequestsResponse, err error) {
+	if err := s.requirePerm(ctx, "read", "role_grant"); err != nil {
+		return nil, err
+	}
+
+	resp, err = s.logic.ListRoleGrantRequests(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// GetRoleGrantRequest implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) GetRoleGrantRequest(
+	ctx context.Context,
+	req *identity_srv.GetRoleGrantRequestRequest,
+) (resp *identity_srv.GetRoleGrantRequestResponse, err error) {
+	if err := s.requirePerm(ctx, "read", "role_grant"); err != nil {
+		return nil, err
+	}
+
+	grantRequest, err := s.logic.GetRoleGrantRequest(ctx, req.GetRequestID())
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return &identity_srv.GetRoleGrantRequestResponse{Request: grantRequest}, nil
+}
+
+// ApproveRoleGrant implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) ApproveRoleGrant(
+	ctx context.Context,
+	req *identity_srv.ApproveRoleGrantRequest,
+) (resp *identity_srv.ApproveRoleGrantResponse, err error) {
+	if err := s.requireGrantApprover(ctx, req.GetRequestID()); err != nil {
+		return nil, err
+	}
+
+	grantRequest, err := s.logic.ApproveRoleGrant(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return &identity_srv.ApproveRoleGrantResponse{Request: grantRequest}, nil
+}
+
+// RejectRoleGrant implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) RejectRoleGrant(
+	ctx context.Context,
+	req *identity_srv.RejectRoleGrantRequest,
+) (resp *identity_srv.RejectRoleGrantResponse, err error) {
+	if err := s.requireGrantApprover(ctx, req.GetRequestID()); err != nil {
+		return nil, err
+	}
+
+	grantRequest, err := s.logic.RejectRoleGrant(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return &identity_srv.RejectRoleGrantResponse{Request: grantRequest}, nil
+}
+
+// requireGrantApprover 审批人由策略决定：需要对申请角色拥有 approve role_grant:<roleID> 权限，
+// 可按角色分别授予审批权，也可用 role_grant:* 授予全部敏感角色的审批权
+func (s *IdentityServiceImpl) requireGrantApprover(ctx context.Context, requestID string) error {
+	grantRequest, err := s.logic.GetRoleGrantRequest(ctx, requestID)
+	if err != nil {
+		return errno.ToKitexError(err)
+	}
+
+	return s.requirePerm(ctx, "approve", "role_grant:"+grantRequest.GetRoleID())
+}
+
+// CreateAccessReviewCampaign implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) CreateAccessReviewCampaign(
+	ctx context.Context,
+	req *identity_srv.CreateAccessReviewCampaignRequest,
+) (resp *identity_srv.CreateAccessReviewCampaignResponse, err error) {
+	if err := s.requirePerm(ctx, "manage", "access_review"); err != nil {
+		return nil, err
+	}
+
+	campaign, err := s.logic.CreateAccessReviewCampaign(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return &identity_srv.CreateAccessReviewCampaignResponse{Campaign: campaign}, nil
+}
+
+// ListAccessReviewCampaigns implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) ListAccessReviewCampaigns(
+	ctx context.Context,
+	req *identity_srv.ListAccessReviewCampaignsRequest,
+) (resp *identity_srv.ListAccessReviewCampaignsResponse, err error) {
+	if err := s.requirePerm(ctx, "read", "access_review"); err != nil {
+		return nil, err
+	}
+
+	resp, err = s.logic.ListAccessReviewCampaigns(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// GetAccessReviewCampaign implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) GetAccessReviewCampaign(
+	ctx context.Context,
+	req *identity_srv.GetAccessReviewCampaignRequest,
+) (resp *identity_srv.GetAccessReviewCampaignResponse, err error) {
+	if err := s.requirePerm(ctx, "read", "access_review"); err != nil {
+		return nil, err
+	}
+
+	campaign, err := s.logic.GetAccessReviewCampaign(ctx, req.GetCampaignID())
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return &identity_srv.GetAccessReviewCampaignResponse{Campaign: campaign}, nil
+}
+
+// ListAccessReviewItems implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) ListAccessReviewItems(
+	ctx context.Context,
+	req *identity_srv.ListAccessReviewItemsRequest,
+) (resp *identity_srv.ListAccessReviewItemsResponse, err error) {
+	if err := s.requirePerm(ctx, "read", "access_review"); err != nil {
+		return nil, err
+	}
+
+	resp, err = s.logic.ListAccessReviewItems(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// DecideAccessReviewItem implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) DecideAccessReviewItem(
+	ctx context.Context,
+	req *identity_srv.DecideAccessReviewItemRequest,
+) (resp *identity_srv.DecideAccessReviewItemResponse, err error) {
+	if err := s.requirePerm(ctx, "review", "access_review"); err != nil {
+		return nil, err
+	}
+
+	item, err := s.logic.DecideAccessReviewItem(ctx, req, s.iam)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return &identity_srv.DecideAccessReviewItemResponse{Item: item}, nil
+}
+
+// CloseAccessReviewCampaign implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) CloseAccessReviewCampaign(
+	ctx context.Context,
+	req *identity_srv.CloseAccessReviewCampaignRequest,
+) (resp *identity_srv.CloseAccessReviewCampaignResponse, err error) {
+	if err := s.requirePerm(ctx, "manage", "access_review"); err != nil {
+		return nil, err
+	}
+
+	campaign, err := s.logic.CloseAccessReviewCampaign(ctx, req, s.iam)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return &identity_srv.CloseAccessReviewCampaignResponse{Campaign: campaign}, nil
+}
+
 // UploadMenu implements the IdentityServiceImpl interface.
 func (s *IdentityServiceImpl) UploadMenu(
 	ctx context.Context,
@@ -807,19 +1348,71 @@ func (s *IdentityServiceImpl) UploadMenu(
 		return nil, err
 	}
 
-	if err := s.logic.UploadMenu(ctx, req); err != nil {
+	resp, err = s.logic.UploadMenu(ctx, req)
+	if err != nil {
 		return nil, errno.ToKitexError(err)
 	}
 
-	return &identity_srv.UploadMenuResponse{}, nil
+	return resp, nil
 }
 
 // GetMenuTree implements the IdentityServiceImpl interface.
 func (s *IdentityServiceImpl) GetMenuTree(
 	ctx context.Context,
-	_ *identity_srv.GetMenuTreeRequest,
+	req *identity_srv.GetMenuTreeRequest,
 ) (resp *identity_srv.GetMenuTreeResponse, err error) {
-	resp, err = s.logic.GetMenuTree(ctx)
+	resp, err = s.logic.GetMenuTree(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// ListMenuVersions implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) ListMenuVersions(
+	ctx context.Context,
+	req *identity_srv.ListMenuVersionsRequest,
+) (resp *identity_srv.ListMenuVersionsResponse, err error) {
+	if err := s.requirePerm(ctx, "read", "menu"); err != nil {
+		return nil, err
+	}
+
+	resp, err = s.logic.ListMenuVersions(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// DiffMenuVersions implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) DiffMenuVersions(
+	ctx context.Context,
+	req *identity_srv.DiffMenuVersionsRequest,
+) (resp *identity_srv.DiffMenuVersionsResponse, err error) {
+	if err := s.requirePerm(ctx, "read", "menu"); err != nil {
+		return nil, err
+	}
+
+	resp, err = s.logic.DiffMenuVersions(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// ActivateMenuVersion implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) ActivateMenuVersion(
+	ctx context.Context,
+	req *identity_srv.ActivateMenuVersionRequest,
+) (resp *identity_srv.ActivateMenuVersionResponse, err error) {
+	if err := s.requirePerm(ctx, "activate", "menu"); err != nil {
+		return nil, err
+	}
+
+	resp, err = s.logic.ActivateMenuVersion(ctx, req)
 	if err != nil {
 		return nil, errno.ToKitexError(err)
 	}
@@ -909,6 +1502,57 @@ func (s *IdentityServiceImpl) GetUserMenuPermissions(
 	return resp, nil
 }
 
+// GetPermissionMatrix implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) GetPermissionMatrix(
+	ctx context.Context,
+	req *identity_srv.GetPermissionMatrixRequest,
+) (resp *identity_srv.GetPermissionMatrixResponse, err error) {
+	if err := s.requirePerm(ctx, "read", "permission_report"); err != nil {
+		return nil, err
+	}
+
+	resp, err = s.logic.GetPermissionMatrix(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// GetUserEffectivePermissions implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) GetUserEffectivePermissions(
+	ctx context.Context,
+	req *identity_srv.GetUserEffectivePermissionsRequest,
+) (resp *identity_srv.GetUserEffectivePermissionsResponse, err error) {
+	if err := s.requirePerm(ctx, "read", "permission_report"); err != nil {
+		return nil, err
+	}
+
+	resp, err = s.logic.GetUserEffectivePermissions(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// ExportPermissionReport implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) ExportPermissionReport(
+	ctx context.Context,
+	req *identity_srv.ExportPermissionReportRequest,
+) (resp *identity_srv.ExportPermissionReportResponse, err error) {
+	if err := s.requirePerm(ctx, "export", "permission_report"); err != nil {
+		return nil, err
+	}
+
+	resp, err = s.logic.ExportPermissionReport(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
 // CreateAuditLog implements the IdentityServiceImpl interface.
 func (s *IdentityServiceImpl) CreateAuditLog(
 	ctx context.Context,
@@ -926,7 +1570,8 @@ func (s *IdentityServiceImpl) ListAuditLogs(
 	ctx context.Context,
 	req *identity_srv.ListAuditLogsRequest,
 ) (resp *identity_srv.ListAuditLogsResponse, err error) {
-	if err := s.requirePerm(ctx, "read", "audit_log"); err != nil {
+	ctx, err = s.withDataScope(ctx, "read", "audit_log", true)
+	if err != nil {
 		return nil, err
 	}
 
@@ -937,3 +1582,85 @@ func (s *IdentityServiceImpl) ListAuditLogs(
 
 	return resp, nil
 }
+
+// ListDeletedEntities implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) ListDeletedEntities(
+	ctx context.Context,
+	req *identity_srv.ListDeletedEntitiesRequest,
+) (resp *identity_srv.ListDeletedEntitiesResponse, err error) {
+	resource, err := recycleBinResource(req.GetEntityType())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = s.withDataScope(ctx, "read_deleted", resource, true)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = s.logic.ListDeletedEntities(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// RestoreDeletedEntity implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) RestoreDeletedEntity(
+	ctx context.Context,
+	req *identity_srv.RestoreDeletedEntityRequest,
+) (resp *identity_srv.RestoreDeletedEntityResponse, err error) {
+	resource, err := recycleBinResource(req.GetEntityType())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = s.withDataScope(ctx, "restore", resource+":"+req.GetId(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = s.logic.RestoreDeletedEntity(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// PurgeDeletedEntity implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) PurgeDeletedEntity(
+	ctx context.Context,
+	req *identity_srv.PurgeDeletedEntityRequest,
+) (resp *identity_srv.PurgeDeletedEntityResponse, err error) {
+	resource, err := recycleBinResource(req.GetEntityType())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = s.withDataScope(ctx, "purge", resource+":"+req.GetId(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.logic.PurgeDeletedEntity(ctx, req); err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return &identity_srv.PurgeDeletedEntityResponse{}, nil
+}
+
+// recycleBinResource 回收站条目类型对应的 PDP 资源名，与各实体删除动作使用的资源一致
+func recycleBinResource(entityType identity_srv.RecycleBinEntityType) (string, error) {
+	switch entityType {
+	case identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_USER:
+		return "user", nil
+	case identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_ORGANIZATION:
+		return "organization", nil
+	case identity_srv.RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_DEPARTMENT:
+		return "department", nil
+	default:
+		return "", errno.ToKitexError(errno.ErrInvalidParams.WithMessage("不支持的回收站类型"))
+	}
+}
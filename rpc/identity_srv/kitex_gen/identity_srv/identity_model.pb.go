@@ -13,34 +13,55 @@ import (
 type AuditAction int32
 
 const (
-	AuditAction_AUDIT_ACTION_UNSPECIFIED     AuditAction = 0
-	AuditAction_AUDIT_ACTION_CREATE          AuditAction = 1
-	AuditAction_AUDIT_ACTION_UPDATE          AuditAction = 2
-	AuditAction_AUDIT_ACTION_DELETE          AuditAction = 3
-	AuditAction_AUDIT_ACTION_LOGIN           AuditAction = 4
-	AuditAction_AUDIT_ACTION_LOGOUT          AuditAction = 5
-	AuditAction_AUDIT_ACTION_PASSWORD_CHANGE AuditAction = 6
+	AuditAction_AUDIT_ACTION_UNSPECIFIED            AuditAction = 0
+	AuditAction_AUDIT_ACTION_CREATE                 AuditAction = 1
+	AuditAction_AUDIT_ACTION_UPDATE                 AuditAction = 2
+	AuditAction_AUDIT_ACTION_DELETE                 AuditAction = 3
+	AuditAction_AUDIT_ACTION_LOGIN                  AuditAction = 4
+	AuditAction_AUDIT_ACTION_LOGOUT                 AuditAction = 5
+	AuditAction_AUDIT_ACTION_PASSWORD_CHANGE        AuditAction = 6
+	AuditAction_AUDIT_ACTION_BREAK_GLASS            AuditAction = 7
+	AuditAction_AUDIT_ACTION_ROLE_GRANT_REQUEST     AuditAction = 8
+	AuditAction_AUDIT_ACTION_ROLE_GRANT_APPROVE     AuditAction = 9
+	AuditAction_AUDIT_ACTION_ROLE_GRANT_REJECT      AuditAction = 10
+	AuditAction_AUDIT_ACTION_ROLE_GRANT_EXPIRE      AuditAction = 11
+	AuditAction_AUDIT_ACTION_PASSWORD_RESET_REQUEST AuditAction = 12
+	AuditAction_AUDIT_ACTION_PASSWORD_RESET         AuditAction = 13
 )
 
 // Enum value maps for AuditAction.
 var AuditAction_name = map[int32]string{
-	0: "AUDIT_ACTION_UNSPECIFIED",
-	1: "AUDIT_ACTION_CREATE",
-	2: "AUDIT_ACTION_UPDATE",
-	3: "AUDIT_ACTION_DELETE",
-	4: "AUDIT_ACTION_LOGIN",
-	5: "AUDIT_ACTION_LOGOUT",
-	6: "AUDIT_ACTION_PASSWORD_CHANGE",
+	0:  "AUDIT_ACTION_UNSPECIFIED",
+	1:  "AUDIT_ACTION_CREATE",
+	2:  "AUDIT_ACTION_UPDATE",
+	3:  "AUDIT_ACTION_DELETE",
+	4:  "AUDIT_ACTION_LOGIN",
+	5:  "AUDIT_ACTION_LOGOUT",
+	6:  "AUDIT_ACTION_PASSWORD_CHANGE",
+	7:  "AUDIT_ACTION_BREAK_GLASS",
+	8:  "AUDIT_ACTION_ROLE_GRANT_REQUEST",
+	9:  "AUDIT_ACTION_ROLE_GRANT_APPROVE",
+	10: "AUDIT_ACTION_ROLE_GRANT_REJECT",
+	11: "AUDIT_ACTION_ROLE_GRANT_EXPIRE",
+	12: "AUDIT_ACTION_PASSWORD_RESET_REQUEST",
+	13: "AUDIT_ACTION_PASSWORD_RESET",
 }
 
 var AuditAction_value = map[string]int32{
-	"AUDIT_ACTION_UNSPECIFIED":     0,
-	"AUDIT_ACTION_CREATE":          1,
-	"AUDIT_ACTION_UPDATE":          2,
-	"AUDIT_ACTION_DELETE":          3,
-	"AUDIT_ACTION_LOGIN":           4,
-	"AUDIT_ACTION_LOGOUT":          5,
-	"AUDIT_ACTION_PASSWORD_CHANGE": 6,
+	"AUDIT_ACTION_UNSPECIFIED":            0,
+	"AUDIT_ACTION_CREATE":                 1,
+	"AUDIT_ACTION_UPDATE":                 2,
+	"AUDIT_ACTION_DELETE":                 3,
+	"AUDIT_ACTION_LOGIN":                  4,
+	"AUDIT_ACTION_LOGOUT":                 5,
+	"AUDIT_ACTION_PASSWORD_CHANGE":        6,
+	"AUDIT_ACTION_BREAK_GLASS":            7,
+	"AUDIT_ACTION_ROLE_GRANT_REQUEST":     8,
+	"AUDIT_ACTION_ROLE_GRANT_APPROVE":     9,
+	"AUDIT_ACTION_ROLE_GRANT_REJECT":      10,
+	"AUDIT_ACTION_ROLE_GRANT_EXPIRE":      11,
+	"AUDIT_ACTION_PASSWORD_RESET_REQUEST": 12,
+	"AUDIT_ACTION_PASSWORD_RESET":         13,
 }
 
 func (x AuditAction) String() string {
@@ -147,6 +168,326 @@ func (x OrganizationLogoStatus) String() string {
 	return strconv.Itoa(int(x))
 }
 
+// 回收站实体类型。
+type RecycleBinEntityType int32
+
+const (
+	RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_UNSPECIFIED  RecycleBinEntityType = 0
+	RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_USER         RecycleBinEntityType = 1
+	RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_ORGANIZATION RecycleBinEntityType = 2
+	RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_DEPARTMENT   RecycleBinEntityType = 3
+)
+
+// Enum value maps for RecycleBinEntityType.
+var RecycleBinEntityType_name = map[int32]string{
+	0: "RECYCLE_BIN_ENTITY_TYPE_UNSPECIFIED",
+	1: "RECYCLE_BIN_ENTITY_TYPE_USER",
+	2: "RECYCLE_BIN_ENTITY_TYPE_ORGANIZATION",
+	3: "RECYCLE_BIN_ENTITY_TYPE_DEPARTMENT",
+}
+
+var RecycleBinEntityType_value = map[string]int32{
+	"RECYCLE_BIN_ENTITY_TYPE_UNSPECIFIED":  0,
+	"RECYCLE_BIN_ENTITY_TYPE_USER":         1,
+	"RECYCLE_BIN_ENTITY_TYPE_ORGANIZATION": 2,
+	"RECYCLE_BIN_ENTITY_TYPE_DEPARTMENT":   3,
+}
+
+func (x RecycleBinEntityType) String() string {
+	s, ok := RecycleBinEntityType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+
+// 导入/导出的文件格式（用户批量导入导出、权限报告导出）。
+type UserFileFormat int32
+
+const (
+	UserFileFormat_USER_FILE_FORMAT_UNSPECIFIED UserFileFormat = 0
+	UserFileFormat_USER_FILE_FORMAT_CSV         UserFileFormat = 1
+	UserFileFormat_USER_FILE_FORMAT_XLSX        UserFileFormat = 2
+)
+
+// Enum value maps for UserFileFormat.
+var UserFileFormat_name = map[int32]string{
+	0: "USER_FILE_FORMAT_UNSPECIFIED",
+	1: "USER_FILE_FORMAT_CSV",
+	2: "USER_FILE_FORMAT_XLSX",
+}
+
+var UserFileFormat_value = map[string]int32{
+	"USER_FILE_FORMAT_UNSPECIFIED": 0,
+	"USER_FILE_FORMAT_CSV":         1,
+	"USER_FILE_FORMAT_XLSX":        2,
+}
+
+func (x UserFileFormat) String() string {
+	s, ok := UserFileFormat_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+
+// 菜单版本差异类型。
+type MenuChangeType int32
+
+const (
+	MenuChangeType_MENU_CHANGE_TYPE_UNSPECIFIED MenuChangeType = 0
+	MenuChangeType_MENU_CHANGE_TYPE_ADDED       MenuChangeType = 1
+	MenuChangeType_MENU_CHANGE_TYPE_REMOVED     MenuChangeType = 2
+
+	// 父节点变化
+	MenuChangeType_MENU_CHANGE_TYPE_MOVED MenuChangeType = 3
+
+	// 显示名称变化
+	MenuChangeType_MENU_CHANGE_TYPE_RENAMED MenuChangeType = 4
+
+	// 路径、组件、图标、权限编码、API 路径或排序等其他字段变化
+	MenuChangeType_MENU_CHANGE_TYPE_MODIFIED MenuChangeType = 5
+)
+
+// Enum value maps for MenuChangeType.
+var MenuChangeType_name = map[int32]string{
+	0: "MENU_CHANGE_TYPE_UNSPECIFIED",
+	1: "MENU_CHANGE_TYPE_ADDED",
+	2: "MENU_CHANGE_TYPE_REMOVED",
+	3: "MENU_CHANGE_TYPE_MOVED",
+	4: "MENU_CHANGE_TYPE_RENAMED",
+	5: "MENU_CHANGE_TYPE_MODIFIED",
+}
+
+var MenuChangeType_value = map[string]int32{
+	"MENU_CHANGE_TYPE_UNSPECIFIED": 0,
+	"MENU_CHANGE_TYPE_ADDED":       1,
+	"MENU_CHANGE_TYPE_REMOVED":     2,
+	"MENU_CHANGE_TYPE_MOVED":       3,
+	"MENU_CHANGE_TYPE_RENAMED":     4,
+	"MENU_CHANGE_TYPE_MODIFIED":    5,
+}
+
+func (x MenuChangeType) String() string {
+	s, ok := MenuChangeType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+
+// 权限报告中授权的来源。
+type PermissionGrantSource int32
+
+const (
+	PermissionGrantSource_PERMISSION_GRANT_SOURCE_UNSPECIFIED PermissionGrantSource = 0
+
+	// 角色菜单授权（role_menu_permissions）
+	PermissionGrantSource_PERMISSION_GRANT_SOURCE_MENU PermissionGrantSource = 1
+
+	// policy_srv 中的策略规则
+	PermissionGrantSource_PERMISSION_GRANT_SOURCE_POLICY PermissionGrantSource = 2
+)
+
+// Enum value maps for PermissionGrantSource.
+var PermissionGrantSource_name = map[int32]string{
+	0: "PERMISSION_GRANT_SOURCE_UNSPECIFIED",
+	1: "PERMISSION_GRANT_SOURCE_MENU",
+	2: "PERMISSION_GRANT_SOURCE_POLICY",
+}
+
+var PermissionGrantSource_value = map[string]int32{
+	"PERMISSION_GRANT_SOURCE_UNSPECIFIED": 0,
+	"PERMISSION_GRANT_SOURCE_MENU":        1,
+	"PERMISSION_GRANT_SOURCE_POLICY":      2,
+}
+
+func (x PermissionGrantSource) String() string {
+	s, ok := PermissionGrantSource_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+
+// 权限报告的视图。
+type PermissionReportView int32
+
+const (
+	PermissionReportView_PERMISSION_REPORT_VIEW_UNSPECIFIED PermissionReportView = 0
+
+	// 角色 × 菜单/策略权限矩阵
+	PermissionReportView_PERMISSION_REPORT_VIEW_ROLE PermissionReportView = 1
+
+	// 用户在各租户下的有效权限
+	PermissionReportView_PERMISSION_REPORT_VIEW_USER PermissionReportView = 2
+)
+
+// Enum value maps for PermissionReportView.
+var PermissionReportView_name = map[int32]string{
+	0: "PERMISSION_REPORT_VIEW_UNSPECIFIED",
+	1: "PERMISSION_REPORT_VIEW_ROLE",
+	2: "PERMISSION_REPORT_VIEW_USER",
+}
+
+var PermissionReportView_value = map[string]int32{
+	"PERMISSION_REPORT_VIEW_UNSPECIFIED": 0,
+	"PERMISSION_REPORT_VIEW_ROLE":        1,
+	"PERMISSION_REPORT_VIEW_USER":        2,
+}
+
+func (x PermissionReportView) String() string {
+	s, ok := PermissionReportView_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+
+// 角色约束类型。
+type RoleConstraintType int32
+
+const (
+	RoleConstraintType_ROLE_CONSTRAINT_TYPE_UNSPECIFIED RoleConstraintType = 0
+
+	// 用户同时持有同一互斥组内的多个角色
+	RoleConstraintType_ROLE_CONSTRAINT_TYPE_EXCLUSIVE RoleConstraintType = 1
+
+	// 持有角色的用户数超过上限
+	RoleConstraintType_ROLE_CONSTRAINT_TYPE_MAX_ASSIGNMENTS RoleConstraintType = 2
+)
+
+// Enum value maps for RoleConstraintType.
+var RoleConstraintType_name = map[int32]string{
+	0: "ROLE_CONSTRAINT_TYPE_UNSPECIFIED",
+	1: "ROLE_CONSTRAINT_TYPE_EXCLUSIVE",
+	2: "ROLE_CONSTRAINT_TYPE_MAX_ASSIGNMENTS",
+}
+
+var RoleConstraintType_value = map[string]int32{
+	"ROLE_CONSTRAINT_TYPE_UNSPECIFIED":     0,
+	"ROLE_CONSTRAINT_TYPE_EXCLUSIVE":       1,
+	"ROLE_CONSTRAINT_TYPE_MAX_ASSIGNMENTS": 2,
+}
+
+func (x RoleConstraintType) String() string {
+	s, ok := RoleConstraintType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+
+// 敏感角色授权申请状态。
+type RoleGrantRequestStatus int32
+
+const (
+	RoleGrantRequestStatus_ROLE_GRANT_REQUEST_STATUS_UNSPECIFIED RoleGrantRequestStatus = 0
+	RoleGrantRequestStatus_ROLE_GRANT_REQUEST_STATUS_PENDING     RoleGrantRequestStatus = 1
+	RoleGrantRequestStatus_ROLE_GRANT_REQUEST_STATUS_APPROVED    RoleGrantRequestStatus = 2
+	RoleGrantRequestStatus_ROLE_GRANT_REQUEST_STATUS_REJECTED    RoleGrantRequestStatus = 3
+
+	// 超过审批期限未处理
+	RoleGrantRequestStatus_ROLE_GRANT_REQUEST_STATUS_EXPIRED RoleGrantRequestStatus = 4
+)
+
+// Enum value maps for RoleGrantRequestStatus.
+var RoleGrantRequestStatus_name = map[int32]string{
+	0: "ROLE_GRANT_REQUEST_STATUS_UNSPECIFIED",
+	1: "ROLE_GRANT_REQUEST_STATUS_PENDING",
+	2: "ROLE_GRANT_REQUEST_STATUS_APPROVED",
+	3: "ROLE_GRANT_REQUEST_STATUS_REJECTED",
+	4: "ROLE_GRANT_REQUEST_STATUS_EXPIRED",
+}
+
+var RoleGrantRequestStatus_value = map[string]int32{
+	"ROLE_GRANT_REQUEST_STATUS_UNSPECIFIED": 0,
+	"ROLE_GRANT_REQUEST_STATUS_PENDING":     1,
+	"ROLE_GRANT_REQUEST_STATUS_APPROVED":    2,
+	"ROLE_GRANT_REQUEST_STATUS_REJECTED":    3,
+	"ROLE_GRANT_REQUEST_STATUS_EXPIRED":     4,
+}
+
+func (x RoleGrantRequestStatus) String() string {
+	s, ok := RoleGrantRequestStatus_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+
+// 访问复核活动状态。
+type AccessReviewCampaignStatus int32
+
+const (
+	AccessReviewCampaignStatus_ACCESS_REVIEW_CAMPAIGN_STATUS_UNSPECIFIED AccessReviewCampaignStatus = 0
+	AccessReviewCampaignStatus_ACCESS_REVIEW_CAMPAIGN_STATUS_OPEN        AccessReviewCampaignStatus = 1
+	AccessReviewCampaignStatus_ACCESS_REVIEW_CAMPAIGN_STATUS_CLOSED      AccessReviewCampaignStatus = 2
+)
+
+// Enum value maps for AccessReviewCampaignStatus.
+var AccessReviewCampaignStatus_name = map[int32]string{
+	0: "ACCESS_REVIEW_CAMPAIGN_STATUS_UNSPECIFIED",
+	1: "ACCESS_REVIEW_CAMPAIGN_STATUS_OPEN",
+	2: "ACCESS_REVIEW_CAMPAIGN_STATUS_CLOSED",
+}
+
+var AccessReviewCampaignStatus_value = map[string]int32{
+	"ACCESS_REVIEW_CAMPAIGN_STATUS_UNSPECIFIED": 0,
+	"ACCESS_REVIEW_CAMPAIGN_STATUS_OPEN":        1,
+	"ACCESS_REVIEW_CAMPAIGN_STATUS_CLOSED":      2,
+}
+
+func (x AccessReviewCampaignStatus) String() string {
+	s, ok := AccessReviewCampaignStatus_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+
+// 访问复核条目的复核结论。
+type AccessReviewDecision int32
+
+const (
+	AccessReviewDecision_ACCESS_REVIEW_DECISION_UNSPECIFIED AccessReviewDecision = 0
+	AccessReviewDecision_ACCESS_REVIEW_DECISION_PENDING     AccessReviewDecision = 1
+
+	// 确认保留该角色分配
+	AccessReviewDecision_ACCESS_REVIEW_DECISION_CERTIFIED AccessReviewDecision = 2
+
+	// 复核人撤销该角色分配
+	AccessReviewDecision_ACCESS_REVIEW_DECISION_REVOKED AccessReviewDecision = 3
+
+	// 活动关闭时仍未复核，按活动配置自动撤销
+	AccessReviewDecision_ACCESS_REVIEW_DECISION_AUTO_REVOKED AccessReviewDecision = 4
+)
+
+// Enum value maps for AccessReviewDecision.
+var AccessReviewDecision_name = map[int32]string{
+	0: "ACCESS_REVIEW_DECISION_UNSPECIFIED",
+	1: "ACCESS_REVIEW_DECISION_PENDING",
+	2: "ACCESS_REVIEW_DECISION_CERTIFIED",
+	3: "ACCESS_REVIEW_DECISION_REVOKED",
+	4: "ACCESS_REVIEW_DECISION_AUTO_REVOKED",
+}
+
+var AccessReviewDecision_value = map[string]int32{
+	"ACCESS_REVIEW_DECISION_UNSPECIFIED":  0,
+	"ACCESS_REVIEW_DECISION_PENDING":      1,
+	"ACCESS_REVIEW_DECISION_CERTIFIED":    2,
+	"ACCESS_REVIEW_DECISION_REVOKED":      3,
+	"ACCESS_REVIEW_DECISION_AUTO_REVOKED": 4,
+}
+
+func (x AccessReviewDecision) String() string {
+	s, ok := AccessReviewDecision_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+
 // 审计日志。
 type AuditLog struct {
 	Id             *string      `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
@@ -318,6 +659,9 @@ type UserProfile struct {
 	OidcName     *string `protobuf:"bytes,27,opt,name=oidcName" json:"oidcName,omitempty"`
 	OidcPicture  *string `protobuf:"bytes,28,opt,name=oidcPicture" json:"oidcPicture,omitempty"`
 	OidcAuthTime *int64  `protobuf:"varint,29,opt,name=oidcAuthTime" json:"oidcAuthTime,omitempty"`
+
+	// 乐观锁版本号，更新时需原样回传
+	Version *int64 `protobuf:"varint,30,opt,name=version" json:"version,omitempty"`
 }
 
 func (x *UserProfile) Reset() { *x = UserProfile{} }
@@ -529,6 +873,13 @@ func (x *UserProfile) GetOidcAuthTime() int64 {
 	return 0
 }
 
+func (x *UserProfile) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
 // 用户成员关系。
 type UserMembership struct {
 	Id             *string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
@@ -538,6 +889,10 @@ type UserMembership struct {
 	IsPrimary      *bool   `protobuf:"varint,5,opt,name=isPrimary" json:"isPrimary,omitempty"`
 	CreatedAt      *int64  `protobuf:"varint,6,opt,name=createdAt" json:"createdAt,omitempty"`
 	UpdatedAt      *int64  `protobuf:"varint,7,opt,name=updatedAt" json:"updatedAt,omitempty"`
+	Version        *int64  `protobuf:"varint,8,opt,name=version" json:"version,omitempty"`
+
+	// 所属组织名称，仅在 GetUserMemberships 请求 withOrganizationName 时填充
+	OrganizationName *string `protobuf:"bytes,9,opt,name=organizationName" json:"organizationName,omitempty"`
 }
 
 func (x *UserMembership) Reset() { *x = UserMembership{} }
@@ -595,6 +950,20 @@ func (x *UserMembership) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *UserMembership) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *UserMembership) GetOrganizationName() string {
+	if x != nil && x.OrganizationName != nil {
+		return *x.OrganizationName
+	}
+	return ""
+}
+
 // 组织。
 type Organization struct {
 	Id                  *string  `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
@@ -608,6 +977,12 @@ type Organization struct {
 	CreatedAt           *int64   `protobuf:"varint,9,opt,name=createdAt" json:"createdAt,omitempty"`
 	UpdatedAt           *int64   `protobuf:"varint,10,opt,name=updatedAt" json:"updatedAt,omitempty"`
 	LogoID              *string  `protobuf:"bytes,15,opt,name=logoID" json:"logoID,omitempty"`
+
+	// 成员登录是否必须启用多因素认证
+	MfaRequired *bool `protobuf:"varint,16,opt,name=mfaRequired" json:"mfaRequired,omitempty"`
+
+	// 乐观锁版本号，更新时需原样回传
+	Version *int64 `protobuf:"varint,17,opt,name=version" json:"version,omitempty"`
 }
 
 func (x *Organization) Reset() { *x = Organization{} }
@@ -693,6 +1068,20 @@ func (x *Organization) GetLogoID() string {
 	return ""
 }
 
+func (x *Organization) GetMfaRequired() bool {
+	if x != nil && x.MfaRequired != nil {
+		return *x.MfaRequired
+	}
+	return false
+}
+
+func (x *Organization) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
 // 部门。
 type Department struct {
 	Id                 *string  `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
@@ -703,6 +1092,9 @@ type Department struct {
 	AvailableEquipment []string `protobuf:"bytes,6,rep,name=availableEquipment" json:"availableEquipment,omitempty"`
 	CreatedAt          *int64   `protobuf:"varint,7,opt,name=createdAt" json:"createdAt,omitempty"`
 	UpdatedAt          *int64   `protobuf:"varint,8,opt,name=updatedAt" json:"updatedAt,omitempty"`
+
+	// 乐观锁版本号，更新时需原样回传
+	Version *int64 `protobuf:"varint,9,opt,name=version" json:"version,omitempty"`
 }
 
 func (x *Department) Reset() { *x = Department{} }
@@ -767,6 +1159,13 @@ func (x *Department) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *Department) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
 // 组织 Logo。
 type OrganizationLogo struct {
 	Id                  *string                 `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
@@ -781,6 +1180,7 @@ type OrganizationLogo struct {
 	UploadedBy          *string                 `protobuf:"bytes,10,opt,name=uploadedBy" json:"uploadedBy,omitempty"`
 	CreatedAt           *int64                  `protobuf:"varint,11,opt,name=createdAt" json:"createdAt,omitempty"`
 	UpdatedAt           *int64                  `protobuf:"varint,12,opt,name=updatedAt" json:"updatedAt,omitempty"`
+	Version             *int64                  `protobuf:"varint,13,opt,name=version" json:"version,omitempty"`
 }
 
 func (x *OrganizationLogo) Reset() { *x = OrganizationLogo{} }
@@ -873,6 +1273,90 @@ func (x *OrganizationLogo) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *OrganizationLogo) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+// 组织密码策略：未单独配置的组织沿用全局默认策略（inherited 为 true）。
+// 禁用密码字典为全局配置，不区分组织。
+type OrganizationPasswordPolicy struct {
+	OrganizationID *string `protobuf:"bytes,1,opt,name=organizationID" json:"organizationID,omitempty"`
+
+	// 最短长度（按字符计，不低于 6）
+	MinLength *int32 `protobuf:"varint,2,opt,name=minLength" json:"minLength,omitempty"`
+
+	// 至少包含的字符种类数：大写字母、小写字母、数字、特殊字符（0-4）
+	MinCharClasses *int32 `protobuf:"varint,3,opt,name=minCharClasses" json:"minCharClasses,omitempty"`
+
+	// 禁止复用最近 N 个密码（含当前密码），0 表示不检查
+	HistorySize *int32 `protobuf:"varint,4,opt,name=historySize" json:"historySize,omitempty"`
+
+	// 密码最长使用期限（小时），0 表示永不过期
+	MaxAgeHours *int32 `protobuf:"varint,5,opt,name=maxAgeHours" json:"maxAgeHours,omitempty"`
+	Inherited   *bool  `protobuf:"varint,6,opt,name=inherited" json:"inherited,omitempty"`
+	UpdatedAt   *int64 `protobuf:"varint,7,opt,name=updatedAt" json:"updatedAt,omitempty"`
+}
+
+func (x *OrganizationPasswordPolicy) Reset() { *x = OrganizationPasswordPolicy{} }
+
+func (x *OrganizationPasswordPolicy) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *OrganizationPasswordPolicy) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *OrganizationPasswordPolicy) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *OrganizationPasswordPolicy) GetMinLength() int32 {
+	if x != nil && x.MinLength != nil {
+		return *x.MinLength
+	}
+	return 0
+}
+
+func (x *OrganizationPasswordPolicy) GetMinCharClasses() int32 {
+	if x != nil && x.MinCharClasses != nil {
+		return *x.MinCharClasses
+	}
+	return 0
+}
+
+func (x *OrganizationPasswordPolicy) GetHistorySize() int32 {
+	if x != nil && x.HistorySize != nil {
+		return *x.HistorySize
+	}
+	return 0
+}
+
+func (x *OrganizationPasswordPolicy) GetMaxAgeHours() int32 {
+	if x != nil && x.MaxAgeHours != nil {
+		return *x.MaxAgeHours
+	}
+	return 0
+}
+
+func (x *OrganizationPasswordPolicy) GetInherited() bool {
+	if x != nil && x.Inherited != nil {
+		return *x.Inherited
+	}
+	return false
+}
+
+func (x *OrganizationPasswordPolicy) GetUpdatedAt() int64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
+}
+
 // 权限。
 type Permission struct {
 	Resource    *string `protobuf:"bytes,1,opt,name=resource" json:"resource,omitempty"`
@@ -924,6 +1408,18 @@ type RoleDefinition struct {
 	ParentRoleID *string          `protobuf:"bytes,14,opt,name=parentRoleID" json:"parentRoleID,omitempty"`
 	DepartmentID *string          `protobuf:"bytes,15,opt,name=departmentID" json:"departmentID,omitempty"`
 	DefaultScope *DataScope       `protobuf:"varint,16,opt,name=defaultScope" json:"defaultScope,omitempty"`
+
+	// 乐观锁版本号，更新时需原样回传
+	Version *int64 `protobuf:"varint,17,opt,name=version" json:"version,omitempty"`
+
+	// 职责分离：互斥角色组，同组角色不能被同一用户同时持有
+	ExclusiveGroups []string `protobuf:"bytes,18,rep,name=exclusiveGroups" json:"exclusiveGroups,omitempty"`
+
+	// 职责分离：最多可分配的用户数，0 表示不限
+	MaxAssignments *int32 `protobuf:"varint,19,opt,name=maxAssignments" json:"maxAssignments,omitempty"`
+
+	// 分配该角色需经审批；系统角色总是需要审批
+	RequiresApproval *bool `protobuf:"varint,20,opt,name=requiresApproval" json:"requiresApproval,omitempty"`
 }
 
 func (x *RoleDefinition) Reset() { *x = RoleDefinition{} }
@@ -1037,15 +1533,51 @@ func (x *RoleDefinition) GetDefaultScope() DataScope {
 	return DataScope_DATA_SCOPE_UNSPECIFIED
 }
 
+func (x *RoleDefinition) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *RoleDefinition) GetExclusiveGroups() []string {
+	if x != nil {
+		return x.ExclusiveGroups
+	}
+	return nil
+}
+
+func (x *RoleDefinition) GetMaxAssignments() int32 {
+	if x != nil && x.MaxAssignments != nil {
+		return *x.MaxAssignments
+	}
+	return 0
+}
+
+func (x *RoleDefinition) GetRequiresApproval() bool {
+	if x != nil && x.RequiresApproval != nil {
+		return *x.RequiresApproval
+	}
+	return false
+}
+
 // 用户角色分配。
 type UserRoleAssignment struct {
-	Id        *string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	UserID    *string `protobuf:"bytes,2,opt,name=userID" json:"userID,omitempty"`
-	RoleID    *string `protobuf:"bytes,3,opt,name=roleID" json:"roleID,omitempty"`
-	CreatedBy *string `protobuf:"bytes,11,opt,name=createdBy" json:"createdBy,omitempty"`
-	UpdatedBy *string `protobuf:"bytes,12,opt,name=updatedBy" json:"updatedBy,omitempty"`
-	CreatedAt *int64  `protobuf:"varint,13,opt,name=createdAt" json:"createdAt,omitempty"`
-	UpdatedAt *int64  `protobuf:"varint,14,opt,name=updatedAt" json:"updatedAt,omitempty"`
+	Id     *string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	UserID *string `protobuf:"bytes,2,opt,name=userID" json:"userID,omitempty"`
+	RoleID *string `protobuf:"bytes,3,opt,name=roleID" json:"roleID,omitempty"`
+
+	// 所属组织ID，为空表示全局分配
+	OrganizationID *string `protobuf:"bytes,4,opt,name=organizationID" json:"organizationID,omitempty"`
+
+	// 生效时间窗口（毫秒时间戳），为空表示不限
+	ValidFrom  *int64  `protobuf:"varint,5,opt,name=validFrom" json:"validFrom,omitempty"`
+	ValidUntil *int64  `protobuf:"varint,6,opt,name=validUntil" json:"validUntil,omitempty"`
+	CreatedBy  *string `protobuf:"bytes,11,opt,name=createdBy" json:"createdBy,omitempty"`
+	UpdatedBy  *string `protobuf:"bytes,12,opt,name=updatedBy" json:"updatedBy,omitempty"`
+	CreatedAt  *int64  `protobuf:"varint,13,opt,name=createdAt" json:"createdAt,omitempty"`
+	UpdatedAt  *int64  `protobuf:"varint,14,opt,name=updatedAt" json:"updatedAt,omitempty"`
+	Version    *int64  `protobuf:"varint,15,opt,name=version" json:"version,omitempty"`
 }
 
 func (x *UserRoleAssignment) Reset() { *x = UserRoleAssignment{} }
@@ -1075,6 +1607,27 @@ func (x *UserRoleAssignment) GetRoleID() string {
 	return ""
 }
 
+func (x *UserRoleAssignment) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *UserRoleAssignment) GetValidFrom() int64 {
+	if x != nil && x.ValidFrom != nil {
+		return *x.ValidFrom
+	}
+	return 0
+}
+
+func (x *UserRoleAssignment) GetValidUntil() int64 {
+	if x != nil && x.ValidUntil != nil {
+		return *x.ValidUntil
+	}
+	return 0
+}
+
 func (x *UserRoleAssignment) GetCreatedBy() string {
 	if x != nil && x.CreatedBy != nil {
 		return *x.CreatedBy
@@ -1103,6 +1656,13 @@ func (x *UserRoleAssignment) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *UserRoleAssignment) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
 // 菜单节点。
 type MenuNode struct {
 	Name            *string          `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
@@ -1113,7 +1673,16 @@ type MenuNode struct {
 	Children        []*MenuNode      `protobuf:"bytes,6,rep,name=children" json:"children,omitempty"`
 	HasPermission   *bool            `protobuf:"varint,7,opt,name=hasPermission" json:"hasPermission,omitempty"`
 	PermissionLevel *PermissionLevel `protobuf:"varint,8,opt,name=permissionLevel" json:"permissionLevel,omitempty"`
-}
+
+	// 权限编码，未配置时为 menu:<id>
+	PermCode *string `protobuf:"bytes,9,opt,name=permCode" json:"permCode,omitempty"`
+
+	// 关联的 API 路径模式（来自 menu.yaml 的 api_paths）
+	ApiPaths []string `protobuf:"bytes,10,rep,name=apiPaths" json:"apiPaths,omitempty"`
+
+	// 按钮级权限项，不属于导航菜单，不会出现在 children 中
+	Buttons []*MenuNode `protobuf:"bytes,11,rep,name=buttons" json:"buttons,omitempty"`
+}
 
 func (x *MenuNode) Reset() { *x = MenuNode{} }
 
@@ -1176,3 +1745,1204 @@ func (x *MenuNode) GetPermissionLevel() PermissionLevel {
 	}
 	return PermissionLevel_PERMISSION_LEVEL_UNSPECIFIED
 }
+
+func (x *MenuNode) GetPermCode() string {
+	if x != nil && x.PermCode != nil {
+		return *x.PermCode
+	}
+	return ""
+}
+
+func (x *MenuNode) GetApiPaths() []string {
+	if x != nil {
+		return x.ApiPaths
+	}
+	return nil
+}
+
+func (x *MenuNode) GetButtons() []*MenuNode {
+	if x != nil {
+		return x.Buttons
+	}
+	return nil
+}
+
+// 搜索命中信息，仅在列表请求带搜索词时返回。
+type SearchHighlight struct {
+	// 命中记录 ID
+	Id *string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+
+	// 命中字段，如 username / real_name / email / name / code
+	Field *string `protobuf:"bytes,2,opt,name=field" json:"field,omitempty"`
+
+	// 命中字段原值
+	Value *string `protobuf:"bytes,3,opt,name=value" json:"value,omitempty"`
+
+	// 命中区间（字符下标，左闭右开）；经拼音命中时覆盖对应汉字
+	Start *int32 `protobuf:"varint,4,opt,name=start" json:"start,omitempty"`
+	End   *int32 `protobuf:"varint,5,opt,name=end" json:"end,omitempty"`
+
+	// 匹配等级：0 完全匹配，1 前缀匹配，2 包含
+	Rank *int32 `protobuf:"varint,6,opt,name=rank" json:"rank,omitempty"`
+
+	// 是否经拼音全拼或首字母命中
+	Pinyin *bool `protobuf:"varint,7,opt,name=pinyin" json:"pinyin,omitempty"`
+}
+
+func (x *SearchHighlight) Reset() { *x = SearchHighlight{} }
+
+func (x *SearchHighlight) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *SearchHighlight) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SearchHighlight) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *SearchHighlight) GetField() string {
+	if x != nil && x.Field != nil {
+		return *x.Field
+	}
+	return ""
+}
+
+func (x *SearchHighlight) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+func (x *SearchHighlight) GetStart() int32 {
+	if x != nil && x.Start != nil {
+		return *x.Start
+	}
+	return 0
+}
+
+func (x *SearchHighlight) GetEnd() int32 {
+	if x != nil && x.End != nil {
+		return *x.End
+	}
+	return 0
+}
+
+func (x *SearchHighlight) GetRank() int32 {
+	if x != nil && x.Rank != nil {
+		return *x.Rank
+	}
+	return 0
+}
+
+func (x *SearchHighlight) GetPinyin() bool {
+	if x != nil && x.Pinyin != nil {
+		return *x.Pinyin
+	}
+	return false
+}
+
+// 回收站中的已删除记录。
+type DeletedEntity struct {
+	Id         *string               `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	EntityType *RecycleBinEntityType `protobuf:"varint,2,opt,name=entityType" json:"entityType,omitempty"`
+
+	// 展示名称：用户为姓名（为空时取用户名），组织、部门为名称
+	Name *string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+
+	// 唯一标识：用户为用户名，组织为组织代码，部门不返回
+	Code *string `protobuf:"bytes,4,opt,name=code" json:"code,omitempty"`
+
+	// 部门所属组织ID
+	OrganizationID *string `protobuf:"bytes,5,opt,name=organizationID" json:"organizationID,omitempty"`
+
+	// 删除时间（毫秒时间戳）
+	DeletedAt *int64 `protobuf:"varint,6,opt,name=deletedAt" json:"deletedAt,omitempty"`
+
+	// 自动清除时间（毫秒时间戳），未开启保留期自动清除时为空
+	PurgeAt *int64 `protobuf:"varint,7,opt,name=purgeAt" json:"purgeAt,omitempty"`
+}
+
+func (x *DeletedEntity) Reset() { *x = DeletedEntity{} }
+
+func (x *DeletedEntity) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *DeletedEntity) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *DeletedEntity) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *DeletedEntity) GetEntityType() RecycleBinEntityType {
+	if x != nil && x.EntityType != nil {
+		return *x.EntityType
+	}
+	return RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *DeletedEntity) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *DeletedEntity) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+func (x *DeletedEntity) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *DeletedEntity) GetDeletedAt() int64 {
+	if x != nil && x.DeletedAt != nil {
+		return *x.DeletedAt
+	}
+	return 0
+}
+
+func (x *DeletedEntity) GetPurgeAt() int64 {
+	if x != nil && x.PurgeAt != nil {
+		return *x.PurgeAt
+	}
+	return 0
+}
+
+// 用户导入的逐行结果。
+type UserImportRowResult struct {
+	// 文件中的行号（表头为第 1 行）
+	RowNumber *int32  `protobuf:"varint,1,opt,name=rowNumber" json:"rowNumber,omitempty"`
+	Username  *string `protobuf:"bytes,2,opt,name=username" json:"username,omitempty"`
+
+	// 校验或写入失败的原因，为空表示该行通过
+	Errors []string `protobuf:"bytes,3,rep,name=errors" json:"errors,omitempty"`
+
+	// 实际导入时创建的用户ID，试运行或失败时为空
+	UserID *string `protobuf:"bytes,4,opt,name=userID" json:"userID,omitempty"`
+}
+
+func (x *UserImportRowResult) Reset() { *x = UserImportRowResult{} }
+
+func (x *UserImportRowResult) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *UserImportRowResult) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *UserImportRowResult) GetRowNumber() int32 {
+	if x != nil && x.RowNumber != nil {
+		return *x.RowNumber
+	}
+	return 0
+}
+
+func (x *UserImportRowResult) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *UserImportRowResult) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *UserImportRowResult) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+// 菜单版本摘要。
+type MenuVersionInfo struct {
+	Version     *int32  `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	ContentHash *string `protobuf:"bytes,2,opt,name=contentHash" json:"contentHash,omitempty"`
+
+	// 该版本的节点数（含按钮）
+	NodeCount *int32  `protobuf:"varint,3,opt,name=nodeCount" json:"nodeCount,omitempty"`
+	CreatedAt *int64  `protobuf:"varint,4,opt,name=createdAt" json:"createdAt,omitempty"`
+	CreatedBy *string `protobuf:"bytes,5,opt,name=createdBy" json:"createdBy,omitempty"`
+
+	// 是否为当前生效版本（即最新版本）
+	Active *bool `protobuf:"varint,6,opt,name=active" json:"active,omitempty"`
+
+	// 内容与之相同的最早历史版本，由回滚或重复上传产生；为空表示首次出现
+	SameContentAs *int32 `protobuf:"varint,7,opt,name=sameContentAs" json:"sameContentAs,omitempty"`
+}
+
+func (x *MenuVersionInfo) Reset() { *x = MenuVersionInfo{} }
+
+func (x *MenuVersionInfo) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *MenuVersionInfo) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *MenuVersionInfo) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *MenuVersionInfo) GetContentHash() string {
+	if x != nil && x.ContentHash != nil {
+		return *x.ContentHash
+	}
+	return ""
+}
+
+func (x *MenuVersionInfo) GetNodeCount() int32 {
+	if x != nil && x.NodeCount != nil {
+		return *x.NodeCount
+	}
+	return 0
+}
+
+func (x *MenuVersionInfo) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *MenuVersionInfo) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
+}
+
+func (x *MenuVersionInfo) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+func (x *MenuVersionInfo) GetSameContentAs() int32 {
+	if x != nil && x.SameContentAs != nil {
+		return *x.SameContentAs
+	}
+	return 0
+}
+
+// 以 SemanticID 为键的菜单差异项。
+type MenuDiffEntry struct {
+	SemanticID *string `protobuf:"bytes,1,opt,name=semanticID" json:"semanticID,omitempty"`
+
+	// 同一节点可能同时移动和重命名
+	Changes []MenuChangeType `protobuf:"varint,2,rep,packed,name=changes" json:"changes,omitempty"`
+	OldName *string          `protobuf:"bytes,3,opt,name=oldName" json:"oldName,omitempty"`
+	NewName *string          `protobuf:"bytes,4,opt,name=newName" json:"newName,omitempty"`
+
+	// 父节点的 SemanticID，为空表示顶级
+	OldParentID *string `protobuf:"bytes,5,opt,name=oldParentID" json:"oldParentID,omitempty"`
+	NewParentID *string `protobuf:"bytes,6,opt,name=newParentID" json:"newParentID,omitempty"`
+
+	// MODIFIED 时变化的字段：path / component / icon / perm_code / api_paths / is_button / sort
+	// sort 仅在同一父节点下保留节点的相对顺序变化时出现，新增或删除兄弟节点引起的序号变化不计入
+	ChangedFields []string `protobuf:"bytes,7,rep,name=changedFields" json:"changedFields,omitempty"`
+	IsButton      *bool    `protobuf:"varint,8,opt,name=isButton" json:"isButton,omitempty"`
+}
+
+func (x *MenuDiffEntry) Reset() { *x = MenuDiffEntry{} }
+
+func (x *MenuDiffEntry) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *MenuDiffEntry) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *MenuDiffEntry) GetSemanticID() string {
+	if x != nil && x.SemanticID != nil {
+		return *x.SemanticID
+	}
+	return ""
+}
+
+func (x *MenuDiffEntry) GetChanges() []MenuChangeType {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *MenuDiffEntry) GetOldName() string {
+	if x != nil && x.OldName != nil {
+		return *x.OldName
+	}
+	return ""
+}
+
+func (x *MenuDiffEntry) GetNewName() string {
+	if x != nil && x.NewName != nil {
+		return *x.NewName
+	}
+	return ""
+}
+
+func (x *MenuDiffEntry) GetOldParentID() string {
+	if x != nil && x.OldParentID != nil {
+		return *x.OldParentID
+	}
+	return ""
+}
+
+func (x *MenuDiffEntry) GetNewParentID() string {
+	if x != nil && x.NewParentID != nil {
+		return *x.NewParentID
+	}
+	return ""
+}
+
+func (x *MenuDiffEntry) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *MenuDiffEntry) GetIsButton() bool {
+	if x != nil && x.IsButton != nil {
+		return *x.IsButton
+	}
+	return false
+}
+
+// 因菜单节点被移除而失效的角色菜单授权。
+type OrphanedMenuGrant struct {
+	RoleID     *string          `protobuf:"bytes,1,opt,name=roleID" json:"roleID,omitempty"`
+	RoleName   *string          `protobuf:"bytes,2,opt,name=roleName" json:"roleName,omitempty"`
+	MenuID     *string          `protobuf:"bytes,3,opt,name=menuID" json:"menuID,omitempty"`
+	Permission *PermissionLevel `protobuf:"varint,4,opt,name=permission" json:"permission,omitempty"`
+}
+
+func (x *OrphanedMenuGrant) Reset() { *x = OrphanedMenuGrant{} }
+
+func (x *OrphanedMenuGrant) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *OrphanedMenuGrant) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *OrphanedMenuGrant) GetRoleID() string {
+	if x != nil && x.RoleID != nil {
+		return *x.RoleID
+	}
+	return ""
+}
+
+func (x *OrphanedMenuGrant) GetRoleName() string {
+	if x != nil && x.RoleName != nil {
+		return *x.RoleName
+	}
+	return ""
+}
+
+func (x *OrphanedMenuGrant) GetMenuID() string {
+	if x != nil && x.MenuID != nil {
+		return *x.MenuID
+	}
+	return ""
+}
+
+func (x *OrphanedMenuGrant) GetPermission() PermissionLevel {
+	if x != nil && x.Permission != nil {
+		return *x.Permission
+	}
+	return PermissionLevel_PERMISSION_LEVEL_UNSPECIFIED
+}
+
+// 权限报告中的一条授权。
+type PermissionGrant struct {
+	Source *PermissionGrantSource `protobuf:"varint,1,opt,name=source" json:"source,omitempty"`
+
+	// 菜单授权：菜单 SemanticID、名称与权限级别
+	MenuID     *string          `protobuf:"bytes,2,opt,name=menuID" json:"menuID,omitempty"`
+	MenuName   *string          `protobuf:"bytes,3,opt,name=menuName" json:"menuName,omitempty"`
+	Permission *PermissionLevel `protobuf:"varint,4,opt,name=permission" json:"permission,omitempty"`
+
+	// 策略授权：资源与动作，"*" 表示任意
+	Resource *string `protobuf:"bytes,5,opt,name=resource" json:"resource,omitempty"`
+	Action   *string `protobuf:"bytes,6,opt,name=action" json:"action,omitempty"`
+
+	// 数据范围：菜单授权为 own_org / all_orgs，策略授权为 self / dept / org / all
+	DataScope *string `protobuf:"bytes,7,opt,name=dataScope" json:"dataScope,omitempty"`
+}
+
+func (x *PermissionGrant) Reset() { *x = PermissionGrant{} }
+
+func (x *PermissionGrant) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *PermissionGrant) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *PermissionGrant) GetSource() PermissionGrantSource {
+	if x != nil && x.Source != nil {
+		return *x.Source
+	}
+	return PermissionGrantSource_PERMISSION_GRANT_SOURCE_UNSPECIFIED
+}
+
+func (x *PermissionGrant) GetMenuID() string {
+	if x != nil && x.MenuID != nil {
+		return *x.MenuID
+	}
+	return ""
+}
+
+func (x *PermissionGrant) GetMenuName() string {
+	if x != nil && x.MenuName != nil {
+		return *x.MenuName
+	}
+	return ""
+}
+
+func (x *PermissionGrant) GetPermission() PermissionLevel {
+	if x != nil && x.Permission != nil {
+		return *x.Permission
+	}
+	return PermissionLevel_PERMISSION_LEVEL_UNSPECIFIED
+}
+
+func (x *PermissionGrant) GetResource() string {
+	if x != nil && x.Resource != nil {
+		return *x.Resource
+	}
+	return ""
+}
+
+func (x *PermissionGrant) GetAction() string {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return ""
+}
+
+func (x *PermissionGrant) GetDataScope() string {
+	if x != nil && x.DataScope != nil {
+		return *x.DataScope
+	}
+	return ""
+}
+
+// 角色及其授予的全部权限。
+type RolePermissions struct {
+	RoleID   *string `protobuf:"bytes,1,opt,name=roleID" json:"roleID,omitempty"`
+	RoleName *string `protobuf:"bytes,2,opt,name=roleName" json:"roleName,omitempty"`
+	RoleCode *string `protobuf:"bytes,3,opt,name=roleCode" json:"roleCode,omitempty"`
+
+	// 角色的策略含通配规则，拥有全部菜单（FULL）与全部动作
+	FullAccess *bool              `protobuf:"varint,4,opt,name=fullAccess" json:"fullAccess,omitempty"`
+	Grants     []*PermissionGrant `protobuf:"bytes,5,rep,name=grants" json:"grants,omitempty"`
+
+	// 用户视图：授予该角色的分配是否为全局分配（不限组织）
+	GlobalAssignment *bool `protobuf:"varint,6,opt,name=globalAssignment" json:"globalAssignment,omitempty"`
+}
+
+func (x *RolePermissions) Reset() { *x = RolePermissions{} }
+
+func (x *RolePermissions) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *RolePermissions) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RolePermissions) GetRoleID() string {
+	if x != nil && x.RoleID != nil {
+		return *x.RoleID
+	}
+	return ""
+}
+
+func (x *RolePermissions) GetRoleName() string {
+	if x != nil && x.RoleName != nil {
+		return *x.RoleName
+	}
+	return ""
+}
+
+func (x *RolePermissions) GetRoleCode() string {
+	if x != nil && x.RoleCode != nil {
+		return *x.RoleCode
+	}
+	return ""
+}
+
+func (x *RolePermissions) GetFullAccess() bool {
+	if x != nil && x.FullAccess != nil {
+		return *x.FullAccess
+	}
+	return false
+}
+
+func (x *RolePermissions) GetGrants() []*PermissionGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+func (x *RolePermissions) GetGlobalAssignment() bool {
+	if x != nil && x.GlobalAssignment != nil {
+		return *x.GlobalAssignment
+	}
+	return false
+}
+
+// 用户在某个租户下的有效权限。
+type TenantPermissions struct {
+	// 租户（组织）ID，为空表示用户不属于任何组织，仅全局分配生效
+	OrganizationID   *string `protobuf:"bytes,1,opt,name=organizationID" json:"organizationID,omitempty"`
+	OrganizationName *string `protobuf:"bytes,2,opt,name=organizationName" json:"organizationName,omitempty"`
+
+	// 生效的角色（全局分配与该组织下的分配）及各自授予的权限
+	Roles []*RolePermissions `protobuf:"bytes,3,rep,name=roles" json:"roles,omitempty"`
+
+	// 直接授予用户（不经角色）的策略规则
+	DirectGrants []*PermissionGrant `protobuf:"bytes,4,rep,name=directGrants" json:"directGrants,omitempty"`
+}
+
+func (x *TenantPermissions) Reset() { *x = TenantPermissions{} }
+
+func (x *TenantPermissions) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *TenantPermissions) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *TenantPermissions) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *TenantPermissions) GetOrganizationName() string {
+	if x != nil && x.OrganizationName != nil {
+		return *x.OrganizationName
+	}
+	return ""
+}
+
+func (x *TenantPermissions) GetRoles() []*RolePermissions {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *TenantPermissions) GetDirectGrants() []*PermissionGrant {
+	if x != nil {
+		return x.DirectGrants
+	}
+	return nil
+}
+
+// 用户的有效权限。
+type UserPermissions struct {
+	UserID   *string `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
+	Username *string `protobuf:"bytes,2,opt,name=username" json:"username,omitempty"`
+	RealName *string `protobuf:"bytes,3,opt,name=realName" json:"realName,omitempty"`
+
+	// 紧急账号绕过策略决策，拥有全部权限与不限数据范围
+	BreakGlass *bool                `protobuf:"varint,4,opt,name=breakGlass" json:"breakGlass,omitempty"`
+	Tenants    []*TenantPermissions `protobuf:"bytes,5,rep,name=tenants" json:"tenants,omitempty"`
+}
+
+func (x *UserPermissions) Reset() { *x = UserPermissions{} }
+
+func (x *UserPermissions) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *UserPermissions) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *UserPermissions) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *UserPermissions) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *UserPermissions) GetRealName() string {
+	if x != nil && x.RealName != nil {
+		return *x.RealName
+	}
+	return ""
+}
+
+func (x *UserPermissions) GetBreakGlass() bool {
+	if x != nil && x.BreakGlass != nil {
+		return *x.BreakGlass
+	}
+	return false
+}
+
+func (x *UserPermissions) GetTenants() []*TenantPermissions {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+// 已存在的角色约束违例（约束在分配已存在之后才配置时产生）。
+type RoleConstraintViolation struct {
+	Type *RoleConstraintType `protobuf:"varint,1,opt,name=type" json:"type,omitempty"`
+
+	// 互斥约束：互斥组名称
+	ExclusiveGroup *string `protobuf:"bytes,2,opt,name=exclusiveGroup" json:"exclusiveGroup,omitempty"`
+
+	// 互斥约束为用户同时持有的同组角色；人数约束为超额的角色
+	RoleIDs   []string `protobuf:"bytes,3,rep,name=roleIDs" json:"roleIDs,omitempty"`
+	RoleCodes []string `protobuf:"bytes,4,rep,name=roleCodes" json:"roleCodes,omitempty"`
+
+	// 互斥约束为违例用户；人数约束为持有该角色的全部用户
+	UserIDs []string `protobuf:"bytes,5,rep,name=userIDs" json:"userIDs,omitempty"`
+
+	// 人数约束：上限与实际持有人数
+	MaxAssignments *int32 `protobuf:"varint,6,opt,name=maxAssignments" json:"maxAssignments,omitempty"`
+	AssignedCount  *int32 `protobuf:"varint,7,opt,name=assignedCount" json:"assignedCount,omitempty"`
+}
+
+func (x *RoleConstraintViolation) Reset() { *x = RoleConstraintViolation{} }
+
+func (x *RoleConstraintViolation) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *RoleConstraintViolation) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RoleConstraintViolation) GetType() RoleConstraintType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return RoleConstraintType_ROLE_CONSTRAINT_TYPE_UNSPECIFIED
+}
+
+func (x *RoleConstraintViolation) GetExclusiveGroup() string {
+	if x != nil && x.ExclusiveGroup != nil {
+		return *x.ExclusiveGroup
+	}
+	return ""
+}
+
+func (x *RoleConstraintViolation) GetRoleIDs() []string {
+	if x != nil {
+		return x.RoleIDs
+	}
+	return nil
+}
+
+func (x *RoleConstraintViolation) GetRoleCodes() []string {
+	if x != nil {
+		return x.RoleCodes
+	}
+	return nil
+}
+
+func (x *RoleConstraintViolation) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *RoleConstraintViolation) GetMaxAssignments() int32 {
+	if x != nil && x.MaxAssignments != nil {
+		return *x.MaxAssignments
+	}
+	return 0
+}
+
+func (x *RoleConstraintViolation) GetAssignedCount() int32 {
+	if x != nil && x.AssignedCount != nil {
+		return *x.AssignedCount
+	}
+	return 0
+}
+
+// 敏感角色授权申请：分配敏感角色时创建，审批通过后才生成角色分配。
+type RoleGrantRequest struct {
+	Id       *string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	UserID   *string `protobuf:"bytes,2,opt,name=userID" json:"userID,omitempty"`
+	RoleID   *string `protobuf:"bytes,3,opt,name=roleID" json:"roleID,omitempty"`
+	RoleCode *string `protobuf:"bytes,4,opt,name=roleCode" json:"roleCode,omitempty"`
+	RoleName *string `protobuf:"bytes,5,opt,name=roleName" json:"roleName,omitempty"`
+
+	// 申请分配的组织范围，为空表示全局分配
+	OrganizationID *string `protobuf:"bytes,6,opt,name=organizationID" json:"organizationID,omitempty"`
+
+	// 申请的生效时间窗口（毫秒时间戳），为空表示不限
+	ValidFrom       *int64                  `protobuf:"varint,7,opt,name=validFrom" json:"validFrom,omitempty"`
+	ValidUntil      *int64                  `protobuf:"varint,8,opt,name=validUntil" json:"validUntil,omitempty"`
+	RequestedBy     *string                 `protobuf:"bytes,9,opt,name=requestedBy" json:"requestedBy,omitempty"`
+	Reason          *string                 `protobuf:"bytes,10,opt,name=reason" json:"reason,omitempty"`
+	Status          *RoleGrantRequestStatus `protobuf:"varint,11,opt,name=status" json:"status,omitempty"`
+	DecidedBy       *string                 `protobuf:"bytes,12,opt,name=decidedBy" json:"decidedBy,omitempty"`
+	DecidedAt       *int64                  `protobuf:"varint,13,opt,name=decidedAt" json:"decidedAt,omitempty"`
+	DecisionComment *string                 `protobuf:"bytes,14,opt,name=decisionComment" json:"decisionComment,omitempty"`
+
+	// 审批截止时间，过期未处理的申请自动失效
+	ExpiresAt *int64 `protobuf:"varint,15,opt,name=expiresAt" json:"expiresAt,omitempty"`
+
+	// 审批通过后生成的角色分配ID
+	AssignmentID *string `protobuf:"bytes,16,opt,name=assignmentID" json:"assignmentID,omitempty"`
+	CreatedAt    *int64  `protobuf:"varint,17,opt,name=createdAt" json:"createdAt,omitempty"`
+}
+
+func (x *RoleGrantRequest) Reset() { *x = RoleGrantRequest{} }
+
+func (x *RoleGrantRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *RoleGrantRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RoleGrantRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *RoleGrantRequest) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *RoleGrantRequest) GetRoleID() string {
+	if x != nil && x.RoleID != nil {
+		return *x.RoleID
+	}
+	return ""
+}
+
+func (x *RoleGrantRequest) GetRoleCode() string {
+	if x != nil && x.RoleCode != nil {
+		return *x.RoleCode
+	}
+	return ""
+}
+
+func (x *RoleGrantRequest) GetRoleName() string {
+	if x != nil && x.RoleName != nil {
+		return *x.RoleName
+	}
+	return ""
+}
+
+func (x *RoleGrantRequest) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *RoleGrantRequest) GetValidFrom() int64 {
+	if x != nil && x.ValidFrom != nil {
+		return *x.ValidFrom
+	}
+	return 0
+}
+
+func (x *RoleGrantRequest) GetValidUntil() int64 {
+	if x != nil && x.ValidUntil != nil {
+		return *x.ValidUntil
+	}
+	return 0
+}
+
+func (x *RoleGrantRequest) GetRequestedBy() string {
+	if x != nil && x.RequestedBy != nil {
+		return *x.RequestedBy
+	}
+	return ""
+}
+
+func (x *RoleGrantRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *RoleGrantRequest) GetStatus() RoleGrantRequestStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return RoleGrantRequestStatus_ROLE_GRANT_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *RoleGrantRequest) GetDecidedBy() string {
+	if x != nil && x.DecidedBy != nil {
+		return *x.DecidedBy
+	}
+	return ""
+}
+
+func (x *RoleGrantRequest) GetDecidedAt() int64 {
+	if x != nil && x.DecidedAt != nil {
+		return *x.DecidedAt
+	}
+	return 0
+}
+
+func (x *RoleGrantRequest) GetDecisionComment() string {
+	if x != nil && x.DecisionComment != nil {
+		return *x.DecisionComment
+	}
+	return ""
+}
+
+func (x *RoleGrantRequest) GetExpiresAt() int64 {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *RoleGrantRequest) GetAssignmentID() string {
+	if x != nil && x.AssignmentID != nil {
+		return *x.AssignmentID
+	}
+	return ""
+}
+
+func (x *RoleGrantRequest) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+// 访问复核活动的完成情况统计。
+type AccessReviewStats struct {
+	Total       *int32 `protobuf:"varint,1,opt,name=total" json:"total,omitempty"`
+	Pending     *int32 `protobuf:"varint,2,opt,name=pending" json:"pending,omitempty"`
+	Certified   *int32 `protobuf:"varint,3,opt,name=certified" json:"certified,omitempty"`
+	Revoked     *int32 `protobuf:"varint,4,opt,name=revoked" json:"revoked,omitempty"`
+	AutoRevoked *int32 `protobuf:"varint,5,opt,name=autoRevoked" json:"autoRevoked,omitempty"`
+
+	// 已复核条目占比（0-100）
+	CompletionPercent *int32 `protobuf:"varint,6,opt,name=completionPercent" json:"completionPercent,omitempty"`
+}
+
+func (x *AccessReviewStats) Reset() { *x = AccessReviewStats{} }
+
+func (x *AccessReviewStats) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *AccessReviewStats) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *AccessReviewStats) GetTotal() int32 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *AccessReviewStats) GetPending() int32 {
+	if x != nil && x.Pending != nil {
+		return *x.Pending
+	}
+	return 0
+}
+
+func (x *AccessReviewStats) GetCertified() int32 {
+	if x != nil && x.Certified != nil {
+		return *x.Certified
+	}
+	return 0
+}
+
+func (x *AccessReviewStats) GetRevoked() int32 {
+	if x != nil && x.Revoked != nil {
+		return *x.Revoked
+	}
+	return 0
+}
+
+func (x *AccessReviewStats) GetAutoRevoked() int32 {
+	if x != nil && x.AutoRevoked != nil {
+		return *x.AutoRevoked
+	}
+	return 0
+}
+
+func (x *AccessReviewStats) GetCompletionPercent() int32 {
+	if x != nil && x.CompletionPercent != nil {
+		return *x.CompletionPercent
+	}
+	return 0
+}
+
+// 访问复核活动：按组织/角色圈定范围，对创建时有效的角色分配逐条复核。
+type AccessReviewCampaign struct {
+	Id          *string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Name        *string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Description *string `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
+
+	// 复核范围，为空表示不限
+	OrganizationID *string `protobuf:"bytes,4,opt,name=organizationID" json:"organizationID,omitempty"`
+	RoleID         *string `protobuf:"bytes,5,opt,name=roleID" json:"roleID,omitempty"`
+
+	// 指定复核人，为空表示拥有复核权限的任意用户
+	ReviewerID *string                     `protobuf:"bytes,6,opt,name=reviewerID" json:"reviewerID,omitempty"`
+	Status     *AccessReviewCampaignStatus `protobuf:"varint,7,opt,name=status" json:"status,omitempty"`
+
+	// 截止时间（毫秒时间戳），过期未关闭的活动由后台任务自动关闭
+	DueAt *int64 `protobuf:"varint,8,opt,name=dueAt" json:"dueAt,omitempty"`
+
+	// 关闭时是否自动撤销未复核的角色分配
+	AutoRevoke *bool              `protobuf:"varint,9,opt,name=autoRevoke" json:"autoRevoke,omitempty"`
+	CreatedBy  *string            `protobuf:"bytes,10,opt,name=createdBy" json:"createdBy,omitempty"`
+	ClosedBy   *string            `protobuf:"bytes,11,opt,name=closedBy" json:"closedBy,omitempty"`
+	ClosedAt   *int64             `protobuf:"varint,12,opt,name=closedAt" json:"closedAt,omitempty"`
+	CreatedAt  *int64             `protobuf:"varint,13,opt,name=createdAt" json:"createdAt,omitempty"`
+	Stats      *AccessReviewStats `protobuf:"bytes,14,opt,name=stats" json:"stats,omitempty"`
+}
+
+func (x *AccessReviewCampaign) Reset() { *x = AccessReviewCampaign{} }
+
+func (x *AccessReviewCampaign) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *AccessReviewCampaign) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *AccessReviewCampaign) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *AccessReviewCampaign) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *AccessReviewCampaign) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *AccessReviewCampaign) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *AccessReviewCampaign) GetRoleID() string {
+	if x != nil && x.RoleID != nil {
+		return *x.RoleID
+	}
+	return ""
+}
+
+func (x *AccessReviewCampaign) GetReviewerID() string {
+	if x != nil && x.ReviewerID != nil {
+		return *x.ReviewerID
+	}
+	return ""
+}
+
+func (x *AccessReviewCampaign) GetStatus() AccessReviewCampaignStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return AccessReviewCampaignStatus_ACCESS_REVIEW_CAMPAIGN_STATUS_UNSPECIFIED
+}
+
+func (x *AccessReviewCampaign) GetDueAt() int64 {
+	if x != nil && x.DueAt != nil {
+		return *x.DueAt
+	}
+	return 0
+}
+
+func (x *AccessReviewCampaign) GetAutoRevoke() bool {
+	if x != nil && x.AutoRevoke != nil {
+		return *x.AutoRevoke
+	}
+	return false
+}
+
+func (x *AccessReviewCampaign) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
+}
+
+func (x *AccessReviewCampaign) GetClosedBy() string {
+	if x != nil && x.ClosedBy != nil {
+		return *x.ClosedBy
+	}
+	return ""
+}
+
+func (x *AccessReviewCampaign) GetClosedAt() int64 {
+	if x != nil && x.ClosedAt != nil {
+		return *x.ClosedAt
+	}
+	return 0
+}
+
+func (x *AccessReviewCampaign) GetCreatedAt() int64 {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AccessReviewCampaign) GetStats() *AccessReviewStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// 访问复核条目：一条待复核的角色分配快照。
+type AccessReviewItem struct {
+	Id           *string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	CampaignID   *string `protobuf:"bytes,2,opt,name=campaignID" json:"campaignID,omitempty"`
+	AssignmentID *string `protobuf:"bytes,3,opt,name=assignmentID" json:"assignmentID,omitempty"`
+	UserID       *string `protobuf:"bytes,4,opt,name=userID" json:"userID,omitempty"`
+	Username     *string `protobuf:"bytes,5,opt,name=username" json:"username,omitempty"`
+	RealName     *string `protobuf:"bytes,6,opt,name=realName" json:"realName,omitempty"`
+	RoleID       *string `protobuf:"bytes,7,opt,name=roleID" json:"roleID,omitempty"`
+	RoleCode     *string `protobuf:"bytes,8,opt,name=roleCode" json:"roleCode,omitempty"`
+	RoleName     *string `protobuf:"bytes,9,opt,name=roleName" json:"roleName,omitempty"`
+
+	// 为空表示全局分配
+	OrganizationID *string               `protobuf:"bytes,10,opt,name=organizationID" json:"organizationID,omitempty"`
+	ValidUntil     *int64                `protobuf:"varint,11,opt,name=validUntil" json:"validUntil,omitempty"`
+	Decision       *AccessReviewDecision `protobuf:"varint,12,opt,name=decision" json:"decision,omitempty"`
+	ReviewedBy     *string               `protobuf:"bytes,13,opt,name=reviewedBy" json:"reviewedBy,omitempty"`
+	ReviewedAt     *int64                `protobuf:"varint,14,opt,name=reviewedAt" json:"reviewedAt,omitempty"`
+	Comment        *string               `protobuf:"bytes,15,opt,name=comment" json:"comment,omitempty"`
+}
+
+func (x *AccessReviewItem) Reset() { *x = AccessReviewItem{} }
+
+func (x *AccessReviewItem) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *AccessReviewItem) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *AccessReviewItem) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *AccessReviewItem) GetCampaignID() string {
+	if x != nil && x.CampaignID != nil {
+		return *x.CampaignID
+	}
+	return ""
+}
+
+func (x *AccessReviewItem) GetAssignmentID() string {
+	if x != nil && x.AssignmentID != nil {
+		return *x.AssignmentID
+	}
+	return ""
+}
+
+func (x *AccessReviewItem) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *AccessReviewItem) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *AccessReviewItem) GetRealName() string {
+	if x != nil && x.RealName != nil {
+		return *x.RealName
+	}
+	return ""
+}
+
+func (x *AccessReviewItem) GetRoleID() string {
+	if x != nil && x.RoleID != nil {
+		return *x.RoleID
+	}
+	return ""
+}
+
+func (x *AccessReviewItem) GetRoleCode() string {
+	if x != nil && x.RoleCode != nil {
+		return *x.RoleCode
+	}
+	return ""
+}
+
+func (x *AccessReviewItem) GetRoleName() string {
+	if x != nil && x.RoleName != nil {
+		return *x.RoleName
+	}
+	return ""
+}
+
+func (x *AccessReviewItem) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *AccessReviewItem) GetValidUntil() int64 {
+	if x != nil && x.ValidUntil != nil {
+		return *x.ValidUntil
+	}
+	return 0
+}
+
+func (x *AccessReviewItem) GetDecision() AccessReviewDecision {
+	if x != nil && x.Decision != nil {
+		return *x.Decision
+	}
+	return AccessReviewDecision_ACCESS_REVIEW_DECISION_UNSPECIFIED
+}
+
+func (x *AccessReviewItem) GetReviewedBy() string {
+	if x != nil && x.ReviewedBy != nil {
+		return *x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *AccessReviewItem) GetReviewedAt() int64 {
+	if x != nil && x.ReviewedAt != nil {
+		return *x.ReviewedAt
+	}
+	return 0
+}
+
+func (x *AccessReviewItem) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
@@ -21,6 +21,9 @@ type LoginRequest struct {
 	OidcRedirectURI  *string `protobuf:"bytes,6,opt,name=oidcRedirectURI" json:"oidcRedirectURI,omitempty"`
 	OidcCodeVerifier *string `protobuf:"bytes,7,opt,name=oidcCodeVerifier" json:"oidcCodeVerifier,omitempty"`
 	Nonce            *string `protobuf:"bytes,8,opt,name=nonce" json:"nonce,omitempty"`
+
+	// 客户端标识（如 nurse-mobile），用于选择响应中 menuTree 与 permissions 所属的菜单产品线
+	ClientID *string `protobuf:"bytes,9,opt,name=clientID" json:"clientID,omitempty"`
 }
 
 func (x *LoginRequest) Reset() { *x = LoginRequest{} }
@@ -85,10 +88,20 @@ func (x *LoginRequest) GetNonce() string {
 	return ""
 }
 
+func (x *LoginRequest) GetClientID() string {
+	if x != nil && x.ClientID != nil {
+		return *x.ClientID
+	}
+	return ""
+}
+
 type LoginResponse struct {
 	UserProfile *UserProfile      `protobuf:"bytes,1,opt,name=userProfile" json:"userProfile,omitempty"`
 	Memberships []*UserMembership `protobuf:"bytes,2,rep,name=memberships" json:"memberships,omitempty"`
 	MenuTree    []*MenuNode       `protobuf:"bytes,3,rep,name=menuTree" json:"menuTree,omitempty"`
+
+	// TODO(roleCodes-rename): 字段名为历史命名，实际内容为 role code 列表（提案 §5.1）。
+	// 字段保留以避免破坏现有 kitex_gen / 网关代码兼容性，2027 年视情况批量改名。
 	RoleIDs     []string          `protobuf:"bytes,4,rep,name=roleIDs" json:"roleIDs,omitempty"`
 	Permissions []*MenuPermission `protobuf:"bytes,5,rep,name=permissions" json:"permissions,omitempty"`
 	RoleDetails []*RoleDefinition `protobuf:"bytes,6,rep,name=roleDetails" json:"roleDetails,omitempty"`
@@ -97,6 +110,11 @@ type LoginResponse struct {
 	IdToken      *string `protobuf:"bytes,7,opt,name=idToken" json:"idToken,omitempty"`
 	AccessToken  *string `protobuf:"bytes,8,opt,name=accessToken" json:"accessToken,omitempty"`
 	RefreshToken *string `protobuf:"bytes,9,opt,name=refreshToken" json:"refreshToken,omitempty"`
+
+	// 多因素认证：mfaRequired 表示用户已启用 MFA，签发 token 前需校验第二因素；
+	// mfaEnrollmentRequired 表示所属组织要求 MFA 但用户尚未登记，需先完成登记。
+	MfaRequired           *bool `protobuf:"varint,10,opt,name=mfaRequired" json:"mfaRequired,omitempty"`
+	MfaEnrollmentRequired *bool `protobuf:"varint,11,opt,name=mfaEnrollmentRequired" json:"mfaEnrollmentRequired,omitempty"`
 }
 
 func (x *LoginResponse) Reset() { *x = LoginResponse{} }
@@ -168,6 +186,20 @@ func (x *LoginResponse) GetRefreshToken() string {
 	return ""
 }
 
+func (x *LoginResponse) GetMfaRequired() bool {
+	if x != nil && x.MfaRequired != nil {
+		return *x.MfaRequired
+	}
+	return false
+}
+
+func (x *LoginResponse) GetMfaEnrollmentRequired() bool {
+	if x != nil && x.MfaEnrollmentRequired != nil {
+		return *x.MfaEnrollmentRequired
+	}
+	return false
+}
+
 type ChangePasswordRequest struct {
 	UserID      *string `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
 	OldPassword *string `protobuf:"bytes,2,opt,name=oldPassword" json:"oldPassword,omitempty"`
@@ -331,6 +363,339 @@ func (x *ForcePasswordChangeRequest) GetUserID() string {
 	return ""
 }
 
+// 发起 TOTP 登记：生成新密钥（未启用），用户用验证器扫码后调用 ConfirmMFA 确认。
+type EnrollMFARequest struct {
+	UserID *string `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
+}
+
+func (x *EnrollMFARequest) Reset() { *x = EnrollMFARequest{} }
+
+func (x *EnrollMFARequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *EnrollMFARequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *EnrollMFARequest) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+type EnrollMFAResponse struct {
+	// Base32 密钥，供无法扫码时手动输入
+	Secret *string `protobuf:"bytes,1,opt,name=secret" json:"secret,omitempty"`
+
+	// otpauth:// URI，前端渲染为二维码
+	ProvisioningURI *string `protobuf:"bytes,2,opt,name=provisioningURI" json:"provisioningURI,omitempty"`
+}
+
+func (x *EnrollMFAResponse) Reset() { *x = EnrollMFAResponse{} }
+
+func (x *EnrollMFAResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *EnrollMFAResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *EnrollMFAResponse) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollMFAResponse) GetProvisioningURI() string {
+	if x != nil && x.ProvisioningURI != nil {
+		return *x.ProvisioningURI
+	}
+	return ""
+}
+
+type ConfirmMFARequest struct {
+	UserID *string `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
+	Code   *string `protobuf:"bytes,2,opt,name=code" json:"code,omitempty"`
+}
+
+func (x *ConfirmMFARequest) Reset() { *x = ConfirmMFARequest{} }
+
+func (x *ConfirmMFARequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ConfirmMFARequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ConfirmMFARequest) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *ConfirmMFARequest) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+type ConfirmMFAResponse struct {
+	// 一次性恢复码明文，仅在此处返回一次
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recoveryCodes" json:"recoveryCodes,omitempty"`
+}
+
+func (x *ConfirmMFAResponse) Reset() { *x = ConfirmMFAResponse{} }
+
+func (x *ConfirmMFAResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ConfirmMFAResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ConfirmMFAResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+// 校验第二因素：code 可以是 TOTP 口令或未使用的恢复码。
+type VerifyMFARequest struct {
+	UserID *string `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
+	Code   *string `protobuf:"bytes,2,opt,name=code" json:"code,omitempty"`
+}
+
+func (x *VerifyMFARequest) Reset() { *x = VerifyMFARequest{} }
+
+func (x *VerifyMFARequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *VerifyMFARequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *VerifyMFARequest) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *VerifyMFARequest) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+type VerifyMFAResponse struct {
+	// 命中的因素类型：totp / recovery_code
+	Method                 *string `protobuf:"bytes,1,opt,name=method" json:"method,omitempty"`
+	RemainingRecoveryCodes *int32  `protobuf:"varint,2,opt,name=remainingRecoveryCodes" json:"remainingRecoveryCodes,omitempty"`
+}
+
+func (x *VerifyMFAResponse) Reset() { *x = VerifyMFAResponse{} }
+
+func (x *VerifyMFAResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *VerifyMFAResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *VerifyMFAResponse) GetMethod() string {
+	if x != nil && x.Method != nil {
+		return *x.Method
+	}
+	return ""
+}
+
+func (x *VerifyMFAResponse) GetRemainingRecoveryCodes() int32 {
+	if x != nil && x.RemainingRecoveryCodes != nil {
+		return *x.RemainingRecoveryCodes
+	}
+	return 0
+}
+
+type DisableMFARequest struct {
+	UserID *string `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
+	Code   *string `protobuf:"bytes,2,opt,name=code" json:"code,omitempty"`
+}
+
+func (x *DisableMFARequest) Reset() { *x = DisableMFARequest{} }
+
+func (x *DisableMFARequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *DisableMFARequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *DisableMFARequest) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *DisableMFARequest) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+type DisableMFAResponse struct {
+}
+
+func (x *DisableMFAResponse) Reset() { *x = DisableMFAResponse{} }
+
+func (x *DisableMFAResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *DisableMFAResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+// 自助找回密码：identifier 可以是用户名、邮箱或手机号。
+// 账号不存在、不可用、没有可送达的邮箱或触发限流时同样返回成功，避免暴露账号是否存在。
+type RequestPasswordResetRequest struct {
+	Identifier *string `protobuf:"bytes,1,opt,name=identifier" json:"identifier,omitempty"`
+
+	// 发起请求的客户端 IP，仅用于审计
+	ClientIP *string `protobuf:"bytes,2,opt,name=clientIP" json:"clientIP,omitempty"`
+}
+
+func (x *RequestPasswordResetRequest) Reset() { *x = RequestPasswordResetRequest{} }
+
+func (x *RequestPasswordResetRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *RequestPasswordResetRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RequestPasswordResetRequest) GetIdentifier() string {
+	if x != nil && x.Identifier != nil {
+		return *x.Identifier
+	}
+	return ""
+}
+
+func (x *RequestPasswordResetRequest) GetClientIP() string {
+	if x != nil && x.ClientIP != nil {
+		return *x.ClientIP
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+}
+
+func (x *RequestPasswordResetResponse) Reset() { *x = RequestPasswordResetResponse{} }
+
+func (x *RequestPasswordResetResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *RequestPasswordResetResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+// 凭邮件中的一次性令牌设置新密码；令牌使用后立即失效。
+type ConfirmPasswordResetRequest struct {
+	Token       *string `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	NewPassword *string `protobuf:"bytes,2,opt,name=newPassword" json:"newPassword,omitempty"`
+	ClientIP    *string `protobuf:"bytes,3,opt,name=clientIP" json:"clientIP,omitempty"`
+}
+
+func (x *ConfirmPasswordResetRequest) Reset() { *x = ConfirmPasswordResetRequest{} }
+
+func (x *ConfirmPasswordResetRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ConfirmPasswordResetRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ConfirmPasswordResetRequest) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+func (x *ConfirmPasswordResetRequest) GetNewPassword() string {
+	if x != nil && x.NewPassword != nil {
+		return *x.NewPassword
+	}
+	return ""
+}
+
+func (x *ConfirmPasswordResetRequest) GetClientIP() string {
+	if x != nil && x.ClientIP != nil {
+		return *x.ClientIP
+	}
+	return ""
+}
+
+type ConfirmPasswordResetResponse struct {
+	// 被重置密码的用户，网关据此吊销该用户的全部会话
+	UserID *string `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
+}
+
+func (x *ConfirmPasswordResetResponse) Reset() { *x = ConfirmPasswordResetResponse{} }
+
+func (x *ConfirmPasswordResetResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ConfirmPasswordResetResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ConfirmPasswordResetResponse) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+// 刷新会话：网关轮换刷新令牌时按当前状态重新计算 claims，
+// 已停用/删除的用户、已退出的租户与已失效的角色不再随刷新延续。
+type RefreshSessionRequest struct {
+	UserID *string `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
+
+	// 会话当前租户，为空表示没有租户（只计算全局角色）
+	OrganizationID *string `protobuf:"bytes,2,opt,name=organizationID" json:"organizationID,omitempty"`
+}
+
+func (x *RefreshSessionRequest) Reset() { *x = RefreshSessionRequest{} }
+
+func (x *RefreshSessionRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *RefreshSessionRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RefreshSessionRequest) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *RefreshSessionRequest) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+type RefreshSessionResponse struct {
+	Username *string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+
+	// role code 列表，与登录响应的 roles claim 语义一致。
+	RoleCodes []string `protobuf:"bytes,2,rep,name=roleCodes" json:"roleCodes,omitempty"`
+}
+
+func (x *RefreshSessionResponse) Reset() { *x = RefreshSessionResponse{} }
+
+func (x *RefreshSessionResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *RefreshSessionResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RefreshSessionResponse) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *RefreshSessionResponse) GetRoleCodes() []string {
+	if x != nil {
+		return x.RoleCodes
+	}
+	return nil
+}
+
 type CreateUserRequest struct {
 	Username           *string      `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
 	Password           *string      `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
@@ -344,6 +709,9 @@ type CreateUserRequest struct {
 	EmployeeID         *string      `protobuf:"bytes,10,opt,name=employeeID" json:"employeeID,omitempty"`
 	MustChangePassword *bool        `protobuf:"varint,11,opt,name=mustChangePassword" json:"mustChangePassword,omitempty"`
 	AccountExpiry      *int64       `protobuf:"varint,12,opt,name=accountExpiry" json:"accountExpiry,omitempty"`
+
+	// 用户将加入的组织，按该组织的密码策略校验初始密码；成员关系仍由调用方单独创建
+	OrganizationID *string `protobuf:"bytes,13,opt,name=organizationID" json:"organizationID,omitempty"`
 }
 
 func (x *CreateUserRequest) Reset() { *x = CreateUserRequest{} }
@@ -436,6 +804,13 @@ func (x *CreateUserRequest) GetAccountExpiry() int64 {
 	return 0
 }
 
+func (x *CreateUserRequest) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
 type GetUserRequest struct {
 	UserID *string `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
 }
@@ -464,6 +839,9 @@ type UpdateUserRequest struct {
 	ProfessionalTitle *string      `protobuf:"bytes,8,opt,name=professionalTitle" json:"professionalTitle,omitempty"`
 	EmployeeID        *string      `protobuf:"bytes,9,opt,name=employeeID" json:"employeeID,omitempty"`
 	AccountExpiry     *int64       `protobuf:"varint,10,opt,name=accountExpiry" json:"accountExpiry,omitempty"`
+
+	// 客户端读取时的版本号，与当前版本不一致时返回版本冲突
+	Version *int64 `protobuf:"varint,11,opt,name=version" json:"version,omitempty"`
 }
 
 func (x *UpdateUserRequest) Reset() { *x = UpdateUserRequest{} }
@@ -542,6 +920,13 @@ func (x *UpdateUserRequest) GetAccountExpiry() int64 {
 	return 0
 }
 
+func (x *UpdateUserRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
 type DeleteUserRequest struct {
 	UserID *string `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
 }
@@ -624,6 +1009,9 @@ func (x *ListUsersRequest) GetStatus() core.UserStatus {
 type ListUsersResponse struct {
 	Users []*UserProfile         `protobuf:"bytes,1,rep,name=users" json:"users,omitempty"`
 	Page  *rpc_base.PageResponse `protobuf:"bytes,2,opt,name=page" json:"page,omitempty"`
+
+	// 带搜索词时每条记录的命中信息
+	Highlights []*SearchHighlight `protobuf:"bytes,3,rep,name=highlights" json:"highlights,omitempty"`
 }
 
 func (x *ListUsersResponse) Reset() { *x = ListUsersResponse{} }
@@ -646,6 +1034,13 @@ func (x *ListUsersResponse) GetPage() *rpc_base.PageResponse {
 	return nil
 }
 
+func (x *ListUsersResponse) GetHighlights() []*SearchHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
 type SearchUsersRequest struct {
 	Page           *rpc_base.PageRequest `protobuf:"bytes,1,opt,name=page" json:"page,omitempty"`
 	OrganizationID *string               `protobuf:"bytes,2,opt,name=organizationID" json:"organizationID,omitempty"`
@@ -674,6 +1069,9 @@ func (x *SearchUsersRequest) GetOrganizationID() string {
 type SearchUsersResponse struct {
 	Users []*UserProfile         `protobuf:"bytes,1,rep,name=users" json:"users,omitempty"`
 	Page  *rpc_base.PageResponse `protobuf:"bytes,2,opt,name=page" json:"page,omitempty"`
+
+	// 带搜索词时每条记录的命中信息
+	Highlights []*SearchHighlight `protobuf:"bytes,3,rep,name=highlights" json:"highlights,omitempty"`
 }
 
 func (x *SearchUsersResponse) Reset() { *x = SearchUsersResponse{} }
@@ -696,6 +1094,13 @@ func (x *SearchUsersResponse) GetPage() *rpc_base.PageResponse {
 	return nil
 }
 
+func (x *SearchUsersResponse) GetHighlights() []*SearchHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
 type ChangeUserStatusRequest struct {
 	UserID    *string          `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
 	NewStatus *core.UserStatus `protobuf:"varint,2,opt,name=newStatus" json:"newStatus,omitempty"`
@@ -747,6 +1152,7 @@ type CreateOrganizationRequest struct {
 	AccreditationStatus *string               `protobuf:"bytes,4,opt,name=accreditationStatus" json:"accreditationStatus,omitempty"`
 	ProvinceCity        *core.StringListValue `protobuf:"bytes,5,opt,name=provinceCity" json:"provinceCity,omitempty"`
 	LogoID              *string               `protobuf:"bytes,6,opt,name=logoID" json:"logoID,omitempty"`
+	MfaRequired         *bool                 `protobuf:"varint,7,opt,name=mfaRequired" json:"mfaRequired,omitempty"`
 }
 
 func (x *CreateOrganizationRequest) Reset() { *x = CreateOrganizationRequest{} }
@@ -799,6 +1205,13 @@ func (x *CreateOrganizationRequest) GetLogoID() string {
 	return ""
 }
 
+func (x *CreateOrganizationRequest) GetMfaRequired() bool {
+	if x != nil && x.MfaRequired != nil {
+		return *x.MfaRequired
+	}
+	return false
+}
+
 type GetOrganizationRequest struct {
 	OrganizationID *string `protobuf:"bytes,1,opt,name=organizationID" json:"organizationID,omitempty"`
 }
@@ -826,6 +1239,10 @@ type UpdateOrganizationRequest struct {
 	AccreditationStatus *string               `protobuf:"bytes,5,opt,name=accreditationStatus" json:"accreditationStatus,omitempty"`
 	ProvinceCity        *core.StringListValue `protobuf:"bytes,6,opt,name=provinceCity" json:"provinceCity,omitempty"`
 	LogoID              *string               `protobuf:"bytes,7,opt,name=logoID" json:"logoID,omitempty"`
+	MfaRequired         *bool                 `protobuf:"varint,8,opt,name=mfaRequired" json:"mfaRequired,omitempty"`
+
+	// 客户端读取时的版本号，与当前版本不一致时返回版本冲突
+	Version *int64 `protobuf:"varint,9,opt,name=version" json:"version,omitempty"`
 }
 
 func (x *UpdateOrganizationRequest) Reset() { *x = UpdateOrganizationRequest{} }
@@ -885,11 +1302,25 @@ func (x *UpdateOrganizationRequest) GetLogoID() string {
 	return ""
 }
 
-type DeleteOrganizationRequest struct {
-	OrganizationID *string `protobuf:"bytes,1,opt,name=organizationID" json:"organizationID,omitempty"`
+func (x *UpdateOrganizationRequest) GetMfaRequired() bool {
+	if x != nil && x.MfaRequired != nil {
+		return *x.MfaRequired
+	}
+	return false
 }
 
-func (x *DeleteOrganizationRequest) Reset() { *x = DeleteOrganizationRequest{} }
+func (x *UpdateOrganizationRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type DeleteOrganizationRequest struct {
+	OrganizationID *string `protobuf:"bytes,1,opt,name=organizationID" json:"organizationID,omitempty"`
+}
+
+func (x *DeleteOrganizationRequest) Reset() { *x = DeleteOrganizationRequest{} }
 
 func (x *DeleteOrganizationRequest) Marshal(in []byte) ([]byte, error) {
 	return prutal.MarshalAppend(in, x)
@@ -945,6 +1376,9 @@ func (x *ListOrganizationsRequest) GetPage() *rpc_base.PageRequest {
 type ListOrganizationsResponse struct {
 	Organizations []*Organization        `protobuf:"bytes,1,rep,name=organizations" json:"organizations,omitempty"`
 	Page          *rpc_base.PageResponse `protobuf:"bytes,2,opt,name=page" json:"page,omitempty"`
+
+	// 带搜索词时每条记录的命中信息
+	Highlights []*SearchHighlight `protobuf:"bytes,3,rep,name=highlights" json:"highlights,omitempty"`
 }
 
 func (x *ListOrganizationsResponse) Reset() { *x = ListOrganizationsResponse{} }
@@ -969,6 +1403,13 @@ func (x *ListOrganizationsResponse) GetPage() *rpc_base.PageResponse {
 	return nil
 }
 
+func (x *ListOrganizationsResponse) GetHighlights() []*SearchHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
 type CreateOrganizationResponse struct {
 	Organization *Organization `protobuf:"bytes,1,opt,name=organization" json:"organization,omitempty"`
 }
@@ -1162,6 +1603,12 @@ type GetUserMembershipsRequest struct {
 	OrganizationID *string               `protobuf:"bytes,2,opt,name=organizationID" json:"organizationID,omitempty"`
 	DepartmentID   *string               `protobuf:"bytes,3,opt,name=departmentID" json:"departmentID,omitempty"`
 	Page           *rpc_base.PageRequest `protobuf:"bytes,4,opt,name=page" json:"page,omitempty"`
+
+	// 仅返回活跃状态的成员关系（未指定任何过滤条件时始终只返回活跃记录）
+	ActiveOnly *bool `protobuf:"varint,5,opt,name=activeOnly" json:"activeOnly,omitempty"`
+
+	// 同时返回所属组织名称（批量查询组织，避免调用方逐条 GetOrganization）
+	WithOrganizationName *bool `protobuf:"varint,6,opt,name=withOrganizationName" json:"withOrganizationName,omitempty"`
 }
 
 func (x *GetUserMembershipsRequest) Reset() { *x = GetUserMembershipsRequest{} }
@@ -1200,6 +1647,20 @@ func (x *GetUserMembershipsRequest) GetPage() *rpc_base.PageRequest {
 	return nil
 }
 
+func (x *GetUserMembershipsRequest) GetActiveOnly() bool {
+	if x != nil && x.ActiveOnly != nil {
+		return *x.ActiveOnly
+	}
+	return false
+}
+
+func (x *GetUserMembershipsRequest) GetWithOrganizationName() bool {
+	if x != nil && x.WithOrganizationName != nil {
+		return *x.WithOrganizationName
+	}
+	return false
+}
+
 type GetUserMembershipsResponse struct {
 	Memberships []*UserMembership      `protobuf:"bytes,1,rep,name=memberships" json:"memberships,omitempty"`
 	Page        *rpc_base.PageResponse `protobuf:"bytes,2,opt,name=page" json:"page,omitempty"`
@@ -1432,6 +1893,9 @@ type UpdateDepartmentRequest struct {
 	DepartmentID   *string `protobuf:"bytes,1,opt,name=departmentID" json:"departmentID,omitempty"`
 	Name           *string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
 	DepartmentType *string `protobuf:"bytes,3,opt,name=departmentType" json:"departmentType,omitempty"`
+
+	// 客户端读取时的版本号，与当前版本不一致时返回版本冲突
+	Version *int64 `protobuf:"varint,4,opt,name=version" json:"version,omitempty"`
 }
 
 func (x *UpdateDepartmentRequest) Reset() { *x = UpdateDepartmentRequest{} }
@@ -1463,6 +1927,13 @@ func (x *UpdateDepartmentRequest) GetDepartmentType() string {
 	return ""
 }
 
+func (x *UpdateDepartmentRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
 type DeleteDepartmentRequest struct {
 	DepartmentID *string `protobuf:"bytes,1,opt,name=departmentID" json:"departmentID,omitempty"`
 }
@@ -1525,6 +1996,9 @@ func (x *GetOrganizationDepartmentsRequest) GetPage() *rpc_base.PageRequest {
 type GetOrganizationDepartmentsResponse struct {
 	Departments []*Department          `protobuf:"bytes,1,rep,name=departments" json:"departments,omitempty"`
 	Page        *rpc_base.PageResponse `protobuf:"bytes,2,opt,name=page" json:"page,omitempty"`
+
+	// 带搜索词时每条记录的命中信息
+	Highlights []*SearchHighlight `protobuf:"bytes,3,rep,name=highlights" json:"highlights,omitempty"`
 }
 
 func (x *GetOrganizationDepartmentsResponse) Reset() { *x = GetOrganizationDepartmentsResponse{} }
@@ -1551,6 +2025,13 @@ func (x *GetOrganizationDepartmentsResponse) GetPage() *rpc_base.PageResponse {
 	return nil
 }
 
+func (x *GetOrganizationDepartmentsResponse) GetHighlights() []*SearchHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
 type CreateDepartmentResponse struct {
 	Department *Department `protobuf:"bytes,1,opt,name=department" json:"department,omitempty"`
 }
@@ -1784,6 +2265,174 @@ func (x *BindLogoToOrganizationResponse) GetOrganizationLogo() *OrganizationLogo
 	return nil
 }
 
+type GetOrganizationPasswordPolicyRequest struct {
+	OrganizationID *string `protobuf:"bytes,1,opt,name=organizationID" json:"organizationID,omitempty"`
+}
+
+func (x *GetOrganizationPasswordPolicyRequest) Reset() { *x = GetOrganizationPasswordPolicyRequest{} }
+
+func (x *GetOrganizationPasswordPolicyRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetOrganizationPasswordPolicyRequest) Unmarshal(in []byte) error {
+	return prutal.Unmarshal(in, x)
+}
+
+func (x *GetOrganizationPasswordPolicyRequest) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+type GetOrganizationPasswordPolicyResponse struct {
+	Policy *OrganizationPasswordPolicy `protobuf:"bytes,1,opt,name=policy" json:"policy,omitempty"`
+}
+
+func (x *GetOrganizationPasswordPolicyResponse) Reset() { *x = GetOrganizationPasswordPolicyResponse{} }
+
+func (x *GetOrganizationPasswordPolicyResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetOrganizationPasswordPolicyResponse) Unmarshal(in []byte) error {
+	return prutal.Unmarshal(in, x)
+}
+
+func (x *GetOrganizationPasswordPolicyResponse) GetPolicy() *OrganizationPasswordPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// 设置组织密码策略，未传的规则沿用该组织当前生效的值
+type UpdateOrganizationPasswordPolicyRequest struct {
+	OrganizationID *string `protobuf:"bytes,1,opt,name=organizationID" json:"organizationID,omitempty"`
+	MinLength      *int32  `protobuf:"varint,2,opt,name=minLength" json:"minLength,omitempty"`
+	MinCharClasses *int32  `protobuf:"varint,3,opt,name=minCharClasses" json:"minCharClasses,omitempty"`
+	HistorySize    *int32  `protobuf:"varint,4,opt,name=historySize" json:"historySize,omitempty"`
+	MaxAgeHours    *int32  `protobuf:"varint,5,opt,name=maxAgeHours" json:"maxAgeHours,omitempty"`
+}
+
+func (x *UpdateOrganizationPasswordPolicyRequest) Reset() {
+	*x = UpdateOrganizationPasswordPolicyRequest{}
+}
+
+func (x *UpdateOrganizationPasswordPolicyRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *UpdateOrganizationPasswordPolicyRequest) Unmarshal(in []byte) error {
+	return prutal.Unmarshal(in, x)
+}
+
+func (x *UpdateOrganizationPasswordPolicyRequest) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationPasswordPolicyRequest) GetMinLength() int32 {
+	if x != nil && x.MinLength != nil {
+		return *x.MinLength
+	}
+	return 0
+}
+
+func (x *UpdateOrganizationPasswordPolicyRequest) GetMinCharClasses() int32 {
+	if x != nil && x.MinCharClasses != nil {
+		return *x.MinCharClasses
+	}
+	return 0
+}
+
+func (x *UpdateOrganizationPasswordPolicyRequest) GetHistorySize() int32 {
+	if x != nil && x.HistorySize != nil {
+		return *x.HistorySize
+	}
+	return 0
+}
+
+func (x *UpdateOrganizationPasswordPolicyRequest) GetMaxAgeHours() int32 {
+	if x != nil && x.MaxAgeHours != nil {
+		return *x.MaxAgeHours
+	}
+	return 0
+}
+
+type UpdateOrganizationPasswordPolicyResponse struct {
+	Policy *OrganizationPasswordPolicy `protobuf:"bytes,1,opt,name=policy" json:"policy,omitempty"`
+}
+
+func (x *UpdateOrganizationPasswordPolicyResponse) Reset() {
+	*x = UpdateOrganizationPasswordPolicyResponse{}
+}
+
+func (x *UpdateOrganizationPasswordPolicyResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *UpdateOrganizationPasswordPolicyResponse) Unmarshal(in []byte) error {
+	return prutal.Unmarshal(in, x)
+}
+
+func (x *UpdateOrganizationPasswordPolicyResponse) GetPolicy() *OrganizationPasswordPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// 删除组织的单独配置，恢复沿用全局默认策略
+type ResetOrganizationPasswordPolicyRequest struct {
+	OrganizationID *string `protobuf:"bytes,1,opt,name=organizationID" json:"organizationID,omitempty"`
+}
+
+func (x *ResetOrganizationPasswordPolicyRequest) Reset() {
+	*x = ResetOrganizationPasswordPolicyRequest{}
+}
+
+func (x *ResetOrganizationPasswordPolicyRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ResetOrganizationPasswordPolicyRequest) Unmarshal(in []byte) error {
+	return prutal.Unmarshal(in, x)
+}
+
+func (x *ResetOrganizationPasswordPolicyRequest) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+type ResetOrganizationPasswordPolicyResponse struct {
+	Policy *OrganizationPasswordPolicy `protobuf:"bytes,1,opt,name=policy" json:"policy,omitempty"`
+}
+
+func (x *ResetOrganizationPasswordPolicyResponse) Reset() {
+	*x = ResetOrganizationPasswordPolicyResponse{}
+}
+
+func (x *ResetOrganizationPasswordPolicyResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ResetOrganizationPasswordPolicyResponse) Unmarshal(in []byte) error {
+	return prutal.Unmarshal(in, x)
+}
+
+func (x *ResetOrganizationPasswordPolicyResponse) GetPolicy() *OrganizationPasswordPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
 type PermissionListValue struct {
 	Items []*Permission `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
 }
@@ -1806,6 +2455,15 @@ type RoleDefinitionCreateRequest struct {
 	Description  *string       `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
 	Permissions  []*Permission `protobuf:"bytes,3,rep,name=permissions" json:"permissions,omitempty"`
 	IsSystemRole *bool         `protobuf:"varint,4,opt,name=isSystemRole" json:"isSystemRole,omitempty"`
+
+	// 互斥角色组，同组角色不能被同一用户同时持有
+	ExclusiveGroups []string `protobuf:"bytes,5,rep,name=exclusiveGroups" json:"exclusiveGroups,omitempty"`
+
+	// 最多可分配的用户数，0 或未指定表示不限
+	MaxAssignments *int32 `protobuf:"varint,6,opt,name=maxAssignments" json:"maxAssignments,omitempty"`
+
+	// 分配该角色需经审批
+	RequiresApproval *bool `protobuf:"varint,7,opt,name=requiresApproval" json:"requiresApproval,omitempty"`
 }
 
 func (x *RoleDefinitionCreateRequest) Reset() { *x = RoleDefinitionCreateRequest{} }
@@ -1844,12 +2502,41 @@ func (x *RoleDefinitionCreateRequest) GetIsSystemRole() bool {
 	return false
 }
 
+func (x *RoleDefinitionCreateRequest) GetExclusiveGroups() []string {
+	if x != nil {
+		return x.ExclusiveGroups
+	}
+	return nil
+}
+
+func (x *RoleDefinitionCreateRequest) GetMaxAssignments() int32 {
+	if x != nil && x.MaxAssignments != nil {
+		return *x.MaxAssignments
+	}
+	return 0
+}
+
+func (x *RoleDefinitionCreateRequest) GetRequiresApproval() bool {
+	if x != nil && x.RequiresApproval != nil {
+		return *x.RequiresApproval
+	}
+	return false
+}
+
 type RoleDefinitionUpdateRequest struct {
 	RoleDefinitionID *string              `protobuf:"bytes,1,opt,name=roleDefinitionID" json:"roleDefinitionID,omitempty"`
 	Description      *string              `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
 	Status           *core.RoleStatus     `protobuf:"varint,3,opt,name=status" json:"status,omitempty"`
 	Permissions      *PermissionListValue `protobuf:"bytes,4,opt,name=permissions" json:"permissions,omitempty"`
 	Name             *string              `protobuf:"bytes,5,opt,name=name" json:"name,omitempty"`
+
+	// 客户端读取时的版本号，与当前版本不一致时返回版本冲突
+	Version *int64 `protobuf:"varint,6,opt,name=version" json:"version,omitempty"`
+
+	// 未指定时保持不变，传空列表表示清空
+	ExclusiveGroups  *core.StringListValue `protobuf:"bytes,7,opt,name=exclusiveGroups" json:"exclusiveGroups,omitempty"`
+	MaxAssignments   *int32                `protobuf:"varint,8,opt,name=maxAssignments" json:"maxAssignments,omitempty"`
+	RequiresApproval *bool                 `protobuf:"varint,9,opt,name=requiresApproval" json:"requiresApproval,omitempty"`
 }
 
 func (x *RoleDefinitionUpdateRequest) Reset() { *x = RoleDefinitionUpdateRequest{} }
@@ -1895,6 +2582,34 @@ func (x *RoleDefinitionUpdateRequest) GetName() string {
 	return ""
 }
 
+func (x *RoleDefinitionUpdateRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *RoleDefinitionUpdateRequest) GetExclusiveGroups() *core.StringListValue {
+	if x != nil {
+		return x.ExclusiveGroups
+	}
+	return nil
+}
+
+func (x *RoleDefinitionUpdateRequest) GetMaxAssignments() int32 {
+	if x != nil && x.MaxAssignments != nil {
+		return *x.MaxAssignments
+	}
+	return 0
+}
+
+func (x *RoleDefinitionUpdateRequest) GetRequiresApproval() bool {
+	if x != nil && x.RequiresApproval != nil {
+		return *x.RequiresApproval
+	}
+	return false
+}
+
 type RoleDefinitionQueryRequest struct {
 	Name         *string               `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	Status       *core.RoleStatus      `protobuf:"varint,2,opt,name=status" json:"status,omitempty"`
@@ -2026,6 +2741,16 @@ type AssignRoleToUserRequest struct {
 	UserID     *string `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
 	RoleID     *string `protobuf:"bytes,2,opt,name=roleID" json:"roleID,omitempty"`
 	AssignedBy *string `protobuf:"bytes,3,opt,name=assignedBy" json:"assignedBy,omitempty"`
+
+	// 角色生效的组织ID，为空表示全局分配（在用户所属的所有组织下生效）
+	OrganizationID *string `protobuf:"bytes,4,opt,name=organizationID" json:"organizationID,omitempty"`
+
+	// 生效时间窗口（毫秒时间戳），未指定表示不限；到期后自动回收
+	ValidFrom  *int64 `protobuf:"varint,5,opt,name=validFrom" json:"validFrom,omitempty"`
+	ValidUntil *int64 `protobuf:"varint,6,opt,name=validUntil" json:"validUntil,omitempty"`
+
+	// 申请理由，分配敏感角色时随授权申请提交给审批人
+	Reason *string `protobuf:"bytes,7,opt,name=reason" json:"reason,omitempty"`
 }
 
 func (x *AssignRoleToUserRequest) Reset() { *x = AssignRoleToUserRequest{} }
@@ -2057,6 +2782,34 @@ func (x *AssignRoleToUserRequest) GetAssignedBy() string {
 	return ""
 }
 
+func (x *AssignRoleToUserRequest) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *AssignRoleToUserRequest) GetValidFrom() int64 {
+	if x != nil && x.ValidFrom != nil {
+		return *x.ValidFrom
+	}
+	return 0
+}
+
+func (x *AssignRoleToUserRequest) GetValidUntil() int64 {
+	if x != nil && x.ValidUntil != nil {
+		return *x.ValidUntil
+	}
+	return 0
+}
+
+func (x *AssignRoleToUserRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
 type UpdateUserRoleAssignmentRequest struct {
 	AssignmentID *string `protobuf:"bytes,1,opt,name=assignmentID" json:"assignmentID,omitempty"`
 	UserID       *string `protobuf:"bytes,2,opt,name=userID" json:"userID,omitempty"`
@@ -2115,6 +2868,9 @@ type RevokeRoleFromUserRequest struct {
 	UserID    *string `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
 	RoleID    *string `protobuf:"bytes,2,opt,name=roleID" json:"roleID,omitempty"`
 	RevokedBy *string `protobuf:"bytes,3,opt,name=revokedBy" json:"revokedBy,omitempty"`
+
+	// 要撤销的分配所属组织ID，为空表示撤销全局分配
+	OrganizationID *string `protobuf:"bytes,4,opt,name=organizationID" json:"organizationID,omitempty"`
 }
 
 func (x *RevokeRoleFromUserRequest) Reset() { *x = RevokeRoleFromUserRequest{} }
@@ -2146,6 +2902,13 @@ func (x *RevokeRoleFromUserRequest) GetRevokedBy() string {
 	return ""
 }
 
+func (x *RevokeRoleFromUserRequest) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
 type RevokeRoleFromUserResponse struct {
 }
 
@@ -2159,6 +2922,10 @@ func (x *RevokeRoleFromUserResponse) Unmarshal(in []byte) error { return prutal.
 
 type UserRoleAssignmentResponse struct {
 	AssignmentID *string `protobuf:"bytes,1,opt,name=assignmentID" json:"assignmentID,omitempty"`
+
+	// 敏感角色不直接分配：pendingApproval 为 true，assignmentID 为空，返回待审批的申请ID
+	PendingApproval *bool   `protobuf:"varint,2,opt,name=pendingApproval" json:"pendingApproval,omitempty"`
+	GrantRequestID  *string `protobuf:"bytes,3,opt,name=grantRequestID" json:"grantRequestID,omitempty"`
 }
 
 func (x *UserRoleAssignmentResponse) Reset() { *x = UserRoleAssignmentResponse{} }
@@ -2176,10 +2943,30 @@ func (x *UserRoleAssignmentResponse) GetAssignmentID() string {
 	return ""
 }
 
+func (x *UserRoleAssignmentResponse) GetPendingApproval() bool {
+	if x != nil && x.PendingApproval != nil {
+		return *x.PendingApproval
+	}
+	return false
+}
+
+func (x *UserRoleAssignmentResponse) GetGrantRequestID() string {
+	if x != nil && x.GrantRequestID != nil {
+		return *x.GrantRequestID
+	}
+	return ""
+}
+
 type UserRoleQueryRequest struct {
 	UserID *string               `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
 	RoleID *string               `protobuf:"bytes,2,opt,name=roleID" json:"roleID,omitempty"`
 	Page   *rpc_base.PageRequest `protobuf:"bytes,3,opt,name=page" json:"page,omitempty"`
+
+	// 按组织过滤：返回全局分配及该组织下的分配；未指定时不过滤
+	OrganizationID *string `protobuf:"bytes,4,opt,name=organizationID" json:"organizationID,omitempty"`
+
+	// 仅返回在未来指定秒数内到期的分配（即将到期的临时授权）
+	ExpiringWithinSeconds *int64 `protobuf:"varint,5,opt,name=expiringWithinSeconds" json:"expiringWithinSeconds,omitempty"`
 }
 
 func (x *UserRoleQueryRequest) Reset() { *x = UserRoleQueryRequest{} }
@@ -2209,6 +2996,20 @@ func (x *UserRoleQueryRequest) GetPage() *rpc_base.PageRequest {
 	return nil
 }
 
+func (x *UserRoleQueryRequest) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *UserRoleQueryRequest) GetExpiringWithinSeconds() int64 {
+	if x != nil && x.ExpiringWithinSeconds != nil {
+		return *x.ExpiringWithinSeconds
+	}
+	return 0
+}
+
 type UserRoleListResponse struct {
 	Assignments []*UserRoleAssignment  `protobuf:"bytes,1,rep,name=assignments" json:"assignments,omitempty"`
 	Page        *rpc_base.PageResponse `protobuf:"bytes,2,opt,name=page" json:"page,omitempty"`
@@ -2236,6 +3037,9 @@ func (x *UserRoleListResponse) GetPage() *rpc_base.PageResponse {
 
 type GetUsersByRoleRequest struct {
 	RoleID *string `protobuf:"bytes,1,opt,name=roleID" json:"roleID,omitempty"`
+
+	// 按组织过滤：返回全局分配及该组织下的分配；未指定时不过滤
+	OrganizationID *string `protobuf:"bytes,2,opt,name=organizationID" json:"organizationID,omitempty"`
 }
 
 func (x *GetUsersByRoleRequest) Reset() { *x = GetUsersByRoleRequest{} }
@@ -2253,6 +3057,13 @@ func (x *GetUsersByRoleRequest) GetRoleID() string {
 	return ""
 }
 
+func (x *GetUsersByRoleRequest) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
 type GetUsersByRoleResponse struct {
 	RoleID  *string  `protobuf:"bytes,1,opt,name=roleID" json:"roleID,omitempty"`
 	UserIDs []string `protobuf:"bytes,2,rep,name=userIDs" json:"userIDs,omitempty"`
@@ -2413,29 +3224,742 @@ func (x *BatchGetUserRolesResponse) GetUserRoles() []*UserRoles {
 	return nil
 }
 
-type DeleteRoleDefinitionRequest struct {
-	RoleID *string `protobuf:"bytes,1,opt,name=roleID" json:"roleID,omitempty"`
+// 获取用户在指定租户（组织）下生效的角色，供网关切换租户时重新签发 token。
+type GetUserTenantRolesRequest struct {
+	UserID         *string `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
+	OrganizationID *string `protobuf:"bytes,2,opt,name=organizationID" json:"organizationID,omitempty"`
 }
 
-func (x *DeleteRoleDefinitionRequest) Reset() { *x = DeleteRoleDefinitionRequest{} }
+func (x *GetUserTenantRolesRequest) Reset() { *x = GetUserTenantRolesRequest{} }
 
-func (x *DeleteRoleDefinitionRequest) Marshal(in []byte) ([]byte, error) {
+func (x *GetUserTenantRolesRequest) Marshal(in []byte) ([]byte, error) {
 	return prutal.MarshalAppend(in, x)
 }
 
-func (x *DeleteRoleDefinitionRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+func (x *GetUserTenantRolesRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
 
-func (x *DeleteRoleDefinitionRequest) GetRoleID() string {
-	if x != nil && x.RoleID != nil {
-		return *x.RoleID
+func (x *GetUserTenantRolesRequest) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
 	}
 	return ""
 }
 
-type DeleteRoleDefinitionResponse struct {
+func (x *GetUserTenantRolesRequest) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
 }
 
-func (x *DeleteRoleDefinitionResponse) Reset() { *x = DeleteRoleDefinitionResponse{} }
+type GetUserTenantRolesResponse struct {
+	// role code 列表，与登录响应的 roles claim 语义一致。
+	RoleCodes   []string          `protobuf:"bytes,1,rep,name=roleCodes" json:"roleCodes,omitempty"`
+	RoleDetails []*RoleDefinition `protobuf:"bytes,2,rep,name=roleDetails" json:"roleDetails,omitempty"`
+}
+
+func (x *GetUserTenantRolesResponse) Reset() { *x = GetUserTenantRolesResponse{} }
+
+func (x *GetUserTenantRolesResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetUserTenantRolesResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetUserTenantRolesResponse) GetRoleCodes() []string {
+	if x != nil {
+		return x.RoleCodes
+	}
+	return nil
+}
+
+func (x *GetUserTenantRolesResponse) GetRoleDetails() []*RoleDefinition {
+	if x != nil {
+		return x.RoleDetails
+	}
+	return nil
+}
+
+type ListRoleConstraintViolationsRequest struct {
+	// 仅返回涉及该角色的违例，未指定时扫描全部配置了约束的角色
+	RoleID *string `protobuf:"bytes,1,opt,name=roleID" json:"roleID,omitempty"`
+}
+
+func (x *ListRoleConstraintViolationsRequest) Reset() { *x = ListRoleConstraintViolationsRequest{} }
+
+func (x *ListRoleConstraintViolationsRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListRoleConstraintViolationsRequest) Unmarshal(in []byte) error {
+	return prutal.Unmarshal(in, x)
+}
+
+func (x *ListRoleConstraintViolationsRequest) GetRoleID() string {
+	if x != nil && x.RoleID != nil {
+		return *x.RoleID
+	}
+	return ""
+}
+
+type ListRoleConstraintViolationsResponse struct {
+	Violations []*RoleConstraintViolation `protobuf:"bytes,1,rep,name=violations" json:"violations,omitempty"`
+}
+
+func (x *ListRoleConstraintViolationsResponse) Reset() { *x = ListRoleConstraintViolationsResponse{} }
+
+func (x *ListRoleConstraintViolationsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListRoleConstraintViolationsResponse) Unmarshal(in []byte) error {
+	return prutal.Unmarshal(in, x)
+}
+
+func (x *ListRoleConstraintViolationsResponse) GetViolations() []*RoleConstraintViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type ListRoleGrantRequestsRequest struct {
+	Page        *rpc_base.PageRequest   `protobuf:"bytes,1,opt,name=page" json:"page,omitempty"`
+	Status      *RoleGrantRequestStatus `protobuf:"varint,2,opt,name=status" json:"status,omitempty"`
+	UserID      *string                 `protobuf:"bytes,3,opt,name=userID" json:"userID,omitempty"`
+	RoleID      *string                 `protobuf:"bytes,4,opt,name=roleID" json:"roleID,omitempty"`
+	RequestedBy *string                 `protobuf:"bytes,5,opt,name=requestedBy" json:"requestedBy,omitempty"`
+}
+
+func (x *ListRoleGrantRequestsRequest) Reset() { *x = ListRoleGrantRequestsRequest{} }
+
+func (x *ListRoleGrantRequestsRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListRoleGrantRequestsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListRoleGrantRequestsRequest) GetPage() *rpc_base.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListRoleGrantRequestsRequest) GetStatus() RoleGrantRequestStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return RoleGrantRequestStatus_ROLE_GRANT_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *ListRoleGrantRequestsRequest) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *ListRoleGrantRequestsRequest) GetRoleID() string {
+	if x != nil && x.RoleID != nil {
+		return *x.RoleID
+	}
+	return ""
+}
+
+func (x *ListRoleGrantRequestsRequest) GetRequestedBy() string {
+	if x != nil && x.RequestedBy != nil {
+		return *x.RequestedBy
+	}
+	return ""
+}
+
+type ListRoleGrantRequestsResponse struct {
+	Requests []*RoleGrantRequest    `protobuf:"bytes,1,rep,name=requests" json:"requests,omitempty"`
+	Page     *rpc_base.PageResponse `protobuf:"bytes,2,opt,name=page" json:"page,omitempty"`
+}
+
+func (x *ListRoleGrantRequestsResponse) Reset() { *x = ListRoleGrantRequestsResponse{} }
+
+func (x *ListRoleGrantRequestsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListRoleGrantRequestsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListRoleGrantRequestsResponse) GetRequests() []*RoleGrantRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *ListRoleGrantRequestsResponse) GetPage() *rpc_base.PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GetRoleGrantRequestRequest struct {
+	RequestID *string `protobuf:"bytes,1,opt,name=requestID" json:"requestID,omitempty"`
+}
+
+func (x *GetRoleGrantRequestRequest) Reset() { *x = GetRoleGrantRequestRequest{} }
+
+func (x *GetRoleGrantRequestRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetRoleGrantRequestRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetRoleGrantRequestRequest) GetRequestID() string {
+	if x != nil && x.RequestID != nil {
+		return *x.RequestID
+	}
+	return ""
+}
+
+type GetRoleGrantRequestResponse struct {
+	Request *RoleGrantRequest `protobuf:"bytes,1,opt,name=request" json:"request,omitempty"`
+}
+
+func (x *GetRoleGrantRequestResponse) Reset() { *x = GetRoleGrantRequestResponse{} }
+
+func (x *GetRoleGrantRequestResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetRoleGrantRequestResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetRoleGrantRequestResponse) GetRequest() *RoleGrantRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ApproveRoleGrantRequest struct {
+	RequestID *string `protobuf:"bytes,1,opt,name=requestID" json:"requestID,omitempty"`
+
+	// 审批人，不能是申请人或被授权用户本人
+	ApproverID *string `protobuf:"bytes,2,opt,name=approverID" json:"approverID,omitempty"`
+	Comment    *string `protobuf:"bytes,3,opt,name=comment" json:"comment,omitempty"`
+}
+
+func (x *ApproveRoleGrantRequest) Reset() { *x = ApproveRoleGrantRequest{} }
+
+func (x *ApproveRoleGrantRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ApproveRoleGrantRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ApproveRoleGrantRequest) GetRequestID() string {
+	if x != nil && x.RequestID != nil {
+		return *x.RequestID
+	}
+	return ""
+}
+
+func (x *ApproveRoleGrantRequest) GetApproverID() string {
+	if x != nil && x.ApproverID != nil {
+		return *x.ApproverID
+	}
+	return ""
+}
+
+func (x *ApproveRoleGrantRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+type ApproveRoleGrantResponse struct {
+	Request *RoleGrantRequest `protobuf:"bytes,1,opt,name=request" json:"request,omitempty"`
+}
+
+func (x *ApproveRoleGrantResponse) Reset() { *x = ApproveRoleGrantResponse{} }
+
+func (x *ApproveRoleGrantResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ApproveRoleGrantResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ApproveRoleGrantResponse) GetRequest() *RoleGrantRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type RejectRoleGrantRequest struct {
+	RequestID  *string `protobuf:"bytes,1,opt,name=requestID" json:"requestID,omitempty"`
+	ApproverID *string `protobuf:"bytes,2,opt,name=approverID" json:"approverID,omitempty"`
+	Comment    *string `protobuf:"bytes,3,opt,name=comment" json:"comment,omitempty"`
+}
+
+func (x *RejectRoleGrantRequest) Reset() { *x = RejectRoleGrantRequest{} }
+
+func (x *RejectRoleGrantRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *RejectRoleGrantRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RejectRoleGrantRequest) GetRequestID() string {
+	if x != nil && x.RequestID != nil {
+		return *x.RequestID
+	}
+	return ""
+}
+
+func (x *RejectRoleGrantRequest) GetApproverID() string {
+	if x != nil && x.ApproverID != nil {
+		return *x.ApproverID
+	}
+	return ""
+}
+
+func (x *RejectRoleGrantRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+type RejectRoleGrantResponse struct {
+	Request *RoleGrantRequest `protobuf:"bytes,1,opt,name=request" json:"request,omitempty"`
+}
+
+func (x *RejectRoleGrantResponse) Reset() { *x = RejectRoleGrantResponse{} }
+
+func (x *RejectRoleGrantResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *RejectRoleGrantResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RejectRoleGrantResponse) GetRequest() *RoleGrantRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type CreateAccessReviewCampaignRequest struct {
+	Name        *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Description *string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+
+	// 复核范围：仅该组织下的分配，为空表示全部（含全局分配）
+	OrganizationID *string `protobuf:"bytes,3,opt,name=organizationID" json:"organizationID,omitempty"`
+
+	// 复核范围：仅该角色的分配，为空表示全部角色
+	RoleID     *string `protobuf:"bytes,4,opt,name=roleID" json:"roleID,omitempty"`
+	ReviewerID *string `protobuf:"bytes,5,opt,name=reviewerID" json:"reviewerID,omitempty"`
+	DueAt      *int64  `protobuf:"varint,6,opt,name=dueAt" json:"dueAt,omitempty"`
+	AutoRevoke *bool   `protobuf:"varint,7,opt,name=autoRevoke" json:"autoRevoke,omitempty"`
+	CreatedBy  *string `protobuf:"bytes,8,opt,name=createdBy" json:"createdBy,omitempty"`
+}
+
+func (x *CreateAccessReviewCampaignRequest) Reset() { *x = CreateAccessReviewCampaignRequest{} }
+
+func (x *CreateAccessReviewCampaignRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *CreateAccessReviewCampaignRequest) Unmarshal(in []byte) error {
+	return prutal.Unmarshal(in, x)
+}
+
+func (x *CreateAccessReviewCampaignRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *CreateAccessReviewCampaignRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateAccessReviewCampaignRequest) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *CreateAccessReviewCampaignRequest) GetRoleID() string {
+	if x != nil && x.RoleID != nil {
+		return *x.RoleID
+	}
+	return ""
+}
+
+func (x *CreateAccessReviewCampaignRequest) GetReviewerID() string {
+	if x != nil && x.ReviewerID != nil {
+		return *x.ReviewerID
+	}
+	return ""
+}
+
+func (x *CreateAccessReviewCampaignRequest) GetDueAt() int64 {
+	if x != nil && x.DueAt != nil {
+		return *x.DueAt
+	}
+	return 0
+}
+
+func (x *CreateAccessReviewCampaignRequest) GetAutoRevoke() bool {
+	if x != nil && x.AutoRevoke != nil {
+		return *x.AutoRevoke
+	}
+	return false
+}
+
+func (x *CreateAccessReviewCampaignRequest) GetCreatedBy() string {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return ""
+}
+
+type CreateAccessReviewCampaignResponse struct {
+	Campaign *AccessReviewCampaign `protobuf:"bytes,1,opt,name=campaign" json:"campaign,omitempty"`
+}
+
+func (x *CreateAccessReviewCampaignResponse) Reset() { *x = CreateAccessReviewCampaignResponse{} }
+
+func (x *CreateAccessReviewCampaignResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *CreateAccessReviewCampaignResponse) Unmarshal(in []byte) error {
+	return prutal.Unmarshal(in, x)
+}
+
+func (x *CreateAccessReviewCampaignResponse) GetCampaign() *AccessReviewCampaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+type ListAccessReviewCampaignsRequest struct {
+	Page           *rpc_base.PageRequest       `protobuf:"bytes,1,opt,name=page" json:"page,omitempty"`
+	Status         *AccessReviewCampaignStatus `protobuf:"varint,2,opt,name=status" json:"status,omitempty"`
+	OrganizationID *string                     `protobuf:"bytes,3,opt,name=organizationID" json:"organizationID,omitempty"`
+}
+
+func (x *ListAccessReviewCampaignsRequest) Reset() { *x = ListAccessReviewCampaignsRequest{} }
+
+func (x *ListAccessReviewCampaignsRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListAccessReviewCampaignsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListAccessReviewCampaignsRequest) GetPage() *rpc_base.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListAccessReviewCampaignsRequest) GetStatus() AccessReviewCampaignStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return AccessReviewCampaignStatus_ACCESS_REVIEW_CAMPAIGN_STATUS_UNSPECIFIED
+}
+
+func (x *ListAccessReviewCampaignsRequest) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+type ListAccessReviewCampaignsResponse struct {
+	Campaigns []*AccessReviewCampaign `protobuf:"bytes,1,rep,name=campaigns" json:"campaigns,omitempty"`
+	Page      *rpc_base.PageResponse  `protobuf:"bytes,2,opt,name=page" json:"page,omitempty"`
+}
+
+func (x *ListAccessReviewCampaignsResponse) Reset() { *x = ListAccessReviewCampaignsResponse{} }
+
+func (x *ListAccessReviewCampaignsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListAccessReviewCampaignsResponse) Unmarshal(in []byte) error {
+	return prutal.Unmarshal(in, x)
+}
+
+func (x *ListAccessReviewCampaignsResponse) GetCampaigns() []*AccessReviewCampaign {
+	if x != nil {
+		return x.Campaigns
+	}
+	return nil
+}
+
+func (x *ListAccessReviewCampaignsResponse) GetPage() *rpc_base.PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GetAccessReviewCampaignRequest struct {
+	CampaignID *string `protobuf:"bytes,1,opt,name=campaignID" json:"campaignID,omitempty"`
+}
+
+func (x *GetAccessReviewCampaignRequest) Reset() { *x = GetAccessReviewCampaignRequest{} }
+
+func (x *GetAccessReviewCampaignRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetAccessReviewCampaignRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetAccessReviewCampaignRequest) GetCampaignID() string {
+	if x != nil && x.CampaignID != nil {
+		return *x.CampaignID
+	}
+	return ""
+}
+
+type GetAccessReviewCampaignResponse struct {
+	Campaign *AccessReviewCampaign `protobuf:"bytes,1,opt,name=campaign" json:"campaign,omitempty"`
+}
+
+func (x *GetAccessReviewCampaignResponse) Reset() { *x = GetAccessReviewCampaignResponse{} }
+
+func (x *GetAccessReviewCampaignResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetAccessReviewCampaignResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetAccessReviewCampaignResponse) GetCampaign() *AccessReviewCampaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+type ListAccessReviewItemsRequest struct {
+	CampaignID *string               `protobuf:"bytes,1,opt,name=campaignID" json:"campaignID,omitempty"`
+	Page       *rpc_base.PageRequest `protobuf:"bytes,2,opt,name=page" json:"page,omitempty"`
+	Decision   *AccessReviewDecision `protobuf:"varint,3,opt,name=decision" json:"decision,omitempty"`
+	UserID     *string               `protobuf:"bytes,4,opt,name=userID" json:"userID,omitempty"`
+}
+
+func (x *ListAccessReviewItemsRequest) Reset() { *x = ListAccessReviewItemsRequest{} }
+
+func (x *ListAccessReviewItemsRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListAccessReviewItemsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListAccessReviewItemsRequest) GetCampaignID() string {
+	if x != nil && x.CampaignID != nil {
+		return *x.CampaignID
+	}
+	return ""
+}
+
+func (x *ListAccessReviewItemsRequest) GetPage() *rpc_base.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListAccessReviewItemsRequest) GetDecision() AccessReviewDecision {
+	if x != nil && x.Decision != nil {
+		return *x.Decision
+	}
+	return AccessReviewDecision_ACCESS_REVIEW_DECISION_UNSPECIFIED
+}
+
+func (x *ListAccessReviewItemsRequest) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+type ListAccessReviewItemsResponse struct {
+	Items []*AccessReviewItem    `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	Page  *rpc_base.PageResponse `protobuf:"bytes,2,opt,name=page" json:"page,omitempty"`
+}
+
+func (x *ListAccessReviewItemsResponse) Reset() { *x = ListAccessReviewItemsResponse{} }
+
+func (x *ListAccessReviewItemsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListAccessReviewItemsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListAccessReviewItemsResponse) GetItems() []*AccessReviewItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListAccessReviewItemsResponse) GetPage() *rpc_base.PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type DecideAccessReviewItemRequest struct {
+	ItemID *string `protobuf:"bytes,1,opt,name=itemID" json:"itemID,omitempty"`
+
+	// 仅支持 CERTIFIED 或 REVOKED
+	Decision *AccessReviewDecision `protobuf:"varint,2,opt,name=decision" json:"decision,omitempty"`
+
+	// 复核人，不能复核自己的角色分配
+	ReviewerID *string `protobuf:"bytes,3,opt,name=reviewerID" json:"reviewerID,omitempty"`
+	Comment    *string `protobuf:"bytes,4,opt,name=comment" json:"comment,omitempty"`
+}
+
+func (x *DecideAccessReviewItemRequest) Reset() { *x = DecideAccessReviewItemRequest{} }
+
+func (x *DecideAccessReviewItemRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *DecideAccessReviewItemRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *DecideAccessReviewItemRequest) GetItemID() string {
+	if x != nil && x.ItemID != nil {
+		return *x.ItemID
+	}
+	return ""
+}
+
+func (x *DecideAccessReviewItemRequest) GetDecision() AccessReviewDecision {
+	if x != nil && x.Decision != nil {
+		return *x.Decision
+	}
+	return AccessReviewDecision_ACCESS_REVIEW_DECISION_UNSPECIFIED
+}
+
+func (x *DecideAccessReviewItemRequest) GetReviewerID() string {
+	if x != nil && x.ReviewerID != nil {
+		return *x.ReviewerID
+	}
+	return ""
+}
+
+func (x *DecideAccessReviewItemRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+type DecideAccessReviewItemResponse struct {
+	Item *AccessReviewItem `protobuf:"bytes,1,opt,name=item" json:"item,omitempty"`
+}
+
+func (x *DecideAccessReviewItemResponse) Reset() { *x = DecideAccessReviewItemResponse{} }
+
+func (x *DecideAccessReviewItemResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *DecideAccessReviewItemResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *DecideAccessReviewItemResponse) GetItem() *AccessReviewItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type CloseAccessReviewCampaignRequest struct {
+	CampaignID *string `protobuf:"bytes,1,opt,name=campaignID" json:"campaignID,omitempty"`
+	ClosedBy   *string `protobuf:"bytes,2,opt,name=closedBy" json:"closedBy,omitempty"`
+}
+
+func (x *CloseAccessReviewCampaignRequest) Reset() { *x = CloseAccessReviewCampaignRequest{} }
+
+func (x *CloseAccessReviewCampaignRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *CloseAccessReviewCampaignRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CloseAccessReviewCampaignRequest) GetCampaignID() string {
+	if x != nil && x.CampaignID != nil {
+		return *x.CampaignID
+	}
+	return ""
+}
+
+func (x *CloseAccessReviewCampaignRequest) GetClosedBy() string {
+	if x != nil && x.ClosedBy != nil {
+		return *x.ClosedBy
+	}
+	return ""
+}
+
+type CloseAccessReviewCampaignResponse struct {
+	Campaign *AccessReviewCampaign `protobuf:"bytes,1,opt,name=campaign" json:"campaign,omitempty"`
+}
+
+func (x *CloseAccessReviewCampaignResponse) Reset() { *x = CloseAccessReviewCampaignResponse{} }
+
+func (x *CloseAccessReviewCampaignResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *CloseAccessReviewCampaignResponse) Unmarshal(in []byte) error {
+	return prutal.Unmarshal(in, x)
+}
+
+func (x *CloseAccessReviewCampaignResponse) GetCampaign() *AccessReviewCampaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+type DeleteRoleDefinitionRequest struct {
+	RoleID *string `protobuf:"bytes,1,opt,name=roleID" json:"roleID,omitempty"`
+}
+
+func (x *DeleteRoleDefinitionRequest) Reset() { *x = DeleteRoleDefinitionRequest{} }
+
+func (x *DeleteRoleDefinitionRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *DeleteRoleDefinitionRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *DeleteRoleDefinitionRequest) GetRoleID() string {
+	if x != nil && x.RoleID != nil {
+		return *x.RoleID
+	}
+	return ""
+}
+
+type DeleteRoleDefinitionResponse struct {
+}
+
+func (x *DeleteRoleDefinitionResponse) Reset() { *x = DeleteRoleDefinitionResponse{} }
 
 func (x *DeleteRoleDefinitionResponse) Marshal(in []byte) ([]byte, error) {
 	return prutal.MarshalAppend(in, x)
@@ -2505,6 +4029,10 @@ func (x *GetLastUserRoleAssignmentResponse) GetUserRoleAssignment() *UserRoleAss
 type UploadMenuRequest struct {
 	ProductLine *string `protobuf:"bytes,1,opt,name=productLine" json:"productLine,omitempty"`
 	YamlContent *string `protobuf:"bytes,2,opt,name=yamlContent" json:"yamlContent,omitempty"`
+
+	// 仅返回与当前版本的差异和将失效的授权，不写入新版本
+	DryRun     *bool   `protobuf:"varint,3,opt,name=dryRun" json:"dryRun,omitempty"`
+	OperatorID *string `protobuf:"bytes,4,opt,name=operatorID" json:"operatorID,omitempty"`
 }
 
 func (x *UploadMenuRequest) Reset() { *x = UploadMenuRequest{} }
@@ -2527,7 +4055,28 @@ func (x *UploadMenuRequest) GetYamlContent() string {
 	return ""
 }
 
+func (x *UploadMenuRequest) GetDryRun() bool {
+	if x != nil && x.DryRun != nil {
+		return *x.DryRun
+	}
+	return false
+}
+
+func (x *UploadMenuRequest) GetOperatorID() string {
+	if x != nil && x.OperatorID != nil {
+		return *x.OperatorID
+	}
+	return ""
+}
+
 type UploadMenuResponse struct {
+	// 新建的版本号；内容未变化或试运行时为当前版本号
+	Version *int32 `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+
+	// 是否创建了新版本
+	Created        *bool                `protobuf:"varint,2,opt,name=created" json:"created,omitempty"`
+	Changes        []*MenuDiffEntry     `protobuf:"bytes,3,rep,name=changes" json:"changes,omitempty"`
+	OrphanedGrants []*OrphanedMenuGrant `protobuf:"bytes,4,rep,name=orphanedGrants" json:"orphanedGrants,omitempty"`
 }
 
 func (x *UploadMenuResponse) Reset() { *x = UploadMenuResponse{} }
@@ -2536,7 +4085,259 @@ func (x *UploadMenuResponse) Marshal(in []byte) ([]byte, error) { return prutal.
 
 func (x *UploadMenuResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
 
+func (x *UploadMenuResponse) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *UploadMenuResponse) GetCreated() bool {
+	if x != nil && x.Created != nil {
+		return *x.Created
+	}
+	return false
+}
+
+func (x *UploadMenuResponse) GetChanges() []*MenuDiffEntry {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *UploadMenuResponse) GetOrphanedGrants() []*OrphanedMenuGrant {
+	if x != nil {
+		return x.OrphanedGrants
+	}
+	return nil
+}
+
+type ListMenuVersionsRequest struct {
+	ProductLine *string `protobuf:"bytes,1,opt,name=productLine" json:"productLine,omitempty"`
+}
+
+func (x *ListMenuVersionsRequest) Reset() { *x = ListMenuVersionsRequest{} }
+
+func (x *ListMenuVersionsRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListMenuVersionsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListMenuVersionsRequest) GetProductLine() string {
+	if x != nil && x.ProductLine != nil {
+		return *x.ProductLine
+	}
+	return ""
+}
+
+type ListMenuVersionsResponse struct {
+	// 按版本号倒序
+	Versions      []*MenuVersionInfo `protobuf:"bytes,1,rep,name=versions" json:"versions,omitempty"`
+	ActiveVersion *int32             `protobuf:"varint,2,opt,name=activeVersion" json:"activeVersion,omitempty"`
+}
+
+func (x *ListMenuVersionsResponse) Reset() { *x = ListMenuVersionsResponse{} }
+
+func (x *ListMenuVersionsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListMenuVersionsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListMenuVersionsResponse) GetVersions() []*MenuVersionInfo {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *ListMenuVersionsResponse) GetActiveVersion() int32 {
+	if x != nil && x.ActiveVersion != nil {
+		return *x.ActiveVersion
+	}
+	return 0
+}
+
+type DiffMenuVersionsRequest struct {
+	ProductLine *string `protobuf:"bytes,1,opt,name=productLine" json:"productLine,omitempty"`
+
+	// 为空时取当前生效版本
+	FromVersion *int32 `protobuf:"varint,2,opt,name=fromVersion" json:"fromVersion,omitempty"`
+	ToVersion   *int32 `protobuf:"varint,3,opt,name=toVersion" json:"toVersion,omitempty"`
+}
+
+func (x *DiffMenuVersionsRequest) Reset() { *x = DiffMenuVersionsRequest{} }
+
+func (x *DiffMenuVersionsRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *DiffMenuVersionsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *DiffMenuVersionsRequest) GetProductLine() string {
+	if x != nil && x.ProductLine != nil {
+		return *x.ProductLine
+	}
+	return ""
+}
+
+func (x *DiffMenuVersionsRequest) GetFromVersion() int32 {
+	if x != nil && x.FromVersion != nil {
+		return *x.FromVersion
+	}
+	return 0
+}
+
+func (x *DiffMenuVersionsRequest) GetToVersion() int32 {
+	if x != nil && x.ToVersion != nil {
+		return *x.ToVersion
+	}
+	return 0
+}
+
+type DiffMenuVersionsResponse struct {
+	FromVersion *int32           `protobuf:"varint,1,opt,name=fromVersion" json:"fromVersion,omitempty"`
+	ToVersion   *int32           `protobuf:"varint,2,opt,name=toVersion" json:"toVersion,omitempty"`
+	Changes     []*MenuDiffEntry `protobuf:"bytes,3,rep,name=changes" json:"changes,omitempty"`
+
+	// 从 fromVersion 切换到 toVersion 时将失效的角色菜单授权
+	OrphanedGrants []*OrphanedMenuGrant `protobuf:"bytes,4,rep,name=orphanedGrants" json:"orphanedGrants,omitempty"`
+}
+
+func (x *DiffMenuVersionsResponse) Reset() { *x = DiffMenuVersionsResponse{} }
+
+func (x *DiffMenuVersionsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *DiffMenuVersionsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *DiffMenuVersionsResponse) GetFromVersion() int32 {
+	if x != nil && x.FromVersion != nil {
+		return *x.FromVersion
+	}
+	return 0
+}
+
+func (x *DiffMenuVersionsResponse) GetToVersion() int32 {
+	if x != nil && x.ToVersion != nil {
+		return *x.ToVersion
+	}
+	return 0
+}
+
+func (x *DiffMenuVersionsResponse) GetChanges() []*MenuDiffEntry {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *DiffMenuVersionsResponse) GetOrphanedGrants() []*OrphanedMenuGrant {
+	if x != nil {
+		return x.OrphanedGrants
+	}
+	return nil
+}
+
+type ActivateMenuVersionRequest struct {
+	ProductLine *string `protobuf:"bytes,1,opt,name=productLine" json:"productLine,omitempty"`
+
+	// 要恢复的历史版本号
+	Version *int32 `protobuf:"varint,2,opt,name=version" json:"version,omitempty"`
+
+	// 仅返回差异和将失效的授权，不切换版本
+	DryRun     *bool   `protobuf:"varint,3,opt,name=dryRun" json:"dryRun,omitempty"`
+	OperatorID *string `protobuf:"bytes,4,opt,name=operatorID" json:"operatorID,omitempty"`
+}
+
+func (x *ActivateMenuVersionRequest) Reset() { *x = ActivateMenuVersionRequest{} }
+
+func (x *ActivateMenuVersionRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ActivateMenuVersionRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ActivateMenuVersionRequest) GetProductLine() string {
+	if x != nil && x.ProductLine != nil {
+		return *x.ProductLine
+	}
+	return ""
+}
+
+func (x *ActivateMenuVersionRequest) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *ActivateMenuVersionRequest) GetDryRun() bool {
+	if x != nil && x.DryRun != nil {
+		return *x.DryRun
+	}
+	return false
+}
+
+func (x *ActivateMenuVersionRequest) GetOperatorID() string {
+	if x != nil && x.OperatorID != nil {
+		return *x.OperatorID
+	}
+	return ""
+}
+
+type ActivateMenuVersionResponse struct {
+	// 切换后的生效版本号（历史版本内容复制为新版本）；未切换时为当前版本号
+	ActiveVersion *int32 `protobuf:"varint,1,opt,name=activeVersion" json:"activeVersion,omitempty"`
+
+	// 是否实际切换
+	Activated      *bool                `protobuf:"varint,2,opt,name=activated" json:"activated,omitempty"`
+	Changes        []*MenuDiffEntry     `protobuf:"bytes,3,rep,name=changes" json:"changes,omitempty"`
+	OrphanedGrants []*OrphanedMenuGrant `protobuf:"bytes,4,rep,name=orphanedGrants" json:"orphanedGrants,omitempty"`
+}
+
+func (x *ActivateMenuVersionResponse) Reset() { *x = ActivateMenuVersionResponse{} }
+
+func (x *ActivateMenuVersionResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ActivateMenuVersionResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ActivateMenuVersionResponse) GetActiveVersion() int32 {
+	if x != nil && x.ActiveVersion != nil {
+		return *x.ActiveVersion
+	}
+	return 0
+}
+
+func (x *ActivateMenuVersionResponse) GetActivated() bool {
+	if x != nil && x.Activated != nil {
+		return *x.Activated
+	}
+	return false
+}
+
+func (x *ActivateMenuVersionResponse) GetChanges() []*MenuDiffEntry {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ActivateMenuVersionResponse) GetOrphanedGrants() []*OrphanedMenuGrant {
+	if x != nil {
+		return x.OrphanedGrants
+	}
+	return nil
+}
+
 type GetMenuTreeRequest struct {
+	// 菜单产品线，为空时使用 default
+	ProductLine *string `protobuf:"bytes,1,opt,name=productLine" json:"productLine,omitempty"`
 }
 
 func (x *GetMenuTreeRequest) Reset() { *x = GetMenuTreeRequest{} }
@@ -2545,6 +4346,13 @@ func (x *GetMenuTreeRequest) Marshal(in []byte) ([]byte, error) { return prutal.
 
 func (x *GetMenuTreeRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
 
+func (x *GetMenuTreeRequest) GetProductLine() string {
+	if x != nil && x.ProductLine != nil {
+		return *x.ProductLine
+	}
+	return ""
+}
+
 type GetMenuTreeResponse struct {
 	MenuTree []*MenuNode `protobuf:"bytes,1,rep,name=menuTree" json:"menuTree,omitempty"`
 }
@@ -2616,6 +4424,9 @@ type ConfigureRoleMenusRequest struct {
 	RoleID      *string       `protobuf:"bytes,1,opt,name=roleID" json:"roleID,omitempty"`
 	MenuConfigs []*MenuConfig `protobuf:"bytes,2,rep,name=menuConfigs" json:"menuConfigs,omitempty"`
 	OperatorID  *string       `protobuf:"bytes,3,opt,name=operatorID" json:"operatorID,omitempty"`
+
+	// 只替换该产品线下的授权，其他产品线的授权保持不变
+	ProductLine *string `protobuf:"bytes,4,opt,name=productLine" json:"productLine,omitempty"`
 }
 
 func (x *ConfigureRoleMenusRequest) Reset() { *x = ConfigureRoleMenusRequest{} }
@@ -2647,6 +4458,13 @@ func (x *ConfigureRoleMenusRequest) GetOperatorID() string {
 	return ""
 }
 
+func (x *ConfigureRoleMenusRequest) GetProductLine() string {
+	if x != nil && x.ProductLine != nil {
+		return *x.ProductLine
+	}
+	return ""
+}
+
 type ConfigureRoleMenusResponse struct {
 	Success *bool   `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
 	Message *string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
@@ -2676,6 +4494,9 @@ func (x *ConfigureRoleMenusResponse) GetMessage() string {
 
 type GetRoleMenuTreeRequest struct {
 	RoleID *string `protobuf:"bytes,1,opt,name=roleID" json:"roleID,omitempty"`
+
+	// 菜单产品线，为空时使用 default
+	ProductLine *string `protobuf:"bytes,2,opt,name=productLine" json:"productLine,omitempty"`
 }
 
 func (x *GetRoleMenuTreeRequest) Reset() { *x = GetRoleMenuTreeRequest{} }
@@ -2693,6 +4514,13 @@ func (x *GetRoleMenuTreeRequest) GetRoleID() string {
 	return ""
 }
 
+func (x *GetRoleMenuTreeRequest) GetProductLine() string {
+	if x != nil && x.ProductLine != nil {
+		return *x.ProductLine
+	}
+	return ""
+}
+
 type GetRoleMenuTreeResponse struct {
 	MenuTree []*MenuNode `protobuf:"bytes,1,rep,name=menuTree" json:"menuTree,omitempty"`
 	RoleID   *string     `protobuf:"bytes,2,opt,name=roleID" json:"roleID,omitempty"`
@@ -2722,6 +4550,15 @@ func (x *GetRoleMenuTreeResponse) GetRoleID() string {
 
 type GetUserMenuTreeRequest struct {
 	UserID *string `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
+
+	// 当前租户（组织）ID，仅计算全局角色及该组织下的角色；未指定时计算全部角色
+	OrganizationID *string `protobuf:"bytes,2,opt,name=organizationID" json:"organizationID,omitempty"`
+
+	// 菜单产品线，为空时使用 default
+	ProductLine *string `protobuf:"bytes,3,opt,name=productLine" json:"productLine,omitempty"`
+
+	// 客户端标识，按配置映射到菜单产品线；同时指定 productLine 时以 productLine 为准
+	ClientID *string `protobuf:"bytes,4,opt,name=clientID" json:"clientID,omitempty"`
 }
 
 func (x *GetUserMenuTreeRequest) Reset() { *x = GetUserMenuTreeRequest{} }
@@ -2739,6 +4576,27 @@ func (x *GetUserMenuTreeRequest) GetUserID() string {
 	return ""
 }
 
+func (x *GetUserMenuTreeRequest) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *GetUserMenuTreeRequest) GetProductLine() string {
+	if x != nil && x.ProductLine != nil {
+		return *x.ProductLine
+	}
+	return ""
+}
+
+func (x *GetUserMenuTreeRequest) GetClientID() string {
+	if x != nil && x.ClientID != nil {
+		return *x.ClientID
+	}
+	return ""
+}
+
 type GetUserMenuTreeResponse struct {
 	MenuTree []*MenuNode `protobuf:"bytes,1,rep,name=menuTree" json:"menuTree,omitempty"`
 	UserID   *string     `protobuf:"bytes,2,opt,name=userID" json:"userID,omitempty"`
@@ -2776,6 +4634,9 @@ func (x *GetUserMenuTreeResponse) GetRoleIDs() []string {
 
 type GetRoleMenuPermissionsRequest struct {
 	RoleID *string `protobuf:"bytes,1,opt,name=roleID" json:"roleID,omitempty"`
+
+	// 菜单产品线，为空时使用 default
+	ProductLine *string `protobuf:"bytes,2,opt,name=productLine" json:"productLine,omitempty"`
 }
 
 func (x *GetRoleMenuPermissionsRequest) Reset() { *x = GetRoleMenuPermissionsRequest{} }
@@ -2793,6 +4654,13 @@ func (x *GetRoleMenuPermissionsRequest) GetRoleID() string {
 	return ""
 }
 
+func (x *GetRoleMenuPermissionsRequest) GetProductLine() string {
+	if x != nil && x.ProductLine != nil {
+		return *x.ProductLine
+	}
+	return ""
+}
+
 type GetRoleMenuPermissionsResponse struct {
 	Permissions []*MenuPermission `protobuf:"bytes,1,rep,name=permissions" json:"permissions,omitempty"`
 	RoleID      *string           `protobuf:"bytes,2,opt,name=roleID" json:"roleID,omitempty"`
@@ -2824,6 +4692,9 @@ type HasMenuPermissionRequest struct {
 	RoleID     *string          `protobuf:"bytes,1,opt,name=roleID" json:"roleID,omitempty"`
 	MenuID     *string          `protobuf:"bytes,2,opt,name=menuID" json:"menuID,omitempty"`
 	Permission *PermissionLevel `protobuf:"varint,3,opt,name=permission" json:"permission,omitempty"`
+
+	// 菜单产品线，为空时使用 default
+	ProductLine *string `protobuf:"bytes,4,opt,name=productLine" json:"productLine,omitempty"`
 }
 
 func (x *HasMenuPermissionRequest) Reset() { *x = HasMenuPermissionRequest{} }
@@ -2855,6 +4726,13 @@ func (x *HasMenuPermissionRequest) GetPermission() PermissionLevel {
 	return PermissionLevel_PERMISSION_LEVEL_UNSPECIFIED
 }
 
+func (x *HasMenuPermissionRequest) GetProductLine() string {
+	if x != nil && x.ProductLine != nil {
+		return *x.ProductLine
+	}
+	return ""
+}
+
 type HasMenuPermissionResponse struct {
 	HasPermission *bool            `protobuf:"varint,1,opt,name=hasPermission" json:"hasPermission,omitempty"`
 	RoleID        *string          `protobuf:"bytes,2,opt,name=roleID" json:"roleID,omitempty"`
@@ -2888,68 +4766,354 @@ func (x *HasMenuPermissionResponse) GetMenuID() string {
 	if x != nil && x.MenuID != nil {
 		return *x.MenuID
 	}
-	return ""
+	return ""
+}
+
+func (x *HasMenuPermissionResponse) GetPermission() PermissionLevel {
+	if x != nil && x.Permission != nil {
+		return *x.Permission
+	}
+	return PermissionLevel_PERMISSION_LEVEL_UNSPECIFIED
+}
+
+type GetUserMenuPermissionsRequest struct {
+	UserID *string `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
+
+	// 当前租户（组织）ID，仅计算全局角色及该组织下的角色；未指定时计算全部角色
+	OrganizationID *string `protobuf:"bytes,2,opt,name=organizationID" json:"organizationID,omitempty"`
+
+	// 菜单产品线，为空时使用 default
+	ProductLine *string `protobuf:"bytes,3,opt,name=productLine" json:"productLine,omitempty"`
+
+	// 客户端标识，按配置映射到菜单产品线；同时指定 productLine 时以 productLine 为准
+	ClientID *string `protobuf:"bytes,4,opt,name=clientID" json:"clientID,omitempty"`
+}
+
+func (x *GetUserMenuPermissionsRequest) Reset() { *x = GetUserMenuPermissionsRequest{} }
+
+func (x *GetUserMenuPermissionsRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetUserMenuPermissionsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetUserMenuPermissionsRequest) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *GetUserMenuPermissionsRequest) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *GetUserMenuPermissionsRequest) GetProductLine() string {
+	if x != nil && x.ProductLine != nil {
+		return *x.ProductLine
+	}
+	return ""
+}
+
+func (x *GetUserMenuPermissionsRequest) GetClientID() string {
+	if x != nil && x.ClientID != nil {
+		return *x.ClientID
+	}
+	return ""
+}
+
+type GetUserMenuPermissionsResponse struct {
+	Permissions []*MenuPermission `protobuf:"bytes,1,rep,name=permissions" json:"permissions,omitempty"`
+	UserID      *string           `protobuf:"bytes,2,opt,name=userID" json:"userID,omitempty"`
+	RoleIDs     []string          `protobuf:"bytes,3,rep,name=roleIDs" json:"roleIDs,omitempty"`
+}
+
+func (x *GetUserMenuPermissionsResponse) Reset() { *x = GetUserMenuPermissionsResponse{} }
+
+func (x *GetUserMenuPermissionsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetUserMenuPermissionsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetUserMenuPermissionsResponse) GetPermissions() []*MenuPermission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *GetUserMenuPermissionsResponse) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *GetUserMenuPermissionsResponse) GetRoleIDs() []string {
+	if x != nil {
+		return x.RoleIDs
+	}
+	return nil
+}
+
+// 角色 × 菜单/策略权限矩阵。菜单授权来自产品线当前生效的菜单版本，
+// 策略授权来自 policy_srv（含角色继承），含通配规则的角色列出全部菜单。
+type GetPermissionMatrixRequest struct {
+	// 菜单产品线，为空时使用 default
+	ProductLine *string `protobuf:"bytes,1,opt,name=productLine" json:"productLine,omitempty"`
+
+	// 仅包含指定角色，为空表示全部角色
+	RoleIDs []string `protobuf:"bytes,2,rep,name=roleIDs" json:"roleIDs,omitempty"`
+
+	// 同时列出该组织域下的策略规则，为空时仅列出全局域规则
+	OrganizationID *string `protobuf:"bytes,3,opt,name=organizationID" json:"organizationID,omitempty"`
+}
+
+func (x *GetPermissionMatrixRequest) Reset() { *x = GetPermissionMatrixRequest{} }
+
+func (x *GetPermissionMatrixRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetPermissionMatrixRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetPermissionMatrixRequest) GetProductLine() string {
+	if x != nil && x.ProductLine != nil {
+		return *x.ProductLine
+	}
+	return ""
+}
+
+func (x *GetPermissionMatrixRequest) GetRoleIDs() []string {
+	if x != nil {
+		return x.RoleIDs
+	}
+	return nil
+}
+
+func (x *GetPermissionMatrixRequest) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+type GetPermissionMatrixResponse struct {
+	ProductLine *string `protobuf:"bytes,1,opt,name=productLine" json:"productLine,omitempty"`
+
+	// 解析菜单名称所用的菜单版本，产品线尚未上传菜单时为 0
+	MenuVersion *int32             `protobuf:"varint,2,opt,name=menuVersion" json:"menuVersion,omitempty"`
+	Roles       []*RolePermissions `protobuf:"bytes,3,rep,name=roles" json:"roles,omitempty"`
+}
+
+func (x *GetPermissionMatrixResponse) Reset() { *x = GetPermissionMatrixResponse{} }
+
+func (x *GetPermissionMatrixResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetPermissionMatrixResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetPermissionMatrixResponse) GetProductLine() string {
+	if x != nil && x.ProductLine != nil {
+		return *x.ProductLine
+	}
+	return ""
+}
+
+func (x *GetPermissionMatrixResponse) GetMenuVersion() int32 {
+	if x != nil && x.MenuVersion != nil {
+		return *x.MenuVersion
+	}
+	return 0
+}
+
+func (x *GetPermissionMatrixResponse) GetRoles() []*RolePermissions {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// 用户在各租户下的有效权限及授予该权限的角色。
+// 租户为用户的活跃成员关系所属组织与组织级角色分配所属组织的并集。
+type GetUserEffectivePermissionsRequest struct {
+	UserID      *string `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
+	ProductLine *string `protobuf:"bytes,2,opt,name=productLine" json:"productLine,omitempty"`
+
+	// 仅计算该租户，为空表示全部租户
+	OrganizationID *string `protobuf:"bytes,3,opt,name=organizationID" json:"organizationID,omitempty"`
+}
+
+func (x *GetUserEffectivePermissionsRequest) Reset() { *x = GetUserEffectivePermissionsRequest{} }
+
+func (x *GetUserEffectivePermissionsRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetUserEffectivePermissionsRequest) Unmarshal(in []byte) error {
+	return prutal.Unmarshal(in, x)
+}
+
+func (x *GetUserEffectivePermissionsRequest) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *GetUserEffectivePermissionsRequest) GetProductLine() string {
+	if x != nil && x.ProductLine != nil {
+		return *x.ProductLine
+	}
+	return ""
+}
+
+func (x *GetUserEffectivePermissionsRequest) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+type GetUserEffectivePermissionsResponse struct {
+	ProductLine *string          `protobuf:"bytes,1,opt,name=productLine" json:"productLine,omitempty"`
+	User        *UserPermissions `protobuf:"bytes,2,opt,name=user" json:"user,omitempty"`
+}
+
+func (x *GetUserEffectivePermissionsResponse) Reset() { *x = GetUserEffectivePermissionsResponse{} }
+
+func (x *GetUserEffectivePermissionsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetUserEffectivePermissionsResponse) Unmarshal(in []byte) error {
+	return prutal.Unmarshal(in, x)
+}
+
+func (x *GetUserEffectivePermissionsResponse) GetProductLine() string {
+	if x != nil && x.ProductLine != nil {
+		return *x.ProductLine
+	}
+	return ""
+}
+
+func (x *GetUserEffectivePermissionsResponse) GetUser() *UserPermissions {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// 导出权限报告，每条授权一行。
+type ExportPermissionReportRequest struct {
+	Format      *UserFileFormat       `protobuf:"varint,1,opt,name=format" json:"format,omitempty"`
+	View        *PermissionReportView `protobuf:"varint,2,opt,name=view" json:"view,omitempty"`
+	ProductLine *string               `protobuf:"bytes,3,opt,name=productLine" json:"productLine,omitempty"`
+
+	// 角色视图：仅包含指定角色，为空表示全部角色
+	RoleIDs []string `protobuf:"bytes,4,rep,name=roleIDs" json:"roleIDs,omitempty"`
+
+	// 用户视图：仅包含指定用户，为空表示全部持有有效角色分配的用户
+	UserIDs        []string `protobuf:"bytes,5,rep,name=userIDs" json:"userIDs,omitempty"`
+	OrganizationID *string  `protobuf:"bytes,6,opt,name=organizationID" json:"organizationID,omitempty"`
+}
+
+func (x *ExportPermissionReportRequest) Reset() { *x = ExportPermissionReportRequest{} }
+
+func (x *ExportPermissionReportRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ExportPermissionReportRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ExportPermissionReportRequest) GetFormat() UserFileFormat {
+	if x != nil && x.Format != nil {
+		return *x.Format
+	}
+	return UserFileFormat_USER_FILE_FORMAT_UNSPECIFIED
 }
 
-func (x *HasMenuPermissionResponse) GetPermission() PermissionLevel {
-	if x != nil && x.Permission != nil {
-		return *x.Permission
+func (x *ExportPermissionReportRequest) GetView() PermissionReportView {
+	if x != nil && x.View != nil {
+		return *x.View
 	}
-	return PermissionLevel_PERMISSION_LEVEL_UNSPECIFIED
+	return PermissionReportView_PERMISSION_REPORT_VIEW_UNSPECIFIED
 }
 
-type GetUserMenuPermissionsRequest struct {
-	UserID *string `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
+func (x *ExportPermissionReportRequest) GetProductLine() string {
+	if x != nil && x.ProductLine != nil {
+		return *x.ProductLine
+	}
+	return ""
 }
 
-func (x *GetUserMenuPermissionsRequest) Reset() { *x = GetUserMenuPermissionsRequest{} }
-
-func (x *GetUserMenuPermissionsRequest) Marshal(in []byte) ([]byte, error) {
-	return prutal.MarshalAppend(in, x)
+func (x *ExportPermissionReportRequest) GetRoleIDs() []string {
+	if x != nil {
+		return x.RoleIDs
+	}
+	return nil
 }
 
-func (x *GetUserMenuPermissionsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+func (x *ExportPermissionReportRequest) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
 
-func (x *GetUserMenuPermissionsRequest) GetUserID() string {
-	if x != nil && x.UserID != nil {
-		return *x.UserID
+func (x *ExportPermissionReportRequest) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
 	}
 	return ""
 }
 
-type GetUserMenuPermissionsResponse struct {
-	Permissions []*MenuPermission `protobuf:"bytes,1,rep,name=permissions" json:"permissions,omitempty"`
-	UserID      *string           `protobuf:"bytes,2,opt,name=userID" json:"userID,omitempty"`
-	RoleIDs     []string          `protobuf:"bytes,3,rep,name=roleIDs" json:"roleIDs,omitempty"`
+type ExportPermissionReportResponse struct {
+	FileContent []byte  `protobuf:"bytes,1,opt,name=fileContent" json:"fileContent,omitempty"`
+	FileName    *string `protobuf:"bytes,2,opt,name=fileName" json:"fileName,omitempty"`
+	ContentType *string `protobuf:"bytes,3,opt,name=contentType" json:"contentType,omitempty"`
+	TotalRows   *int32  `protobuf:"varint,4,opt,name=totalRows" json:"totalRows,omitempty"`
 }
 
-func (x *GetUserMenuPermissionsResponse) Reset() { *x = GetUserMenuPermissionsResponse{} }
+func (x *ExportPermissionReportResponse) Reset() { *x = ExportPermissionReportResponse{} }
 
-func (x *GetUserMenuPermissionsResponse) Marshal(in []byte) ([]byte, error) {
+func (x *ExportPermissionReportResponse) Marshal(in []byte) ([]byte, error) {
 	return prutal.MarshalAppend(in, x)
 }
 
-func (x *GetUserMenuPermissionsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+func (x *ExportPermissionReportResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
 
-func (x *GetUserMenuPermissionsResponse) GetPermissions() []*MenuPermission {
+func (x *ExportPermissionReportResponse) GetFileContent() []byte {
 	if x != nil {
-		return x.Permissions
+		return x.FileContent
 	}
 	return nil
 }
 
-func (x *GetUserMenuPermissionsResponse) GetUserID() string {
-	if x != nil && x.UserID != nil {
-		return *x.UserID
+func (x *ExportPermissionReportResponse) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
 	}
 	return ""
 }
 
-func (x *GetUserMenuPermissionsResponse) GetRoleIDs() []string {
-	if x != nil {
-		return x.RoleIDs
+func (x *ExportPermissionReportResponse) GetContentType() string {
+	if x != nil && x.ContentType != nil {
+		return *x.ContentType
 	}
-	return nil
+	return ""
+}
+
+func (x *ExportPermissionReportResponse) GetTotalRows() int32 {
+	if x != nil && x.TotalRows != nil {
+		return *x.TotalRows
+	}
+	return 0
 }
 
 type CreateAuditLogRequest struct {
@@ -3219,11 +5383,369 @@ func (x *ListAuditLogsResponse) GetStats() *AuditLogStats {
 	return nil
 }
 
+type ListDeletedEntitiesRequest struct {
+	EntityType *RecycleBinEntityType `protobuf:"varint,1,opt,name=entityType" json:"entityType,omitempty"`
+
+	// 支持 search（按名称/代码，拼音感知）与分页；默认按删除时间倒序
+	Page *rpc_base.PageRequest `protobuf:"bytes,2,opt,name=page" json:"page,omitempty"`
+}
+
+func (x *ListDeletedEntitiesRequest) Reset() { *x = ListDeletedEntitiesRequest{} }
+
+func (x *ListDeletedEntitiesRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListDeletedEntitiesRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListDeletedEntitiesRequest) GetEntityType() RecycleBinEntityType {
+	if x != nil && x.EntityType != nil {
+		return *x.EntityType
+	}
+	return RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *ListDeletedEntitiesRequest) GetPage() *rpc_base.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListDeletedEntitiesResponse struct {
+	Entities []*DeletedEntity       `protobuf:"bytes,1,rep,name=entities" json:"entities,omitempty"`
+	Page     *rpc_base.PageResponse `protobuf:"bytes,2,opt,name=page" json:"page,omitempty"`
+}
+
+func (x *ListDeletedEntitiesResponse) Reset() { *x = ListDeletedEntitiesResponse{} }
+
+func (x *ListDeletedEntitiesResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListDeletedEntitiesResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListDeletedEntitiesResponse) GetEntities() []*DeletedEntity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *ListDeletedEntitiesResponse) GetPage() *rpc_base.PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type RestoreDeletedEntityRequest struct {
+	EntityType *RecycleBinEntityType `protobuf:"varint,1,opt,name=entityType" json:"entityType,omitempty"`
+	Id         *string               `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+}
+
+func (x *RestoreDeletedEntityRequest) Reset() { *x = RestoreDeletedEntityRequest{} }
+
+func (x *RestoreDeletedEntityRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *RestoreDeletedEntityRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RestoreDeletedEntityRequest) GetEntityType() RecycleBinEntityType {
+	if x != nil && x.EntityType != nil {
+		return *x.EntityType
+	}
+	return RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *RestoreDeletedEntityRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+type RestoreDeletedEntityResponse struct {
+	// 恢复用户时随之恢复的角色分配与成员关系数量（删除用户时级联删除的数据）
+	RestoredRoleAssignments *int32 `protobuf:"varint,1,opt,name=restoredRoleAssignments" json:"restoredRoleAssignments,omitempty"`
+	RestoredMemberships     *int32 `protobuf:"varint,2,opt,name=restoredMemberships" json:"restoredMemberships,omitempty"`
+
+	// 需经审批或违反职责分离约束而未随用户恢复的角色编码，需重新发起分配
+	SkippedRoleCodes []string `protobuf:"bytes,3,rep,name=skippedRoleCodes" json:"skippedRoleCodes,omitempty"`
+}
+
+func (x *RestoreDeletedEntityResponse) Reset() { *x = RestoreDeletedEntityResponse{} }
+
+func (x *RestoreDeletedEntityResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *RestoreDeletedEntityResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RestoreDeletedEntityResponse) GetRestoredRoleAssignments() int32 {
+	if x != nil && x.RestoredRoleAssignments != nil {
+		return *x.RestoredRoleAssignments
+	}
+	return 0
+}
+
+func (x *RestoreDeletedEntityResponse) GetRestoredMemberships() int32 {
+	if x != nil && x.RestoredMemberships != nil {
+		return *x.RestoredMemberships
+	}
+	return 0
+}
+
+func (x *RestoreDeletedEntityResponse) GetSkippedRoleCodes() []string {
+	if x != nil {
+		return x.SkippedRoleCodes
+	}
+	return nil
+}
+
+type PurgeDeletedEntityRequest struct {
+	EntityType *RecycleBinEntityType `protobuf:"varint,1,opt,name=entityType" json:"entityType,omitempty"`
+	Id         *string               `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+}
+
+func (x *PurgeDeletedEntityRequest) Reset() { *x = PurgeDeletedEntityRequest{} }
+
+func (x *PurgeDeletedEntityRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *PurgeDeletedEntityRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *PurgeDeletedEntityRequest) GetEntityType() RecycleBinEntityType {
+	if x != nil && x.EntityType != nil {
+		return *x.EntityType
+	}
+	return RecycleBinEntityType_RECYCLE_BIN_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *PurgeDeletedEntityRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+type PurgeDeletedEntityResponse struct {
+}
+
+func (x *PurgeDeletedEntityResponse) Reset() { *x = PurgeDeletedEntityResponse{} }
+
+func (x *PurgeDeletedEntityResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *PurgeDeletedEntityResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+// 批量导入用户：每行创建一个用户及其成员关系与角色分配。
+// 所有行先按 CreateUser 的规则校验（含文件内重复），dryRun 时只返回逐行校验结果；
+// 否则按批次提交通过校验的行，每批一个事务，失败的批次不影响其他批次。
+type ImportUsersRequest struct {
+	FileContent []byte          `protobuf:"bytes,1,opt,name=fileContent" json:"fileContent,omitempty"`
+	Format      *UserFileFormat `protobuf:"varint,2,opt,name=format" json:"format,omitempty"`
+	DryRun      *bool           `protobuf:"varint,3,opt,name=dryRun" json:"dryRun,omitempty"`
+
+	// 每批提交的行数，默认 100，最大 500
+	BatchSize *int32 `protobuf:"varint,4,opt,name=batchSize" json:"batchSize,omitempty"`
+
+	// 操作人ID，记录为角色分配的创建者
+	OperatorID *string `protobuf:"bytes,5,opt,name=operatorID" json:"operatorID,omitempty"`
+}
+
+func (x *ImportUsersRequest) Reset() { *x = ImportUsersRequest{} }
+
+func (x *ImportUsersRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ImportUsersRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ImportUsersRequest) GetFileContent() []byte {
+	if x != nil {
+		return x.FileContent
+	}
+	return nil
+}
+
+func (x *ImportUsersRequest) GetFormat() UserFileFormat {
+	if x != nil && x.Format != nil {
+		return *x.Format
+	}
+	return UserFileFormat_USER_FILE_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportUsersRequest) GetDryRun() bool {
+	if x != nil && x.DryRun != nil {
+		return *x.DryRun
+	}
+	return false
+}
+
+func (x *ImportUsersRequest) GetBatchSize() int32 {
+	if x != nil && x.BatchSize != nil {
+		return *x.BatchSize
+	}
+	return 0
+}
+
+func (x *ImportUsersRequest) GetOperatorID() string {
+	if x != nil && x.OperatorID != nil {
+		return *x.OperatorID
+	}
+	return ""
+}
+
+type ImportUsersResponse struct {
+	DryRun    *bool  `protobuf:"varint,1,opt,name=dryRun" json:"dryRun,omitempty"`
+	TotalRows *int32 `protobuf:"varint,2,opt,name=totalRows" json:"totalRows,omitempty"`
+
+	// 通过校验的行数
+	ValidRows *int32 `protobuf:"varint,3,opt,name=validRows" json:"validRows,omitempty"`
+
+	// 实际创建的用户数，试运行时为 0
+	CreatedRows *int32                 `protobuf:"varint,4,opt,name=createdRows" json:"createdRows,omitempty"`
+	FailedRows  *int32                 `protobuf:"varint,5,opt,name=failedRows" json:"failedRows,omitempty"`
+	Rows        []*UserImportRowResult `protobuf:"bytes,6,rep,name=rows" json:"rows,omitempty"`
+}
+
+func (x *ImportUsersResponse) Reset() { *x = ImportUsersResponse{} }
+
+func (x *ImportUsersResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ImportUsersResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ImportUsersResponse) GetDryRun() bool {
+	if x != nil && x.DryRun != nil {
+		return *x.DryRun
+	}
+	return false
+}
+
+func (x *ImportUsersResponse) GetTotalRows() int32 {
+	if x != nil && x.TotalRows != nil {
+		return *x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportUsersResponse) GetValidRows() int32 {
+	if x != nil && x.ValidRows != nil {
+		return *x.ValidRows
+	}
+	return 0
+}
+
+func (x *ImportUsersResponse) GetCreatedRows() int32 {
+	if x != nil && x.CreatedRows != nil {
+		return *x.CreatedRows
+	}
+	return 0
+}
+
+func (x *ImportUsersResponse) GetFailedRows() int32 {
+	if x != nil && x.FailedRows != nil {
+		return *x.FailedRows
+	}
+	return 0
+}
+
+func (x *ImportUsersResponse) GetRows() []*UserImportRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// 导出用户及其成员关系与角色，列与导入模板一致（不含密码），可修改后重新导入。
+type ExportUsersRequest struct {
+	Format         *UserFileFormat  `protobuf:"varint,1,opt,name=format" json:"format,omitempty"`
+	OrganizationID *string          `protobuf:"bytes,2,opt,name=organizationID" json:"organizationID,omitempty"`
+	Status         *core.UserStatus `protobuf:"varint,3,opt,name=status" json:"status,omitempty"`
+}
+
+func (x *ExportUsersRequest) Reset() { *x = ExportUsersRequest{} }
+
+func (x *ExportUsersRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ExportUsersRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ExportUsersRequest) GetFormat() UserFileFormat {
+	if x != nil && x.Format != nil {
+		return *x.Format
+	}
+	return UserFileFormat_USER_FILE_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportUsersRequest) GetOrganizationID() string {
+	if x != nil && x.OrganizationID != nil {
+		return *x.OrganizationID
+	}
+	return ""
+}
+
+func (x *ExportUsersRequest) GetStatus() core.UserStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return core.UserStatus_USER_STATUS_UNSPECIFIED
+}
+
+type ExportUsersResponse struct {
+	FileContent []byte  `protobuf:"bytes,1,opt,name=fileContent" json:"fileContent,omitempty"`
+	FileName    *string `protobuf:"bytes,2,opt,name=fileName" json:"fileName,omitempty"`
+	ContentType *string `protobuf:"bytes,3,opt,name=contentType" json:"contentType,omitempty"`
+	TotalRows   *int32  `protobuf:"varint,4,opt,name=totalRows" json:"totalRows,omitempty"`
+}
+
+func (x *ExportUsersResponse) Reset() { *x = ExportUsersResponse{} }
+
+func (x *ExportUsersResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ExportUsersResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ExportUsersResponse) GetFileContent() []byte {
+	if x != nil {
+		return x.FileContent
+	}
+	return nil
+}
+
+func (x *ExportUsersResponse) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
+	}
+	return ""
+}
+
+func (x *ExportUsersResponse) GetContentType() string {
+	if x != nil && x.ContentType != nil {
+		return *x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportUsersResponse) GetTotalRows() int32 {
+	if x != nil && x.TotalRows != nil {
+		return *x.TotalRows
+	}
+	return 0
+}
+
 type IdentityService interface {
 	Login(ctx context.Context, req *LoginRequest) (res *LoginResponse, err error)
 	ChangePassword(ctx context.Context, req *ChangePasswordRequest) (res *ChangePasswordResponse, err error)
 	ResetPassword(ctx context.Context, req *ResetPasswordRequest) (res *ResetPasswordResponse, err error)
 	ForcePasswordChange(ctx context.Context, req *ForcePasswordChangeRequest) (res *ForcePasswordChangeResponse, err error)
+	EnrollMFA(ctx context.Context, req *EnrollMFARequest) (res *EnrollMFAResponse, err error)
+	ConfirmMFA(ctx context.Context, req *ConfirmMFARequest) (res *ConfirmMFAResponse, err error)
+	VerifyMFA(ctx context.Context, req *VerifyMFARequest) (res *VerifyMFAResponse, err error)
+	DisableMFA(ctx context.Context, req *DisableMFARequest) (res *DisableMFAResponse, err error)
+	RequestPasswordReset(ctx context.Context, req *RequestPasswordResetRequest) (res *RequestPasswordResetResponse, err error)
+	ConfirmPasswordReset(ctx context.Context, req *ConfirmPasswordResetRequest) (res *ConfirmPasswordResetResponse, err error)
+	RefreshSession(ctx context.Context, req *RefreshSessionRequest) (res *RefreshSessionResponse, err error)
 	CreateUser(ctx context.Context, req *CreateUserRequest) (res *CreateUserResponse, err error)
 	GetUser(ctx context.Context, req *GetUserRequest) (res *GetUserResponse, err error)
 	UpdateUser(ctx context.Context, req *UpdateUserRequest) (res *UpdateUserResponse, err error)
@@ -3232,6 +5754,8 @@ type IdentityService interface {
 	SearchUsers(ctx context.Context, req *SearchUsersRequest) (res *SearchUsersResponse, err error)
 	ChangeUserStatus(ctx context.Context, req *ChangeUserStatusRequest) (res *ChangeUserStatusResponse, err error)
 	UnlockUser(ctx context.Context, req *UnlockUserRequest) (res *UnlockUserResponse, err error)
+	ImportUsers(ctx context.Context, req *ImportUsersRequest) (res *ImportUsersResponse, err error)
+	ExportUsers(ctx context.Context, req *ExportUsersRequest) (res *ExportUsersResponse, err error)
 	CreateOrganization(ctx context.Context, req *CreateOrganizationRequest) (res *CreateOrganizationResponse, err error)
 	GetOrganization(ctx context.Context, req *GetOrganizationRequest) (res *GetOrganizationResponse, err error)
 	UpdateOrganization(ctx context.Context, req *UpdateOrganizationRequest) (res *UpdateOrganizationResponse, err error)
@@ -3253,6 +5777,9 @@ type IdentityService interface {
 	GetOrganizationLogo(ctx context.Context, req *GetOrganizationLogoRequest) (res *GetOrganizationLogoResponse, err error)
 	DeleteOrganizationLogo(ctx context.Context, req *DeleteOrganizationLogoRequest) (res *DeleteOrganizationLogoResponse, err error)
 	BindLogoToOrganization(ctx context.Context, req *BindLogoToOrganizationRequest) (res *BindLogoToOrganizationResponse, err error)
+	GetOrganizationPasswordPolicy(ctx context.Context, req *GetOrganizationPasswordPolicyRequest) (res *GetOrganizationPasswordPolicyResponse, err error)
+	UpdateOrganizationPasswordPolicy(ctx context.Context, req *UpdateOrganizationPasswordPolicyRequest) (res *UpdateOrganizationPasswordPolicyResponse, err error)
+	ResetOrganizationPasswordPolicy(ctx context.Context, req *ResetOrganizationPasswordPolicyRequest) (res *ResetOrganizationPasswordPolicyResponse, err error)
 	CreateRoleDefinition(ctx context.Context, req *RoleDefinitionCreateRequest) (res *CreateRoleDefinitionResponse, err error)
 	UpdateRoleDefinition(ctx context.Context, req *RoleDefinitionUpdateRequest) (res *UpdateRoleDefinitionResponse, err error)
 	DeleteRoleDefinition(ctx context.Context, req *DeleteRoleDefinitionRequest) (res *DeleteRoleDefinitionResponse, err error)
@@ -3266,14 +5793,35 @@ type IdentityService interface {
 	GetUsersByRole(ctx context.Context, req *GetUsersByRoleRequest) (res *GetUsersByRoleResponse, err error)
 	BatchBindUsersToRole(ctx context.Context, req *BatchBindUsersToRoleRequest) (res *BatchBindUsersToRoleResponse, err error)
 	BatchGetUserRoles(ctx context.Context, req *BatchGetUserRolesRequest) (res *BatchGetUserRolesResponse, err error)
+	GetUserTenantRoles(ctx context.Context, req *GetUserTenantRolesRequest) (res *GetUserTenantRolesResponse, err error)
+	ListRoleConstraintViolations(ctx context.Context, req *ListRoleConstraintViolationsRequest) (res *ListRoleConstraintViolationsResponse, err error)
+	ListRoleGrantRequests(ctx context.Context, req *ListRoleGrantRequestsRequest) (res *ListRoleGrantRequestsResponse, err error)
+	GetRoleGrantRequest(ctx context.Context, req *GetRoleGrantRequestRequest) (res *GetRoleGrantRequestResponse, err error)
+	ApproveRoleGrant(ctx context.Context, req *ApproveRoleGrantRequest) (res *ApproveRoleGrantResponse, err error)
+	RejectRoleGrant(ctx context.Context, req *RejectRoleGrantRequest) (res *RejectRoleGrantResponse, err error)
+	CreateAccessReviewCampaign(ctx context.Context, req *CreateAccessReviewCampaignRequest) (res *CreateAccessReviewCampaignResponse, err error)
+	ListAccessReviewCampaigns(ctx context.Context, req *ListAccessReviewCampaignsRequest) (res *ListAccessReviewCampaignsResponse, err error)
+	GetAccessReviewCampaign(ctx context.Context, req *GetAccessReviewCampaignRequest) (res *GetAccessReviewCampaignResponse, err error)
+	ListAccessReviewItems(ctx context.Context, req *ListAccessReviewItemsRequest) (res *ListAccessReviewItemsResponse, err error)
+	DecideAccessReviewItem(ctx context.Context, req *DecideAccessReviewItemRequest) (res *DecideAccessReviewItemResponse, err error)
+	CloseAccessReviewCampaign(ctx context.Context, req *CloseAccessReviewCampaignRequest) (res *CloseAccessReviewCampaignResponse, err error)
 	UploadMenu(ctx context.Context, req *UploadMenuRequest) (res *UploadMenuResponse, err error)
 	GetMenuTree(ctx context.Context, req *GetMenuTreeRequest) (res *GetMenuTreeResponse, err error)
+	ListMenuVersions(ctx context.Context, req *ListMenuVersionsRequest) (res *ListMenuVersionsResponse, err error)
+	DiffMenuVersions(ctx context.Context, req *DiffMenuVersionsRequest) (res *DiffMenuVersionsResponse, err error)
+	ActivateMenuVersion(ctx context.Context, req *ActivateMenuVersionRequest) (res *ActivateMenuVersionResponse, err error)
 	ConfigureRoleMenus(ctx context.Context, req *ConfigureRoleMenusRequest) (res *ConfigureRoleMenusResponse, err error)
 	GetRoleMenuTree(ctx context.Context, req *GetRoleMenuTreeRequest) (res *GetRoleMenuTreeResponse, err error)
 	GetUserMenuTree(ctx context.Context, req *GetUserMenuTreeRequest) (res *GetUserMenuTreeResponse, err error)
 	GetRoleMenuPermissions(ctx context.Context, req *GetRoleMenuPermissionsRequest) (res *GetRoleMenuPermissionsResponse, err error)
 	HasMenuPermission(ctx context.Context, req *HasMenuPermissionRequest) (res *HasMenuPermissionResponse, err error)
 	GetUserMenuPermissions(ctx context.Context, req *GetUserMenuPermissionsRequest) (res *GetUserMenuPermissionsResponse, err error)
+	GetPermissionMatrix(ctx context.Context, req *GetPermissionMatrixRequest) (res *GetPermissionMatrixResponse, err error)
+	GetUserEffectivePermissions(ctx context.Context, req *GetUserEffectivePermissionsRequest) (res *GetUserEffectivePermissionsResponse, err error)
+	ExportPermissionReport(ctx context.Context, req *ExportPermissionReportRequest) (res *ExportPermissionReportResponse, err error)
 	CreateAuditLog(ctx context.Context, req *CreateAuditLogRequest) (res *CreateAuditLogResponse, err error)
 	ListAuditLogs(ctx context.Context, req *ListAuditLogsRequest) (res *ListAuditLogsResponse, err error)
+	ListDeletedEntities(ctx context.Context, req *ListDeletedEntitiesRequest) (res *ListDeletedEntitiesResponse, err error)
+	RestoreDeletedEntity(ctx context.Context, req *RestoreDeletedEntityRequest) (res *RestoreDeletedEntityResponse, err error)
+	PurgeDeletedEntity(ctx context.Context, req *PurgeDeletedEntityRequest) (res *PurgeDeletedEntityResponse, err error)
 }
@@ -15,6 +15,13 @@ type Client interface {
 	ChangePassword(ctx context.Context, Req *identity_srv.ChangePasswordRequest, callOptions ...callopt.Option) (r *identity_srv.ChangePasswordResponse, err error)
 	ResetPassword(ctx context.Context, Req *identity_srv.ResetPasswordRequest, callOptions ...callopt.Option) (r *identity_srv.ResetPasswordResponse, err error)
 	ForcePasswordChange(ctx context.Context, Req *identity_srv.ForcePasswordChangeRequest, callOptions ...callopt.Option) (r *identity_srv.ForcePasswordChangeResponse, err error)
+	EnrollMFA(ctx context.Context, Req *identity_srv.EnrollMFARequest, callOptions ...callopt.Option) (r *identity_srv.EnrollMFAResponse, err error)
+	ConfirmMFA(ctx context.Context, Req *identity_srv.ConfirmMFARequest, callOptions ...callopt.Option) (r *identity_srv.ConfirmMFAResponse, err error)
+	VerifyMFA(ctx context.Context, Req *identity_srv.VerifyMFARequest, callOptions ...callopt.Option) (r *identity_srv.VerifyMFAResponse, err error)
+	DisableMFA(ctx context.Context, Req *identity_srv.DisableMFARequest, callOptions ...callopt.Option) (r *identity_srv.DisableMFAResponse, err error)
+	RequestPasswordReset(ctx context.Context, Req *identity_srv.RequestPasswordResetRequest, callOptions ...callopt.Option) (r *identity_srv.RequestPasswordResetResponse, err error)
+	ConfirmPasswordReset(ctx context.Context, Req *identity_srv.ConfirmPasswordResetRequest, callOptions ...callopt.Option) (r *identity_srv.ConfirmPasswordResetResponse, err error)
+	RefreshSession(ctx context.Context, Req *identity_srv.RefreshSessionRequest, callOptions ...callopt.Option) (r *identity_srv.RefreshSessionResponse, err error)
 	CreateUser(ctx context.Context, Req *identity_srv.CreateUserRequest, callOptions ...callopt.Option) (r *identity_srv.CreateUserResponse, err error)
 	GetUser(ctx context.Context, Req *identity_srv.GetUserRequest, callOptions ...callopt.Option) (r *identity_srv.GetUserResponse, err error)
 	UpdateUser(ctx context.Context, Req *identity_srv.UpdateUserRequest, callOptions ...callopt.Option) (r *identity_srv.UpdateUserResponse, err error)
@@ -23,6 +30,8 @@ type Client interface {
 	SearchUsers(ctx context.Context, Req *identity_srv.SearchUsersRequest, callOptions ...callopt.Option) (r *identity_srv.SearchUsersResponse, err error)
 	ChangeUserStatus(ctx context.Context, Req *identity_srv.ChangeUserStatusRequest, callOptions ...callopt.Option) (r *identity_srv.ChangeUserStatusResponse, err error)
 	UnlockUser(ctx context.Context, Req *identity_srv.UnlockUserRequest, callOptions ...callopt.Option) (r *identity_srv.UnlockUserResponse, err error)
+	ImportUsers(ctx context.Context, Req *identity_srv.ImportUsersRequest, callOptions ...callopt.Option) (r *identity_srv.ImportUsersResponse, err error)
+	ExportUsers(ctx context.Context, Req *identity_srv.ExportUsersRequest, callOptions ...callopt.Option) (r *identity_srv.ExportUsersResponse, err error)
 	CreateOrganization(ctx context.Context, Req *identity_srv.CreateOrganizationRequest, callOptions ...callopt.Option) (r *identity_srv.CreateOrganizationResponse, err error)
 	GetOrganization(ctx context.Context, Req *identity_srv.GetOrganizationRequest, callOptions ...callopt.Option) (r *identity_srv.GetOrganizationResponse, err error)
 	UpdateOrganization(ctx context.Context, Req *identity_srv.UpdateOrganizationRequest, callOptions ...callopt.Option) (r *identity_srv.UpdateOrganizationResponse, err error)
@@ -44,6 +53,9 @@ type Client interface {
 	GetOrganizationLogo(ctx context.Context, Req *identity_srv.GetOrganizationLogoRequest, callOptions ...callopt.Option) (r *identity_srv.GetOrganizationLogoResponse, err error)
 	DeleteOrganizationLogo(ctx context.Context, Req *identity_srv.DeleteOrganizationLogoRequest, callOptions ...callopt.Option) (r *identity_srv.DeleteOrganizationLogoResponse, err error)
 	BindLogoToOrganization(ctx context.Context, Req *identity_srv.BindLogoToOrganizationRequest, callOptions ...callopt.Option) (r *identity_srv.BindLogoToOrganizationResponse, err error)
+	GetOrganizationPasswordPolicy(ctx context.Context, Req *identity_srv.GetOrganizationPasswordPolicyRequest, callOptions ...callopt.Option) (r *identity_srv.GetOrganizationPasswordPolicyResponse, err error)
+	UpdateOrganizationPasswordPolicy(ctx context.Context, Req *identity_srv.UpdateOrganizationPasswordPolicyRequest, callOptions ...callopt.Option) (r *identity_srv.UpdateOrganizationPasswordPolicyResponse, err error)
+	ResetOrganizationPasswordPolicy(ctx context.Context, Req *identity_srv.ResetOrganizationPasswordPolicyRequest, callOptions ...callopt.Option) (r *identity_srv.ResetOrganizationPasswordPolicyResponse, err error)
 	CreateRoleDefinition(ctx context.Context, Req *identity_srv.RoleDefinitionCreateRequest, callOptions ...callopt.Option) (r *identity_srv.CreateRoleDefinitionResponse, err error)
 	UpdateRoleDefinition(ctx context.Context, Req *identity_srv.RoleDefinitionUpdateRequest, callOptions ...callopt.Option) (r *identity_srv.UpdateRoleDefinitionResponse, err error)
 	DeleteRoleDefinition(ctx context.Context, Req *identity_srv.DeleteRoleDefinitionRequest, callOptions ...callopt.Option) (r *identity_srv.DeleteRoleDefinitionResponse, err error)
@@ -57,16 +69,37 @@ type Client interface {
 	GetUsersByRole(ctx context.Context, Req *identity_srv.GetUsersByRoleRequest, callOptions ...callopt.Option) (r *identity_srv.GetUsersByRoleResponse, err error)
 	BatchBindUsersToRole(ctx context.Context, Req *identity_srv.BatchBindUsersToRoleRequest, callOptions ...callopt.Option) (r *identity_srv.BatchBindUsersToRoleResponse, err error)
 	BatchGetUserRoles(ctx context.Context, Req *identity_srv.BatchGetUserRolesRequest, callOptions ...callopt.Option) (r *identity_srv.BatchGetUserRolesResponse, err error)
+	GetUserTenantRoles(ctx context.Context, Req *identity_srv.GetUserTenantRolesRequest, callOptions ...callopt.Option) (r *identity_srv.GetUserTenantRolesResponse, err error)
+	ListRoleConstraintViolations(ctx context.Context, Req *identity_srv.ListRoleConstraintViolationsRequest, callOptions ...callopt.Option) (r *identity_srv.ListRoleConstraintViolationsResponse, err error)
+	ListRoleGrantRequests(ctx context.Context, Req *identity_srv.ListRoleGrantRequestsRequest, callOptions ...callopt.Option) (r *identity_srv.ListRoleGrantRequestsResponse, err error)
+	GetRoleGrantRequest(ctx context.Context, Req *identity_srv.GetRoleGrantRequestRequest, callOptions ...callopt.Option) (r *identity_srv.GetRoleGrantRequestResponse, err error)
+	ApproveRoleGrant(ctx context.Context, Req *identity_srv.ApproveRoleGrantRequest, callOptions ...callopt.Option) (r *identity_srv.ApproveRoleGrantResponse, err error)
+	RejectRoleGrant(ctx context.Context, Req *identity_srv.RejectRoleGrantRequest, callOptions ...callopt.Option) (r *identity_srv.RejectRoleGrantResponse, err error)
+	CreateAccessReviewCampaign(ctx context.Context, Req *identity_srv.CreateAccessReviewCampaignRequest, callOptions ...callopt.Option) (r *identity_srv.CreateAccessReviewCampaignResponse, err error)
+	ListAccessReviewCampaigns(ctx context.Context, Req *identity_srv.ListAccessReviewCampaignsRequest, callOptions ...callopt.Option) (r *identity_srv.ListAccessReviewCampaignsResponse, err error)
+	GetAccessReviewCampaign(ctx context.Context, Req *identity_srv.GetAccessReviewCampaignRequest, callOptions ...callopt.Option) (r *identity_srv.GetAccessReviewCampaignResponse, err error)
+	ListAccessReviewItems(ctx context.Context, Req *identity_srv.ListAccessReviewItemsRequest, callOptions ...callopt.Option) (r *identity_srv.ListAccessReviewItemsResponse, err error)
+	DecideAccessReviewItem(ctx context.Context, Req *identity_srv.DecideAccessReviewItemRequest, callOptions ...callopt.Option) (r *identity_srv.DecideAccessReviewItemResponse, err error)
+	CloseAccessReviewCampaign(ctx context.Context, Req *identity_srv.CloseAccessReviewCampaignRequest, callOptions ...callopt.Option) (r *identity_srv.CloseAccessReviewCampaignResponse, err error)
 	UploadMenu(ctx context.Context, Req *identity_srv.UploadMenuRequest, callOptions ...callopt.Option) (r *identity_srv.UploadMenuResponse, err error)
 	GetMenuTree(ctx context.Context, Req *identity_srv.GetMenuTreeRequest, callOptions ...callopt.Option) (r *identity_srv.GetMenuTreeResponse, err error)
+	ListMenuVersions(ctx context.Context, Req *identity_srv.ListMenuVersionsRequest, callOptions ...callopt.Option) (r *identity_srv.ListMenuVersionsResponse, err error)
+	DiffMenuVersions(ctx context.Context, Req *identity_srv.DiffMenuVersionsRequest, callOptions ...callopt.Option) (r *identity_srv.DiffMenuVersionsResponse, err error)
+	ActivateMenuVersion(ctx context.Context, Req *identity_srv.ActivateMenuVersionRequest, callOptions ...callopt.Option) (r *identity_srv.ActivateMenuVersionResponse, err error)
 	ConfigureRoleMenus(ctx context.Context, Req *identity_srv.ConfigureRoleMenusRequest, callOptions ...callopt.Option) (r *identity_srv.ConfigureRoleMenusResponse, err error)
 	GetRoleMenuTree(ctx context.Context, Req *identity_srv.GetRoleMenuTreeRequest, callOptions ...callopt.Option) (r *identity_srv.GetRoleMenuTreeResponse, err error)
 	GetUserMenuTree(ctx context.Context, Req *identity_srv.GetUserMenuTreeRequest, callOptions ...callopt.Option) (r *identity_srv.GetUserMenuTreeResponse, err error)
 	GetRoleMenuPermissions(ctx context.Context, Req *identity_srv.GetRoleMenuPermissionsRequest, callOptions ...callopt.Option) (r *identity_srv.GetRoleMenuPermissionsResponse, err error)
 	HasMenuPermission(ctx context.Context, Req *identity_srv.HasMenuPermissionRequest, callOptions ...callopt.Option) (r *identity_srv.HasMenuPermissionResponse, err error)
 	GetUserMenuPermissions(ctx context.Context, Req *identity_srv.GetUserMenuPermissionsRequest, callOptions ...callopt.Option) (r *identity_srv.GetUserMenuPermissionsResponse, err error)
+	GetPermissionMatrix(ctx context.Context, Req *identity_srv.GetPermissionMatrixRequest, callOptions ...callopt.Option) (r *identity_srv.GetPermissionMatrixResponse, err error)
+	GetUserEffectivePermissions(ctx context.Context, Req *identity_srv.GetUserEffectivePermissionsRequest, callOptions ...callopt.Option) (r *identity_srv.GetUserEffectivePermissionsResponse, err error)
+	ExportPermissionReport(ctx context.Context, Req *identity_srv.ExportPermissionReportRequest, callOptions ...callopt.Option) (r *identity_srv.ExportPermissionReportResponse, err error)
 	CreateAuditLog(ctx context.Context, Req *identity_srv.CreateAuditLogRequest, callOptions ...callopt.Option) (r *identity_srv.CreateAuditLogResponse, err error)
 	ListAuditLogs(ctx context.Context, Req *identity_srv.ListAuditLogsRequest, callOptions ...callopt.Option) (r *identity_srv.ListAuditLogsResponse, err error)
+	ListDeletedEntities(ctx context.Context, Req *identity_srv.ListDeletedEntitiesRequest, callOptions ...callopt.Option) (r *identity_srv.ListDeletedEntitiesResponse, err error)
+	RestoreDeletedEntity(ctx context.Context, Req *identity_srv.RestoreDeletedEntityRequest, callOptions ...callopt.Option) (r *identity_srv.RestoreDeletedEntityResponse, err error)
+	PurgeDeletedEntity(ctx context.Context, Req *identity_srv.PurgeDeletedEntityRequest, callOptions ...callopt.Option) (r *identity_srv.PurgeDeletedEntityResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -118,6 +151,41 @@ func (p *kIdentityServiceClient) ForcePasswordChange(ctx context.Context, Req *i
 	return p.kClient.ForcePasswordChange(ctx, Req)
 }
 
+func (p *kIdentityServiceClient) EnrollMFA(ctx context.Context, Req *identity_srv.EnrollMFARequest, callOptions ...callopt.Option) (r *identity_srv.EnrollMFAResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EnrollMFA(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) ConfirmMFA(ctx context.Context, Req *identity_srv.ConfirmMFARequest, callOptions ...callopt.Option) (r *identity_srv.ConfirmMFAResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ConfirmMFA(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) VerifyMFA(ctx context.Context, Req *identity_srv.VerifyMFARequest, callOptions ...callopt.Option) (r *identity_srv.VerifyMFAResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.VerifyMFA(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) DisableMFA(ctx context.Context, Req *identity_srv.DisableMFARequest, callOptions ...callopt.Option) (r *identity_srv.DisableMFAResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DisableMFA(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) RequestPasswordReset(ctx context.Context, Req *identity_srv.RequestPasswordResetRequest, callOptions ...callopt.Option) (r *identity_srv.RequestPasswordResetResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RequestPasswordReset(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) ConfirmPasswordReset(ctx context.Context, Req *identity_srv.ConfirmPasswordResetRequest, callOptions ...callopt.Option) (r *identity_srv.ConfirmPasswordResetResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ConfirmPasswordReset(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) RefreshSession(ctx context.Context, Req *identity_srv.RefreshSessionRequest, callOptions ...callopt.Option) (r *identity_srv.RefreshSessionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RefreshSession(ctx, Req)
+}
+
 func (p *kIdentityServiceClient) CreateUser(ctx context.Context, Req *identity_srv.CreateUserRequest, callOptions ...callopt.Option) (r *identity_srv.CreateUserResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.CreateUser(ctx, Req)
@@ -158,6 +226,16 @@ func (p *kIdentityServiceClient) UnlockUser(ctx context.Context, Req *identity_s
 	return p.kClient.UnlockUser(ctx, Req)
 }
 
+func (p *kIdentityServiceClient) ImportUsers(ctx context.Context, Req *identity_srv.ImportUsersRequest, callOptions ...callopt.Option) (r *identity_srv.ImportUsersResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ImportUsers(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) ExportUsers(ctx context.Context, Req *identity_srv.ExportUsersRequest, callOptions ...callopt.Option) (r *identity_srv.ExportUsersResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportUsers(ctx, Req)
+}
+
 func (p *kIdentityServiceClient) CreateOrganization(ctx context.Context, Req *identity_srv.CreateOrganizationRequest, callOptions ...callopt.Option) (r *identity_srv.CreateOrganizationResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.CreateOrganization(ctx, Req)
@@ -263,6 +341,21 @@ func (p *kIdentityServiceClient) BindLogoToOrganization(ctx context.Context, Req
 	return p.kClient.BindLogoToOrganization(ctx, Req)
 }
 
+func (p *kIdentityServiceClient) GetOrganizationPasswordPolicy(ctx context.Context, Req *identity_srv.GetOrganizationPasswordPolicyRequest, callOptions ...callopt.Option) (r *identity_srv.GetOrganizationPasswordPolicyResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetOrganizationPasswordPolicy(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) UpdateOrganizationPasswordPolicy(ctx context.Context, Req *identity_srv.UpdateOrganizationPasswordPolicyRequest, callOptions ...callopt.Option) (r *identity_srv.UpdateOrganizationPasswordPolicyResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateOrganizationPasswordPolicy(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) ResetOrganizationPasswordPolicy(ctx context.Context, Req *identity_srv.ResetOrganizationPasswordPolicyRequest, callOptions ...callopt.Option) (r *identity_srv.ResetOrganizationPasswordPolicyResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ResetOrganizationPasswordPolicy(ctx, Req)
+}
+
 func (p *kIdentityServiceClient) CreateRoleDefinition(ctx context.Context, Req *identity_srv.RoleDefinitionCreateRequest, callOptions ...callopt.Option) (r *identity_srv.CreateRoleDefinitionResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.CreateRoleDefinition(ctx, Req)
@@ -328,6 +421,66 @@ func (p *kIdentityServiceClient) BatchGetUserRoles(ctx context.Context, Req *ide
 	return p.kClient.BatchGetUserRoles(ctx, Req)
 }
 
+func (p *kIdentityServiceClient) GetUserTenantRoles(ctx context.Context, Req *identity_srv.GetUserTenantRolesRequest, callOptions ...callopt.Option) (r *identity_srv.GetUserTenantRolesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetUserTenantRoles(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) ListRoleConstraintViolations(ctx context.Context, Req *identity_srv.ListRoleConstraintViolationsRequest, callOptions ...callopt.Option) (r *identity_srv.ListRoleConstraintViolationsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListRoleConstraintViolations(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) ListRoleGrantRequests(ctx context.Context, Req *identity_srv.ListRoleGrantRequestsRequest, callOptions ...callopt.Option) (r *identity_srv.ListRoleGrantRequestsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListRoleGrantRequests(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) GetRoleGrantRequest(ctx context.Context, Req *identity_srv.GetRoleGrantRequestRequest, callOptions ...callopt.Option) (r *identity_srv.GetRoleGrantRequestResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetRoleGrantRequest(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) ApproveRoleGrant(ctx context.Context, Req *identity_srv.ApproveRoleGrantRequest, callOptions ...callopt.Option) (r *identity_srv.ApproveRoleGrantResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ApproveRoleGrant(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) RejectRoleGrant(ctx context.Context, Req *identity_srv.RejectRoleGrantRequest, callOptions ...callopt.Option) (r *identity_srv.RejectRoleGrantResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RejectRoleGrant(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) CreateAccessReviewCampaign(ctx context.Context, Req *identity_srv.CreateAccessReviewCampaignRequest, callOptions ...callopt.Option) (r *identity_srv.CreateAccessReviewCampaignResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateAccessReviewCampaign(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) ListAccessReviewCampaigns(ctx context.Context, Req *identity_srv.ListAccessReviewCampaignsRequest, callOptions ...callopt.Option) (r *identity_srv.ListAccessReviewCampaignsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListAccessReviewCampaigns(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) GetAccessReviewCampaign(ctx context.Context, Req *identity_srv.GetAccessReviewCampaignRequest, callOptions ...callopt.Option) (r *identity_srv.GetAccessReviewCampaignResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetAccessReviewCampaign(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) ListAccessReviewItems(ctx context.Context, Req *identity_srv.ListAccessReviewItemsRequest, callOptions ...callopt.Option) (r *identity_srv.ListAccessReviewItemsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListAccessReviewItems(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) DecideAccessReviewItem(ctx context.Context, Req *identity_srv.DecideAccessReviewItemRequest, callOptions ...callopt.Option) (r *identity_srv.DecideAccessReviewItemResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DecideAccessReviewItem(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) CloseAccessReviewCampaign(ctx context.Context, Req *identity_srv.CloseAccessReviewCampaignRequest, callOptions ...callopt.Option) (r *identity_srv.CloseAccessReviewCampaignResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CloseAccessReviewCampaign(ctx, Req)
+}
+
 func (p *kIdentityServiceClient) UploadMenu(ctx context.Context, Req *identity_srv.UploadMenuRequest, callOptions ...callopt.Option) (r *identity_srv.UploadMenuResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.UploadMenu(ctx, Req)
@@ -338,6 +491,21 @@ func (p *kIdentityServiceClient) GetMenuTree(ctx context.Context, Req *identity_
 	return p.kClient.GetMenuTree(ctx, Req)
 }
 
+func (p *kIdentityServiceClient) ListMenuVersions(ctx context.Context, Req *identity_srv.ListMenuVersionsRequest, callOptions ...callopt.Option) (r *identity_srv.ListMenuVersionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListMenuVersions(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) DiffMenuVersions(ctx context.Context, Req *identity_srv.DiffMenuVersionsRequest, callOptions ...callopt.Option) (r *identity_srv.DiffMenuVersionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DiffMenuVersions(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) ActivateMenuVersion(ctx context.Context, Req *identity_srv.ActivateMenuVersionRequest, callOptions ...callopt.Option) (r *identity_srv.ActivateMenuVersionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ActivateMenuVersion(ctx, Req)
+}
+
 func (p *kIdentityServiceClient) ConfigureRoleMenus(ctx context.Context, Req *identity_srv.ConfigureRoleMenusRequest, callOptions ...callopt.Option) (r *identity_srv.ConfigureRoleMenusResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ConfigureRoleMenus(ctx, Req)
@@ -368,6 +536,21 @@ func (p *kIdentityServiceClient) GetUserMenuPermissions(ctx context.Context, Req
 	return p.kClient.GetUserMenuPermissions(ctx, Req)
 }
 
+func (p *kIdentityServiceClient) GetPermissionMatrix(ctx context.Context, Req *identity_srv.GetPermissionMatrixRequest, callOptions ...callopt.Option) (r *identity_srv.GetPermissionMatrixResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetPermissionMatrix(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) GetUserEffectivePermissions(ctx context.Context, Req *identity_srv.GetUserEffectivePermissionsRequest, callOptions ...callopt.Option) (r *identity_srv.GetUserEffectivePermissionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetUserEffectivePermissions(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) ExportPermissionReport(ctx context.Context, Req *identity_srv.ExportPermissionReportRequest, callOptions ...callopt.Option) (r *identity_srv.ExportPermissionReportResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportPermissionReport(ctx, Req)
+}
+
 func (p *kIdentityServiceClient) CreateAuditLog(ctx context.Context, Req *identity_srv.CreateAuditLogRequest, callOptions ...callopt.Option) (r *identity_srv.CreateAuditLogResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.CreateAuditLog(ctx, Req)
@@ -377,3 +560,18 @@ func (p *kIdentityServiceClient) ListAuditLogs(ctx context.Context, Req *identit
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ListAuditLogs(ctx, Req)
 }
+
+func (p *kIdentityServiceClient) ListDeletedEntities(ctx context.Context, Req *identity_srv.ListDeletedEntitiesRequest, callOptions ...callopt.Option) (r *identity_srv.ListDeletedEntitiesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListDeletedEntities(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) RestoreDeletedEntity(ctx context.Context, Req *identity_srv.RestoreDeletedEntityRequest, callOptions ...callopt.Option) (r *identity_srv.RestoreDeletedEntityResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RestoreDeletedEntity(ctx, Req)
+}
+
+func (p *kIdentityServiceClient) PurgeDeletedEntity(ctx context.Context, Req *identity_srv.PurgeDeletedEntityRequest, callOptions ...callopt.Option) (r *identity_srv.PurgeDeletedEntityResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.PurgeDeletedEntity(ctx, Req)
+}
@@ -43,6 +43,55 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"EnrollMFA": kitex.NewMethodInfo(
+		enrollMFAHandler,
+		newEnrollMFAArgs,
+		newEnrollMFAResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ConfirmMFA": kitex.NewMethodInfo(
+		confirmMFAHandler,
+		newConfirmMFAArgs,
+		newConfirmMFAResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"VerifyMFA": kitex.NewMethodInfo(
+		verifyMFAHandler,
+		newVerifyMFAArgs,
+		newVerifyMFAResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"DisableMFA": kitex.NewMethodInfo(
+		disableMFAHandler,
+		newDisableMFAArgs,
+		newDisableMFAResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RequestPasswordReset": kitex.NewMethodInfo(
+		requestPasswordResetHandler,
+		newRequestPasswordResetArgs,
+		newRequestPasswordResetResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ConfirmPasswordReset": kitex.NewMethodInfo(
+		confirmPasswordResetHandler,
+		newConfirmPasswordResetArgs,
+		newConfirmPasswordResetResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RefreshSession": kitex.NewMethodInfo(
+		refreshSessionHandler,
+		newRefreshSessionArgs,
+		newRefreshSessionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 	"CreateUser": kitex.NewMethodInfo(
 		createUserHandler,
 		newCreateUserArgs,
@@ -99,6 +148,20 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"ImportUsers": kitex.NewMethodInfo(
+		importUsersHandler,
+		newImportUsersArgs,
+		newImportUsersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ExportUsers": kitex.NewMethodInfo(
+		exportUsersHandler,
+		newExportUsersArgs,
+		newExportUsersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 	"CreateOrganization": kitex.NewMethodInfo(
 		createOrganizationHandler,
 		newCreateOrganizationArgs,
@@ -246,6 +309,27 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"GetOrganizationPasswordPolicy": kitex.NewMethodInfo(
+		getOrganizationPasswordPolicyHandler,
+		newGetOrganizationPasswordPolicyArgs,
+		newGetOrganizationPasswordPolicyResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"UpdateOrganizationPasswordPolicy": kitex.NewMethodInfo(
+		updateOrganizationPasswordPolicyHandler,
+		newUpdateOrganizationPasswordPolicyArgs,
+		newUpdateOrganizationPasswordPolicyResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ResetOrganizationPasswordPolicy": kitex.NewMethodInfo(
+		resetOrganizationPasswordPolicyHandler,
+		newResetOrganizationPasswordPolicyArgs,
+		newResetOrganizationPasswordPolicyResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 	"CreateRoleDefinition": kitex.NewMethodInfo(
 		createRoleDefinitionHandler,
 		newCreateRoleDefinitionArgs,
@@ -337,6 +421,90 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"GetUserTenantRoles": kitex.NewMethodInfo(
+		getUserTenantRolesHandler,
+		newGetUserTenantRolesArgs,
+		newGetUserTenantRolesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListRoleConstraintViolations": kitex.NewMethodInfo(
+		listRoleConstraintViolationsHandler,
+		newListRoleConstraintViolationsArgs,
+		newListRoleConstraintViolationsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListRoleGrantRequests": kitex.NewMethodInfo(
+		listRoleGrantRequestsHandler,
+		newListRoleGrantRequestsArgs,
+		newListRoleGrantRequestsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetRoleGrantRequest": kitex.NewMethodInfo(
+		getRoleGrantRequestHandler,
+		newGetRoleGrantRequestArgs,
+		newGetRoleGrantRequestResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ApproveRoleGrant": kitex.NewMethodInfo(
+		approveRoleGrantHandler,
+		newApproveRoleGrantArgs,
+		newApproveRoleGrantResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RejectRoleGrant": kitex.NewMethodInfo(
+		rejectRoleGrantHandler,
+		newRejectRoleGrantArgs,
+		newRejectRoleGrantResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"CreateAccessReviewCampaign": kitex.NewMethodInfo(
+		createAccessReviewCampaignHandler,
+		newCreateAccessReviewCampaignArgs,
+		newCreateAccessReviewCampaignResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListAccessReviewCampaigns": kitex.NewMethodInfo(
+		listAccessReviewCampaignsHandler,
+		newListAccessReviewCampaignsArgs,
+		newListAccessReviewCampaignsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetAccessReviewCampaign": kitex.NewMethodInfo(
+		getAccessReviewCampaignHandler,
+		newGetAccessReviewCampaignArgs,
+		newGetAccessReviewCampaignResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListAccessReviewItems": kitex.NewMethodInfo(
+		listAccessReviewItemsHandler,
+		newListAccessReviewItemsArgs,
+		newListAccessReviewItemsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"DecideAccessReviewItem": kitex.NewMethodInfo(
+		decideAccessReviewItemHandler,
+		newDecideAccessReviewItemArgs,
+		newDecideAccessReviewItemResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"CloseAccessReviewCampaign": kitex.NewMethodInfo(
+		closeAccessReviewCampaignHandler,
+		newCloseAccessReviewCampaignArgs,
+		newCloseAccessReviewCampaignResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 	"UploadMenu": kitex.NewMethodInfo(
 		uploadMenuHandler,
 		newUploadMenuArgs,
@@ -351,6 +519,27 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"ListMenuVersions": kitex.NewMethodInfo(
+		listMenuVersionsHandler,
+		newListMenuVersionsArgs,
+		newListMenuVersionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"DiffMenuVersions": kitex.NewMethodInfo(
+		diffMenuVersionsHandler,
+		newDiffMenuVersionsArgs,
+		newDiffMenuVersionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ActivateMenuVersion": kitex.NewMethodInfo(
+		activateMenuVersionHandler,
+		newActivateMenuVersionArgs,
+		newActivateMenuVersionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 	"ConfigureRoleMenus": kitex.NewMethodInfo(
 		configureRoleMenusHandler,
 		newConfigureRoleMenusArgs,
@@ -393,6 +582,27 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"GetPermissionMatrix": kitex.NewMethodInfo(
+		getPermissionMatrixHandler,
+		newGetPermissionMatrixArgs,
+		newGetPermissionMatrixResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetUserEffectivePermissions": kitex.NewMethodInfo(
+		getUserEffectivePermissionsHandler,
+		newGetUserEffectivePermissionsArgs,
+		newGetUserEffectivePermissionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ExportPermissionReport": kitex.NewMethodInfo(
+		exportPermissionReportHandler,
+		newExportPermissionReportArgs,
+		newExportPermissionReportResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 	"CreateAuditLog": kitex.NewMethodInfo(
 		createAuditLogHandler,
 		newCreateAuditLogArgs,
@@ -407,6 +617,27 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"ListDeletedEntities": kitex.NewMethodInfo(
+		listDeletedEntitiesHandler,
+		newListDeletedEntitiesArgs,
+		newListDeletedEntitiesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RestoreDeletedEntity": kitex.NewMethodInfo(
+		restoreDeletedEntityHandler,
+		newRestoreDeletedEntityArgs,
+		newRestoreDeletedEntityResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"PurgeDeletedEntity": kitex.NewMethodInfo(
+		purgeDeletedEntityHandler,
+		newPurgeDeletedEntityArgs,
+		newPurgeDeletedEntityResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 }
 
 var (
@@ -917,52 +1148,52 @@ func (p *ForcePasswordChangeResult) GetResult() interface{} {
 	return p.Success
 }
 
-func createUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func enrollMFAHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.CreateUserRequest)
+		req := new(identity_srv.EnrollMFARequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).CreateUser(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).EnrollMFA(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *CreateUserArgs:
-		success, err := handler.(identity_srv.IdentityService).CreateUser(ctx, s.Req)
+	case *EnrollMFAArgs:
+		success, err := handler.(identity_srv.IdentityService).EnrollMFA(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*CreateUserResult)
+		realResult := result.(*EnrollMFAResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newCreateUserArgs() interface{} {
-	return &CreateUserArgs{}
+func newEnrollMFAArgs() interface{} {
+	return &EnrollMFAArgs{}
 }
 
-func newCreateUserResult() interface{} {
-	return &CreateUserResult{}
+func newEnrollMFAResult() interface{} {
+	return &EnrollMFAResult{}
 }
 
-type CreateUserArgs struct {
-	Req *identity_srv.CreateUserRequest
+type EnrollMFAArgs struct {
+	Req *identity_srv.EnrollMFARequest
 }
 
-func (p *CreateUserArgs) Marshal(out []byte) ([]byte, error) {
+func (p *EnrollMFAArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *CreateUserArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.CreateUserRequest)
+func (p *EnrollMFAArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.EnrollMFARequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -970,38 +1201,38 @@ func (p *CreateUserArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var CreateUserArgs_Req_DEFAULT *identity_srv.CreateUserRequest
+var EnrollMFAArgs_Req_DEFAULT *identity_srv.EnrollMFARequest
 
-func (p *CreateUserArgs) GetReq() *identity_srv.CreateUserRequest {
+func (p *EnrollMFAArgs) GetReq() *identity_srv.EnrollMFARequest {
 	if !p.IsSetReq() {
-		return CreateUserArgs_Req_DEFAULT
+		return EnrollMFAArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *CreateUserArgs) IsSetReq() bool {
+func (p *EnrollMFAArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *CreateUserArgs) GetFirstArgument() interface{} {
+func (p *EnrollMFAArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type CreateUserResult struct {
-	Success *identity_srv.CreateUserResponse
+type EnrollMFAResult struct {
+	Success *identity_srv.EnrollMFAResponse
 }
 
-var CreateUserResult_Success_DEFAULT *identity_srv.CreateUserResponse
+var EnrollMFAResult_Success_DEFAULT *identity_srv.EnrollMFAResponse
 
-func (p *CreateUserResult) Marshal(out []byte) ([]byte, error) {
+func (p *EnrollMFAResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *CreateUserResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.CreateUserResponse)
+func (p *EnrollMFAResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.EnrollMFAResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1009,71 +1240,71 @@ func (p *CreateUserResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *CreateUserResult) GetSuccess() *identity_srv.CreateUserResponse {
+func (p *EnrollMFAResult) GetSuccess() *identity_srv.EnrollMFAResponse {
 	if !p.IsSetSuccess() {
-		return CreateUserResult_Success_DEFAULT
+		return EnrollMFAResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *CreateUserResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.CreateUserResponse)
+func (p *EnrollMFAResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.EnrollMFAResponse)
 }
 
-func (p *CreateUserResult) IsSetSuccess() bool {
+func (p *EnrollMFAResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CreateUserResult) GetResult() interface{} {
+func (p *EnrollMFAResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func confirmMFAHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.GetUserRequest)
+		req := new(identity_srv.ConfirmMFARequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).GetUser(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).ConfirmMFA(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *GetUserArgs:
-		success, err := handler.(identity_srv.IdentityService).GetUser(ctx, s.Req)
+	case *ConfirmMFAArgs:
+		success, err := handler.(identity_srv.IdentityService).ConfirmMFA(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetUserResult)
+		realResult := result.(*ConfirmMFAResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetUserArgs() interface{} {
-	return &GetUserArgs{}
+func newConfirmMFAArgs() interface{} {
+	return &ConfirmMFAArgs{}
 }
 
-func newGetUserResult() interface{} {
-	return &GetUserResult{}
+func newConfirmMFAResult() interface{} {
+	return &ConfirmMFAResult{}
 }
 
-type GetUserArgs struct {
-	Req *identity_srv.GetUserRequest
+type ConfirmMFAArgs struct {
+	Req *identity_srv.ConfirmMFARequest
 }
 
-func (p *GetUserArgs) Marshal(out []byte) ([]byte, error) {
+func (p *ConfirmMFAArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetUserArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetUserRequest)
+func (p *ConfirmMFAArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ConfirmMFARequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1081,38 +1312,38 @@ func (p *GetUserArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetUserArgs_Req_DEFAULT *identity_srv.GetUserRequest
+var ConfirmMFAArgs_Req_DEFAULT *identity_srv.ConfirmMFARequest
 
-func (p *GetUserArgs) GetReq() *identity_srv.GetUserRequest {
+func (p *ConfirmMFAArgs) GetReq() *identity_srv.ConfirmMFARequest {
 	if !p.IsSetReq() {
-		return GetUserArgs_Req_DEFAULT
+		return ConfirmMFAArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetUserArgs) IsSetReq() bool {
+func (p *ConfirmMFAArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetUserArgs) GetFirstArgument() interface{} {
+func (p *ConfirmMFAArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetUserResult struct {
-	Success *identity_srv.GetUserResponse
+type ConfirmMFAResult struct {
+	Success *identity_srv.ConfirmMFAResponse
 }
 
-var GetUserResult_Success_DEFAULT *identity_srv.GetUserResponse
+var ConfirmMFAResult_Success_DEFAULT *identity_srv.ConfirmMFAResponse
 
-func (p *GetUserResult) Marshal(out []byte) ([]byte, error) {
+func (p *ConfirmMFAResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetUserResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetUserResponse)
+func (p *ConfirmMFAResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ConfirmMFAResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1120,71 +1351,71 @@ func (p *GetUserResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetUserResult) GetSuccess() *identity_srv.GetUserResponse {
+func (p *ConfirmMFAResult) GetSuccess() *identity_srv.ConfirmMFAResponse {
 	if !p.IsSetSuccess() {
-		return GetUserResult_Success_DEFAULT
+		return ConfirmMFAResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetUserResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.GetUserResponse)
+func (p *ConfirmMFAResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.ConfirmMFAResponse)
 }
 
-func (p *GetUserResult) IsSetSuccess() bool {
+func (p *ConfirmMFAResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetUserResult) GetResult() interface{} {
+func (p *ConfirmMFAResult) GetResult() interface{} {
 	return p.Success
 }
 
-func updateUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func verifyMFAHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.UpdateUserRequest)
+		req := new(identity_srv.VerifyMFARequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).UpdateUser(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).VerifyMFA(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *UpdateUserArgs:
-		success, err := handler.(identity_srv.IdentityService).UpdateUser(ctx, s.Req)
+	case *VerifyMFAArgs:
+		success, err := handler.(identity_srv.IdentityService).VerifyMFA(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*UpdateUserResult)
+		realResult := result.(*VerifyMFAResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newUpdateUserArgs() interface{} {
-	return &UpdateUserArgs{}
+func newVerifyMFAArgs() interface{} {
+	return &VerifyMFAArgs{}
 }
 
-func newUpdateUserResult() interface{} {
-	return &UpdateUserResult{}
+func newVerifyMFAResult() interface{} {
+	return &VerifyMFAResult{}
 }
 
-type UpdateUserArgs struct {
-	Req *identity_srv.UpdateUserRequest
+type VerifyMFAArgs struct {
+	Req *identity_srv.VerifyMFARequest
 }
 
-func (p *UpdateUserArgs) Marshal(out []byte) ([]byte, error) {
+func (p *VerifyMFAArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *UpdateUserArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.UpdateUserRequest)
+func (p *VerifyMFAArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.VerifyMFARequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1192,38 +1423,38 @@ func (p *UpdateUserArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var UpdateUserArgs_Req_DEFAULT *identity_srv.UpdateUserRequest
+var VerifyMFAArgs_Req_DEFAULT *identity_srv.VerifyMFARequest
 
-func (p *UpdateUserArgs) GetReq() *identity_srv.UpdateUserRequest {
+func (p *VerifyMFAArgs) GetReq() *identity_srv.VerifyMFARequest {
 	if !p.IsSetReq() {
-		return UpdateUserArgs_Req_DEFAULT
+		return VerifyMFAArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *UpdateUserArgs) IsSetReq() bool {
+func (p *VerifyMFAArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UpdateUserArgs) GetFirstArgument() interface{} {
+func (p *VerifyMFAArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type UpdateUserResult struct {
-	Success *identity_srv.UpdateUserResponse
+type VerifyMFAResult struct {
+	Success *identity_srv.VerifyMFAResponse
 }
 
-var UpdateUserResult_Success_DEFAULT *identity_srv.UpdateUserResponse
+var VerifyMFAResult_Success_DEFAULT *identity_srv.VerifyMFAResponse
 
-func (p *UpdateUserResult) Marshal(out []byte) ([]byte, error) {
+func (p *VerifyMFAResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *UpdateUserResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.UpdateUserResponse)
+func (p *VerifyMFAResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.VerifyMFAResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1231,71 +1462,71 @@ func (p *UpdateUserResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *UpdateUserResult) GetSuccess() *identity_srv.UpdateUserResponse {
+func (p *VerifyMFAResult) GetSuccess() *identity_srv.VerifyMFAResponse {
 	if !p.IsSetSuccess() {
-		return UpdateUserResult_Success_DEFAULT
+		return VerifyMFAResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *UpdateUserResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.UpdateUserResponse)
+func (p *VerifyMFAResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.VerifyMFAResponse)
 }
 
-func (p *UpdateUserResult) IsSetSuccess() bool {
+func (p *VerifyMFAResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UpdateUserResult) GetResult() interface{} {
+func (p *VerifyMFAResult) GetResult() interface{} {
 	return p.Success
 }
 
-func deleteUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func disableMFAHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.DeleteUserRequest)
+		req := new(identity_srv.DisableMFARequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).DeleteUser(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).DisableMFA(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *DeleteUserArgs:
-		success, err := handler.(identity_srv.IdentityService).DeleteUser(ctx, s.Req)
+	case *DisableMFAArgs:
+		success, err := handler.(identity_srv.IdentityService).DisableMFA(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*DeleteUserResult)
+		realResult := result.(*DisableMFAResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newDeleteUserArgs() interface{} {
-	return &DeleteUserArgs{}
+func newDisableMFAArgs() interface{} {
+	return &DisableMFAArgs{}
 }
 
-func newDeleteUserResult() interface{} {
-	return &DeleteUserResult{}
+func newDisableMFAResult() interface{} {
+	return &DisableMFAResult{}
 }
 
-type DeleteUserArgs struct {
-	Req *identity_srv.DeleteUserRequest
+type DisableMFAArgs struct {
+	Req *identity_srv.DisableMFARequest
 }
 
-func (p *DeleteUserArgs) Marshal(out []byte) ([]byte, error) {
+func (p *DisableMFAArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *DeleteUserArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.DeleteUserRequest)
+func (p *DisableMFAArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.DisableMFARequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1303,38 +1534,38 @@ func (p *DeleteUserArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var DeleteUserArgs_Req_DEFAULT *identity_srv.DeleteUserRequest
+var DisableMFAArgs_Req_DEFAULT *identity_srv.DisableMFARequest
 
-func (p *DeleteUserArgs) GetReq() *identity_srv.DeleteUserRequest {
+func (p *DisableMFAArgs) GetReq() *identity_srv.DisableMFARequest {
 	if !p.IsSetReq() {
-		return DeleteUserArgs_Req_DEFAULT
+		return DisableMFAArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *DeleteUserArgs) IsSetReq() bool {
+func (p *DisableMFAArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DeleteUserArgs) GetFirstArgument() interface{} {
+func (p *DisableMFAArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type DeleteUserResult struct {
-	Success *identity_srv.DeleteUserResponse
+type DisableMFAResult struct {
+	Success *identity_srv.DisableMFAResponse
 }
 
-var DeleteUserResult_Success_DEFAULT *identity_srv.DeleteUserResponse
+var DisableMFAResult_Success_DEFAULT *identity_srv.DisableMFAResponse
 
-func (p *DeleteUserResult) Marshal(out []byte) ([]byte, error) {
+func (p *DisableMFAResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *DeleteUserResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.DeleteUserResponse)
+func (p *DisableMFAResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.DisableMFAResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1342,71 +1573,71 @@ func (p *DeleteUserResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *DeleteUserResult) GetSuccess() *identity_srv.DeleteUserResponse {
+func (p *DisableMFAResult) GetSuccess() *identity_srv.DisableMFAResponse {
 	if !p.IsSetSuccess() {
-		return DeleteUserResult_Success_DEFAULT
+		return DisableMFAResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *DeleteUserResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.DeleteUserResponse)
+func (p *DisableMFAResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.DisableMFAResponse)
 }
 
-func (p *DeleteUserResult) IsSetSuccess() bool {
+func (p *DisableMFAResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DeleteUserResult) GetResult() interface{} {
+func (p *DisableMFAResult) GetResult() interface{} {
 	return p.Success
 }
 
-func listUsersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func requestPasswordResetHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.ListUsersRequest)
+		req := new(identity_srv.RequestPasswordResetRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).ListUsers(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).RequestPasswordReset(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *ListUsersArgs:
-		success, err := handler.(identity_srv.IdentityService).ListUsers(ctx, s.Req)
+	case *RequestPasswordResetArgs:
+		success, err := handler.(identity_srv.IdentityService).RequestPasswordReset(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*ListUsersResult)
+		realResult := result.(*RequestPasswordResetResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newListUsersArgs() interface{} {
-	return &ListUsersArgs{}
+func newRequestPasswordResetArgs() interface{} {
+	return &RequestPasswordResetArgs{}
 }
 
-func newListUsersResult() interface{} {
-	return &ListUsersResult{}
+func newRequestPasswordResetResult() interface{} {
+	return &RequestPasswordResetResult{}
 }
 
-type ListUsersArgs struct {
-	Req *identity_srv.ListUsersRequest
+type RequestPasswordResetArgs struct {
+	Req *identity_srv.RequestPasswordResetRequest
 }
 
-func (p *ListUsersArgs) Marshal(out []byte) ([]byte, error) {
+func (p *RequestPasswordResetArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *ListUsersArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.ListUsersRequest)
+func (p *RequestPasswordResetArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.RequestPasswordResetRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1414,38 +1645,38 @@ func (p *ListUsersArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var ListUsersArgs_Req_DEFAULT *identity_srv.ListUsersRequest
+var RequestPasswordResetArgs_Req_DEFAULT *identity_srv.RequestPasswordResetRequest
 
-func (p *ListUsersArgs) GetReq() *identity_srv.ListUsersRequest {
+func (p *RequestPasswordResetArgs) GetReq() *identity_srv.RequestPasswordResetRequest {
 	if !p.IsSetReq() {
-		return ListUsersArgs_Req_DEFAULT
+		return RequestPasswordResetArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *ListUsersArgs) IsSetReq() bool {
+func (p *RequestPasswordResetArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ListUsersArgs) GetFirstArgument() interface{} {
+func (p *RequestPasswordResetArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type ListUsersResult struct {
-	Success *identity_srv.ListUsersResponse
+type RequestPasswordResetResult struct {
+	Success *identity_srv.RequestPasswordResetResponse
 }
 
-var ListUsersResult_Success_DEFAULT *identity_srv.ListUsersResponse
+var RequestPasswordResetResult_Success_DEFAULT *identity_srv.RequestPasswordResetResponse
 
-func (p *ListUsersResult) Marshal(out []byte) ([]byte, error) {
+func (p *RequestPasswordResetResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *ListUsersResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.ListUsersResponse)
+func (p *RequestPasswordResetResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.RequestPasswordResetResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1453,71 +1684,71 @@ func (p *ListUsersResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *ListUsersResult) GetSuccess() *identity_srv.ListUsersResponse {
+func (p *RequestPasswordResetResult) GetSuccess() *identity_srv.RequestPasswordResetResponse {
 	if !p.IsSetSuccess() {
-		return ListUsersResult_Success_DEFAULT
+		return RequestPasswordResetResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *ListUsersResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.ListUsersResponse)
+func (p *RequestPasswordResetResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.RequestPasswordResetResponse)
 }
 
-func (p *ListUsersResult) IsSetSuccess() bool {
+func (p *RequestPasswordResetResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ListUsersResult) GetResult() interface{} {
+func (p *RequestPasswordResetResult) GetResult() interface{} {
 	return p.Success
 }
 
-func searchUsersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func confirmPasswordResetHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.SearchUsersRequest)
+		req := new(identity_srv.ConfirmPasswordResetRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).SearchUsers(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).ConfirmPasswordReset(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *SearchUsersArgs:
-		success, err := handler.(identity_srv.IdentityService).SearchUsers(ctx, s.Req)
+	case *ConfirmPasswordResetArgs:
+		success, err := handler.(identity_srv.IdentityService).ConfirmPasswordReset(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*SearchUsersResult)
+		realResult := result.(*ConfirmPasswordResetResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newSearchUsersArgs() interface{} {
-	return &SearchUsersArgs{}
+func newConfirmPasswordResetArgs() interface{} {
+	return &ConfirmPasswordResetArgs{}
 }
 
-func newSearchUsersResult() interface{} {
-	return &SearchUsersResult{}
+func newConfirmPasswordResetResult() interface{} {
+	return &ConfirmPasswordResetResult{}
 }
 
-type SearchUsersArgs struct {
-	Req *identity_srv.SearchUsersRequest
+type ConfirmPasswordResetArgs struct {
+	Req *identity_srv.ConfirmPasswordResetRequest
 }
 
-func (p *SearchUsersArgs) Marshal(out []byte) ([]byte, error) {
+func (p *ConfirmPasswordResetArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *SearchUsersArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.SearchUsersRequest)
+func (p *ConfirmPasswordResetArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ConfirmPasswordResetRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1525,38 +1756,38 @@ func (p *SearchUsersArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var SearchUsersArgs_Req_DEFAULT *identity_srv.SearchUsersRequest
+var ConfirmPasswordResetArgs_Req_DEFAULT *identity_srv.ConfirmPasswordResetRequest
 
-func (p *SearchUsersArgs) GetReq() *identity_srv.SearchUsersRequest {
+func (p *ConfirmPasswordResetArgs) GetReq() *identity_srv.ConfirmPasswordResetRequest {
 	if !p.IsSetReq() {
-		return SearchUsersArgs_Req_DEFAULT
+		return ConfirmPasswordResetArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *SearchUsersArgs) IsSetReq() bool {
+func (p *ConfirmPasswordResetArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SearchUsersArgs) GetFirstArgument() interface{} {
+func (p *ConfirmPasswordResetArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type SearchUsersResult struct {
-	Success *identity_srv.SearchUsersResponse
+type ConfirmPasswordResetResult struct {
+	Success *identity_srv.ConfirmPasswordResetResponse
 }
 
-var SearchUsersResult_Success_DEFAULT *identity_srv.SearchUsersResponse
+var ConfirmPasswordResetResult_Success_DEFAULT *identity_srv.ConfirmPasswordResetResponse
 
-func (p *SearchUsersResult) Marshal(out []byte) ([]byte, error) {
+func (p *ConfirmPasswordResetResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *SearchUsersResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.SearchUsersResponse)
+func (p *ConfirmPasswordResetResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ConfirmPasswordResetResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1564,71 +1795,71 @@ func (p *SearchUsersResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *SearchUsersResult) GetSuccess() *identity_srv.SearchUsersResponse {
+func (p *ConfirmPasswordResetResult) GetSuccess() *identity_srv.ConfirmPasswordResetResponse {
 	if !p.IsSetSuccess() {
-		return SearchUsersResult_Success_DEFAULT
+		return ConfirmPasswordResetResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *SearchUsersResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.SearchUsersResponse)
+func (p *ConfirmPasswordResetResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.ConfirmPasswordResetResponse)
 }
 
-func (p *SearchUsersResult) IsSetSuccess() bool {
+func (p *ConfirmPasswordResetResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SearchUsersResult) GetResult() interface{} {
+func (p *ConfirmPasswordResetResult) GetResult() interface{} {
 	return p.Success
 }
 
-func changeUserStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func refreshSessionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.ChangeUserStatusRequest)
+		req := new(identity_srv.RefreshSessionRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).ChangeUserStatus(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).RefreshSession(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *ChangeUserStatusArgs:
-		success, err := handler.(identity_srv.IdentityService).ChangeUserStatus(ctx, s.Req)
+	case *RefreshSessionArgs:
+		success, err := handler.(identity_srv.IdentityService).RefreshSession(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*ChangeUserStatusResult)
+		realResult := result.(*RefreshSessionResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newChangeUserStatusArgs() interface{} {
-	return &ChangeUserStatusArgs{}
+func newRefreshSessionArgs() interface{} {
+	return &RefreshSessionArgs{}
 }
 
-func newChangeUserStatusResult() interface{} {
-	return &ChangeUserStatusResult{}
+func newRefreshSessionResult() interface{} {
+	return &RefreshSessionResult{}
 }
 
-type ChangeUserStatusArgs struct {
-	Req *identity_srv.ChangeUserStatusRequest
+type RefreshSessionArgs struct {
+	Req *identity_srv.RefreshSessionRequest
 }
 
-func (p *ChangeUserStatusArgs) Marshal(out []byte) ([]byte, error) {
+func (p *RefreshSessionArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *ChangeUserStatusArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.ChangeUserStatusRequest)
+func (p *RefreshSessionArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.RefreshSessionRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1636,38 +1867,38 @@ func (p *ChangeUserStatusArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var ChangeUserStatusArgs_Req_DEFAULT *identity_srv.ChangeUserStatusRequest
+var RefreshSessionArgs_Req_DEFAULT *identity_srv.RefreshSessionRequest
 
-func (p *ChangeUserStatusArgs) GetReq() *identity_srv.ChangeUserStatusRequest {
+func (p *RefreshSessionArgs) GetReq() *identity_srv.RefreshSessionRequest {
 	if !p.IsSetReq() {
-		return ChangeUserStatusArgs_Req_DEFAULT
+		return RefreshSessionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *ChangeUserStatusArgs) IsSetReq() bool {
+func (p *RefreshSessionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ChangeUserStatusArgs) GetFirstArgument() interface{} {
+func (p *RefreshSessionArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type ChangeUserStatusResult struct {
-	Success *identity_srv.ChangeUserStatusResponse
+type RefreshSessionResult struct {
+	Success *identity_srv.RefreshSessionResponse
 }
 
-var ChangeUserStatusResult_Success_DEFAULT *identity_srv.ChangeUserStatusResponse
+var RefreshSessionResult_Success_DEFAULT *identity_srv.RefreshSessionResponse
 
-func (p *ChangeUserStatusResult) Marshal(out []byte) ([]byte, error) {
+func (p *RefreshSessionResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *ChangeUserStatusResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.ChangeUserStatusResponse)
+func (p *RefreshSessionResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.RefreshSessionResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1675,71 +1906,71 @@ func (p *ChangeUserStatusResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *ChangeUserStatusResult) GetSuccess() *identity_srv.ChangeUserStatusResponse {
+func (p *RefreshSessionResult) GetSuccess() *identity_srv.RefreshSessionResponse {
 	if !p.IsSetSuccess() {
-		return ChangeUserStatusResult_Success_DEFAULT
+		return RefreshSessionResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *ChangeUserStatusResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.ChangeUserStatusResponse)
+func (p *RefreshSessionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.RefreshSessionResponse)
 }
 
-func (p *ChangeUserStatusResult) IsSetSuccess() bool {
+func (p *RefreshSessionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ChangeUserStatusResult) GetResult() interface{} {
+func (p *RefreshSessionResult) GetResult() interface{} {
 	return p.Success
 }
 
-func unlockUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func createUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.UnlockUserRequest)
+		req := new(identity_srv.CreateUserRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).UnlockUser(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).CreateUser(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *UnlockUserArgs:
-		success, err := handler.(identity_srv.IdentityService).UnlockUser(ctx, s.Req)
+	case *CreateUserArgs:
+		success, err := handler.(identity_srv.IdentityService).CreateUser(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*UnlockUserResult)
+		realResult := result.(*CreateUserResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newUnlockUserArgs() interface{} {
-	return &UnlockUserArgs{}
+func newCreateUserArgs() interface{} {
+	return &CreateUserArgs{}
 }
 
-func newUnlockUserResult() interface{} {
-	return &UnlockUserResult{}
+func newCreateUserResult() interface{} {
+	return &CreateUserResult{}
 }
 
-type UnlockUserArgs struct {
-	Req *identity_srv.UnlockUserRequest
+type CreateUserArgs struct {
+	Req *identity_srv.CreateUserRequest
 }
 
-func (p *UnlockUserArgs) Marshal(out []byte) ([]byte, error) {
+func (p *CreateUserArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *UnlockUserArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.UnlockUserRequest)
+func (p *CreateUserArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.CreateUserRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1747,38 +1978,38 @@ func (p *UnlockUserArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var UnlockUserArgs_Req_DEFAULT *identity_srv.UnlockUserRequest
+var CreateUserArgs_Req_DEFAULT *identity_srv.CreateUserRequest
 
-func (p *UnlockUserArgs) GetReq() *identity_srv.UnlockUserRequest {
+func (p *CreateUserArgs) GetReq() *identity_srv.CreateUserRequest {
 	if !p.IsSetReq() {
-		return UnlockUserArgs_Req_DEFAULT
+		return CreateUserArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *UnlockUserArgs) IsSetReq() bool {
+func (p *CreateUserArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UnlockUserArgs) GetFirstArgument() interface{} {
+func (p *CreateUserArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type UnlockUserResult struct {
-	Success *identity_srv.UnlockUserResponse
+type CreateUserResult struct {
+	Success *identity_srv.CreateUserResponse
 }
 
-var UnlockUserResult_Success_DEFAULT *identity_srv.UnlockUserResponse
+var CreateUserResult_Success_DEFAULT *identity_srv.CreateUserResponse
 
-func (p *UnlockUserResult) Marshal(out []byte) ([]byte, error) {
+func (p *CreateUserResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *UnlockUserResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.UnlockUserResponse)
+func (p *CreateUserResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.CreateUserResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1786,71 +2017,71 @@ func (p *UnlockUserResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *UnlockUserResult) GetSuccess() *identity_srv.UnlockUserResponse {
+func (p *CreateUserResult) GetSuccess() *identity_srv.CreateUserResponse {
 	if !p.IsSetSuccess() {
-		return UnlockUserResult_Success_DEFAULT
+		return CreateUserResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *UnlockUserResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.UnlockUserResponse)
+func (p *CreateUserResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.CreateUserResponse)
 }
 
-func (p *UnlockUserResult) IsSetSuccess() bool {
+func (p *CreateUserResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UnlockUserResult) GetResult() interface{} {
+func (p *CreateUserResult) GetResult() interface{} {
 	return p.Success
 }
 
-func createOrganizationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func getUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.CreateOrganizationRequest)
+		req := new(identity_srv.GetUserRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).CreateOrganization(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).GetUser(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *CreateOrganizationArgs:
-		success, err := handler.(identity_srv.IdentityService).CreateOrganization(ctx, s.Req)
+	case *GetUserArgs:
+		success, err := handler.(identity_srv.IdentityService).GetUser(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*CreateOrganizationResult)
+		realResult := result.(*GetUserResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newCreateOrganizationArgs() interface{} {
-	return &CreateOrganizationArgs{}
+func newGetUserArgs() interface{} {
+	return &GetUserArgs{}
 }
 
-func newCreateOrganizationResult() interface{} {
-	return &CreateOrganizationResult{}
+func newGetUserResult() interface{} {
+	return &GetUserResult{}
 }
 
-type CreateOrganizationArgs struct {
-	Req *identity_srv.CreateOrganizationRequest
+type GetUserArgs struct {
+	Req *identity_srv.GetUserRequest
 }
 
-func (p *CreateOrganizationArgs) Marshal(out []byte) ([]byte, error) {
+func (p *GetUserArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *CreateOrganizationArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.CreateOrganizationRequest)
+func (p *GetUserArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetUserRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1858,38 +2089,38 @@ func (p *CreateOrganizationArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var CreateOrganizationArgs_Req_DEFAULT *identity_srv.CreateOrganizationRequest
+var GetUserArgs_Req_DEFAULT *identity_srv.GetUserRequest
 
-func (p *CreateOrganizationArgs) GetReq() *identity_srv.CreateOrganizationRequest {
+func (p *GetUserArgs) GetReq() *identity_srv.GetUserRequest {
 	if !p.IsSetReq() {
-		return CreateOrganizationArgs_Req_DEFAULT
+		return GetUserArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *CreateOrganizationArgs) IsSetReq() bool {
+func (p *GetUserArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *CreateOrganizationArgs) GetFirstArgument() interface{} {
+func (p *GetUserArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type CreateOrganizationResult struct {
-	Success *identity_srv.CreateOrganizationResponse
+type GetUserResult struct {
+	Success *identity_srv.GetUserResponse
 }
 
-var CreateOrganizationResult_Success_DEFAULT *identity_srv.CreateOrganizationResponse
+var GetUserResult_Success_DEFAULT *identity_srv.GetUserResponse
 
-func (p *CreateOrganizationResult) Marshal(out []byte) ([]byte, error) {
+func (p *GetUserResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *CreateOrganizationResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.CreateOrganizationResponse)
+func (p *GetUserResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetUserResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1897,71 +2128,71 @@ func (p *CreateOrganizationResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *CreateOrganizationResult) GetSuccess() *identity_srv.CreateOrganizationResponse {
+func (p *GetUserResult) GetSuccess() *identity_srv.GetUserResponse {
 	if !p.IsSetSuccess() {
-		return CreateOrganizationResult_Success_DEFAULT
+		return GetUserResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *CreateOrganizationResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.CreateOrganizationResponse)
+func (p *GetUserResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetUserResponse)
 }
 
-func (p *CreateOrganizationResult) IsSetSuccess() bool {
+func (p *GetUserResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CreateOrganizationResult) GetResult() interface{} {
+func (p *GetUserResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getOrganizationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func updateUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.GetOrganizationRequest)
+		req := new(identity_srv.UpdateUserRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).GetOrganization(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).UpdateUser(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *GetOrganizationArgs:
-		success, err := handler.(identity_srv.IdentityService).GetOrganization(ctx, s.Req)
+	case *UpdateUserArgs:
+		success, err := handler.(identity_srv.IdentityService).UpdateUser(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetOrganizationResult)
+		realResult := result.(*UpdateUserResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetOrganizationArgs() interface{} {
-	return &GetOrganizationArgs{}
+func newUpdateUserArgs() interface{} {
+	return &UpdateUserArgs{}
 }
 
-func newGetOrganizationResult() interface{} {
-	return &GetOrganizationResult{}
+func newUpdateUserResult() interface{} {
+	return &UpdateUserResult{}
 }
 
-type GetOrganizationArgs struct {
-	Req *identity_srv.GetOrganizationRequest
+type UpdateUserArgs struct {
+	Req *identity_srv.UpdateUserRequest
 }
 
-func (p *GetOrganizationArgs) Marshal(out []byte) ([]byte, error) {
+func (p *UpdateUserArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetOrganizationArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetOrganizationRequest)
+func (p *UpdateUserArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UpdateUserRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1969,38 +2200,38 @@ func (p *GetOrganizationArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetOrganizationArgs_Req_DEFAULT *identity_srv.GetOrganizationRequest
+var UpdateUserArgs_Req_DEFAULT *identity_srv.UpdateUserRequest
 
-func (p *GetOrganizationArgs) GetReq() *identity_srv.GetOrganizationRequest {
+func (p *UpdateUserArgs) GetReq() *identity_srv.UpdateUserRequest {
 	if !p.IsSetReq() {
-		return GetOrganizationArgs_Req_DEFAULT
+		return UpdateUserArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetOrganizationArgs) IsSetReq() bool {
+func (p *UpdateUserArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetOrganizationArgs) GetFirstArgument() interface{} {
+func (p *UpdateUserArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetOrganizationResult struct {
-	Success *identity_srv.GetOrganizationResponse
+type UpdateUserResult struct {
+	Success *identity_srv.UpdateUserResponse
 }
 
-var GetOrganizationResult_Success_DEFAULT *identity_srv.GetOrganizationResponse
+var UpdateUserResult_Success_DEFAULT *identity_srv.UpdateUserResponse
 
-func (p *GetOrganizationResult) Marshal(out []byte) ([]byte, error) {
+func (p *UpdateUserResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetOrganizationResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetOrganizationResponse)
+func (p *UpdateUserResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UpdateUserResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -2008,71 +2239,3734 @@ func (p *GetOrganizationResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetOrganizationResult) GetSuccess() *identity_srv.GetOrganizationResponse {
+func (p *UpdateUserResult) GetSuccess() *identity_srv.UpdateUserResponse {
 	if !p.IsSetSuccess() {
-		return GetOrganizationResult_Success_DEFAULT
+		return UpdateUserResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetOrganizationResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.GetOrganizationResponse)
+func (p *UpdateUserResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.UpdateUserResponse)
 }
 
-func (p *GetOrganizationResult) IsSetSuccess() bool {
+func (p *UpdateUserResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateUserResult) GetResult() interface{} {
+	return p.Success
+}
+
+func deleteUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.DeleteUserRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).DeleteUser(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DeleteUserArgs:
+		success, err := handler.(identity_srv.IdentityService).DeleteUser(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteUserResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDeleteUserArgs() interface{} {
+	return &DeleteUserArgs{}
+}
+
+func newDeleteUserResult() interface{} {
+	return &DeleteUserResult{}
+}
+
+type DeleteUserArgs struct {
+	Req *identity_srv.DeleteUserRequest
+}
+
+func (p *DeleteUserArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeleteUserArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.DeleteUserRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeleteUserArgs_Req_DEFAULT *identity_srv.DeleteUserRequest
+
+func (p *DeleteUserArgs) GetReq() *identity_srv.DeleteUserRequest {
+	if !p.IsSetReq() {
+		return DeleteUserArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeleteUserArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeleteUserArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeleteUserResult struct {
+	Success *identity_srv.DeleteUserResponse
+}
+
+var DeleteUserResult_Success_DEFAULT *identity_srv.DeleteUserResponse
+
+func (p *DeleteUserResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeleteUserResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.DeleteUserResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteUserResult) GetSuccess() *identity_srv.DeleteUserResponse {
+	if !p.IsSetSuccess() {
+		return DeleteUserResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteUserResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.DeleteUserResponse)
+}
+
+func (p *DeleteUserResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeleteUserResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listUsersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.ListUsersRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).ListUsers(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListUsersArgs:
+		success, err := handler.(identity_srv.IdentityService).ListUsers(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListUsersResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListUsersArgs() interface{} {
+	return &ListUsersArgs{}
+}
+
+func newListUsersResult() interface{} {
+	return &ListUsersResult{}
+}
+
+type ListUsersArgs struct {
+	Req *identity_srv.ListUsersRequest
+}
+
+func (p *ListUsersArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListUsersArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ListUsersRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListUsersArgs_Req_DEFAULT *identity_srv.ListUsersRequest
+
+func (p *ListUsersArgs) GetReq() *identity_srv.ListUsersRequest {
+	if !p.IsSetReq() {
+		return ListUsersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListUsersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListUsersArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListUsersResult struct {
+	Success *identity_srv.ListUsersResponse
+}
+
+var ListUsersResult_Success_DEFAULT *identity_srv.ListUsersResponse
+
+func (p *ListUsersResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListUsersResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ListUsersResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListUsersResult) GetSuccess() *identity_srv.ListUsersResponse {
+	if !p.IsSetSuccess() {
+		return ListUsersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListUsersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.ListUsersResponse)
+}
+
+func (p *ListUsersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListUsersResult) GetResult() interface{} {
+	return p.Success
+}
+
+func searchUsersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.SearchUsersRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).SearchUsers(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SearchUsersArgs:
+		success, err := handler.(identity_srv.IdentityService).SearchUsers(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SearchUsersResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSearchUsersArgs() interface{} {
+	return &SearchUsersArgs{}
+}
+
+func newSearchUsersResult() interface{} {
+	return &SearchUsersResult{}
+}
+
+type SearchUsersArgs struct {
+	Req *identity_srv.SearchUsersRequest
+}
+
+func (p *SearchUsersArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SearchUsersArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.SearchUsersRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SearchUsersArgs_Req_DEFAULT *identity_srv.SearchUsersRequest
+
+func (p *SearchUsersArgs) GetReq() *identity_srv.SearchUsersRequest {
+	if !p.IsSetReq() {
+		return SearchUsersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SearchUsersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SearchUsersArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SearchUsersResult struct {
+	Success *identity_srv.SearchUsersResponse
+}
+
+var SearchUsersResult_Success_DEFAULT *identity_srv.SearchUsersResponse
+
+func (p *SearchUsersResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SearchUsersResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.SearchUsersResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SearchUsersResult) GetSuccess() *identity_srv.SearchUsersResponse {
+	if !p.IsSetSuccess() {
+		return SearchUsersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SearchUsersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.SearchUsersResponse)
+}
+
+func (p *SearchUsersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SearchUsersResult) GetResult() interface{} {
+	return p.Success
+}
+
+func changeUserStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.ChangeUserStatusRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).ChangeUserStatus(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ChangeUserStatusArgs:
+		success, err := handler.(identity_srv.IdentityService).ChangeUserStatus(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ChangeUserStatusResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newChangeUserStatusArgs() interface{} {
+	return &ChangeUserStatusArgs{}
+}
+
+func newChangeUserStatusResult() interface{} {
+	return &ChangeUserStatusResult{}
+}
+
+type ChangeUserStatusArgs struct {
+	Req *identity_srv.ChangeUserStatusRequest
+}
+
+func (p *ChangeUserStatusArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ChangeUserStatusArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ChangeUserStatusRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ChangeUserStatusArgs_Req_DEFAULT *identity_srv.ChangeUserStatusRequest
+
+func (p *ChangeUserStatusArgs) GetReq() *identity_srv.ChangeUserStatusRequest {
+	if !p.IsSetReq() {
+		return ChangeUserStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ChangeUserStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ChangeUserStatusArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ChangeUserStatusResult struct {
+	Success *identity_srv.ChangeUserStatusResponse
+}
+
+var ChangeUserStatusResult_Success_DEFAULT *identity_srv.ChangeUserStatusResponse
+
+func (p *ChangeUserStatusResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ChangeUserStatusResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ChangeUserStatusResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ChangeUserStatusResult) GetSuccess() *identity_srv.ChangeUserStatusResponse {
+	if !p.IsSetSuccess() {
+		return ChangeUserStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ChangeUserStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.ChangeUserStatusResponse)
+}
+
+func (p *ChangeUserStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ChangeUserStatusResult) GetResult() interface{} {
+	return p.Success
+}
+
+func unlockUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.UnlockUserRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).UnlockUser(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UnlockUserArgs:
+		success, err := handler.(identity_srv.IdentityService).UnlockUser(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UnlockUserResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUnlockUserArgs() interface{} {
+	return &UnlockUserArgs{}
+}
+
+func newUnlockUserResult() interface{} {
+	return &UnlockUserResult{}
+}
+
+type UnlockUserArgs struct {
+	Req *identity_srv.UnlockUserRequest
+}
+
+func (p *UnlockUserArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UnlockUserArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UnlockUserRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UnlockUserArgs_Req_DEFAULT *identity_srv.UnlockUserRequest
+
+func (p *UnlockUserArgs) GetReq() *identity_srv.UnlockUserRequest {
+	if !p.IsSetReq() {
+		return UnlockUserArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UnlockUserArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UnlockUserArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UnlockUserResult struct {
+	Success *identity_srv.UnlockUserResponse
+}
+
+var UnlockUserResult_Success_DEFAULT *identity_srv.UnlockUserResponse
+
+func (p *UnlockUserResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UnlockUserResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UnlockUserResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UnlockUserResult) GetSuccess() *identity_srv.UnlockUserResponse {
+	if !p.IsSetSuccess() {
+		return UnlockUserResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UnlockUserResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.UnlockUserResponse)
+}
+
+func (p *UnlockUserResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UnlockUserResult) GetResult() interface{} {
+	return p.Success
+}
+
+func importUsersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.ImportUsersRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).ImportUsers(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ImportUsersArgs:
+		success, err := handler.(identity_srv.IdentityService).ImportUsers(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ImportUsersResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newImportUsersArgs() interface{} {
+	return &ImportUsersArgs{}
+}
+
+func newImportUsersResult() interface{} {
+	return &ImportUsersResult{}
+}
+
+type ImportUsersArgs struct {
+	Req *identity_srv.ImportUsersRequest
+}
+
+func (p *ImportUsersArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ImportUsersArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ImportUsersRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ImportUsersArgs_Req_DEFAULT *identity_srv.ImportUsersRequest
+
+func (p *ImportUsersArgs) GetReq() *identity_srv.ImportUsersRequest {
+	if !p.IsSetReq() {
+		return ImportUsersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ImportUsersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ImportUsersArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ImportUsersResult struct {
+	Success *identity_srv.ImportUsersResponse
+}
+
+var ImportUsersResult_Success_DEFAULT *identity_srv.ImportUsersResponse
+
+func (p *ImportUsersResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ImportUsersResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ImportUsersResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ImportUsersResult) GetSuccess() *identity_srv.ImportUsersResponse {
+	if !p.IsSetSuccess() {
+		return ImportUsersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ImportUsersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.ImportUsersResponse)
+}
+
+func (p *ImportUsersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ImportUsersResult) GetResult() interface{} {
+	return p.Success
+}
+
+func exportUsersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.ExportUsersRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).ExportUsers(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ExportUsersArgs:
+		success, err := handler.(identity_srv.IdentityService).ExportUsers(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ExportUsersResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newExportUsersArgs() interface{} {
+	return &ExportUsersArgs{}
+}
+
+func newExportUsersResult() interface{} {
+	return &ExportUsersResult{}
+}
+
+type ExportUsersArgs struct {
+	Req *identity_srv.ExportUsersRequest
+}
+
+func (p *ExportUsersArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ExportUsersArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ExportUsersRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ExportUsersArgs_Req_DEFAULT *identity_srv.ExportUsersRequest
+
+func (p *ExportUsersArgs) GetReq() *identity_srv.ExportUsersRequest {
+	if !p.IsSetReq() {
+		return ExportUsersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ExportUsersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ExportUsersArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ExportUsersResult struct {
+	Success *identity_srv.ExportUsersResponse
+}
+
+var ExportUsersResult_Success_DEFAULT *identity_srv.ExportUsersResponse
+
+func (p *ExportUsersResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ExportUsersResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ExportUsersResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ExportUsersResult) GetSuccess() *identity_srv.ExportUsersResponse {
+	if !p.IsSetSuccess() {
+		return ExportUsersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ExportUsersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.ExportUsersResponse)
+}
+
+func (p *ExportUsersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ExportUsersResult) GetResult() interface{} {
+	return p.Success
+}
+
+func createOrganizationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.CreateOrganizationRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).CreateOrganization(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreateOrganizationArgs:
+		success, err := handler.(identity_srv.IdentityService).CreateOrganization(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateOrganizationResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreateOrganizationArgs() interface{} {
+	return &CreateOrganizationArgs{}
+}
+
+func newCreateOrganizationResult() interface{} {
+	return &CreateOrganizationResult{}
+}
+
+type CreateOrganizationArgs struct {
+	Req *identity_srv.CreateOrganizationRequest
+}
+
+func (p *CreateOrganizationArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateOrganizationArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.CreateOrganizationRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateOrganizationArgs_Req_DEFAULT *identity_srv.CreateOrganizationRequest
+
+func (p *CreateOrganizationArgs) GetReq() *identity_srv.CreateOrganizationRequest {
+	if !p.IsSetReq() {
+		return CreateOrganizationArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateOrganizationArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateOrganizationArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateOrganizationResult struct {
+	Success *identity_srv.CreateOrganizationResponse
+}
+
+var CreateOrganizationResult_Success_DEFAULT *identity_srv.CreateOrganizationResponse
+
+func (p *CreateOrganizationResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateOrganizationResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.CreateOrganizationResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateOrganizationResult) GetSuccess() *identity_srv.CreateOrganizationResponse {
+	if !p.IsSetSuccess() {
+		return CreateOrganizationResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateOrganizationResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.CreateOrganizationResponse)
+}
+
+func (p *CreateOrganizationResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateOrganizationResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getOrganizationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.GetOrganizationRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).GetOrganization(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetOrganizationArgs:
+		success, err := handler.(identity_srv.IdentityService).GetOrganization(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetOrganizationResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetOrganizationArgs() interface{} {
+	return &GetOrganizationArgs{}
+}
+
+func newGetOrganizationResult() interface{} {
+	return &GetOrganizationResult{}
+}
+
+type GetOrganizationArgs struct {
+	Req *identity_srv.GetOrganizationRequest
+}
+
+func (p *GetOrganizationArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetOrganizationArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetOrganizationRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetOrganizationArgs_Req_DEFAULT *identity_srv.GetOrganizationRequest
+
+func (p *GetOrganizationArgs) GetReq() *identity_srv.GetOrganizationRequest {
+	if !p.IsSetReq() {
+		return GetOrganizationArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetOrganizationArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetOrganizationArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetOrganizationResult struct {
+	Success *identity_srv.GetOrganizationResponse
+}
+
+var GetOrganizationResult_Success_DEFAULT *identity_srv.GetOrganizationResponse
+
+func (p *GetOrganizationResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetOrganizationResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetOrganizationResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetOrganizationResult) GetSuccess() *identity_srv.GetOrganizationResponse {
+	if !p.IsSetSuccess() {
+		return GetOrganizationResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetOrganizationResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetOrganizationResponse)
+}
+
+func (p *GetOrganizationResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetOrganizationResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateOrganizationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.UpdateOrganizationRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).UpdateOrganization(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UpdateOrganizationArgs:
+		success, err := handler.(identity_srv.IdentityService).UpdateOrganization(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateOrganizationResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUpdateOrganizationArgs() interface{} {
+	return &UpdateOrganizationArgs{}
+}
+
+func newUpdateOrganizationResult() interface{} {
+	return &UpdateOrganizationResult{}
+}
+
+type UpdateOrganizationArgs struct {
+	Req *identity_srv.UpdateOrganizationRequest
+}
+
+func (p *UpdateOrganizationArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateOrganizationArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UpdateOrganizationRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateOrganizationArgs_Req_DEFAULT *identity_srv.UpdateOrganizationRequest
+
+func (p *UpdateOrganizationArgs) GetReq() *identity_srv.UpdateOrganizationRequest {
+	if !p.IsSetReq() {
+		return UpdateOrganizationArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateOrganizationArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateOrganizationArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateOrganizationResult struct {
+	Success *identity_srv.UpdateOrganizationResponse
+}
+
+var UpdateOrganizationResult_Success_DEFAULT *identity_srv.UpdateOrganizationResponse
+
+func (p *UpdateOrganizationResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateOrganizationResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UpdateOrganizationResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateOrganizationResult) GetSuccess() *identity_srv.UpdateOrganizationResponse {
+	if !p.IsSetSuccess() {
+		return UpdateOrganizationResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateOrganizationResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.UpdateOrganizationResponse)
+}
+
+func (p *UpdateOrganizationResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateOrganizationResult) GetResult() interface{} {
+	return p.Success
+}
+
+func deleteOrganizationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.DeleteOrganizationRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).DeleteOrganization(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DeleteOrganizationArgs:
+		success, err := handler.(identity_srv.IdentityService).DeleteOrganization(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteOrganizationResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDeleteOrganizationArgs() interface{} {
+	return &DeleteOrganizationArgs{}
+}
+
+func newDeleteOrganizationResult() interface{} {
+	return &DeleteOrganizationResult{}
+}
+
+type DeleteOrganizationArgs struct {
+	Req *identity_srv.DeleteOrganizationRequest
+}
+
+func (p *DeleteOrganizationArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeleteOrganizationArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.DeleteOrganizationRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeleteOrganizationArgs_Req_DEFAULT *identity_srv.DeleteOrganizationRequest
+
+func (p *DeleteOrganizationArgs) GetReq() *identity_srv.DeleteOrganizationRequest {
+	if !p.IsSetReq() {
+		return DeleteOrganizationArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeleteOrganizationArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeleteOrganizationArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeleteOrganizationResult struct {
+	Success *identity_srv.DeleteOrganizationResponse
+}
+
+var DeleteOrganizationResult_Success_DEFAULT *identity_srv.DeleteOrganizationResponse
+
+func (p *DeleteOrganizationResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeleteOrganizationResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.DeleteOrganizationResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteOrganizationResult) GetSuccess() *identity_srv.DeleteOrganizationResponse {
+	if !p.IsSetSuccess() {
+		return DeleteOrganizationResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteOrganizationResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.DeleteOrganizationResponse)
+}
+
+func (p *DeleteOrganizationResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeleteOrganizationResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listOrganizationsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.ListOrganizationsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).ListOrganizations(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListOrganizationsArgs:
+		success, err := handler.(identity_srv.IdentityService).ListOrganizations(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListOrganizationsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListOrganizationsArgs() interface{} {
+	return &ListOrganizationsArgs{}
+}
+
+func newListOrganizationsResult() interface{} {
+	return &ListOrganizationsResult{}
+}
+
+type ListOrganizationsArgs struct {
+	Req *identity_srv.ListOrganizationsRequest
+}
+
+func (p *ListOrganizationsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListOrganizationsArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ListOrganizationsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListOrganizationsArgs_Req_DEFAULT *identity_srv.ListOrganizationsRequest
+
+func (p *ListOrganizationsArgs) GetReq() *identity_srv.ListOrganizationsRequest {
+	if !p.IsSetReq() {
+		return ListOrganizationsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListOrganizationsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListOrganizationsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListOrganizationsResult struct {
+	Success *identity_srv.ListOrganizationsResponse
+}
+
+var ListOrganizationsResult_Success_DEFAULT *identity_srv.ListOrganizationsResponse
+
+func (p *ListOrganizationsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListOrganizationsResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ListOrganizationsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListOrganizationsResult) GetSuccess() *identity_srv.ListOrganizationsResponse {
+	if !p.IsSetSuccess() {
+		return ListOrganizationsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListOrganizationsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.ListOrganizationsResponse)
+}
+
+func (p *ListOrganizationsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListOrganizationsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func addMembershipHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.AddMembershipRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).AddMembership(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *AddMembershipArgs:
+		success, err := handler.(identity_srv.IdentityService).AddMembership(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*AddMembershipResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newAddMembershipArgs() interface{} {
+	return &AddMembershipArgs{}
+}
+
+func newAddMembershipResult() interface{} {
+	return &AddMembershipResult{}
+}
+
+type AddMembershipArgs struct {
+	Req *identity_srv.AddMembershipRequest
+}
+
+func (p *AddMembershipArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *AddMembershipArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.AddMembershipRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var AddMembershipArgs_Req_DEFAULT *identity_srv.AddMembershipRequest
+
+func (p *AddMembershipArgs) GetReq() *identity_srv.AddMembershipRequest {
+	if !p.IsSetReq() {
+		return AddMembershipArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *AddMembershipArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AddMembershipArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type AddMembershipResult struct {
+	Success *identity_srv.AddMembershipResponse
+}
+
+var AddMembershipResult_Success_DEFAULT *identity_srv.AddMembershipResponse
+
+func (p *AddMembershipResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *AddMembershipResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.AddMembershipResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *AddMembershipResult) GetSuccess() *identity_srv.AddMembershipResponse {
+	if !p.IsSetSuccess() {
+		return AddMembershipResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *AddMembershipResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.AddMembershipResponse)
+}
+
+func (p *AddMembershipResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AddMembershipResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateMembershipHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.UpdateMembershipRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).UpdateMembership(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UpdateMembershipArgs:
+		success, err := handler.(identity_srv.IdentityService).UpdateMembership(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateMembershipResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUpdateMembershipArgs() interface{} {
+	return &UpdateMembershipArgs{}
+}
+
+func newUpdateMembershipResult() interface{} {
+	return &UpdateMembershipResult{}
+}
+
+type UpdateMembershipArgs struct {
+	Req *identity_srv.UpdateMembershipRequest
+}
+
+func (p *UpdateMembershipArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateMembershipArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UpdateMembershipRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateMembershipArgs_Req_DEFAULT *identity_srv.UpdateMembershipRequest
+
+func (p *UpdateMembershipArgs) GetReq() *identity_srv.UpdateMembershipRequest {
+	if !p.IsSetReq() {
+		return UpdateMembershipArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateMembershipArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateMembershipArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateMembershipResult struct {
+	Success *identity_srv.UpdateMembershipResponse
+}
+
+var UpdateMembershipResult_Success_DEFAULT *identity_srv.UpdateMembershipResponse
+
+func (p *UpdateMembershipResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateMembershipResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UpdateMembershipResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateMembershipResult) GetSuccess() *identity_srv.UpdateMembershipResponse {
+	if !p.IsSetSuccess() {
+		return UpdateMembershipResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateMembershipResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.UpdateMembershipResponse)
+}
+
+func (p *UpdateMembershipResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateMembershipResult) GetResult() interface{} {
+	return p.Success
+}
+
+func removeMembershipHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.RemoveMembershipRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).RemoveMembership(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *RemoveMembershipArgs:
+		success, err := handler.(identity_srv.IdentityService).RemoveMembership(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*RemoveMembershipResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newRemoveMembershipArgs() interface{} {
+	return &RemoveMembershipArgs{}
+}
+
+func newRemoveMembershipResult() interface{} {
+	return &RemoveMembershipResult{}
+}
+
+type RemoveMembershipArgs struct {
+	Req *identity_srv.RemoveMembershipRequest
+}
+
+func (p *RemoveMembershipArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *RemoveMembershipArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.RemoveMembershipRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var RemoveMembershipArgs_Req_DEFAULT *identity_srv.RemoveMembershipRequest
+
+func (p *RemoveMembershipArgs) GetReq() *identity_srv.RemoveMembershipRequest {
+	if !p.IsSetReq() {
+		return RemoveMembershipArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *RemoveMembershipArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RemoveMembershipArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type RemoveMembershipResult struct {
+	Success *identity_srv.RemoveMembershipResponse
+}
+
+var RemoveMembershipResult_Success_DEFAULT *identity_srv.RemoveMembershipResponse
+
+func (p *RemoveMembershipResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *RemoveMembershipResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.RemoveMembershipResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *RemoveMembershipResult) GetSuccess() *identity_srv.RemoveMembershipResponse {
+	if !p.IsSetSuccess() {
+		return RemoveMembershipResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *RemoveMembershipResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.RemoveMembershipResponse)
+}
+
+func (p *RemoveMembershipResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RemoveMembershipResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getMembershipHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.GetMembershipRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).GetMembership(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetMembershipArgs:
+		success, err := handler.(identity_srv.IdentityService).GetMembership(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetMembershipResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetMembershipArgs() interface{} {
+	return &GetMembershipArgs{}
+}
+
+func newGetMembershipResult() interface{} {
+	return &GetMembershipResult{}
+}
+
+type GetMembershipArgs struct {
+	Req *identity_srv.GetMembershipRequest
+}
+
+func (p *GetMembershipArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetMembershipArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetMembershipRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetMembershipArgs_Req_DEFAULT *identity_srv.GetMembershipRequest
+
+func (p *GetMembershipArgs) GetReq() *identity_srv.GetMembershipRequest {
+	if !p.IsSetReq() {
+		return GetMembershipArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetMembershipArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetMembershipArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetMembershipResult struct {
+	Success *identity_srv.GetMembershipResponse
+}
+
+var GetMembershipResult_Success_DEFAULT *identity_srv.GetMembershipResponse
+
+func (p *GetMembershipResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetMembershipResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetMembershipResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetMembershipResult) GetSuccess() *identity_srv.GetMembershipResponse {
+	if !p.IsSetSuccess() {
+		return GetMembershipResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetMembershipResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetMembershipResponse)
+}
+
+func (p *GetMembershipResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetMembershipResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getUserMembershipsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.GetUserMembershipsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).GetUserMemberships(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetUserMembershipsArgs:
+		success, err := handler.(identity_srv.IdentityService).GetUserMemberships(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetUserMembershipsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetUserMembershipsArgs() interface{} {
+	return &GetUserMembershipsArgs{}
+}
+
+func newGetUserMembershipsResult() interface{} {
+	return &GetUserMembershipsResult{}
+}
+
+type GetUserMembershipsArgs struct {
+	Req *identity_srv.GetUserMembershipsRequest
+}
+
+func (p *GetUserMembershipsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetUserMembershipsArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetUserMembershipsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetUserMembershipsArgs_Req_DEFAULT *identity_srv.GetUserMembershipsRequest
+
+func (p *GetUserMembershipsArgs) GetReq() *identity_srv.GetUserMembershipsRequest {
+	if !p.IsSetReq() {
+		return GetUserMembershipsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetUserMembershipsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetUserMembershipsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetUserMembershipsResult struct {
+	Success *identity_srv.GetUserMembershipsResponse
+}
+
+var GetUserMembershipsResult_Success_DEFAULT *identity_srv.GetUserMembershipsResponse
+
+func (p *GetUserMembershipsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetUserMembershipsResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetUserMembershipsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetUserMembershipsResult) GetSuccess() *identity_srv.GetUserMembershipsResponse {
+	if !p.IsSetSuccess() {
+		return GetUserMembershipsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetUserMembershipsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetUserMembershipsResponse)
+}
+
+func (p *GetUserMembershipsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetUserMembershipsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getPrimaryMembershipHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.GetPrimaryMembershipRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).GetPrimaryMembership(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetPrimaryMembershipArgs:
+		success, err := handler.(identity_srv.IdentityService).GetPrimaryMembership(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetPrimaryMembershipResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetPrimaryMembershipArgs() interface{} {
+	return &GetPrimaryMembershipArgs{}
+}
+
+func newGetPrimaryMembershipResult() interface{} {
+	return &GetPrimaryMembershipResult{}
+}
+
+type GetPrimaryMembershipArgs struct {
+	Req *identity_srv.GetPrimaryMembershipRequest
+}
+
+func (p *GetPrimaryMembershipArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetPrimaryMembershipArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetPrimaryMembershipRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetPrimaryMembershipArgs_Req_DEFAULT *identity_srv.GetPrimaryMembershipRequest
+
+func (p *GetPrimaryMembershipArgs) GetReq() *identity_srv.GetPrimaryMembershipRequest {
+	if !p.IsSetReq() {
+		return GetPrimaryMembershipArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetPrimaryMembershipArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetPrimaryMembershipArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetPrimaryMembershipResult struct {
+	Success *identity_srv.GetPrimaryMembershipResponse
+}
+
+var GetPrimaryMembershipResult_Success_DEFAULT *identity_srv.GetPrimaryMembershipResponse
+
+func (p *GetPrimaryMembershipResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetPrimaryMembershipResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetPrimaryMembershipResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetPrimaryMembershipResult) GetSuccess() *identity_srv.GetPrimaryMembershipResponse {
+	if !p.IsSetSuccess() {
+		return GetPrimaryMembershipResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetPrimaryMembershipResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetPrimaryMembershipResponse)
+}
+
+func (p *GetPrimaryMembershipResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetPrimaryMembershipResult) GetResult() interface{} {
+	return p.Success
+}
+
+func checkMembershipHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.CheckMembershipRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).CheckMembership(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CheckMembershipArgs:
+		success, err := handler.(identity_srv.IdentityService).CheckMembership(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CheckMembershipResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCheckMembershipArgs() interface{} {
+	return &CheckMembershipArgs{}
+}
+
+func newCheckMembershipResult() interface{} {
+	return &CheckMembershipResult{}
+}
+
+type CheckMembershipArgs struct {
+	Req *identity_srv.CheckMembershipRequest
+}
+
+func (p *CheckMembershipArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CheckMembershipArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.CheckMembershipRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CheckMembershipArgs_Req_DEFAULT *identity_srv.CheckMembershipRequest
+
+func (p *CheckMembershipArgs) GetReq() *identity_srv.CheckMembershipRequest {
+	if !p.IsSetReq() {
+		return CheckMembershipArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CheckMembershipArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CheckMembershipArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CheckMembershipResult struct {
+	Success *identity_srv.CheckMembershipResponse
+}
+
+var CheckMembershipResult_Success_DEFAULT *identity_srv.CheckMembershipResponse
+
+func (p *CheckMembershipResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CheckMembershipResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.CheckMembershipResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CheckMembershipResult) GetSuccess() *identity_srv.CheckMembershipResponse {
+	if !p.IsSetSuccess() {
+		return CheckMembershipResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CheckMembershipResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.CheckMembershipResponse)
+}
+
+func (p *CheckMembershipResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CheckMembershipResult) GetResult() interface{} {
+	return p.Success
+}
+
+func createDepartmentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.CreateDepartmentRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).CreateDepartment(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreateDepartmentArgs:
+		success, err := handler.(identity_srv.IdentityService).CreateDepartment(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateDepartmentResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreateDepartmentArgs() interface{} {
+	return &CreateDepartmentArgs{}
+}
+
+func newCreateDepartmentResult() interface{} {
+	return &CreateDepartmentResult{}
+}
+
+type CreateDepartmentArgs struct {
+	Req *identity_srv.CreateDepartmentRequest
+}
+
+func (p *CreateDepartmentArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateDepartmentArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.CreateDepartmentRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateDepartmentArgs_Req_DEFAULT *identity_srv.CreateDepartmentRequest
+
+func (p *CreateDepartmentArgs) GetReq() *identity_srv.CreateDepartmentRequest {
+	if !p.IsSetReq() {
+		return CreateDepartmentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateDepartmentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateDepartmentArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateDepartmentResult struct {
+	Success *identity_srv.CreateDepartmentResponse
+}
+
+var CreateDepartmentResult_Success_DEFAULT *identity_srv.CreateDepartmentResponse
+
+func (p *CreateDepartmentResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateDepartmentResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.CreateDepartmentResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateDepartmentResult) GetSuccess() *identity_srv.CreateDepartmentResponse {
+	if !p.IsSetSuccess() {
+		return CreateDepartmentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateDepartmentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.CreateDepartmentResponse)
+}
+
+func (p *CreateDepartmentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateDepartmentResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getDepartmentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.GetDepartmentRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).GetDepartment(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetDepartmentArgs:
+		success, err := handler.(identity_srv.IdentityService).GetDepartment(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetDepartmentResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetDepartmentArgs() interface{} {
+	return &GetDepartmentArgs{}
+}
+
+func newGetDepartmentResult() interface{} {
+	return &GetDepartmentResult{}
+}
+
+type GetDepartmentArgs struct {
+	Req *identity_srv.GetDepartmentRequest
+}
+
+func (p *GetDepartmentArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetDepartmentArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetDepartmentRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetDepartmentArgs_Req_DEFAULT *identity_srv.GetDepartmentRequest
+
+func (p *GetDepartmentArgs) GetReq() *identity_srv.GetDepartmentRequest {
+	if !p.IsSetReq() {
+		return GetDepartmentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetDepartmentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetDepartmentArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetDepartmentResult struct {
+	Success *identity_srv.GetDepartmentResponse
+}
+
+var GetDepartmentResult_Success_DEFAULT *identity_srv.GetDepartmentResponse
+
+func (p *GetDepartmentResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetDepartmentResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetDepartmentResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetDepartmentResult) GetSuccess() *identity_srv.GetDepartmentResponse {
+	if !p.IsSetSuccess() {
+		return GetDepartmentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetDepartmentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetDepartmentResponse)
+}
+
+func (p *GetDepartmentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetDepartmentResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateDepartmentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.UpdateDepartmentRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).UpdateDepartment(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UpdateDepartmentArgs:
+		success, err := handler.(identity_srv.IdentityService).UpdateDepartment(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateDepartmentResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUpdateDepartmentArgs() interface{} {
+	return &UpdateDepartmentArgs{}
+}
+
+func newUpdateDepartmentResult() interface{} {
+	return &UpdateDepartmentResult{}
+}
+
+type UpdateDepartmentArgs struct {
+	Req *identity_srv.UpdateDepartmentRequest
+}
+
+func (p *UpdateDepartmentArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateDepartmentArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UpdateDepartmentRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateDepartmentArgs_Req_DEFAULT *identity_srv.UpdateDepartmentRequest
+
+func (p *UpdateDepartmentArgs) GetReq() *identity_srv.UpdateDepartmentRequest {
+	if !p.IsSetReq() {
+		return UpdateDepartmentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateDepartmentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateDepartmentArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateDepartmentResult struct {
+	Success *identity_srv.UpdateDepartmentResponse
+}
+
+var UpdateDepartmentResult_Success_DEFAULT *identity_srv.UpdateDepartmentResponse
+
+func (p *UpdateDepartmentResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateDepartmentResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UpdateDepartmentResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateDepartmentResult) GetSuccess() *identity_srv.UpdateDepartmentResponse {
+	if !p.IsSetSuccess() {
+		return UpdateDepartmentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateDepartmentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.UpdateDepartmentResponse)
+}
+
+func (p *UpdateDepartmentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateDepartmentResult) GetResult() interface{} {
+	return p.Success
+}
+
+func deleteDepartmentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.DeleteDepartmentRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).DeleteDepartment(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DeleteDepartmentArgs:
+		success, err := handler.(identity_srv.IdentityService).DeleteDepartment(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteDepartmentResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDeleteDepartmentArgs() interface{} {
+	return &DeleteDepartmentArgs{}
+}
+
+func newDeleteDepartmentResult() interface{} {
+	return &DeleteDepartmentResult{}
+}
+
+type DeleteDepartmentArgs struct {
+	Req *identity_srv.DeleteDepartmentRequest
+}
+
+func (p *DeleteDepartmentArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeleteDepartmentArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.DeleteDepartmentRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeleteDepartmentArgs_Req_DEFAULT *identity_srv.DeleteDepartmentRequest
+
+func (p *DeleteDepartmentArgs) GetReq() *identity_srv.DeleteDepartmentRequest {
+	if !p.IsSetReq() {
+		return DeleteDepartmentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeleteDepartmentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeleteDepartmentArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeleteDepartmentResult struct {
+	Success *identity_srv.DeleteDepartmentResponse
+}
+
+var DeleteDepartmentResult_Success_DEFAULT *identity_srv.DeleteDepartmentResponse
+
+func (p *DeleteDepartmentResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeleteDepartmentResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.DeleteDepartmentResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteDepartmentResult) GetSuccess() *identity_srv.DeleteDepartmentResponse {
+	if !p.IsSetSuccess() {
+		return DeleteDepartmentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteDepartmentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.DeleteDepartmentResponse)
+}
+
+func (p *DeleteDepartmentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeleteDepartmentResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getOrganizationDepartmentsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.GetOrganizationDepartmentsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).GetOrganizationDepartments(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetOrganizationDepartmentsArgs:
+		success, err := handler.(identity_srv.IdentityService).GetOrganizationDepartments(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetOrganizationDepartmentsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetOrganizationDepartmentsArgs() interface{} {
+	return &GetOrganizationDepartmentsArgs{}
+}
+
+func newGetOrganizationDepartmentsResult() interface{} {
+	return &GetOrganizationDepartmentsResult{}
+}
+
+type GetOrganizationDepartmentsArgs struct {
+	Req *identity_srv.GetOrganizationDepartmentsRequest
+}
+
+func (p *GetOrganizationDepartmentsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetOrganizationDepartmentsArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetOrganizationDepartmentsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetOrganizationDepartmentsArgs_Req_DEFAULT *identity_srv.GetOrganizationDepartmentsRequest
+
+func (p *GetOrganizationDepartmentsArgs) GetReq() *identity_srv.GetOrganizationDepartmentsRequest {
+	if !p.IsSetReq() {
+		return GetOrganizationDepartmentsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetOrganizationDepartmentsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetOrganizationDepartmentsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetOrganizationDepartmentsResult struct {
+	Success *identity_srv.GetOrganizationDepartmentsResponse
+}
+
+var GetOrganizationDepartmentsResult_Success_DEFAULT *identity_srv.GetOrganizationDepartmentsResponse
+
+func (p *GetOrganizationDepartmentsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetOrganizationDepartmentsResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetOrganizationDepartmentsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetOrganizationDepartmentsResult) GetSuccess() *identity_srv.GetOrganizationDepartmentsResponse {
+	if !p.IsSetSuccess() {
+		return GetOrganizationDepartmentsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetOrganizationDepartmentsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetOrganizationDepartmentsResponse)
+}
+
+func (p *GetOrganizationDepartmentsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetOrganizationDepartmentsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func uploadTemporaryLogoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.UploadTemporaryLogoRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).UploadTemporaryLogo(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UploadTemporaryLogoArgs:
+		success, err := handler.(identity_srv.IdentityService).UploadTemporaryLogo(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UploadTemporaryLogoResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUploadTemporaryLogoArgs() interface{} {
+	return &UploadTemporaryLogoArgs{}
+}
+
+func newUploadTemporaryLogoResult() interface{} {
+	return &UploadTemporaryLogoResult{}
+}
+
+type UploadTemporaryLogoArgs struct {
+	Req *identity_srv.UploadTemporaryLogoRequest
+}
+
+func (p *UploadTemporaryLogoArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UploadTemporaryLogoArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UploadTemporaryLogoRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UploadTemporaryLogoArgs_Req_DEFAULT *identity_srv.UploadTemporaryLogoRequest
+
+func (p *UploadTemporaryLogoArgs) GetReq() *identity_srv.UploadTemporaryLogoRequest {
+	if !p.IsSetReq() {
+		return UploadTemporaryLogoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UploadTemporaryLogoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UploadTemporaryLogoArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UploadTemporaryLogoResult struct {
+	Success *identity_srv.UploadTemporaryLogoResponse
+}
+
+var UploadTemporaryLogoResult_Success_DEFAULT *identity_srv.UploadTemporaryLogoResponse
+
+func (p *UploadTemporaryLogoResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UploadTemporaryLogoResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UploadTemporaryLogoResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UploadTemporaryLogoResult) GetSuccess() *identity_srv.UploadTemporaryLogoResponse {
+	if !p.IsSetSuccess() {
+		return UploadTemporaryLogoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UploadTemporaryLogoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.UploadTemporaryLogoResponse)
+}
+
+func (p *UploadTemporaryLogoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UploadTemporaryLogoResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getOrganizationLogoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.GetOrganizationLogoRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).GetOrganizationLogo(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetOrganizationLogoArgs:
+		success, err := handler.(identity_srv.IdentityService).GetOrganizationLogo(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetOrganizationLogoResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetOrganizationLogoArgs() interface{} {
+	return &GetOrganizationLogoArgs{}
+}
+
+func newGetOrganizationLogoResult() interface{} {
+	return &GetOrganizationLogoResult{}
+}
+
+type GetOrganizationLogoArgs struct {
+	Req *identity_srv.GetOrganizationLogoRequest
+}
+
+func (p *GetOrganizationLogoArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetOrganizationLogoArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetOrganizationLogoRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetOrganizationLogoArgs_Req_DEFAULT *identity_srv.GetOrganizationLogoRequest
+
+func (p *GetOrganizationLogoArgs) GetReq() *identity_srv.GetOrganizationLogoRequest {
+	if !p.IsSetReq() {
+		return GetOrganizationLogoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetOrganizationLogoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetOrganizationLogoArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetOrganizationLogoResult struct {
+	Success *identity_srv.GetOrganizationLogoResponse
+}
+
+var GetOrganizationLogoResult_Success_DEFAULT *identity_srv.GetOrganizationLogoResponse
+
+func (p *GetOrganizationLogoResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetOrganizationLogoResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetOrganizationLogoResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetOrganizationLogoResult) GetSuccess() *identity_srv.GetOrganizationLogoResponse {
+	if !p.IsSetSuccess() {
+		return GetOrganizationLogoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetOrganizationLogoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetOrganizationLogoResponse)
+}
+
+func (p *GetOrganizationLogoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetOrganizationLogoResult) GetResult() interface{} {
+	return p.Success
+}
+
+func deleteOrganizationLogoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.DeleteOrganizationLogoRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).DeleteOrganizationLogo(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DeleteOrganizationLogoArgs:
+		success, err := handler.(identity_srv.IdentityService).DeleteOrganizationLogo(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteOrganizationLogoResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDeleteOrganizationLogoArgs() interface{} {
+	return &DeleteOrganizationLogoArgs{}
+}
+
+func newDeleteOrganizationLogoResult() interface{} {
+	return &DeleteOrganizationLogoResult{}
+}
+
+type DeleteOrganizationLogoArgs struct {
+	Req *identity_srv.DeleteOrganizationLogoRequest
+}
+
+func (p *DeleteOrganizationLogoArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeleteOrganizationLogoArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.DeleteOrganizationLogoRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeleteOrganizationLogoArgs_Req_DEFAULT *identity_srv.DeleteOrganizationLogoRequest
+
+func (p *DeleteOrganizationLogoArgs) GetReq() *identity_srv.DeleteOrganizationLogoRequest {
+	if !p.IsSetReq() {
+		return DeleteOrganizationLogoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeleteOrganizationLogoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeleteOrganizationLogoArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeleteOrganizationLogoResult struct {
+	Success *identity_srv.DeleteOrganizationLogoResponse
+}
+
+var DeleteOrganizationLogoResult_Success_DEFAULT *identity_srv.DeleteOrganizationLogoResponse
+
+func (p *DeleteOrganizationLogoResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeleteOrganizationLogoResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.DeleteOrganizationLogoResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteOrganizationLogoResult) GetSuccess() *identity_srv.DeleteOrganizationLogoResponse {
+	if !p.IsSetSuccess() {
+		return DeleteOrganizationLogoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteOrganizationLogoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.DeleteOrganizationLogoResponse)
+}
+
+func (p *DeleteOrganizationLogoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeleteOrganizationLogoResult) GetResult() interface{} {
+	return p.Success
+}
+
+func bindLogoToOrganizationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.BindLogoToOrganizationRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).BindLogoToOrganization(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *BindLogoToOrganizationArgs:
+		success, err := handler.(identity_srv.IdentityService).BindLogoToOrganization(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*BindLogoToOrganizationResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newBindLogoToOrganizationArgs() interface{} {
+	return &BindLogoToOrganizationArgs{}
+}
+
+func newBindLogoToOrganizationResult() interface{} {
+	return &BindLogoToOrganizationResult{}
+}
+
+type BindLogoToOrganizationArgs struct {
+	Req *identity_srv.BindLogoToOrganizationRequest
+}
+
+func (p *BindLogoToOrganizationArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *BindLogoToOrganizationArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.BindLogoToOrganizationRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var BindLogoToOrganizationArgs_Req_DEFAULT *identity_srv.BindLogoToOrganizationRequest
+
+func (p *BindLogoToOrganizationArgs) GetReq() *identity_srv.BindLogoToOrganizationRequest {
+	if !p.IsSetReq() {
+		return BindLogoToOrganizationArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *BindLogoToOrganizationArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *BindLogoToOrganizationArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type BindLogoToOrganizationResult struct {
+	Success *identity_srv.BindLogoToOrganizationResponse
+}
+
+var BindLogoToOrganizationResult_Success_DEFAULT *identity_srv.BindLogoToOrganizationResponse
+
+func (p *BindLogoToOrganizationResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *BindLogoToOrganizationResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.BindLogoToOrganizationResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *BindLogoToOrganizationResult) GetSuccess() *identity_srv.BindLogoToOrganizationResponse {
+	if !p.IsSetSuccess() {
+		return BindLogoToOrganizationResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *BindLogoToOrganizationResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.BindLogoToOrganizationResponse)
+}
+
+func (p *BindLogoToOrganizationResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *BindLogoToOrganizationResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getOrganizationPasswordPolicyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.GetOrganizationPasswordPolicyRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).GetOrganizationPasswordPolicy(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetOrganizationPasswordPolicyArgs:
+		success, err := handler.(identity_srv.IdentityService).GetOrganizationPasswordPolicy(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetOrganizationPasswordPolicyResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetOrganizationPasswordPolicyArgs() interface{} {
+	return &GetOrganizationPasswordPolicyArgs{}
+}
+
+func newGetOrganizationPasswordPolicyResult() interface{} {
+	return &GetOrganizationPasswordPolicyResult{}
+}
+
+type GetOrganizationPasswordPolicyArgs struct {
+	Req *identity_srv.GetOrganizationPasswordPolicyRequest
+}
+
+func (p *GetOrganizationPasswordPolicyArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetOrganizationPasswordPolicyArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetOrganizationPasswordPolicyRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetOrganizationPasswordPolicyArgs_Req_DEFAULT *identity_srv.GetOrganizationPasswordPolicyRequest
+
+func (p *GetOrganizationPasswordPolicyArgs) GetReq() *identity_srv.GetOrganizationPasswordPolicyRequest {
+	if !p.IsSetReq() {
+		return GetOrganizationPasswordPolicyArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetOrganizationPasswordPolicyArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetOrganizationPasswordPolicyArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetOrganizationPasswordPolicyResult struct {
+	Success *identity_srv.GetOrganizationPasswordPolicyResponse
+}
+
+var GetOrganizationPasswordPolicyResult_Success_DEFAULT *identity_srv.GetOrganizationPasswordPolicyResponse
+
+func (p *GetOrganizationPasswordPolicyResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetOrganizationPasswordPolicyResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetOrganizationPasswordPolicyResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetOrganizationPasswordPolicyResult) GetSuccess() *identity_srv.GetOrganizationPasswordPolicyResponse {
+	if !p.IsSetSuccess() {
+		return GetOrganizationPasswordPolicyResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetOrganizationPasswordPolicyResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetOrganizationPasswordPolicyResponse)
+}
+
+func (p *GetOrganizationPasswordPolicyResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetOrganizationPasswordPolicyResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateOrganizationPasswordPolicyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.UpdateOrganizationPasswordPolicyRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).UpdateOrganizationPasswordPolicy(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UpdateOrganizationPasswordPolicyArgs:
+		success, err := handler.(identity_srv.IdentityService).UpdateOrganizationPasswordPolicy(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateOrganizationPasswordPolicyResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUpdateOrganizationPasswordPolicyArgs() interface{} {
+	return &UpdateOrganizationPasswordPolicyArgs{}
+}
+
+func newUpdateOrganizationPasswordPolicyResult() interface{} {
+	return &UpdateOrganizationPasswordPolicyResult{}
+}
+
+type UpdateOrganizationPasswordPolicyArgs struct {
+	Req *identity_srv.UpdateOrganizationPasswordPolicyRequest
+}
+
+func (p *UpdateOrganizationPasswordPolicyArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateOrganizationPasswordPolicyArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UpdateOrganizationPasswordPolicyRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateOrganizationPasswordPolicyArgs_Req_DEFAULT *identity_srv.UpdateOrganizationPasswordPolicyRequest
+
+func (p *UpdateOrganizationPasswordPolicyArgs) GetReq() *identity_srv.UpdateOrganizationPasswordPolicyRequest {
+	if !p.IsSetReq() {
+		return UpdateOrganizationPasswordPolicyArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateOrganizationPasswordPolicyArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateOrganizationPasswordPolicyArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateOrganizationPasswordPolicyResult struct {
+	Success *identity_srv.UpdateOrganizationPasswordPolicyResponse
+}
+
+var UpdateOrganizationPasswordPolicyResult_Success_DEFAULT *identity_srv.UpdateOrganizationPasswordPolicyResponse
+
+func (p *UpdateOrganizationPasswordPolicyResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateOrganizationPasswordPolicyResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UpdateOrganizationPasswordPolicyResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateOrganizationPasswordPolicyResult) GetSuccess() *identity_srv.UpdateOrganizationPasswordPolicyResponse {
+	if !p.IsSetSuccess() {
+		return UpdateOrganizationPasswordPolicyResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateOrganizationPasswordPolicyResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.UpdateOrganizationPasswordPolicyResponse)
+}
+
+func (p *UpdateOrganizationPasswordPolicyResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateOrganizationPasswordPolicyResult) GetResult() interface{} {
+	return p.Success
+}
+
+func resetOrganizationPasswordPolicyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.ResetOrganizationPasswordPolicyRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).ResetOrganizationPasswordPolicy(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ResetOrganizationPasswordPolicyArgs:
+		success, err := handler.(identity_srv.IdentityService).ResetOrganizationPasswordPolicy(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ResetOrganizationPasswordPolicyResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newResetOrganizationPasswordPolicyArgs() interface{} {
+	return &ResetOrganizationPasswordPolicyArgs{}
+}
+
+func newResetOrganizationPasswordPolicyResult() interface{} {
+	return &ResetOrganizationPasswordPolicyResult{}
+}
+
+type ResetOrganizationPasswordPolicyArgs struct {
+	Req *identity_srv.ResetOrganizationPasswordPolicyRequest
+}
+
+func (p *ResetOrganizationPasswordPolicyArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ResetOrganizationPasswordPolicyArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ResetOrganizationPasswordPolicyRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ResetOrganizationPasswordPolicyArgs_Req_DEFAULT *identity_srv.ResetOrganizationPasswordPolicyRequest
+
+func (p *ResetOrganizationPasswordPolicyArgs) GetReq() *identity_srv.ResetOrganizationPasswordPolicyRequest {
+	if !p.IsSetReq() {
+		return ResetOrganizationPasswordPolicyArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ResetOrganizationPasswordPolicyArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ResetOrganizationPasswordPolicyArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ResetOrganizationPasswordPolicyResult struct {
+	Success *identity_srv.ResetOrganizationPasswordPolicyResponse
+}
+
+var ResetOrganizationPasswordPolicyResult_Success_DEFAULT *identity_srv.ResetOrganizationPasswordPolicyResponse
+
+func (p *ResetOrganizationPasswordPolicyResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ResetOrganizationPasswordPolicyResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ResetOrganizationPasswordPolicyResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ResetOrganizationPasswordPolicyResult) GetSuccess() *identity_srv.ResetOrganizationPasswordPolicyResponse {
+	if !p.IsSetSuccess() {
+		return ResetOrganizationPasswordPolicyResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ResetOrganizationPasswordPolicyResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.ResetOrganizationPasswordPolicyResponse)
+}
+
+func (p *ResetOrganizationPasswordPolicyResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ResetOrganizationPasswordPolicyResult) GetResult() interface{} {
+	return p.Success
+}
+
+func createRoleDefinitionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.RoleDefinitionCreateRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).CreateRoleDefinition(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreateRoleDefinitionArgs:
+		success, err := handler.(identity_srv.IdentityService).CreateRoleDefinition(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateRoleDefinitionResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreateRoleDefinitionArgs() interface{} {
+	return &CreateRoleDefinitionArgs{}
+}
+
+func newCreateRoleDefinitionResult() interface{} {
+	return &CreateRoleDefinitionResult{}
+}
+
+type CreateRoleDefinitionArgs struct {
+	Req *identity_srv.RoleDefinitionCreateRequest
+}
+
+func (p *CreateRoleDefinitionArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateRoleDefinitionArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.RoleDefinitionCreateRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateRoleDefinitionArgs_Req_DEFAULT *identity_srv.RoleDefinitionCreateRequest
+
+func (p *CreateRoleDefinitionArgs) GetReq() *identity_srv.RoleDefinitionCreateRequest {
+	if !p.IsSetReq() {
+		return CreateRoleDefinitionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateRoleDefinitionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateRoleDefinitionArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateRoleDefinitionResult struct {
+	Success *identity_srv.CreateRoleDefinitionResponse
+}
+
+var CreateRoleDefinitionResult_Success_DEFAULT *identity_srv.CreateRoleDefinitionResponse
+
+func (p *CreateRoleDefinitionResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateRoleDefinitionResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.CreateRoleDefinitionResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateRoleDefinitionResult) GetSuccess() *identity_srv.CreateRoleDefinitionResponse {
+	if !p.IsSetSuccess() {
+		return CreateRoleDefinitionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateRoleDefinitionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.CreateRoleDefinitionResponse)
+}
+
+func (p *CreateRoleDefinitionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateRoleDefinitionResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateRoleDefinitionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(identity_srv.RoleDefinitionUpdateRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(identity_srv.IdentityService).UpdateRoleDefinition(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UpdateRoleDefinitionArgs:
+		success, err := handler.(identity_srv.IdentityService).UpdateRoleDefinition(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateRoleDefinitionResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUpdateRoleDefinitionArgs() interface{} {
+	return &UpdateRoleDefinitionArgs{}
+}
+
+func newUpdateRoleDefinitionResult() interface{} {
+	return &UpdateRoleDefinitionResult{}
+}
+
+type UpdateRoleDefinitionArgs struct {
+	Req *identity_srv.RoleDefinitionUpdateRequest
+}
+
+func (p *UpdateRoleDefinitionArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateRoleDefinitionArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.RoleDefinitionUpdateRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateRoleDefinitionArgs_Req_DEFAULT *identity_srv.RoleDefinitionUpdateRequest
+
+func (p *UpdateRoleDefinitionArgs) GetReq() *identity_srv.RoleDefinitionUpdateRequest {
+	if !p.IsSetReq() {
+		return UpdateRoleDefinitionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateRoleDefinitionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateRoleDefinitionArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateRoleDefinitionResult struct {
+	Success *identity_srv.UpdateRoleDefinitionResponse
+}
+
+var UpdateRoleDefinitionResult_Success_DEFAULT *identity_srv.UpdateRoleDefinitionResponse
+
+func (p *UpdateRoleDefinitionResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateRoleDefinitionResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UpdateRoleDefinitionResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateRoleDefinitionResult) GetSuccess() *identity_srv.UpdateRoleDefinitionResponse {
+	if !p.IsSetSuccess() {
+		return UpdateRoleDefinitionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateRoleDefinitionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.UpdateRoleDefinitionResponse)
+}
+
+func (p *UpdateRoleDefinitionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetOrganizationResult) GetResult() interface{} {
+func (p *UpdateRoleDefinitionResult) GetResult() interface{} {
 	return p.Success
 }
 
-func updateOrganizationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func deleteRoleDefinitionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.UpdateOrganizationRequest)
+		req := new(identity_srv.DeleteRoleDefinitionRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).UpdateOrganization(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).DeleteRoleDefinition(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *UpdateOrganizationArgs:
-		success, err := handler.(identity_srv.IdentityService).UpdateOrganization(ctx, s.Req)
+	case *DeleteRoleDefinitionArgs:
+		success, err := handler.(identity_srv.IdentityService).DeleteRoleDefinition(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*UpdateOrganizationResult)
+		realResult := result.(*DeleteRoleDefinitionResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newUpdateOrganizationArgs() interface{} {
-	return &UpdateOrganizationArgs{}
+func newDeleteRoleDefinitionArgs() interface{} {
+	return &DeleteRoleDefinitionArgs{}
 }
 
-func newUpdateOrganizationResult() interface{} {
-	return &UpdateOrganizationResult{}
+func newDeleteRoleDefinitionResult() interface{} {
+	return &DeleteRoleDefinitionResult{}
 }
 
-type UpdateOrganizationArgs struct {
-	Req *identity_srv.UpdateOrganizationRequest
+type DeleteRoleDefinitionArgs struct {
+	Req *identity_srv.DeleteRoleDefinitionRequest
 }
 
-func (p *UpdateOrganizationArgs) Marshal(out []byte) ([]byte, error) {
+func (p *DeleteRoleDefinitionArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *UpdateOrganizationArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.UpdateOrganizationRequest)
+func (p *DeleteRoleDefinitionArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.DeleteRoleDefinitionRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -2080,38 +5974,38 @@ func (p *UpdateOrganizationArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var UpdateOrganizationArgs_Req_DEFAULT *identity_srv.UpdateOrganizationRequest
+var DeleteRoleDefinitionArgs_Req_DEFAULT *identity_srv.DeleteRoleDefinitionRequest
 
-func (p *UpdateOrganizationArgs) GetReq() *identity_srv.UpdateOrganizationRequest {
+func (p *DeleteRoleDefinitionArgs) GetReq() *identity_srv.DeleteRoleDefinitionRequest {
 	if !p.IsSetReq() {
-		return UpdateOrganizationArgs_Req_DEFAULT
+		return DeleteRoleDefinitionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *UpdateOrganizationArgs) IsSetReq() bool {
+func (p *DeleteRoleDefinitionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UpdateOrganizationArgs) GetFirstArgument() interface{} {
+func (p *DeleteRoleDefinitionArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type UpdateOrganizationResult struct {
-	Success *identity_srv.UpdateOrganizationResponse
+type DeleteRoleDefinitionResult struct {
+	Success *identity_srv.DeleteRoleDefinitionResponse
 }
 
-var UpdateOrganizationResult_Success_DEFAULT *identity_srv.UpdateOrganizationResponse
+var DeleteRoleDefinitionResult_Success_DEFAULT *identity_srv.DeleteRoleDefinitionResponse
 
-func (p *UpdateOrganizationResult) Marshal(out []byte) ([]byte, error) {
+func (p *DeleteRoleDefinitionResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *UpdateOrganizationResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.UpdateOrganizationResponse)
+func (p *DeleteRoleDefinitionResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.DeleteRoleDefinitionResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -2119,71 +6013,71 @@ func (p *UpdateOrganizationResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *UpdateOrganizationResult) GetSuccess() *identity_srv.UpdateOrganizationResponse {
+func (p *DeleteRoleDefinitionResult) GetSuccess() *identity_srv.DeleteRoleDefinitionResponse {
 	if !p.IsSetSuccess() {
-		return UpdateOrganizationResult_Success_DEFAULT
+		return DeleteRoleDefinitionResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *UpdateOrganizationResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.UpdateOrganizationResponse)
+func (p *DeleteRoleDefinitionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.DeleteRoleDefinitionResponse)
 }
 
-func (p *UpdateOrganizationResult) IsSetSuccess() bool {
+func (p *DeleteRoleDefinitionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UpdateOrganizationResult) GetResult() interface{} {
+func (p *DeleteRoleDefinitionResult) GetResult() interface{} {
 	return p.Success
 }
 
-func deleteOrganizationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func getRoleDefinitionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.DeleteOrganizationRequest)
+		req := new(identity_srv.GetRoleDefinitionRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).DeleteOrganization(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).GetRoleDefinition(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *DeleteOrganizationArgs:
-		success, err := handler.(identity_srv.IdentityService).DeleteOrganization(ctx, s.Req)
+	case *GetRoleDefinitionArgs:
+		success, err := handler.(identity_srv.IdentityService).GetRoleDefinition(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*DeleteOrganizationResult)
+		realResult := result.(*GetRoleDefinitionResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newDeleteOrganizationArgs() interface{} {
-	return &DeleteOrganizationArgs{}
+func newGetRoleDefinitionArgs() interface{} {
+	return &GetRoleDefinitionArgs{}
 }
 
-func newDeleteOrganizationResult() interface{} {
-	return &DeleteOrganizationResult{}
+func newGetRoleDefinitionResult() interface{} {
+	return &GetRoleDefinitionResult{}
 }
 
-type DeleteOrganizationArgs struct {
-	Req *identity_srv.DeleteOrganizationRequest
+type GetRoleDefinitionArgs struct {
+	Req *identity_srv.GetRoleDefinitionRequest
 }
 
-func (p *DeleteOrganizationArgs) Marshal(out []byte) ([]byte, error) {
+func (p *GetRoleDefinitionArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *DeleteOrganizationArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.DeleteOrganizationRequest)
+func (p *GetRoleDefinitionArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetRoleDefinitionRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -2191,38 +6085,38 @@ func (p *DeleteOrganizationArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var DeleteOrganizationArgs_Req_DEFAULT *identity_srv.DeleteOrganizationRequest
+var GetRoleDefinitionArgs_Req_DEFAULT *identity_srv.GetRoleDefinitionRequest
 
-func (p *DeleteOrganizationArgs) GetReq() *identity_srv.DeleteOrganizationRequest {
+func (p *GetRoleDefinitionArgs) GetReq() *identity_srv.GetRoleDefinitionRequest {
 	if !p.IsSetReq() {
-		return DeleteOrganizationArgs_Req_DEFAULT
+		return GetRoleDefinitionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *DeleteOrganizationArgs) IsSetReq() bool {
+func (p *GetRoleDefinitionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DeleteOrganizationArgs) GetFirstArgument() interface{} {
+func (p *GetRoleDefinitionArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type DeleteOrganizationResult struct {
-	Success *identity_srv.DeleteOrganizationResponse
+type GetRoleDefinitionResult struct {
+	Success *identity_srv.GetRoleDefinitionResponse
 }
 
-var DeleteOrganizationResult_Success_DEFAULT *identity_srv.DeleteOrganizationResponse
+var GetRoleDefinitionResult_Success_DEFAULT *identity_srv.GetRoleDefinitionResponse
 
-func (p *DeleteOrganizationResult) Marshal(out []byte) ([]byte, error) {
+func (p *GetRoleDefinitionResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *DeleteOrganizationResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.DeleteOrganizationResponse)
+func (p *GetRoleDefinitionResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetRoleDefinitionResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -2230,71 +6124,71 @@ func (p *DeleteOrganizationResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *DeleteOrganizationResult) GetSuccess() *identity_srv.DeleteOrganizationResponse {
+func (p *GetRoleDefinitionResult) GetSuccess() *identity_srv.GetRoleDefinitionResponse {
 	if !p.IsSetSuccess() {
-		return DeleteOrganizationResult_Success_DEFAULT
+		return GetRoleDefinitionResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *DeleteOrganizationResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.DeleteOrganizationResponse)
+func (p *GetRoleDefinitionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetRoleDefinitionResponse)
 }
 
-func (p *DeleteOrganizationResult) IsSetSuccess() bool {
+func (p *GetRoleDefinitionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DeleteOrganizationResult) GetResult() interface{} {
+func (p *GetRoleDefinitionResult) GetResult() interface{} {
 	return p.Success
 }
 
-func listOrganizationsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func listRoleDefinitionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.ListOrganizationsRequest)
+		req := new(identity_srv.RoleDefinitionQueryRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).ListOrganizations(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).ListRoleDefinitions(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *ListOrganizationsArgs:
-		success, err := handler.(identity_srv.IdentityService).ListOrganizations(ctx, s.Req)
+	case *ListRoleDefinitionsArgs:
+		success, err := handler.(identity_srv.IdentityService).ListRoleDefinitions(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*ListOrganizationsResult)
+		realResult := result.(*ListRoleDefinitionsResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newListOrganizationsArgs() interface{} {
-	return &ListOrganizationsArgs{}
+func newListRoleDefinitionsArgs() interface{} {
+	return &ListRoleDefinitionsArgs{}
 }
 
-func newListOrganizationsResult() interface{} {
-	return &ListOrganizationsResult{}
+func newListRoleDefinitionsResult() interface{} {
+	return &ListRoleDefinitionsResult{}
 }
 
-type ListOrganizationsArgs struct {
-	Req *identity_srv.ListOrganizationsRequest
+type ListRoleDefinitionsArgs struct {
+	Req *identity_srv.RoleDefinitionQueryRequest
 }
 
-func (p *ListOrganizationsArgs) Marshal(out []byte) ([]byte, error) {
+func (p *ListRoleDefinitionsArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *ListOrganizationsArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.ListOrganizationsRequest)
+func (p *ListRoleDefinitionsArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.RoleDefinitionQueryRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -2302,38 +6196,38 @@ func (p *ListOrganizationsArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var ListOrganizationsArgs_Req_DEFAULT *identity_srv.ListOrganizationsRequest
+var ListRoleDefinitionsArgs_Req_DEFAULT *identity_srv.RoleDefinitionQueryRequest
 
-func (p *ListOrganizationsArgs) GetReq() *identity_srv.ListOrganizationsRequest {
+func (p *ListRoleDefinitionsArgs) GetReq() *identity_srv.RoleDefinitionQueryRequest {
 	if !p.IsSetReq() {
-		return ListOrganizationsArgs_Req_DEFAULT
+		return ListRoleDefinitionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *ListOrganizationsArgs) IsSetReq() bool {
+func (p *ListRoleDefinitionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ListOrganizationsArgs) GetFirstArgument() interface{} {
+func (p *ListRoleDefinitionsArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type ListOrganizationsResult struct {
-	Success *identity_srv.ListOrganizationsResponse
+type ListRoleDefinitionsResult struct {
+	Success *identity_srv.RoleDefinitionListResponse
 }
 
-var ListOrganizationsResult_Success_DEFAULT *identity_srv.ListOrganizationsResponse
+var ListRoleDefinitionsResult_Success_DEFAULT *identity_srv.RoleDefinitionListResponse
 
-func (p *ListOrganizationsResult) Marshal(out []byte) ([]byte, error) {
+func (p *ListRoleDefinitionsResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *ListOrganizationsResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.ListOrganizationsResponse)
+func (p *ListRoleDefinitionsResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.RoleDefinitionListResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -2341,71 +6235,71 @@ func (p *ListOrganizationsResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *ListOrganizationsResult) GetSuccess() *identity_srv.ListOrganizationsResponse {
+func (p *ListRoleDefinitionsResult) GetSuccess() *identity_srv.RoleDefinitionListResponse {
 	if !p.IsSetSuccess() {
-		return ListOrganizationsResult_Success_DEFAULT
+		return ListRoleDefinitionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *ListOrganizationsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.ListOrganizationsResponse)
+func (p *ListRoleDefinitionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.RoleDefinitionListResponse)
 }
 
-func (p *ListOrganizationsResult) IsSetSuccess() bool {
+func (p *ListRoleDefinitionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ListOrganizationsResult) GetResult() interface{} {
+func (p *ListRoleDefinitionsResult) GetResult() interface{} {
 	return p.Success
 }
 
-func addMembershipHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func assignRoleToUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.AddMembershipRequest)
+		req := new(identity_srv.AssignRoleToUserRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).AddMembership(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).AssignRoleToUser(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *AddMembershipArgs:
-		success, err := handler.(identity_srv.IdentityService).AddMembership(ctx, s.Req)
+	case *AssignRoleToUserArgs:
+		success, err := handler.(identity_srv.IdentityService).AssignRoleToUser(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*AddMembershipResult)
+		realResult := result.(*AssignRoleToUserResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newAddMembershipArgs() interface{} {
-	return &AddMembershipArgs{}
+func newAssignRoleToUserArgs() interface{} {
+	return &AssignRoleToUserArgs{}
 }
 
-func newAddMembershipResult() interface{} {
-	return &AddMembershipResult{}
+func newAssignRoleToUserResult() interface{} {
+	return &AssignRoleToUserResult{}
 }
 
-type AddMembershipArgs struct {
-	Req *identity_srv.AddMembershipRequest
+type AssignRoleToUserArgs struct {
+	Req *identity_srv.AssignRoleToUserRequest
 }
 
-func (p *AddMembershipArgs) Marshal(out []byte) ([]byte, error) {
+func (p *AssignRoleToUserArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *AddMembershipArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.AddMembershipRequest)
+func (p *AssignRoleToUserArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.AssignRoleToUserRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -2413,38 +6307,38 @@ func (p *AddMembershipArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var AddMembershipArgs_Req_DEFAULT *identity_srv.AddMembershipRequest
+var AssignRoleToUserArgs_Req_DEFAULT *identity_srv.AssignRoleToUserRequest
 
-func (p *AddMembershipArgs) GetReq() *identity_srv.AddMembershipRequest {
+func (p *AssignRoleToUserArgs) GetReq() *identity_srv.AssignRoleToUserRequest {
 	if !p.IsSetReq() {
-		return AddMembershipArgs_Req_DEFAULT
+		return AssignRoleToUserArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *AddMembershipArgs) IsSetReq() bool {
+func (p *AssignRoleToUserArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AddMembershipArgs) GetFirstArgument() interface{} {
+func (p *AssignRoleToUserArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type AddMembershipResult struct {
-	Success *identity_srv.AddMembershipResponse
+type AssignRoleToUserResult struct {
+	Success *identity_srv.UserRoleAssignmentResponse
 }
 
-var AddMembershipResult_Success_DEFAULT *identity_srv.AddMembershipResponse
+var AssignRoleToUserResult_Success_DEFAULT *identity_srv.UserRoleAssignmentResponse
 
-func (p *AddMembershipResult) Marshal(out []byte) ([]byte, error) {
+func (p *AssignRoleToUserResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *AddMembershipResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.AddMembershipResponse)
+func (p *AssignRoleToUserResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UserRoleAssignmentResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -2452,71 +6346,71 @@ func (p *AddMembershipResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *AddMembershipResult) GetSuccess() *identity_srv.AddMembershipResponse {
+func (p *AssignRoleToUserResult) GetSuccess() *identity_srv.UserRoleAssignmentResponse {
 	if !p.IsSetSuccess() {
-		return AddMembershipResult_Success_DEFAULT
+		return AssignRoleToUserResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *AddMembershipResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.AddMembershipResponse)
+func (p *AssignRoleToUserResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.UserRoleAssignmentResponse)
 }
 
-func (p *AddMembershipResult) IsSetSuccess() bool {
+func (p *AssignRoleToUserResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AddMembershipResult) GetResult() interface{} {
+func (p *AssignRoleToUserResult) GetResult() interface{} {
 	return p.Success
 }
 
-func updateMembershipHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func updateUserRoleAssignmentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.UpdateMembershipRequest)
+		req := new(identity_srv.UpdateUserRoleAssignmentRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).UpdateMembership(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).UpdateUserRoleAssignment(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *UpdateMembershipArgs:
-		success, err := handler.(identity_srv.IdentityService).UpdateMembership(ctx, s.Req)
+	case *UpdateUserRoleAssignmentArgs:
+		success, err := handler.(identity_srv.IdentityService).UpdateUserRoleAssignment(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*UpdateMembershipResult)
+		realResult := result.(*UpdateUserRoleAssignmentResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newUpdateMembershipArgs() interface{} {
-	return &UpdateMembershipArgs{}
+func newUpdateUserRoleAssignmentArgs() interface{} {
+	return &UpdateUserRoleAssignmentArgs{}
 }
 
-func newUpdateMembershipResult() interface{} {
-	return &UpdateMembershipResult{}
+func newUpdateUserRoleAssignmentResult() interface{} {
+	return &UpdateUserRoleAssignmentResult{}
 }
 
-type UpdateMembershipArgs struct {
-	Req *identity_srv.UpdateMembershipRequest
+type UpdateUserRoleAssignmentArgs struct {
+	Req *identity_srv.UpdateUserRoleAssignmentRequest
 }
 
-func (p *UpdateMembershipArgs) Marshal(out []byte) ([]byte, error) {
+func (p *UpdateUserRoleAssignmentArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *UpdateMembershipArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.UpdateMembershipRequest)
+func (p *UpdateUserRoleAssignmentArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UpdateUserRoleAssignmentRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -2524,38 +6418,38 @@ func (p *UpdateMembershipArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var UpdateMembershipArgs_Req_DEFAULT *identity_srv.UpdateMembershipRequest
+var UpdateUserRoleAssignmentArgs_Req_DEFAULT *identity_srv.UpdateUserRoleAssignmentRequest
 
-func (p *UpdateMembershipArgs) GetReq() *identity_srv.UpdateMembershipRequest {
+func (p *UpdateUserRoleAssignmentArgs) GetReq() *identity_srv.UpdateUserRoleAssignmentRequest {
 	if !p.IsSetReq() {
-		return UpdateMembershipArgs_Req_DEFAULT
+		return UpdateUserRoleAssignmentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *UpdateMembershipArgs) IsSetReq() bool {
+func (p *UpdateUserRoleAssignmentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UpdateMembershipArgs) GetFirstArgument() interface{} {
+func (p *UpdateUserRoleAssignmentArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type UpdateMembershipResult struct {
-	Success *identity_srv.UpdateMembershipResponse
+type UpdateUserRoleAssignmentResult struct {
+	Success *identity_srv.UpdateUserRoleAssignmentResponse
 }
 
-var UpdateMembershipResult_Success_DEFAULT *identity_srv.UpdateMembershipResponse
+var UpdateUserRoleAssignmentResult_Success_DEFAULT *identity_srv.UpdateUserRoleAssignmentResponse
 
-func (p *UpdateMembershipResult) Marshal(out []byte) ([]byte, error) {
+func (p *UpdateUserRoleAssignmentResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *UpdateMembershipResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.UpdateMembershipResponse)
+func (p *UpdateUserRoleAssignmentResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UpdateUserRoleAssignmentResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -2563,71 +6457,71 @@ func (p *UpdateMembershipResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *UpdateMembershipResult) GetSuccess() *identity_srv.UpdateMembershipResponse {
+func (p *UpdateUserRoleAssignmentResult) GetSuccess() *identity_srv.UpdateUserRoleAssignmentResponse {
 	if !p.IsSetSuccess() {
-		return UpdateMembershipResult_Success_DEFAULT
+		return UpdateUserRoleAssignmentResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *UpdateMembershipResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.UpdateMembershipResponse)
+func (p *UpdateUserRoleAssignmentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.UpdateUserRoleAssignmentResponse)
 }
 
-func (p *UpdateMembershipResult) IsSetSuccess() bool {
+func (p *UpdateUserRoleAssignmentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UpdateMembershipResult) GetResult() interface{} {
+func (p *UpdateUserRoleAssignmentResult) GetResult() interface{} {
 	return p.Success
 }
 
-func removeMembershipHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func revokeRoleFromUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.RemoveMembershipRequest)
+		req := new(identity_srv.RevokeRoleFromUserRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).RemoveMembership(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).RevokeRoleFromUser(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *RemoveMembershipArgs:
-		success, err := handler.(identity_srv.IdentityService).RemoveMembership(ctx, s.Req)
+	case *RevokeRoleFromUserArgs:
+		success, err := handler.(identity_srv.IdentityService).RevokeRoleFromUser(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*RemoveMembershipResult)
+		realResult := result.(*RevokeRoleFromUserResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newRemoveMembershipArgs() interface{} {
-	return &RemoveMembershipArgs{}
+func newRevokeRoleFromUserArgs() interface{} {
+	return &RevokeRoleFromUserArgs{}
 }
 
-func newRemoveMembershipResult() interface{} {
-	return &RemoveMembershipResult{}
+func newRevokeRoleFromUserResult() interface{} {
+	return &RevokeRoleFromUserResult{}
 }
 
-type RemoveMembershipArgs struct {
-	Req *identity_srv.RemoveMembershipRequest
+type RevokeRoleFromUserArgs struct {
+	Req *identity_srv.RevokeRoleFromUserRequest
 }
 
-func (p *RemoveMembershipArgs) Marshal(out []byte) ([]byte, error) {
+func (p *RevokeRoleFromUserArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *RemoveMembershipArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.RemoveMembershipRequest)
+func (p *RevokeRoleFromUserArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.RevokeRoleFromUserRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -2635,38 +6529,38 @@ func (p *RemoveMembershipArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var RemoveMembershipArgs_Req_DEFAULT *identity_srv.RemoveMembershipRequest
+var RevokeRoleFromUserArgs_Req_DEFAULT *identity_srv.RevokeRoleFromUserRequest
 
-func (p *RemoveMembershipArgs) GetReq() *identity_srv.RemoveMembershipRequest {
+func (p *RevokeRoleFromUserArgs) GetReq() *identity_srv.RevokeRoleFromUserRequest {
 	if !p.IsSetReq() {
-		return RemoveMembershipArgs_Req_DEFAULT
+		return RevokeRoleFromUserArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *RemoveMembershipArgs) IsSetReq() bool {
+func (p *RevokeRoleFromUserArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RemoveMembershipArgs) GetFirstArgument() interface{} {
+func (p *RevokeRoleFromUserArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type RemoveMembershipResult struct {
-	Success *identity_srv.RemoveMembershipResponse
+type RevokeRoleFromUserResult struct {
+	Success *identity_srv.RevokeRoleFromUserResponse
 }
 
-var RemoveMembershipResult_Success_DEFAULT *identity_srv.RemoveMembershipResponse
+var RevokeRoleFromUserResult_Success_DEFAULT *identity_srv.RevokeRoleFromUserResponse
 
-func (p *RemoveMembershipResult) Marshal(out []byte) ([]byte, error) {
+func (p *RevokeRoleFromUserResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *RemoveMembershipResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.RemoveMembershipResponse)
+func (p *RevokeRoleFromUserResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.RevokeRoleFromUserResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -2674,71 +6568,71 @@ func (p *RemoveMembershipResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *RemoveMembershipResult) GetSuccess() *identity_srv.RemoveMembershipResponse {
+func (p *RevokeRoleFromUserResult) GetSuccess() *identity_srv.RevokeRoleFromUserResponse {
 	if !p.IsSetSuccess() {
-		return RemoveMembershipResult_Success_DEFAULT
+		return RevokeRoleFromUserResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *RemoveMembershipResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.RemoveMembershipResponse)
+func (p *RevokeRoleFromUserResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.RevokeRoleFromUserResponse)
 }
 
-func (p *RemoveMembershipResult) IsSetSuccess() bool {
+func (p *RevokeRoleFromUserResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RemoveMembershipResult) GetResult() interface{} {
+func (p *RevokeRoleFromUserResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getMembershipHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func getLastUserRoleAssignmentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.GetMembershipRequest)
+		req := new(identity_srv.GetLastUserRoleAssignmentRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).GetMembership(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).GetLastUserRoleAssignment(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *GetMembershipArgs:
-		success, err := handler.(identity_srv.IdentityService).GetMembership(ctx, s.Req)
+	case *GetLastUserRoleAssignmentArgs:
+		success, err := handler.(identity_srv.IdentityService).GetLastUserRoleAssignment(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetMembershipResult)
+		realResult := result.(*GetLastUserRoleAssignmentResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetMembershipArgs() interface{} {
-	return &GetMembershipArgs{}
+func newGetLastUserRoleAssignmentArgs() interface{} {
+	return &GetLastUserRoleAssignmentArgs{}
 }
 
-func newGetMembershipResult() interface{} {
-	return &GetMembershipResult{}
+func newGetLastUserRoleAssignmentResult() interface{} {
+	return &GetLastUserRoleAssignmentResult{}
 }
 
-type GetMembershipArgs struct {
-	Req *identity_srv.GetMembershipRequest
+type GetLastUserRoleAssignmentArgs struct {
+	Req *identity_srv.GetLastUserRoleAssignmentRequest
 }
 
-func (p *GetMembershipArgs) Marshal(out []byte) ([]byte, error) {
+func (p *GetLastUserRoleAssignmentArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetMembershipArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetMembershipRequest)
+func (p *GetLastUserRoleAssignmentArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetLastUserRoleAssignmentRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -2746,38 +6640,38 @@ func (p *GetMembershipArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetMembershipArgs_Req_DEFAULT *identity_srv.GetMembershipRequest
+var GetLastUserRoleAssignmentArgs_Req_DEFAULT *identity_srv.GetLastUserRoleAssignmentRequest
 
-func (p *GetMembershipArgs) GetReq() *identity_srv.GetMembershipRequest {
+func (p *GetLastUserRoleAssignmentArgs) GetReq() *identity_srv.GetLastUserRoleAssignmentRequest {
 	if !p.IsSetReq() {
-		return GetMembershipArgs_Req_DEFAULT
+		return GetLastUserRoleAssignmentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetMembershipArgs) IsSetReq() bool {
+func (p *GetLastUserRoleAssignmentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetMembershipArgs) GetFirstArgument() interface{} {
+func (p *GetLastUserRoleAssignmentArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetMembershipResult struct {
-	Success *identity_srv.GetMembershipResponse
+type GetLastUserRoleAssignmentResult struct {
+	Success *identity_srv.GetLastUserRoleAssignmentResponse
 }
 
-var GetMembershipResult_Success_DEFAULT *identity_srv.GetMembershipResponse
+var GetLastUserRoleAssignmentResult_Success_DEFAULT *identity_srv.GetLastUserRoleAssignmentResponse
 
-func (p *GetMembershipResult) Marshal(out []byte) ([]byte, error) {
+func (p *GetLastUserRoleAssignmentResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetMembershipResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetMembershipResponse)
+func (p *GetLastUserRoleAssignmentResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetLastUserRoleAssignmentResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -2785,71 +6679,71 @@ func (p *GetMembershipResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetMembershipResult) GetSuccess() *identity_srv.GetMembershipResponse {
+func (p *GetLastUserRoleAssignmentResult) GetSuccess() *identity_srv.GetLastUserRoleAssignmentResponse {
 	if !p.IsSetSuccess() {
-		return GetMembershipResult_Success_DEFAULT
+		return GetLastUserRoleAssignmentResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetMembershipResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.GetMembershipResponse)
+func (p *GetLastUserRoleAssignmentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetLastUserRoleAssignmentResponse)
 }
 
-func (p *GetMembershipResult) IsSetSuccess() bool {
+func (p *GetLastUserRoleAssignmentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetMembershipResult) GetResult() interface{} {
+func (p *GetLastUserRoleAssignmentResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getUserMembershipsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func listUserRoleAssignmentsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.GetUserMembershipsRequest)
+		req := new(identity_srv.UserRoleQueryRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).GetUserMemberships(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).ListUserRoleAssignments(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *GetUserMembershipsArgs:
-		success, err := handler.(identity_srv.IdentityService).GetUserMemberships(ctx, s.Req)
+	case *ListUserRoleAssignmentsArgs:
+		success, err := handler.(identity_srv.IdentityService).ListUserRoleAssignments(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetUserMembershipsResult)
+		realResult := result.(*ListUserRoleAssignmentsResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetUserMembershipsArgs() interface{} {
-	return &GetUserMembershipsArgs{}
+func newListUserRoleAssignmentsArgs() interface{} {
+	return &ListUserRoleAssignmentsArgs{}
 }
 
-func newGetUserMembershipsResult() interface{} {
-	return &GetUserMembershipsResult{}
+func newListUserRoleAssignmentsResult() interface{} {
+	return &ListUserRoleAssignmentsResult{}
 }
 
-type GetUserMembershipsArgs struct {
-	Req *identity_srv.GetUserMembershipsRequest
+type ListUserRoleAssignmentsArgs struct {
+	Req *identity_srv.UserRoleQueryRequest
 }
 
-func (p *GetUserMembershipsArgs) Marshal(out []byte) ([]byte, error) {
+func (p *ListUserRoleAssignmentsArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetUserMembershipsArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetUserMembershipsRequest)
+func (p *ListUserRoleAssignmentsArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UserRoleQueryRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -2857,38 +6751,38 @@ func (p *GetUserMembershipsArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetUserMembershipsArgs_Req_DEFAULT *identity_srv.GetUserMembershipsRequest
+var ListUserRoleAssignmentsArgs_Req_DEFAULT *identity_srv.UserRoleQueryRequest
 
-func (p *GetUserMembershipsArgs) GetReq() *identity_srv.GetUserMembershipsRequest {
+func (p *ListUserRoleAssignmentsArgs) GetReq() *identity_srv.UserRoleQueryRequest {
 	if !p.IsSetReq() {
-		return GetUserMembershipsArgs_Req_DEFAULT
+		return ListUserRoleAssignmentsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetUserMembershipsArgs) IsSetReq() bool {
+func (p *ListUserRoleAssignmentsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetUserMembershipsArgs) GetFirstArgument() interface{} {
+func (p *ListUserRoleAssignmentsArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetUserMembershipsResult struct {
-	Success *identity_srv.GetUserMembershipsResponse
+type ListUserRoleAssignmentsResult struct {
+	Success *identity_srv.UserRoleListResponse
 }
 
-var GetUserMembershipsResult_Success_DEFAULT *identity_srv.GetUserMembershipsResponse
+var ListUserRoleAssignmentsResult_Success_DEFAULT *identity_srv.UserRoleListResponse
 
-func (p *GetUserMembershipsResult) Marshal(out []byte) ([]byte, error) {
+func (p *ListUserRoleAssignmentsResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetUserMembershipsResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetUserMembershipsResponse)
+func (p *ListUserRoleAssignmentsResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UserRoleListResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -2896,71 +6790,71 @@ func (p *GetUserMembershipsResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetUserMembershipsResult) GetSuccess() *identity_srv.GetUserMembershipsResponse {
+func (p *ListUserRoleAssignmentsResult) GetSuccess() *identity_srv.UserRoleListResponse {
 	if !p.IsSetSuccess() {
-		return GetUserMembershipsResult_Success_DEFAULT
+		return ListUserRoleAssignmentsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetUserMembershipsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.GetUserMembershipsResponse)
+func (p *ListUserRoleAssignmentsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.UserRoleListResponse)
 }
 
-func (p *GetUserMembershipsResult) IsSetSuccess() bool {
+func (p *ListUserRoleAssignmentsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetUserMembershipsResult) GetResult() interface{} {
+func (p *ListUserRoleAssignmentsResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getPrimaryMembershipHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func getUsersByRoleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.GetPrimaryMembershipRequest)
+		req := new(identity_srv.GetUsersByRoleRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).GetPrimaryMembership(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).GetUsersByRole(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *GetPrimaryMembershipArgs:
-		success, err := handler.(identity_srv.IdentityService).GetPrimaryMembership(ctx, s.Req)
+	case *GetUsersByRoleArgs:
+		success, err := handler.(identity_srv.IdentityService).GetUsersByRole(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetPrimaryMembershipResult)
+		realResult := result.(*GetUsersByRoleResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetPrimaryMembershipArgs() interface{} {
-	return &GetPrimaryMembershipArgs{}
+func newGetUsersByRoleArgs() interface{} {
+	return &GetUsersByRoleArgs{}
 }
 
-func newGetPrimaryMembershipResult() interface{} {
-	return &GetPrimaryMembershipResult{}
+func newGetUsersByRoleResult() interface{} {
+	return &GetUsersByRoleResult{}
 }
 
-type GetPrimaryMembershipArgs struct {
-	Req *identity_srv.GetPrimaryMembershipRequest
+type GetUsersByRoleArgs struct {
+	Req *identity_srv.GetUsersByRoleRequest
 }
 
-func (p *GetPrimaryMembershipArgs) Marshal(out []byte) ([]byte, error) {
+func (p *GetUsersByRoleArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetPrimaryMembershipArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetPrimaryMembershipRequest)
+func (p *GetUsersByRoleArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetUsersByRoleRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -2968,38 +6862,38 @@ func (p *GetPrimaryMembershipArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetPrimaryMembershipArgs_Req_DEFAULT *identity_srv.GetPrimaryMembershipRequest
+var GetUsersByRoleArgs_Req_DEFAULT *identity_srv.GetUsersByRoleRequest
 
-func (p *GetPrimaryMembershipArgs) GetReq() *identity_srv.GetPrimaryMembershipRequest {
+func (p *GetUsersByRoleArgs) GetReq() *identity_srv.GetUsersByRoleRequest {
 	if !p.IsSetReq() {
-		return GetPrimaryMembershipArgs_Req_DEFAULT
+		return GetUsersByRoleArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetPrimaryMembershipArgs) IsSetReq() bool {
+func (p *GetUsersByRoleArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetPrimaryMembershipArgs) GetFirstArgument() interface{} {
+func (p *GetUsersByRoleArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetPrimaryMembershipResult struct {
-	Success *identity_srv.GetPrimaryMembershipResponse
+type GetUsersByRoleResult struct {
+	Success *identity_srv.GetUsersByRoleResponse
 }
 
-var GetPrimaryMembershipResult_Success_DEFAULT *identity_srv.GetPrimaryMembershipResponse
+var GetUsersByRoleResult_Success_DEFAULT *identity_srv.GetUsersByRoleResponse
 
-func (p *GetPrimaryMembershipResult) Marshal(out []byte) ([]byte, error) {
+func (p *GetUsersByRoleResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetPrimaryMembershipResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetPrimaryMembershipResponse)
+func (p *GetUsersByRoleResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetUsersByRoleResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -3007,71 +6901,71 @@ func (p *GetPrimaryMembershipResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetPrimaryMembershipResult) GetSuccess() *identity_srv.GetPrimaryMembershipResponse {
+func (p *GetUsersByRoleResult) GetSuccess() *identity_srv.GetUsersByRoleResponse {
 	if !p.IsSetSuccess() {
-		return GetPrimaryMembershipResult_Success_DEFAULT
+		return GetUsersByRoleResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetPrimaryMembershipResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.GetPrimaryMembershipResponse)
+func (p *GetUsersByRoleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetUsersByRoleResponse)
 }
 
-func (p *GetPrimaryMembershipResult) IsSetSuccess() bool {
+func (p *GetUsersByRoleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetPrimaryMembershipResult) GetResult() interface{} {
+func (p *GetUsersByRoleResult) GetResult() interface{} {
 	return p.Success
 }
 
-func checkMembershipHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func batchBindUsersToRoleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.CheckMembershipRequest)
+		req := new(identity_srv.BatchBindUsersToRoleRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).CheckMembership(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).BatchBindUsersToRole(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *CheckMembershipArgs:
-		success, err := handler.(identity_srv.IdentityService).CheckMembership(ctx, s.Req)
+	case *BatchBindUsersToRoleArgs:
+		success, err := handler.(identity_srv.IdentityService).BatchBindUsersToRole(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*CheckMembershipResult)
+		realResult := result.(*BatchBindUsersToRoleResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newCheckMembershipArgs() interface{} {
-	return &CheckMembershipArgs{}
+func newBatchBindUsersToRoleArgs() interface{} {
+	return &BatchBindUsersToRoleArgs{}
 }
 
-func newCheckMembershipResult() interface{} {
-	return &CheckMembershipResult{}
+func newBatchBindUsersToRoleResult() interface{} {
+	return &BatchBindUsersToRoleResult{}
 }
 
-type CheckMembershipArgs struct {
-	Req *identity_srv.CheckMembershipRequest
+type BatchBindUsersToRoleArgs struct {
+	Req *identity_srv.BatchBindUsersToRoleRequest
 }
 
-func (p *CheckMembershipArgs) Marshal(out []byte) ([]byte, error) {
+func (p *BatchBindUsersToRoleArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *CheckMembershipArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.CheckMembershipRequest)
+func (p *BatchBindUsersToRoleArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.BatchBindUsersToRoleRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -3079,38 +6973,38 @@ func (p *CheckMembershipArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var CheckMembershipArgs_Req_DEFAULT *identity_srv.CheckMembershipRequest
+var BatchBindUsersToRoleArgs_Req_DEFAULT *identity_srv.BatchBindUsersToRoleRequest
 
-func (p *CheckMembershipArgs) GetReq() *identity_srv.CheckMembershipRequest {
+func (p *BatchBindUsersToRoleArgs) GetReq() *identity_srv.BatchBindUsersToRoleRequest {
 	if !p.IsSetReq() {
-		return CheckMembershipArgs_Req_DEFAULT
+		return BatchBindUsersToRoleArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *CheckMembershipArgs) IsSetReq() bool {
+func (p *BatchBindUsersToRoleArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *CheckMembershipArgs) GetFirstArgument() interface{} {
+func (p *BatchBindUsersToRoleArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type CheckMembershipResult struct {
-	Success *identity_srv.CheckMembershipResponse
+type BatchBindUsersToRoleResult struct {
+	Success *identity_srv.BatchBindUsersToRoleResponse
 }
 
-var CheckMembershipResult_Success_DEFAULT *identity_srv.CheckMembershipResponse
+var BatchBindUsersToRoleResult_Success_DEFAULT *identity_srv.BatchBindUsersToRoleResponse
 
-func (p *CheckMembershipResult) Marshal(out []byte) ([]byte, error) {
+func (p *BatchBindUsersToRoleResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *CheckMembershipResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.CheckMembershipResponse)
+func (p *BatchBindUsersToRoleResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.BatchBindUsersToRoleResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -3118,71 +7012,71 @@ func (p *CheckMembershipResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *CheckMembershipResult) GetSuccess() *identity_srv.CheckMembershipResponse {
+func (p *BatchBindUsersToRoleResult) GetSuccess() *identity_srv.BatchBindUsersToRoleResponse {
 	if !p.IsSetSuccess() {
-		return CheckMembershipResult_Success_DEFAULT
+		return BatchBindUsersToRoleResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *CheckMembershipResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.CheckMembershipResponse)
+func (p *BatchBindUsersToRoleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.BatchBindUsersToRoleResponse)
 }
 
-func (p *CheckMembershipResult) IsSetSuccess() bool {
+func (p *BatchBindUsersToRoleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CheckMembershipResult) GetResult() interface{} {
+func (p *BatchBindUsersToRoleResult) GetResult() interface{} {
 	return p.Success
 }
 
-func createDepartmentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func batchGetUserRolesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.CreateDepartmentRequest)
+		req := new(identity_srv.BatchGetUserRolesRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).CreateDepartment(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).BatchGetUserRoles(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *CreateDepartmentArgs:
-		success, err := handler.(identity_srv.IdentityService).CreateDepartment(ctx, s.Req)
+	case *BatchGetUserRolesArgs:
+		success, err := handler.(identity_srv.IdentityService).BatchGetUserRoles(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*CreateDepartmentResult)
+		realResult := result.(*BatchGetUserRolesResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newCreateDepartmentArgs() interface{} {
-	return &CreateDepartmentArgs{}
+func newBatchGetUserRolesArgs() interface{} {
+	return &BatchGetUserRolesArgs{}
 }
 
-func newCreateDepartmentResult() interface{} {
-	return &CreateDepartmentResult{}
+func newBatchGetUserRolesResult() interface{} {
+	return &BatchGetUserRolesResult{}
 }
 
-type CreateDepartmentArgs struct {
-	Req *identity_srv.CreateDepartmentRequest
+type BatchGetUserRolesArgs struct {
+	Req *identity_srv.BatchGetUserRolesRequest
 }
 
-func (p *CreateDepartmentArgs) Marshal(out []byte) ([]byte, error) {
+func (p *BatchGetUserRolesArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *CreateDepartmentArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.CreateDepartmentRequest)
+func (p *BatchGetUserRolesArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.BatchGetUserRolesRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -3190,38 +7084,38 @@ func (p *CreateDepartmentArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var CreateDepartmentArgs_Req_DEFAULT *identity_srv.CreateDepartmentRequest
+var BatchGetUserRolesArgs_Req_DEFAULT *identity_srv.BatchGetUserRolesRequest
 
-func (p *CreateDepartmentArgs) GetReq() *identity_srv.CreateDepartmentRequest {
+func (p *BatchGetUserRolesArgs) GetReq() *identity_srv.BatchGetUserRolesRequest {
 	if !p.IsSetReq() {
-		return CreateDepartmentArgs_Req_DEFAULT
+		return BatchGetUserRolesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *CreateDepartmentArgs) IsSetReq() bool {
+func (p *BatchGetUserRolesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *CreateDepartmentArgs) GetFirstArgument() interface{} {
+func (p *BatchGetUserRolesArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type CreateDepartmentResult struct {
-	Success *identity_srv.CreateDepartmentResponse
+type BatchGetUserRolesResult struct {
+	Success *identity_srv.BatchGetUserRolesResponse
 }
 
-var CreateDepartmentResult_Success_DEFAULT *identity_srv.CreateDepartmentResponse
+var BatchGetUserRolesResult_Success_DEFAULT *identity_srv.BatchGetUserRolesResponse
 
-func (p *CreateDepartmentResult) Marshal(out []byte) ([]byte, error) {
+func (p *BatchGetUserRolesResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *CreateDepartmentResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.CreateDepartmentResponse)
+func (p *BatchGetUserRolesResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.BatchGetUserRolesResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -3229,71 +7123,71 @@ func (p *CreateDepartmentResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *CreateDepartmentResult) GetSuccess() *identity_srv.CreateDepartmentResponse {
+func (p *BatchGetUserRolesResult) GetSuccess() *identity_srv.BatchGetUserRolesResponse {
 	if !p.IsSetSuccess() {
-		return CreateDepartmentResult_Success_DEFAULT
+		return BatchGetUserRolesResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *CreateDepartmentResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.CreateDepartmentResponse)
+func (p *BatchGetUserRolesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.BatchGetUserRolesResponse)
 }
 
-func (p *CreateDepartmentResult) IsSetSuccess() bool {
+func (p *BatchGetUserRolesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CreateDepartmentResult) GetResult() interface{} {
+func (p *BatchGetUserRolesResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getDepartmentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func getUserTenantRolesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.GetDepartmentRequest)
+		req := new(identity_srv.GetUserTenantRolesRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).GetDepartment(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).GetUserTenantRoles(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *GetDepartmentArgs:
-		success, err := handler.(identity_srv.IdentityService).GetDepartment(ctx, s.Req)
+	case *GetUserTenantRolesArgs:
+		success, err := handler.(identity_srv.IdentityService).GetUserTenantRoles(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetDepartmentResult)
+		realResult := result.(*GetUserTenantRolesResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetDepartmentArgs() interface{} {
-	return &GetDepartmentArgs{}
+func newGetUserTenantRolesArgs() interface{} {
+	return &GetUserTenantRolesArgs{}
 }
 
-func newGetDepartmentResult() interface{} {
-	return &GetDepartmentResult{}
+func newGetUserTenantRolesResult() interface{} {
+	return &GetUserTenantRolesResult{}
 }
 
-type GetDepartmentArgs struct {
-	Req *identity_srv.GetDepartmentRequest
+type GetUserTenantRolesArgs struct {
+	Req *identity_srv.GetUserTenantRolesRequest
 }
 
-func (p *GetDepartmentArgs) Marshal(out []byte) ([]byte, error) {
+func (p *GetUserTenantRolesArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetDepartmentArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetDepartmentRequest)
+func (p *GetUserTenantRolesArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetUserTenantRolesRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -3301,38 +7195,38 @@ func (p *GetDepartmentArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetDepartmentArgs_Req_DEFAULT *identity_srv.GetDepartmentRequest
+var GetUserTenantRolesArgs_Req_DEFAULT *identity_srv.GetUserTenantRolesRequest
 
-func (p *GetDepartmentArgs) GetReq() *identity_srv.GetDepartmentRequest {
+func (p *GetUserTenantRolesArgs) GetReq() *identity_srv.GetUserTenantRolesRequest {
 	if !p.IsSetReq() {
-		return GetDepartmentArgs_Req_DEFAULT
+		return GetUserTenantRolesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetDepartmentArgs) IsSetReq() bool {
+func (p *GetUserTenantRolesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetDepartmentArgs) GetFirstArgument() interface{} {
+func (p *GetUserTenantRolesArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetDepartmentResult struct {
-	Success *identity_srv.GetDepartmentResponse
+type GetUserTenantRolesResult struct {
+	Success *identity_srv.GetUserTenantRolesResponse
 }
 
-var GetDepartmentResult_Success_DEFAULT *identity_srv.GetDepartmentResponse
+var GetUserTenantRolesResult_Success_DEFAULT *identity_srv.GetUserTenantRolesResponse
 
-func (p *GetDepartmentResult) Marshal(out []byte) ([]byte, error) {
+func (p *GetUserTenantRolesResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetDepartmentResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetDepartmentResponse)
+func (p *GetUserTenantRolesResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetUserTenantRolesResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -3340,71 +7234,71 @@ func (p *GetDepartmentResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetDepartmentResult) GetSuccess() *identity_srv.GetDepartmentResponse {
+func (p *GetUserTenantRolesResult) GetSuccess() *identity_srv.GetUserTenantRolesResponse {
 	if !p.IsSetSuccess() {
-		return GetDepartmentResult_Success_DEFAULT
+		return GetUserTenantRolesResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetDepartmentResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.GetDepartmentResponse)
+func (p *GetUserTenantRolesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetUserTenantRolesResponse)
 }
 
-func (p *GetDepartmentResult) IsSetSuccess() bool {
+func (p *GetUserTenantRolesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetDepartmentResult) GetResult() interface{} {
+func (p *GetUserTenantRolesResult) GetResult() interface{} {
 	return p.Success
 }
 
-func updateDepartmentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func listRoleConstraintViolationsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.UpdateDepartmentRequest)
+		req := new(identity_srv.ListRoleConstraintViolationsRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).UpdateDepartment(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).ListRoleConstraintViolations(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *UpdateDepartmentArgs:
-		success, err := handler.(identity_srv.IdentityService).UpdateDepartment(ctx, s.Req)
+	case *ListRoleConstraintViolationsArgs:
+		success, err := handler.(identity_srv.IdentityService).ListRoleConstraintViolations(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*UpdateDepartmentResult)
+		realResult := result.(*ListRoleConstraintViolationsResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newUpdateDepartmentArgs() interface{} {
-	return &UpdateDepartmentArgs{}
+func newListRoleConstraintViolationsArgs() interface{} {
+	return &ListRoleConstraintViolationsArgs{}
 }
 
-func newUpdateDepartmentResult() interface{} {
-	return &UpdateDepartmentResult{}
+func newListRoleConstraintViolationsResult() interface{} {
+	return &ListRoleConstraintViolationsResult{}
 }
 
-type UpdateDepartmentArgs struct {
-	Req *identity_srv.UpdateDepartmentRequest
+type ListRoleConstraintViolationsArgs struct {
+	Req *identity_srv.ListRoleConstraintViolationsRequest
 }
 
-func (p *UpdateDepartmentArgs) Marshal(out []byte) ([]byte, error) {
+func (p *ListRoleConstraintViolationsArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *UpdateDepartmentArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.UpdateDepartmentRequest)
+func (p *ListRoleConstraintViolationsArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ListRoleConstraintViolationsRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -3412,38 +7306,38 @@ func (p *UpdateDepartmentArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var UpdateDepartmentArgs_Req_DEFAULT *identity_srv.UpdateDepartmentRequest
+var ListRoleConstraintViolationsArgs_Req_DEFAULT *identity_srv.ListRoleConstraintViolationsRequest
 
-func (p *UpdateDepartmentArgs) GetReq() *identity_srv.UpdateDepartmentRequest {
+func (p *ListRoleConstraintViolationsArgs) GetReq() *identity_srv.ListRoleConstraintViolationsRequest {
 	if !p.IsSetReq() {
-		return UpdateDepartmentArgs_Req_DEFAULT
+		return ListRoleConstraintViolationsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *UpdateDepartmentArgs) IsSetReq() bool {
+func (p *ListRoleConstraintViolationsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UpdateDepartmentArgs) GetFirstArgument() interface{} {
+func (p *ListRoleConstraintViolationsArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type UpdateDepartmentResult struct {
-	Success *identity_srv.UpdateDepartmentResponse
+type ListRoleConstraintViolationsResult struct {
+	Success *identity_srv.ListRoleConstraintViolationsResponse
 }
 
-var UpdateDepartmentResult_Success_DEFAULT *identity_srv.UpdateDepartmentResponse
+var ListRoleConstraintViolationsResult_Success_DEFAULT *identity_srv.ListRoleConstraintViolationsResponse
 
-func (p *UpdateDepartmentResult) Marshal(out []byte) ([]byte, error) {
+func (p *ListRoleConstraintViolationsResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *UpdateDepartmentResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.UpdateDepartmentResponse)
+func (p *ListRoleConstraintViolationsResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ListRoleConstraintViolationsResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -3451,71 +7345,71 @@ func (p *UpdateDepartmentResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *UpdateDepartmentResult) GetSuccess() *identity_srv.UpdateDepartmentResponse {
+func (p *ListRoleConstraintViolationsResult) GetSuccess() *identity_srv.ListRoleConstraintViolationsResponse {
 	if !p.IsSetSuccess() {
-		return UpdateDepartmentResult_Success_DEFAULT
+		return ListRoleConstraintViolationsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *UpdateDepartmentResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.UpdateDepartmentResponse)
+func (p *ListRoleConstraintViolationsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.ListRoleConstraintViolationsResponse)
 }
 
-func (p *UpdateDepartmentResult) IsSetSuccess() bool {
+func (p *ListRoleConstraintViolationsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UpdateDepartmentResult) GetResult() interface{} {
+func (p *ListRoleConstraintViolationsResult) GetResult() interface{} {
 	return p.Success
 }
 
-func deleteDepartmentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func listRoleGrantRequestsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.DeleteDepartmentRequest)
+		req := new(identity_srv.ListRoleGrantRequestsRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).DeleteDepartment(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).ListRoleGrantRequests(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *DeleteDepartmentArgs:
-		success, err := handler.(identity_srv.IdentityService).DeleteDepartment(ctx, s.Req)
+	case *ListRoleGrantRequestsArgs:
+		success, err := handler.(identity_srv.IdentityService).ListRoleGrantRequests(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*DeleteDepartmentResult)
+		realResult := result.(*ListRoleGrantRequestsResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newDeleteDepartmentArgs() interface{} {
-	return &DeleteDepartmentArgs{}
+func newListRoleGrantRequestsArgs() interface{} {
+	return &ListRoleGrantRequestsArgs{}
 }
 
-func newDeleteDepartmentResult() interface{} {
-	return &DeleteDepartmentResult{}
+func newListRoleGrantRequestsResult() interface{} {
+	return &ListRoleGrantRequestsResult{}
 }
 
-type DeleteDepartmentArgs struct {
-	Req *identity_srv.DeleteDepartmentRequest
+type ListRoleGrantRequestsArgs struct {
+	Req *identity_srv.ListRoleGrantRequestsRequest
 }
 
-func (p *DeleteDepartmentArgs) Marshal(out []byte) ([]byte, error) {
+func (p *ListRoleGrantRequestsArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *DeleteDepartmentArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.DeleteDepartmentRequest)
+func (p *ListRoleGrantRequestsArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ListRoleGrantRequestsRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -3523,38 +7417,38 @@ func (p *DeleteDepartmentArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var DeleteDepartmentArgs_Req_DEFAULT *identity_srv.DeleteDepartmentRequest
+var ListRoleGrantRequestsArgs_Req_DEFAULT *identity_srv.ListRoleGrantRequestsRequest
 
-func (p *DeleteDepartmentArgs) GetReq() *identity_srv.DeleteDepartmentRequest {
+func (p *ListRoleGrantRequestsArgs) GetReq() *identity_srv.ListRoleGrantRequestsRequest {
 	if !p.IsSetReq() {
-		return DeleteDepartmentArgs_Req_DEFAULT
+		return ListRoleGrantRequestsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *DeleteDepartmentArgs) IsSetReq() bool {
+func (p *ListRoleGrantRequestsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DeleteDepartmentArgs) GetFirstArgument() interface{} {
+func (p *ListRoleGrantRequestsArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type DeleteDepartmentResult struct {
-	Success *identity_srv.DeleteDepartmentResponse
+type ListRoleGrantRequestsResult struct {
+	Success *identity_srv.ListRoleGrantRequestsResponse
 }
 
-var DeleteDepartmentResult_Success_DEFAULT *identity_srv.DeleteDepartmentResponse
+var ListRoleGrantRequestsResult_Success_DEFAULT *identity_srv.ListRoleGrantRequestsResponse
 
-func (p *DeleteDepartmentResult) Marshal(out []byte) ([]byte, error) {
+func (p *ListRoleGrantRequestsResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *DeleteDepartmentResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.DeleteDepartmentResponse)
+func (p *ListRoleGrantRequestsResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ListRoleGrantRequestsResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -3562,71 +7456,71 @@ func (p *DeleteDepartmentResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *DeleteDepartmentResult) GetSuccess() *identity_srv.DeleteDepartmentResponse {
+func (p *ListRoleGrantRequestsResult) GetSuccess() *identity_srv.ListRoleGrantRequestsResponse {
 	if !p.IsSetSuccess() {
-		return DeleteDepartmentResult_Success_DEFAULT
+		return ListRoleGrantRequestsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *DeleteDepartmentResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.DeleteDepartmentResponse)
+func (p *ListRoleGrantRequestsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.ListRoleGrantRequestsResponse)
 }
 
-func (p *DeleteDepartmentResult) IsSetSuccess() bool {
+func (p *ListRoleGrantRequestsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DeleteDepartmentResult) GetResult() interface{} {
+func (p *ListRoleGrantRequestsResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getOrganizationDepartmentsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func getRoleGrantRequestHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.GetOrganizationDepartmentsRequest)
+		req := new(identity_srv.GetRoleGrantRequestRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).GetOrganizationDepartments(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).GetRoleGrantRequest(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *GetOrganizationDepartmentsArgs:
-		success, err := handler.(identity_srv.IdentityService).GetOrganizationDepartments(ctx, s.Req)
+	case *GetRoleGrantRequestArgs:
+		success, err := handler.(identity_srv.IdentityService).GetRoleGrantRequest(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetOrganizationDepartmentsResult)
+		realResult := result.(*GetRoleGrantRequestResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetOrganizationDepartmentsArgs() interface{} {
-	return &GetOrganizationDepartmentsArgs{}
+func newGetRoleGrantRequestArgs() interface{} {
+	return &GetRoleGrantRequestArgs{}
 }
 
-func newGetOrganizationDepartmentsResult() interface{} {
-	return &GetOrganizationDepartmentsResult{}
+func newGetRoleGrantRequestResult() interface{} {
+	return &GetRoleGrantRequestResult{}
 }
 
-type GetOrganizationDepartmentsArgs struct {
-	Req *identity_srv.GetOrganizationDepartmentsRequest
+type GetRoleGrantRequestArgs struct {
+	Req *identity_srv.GetRoleGrantRequestRequest
 }
 
-func (p *GetOrganizationDepartmentsArgs) Marshal(out []byte) ([]byte, error) {
+func (p *GetRoleGrantRequestArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetOrganizationDepartmentsArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetOrganizationDepartmentsRequest)
+func (p *GetRoleGrantRequestArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetRoleGrantRequestRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -3634,38 +7528,38 @@ func (p *GetOrganizationDepartmentsArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetOrganizationDepartmentsArgs_Req_DEFAULT *identity_srv.GetOrganizationDepartmentsRequest
+var GetRoleGrantRequestArgs_Req_DEFAULT *identity_srv.GetRoleGrantRequestRequest
 
-func (p *GetOrganizationDepartmentsArgs) GetReq() *identity_srv.GetOrganizationDepartmentsRequest {
+func (p *GetRoleGrantRequestArgs) GetReq() *identity_srv.GetRoleGrantRequestRequest {
 	if !p.IsSetReq() {
-		return GetOrganizationDepartmentsArgs_Req_DEFAULT
+		return GetRoleGrantRequestArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetOrganizationDepartmentsArgs) IsSetReq() bool {
+func (p *GetRoleGrantRequestArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetOrganizationDepartmentsArgs) GetFirstArgument() interface{} {
+func (p *GetRoleGrantRequestArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetOrganizationDepartmentsResult struct {
-	Success *identity_srv.GetOrganizationDepartmentsResponse
+type GetRoleGrantRequestResult struct {
+	Success *identity_srv.GetRoleGrantRequestResponse
 }
 
-var GetOrganizationDepartmentsResult_Success_DEFAULT *identity_srv.GetOrganizationDepartmentsResponse
+var GetRoleGrantRequestResult_Success_DEFAULT *identity_srv.GetRoleGrantRequestResponse
 
-func (p *GetOrganizationDepartmentsResult) Marshal(out []byte) ([]byte, error) {
+func (p *GetRoleGrantRequestResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetOrganizationDepartmentsResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetOrganizationDepartmentsResponse)
+func (p *GetRoleGrantRequestResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetRoleGrantRequestResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -3673,71 +7567,71 @@ func (p *GetOrganizationDepartmentsResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetOrganizationDepartmentsResult) GetSuccess() *identity_srv.GetOrganizationDepartmentsResponse {
+func (p *GetRoleGrantRequestResult) GetSuccess() *identity_srv.GetRoleGrantRequestResponse {
 	if !p.IsSetSuccess() {
-		return GetOrganizationDepartmentsResult_Success_DEFAULT
+		return GetRoleGrantRequestResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetOrganizationDepartmentsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.GetOrganizationDepartmentsResponse)
+func (p *GetRoleGrantRequestResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetRoleGrantRequestResponse)
 }
 
-func (p *GetOrganizationDepartmentsResult) IsSetSuccess() bool {
+func (p *GetRoleGrantRequestResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetOrganizationDepartmentsResult) GetResult() interface{} {
+func (p *GetRoleGrantRequestResult) GetResult() interface{} {
 	return p.Success
 }
 
-func uploadTemporaryLogoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func approveRoleGrantHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.UploadTemporaryLogoRequest)
+		req := new(identity_srv.ApproveRoleGrantRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).UploadTemporaryLogo(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).ApproveRoleGrant(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *UploadTemporaryLogoArgs:
-		success, err := handler.(identity_srv.IdentityService).UploadTemporaryLogo(ctx, s.Req)
+	case *ApproveRoleGrantArgs:
+		success, err := handler.(identity_srv.IdentityService).ApproveRoleGrant(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*UploadTemporaryLogoResult)
+		realResult := result.(*ApproveRoleGrantResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newUploadTemporaryLogoArgs() interface{} {
-	return &UploadTemporaryLogoArgs{}
+func newApproveRoleGrantArgs() interface{} {
+	return &ApproveRoleGrantArgs{}
 }
 
-func newUploadTemporaryLogoResult() interface{} {
-	return &UploadTemporaryLogoResult{}
+func newApproveRoleGrantResult() interface{} {
+	return &ApproveRoleGrantResult{}
 }
 
-type UploadTemporaryLogoArgs struct {
-	Req *identity_srv.UploadTemporaryLogoRequest
+type ApproveRoleGrantArgs struct {
+	Req *identity_srv.ApproveRoleGrantRequest
 }
 
-func (p *UploadTemporaryLogoArgs) Marshal(out []byte) ([]byte, error) {
+func (p *ApproveRoleGrantArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *UploadTemporaryLogoArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.UploadTemporaryLogoRequest)
+func (p *ApproveRoleGrantArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ApproveRoleGrantRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -3745,38 +7639,38 @@ func (p *UploadTemporaryLogoArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var UploadTemporaryLogoArgs_Req_DEFAULT *identity_srv.UploadTemporaryLogoRequest
+var ApproveRoleGrantArgs_Req_DEFAULT *identity_srv.ApproveRoleGrantRequest
 
-func (p *UploadTemporaryLogoArgs) GetReq() *identity_srv.UploadTemporaryLogoRequest {
+func (p *ApproveRoleGrantArgs) GetReq() *identity_srv.ApproveRoleGrantRequest {
 	if !p.IsSetReq() {
-		return UploadTemporaryLogoArgs_Req_DEFAULT
+		return ApproveRoleGrantArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *UploadTemporaryLogoArgs) IsSetReq() bool {
+func (p *ApproveRoleGrantArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UploadTemporaryLogoArgs) GetFirstArgument() interface{} {
+func (p *ApproveRoleGrantArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type UploadTemporaryLogoResult struct {
-	Success *identity_srv.UploadTemporaryLogoResponse
+type ApproveRoleGrantResult struct {
+	Success *identity_srv.ApproveRoleGrantResponse
 }
 
-var UploadTemporaryLogoResult_Success_DEFAULT *identity_srv.UploadTemporaryLogoResponse
+var ApproveRoleGrantResult_Success_DEFAULT *identity_srv.ApproveRoleGrantResponse
 
-func (p *UploadTemporaryLogoResult) Marshal(out []byte) ([]byte, error) {
+func (p *ApproveRoleGrantResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *UploadTemporaryLogoResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.UploadTemporaryLogoResponse)
+func (p *ApproveRoleGrantResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ApproveRoleGrantResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -3784,71 +7678,71 @@ func (p *UploadTemporaryLogoResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *UploadTemporaryLogoResult) GetSuccess() *identity_srv.UploadTemporaryLogoResponse {
+func (p *ApproveRoleGrantResult) GetSuccess() *identity_srv.ApproveRoleGrantResponse {
 	if !p.IsSetSuccess() {
-		return UploadTemporaryLogoResult_Success_DEFAULT
+		return ApproveRoleGrantResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *UploadTemporaryLogoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.UploadTemporaryLogoResponse)
+func (p *ApproveRoleGrantResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.ApproveRoleGrantResponse)
 }
 
-func (p *UploadTemporaryLogoResult) IsSetSuccess() bool {
+func (p *ApproveRoleGrantResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UploadTemporaryLogoResult) GetResult() interface{} {
+func (p *ApproveRoleGrantResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getOrganizationLogoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func rejectRoleGrantHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.GetOrganizationLogoRequest)
+		req := new(identity_srv.RejectRoleGrantRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).GetOrganizationLogo(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).RejectRoleGrant(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *GetOrganizationLogoArgs:
-		success, err := handler.(identity_srv.IdentityService).GetOrganizationLogo(ctx, s.Req)
+	case *RejectRoleGrantArgs:
+		success, err := handler.(identity_srv.IdentityService).RejectRoleGrant(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetOrganizationLogoResult)
+		realResult := result.(*RejectRoleGrantResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetOrganizationLogoArgs() interface{} {
-	return &GetOrganizationLogoArgs{}
+func newRejectRoleGrantArgs() interface{} {
+	return &RejectRoleGrantArgs{}
 }
 
-func newGetOrganizationLogoResult() interface{} {
-	return &GetOrganizationLogoResult{}
+func newRejectRoleGrantResult() interface{} {
+	return &RejectRoleGrantResult{}
 }
 
-type GetOrganizationLogoArgs struct {
-	Req *identity_srv.GetOrganizationLogoRequest
+type RejectRoleGrantArgs struct {
+	Req *identity_srv.RejectRoleGrantRequest
 }
 
-func (p *GetOrganizationLogoArgs) Marshal(out []byte) ([]byte, error) {
+func (p *RejectRoleGrantArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetOrganizationLogoArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetOrganizationLogoRequest)
+func (p *RejectRoleGrantArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.RejectRoleGrantRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -3856,38 +7750,38 @@ func (p *GetOrganizationLogoArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetOrganizationLogoArgs_Req_DEFAULT *identity_srv.GetOrganizationLogoRequest
+var RejectRoleGrantArgs_Req_DEFAULT *identity_srv.RejectRoleGrantRequest
 
-func (p *GetOrganizationLogoArgs) GetReq() *identity_srv.GetOrganizationLogoRequest {
+func (p *RejectRoleGrantArgs) GetReq() *identity_srv.RejectRoleGrantRequest {
 	if !p.IsSetReq() {
-		return GetOrganizationLogoArgs_Req_DEFAULT
+		return RejectRoleGrantArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetOrganizationLogoArgs) IsSetReq() bool {
+func (p *RejectRoleGrantArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetOrganizationLogoArgs) GetFirstArgument() interface{} {
+func (p *RejectRoleGrantArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetOrganizationLogoResult struct {
-	Success *identity_srv.GetOrganizationLogoResponse
+type RejectRoleGrantResult struct {
+	Success *identity_srv.RejectRoleGrantResponse
 }
 
-var GetOrganizationLogoResult_Success_DEFAULT *identity_srv.GetOrganizationLogoResponse
+var RejectRoleGrantResult_Success_DEFAULT *identity_srv.RejectRoleGrantResponse
 
-func (p *GetOrganizationLogoResult) Marshal(out []byte) ([]byte, error) {
+func (p *RejectRoleGrantResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetOrganizationLogoResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetOrganizationLogoResponse)
+func (p *RejectRoleGrantResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.RejectRoleGrantResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -3895,71 +7789,71 @@ func (p *GetOrganizationLogoResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetOrganizationLogoResult) GetSuccess() *identity_srv.GetOrganizationLogoResponse {
+func (p *RejectRoleGrantResult) GetSuccess() *identity_srv.RejectRoleGrantResponse {
 	if !p.IsSetSuccess() {
-		return GetOrganizationLogoResult_Success_DEFAULT
+		return RejectRoleGrantResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetOrganizationLogoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.GetOrganizationLogoResponse)
+func (p *RejectRoleGrantResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.RejectRoleGrantResponse)
 }
 
-func (p *GetOrganizationLogoResult) IsSetSuccess() bool {
+func (p *RejectRoleGrantResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetOrganizationLogoResult) GetResult() interface{} {
+func (p *RejectRoleGrantResult) GetResult() interface{} {
 	return p.Success
 }
 
-func deleteOrganizationLogoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func createAccessReviewCampaignHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.DeleteOrganizationLogoRequest)
+		req := new(identity_srv.CreateAccessReviewCampaignRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).DeleteOrganizationLogo(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).CreateAccessReviewCampaign(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *DeleteOrganizationLogoArgs:
-		success, err := handler.(identity_srv.IdentityService).DeleteOrganizationLogo(ctx, s.Req)
+	case *CreateAccessReviewCampaignArgs:
+		success, err := handler.(identity_srv.IdentityService).CreateAccessReviewCampaign(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*DeleteOrganizationLogoResult)
+		realResult := result.(*CreateAccessReviewCampaignResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newDeleteOrganizationLogoArgs() interface{} {
-	return &DeleteOrganizationLogoArgs{}
+func newCreateAccessReviewCampaignArgs() interface{} {
+	return &CreateAccessReviewCampaignArgs{}
 }
 
-func newDeleteOrganizationLogoResult() interface{} {
-	return &DeleteOrganizationLogoResult{}
+func newCreateAccessReviewCampaignResult() interface{} {
+	return &CreateAccessReviewCampaignResult{}
 }
 
-type DeleteOrganizationLogoArgs struct {
-	Req *identity_srv.DeleteOrganizationLogoRequest
+type CreateAccessReviewCampaignArgs struct {
+	Req *identity_srv.CreateAccessReviewCampaignRequest
 }
 
-func (p *DeleteOrganizationLogoArgs) Marshal(out []byte) ([]byte, error) {
+func (p *CreateAccessReviewCampaignArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *DeleteOrganizationLogoArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.DeleteOrganizationLogoRequest)
+func (p *CreateAccessReviewCampaignArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.CreateAccessReviewCampaignRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -3967,38 +7861,38 @@ func (p *DeleteOrganizationLogoArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var DeleteOrganizationLogoArgs_Req_DEFAULT *identity_srv.DeleteOrganizationLogoRequest
+var CreateAccessReviewCampaignArgs_Req_DEFAULT *identity_srv.CreateAccessReviewCampaignRequest
 
-func (p *DeleteOrganizationLogoArgs) GetReq() *identity_srv.DeleteOrganizationLogoRequest {
+func (p *CreateAccessReviewCampaignArgs) GetReq() *identity_srv.CreateAccessReviewCampaignRequest {
 	if !p.IsSetReq() {
-		return DeleteOrganizationLogoArgs_Req_DEFAULT
+		return CreateAccessReviewCampaignArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *DeleteOrganizationLogoArgs) IsSetReq() bool {
+func (p *CreateAccessReviewCampaignArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DeleteOrganizationLogoArgs) GetFirstArgument() interface{} {
+func (p *CreateAccessReviewCampaignArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type DeleteOrganizationLogoResult struct {
-	Success *identity_srv.DeleteOrganizationLogoResponse
+type CreateAccessReviewCampaignResult struct {
+	Success *identity_srv.CreateAccessReviewCampaignResponse
 }
 
-var DeleteOrganizationLogoResult_Success_DEFAULT *identity_srv.DeleteOrganizationLogoResponse
+var CreateAccessReviewCampaignResult_Success_DEFAULT *identity_srv.CreateAccessReviewCampaignResponse
 
-func (p *DeleteOrganizationLogoResult) Marshal(out []byte) ([]byte, error) {
+func (p *CreateAccessReviewCampaignResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *DeleteOrganizationLogoResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.DeleteOrganizationLogoResponse)
+func (p *CreateAccessReviewCampaignResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.CreateAccessReviewCampaignResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -4006,71 +7900,71 @@ func (p *DeleteOrganizationLogoResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *DeleteOrganizationLogoResult) GetSuccess() *identity_srv.DeleteOrganizationLogoResponse {
+func (p *CreateAccessReviewCampaignResult) GetSuccess() *identity_srv.CreateAccessReviewCampaignResponse {
 	if !p.IsSetSuccess() {
-		return DeleteOrganizationLogoResult_Success_DEFAULT
+		return CreateAccessReviewCampaignResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *DeleteOrganizationLogoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.DeleteOrganizationLogoResponse)
+func (p *CreateAccessReviewCampaignResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.CreateAccessReviewCampaignResponse)
 }
 
-func (p *DeleteOrganizationLogoResult) IsSetSuccess() bool {
+func (p *CreateAccessReviewCampaignResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DeleteOrganizationLogoResult) GetResult() interface{} {
+func (p *CreateAccessReviewCampaignResult) GetResult() interface{} {
 	return p.Success
 }
 
-func bindLogoToOrganizationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func listAccessReviewCampaignsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.BindLogoToOrganizationRequest)
+		req := new(identity_srv.ListAccessReviewCampaignsRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).BindLogoToOrganization(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).ListAccessReviewCampaigns(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *BindLogoToOrganizationArgs:
-		success, err := handler.(identity_srv.IdentityService).BindLogoToOrganization(ctx, s.Req)
+	case *ListAccessReviewCampaignsArgs:
+		success, err := handler.(identity_srv.IdentityService).ListAccessReviewCampaigns(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*BindLogoToOrganizationResult)
+		realResult := result.(*ListAccessReviewCampaignsResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newBindLogoToOrganizationArgs() interface{} {
-	return &BindLogoToOrganizationArgs{}
+func newListAccessReviewCampaignsArgs() interface{} {
+	return &ListAccessReviewCampaignsArgs{}
 }
 
-func newBindLogoToOrganizationResult() interface{} {
-	return &BindLogoToOrganizationResult{}
+func newListAccessReviewCampaignsResult() interface{} {
+	return &ListAccessReviewCampaignsResult{}
 }
 
-type BindLogoToOrganizationArgs struct {
-	Req *identity_srv.BindLogoToOrganizationRequest
+type ListAccessReviewCampaignsArgs struct {
+	Req *identity_srv.ListAccessReviewCampaignsRequest
 }
 
-func (p *BindLogoToOrganizationArgs) Marshal(out []byte) ([]byte, error) {
+func (p *ListAccessReviewCampaignsArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *BindLogoToOrganizationArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.BindLogoToOrganizationRequest)
+func (p *ListAccessReviewCampaignsArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ListAccessReviewCampaignsRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -4078,38 +7972,38 @@ func (p *BindLogoToOrganizationArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var BindLogoToOrganizationArgs_Req_DEFAULT *identity_srv.BindLogoToOrganizationRequest
+var ListAccessReviewCampaignsArgs_Req_DEFAULT *identity_srv.ListAccessReviewCampaignsRequest
 
-func (p *BindLogoToOrganizationArgs) GetReq() *identity_srv.BindLogoToOrganizationRequest {
+func (p *ListAccessReviewCampaignsArgs) GetReq() *identity_srv.ListAccessReviewCampaignsRequest {
 	if !p.IsSetReq() {
-		return BindLogoToOrganizationArgs_Req_DEFAULT
+		return ListAccessReviewCampaignsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *BindLogoToOrganizationArgs) IsSetReq() bool {
+func (p *ListAccessReviewCampaignsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *BindLogoToOrganizationArgs) GetFirstArgument() interface{} {
+func (p *ListAccessReviewCampaignsArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type BindLogoToOrganizationResult struct {
-	Success *identity_srv.BindLogoToOrganizationResponse
+type ListAccessReviewCampaignsResult struct {
+	Success *identity_srv.ListAccessReviewCampaignsResponse
 }
 
-var BindLogoToOrganizationResult_Success_DEFAULT *identity_srv.BindLogoToOrganizationResponse
+var ListAccessReviewCampaignsResult_Success_DEFAULT *identity_srv.ListAccessReviewCampaignsResponse
 
-func (p *BindLogoToOrganizationResult) Marshal(out []byte) ([]byte, error) {
+func (p *ListAccessReviewCampaignsResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *BindLogoToOrganizationResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.BindLogoToOrganizationResponse)
+func (p *ListAccessReviewCampaignsResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ListAccessReviewCampaignsResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -4117,71 +8011,71 @@ func (p *BindLogoToOrganizationResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *BindLogoToOrganizationResult) GetSuccess() *identity_srv.BindLogoToOrganizationResponse {
+func (p *ListAccessReviewCampaignsResult) GetSuccess() *identity_srv.ListAccessReviewCampaignsResponse {
 	if !p.IsSetSuccess() {
-		return BindLogoToOrganizationResult_Success_DEFAULT
+		return ListAccessReviewCampaignsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *BindLogoToOrganizationResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.BindLogoToOrganizationResponse)
+func (p *ListAccessReviewCampaignsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.ListAccessReviewCampaignsResponse)
 }
 
-func (p *BindLogoToOrganizationResult) IsSetSuccess() bool {
+func (p *ListAccessReviewCampaignsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *BindLogoToOrganizationResult) GetResult() interface{} {
+func (p *ListAccessReviewCampaignsResult) GetResult() interface{} {
 	return p.Success
 }
 
-func createRoleDefinitionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func getAccessReviewCampaignHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.RoleDefinitionCreateRequest)
+		req := new(identity_srv.GetAccessReviewCampaignRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).CreateRoleDefinition(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).GetAccessReviewCampaign(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *CreateRoleDefinitionArgs:
-		success, err := handler.(identity_srv.IdentityService).CreateRoleDefinition(ctx, s.Req)
+	case *GetAccessReviewCampaignArgs:
+		success, err := handler.(identity_srv.IdentityService).GetAccessReviewCampaign(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*CreateRoleDefinitionResult)
+		realResult := result.(*GetAccessReviewCampaignResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newCreateRoleDefinitionArgs() interface{} {
-	return &CreateRoleDefinitionArgs{}
+func newGetAccessReviewCampaignArgs() interface{} {
+	return &GetAccessReviewCampaignArgs{}
 }
 
-func newCreateRoleDefinitionResult() interface{} {
-	return &CreateRoleDefinitionResult{}
+func newGetAccessReviewCampaignResult() interface{} {
+	return &GetAccessReviewCampaignResult{}
 }
 
-type CreateRoleDefinitionArgs struct {
-	Req *identity_srv.RoleDefinitionCreateRequest
+type GetAccessReviewCampaignArgs struct {
+	Req *identity_srv.GetAccessReviewCampaignRequest
 }
 
-func (p *CreateRoleDefinitionArgs) Marshal(out []byte) ([]byte, error) {
+func (p *GetAccessReviewCampaignArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *CreateRoleDefinitionArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.RoleDefinitionCreateRequest)
+func (p *GetAccessReviewCampaignArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetAccessReviewCampaignRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -4189,38 +8083,38 @@ func (p *CreateRoleDefinitionArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var CreateRoleDefinitionArgs_Req_DEFAULT *identity_srv.RoleDefinitionCreateRequest
+var GetAccessReviewCampaignArgs_Req_DEFAULT *identity_srv.GetAccessReviewCampaignRequest
 
-func (p *CreateRoleDefinitionArgs) GetReq() *identity_srv.RoleDefinitionCreateRequest {
+func (p *GetAccessReviewCampaignArgs) GetReq() *identity_srv.GetAccessReviewCampaignRequest {
 	if !p.IsSetReq() {
-		return CreateRoleDefinitionArgs_Req_DEFAULT
+		return GetAccessReviewCampaignArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *CreateRoleDefinitionArgs) IsSetReq() bool {
+func (p *GetAccessReviewCampaignArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *CreateRoleDefinitionArgs) GetFirstArgument() interface{} {
+func (p *GetAccessReviewCampaignArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type CreateRoleDefinitionResult struct {
-	Success *identity_srv.CreateRoleDefinitionResponse
+type GetAccessReviewCampaignResult struct {
+	Success *identity_srv.GetAccessReviewCampaignResponse
 }
 
-var CreateRoleDefinitionResult_Success_DEFAULT *identity_srv.CreateRoleDefinitionResponse
+var GetAccessReviewCampaignResult_Success_DEFAULT *identity_srv.GetAccessReviewCampaignResponse
 
-func (p *CreateRoleDefinitionResult) Marshal(out []byte) ([]byte, error) {
+func (p *GetAccessReviewCampaignResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *CreateRoleDefinitionResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.CreateRoleDefinitionResponse)
+func (p *GetAccessReviewCampaignResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetAccessReviewCampaignResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -4228,71 +8122,71 @@ func (p *CreateRoleDefinitionResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *CreateRoleDefinitionResult) GetSuccess() *identity_srv.CreateRoleDefinitionResponse {
+func (p *GetAccessReviewCampaignResult) GetSuccess() *identity_srv.GetAccessReviewCampaignResponse {
 	if !p.IsSetSuccess() {
-		return CreateRoleDefinitionResult_Success_DEFAULT
+		return GetAccessReviewCampaignResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *CreateRoleDefinitionResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.CreateRoleDefinitionResponse)
+func (p *GetAccessReviewCampaignResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetAccessReviewCampaignResponse)
 }
 
-func (p *CreateRoleDefinitionResult) IsSetSuccess() bool {
+func (p *GetAccessReviewCampaignResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CreateRoleDefinitionResult) GetResult() interface{} {
+func (p *GetAccessReviewCampaignResult) GetResult() interface{} {
 	return p.Success
 }
 
-func updateRoleDefinitionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func listAccessReviewItemsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.RoleDefinitionUpdateRequest)
+		req := new(identity_srv.ListAccessReviewItemsRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).UpdateRoleDefinition(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).ListAccessReviewItems(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *UpdateRoleDefinitionArgs:
-		success, err := handler.(identity_srv.IdentityService).UpdateRoleDefinition(ctx, s.Req)
+	case *ListAccessReviewItemsArgs:
+		success, err := handler.(identity_srv.IdentityService).ListAccessReviewItems(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*UpdateRoleDefinitionResult)
+		realResult := result.(*ListAccessReviewItemsResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newUpdateRoleDefinitionArgs() interface{} {
-	return &UpdateRoleDefinitionArgs{}
+func newListAccessReviewItemsArgs() interface{} {
+	return &ListAccessReviewItemsArgs{}
 }
 
-func newUpdateRoleDefinitionResult() interface{} {
-	return &UpdateRoleDefinitionResult{}
+func newListAccessReviewItemsResult() interface{} {
+	return &ListAccessReviewItemsResult{}
 }
 
-type UpdateRoleDefinitionArgs struct {
-	Req *identity_srv.RoleDefinitionUpdateRequest
+type ListAccessReviewItemsArgs struct {
+	Req *identity_srv.ListAccessReviewItemsRequest
 }
 
-func (p *UpdateRoleDefinitionArgs) Marshal(out []byte) ([]byte, error) {
+func (p *ListAccessReviewItemsArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *UpdateRoleDefinitionArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.RoleDefinitionUpdateRequest)
+func (p *ListAccessReviewItemsArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ListAccessReviewItemsRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -4300,38 +8194,38 @@ func (p *UpdateRoleDefinitionArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var UpdateRoleDefinitionArgs_Req_DEFAULT *identity_srv.RoleDefinitionUpdateRequest
+var ListAccessReviewItemsArgs_Req_DEFAULT *identity_srv.ListAccessReviewItemsRequest
 
-func (p *UpdateRoleDefinitionArgs) GetReq() *identity_srv.RoleDefinitionUpdateRequest {
+func (p *ListAccessReviewItemsArgs) GetReq() *identity_srv.ListAccessReviewItemsRequest {
 	if !p.IsSetReq() {
-		return UpdateRoleDefinitionArgs_Req_DEFAULT
+		return ListAccessReviewItemsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *UpdateRoleDefinitionArgs) IsSetReq() bool {
+func (p *ListAccessReviewItemsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UpdateRoleDefinitionArgs) GetFirstArgument() interface{} {
+func (p *ListAccessReviewItemsArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type UpdateRoleDefinitionResult struct {
-	Success *identity_srv.UpdateRoleDefinitionResponse
+type ListAccessReviewItemsResult struct {
+	Success *identity_srv.ListAccessReviewItemsResponse
 }
 
-var UpdateRoleDefinitionResult_Success_DEFAULT *identity_srv.UpdateRoleDefinitionResponse
+var ListAccessReviewItemsResult_Success_DEFAULT *identity_srv.ListAccessReviewItemsResponse
 
-func (p *UpdateRoleDefinitionResult) Marshal(out []byte) ([]byte, error) {
+func (p *ListAccessReviewItemsResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *UpdateRoleDefinitionResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.UpdateRoleDefinitionResponse)
+func (p *ListAccessReviewItemsResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ListAccessReviewItemsResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -4339,71 +8233,71 @@ func (p *UpdateRoleDefinitionResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *UpdateRoleDefinitionResult) GetSuccess() *identity_srv.UpdateRoleDefinitionResponse {
+func (p *ListAccessReviewItemsResult) GetSuccess() *identity_srv.ListAccessReviewItemsResponse {
 	if !p.IsSetSuccess() {
-		return UpdateRoleDefinitionResult_Success_DEFAULT
+		return ListAccessReviewItemsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *UpdateRoleDefinitionResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.UpdateRoleDefinitionResponse)
+func (p *ListAccessReviewItemsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.ListAccessReviewItemsResponse)
 }
 
-func (p *UpdateRoleDefinitionResult) IsSetSuccess() bool {
+func (p *ListAccessReviewItemsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UpdateRoleDefinitionResult) GetResult() interface{} {
+func (p *ListAccessReviewItemsResult) GetResult() interface{} {
 	return p.Success
 }
 
-func deleteRoleDefinitionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func decideAccessReviewItemHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.DeleteRoleDefinitionRequest)
+		req := new(identity_srv.DecideAccessReviewItemRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).DeleteRoleDefinition(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).DecideAccessReviewItem(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *DeleteRoleDefinitionArgs:
-		success, err := handler.(identity_srv.IdentityService).DeleteRoleDefinition(ctx, s.Req)
+	case *DecideAccessReviewItemArgs:
+		success, err := handler.(identity_srv.IdentityService).DecideAccessReviewItem(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*DeleteRoleDefinitionResult)
+		realResult := result.(*DecideAccessReviewItemResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newDeleteRoleDefinitionArgs() interface{} {
-	return &DeleteRoleDefinitionArgs{}
+func newDecideAccessReviewItemArgs() interface{} {
+	return &DecideAccessReviewItemArgs{}
 }
 
-func newDeleteRoleDefinitionResult() interface{} {
-	return &DeleteRoleDefinitionResult{}
+func newDecideAccessReviewItemResult() interface{} {
+	return &DecideAccessReviewItemResult{}
 }
 
-type DeleteRoleDefinitionArgs struct {
-	Req *identity_srv.DeleteRoleDefinitionRequest
+type DecideAccessReviewItemArgs struct {
+	Req *identity_srv.DecideAccessReviewItemRequest
 }
 
-func (p *DeleteRoleDefinitionArgs) Marshal(out []byte) ([]byte, error) {
+func (p *DecideAccessReviewItemArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *DeleteRoleDefinitionArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.DeleteRoleDefinitionRequest)
+func (p *DecideAccessReviewItemArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.DecideAccessReviewItemRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -4411,38 +8305,38 @@ func (p *DeleteRoleDefinitionArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var DeleteRoleDefinitionArgs_Req_DEFAULT *identity_srv.DeleteRoleDefinitionRequest
+var DecideAccessReviewItemArgs_Req_DEFAULT *identity_srv.DecideAccessReviewItemRequest
 
-func (p *DeleteRoleDefinitionArgs) GetReq() *identity_srv.DeleteRoleDefinitionRequest {
+func (p *DecideAccessReviewItemArgs) GetReq() *identity_srv.DecideAccessReviewItemRequest {
 	if !p.IsSetReq() {
-		return DeleteRoleDefinitionArgs_Req_DEFAULT
+		return DecideAccessReviewItemArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *DeleteRoleDefinitionArgs) IsSetReq() bool {
+func (p *DecideAccessReviewItemArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DeleteRoleDefinitionArgs) GetFirstArgument() interface{} {
+func (p *DecideAccessReviewItemArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type DeleteRoleDefinitionResult struct {
-	Success *identity_srv.DeleteRoleDefinitionResponse
+type DecideAccessReviewItemResult struct {
+	Success *identity_srv.DecideAccessReviewItemResponse
 }
 
-var DeleteRoleDefinitionResult_Success_DEFAULT *identity_srv.DeleteRoleDefinitionResponse
+var DecideAccessReviewItemResult_Success_DEFAULT *identity_srv.DecideAccessReviewItemResponse
 
-func (p *DeleteRoleDefinitionResult) Marshal(out []byte) ([]byte, error) {
+func (p *DecideAccessReviewItemResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *DeleteRoleDefinitionResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.DeleteRoleDefinitionResponse)
+func (p *DecideAccessReviewItemResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.DecideAccessReviewItemResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -4450,71 +8344,71 @@ func (p *DeleteRoleDefinitionResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *DeleteRoleDefinitionResult) GetSuccess() *identity_srv.DeleteRoleDefinitionResponse {
+func (p *DecideAccessReviewItemResult) GetSuccess() *identity_srv.DecideAccessReviewItemResponse {
 	if !p.IsSetSuccess() {
-		return DeleteRoleDefinitionResult_Success_DEFAULT
+		return DecideAccessReviewItemResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *DeleteRoleDefinitionResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.DeleteRoleDefinitionResponse)
+func (p *DecideAccessReviewItemResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.DecideAccessReviewItemResponse)
 }
 
-func (p *DeleteRoleDefinitionResult) IsSetSuccess() bool {
+func (p *DecideAccessReviewItemResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DeleteRoleDefinitionResult) GetResult() interface{} {
+func (p *DecideAccessReviewItemResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getRoleDefinitionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func closeAccessReviewCampaignHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.GetRoleDefinitionRequest)
+		req := new(identity_srv.CloseAccessReviewCampaignRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).GetRoleDefinition(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).CloseAccessReviewCampaign(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *GetRoleDefinitionArgs:
-		success, err := handler.(identity_srv.IdentityService).GetRoleDefinition(ctx, s.Req)
+	case *CloseAccessReviewCampaignArgs:
+		success, err := handler.(identity_srv.IdentityService).CloseAccessReviewCampaign(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetRoleDefinitionResult)
+		realResult := result.(*CloseAccessReviewCampaignResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetRoleDefinitionArgs() interface{} {
-	return &GetRoleDefinitionArgs{}
+func newCloseAccessReviewCampaignArgs() interface{} {
+	return &CloseAccessReviewCampaignArgs{}
 }
 
-func newGetRoleDefinitionResult() interface{} {
-	return &GetRoleDefinitionResult{}
+func newCloseAccessReviewCampaignResult() interface{} {
+	return &CloseAccessReviewCampaignResult{}
 }
 
-type GetRoleDefinitionArgs struct {
-	Req *identity_srv.GetRoleDefinitionRequest
+type CloseAccessReviewCampaignArgs struct {
+	Req *identity_srv.CloseAccessReviewCampaignRequest
 }
 
-func (p *GetRoleDefinitionArgs) Marshal(out []byte) ([]byte, error) {
+func (p *CloseAccessReviewCampaignArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetRoleDefinitionArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetRoleDefinitionRequest)
+func (p *CloseAccessReviewCampaignArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.CloseAccessReviewCampaignRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -4522,38 +8416,38 @@ func (p *GetRoleDefinitionArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetRoleDefinitionArgs_Req_DEFAULT *identity_srv.GetRoleDefinitionRequest
+var CloseAccessReviewCampaignArgs_Req_DEFAULT *identity_srv.CloseAccessReviewCampaignRequest
 
-func (p *GetRoleDefinitionArgs) GetReq() *identity_srv.GetRoleDefinitionRequest {
+func (p *CloseAccessReviewCampaignArgs) GetReq() *identity_srv.CloseAccessReviewCampaignRequest {
 	if !p.IsSetReq() {
-		return GetRoleDefinitionArgs_Req_DEFAULT
+		return CloseAccessReviewCampaignArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetRoleDefinitionArgs) IsSetReq() bool {
+func (p *CloseAccessReviewCampaignArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetRoleDefinitionArgs) GetFirstArgument() interface{} {
+func (p *CloseAccessReviewCampaignArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetRoleDefinitionResult struct {
-	Success *identity_srv.GetRoleDefinitionResponse
+type CloseAccessReviewCampaignResult struct {
+	Success *identity_srv.CloseAccessReviewCampaignResponse
 }
 
-var GetRoleDefinitionResult_Success_DEFAULT *identity_srv.GetRoleDefinitionResponse
+var CloseAccessReviewCampaignResult_Success_DEFAULT *identity_srv.CloseAccessReviewCampaignResponse
 
-func (p *GetRoleDefinitionResult) Marshal(out []byte) ([]byte, error) {
+func (p *CloseAccessReviewCampaignResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetRoleDefinitionResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetRoleDefinitionResponse)
+func (p *CloseAccessReviewCampaignResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.CloseAccessReviewCampaignResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -4561,71 +8455,71 @@ func (p *GetRoleDefinitionResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetRoleDefinitionResult) GetSuccess() *identity_srv.GetRoleDefinitionResponse {
+func (p *CloseAccessReviewCampaignResult) GetSuccess() *identity_srv.CloseAccessReviewCampaignResponse {
 	if !p.IsSetSuccess() {
-		return GetRoleDefinitionResult_Success_DEFAULT
+		return CloseAccessReviewCampaignResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetRoleDefinitionResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.GetRoleDefinitionResponse)
+func (p *CloseAccessReviewCampaignResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.CloseAccessReviewCampaignResponse)
 }
 
-func (p *GetRoleDefinitionResult) IsSetSuccess() bool {
+func (p *CloseAccessReviewCampaignResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetRoleDefinitionResult) GetResult() interface{} {
+func (p *CloseAccessReviewCampaignResult) GetResult() interface{} {
 	return p.Success
 }
 
-func listRoleDefinitionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func uploadMenuHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.RoleDefinitionQueryRequest)
+		req := new(identity_srv.UploadMenuRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).ListRoleDefinitions(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).UploadMenu(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *ListRoleDefinitionsArgs:
-		success, err := handler.(identity_srv.IdentityService).ListRoleDefinitions(ctx, s.Req)
+	case *UploadMenuArgs:
+		success, err := handler.(identity_srv.IdentityService).UploadMenu(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*ListRoleDefinitionsResult)
+		realResult := result.(*UploadMenuResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newListRoleDefinitionsArgs() interface{} {
-	return &ListRoleDefinitionsArgs{}
+func newUploadMenuArgs() interface{} {
+	return &UploadMenuArgs{}
 }
 
-func newListRoleDefinitionsResult() interface{} {
-	return &ListRoleDefinitionsResult{}
+func newUploadMenuResult() interface{} {
+	return &UploadMenuResult{}
 }
 
-type ListRoleDefinitionsArgs struct {
-	Req *identity_srv.RoleDefinitionQueryRequest
+type UploadMenuArgs struct {
+	Req *identity_srv.UploadMenuRequest
 }
 
-func (p *ListRoleDefinitionsArgs) Marshal(out []byte) ([]byte, error) {
+func (p *UploadMenuArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *ListRoleDefinitionsArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.RoleDefinitionQueryRequest)
+func (p *UploadMenuArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UploadMenuRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -4633,38 +8527,38 @@ func (p *ListRoleDefinitionsArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var ListRoleDefinitionsArgs_Req_DEFAULT *identity_srv.RoleDefinitionQueryRequest
+var UploadMenuArgs_Req_DEFAULT *identity_srv.UploadMenuRequest
 
-func (p *ListRoleDefinitionsArgs) GetReq() *identity_srv.RoleDefinitionQueryRequest {
+func (p *UploadMenuArgs) GetReq() *identity_srv.UploadMenuRequest {
 	if !p.IsSetReq() {
-		return ListRoleDefinitionsArgs_Req_DEFAULT
+		return UploadMenuArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *ListRoleDefinitionsArgs) IsSetReq() bool {
+func (p *UploadMenuArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ListRoleDefinitionsArgs) GetFirstArgument() interface{} {
+func (p *UploadMenuArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type ListRoleDefinitionsResult struct {
-	Success *identity_srv.RoleDefinitionListResponse
+type UploadMenuResult struct {
+	Success *identity_srv.UploadMenuResponse
 }
 
-var ListRoleDefinitionsResult_Success_DEFAULT *identity_srv.RoleDefinitionListResponse
+var UploadMenuResult_Success_DEFAULT *identity_srv.UploadMenuResponse
 
-func (p *ListRoleDefinitionsResult) Marshal(out []byte) ([]byte, error) {
+func (p *UploadMenuResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *ListRoleDefinitionsResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.RoleDefinitionListResponse)
+func (p *UploadMenuResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.UploadMenuResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -4672,71 +8566,71 @@ func (p *ListRoleDefinitionsResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *ListRoleDefinitionsResult) GetSuccess() *identity_srv.RoleDefinitionListResponse {
+func (p *UploadMenuResult) GetSuccess() *identity_srv.UploadMenuResponse {
 	if !p.IsSetSuccess() {
-		return ListRoleDefinitionsResult_Success_DEFAULT
+		return UploadMenuResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *ListRoleDefinitionsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.RoleDefinitionListResponse)
+func (p *UploadMenuResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.UploadMenuResponse)
 }
 
-func (p *ListRoleDefinitionsResult) IsSetSuccess() bool {
+func (p *UploadMenuResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ListRoleDefinitionsResult) GetResult() interface{} {
+func (p *UploadMenuResult) GetResult() interface{} {
 	return p.Success
 }
 
-func assignRoleToUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func getMenuTreeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.AssignRoleToUserRequest)
+		req := new(identity_srv.GetMenuTreeRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).AssignRoleToUser(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).GetMenuTree(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *AssignRoleToUserArgs:
-		success, err := handler.(identity_srv.IdentityService).AssignRoleToUser(ctx, s.Req)
+	case *GetMenuTreeArgs:
+		success, err := handler.(identity_srv.IdentityService).GetMenuTree(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*AssignRoleToUserResult)
+		realResult := result.(*GetMenuTreeResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newAssignRoleToUserArgs() interface{} {
-	return &AssignRoleToUserArgs{}
+func newGetMenuTreeArgs() interface{} {
+	return &GetMenuTreeArgs{}
 }
 
-func newAssignRoleToUserResult() interface{} {
-	return &AssignRoleToUserResult{}
+func newGetMenuTreeResult() interface{} {
+	return &GetMenuTreeResult{}
 }
 
-type AssignRoleToUserArgs struct {
-	Req *identity_srv.AssignRoleToUserRequest
+type GetMenuTreeArgs struct {
+	Req *identity_srv.GetMenuTreeRequest
 }
 
-func (p *AssignRoleToUserArgs) Marshal(out []byte) ([]byte, error) {
+func (p *GetMenuTreeArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *AssignRoleToUserArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.AssignRoleToUserRequest)
+func (p *GetMenuTreeArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetMenuTreeRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -4744,38 +8638,38 @@ func (p *AssignRoleToUserArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var AssignRoleToUserArgs_Req_DEFAULT *identity_srv.AssignRoleToUserRequest
+var GetMenuTreeArgs_Req_DEFAULT *identity_srv.GetMenuTreeRequest
 
-func (p *AssignRoleToUserArgs) GetReq() *identity_srv.AssignRoleToUserRequest {
+func (p *GetMenuTreeArgs) GetReq() *identity_srv.GetMenuTreeRequest {
 	if !p.IsSetReq() {
-		return AssignRoleToUserArgs_Req_DEFAULT
+		return GetMenuTreeArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *AssignRoleToUserArgs) IsSetReq() bool {
+func (p *GetMenuTreeArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AssignRoleToUserArgs) GetFirstArgument() interface{} {
+func (p *GetMenuTreeArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type AssignRoleToUserResult struct {
-	Success *identity_srv.UserRoleAssignmentResponse
+type GetMenuTreeResult struct {
+	Success *identity_srv.GetMenuTreeResponse
 }
 
-var AssignRoleToUserResult_Success_DEFAULT *identity_srv.UserRoleAssignmentResponse
+var GetMenuTreeResult_Success_DEFAULT *identity_srv.GetMenuTreeResponse
 
-func (p *AssignRoleToUserResult) Marshal(out []byte) ([]byte, error) {
+func (p *GetMenuTreeResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *AssignRoleToUserResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.UserRoleAssignmentResponse)
+func (p *GetMenuTreeResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetMenuTreeResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -4783,71 +8677,71 @@ func (p *AssignRoleToUserResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *AssignRoleToUserResult) GetSuccess() *identity_srv.UserRoleAssignmentResponse {
+func (p *GetMenuTreeResult) GetSuccess() *identity_srv.GetMenuTreeResponse {
 	if !p.IsSetSuccess() {
-		return AssignRoleToUserResult_Success_DEFAULT
+		return GetMenuTreeResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *AssignRoleToUserResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.UserRoleAssignmentResponse)
+func (p *GetMenuTreeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetMenuTreeResponse)
 }
 
-func (p *AssignRoleToUserResult) IsSetSuccess() bool {
+func (p *GetMenuTreeResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AssignRoleToUserResult) GetResult() interface{} {
+func (p *GetMenuTreeResult) GetResult() interface{} {
 	return p.Success
 }
 
-func updateUserRoleAssignmentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func listMenuVersionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.UpdateUserRoleAssignmentRequest)
+		req := new(identity_srv.ListMenuVersionsRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).UpdateUserRoleAssignment(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).ListMenuVersions(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *UpdateUserRoleAssignmentArgs:
-		success, err := handler.(identity_srv.IdentityService).UpdateUserRoleAssignment(ctx, s.Req)
+	case *ListMenuVersionsArgs:
+		success, err := handler.(identity_srv.IdentityService).ListMenuVersions(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*UpdateUserRoleAssignmentResult)
+		realResult := result.(*ListMenuVersionsResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newUpdateUserRoleAssignmentArgs() interface{} {
-	return &UpdateUserRoleAssignmentArgs{}
+func newListMenuVersionsArgs() interface{} {
+	return &ListMenuVersionsArgs{}
 }
 
-func newUpdateUserRoleAssignmentResult() interface{} {
-	return &UpdateUserRoleAssignmentResult{}
+func newListMenuVersionsResult() interface{} {
+	return &ListMenuVersionsResult{}
 }
 
-type UpdateUserRoleAssignmentArgs struct {
-	Req *identity_srv.UpdateUserRoleAssignmentRequest
+type ListMenuVersionsArgs struct {
+	Req *identity_srv.ListMenuVersionsRequest
 }
 
-func (p *UpdateUserRoleAssignmentArgs) Marshal(out []byte) ([]byte, error) {
+func (p *ListMenuVersionsArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *UpdateUserRoleAssignmentArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.UpdateUserRoleAssignmentRequest)
+func (p *ListMenuVersionsArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ListMenuVersionsRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -4855,38 +8749,38 @@ func (p *UpdateUserRoleAssignmentArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var UpdateUserRoleAssignmentArgs_Req_DEFAULT *identity_srv.UpdateUserRoleAssignmentRequest
+var ListMenuVersionsArgs_Req_DEFAULT *identity_srv.ListMenuVersionsRequest
 
-func (p *UpdateUserRoleAssignmentArgs) GetReq() *identity_srv.UpdateUserRoleAssignmentRequest {
+func (p *ListMenuVersionsArgs) GetReq() *identity_srv.ListMenuVersionsRequest {
 	if !p.IsSetReq() {
-		return UpdateUserRoleAssignmentArgs_Req_DEFAULT
+		return ListMenuVersionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *UpdateUserRoleAssignmentArgs) IsSetReq() bool {
+func (p *ListMenuVersionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UpdateUserRoleAssignmentArgs) GetFirstArgument() interface{} {
+func (p *ListMenuVersionsArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type UpdateUserRoleAssignmentResult struct {
-	Success *identity_srv.UpdateUserRoleAssignmentResponse
+type ListMenuVersionsResult struct {
+	Success *identity_srv.ListMenuVersionsResponse
 }
 
-var UpdateUserRoleAssignmentResult_Success_DEFAULT *identity_srv.UpdateUserRoleAssignmentResponse
+var ListMenuVersionsResult_Success_DEFAULT *identity_srv.ListMenuVersionsResponse
 
-func (p *UpdateUserRoleAssignmentResult) Marshal(out []byte) ([]byte, error) {
+func (p *ListMenuVersionsResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *UpdateUserRoleAssignmentResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.UpdateUserRoleAssignmentResponse)
+func (p *ListMenuVersionsResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ListMenuVersionsResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -4894,71 +8788,71 @@ func (p *UpdateUserRoleAssignmentResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *UpdateUserRoleAssignmentResult) GetSuccess() *identity_srv.UpdateUserRoleAssignmentResponse {
+func (p *ListMenuVersionsResult) GetSuccess() *identity_srv.ListMenuVersionsResponse {
 	if !p.IsSetSuccess() {
-		return UpdateUserRoleAssignmentResult_Success_DEFAULT
+		return ListMenuVersionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *UpdateUserRoleAssignmentResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.UpdateUserRoleAssignmentResponse)
+func (p *ListMenuVersionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.ListMenuVersionsResponse)
 }
 
-func (p *UpdateUserRoleAssignmentResult) IsSetSuccess() bool {
+func (p *ListMenuVersionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UpdateUserRoleAssignmentResult) GetResult() interface{} {
+func (p *ListMenuVersionsResult) GetResult() interface{} {
 	return p.Success
 }
 
-func revokeRoleFromUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func diffMenuVersionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.RevokeRoleFromUserRequest)
+		req := new(identity_srv.DiffMenuVersionsRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).RevokeRoleFromUser(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).DiffMenuVersions(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *RevokeRoleFromUserArgs:
-		success, err := handler.(identity_srv.IdentityService).RevokeRoleFromUser(ctx, s.Req)
+	case *DiffMenuVersionsArgs:
+		success, err := handler.(identity_srv.IdentityService).DiffMenuVersions(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*RevokeRoleFromUserResult)
+		realResult := result.(*DiffMenuVersionsResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newRevokeRoleFromUserArgs() interface{} {
-	return &RevokeRoleFromUserArgs{}
+func newDiffMenuVersionsArgs() interface{} {
+	return &DiffMenuVersionsArgs{}
 }
 
-func newRevokeRoleFromUserResult() interface{} {
-	return &RevokeRoleFromUserResult{}
+func newDiffMenuVersionsResult() interface{} {
+	return &DiffMenuVersionsResult{}
 }
 
-type RevokeRoleFromUserArgs struct {
-	Req *identity_srv.RevokeRoleFromUserRequest
+type DiffMenuVersionsArgs struct {
+	Req *identity_srv.DiffMenuVersionsRequest
 }
 
-func (p *RevokeRoleFromUserArgs) Marshal(out []byte) ([]byte, error) {
+func (p *DiffMenuVersionsArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *RevokeRoleFromUserArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.RevokeRoleFromUserRequest)
+func (p *DiffMenuVersionsArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.DiffMenuVersionsRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -4966,38 +8860,38 @@ func (p *RevokeRoleFromUserArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var RevokeRoleFromUserArgs_Req_DEFAULT *identity_srv.RevokeRoleFromUserRequest
+var DiffMenuVersionsArgs_Req_DEFAULT *identity_srv.DiffMenuVersionsRequest
 
-func (p *RevokeRoleFromUserArgs) GetReq() *identity_srv.RevokeRoleFromUserRequest {
+func (p *DiffMenuVersionsArgs) GetReq() *identity_srv.DiffMenuVersionsRequest {
 	if !p.IsSetReq() {
-		return RevokeRoleFromUserArgs_Req_DEFAULT
+		return DiffMenuVersionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *RevokeRoleFromUserArgs) IsSetReq() bool {
+func (p *DiffMenuVersionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RevokeRoleFromUserArgs) GetFirstArgument() interface{} {
+func (p *DiffMenuVersionsArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type RevokeRoleFromUserResult struct {
-	Success *identity_srv.RevokeRoleFromUserResponse
+type DiffMenuVersionsResult struct {
+	Success *identity_srv.DiffMenuVersionsResponse
 }
 
-var RevokeRoleFromUserResult_Success_DEFAULT *identity_srv.RevokeRoleFromUserResponse
+var DiffMenuVersionsResult_Success_DEFAULT *identity_srv.DiffMenuVersionsResponse
 
-func (p *RevokeRoleFromUserResult) Marshal(out []byte) ([]byte, error) {
+func (p *DiffMenuVersionsResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *RevokeRoleFromUserResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.RevokeRoleFromUserResponse)
+func (p *DiffMenuVersionsResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.DiffMenuVersionsResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -5005,71 +8899,71 @@ func (p *RevokeRoleFromUserResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *RevokeRoleFromUserResult) GetSuccess() *identity_srv.RevokeRoleFromUserResponse {
+func (p *DiffMenuVersionsResult) GetSuccess() *identity_srv.DiffMenuVersionsResponse {
 	if !p.IsSetSuccess() {
-		return RevokeRoleFromUserResult_Success_DEFAULT
+		return DiffMenuVersionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *RevokeRoleFromUserResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.RevokeRoleFromUserResponse)
+func (p *DiffMenuVersionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.DiffMenuVersionsResponse)
 }
 
-func (p *RevokeRoleFromUserResult) IsSetSuccess() bool {
+func (p *DiffMenuVersionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RevokeRoleFromUserResult) GetResult() interface{} {
+func (p *DiffMenuVersionsResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getLastUserRoleAssignmentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func activateMenuVersionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.GetLastUserRoleAssignmentRequest)
+		req := new(identity_srv.ActivateMenuVersionRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).GetLastUserRoleAssignment(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).ActivateMenuVersion(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *GetLastUserRoleAssignmentArgs:
-		success, err := handler.(identity_srv.IdentityService).GetLastUserRoleAssignment(ctx, s.Req)
+	case *ActivateMenuVersionArgs:
+		success, err := handler.(identity_srv.IdentityService).ActivateMenuVersion(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetLastUserRoleAssignmentResult)
+		realResult := result.(*ActivateMenuVersionResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetLastUserRoleAssignmentArgs() interface{} {
-	return &GetLastUserRoleAssignmentArgs{}
+func newActivateMenuVersionArgs() interface{} {
+	return &ActivateMenuVersionArgs{}
 }
 
-func newGetLastUserRoleAssignmentResult() interface{} {
-	return &GetLastUserRoleAssignmentResult{}
+func newActivateMenuVersionResult() interface{} {
+	return &ActivateMenuVersionResult{}
 }
 
-type GetLastUserRoleAssignmentArgs struct {
-	Req *identity_srv.GetLastUserRoleAssignmentRequest
+type ActivateMenuVersionArgs struct {
+	Req *identity_srv.ActivateMenuVersionRequest
 }
 
-func (p *GetLastUserRoleAssignmentArgs) Marshal(out []byte) ([]byte, error) {
+func (p *ActivateMenuVersionArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetLastUserRoleAssignmentArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetLastUserRoleAssignmentRequest)
+func (p *ActivateMenuVersionArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ActivateMenuVersionRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -5077,38 +8971,38 @@ func (p *GetLastUserRoleAssignmentArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetLastUserRoleAssignmentArgs_Req_DEFAULT *identity_srv.GetLastUserRoleAssignmentRequest
+var ActivateMenuVersionArgs_Req_DEFAULT *identity_srv.ActivateMenuVersionRequest
 
-func (p *GetLastUserRoleAssignmentArgs) GetReq() *identity_srv.GetLastUserRoleAssignmentRequest {
+func (p *ActivateMenuVersionArgs) GetReq() *identity_srv.ActivateMenuVersionRequest {
 	if !p.IsSetReq() {
-		return GetLastUserRoleAssignmentArgs_Req_DEFAULT
+		return ActivateMenuVersionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetLastUserRoleAssignmentArgs) IsSetReq() bool {
+func (p *ActivateMenuVersionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetLastUserRoleAssignmentArgs) GetFirstArgument() interface{} {
+func (p *ActivateMenuVersionArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetLastUserRoleAssignmentResult struct {
-	Success *identity_srv.GetLastUserRoleAssignmentResponse
+type ActivateMenuVersionResult struct {
+	Success *identity_srv.ActivateMenuVersionResponse
 }
 
-var GetLastUserRoleAssignmentResult_Success_DEFAULT *identity_srv.GetLastUserRoleAssignmentResponse
+var ActivateMenuVersionResult_Success_DEFAULT *identity_srv.ActivateMenuVersionResponse
 
-func (p *GetLastUserRoleAssignmentResult) Marshal(out []byte) ([]byte, error) {
+func (p *ActivateMenuVersionResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetLastUserRoleAssignmentResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetLastUserRoleAssignmentResponse)
+func (p *ActivateMenuVersionResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ActivateMenuVersionResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -5116,71 +9010,71 @@ func (p *GetLastUserRoleAssignmentResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetLastUserRoleAssignmentResult) GetSuccess() *identity_srv.GetLastUserRoleAssignmentResponse {
+func (p *ActivateMenuVersionResult) GetSuccess() *identity_srv.ActivateMenuVersionResponse {
 	if !p.IsSetSuccess() {
-		return GetLastUserRoleAssignmentResult_Success_DEFAULT
+		return ActivateMenuVersionResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetLastUserRoleAssignmentResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.GetLastUserRoleAssignmentResponse)
+func (p *ActivateMenuVersionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.ActivateMenuVersionResponse)
 }
 
-func (p *GetLastUserRoleAssignmentResult) IsSetSuccess() bool {
+func (p *ActivateMenuVersionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetLastUserRoleAssignmentResult) GetResult() interface{} {
+func (p *ActivateMenuVersionResult) GetResult() interface{} {
 	return p.Success
 }
 
-func listUserRoleAssignmentsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func configureRoleMenusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.UserRoleQueryRequest)
+		req := new(identity_srv.ConfigureRoleMenusRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).ListUserRoleAssignments(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).ConfigureRoleMenus(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *ListUserRoleAssignmentsArgs:
-		success, err := handler.(identity_srv.IdentityService).ListUserRoleAssignments(ctx, s.Req)
+	case *ConfigureRoleMenusArgs:
+		success, err := handler.(identity_srv.IdentityService).ConfigureRoleMenus(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*ListUserRoleAssignmentsResult)
+		realResult := result.(*ConfigureRoleMenusResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newListUserRoleAssignmentsArgs() interface{} {
-	return &ListUserRoleAssignmentsArgs{}
+func newConfigureRoleMenusArgs() interface{} {
+	return &ConfigureRoleMenusArgs{}
 }
 
-func newListUserRoleAssignmentsResult() interface{} {
-	return &ListUserRoleAssignmentsResult{}
+func newConfigureRoleMenusResult() interface{} {
+	return &ConfigureRoleMenusResult{}
 }
 
-type ListUserRoleAssignmentsArgs struct {
-	Req *identity_srv.UserRoleQueryRequest
+type ConfigureRoleMenusArgs struct {
+	Req *identity_srv.ConfigureRoleMenusRequest
 }
 
-func (p *ListUserRoleAssignmentsArgs) Marshal(out []byte) ([]byte, error) {
+func (p *ConfigureRoleMenusArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *ListUserRoleAssignmentsArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.UserRoleQueryRequest)
+func (p *ConfigureRoleMenusArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ConfigureRoleMenusRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -5188,38 +9082,38 @@ func (p *ListUserRoleAssignmentsArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var ListUserRoleAssignmentsArgs_Req_DEFAULT *identity_srv.UserRoleQueryRequest
+var ConfigureRoleMenusArgs_Req_DEFAULT *identity_srv.ConfigureRoleMenusRequest
 
-func (p *ListUserRoleAssignmentsArgs) GetReq() *identity_srv.UserRoleQueryRequest {
+func (p *ConfigureRoleMenusArgs) GetReq() *identity_srv.ConfigureRoleMenusRequest {
 	if !p.IsSetReq() {
-		return ListUserRoleAssignmentsArgs_Req_DEFAULT
+		return ConfigureRoleMenusArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *ListUserRoleAssignmentsArgs) IsSetReq() bool {
+func (p *ConfigureRoleMenusArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ListUserRoleAssignmentsArgs) GetFirstArgument() interface{} {
+func (p *ConfigureRoleMenusArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type ListUserRoleAssignmentsResult struct {
-	Success *identity_srv.UserRoleListResponse
+type ConfigureRoleMenusResult struct {
+	Success *identity_srv.ConfigureRoleMenusResponse
 }
 
-var ListUserRoleAssignmentsResult_Success_DEFAULT *identity_srv.UserRoleListResponse
+var ConfigureRoleMenusResult_Success_DEFAULT *identity_srv.ConfigureRoleMenusResponse
 
-func (p *ListUserRoleAssignmentsResult) Marshal(out []byte) ([]byte, error) {
+func (p *ConfigureRoleMenusResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *ListUserRoleAssignmentsResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.UserRoleListResponse)
+func (p *ConfigureRoleMenusResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ConfigureRoleMenusResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -5227,71 +9121,71 @@ func (p *ListUserRoleAssignmentsResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *ListUserRoleAssignmentsResult) GetSuccess() *identity_srv.UserRoleListResponse {
+func (p *ConfigureRoleMenusResult) GetSuccess() *identity_srv.ConfigureRoleMenusResponse {
 	if !p.IsSetSuccess() {
-		return ListUserRoleAssignmentsResult_Success_DEFAULT
+		return ConfigureRoleMenusResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *ListUserRoleAssignmentsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.UserRoleListResponse)
+func (p *ConfigureRoleMenusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.ConfigureRoleMenusResponse)
 }
 
-func (p *ListUserRoleAssignmentsResult) IsSetSuccess() bool {
+func (p *ConfigureRoleMenusResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ListUserRoleAssignmentsResult) GetResult() interface{} {
+func (p *ConfigureRoleMenusResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getUsersByRoleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func getRoleMenuTreeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.GetUsersByRoleRequest)
+		req := new(identity_srv.GetRoleMenuTreeRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).GetUsersByRole(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).GetRoleMenuTree(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *GetUsersByRoleArgs:
-		success, err := handler.(identity_srv.IdentityService).GetUsersByRole(ctx, s.Req)
+	case *GetRoleMenuTreeArgs:
+		success, err := handler.(identity_srv.IdentityService).GetRoleMenuTree(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetUsersByRoleResult)
+		realResult := result.(*GetRoleMenuTreeResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetUsersByRoleArgs() interface{} {
-	return &GetUsersByRoleArgs{}
+func newGetRoleMenuTreeArgs() interface{} {
+	return &GetRoleMenuTreeArgs{}
 }
 
-func newGetUsersByRoleResult() interface{} {
-	return &GetUsersByRoleResult{}
+func newGetRoleMenuTreeResult() interface{} {
+	return &GetRoleMenuTreeResult{}
 }
 
-type GetUsersByRoleArgs struct {
-	Req *identity_srv.GetUsersByRoleRequest
+type GetRoleMenuTreeArgs struct {
+	Req *identity_srv.GetRoleMenuTreeRequest
 }
 
-func (p *GetUsersByRoleArgs) Marshal(out []byte) ([]byte, error) {
+func (p *GetRoleMenuTreeArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetUsersByRoleArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetUsersByRoleRequest)
+func (p *GetRoleMenuTreeArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetRoleMenuTreeRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -5299,38 +9193,38 @@ func (p *GetUsersByRoleArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetUsersByRoleArgs_Req_DEFAULT *identity_srv.GetUsersByRoleRequest
+var GetRoleMenuTreeArgs_Req_DEFAULT *identity_srv.GetRoleMenuTreeRequest
 
-func (p *GetUsersByRoleArgs) GetReq() *identity_srv.GetUsersByRoleRequest {
+func (p *GetRoleMenuTreeArgs) GetReq() *identity_srv.GetRoleMenuTreeRequest {
 	if !p.IsSetReq() {
-		return GetUsersByRoleArgs_Req_DEFAULT
+		return GetRoleMenuTreeArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetUsersByRoleArgs) IsSetReq() bool {
+func (p *GetRoleMenuTreeArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetUsersByRoleArgs) GetFirstArgument() interface{} {
+func (p *GetRoleMenuTreeArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetUsersByRoleResult struct {
-	Success *identity_srv.GetUsersByRoleResponse
+type GetRoleMenuTreeResult struct {
+	Success *identity_srv.GetRoleMenuTreeResponse
 }
 
-var GetUsersByRoleResult_Success_DEFAULT *identity_srv.GetUsersByRoleResponse
+var GetRoleMenuTreeResult_Success_DEFAULT *identity_srv.GetRoleMenuTreeResponse
 
-func (p *GetUsersByRoleResult) Marshal(out []byte) ([]byte, error) {
+func (p *GetRoleMenuTreeResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetUsersByRoleResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetUsersByRoleResponse)
+func (p *GetRoleMenuTreeResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetRoleMenuTreeResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -5338,71 +9232,71 @@ func (p *GetUsersByRoleResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetUsersByRoleResult) GetSuccess() *identity_srv.GetUsersByRoleResponse {
+func (p *GetRoleMenuTreeResult) GetSuccess() *identity_srv.GetRoleMenuTreeResponse {
 	if !p.IsSetSuccess() {
-		return GetUsersByRoleResult_Success_DEFAULT
+		return GetRoleMenuTreeResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetUsersByRoleResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.GetUsersByRoleResponse)
+func (p *GetRoleMenuTreeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetRoleMenuTreeResponse)
 }
 
-func (p *GetUsersByRoleResult) IsSetSuccess() bool {
+func (p *GetRoleMenuTreeResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetUsersByRoleResult) GetResult() interface{} {
+func (p *GetRoleMenuTreeResult) GetResult() interface{} {
 	return p.Success
 }
 
-func batchBindUsersToRoleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func getUserMenuTreeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.BatchBindUsersToRoleRequest)
+		req := new(identity_srv.GetUserMenuTreeRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).BatchBindUsersToRole(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).GetUserMenuTree(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *BatchBindUsersToRoleArgs:
-		success, err := handler.(identity_srv.IdentityService).BatchBindUsersToRole(ctx, s.Req)
+	case *GetUserMenuTreeArgs:
+		success, err := handler.(identity_srv.IdentityService).GetUserMenuTree(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*BatchBindUsersToRoleResult)
+		realResult := result.(*GetUserMenuTreeResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newBatchBindUsersToRoleArgs() interface{} {
-	return &BatchBindUsersToRoleArgs{}
+func newGetUserMenuTreeArgs() interface{} {
+	return &GetUserMenuTreeArgs{}
 }
 
-func newBatchBindUsersToRoleResult() interface{} {
-	return &BatchBindUsersToRoleResult{}
+func newGetUserMenuTreeResult() interface{} {
+	return &GetUserMenuTreeResult{}
 }
 
-type BatchBindUsersToRoleArgs struct {
-	Req *identity_srv.BatchBindUsersToRoleRequest
+type GetUserMenuTreeArgs struct {
+	Req *identity_srv.GetUserMenuTreeRequest
 }
 
-func (p *BatchBindUsersToRoleArgs) Marshal(out []byte) ([]byte, error) {
+func (p *GetUserMenuTreeArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *BatchBindUsersToRoleArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.BatchBindUsersToRoleRequest)
+func (p *GetUserMenuTreeArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetUserMenuTreeRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -5410,38 +9304,38 @@ func (p *BatchBindUsersToRoleArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var BatchBindUsersToRoleArgs_Req_DEFAULT *identity_srv.BatchBindUsersToRoleRequest
+var GetUserMenuTreeArgs_Req_DEFAULT *identity_srv.GetUserMenuTreeRequest
 
-func (p *BatchBindUsersToRoleArgs) GetReq() *identity_srv.BatchBindUsersToRoleRequest {
+func (p *GetUserMenuTreeArgs) GetReq() *identity_srv.GetUserMenuTreeRequest {
 	if !p.IsSetReq() {
-		return BatchBindUsersToRoleArgs_Req_DEFAULT
+		return GetUserMenuTreeArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *BatchBindUsersToRoleArgs) IsSetReq() bool {
+func (p *GetUserMenuTreeArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *BatchBindUsersToRoleArgs) GetFirstArgument() interface{} {
+func (p *GetUserMenuTreeArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type BatchBindUsersToRoleResult struct {
-	Success *identity_srv.BatchBindUsersToRoleResponse
+type GetUserMenuTreeResult struct {
+	Success *identity_srv.GetUserMenuTreeResponse
 }
 
-var BatchBindUsersToRoleResult_Success_DEFAULT *identity_srv.BatchBindUsersToRoleResponse
+var GetUserMenuTreeResult_Success_DEFAULT *identity_srv.GetUserMenuTreeResponse
 
-func (p *BatchBindUsersToRoleResult) Marshal(out []byte) ([]byte, error) {
+func (p *GetUserMenuTreeResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *BatchBindUsersToRoleResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.BatchBindUsersToRoleResponse)
+func (p *GetUserMenuTreeResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetUserMenuTreeResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -5449,71 +9343,71 @@ func (p *BatchBindUsersToRoleResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *BatchBindUsersToRoleResult) GetSuccess() *identity_srv.BatchBindUsersToRoleResponse {
+func (p *GetUserMenuTreeResult) GetSuccess() *identity_srv.GetUserMenuTreeResponse {
 	if !p.IsSetSuccess() {
-		return BatchBindUsersToRoleResult_Success_DEFAULT
+		return GetUserMenuTreeResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *BatchBindUsersToRoleResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.BatchBindUsersToRoleResponse)
+func (p *GetUserMenuTreeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetUserMenuTreeResponse)
 }
 
-func (p *BatchBindUsersToRoleResult) IsSetSuccess() bool {
+func (p *GetUserMenuTreeResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *BatchBindUsersToRoleResult) GetResult() interface{} {
+func (p *GetUserMenuTreeResult) GetResult() interface{} {
 	return p.Success
 }
 
-func batchGetUserRolesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func getRoleMenuPermissionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.BatchGetUserRolesRequest)
+		req := new(identity_srv.GetRoleMenuPermissionsRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).BatchGetUserRoles(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).GetRoleMenuPermissions(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *BatchGetUserRolesArgs:
-		success, err := handler.(identity_srv.IdentityService).BatchGetUserRoles(ctx, s.Req)
+	case *GetRoleMenuPermissionsArgs:
+		success, err := handler.(identity_srv.IdentityService).GetRoleMenuPermissions(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*BatchGetUserRolesResult)
+		realResult := result.(*GetRoleMenuPermissionsResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newBatchGetUserRolesArgs() interface{} {
-	return &BatchGetUserRolesArgs{}
+func newGetRoleMenuPermissionsArgs() interface{} {
+	return &GetRoleMenuPermissionsArgs{}
 }
 
-func newBatchGetUserRolesResult() interface{} {
-	return &BatchGetUserRolesResult{}
+func newGetRoleMenuPermissionsResult() interface{} {
+	return &GetRoleMenuPermissionsResult{}
 }
 
-type BatchGetUserRolesArgs struct {
-	Req *identity_srv.BatchGetUserRolesRequest
+type GetRoleMenuPermissionsArgs struct {
+	Req *identity_srv.GetRoleMenuPermissionsRequest
 }
 
-func (p *BatchGetUserRolesArgs) Marshal(out []byte) ([]byte, error) {
+func (p *GetRoleMenuPermissionsArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *BatchGetUserRolesArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.BatchGetUserRolesRequest)
+func (p *GetRoleMenuPermissionsArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetRoleMenuPermissionsRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -5521,38 +9415,38 @@ func (p *BatchGetUserRolesArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var BatchGetUserRolesArgs_Req_DEFAULT *identity_srv.BatchGetUserRolesRequest
+var GetRoleMenuPermissionsArgs_Req_DEFAULT *identity_srv.GetRoleMenuPermissionsRequest
 
-func (p *BatchGetUserRolesArgs) GetReq() *identity_srv.BatchGetUserRolesRequest {
+func (p *GetRoleMenuPermissionsArgs) GetReq() *identity_srv.GetRoleMenuPermissionsRequest {
 	if !p.IsSetReq() {
-		return BatchGetUserRolesArgs_Req_DEFAULT
+		return GetRoleMenuPermissionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *BatchGetUserRolesArgs) IsSetReq() bool {
+func (p *GetRoleMenuPermissionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *BatchGetUserRolesArgs) GetFirstArgument() interface{} {
+func (p *GetRoleMenuPermissionsArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type BatchGetUserRolesResult struct {
-	Success *identity_srv.BatchGetUserRolesResponse
+type GetRoleMenuPermissionsResult struct {
+	Success *identity_srv.GetRoleMenuPermissionsResponse
 }
 
-var BatchGetUserRolesResult_Success_DEFAULT *identity_srv.BatchGetUserRolesResponse
+var GetRoleMenuPermissionsResult_Success_DEFAULT *identity_srv.GetRoleMenuPermissionsResponse
 
-func (p *BatchGetUserRolesResult) Marshal(out []byte) ([]byte, error) {
+func (p *GetRoleMenuPermissionsResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *BatchGetUserRolesResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.BatchGetUserRolesResponse)
+func (p *GetRoleMenuPermissionsResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetRoleMenuPermissionsResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -5560,71 +9454,71 @@ func (p *BatchGetUserRolesResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *BatchGetUserRolesResult) GetSuccess() *identity_srv.BatchGetUserRolesResponse {
+func (p *GetRoleMenuPermissionsResult) GetSuccess() *identity_srv.GetRoleMenuPermissionsResponse {
 	if !p.IsSetSuccess() {
-		return BatchGetUserRolesResult_Success_DEFAULT
+		return GetRoleMenuPermissionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *BatchGetUserRolesResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.BatchGetUserRolesResponse)
+func (p *GetRoleMenuPermissionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetRoleMenuPermissionsResponse)
 }
 
-func (p *BatchGetUserRolesResult) IsSetSuccess() bool {
+func (p *GetRoleMenuPermissionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *BatchGetUserRolesResult) GetResult() interface{} {
+func (p *GetRoleMenuPermissionsResult) GetResult() interface{} {
 	return p.Success
 }
 
-func uploadMenuHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func hasMenuPermissionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.UploadMenuRequest)
+		req := new(identity_srv.HasMenuPermissionRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).UploadMenu(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).HasMenuPermission(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *UploadMenuArgs:
-		success, err := handler.(identity_srv.IdentityService).UploadMenu(ctx, s.Req)
+	case *HasMenuPermissionArgs:
+		success, err := handler.(identity_srv.IdentityService).HasMenuPermission(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*UploadMenuResult)
+		realResult := result.(*HasMenuPermissionResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newUploadMenuArgs() interface{} {
-	return &UploadMenuArgs{}
+func newHasMenuPermissionArgs() interface{} {
+	return &HasMenuPermissionArgs{}
 }
 
-func newUploadMenuResult() interface{} {
-	return &UploadMenuResult{}
+func newHasMenuPermissionResult() interface{} {
+	return &HasMenuPermissionResult{}
 }
 
-type UploadMenuArgs struct {
-	Req *identity_srv.UploadMenuRequest
+type HasMenuPermissionArgs struct {
+	Req *identity_srv.HasMenuPermissionRequest
 }
 
-func (p *UploadMenuArgs) Marshal(out []byte) ([]byte, error) {
+func (p *HasMenuPermissionArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *UploadMenuArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.UploadMenuRequest)
+func (p *HasMenuPermissionArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.HasMenuPermissionRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -5632,38 +9526,38 @@ func (p *UploadMenuArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var UploadMenuArgs_Req_DEFAULT *identity_srv.UploadMenuRequest
+var HasMenuPermissionArgs_Req_DEFAULT *identity_srv.HasMenuPermissionRequest
 
-func (p *UploadMenuArgs) GetReq() *identity_srv.UploadMenuRequest {
+func (p *HasMenuPermissionArgs) GetReq() *identity_srv.HasMenuPermissionRequest {
 	if !p.IsSetReq() {
-		return UploadMenuArgs_Req_DEFAULT
+		return HasMenuPermissionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *UploadMenuArgs) IsSetReq() bool {
+func (p *HasMenuPermissionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UploadMenuArgs) GetFirstArgument() interface{} {
+func (p *HasMenuPermissionArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type UploadMenuResult struct {
-	Success *identity_srv.UploadMenuResponse
+type HasMenuPermissionResult struct {
+	Success *identity_srv.HasMenuPermissionResponse
 }
 
-var UploadMenuResult_Success_DEFAULT *identity_srv.UploadMenuResponse
+var HasMenuPermissionResult_Success_DEFAULT *identity_srv.HasMenuPermissionResponse
 
-func (p *UploadMenuResult) Marshal(out []byte) ([]byte, error) {
+func (p *HasMenuPermissionResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *UploadMenuResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.UploadMenuResponse)
+func (p *HasMenuPermissionResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.HasMenuPermissionResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -5671,71 +9565,71 @@ func (p *UploadMenuResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *UploadMenuResult) GetSuccess() *identity_srv.UploadMenuResponse {
+func (p *HasMenuPermissionResult) GetSuccess() *identity_srv.HasMenuPermissionResponse {
 	if !p.IsSetSuccess() {
-		return UploadMenuResult_Success_DEFAULT
+		return HasMenuPermissionResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *UploadMenuResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.UploadMenuResponse)
+func (p *HasMenuPermissionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.HasMenuPermissionResponse)
 }
 
-func (p *UploadMenuResult) IsSetSuccess() bool {
+func (p *HasMenuPermissionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UploadMenuResult) GetResult() interface{} {
+func (p *HasMenuPermissionResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getMenuTreeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func getUserMenuPermissionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.GetMenuTreeRequest)
+		req := new(identity_srv.GetUserMenuPermissionsRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).GetMenuTree(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).GetUserMenuPermissions(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *GetMenuTreeArgs:
-		success, err := handler.(identity_srv.IdentityService).GetMenuTree(ctx, s.Req)
+	case *GetUserMenuPermissionsArgs:
+		success, err := handler.(identity_srv.IdentityService).GetUserMenuPermissions(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetMenuTreeResult)
+		realResult := result.(*GetUserMenuPermissionsResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetMenuTreeArgs() interface{} {
-	return &GetMenuTreeArgs{}
+func newGetUserMenuPermissionsArgs() interface{} {
+	return &GetUserMenuPermissionsArgs{}
 }
 
-func newGetMenuTreeResult() interface{} {
-	return &GetMenuTreeResult{}
+func newGetUserMenuPermissionsResult() interface{} {
+	return &GetUserMenuPermissionsResult{}
 }
 
-type GetMenuTreeArgs struct {
-	Req *identity_srv.GetMenuTreeRequest
+type GetUserMenuPermissionsArgs struct {
+	Req *identity_srv.GetUserMenuPermissionsRequest
 }
 
-func (p *GetMenuTreeArgs) Marshal(out []byte) ([]byte, error) {
+func (p *GetUserMenuPermissionsArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetMenuTreeArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetMenuTreeRequest)
+func (p *GetUserMenuPermissionsArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetUserMenuPermissionsRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -5743,38 +9637,38 @@ func (p *GetMenuTreeArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetMenuTreeArgs_Req_DEFAULT *identity_srv.GetMenuTreeRequest
+var GetUserMenuPermissionsArgs_Req_DEFAULT *identity_srv.GetUserMenuPermissionsRequest
 
-func (p *GetMenuTreeArgs) GetReq() *identity_srv.GetMenuTreeRequest {
+func (p *GetUserMenuPermissionsArgs) GetReq() *identity_srv.GetUserMenuPermissionsRequest {
 	if !p.IsSetReq() {
-		return GetMenuTreeArgs_Req_DEFAULT
+		return GetUserMenuPermissionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetMenuTreeArgs) IsSetReq() bool {
+func (p *GetUserMenuPermissionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetMenuTreeArgs) GetFirstArgument() interface{} {
+func (p *GetUserMenuPermissionsArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetMenuTreeResult struct {
-	Success *identity_srv.GetMenuTreeResponse
+type GetUserMenuPermissionsResult struct {
+	Success *identity_srv.GetUserMenuPermissionsResponse
 }
 
-var GetMenuTreeResult_Success_DEFAULT *identity_srv.GetMenuTreeResponse
+var GetUserMenuPermissionsResult_Success_DEFAULT *identity_srv.GetUserMenuPermissionsResponse
 
-func (p *GetMenuTreeResult) Marshal(out []byte) ([]byte, error) {
+func (p *GetUserMenuPermissionsResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetMenuTreeResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetMenuTreeResponse)
+func (p *GetUserMenuPermissionsResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetUserMenuPermissionsResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -5782,71 +9676,71 @@ func (p *GetMenuTreeResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetMenuTreeResult) GetSuccess() *identity_srv.GetMenuTreeResponse {
+func (p *GetUserMenuPermissionsResult) GetSuccess() *identity_srv.GetUserMenuPermissionsResponse {
 	if !p.IsSetSuccess() {
-		return GetMenuTreeResult_Success_DEFAULT
+		return GetUserMenuPermissionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetMenuTreeResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.GetMenuTreeResponse)
+func (p *GetUserMenuPermissionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetUserMenuPermissionsResponse)
 }
 
-func (p *GetMenuTreeResult) IsSetSuccess() bool {
+func (p *GetUserMenuPermissionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetMenuTreeResult) GetResult() interface{} {
+func (p *GetUserMenuPermissionsResult) GetResult() interface{} {
 	return p.Success
 }
 
-func configureRoleMenusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func getPermissionMatrixHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.ConfigureRoleMenusRequest)
+		req := new(identity_srv.GetPermissionMatrixRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).ConfigureRoleMenus(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).GetPermissionMatrix(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *ConfigureRoleMenusArgs:
-		success, err := handler.(identity_srv.IdentityService).ConfigureRoleMenus(ctx, s.Req)
+	case *GetPermissionMatrixArgs:
+		success, err := handler.(identity_srv.IdentityService).GetPermissionMatrix(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*ConfigureRoleMenusResult)
+		realResult := result.(*GetPermissionMatrixResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newConfigureRoleMenusArgs() interface{} {
-	return &ConfigureRoleMenusArgs{}
+func newGetPermissionMatrixArgs() interface{} {
+	return &GetPermissionMatrixArgs{}
 }
 
-func newConfigureRoleMenusResult() interface{} {
-	return &ConfigureRoleMenusResult{}
+func newGetPermissionMatrixResult() interface{} {
+	return &GetPermissionMatrixResult{}
 }
 
-type ConfigureRoleMenusArgs struct {
-	Req *identity_srv.ConfigureRoleMenusRequest
+type GetPermissionMatrixArgs struct {
+	Req *identity_srv.GetPermissionMatrixRequest
 }
 
-func (p *ConfigureRoleMenusArgs) Marshal(out []byte) ([]byte, error) {
+func (p *GetPermissionMatrixArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *ConfigureRoleMenusArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.ConfigureRoleMenusRequest)
+func (p *GetPermissionMatrixArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetPermissionMatrixRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -5854,38 +9748,38 @@ func (p *ConfigureRoleMenusArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var ConfigureRoleMenusArgs_Req_DEFAULT *identity_srv.ConfigureRoleMenusRequest
+var GetPermissionMatrixArgs_Req_DEFAULT *identity_srv.GetPermissionMatrixRequest
 
-func (p *ConfigureRoleMenusArgs) GetReq() *identity_srv.ConfigureRoleMenusRequest {
+func (p *GetPermissionMatrixArgs) GetReq() *identity_srv.GetPermissionMatrixRequest {
 	if !p.IsSetReq() {
-		return ConfigureRoleMenusArgs_Req_DEFAULT
+		return GetPermissionMatrixArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *ConfigureRoleMenusArgs) IsSetReq() bool {
+func (p *GetPermissionMatrixArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ConfigureRoleMenusArgs) GetFirstArgument() interface{} {
+func (p *GetPermissionMatrixArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type ConfigureRoleMenusResult struct {
-	Success *identity_srv.ConfigureRoleMenusResponse
+type GetPermissionMatrixResult struct {
+	Success *identity_srv.GetPermissionMatrixResponse
 }
 
-var ConfigureRoleMenusResult_Success_DEFAULT *identity_srv.ConfigureRoleMenusResponse
+var GetPermissionMatrixResult_Success_DEFAULT *identity_srv.GetPermissionMatrixResponse
 
-func (p *ConfigureRoleMenusResult) Marshal(out []byte) ([]byte, error) {
+func (p *GetPermissionMatrixResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *ConfigureRoleMenusResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.ConfigureRoleMenusResponse)
+func (p *GetPermissionMatrixResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetPermissionMatrixResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -5893,71 +9787,71 @@ func (p *ConfigureRoleMenusResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *ConfigureRoleMenusResult) GetSuccess() *identity_srv.ConfigureRoleMenusResponse {
+func (p *GetPermissionMatrixResult) GetSuccess() *identity_srv.GetPermissionMatrixResponse {
 	if !p.IsSetSuccess() {
-		return ConfigureRoleMenusResult_Success_DEFAULT
+		return GetPermissionMatrixResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *ConfigureRoleMenusResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.ConfigureRoleMenusResponse)
+func (p *GetPermissionMatrixResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetPermissionMatrixResponse)
 }
 
-func (p *ConfigureRoleMenusResult) IsSetSuccess() bool {
+func (p *GetPermissionMatrixResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ConfigureRoleMenusResult) GetResult() interface{} {
+func (p *GetPermissionMatrixResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getRoleMenuTreeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func getUserEffectivePermissionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.GetRoleMenuTreeRequest)
+		req := new(identity_srv.GetUserEffectivePermissionsRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).GetRoleMenuTree(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).GetUserEffectivePermissions(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *GetRoleMenuTreeArgs:
-		success, err := handler.(identity_srv.IdentityService).GetRoleMenuTree(ctx, s.Req)
+	case *GetUserEffectivePermissionsArgs:
+		success, err := handler.(identity_srv.IdentityService).GetUserEffectivePermissions(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetRoleMenuTreeResult)
+		realResult := result.(*GetUserEffectivePermissionsResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetRoleMenuTreeArgs() interface{} {
-	return &GetRoleMenuTreeArgs{}
+func newGetUserEffectivePermissionsArgs() interface{} {
+	return &GetUserEffectivePermissionsArgs{}
 }
 
-func newGetRoleMenuTreeResult() interface{} {
-	return &GetRoleMenuTreeResult{}
+func newGetUserEffectivePermissionsResult() interface{} {
+	return &GetUserEffectivePermissionsResult{}
 }
 
-type GetRoleMenuTreeArgs struct {
-	Req *identity_srv.GetRoleMenuTreeRequest
+type GetUserEffectivePermissionsArgs struct {
+	Req *identity_srv.GetUserEffectivePermissionsRequest
 }
 
-func (p *GetRoleMenuTreeArgs) Marshal(out []byte) ([]byte, error) {
+func (p *GetUserEffectivePermissionsArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetRoleMenuTreeArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetRoleMenuTreeRequest)
+func (p *GetUserEffectivePermissionsArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetUserEffectivePermissionsRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -5965,38 +9859,38 @@ func (p *GetRoleMenuTreeArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetRoleMenuTreeArgs_Req_DEFAULT *identity_srv.GetRoleMenuTreeRequest
+var GetUserEffectivePermissionsArgs_Req_DEFAULT *identity_srv.GetUserEffectivePermissionsRequest
 
-func (p *GetRoleMenuTreeArgs) GetReq() *identity_srv.GetRoleMenuTreeRequest {
+func (p *GetUserEffectivePermissionsArgs) GetReq() *identity_srv.GetUserEffectivePermissionsRequest {
 	if !p.IsSetReq() {
-		return GetRoleMenuTreeArgs_Req_DEFAULT
+		return GetUserEffectivePermissionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetRoleMenuTreeArgs) IsSetReq() bool {
+func (p *GetUserEffectivePermissionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetRoleMenuTreeArgs) GetFirstArgument() interface{} {
+func (p *GetUserEffectivePermissionsArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetRoleMenuTreeResult struct {
-	Success *identity_srv.GetRoleMenuTreeResponse
+type GetUserEffectivePermissionsResult struct {
+	Success *identity_srv.GetUserEffectivePermissionsResponse
 }
 
-var GetRoleMenuTreeResult_Success_DEFAULT *identity_srv.GetRoleMenuTreeResponse
+var GetUserEffectivePermissionsResult_Success_DEFAULT *identity_srv.GetUserEffectivePermissionsResponse
 
-func (p *GetRoleMenuTreeResult) Marshal(out []byte) ([]byte, error) {
+func (p *GetUserEffectivePermissionsResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetRoleMenuTreeResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetRoleMenuTreeResponse)
+func (p *GetUserEffectivePermissionsResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.GetUserEffectivePermissionsResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -6004,71 +9898,71 @@ func (p *GetRoleMenuTreeResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetRoleMenuTreeResult) GetSuccess() *identity_srv.GetRoleMenuTreeResponse {
+func (p *GetUserEffectivePermissionsResult) GetSuccess() *identity_srv.GetUserEffectivePermissionsResponse {
 	if !p.IsSetSuccess() {
-		return GetRoleMenuTreeResult_Success_DEFAULT
+		return GetUserEffectivePermissionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetRoleMenuTreeResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.GetRoleMenuTreeResponse)
+func (p *GetUserEffectivePermissionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.GetUserEffectivePermissionsResponse)
 }
 
-func (p *GetRoleMenuTreeResult) IsSetSuccess() bool {
+func (p *GetUserEffectivePermissionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetRoleMenuTreeResult) GetResult() interface{} {
+func (p *GetUserEffectivePermissionsResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getUserMenuTreeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func exportPermissionReportHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.GetUserMenuTreeRequest)
+		req := new(identity_srv.ExportPermissionReportRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).GetUserMenuTree(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).ExportPermissionReport(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *GetUserMenuTreeArgs:
-		success, err := handler.(identity_srv.IdentityService).GetUserMenuTree(ctx, s.Req)
+	case *ExportPermissionReportArgs:
+		success, err := handler.(identity_srv.IdentityService).ExportPermissionReport(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetUserMenuTreeResult)
+		realResult := result.(*ExportPermissionReportResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetUserMenuTreeArgs() interface{} {
-	return &GetUserMenuTreeArgs{}
+func newExportPermissionReportArgs() interface{} {
+	return &ExportPermissionReportArgs{}
 }
 
-func newGetUserMenuTreeResult() interface{} {
-	return &GetUserMenuTreeResult{}
+func newExportPermissionReportResult() interface{} {
+	return &ExportPermissionReportResult{}
 }
 
-type GetUserMenuTreeArgs struct {
-	Req *identity_srv.GetUserMenuTreeRequest
+type ExportPermissionReportArgs struct {
+	Req *identity_srv.ExportPermissionReportRequest
 }
 
-func (p *GetUserMenuTreeArgs) Marshal(out []byte) ([]byte, error) {
+func (p *ExportPermissionReportArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetUserMenuTreeArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetUserMenuTreeRequest)
+func (p *ExportPermissionReportArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ExportPermissionReportRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -6076,38 +9970,38 @@ func (p *GetUserMenuTreeArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetUserMenuTreeArgs_Req_DEFAULT *identity_srv.GetUserMenuTreeRequest
+var ExportPermissionReportArgs_Req_DEFAULT *identity_srv.ExportPermissionReportRequest
 
-func (p *GetUserMenuTreeArgs) GetReq() *identity_srv.GetUserMenuTreeRequest {
+func (p *ExportPermissionReportArgs) GetReq() *identity_srv.ExportPermissionReportRequest {
 	if !p.IsSetReq() {
-		return GetUserMenuTreeArgs_Req_DEFAULT
+		return ExportPermissionReportArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetUserMenuTreeArgs) IsSetReq() bool {
+func (p *ExportPermissionReportArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetUserMenuTreeArgs) GetFirstArgument() interface{} {
+func (p *ExportPermissionReportArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetUserMenuTreeResult struct {
-	Success *identity_srv.GetUserMenuTreeResponse
+type ExportPermissionReportResult struct {
+	Success *identity_srv.ExportPermissionReportResponse
 }
 
-var GetUserMenuTreeResult_Success_DEFAULT *identity_srv.GetUserMenuTreeResponse
+var ExportPermissionReportResult_Success_DEFAULT *identity_srv.ExportPermissionReportResponse
 
-func (p *GetUserMenuTreeResult) Marshal(out []byte) ([]byte, error) {
+func (p *ExportPermissionReportResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetUserMenuTreeResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetUserMenuTreeResponse)
+func (p *ExportPermissionReportResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ExportPermissionReportResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -6115,71 +10009,71 @@ func (p *GetUserMenuTreeResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetUserMenuTreeResult) GetSuccess() *identity_srv.GetUserMenuTreeResponse {
+func (p *ExportPermissionReportResult) GetSuccess() *identity_srv.ExportPermissionReportResponse {
 	if !p.IsSetSuccess() {
-		return GetUserMenuTreeResult_Success_DEFAULT
+		return ExportPermissionReportResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetUserMenuTreeResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.GetUserMenuTreeResponse)
+func (p *ExportPermissionReportResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.ExportPermissionReportResponse)
 }
 
-func (p *GetUserMenuTreeResult) IsSetSuccess() bool {
+func (p *ExportPermissionReportResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetUserMenuTreeResult) GetResult() interface{} {
+func (p *ExportPermissionReportResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getRoleMenuPermissionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func createAuditLogHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.GetRoleMenuPermissionsRequest)
+		req := new(identity_srv.CreateAuditLogRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).GetRoleMenuPermissions(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).CreateAuditLog(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *GetRoleMenuPermissionsArgs:
-		success, err := handler.(identity_srv.IdentityService).GetRoleMenuPermissions(ctx, s.Req)
+	case *CreateAuditLogArgs:
+		success, err := handler.(identity_srv.IdentityService).CreateAuditLog(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetRoleMenuPermissionsResult)
+		realResult := result.(*CreateAuditLogResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetRoleMenuPermissionsArgs() interface{} {
-	return &GetRoleMenuPermissionsArgs{}
+func newCreateAuditLogArgs() interface{} {
+	return &CreateAuditLogArgs{}
 }
 
-func newGetRoleMenuPermissionsResult() interface{} {
-	return &GetRoleMenuPermissionsResult{}
+func newCreateAuditLogResult() interface{} {
+	return &CreateAuditLogResult{}
 }
 
-type GetRoleMenuPermissionsArgs struct {
-	Req *identity_srv.GetRoleMenuPermissionsRequest
+type CreateAuditLogArgs struct {
+	Req *identity_srv.CreateAuditLogRequest
 }
 
-func (p *GetRoleMenuPermissionsArgs) Marshal(out []byte) ([]byte, error) {
+func (p *CreateAuditLogArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetRoleMenuPermissionsArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetRoleMenuPermissionsRequest)
+func (p *CreateAuditLogArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.CreateAuditLogRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -6187,38 +10081,38 @@ func (p *GetRoleMenuPermissionsArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetRoleMenuPermissionsArgs_Req_DEFAULT *identity_srv.GetRoleMenuPermissionsRequest
+var CreateAuditLogArgs_Req_DEFAULT *identity_srv.CreateAuditLogRequest
 
-func (p *GetRoleMenuPermissionsArgs) GetReq() *identity_srv.GetRoleMenuPermissionsRequest {
+func (p *CreateAuditLogArgs) GetReq() *identity_srv.CreateAuditLogRequest {
 	if !p.IsSetReq() {
-		return GetRoleMenuPermissionsArgs_Req_DEFAULT
+		return CreateAuditLogArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetRoleMenuPermissionsArgs) IsSetReq() bool {
+func (p *CreateAuditLogArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetRoleMenuPermissionsArgs) GetFirstArgument() interface{} {
+func (p *CreateAuditLogArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetRoleMenuPermissionsResult struct {
-	Success *identity_srv.GetRoleMenuPermissionsResponse
+type CreateAuditLogResult struct {
+	Success *identity_srv.CreateAuditLogResponse
 }
 
-var GetRoleMenuPermissionsResult_Success_DEFAULT *identity_srv.GetRoleMenuPermissionsResponse
+var CreateAuditLogResult_Success_DEFAULT *identity_srv.CreateAuditLogResponse
 
-func (p *GetRoleMenuPermissionsResult) Marshal(out []byte) ([]byte, error) {
+func (p *CreateAuditLogResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetRoleMenuPermissionsResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetRoleMenuPermissionsResponse)
+func (p *CreateAuditLogResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.CreateAuditLogResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -6226,71 +10120,71 @@ func (p *GetRoleMenuPermissionsResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetRoleMenuPermissionsResult) GetSuccess() *identity_srv.GetRoleMenuPermissionsResponse {
+func (p *CreateAuditLogResult) GetSuccess() *identity_srv.CreateAuditLogResponse {
 	if !p.IsSetSuccess() {
-		return GetRoleMenuPermissionsResult_Success_DEFAULT
+		return CreateAuditLogResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetRoleMenuPermissionsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.GetRoleMenuPermissionsResponse)
+func (p *CreateAuditLogResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.CreateAuditLogResponse)
 }
 
-func (p *GetRoleMenuPermissionsResult) IsSetSuccess() bool {
+func (p *CreateAuditLogResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetRoleMenuPermissionsResult) GetResult() interface{} {
+func (p *CreateAuditLogResult) GetResult() interface{} {
 	return p.Success
 }
 
-func hasMenuPermissionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func listAuditLogsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.HasMenuPermissionRequest)
+		req := new(identity_srv.ListAuditLogsRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).HasMenuPermission(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).ListAuditLogs(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *HasMenuPermissionArgs:
-		success, err := handler.(identity_srv.IdentityService).HasMenuPermission(ctx, s.Req)
+	case *ListAuditLogsArgs:
+		success, err := handler.(identity_srv.IdentityService).ListAuditLogs(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*HasMenuPermissionResult)
+		realResult := result.(*ListAuditLogsResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newHasMenuPermissionArgs() interface{} {
-	return &HasMenuPermissionArgs{}
+func newListAuditLogsArgs() interface{} {
+	return &ListAuditLogsArgs{}
 }
 
-func newHasMenuPermissionResult() interface{} {
-	return &HasMenuPermissionResult{}
+func newListAuditLogsResult() interface{} {
+	return &ListAuditLogsResult{}
 }
 
-type HasMenuPermissionArgs struct {
-	Req *identity_srv.HasMenuPermissionRequest
+type ListAuditLogsArgs struct {
+	Req *identity_srv.ListAuditLogsRequest
 }
 
-func (p *HasMenuPermissionArgs) Marshal(out []byte) ([]byte, error) {
+func (p *ListAuditLogsArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *HasMenuPermissionArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.HasMenuPermissionRequest)
+func (p *ListAuditLogsArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ListAuditLogsRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -6298,38 +10192,38 @@ func (p *HasMenuPermissionArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var HasMenuPermissionArgs_Req_DEFAULT *identity_srv.HasMenuPermissionRequest
+var ListAuditLogsArgs_Req_DEFAULT *identity_srv.ListAuditLogsRequest
 
-func (p *HasMenuPermissionArgs) GetReq() *identity_srv.HasMenuPermissionRequest {
+func (p *ListAuditLogsArgs) GetReq() *identity_srv.ListAuditLogsRequest {
 	if !p.IsSetReq() {
-		return HasMenuPermissionArgs_Req_DEFAULT
+		return ListAuditLogsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *HasMenuPermissionArgs) IsSetReq() bool {
+func (p *ListAuditLogsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *HasMenuPermissionArgs) GetFirstArgument() interface{} {
+func (p *ListAuditLogsArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type HasMenuPermissionResult struct {
-	Success *identity_srv.HasMenuPermissionResponse
+type ListAuditLogsResult struct {
+	Success *identity_srv.ListAuditLogsResponse
 }
 
-var HasMenuPermissionResult_Success_DEFAULT *identity_srv.HasMenuPermissionResponse
+var ListAuditLogsResult_Success_DEFAULT *identity_srv.ListAuditLogsResponse
 
-func (p *HasMenuPermissionResult) Marshal(out []byte) ([]byte, error) {
+func (p *ListAuditLogsResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *HasMenuPermissionResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.HasMenuPermissionResponse)
+func (p *ListAuditLogsResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ListAuditLogsResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -6337,71 +10231,71 @@ func (p *HasMenuPermissionResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *HasMenuPermissionResult) GetSuccess() *identity_srv.HasMenuPermissionResponse {
+func (p *ListAuditLogsResult) GetSuccess() *identity_srv.ListAuditLogsResponse {
 	if !p.IsSetSuccess() {
-		return HasMenuPermissionResult_Success_DEFAULT
+		return ListAuditLogsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *HasMenuPermissionResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.HasMenuPermissionResponse)
+func (p *ListAuditLogsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.ListAuditLogsResponse)
 }
 
-func (p *HasMenuPermissionResult) IsSetSuccess() bool {
+func (p *ListAuditLogsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *HasMenuPermissionResult) GetResult() interface{} {
+func (p *ListAuditLogsResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getUserMenuPermissionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func listDeletedEntitiesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.GetUserMenuPermissionsRequest)
+		req := new(identity_srv.ListDeletedEntitiesRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).GetUserMenuPermissions(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).ListDeletedEntities(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *GetUserMenuPermissionsArgs:
-		success, err := handler.(identity_srv.IdentityService).GetUserMenuPermissions(ctx, s.Req)
+	case *ListDeletedEntitiesArgs:
+		success, err := handler.(identity_srv.IdentityService).ListDeletedEntities(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetUserMenuPermissionsResult)
+		realResult := result.(*ListDeletedEntitiesResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetUserMenuPermissionsArgs() interface{} {
-	return &GetUserMenuPermissionsArgs{}
+func newListDeletedEntitiesArgs() interface{} {
+	return &ListDeletedEntitiesArgs{}
 }
 
-func newGetUserMenuPermissionsResult() interface{} {
-	return &GetUserMenuPermissionsResult{}
+func newListDeletedEntitiesResult() interface{} {
+	return &ListDeletedEntitiesResult{}
 }
 
-type GetUserMenuPermissionsArgs struct {
-	Req *identity_srv.GetUserMenuPermissionsRequest
+type ListDeletedEntitiesArgs struct {
+	Req *identity_srv.ListDeletedEntitiesRequest
 }
 
-func (p *GetUserMenuPermissionsArgs) Marshal(out []byte) ([]byte, error) {
+func (p *ListDeletedEntitiesArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetUserMenuPermissionsArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetUserMenuPermissionsRequest)
+func (p *ListDeletedEntitiesArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ListDeletedEntitiesRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -6409,38 +10303,38 @@ func (p *GetUserMenuPermissionsArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetUserMenuPermissionsArgs_Req_DEFAULT *identity_srv.GetUserMenuPermissionsRequest
+var ListDeletedEntitiesArgs_Req_DEFAULT *identity_srv.ListDeletedEntitiesRequest
 
-func (p *GetUserMenuPermissionsArgs) GetReq() *identity_srv.GetUserMenuPermissionsRequest {
+func (p *ListDeletedEntitiesArgs) GetReq() *identity_srv.ListDeletedEntitiesRequest {
 	if !p.IsSetReq() {
-		return GetUserMenuPermissionsArgs_Req_DEFAULT
+		return ListDeletedEntitiesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetUserMenuPermissionsArgs) IsSetReq() bool {
+func (p *ListDeletedEntitiesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetUserMenuPermissionsArgs) GetFirstArgument() interface{} {
+func (p *ListDeletedEntitiesArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetUserMenuPermissionsResult struct {
-	Success *identity_srv.GetUserMenuPermissionsResponse
+type ListDeletedEntitiesResult struct {
+	Success *identity_srv.ListDeletedEntitiesResponse
 }
 
-var GetUserMenuPermissionsResult_Success_DEFAULT *identity_srv.GetUserMenuPermissionsResponse
+var ListDeletedEntitiesResult_Success_DEFAULT *identity_srv.ListDeletedEntitiesResponse
 
-func (p *GetUserMenuPermissionsResult) Marshal(out []byte) ([]byte, error) {
+func (p *ListDeletedEntitiesResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetUserMenuPermissionsResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.GetUserMenuPermissionsResponse)
+func (p *ListDeletedEntitiesResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.ListDeletedEntitiesResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -6448,71 +10342,71 @@ func (p *GetUserMenuPermissionsResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetUserMenuPermissionsResult) GetSuccess() *identity_srv.GetUserMenuPermissionsResponse {
+func (p *ListDeletedEntitiesResult) GetSuccess() *identity_srv.ListDeletedEntitiesResponse {
 	if !p.IsSetSuccess() {
-		return GetUserMenuPermissionsResult_Success_DEFAULT
+		return ListDeletedEntitiesResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetUserMenuPermissionsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.GetUserMenuPermissionsResponse)
+func (p *ListDeletedEntitiesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.ListDeletedEntitiesResponse)
 }
 
-func (p *GetUserMenuPermissionsResult) IsSetSuccess() bool {
+func (p *ListDeletedEntitiesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetUserMenuPermissionsResult) GetResult() interface{} {
+func (p *ListDeletedEntitiesResult) GetResult() interface{} {
 	return p.Success
 }
 
-func createAuditLogHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func restoreDeletedEntityHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.CreateAuditLogRequest)
+		req := new(identity_srv.RestoreDeletedEntityRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).CreateAuditLog(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).RestoreDeletedEntity(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *CreateAuditLogArgs:
-		success, err := handler.(identity_srv.IdentityService).CreateAuditLog(ctx, s.Req)
+	case *RestoreDeletedEntityArgs:
+		success, err := handler.(identity_srv.IdentityService).RestoreDeletedEntity(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*CreateAuditLogResult)
+		realResult := result.(*RestoreDeletedEntityResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newCreateAuditLogArgs() interface{} {
-	return &CreateAuditLogArgs{}
+func newRestoreDeletedEntityArgs() interface{} {
+	return &RestoreDeletedEntityArgs{}
 }
 
-func newCreateAuditLogResult() interface{} {
-	return &CreateAuditLogResult{}
+func newRestoreDeletedEntityResult() interface{} {
+	return &RestoreDeletedEntityResult{}
 }
 
-type CreateAuditLogArgs struct {
-	Req *identity_srv.CreateAuditLogRequest
+type RestoreDeletedEntityArgs struct {
+	Req *identity_srv.RestoreDeletedEntityRequest
 }
 
-func (p *CreateAuditLogArgs) Marshal(out []byte) ([]byte, error) {
+func (p *RestoreDeletedEntityArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *CreateAuditLogArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.CreateAuditLogRequest)
+func (p *RestoreDeletedEntityArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.RestoreDeletedEntityRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -6520,38 +10414,38 @@ func (p *CreateAuditLogArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var CreateAuditLogArgs_Req_DEFAULT *identity_srv.CreateAuditLogRequest
+var RestoreDeletedEntityArgs_Req_DEFAULT *identity_srv.RestoreDeletedEntityRequest
 
-func (p *CreateAuditLogArgs) GetReq() *identity_srv.CreateAuditLogRequest {
+func (p *RestoreDeletedEntityArgs) GetReq() *identity_srv.RestoreDeletedEntityRequest {
 	if !p.IsSetReq() {
-		return CreateAuditLogArgs_Req_DEFAULT
+		return RestoreDeletedEntityArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *CreateAuditLogArgs) IsSetReq() bool {
+func (p *RestoreDeletedEntityArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *CreateAuditLogArgs) GetFirstArgument() interface{} {
+func (p *RestoreDeletedEntityArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type CreateAuditLogResult struct {
-	Success *identity_srv.CreateAuditLogResponse
+type RestoreDeletedEntityResult struct {
+	Success *identity_srv.RestoreDeletedEntityResponse
 }
 
-var CreateAuditLogResult_Success_DEFAULT *identity_srv.CreateAuditLogResponse
+var RestoreDeletedEntityResult_Success_DEFAULT *identity_srv.RestoreDeletedEntityResponse
 
-func (p *CreateAuditLogResult) Marshal(out []byte) ([]byte, error) {
+func (p *RestoreDeletedEntityResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *CreateAuditLogResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.CreateAuditLogResponse)
+func (p *RestoreDeletedEntityResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.RestoreDeletedEntityResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -6559,71 +10453,71 @@ func (p *CreateAuditLogResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *CreateAuditLogResult) GetSuccess() *identity_srv.CreateAuditLogResponse {
+func (p *RestoreDeletedEntityResult) GetSuccess() *identity_srv.RestoreDeletedEntityResponse {
 	if !p.IsSetSuccess() {
-		return CreateAuditLogResult_Success_DEFAULT
+		return RestoreDeletedEntityResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *CreateAuditLogResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.CreateAuditLogResponse)
+func (p *RestoreDeletedEntityResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.RestoreDeletedEntityResponse)
 }
 
-func (p *CreateAuditLogResult) IsSetSuccess() bool {
+func (p *RestoreDeletedEntityResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CreateAuditLogResult) GetResult() interface{} {
+func (p *RestoreDeletedEntityResult) GetResult() interface{} {
 	return p.Success
 }
 
-func listAuditLogsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func purgeDeletedEntityHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(identity_srv.ListAuditLogsRequest)
+		req := new(identity_srv.PurgeDeletedEntityRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(identity_srv.IdentityService).ListAuditLogs(ctx, req)
+		resp, err := handler.(identity_srv.IdentityService).PurgeDeletedEntity(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *ListAuditLogsArgs:
-		success, err := handler.(identity_srv.IdentityService).ListAuditLogs(ctx, s.Req)
+	case *PurgeDeletedEntityArgs:
+		success, err := handler.(identity_srv.IdentityService).PurgeDeletedEntity(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*ListAuditLogsResult)
+		realResult := result.(*PurgeDeletedEntityResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newListAuditLogsArgs() interface{} {
-	return &ListAuditLogsArgs{}
+func newPurgeDeletedEntityArgs() interface{} {
+	return &PurgeDeletedEntityArgs{}
 }
 
-func newListAuditLogsResult() interface{} {
-	return &ListAuditLogsResult{}
+func newPurgeDeletedEntityResult() interface{} {
+	return &PurgeDeletedEntityResult{}
 }
 
-type ListAuditLogsArgs struct {
-	Req *identity_srv.ListAuditLogsRequest
+type PurgeDeletedEntityArgs struct {
+	Req *identity_srv.PurgeDeletedEntityRequest
 }
 
-func (p *ListAuditLogsArgs) Marshal(out []byte) ([]byte, error) {
+func (p *PurgeDeletedEntityArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *ListAuditLogsArgs) Unmarshal(in []byte) error {
-	msg := new(identity_srv.ListAuditLogsRequest)
+func (p *PurgeDeletedEntityArgs) Unmarshal(in []byte) error {
+	msg := new(identity_srv.PurgeDeletedEntityRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -6631,38 +10525,38 @@ func (p *ListAuditLogsArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var ListAuditLogsArgs_Req_DEFAULT *identity_srv.ListAuditLogsRequest
+var PurgeDeletedEntityArgs_Req_DEFAULT *identity_srv.PurgeDeletedEntityRequest
 
-func (p *ListAuditLogsArgs) GetReq() *identity_srv.ListAuditLogsRequest {
+func (p *PurgeDeletedEntityArgs) GetReq() *identity_srv.PurgeDeletedEntityRequest {
 	if !p.IsSetReq() {
-		return ListAuditLogsArgs_Req_DEFAULT
+		return PurgeDeletedEntityArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *ListAuditLogsArgs) IsSetReq() bool {
+func (p *PurgeDeletedEntityArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ListAuditLogsArgs) GetFirstArgument() interface{} {
+func (p *PurgeDeletedEntityArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type ListAuditLogsResult struct {
-	Success *identity_srv.ListAuditLogsResponse
+type PurgeDeletedEntityResult struct {
+	Success *identity_srv.PurgeDeletedEntityResponse
 }
 
-var ListAuditLogsResult_Success_DEFAULT *identity_srv.ListAuditLogsResponse
+var PurgeDeletedEntityResult_Success_DEFAULT *identity_srv.PurgeDeletedEntityResponse
 
-func (p *ListAuditLogsResult) Marshal(out []byte) ([]byte, error) {
+func (p *PurgeDeletedEntityResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *ListAuditLogsResult) Unmarshal(in []byte) error {
-	msg := new(identity_srv.ListAuditLogsResponse)
+func (p *PurgeDeletedEntityResult) Unmarshal(in []byte) error {
+	msg := new(identity_srv.PurgeDeletedEntityResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -6670,22 +10564,22 @@ func (p *ListAuditLogsResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *ListAuditLogsResult) GetSuccess() *identity_srv.ListAuditLogsResponse {
+func (p *PurgeDeletedEntityResult) GetSuccess() *identity_srv.PurgeDeletedEntityResponse {
 	if !p.IsSetSuccess() {
-		return ListAuditLogsResult_Success_DEFAULT
+		return PurgeDeletedEntityResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *ListAuditLogsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*identity_srv.ListAuditLogsResponse)
+func (p *PurgeDeletedEntityResult) SetSuccess(x interface{}) {
+	p.Success = x.(*identity_srv.PurgeDeletedEntityResponse)
 }
 
-func (p *ListAuditLogsResult) IsSetSuccess() bool {
+func (p *PurgeDeletedEntityResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ListAuditLogsResult) GetResult() interface{} {
+func (p *PurgeDeletedEntityResult) GetResult() interface{} {
 	return p.Success
 }
 
@@ -6739,6 +10633,76 @@ func (p *kClient) ForcePasswordChange(ctx context.Context, Req *identity_srv.For
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) EnrollMFA(ctx context.Context, Req *identity_srv.EnrollMFARequest) (r *identity_srv.EnrollMFAResponse, err error) {
+	var _args EnrollMFAArgs
+	_args.Req = Req
+	var _result EnrollMFAResult
+	if err = p.c.Call(ctx, "EnrollMFA", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ConfirmMFA(ctx context.Context, Req *identity_srv.ConfirmMFARequest) (r *identity_srv.ConfirmMFAResponse, err error) {
+	var _args ConfirmMFAArgs
+	_args.Req = Req
+	var _result ConfirmMFAResult
+	if err = p.c.Call(ctx, "ConfirmMFA", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) VerifyMFA(ctx context.Context, Req *identity_srv.VerifyMFARequest) (r *identity_srv.VerifyMFAResponse, err error) {
+	var _args VerifyMFAArgs
+	_args.Req = Req
+	var _result VerifyMFAResult
+	if err = p.c.Call(ctx, "VerifyMFA", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DisableMFA(ctx context.Context, Req *identity_srv.DisableMFARequest) (r *identity_srv.DisableMFAResponse, err error) {
+	var _args DisableMFAArgs
+	_args.Req = Req
+	var _result DisableMFAResult
+	if err = p.c.Call(ctx, "DisableMFA", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RequestPasswordReset(ctx context.Context, Req *identity_srv.RequestPasswordResetRequest) (r *identity_srv.RequestPasswordResetResponse, err error) {
+	var _args RequestPasswordResetArgs
+	_args.Req = Req
+	var _result RequestPasswordResetResult
+	if err = p.c.Call(ctx, "RequestPasswordReset", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ConfirmPasswordReset(ctx context.Context, Req *identity_srv.ConfirmPasswordResetRequest) (r *identity_srv.ConfirmPasswordResetResponse, err error) {
+	var _args ConfirmPasswordResetArgs
+	_args.Req = Req
+	var _result ConfirmPasswordResetResult
+	if err = p.c.Call(ctx, "ConfirmPasswordReset", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RefreshSession(ctx context.Context, Req *identity_srv.RefreshSessionRequest) (r *identity_srv.RefreshSessionResponse, err error) {
+	var _args RefreshSessionArgs
+	_args.Req = Req
+	var _result RefreshSessionResult
+	if err = p.c.Call(ctx, "RefreshSession", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) CreateUser(ctx context.Context, Req *identity_srv.CreateUserRequest) (r *identity_srv.CreateUserResponse, err error) {
 	var _args CreateUserArgs
 	_args.Req = Req
@@ -6819,6 +10783,26 @@ func (p *kClient) UnlockUser(ctx context.Context, Req *identity_srv.UnlockUserRe
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) ImportUsers(ctx context.Context, Req *identity_srv.ImportUsersRequest) (r *identity_srv.ImportUsersResponse, err error) {
+	var _args ImportUsersArgs
+	_args.Req = Req
+	var _result ImportUsersResult
+	if err = p.c.Call(ctx, "ImportUsers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ExportUsers(ctx context.Context, Req *identity_srv.ExportUsersRequest) (r *identity_srv.ExportUsersResponse, err error) {
+	var _args ExportUsersArgs
+	_args.Req = Req
+	var _result ExportUsersResult
+	if err = p.c.Call(ctx, "ExportUsers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) CreateOrganization(ctx context.Context, Req *identity_srv.CreateOrganizationRequest) (r *identity_srv.CreateOrganizationResponse, err error) {
 	var _args CreateOrganizationArgs
 	_args.Req = Req
@@ -7029,6 +11013,36 @@ func (p *kClient) BindLogoToOrganization(ctx context.Context, Req *identity_srv.
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) GetOrganizationPasswordPolicy(ctx context.Context, Req *identity_srv.GetOrganizationPasswordPolicyRequest) (r *identity_srv.GetOrganizationPasswordPolicyResponse, err error) {
+	var _args GetOrganizationPasswordPolicyArgs
+	_args.Req = Req
+	var _result GetOrganizationPasswordPolicyResult
+	if err = p.c.Call(ctx, "GetOrganizationPasswordPolicy", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateOrganizationPasswordPolicy(ctx context.Context, Req *identity_srv.UpdateOrganizationPasswordPolicyRequest) (r *identity_srv.UpdateOrganizationPasswordPolicyResponse, err error) {
+	var _args UpdateOrganizationPasswordPolicyArgs
+	_args.Req = Req
+	var _result UpdateOrganizationPasswordPolicyResult
+	if err = p.c.Call(ctx, "UpdateOrganizationPasswordPolicy", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ResetOrganizationPasswordPolicy(ctx context.Context, Req *identity_srv.ResetOrganizationPasswordPolicyRequest) (r *identity_srv.ResetOrganizationPasswordPolicyResponse, err error) {
+	var _args ResetOrganizationPasswordPolicyArgs
+	_args.Req = Req
+	var _result ResetOrganizationPasswordPolicyResult
+	if err = p.c.Call(ctx, "ResetOrganizationPasswordPolicy", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) CreateRoleDefinition(ctx context.Context, Req *identity_srv.RoleDefinitionCreateRequest) (r *identity_srv.CreateRoleDefinitionResponse, err error) {
 	var _args CreateRoleDefinitionArgs
 	_args.Req = Req
@@ -7159,6 +11173,126 @@ func (p *kClient) BatchGetUserRoles(ctx context.Context, Req *identity_srv.Batch
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) GetUserTenantRoles(ctx context.Context, Req *identity_srv.GetUserTenantRolesRequest) (r *identity_srv.GetUserTenantRolesResponse, err error) {
+	var _args GetUserTenantRolesArgs
+	_args.Req = Req
+	var _result GetUserTenantRolesResult
+	if err = p.c.Call(ctx, "GetUserTenantRoles", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListRoleConstraintViolations(ctx context.Context, Req *identity_srv.ListRoleConstraintViolationsRequest) (r *identity_srv.ListRoleConstraintViolationsResponse, err error) {
+	var _args ListRoleConstraintViolationsArgs
+	_args.Req = Req
+	var _result ListRoleConstraintViolationsResult
+	if err = p.c.Call(ctx, "ListRoleConstraintViolations", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListRoleGrantRequests(ctx context.Context, Req *identity_srv.ListRoleGrantRequestsRequest) (r *identity_srv.ListRoleGrantRequestsResponse, err error) {
+	var _args ListRoleGrantRequestsArgs
+	_args.Req = Req
+	var _result ListRoleGrantRequestsResult
+	if err = p.c.Call(ctx, "ListRoleGrantRequests", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetRoleGrantRequest(ctx context.Context, Req *identity_srv.GetRoleGrantRequestRequest) (r *identity_srv.GetRoleGrantRequestResponse, err error) {
+	var _args GetRoleGrantRequestArgs
+	_args.Req = Req
+	var _result GetRoleGrantRequestResult
+	if err = p.c.Call(ctx, "GetRoleGrantRequest", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ApproveRoleGrant(ctx context.Context, Req *identity_srv.ApproveRoleGrantRequest) (r *identity_srv.ApproveRoleGrantResponse, err error) {
+	var _args ApproveRoleGrantArgs
+	_args.Req = Req
+	var _result ApproveRoleGrantResult
+	if err = p.c.Call(ctx, "ApproveRoleGrant", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RejectRoleGrant(ctx context.Context, Req *identity_srv.RejectRoleGrantRequest) (r *identity_srv.RejectRoleGrantResponse, err error) {
+	var _args RejectRoleGrantArgs
+	_args.Req = Req
+	var _result RejectRoleGrantResult
+	if err = p.c.Call(ctx, "RejectRoleGrant", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateAccessReviewCampaign(ctx context.Context, Req *identity_srv.CreateAccessReviewCampaignRequest) (r *identity_srv.CreateAccessReviewCampaignResponse, err error) {
+	var _args CreateAccessReviewCampaignArgs
+	_args.Req = Req
+	var _result CreateAccessReviewCampaignResult
+	if err = p.c.Call(ctx, "CreateAccessReviewCampaign", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListAccessReviewCampaigns(ctx context.Context, Req *identity_srv.ListAccessReviewCampaignsRequest) (r *identity_srv.ListAccessReviewCampaignsResponse, err error) {
+	var _args ListAccessReviewCampaignsArgs
+	_args.Req = Req
+	var _result ListAccessReviewCampaignsResult
+	if err = p.c.Call(ctx, "ListAccessReviewCampaigns", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetAccessReviewCampaign(ctx context.Context, Req *identity_srv.GetAccessReviewCampaignRequest) (r *identity_srv.GetAccessReviewCampaignResponse, err error) {
+	var _args GetAccessReviewCampaignArgs
+	_args.Req = Req
+	var _result GetAccessReviewCampaignResult
+	if err = p.c.Call(ctx, "GetAccessReviewCampaign", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListAccessReviewItems(ctx context.Context, Req *identity_srv.ListAccessReviewItemsRequest) (r *identity_srv.ListAccessReviewItemsResponse, err error) {
+	var _args ListAccessReviewItemsArgs
+	_args.Req = Req
+	var _result ListAccessReviewItemsResult
+	if err = p.c.Call(ctx, "ListAccessReviewItems", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DecideAccessReviewItem(ctx context.Context, Req *identity_srv.DecideAccessReviewItemRequest) (r *identity_srv.DecideAccessReviewItemResponse, err error) {
+	var _args DecideAccessReviewItemArgs
+	_args.Req = Req
+	var _result DecideAccessReviewItemResult
+	if err = p.c.Call(ctx, "DecideAccessReviewItem", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CloseAccessReviewCampaign(ctx context.Context, Req *identity_srv.CloseAccessReviewCampaignRequest) (r *identity_srv.CloseAccessReviewCampaignResponse, err error) {
+	var _args CloseAccessReviewCampaignArgs
+	_args.Req = Req
+	var _result CloseAccessReviewCampaignResult
+	if err = p.c.Call(ctx, "CloseAccessReviewCampaign", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) UploadMenu(ctx context.Context, Req *identity_srv.UploadMenuRequest) (r *identity_srv.UploadMenuResponse, err error) {
 	var _args UploadMenuArgs
 	_args.Req = Req
@@ -7179,6 +11313,36 @@ func (p *kClient) GetMenuTree(ctx context.Context, Req *identity_srv.GetMenuTree
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) ListMenuVersions(ctx context.Context, Req *identity_srv.ListMenuVersionsRequest) (r *identity_srv.ListMenuVersionsResponse, err error) {
+	var _args ListMenuVersionsArgs
+	_args.Req = Req
+	var _result ListMenuVersionsResult
+	if err = p.c.Call(ctx, "ListMenuVersions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DiffMenuVersions(ctx context.Context, Req *identity_srv.DiffMenuVersionsRequest) (r *identity_srv.DiffMenuVersionsResponse, err error) {
+	var _args DiffMenuVersionsArgs
+	_args.Req = Req
+	var _result DiffMenuVersionsResult
+	if err = p.c.Call(ctx, "DiffMenuVersions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ActivateMenuVersion(ctx context.Context, Req *identity_srv.ActivateMenuVersionRequest) (r *identity_srv.ActivateMenuVersionResponse, err error) {
+	var _args ActivateMenuVersionArgs
+	_args.Req = Req
+	var _result ActivateMenuVersionResult
+	if err = p.c.Call(ctx, "ActivateMenuVersion", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) ConfigureRoleMenus(ctx context.Context, Req *identity_srv.ConfigureRoleMenusRequest) (r *identity_srv.ConfigureRoleMenusResponse, err error) {
 	var _args ConfigureRoleMenusArgs
 	_args.Req = Req
@@ -7239,6 +11403,36 @@ func (p *kClient) GetUserMenuPermissions(ctx context.Context, Req *identity_srv.
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) GetPermissionMatrix(ctx context.Context, Req *identity_srv.GetPermissionMatrixRequest) (r *identity_srv.GetPermissionMatrixResponse, err error) {
+	var _args GetPermissionMatrixArgs
+	_args.Req = Req
+	var _result GetPermissionMatrixResult
+	if err = p.c.Call(ctx, "GetPermissionMatrix", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetUserEffectivePermissions(ctx context.Context, Req *identity_srv.GetUserEffectivePermissionsRequest) (r *identity_srv.GetUserEffectivePermissionsResponse, err error) {
+	var _args GetUserEffectivePermissionsArgs
+	_args.Req = Req
+	var _result GetUserEffectivePermissionsResult
+	if err = p.c.Call(ctx, "GetUserEffectivePermissions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ExportPermissionReport(ctx context.Context, Req *identity_srv.ExportPermissionReportRequest) (r *identity_srv.ExportPermissionReportResponse, err error) {
+	var _args ExportPermissionReportArgs
+	_args.Req = Req
+	var _result ExportPermissionReportResult
+	if err = p.c.Call(ctx, "ExportPermissionReport", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) CreateAuditLog(ctx context.Context, Req *identity_srv.CreateAuditLogRequest) (r *identity_srv.CreateAuditLogResponse, err error) {
 	var _args CreateAuditLogArgs
 	_args.Req = Req
@@ -7258,3 +11452,33 @@ func (p *kClient) ListAuditLogs(ctx context.Context, Req *identity_srv.ListAudit
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) ListDeletedEntities(ctx context.Context, Req *identity_srv.ListDeletedEntitiesRequest) (r *identity_srv.ListDeletedEntitiesResponse, err error) {
+	var _args ListDeletedEntitiesArgs
+	_args.Req = Req
+	var _result ListDeletedEntitiesResult
+	if err = p.c.Call(ctx, "ListDeletedEntities", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RestoreDeletedEntity(ctx context.Context, Req *identity_srv.RestoreDeletedEntityRequest) (r *identity_srv.RestoreDeletedEntityResponse, err error) {
+	var _args RestoreDeletedEntityArgs
+	_args.Req = Req
+	var _result RestoreDeletedEntityResult
+	if err = p.c.Call(ctx, "RestoreDeletedEntity", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) PurgeDeletedEntity(ctx context.Context, Req *identity_srv.PurgeDeletedEntityRequest) (r *identity_srv.PurgeDeletedEntityResponse, err error) {
+	var _args PurgeDeletedEntityArgs
+	_args.Req = Req
+	var _result PurgeDeletedEntityResult
+	if err = p.c.Call(ctx, "PurgeDeletedEntity", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -10,14 +10,20 @@ import (
 
 // PageRequest defines pagination and query options.
 type PageRequest struct {
-	Page         *int32                `protobuf:"varint,1,opt,name=page" json:"page,omitempty"`
-	Limit        *int32                `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
-	Search       *string               `protobuf:"bytes,3,opt,name=search" json:"search,omitempty"`
-	Filter       *core.StringMapValue  `protobuf:"bytes,4,opt,name=filter" json:"filter,omitempty"`
-	Sort         *string               `protobuf:"bytes,5,opt,name=sort" json:"sort,omitempty"`
-	Fields       *core.StringListValue `protobuf:"bytes,6,opt,name=fields" json:"fields,omitempty"`
-	IncludeTotal *bool                 `protobuf:"varint,7,opt,name=includeTotal" json:"includeTotal,omitempty"`
-	FetchAll     *bool                 `protobuf:"varint,8,opt,name=fetchAll" json:"fetchAll,omitempty"`
+	Page   *int32                `protobuf:"varint,1,opt,name=page" json:"page,omitempty"`
+	Limit  *int32                `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	Search *string               `protobuf:"bytes,3,opt,name=search" json:"search,omitempty"`
+	Filter *core.StringMapValue  `protobuf:"bytes,4,opt,name=filter" json:"filter,omitempty"`
+	Sort   *string               `protobuf:"bytes,5,opt,name=sort" json:"sort,omitempty"`
+	Fields *core.StringListValue `protobuf:"bytes,6,opt,name=fields" json:"fields,omitempty"`
+
+	// 是否统计总数：未指定时页码分页统计、游标分页不统计
+	IncludeTotal *bool `protobuf:"varint,7,opt,name=includeTotal" json:"includeTotal,omitempty"`
+	FetchAll     *bool `protobuf:"varint,8,opt,name=fetchAll" json:"fetchAll,omitempty"`
+
+	// 游标（键集）分页：设置后忽略 page，按 (created_at, id) 稳定排序；
+	// 首页传空字符串，后续传上一页返回的 nextCursor
+	Cursor *string `protobuf:"bytes,9,opt,name=cursor" json:"cursor,omitempty"`
 }
 
 func (x *PageRequest) Reset() { *x = PageRequest{} }
@@ -82,6 +88,13 @@ func (x *PageRequest) GetFetchAll() bool {
 	return false
 }
 
+func (x *PageRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
 // PageResponse defines pagination metadata.
 type PageResponse struct {
 	Total      *int32 `protobuf:"varint,1,opt,name=total" json:"total,omitempty"`
@@ -90,6 +103,9 @@ type PageResponse struct {
 	TotalPages *int32 `protobuf:"varint,4,opt,name=totalPages" json:"totalPages,omitempty"`
 	HasNext    *bool  `protobuf:"varint,5,opt,name=hasNext" json:"hasNext,omitempty"`
 	HasPrev    *bool  `protobuf:"varint,6,opt,name=hasPrev" json:"hasPrev,omitempty"`
+
+	// 游标分页下一页的游标，没有下一页时为空
+	NextCursor *string `protobuf:"bytes,7,opt,name=nextCursor" json:"nextCursor,omitempty"`
 }
 
 func (x *PageResponse) Reset() { *x = PageResponse{} }
@@ -139,3 +155,10 @@ func (x *PageResponse) GetHasPrev() bool {
 	}
 	return false
 }
+
+func (x *PageResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"os"
+
 	"github.com/cloudwego/kitex/pkg/klog"
 
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/kitex_gen/identity_srv/identityservice"
@@ -8,6 +10,16 @@ import (
 )
 
 func main() {
+	// migrate 子命令：只执行数据库迁移，不启动服务
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(runMigrate(os.Args[2:]))
+	}
+
+	// backfill-search 子命令：回填拼音检索文本，不启动服务
+	if len(os.Args) > 1 && os.Args[1] == "backfill-search" {
+		os.Exit(runBackfillSearch(os.Args[2:]))
+	}
+
 	// 统一初始化所有依赖（只初始化一次）
 	// Wire 自动管理依赖图和生命周期
 	container, cleanup, err := wire.InitializeApp()
@@ -19,6 +31,12 @@ func main() {
 	// 启动健康检查服务器（独立的 goroutine）
 	container.StartHealthCheck()
 
+	// 启动临时授权回收任务（独立的 goroutine）
+	container.StartRoleExpiryJob()
+
+	// 启动回收站自动清除任务（独立的 goroutine）
+	container.StartRecycleBinPurgeJob()
+
 	// 创建 Handler 实例
 	serviceImpl := NewIdentityServiceImpl(container)
 
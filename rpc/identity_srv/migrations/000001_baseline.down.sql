@@ -0,0 +1,14 @@
+-- 回滚基线：删除全部 identity_srv 表（数据不可恢复，仅用于开发环境重建）
+
+DROP TABLE IF EXISTS "mfa_recovery_codes";
+DROP TABLE IF EXISTS "user_mfa";
+DROP TABLE IF EXISTS "audit_logs";
+DROP TABLE IF EXISTS "role_menu_permissions";
+DROP TABLE IF EXISTS "menus";
+DROP TABLE IF EXISTS "user_role_assignments";
+DROP TABLE IF EXISTS "role_definitions";
+DROP TABLE IF EXISTS "organization_logos";
+DROP TABLE IF EXISTS "departments";
+DROP TABLE IF EXISTS "organizations";
+DROP TABLE IF EXISTS "user_memberships";
+DROP TABLE IF EXISTS "user_profiles";
@@ -0,0 +1,439 @@
+-- 基线迁移：等价于 GORM AutoMigrate 生成的 identity_srv 表结构（含历史上在 AutoMigrate 之后执行的补丁）。
+--
+-- 全部语句幂等（IF NOT EXISTS）：对已由 AutoMigrate 建好的存量库执行时只补记版本，不改动数据。
+
+-- user_profiles
+CREATE TABLE IF NOT EXISTS "user_profiles" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "username" varchar(20) NOT NULL,
+    "password_hash" varchar(255) NOT NULL,
+    "email" varchar(255),
+    "phone" varchar(20),
+    "is_system_user" boolean NOT NULL DEFAULT false,
+    "first_name" varchar(50),
+    "last_name" varchar(50),
+    "real_name" varchar(100),
+    "gender" integer DEFAULT 0,
+    "professional_title" varchar(100),
+    "employee_id" varchar(50),
+    "status" integer NOT NULL DEFAULT 2,
+    "login_attempts" integer NOT NULL DEFAULT 0,
+    "must_change_password" boolean NOT NULL DEFAULT false,
+    "account_expiry" bigint,
+    "created_by" uuid,
+    "updated_by" uuid,
+    "last_login_time" bigint,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_created_at" ON "user_profiles" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_deleted_at" ON "user_profiles" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_email" ON "user_profiles" ("email");
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_employee_id" ON "user_profiles" ("employee_id");
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_is_system_user" ON "user_profiles" ("is_system_user");
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_phone" ON "user_profiles" ("phone");
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_real_name" ON "user_profiles" ("real_name");
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_status" ON "user_profiles" ("status");
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_updated_at" ON "user_profiles" ("updated_at");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_user_profiles_username" ON "user_profiles" ("username");
+COMMENT ON COLUMN "user_profiles"."id" IS '主键';
+COMMENT ON COLUMN "user_profiles"."created_at" IS '创建时间';
+COMMENT ON COLUMN "user_profiles"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "user_profiles"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "user_profiles"."username" IS '用户名，唯一索引';
+COMMENT ON COLUMN "user_profiles"."password_hash" IS '密码哈希';
+COMMENT ON COLUMN "user_profiles"."email" IS '邮箱，索引';
+COMMENT ON COLUMN "user_profiles"."phone" IS '手机号，索引';
+COMMENT ON COLUMN "user_profiles"."is_system_user" IS '是否为系统内置用户';
+COMMENT ON COLUMN "user_profiles"."first_name" IS '名';
+COMMENT ON COLUMN "user_profiles"."last_name" IS '姓';
+COMMENT ON COLUMN "user_profiles"."real_name" IS '真实姓名，索引';
+COMMENT ON COLUMN "user_profiles"."gender" IS '性别';
+COMMENT ON COLUMN "user_profiles"."professional_title" IS '专业标题';
+COMMENT ON COLUMN "user_profiles"."employee_id" IS '员工ID，索引';
+COMMENT ON COLUMN "user_profiles"."status" IS '用户状态';
+COMMENT ON COLUMN "user_profiles"."login_attempts" IS '登录尝试次数';
+COMMENT ON COLUMN "user_profiles"."must_change_password" IS '是否必须修改密码';
+COMMENT ON COLUMN "user_profiles"."account_expiry" IS '账户过期时间';
+COMMENT ON COLUMN "user_profiles"."created_by" IS '创建者ID';
+COMMENT ON COLUMN "user_profiles"."updated_by" IS '更新者ID';
+COMMENT ON COLUMN "user_profiles"."last_login_time" IS '最后登录时间';
+
+-- user_memberships
+CREATE TABLE IF NOT EXISTS "user_memberships" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "user_id" uuid NOT NULL,
+    "organization_id" uuid NOT NULL,
+    "department_id" uuid,
+    "status" integer NOT NULL DEFAULT 1,
+    "is_primary" boolean NOT NULL DEFAULT false,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_dept_memberships" ON "user_memberships" ("department_id");
+CREATE INDEX IF NOT EXISTS "idx_org_memberships" ON "user_memberships" ("organization_id");
+CREATE INDEX IF NOT EXISTS "idx_user_memberships" ON "user_memberships" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_user_memberships_created_at" ON "user_memberships" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_user_memberships_deleted_at" ON "user_memberships" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_user_memberships_is_primary" ON "user_memberships" ("is_primary");
+CREATE INDEX IF NOT EXISTS "idx_user_memberships_status" ON "user_memberships" ("status");
+CREATE INDEX IF NOT EXISTS "idx_user_memberships_updated_at" ON "user_memberships" ("updated_at");
+COMMENT ON COLUMN "user_memberships"."id" IS '主键';
+COMMENT ON COLUMN "user_memberships"."created_at" IS '创建时间';
+COMMENT ON COLUMN "user_memberships"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "user_memberships"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "user_memberships"."user_id" IS '用户ID';
+COMMENT ON COLUMN "user_memberships"."organization_id" IS '组织ID';
+COMMENT ON COLUMN "user_memberships"."department_id" IS '部门ID';
+COMMENT ON COLUMN "user_memberships"."status" IS '成员状态';
+COMMENT ON COLUMN "user_memberships"."is_primary" IS '是否主要成员';
+
+-- organizations
+CREATE TABLE IF NOT EXISTS "organizations" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "code" varchar(50) NOT NULL,
+    "name" varchar(100) NOT NULL,
+    "parent_id" uuid,
+    "facility_type" varchar(100),
+    "accreditation_status" varchar(100),
+    "province_city" json,
+    "mfa_required" boolean NOT NULL DEFAULT false,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_organizations_code" ON "organizations" ("code");
+CREATE INDEX IF NOT EXISTS "idx_organizations_created_at" ON "organizations" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_organizations_deleted_at" ON "organizations" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_organizations_facility_type" ON "organizations" ("facility_type");
+CREATE INDEX IF NOT EXISTS "idx_organizations_name" ON "organizations" ("name");
+CREATE INDEX IF NOT EXISTS "idx_organizations_updated_at" ON "organizations" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_parent_org" ON "organizations" ("parent_id");
+COMMENT ON COLUMN "organizations"."id" IS '主键';
+COMMENT ON COLUMN "organizations"."created_at" IS '创建时间';
+COMMENT ON COLUMN "organizations"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "organizations"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "organizations"."code" IS '组织代码，必须唯一';
+COMMENT ON COLUMN "organizations"."name" IS '组织名称，用于搜索';
+COMMENT ON COLUMN "organizations"."parent_id" IS '支持层级组织结构';
+COMMENT ON COLUMN "organizations"."facility_type" IS '组织类型';
+COMMENT ON COLUMN "organizations"."accreditation_status" IS '认证状态';
+COMMENT ON COLUMN "organizations"."province_city" IS '组织所在省市列表';
+COMMENT ON COLUMN "organizations"."mfa_required" IS '成员登录是否必须启用多因素认证';
+
+-- departments
+CREATE TABLE IF NOT EXISTS "departments" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "name" varchar(100) NOT NULL,
+    "organization_id" uuid NOT NULL,
+    "department_type" varchar(100),
+    "available_equipment" text,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_departments_created_at" ON "departments" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_departments_deleted_at" ON "departments" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_departments_department_type" ON "departments" ("department_type");
+CREATE INDEX IF NOT EXISTS "idx_departments_name" ON "departments" ("name");
+CREATE INDEX IF NOT EXISTS "idx_departments_updated_at" ON "departments" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_org_departments" ON "departments" ("organization_id");
+COMMENT ON COLUMN "departments"."id" IS '主键';
+COMMENT ON COLUMN "departments"."created_at" IS '创建时间';
+COMMENT ON COLUMN "departments"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "departments"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "departments"."name" IS '部门名称，用于搜索';
+COMMENT ON COLUMN "departments"."organization_id" IS '组织ID';
+COMMENT ON COLUMN "departments"."department_type" IS '部门类型';
+COMMENT ON COLUMN "departments"."available_equipment" IS 'JSON 存储 list<ULID>';
+
+-- organization_logos
+CREATE TABLE IF NOT EXISTS "organization_logos" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "status" smallint NOT NULL DEFAULT 0,
+    "bound_organization_id" uuid,
+    "file_id" varchar(500) NOT NULL,
+    "file_name" varchar(255) NOT NULL,
+    "file_size" bigint NOT NULL,
+    "mime_type" varchar(100) NOT NULL,
+    "expires_at" bigint,
+    "uploaded_by" uuid NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_organization_logos_bound_organization_id" ON "organization_logos" ("bound_organization_id");
+CREATE INDEX IF NOT EXISTS "idx_organization_logos_created_at" ON "organization_logos" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_organization_logos_deleted_at" ON "organization_logos" ("deleted_at");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_organization_logos_file_id" ON "organization_logos" ("file_id");
+CREATE INDEX IF NOT EXISTS "idx_organization_logos_status" ON "organization_logos" ("status");
+CREATE INDEX IF NOT EXISTS "idx_organization_logos_updated_at" ON "organization_logos" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_organization_logos_uploaded_by" ON "organization_logos" ("uploaded_by");
+COMMENT ON COLUMN "organization_logos"."id" IS '主键';
+COMMENT ON COLUMN "organization_logos"."created_at" IS '创建时间';
+COMMENT ON COLUMN "organization_logos"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "organization_logos"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "organization_logos"."status" IS 'Logo状态';
+COMMENT ON COLUMN "organization_logos"."bound_organization_id" IS '绑定的组织ID（临时状态时为NULL）';
+COMMENT ON COLUMN "organization_logos"."file_id" IS 'S3存储路径: organization-logos/{uuid}.{ext}';
+COMMENT ON COLUMN "organization_logos"."file_name" IS '原始文件名';
+COMMENT ON COLUMN "organization_logos"."file_size" IS '文件大小（字节）';
+COMMENT ON COLUMN "organization_logos"."mime_type" IS 'MIME类型（image/png, image/jpeg等）';
+COMMENT ON COLUMN "organization_logos"."expires_at" IS '过期时间（毫秒时间戳，临时状态必填）';
+COMMENT ON COLUMN "organization_logos"."uploaded_by" IS '上传者用户ID';
+
+-- role_definitions
+CREATE TABLE IF NOT EXISTS "role_definitions" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "name" varchar(50) NOT NULL,
+    "description" text,
+    "status" integer NOT NULL,
+    "permissions" jsonb,
+    "is_system_role" boolean NOT NULL DEFAULT false,
+    "created_by" uuid,
+    "updated_by" uuid,
+    "role_code" varchar(50),
+    "parent_role_id" uuid,
+    "department_id" uuid,
+    "default_scope" smallint DEFAULT 1,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_role_definitions_parent_role" FOREIGN KEY ("parent_role_id") REFERENCES "role_definitions"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_role_definitions_created_at" ON "role_definitions" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_role_definitions_deleted_at" ON "role_definitions" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_role_definitions_department_id" ON "role_definitions" ("department_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_role_definitions_name" ON "role_definitions" ("name");
+CREATE INDEX IF NOT EXISTS "idx_role_definitions_parent_role_id" ON "role_definitions" ("parent_role_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_role_definitions_role_code" ON "role_definitions" ("role_code");
+CREATE INDEX IF NOT EXISTS "idx_role_definitions_updated_at" ON "role_definitions" ("updated_at");
+COMMENT ON COLUMN "role_definitions"."id" IS '主键';
+COMMENT ON COLUMN "role_definitions"."created_at" IS '创建时间';
+COMMENT ON COLUMN "role_definitions"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "role_definitions"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "role_definitions"."name" IS '角色唯一名称';
+COMMENT ON COLUMN "role_definitions"."description" IS '角色详细描述';
+COMMENT ON COLUMN "role_definitions"."status" IS '角色状态:1-活跃,2-未激活,3-已弃用';
+COMMENT ON COLUMN "role_definitions"."permissions" IS '角色拥有的权限列表';
+COMMENT ON COLUMN "role_definitions"."is_system_role" IS '是否为系统内置角色';
+COMMENT ON COLUMN "role_definitions"."created_by" IS '创建者ID';
+COMMENT ON COLUMN "role_definitions"."updated_by" IS '最后更新者ID';
+COMMENT ON COLUMN "role_definitions"."role_code" IS '角色编码,用于Casbin策略标识';
+COMMENT ON COLUMN "role_definitions"."parent_role_id" IS '父角色ID,支持角色继承';
+COMMENT ON COLUMN "role_definitions"."department_id" IS '绑定科室ID,NULL表示全院通用角色';
+COMMENT ON COLUMN "role_definitions"."default_scope" IS '默认数据范围:1-本人,2-本科室,3-全院';
+
+-- user_role_assignments
+CREATE TABLE IF NOT EXISTS "user_role_assignments" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "user_id" uuid NOT NULL,
+    "role_id" uuid NOT NULL,
+    "organization_id" uuid,
+    "valid_from" bigint,
+    "valid_until" bigint,
+    "created_by" uuid,
+    "updated_by" uuid,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_user_role_assignments_created_at" ON "user_role_assignments" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_user_role_assignments_deleted_at" ON "user_role_assignments" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_user_role_assignments_organization_id" ON "user_role_assignments" ("organization_id");
+CREATE INDEX IF NOT EXISTS "idx_user_role_assignments_role_id" ON "user_role_assignments" ("role_id");
+CREATE INDEX IF NOT EXISTS "idx_user_role_assignments_updated_at" ON "user_role_assignments" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_user_role_assignments_user_id" ON "user_role_assignments" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_user_role_assignments_valid_until" ON "user_role_assignments" ("valid_until");
+COMMENT ON COLUMN "user_role_assignments"."id" IS '主键';
+COMMENT ON COLUMN "user_role_assignments"."created_at" IS '创建时间';
+COMMENT ON COLUMN "user_role_assignments"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "user_role_assignments"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "user_role_assignments"."user_id" IS '用户ID';
+COMMENT ON COLUMN "user_role_assignments"."role_id" IS '角色ID';
+COMMENT ON COLUMN "user_role_assignments"."organization_id" IS '所属组织ID，为空表示全局分配';
+COMMENT ON COLUMN "user_role_assignments"."valid_from" IS '生效开始时间，为空表示立即生效';
+COMMENT ON COLUMN "user_role_assignments"."valid_until" IS '生效截止时间，为空表示永久有效';
+COMMENT ON COLUMN "user_role_assignments"."created_by" IS '创建者ID';
+COMMENT ON COLUMN "user_role_assignments"."updated_by" IS '最后更新者ID';
+
+-- menus
+CREATE TABLE IF NOT EXISTS "menus" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "product_line" varchar(50) NOT NULL,
+    "semantic_id" varchar(100) NOT NULL,
+    "version" bigint NOT NULL DEFAULT 1,
+    "content_hash" varchar(64),
+    "name" varchar(100) NOT NULL,
+    "path" varchar(255) NOT NULL,
+    "component" varchar(255),
+    "icon" varchar(100),
+    "parent_id" uuid,
+    "sort" bigint NOT NULL DEFAULT 0,
+    "created_by" uuid,
+    "updated_by" uuid,
+    "perm_code" varchar(100),
+    "api_path" varchar(255),
+    "is_button" boolean DEFAULT false,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_menus_children" FOREIGN KEY ("parent_id") REFERENCES "menus"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_menus_content_hash" ON "menus" ("content_hash");
+CREATE INDEX IF NOT EXISTS "idx_menus_created_at" ON "menus" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_menus_deleted_at" ON "menus" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_menus_parent_id" ON "menus" ("parent_id");
+CREATE INDEX IF NOT EXISTS "idx_menus_perm_code" ON "menus" ("perm_code");
+CREATE INDEX IF NOT EXISTS "idx_menus_updated_at" ON "menus" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_psv" ON "menus" ("product_line","semantic_id","version");
+COMMENT ON COLUMN "menus"."id" IS '主键';
+COMMENT ON COLUMN "menus"."created_at" IS '创建时间';
+COMMENT ON COLUMN "menus"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "menus"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "menus"."product_line" IS '产品线标识';
+COMMENT ON COLUMN "menus"."semantic_id" IS '语义化标识符';
+COMMENT ON COLUMN "menus"."version" IS '版本号(自增)';
+COMMENT ON COLUMN "menus"."content_hash" IS '内容哈希(SHA256)';
+COMMENT ON COLUMN "menus"."name" IS '菜单显示名称';
+COMMENT ON COLUMN "menus"."path" IS '前端路由路径';
+COMMENT ON COLUMN "menus"."component" IS '前端组件的路径';
+COMMENT ON COLUMN "menus"."icon" IS '菜单图标的标识符	';
+COMMENT ON COLUMN "menus"."parent_id" IS '父菜单ID';
+COMMENT ON COLUMN "menus"."sort" IS '排序字段';
+COMMENT ON COLUMN "menus"."created_by" IS '创建者ID';
+COMMENT ON COLUMN "menus"."updated_by" IS '最后更新者ID';
+COMMENT ON COLUMN "menus"."perm_code" IS '权限编码,如 emr:create, patient:read';
+COMMENT ON COLUMN "menus"."api_path" IS '关联的API路径,如 /api/v1/patients';
+COMMENT ON COLUMN "menus"."is_button" IS '是否为按钮级权限(非菜单项)';
+
+-- role_menu_permissions
+CREATE TABLE IF NOT EXISTS "role_menu_permissions" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "role_id" uuid NOT NULL,
+    "menu_id" varchar(100) NOT NULL,
+    "permission_type" smallint NOT NULL DEFAULT 1,
+    "data_scope" smallint NOT NULL DEFAULT 1,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_role_menu_permissions_role" FOREIGN KEY ("role_id") REFERENCES "role_definitions"("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_role_menu" ON "role_menu_permissions" ("role_id","menu_id");
+CREATE INDEX IF NOT EXISTS "idx_role_menu_permissions_created_at" ON "role_menu_permissions" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_role_menu_permissions_deleted_at" ON "role_menu_permissions" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_role_menu_permissions_updated_at" ON "role_menu_permissions" ("updated_at");
+COMMENT ON COLUMN "role_menu_permissions"."id" IS '主键';
+COMMENT ON COLUMN "role_menu_permissions"."created_at" IS '创建时间';
+COMMENT ON COLUMN "role_menu_permissions"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "role_menu_permissions"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "role_menu_permissions"."role_id" IS '角色ID';
+COMMENT ON COLUMN "role_menu_permissions"."menu_id" IS '菜单ID';
+COMMENT ON COLUMN "role_menu_permissions"."permission_type" IS '权限类型:无/查看/编辑/管理/完全控制';
+COMMENT ON COLUMN "role_menu_permissions"."data_scope" IS '数据范围:0-无,1-所在组织,2-所有组织';
+
+-- audit_logs
+CREATE TABLE IF NOT EXISTS "audit_logs" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "request_id" text,
+    "trace_id" text,
+    "user_id" text,
+    "username" text,
+    "organization_id" text,
+    "action" smallint,
+    "resource" text,
+    "resource_id" text,
+    "status_code" integer,
+    "success" boolean,
+    "client_ip" text,
+    "user_agent" text,
+    "request_body" text,
+    "duration_ms" integer,
+    "created_at" bigint,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_action" ON "audit_logs" ("action");
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_created_at" ON "audit_logs" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_organization_id" ON "audit_logs" ("organization_id");
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_request_id" ON "audit_logs" ("request_id");
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_resource" ON "audit_logs" ("resource");
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_success" ON "audit_logs" ("success");
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_user_id" ON "audit_logs" ("user_id");
+
+
+-- user_mfa
+CREATE TABLE IF NOT EXISTS "user_mfa" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "user_id" uuid NOT NULL,
+    "secret" varchar(64) NOT NULL,
+    "enabled" boolean NOT NULL DEFAULT false,
+    "enabled_at" bigint,
+    "last_used_step" bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_user_mfa_created_at" ON "user_mfa" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_user_mfa_deleted_at" ON "user_mfa" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_user_mfa_updated_at" ON "user_mfa" ("updated_at");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_user_mfa_user_id" ON "user_mfa" ("user_id");
+COMMENT ON COLUMN "user_mfa"."id" IS '主键';
+COMMENT ON COLUMN "user_mfa"."created_at" IS '创建时间';
+COMMENT ON COLUMN "user_mfa"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "user_mfa"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "user_mfa"."user_id" IS '用户ID';
+COMMENT ON COLUMN "user_mfa"."secret" IS 'TOTP密钥（Base32）';
+COMMENT ON COLUMN "user_mfa"."enabled" IS '是否已启用';
+COMMENT ON COLUMN "user_mfa"."enabled_at" IS '启用时间（毫秒时间戳）';
+COMMENT ON COLUMN "user_mfa"."last_used_step" IS '最近使用的TOTP时间步';
+
+-- mfa_recovery_codes
+CREATE TABLE IF NOT EXISTS "mfa_recovery_codes" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "user_id" uuid NOT NULL,
+    "code_hash" varchar(64) NOT NULL,
+    "used_at" bigint,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_mfa_recovery_codes_created_at" ON "mfa_recovery_codes" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_mfa_recovery_codes_deleted_at" ON "mfa_recovery_codes" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_mfa_recovery_codes_updated_at" ON "mfa_recovery_codes" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_mfa_recovery_user_hash" ON "mfa_recovery_codes" ("user_id","code_hash");
+COMMENT ON COLUMN "mfa_recovery_codes"."id" IS '主键';
+COMMENT ON COLUMN "mfa_recovery_codes"."created_at" IS '创建时间';
+COMMENT ON COLUMN "mfa_recovery_codes"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "mfa_recovery_codes"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "mfa_recovery_codes"."user_id" IS '用户ID';
+COMMENT ON COLUMN "mfa_recovery_codes"."code_hash" IS '恢复码SHA-256哈希';
+COMMENT ON COLUMN "mfa_recovery_codes"."used_at" IS '使用时间（毫秒时间戳），NULL 表示未使用';
+
+-- menus：删除旧的唯一约束索引（已由 idx_psv 取代）
+DROP INDEX IF EXISTS idx_semantic_version;
+
+-- user_role_assignments：历史零值 UUID 归为全局分配，并按范围建立部分唯一索引
+UPDATE user_role_assignments
+SET organization_id = NULL
+WHERE organization_id = '00000000-0000-0000-0000-000000000000';
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_role_global
+    ON user_role_assignments (user_id, role_id)
+    WHERE organization_id IS NULL AND deleted_at IS NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_role_org
+    ON user_role_assignments (user_id, role_id, organization_id)
+    WHERE organization_id IS NOT NULL AND deleted_at IS NULL;
@@ -0,0 +1,5 @@
+-- 回滚游标分页索引
+
+DROP INDEX IF EXISTS "idx_user_role_assignments_created_at_id";
+DROP INDEX IF EXISTS "idx_user_profiles_created_at_id";
+DROP INDEX IF EXISTS "idx_audit_logs_created_at_id";
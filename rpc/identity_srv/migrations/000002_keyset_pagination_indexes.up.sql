@@ -0,0 +1,6 @@
+-- 游标（键集）分页索引：列表按 (created_at, id) 稳定排序并以行值比较翻页，
+-- 复合索引使任意深度的翻页都只需一次索引范围扫描。
+
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_created_at_id" ON "audit_logs" ("created_at", "id");
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_created_at_id" ON "user_profiles" ("created_at", "id");
+CREATE INDEX IF NOT EXISTS "idx_user_role_assignments_created_at_id" ON "user_role_assignments" ("created_at", "id");
@@ -0,0 +1,9 @@
+-- 回滚拼音感知搜索（保留 pg_trgm 扩展，可能被其他对象使用）
+
+DROP INDEX IF EXISTS "idx_departments_search_tokens_trgm";
+DROP INDEX IF EXISTS "idx_organizations_search_tokens_trgm";
+DROP INDEX IF EXISTS "idx_user_profiles_search_tokens_trgm";
+
+ALTER TABLE "departments" DROP COLUMN IF EXISTS "search_tokens";
+ALTER TABLE "organizations" DROP COLUMN IF EXISTS "search_tokens";
+ALTER TABLE "user_profiles" DROP COLUMN IF EXISTS "search_tokens";
@@ -0,0 +1,31 @@
+-- 拼音感知搜索：user_profiles / organizations / departments 新增 search_tokens 检索文本列，
+-- 内容为原文（小写）+ 拼音全拼 + 拼音首字母，由服务在写入时生成；
+-- pg_trgm GIN 索引使 LIKE '%词%' 子串匹配可以走索引。
+--
+-- 存量数据先以原文回填，保证上线后搜索结果不少于改造前；拼音部分由
+-- `identity-srv backfill-search` 子命令补齐（可重复执行）。
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE "user_profiles" ADD COLUMN IF NOT EXISTS "search_tokens" text;
+ALTER TABLE "organizations" ADD COLUMN IF NOT EXISTS "search_tokens" text;
+ALTER TABLE "departments" ADD COLUMN IF NOT EXISTS "search_tokens" text;
+
+UPDATE "user_profiles"
+SET "search_tokens" = lower(concat_ws(' ', "username", "real_name", "last_name", "first_name", "email", "employee_id"))
+WHERE "search_tokens" IS NULL;
+
+UPDATE "organizations"
+SET "search_tokens" = lower(concat_ws(' ', "name", "code", "facility_type"))
+WHERE "search_tokens" IS NULL;
+
+UPDATE "departments"
+SET "search_tokens" = lower(concat_ws(' ', "name", "department_type"))
+WHERE "search_tokens" IS NULL;
+
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_search_tokens_trgm"
+    ON "user_profiles" USING gin ("search_tokens" gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS "idx_organizations_search_tokens_trgm"
+    ON "organizations" USING gin ("search_tokens" gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS "idx_departments_search_tokens_trgm"
+    ON "departments" USING gin ("search_tokens" gin_trgm_ops);
@@ -0,0 +1,11 @@
+-- 回滚乐观锁版本列
+
+ALTER TABLE "user_mfa" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "user_role_assignments" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "role_menu_permissions" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "role_definitions" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "departments" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "organization_logos" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "organizations" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "user_memberships" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "user_profiles" DROP COLUMN IF EXISTS "version";
@@ -0,0 +1,12 @@
+-- 乐观锁：所有嵌入 BaseModel 的表新增 version 列，更新时按版本号条件写入并加一；
+-- 存量记录从版本 1 开始。menus 的 version 列是菜单上传版本号（见 baseline），不在此列。
+
+ALTER TABLE "user_profiles" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "user_memberships" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "organizations" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "organization_logos" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "departments" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "role_definitions" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "role_menu_permissions" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "user_role_assignments" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "user_mfa" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
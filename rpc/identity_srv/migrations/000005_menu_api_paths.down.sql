@@ -0,0 +1,3 @@
+-- 回滚菜单 API 路径子表
+
+DROP TABLE IF EXISTS "menu_api_paths";
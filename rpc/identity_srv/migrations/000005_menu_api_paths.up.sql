@@ -0,0 +1,17 @@
+-- 菜单关联的 API 路径：menu.yaml 中每个菜单/按钮节点可配置多条 api_paths，
+-- 随菜单版本一起写入、不单独更新；menus.api_path 单值列保留但不再写入。
+
+CREATE TABLE IF NOT EXISTS "menu_api_paths" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "menu_id" uuid NOT NULL,
+    "path" varchar(255) NOT NULL,
+    "sort" bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_menus_api_paths" FOREIGN KEY ("menu_id") REFERENCES "menus"("id") ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_menu_api_paths_menu_path" ON "menu_api_paths" ("menu_id","path");
+CREATE INDEX IF NOT EXISTS "idx_menu_api_paths_path" ON "menu_api_paths" ("path");
+COMMENT ON COLUMN "menu_api_paths"."id" IS '主键';
+COMMENT ON COLUMN "menu_api_paths"."menu_id" IS '所属菜单ID';
+COMMENT ON COLUMN "menu_api_paths"."path" IS 'API路径模式,如 /api/v1/identity/users/*';
+COMMENT ON COLUMN "menu_api_paths"."sort" IS '在菜单配置中的顺序';
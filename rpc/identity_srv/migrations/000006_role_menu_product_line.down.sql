@@ -0,0 +1,8 @@
+-- 回滚角色菜单授权的产品线隔离：仅保留 default 产品线的授权
+
+DELETE FROM "role_menu_permissions" WHERE "product_line" <> 'default';
+
+DROP INDEX IF EXISTS "idx_role_menu";
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_role_menu" ON "role_menu_permissions" ("role_id","menu_id");
+
+ALTER TABLE "role_menu_permissions" DROP COLUMN IF EXISTS "product_line";
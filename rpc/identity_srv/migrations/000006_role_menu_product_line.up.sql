@@ -0,0 +1,8 @@
+-- 角色菜单授权按产品线隔离：不同前端（如移动护理端）各有一套菜单，语义ID可能重名，
+-- 授权唯一键由 (role_id, menu_id) 改为 (role_id, product_line, menu_id)；存量授权归入 default。
+
+ALTER TABLE "role_menu_permissions" ADD COLUMN IF NOT EXISTS "product_line" varchar(50) NOT NULL DEFAULT 'default';
+COMMENT ON COLUMN "role_menu_permissions"."product_line" IS '菜单所属产品线';
+
+DROP INDEX IF EXISTS "idx_role_menu";
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_role_menu" ON "role_menu_permissions" ("role_id","product_line","menu_id");
@@ -0,0 +1,3 @@
+-- 被清除的 superadmin 角色菜单授权不可恢复；全量菜单权限仍由 policy_srv 通配策略给出，无需回滚数据。
+
+SELECT 1;
@@ -0,0 +1,5 @@
+-- superadmin 的全量菜单权限改由 policy_srv 通配策略（p, role:superadmin, *, *, *, all）给出，
+-- 清除种子曾写入的 superadmin 角色菜单授权，避免策略收回后仍残留一份全量授权。
+
+DELETE FROM "role_menu_permissions"
+WHERE "role_id" IN (SELECT "id" FROM "role_definitions" WHERE "name" = 'superadmin');
@@ -0,0 +1,4 @@
+-- 回滚角色职责分离约束
+
+ALTER TABLE "role_definitions" DROP COLUMN IF EXISTS "max_assignments";
+ALTER TABLE "role_definitions" DROP COLUMN IF EXISTS "exclusive_groups";
@@ -0,0 +1,7 @@
+-- 角色职责分离约束：互斥角色组与最大分配人数。
+-- 同一互斥组内的角色不能被同一用户在重叠的生效时间窗口内同时持有；max_assignments 为 0 表示不限。
+
+ALTER TABLE "role_definitions" ADD COLUMN IF NOT EXISTS "exclusive_groups" jsonb NOT NULL DEFAULT '[]';
+ALTER TABLE "role_definitions" ADD COLUMN IF NOT EXISTS "max_assignments" integer NOT NULL DEFAULT 0;
+COMMENT ON COLUMN "role_definitions"."exclusive_groups" IS '互斥角色组,同组角色不能被同一用户同时持有';
+COMMENT ON COLUMN "role_definitions"."max_assignments" IS '最多可分配的用户数,0表示不限';
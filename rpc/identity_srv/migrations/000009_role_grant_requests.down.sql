@@ -0,0 +1,4 @@
+-- 回滚敏感角色授权审批
+
+DROP TABLE IF EXISTS "role_grant_requests";
+ALTER TABLE "role_definitions" DROP COLUMN IF EXISTS "requires_approval";
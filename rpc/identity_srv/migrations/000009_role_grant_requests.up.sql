@@ -0,0 +1,53 @@
+-- 敏感角色授权审批：系统角色或标记为需审批的角色在分配时先生成待审批申请，
+-- 审批通过后才写入 user_role_assignments；过期未处理的申请由后台任务置为已过期。
+
+ALTER TABLE "role_definitions" ADD COLUMN IF NOT EXISTS "requires_approval" boolean NOT NULL DEFAULT false;
+COMMENT ON COLUMN "role_definitions"."requires_approval" IS '分配该角色是否需要审批';
+
+CREATE TABLE IF NOT EXISTS "role_grant_requests" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "version" bigint NOT NULL DEFAULT 1,
+    "user_id" uuid NOT NULL,
+    "role_id" uuid NOT NULL,
+    "organization_id" uuid,
+    "valid_from" bigint,
+    "valid_until" bigint,
+    "requested_by" uuid,
+    "reason" text,
+    "status" smallint NOT NULL,
+    "expires_at" bigint NOT NULL,
+    "decided_by" uuid,
+    "decided_at" bigint,
+    "decision_comment" text,
+    "assignment_id" uuid,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_role_grant_requests_created_at" ON "role_grant_requests" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_role_grant_requests_deleted_at" ON "role_grant_requests" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_role_grant_requests_updated_at" ON "role_grant_requests" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_role_grant_requests_user_id" ON "role_grant_requests" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_role_grant_requests_role_id" ON "role_grant_requests" ("role_id");
+CREATE INDEX IF NOT EXISTS "idx_role_grant_requests_requested_by" ON "role_grant_requests" ("requested_by");
+CREATE INDEX IF NOT EXISTS "idx_role_grant_requests_status" ON "role_grant_requests" ("status");
+CREATE INDEX IF NOT EXISTS "idx_role_grant_requests_expires_at" ON "role_grant_requests" ("expires_at");
+COMMENT ON COLUMN "role_grant_requests"."id" IS '主键';
+COMMENT ON COLUMN "role_grant_requests"."created_at" IS '创建时间';
+COMMENT ON COLUMN "role_grant_requests"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "role_grant_requests"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "role_grant_requests"."version" IS '乐观锁版本号';
+COMMENT ON COLUMN "role_grant_requests"."user_id" IS '被授权用户ID';
+COMMENT ON COLUMN "role_grant_requests"."role_id" IS '申请的角色ID';
+COMMENT ON COLUMN "role_grant_requests"."organization_id" IS '申请分配的组织ID，为空表示全局分配';
+COMMENT ON COLUMN "role_grant_requests"."valid_from" IS '申请的生效开始时间';
+COMMENT ON COLUMN "role_grant_requests"."valid_until" IS '申请的生效截止时间';
+COMMENT ON COLUMN "role_grant_requests"."requested_by" IS '申请人ID';
+COMMENT ON COLUMN "role_grant_requests"."reason" IS '申请理由';
+COMMENT ON COLUMN "role_grant_requests"."status" IS '状态:1-待审批,2-已通过,3-已驳回,4-已过期';
+COMMENT ON COLUMN "role_grant_requests"."expires_at" IS '审批截止时间（毫秒时间戳）';
+COMMENT ON COLUMN "role_grant_requests"."decided_by" IS '审批人ID';
+COMMENT ON COLUMN "role_grant_requests"."decided_at" IS '审批时间（毫秒时间戳）';
+COMMENT ON COLUMN "role_grant_requests"."decision_comment" IS '审批意见';
+COMMENT ON COLUMN "role_grant_requests"."assignment_id" IS '审批通过后生成的角色分配ID';
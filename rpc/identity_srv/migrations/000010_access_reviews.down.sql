@@ -0,0 +1,4 @@
+-- 回滚访问复核
+
+DROP TABLE IF EXISTS "access_review_items";
+DROP TABLE IF EXISTS "access_review_campaigns";
@@ -0,0 +1,87 @@
+-- 访问复核：按组织/角色范围创建复核活动，把当时有效的角色分配快照为复核条目，
+-- 复核人逐条确认保留或撤销；关闭活动时可自动撤销仍未复核的分配。
+
+CREATE TABLE IF NOT EXISTS "access_review_campaigns" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "version" bigint NOT NULL DEFAULT 1,
+    "name" varchar(100) NOT NULL,
+    "description" text,
+    "organization_id" uuid,
+    "role_id" uuid,
+    "reviewer_id" uuid,
+    "status" smallint NOT NULL,
+    "due_at" bigint,
+    "auto_revoke" boolean NOT NULL DEFAULT false,
+    "created_by" uuid,
+    "closed_by" uuid,
+    "closed_at" bigint,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_access_review_campaigns_created_at" ON "access_review_campaigns" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_access_review_campaigns_deleted_at" ON "access_review_campaigns" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_access_review_campaigns_updated_at" ON "access_review_campaigns" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_access_review_campaigns_organization_id" ON "access_review_campaigns" ("organization_id");
+CREATE INDEX IF NOT EXISTS "idx_access_review_campaigns_role_id" ON "access_review_campaigns" ("role_id");
+CREATE INDEX IF NOT EXISTS "idx_access_review_campaigns_reviewer_id" ON "access_review_campaigns" ("reviewer_id");
+CREATE INDEX IF NOT EXISTS "idx_access_review_campaigns_status" ON "access_review_campaigns" ("status");
+CREATE INDEX IF NOT EXISTS "idx_access_review_campaigns_due_at" ON "access_review_campaigns" ("due_at");
+COMMENT ON COLUMN "access_review_campaigns"."id" IS '主键';
+COMMENT ON COLUMN "access_review_campaigns"."created_at" IS '创建时间';
+COMMENT ON COLUMN "access_review_campaigns"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "access_review_campaigns"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "access_review_campaigns"."version" IS '乐观锁版本号';
+COMMENT ON COLUMN "access_review_campaigns"."name" IS '活动名称';
+COMMENT ON COLUMN "access_review_campaigns"."description" IS '活动说明';
+COMMENT ON COLUMN "access_review_campaigns"."organization_id" IS '复核范围：组织ID，为空表示全部';
+COMMENT ON COLUMN "access_review_campaigns"."role_id" IS '复核范围：角色ID，为空表示全部角色';
+COMMENT ON COLUMN "access_review_campaigns"."reviewer_id" IS '指定复核人ID，为空表示拥有复核权限的任意用户';
+COMMENT ON COLUMN "access_review_campaigns"."status" IS '状态:1-进行中,2-已关闭';
+COMMENT ON COLUMN "access_review_campaigns"."due_at" IS '截止时间（毫秒时间戳），过期后自动关闭';
+COMMENT ON COLUMN "access_review_campaigns"."auto_revoke" IS '关闭时是否自动撤销未复核的分配';
+COMMENT ON COLUMN "access_review_campaigns"."created_by" IS '创建人ID';
+COMMENT ON COLUMN "access_review_campaigns"."closed_by" IS '关闭人ID，为空表示到期自动关闭';
+COMMENT ON COLUMN "access_review_campaigns"."closed_at" IS '关闭时间（毫秒时间戳）';
+
+CREATE TABLE IF NOT EXISTS "access_review_items" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "version" bigint NOT NULL DEFAULT 1,
+    "campaign_id" uuid NOT NULL,
+    "assignment_id" uuid NOT NULL,
+    "user_id" uuid NOT NULL,
+    "role_id" uuid NOT NULL,
+    "organization_id" uuid,
+    "valid_until" bigint,
+    "decision" smallint NOT NULL,
+    "reviewed_by" uuid,
+    "reviewed_at" bigint,
+    "comment" text,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_access_review_items_created_at" ON "access_review_items" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_access_review_items_deleted_at" ON "access_review_items" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_access_review_items_updated_at" ON "access_review_items" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_access_review_items_campaign_id" ON "access_review_items" ("campaign_id");
+CREATE INDEX IF NOT EXISTS "idx_access_review_items_assignment_id" ON "access_review_items" ("assignment_id");
+CREATE INDEX IF NOT EXISTS "idx_access_review_items_user_id" ON "access_review_items" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_access_review_items_decision" ON "access_review_items" ("decision");
+COMMENT ON COLUMN "access_review_items"."id" IS '主键';
+COMMENT ON COLUMN "access_review_items"."created_at" IS '创建时间';
+COMMENT ON COLUMN "access_review_items"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "access_review_items"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "access_review_items"."version" IS '乐观锁版本号';
+COMMENT ON COLUMN "access_review_items"."campaign_id" IS '所属复核活动ID';
+COMMENT ON COLUMN "access_review_items"."assignment_id" IS '被复核的角色分配ID';
+COMMENT ON COLUMN "access_review_items"."user_id" IS '用户ID';
+COMMENT ON COLUMN "access_review_items"."role_id" IS '角色ID';
+COMMENT ON COLUMN "access_review_items"."organization_id" IS '分配的组织ID，为空表示全局分配';
+COMMENT ON COLUMN "access_review_items"."valid_until" IS '分配的生效截止时间';
+COMMENT ON COLUMN "access_review_items"."decision" IS '复核结论:1-待复核,2-确认保留,3-撤销,4-自动撤销';
+COMMENT ON COLUMN "access_review_items"."reviewed_by" IS '复核人ID';
+COMMENT ON COLUMN "access_review_items"."reviewed_at" IS '复核时间（毫秒时间戳）';
+COMMENT ON COLUMN "access_review_items"."comment" IS '复核意见';
@@ -0,0 +1,3 @@
+-- 回滚自助找回密码令牌
+
+DROP TABLE IF EXISTS "password_reset_tokens";
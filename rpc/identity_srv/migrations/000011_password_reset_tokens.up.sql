@@ -0,0 +1,31 @@
+-- 自助找回密码：按用户名/邮箱/手机号发起，签发一次性令牌并通过通知渠道送达，
+-- 库中只保存令牌的 SHA-256 哈希；令牌使用、过期或被新令牌取代后失效。
+
+CREATE TABLE IF NOT EXISTS "password_reset_tokens" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "version" bigint NOT NULL DEFAULT 1,
+    "user_id" uuid NOT NULL,
+    "token_hash" varchar(64) NOT NULL,
+    "expires_at" bigint NOT NULL,
+    "used_at" bigint,
+    "request_ip" varchar(64),
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_password_reset_tokens_created_at" ON "password_reset_tokens" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_password_reset_tokens_deleted_at" ON "password_reset_tokens" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_password_reset_tokens_updated_at" ON "password_reset_tokens" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_password_reset_tokens_user_id" ON "password_reset_tokens" ("user_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_password_reset_tokens_token_hash" ON "password_reset_tokens" ("token_hash");
+COMMENT ON COLUMN "password_reset_tokens"."id" IS '主键';
+COMMENT ON COLUMN "password_reset_tokens"."created_at" IS '创建时间';
+COMMENT ON COLUMN "password_reset_tokens"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "password_reset_tokens"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "password_reset_tokens"."version" IS '乐观锁版本号';
+COMMENT ON COLUMN "password_reset_tokens"."user_id" IS '用户ID';
+COMMENT ON COLUMN "password_reset_tokens"."token_hash" IS '令牌SHA-256哈希';
+COMMENT ON COLUMN "password_reset_tokens"."expires_at" IS '过期时间（毫秒时间戳）';
+COMMENT ON COLUMN "password_reset_tokens"."used_at" IS '失效时间（毫秒时间戳），NULL 表示未使用';
+COMMENT ON COLUMN "password_reset_tokens"."request_ip" IS '发起请求的客户端IP';
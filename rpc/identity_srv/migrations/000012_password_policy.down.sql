@@ -0,0 +1,4 @@
+-- 回滚组织级密码策略
+
+DROP TABLE IF EXISTS "password_histories";
+ALTER TABLE "user_profiles" DROP COLUMN IF EXISTS "password_changed_at";
@@ -0,0 +1,3 @@
+-- 回滚组织级密码策略配置
+
+DROP TABLE IF EXISTS "organization_password_policies";
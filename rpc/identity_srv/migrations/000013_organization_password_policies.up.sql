@@ -0,0 +1,30 @@
+-- 组织级密码策略：组织可单独配置最短长度、字符种类数、历史密码数与最长使用期限，
+-- 未配置的组织沿用全局默认策略（PASSWORD_POLICY_*）；禁用密码字典仍为全局配置。
+
+CREATE TABLE IF NOT EXISTS "organization_password_policies" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "version" bigint NOT NULL DEFAULT 1,
+    "organization_id" uuid NOT NULL,
+    "min_length" integer NOT NULL,
+    "min_char_classes" integer NOT NULL,
+    "history_size" integer NOT NULL,
+    "max_age_hours" integer NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_organization_password_policies_created_at" ON "organization_password_policies" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_organization_password_policies_deleted_at" ON "organization_password_policies" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_organization_password_policies_updated_at" ON "organization_password_policies" ("updated_at");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_organization_password_policies_organization_id" ON "organization_password_policies" ("organization_id");
+COMMENT ON COLUMN "organization_password_policies"."id" IS '主键';
+COMMENT ON COLUMN "organization_password_policies"."created_at" IS '创建时间';
+COMMENT ON COLUMN "organization_password_policies"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "organization_password_policies"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "organization_password_policies"."version" IS '乐观锁版本号';
+COMMENT ON COLUMN "organization_password_policies"."organization_id" IS '组织ID';
+COMMENT ON COLUMN "organization_password_policies"."min_length" IS '密码最短长度（按字符计）';
+COMMENT ON COLUMN "organization_password_policies"."min_char_classes" IS '至少包含的字符种类数（0-4）';
+COMMENT ON COLUMN "organization_password_policies"."history_size" IS '禁止复用最近N个密码，0表示不检查';
+COMMENT ON COLUMN "organization_password_policies"."max_age_hours" IS '密码最长使用期限（小时），0表示永不过期';
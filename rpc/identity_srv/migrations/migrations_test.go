@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/dbmigrate"
+)
+
+func TestFS_Loads(t *testing.T) {
+	migrations, err := dbmigrate.Load(FS, ".")
+
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+	assert.Equal(t, int64(1), migrations[0].Version)
+	assert.Equal(t, "baseline", migrations[0].Name)
+
+	for i := 1; i < len(migrations); i++ {
+		assert.Greater(t, migrations[i].Version, migrations[i-1].Version)
+	}
+}
+
+// menus.version 是 baseline 中的菜单上传版本号（idx_psv），乐观锁迁移不得新增或回滚它
+func TestEntityVersion_KeepsMenuUploadVersion(t *testing.T) {
+	migrations, err := dbmigrate.Load(FS, ".")
+	require.NoError(t, err)
+
+	for _, m := range migrations {
+		if m.Name != "entity_version" {
+			continue
+		}
+
+		assert.NotContains(t, m.Up, `"menus"`)
+		assert.NotContains(t, m.Down, `"menus"`)
+
+		return
+	}
+
+	t.Fatal("entity_version migration not found")
+}
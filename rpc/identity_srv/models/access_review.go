@@ -0,0 +1,87 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+// AccessReviewCampaignStatus 访问复核活动状态
+type AccessReviewCampaignStatus int8
+
+const (
+	AccessReviewCampaignStatusOpen   AccessReviewCampaignStatus = 1 // 进行中
+	AccessReviewCampaignStatusClosed AccessReviewCampaignStatus = 2 // 已关闭
+)
+
+// AccessReviewDecision 访问复核条目的复核结论
+type AccessReviewDecision int8
+
+const (
+	AccessReviewDecisionPending     AccessReviewDecision = 1 // 待复核
+	AccessReviewDecisionCertified   AccessReviewDecision = 2 // 确认保留
+	AccessReviewDecisionRevoked     AccessReviewDecision = 3 // 复核人撤销
+	AccessReviewDecisionAutoRevoked AccessReviewDecision = 4 // 活动关闭时未复核，自动撤销
+)
+
+// AccessReviewCampaign 访问复核活动
+//
+// 合规要求定期（如每季度）对角色分配重新认证。创建活动时按组织/角色范围
+// 把当时有效的 UserRoleAssignment 快照为 AccessReviewItem，复核人逐条确认保留或撤销；
+// 关闭活动时若 AutoRevoke 为 true，仍未复核的分配会被自动撤销。
+type AccessReviewCampaign struct {
+	BaseModel
+
+	Name        string `gorm:"column:name;size:100;not null;comment:活动名称"`
+	Description string `gorm:"column:description;type:text;comment:活动说明"`
+
+	OrganizationID *uuid.UUID `gorm:"column:organization_id;type:uuid;index;comment:复核范围：组织ID，为空表示全部"`
+	RoleID         *uuid.UUID `gorm:"column:role_id;type:uuid;index;comment:复核范围：角色ID，为空表示全部角色"`
+	ReviewerID     *uuid.UUID `gorm:"column:reviewer_id;type:uuid;index;comment:指定复核人ID，为空表示拥有复核权限的任意用户"`
+
+	Status     AccessReviewCampaignStatus `gorm:"column:status;not null;index;comment:状态:1-进行中,2-已关闭"`
+	DueAt      *int64                     `gorm:"column:due_at;index;comment:截止时间（毫秒时间戳），过期后自动关闭"`
+	AutoRevoke bool                       `gorm:"column:auto_revoke;not null;default:false;comment:关闭时是否自动撤销未复核的分配"`
+
+	CreatedBy *uuid.UUID `gorm:"column:created_by;type:uuid;comment:创建人ID"`
+	ClosedBy  *uuid.UUID `gorm:"column:closed_by;type:uuid;comment:关闭人ID，为空表示到期自动关闭"`
+	ClosedAt  *int64     `gorm:"column:closed_at;comment:关闭时间（毫秒时间戳）"`
+}
+
+// TableName 指定表名
+func (AccessReviewCampaign) TableName() string {
+	return "access_review_campaigns"
+}
+
+// IsOpen 活动是否仍在进行中
+func (c *AccessReviewCampaign) IsOpen() bool {
+	return c.Status == AccessReviewCampaignStatusOpen
+}
+
+// AccessReviewItem 访问复核条目
+//
+// 记录创建活动时的角色分配快照；分配被撤销后 AssignmentID 指向的记录可能已删除，
+// 条目本身保留作为复核留痕。
+type AccessReviewItem struct {
+	BaseModel
+
+	CampaignID     uuid.UUID  `gorm:"column:campaign_id;type:uuid;not null;index;comment:所属复核活动ID"`
+	AssignmentID   uuid.UUID  `gorm:"column:assignment_id;type:uuid;not null;index;comment:被复核的角色分配ID"`
+	UserID         uuid.UUID  `gorm:"column:user_id;type:uuid;not null;index;comment:用户ID"`
+	RoleID         uuid.UUID  `gorm:"column:role_id;type:uuid;not null;comment:角色ID"`
+	OrganizationID *uuid.UUID `gorm:"column:organization_id;type:uuid;comment:分配的组织ID，为空表示全局分配"`
+	ValidUntil     *int64     `gorm:"column:valid_until;comment:分配的生效截止时间"`
+
+	Decision   AccessReviewDecision `gorm:"column:decision;not null;index;comment:复核结论:1-待复核,2-确认保留,3-撤销,4-自动撤销"`
+	ReviewedBy *uuid.UUID           `gorm:"column:reviewed_by;type:uuid;comment:复核人ID"`
+	ReviewedAt *int64               `gorm:"column:reviewed_at;comment:复核时间（毫秒时间戳）"`
+	Comment    string               `gorm:"column:comment;type:text;comment:复核意见"`
+}
+
+// TableName 指定表名
+func (AccessReviewItem) TableName() string {
+	return "access_review_items"
+}
+
+// IsPending 条目是否仍待复核
+func (i *AccessReviewItem) IsPending() bool {
+	return i.Decision == AccessReviewDecisionPending
+}
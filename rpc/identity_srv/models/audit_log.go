@@ -8,12 +8,19 @@ import (
 type AuditAction int8
 
 const (
-	AuditActionCreate         AuditAction = 1
-	AuditActionUpdate         AuditAction = 2
-	AuditActionDelete         AuditAction = 3
-	AuditActionLogin          AuditAction = 4
-	AuditActionLogout         AuditAction = 5
-	AuditActionPasswordChange AuditAction = 6
+	AuditActionCreate               AuditAction = 1
+	AuditActionUpdate               AuditAction = 2
+	AuditActionDelete               AuditAction = 3
+	AuditActionLogin                AuditAction = 4
+	AuditActionLogout               AuditAction = 5
+	AuditActionPasswordChange       AuditAction = 6
+	AuditActionBreakGlass           AuditAction = 7  // 紧急账号绕过策略决策
+	AuditActionRoleGrantRequest     AuditAction = 8  // 发起敏感角色授权申请
+	AuditActionRoleGrantApprove     AuditAction = 9  // 通过敏感角色授权申请
+	AuditActionRoleGrantReject      AuditAction = 10 // 驳回敏感角色授权申请
+	AuditActionRoleGrantExpire      AuditAction = 11 // 敏感角色授权申请过期
+	AuditActionPasswordResetRequest AuditAction = 12 // 签发自助找回密码令牌
+	AuditActionPasswordReset        AuditAction = 13 // 凭找回令牌重置密码
 )
 
 // AuditLog 审计日志模型
@@ -41,3 +48,8 @@ type AuditLog struct {
 func (AuditLog) TableName() string {
 	return "audit_logs"
 }
+
+// CursorKey 游标分页的排序键 (created_at, id)
+func (a AuditLog) CursorKey() (int64, string) {
+	return a.CreatedAt, a.ID.String()
+}
@@ -10,4 +10,41 @@ type BaseModel struct {
 	CreatedAt int64          `gorm:"column:created_at;autoCreateTime:milli;index;comment:创建时间"`
 	UpdatedAt int64          `gorm:"column:updated_at;autoUpdateTime:milli;index;comment:更新时间"`
 	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index;comment:删除时间"`
+	Version   int64          `gorm:"column:version;not null;default:1;comment:乐观锁版本号"`
+}
+
+// GetVersion 获取乐观锁版本号
+func (m *BaseModel) GetVersion() int64 {
+	return m.Version
+}
+
+// SetVersion 设置乐观锁版本号
+func (m *BaseModel) SetVersion(version int64) {
+	m.Version = version
+}
+
+// Versioned 支持乐观锁的模型，嵌入 BaseModel 的模型均满足该接口
+type Versioned interface {
+	GetVersion() int64
+	SetVersion(version int64)
+}
+
+// CursorKey 游标分页的排序键 (created_at, id)
+func (m BaseModel) CursorKey() (int64, string) {
+	return m.CreatedAt, m.ID.String()
+}
+
+// UnversionedModel 不含乐观锁版本号的基础模型
+// 用于 version 列已有业务含义的表（如 menus 的菜单上传版本）或只追加不修改的表（如 password_histories），
+// 这类模型不满足 Versioned，仓储更新时不做版本校验
+type UnversionedModel struct {
+	ID        uuid.UUID      `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid();comment:主键"`
+	CreatedAt int64          `gorm:"column:created_at;autoCreateTime:milli;index;comment:创建时间"`
+	UpdatedAt int64          `gorm:"column:updated_at;autoUpdateTime:milli;index;comment:更新时间"`
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index;comment:删除时间"`
+}
+
+// CursorKey 游标分页的排序键 (created_at, id)
+func (m UnversionedModel) CursorKey() (int64, string) {
+	return m.CreatedAt, m.ID.String()
 }
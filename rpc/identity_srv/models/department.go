@@ -5,6 +5,8 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/search"
 )
 
 // Department 部门/部门模型 (与 IDL identity_model.Department 对应)
@@ -17,6 +19,9 @@ type Department struct {
 	// 部门属性
 	DepartmentType     string `gorm:"column:department_type;size:100;index;comment:部门类型"`
 	AvailableEquipment string `gorm:"column:available_equipment;type:text;comment:JSON 存储 list<ULID>"`
+
+	// 检索文本（原文 + 拼音全拼 + 首字母），写入时由钩子生成
+	SearchTokens string `gorm:"column:search_tokens;type:text;comment:检索文本"`
 }
 
 // TableName 指定表名
@@ -26,14 +31,36 @@ func (Department) TableName() string {
 
 // BeforeCreate GORM钩子
 func (d *Department) BeforeCreate(tx *gorm.DB) error {
+	d.RefreshSearchTokens()
+
 	return d.validateFields(tx)
 }
 
 // BeforeUpdate GORM钩子
 func (d *Department) BeforeUpdate(tx *gorm.DB) error {
+	d.RefreshSearchTokens()
+
 	return d.validateFields(tx)
 }
 
+// RefreshSearchTokens 根据当前字段重新生成检索文本
+func (d *Department) RefreshSearchTokens() {
+	d.SearchTokens = search.NewBuilder().Add(d.Name).Add(d.DepartmentType).String()
+}
+
+// SearchID 搜索命中信息中的记录 ID
+func (d *Department) SearchID() string {
+	return d.ID.String()
+}
+
+// SearchFields 参与搜索命中计算的字段，顺序即同等级命中时的优先级
+func (d *Department) SearchFields() []search.Field {
+	return []search.Field{
+		{Name: "name", Value: d.Name},
+		{Name: "department_type", Value: d.DepartmentType},
+	}
+}
+
 // validateFields 验证字段
 func (d *Department) validateFields(tx *gorm.DB) error {
 	if d.Name == "" {
@@ -8,8 +8,9 @@ import (
 // Menu 菜单模型
 // 用于在数据库中存储和管理层级菜单结构。
 // 注意：此模型使用原生 UUID 作为主键，这是为了遵循 PostgreSQL 的最佳实践。
+// version 列是菜单上传版本号，因此不嵌入带乐观锁版本号的 BaseModel。
 type Menu struct {
-	BaseModel
+	UnversionedModel
 
 	// 产品线标识 (用于多租户/多产品线隔离)
 	ProductLine string     `gorm:"column:product_line;not null;size:50;index:idx_psv,priority:1;comment:产品线标识"`
@@ -27,12 +28,38 @@ type Menu struct {
 
 	// Casbin 权限扩展字段
 	PermCode string `gorm:"column:perm_code;size:100;index;comment:权限编码,如 emr:create, patient:read"`
+	// Deprecated: 已由 ApiPaths 子表取代，保留列仅为兼容存量数据
 	ApiPath  string `gorm:"column:api_path;size:255;comment:关联的API路径,如 /api/v1/patients"`
 	IsButton bool   `gorm:"column:is_button;default:false;comment:是否为按钮级权限(非菜单项)"`
 
 	// 关联关系
-	Parent   *Menu   `gorm:"foreignKey:ParentID;references:ID;comment:父菜单关联"`
-	Children []*Menu `gorm:"foreignKey:ParentID;references:ID;comment:子菜单列表关联"`
+	Parent   *Menu          `gorm:"foreignKey:ParentID;references:ID;comment:父菜单关联"`
+	Children []*Menu        `gorm:"foreignKey:ParentID;references:ID;comment:子菜单列表关联"`
+	ApiPaths []*MenuApiPath `gorm:"foreignKey:MenuID;references:ID;comment:关联的API路径"`
+}
+
+// MenuApiPath 菜单关联的 API 路径
+// 随菜单版本一起创建、不单独更新，删除菜单时级联删除。
+type MenuApiPath struct {
+	ID     uuid.UUID `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid();comment:主键"`
+	MenuID uuid.UUID `gorm:"column:menu_id;not null;type:uuid;uniqueIndex:idx_menu_api_paths_menu_path,priority:1;comment:所属菜单ID"`
+	Path   string    `gorm:"column:path;not null;size:255;uniqueIndex:idx_menu_api_paths_menu_path,priority:2;index;comment:API路径模式"`
+	Sort   int       `gorm:"column:sort;not null;default:0;comment:在菜单配置中的顺序"`
+}
+
+// MenuVersionSummary 菜单版本摘要
+// 按 (product_line, version) 聚合 menus 表得到，不对应独立的表。
+type MenuVersionSummary struct {
+	Version     int        `gorm:"column:version"`
+	ContentHash string     `gorm:"column:content_hash"`
+	NodeCount   int64      `gorm:"column:node_count"`
+	CreatedAt   int64      `gorm:"column:created_at"`
+	CreatedBy   *uuid.UUID `gorm:"column:created_by"`
+}
+
+// TableName 指定 MenuApiPath 模型对应的数据库表名。
+func (MenuApiPath) TableName() string {
+	return "menu_api_paths"
 }
 
 // TableName 指定 Menu 模型对应的数据库表名。
@@ -76,3 +103,13 @@ func (m *Menu) GetCasbinApiObject() string {
 
 	return ""
 }
+
+// GetApiPaths 按配置顺序返回菜单关联的 API 路径
+func (m *Menu) GetApiPaths() []string {
+	paths := make([]string, 0, len(m.ApiPaths))
+	for _, p := range m.ApiPaths {
+		paths = append(paths, p.Path)
+	}
+
+	return paths
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/search"
 )
 
 // StringSlice 自定义切片类型，用于JSON存储
@@ -53,6 +55,12 @@ type Organization struct {
 	FacilityType        string      `gorm:"column:facility_type;size:100;index;comment:组织类型"`
 	AccreditationStatus string      `gorm:"column:accreditation_status;size:100;comment:认证状态"`
 	ProvinceCity        StringSlice `gorm:"column:province_city;type:json;comment:组织所在省市列表"`
+
+	// 安全策略
+	MFARequired bool `gorm:"column:mfa_required;not null;default:false;comment:成员登录是否必须启用多因素认证"`
+
+	// 检索文本（原文 + 拼音全拼 + 首字母），写入时由钩子生成
+	SearchTokens string `gorm:"column:search_tokens;type:text;comment:检索文本"`
 }
 
 // TableName 指定表名
@@ -72,14 +80,37 @@ func (o *Organization) BeforeCreate(tx *gorm.DB) error {
 		o.Code = code
 	}
 
+	o.RefreshSearchTokens()
+
 	return o.validateFields(tx)
 }
 
 // BeforeUpdate GORM钩子
 func (o *Organization) BeforeUpdate(tx *gorm.DB) error {
+	o.RefreshSearchTokens()
+
 	return o.validateFields(tx)
 }
 
+// RefreshSearchTokens 根据当前字段重新生成检索文本
+func (o *Organization) RefreshSearchTokens() {
+	o.SearchTokens = search.NewBuilder().Add(o.Name).Add(o.Code).Add(o.FacilityType).String()
+}
+
+// SearchID 搜索命中信息中的记录 ID
+func (o *Organization) SearchID() string {
+	return o.ID.String()
+}
+
+// SearchFields 参与搜索命中计算的字段，顺序即同等级命中时的优先级
+func (o *Organization) SearchFields() []search.Field {
+	return []search.Field{
+		{Name: "name", Value: o.Name},
+		{Name: "code", Value: o.Code},
+		{Name: "facility_type", Value: o.FacilityType},
+	}
+}
+
 // validateFields 验证字段（简化版，支持2级层级）
 func (o *Organization) validateFields(tx *gorm.DB) error {
 	if o.Name == "" {
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// OrganizationPasswordPolicy 组织单独配置的密码策略
+//
+// 每个组织至多一条记录，没有记录的组织沿用全局默认策略（PASSWORD_POLICY_*）。
+// 禁用密码字典为全局配置，不在此保存。
+type OrganizationPasswordPolicy struct {
+	BaseModel
+
+	OrganizationID uuid.UUID `gorm:"column:organization_id;type:uuid;not null;uniqueIndex;comment:组织ID"`
+
+	MinLength      int32 `gorm:"column:min_length;not null;comment:密码最短长度（按字符计）"`
+	MinCharClasses int32 `gorm:"column:min_char_classes;not null;comment:至少包含的字符种类数（0-4）"`
+	HistorySize    int32 `gorm:"column:history_size;not null;comment:禁止复用最近N个密码，0表示不检查"`
+	MaxAgeHours    int32 `gorm:"column:max_age_hours;not null;comment:密码最长使用期限（小时），0表示永不过期"`
+}
+
+// TableName 指定表名
+func (OrganizationPasswordPolicy) TableName() string {
+	return "organization_password_policies"
+}
+
+// BeforeCreate GORM 钩子
+func (p *OrganizationPasswordPolicy) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+
+	return nil
+}
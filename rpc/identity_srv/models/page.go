@@ -18,6 +18,10 @@ type PageResult struct {
 	TotalPages int32 `json:"total_pages"` // 总页数
 	HasNext    bool  `json:"has_next"`    // 是否有下一页
 	HasPrev    bool  `json:"has_prev"`    // 是否有上一页
+
+	// 游标分页
+	NextCursor   string `json:"next_cursor,omitempty"` // 下一页游标，没有下一页时为空
+	TotalSkipped bool   `json:"-"`                     // 未统计总数（Total/TotalPages 无意义）
 }
 
 // NewPageOptions 创建分页选项
@@ -67,3 +71,14 @@ func NewPageResult(total, page, limit int32) *PageResult {
 		HasPrev:    page > 1,
 	}
 }
+
+// NewPageResultWithoutTotal 创建未统计总数的分页结果，hasNext 由多取一条记录判断
+func NewPageResultWithoutTotal(page, limit int32, hasNext bool) *PageResult {
+	return &PageResult{
+		Page:         page,
+		Limit:        limit,
+		HasNext:      hasNext,
+		HasPrev:      page > 1,
+		TotalSkipped: true,
+	}
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// PasswordHistory 用户历史密码，只保存 bcrypt 哈希
+//
+// 每次设置密码（创建、导入、修改、重置、找回）都追加一条，用于禁止复用最近 N 个密码；
+// 超出保留数量的旧记录在写入时被物理删除。只追加不修改，不需要乐观锁版本号。
+type PasswordHistory struct {
+	UnversionedModel
+
+	UserID       uuid.UUID `gorm:"column:user_id;type:uuid;not null;index;comment:用户ID"`
+	PasswordHash string    `gorm:"column:password_hash;not null;size:255;comment:密码哈希"`
+}
+
+// TableName 指定表名
+func (PasswordHistory) TableName() string {
+	return "password_histories"
+}
+
+// BeforeCreate GORM 钩子
+func (h *PasswordHistory) BeforeCreate(tx *gorm.DB) error {
+	if h.ID == uuid.Nil {
+		h.ID = uuid.New()
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// PasswordResetToken 自助找回密码的一次性令牌，仅保存令牌的 SHA-256 哈希
+//
+// 令牌明文只出现在发给用户的通知中；使用、过期或被同一用户新签发的令牌取代后均不可再用。
+type PasswordResetToken struct {
+	BaseModel
+
+	UserID    uuid.UUID `gorm:"column:user_id;type:uuid;not null;index;comment:用户ID"`
+	TokenHash string    `gorm:"column:token_hash;size:64;not null;uniqueIndex;comment:令牌SHA-256哈希"`
+
+	ExpiresAt int64  `gorm:"column:expires_at;not null;comment:过期时间（毫秒时间戳）"`
+	UsedAt    *int64 `gorm:"column:used_at;comment:失效时间（毫秒时间戳），NULL 表示未使用"`
+
+	RequestIP string `gorm:"column:request_ip;size:64;comment:发起请求的客户端IP"`
+}
+
+// TableName 指定表名
+func (PasswordResetToken) TableName() string {
+	return "password_reset_tokens"
+}
+
+// BeforeCreate GORM 钩子
+func (t *PasswordResetToken) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+
+	return nil
+}
+
+// IsUsable 判断令牌在给定时刻（毫秒时间戳）是否仍可使用
+func (t *PasswordResetToken) IsUsable(nowMillis int64) bool {
+	return t.UsedAt == nil && t.ExpiresAt > nowMillis
+}
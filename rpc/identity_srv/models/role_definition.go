@@ -48,6 +48,8 @@ const (
 	DataScopeDept DataScopeType = 2
 	// DataScopeOrg 全院/组织数据
 	DataScopeOrg DataScopeType = 3
+	// DataScopeAll 不限范围（跨租户，仅 superadmin 通配策略会给出）
+	DataScopeAll DataScopeType = 4
 )
 
 // String 返回数据范围类型的字符串表示
@@ -59,11 +61,29 @@ func (d DataScopeType) String() string {
 		return "dept"
 	case DataScopeOrg:
 		return "org"
+	case DataScopeAll:
+		return "all"
 	default:
 		return "unknown"
 	}
 }
 
+// ParseDataScopeType 解析 policy_srv 返回的 data_scope 提示（self/dept/org/all）
+func ParseDataScopeType(s string) (DataScopeType, bool) {
+	switch s {
+	case "self":
+		return DataScopeSelf, true
+	case "dept":
+		return DataScopeDept, true
+	case "org":
+		return DataScopeOrg, true
+	case "all":
+		return DataScopeAll, true
+	default:
+		return 0, false
+	}
+}
+
 // RoleDefinition 角色定义模型
 type RoleDefinition struct {
 	BaseModel
@@ -82,6 +102,13 @@ type RoleDefinition struct {
 	DepartmentID *uuid.UUID    `gorm:"column:department_id;type:uuid;index;comment:绑定科室ID,NULL表示全院通用角色"`
 	DefaultScope DataScopeType `gorm:"column:default_scope;default:1;comment:默认数据范围:1-本人,2-本科室,3-全院"`
 
+	// 职责分离约束
+	ExclusiveGroups StringSlice `gorm:"column:exclusive_groups;type:jsonb;not null;default:'[]';comment:互斥角色组,同组角色不能被同一用户同时持有"`
+	MaxAssignments  int32       `gorm:"column:max_assignments;not null;default:0;comment:最多可分配的用户数,0表示不限"`
+
+	// RequiresApproval 分配该角色需经审批，系统角色无论此标记均需审批
+	RequiresApproval bool `gorm:"column:requires_approval;not null;default:false;comment:分配该角色是否需要审批"`
+
 	// 关联关系
 	ParentRole *RoleDefinition `gorm:"foreignKey:ParentRoleID;references:ID;comment:父角色关联"`
 
@@ -94,6 +121,11 @@ func (RoleDefinition) TableName() string {
 	return "role_definitions"
 }
 
+// IsSensitive 是否为敏感角色：系统角色或标记为需审批的角色，分配时需走审批流程
+func (r *RoleDefinition) IsSensitive() bool {
+	return r.IsSystemRole || r.RequiresApproval
+}
+
 // BeforeCreate GORM钩子，在创建记录前执行。
 func (r *RoleDefinition) BeforeCreate(tx *gorm.DB) error {
 	// ID 由数据库默认生成，不再需要应用程序处理。
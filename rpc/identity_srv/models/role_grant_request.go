@@ -0,0 +1,51 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+// RoleGrantRequestStatus 敏感角色授权申请状态
+type RoleGrantRequestStatus int8
+
+const (
+	RoleGrantRequestStatusPending  RoleGrantRequestStatus = 1 // 待审批
+	RoleGrantRequestStatusApproved RoleGrantRequestStatus = 2 // 已通过，已生成角色分配
+	RoleGrantRequestStatusRejected RoleGrantRequestStatus = 3 // 已驳回
+	RoleGrantRequestStatusExpired  RoleGrantRequestStatus = 4 // 超过审批期限未处理
+)
+
+// RoleGrantRequest 敏感角色授权申请
+//
+// 分配敏感角色（系统角色或标记为需审批的角色）时不直接写入 UserRoleAssignment，
+// 而是创建一条待审批申请；审批通过后按申请内容生成角色分配，驳回或过期则不生效。
+// 申请字段与 UserRoleAssignment 对应：OrganizationID 为空表示全局分配，
+// ValidFrom/ValidUntil 为申请的生效时间窗口。
+type RoleGrantRequest struct {
+	BaseModel
+
+	UserID         uuid.UUID  `gorm:"column:user_id;type:uuid;not null;index;comment:被授权用户ID"`
+	RoleID         uuid.UUID  `gorm:"column:role_id;type:uuid;not null;index;comment:申请的角色ID"`
+	OrganizationID *uuid.UUID `gorm:"column:organization_id;type:uuid;comment:申请分配的组织ID，为空表示全局分配"`
+	ValidFrom      *int64     `gorm:"column:valid_from;comment:申请的生效开始时间"`
+	ValidUntil     *int64     `gorm:"column:valid_until;comment:申请的生效截止时间"`
+
+	RequestedBy *uuid.UUID             `gorm:"column:requested_by;type:uuid;index;comment:申请人ID"`
+	Reason      string                 `gorm:"column:reason;type:text;comment:申请理由"`
+	Status      RoleGrantRequestStatus `gorm:"column:status;not null;index;comment:状态:1-待审批,2-已通过,3-已驳回,4-已过期"`
+	ExpiresAt   int64                  `gorm:"column:expires_at;not null;index;comment:审批截止时间（毫秒时间戳）"`
+
+	DecidedBy       *uuid.UUID `gorm:"column:decided_by;type:uuid;comment:审批人ID"`
+	DecidedAt       *int64     `gorm:"column:decided_at;comment:审批时间（毫秒时间戳）"`
+	DecisionComment string     `gorm:"column:decision_comment;type:text;comment:审批意见"`
+	AssignmentID    *uuid.UUID `gorm:"column:assignment_id;type:uuid;comment:审批通过后生成的角色分配ID"`
+}
+
+// TableName 指定表名
+func (RoleGrantRequest) TableName() string {
+	return "role_grant_requests"
+}
+
+// IsPending 是否仍待审批
+func (r *RoleGrantRequest) IsPending() bool {
+	return r.Status == RoleGrantRequestStatusPending
+}
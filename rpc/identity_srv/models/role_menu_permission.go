@@ -110,8 +110,9 @@ func ParseDataScope(s string) DataScope {
 type RoleMenuPermission struct {
 	BaseModel
 
-	RoleID         uuid.UUID          `gorm:"column:role_id;type:uuid;not null;index:idx_role_menu,unique;comment:角色ID"`
-	MenuID         string             `gorm:"column:menu_id;size:100;not null;index:idx_role_menu,unique;comment:菜单ID"`
+	RoleID         uuid.UUID          `gorm:"column:role_id;type:uuid;not null;index:idx_role_menu,unique,priority:1;comment:角色ID"`
+	ProductLine    string             `gorm:"column:product_line;size:50;not null;default:default;index:idx_role_menu,unique,priority:2;comment:菜单所属产品线"`
+	MenuID         string             `gorm:"column:menu_id;size:100;not null;index:idx_role_menu,unique,priority:3;comment:菜单ID"`
 	PermissionType MenuPermissionType `gorm:"column:permission_type;not null;default:1;comment:权限类型:无/查看/编辑/管理/完全控制"`
 	DataScope      DataScope          `gorm:"column:data_scope;not null;default:1;comment:数据范围:0-无,1-所在组织,2-所有组织"`
 
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// MFAMethod 多因素认证第二因素类型
+type MFAMethod string
+
+const (
+	// MFAMethodTOTP 验证器应用生成的一次性口令
+	MFAMethodTOTP MFAMethod = "totp"
+	// MFAMethodRecoveryCode 一次性恢复码
+	MFAMethodRecoveryCode MFAMethod = "recovery_code"
+)
+
+// UserMFA 用户 TOTP 多因素认证配置
+//
+// 每个用户至多一条记录。发起登记时写入密钥但保持未启用，
+// 用户用验证器口令确认后才置为启用；确认前重新登记会覆盖密钥。
+type UserMFA struct {
+	BaseModel
+
+	UserID uuid.UUID `gorm:"column:user_id;type:uuid;not null;uniqueIndex;comment:用户ID"`
+
+	// Secret Base32 编码的 TOTP 密钥
+	Secret  string `gorm:"column:secret;size:64;not null;comment:TOTP密钥（Base32）"`
+	Enabled bool   `gorm:"column:enabled;not null;default:false;comment:是否已启用"`
+
+	EnabledAt *int64 `gorm:"column:enabled_at;comment:启用时间（毫秒时间戳）"`
+
+	// LastUsedStep 最近一次校验通过的时间步，用于拒绝口令重放
+	LastUsedStep int64 `gorm:"column:last_used_step;not null;default:0;comment:最近使用的TOTP时间步"`
+}
+
+// TableName 指定表名
+func (UserMFA) TableName() string {
+	return "user_mfa"
+}
+
+// BeforeCreate GORM 钩子
+func (m *UserMFA) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+
+	return nil
+}
+
+// MFARecoveryCode 一次性恢复码，仅保存 SHA-256 哈希
+type MFARecoveryCode struct {
+	BaseModel
+
+	UserID   uuid.UUID `gorm:"column:user_id;type:uuid;not null;index:idx_mfa_recovery_user_hash;comment:用户ID"`
+	CodeHash string    `gorm:"column:code_hash;size:64;not null;index:idx_mfa_recovery_user_hash;comment:恢复码SHA-256哈希"`
+
+	UsedAt *int64 `gorm:"column:used_at;comment:使用时间（毫秒时间戳），NULL 表示未使用"`
+}
+
+// TableName 指定表名
+func (MFARecoveryCode) TableName() string {
+	return "mfa_recovery_codes"
+}
+
+// BeforeCreate GORM 钩子
+func (c *MFARecoveryCode) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+
+	return nil
+}
+
+// IsUsed 判断恢复码是否已使用
+func (c *MFARecoveryCode) IsUsed() bool {
+	return c.UsedAt != nil
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/pkg/search"
 )
 
 // UserProfile 用户档案模型 (与 IDL identity_model.UserProfile 对应)
@@ -37,11 +39,15 @@ type UserProfile struct {
 	LoginAttempts      int32      `gorm:"column:login_attempts;not null;default:0;comment:登录尝试次数"`
 	MustChangePassword bool       `gorm:"column:must_change_password;not null;default:false;comment:是否必须修改密码"`
 	AccountExpiry      *int64     `gorm:"column:account_expiry;comment:账户过期时间"`
+	PasswordChangedAt  *int64     `gorm:"column:password_changed_at;comment:密码最近修改时间"`
 
 	// 审计信息
 	CreatedBy     *uuid.UUID `gorm:"column:created_by;type:uuid;comment:创建者ID"`
 	UpdatedBy     *uuid.UUID `gorm:"column:updated_by;type:uuid;comment:更新者ID"`
 	LastLoginTime *int64     `gorm:"column:last_login_time;comment:最后登录时间"`
+
+	// 检索文本（原文 + 拼音全拼 + 首字母），写入时由钩子生成
+	SearchTokens string `gorm:"column:search_tokens;type:text;comment:检索文本"`
 }
 
 // TableName 指定表名
@@ -55,6 +61,13 @@ func (u *UserProfile) BeforeCreate(tx *gorm.DB) error {
 		u.Status = UserStatusInactive // 默认未激活状态
 	}
 
+	if u.PasswordChangedAt == nil && u.PasswordHash != "" {
+		now := GetCurrentTimestamp()
+		u.PasswordChangedAt = &now
+	}
+
+	u.RefreshSearchTokens()
+
 	return u.validateFields(true)
 }
 
@@ -71,9 +84,40 @@ func (u *UserProfile) BeforeUpdate(tx *gorm.DB) error {
 		}
 	}
 
+	u.RefreshSearchTokens()
+
 	return u.validateFields(false)
 }
 
+// RefreshSearchTokens 根据当前字段重新生成检索文本
+func (u *UserProfile) RefreshSearchTokens() {
+	u.SearchTokens = search.NewBuilder().
+		Add(u.Username).
+		AddName(u.RealName).
+		AddName(u.LastName).
+		Add(u.FirstName).
+		Add(u.Email).
+		Add(u.EmployeeID).
+		String()
+}
+
+// SearchID 搜索命中信息中的记录 ID
+func (u *UserProfile) SearchID() string {
+	return u.ID.String()
+}
+
+// SearchFields 参与搜索命中计算的字段，顺序即同等级命中时的优先级
+func (u *UserProfile) SearchFields() []search.Field {
+	return []search.Field{
+		{Name: "username", Value: u.Username},
+		{Name: "real_name", Value: u.RealName, IsName: true},
+		{Name: "last_name", Value: u.LastName, IsName: true},
+		{Name: "first_name", Value: u.FirstName},
+		{Name: "email", Value: u.Email},
+		{Name: "employee_id", Value: u.EmployeeID},
+	}
+}
+
 // validateFields 验证字段
 // isCreate: true表示创建操作，false表示更新操作
 func (u *UserProfile) validateFields(isCreate bool) error {
@@ -96,6 +140,11 @@ func (u *UserProfile) validateFields(isCreate bool) error {
 	return nil
 }
 
+// ValidateForCreate 按创建时的规则校验字段，供批量导入在写库前逐行预检
+func (u *UserProfile) ValidateForCreate() error {
+	return u.validateFields(true)
+}
+
 // IsActive 检查用户是否处于活跃状态
 func (u *UserProfile) IsActive() bool {
 	if u.Status != UserStatusActive {
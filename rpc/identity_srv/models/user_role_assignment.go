@@ -8,13 +8,22 @@ import (
 )
 
 // UserRoleAssignment 用户角色分配模型
+//
+// OrganizationID 为空表示全局分配，角色在用户所属的所有组织下生效；
+// 非空时角色仅在该组织（租户）下生效，与 policy_srv 中 g(user, role, domain) 的 domain 对应。
+//
+// ValidFrom/ValidUntil 为可选的生效时间窗口（毫秒时间戳），窗口外的分配在登录和菜单计算中被忽略，
+// 到期后由后台任务自动回收。
 type UserRoleAssignment struct {
 	BaseModel
 
-	UserID    uuid.UUID  `gorm:"column:user_id;not null;index;type:uuid;comment:用户ID"`
-	RoleID    uuid.UUID  `gorm:"column:role_id;not null;index;type:uuid;comment:角色ID"`
-	CreatedBy *uuid.UUID `gorm:"column:created_by;type:uuid;comment:创建者ID"`
-	UpdatedBy *uuid.UUID `gorm:"column:updated_by;type:uuid;comment:最后更新者ID"`
+	UserID         uuid.UUID  `gorm:"column:user_id;not null;index;type:uuid;comment:用户ID"`
+	RoleID         uuid.UUID  `gorm:"column:role_id;not null;index;type:uuid;comment:角色ID"`
+	OrganizationID *uuid.UUID `gorm:"column:organization_id;type:uuid;index;comment:所属组织ID，为空表示全局分配"`
+	ValidFrom      *int64     `gorm:"column:valid_from;comment:生效开始时间，为空表示立即生效"`
+	ValidUntil     *int64     `gorm:"column:valid_until;index;comment:生效截止时间，为空表示永久有效"`
+	CreatedBy      *uuid.UUID `gorm:"column:created_by;type:uuid;comment:创建者ID"`
+	UpdatedBy      *uuid.UUID `gorm:"column:updated_by;type:uuid;comment:最后更新者ID"`
 }
 
 // TableName 指定表名
@@ -22,6 +31,24 @@ func (UserRoleAssignment) TableName() string {
 	return "user_role_assignments"
 }
 
+// IsGlobal 是否为全局分配（不限定组织）
+func (u *UserRoleAssignment) IsGlobal() bool {
+	return u.OrganizationID == nil || *u.OrganizationID == uuid.Nil
+}
+
+// IsEffectiveAt 判断分配在指定时间（毫秒时间戳）是否处于生效窗口内
+func (u *UserRoleAssignment) IsEffectiveAt(nowMillis int64) bool {
+	if u.ValidFrom != nil && *u.ValidFrom > nowMillis {
+		return false
+	}
+
+	if u.ValidUntil != nil && *u.ValidUntil <= nowMillis {
+		return false
+	}
+
+	return true
+}
+
 // BeforeCreate GORM钩子，在创建记录前执行。
 func (u *UserRoleAssignment) BeforeCreate(tx *gorm.DB) error {
 	// ID 由数据库默认生成，不再需要应用程序处理。
@@ -39,6 +66,10 @@ func (u *UserRoleAssignment) validateFields(tx *gorm.DB) error {
 		return fmt.Errorf("角色ID不能为空")
 	}
 
+	if u.ValidFrom != nil && u.ValidUntil != nil && *u.ValidUntil <= *u.ValidFrom {
+		return fmt.Errorf("生效截止时间必须晚于开始时间")
+	}
+
 	// 验证引用的角色是否存在
 	var roleCount int64
 	if err := tx.Model(&RoleDefinition{}).Where("id = ?", u.RoleID).Count(&roleCount).Error; err != nil {
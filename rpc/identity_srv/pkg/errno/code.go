@@ -19,6 +19,7 @@ package errno
 //   - 05: 姿态资源领域
 //   - 06: 组织Logo领域
 //   - 07: 角色分配领域
+//   - 08: 访问复核领域
 //
 // CCC: 具体错误编码 (3位)
 // 错误码定义
@@ -30,6 +31,7 @@ const (
 	// 通用业务错误 (200xxx)
 	ErrorCodeInvalidParams   = 200100 // 参数错误
 	ErrorCodeOperationFailed = 200101 // 操作失败（通用）
+	ErrorCodeVersionConflict = 200102 // 数据版本冲突（乐观锁校验失败）
 
 	// 用户相关错误 (201xxx)
 	ErrorCodeUserNotFound              = 201001
@@ -46,6 +48,12 @@ const (
 	ErrorCodeMustChangePassword        = 201018
 	ErrorCodeSystemUserCannotDelete    = 201019 // 系统用户无法删除
 	ErrorCodeSystemUserCannotModifyKey = 201020 // 系统用户关键属性无法修改
+	ErrorCodeMFANotEnabled             = 201021 // 未启用多因素认证
+	ErrorCodeMFAAlreadyEnabled         = 201022 // 已启用多因素认证
+	ErrorCodeInvalidMFACode            = 201023 // 多因素认证验证码无效
+	ErrorCodeMFAEnrollmentNotStarted   = 201024 // 未发起多因素认证登记
+	ErrorCodePasswordResetTokenInvalid = 201025 // 找回密码令牌无效、已使用或已过期
+	ErrorCodePasswordPolicyViolation   = 201026 // 密码不符合安全策略
 
 	// 组织相关错误 (202xxx)
 	ErrorCodeOrganizationNotFound                    = 202001
@@ -68,6 +76,9 @@ const (
 	ErrorCodeTransactionFailed         = 204004
 	ErrorCodeMembershipNotFound        = 204005
 	ErrorCodeMembershipAlreadyExists   = 204006
+	ErrorCodeDeletedEntityNotFound     = 204007 // 回收站中不存在该记录
+	ErrorCodeRestoreParentDeleted      = 204008 // 上级记录已删除，无法恢复
+	ErrorCodePurgeEntityInUse          = 204009 // 仍被未删除的数据引用，无法彻底删除
 
 	// 组织Logo相关错误 (206xxx)
 	ErrorCodeLogoNotFound      = 206001
@@ -99,4 +110,19 @@ const (
 	ErrorCodeMenuPermissionDenied        = 207015 // 菜单权限不足
 	ErrorCodeNoActiveRoles               = 207016 // 用户没有可用角色
 	ErrorCodeSystemRoleCannotRevoke      = 207017 // 系统用户的系统角色无法撤销
+	ErrorCodeRoleConstraintViolation     = 207018 // 违反角色职责分离约束（互斥角色或分配人数上限）
+	ErrorCodeRoleApprovalRequired        = 207019 // 敏感角色需经审批才能分配
+	ErrorCodeRoleGrantRequestNotFound    = 207020 // 角色授权申请不存在
+	ErrorCodeRoleGrantRequestNotPending  = 207021 // 角色授权申请已处理或已过期
+	ErrorCodeRoleGrantRequestDuplicate   = 207022 // 已存在待审批的相同授权申请
+	ErrorCodeRoleGrantSelfApproval       = 207023 // 不能审批自己发起或授予自己的申请
+
+	// 访问复核相关错误 (208xxx)
+	ErrorCodeAccessReviewCampaignNotFound = 208001 // 访问复核活动不存在
+	ErrorCodeAccessReviewItemNotFound     = 208002 // 访问复核条目不存在
+	ErrorCodeAccessReviewCampaignClosed   = 208003 // 访问复核活动已关闭
+	ErrorCodeAccessReviewItemDecided      = 208004 // 访问复核条目已复核
+	ErrorCodeAccessReviewSelfReview       = 208005 // 不能复核自己的角色分配
+	ErrorCodeAccessReviewNotReviewer      = 208006 // 非该活动指定的复核人
+	ErrorCodeAccessReviewEmptyScope       = 208007 // 复核范围内没有有效的角色分配
 )
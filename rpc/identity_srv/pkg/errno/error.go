@@ -3,6 +3,7 @@ package errno
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/cloudwego/kitex/pkg/kerrors"
 	"gorm.io/gorm"
@@ -35,12 +36,56 @@ func (e ErrNo) WithMessage(msg string) ErrNo {
 func (e ErrNo) Code() int32     { return e.ErrCode }
 func (e ErrNo) Message() string { return e.ErrMsg }
 
+// CurrentVersionExtraKey 版本冲突时 BizStatusError 附加信息中记录当前版本号的键
+const CurrentVersionExtraKey = "current_version"
+
+// VersionConflictError 乐观锁版本冲突错误，携带记录的当前版本号
+// 供调用方刷新数据后携带新版本号重试
+type VersionConflictError struct {
+	ErrNo
+	CurrentVersion int64
+}
+
+// NewVersionConflictError 根据记录当前版本号创建版本冲突错误
+func NewVersionConflictError(currentVersion int64) VersionConflictError {
+	return VersionConflictError{
+		ErrNo: ErrVersionConflict.WithMessage(
+			fmt.Sprintf("%s（当前版本: %d）", ErrVersionConflict.ErrMsg, currentVersion),
+		),
+		CurrentVersion: currentVersion,
+	}
+}
+
+// Unwrap 支持 errors.Is(err, ErrVersionConflict) 按错误码判断
+func (e VersionConflictError) Unwrap() error { return ErrVersionConflict }
+
+// CheckVersion 校验请求携带的版本号与记录当前版本号一致
+// 未携带版本号返回参数错误，不一致返回版本冲突错误
+func CheckVersion(expected *int64, currentVersion int64) error {
+	if expected == nil || *expected <= 0 {
+		return ErrInvalidParams.WithMessage("版本号不能为空")
+	}
+
+	if *expected != currentVersion {
+		return NewVersionConflictError(currentVersion)
+	}
+
+	return nil
+}
+
 // ToKitexError 转换为Kitex错误
 func ToKitexError(err error) error {
 	if err == nil {
 		return nil
 	}
 
+	var conflict VersionConflictError
+	if errors.As(err, &conflict) {
+		return kerrors.NewBizStatusErrorWithExtra(conflict.Code(), conflict.Message(), map[string]string{
+			CurrentVersionExtraKey: strconv.FormatInt(conflict.CurrentVersion, 10),
+		})
+	}
+
 	if e, ok := err.(ErrNo); ok {
 		// 使用Kitex的NewBizStatusError创建业务错误
 		return kerrors.NewBizStatusError(e.Code(), e.Message())
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/cloudwego/kitex/pkg/kerrors"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
 )
@@ -134,3 +135,27 @@ func TestErrorCodes(t *testing.T) {
 		assert.Equal(t, int32(207002), int32(ErrorCodeRoleNameAlreadyExists))
 	})
 }
+
+func TestCheckVersion(t *testing.T) {
+	version := int64(2)
+	stale := int64(1)
+
+	assert.NoError(t, CheckVersion(&version, 2))
+	assert.Equal(t, ErrInvalidParams.ErrCode, CheckVersion(nil, 2).(ErrNo).ErrCode)
+
+	err := CheckVersion(&stale, 2)
+
+	var conflict VersionConflictError
+	assert.True(t, errors.As(err, &conflict))
+	assert.True(t, errors.Is(err, ErrVersionConflict))
+	assert.Equal(t, int64(2), conflict.CurrentVersion)
+}
+
+func TestToKitexError_VersionConflict(t *testing.T) {
+	kitexErr := ToKitexError(NewVersionConflictError(5))
+
+	bizErr, ok := kerrors.FromBizStatusError(kitexErr)
+	assert.True(t, ok)
+	assert.Equal(t, int32(ErrorCodeVersionConflict), bizErr.BizStatusCode())
+	assert.Equal(t, "5", bizErr.BizExtra()[CurrentVersionExtraKey])
+}
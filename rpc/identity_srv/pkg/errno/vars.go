@@ -9,6 +9,7 @@ var (
 	// 通用业务错误
 	ErrInvalidParams   = NewErrNo(ErrorCodeInvalidParams, "参数错误")
 	ErrOperationFailed = NewErrNo(ErrorCodeOperationFailed, "操作失败")
+	ErrVersionConflict = NewErrNo(ErrorCodeVersionConflict, "数据已被他人修改，请刷新后重试")
 
 	// 用户相关错误
 	ErrUserNotFound           = NewErrNo(ErrorCodeUserNotFound, "用户不存在")
@@ -28,6 +29,16 @@ var (
 	ErrSystemUserCannotDelete    = NewErrNo(ErrorCodeSystemUserCannotDelete, "系统用户无法删除")
 	ErrSystemUserCannotModifyKey = NewErrNo(ErrorCodeSystemUserCannotModifyKey, "系统用户关键属性无法修改")
 
+	// 多因素认证相关错误
+	ErrMFANotEnabled           = NewErrNo(ErrorCodeMFANotEnabled, "未启用多因素认证")
+	ErrMFAAlreadyEnabled       = NewErrNo(ErrorCodeMFAAlreadyEnabled, "已启用多因素认证")
+	ErrInvalidMFACode          = NewErrNo(ErrorCodeInvalidMFACode, "验证码无效或已使用")
+	ErrMFAEnrollmentNotStarted = NewErrNo(ErrorCodeMFAEnrollmentNotStarted, "请先发起多因素认证登记")
+
+	// 找回密码与密码策略相关错误
+	ErrPasswordResetTokenInvalid = NewErrNo(ErrorCodePasswordResetTokenInvalid, "重置链接无效或已过期")
+	ErrPasswordPolicyViolation   = NewErrNo(ErrorCodePasswordPolicyViolation, "密码不符合安全策略")
+
 	// 组织相关错误
 	ErrOrganizationNotFound       = NewErrNo(ErrorCodeOrganizationNotFound, "组织不存在")
 	ErrParentOrganizationNotFound = NewErrNo(ErrorCodeParentOrganizationNotFound, "引用的父组织不存在")
@@ -56,6 +67,11 @@ var (
 	ErrDataInconsistency         = NewErrNo(ErrorCodeDataInconsistency, "数据一致性错误")
 	ErrTransactionFailed         = NewErrNo(ErrorCodeTransactionFailed, "事务执行失败")
 
+	// 回收站相关错误
+	ErrDeletedEntityNotFound = NewErrNo(ErrorCodeDeletedEntityNotFound, "回收站中不存在该记录")
+	ErrRestoreParentDeleted  = NewErrNo(ErrorCodeRestoreParentDeleted, "上级记录已删除，请先恢复上级")
+	ErrPurgeEntityInUse      = NewErrNo(ErrorCodePurgeEntityInUse, "仍被未删除的数据引用，无法彻底删除")
+
 	// 成员关系相关错误
 	ErrMembershipNotFound      = NewErrNo(ErrorCodeMembershipNotFound, "成员关系不存在")
 	ErrMembershipAlreadyExists = NewErrNo(ErrorCodeMembershipAlreadyExists, "成员关系已存在")
@@ -84,6 +100,23 @@ var (
 	ErrRoleAssignmentAlreadyExists = NewErrNo(ErrorCodeRoleAssignmentAlreadyExists, "用户角色分配已存在")
 	ErrRoleAssignmentConflict      = NewErrNo(ErrorCodeRoleAssignmentConflict, "用户角色分配冲突")
 	ErrAssignerPermissionDenied    = NewErrNo(ErrorCodeAssignerPermissionDenied, "分配者权限不足")
+	ErrRoleConstraintViolation     = NewErrNo(ErrorCodeRoleConstraintViolation, "违反角色职责分离约束")
+
+	// 敏感角色授权审批相关错误
+	ErrRoleApprovalRequired       = NewErrNo(ErrorCodeRoleApprovalRequired, "敏感角色需经审批才能分配")
+	ErrRoleGrantRequestNotFound   = NewErrNo(ErrorCodeRoleGrantRequestNotFound, "角色授权申请不存在")
+	ErrRoleGrantRequestNotPending = NewErrNo(ErrorCodeRoleGrantRequestNotPending, "角色授权申请已处理或已过期")
+	ErrRoleGrantRequestDuplicate  = NewErrNo(ErrorCodeRoleGrantRequestDuplicate, "已存在待审批的相同授权申请")
+	ErrRoleGrantSelfApproval      = NewErrNo(ErrorCodeRoleGrantSelfApproval, "不能审批自己发起或授予自己的申请")
+
+	// 访问复核相关错误
+	ErrAccessReviewCampaignNotFound = NewErrNo(ErrorCodeAccessReviewCampaignNotFound, "访问复核活动不存在")
+	ErrAccessReviewItemNotFound     = NewErrNo(ErrorCodeAccessReviewItemNotFound, "访问复核条目不存在")
+	ErrAccessReviewCampaignClosed   = NewErrNo(ErrorCodeAccessReviewCampaignClosed, "访问复核活动已关闭")
+	ErrAccessReviewItemDecided      = NewErrNo(ErrorCodeAccessReviewItemDecided, "访问复核条目已复核")
+	ErrAccessReviewSelfReview       = NewErrNo(ErrorCodeAccessReviewSelfReview, "不能复核自己的角色分配")
+	ErrAccessReviewNotReviewer      = NewErrNo(ErrorCodeAccessReviewNotReviewer, "非该活动指定的复核人")
+	ErrAccessReviewEmptyScope       = NewErrNo(ErrorCodeAccessReviewEmptyScope, "复核范围内没有有效的角色分配")
 
 	// 菜单权限相关错误
 	ErrMenuNotFound           = NewErrNo(ErrorCodeMenuNotFound, "菜单不存在")
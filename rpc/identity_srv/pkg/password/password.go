@@ -1,9 +1,25 @@
 package password
 
 import (
+	"errors"
+	"fmt"
+
 	"golang.org/x/crypto/bcrypt"
 )
 
+// 密码长度约束：最短长度与网关参数校验一致；bcrypt 只处理前 72 字节，超出部分会被静默截断
+const (
+	MinLength = 6
+	MaxLength = 72
+)
+
+// 密码策略校验错误
+var (
+	ErrTooShort = fmt.Errorf("密码长度至少为 %d 位", MinLength)
+	ErrTooLong  = fmt.Errorf("密码长度不能超过 %d 字节", MaxLength)
+	ErrBlank    = errors.New("密码不能全部为空白字符")
+)
+
 // HashPassword 对密码进行加密
 func HashPassword(password string) (string, error) {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
@@ -19,3 +35,22 @@ func VerifyPassword(password, hashedPassword string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
 	return err == nil
 }
+
+// Validate 校验密码是否满足基本策略（长度范围、非全空白）
+func Validate(password string) error {
+	if len([]rune(password)) < MinLength {
+		return ErrTooShort
+	}
+
+	if len(password) > MaxLength {
+		return ErrTooLong
+	}
+
+	for _, r := range password {
+		if r != ' ' && r != '\t' && r != '\n' && r != '\r' {
+			return nil
+		}
+	}
+
+	return ErrBlank
+}
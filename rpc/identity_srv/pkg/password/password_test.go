@@ -1,6 +1,7 @@
 package password
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -117,3 +118,24 @@ func BenchmarkVerifyPassword(b *testing.B) {
 		VerifyPassword(password, hash)
 	}
 }
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		want     error
+	}{
+		{name: "valid password", password: "Secret123", want: nil},
+		{name: "minimum length", password: "abcdef", want: nil},
+		{name: "multibyte characters count as runes", password: "密码安全六位", want: nil},
+		{name: "too short", password: "abc12", want: ErrTooShort},
+		{name: "too long for bcrypt", password: strings.Repeat("a", MaxLength+1), want: ErrTooLong},
+		{name: "blank", password: "        ", want: ErrBlank},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Validate(tt.password))
+		})
+	}
+}
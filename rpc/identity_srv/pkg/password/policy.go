@@ -0,0 +1,225 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1" //nolint:gosec // 泄露密码库（如 HIBP）以 SHA-1 发布，仅用于比对
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// 密码策略校验错误（基础长度与空白检查见 Validate）
+var (
+	ErrBelowMinLength  = errors.New("密码长度不满足策略要求")
+	ErrTooFewCharClass = errors.New("密码字符种类不满足策略要求")
+	ErrInDictionary    = errors.New("密码过于常见或已在泄露密码库中，请更换")
+	ErrRecentlyUsed    = errors.New("不能使用最近使用过的密码")
+)
+
+// charClassNames 字符种类的展示名称，与 charClasses 的计数顺序一致
+const charClassNames = "大写字母、小写字母、数字、特殊字符"
+
+// Policy 组织级密码策略
+//
+// 零值策略只做 Validate 的基础检查，不限制字符种类、不检查字典与历史、密码永不过期。
+type Policy struct {
+	// MinLength 最短长度（按字符计），低于 Validate 的基础下限时以基础下限为准
+	MinLength int
+
+	// MinCharClasses 至少包含的字符种类数（大写字母、小写字母、数字、特殊字符，0-4）
+	MinCharClasses int
+
+	// HistorySize 禁止复用最近 N 个密码（含当前密码），0 表示不检查
+	HistorySize int
+
+	// MaxAge 密码最长使用期限，超过后需修改密码，0 表示永不过期
+	MaxAge time.Duration
+
+	dictionary *Dictionary
+}
+
+// NewPolicy 创建密码策略，dictionary 为 nil 表示不检查常见/泄露密码
+func NewPolicy(
+	minLength, minCharClasses, historySize int,
+	maxAge time.Duration,
+	dictionary *Dictionary,
+) *Policy {
+	return &Policy{
+		MinLength:      minLength,
+		MinCharClasses: minCharClasses,
+		HistorySize:    historySize,
+		MaxAge:         maxAge,
+		dictionary:     dictionary,
+	}
+}
+
+// WithRules 返回替换了长度、字符种类、历史与期限规则的新策略，禁用字典沿用当前策略
+// 用于在全局默认策略之上叠加组织单独配置的规则
+func (p *Policy) WithRules(
+	minLength, minCharClasses, historySize int,
+	maxAge time.Duration,
+) *Policy {
+	return NewPolicy(minLength, minCharClasses, historySize, maxAge, p.dictionary)
+}
+
+// Check 校验密码是否满足策略：基础检查、最短长度、字符种类与禁用字典
+// 不涉及历史密码，复用检查见 MatchesAny
+func (p *Policy) Check(password string) error {
+	if err := Validate(password); err != nil {
+		return err
+	}
+
+	if p.MinLength > MinLength && len([]rune(password)) < p.MinLength {
+		return fmt.Errorf("%w：长度至少为 %d 位", ErrBelowMinLength, p.MinLength)
+	}
+
+	if p.MinCharClasses > 0 && charClasses(password) < p.MinCharClasses {
+		return fmt.Errorf("%w：需包含%s中的至少 %d 种", ErrTooFewCharClass, charClassNames, p.MinCharClasses)
+	}
+
+	if p.dictionary.Contains(password) {
+		return ErrInDictionary
+	}
+
+	return nil
+}
+
+// Expired 判断在 changedAt 设置的密码到 now 时是否已超过最长使用期限
+// changedAt 为零值（未知修改时间）时不视为过期
+func (p *Policy) Expired(changedAt, now time.Time) bool {
+	if p.MaxAge <= 0 || changedAt.IsZero() {
+		return false
+	}
+
+	return now.Sub(changedAt) >= p.MaxAge
+}
+
+// MatchesAny 判断密码是否与任一 bcrypt 哈希匹配，用于检查历史密码复用
+func MatchesAny(password string, hashes []string) bool {
+	for _, hash := range hashes {
+		if hash != "" && VerifyPassword(password, hash) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// charClasses 统计密码包含的字符种类数
+func charClasses(password string) int {
+	var upper, lower, digit, symbol bool
+
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+
+	count := 0
+
+	for _, present := range []bool{upper, lower, digit, symbol} {
+		if present {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Dictionary 禁用密码字典（常见密码表或泄露密码库）
+type Dictionary struct {
+	words  map[string]struct{} // 小写明文，比较时忽略大小写
+	hashes map[string]struct{} // 大写 SHA-1 十六进制，按原文精确比较
+}
+
+// LoadDictionary 从本地文件加载禁用密码字典
+//
+// 每行一条，空行与 # 开头的行忽略。明文条目比较时忽略大小写；
+// 40 位十六进制（可带 ":出现次数" 后缀，即 HIBP 导出格式）视为密码原文的 SHA-1。
+func LoadDictionary(path string) (*Dictionary, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("打开密码字典失败: %w", err)
+	}
+	defer file.Close()
+
+	dict := &Dictionary{
+		words:  make(map[string]struct{}),
+		hashes: make(map[string]struct{}),
+	}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if hash, ok := parseSHA1Entry(line); ok {
+			dict.hashes[hash] = struct{}{}
+			continue
+		}
+
+		dict.words[strings.ToLower(line)] = struct{}{}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("读取密码字典失败: %w", err)
+	}
+
+	return dict, nil
+}
+
+// Len 返回字典条目数
+func (d *Dictionary) Len() int {
+	if d == nil {
+		return 0
+	}
+
+	return len(d.words) + len(d.hashes)
+}
+
+// Contains 判断密码是否在字典中，nil 字典恒为 false
+func (d *Dictionary) Contains(password string) bool {
+	if d == nil {
+		return false
+	}
+
+	if _, ok := d.words[strings.ToLower(password)]; ok {
+		return true
+	}
+
+	if len(d.hashes) == 0 {
+		return false
+	}
+
+	sum := sha1.Sum([]byte(password)) //nolint:gosec // 见导入处说明
+	_, ok := d.hashes[strings.ToUpper(hex.EncodeToString(sum[:]))]
+
+	return ok
+}
+
+// parseSHA1Entry 解析 SHA-1 条目（"HASH" 或 "HASH:count"），返回大写哈希
+func parseSHA1Entry(line string) (string, bool) {
+	hash, _, _ := strings.Cut(line, ":")
+	if len(hash) != sha1.Size*2 {
+		return "", false
+	}
+
+	if _, err := hex.DecodeString(hash); err != nil {
+		return "", false
+	}
+
+	return strings.ToUpper(hash), true
+}
@@ -0,0 +1,90 @@
+package password
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeDictionary(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "dictionary.txt")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestPolicyCheck(t *testing.T) {
+	// "P@ssw0rd" 的 SHA-1，模拟 HIBP 导出格式
+	dict, err := LoadDictionary(writeDictionary(t, "# 常见密码\nqwerty123\n\n"+
+		"21BD12DC183F740EE76F27B78EB39C8AD972A757:1000\n"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, dict.Len())
+
+	policy := NewPolicy(10, 3, 5, 0, dict)
+
+	tests := []struct {
+		name     string
+		password string
+		want     error
+	}{
+		{name: "valid password", password: "Secret-Pass1", want: nil},
+		{name: "basic rules still apply", password: "abc", want: ErrTooShort},
+		{name: "below policy length", password: "Sec-pass1", want: ErrBelowMinLength},
+		{name: "too few character classes", password: "secretpassword1", want: ErrTooFewCharClass},
+		{name: "multibyte counts as symbol class", password: "密码Secret12", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Check(tt.password)
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+
+	t.Run("dictionary entries rejected", func(t *testing.T) {
+		relaxed := NewPolicy(0, 0, 0, 0, dict)
+
+		assert.ErrorIs(t, relaxed.Check("QWERTY123"), ErrInDictionary)
+		assert.ErrorIs(t, relaxed.Check("P@ssw0rd"), ErrInDictionary)
+		assert.NoError(t, relaxed.Check("p@ssw0rd"), "SHA-1 条目按原文比较，区分大小写")
+	})
+
+	t.Run("zero policy only applies basic rules", func(t *testing.T) {
+		assert.NoError(t, (&Policy{}).Check("abcdef"))
+	})
+}
+
+func TestPolicyExpired(t *testing.T) {
+	now := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	policy := NewPolicy(0, 0, 0, 30*24*time.Hour, nil)
+
+	assert.True(t, policy.Expired(now.Add(-30*24*time.Hour), now))
+	assert.False(t, policy.Expired(now.Add(-29*24*time.Hour), now))
+	assert.False(t, policy.Expired(time.Time{}, now), "修改时间未知时不视为过期")
+	assert.False(t, NewPolicy(0, 0, 0, 0, nil).Expired(now.AddDate(-1, 0, 0), now))
+}
+
+func TestMatchesAny(t *testing.T) {
+	old, err := HashPassword("Old-Secret1")
+	require.NoError(t, err)
+
+	assert.True(t, MatchesAny("Old-Secret1", []string{"", old}))
+	assert.False(t, MatchesAny("New-Secret1", []string{old}))
+	assert.False(t, MatchesAny("Old-Secret1", nil))
+}
+
+func TestLoadDictionary_MissingFile(t *testing.T) {
+	_, err := LoadDictionary(filepath.Join(t.TempDir(), "missing.txt"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,195 @@
+package search
+
+import (
+	"strings"
+)
+
+// Rank 匹配等级，数值越小匹配质量越高
+type Rank int32
+
+const (
+	// RankExact 字段（或其拼音全拼、首字母）与搜索词完全相同
+	RankExact Rank = iota
+	// RankPrefix 字段以搜索词开头
+	RankPrefix
+	// RankContains 字段包含搜索词
+	RankContains
+)
+
+// Field 参与命中计算的字段
+type Field struct {
+	Name   string
+	Value  string
+	IsName bool // 人名字段：首字按姓氏读音参与拼音匹配
+}
+
+// Highlight 一条记录的最佳命中
+//
+// Start/End 为 Value 中命中区间的字符下标（左闭右开）；经拼音命中时区间覆盖对应的汉字。
+type Highlight struct {
+	Field  string
+	Value  string
+	Start  int
+	End    int
+	Rank   Rank
+	Pinyin bool
+}
+
+// Match 计算 term 在各字段上的最佳命中，未命中返回 nil
+//
+// term 应已经过 Normalize。等级相同时直接文本命中优先于拼音命中，再按字段顺序取靠前者。
+func Match(term string, fields ...Field) *Highlight {
+	if term == "" {
+		return nil
+	}
+
+	var best *Highlight
+
+	for _, f := range fields {
+		h := matchField(term, f)
+		if h != nil && (best == nil || better(h, best)) {
+			best = h
+		}
+	}
+
+	return best
+}
+
+func better(a, b *Highlight) bool {
+	if a.Rank != b.Rank {
+		return a.Rank < b.Rank
+	}
+
+	return !a.Pinyin && b.Pinyin
+}
+
+// matchField 单个字段上的最佳命中
+func matchField(term string, f Field) *Highlight {
+	if f.Value == "" {
+		return nil
+	}
+
+	value := []rune(strings.ToLower(f.Value))
+	needle := []rune(term)
+
+	if idx := indexRunes(value, needle); idx >= 0 {
+		return &Highlight{
+			Field: f.Name,
+			Value: f.Value,
+			Start: idx,
+			End:   idx + len(needle),
+			Rank:  rankOf(idx, len(needle), len(value)),
+		}
+	}
+
+	if !isASCIIAlnum(term) || !containsHan(f.Value) {
+		return nil
+	}
+
+	var best *Highlight
+
+	readings := [][]string{Syllables(string(value), false)}
+	if f.IsName {
+		readings = append(readings, Syllables(string(value), true))
+	}
+
+	for _, syllables := range readings {
+		for _, h := range []*Highlight{matchFull(term, syllables), matchInitials(needle, syllables)} {
+			if h != nil && (best == nil || h.Rank < best.Rank) {
+				best = h
+			}
+		}
+	}
+
+	if best != nil {
+		best.Field = f.Name
+		best.Value = f.Value
+		best.Pinyin = true
+	}
+
+	return best
+}
+
+// matchFull 在拼音全拼上匹配，并把字节区间映射回字符区间
+func matchFull(term string, syllables []string) *Highlight {
+	full := strings.Join(syllables, "")
+
+	idx := strings.Index(full, term)
+	if idx < 0 {
+		return nil
+	}
+
+	start, end := -1, -1
+	offset := 0
+
+	for i, s := range syllables {
+		next := offset + len(s)
+		if start < 0 && idx < next {
+			start = i
+		}
+
+		if idx+len(term) <= next {
+			end = i + 1
+			break
+		}
+
+		offset = next
+	}
+
+	return &Highlight{Start: start, End: end, Rank: rankOf(idx, len(term), len(full))}
+}
+
+// matchInitials 在拼音首字母上匹配，首字母与字符一一对应
+func matchInitials(needle []rune, syllables []string) *Highlight {
+	idx := indexRunes([]rune(initials(syllables)), needle)
+	if idx < 0 {
+		return nil
+	}
+
+	return &Highlight{Start: idx, End: idx + len(needle), Rank: rankOf(idx, len(needle), len(syllables))}
+}
+
+func rankOf(idx, matched, total int) Rank {
+	switch {
+	case idx == 0 && matched == total:
+		return RankExact
+	case idx == 0:
+		return RankPrefix
+	default:
+		return RankContains
+	}
+}
+
+func indexRunes(haystack, needle []rune) int {
+	if len(needle) == 0 || len(needle) > len(haystack) {
+		return -1
+	}
+
+	for i := 0; i+len(needle) <= len(haystack); i++ {
+		match := true
+
+		for j := range needle {
+			if haystack[i+j] != needle[j] {
+				match = false
+				break
+			}
+		}
+
+		if match {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func isASCIIAlnum(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+
+	return true
+}
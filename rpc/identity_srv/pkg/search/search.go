@@ -0,0 +1,181 @@
+// Package search 为用户、组织、部门生成拼音感知的检索文本，并计算搜索命中信息
+//
+// 写入时把原文（小写）、拼音全拼与拼音首字母去重后以空格拼接，存入各表的
+// search_tokens 列；查询时在该列上做子串匹配（pg_trgm GIN 索引加速）。
+// 例如「张三」生成 "张三 zhangsan zs"，输入 "zhangs"、"zs"、"张" 均可命中。
+package search
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/mozillazg/go-pinyin"
+)
+
+// MaxTermLength 搜索词最大长度（按字符计），超出部分截断
+const MaxTermLength = 64
+
+// pinyinArgs 无声调、每字取首选读音
+var pinyinArgs = pinyin.NewArgs()
+
+// surnameReadings 常见多音字姓氏的姓氏读音（人名首字按此读音）
+var surnameReadings = map[rune]string{
+	'单': "shan",
+	'曾': "zeng",
+	'解': "xie",
+	'仇': "qiu",
+	'朴': "piao",
+	'区': "ou",
+	'查': "zha",
+	'缪': "miao",
+	'覃': "qin",
+	'翟': "zhai",
+	'盖': "ge",
+	'乐': "yue",
+	'尉': "yu",
+	'召': "shao",
+	'种': "chong",
+	'长': "chang",
+}
+
+// Builder 累积一条记录的检索文本
+type Builder struct {
+	seen  map[string]struct{}
+	parts []string
+}
+
+// NewBuilder 创建检索文本构建器
+func NewBuilder() *Builder {
+	return &Builder{seen: make(map[string]struct{})}
+}
+
+// Add 追加普通文本：原文、拼音全拼、拼音首字母
+func (b *Builder) Add(text string) *Builder {
+	return b.add(text, false)
+}
+
+// AddName 追加人名：首字为多音字姓氏时按姓氏读音，同时保留默认读音
+func (b *Builder) AddName(name string) *Builder {
+	return b.add(name, true)
+}
+
+// String 返回以空格分隔的检索文本
+func (b *Builder) String() string {
+	return strings.Join(b.parts, " ")
+}
+
+func (b *Builder) add(text string, isName bool) *Builder {
+	for _, word := range strings.Fields(strings.ToLower(text)) {
+		b.push(word)
+
+		if !containsHan(word) {
+			continue
+		}
+
+		syllables := Syllables(word, false)
+		b.push(strings.Join(syllables, ""))
+		b.push(initials(syllables))
+
+		if isName {
+			surname := Syllables(word, true)
+			b.push(strings.Join(surname, ""))
+			b.push(initials(surname))
+		}
+	}
+
+	return b
+}
+
+func (b *Builder) push(token string) {
+	if token == "" {
+		return
+	}
+
+	if _, ok := b.seen[token]; ok {
+		return
+	}
+
+	b.seen[token] = struct{}{}
+	b.parts = append(b.parts, token)
+}
+
+// Syllables 逐字转换：汉字取拼音，其余字符原样小写
+//
+// 返回切片与 text 的字符一一对应；isName 为 true 时首字按姓氏读音。
+func Syllables(text string, isName bool) []string {
+	runes := []rune(text)
+	out := make([]string, len(runes))
+
+	for i, r := range runes {
+		if !unicode.Is(unicode.Han, r) {
+			out[i] = string(unicode.ToLower(r))
+			continue
+		}
+
+		if isName && i == 0 {
+			if reading, ok := surnameReadings[r]; ok {
+				out[i] = reading
+				continue
+			}
+		}
+
+		if pys := pinyin.SinglePinyin(r, pinyinArgs); len(pys) > 0 {
+			out[i] = pys[0]
+		} else {
+			out[i] = string(r)
+		}
+	}
+
+	return out
+}
+
+// Normalize 规范化搜索词：小写、去除全部空白，并截断到 MaxTermLength
+//
+// 去除内部空白使 "zhang san" 与全拼 "zhangsan" 一致。
+func Normalize(term string) string {
+	var sb strings.Builder
+
+	n := 0
+	for _, r := range strings.ToLower(term) {
+		if unicode.IsSpace(r) {
+			continue
+		}
+
+		if n == MaxTermLength {
+			break
+		}
+
+		sb.WriteRune(r)
+		n++
+	}
+
+	return sb.String()
+}
+
+// EscapeLike 转义 LIKE 通配符（%、_ 与转义符 \）
+func EscapeLike(term string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(term)
+}
+
+func containsHan(text string) bool {
+	for _, r := range text {
+		if unicode.Is(unicode.Han, r) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func initials(syllables []string) string {
+	var sb strings.Builder
+
+	for _, s := range syllables {
+		if s != "" {
+			r := []rune(s)[0]
+			sb.WriteRune(r)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,97 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilder(t *testing.T) {
+	t.Run("汉字生成全拼与首字母", func(t *testing.T) {
+		tokens := NewBuilder().Add("Admin").AddName("张三").String()
+
+		assert.Equal(t, "admin 张三 zhangsan zs", tokens)
+	})
+
+	t.Run("多音字姓氏同时保留姓氏读音与默认读音", func(t *testing.T) {
+		tokens := NewBuilder().AddName("曾小明").String()
+
+		assert.Contains(t, tokens, "zengxiaoming")
+		assert.Contains(t, tokens, "zxm")
+		assert.Contains(t, tokens, "cengxiaoming")
+	})
+
+	t.Run("普通文本首字不按姓氏读音", func(t *testing.T) {
+		tokens := NewBuilder().Add("单位").String()
+
+		assert.Equal(t, "单位 danwei dw", tokens)
+	})
+
+	t.Run("去重并忽略空值", func(t *testing.T) {
+		tokens := NewBuilder().Add("").Add("li").Add("LI").Add("a b").String()
+
+		assert.Equal(t, "li a b", tokens)
+	})
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "zhangsan", Normalize("  Zhang San "))
+	assert.Len(t, []rune(Normalize(string(make([]rune, MaxTermLength+10)))), MaxTermLength)
+}
+
+func TestEscapeLike(t *testing.T) {
+	assert.Equal(t, `100\%\_a\\b`, EscapeLike(`100%_a\b`))
+}
+
+func TestMatch(t *testing.T) {
+	fields := []Field{
+		{Name: "username", Value: "zs001"},
+		{Name: "real_name", Value: "张三丰", IsName: true},
+		{Name: "email", Value: "sanfeng@example.com"},
+	}
+
+	cases := []struct {
+		name   string
+		term   string
+		field  string
+		start  int
+		end    int
+		rank   Rank
+		pinyin bool
+	}{
+		{"直接文本前缀优先于拼音", "zs", "username", 0, 2, RankPrefix, false},
+		{"汉字包含", "三", "real_name", 1, 2, RankContains, false},
+		{"全拼完全命中", "zhangsanfeng", "real_name", 0, 3, RankExact, true},
+		{"全拼跨字前缀", "zhangs", "real_name", 0, 2, RankPrefix, true},
+		{"首字母完全命中", "zsf", "real_name", 0, 3, RankExact, true},
+		{"全拼中段", "sanfeng", "email", 0, 7, RankPrefix, false},
+		{"拼音包含", "feng", "email", 3, 7, RankContains, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := Match(tc.term, fields...)
+			require.NotNil(t, h)
+
+			assert.Equal(t, tc.field, h.Field)
+			assert.Equal(t, tc.start, h.Start)
+			assert.Equal(t, tc.end, h.End)
+			assert.Equal(t, tc.rank, h.Rank)
+			assert.Equal(t, tc.pinyin, h.Pinyin)
+		})
+	}
+
+	t.Run("姓氏读音", func(t *testing.T) {
+		h := Match("shanxiong", Field{Name: "real_name", Value: "单雄", IsName: true})
+		require.NotNil(t, h)
+		assert.Equal(t, RankExact, h.Rank)
+
+		assert.Nil(t, Match("shanxiong", Field{Name: "name", Value: "单雄"}))
+	})
+
+	t.Run("未命中", func(t *testing.T) {
+		assert.Nil(t, Match("wang", fields...))
+		assert.Nil(t, Match("", fields...))
+	})
+}
@@ -0,0 +1,78 @@
+// Package tabular 将表头与数据行写为 CSV / XLSX 文件，供各类导出共用。
+package tabular
+
+import (
+	"bytes"
+	"encoding/csv"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	CSVContentType  = "text/csv; charset=utf-8"
+	XLSXContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+// UTF8BOM Excel 打开无 BOM 的 UTF-8 CSV 会出现中文乱码，导出时写入、导入时跳过
+var UTF8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// WriteCSV 写入带 BOM 的 UTF-8 CSV
+func WriteCSV(header []string, rows [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.Write(UTF8BOM)
+
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+
+	if err := writer.WriteAll(rows); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// WriteXLSX 写入单个工作表的 XLSX，所有单元格按文本写入
+func WriteXLSX(sheetName string, header []string, rows [][]string) ([]byte, error) {
+	file := excelize.NewFile()
+	defer func() { _ = file.Close() }()
+
+	if err := file.SetSheetName(file.GetSheetName(0), sheetName); err != nil {
+		return nil, err
+	}
+
+	writer, err := file.NewStreamWriter(sheetName)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, row := range append([][]string{header}, rows...) {
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		if err != nil {
+			return nil, err
+		}
+
+		// 一律按文本写入，避免手机号、工号被识别为数字丢失前导零
+		values := make([]interface{}, len(row))
+		for j, value := range row {
+			values[j] = value
+		}
+
+		if err := writer.SetRow(cell, values); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		return nil, err
+	}
+
+	buf, err := file.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
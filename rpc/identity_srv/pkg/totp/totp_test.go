@@ -0,0 +1,100 @@
+package totp
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret RFC 6238 附录 B 的 SHA1 测试密钥 "12345678901234567890"
+var rfcSecret = encoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestCode_RFC6238Vectors(t *testing.T) {
+	// RFC 6238 给出的是 8 位口令，取末 6 位即为 6 位口令
+	cases := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, tc := range cases {
+		code, err := Code(rfcSecret, Step(time.Unix(tc.unix, 0)))
+		require.NoError(t, err)
+		assert.Equal(t, tc.code, code, "unix=%d", tc.unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+
+	t.Run("accepts current step", func(t *testing.T) {
+		step, ok := Validate(rfcSecret, "005924", now, 1)
+		assert.True(t, ok)
+		assert.Equal(t, Step(now), step)
+	})
+
+	t.Run("accepts adjacent step within skew", func(t *testing.T) {
+		prev, err := Code(rfcSecret, Step(now)-1)
+		require.NoError(t, err)
+
+		step, ok := Validate(rfcSecret, prev, now, 1)
+		assert.True(t, ok)
+		assert.Equal(t, Step(now)-1, step)
+	})
+
+	t.Run("rejects step outside skew", func(t *testing.T) {
+		old, err := Code(rfcSecret, Step(now)-2)
+		require.NoError(t, err)
+
+		_, ok := Validate(rfcSecret, old, now, 1)
+		assert.False(t, ok)
+	})
+
+	t.Run("rejects malformed code", func(t *testing.T) {
+		_, ok := Validate(rfcSecret, "12345", now, 1)
+		assert.False(t, ok)
+	})
+
+	t.Run("rejects invalid secret", func(t *testing.T) {
+		_, ok := Validate("not base32!", "005924", now, 1)
+		assert.False(t, ok)
+	})
+}
+
+func TestGenerateSecret(t *testing.T) {
+	s1, err := GenerateSecret()
+	require.NoError(t, err)
+
+	s2, err := GenerateSecret()
+	require.NoError(t, err)
+
+	assert.Len(t, s1, 32) // 20 字节 Base32 无填充
+	assert.NotEqual(t, s1, s2)
+
+	_, err = Code(s1, 1)
+	assert.NoError(t, err)
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri := ProvisioningURI("CloudWeGo IAM", "alice", "JBSWY3DPEHPK3PXP")
+
+	require.True(t, strings.HasPrefix(uri, "otpauth://totp/CloudWeGo%20IAM:alice?"))
+
+	parsed, err := url.Parse(uri)
+	require.NoError(t, err)
+
+	query := parsed.Query()
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", query.Get("secret"))
+	assert.Equal(t, "CloudWeGo IAM", query.Get("issuer"))
+	assert.Equal(t, "6", query.Get("digits"))
+	assert.Equal(t, "30", query.Get("period"))
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/masonsxu/cloudwego-microservice-demo/iamclient"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/breakglass"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/config"
 )
 
@@ -19,8 +20,11 @@ type AppContainer struct {
 	DB                *gorm.DB
 	Logic             logic.Logic
 	IAMClient         *iamclient.Client
+	BreakGlass        *breakglass.Guard
 	ServerOptions     *ServerOptions
 	HealthCheckServer *HealthCheckServer
+	RoleExpiryJob     *RoleExpiryJob
+	RecycleBinJob     *RecycleBinPurgeJob
 }
 
 // NewAppContainer 创建应用容器
@@ -30,8 +34,11 @@ func NewAppContainer(
 	db *gorm.DB,
 	logicImpl logic.Logic,
 	iamCli *iamclient.Client,
+	breakGlass *breakglass.Guard,
 	serverOpts *ServerOptions,
 	healthServer *HealthCheckServer,
+	roleExpiryJob *RoleExpiryJob,
+	recycleBinJob *RecycleBinPurgeJob,
 ) *AppContainer {
 	klog.Infof("Application container initialized successfully")
 
@@ -41,8 +48,11 @@ func NewAppContainer(
 		DB:                db,
 		Logic:             logicImpl,
 		IAMClient:         iamCli,
+		BreakGlass:        breakGlass,
 		ServerOptions:     serverOpts,
 		HealthCheckServer: healthServer,
+		RoleExpiryJob:     roleExpiryJob,
+		RecycleBinJob:     recycleBinJob,
 	}
 }
 
@@ -50,3 +60,13 @@ func NewAppContainer(
 func (c *AppContainer) StartHealthCheck() {
 	c.HealthCheckServer.Start()
 }
+
+// StartRoleExpiryJob 启动临时授权回收任务
+func (c *AppContainer) StartRoleExpiryJob() {
+	c.RoleExpiryJob.Start()
+}
+
+// StartRecycleBinPurgeJob 启动回收站自动清除任务
+func (c *AppContainer) StartRecycleBinPurgeJob() {
+	c.RecycleBinJob.Start()
+}
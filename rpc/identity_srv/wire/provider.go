@@ -5,6 +5,10 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/masonsxu/cloudwego-microservice-demo/iamclient"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/breakglass"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/passwordpolicy"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/notifier"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/config"
 )
 
@@ -64,13 +68,6 @@ func WithDBDebugMode() DBOption {
 	}
 }
 
-// WithDBMigration 执行数据库迁移
-func WithDBMigration(models ...interface{}) DBOption {
-	return func(db *gorm.DB) error {
-		return db.AutoMigrate(models...)
-	}
-}
-
 // ProvideLoggerWithOptions 提供带自定义选项的日志器
 // 注意：zerolog 使用不同的配置方式，此函数保留以保持兼容性
 // 实际配置通过 config.CreateLogger 处理
@@ -95,3 +92,49 @@ func ProvideIAMClient(cfg *config.Config) (*iamclient.Client, func(), error) {
 
 	return cli, func() { _ = cli.Close() }, nil
 }
+
+// ProvideBreakGlassGuard 提供紧急账号守卫
+//
+// 未配置 BREAK_GLASS_USER_IDS 时守卫不启用；用户ID无效时拒绝启动。
+// 使用记录写入 identity_srv 自身的审计日志表。
+func ProvideBreakGlassGuard(cfg *config.Config, d dal.DAL) (*breakglass.Guard, error) {
+	return breakglass.NewGuard(cfg.BreakGlass, d.AuditLog())
+}
+
+// ProvidePasswordPolicy 提供密码策略执行器
+//
+// 与通知渠道不同，密码策略是安全控制：字典文件无法加载或配置无效时拒绝启动，避免静默放宽策略。
+func ProvidePasswordPolicy(cfg *config.Config, logger *zerolog.Logger) (*passwordpolicy.Enforcer, error) {
+	enforcer, err := passwordpolicy.NewEnforcerFromConfig(cfg.PasswordPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := enforcer.Defaults()
+	logger.Info().
+		Int("min_length", policy.MinLength).
+		Int("min_char_classes", policy.MinCharClasses).
+		Int("history_size", policy.HistorySize).
+		Dur("max_age", policy.MaxAge).
+		Str("dictionary_file", cfg.PasswordPolicy.DictionaryFile).
+		Msg("全局默认密码策略已加载，组织可单独配置")
+
+	return enforcer, nil
+}
+
+// ProvideNotifier 提供通知发送器（找回密码链接的投递渠道）
+//
+// 配置无效时只记录错误并返回 nil，服务照常启动，发起找回密码会返回错误。
+func ProvideNotifier(cfg *config.Config, logger *zerolog.Logger) notifier.Notifier {
+	n, err := notifier.NewNotifier(&cfg.Notifier)
+	if err != nil {
+		logger.Error().
+			Err(err).
+			Str("notifier_type", cfg.Notifier.Type).
+			Msg("通知发送器初始化失败，自助找回密码不可用")
+
+		return nil
+	}
+
+	return n
+}
@@ -0,0 +1,99 @@
+// Package wire 回收站自动清除任务依赖注入提供者
+package wire
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/wire"
+	"github.com/rs/zerolog"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/config"
+)
+
+// RecycleBinSet 回收站自动清除任务 Provider 集合
+var RecycleBinSet = wire.NewSet(
+	ProvideRecycleBinPurgeJob,
+)
+
+// RecycleBinPurgeJob 回收站自动清除任务
+// 按固定周期彻底删除超过保留期的已删除用户、组织、部门
+type RecycleBinPurgeJob struct {
+	logic     logic.Logic
+	logger    *zerolog.Logger
+	retention time.Duration
+	interval  time.Duration
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// ProvideRecycleBinPurgeJob 提供回收站自动清除任务
+// 返回的 cleanup 停止后台清除，由 Wire 纳入 InitializeApp 的 cleanup
+func ProvideRecycleBinPurgeJob(
+	cfg *config.Config,
+	logicImpl logic.Logic,
+	logger *zerolog.Logger,
+) (*RecycleBinPurgeJob, func(), error) {
+	job := &RecycleBinPurgeJob{
+		logic:     logicImpl,
+		logger:    logger,
+		retention: cfg.RecycleBin.Retention,
+		interval:  cfg.RecycleBin.PurgeInterval,
+		stopCh:    make(chan struct{}),
+	}
+
+	return job, job.Stop, nil
+}
+
+// Start 启动后台清除（在独立的 goroutine 中运行），保留期或周期 <= 0 时不启动
+func (j *RecycleBinPurgeJob) Start() {
+	if j.retention <= 0 || j.interval <= 0 {
+		j.logger.Info().Msg("回收站自动清除已关闭")
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		j.logger.Info().
+			Dur("retention", j.retention).
+			Dur("interval", j.interval).
+			Msg("回收站自动清除已启动")
+
+		for {
+			select {
+			case <-j.stopCh:
+				return
+			case <-ticker.C:
+				j.runOnce()
+			}
+		}
+	}()
+}
+
+// Stop 停止后台清除
+func (j *RecycleBinPurgeJob) Stop() {
+	j.stopOnce.Do(func() {
+		close(j.stopCh)
+	})
+}
+
+// runOnce 执行一轮清除，单轮耗时不超过清除周期
+func (j *RecycleBinPurgeJob) runOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), j.interval)
+	defer cancel()
+
+	count, err := j.logic.PurgeExpiredEntities(ctx, time.Now().Add(-j.retention))
+	if err != nil {
+		j.logger.Error().Err(err).Msg("回收站自动清除失败")
+		return
+	}
+
+	if count > 0 {
+		j.logger.Info().Int("count", count).Msg("回收站自动清除完成")
+	}
+}
@@ -0,0 +1,110 @@
+// Package wire 临时授权回收任务依赖注入提供者
+package wire
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/wire"
+	"github.com/rs/zerolog"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/iamclient"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/config"
+)
+
+// RoleExpirySet 临时授权回收任务 Provider 集合
+var RoleExpirySet = wire.NewSet(
+	ProvideRoleExpiryJob,
+)
+
+// RoleExpiryJob 到期角色分配回收任务
+// 按固定周期回收 valid_until 已过的角色分配，并删除 policy_srv 中对应的 g 规则；
+// 同时把超过审批期限的敏感角色授权申请置为过期，并关闭已过截止时间的访问复核活动
+type RoleExpiryJob struct {
+	logic    logic.Logic
+	iam      *iamclient.Client
+	logger   *zerolog.Logger
+	interval time.Duration
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// ProvideRoleExpiryJob 提供临时授权回收任务
+// 返回的 cleanup 停止后台回收，由 Wire 纳入 InitializeApp 的 cleanup
+func ProvideRoleExpiryJob(
+	cfg *config.Config,
+	logicImpl logic.Logic,
+	iamCli *iamclient.Client,
+	logger *zerolog.Logger,
+) (*RoleExpiryJob, func(), error) {
+	job := &RoleExpiryJob{
+		logic:    logicImpl,
+		iam:      iamCli,
+		logger:   logger,
+		interval: cfg.RoleAssignment.ExpiryInterval,
+		stopCh:   make(chan struct{}),
+	}
+
+	return job, job.Stop, nil
+}
+
+// Start 启动后台回收（在独立的 goroutine 中运行），interval <= 0 时不启动
+func (j *RoleExpiryJob) Start() {
+	if j.interval <= 0 {
+		j.logger.Info().Msg("临时授权自动回收已关闭")
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		j.logger.Info().Dur("interval", j.interval).Msg("临时授权自动回收已启动")
+
+		for {
+			select {
+			case <-j.stopCh:
+				return
+			case <-ticker.C:
+				j.runOnce()
+			}
+		}
+	}()
+}
+
+// Stop 停止后台回收
+func (j *RoleExpiryJob) Stop() {
+	j.stopOnce.Do(func() {
+		close(j.stopCh)
+	})
+}
+
+// runOnce 执行一轮回收，单轮耗时不超过回收周期
+func (j *RoleExpiryJob) runOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), j.interval)
+	defer cancel()
+
+	count, err := j.logic.ExpireRoleAssignments(ctx, j.iam)
+	if err != nil {
+		j.logger.Error().Err(err).Msg("回收到期角色分配失败")
+	} else if count > 0 {
+		j.logger.Info().Int("count", count).Msg("到期角色分配回收完成")
+	}
+
+	count, err = j.logic.ExpireRoleGrantRequests(ctx)
+	if err != nil {
+		j.logger.Error().Err(err).Msg("处理过期角色授权申请失败")
+	} else if count > 0 {
+		j.logger.Info().Int("count", count).Msg("过期角色授权申请处理完成")
+	}
+
+	count, err = j.logic.CloseOverdueAccessReviewCampaigns(ctx, j.iam)
+	if err != nil {
+		j.logger.Error().Err(err).Msg("关闭到期访问复核活动失败")
+	} else if count > 0 {
+		j.logger.Info().Int("count", count).Msg("到期访问复核活动关闭完成")
+	}
+}
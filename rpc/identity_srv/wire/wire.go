@@ -6,9 +6,11 @@ import (
 	"github.com/google/wire"
 	"github.com/rs/zerolog"
 
+	"github.com/masonsxu/cloudwego-microservice-demo/iamclient"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/menu"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/config"
 )
 
@@ -37,11 +39,15 @@ var DALSet = wire.NewSet(
 // LogicSet 业务逻辑层 Provider 集合
 var LogicSet = wire.NewSet(
 	logic.NewLogicImpl,
+	ProvideBreakGlassGuard,
+	ProvideNotifier,
+	ProvidePasswordPolicy,
 )
 
 // IAMClientSet IAM 客户端 Provider 集合（PDP 决策入口）
 var IAMClientSet = wire.NewSet(
 	ProvideIAMClient,
+	wire.Bind(new(menu.PermissionLister), new(*iamclient.Client)),
 )
 
 // ApplicationSet 完整应用 Provider 集合
@@ -60,6 +66,8 @@ var AllSet = wire.NewSet(
 	ApplicationSet,
 	ServerSet,
 	HealthCheckSet,
+	RoleExpirySet,
+	RecycleBinSet,
 	NewAppContainer,
 )
 
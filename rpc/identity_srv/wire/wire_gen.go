@@ -8,9 +8,11 @@ package wire
 
 import (
 	"github.com/google/wire"
+	"github.com/masonsxu/cloudwego-microservice-demo/iamclient"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/converter"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/dal"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/biz/logic/menu"
 	"github.com/masonsxu/cloudwego-microservice-demo/rpc/identity-srv/config"
 	"github.com/rs/zerolog"
 )
@@ -34,19 +36,30 @@ func InitializeApp() (*AppContainer, func(), error) {
 		return nil, nil, err
 	}
 	dalDAL := dal.NewDALImpl(db)
-	logicLogic := logic.NewLogicImpl(dalDAL, configConfig)
-	iamCli, iamCleanup, err := ProvideIAMClient(configConfig)
+	client, cleanup, err := ProvideIAMClient(configConfig)
 	if err != nil {
 		return nil, nil, err
 	}
-	provider, cleanup, err := ProvideOtelProvider(configConfig)
+	guard, err := ProvideBreakGlassGuard(configConfig, dalDAL)
 	if err != nil {
-		iamCleanup()
+		cleanup()
+		return nil, nil, err
+	}
+	notifier := ProvideNotifier(configConfig, logger)
+	enforcer, err := ProvidePasswordPolicy(configConfig, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	logicLogic := logic.NewLogicImpl(dalDAL, configConfig, client, guard, notifier, enforcer)
+	provider, cleanup2, err := ProvideOtelProvider(configConfig)
+	if err != nil {
+		cleanup()
 		return nil, nil, err
 	}
 	registry, err := ProvideEtcdRegistry(configConfig)
 	if err != nil {
-		iamCleanup()
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
@@ -54,20 +67,35 @@ func InitializeApp() (*AppContainer, func(), error) {
 	error2 := ProvideKitexLogger(configConfig)
 	serverOptions, err := ProvideServerOptions(configConfig, provider, registry, metaInfoMiddleware, error2)
 	if err != nil {
-		iamCleanup()
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
 	sqlDB, err := ProvideSQLDB(db)
 	if err != nil {
-		iamCleanup()
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
 	healthCheckServer := ProvideHealthCheckServer(configConfig, sqlDB)
-	appContainer := NewAppContainer(configConfig, logger, db, logicLogic, iamCli, serverOptions, healthCheckServer)
+	roleExpiryJob, cleanup3, err := ProvideRoleExpiryJob(configConfig, logicLogic, client, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	recycleBinPurgeJob, cleanup4, err := ProvideRecycleBinPurgeJob(configConfig, logicLogic, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	appContainer := NewAppContainer(configConfig, logger, db, logicLogic, client, guard, serverOptions, healthCheckServer, roleExpiryJob, recycleBinPurgeJob)
 	return appContainer, func() {
-		iamCleanup()
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -100,10 +128,15 @@ var ConverterSet = wire.NewSet(converter.NewConverter)
 var DALSet = wire.NewSet(dal.NewDALImpl)
 
 // LogicSet 业务逻辑层 Provider 集合
-var LogicSet = wire.NewSet(logic.NewLogicImpl)
+var LogicSet = wire.NewSet(logic.NewLogicImpl, ProvideBreakGlassGuard,
+	ProvideNotifier,
+	ProvidePasswordPolicy,
+)
 
 // IAMClientSet IAM 客户端 Provider 集合（PDP 决策入口）
-var IAMClientSet = wire.NewSet(ProvideIAMClient)
+var IAMClientSet = wire.NewSet(
+	ProvideIAMClient, wire.Bind(new(menu.PermissionLister), new(*iamclient.Client)),
+)
 
 // ApplicationSet 完整应用 Provider 集合
 // 包含业务逻辑相关的所有依赖
@@ -121,5 +154,7 @@ var AllSet = wire.NewSet(
 	ApplicationSet,
 	ServerSet,
 	HealthCheckSet,
+	RoleExpirySet,
+	RecycleBinSet,
 	NewAppContainer,
 )
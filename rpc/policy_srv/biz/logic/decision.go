@@ -91,10 +91,11 @@ func (s *DecisionService) BatchCheck(ctx context.Context, req *pb.BatchCheckRequ
 		}
 
 		results = append(results, &pb.CheckResult{
-			Action:   item.GetAction(),
-			Resource: item.GetResource(),
-			Allowed:  resp.GetAllowed(),
-			Reason:   resp.GetReason(),
+			Action:        item.GetAction(),
+			Resource:      item.GetResource(),
+			Allowed:       resp.GetAllowed(),
+			Reason:        resp.GetReason(),
+			DataScopeHint: resp.GetDataScopeHint(),
 		})
 	}
 
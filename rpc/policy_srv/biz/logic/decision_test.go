@@ -71,6 +71,7 @@ func TestDecisionService_BatchCheck_ReturnsPerItemResults(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, resp.GetResults(), 2)
 	require.True(t, resp.GetResults()[0].GetAllowed())
+	require.Equal(t, "dept", resp.GetResults()[0].GetDataScopeHint())
 	require.False(t, resp.GetResults()[1].GetAllowed())
 }
 
@@ -60,8 +60,14 @@ type EnforcerService struct {
 }
 
 // NewEnforcerService 创建 enforcer 服务（生产路径，使用 GORM adapter）
+//
+// casbin_rule 表由版本化迁移维护，这里关闭 adapter 自带的 AutoMigrate。
+// TurnOffAutoMigrate 会原地改写传入的 *gorm.DB，因此作用在会话副本上。
 func NewEnforcerService(db *gorm.DB, logger *zerolog.Logger) (*EnforcerService, error) {
-	adapter, err := gormadapter.NewAdapterByDB(db)
+	adapterDB := db.Session(&gorm.Session{})
+	gormadapter.TurnOffAutoMigrate(adapterDB)
+
+	adapter, err := gormadapter.NewAdapterByDB(adapterDB)
 	if err != nil {
 		return nil, fmt.Errorf("创建 GORM adapter 失败: %w", err)
 	}
@@ -162,6 +168,8 @@ func (s *EnforcerService) GetPolicyCount() (int, int, int) {
 // 都获得 data_scope=all。配合 identity_srv seeder 中已建立的 superadmin 角色
 // （RoleCode 由 model.GenerateRoleCode 生成为 "role:superadmin"），
 // 即可在首次启动时打通 PDP 决策链路（提案 §14 Phase 4b 后的前置遗漏 #2）。
+// identity_srv 计算菜单时也经 ListPermissions 识别这条通配规则授予全部菜单，
+// 不再按角色名特判 superadmin，菜单可见性与 PDP 决策同源。
 //
 // 行为说明：
 //   - 仅在所有策略表（p / g / g2）都为空时尝试写入，避免污染已有数据；
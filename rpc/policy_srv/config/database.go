@@ -9,8 +9,22 @@ import (
 	gormlogger "gorm.io/gorm/logger"
 )
 
-// InitDB 初始化数据库连接
+// InitDB 初始化数据库连接，并按 MigrateOnBoot 执行或校验版本化迁移
 func InitDB(cfg *Config, logger *zerolog.Logger) (*gorm.DB, error) {
+	db, err := OpenDB(cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := migrateOnBoot(db, cfg, logger); err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// OpenDB 建立数据库连接并配置连接池，不执行迁移（migrate 子命令直接使用）
+func OpenDB(cfg *Config, logger *zerolog.Logger) (*gorm.DB, error) {
 	dsn := fmt.Sprintf(
 		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
 		cfg.Database.Host,
@@ -14,6 +14,7 @@ func mapEnvVarsToConfig(v *viper.Viper) {
 	_ = v.BindEnv("database.password", "DB_PASSWORD")
 	_ = v.BindEnv("database.dbname", "DB_NAME")
 	_ = v.BindEnv("database.sslmode", "DB_SSLMODE")
+	_ = v.BindEnv("database.migrate_on_boot", "DB_MIGRATE_ON_BOOT")
 
 	// Server
 	_ = v.BindEnv("server.port", "SERVER_PORT")
@@ -0,0 +1,64 @@
+package config
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/dbmigrate"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/policy-srv/migrations"
+)
+
+// MigrationTable policy_srv 的迁移记录表
+//
+// 默认与 identity_srv 共用同一个库，使用独立的记录表避免两边互相把对方的迁移视为缺失。
+const MigrationTable = "policy_schema_migrations"
+
+// NewMigrator 基于已建立的连接创建版本化迁移执行器
+func NewMigrator(db *gorm.DB, logger *zerolog.Logger) (*dbmigrate.Migrator, error) {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql.DB: %w", err)
+	}
+
+	list, err := dbmigrate.Load(migrations.FS, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	return dbmigrate.New(sqlDB, list,
+		dbmigrate.WithTable(MigrationTable),
+		dbmigrate.WithLogger(func(format string, args ...any) {
+			logger.Info().Msgf(format, args...)
+		}),
+	), nil
+}
+
+// migrateOnBoot 启动时执行（MigrateOnBoot=true）或仅校验（false）迁移
+func migrateOnBoot(db *gorm.DB, cfg *Config, logger *zerolog.Logger) error {
+	m, err := NewMigrator(db, logger)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	if !cfg.Database.MigrateOnBoot {
+		if err := m.Verify(ctx); err != nil {
+			return fmt.Errorf("数据库 schema 版本校验失败（可执行 migrate up 升级）: %w", err)
+		}
+
+		return nil
+	}
+
+	done, err := m.Up(ctx)
+	if err != nil {
+		return fmt.Errorf("数据库迁移失败: %w", err)
+	}
+
+	logger.Info().Int("applied", len(done)).Msg("数据库迁移完成")
+
+	return nil
+}
@@ -65,4 +65,7 @@ type DatabaseConfig struct {
 	MaxOpenConns    int           `mapstructure:"max_open_conns"`
 	MaxIdleConns    int           `mapstructure:"max_idle_conns"`
 	ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime"`
+
+	// MigrateOnBoot 启动时自动执行未执行的迁移（默认开启）；关闭后仅校验版本，不一致拒绝启动
+	MigrateOnBoot bool `mapstructure:"migrate_on_boot"`
 }
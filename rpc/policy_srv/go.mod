@@ -10,6 +10,7 @@ require (
 	github.com/google/wire v0.7.0
 	github.com/kitex-contrib/obs-opentelemetry v0.3.0
 	github.com/kitex-contrib/registry-etcd v0.3.0
+	github.com/masonsxu/cloudwego-microservice-demo/dbmigrate v0.0.0-00010101000000-000000000000
 	github.com/rs/zerolog v1.35.1
 	github.com/spf13/viper v1.21.0
 	github.com/stretchr/testify v1.11.1
@@ -127,3 +128,5 @@ require (
 	modernc.org/memory v1.11.0 // indirect
 	modernc.org/sqlite v1.42.2 // indirect
 )
+
+replace github.com/masonsxu/cloudwego-microservice-demo/dbmigrate => ../../dbmigrate
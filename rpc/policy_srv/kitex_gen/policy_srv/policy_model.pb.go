@@ -200,10 +200,11 @@ func (x *BatchCheckResponse) GetResults() []*CheckResult {
 }
 
 type CheckResult struct {
-	Action   string `protobuf:"bytes,1,opt,name=action" json:"action,omitempty"`
-	Resource string `protobuf:"bytes,2,opt,name=resource" json:"resource,omitempty"`
-	Allowed  bool   `protobuf:"varint,3,opt,name=allowed" json:"allowed,omitempty"`
-	Reason   string `protobuf:"bytes,4,opt,name=reason" json:"reason,omitempty"`
+	Action        string `protobuf:"bytes,1,opt,name=action" json:"action,omitempty"`
+	Resource      string `protobuf:"bytes,2,opt,name=resource" json:"resource,omitempty"`
+	Allowed       bool   `protobuf:"varint,3,opt,name=allowed" json:"allowed,omitempty"`
+	Reason        string `protobuf:"bytes,4,opt,name=reason" json:"reason,omitempty"`
+	DataScopeHint string `protobuf:"bytes,5,opt,name=data_scope_hint" json:"data_scope_hint,omitempty"` // 数据范围提示（可选）
 }
 
 func (x *CheckResult) Reset() { *x = CheckResult{} }
@@ -240,6 +241,13 @@ func (x *CheckResult) GetReason() string {
 	return ""
 }
 
+func (x *CheckResult) GetDataScopeHint() string {
+	if x != nil {
+		return x.DataScopeHint
+	}
+	return ""
+}
+
 // ListPermissionsRequest 查询主体所有权限
 type ListPermissionsRequest struct {
 	Subject *Subject `protobuf:"bytes,1,opt,name=subject" json:"subject,omitempty"`
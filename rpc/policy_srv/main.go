@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"os"
 
 	"github.com/cloudwego/kitex/pkg/klog"
 
@@ -10,6 +11,11 @@ import (
 )
 
 func main() {
+	// migrate 子命令：只执行数据库迁移，不启动服务
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(runMigrate(os.Args[2:]))
+	}
+
 	container, cleanup, err := wire.InitializeApp()
 	if err != nil {
 		klog.Fatalf("初始化应用失败: %v", err)
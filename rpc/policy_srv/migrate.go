@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/masonsxu/cloudwego-microservice-demo/dbmigrate"
+	"github.com/masonsxu/cloudwego-microservice-demo/rpc/policy-srv/config"
+)
+
+// runMigrate 执行 migrate 子命令，返回进程退出码
+//
+// 只建立数据库连接、不初始化其他依赖，也不会触发启动时的自动迁移与默认策略写入：
+//
+//	policy-srv migrate up | down N | status | verify
+func runMigrate(args []string) int {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "加载配置失败: %v\n", err)
+		return 1
+	}
+
+	logger, err := config.CreateLogger(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "初始化日志失败: %v\n", err)
+		return 1
+	}
+
+	db, err := config.OpenDB(cfg, logger)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "连接数据库失败: %v\n", err)
+		return 1
+	}
+
+	if sqlDB, err := db.DB(); err == nil {
+		defer sqlDB.Close()
+	}
+
+	m, err := config.NewMigrator(db, logger)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "加载迁移失败: %v\n", err)
+		return 1
+	}
+
+	if err := dbmigrate.Run(context.Background(), m, args, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+
+		if errors.Is(err, dbmigrate.ErrUsage) {
+			return 2
+		}
+
+		return 1
+	}
+
+	return 0
+}
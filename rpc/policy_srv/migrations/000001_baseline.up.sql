@@ -0,0 +1,17 @@
+-- 基线迁移：policy_srv 的 casbin_rule 策略表，与 gorm-adapter 自动建表的结构一致。
+--
+-- 全部语句幂等（IF NOT EXISTS）：对已由 adapter 建好表的存量库执行时只补记版本，不改动数据。
+
+CREATE TABLE IF NOT EXISTS casbin_rule (
+    id bigserial,
+    ptype varchar(100),
+    v0 varchar(100),
+    v1 varchar(100),
+    v2 varchar(100),
+    v3 varchar(100),
+    v4 varchar(100),
+    v5 varchar(100),
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_casbin_rule ON casbin_rule (ptype, v0, v1, v2, v3, v4, v5);
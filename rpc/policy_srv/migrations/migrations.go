@@ -0,0 +1,12 @@
+// Package migrations 内嵌 policy_srv 的版本化 SQL 迁移脚本。
+//
+// 新增迁移：按 <version>_<name>.up.sql / .down.sql 命名放入本目录，版本号递增且不可复用；
+// 已发布的脚本禁止修改（校验和不一致时 migrate up 会拒绝执行）。
+package migrations
+
+import "embed"
+
+// FS 迁移脚本文件系统，配合 dbmigrate.Load(FS, ".") 使用
+//
+//go:embed *.sql
+var FS embed.FS
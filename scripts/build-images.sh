@@ -34,7 +34,7 @@ build_policy() {
     podman build \
         -f "${ROOT_DIR}/rpc/policy_srv/docker/Dockerfile" \
         -t policy-srv:latest \
-        "${ROOT_DIR}/rpc/policy_srv"
+        "${ROOT_DIR}"
 }
 
 case "${TARGET}" in
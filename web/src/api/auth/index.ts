@@ -48,6 +48,25 @@ export function resetPassword(userId: string, newPassword: string, resetReason?:
   })
 }
 
+// 找回密码：向账号绑定的邮箱发送一次性重置链接
+// 无论账号是否存在都返回成功，避免账号枚举
+export function requestPasswordReset(identifier: string) {
+  return request({
+    url: '/api/v1/identity/auth/password/forgot',
+    method: 'POST',
+    data: { identifier },
+  })
+}
+
+// 凭重置链接中的一次性令牌设置新密码，成功后该账号的全部会话失效
+export function confirmPasswordReset(token: string, newPassword: string) {
+  return request({
+    url: '/api/v1/identity/auth/password/reset/confirm',
+    method: 'POST',
+    data: { token, new_password: newPassword },
+  })
+}
+
 // 刷新访问令牌（单 Token 方案）
 // 后端从 Authorization Header 读取当前 token 作为刷新凭证
 export function refreshToken() {
@@ -70,5 +89,7 @@ export const authApi = {
   changePassword,
   forcePasswordChange,
   resetPassword,
+  requestPasswordReset,
+  confirmPasswordReset,
   refreshToken,
 }
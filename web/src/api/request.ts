@@ -120,6 +120,11 @@ instance.interceptors.response.use(
             return Promise.reject(refreshError)
           }
 
+        case 400:
+          // 参数校验或业务规则不通过（如重置链接失效、密码不符合策略），展示服务端原因
+          toast.error((error.response.data as any)?.base_resp?.message || '请求参数错误')
+          break
+
         case 403:
           toast.error('没有权限访问')
           break
@@ -128,6 +133,11 @@ instance.interceptors.response.use(
           toast.error('请求的资源不存在')
           break
 
+        case 409:
+          // 乐观锁版本冲突：数据已被他人修改，提示刷新后重试
+          toast.error((error.response.data as any)?.base_resp?.message || '数据已被他人修改，请刷新后重试')
+          break
+
         case 500:
           toast.error('服务器内部错误')
           break
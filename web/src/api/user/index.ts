@@ -3,7 +3,9 @@ import type {
   UserProfile,
   UserListItem,
   CreateUserRequest,
-  UpdateUserRequest
+  UpdateUserRequest,
+  UserFileFormat,
+  ImportUsersResponse
 } from '@/types/user'
 import type { PageParams, PageResponse } from '@/types/api'
 
@@ -93,3 +95,33 @@ export function searchUsers(params: PageParams & { organization_id?: string }) {
     params
   })
 }
+
+// 批量导入用户（dry_run 为 true 时仅校验不写入）
+export function importUsers(
+  file: File,
+  options: { format?: UserFileFormat; dry_run?: boolean; batch_size?: number } = {}
+) {
+  const formData = new FormData()
+  formData.append('file', file)
+  if (options.format) formData.append('format', options.format)
+  if (options.dry_run !== undefined) formData.append('dry_run', String(options.dry_run))
+  if (options.batch_size) formData.append('batch_size', String(options.batch_size))
+  return request<ImportUsersResponse>({
+    url: '/api/v1/identity/users/import',
+    method: 'POST',
+    data: formData,
+    headers: { 'Content-Type': 'multipart/form-data' },
+    timeout: 120000
+  })
+}
+
+// 导出用户为文件
+export function exportUsers(params: { format?: UserFileFormat; organization_id?: string; status?: number }) {
+  return request<Blob>({
+    url: '/api/v1/identity/users/export',
+    method: 'GET',
+    params,
+    responseType: 'blob',
+    timeout: 120000
+  })
+}
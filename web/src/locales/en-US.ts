@@ -91,11 +91,20 @@ export default {
     forgotBrandTitle: 'Recover your access',
     forgotBrandSubtitle: 'We will send a password reset link to your registered email. Verify your identity to set a new password.',
     forgotTitle: 'Reset password',
-    forgotSubtitle: "We'll send a reset link to your email",
+    forgotSubtitle: "Enter your username, email or phone and we'll email you a reset link",
+    identifier: 'Username / email / phone',
+    identifierRequired: 'Please enter your username, email or phone',
     sending: 'Sending…',
     sendResetLink: 'Send reset link',
     backToLogin: 'Back to login',
-    forgotComingSoon: 'Password reset coming soon',
+    resetLinkSent: 'If the account exists and has an email on file, a reset link is on its way. Please check your inbox.',
+    resetTitle: 'Set a new password',
+    resetSubtitle: 'The link can be used once. All signed-in devices will be signed out after the reset.',
+    resetSubmit: 'Reset password',
+    resetting: 'Resetting…',
+    resetSuccess: 'Password reset. Please sign in with your new password',
+    resetLinkInvalid: 'This reset link is invalid or has expired',
+    requestNewLink: 'Request a new link',
     loginSuccess: 'Login successful',
     loginFailed: 'Login failed',
     logoutConfirm: 'Are you sure to logout?',
@@ -305,7 +314,14 @@ export default {
       delete: 'Delete',
       login: 'Login',
       logout: 'Logout',
-      passwordChange: 'Password Change'
+      passwordChange: 'Password Change',
+      breakGlass: 'Break-glass Access',
+      roleGrantRequest: 'Role Grant Request',
+      roleGrantApprove: 'Role Grant Approved',
+      roleGrantReject: 'Role Grant Rejected',
+      roleGrantExpire: 'Role Grant Expired',
+      passwordResetRequest: 'Password Reset Requested',
+      passwordReset: 'Password Reset'
     }
   },
   oidc: {
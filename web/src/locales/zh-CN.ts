@@ -94,11 +94,20 @@ export default {
     forgotBrandTitle: '找回访问权限',
     forgotBrandSubtitle: '我们将通过你预留的邮箱发送密码重置链接，验证身份后即可重新设置。',
     forgotTitle: '重置密码',
-    forgotSubtitle: '我们会发送重置链接到你的邮箱',
+    forgotSubtitle: '输入用户名、邮箱或手机号，我们会发送重置链接到账号绑定的邮箱',
+    identifier: '用户名 / 邮箱 / 手机号',
+    identifierRequired: '请输入用户名、邮箱或手机号',
     sending: '发送中…',
     sendResetLink: '发送重置链接',
     backToLogin: '返回登录',
-    forgotComingSoon: '密码重置功能即将开放',
+    resetLinkSent: '如果账号存在且已绑定邮箱，重置链接已发送，请查收邮件',
+    resetTitle: '设置新密码',
+    resetSubtitle: '链接只能使用一次，重置后所有已登录设备都将退出',
+    resetSubmit: '重置密码',
+    resetting: '重置中…',
+    resetSuccess: '密码已重置，请使用新密码登录',
+    resetLinkInvalid: '重置链接无效或已过期',
+    requestNewLink: '重新获取链接',
     loginSuccess: '登录成功',
     loginFailed: '登录失败',
     logoutConfirm: '确定要退出登录吗？',
@@ -308,7 +317,14 @@ export default {
       delete: '删除',
       login: '登录',
       logout: '登出',
-      passwordChange: '密码修改'
+      passwordChange: '密码修改',
+      breakGlass: '紧急账号访问',
+      roleGrantRequest: '角色授权申请',
+      roleGrantApprove: '角色授权审批通过',
+      roleGrantReject: '角色授权驳回',
+      roleGrantExpire: '角色授权申请过期',
+      passwordResetRequest: '申请找回密码',
+      passwordReset: '找回密码重置'
     }
   },
   oidc: {
@@ -38,6 +38,12 @@ const routes: RouteRecordRaw[] = [
     component: () => import('@/views/auth/ForgotPassword.vue'),
     meta: { requiresAuth: false, title: 'auth.forgotPassword' } satisfies AppRouteMeta
   },
+  {
+    path: '/reset-password',
+    name: 'ResetPassword',
+    component: () => import('@/views/auth/ResetPassword.vue'),
+    meta: { requiresAuth: false, title: 'auth.resetTitle' } satisfies AppRouteMeta
+  },
   {
     path: '/oidc/callback',
     name: 'OIDCCallback',
@@ -15,6 +15,8 @@ export interface Organization {
   department_count?: number
   created_at: number
   updated_at?: number
+  // 乐观锁版本号，更新时原样回传
+  version?: number
   parent?: Organization
   children?: Organization[]
 }
@@ -30,6 +32,8 @@ export interface Department {
   member_count?: number
   created_at: number
   updated_at?: number
+  // 乐观锁版本号，更新时原样回传
+  version?: number
   organization?: Organization
 }
 
@@ -49,6 +53,7 @@ export interface UpdateOrganizationRequest {
   facility_type?: string
   accreditation_status?: string
   province_city?: string[]
+  version?: number
 }
 
 // 创建部门请求
@@ -62,4 +67,5 @@ export interface CreateDepartmentRequest {
 export interface UpdateDepartmentRequest {
   name?: string
   department_type?: string
+  version?: number
 }
@@ -29,6 +29,8 @@ export interface UserProfile {
   status: UserStatus
   created_at: number
   updated_at?: number
+  // 乐观锁版本号，更新时原样回传
+  version?: number
   account_expiry?: number
   must_change_password?: boolean
   // 扩展字段
@@ -64,6 +66,8 @@ export interface UserListItem extends UserProfile {
 export interface LoginRequest {
   username: string
   password: string
+  // 客户端标识，用于选择菜单产品线；不传时使用 default
+  client_id?: string
 }
 
 // 登录响应
@@ -123,6 +127,28 @@ export interface UpdateUserRequest {
   gender?: Gender
   role_ids?: string[]
   organization_id?: string
+  version?: number
+}
+
+// 批量导入/导出文件格式
+export type UserFileFormat = 'csv' | 'xlsx'
+
+// 批量导入逐行结果
+export interface UserImportRowResult {
+  row_number: number
+  username?: string
+  errors?: string[]
+  user_id?: string
+}
+
+// 批量导入响应
+export interface ImportUsersResponse {
+  dry_run: boolean
+  total_rows: number
+  valid_rows: number
+  created_rows: number
+  failed_rows: number
+  rows?: UserImportRowResult[]
 }
 
 // 角色